@@ -12,6 +12,7 @@ import (
 	"github.com/gravitrone/nebula-core/cli/internal/cmd"
 	"github.com/gravitrone/nebula-core/cli/internal/config"
 	"github.com/gravitrone/nebula-core/cli/internal/ui"
+	"github.com/gravitrone/nebula-core/cli/internal/version"
 )
 
 var runBubbleTUI = func(app tea.Model) error {
@@ -32,9 +33,10 @@ func main() {
 // newRootCommand handles new root command.
 func newRootCommand() *cobra.Command {
 	root := &cobra.Command{
-		Use:   "nebula",
-		Short: "Nebula - agent context layer",
-		Long:  "Nebula CLI: manage entities, approve agent actions, add context, and monitor jobs.",
+		Use:     "nebula",
+		Short:   "Nebula - agent context layer",
+		Long:    "Nebula CLI: manage entities, approve agent actions, add context, and monitor jobs.",
+		Version: version.Version,
 		RunE: func(_ *cobra.Command, _ []string) error {
 			return runTUI()
 		},
@@ -45,12 +47,29 @@ func newRootCommand() *cobra.Command {
 	root.AddCommand(cmd.LoginCmd())
 	root.AddCommand(cmd.AgentCmd())
 	root.AddCommand(cmd.KeysCmd())
+	root.AddCommand(cmd.ProfileCmd())
 	root.AddCommand(cmd.StartCmd())
 	root.AddCommand(cmd.StopCmd())
 	root.AddCommand(cmd.LogsCmd())
 	root.AddCommand(cmd.DoctorCmd())
 	root.AddCommand(cmd.APICmd())
+	root.AddCommand(cmd.PluginsCmd())
+	root.AddCommand(cmd.ProxyCmd())
+	root.AddCommand(cmd.ContextCmd())
+	root.AddCommand(cmd.SyncCmd())
+	root.AddCommand(cmd.KnowledgeCmd())
+	root.AddCommand(cmd.IngestCmd())
+	root.AddCommand(cmd.ReportCmd())
+	root.AddCommand(cmd.JobsCmd())
+	root.AddCommand(cmd.FilesCmd())
+	root.AddCommand(cmd.UpdateCmd())
+	root.AddCommand(cmd.OpenCmd(func(link ui.DeepLink) error {
+		return runTUIAt(&link)
+	}))
 	cmd.AttachOutputFlags(root, cmd.OutputModeAuto)
+	cmd.AttachProfileFlag(root)
+	cmd.AttachKeyringMigration(root)
+	cmd.AttachCompletion(root)
 	cmd.ApplyNebulaHelp(root)
 
 	return root
@@ -65,6 +84,11 @@ func init() {
 
 // runTUI runs run tui.
 func runTUI() error {
+	return runTUIAt(nil)
+}
+
+// runTUIAt runs the TUI, opening link on launch when it is set.
+func runTUIAt(link *ui.DeepLink) error {
 	cfg, err := config.Load()
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
@@ -79,11 +103,17 @@ func runTUI() error {
 	}
 
 	apiKey := ""
+	baseURL := ""
 	if cfg != nil {
 		apiKey = cfg.APIKey
+		baseURL = cfg.APIURL
 	}
-	client := api.NewDefaultClient(apiKey)
+	client := api.NewClient(api.ResolveBaseURL(baseURL), apiKey, cfg.RequestTimeoutDuration())
+	cmd.AttachTokenRefresh(client, cfg)
 	app := ui.NewApp(client, cfg)
+	if link != nil {
+		app = app.WithDeepLink(*link)
+	}
 
 	if err := runBubbleTUI(app); err != nil {
 		return fmt.Errorf("tui error: %w", err)
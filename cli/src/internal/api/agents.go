@@ -42,3 +42,21 @@ func (c *Client) UpdateAgent(id string, input UpdateAgentInput) (*Agent, error)
 	}
 	return decodeOne[Agent](data)
 }
+
+// GetAgentPermissions gets the permission set for an agent.
+func (c *Client) GetAgentPermissions(id string) (*AgentPermissions, error) {
+	data, err := c.get(fmt.Sprintf("/api/agents/%s/permissions", id))
+	if err != nil {
+		return nil, err
+	}
+	return decodeOne[AgentPermissions](data)
+}
+
+// UpdateAgentPermissions replaces the permission set for an agent.
+func (c *Client) UpdateAgentPermissions(id string, input UpdateAgentPermissionsInput) (*AgentPermissions, error) {
+	data, err := c.patch(fmt.Sprintf("/api/agents/%s/permissions", id), input)
+	if err != nil {
+		return nil, err
+	}
+	return decodeOne[AgentPermissions](data)
+}
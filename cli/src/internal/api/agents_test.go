@@ -113,3 +113,38 @@ func TestRegisterAgentDuplicate(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "DUPLICATE")
 }
+
+// TestAgentPermissionsRoundTrip handles test agent permissions round trip.
+func TestAgentPermissionsRoundTrip(t *testing.T) {
+	_, client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/agents/ag-1/permissions", r.URL.Path)
+		if r.Method == http.MethodPatch {
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, []any{"create_entity"}, body["request_types"])
+			assert.Equal(t, float64(30), body["rate_limit_per_minute"])
+			assert.Contains(t, body, "expires_at")
+			assert.Nil(t, body["expires_at"])
+		}
+		_, _ = w.Write(jsonResponse(map[string]any{
+			"agent_id":              "ag-1",
+			"scopes":                []string{"public"},
+			"request_types":         []string{"create_entity"},
+			"rate_limit_per_minute": 30,
+			"expires_at":            "2026-12-31T23:59:00Z",
+		}))
+	})
+
+	perms, err := client.GetAgentPermissions("ag-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"public"}, perms.Scopes)
+	assert.Equal(t, 30, perms.RateLimitPerMinute)
+	require.NotNil(t, perms.ExpiresAt)
+
+	_, err = client.UpdateAgentPermissions("ag-1", UpdateAgentPermissionsInput{
+		Scopes:             []string{"public"},
+		RequestTypes:       []string{"create_entity"},
+		RateLimitPerMinute: 30,
+	})
+	require.NoError(t, err)
+}
@@ -10,10 +10,17 @@ func (c *Client) GetPendingApprovals() ([]Approval, error) {
 
 // GetPendingApprovalsWithParams gets get pending approvals with params.
 func (c *Client) GetPendingApprovalsWithParams(limit, offset int) ([]Approval, error) {
-	data, err := c.get(buildQuery("/api/approvals/pending", QueryParams{
+	return c.GetPendingApprovalsInWindow(limit, offset, TimeWindow{})
+}
+
+// GetPendingApprovalsInWindow gets pending approvals created within window.
+func (c *Client) GetPendingApprovalsInWindow(limit, offset int, window TimeWindow) ([]Approval, error) {
+	params := QueryParams{
 		"limit":  fmt.Sprintf("%d", limit),
 		"offset": fmt.Sprintf("%d", offset),
-	}))
+	}
+	window.Apply(params)
+	data, err := c.get(buildQuery("/api/approvals/pending", params))
 	if err != nil {
 		return nil, err
 	}
@@ -57,6 +64,20 @@ func (c *Client) RejectRequest(id string, notes string) (*Approval, error) {
 	return decodeOne[Approval](data)
 }
 
+// DelegateApproval hands a pending approval to another entity. An empty to
+// clears the delegation.
+func (c *Client) DelegateApproval(id, to string) (*Approval, error) {
+	body := map[string]any{"to": nil}
+	if to != "" {
+		body["to"] = to
+	}
+	data, err := c.post(fmt.Sprintf("/api/approvals/%s/delegate", id), body)
+	if err != nil {
+		return nil, err
+	}
+	return decodeOne[Approval](data)
+}
+
 // GetApprovalDiff gets get approval diff.
 func (c *Client) GetApprovalDiff(id string) (*ApprovalDiff, error) {
 	data, err := c.get(fmt.Sprintf("/api/approvals/%s/diff", id))
@@ -65,3 +86,21 @@ func (c *Client) GetApprovalDiff(id string) (*ApprovalDiff, error) {
 	}
 	return decodeOne[ApprovalDiff](data)
 }
+
+// ListApprovalComments lists the comment thread of an approval, oldest first.
+func (c *Client) ListApprovalComments(id string) ([]ApprovalComment, error) {
+	data, err := c.get(fmt.Sprintf("/api/approvals/%s/comments", id))
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[ApprovalComment](data)
+}
+
+// AddApprovalComment posts a comment or reply without reviewing the approval.
+func (c *Client) AddApprovalComment(id string, input CreateApprovalCommentInput) (*ApprovalComment, error) {
+	data, err := c.post(fmt.Sprintf("/api/approvals/%s/comments", id), input)
+	if err != nil {
+		return nil, err
+	}
+	return decodeOne[ApprovalComment](data)
+}
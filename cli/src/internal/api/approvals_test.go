@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -161,3 +162,49 @@ func TestApproveRequestWithInput(t *testing.T) {
 	assert.Equal(t, []any{"public", "private"}, body["grant_scopes"])
 	assert.Equal(t, false, body["grant_requires_approval"])
 }
+
+// TestApprovalComments handles test approval comments.
+func TestApprovalComments(t *testing.T) {
+	_, client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/approvals/ap-1/comments", r.URL.Path)
+		switch r.Method {
+		case http.MethodGet:
+			_, err := w.Write(jsonResponse([]map[string]any{
+				{"id": "c-1", "approval_id": "ap-1", "author_name": "alxx", "author_type": "user", "body": "why?"},
+				{"id": "c-2", "approval_id": "ap-1", "parent_id": "c-1", "author_name": "scout", "author_type": "agent", "body": "dedupe"},
+			}))
+			require.NoError(t, err)
+		case http.MethodPost:
+			var body map[string]string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, map[string]string{"body": "thanks", "parent_id": "c-2"}, body)
+			_, err := w.Write(jsonResponse(map[string]any{"id": "c-3", "approval_id": "ap-1", "body": "thanks"}))
+			require.NoError(t, err)
+		}
+	})
+
+	comments, err := client.ListApprovalComments("ap-1")
+	require.NoError(t, err)
+	require.Len(t, comments, 2)
+	require.NotNil(t, comments[1].ParentID)
+	assert.Equal(t, "c-1", *comments[1].ParentID)
+
+	created, err := client.AddApprovalComment("ap-1", CreateApprovalCommentInput{Body: "thanks", ParentID: "c-2"})
+	require.NoError(t, err)
+	assert.Equal(t, "c-3", created.ID)
+}
+
+// TestGetPendingApprovalsInWindowSendsBounds checks since and until params.
+func TestGetPendingApprovalsInWindowSendsBounds(t *testing.T) {
+	_, client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/approvals/pending", r.URL.Path)
+		assert.Equal(t, "2024-01-01T00:00:00Z", r.URL.Query().Get("since"))
+		assert.Empty(t, r.URL.Query().Get("until"))
+		_, err := w.Write(jsonResponse([]map[string]any{}))
+		require.NoError(t, err)
+	})
+
+	window := TimeWindow{Since: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	_, err := client.GetPendingApprovalsInWindow(10, 0, window)
+	require.NoError(t, err)
+}
@@ -1,6 +1,9 @@
 package api
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+)
 
 // QueryAuditLog retrieves audit log entries with optional filters.
 func (c *Client) QueryAuditLog(params QueryParams) ([]AuditEntry, error) {
@@ -11,6 +14,29 @@ func (c *Client) QueryAuditLog(params QueryParams) ([]AuditEntry, error) {
 	return decodeList[AuditEntry](data)
 }
 
+// QueryAuditRecords retrieves audit log entries as the raw JSON the server sent,
+// so exports keep every field the hash chain covers.
+func (c *Client) QueryAuditRecords(params QueryParams) ([]json.RawMessage, error) {
+	data, err := c.get(buildQuery("/api/audit", params))
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[json.RawMessage](data)
+}
+
+// GetAuditIntegrity retrieves the signed chain head, optionally at a given entry.
+func (c *Client) GetAuditIntegrity(entryID string) (*AuditIntegrity, error) {
+	params := QueryParams{}
+	if entryID != "" {
+		params["entry_id"] = entryID
+	}
+	data, err := c.get(buildQuery("/api/audit/integrity", params))
+	if err != nil {
+		return nil, err
+	}
+	return decodeOne[AuditIntegrity](data)
+}
+
 // QueryAuditLogWithPagination builds common audit params.
 func (c *Client) QueryAuditLogWithPagination(
 	tableName string,
@@ -21,8 +47,25 @@ func (c *Client) QueryAuditLogWithPagination(
 	scopeID string,
 	limit int,
 	offset int,
+) ([]AuditEntry, error) {
+	return c.QueryAuditLogInWindow(tableName, action, actorType, actorID, recordID, scopeID, TimeWindow{}, limit, offset)
+}
+
+// QueryAuditLogInWindow is QueryAuditLogWithPagination limited to entries
+// changed within window.
+func (c *Client) QueryAuditLogInWindow(
+	tableName string,
+	action string,
+	actorType string,
+	actorID string,
+	recordID string,
+	scopeID string,
+	window TimeWindow,
+	limit int,
+	offset int,
 ) ([]AuditEntry, error) {
 	params := QueryParams{}
+	window.Apply(params)
 	if tableName != "" {
 		params["table"] = tableName
 	}
@@ -67,3 +110,13 @@ func (c *Client) ListAuditActors(actorType string) ([]AuditActor, error) {
 	}
 	return decodeList[AuditActor](data)
 }
+
+// GetAuditStats retrieves daily counts and top actors, tables, and records
+// for audit entries matching params.
+func (c *Client) GetAuditStats(params QueryParams) (*AuditStats, error) {
+	data, err := c.get(buildQuery("/api/audit/stats", params))
+	if err != nil {
+		return nil, err
+	}
+	return decodeOne[AuditStats](data)
+}
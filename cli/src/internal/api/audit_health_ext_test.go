@@ -123,3 +123,47 @@ func TestHealthHTTPErrorPath(t *testing.T) {
 	require.Error(t, err)
 	assert.ErrorContains(t, err, "UNAVAILABLE")
 }
+
+func TestGetAuditIntegrityPassesEntryID(t *testing.T) {
+	_, client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/api/audit/integrity", r.URL.Path)
+		assert.Equal(t, "audit-9", r.URL.Query().Get("entry_id"))
+		_, err := w.Write(jsonResponse(map[string]any{
+			"entry_id":  "audit-9",
+			"hash":      "abc123",
+			"algorithm": "ed25519",
+		}))
+		require.NoError(t, err)
+	})
+
+	integrity, err := client.GetAuditIntegrity("audit-9")
+	require.NoError(t, err)
+	assert.Equal(t, "abc123", integrity.Hash)
+	assert.Equal(t, "ed25519", integrity.Algorithm)
+}
+
+func TestGetAuditStatsDecodesSummary(t *testing.T) {
+	_, client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/audit/stats", r.URL.Path)
+		assert.Equal(t, "entities", r.URL.Query().Get("table"))
+		_, err := w.Write(jsonResponse(map[string]any{
+			"total": 3,
+			"daily": []map[string]any{{"day": "2024-01-02", "action_count": 3}},
+			"top_actors": []map[string]any{
+				{"changed_by_type": "agent", "changed_by_id": "agent-1", "actor_name": "bot", "action_count": 3},
+			},
+			"top_tables":  []map[string]any{{"table_name": "entities", "action_count": 3}},
+			"top_records": []map[string]any{{"table_name": "entities", "record_id": "ent-1", "action_count": 2}},
+		}))
+		require.NoError(t, err)
+	})
+
+	stats, err := client.GetAuditStats(QueryParams{"table": "entities"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, stats.Total)
+	require.Len(t, stats.Daily, 1)
+	assert.Equal(t, "2024-01-02", stats.Daily[0].Day)
+	assert.Equal(t, "agent-1", stats.TopActors[0].ActorID)
+	assert.Equal(t, "ent-1", stats.TopRecords[0].RecordID)
+}
@@ -0,0 +1,168 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// tokenRefreshWindow is how early before expiry an access token is renewed.
+const tokenRefreshWindow = 30 * time.Second
+
+// Device login poll outcomes reported by the server.
+var (
+	ErrAuthorizationPending = errors.New("authorization pending")
+	ErrSlowDown             = errors.New("polling too fast")
+	ErrDeviceCodeExpired    = errors.New("device code expired")
+	ErrAccessDenied         = errors.New("access denied")
+)
+
+// ErrSSOUnavailable reports a server without the device login endpoints.
+var ErrSSOUnavailable = errors.New("sso login is not available on this server")
+
+// --- Auth Methods ---
+
+// StartDeviceLogin begins an SSO device-code login (unauthenticated). Servers
+// without an SSO provider answer 404, reported as ErrSSOUnavailable.
+func (c *Client) StartDeviceLogin() (*DeviceCode, error) {
+	data, err := c.post("/api/auth/device", map[string]string{"client_id": "nebula-cli"})
+	if err != nil {
+		var apiErr *Error
+		if errors.As(err, &apiErr) && apiErr.Status == http.StatusNotFound {
+			return nil, ErrSSOUnavailable
+		}
+		return nil, err
+	}
+	return decodeOne[DeviceCode](data)
+}
+
+// PollDeviceToken exchanges a device code for a token once the user has
+// approved the login. Pending, slow-down, expired, and denied answers come
+// back as the matching sentinel errors.
+func (c *Client) PollDeviceToken(deviceCode string) (*OAuthToken, error) {
+	data, err := c.post("/api/auth/device/token", map[string]string{"device_code": deviceCode})
+	if err != nil {
+		return nil, deviceTokenError(err)
+	}
+	return decodeOne[OAuthToken](data)
+}
+
+// RefreshToken trades a refresh token for a new access token.
+func (c *Client) RefreshToken(refreshToken string) (*OAuthToken, error) {
+	// Sent directly so a failed refresh never triggers another refresh.
+	body, err := json.Marshal(map[string]string{"refresh_token": refreshToken})
+	if err != nil {
+		return nil, fmt.Errorf("marshal body: %w", err)
+	}
+	data, status, _, err := c.send(context.Background(), http.MethodPost, "/api/auth/token/refresh", body, true)
+	if err == nil {
+		data, _, err = checkResponse(data, status)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("refresh token: %w", err)
+	}
+	return decodeOne[OAuthToken](data)
+}
+
+// deviceTokenError maps OAuth device flow error codes onto sentinel errors.
+func deviceTokenError(err error) error {
+	lower := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(lower, "authorization_pending"):
+		return ErrAuthorizationPending
+	case strings.Contains(lower, "slow_down"):
+		return ErrSlowDown
+	case strings.Contains(lower, "expired_token"):
+		return ErrDeviceCodeExpired
+	case strings.Contains(lower, "access_denied"):
+		return ErrAccessDenied
+	}
+	return err
+}
+
+// ExpiresAt returns when the access token stops working, zero if unknown.
+func (t OAuthToken) ExpiresAt(now time.Time) time.Time {
+	if t.ExpiresIn <= 0 {
+		return time.Time{}
+	}
+	return now.Add(time.Duration(t.ExpiresIn) * time.Second)
+}
+
+// SetOAuthToken switches the client to an SSO access token. With a refresh
+// token set, the client renews the access token shortly before expiresAt and
+// once after a 401.
+func (c *Client) SetOAuthToken(accessToken, refreshToken string, expiresAt time.Time) {
+	c = c.root()
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+	c.apiKey = accessToken
+	c.refreshToken = refreshToken
+	c.tokenExpiresAt = expiresAt
+}
+
+// OnTokenRefresh registers fn to persist tokens after the client renews them.
+func (c *Client) OnTokenRefresh(fn func(token OAuthToken)) {
+	c.onTokenRefresh = fn
+}
+
+// OnSessionExpired registers fn to handle a 401 the client cannot recover
+// from on its own. fn blocks until the user re-authenticates or gives up;
+// returning true replays the request with the client's current token.
+func (c *Client) OnSessionExpired(fn func() bool) {
+	c.onSessionExpired = fn
+}
+
+// bearer returns the current access token.
+func (c *Client) bearer() string {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+	return c.apiKey
+}
+
+// tokenExpiring reports whether a refreshable token is due for renewal.
+func (c *Client) tokenExpiring(now time.Time) bool {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+	if c.refreshToken == "" || c.tokenExpiresAt.IsZero() {
+		return false
+	}
+	return now.Add(tokenRefreshWindow).After(c.tokenExpiresAt)
+}
+
+// canRefresh reports whether the client holds a refresh token.
+func (c *Client) canRefresh() bool {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+	return c.refreshToken != ""
+}
+
+// refreshAccessToken renews the access token and hands it to the refresh
+// callback. Concurrent callers that lost the race reuse the new token.
+func (c *Client) refreshAccessToken(stale string) error {
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+	if current := c.bearer(); current != stale {
+		return nil
+	}
+
+	c.authMu.Lock()
+	refresh := c.refreshToken
+	c.authMu.Unlock()
+
+	token, err := c.RefreshToken(refresh)
+	if err != nil {
+		return err
+	}
+	if token.RefreshToken == "" {
+		token.RefreshToken = refresh
+	}
+	c.SetOAuthToken(token.AccessToken, token.RefreshToken, token.ExpiresAt(time.Now()))
+	if c.onTokenRefresh != nil {
+		c.onTokenRefresh(*token)
+	}
+	return nil
+}
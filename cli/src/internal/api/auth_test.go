@@ -0,0 +1,144 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDeviceLoginStartAndPoll handles test device login start and poll.
+func TestDeviceLoginStartAndPoll(t *testing.T) {
+	polls := 0
+	_, client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/auth/device":
+			_, _ = w.Write(jsonResponse(map[string]any{
+				"device_code": "dev-1", "user_code": "ABCD-EFGH",
+				"verification_uri": "https://sso.example/device", "expires_in": 600, "interval": 5,
+			}))
+		case "/api/auth/device/token":
+			polls++
+			if polls == 1 {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":"authorization_pending"}`))
+				return
+			}
+			if polls == 2 {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":{"code":"ACCESS_DENIED","message":"access_denied"}}`))
+				return
+			}
+			_, _ = w.Write(jsonResponse(map[string]any{"access_token": "at-1", "refresh_token": "rt-1", "expires_in": 3600}))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	code, err := client.StartDeviceLogin()
+	require.NoError(t, err)
+	assert.Equal(t, "ABCD-EFGH", code.UserCode)
+
+	_, err = client.PollDeviceToken(code.DeviceCode)
+	assert.ErrorIs(t, err, ErrAuthorizationPending)
+	_, err = client.PollDeviceToken(code.DeviceCode)
+	assert.ErrorIs(t, err, ErrAccessDenied)
+	token, err := client.PollDeviceToken(code.DeviceCode)
+	require.NoError(t, err)
+	assert.Equal(t, "at-1", token.AccessToken)
+	assert.Equal(t, "rt-1", token.RefreshToken)
+}
+
+// TestClientRefreshesExpiringToken handles test client refreshes expiring token.
+func TestClientRefreshesExpiringToken(t *testing.T) {
+	var seen []string
+	_, client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/auth/token/refresh" {
+			var body map[string]string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "rt-1", body["refresh_token"])
+			_, _ = w.Write(jsonResponse(map[string]any{"access_token": "at-2", "expires_in": 3600}))
+			return
+		}
+		seen = append(seen, r.Header.Get("Authorization"))
+		_, _ = w.Write(jsonResponse([]any{}))
+	})
+	client.SetOAuthToken("at-1", "rt-1", time.Now().Add(5*time.Second))
+	var refreshed OAuthToken
+	client.OnTokenRefresh(func(token OAuthToken) { refreshed = token })
+
+	_, err := client.ListKeys()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Bearer at-2"}, seen)
+	assert.Equal(t, "at-2", refreshed.AccessToken)
+	// The refresh token is kept when the server does not rotate it.
+	assert.Equal(t, "rt-1", refreshed.RefreshToken)
+}
+
+// TestClientRefreshesAfterUnauthorized handles test client refreshes after unauthorized.
+func TestClientRefreshesAfterUnauthorized(t *testing.T) {
+	refreshes := 0
+	_, client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/auth/token/refresh" {
+			refreshes++
+			_, _ = w.Write(jsonResponse(map[string]any{"access_token": "at-2", "refresh_token": "rt-2", "expires_in": 3600}))
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer at-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":{"code":"INVALID_API_KEY","message":"token expired"}}`))
+			return
+		}
+		_, _ = w.Write(jsonResponse([]any{}))
+	})
+	client.SetOAuthToken("at-1", "rt-1", time.Time{})
+
+	_, err := client.ListKeys()
+	require.NoError(t, err)
+	assert.Equal(t, 1, refreshes)
+
+	// Without a refresh token a 401 is returned as before.
+	plain := NewClient(client.baseURL, "nbl_old")
+	_, err = plain.ListKeys()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "INVALID_API_KEY")
+}
+
+// TestClientReplaysRequestAfterSessionExpiry handles test client replays request after session expiry.
+func TestClientReplaysRequestAfterSessionExpiry(t *testing.T) {
+	calls := 0
+	_, client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Header.Get("Authorization") != "Bearer nbl_new" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":{"code":"INVALID_API_KEY","message":"expired"}}`))
+			return
+		}
+		_, _ = w.Write(jsonResponse([]any{}))
+	})
+	client.SetAPIKey("nbl_old")
+	expired := 0
+	client.OnSessionExpired(func() bool {
+		expired++
+		client.SetAPIKey("nbl_new")
+		return true
+	})
+
+	_, err := client.ListKeys()
+	require.NoError(t, err)
+	assert.Equal(t, 1, expired)
+	assert.Equal(t, 2, calls)
+
+	// Declining re-auth surfaces the original 401, and probes skip the handler.
+	client.SetAPIKey("nbl_old")
+	client.OnSessionExpired(func() bool { expired++; return false })
+	_, err = client.ListKeys()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "INVALID_API_KEY")
+	_, err = client.WithTimeout(time.Second).ListKeys()
+	require.Error(t, err)
+	assert.Equal(t, 2, expired)
+}
@@ -1,6 +1,9 @@
 package api
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // DefaultAPIPort is the default local Nebula API port used by the CLI.
 const DefaultAPIPort = 8765
@@ -12,3 +15,11 @@ const DefaultBaseURL = "http://127.0.0.1:8765"
 func NewDefaultClient(apiKey string, timeout ...time.Duration) *Client {
 	return NewClient(DefaultBaseURL, apiKey, timeout...)
 }
+
+// ResolveBaseURL returns the configured API URL, falling back to DefaultBaseURL.
+func ResolveBaseURL(configured string) string {
+	if trimmed := strings.TrimRight(strings.TrimSpace(configured), "/"); trimmed != "" {
+		return trimmed
+	}
+	return DefaultBaseURL
+}
@@ -2,12 +2,15 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -16,6 +19,24 @@ type Client struct {
 	baseURL    string
 	apiKey     string
 	httpClient *http.Client
+
+	limiter       *tokenBucket
+	onRateLimited func(wait time.Duration)
+	sleep         func(time.Duration)
+
+	authMu         sync.Mutex
+	refreshMu      sync.Mutex
+	refreshToken   string
+	tokenExpiresAt time.Time
+	onTokenRefresh func(token OAuthToken)
+
+	onSessionExpired func() bool
+
+	// ctx cancels in-flight reads. Writes ignore it so a cancelled view never
+	// leaves a mutation in an unknown state.
+	ctx context.Context
+	// parent owns the credentials of a context-bound clone.
+	parent *Client
 }
 
 // NewClient creates a new API client.
@@ -30,43 +51,155 @@ func NewClient(baseURL, apiKey string, timeout ...time.Duration) *Client {
 		httpClient: &http.Client{
 			Timeout: httpTimeout,
 		},
+		limiter: newTokenBucket(DefaultRequestsPerSecond, DefaultRequestBurst),
+		sleep:   time.Sleep,
 	}
 }
 
 // SetAPIKey updates the bearer token used for subsequent requests.
 func (c *Client) SetAPIKey(apiKey string) {
+	c = c.root()
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
 	c.apiKey = apiKey
 }
 
-// WithTimeout clones the client with a different HTTP timeout.
+// WithTimeout clones the client with a different HTTP timeout. The clone
+// shares the request throttle and rate limit callback but not the session
+// expiry handler, so short-lived probes fail fast on a 401.
 func (c *Client) WithTimeout(timeout time.Duration) *Client {
-	return NewClient(c.baseURL, c.apiKey, timeout)
+	c = c.root()
+	clone := NewClient(c.baseURL, c.bearer(), timeout)
+	clone.limiter = c.limiter
+	clone.onRateLimited = c.onRateLimited
+	clone.sleep = c.sleep
+	c.authMu.Lock()
+	clone.refreshToken = c.refreshToken
+	clone.tokenExpiresAt = c.tokenExpiresAt
+	c.authMu.Unlock()
+	clone.onTokenRefresh = c.onTokenRefresh
+	return clone
+}
+
+// WithContext clones the client so its reads are cancelled with ctx. The
+// clone shares the parent's credentials, throttle, and session handling.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	root := c.root()
+	return &Client{
+		baseURL:       root.baseURL,
+		httpClient:    root.httpClient,
+		limiter:       root.limiter,
+		onRateLimited: root.onRateLimited,
+		sleep:         root.sleep,
+		ctx:           ctx,
+		parent:        root,
+	}
+}
+
+// root returns the client that owns the credentials.
+func (c *Client) root() *Client {
+	if c.parent != nil {
+		return c.parent
+	}
+	return c
 }
 
-// do executes an HTTP request and returns the raw response body.
+// requestContext returns the context a request runs under.
+func (c *Client) requestContext(method string) context.Context {
+	if c.ctx == nil || method != http.MethodGet {
+		return context.Background()
+	}
+	return c.ctx
+}
+
+// do executes an HTTP request and returns the raw response body. Requests
+// are throttled client-side, 429 responses are retried after the wait the
+// server asks for, and SSO tokens are refreshed before expiry or after a 401.
+// A 401 that survives refresh goes to the session expiry handler, which may
+// re-authenticate and have the request replayed once.
 func (c *Client) do(method, path string, body any) ([]byte, int, error) {
-	var reqBody io.Reader
+	var data []byte
 	if body != nil {
-		data, err := json.Marshal(body)
+		var err error
+		data, err = json.Marshal(body)
 		if err != nil {
 			return nil, 0, fmt.Errorf("marshal body: %w", err)
 		}
+	}
+
+	auth := c.root()
+	if auth.tokenExpiring(time.Now()) {
+		if err := auth.refreshAccessToken(auth.bearer()); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	ctx := c.requestContext(method)
+	refreshed, replayed := false, false
+	for attempt := 0; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return nil, 0, fmt.Errorf("request cancelled: %w", err)
+		}
+		token := auth.bearer()
+		respBody, status, header, err := c.send(ctx, method, path, data, body != nil)
+		if err == nil && status == http.StatusUnauthorized && !refreshed && auth.canRefresh() {
+			refreshed = true
+			if refreshErr := auth.refreshAccessToken(token); refreshErr != nil {
+				return nil, status, refreshErr
+			}
+			continue
+		}
+		if err == nil && status == http.StatusUnauthorized && !replayed && auth.onSessionExpired != nil {
+			replayed = true
+			if auth.onSessionExpired() {
+				continue
+			}
+		}
+		if err != nil || status != http.StatusTooManyRequests || attempt >= maxRateLimitRetries {
+			if err != nil {
+				return nil, status, err
+			}
+			return checkResponse(respBody, status)
+		}
+		wait, ok := retryAfter(header.Get("Retry-After"), attempt, time.Now())
+		if !ok {
+			return checkResponse(respBody, status)
+		}
+		if c.onRateLimited != nil {
+			c.onRateLimited(wait)
+		}
+		if c.sleep != nil {
+			c.sleep(wait)
+		}
+	}
+}
+
+// send performs one HTTP round trip.
+func (c *Client) send(ctx context.Context, method, path string, data []byte, hasBody bool) ([]byte, int, http.Header, error) {
+	var reqBody io.Reader
+	if hasBody {
 		reqBody = bytes.NewReader(data)
 	}
 
-	req, err := http.NewRequest(method, c.baseURL+path, reqBody)
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
 	if err != nil {
-		return nil, 0, fmt.Errorf("create request: %w", err)
+		return nil, 0, nil, fmt.Errorf("create request: %w", err)
 	}
 
-	if c.apiKey != "" {
-		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	if token := c.root().bearer(); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
 	}
 	req.Header.Set("Content-Type", "application/json")
 
+	if c.limiter != nil {
+		c.limiter.take()
+	}
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, 0, fmt.Errorf("request failed: %w", err)
+		if ctx.Err() == nil {
+			err = unreachableError{err}
+		}
+		return nil, 0, nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer func() {
 		_ = resp.Body.Close()
@@ -74,26 +207,36 @@ func (c *Client) do(method, path string, body any) ([]byte, int, error) {
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, resp.StatusCode, fmt.Errorf("read response: %w", err)
+		return nil, resp.StatusCode, nil, fmt.Errorf("read response: %w", err)
 	}
+	return respBody, resp.StatusCode, resp.Header, nil
+}
+
+// ErrConflict matches 409 responses, e.g. an update against a stale record.
+var ErrConflict = errors.New("conflict")
+
+// ErrUnreachable matches requests that never got a response, e.g. while the
+// server is down or the machine is offline.
+var ErrUnreachable = errors.New("server unreachable")
+
+// unreachableError keeps the transport error while matching ErrUnreachable.
+type unreachableError struct{ err error }
+
+func (e unreachableError) Error() string { return e.err.Error() }
+
+func (e unreachableError) Unwrap() []error { return []error{ErrUnreachable, e.err} }
 
-	if resp.StatusCode >= 400 {
-		if msg, ok := extractAPIErrorBody(respBody); ok {
-			return nil, resp.StatusCode, fmt.Errorf(
-				"%s",
-				normalizeAPIError(resp.StatusCode, msg),
-			)
+// checkResponse turns error statuses into normalized *Error values.
+func checkResponse(respBody []byte, statusCode int) ([]byte, int, error) {
+	if statusCode >= 400 {
+		msg, ok := extractAPIErrorBody(respBody)
+		if !ok {
+			msg = fmt.Sprintf("HTTP %d: %s", statusCode, string(respBody))
 		}
-		return nil, resp.StatusCode, fmt.Errorf(
-			"%s",
-			normalizeAPIError(
-				resp.StatusCode,
-				fmt.Sprintf("HTTP %d: %s", resp.StatusCode, string(respBody)),
-			),
-		)
+		return nil, statusCode, newError(statusCode, normalizeAPIError(statusCode, msg), respBody)
 	}
 
-	return respBody, resp.StatusCode, nil
+	return respBody, statusCode, nil
 }
 
 // normalizeAPIError keeps auth/multi-api recovery branches consistent across all callers.
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -373,6 +374,18 @@ func TestHealth(t *testing.T) {
 	assert.Equal(t, "ok", status)
 }
 
+// TestHealthInfoIncludesVersion handles test health info includes version.
+func TestHealthInfoIncludesVersion(t *testing.T) {
+	_, client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"status": "ok", "version": "0.2.1"})
+	})
+
+	info, err := client.HealthInfo()
+	require.NoError(t, err)
+	assert.Equal(t, "ok", info.Status)
+	assert.Equal(t, "0.2.1", info.Version)
+}
+
 // TestBuildQuery handles test build query.
 func TestBuildQuery(t *testing.T) {
 	result := buildQuery("/api/entities", QueryParams{"status": "active", "type": "person"})
@@ -430,6 +443,45 @@ func TestWithTimeoutClonesClient(t *testing.T) {
 	assert.NotSame(t, client, clone)
 }
 
+// TestWithContextCancelsReads handles test with context cancels reads.
+func TestWithContextCancelsReads(t *testing.T) {
+	var auth atomic.Value
+	_, client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		auth.Store(r.Header.Get("Authorization"))
+		_, err := w.Write(jsonResponse(map[string]any{"id": "ent-1", "name": "x", "tags": []string{}}))
+		require.NoError(t, err)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	bound := client.WithContext(ctx)
+	client.SetAPIKey("nbl_rotated")
+
+	_, err := bound.GetEntity("ent-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer nbl_rotated", auth.Load())
+
+	cancel()
+	_, err = bound.GetEntity("ent-1")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	// Writes run to completion even after the view that issued them is left.
+	_, err = bound.UpdateEntity("ent-1", UpdateEntityInput{})
+	require.NoError(t, err)
+}
+
+func TestUnreachableServerMatchesErrUnreachable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	client := NewClient(srv.URL, "nbl_testkey")
+	srv.Close()
+
+	_, err := client.GetEntity("ent-1")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrUnreachable)
+	assert.Contains(t, err.Error(), "request failed")
+	assert.NotErrorIs(t, err, ErrConflict)
+}
+
 // TestClientConcurrentRequests handles test client concurrent requests.
 func TestClientConcurrentRequests(t *testing.T) {
 	var count atomic.Int32
@@ -0,0 +1,63 @@
+package api
+
+import "fmt"
+
+// --- Collection Methods ---
+
+// ListCollections lists the caller's collections with member counts.
+func (c *Client) ListCollections() ([]Collection, error) {
+	data, err := c.get("/api/collections")
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[Collection](data)
+}
+
+// GetCollection gets a collection with the entities the caller can see.
+func (c *Client) GetCollection(id string) (*Collection, error) {
+	data, err := c.get(fmt.Sprintf("/api/collections/%s", id))
+	if err != nil {
+		return nil, err
+	}
+	return decodeOne[Collection](data)
+}
+
+// CreateCollection creates a collection.
+func (c *Client) CreateCollection(input CreateCollectionInput) (*Collection, error) {
+	data, err := c.post("/api/collections", input)
+	if err != nil {
+		return nil, err
+	}
+	return decodeOne[Collection](data)
+}
+
+// UpdateCollection renames or describes a collection.
+func (c *Client) UpdateCollection(id string, input UpdateCollectionInput) (*Collection, error) {
+	data, err := c.patch(fmt.Sprintf("/api/collections/%s", id), input)
+	if err != nil {
+		return nil, err
+	}
+	return decodeOne[Collection](data)
+}
+
+// DeleteCollection deletes a collection. Its entities are untouched.
+func (c *Client) DeleteCollection(id string) error {
+	_, err := c.del(fmt.Sprintf("/api/collections/%s", id))
+	return err
+}
+
+// AddCollectionEntities adds entities to a collection.
+func (c *Client) AddCollectionEntities(id string, entityIDs []string) (*CollectionEntitiesResult, error) {
+	body := map[string][]string{"entity_ids": entityIDs}
+	data, err := c.post(fmt.Sprintf("/api/collections/%s/entities", id), body)
+	if err != nil {
+		return nil, err
+	}
+	return decodeOne[CollectionEntitiesResult](data)
+}
+
+// RemoveCollectionEntity removes an entity from a collection.
+func (c *Client) RemoveCollectionEntity(id, entityID string) error {
+	_, err := c.del(fmt.Sprintf("/api/collections/%s/entities/%s", id, entityID))
+	return err
+}
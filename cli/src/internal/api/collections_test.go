@@ -0,0 +1,64 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCollectionsRoundTrip covers the collection endpoints.
+func TestCollectionsRoundTrip(t *testing.T) {
+	var addBody map[string][]string
+	_, client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var payload any
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/collections":
+			payload = []map[string]any{{"id": "col-1", "name": "Q3 launch", "entity_count": 2}}
+		case "GET /api/collections/col-1":
+			payload = map[string]any{"id": "col-1", "name": "Q3 launch", "entities": []map[string]any{{"id": "ent-1", "name": "Plan"}}}
+		case "POST /api/collections":
+			payload = map[string]any{"id": "col-2", "name": "Docs"}
+		case "PATCH /api/collections/col-1":
+			payload = map[string]any{"id": "col-1", "name": "Q4 launch"}
+		case "POST /api/collections/col-1/entities":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&addBody))
+			payload = map[string]any{"added": 1, "requested": 2}
+		case "DELETE /api/collections/col-1", "DELETE /api/collections/col-1/entities/ent-1":
+			payload = map[string]any{}
+		default:
+			t.Fatalf("unexpected %s %s", r.Method, r.URL.Path)
+		}
+		_, err := w.Write(jsonResponse(payload))
+		require.NoError(t, err)
+	})
+
+	list, err := client.ListCollections()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, 2, list[0].EntityCount)
+
+	got, err := client.GetCollection("col-1")
+	require.NoError(t, err)
+	require.Len(t, got.Entities, 1)
+	assert.Equal(t, "Plan", got.Entities[0].Name)
+
+	created, err := client.CreateCollection(CreateCollectionInput{Name: "Docs"})
+	require.NoError(t, err)
+	assert.Equal(t, "col-2", created.ID)
+
+	name := "Q4 launch"
+	updated, err := client.UpdateCollection("col-1", UpdateCollectionInput{Name: &name})
+	require.NoError(t, err)
+	assert.Equal(t, name, updated.Name)
+
+	added, err := client.AddCollectionEntities("col-1", []string{"ent-1", "ent-2"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, added.Added)
+	assert.Equal(t, []string{"ent-1", "ent-2"}, addBody["entity_ids"])
+
+	require.NoError(t, client.RemoveCollectionEntity("col-1", "ent-1"))
+	require.NoError(t, client.DeleteCollection("col-1"))
+}
@@ -0,0 +1,45 @@
+package api
+
+import "fmt"
+
+// --- Comment Methods ---
+
+// Comment targets, the collection a commented record lives in.
+const (
+	CommentOnEntity  = "entities"
+	CommentOnContext = "context"
+)
+
+// ListComments lists the comments on a record, oldest first. target is
+// CommentOnEntity or CommentOnContext.
+func (c *Client) ListComments(target, id string) ([]Comment, error) {
+	data, err := c.get(fmt.Sprintf("/api/%s/%s/comments", target, id))
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[Comment](data)
+}
+
+// AddComment adds a comment to a record as the caller.
+func (c *Client) AddComment(target, id, body string) (*Comment, error) {
+	data, err := c.post(fmt.Sprintf("/api/%s/%s/comments", target, id), CommentInput{Body: body})
+	if err != nil {
+		return nil, err
+	}
+	return decodeOne[Comment](data)
+}
+
+// UpdateComment edits the body of a comment the caller wrote.
+func (c *Client) UpdateComment(id, body string) (*Comment, error) {
+	data, err := c.patch(fmt.Sprintf("/api/comments/%s", id), CommentInput{Body: body})
+	if err != nil {
+		return nil, err
+	}
+	return decodeOne[Comment](data)
+}
+
+// DeleteComment deletes a comment the caller wrote.
+func (c *Client) DeleteComment(id string) error {
+	_, err := c.del(fmt.Sprintf("/api/comments/%s", id))
+	return err
+}
@@ -0,0 +1,49 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCommentsRoundTrip covers the comment endpoints.
+func TestCommentsRoundTrip(t *testing.T) {
+	var bodies []CommentInput
+	_, client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var payload any
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/context/ctx-1/comments":
+			payload = []map[string]any{{"id": "com-1", "author_name": "alxx", "body": "Outdated"}}
+		case "POST /api/entities/ent-1/comments", "PATCH /api/comments/com-1":
+			var input CommentInput
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&input))
+			bodies = append(bodies, input)
+			payload = map[string]any{"id": "com-1", "body": input.Body}
+		case "DELETE /api/comments/com-1":
+			payload = map[string]any{"deleted": true}
+		default:
+			t.Fatalf("unexpected %s %s", r.Method, r.URL.Path)
+		}
+		_, err := w.Write(jsonResponse(payload))
+		require.NoError(t, err)
+	})
+
+	list, err := client.ListComments(CommentOnContext, "ctx-1")
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, "alxx", list[0].AuthorName)
+
+	created, err := client.AddComment(CommentOnEntity, "ent-1", "Needs review")
+	require.NoError(t, err)
+	assert.Equal(t, "Needs review", created.Body)
+
+	updated, err := client.UpdateComment("com-1", "Reviewed")
+	require.NoError(t, err)
+	assert.Equal(t, "Reviewed", updated.Body)
+	assert.Equal(t, []CommentInput{{Body: "Needs review"}, {Body: "Reviewed"}}, bodies)
+
+	require.NoError(t, client.DeleteComment("com-1"))
+}
@@ -21,6 +21,19 @@ func (c *Client) GetContext(id string) (*Context, error) {
 	return decodeOne[Context](data)
 }
 
+// GetContextHistory lists audit entries for a context item, newest first.
+func (c *Client) GetContextHistory(id string, limit int, offset int) ([]AuditEntry, error) {
+	params := QueryParams{
+		"limit":  fmt.Sprintf("%d", limit),
+		"offset": fmt.Sprintf("%d", offset),
+	}
+	data, err := c.get(buildQuery(fmt.Sprintf("/api/context/%s/history", id), params))
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[AuditEntry](data)
+}
+
 // QueryContext handles query context.
 func (c *Client) QueryContext(params QueryParams) ([]Context, error) {
 	data, err := c.get(buildQuery("/api/context", params))
@@ -61,9 +61,22 @@ func (c *Client) GetEntityHistory(id string, limit int, offset int) ([]AuditEntr
 	return decodeList[AuditEntry](data)
 }
 
-// RevertEntity handles revert entity.
-func (c *Client) RevertEntity(id string, auditID string) (*Entity, error) {
-	body := map[string]string{"audit_id": auditID}
+// GetEntityReferences lists the context, jobs, logs, and protocols that link
+// to an entity or mention its name.
+func (c *Client) GetEntityReferences(id string) ([]EntityReference, error) {
+	data, err := c.get(fmt.Sprintf("/api/entities/%s/references", id))
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[EntityReference](data)
+}
+
+// RevertEntity restores an entity to an audit entry, limited to fields when given.
+func (c *Client) RevertEntity(id string, auditID string, fields ...string) (*Entity, error) {
+	body := map[string]any{"audit_id": auditID}
+	if len(fields) > 0 {
+		body["fields"] = fields
+	}
 	data, err := c.post(fmt.Sprintf("/api/entities/%s/revert", id), body)
 	if err != nil {
 		return nil, err
@@ -88,3 +101,9 @@ func (c *Client) BulkUpdateEntityScopes(input BulkUpdateEntityScopesInput) (*Bul
 	}
 	return decodeOne[BulkUpdateResult](data)
 }
+
+// DeleteEntity permanently deletes an archived entity and its relationships.
+func (c *Client) DeleteEntity(id string) error {
+	_, err := c.del(fmt.Sprintf("/api/entities/%s", id))
+	return err
+}
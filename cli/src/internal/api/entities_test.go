@@ -185,6 +185,23 @@ func TestRevertEntity(t *testing.T) {
 	assert.Equal(t, "Restored", entity.Name)
 }
 
+// TestRevertEntityWithFields handles test revert entity with fields.
+func TestRevertEntityWithFields(t *testing.T) {
+	_, client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "audit-1", body["audit_id"])
+		assert.Equal(t, []any{"name", "tags"}, body["fields"])
+
+		_, err := w.Write(jsonResponse(map[string]any{"id": "ent-1", "name": "Restored"}))
+		require.NoError(t, err)
+	})
+
+	entity, err := client.RevertEntity("ent-1", "audit-1", "name", "tags")
+	require.NoError(t, err)
+	assert.Equal(t, "Restored", entity.Name)
+}
+
 // stringPtr handles string ptr.
 func stringPtr(s string) *string {
 	return &s
@@ -194,3 +211,20 @@ func stringPtr(s string) *string {
 func stringSlicePtr(v []string) *[]string {
 	return &v
 }
+
+// TestUpdateEntityReportsStaleConflict handles test update entity reports stale conflict.
+func TestUpdateEntityReportsStaleConflict(t *testing.T) {
+	seen := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	_, client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "2026-10-16T09:00:00Z", body["expected_updated_at"])
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"error":{"code":"CONFLICT","message":"entity was modified"}}`))
+	})
+
+	_, err := client.UpdateEntity("ent-1", UpdateEntityInput{Name: stringPtr("x"), ExpectedUpdatedAt: &seen})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrConflict)
+	assert.Contains(t, err.Error(), "entity was modified")
+}
@@ -0,0 +1,177 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Error is a non-2xx API response. Error() returns the normalized
+// "CODE: message" text the CLI has always shown. Fields holds per-field
+// messages from request validation, keyed by the JSON field name.
+type Error struct {
+	Status  int
+	Code    string
+	Message string
+	Fields  map[string]string
+	text    string
+}
+
+func (e *Error) Error() string { return e.text }
+
+// Unwrap lets 409 responses match ErrConflict.
+func (e *Error) Unwrap() error {
+	if e.Status == http.StatusConflict {
+		return ErrConflict
+	}
+	return nil
+}
+
+// ErrorKind groups API failures by how the user recovers from them.
+type ErrorKind string
+
+const (
+	ErrorKindUnknown    ErrorKind = ""
+	ErrorKindAuth       ErrorKind = "auth"
+	ErrorKindScope      ErrorKind = "scope"
+	ErrorKindValidation ErrorKind = "validation"
+	ErrorKindRateLimit  ErrorKind = "rate_limit"
+	ErrorKindNetwork    ErrorKind = "network"
+	ErrorKindServer     ErrorKind = "server"
+)
+
+// errorCodeKinds maps server error codes to their kind.
+var errorCodeKinds = map[string]ErrorKind{
+	"INVALID_API_KEY":                 ErrorKindAuth,
+	"AUTH_REQUIRED":                   ErrorKindAuth,
+	"UNAUTHORIZED":                    ErrorKindAuth,
+	"ENROLLMENT_REQUIRED":             ErrorKindAuth,
+	"FORBIDDEN":                       ErrorKindScope,
+	"INVALID_INPUT":                   ErrorKindValidation,
+	"VALIDATION_ERROR":                ErrorKindValidation,
+	"CONFLICT":                        ErrorKindValidation,
+	"DUPLICATE":                       ErrorKindValidation,
+	"EXECUTION_FAILED":                ErrorKindValidation,
+	"RATE_LIMITED":                    ErrorKindRateLimit,
+	"INTERNAL":                        ErrorKindServer,
+	"SERVICE_UNAVAILABLE":             ErrorKindServer,
+	"MULTIPLE_API_INSTANCES_DETECTED": ErrorKindServer,
+}
+
+// newError builds the error for a failed response from its normalized text.
+func newError(status int, text string, body []byte) *Error {
+	code, message := parseErrorCode(text)
+	e := &Error{Status: status, Code: code, Message: message, text: text}
+	if status == http.StatusUnprocessableEntity {
+		e.Fields = parseFieldErrors(body)
+	}
+	return e
+}
+
+// parseFieldErrors reads request validation errors in the FastAPI shape,
+// {"detail": [{"loc": ["body", "name"], "msg": "..."}]}. Nested locations
+// are attributed to their top-level field; query errors are skipped.
+func parseFieldErrors(body []byte) map[string]string {
+	var payload struct {
+		Detail []struct {
+			Loc []any  `json:"loc"`
+			Msg string `json:"msg"`
+		} `json:"detail"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil
+	}
+	fields := map[string]string{}
+	for _, item := range payload.Detail {
+		if len(item.Loc) < 2 || item.Loc[0] != "body" {
+			continue
+		}
+		name, ok := item.Loc[1].(string)
+		msg := strings.TrimSpace(item.Msg)
+		if !ok || msg == "" {
+			continue
+		}
+		if _, seen := fields[name]; !seen {
+			fields[name] = msg
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return fields
+}
+
+// FieldErrors returns the per-field validation messages carried by err.
+func FieldErrors(err error) map[string]string {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Fields
+	}
+	return nil
+}
+
+// Kind classifies the response by its error code, then its HTTP status.
+func (e *Error) Kind() ErrorKind {
+	if kind, ok := errorCodeKinds[e.Code]; ok {
+		return kind
+	}
+	return statusKind(e.Status)
+}
+
+// statusKind classifies an HTTP status with no known error code.
+func statusKind(status int) ErrorKind {
+	switch {
+	case status == http.StatusUnauthorized:
+		return ErrorKindAuth
+	case status == http.StatusForbidden:
+		return ErrorKindScope
+	case status == http.StatusTooManyRequests:
+		return ErrorKindRateLimit
+	case status == http.StatusBadRequest, status == http.StatusConflict, status == http.StatusUnprocessableEntity:
+		return ErrorKindValidation
+	case status >= 500:
+		return ErrorKindServer
+	}
+	return ErrorKindUnknown
+}
+
+// ClassifyError returns the kind of err. Errors that lost their type on the
+// way (e.g. wrapped with %v) are classified by their leading error code.
+func ClassifyError(err error) ErrorKind {
+	if err == nil {
+		return ErrorKindUnknown
+	}
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Kind()
+	}
+	if errors.Is(err, ErrUnreachable) || errors.Is(err, context.DeadlineExceeded) {
+		return ErrorKindNetwork
+	}
+	return ClassifyErrorText(err.Error())
+}
+
+// ClassifyErrorText classifies an error message by its "CODE: message" or
+// "HTTP <status>: ..." prefix, after any "context: " wrapping.
+func ClassifyErrorText(text string) ErrorKind {
+	for rest := strings.TrimSpace(text); rest != ""; {
+		head, tail, found := strings.Cut(rest, ":")
+		head = strings.ToUpper(strings.TrimSpace(head))
+		if kind, ok := errorCodeKinds[head]; ok {
+			return kind
+		}
+		if fields := strings.Fields(head); len(fields) > 1 && fields[0] == "HTTP" {
+			if status, err := strconv.Atoi(fields[1]); err == nil {
+				return statusKind(status)
+			}
+		}
+		if !found {
+			break
+		}
+		rest = strings.TrimSpace(tail)
+	}
+	return ErrorKindUnknown
+}
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckResponseReturnsTypedError(t *testing.T) {
+	_, _, err := checkResponse([]byte(`{"error":{"code":"FORBIDDEN","message":"admin scope required"}}`), http.StatusForbidden)
+	var apiErr *Error
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusForbidden, apiErr.Status)
+	assert.Equal(t, "FORBIDDEN", apiErr.Code)
+	assert.Equal(t, "admin scope required", apiErr.Message)
+	assert.Equal(t, "FORBIDDEN: admin scope required", err.Error())
+	assert.Equal(t, ErrorKindScope, ClassifyError(err))
+
+	_, _, err = checkResponse([]byte(`{"error":{"code":"CONFLICT","message":"stale"}}`), http.StatusConflict)
+	assert.ErrorIs(t, err, ErrConflict)
+	assert.Equal(t, ErrorKindValidation, ClassifyError(fmt.Errorf("save: %w", err)))
+}
+
+func TestClassifyError(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want ErrorKind
+	}{
+		{"auth status", &Error{Status: http.StatusUnauthorized}, ErrorKindAuth},
+		{"unknown code falls back to status", &Error{Status: http.StatusTooManyRequests, Code: "SLOW_DOWN"}, ErrorKindRateLimit},
+		{"server status", &Error{Status: http.StatusBadGateway}, ErrorKindServer},
+		{"not found", &Error{Status: http.StatusNotFound, Code: "NOT_FOUND"}, ErrorKindUnknown},
+		{"unreachable", fmt.Errorf("request failed: %w", unreachableError{errors.New("connection refused")}), ErrorKindNetwork},
+		{"deadline", fmt.Errorf("request cancelled: %w", context.DeadlineExceeded), ErrorKindNetwork},
+		{"wrapped text code", errors.New("re-login failed: INVALID_API_KEY: bad token"), ErrorKindAuth},
+		{"lowercase code", errors.New("invalid_api_key: token expired"), ErrorKindAuth},
+		{"http status text", errors.New("HTTP 422: bad field"), ErrorKindValidation},
+		{"plain text", errors.New("save config: permission denied"), ErrorKindUnknown},
+		{"nil", nil, ErrorKindUnknown},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, ClassifyError(tc.err))
+		})
+	}
+}
+
+func TestCheckResponseParsesFieldErrors(t *testing.T) {
+	body := []byte(`{"detail":[
+		{"loc":["body","name"],"msg":"Field required","type":"missing"},
+		{"loc":["body","metadata","owner"],"msg":"Input should be a valid string"},
+		{"loc":["body","name"],"msg":"second message is dropped"},
+		{"loc":["query","limit"],"msg":"too big"}
+	]}`)
+	_, _, err := checkResponse(body, http.StatusUnprocessableEntity)
+	require.Error(t, err)
+	assert.Equal(t, map[string]string{
+		"name":     "Field required",
+		"metadata": "Input should be a valid string",
+	}, FieldErrors(fmt.Errorf("create: %w", err)))
+	assert.Equal(t, ErrorKindValidation, ClassifyError(err))
+
+	_, _, err = checkResponse([]byte(`{"error":{"code":"INVALID_INPUT","message":"bad"}}`), http.StatusBadRequest)
+	assert.Nil(t, FieldErrors(err))
+}
@@ -5,18 +5,32 @@ import (
 	"fmt"
 )
 
+// HealthInfo is the /api/health payload. Version is empty on servers that
+// predate version reporting.
+type HealthInfo struct {
+	Status  string `json:"status"`
+	Version string `json:"version,omitempty"`
+}
+
 // Health calls /api/health and returns its status string.
 func (c *Client) Health() (string, error) {
-	data, err := c.get("/api/health")
+	info, err := c.HealthInfo()
 	if err != nil {
 		return "", err
 	}
+	return info.Status, nil
+}
 
-	var payload struct {
-		Status string `json:"status"`
+// HealthInfo calls /api/health and returns the status and server version.
+func (c *Client) HealthInfo() (*HealthInfo, error) {
+	data, err := c.get("/api/health")
+	if err != nil {
+		return nil, err
 	}
+
+	var payload HealthInfo
 	if err := json.Unmarshal(data, &payload); err != nil {
-		return "", fmt.Errorf("decode response: %w", err)
+		return nil, fmt.Errorf("decode response: %w", err)
 	}
-	return payload.Status, nil
+	return &payload, nil
 }
@@ -43,3 +43,13 @@ func (c *Client) ImportJobs(payload BulkImportRequest) (*BulkImportResult, error
 	}
 	return decodeOne[BulkImportResult](data)
 }
+
+// ValidateImport dry-runs a bulk import of resource (entities, context,
+// relationships, or jobs) and reports what each row would do.
+func (c *Client) ValidateImport(resource string, payload BulkImportRequest) (*ImportValidation, error) {
+	data, err := c.post("/api/import/"+resource+"/validate", payload)
+	if err != nil {
+		return nil, err
+	}
+	return decodeOne[ImportValidation](data)
+}
@@ -39,3 +39,36 @@ func TestImportEntities(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, 1, resp.Created)
 }
+
+func TestValidateImport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/import/context/validate", r.URL.Path)
+
+		var body BulkImportRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "markdown", body.Format)
+
+		_, err := w.Write(jsonResponse(map[string]any{
+			"resource": "context",
+			"total":    1,
+			"counts":   map[string]int{"create": 0, "skip": 1, "invalid": 0},
+			"rows": []map[string]any{{
+				"row": 1, "action": "skip", "label": "Notes",
+				"warnings":   []string{"Already exists"},
+				"duplicates": []map[string]any{{"id": "ctx-1", "label": "Notes"}},
+				"item":       map[string]any{"title": "Notes"},
+			}},
+		}))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(srv.Close)
+
+	client := NewClient(srv.URL, "nbl_testkey")
+	report, err := client.ValidateImport("context", BulkImportRequest{Format: "markdown", Data: "# Notes"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Counts["skip"])
+	require.Len(t, report.Rows, 1)
+	assert.Equal(t, "ctx-1", report.Rows[0].Duplicates[0].ID)
+	assert.Equal(t, "Notes", report.Rows[0].Item["title"])
+}
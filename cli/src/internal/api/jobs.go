@@ -57,3 +57,66 @@ func (c *Client) CreateSubtask(jobID string, input map[string]string) (*Job, err
 	}
 	return decodeOne[Job](data)
 }
+
+// JobStatusAbandoned is the seeded archived status a cancelled job moves to.
+const JobStatusAbandoned = "abandoned"
+
+// CancelJob moves a job to abandoned and records why on the job.
+func (c *Client) CancelJob(id, reason string) (*Job, error) {
+	body := map[string]string{"status": JobStatusAbandoned, "status_reason": reason}
+	data, err := c.patch(fmt.Sprintf("/api/jobs/%s/status", id), body)
+	if err != nil {
+		return nil, err
+	}
+	return decodeOne[Job](data)
+}
+
+// RetryJob creates a copy of a job, marked in metadata with the job it
+// retries and its attempt number, and links it to the original.
+func (c *Client) RetryJob(id string) (*Job, error) {
+	orig, err := c.GetJob(id)
+	if err != nil {
+		return nil, err
+	}
+	meta := map[string]any{}
+	for k, v := range orig.Metadata {
+		meta[k] = v
+	}
+	attempt := 1
+	if prev, ok := meta["retry_attempt"].(float64); ok {
+		attempt = int(prev) + 1
+	}
+	meta["retry_of"] = orig.ID
+	meta["retry_attempt"] = attempt
+
+	input := CreateJobInput{
+		Title:    orig.Title,
+		Metadata: meta,
+	}
+	if orig.Description != nil {
+		input.Description = *orig.Description
+	}
+	if orig.Priority != nil {
+		input.Priority = *orig.Priority
+	}
+	if orig.JobType != nil {
+		input.JobType = *orig.JobType
+	}
+	if orig.AssignedTo != nil {
+		input.AssignedTo = *orig.AssignedTo
+	}
+	retry, err := c.CreateJob(input)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := c.CreateRelationship(CreateRelationshipInput{
+		SourceType: "job",
+		SourceID:   retry.ID,
+		TargetType: "job",
+		TargetID:   orig.ID,
+		Type:       "references",
+	}); err != nil {
+		return retry, fmt.Errorf("link retry %s to %s: %w", retry.ID, orig.ID, err)
+	}
+	return retry, nil
+}
@@ -136,3 +136,84 @@ func TestUpdateJobStatusInvalid(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "INVALID_STATUS")
 }
+
+func TestUpdateJobSendsExplicitClears(t *testing.T) {
+	_, client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{"title": "Renew", "assigned_to": nil, "due_at": nil}, body)
+		_, err := w.Write(jsonResponse(map[string]any{"id": "job-1", "title": "Renew"}))
+		require.NoError(t, err)
+	})
+
+	title := "Renew"
+	_, err := client.UpdateJob("job-1", UpdateJobInput{Title: &title, ClearAssignee: true, ClearDue: true})
+	require.NoError(t, err)
+}
+
+func TestUpdateJobOmitsUntouchedFields(t *testing.T) {
+	_, client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{"title": "Renew"}, body)
+		_, err := w.Write(jsonResponse(map[string]any{"id": "job-1", "title": "Renew"}))
+		require.NoError(t, err)
+	})
+
+	title := "Renew"
+	_, err := client.UpdateJob("job-1", UpdateJobInput{Title: &title})
+	require.NoError(t, err)
+}
+
+func TestCancelJobRecordsReason(t *testing.T) {
+	_, client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "/api/jobs/job-1/status", r.URL.Path)
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]string{"status": "abandoned", "status_reason": "superseded"}, body)
+		_, err := w.Write(jsonResponse(map[string]any{"id": "job-1", "status": "abandoned", "status_reason": "superseded"}))
+		require.NoError(t, err)
+	})
+
+	job, err := client.CancelJob("job-1", "superseded")
+	require.NoError(t, err)
+	require.NotNil(t, job.StatusReason)
+	assert.Equal(t, "superseded", *job.StatusReason)
+}
+
+func TestRetryJobCopiesAndLinks(t *testing.T) {
+	var created map[string]any
+	var linked CreateRelationshipInput
+	_, client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var resp any
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/jobs/job-1":
+			resp = map[string]any{
+				"id": "job-1", "title": "Import", "description": "nightly", "status": "failed",
+				"priority": "high", "job_type": "agent", "metadata": map[string]any{"source": "s3", "retry_attempt": 1},
+			}
+		case r.Method == http.MethodPost && r.URL.Path == "/api/jobs":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			resp = map[string]any{"id": "job-2", "title": "Import", "status": "pending"}
+		case r.Method == http.MethodPost && r.URL.Path == "/api/relationships":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&linked))
+			resp = map[string]any{"id": "rel-1"}
+		default:
+			t.Fatalf("unexpected %s %s", r.Method, r.URL.Path)
+		}
+		_, err := w.Write(jsonResponse(resp))
+		require.NoError(t, err)
+	})
+
+	retry, err := client.RetryJob("job-1")
+	require.NoError(t, err)
+	assert.Equal(t, "job-2", retry.ID)
+	assert.Equal(t, "Import", created["title"])
+	assert.Equal(t, "nightly", created["description"])
+	assert.NotContains(t, created, "status", "the server picks the status of a new job")
+	assert.Equal(t, "high", created["priority"])
+	assert.Equal(t, map[string]any{"source": "s3", "retry_of": "job-1", "retry_attempt": float64(2)}, created["metadata"])
+	assert.Equal(t, CreateRelationshipInput{SourceType: "job", SourceID: "job-2", TargetType: "job", TargetID: "job-1", Type: "references"}, linked)
+}
@@ -38,3 +38,28 @@ func (c *Client) UpdateProtocol(name string, input UpdateProtocolInput) (*Protoc
 	}
 	return decodeOne[Protocol](data)
 }
+
+// GetProtocolHistory lists the audit entries recorded for a protocol, newest
+// first. Each insert or update carries the full row as saved.
+func (c *Client) GetProtocolHistory(id string, limit int, offset int) ([]AuditEntry, error) {
+	params := QueryParams{
+		"table":     "protocols",
+		"record_id": id,
+		"limit":     fmt.Sprintf("%d", limit),
+		"offset":    fmt.Sprintf("%d", offset),
+	}
+	data, err := c.get(buildQuery("/api/audit", params))
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[AuditEntry](data)
+}
+
+// DryRunProtocol renders a protocol against sample input. Nothing is saved.
+func (c *Client) DryRunProtocol(name string, input ProtocolDryRunInput) (*ProtocolDryRun, error) {
+	data, err := c.post(fmt.Sprintf("/api/protocols/%s/dry-run", name), input)
+	if err != nil {
+		return nil, err
+	}
+	return decodeOne[ProtocolDryRun](data)
+}
@@ -101,3 +101,58 @@ func TestUpdateProtocol(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "Protocol Updated", proto.Title)
 }
+
+// TestGetProtocolHistory handles test get protocol history.
+func TestGetProtocolHistory(t *testing.T) {
+	_, client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/api/audit", r.URL.Path)
+		assert.Equal(t, "protocols", r.URL.Query().Get("table"))
+		assert.Equal(t, "proto-id", r.URL.Query().Get("record_id"))
+		assert.Equal(t, "20", r.URL.Query().Get("limit"))
+
+		_, err := w.Write(jsonResponse([]map[string]any{
+			{
+				"id":         "audit-2",
+				"table_name": "protocols",
+				"record_id":  "proto-id",
+				"action":     "update",
+				"new_data":   map[string]any{"title": "Protocol Two"},
+			},
+		}))
+		require.NoError(t, err)
+	})
+
+	rows, err := client.GetProtocolHistory("proto-id", 20, 0)
+	require.NoError(t, err)
+	if assert.Len(t, rows, 1) {
+		assert.Equal(t, "audit-2", rows[0].ID)
+		assert.Equal(t, "Protocol Two", rows[0].NewData["title"])
+	}
+}
+
+// TestDryRunProtocol handles test dry run protocol.
+func TestDryRunProtocol(t *testing.T) {
+	_, client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/protocols/proto-1/dry-run", r.URL.Path)
+
+		var body ProtocolDryRunInput
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "nebula", body.Input["repo"])
+
+		_, err := w.Write(jsonResponse(map[string]any{
+			"name":    "proto-1",
+			"output":  "1. Open nebula",
+			"steps":   []map[string]any{{"index": 1, "text": "Open nebula"}},
+			"missing": []string{"owner"},
+		}))
+		require.NoError(t, err)
+	})
+
+	run, err := client.DryRunProtocol("proto-1", ProtocolDryRunInput{Input: map[string]any{"repo": "nebula"}})
+	require.NoError(t, err)
+	require.Len(t, run.Steps, 1)
+	assert.Equal(t, "Open nebula", run.Steps[0].Text)
+	assert.Equal(t, []string{"owner"}, run.Missing)
+}
@@ -0,0 +1,104 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultRequestsPerSecond is the steady request rate the client allows.
+	DefaultRequestsPerSecond = 20
+	// DefaultRequestBurst is how many requests may go out back to back.
+	DefaultRequestBurst = 40
+
+	// maxRateLimitRetries bounds automatic retries of a 429 response.
+	maxRateLimitRetries = 3
+	// maxRetryAfter is the longest server-requested wait the client sits out.
+	maxRetryAfter = 60 * time.Second
+)
+
+// tokenBucket throttles outgoing requests. Tokens refill continuously at rate
+// per second up to burst; take blocks until one is available.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+	sleep  func(time.Duration)
+}
+
+// newTokenBucket creates a full bucket.
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+		now:    time.Now,
+		sleep:  time.Sleep,
+	}
+}
+
+// take waits for and consumes one token.
+func (b *tokenBucket) take() {
+	for {
+		b.mu.Lock()
+		now := b.now()
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+		if b.tokens >= 1 {
+			b.tokens--
+			b.mu.Unlock()
+			return
+		}
+		wait := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+		b.mu.Unlock()
+		b.sleep(wait)
+	}
+}
+
+// SetRateLimit changes the client-side request rate. A non-positive rate
+// turns throttling off.
+func (c *Client) SetRateLimit(perSecond float64, burst int) {
+	if perSecond <= 0 {
+		c.limiter = nil
+		return
+	}
+	c.limiter = newTokenBucket(perSecond, burst)
+}
+
+// OnRateLimited registers fn to be called before the client waits out a 429
+// response. fn runs on the requesting goroutine and must not block.
+func (c *Client) OnRateLimited(fn func(wait time.Duration)) {
+	c.onRateLimited = fn
+}
+
+// retryAfter returns how long to wait before retrying a 429 response. It
+// honours Retry-After in seconds or as an HTTP date and falls back to
+// exponential backoff. ok is false when the wait is too long to sit out.
+func retryAfter(header string, attempt int, now time.Time) (time.Duration, bool) {
+	wait := time.Second << attempt
+	header = strings.TrimSpace(header)
+	if header != "" {
+		if secs, err := strconv.Atoi(header); err == nil && secs >= 0 {
+			wait = time.Duration(secs) * time.Second
+		} else if at, err := http.ParseTime(header); err == nil {
+			wait = at.Sub(now)
+		}
+	}
+	if wait < 0 {
+		wait = 0
+	}
+	return wait, wait <= maxRetryAfter
+}
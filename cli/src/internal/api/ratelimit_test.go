@@ -0,0 +1,90 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRetryAfter handles test retry after.
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	wait, ok := retryAfter("7", 0, now)
+	assert.True(t, ok)
+	assert.Equal(t, 7*time.Second, wait)
+
+	wait, ok = retryAfter(now.Add(3*time.Second).Format(http.TimeFormat), 0, now)
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, wait)
+
+	wait, ok = retryAfter("", 2, now)
+	assert.True(t, ok)
+	assert.Equal(t, 4*time.Second, wait)
+
+	_, ok = retryAfter("3600", 0, now)
+	assert.False(t, ok)
+}
+
+// TestClientRetriesRateLimitedRequests handles test client retries rate limited requests.
+func TestClientRetriesRateLimitedRequests(t *testing.T) {
+	calls := 0
+	_, client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.Header().Set("Retry-After", "2")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"error":{"code":"RATE_LIMITED","message":"slow down"}}`))
+			return
+		}
+		_, _ = w.Write(jsonResponse(map[string]any{"id": "ap-1"}))
+	})
+	var slept, notified []time.Duration
+	client.sleep = func(d time.Duration) { slept = append(slept, d) }
+	client.OnRateLimited(func(wait time.Duration) { notified = append(notified, wait) })
+
+	_, err := client.ApproveRequest("ap-1")
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, []time.Duration{2 * time.Second, 2 * time.Second}, slept)
+	assert.Equal(t, slept, notified)
+}
+
+// TestClientGivesUpOnPersistentRateLimit handles test client gives up on persistent rate limit.
+func TestClientGivesUpOnPersistentRateLimit(t *testing.T) {
+	calls := 0
+	_, client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"error":{"code":"RATE_LIMITED","message":"slow down"}}`))
+	})
+	client.sleep = func(time.Duration) {}
+
+	_, err := client.ApproveRequest("ap-1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "RATE_LIMITED")
+	assert.Equal(t, maxRateLimitRetries+1, calls)
+}
+
+// TestTokenBucketWaitsForRefill handles test token bucket waits for refill.
+func TestTokenBucketWaitsForRefill(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	bucket := newTokenBucket(2, 2)
+	bucket.last = now
+	bucket.now = func() time.Time { return now }
+	var slept []time.Duration
+	bucket.sleep = func(d time.Duration) {
+		slept = append(slept, d)
+		now = now.Add(d)
+	}
+
+	bucket.take()
+	bucket.take()
+	assert.Empty(t, slept)
+
+	bucket.take()
+	assert.Equal(t, []time.Duration{500 * time.Millisecond}, slept)
+}
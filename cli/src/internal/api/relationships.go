@@ -38,3 +38,9 @@ func (c *Client) UpdateRelationship(id string, input UpdateRelationshipInput) (*
 	}
 	return decodeOne[Relationship](data)
 }
+
+// DeleteRelationship permanently deletes an archived relationship.
+func (c *Client) DeleteRelationship(id string) error {
+	_, err := c.del(fmt.Sprintf("/api/relationships/%s", id))
+	return err
+}
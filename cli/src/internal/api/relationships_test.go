@@ -133,3 +133,18 @@ func TestCreateRelationshipInvalidType(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "INVALID_TYPE")
 }
+
+// TestDeleteEntityAndRelationship handles test delete entity and relationship.
+func TestDeleteEntityAndRelationship(t *testing.T) {
+	var paths []string
+	_, client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		paths = append(paths, r.URL.Path)
+		_, err := w.Write(jsonResponse(map[string]any{"id": "x"}))
+		require.NoError(t, err)
+	})
+
+	require.NoError(t, client.DeleteEntity("ent-1"))
+	require.NoError(t, client.DeleteRelationship("rel-1"))
+	assert.Equal(t, []string{"/api/entities/ent-1", "/api/relationships/rel-1"}, paths)
+}
@@ -0,0 +1,56 @@
+package api
+
+import "fmt"
+
+// ListTags returns every tag with usage counts across entities, knowledge, and files.
+func (c *Client) ListTags(search string, limit, offset int) ([]TagUsage, error) {
+	params := QueryParams{}
+	if search != "" {
+		params["search"] = search
+	}
+	if limit > 0 {
+		params["limit"] = fmt.Sprintf("%d", limit)
+	}
+	if offset > 0 {
+		params["offset"] = fmt.Sprintf("%d", offset)
+	}
+	data, err := c.get(buildQuery("/api/tags", params))
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[TagUsage](data)
+}
+
+// RenameTag renames a tag everywhere it is used.
+func (c *Client) RenameTag(from, to string) (*TagBulkResult, error) {
+	body := map[string]string{"from": from, "to": to}
+	data, err := c.post("/api/tags/rename", body)
+	if err != nil {
+		return nil, err
+	}
+	return decodeOne[TagBulkResult](data)
+}
+
+// MergeTags replaces source with target everywhere and drops source.
+func (c *Client) MergeTags(source, target string) (*TagBulkResult, error) {
+	body := map[string]string{"source": source, "target": target}
+	data, err := c.post("/api/tags/merge", body)
+	if err != nil {
+		return nil, err
+	}
+	return decodeOne[TagBulkResult](data)
+}
+
+// DeleteUnusedTags removes the given tags if nothing uses them, or every
+// unused tag when none are given.
+func (c *Client) DeleteUnusedTags(tags ...string) (*TagBulkResult, error) {
+	body := map[string]any{}
+	if len(tags) > 0 {
+		body["tags"] = tags
+	}
+	data, err := c.post("/api/tags/delete-unused", body)
+	if err != nil {
+		return nil, err
+	}
+	return decodeOne[TagBulkResult](data)
+}
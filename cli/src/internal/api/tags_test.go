@@ -0,0 +1,69 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestListTags handles test list tags.
+func TestListTags(t *testing.T) {
+	_, client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/api/tags", r.URL.Path)
+		assert.Equal(t, "inf", r.URL.Query().Get("search"))
+		assert.Equal(t, "100", r.URL.Query().Get("limit"))
+		assert.Empty(t, r.URL.Query().Get("offset"))
+
+		_, err := w.Write(jsonResponse([]map[string]any{
+			{"name": "infra", "entities": 3, "knowledge": 2, "files": 1},
+		}))
+		require.NoError(t, err)
+	})
+
+	items, err := client.ListTags("inf", 100, 0)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "infra", items[0].Name)
+	assert.Equal(t, 6, items[0].Total())
+}
+
+// TestBulkTagOperations handles test bulk tag operations.
+func TestBulkTagOperations(t *testing.T) {
+	bodies := map[string]map[string]any{}
+	_, client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		bodies[r.URL.Path] = body
+
+		result := map[string]any{"entities_updated": 2, "knowledge_updated": 1, "files_updated": 0}
+		if r.URL.Path == "/api/tags/delete-unused" {
+			result = map[string]any{"deleted": []string{"stale"}}
+		}
+		_, err := w.Write(jsonResponse(result))
+		require.NoError(t, err)
+	})
+
+	renamed, err := client.RenameTag("infra", "infrastructure")
+	require.NoError(t, err)
+	assert.Equal(t, 2, renamed.EntitiesUpdated)
+	assert.Equal(t, map[string]any{"from": "infra", "to": "infrastructure"}, bodies["/api/tags/rename"])
+
+	merged, err := client.MergeTags("ops", "infrastructure")
+	require.NoError(t, err)
+	assert.Equal(t, 1, merged.KnowledgeUpdated)
+	assert.Equal(t, map[string]any{"source": "ops", "target": "infrastructure"}, bodies["/api/tags/merge"])
+
+	deleted, err := client.DeleteUnusedTags()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"stale"}, deleted.Deleted)
+	assert.Equal(t, map[string]any{}, bodies["/api/tags/delete-unused"])
+
+	_, err = client.DeleteUnusedTags("stale")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"tags": []any{"stale"}}, bodies["/api/tags/delete-unused"])
+}
@@ -59,3 +59,14 @@ func (c *Client) ActivateTaxonomy(kind, id string) (*TaxonomyEntry, error) {
 	}
 	return decodeOne[TaxonomyEntry](data)
 }
+
+// MergeScope reassigns everything tagged with sourceID to targetID and
+// archives the source scope. The server records the merge in the audit log.
+func (c *Client) MergeScope(sourceID, targetID string) (*ScopeMergeResult, error) {
+	body := map[string]string{"target_id": targetID}
+	data, err := c.post(fmt.Sprintf("/api/taxonomy/scopes/%s/merge", sourceID), body)
+	if err != nil {
+		return nil, err
+	}
+	return decodeOne[ScopeMergeResult](data)
+}
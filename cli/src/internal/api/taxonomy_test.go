@@ -138,3 +138,29 @@ func TestArchiveAndActivateTaxonomy(t *testing.T) {
 	require.NoError(t, err)
 	assert.True(t, active.IsActive)
 }
+
+// TestMergeScope handles test merge scope.
+func TestMergeScope(t *testing.T) {
+	_, client := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/taxonomy/scopes/scope-a/merge", r.URL.Path)
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "scope-b", body["target_id"])
+		_, err := w.Write(jsonResponse(map[string]any{
+			"source_id":         "scope-a",
+			"target_id":         "scope-b",
+			"entities_updated":  4,
+			"knowledge_updated": 2,
+			"audit_id":          "audit-9",
+		}))
+		require.NoError(t, err)
+	})
+
+	result, err := client.MergeScope("scope-a", "scope-b")
+	require.NoError(t, err)
+	assert.Equal(t, 4, result.EntitiesUpdated)
+	assert.Equal(t, 2, result.KnowledgeUpdated)
+	require.NotNil(t, result.AuditID)
+	assert.Equal(t, "audit-9", *result.AuditID)
+}
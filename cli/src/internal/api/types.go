@@ -76,6 +76,9 @@ type UpdateEntityInput struct {
 	Tags         *[]string      `json:"tags,omitempty"`
 	Metadata     map[string]any `json:"metadata,omitempty"`
 	StatusReason *string        `json:"status_reason,omitempty"`
+	Type         *string        `json:"type,omitempty"`
+	// ExpectedUpdatedAt rejects the update with a 409 if the entity changed since.
+	ExpectedUpdatedAt *time.Time `json:"expected_updated_at,omitempty"`
 }
 
 // BulkUpdateEntityTagsInput defines the fields for bulk tag updates.
@@ -98,6 +101,37 @@ type BulkUpdateResult struct {
 	EntityIDs []string `json:"entity_ids"`
 }
 
+// --- Collection ---
+
+// Collection is a named, user-owned group of entities.
+type Collection struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description,omitempty"`
+	EntityCount int       `json:"entity_count"`
+	Entities    []Entity  `json:"entities,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CreateCollectionInput defines the fields for creating a collection.
+type CreateCollectionInput struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+}
+
+// UpdateCollectionInput defines the fields for renaming a collection.
+type UpdateCollectionInput struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// CollectionEntitiesResult reports how many entities were newly added.
+type CollectionEntitiesResult struct {
+	Added     int `json:"added"`
+	Requested int `json:"requested"`
+}
+
 // --- Context ---
 
 // Context represents a piece of information or documentation.
@@ -155,6 +189,8 @@ type UpdateContextInput struct {
 	Scopes     *[]string      `json:"scopes,omitempty"`
 	Tags       *[]string      `json:"tags,omitempty"`
 	Metadata   map[string]any `json:"metadata,omitempty"`
+	// ExpectedUpdatedAt rejects the update with a 409 if the context changed since.
+	ExpectedUpdatedAt *time.Time `json:"expected_updated_at,omitempty"`
 }
 
 // --- Protocol ---
@@ -206,6 +242,31 @@ type UpdateProtocolInput struct {
 	SourcePath   *string        `json:"source_path,omitempty"`
 }
 
+// ProtocolDryRunInput carries sample input for a protocol dry run.
+type ProtocolDryRunInput struct {
+	Input map[string]any `json:"input"`
+}
+
+// ProtocolDryRunStep is one step found in a rendered protocol.
+type ProtocolDryRunStep struct {
+	Index   int     `json:"index"`
+	Section *string `json:"section,omitempty"`
+	Text    string  `json:"text"`
+}
+
+// ProtocolDryRun is a protocol rendered against sample input without saving.
+type ProtocolDryRun struct {
+	Name         string               `json:"name"`
+	Title        string               `json:"title"`
+	Version      *string              `json:"version,omitempty"`
+	Status       string               `json:"status,omitempty"`
+	Output       string               `json:"output"`
+	Steps        []ProtocolDryRunStep `json:"steps"`
+	Placeholders []string             `json:"placeholders"`
+	Missing      []string             `json:"missing"`
+	Unused       []string             `json:"unused"`
+}
+
 // --- Logs ---
 
 // Log represents a log entry.
@@ -324,22 +385,29 @@ type UpdateRelationshipInput struct {
 
 // Job represents an asynchronous task or workflow.
 type Job struct {
-	ID          string    `json:"id"`
-	Title       string    `json:"title"`
-	Description *string   `json:"description"`
-	Status      string    `json:"status"`
-	Priority    *string   `json:"priority"`
-	Metadata    JSONMap   `json:"metadata"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID           string     `json:"id"`
+	Title        string     `json:"title"`
+	Description  *string    `json:"description"`
+	Status       string     `json:"status"`
+	StatusReason *string    `json:"status_reason,omitempty"`
+	Priority     *string    `json:"priority"`
+	JobType      *string    `json:"job_type,omitempty"`
+	AssignedTo   *string    `json:"assigned_to,omitempty"`
+	DueAt        *time.Time `json:"due_at,omitempty"`
+	Metadata     JSONMap    `json:"metadata"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
 }
 
 // CreateJobInput defines the fields required to create a new job.
 type CreateJobInput struct {
 	Title       string         `json:"title"`
 	Description string         `json:"description,omitempty"`
-	Status      string         `json:"status"`
+	Status      string         `json:"status,omitempty"`
 	Priority    string         `json:"priority,omitempty"`
+	JobType     string         `json:"job_type,omitempty"`
+	AssignedTo  string         `json:"assigned_to,omitempty"`
+	DueAt       string         `json:"due_at,omitempty"`
 	Metadata    map[string]any `json:"metadata,omitempty"`
 }
 
@@ -349,24 +417,56 @@ type UpdateJobInput struct {
 	Description *string        `json:"description,omitempty"`
 	Status      *string        `json:"status,omitempty"`
 	Priority    *string        `json:"priority,omitempty"`
+	AssignedTo  *string        `json:"assigned_to,omitempty"`
+	DueAt       *string        `json:"due_at,omitempty"`
 	Metadata    map[string]any `json:"metadata,omitempty"`
+
+	// ClearAssignee and ClearDue send an explicit null so the server drops the
+	// current value; a nil pointer alone leaves the field untouched.
+	ClearAssignee bool `json:"-"`
+	ClearDue      bool `json:"-"`
+}
+
+// MarshalJSON handles marshal json.
+func (in UpdateJobInput) MarshalJSON() ([]byte, error) {
+	type plain UpdateJobInput
+	data, err := json.Marshal(plain(in))
+	if err != nil || (!in.ClearAssignee && !in.ClearDue) {
+		return data, err
+	}
+	var body map[string]any
+	if err := json.Unmarshal(data, &body); err != nil {
+		return nil, err
+	}
+	if in.ClearAssignee {
+		body["assigned_to"] = nil
+	}
+	if in.ClearDue {
+		body["due_at"] = nil
+	}
+	return json.Marshal(body)
 }
 
 // --- Approval ---
 
 // Approval represents a request requiring human intervention.
 type Approval struct {
-	ID              string    `json:"id"`
-	JobID           *string   `json:"job_id"`
-	RequestType     string    `json:"request_type"`
-	RequestedBy     string    `json:"requested_by"`
-	RequestedByName string    `json:"requested_by_name"`
-	AgentName       string    `json:"agent_name"`
-	ChangeDetails   JSONMap   `json:"change_details"`
-	ReviewDetails   JSONMap   `json:"review_details"`
-	Status          string    `json:"status"`
-	Notes           *string   `json:"review_notes"`
-	CreatedAt       time.Time `json:"created_at"`
+	ID              string     `json:"id"`
+	JobID           *string    `json:"job_id"`
+	RequestType     string     `json:"request_type"`
+	RequestedBy     string     `json:"requested_by"`
+	RequestedByName string     `json:"requested_by_name"`
+	AgentName       string     `json:"agent_name"`
+	ChangeDetails   JSONMap    `json:"change_details"`
+	ReviewDetails   JSONMap    `json:"review_details"`
+	Status          string     `json:"status"`
+	Priority        string     `json:"priority"`
+	Notes           *string    `json:"review_notes"`
+	DelegatedTo     *string    `json:"delegated_to"`
+	DelegatedToName string     `json:"delegated_to_name"`
+	DelegatedBy     *string    `json:"delegated_by"`
+	DelegatedAt     *time.Time `json:"delegated_at"`
+	CreatedAt       time.Time  `json:"created_at"`
 }
 
 // ApproveRequestInput defines optional reviewer grants for approval execution.
@@ -376,6 +476,43 @@ type ApproveRequestInput struct {
 	GrantRequiresApproval *bool    `json:"grant_requires_approval,omitempty"`
 }
 
+// ApprovalComment is one message in an approval's discussion thread. Comments
+// never change the approval status; agents read them to answer reviewers.
+type ApprovalComment struct {
+	ID         string    `json:"id"`
+	ApprovalID string    `json:"approval_id"`
+	ParentID   *string   `json:"parent_id"`
+	AuthorID   string    `json:"author_id"`
+	AuthorName string    `json:"author_name"`
+	AuthorType string    `json:"author_type"`
+	Body       string    `json:"body"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// CreateApprovalCommentInput defines a new approval comment or reply.
+type CreateApprovalCommentInput struct {
+	Body     string `json:"body"`
+	ParentID string `json:"parent_id,omitempty"`
+}
+
+// Comment is a note on an entity or context item, kept apart from metadata.
+type Comment struct {
+	ID         string    `json:"id"`
+	EntityID   *string   `json:"entity_id,omitempty"`
+	ContextID  *string   `json:"context_id,omitempty"`
+	AuthorType string    `json:"author_type"`
+	AuthorID   string    `json:"author_id"`
+	AuthorName string    `json:"author_name"`
+	Body       string    `json:"body"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// CommentInput is the body of a new or edited comment.
+type CommentInput struct {
+	Body string `json:"body"`
+}
+
 // ApprovalDiff represents server computed diff for approval requests.
 type ApprovalDiff struct {
 	ApprovalID  string         `json:"approval_id"`
@@ -383,6 +520,15 @@ type ApprovalDiff struct {
 	Changes     map[string]any `json:"changes"`
 }
 
+// EntityReference is an item that links to or mentions an entity. Via is the
+// relationship type, or "mentions" for a name match.
+type EntityReference struct {
+	Kind  string `json:"kind"`
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Via   string `json:"via"`
+}
+
 // AuditEntry represents a history entry from audit_log.
 type AuditEntry struct {
 	ID            string    `json:"id"`
@@ -398,6 +544,18 @@ type AuditEntry struct {
 	ChangeReason  *string   `json:"change_reason"`
 	Metadata      JSONMap   `json:"metadata"`
 	ChangedAt     time.Time `json:"changed_at"`
+	Hash          *string   `json:"hash,omitempty"`
+	PrevHash      *string   `json:"prev_hash,omitempty"`
+}
+
+// AuditIntegrity is the server-signed head of the audit hash chain.
+type AuditIntegrity struct {
+	EntryID   string    `json:"entry_id"`
+	Hash      string    `json:"hash"`
+	Algorithm string    `json:"algorithm"`
+	Signature string    `json:"signature"`
+	PublicKey string    `json:"public_key"`
+	SignedAt  time.Time `json:"signed_at"`
 }
 
 // AuditScope represents privacy scope usage stats.
@@ -419,6 +577,34 @@ type AuditActor struct {
 	LastSeen    time.Time `json:"last_seen"`
 }
 
+// AuditStats summarizes audit activity over a time window.
+type AuditStats struct {
+	Total      int                `json:"total"`
+	Daily      []AuditDayCount    `json:"daily"`
+	TopActors  []AuditActor       `json:"top_actors"`
+	TopTables  []AuditTableCount  `json:"top_tables"`
+	TopRecords []AuditRecordCount `json:"top_records"`
+}
+
+// AuditDayCount is the number of audit entries on one day (YYYY-MM-DD).
+type AuditDayCount struct {
+	Day         string `json:"day"`
+	ActionCount int    `json:"action_count"`
+}
+
+// AuditTableCount is the number of audit entries for one table.
+type AuditTableCount struct {
+	TableName   string `json:"table_name"`
+	ActionCount int    `json:"action_count"`
+}
+
+// AuditRecordCount is the number of audit entries for one record.
+type AuditRecordCount struct {
+	TableName   string `json:"table_name"`
+	RecordID    string `json:"record_id"`
+	ActionCount int    `json:"action_count"`
+}
+
 // BulkImportError represents a per-row import error.
 type BulkImportError struct {
 	Row   int    `json:"row"`
@@ -428,42 +614,84 @@ type BulkImportError struct {
 // BulkImportResult represents the result of a bulk import.
 type BulkImportResult struct {
 	Created int               `json:"created"`
+	Updated int               `json:"updated"`
 	Failed  int               `json:"failed"`
 	Errors  []BulkImportError `json:"errors"`
 	Items   []map[string]any  `json:"items"`
 }
 
+// ImportValidation is the dry-run report of a bulk import.
+type ImportValidation struct {
+	Resource string                `json:"resource"`
+	Total    int                   `json:"total"`
+	Counts   map[string]int        `json:"counts"`
+	Warnings int                   `json:"warnings"`
+	Rows     []ImportValidationRow `json:"rows"`
+}
+
+// ImportValidationRow is what an import would do with one row. Action is
+// create, update, skip, or invalid; Item is the row as it was sent. Rows
+// whose ExternalID was imported before update RecordID.
+type ImportValidationRow struct {
+	Row        int               `json:"row"`
+	Action     string            `json:"action"`
+	Label      string            `json:"label"`
+	Errors     []string          `json:"errors"`
+	Warnings   []string          `json:"warnings"`
+	Duplicates []ImportDuplicate `json:"duplicates"`
+	ExternalID string            `json:"external_id,omitempty"`
+	RecordID   string            `json:"record_id,omitempty"`
+	Item       map[string]any    `json:"item"`
+}
+
+// ImportDuplicate is an existing record an import row matches.
+type ImportDuplicate struct {
+	ID    string `json:"id"`
+	Label string `json:"label"`
+}
+
 // ExportResult represents export payload data.
 type ExportResult struct {
 	Format  string           `json:"format"`
 	Content string           `json:"content,omitempty"`
 	Items   []map[string]any `json:"items,omitempty"`
 	Count   int              `json:"count"`
+	// Total counts every matching row. The server only sends it with the
+	// first page.
+	Total int `json:"total,omitempty"`
 }
 
 // --- Taxonomy ---
 
 // TaxonomyEntry represents a taxonomy row for scopes/types.
 type TaxonomyEntry struct {
-	ID          string    `json:"id"`
-	Name        string    `json:"name"`
-	Description *string   `json:"description,omitempty"`
-	IsBuiltin   bool      `json:"is_builtin"`
-	IsActive    bool      `json:"is_active"`
-	Metadata    JSONMap   `json:"metadata,omitempty"`
-	IsSymmetric *bool     `json:"is_symmetric,omitempty"`
-	ValueSchema JSONMap   `json:"value_schema,omitempty"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	IsBuiltin   bool    `json:"is_builtin"`
+	IsActive    bool    `json:"is_active"`
+	Metadata    JSONMap `json:"metadata,omitempty"`
+	IsSymmetric *bool   `json:"is_symmetric,omitempty"`
+	ValueSchema JSONMap `json:"value_schema,omitempty"`
+	InverseName *string `json:"inverse_name,omitempty"`
+	// SourceEntityTypes and TargetEntityTypes restrict which entity types a
+	// relationship type may connect. Empty means any.
+	SourceEntityTypes []string  `json:"source_entity_types,omitempty"`
+	TargetEntityTypes []string  `json:"target_entity_types,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
 }
 
 // CreateTaxonomyInput defines fields for creating taxonomy entries.
 type CreateTaxonomyInput struct {
-	Name        string         `json:"name"`
-	Description string         `json:"description,omitempty"`
-	Metadata    map[string]any `json:"metadata,omitempty"`
-	IsSymmetric *bool          `json:"is_symmetric,omitempty"`
-	ValueSchema map[string]any `json:"value_schema,omitempty"`
+	Name              string         `json:"name"`
+	Description       string         `json:"description,omitempty"`
+	Metadata          map[string]any `json:"metadata,omitempty"`
+	IsSymmetric       *bool          `json:"is_symmetric,omitempty"`
+	ValueSchema       map[string]any `json:"value_schema,omitempty"`
+	InverseName       string         `json:"inverse_name,omitempty"`
+	SourceEntityTypes []string       `json:"source_entity_types,omitempty"`
+	TargetEntityTypes []string       `json:"target_entity_types,omitempty"`
 }
 
 // UpdateTaxonomyInput defines fields for updating taxonomy entries.
@@ -473,6 +701,42 @@ type UpdateTaxonomyInput struct {
 	Metadata    map[string]any `json:"metadata,omitempty"`
 	IsSymmetric *bool          `json:"is_symmetric,omitempty"`
 	ValueSchema map[string]any `json:"value_schema,omitempty"`
+	InverseName *string        `json:"inverse_name,omitempty"`
+	// Pointers so an empty list clears the allow-list while nil leaves it.
+	SourceEntityTypes *[]string `json:"source_entity_types,omitempty"`
+	TargetEntityTypes *[]string `json:"target_entity_types,omitempty"`
+}
+
+// ScopeMergeResult reports what a scope merge reassigned.
+type ScopeMergeResult struct {
+	SourceID         string  `json:"source_id"`
+	TargetID         string  `json:"target_id"`
+	EntitiesUpdated  int     `json:"entities_updated"`
+	KnowledgeUpdated int     `json:"knowledge_updated"`
+	AuditID          *string `json:"audit_id,omitempty"`
+}
+
+// --- Tags ---
+
+// TagUsage reports how often a tag is used per resource kind.
+type TagUsage struct {
+	Name      string `json:"name"`
+	Entities  int    `json:"entities"`
+	Knowledge int    `json:"knowledge"`
+	Files     int    `json:"files"`
+}
+
+// Total returns the usage count across every resource kind.
+func (t TagUsage) Total() int {
+	return t.Entities + t.Knowledge + t.Files
+}
+
+// TagBulkResult reports what a bulk tag operation changed.
+type TagBulkResult struct {
+	EntitiesUpdated  int      `json:"entities_updated"`
+	KnowledgeUpdated int      `json:"knowledge_updated"`
+	FilesUpdated     int      `json:"files_updated"`
+	Deleted          []string `json:"deleted,omitempty"`
 }
 
 // --- Agent ---
@@ -515,6 +779,23 @@ type UpdateAgentInput struct {
 	Scopes           []string `json:"scopes,omitempty"`
 }
 
+// AgentPermissions describes the scopes, request types, and limits granted to an agent.
+type AgentPermissions struct {
+	AgentID            string     `json:"agent_id,omitempty"`
+	Scopes             []string   `json:"scopes"`
+	RequestTypes       []string   `json:"request_types"`
+	RateLimitPerMinute int        `json:"rate_limit_per_minute"`
+	ExpiresAt          *time.Time `json:"expires_at"`
+}
+
+// UpdateAgentPermissionsInput replaces an agent's permission set; a nil ExpiresAt clears the expiry.
+type UpdateAgentPermissionsInput struct {
+	Scopes             []string `json:"scopes"`
+	RequestTypes       []string `json:"request_types"`
+	RateLimitPerMinute int      `json:"rate_limit_per_minute"`
+	ExpiresAt          *string  `json:"expires_at"`
+}
+
 // --- API Key ---
 
 // APIKey represents an authentication token.
@@ -550,7 +831,49 @@ type LoginResponse struct {
 	Username string `json:"username"`
 }
 
+// DeviceCode is the server's answer to a device login request.
+type DeviceCode struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete,omitempty"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+// OAuthToken is an SSO access token with the refresh token that renews it.
+type OAuthToken struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	TokenType    string `json:"token_type,omitempty"`
+	ExpiresIn    int    `json:"expires_in"`
+	EntityID     string `json:"entity_id,omitempty"`
+	Username     string `json:"username,omitempty"`
+}
+
 // --- Query ---
 
 // QueryParams is a map of URL query parameters.
 type QueryParams map[string]string
+
+// TimeWindow bounds a query by time. A zero Since or Until leaves that side
+// open; Until is exclusive.
+type TimeWindow struct {
+	Since time.Time
+	Until time.Time
+}
+
+// IsZero reports whether the window is open on both sides.
+func (w TimeWindow) IsZero() bool {
+	return w.Since.IsZero() && w.Until.IsZero()
+}
+
+// Apply adds the since and until params in RFC 3339.
+func (w TimeWindow) Apply(params QueryParams) {
+	if !w.Since.IsZero() {
+		params["since"] = w.Since.Format(time.RFC3339)
+	}
+	if !w.Until.IsZero() {
+		params["until"] = w.Until.Format(time.RFC3339)
+	}
+}
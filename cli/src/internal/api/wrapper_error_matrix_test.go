@@ -245,6 +245,26 @@ func TestClientWrappersReturnErrorsOnNon2xx(t *testing.T) {
 			name: "taxonomy/activate",
 			call: func(c *Client) error { _, err := c.ActivateTaxonomy("status", "id-1"); return err },
 		},
+		{
+			name: "taxonomy/merge-scope",
+			call: func(c *Client) error { _, err := c.MergeScope("id-1", "id-2"); return err },
+		},
+		{
+			name: "tags/list",
+			call: func(c *Client) error { _, err := c.ListTags("", 0, 0); return err },
+		},
+		{
+			name: "tags/rename",
+			call: func(c *Client) error { _, err := c.RenameTag("a", "b"); return err },
+		},
+		{
+			name: "tags/merge",
+			call: func(c *Client) error { _, err := c.MergeTags("a", "b"); return err },
+		},
+		{
+			name: "tags/delete-unused",
+			call: func(c *Client) error { _, err := c.DeleteUnusedTags(); return err },
+		},
 	}
 
 	for _, tc := range cases {
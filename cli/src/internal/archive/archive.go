@@ -0,0 +1,112 @@
+package archive
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// Magic opens every encrypted archive and identifies its format version.
+const Magic = "NEBULA-ENC1\n"
+
+// Iterations is the PBKDF2-SHA256 work factor for deriving archive keys.
+const Iterations = 600_000
+
+const (
+	saltSize = 16
+	keySize  = 32
+)
+
+// ErrPassphrase is returned when an archive does not open with the given
+// passphrase, or was tampered with.
+var ErrPassphrase = errors.New("wrong passphrase or corrupted archive")
+
+// Encrypt seals plaintext with AES-256-GCM under a key derived from
+// passphrase. The result is Magic, the salt, the nonce, then the ciphertext.
+func Encrypt(plaintext []byte, passphrase string) ([]byte, error) {
+	if passphrase == "" {
+		return nil, fmt.Errorf("passphrase is required")
+	}
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("generate salt: %w", err)
+	}
+	aead, err := newAEAD(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+	out := make([]byte, 0, len(Magic)+saltSize+len(nonce)+len(plaintext)+aead.Overhead())
+	out = append(out, Magic...)
+	out = append(out, salt...)
+	out = append(out, nonce...)
+	return aead.Seal(out, nonce, plaintext, []byte(Magic)), nil
+}
+
+// Decrypt opens an archive made by Encrypt.
+func Decrypt(data []byte, passphrase string) ([]byte, error) {
+	if !IsEncrypted(data) {
+		return nil, fmt.Errorf("not an encrypted nebula archive")
+	}
+	rest := data[len(Magic):]
+	if len(rest) < saltSize {
+		return nil, ErrPassphrase
+	}
+	salt, rest := rest[:saltSize], rest[saltSize:]
+	aead, err := newAEAD(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) < aead.NonceSize() {
+		return nil, ErrPassphrase
+	}
+	nonce, sealed := rest[:aead.NonceSize()], rest[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, sealed, []byte(Magic))
+	if err != nil {
+		return nil, ErrPassphrase
+	}
+	return plaintext, nil
+}
+
+// IsEncrypted reports whether data starts with the archive header.
+func IsEncrypted(data []byte) bool {
+	return bytes.HasPrefix(data, []byte(Magic))
+}
+
+// IsEncryptedFile reports whether the file at path is an encrypted archive.
+func IsEncryptedFile(path string) (bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+	head := make([]byte, len(Magic))
+	n, _ := file.Read(head)
+	return IsEncrypted(head[:n]), nil
+}
+
+// newAEAD derives the archive key and returns its GCM cipher.
+func newAEAD(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := pbkdf2.Key(sha256.New, passphrase, salt, Iterations, keySize)
+	if err != nil {
+		return nil, fmt.Errorf("derive key: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("init cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("init gcm: %w", err)
+	}
+	return aead, nil
+}
@@ -0,0 +1,63 @@
+package archive
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptDecryptRoundTrip(t *testing.T) {
+	plaintext := []byte(`{"items":[{"name":"Alpha","scopes":["admin"]}]}`)
+
+	sealed, err := Encrypt(plaintext, "correct horse")
+	require.NoError(t, err)
+	assert.True(t, IsEncrypted(sealed))
+	assert.NotContains(t, string(sealed), "Alpha")
+
+	opened, err := Decrypt(sealed, "correct horse")
+	require.NoError(t, err)
+	assert.Equal(t, plaintext, opened)
+
+	again, err := Encrypt(plaintext, "correct horse")
+	require.NoError(t, err)
+	assert.NotEqual(t, sealed, again, "salt and nonce are fresh per archive")
+}
+
+func TestDecryptRejectsWrongPassphraseAndTampering(t *testing.T) {
+	sealed, err := Encrypt([]byte("secret"), "right")
+	require.NoError(t, err)
+
+	_, err = Decrypt(sealed, "wrong")
+	assert.ErrorIs(t, err, ErrPassphrase)
+
+	sealed[len(sealed)-1] ^= 0xff
+	_, err = Decrypt(sealed, "right")
+	assert.ErrorIs(t, err, ErrPassphrase)
+
+	_, err = Decrypt([]byte("plain"), "right")
+	assert.Error(t, err)
+	_, err = Encrypt([]byte("plain"), "")
+	assert.Error(t, err)
+}
+
+func TestIsEncryptedFile(t *testing.T) {
+	dir := t.TempDir()
+	sealed, err := Encrypt([]byte("secret"), "pw")
+	require.NoError(t, err)
+	encPath := filepath.Join(dir, "export.nebx")
+	plainPath := filepath.Join(dir, "export.json")
+	require.NoError(t, os.WriteFile(encPath, sealed, 0o600))
+	require.NoError(t, os.WriteFile(plainPath, []byte("[]"), 0o600))
+
+	ok, err := IsEncryptedFile(encPath)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = IsEncryptedFile(plainPath)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	_, err = IsEncryptedFile(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,315 @@
+// Package bookmarks reads Chrome and Firefox bookmark exports and turns them
+// into knowledge import rows.
+package bookmarks
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Bookmark is one saved link and the folders it was filed under, outermost
+// first.
+type Bookmark struct {
+	Title   string
+	URL     string
+	Folders []string
+	AddedAt time.Time
+}
+
+// Parse reads a bookmark export. format is "html" for the Netscape bookmark
+// file both browsers export, or "json" for a Chrome Bookmarks file or a
+// Firefox backup.
+func Parse(format string, data []byte) ([]Bookmark, error) {
+	switch format {
+	case "html":
+		return parseHTML(string(data))
+	case "json":
+		return parseJSON(data)
+	}
+	return nil, fmt.Errorf("unknown bookmark format %q", format)
+}
+
+// htmlToken matches the parts of a Netscape bookmark file that carry
+// structure: folder headings, links, and list open and close tags.
+var htmlToken = regexp.MustCompile(`(?is)<h3([^>]*)>(.*?)</h3>|<a\s([^>]*)>(.*?)</a>|<dl[^>]*>|</dl>`)
+
+var (
+	htmlAttr = regexp.MustCompile(`(?i)([a-z_]+)\s*=\s*"([^"]*)"`)
+	htmlTags = regexp.MustCompile(`(?s)<[^>]*>`)
+)
+
+// parseHTML reads the Netscape bookmark file format. A folder heading names
+// the list that follows it.
+func parseHTML(doc string) ([]Bookmark, error) {
+	if !strings.Contains(strings.ToUpper(doc), "<DL") {
+		return nil, fmt.Errorf("not a bookmark file: no bookmark list found")
+	}
+	var (
+		out     []Bookmark
+		stack   []string
+		pending string
+	)
+	for _, m := range htmlToken.FindAllStringSubmatch(doc, -1) {
+		token := strings.ToLower(m[0])
+		switch {
+		case strings.HasPrefix(token, "<h3"):
+			pending = htmlText(m[2])
+			if attrs := htmlAttrs(m[1]); attrs["personal_toolbar_folder"] != "" || attrs["unfiled_bookmarks_folder"] != "" {
+				// Browser root folders say where a link was shown, not
+				// what it is about.
+				pending = ""
+			}
+		case strings.HasPrefix(token, "<dl"):
+			stack = append(stack, pending)
+			pending = ""
+		case token == "</dl>":
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+		default:
+			attrs := htmlAttrs(m[3])
+			out = append(out, Bookmark{
+				Title:   htmlText(m[4]),
+				URL:     strings.TrimSpace(attrs["href"]),
+				Folders: folderPath(stack),
+				AddedAt: unixTime(attrs["add_date"], time.Second),
+			})
+		}
+	}
+	return out, nil
+}
+
+// htmlAttrs reads the quoted attributes of a tag, keyed in lower case.
+func htmlAttrs(raw string) map[string]string {
+	attrs := map[string]string{}
+	for _, m := range htmlAttr.FindAllStringSubmatch(raw, -1) {
+		attrs[strings.ToLower(m[1])] = html.UnescapeString(m[2])
+	}
+	return attrs
+}
+
+// htmlText reduces inline HTML to plain text.
+func htmlText(raw string) string {
+	return strings.TrimSpace(html.UnescapeString(htmlTags.ReplaceAllString(raw, "")))
+}
+
+// folderPath copies the named folders of a stack.
+func folderPath(stack []string) []string {
+	var out []string
+	for _, name := range stack {
+		if name != "" {
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+// unixTime reads a decimal timestamp in the given unit; zero when absent.
+func unixTime(raw string, unit time.Duration) time.Time {
+	n, err := strconv.ParseInt(strings.TrimSpace(raw), 10, 64)
+	if err != nil || n <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, 0).Add(time.Duration(n) * unit).UTC()
+}
+
+// jsonNode covers both Chrome bookmark nodes and Firefox backup nodes.
+type jsonNode struct {
+	// Chrome
+	Type      string     `json:"type"`
+	Name      string     `json:"name"`
+	URL       string     `json:"url"`
+	DateAdded string     `json:"date_added"`
+	Children  []jsonNode `json:"children"`
+	// Firefox
+	Title     string `json:"title"`
+	URI       string `json:"uri"`
+	Root      string `json:"root"`
+	FFAddedAt int64  `json:"dateAdded"`
+}
+
+// parseJSON reads a Chrome Bookmarks file ({"roots": {...}}) or a Firefox
+// JSON backup (a tree of text/x-moz-place nodes).
+func parseJSON(data []byte) ([]Bookmark, error) {
+	var chrome struct {
+		Roots map[string]json.RawMessage `json:"roots"`
+	}
+	if err := json.Unmarshal(data, &chrome); err != nil {
+		return nil, fmt.Errorf("parse bookmark JSON: %w", err)
+	}
+	var out []Bookmark
+	if len(chrome.Roots) > 0 {
+		for _, key := range []string{"bookmark_bar", "other", "synced"} {
+			// The roots are the bar and menus links sit in, not folders.
+			var root jsonNode
+			if raw, ok := chrome.Roots[key]; ok && json.Unmarshal(raw, &root) == nil {
+				for _, child := range root.Children {
+					out = walkJSON(child, nil, out)
+				}
+			}
+		}
+		return out, nil
+	}
+	var root jsonNode
+	if err := json.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("parse bookmark JSON: %w", err)
+	}
+	if !strings.HasPrefix(root.Type, "text/x-moz-place") {
+		return nil, fmt.Errorf("not a bookmark file: expected Chrome roots or a Firefox backup")
+	}
+	return walkJSON(root, nil, out), nil
+}
+
+// walkJSON collects the links under node. Firefox root containers add no
+// folder of their own.
+func walkJSON(node jsonNode, folders []string, out []Bookmark) []Bookmark {
+	switch node.Type {
+	case "url":
+		return append(out, Bookmark{
+			Title:   strings.TrimSpace(node.Name),
+			URL:     strings.TrimSpace(node.URL),
+			Folders: folders,
+			AddedAt: chromeTime(node.DateAdded),
+		})
+	case "text/x-moz-place":
+		return append(out, Bookmark{
+			Title:   strings.TrimSpace(node.Title),
+			URL:     strings.TrimSpace(node.URI),
+			Folders: folders,
+			AddedAt: unixTime(strconv.FormatInt(node.FFAddedAt, 10), time.Microsecond),
+		})
+	}
+	if name := strings.TrimSpace(node.Name + node.Title); name != "" && node.Root == "" {
+		folders = append(slices.Clone(folders), name)
+	}
+	for _, child := range node.Children {
+		out = walkJSON(child, folders, out)
+	}
+	return out
+}
+
+// chromeEpochOffset is the number of seconds between 1601-01-01 and the
+// Unix epoch.
+const chromeEpochOffset = 11644473600
+
+// chromeTime reads a Chrome timestamp: microseconds since 1601-01-01.
+func chromeTime(raw string) time.Time {
+	n, err := strconv.ParseInt(strings.TrimSpace(raw), 10, 64)
+	if err != nil || n <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(n/1e6-chromeEpochOffset, (n%1e6)*1e3).UTC()
+}
+
+// Set is the outcome of preparing a bookmark export for import.
+type Set struct {
+	Bookmarks []Bookmark
+	// Duplicates counts links merged into an earlier one with the same URL.
+	Duplicates int
+	// Unsupported counts links that are not http(s), such as javascript:
+	// bookmarklets and browser-internal pages.
+	Unsupported int
+}
+
+// Prepare drops links that cannot be imported and merges duplicate URLs,
+// keeping the first title and the folders of every copy.
+func Prepare(in []Bookmark) Set {
+	var set Set
+	index := map[string]int{}
+	for _, b := range in {
+		if !strings.HasPrefix(b.URL, "http://") && !strings.HasPrefix(b.URL, "https://") {
+			set.Unsupported++
+			continue
+		}
+		key := NormalizeURL(b.URL)
+		if i, ok := index[key]; ok {
+			set.Duplicates++
+			kept := &set.Bookmarks[i]
+			for _, folder := range b.Folders {
+				if !slices.Contains(kept.Folders, folder) {
+					kept.Folders = append(kept.Folders, folder)
+				}
+			}
+			continue
+		}
+		index[key] = len(set.Bookmarks)
+		b.Folders = slices.Clone(b.Folders)
+		if b.Title == "" {
+			b.Title = b.URL
+		}
+		set.Bookmarks = append(set.Bookmarks, b)
+	}
+	return set
+}
+
+// NormalizeURL reduces a URL to the form two copies of a link share: lower
+// case scheme and host, no fragment, and no trailing slash.
+func NormalizeURL(raw string) string {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return strings.TrimSpace(raw)
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	u.Fragment = ""
+	u.RawFragment = ""
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	u.RawPath = strings.TrimSuffix(u.RawPath, "/")
+	return u.String()
+}
+
+// FolderTag turns a folder name into a tag.
+func FolderTag(name string) string {
+	s := strings.ToLower(strings.TrimSpace(name))
+	s = strings.ReplaceAll(s, "_", "-")
+	return strings.Join(strings.Fields(s), "-")
+}
+
+// Tags returns the tags of a bookmark: "bookmark" and one per folder.
+func (b Bookmark) Tags() []string {
+	tags := []string{"bookmark"}
+	for _, folder := range b.Folders {
+		if tag := FolderTag(folder); tag != "" && !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// ExternalID keys a bookmark by its normalized URL, so importing a newer
+// export updates the links an earlier one created.
+func (b Bookmark) ExternalID() string {
+	return "bookmark:" + NormalizeURL(b.URL)
+}
+
+// Items returns the knowledge import rows for the set: articles tagged with
+// their folders.
+func (s Set) Items(scopes []string) []map[string]any {
+	items := make([]map[string]any, 0, len(s.Bookmarks))
+	for _, b := range s.Bookmarks {
+		meta := map[string]any{"folder": strings.Join(b.Folders, " / ")}
+		if !b.AddedAt.IsZero() {
+			meta["added_at"] = b.AddedAt.Format(time.RFC3339)
+		}
+		item := map[string]any{
+			"external_id": b.ExternalID(),
+			"title":       b.Title,
+			"url":         b.URL,
+			"source_type": "article",
+			"tags":        b.Tags(),
+			"metadata":    map[string]any{"bookmark": meta},
+		}
+		if len(scopes) > 0 {
+			item["scopes"] = scopes
+		}
+		items = append(items, item)
+	}
+	return items
+}
@@ -0,0 +1,95 @@
+package bookmarks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testHTML = `<!DOCTYPE NETSCAPE-Bookmark-file-1>
+<META HTTP-EQUIV="Content-Type" CONTENT="text/html; charset=UTF-8">
+<TITLE>Bookmarks</TITLE>
+<H1>Bookmarks</H1>
+<DL><p>
+    <DT><H3 ADD_DATE="1700000000" PERSONAL_TOOLBAR_FOLDER="true">Bookmarks bar</H3>
+    <DL><p>
+        <DT><H3>Dev Tools</H3>
+        <DL><p>
+            <DT><A HREF="https://go.dev/doc/" ADD_DATE="1700000100">Go &amp; docs</A>
+            <DT><H3>Reading_List</H3>
+            <DL><p>
+                <DT><A HREF="https://example.com/post#intro">A post</A>
+            </DL><p>
+        </DL><p>
+        <DT><A HREF="javascript:alert(1)">Bookmarklet</A>
+    </DL><p>
+    <DT><A HREF="https://Example.com/post/">Same post</A>
+</DL><p>
+`
+
+func TestParseHTMLMapsFoldersAndDedupesURLs(t *testing.T) {
+	parsed, err := Parse("html", []byte(testHTML))
+	require.NoError(t, err)
+	require.Len(t, parsed, 4)
+	assert.Equal(t, Bookmark{
+		Title:   "Go & docs",
+		URL:     "https://go.dev/doc/",
+		Folders: []string{"Dev Tools"},
+		AddedAt: time.Unix(1700000100, 0).UTC(),
+	}, parsed[0])
+	assert.Equal(t, []string{"Dev Tools", "Reading_List"}, parsed[1].Folders)
+	assert.Empty(t, parsed[3].Folders)
+
+	set := Prepare(parsed)
+	assert.Equal(t, 1, set.Duplicates)
+	assert.Equal(t, 1, set.Unsupported)
+	require.Len(t, set.Bookmarks, 2)
+	assert.Equal(t, []string{"bookmark", "dev-tools", "reading-list"}, set.Bookmarks[1].Tags())
+
+	items := set.Items([]string{"work"})
+	assert.Equal(t, "bookmark:https://example.com/post", items[1]["external_id"])
+	assert.Equal(t, "article", items[1]["source_type"])
+	assert.Equal(t, []string{"work"}, items[1]["scopes"])
+	assert.Equal(t, map[string]any{"folder": "Dev Tools / Reading_List"}, items[1]["metadata"].(map[string]any)["bookmark"])
+
+	_, err = Parse("html", []byte("<html>nothing</html>"))
+	assert.ErrorContains(t, err, "not a bookmark file")
+}
+
+func TestParseJSONReadsChromeAndFirefoxExports(t *testing.T) {
+	chrome := `{"roots": {
+		"bookmark_bar": {"type": "folder", "name": "Bookmarks bar", "children": [
+			{"type": "folder", "name": "Research", "children": [
+				{"type": "url", "name": "Paper", "url": "https://arxiv.org/abs/1", "date_added": "13345000000000000"}
+			]}
+		]},
+		"other": {"type": "folder", "name": "Other bookmarks", "children": [
+			{"type": "url", "name": "News", "url": "https://news.example.com"}
+		]}
+	}}`
+	parsed, err := Parse("json", []byte(chrome))
+	require.NoError(t, err)
+	require.Len(t, parsed, 2)
+	assert.Equal(t, []string{"Research"}, parsed[0].Folders)
+	assert.Equal(t, 2023, parsed[0].AddedAt.Year())
+	assert.Empty(t, parsed[1].Folders)
+
+	firefox := `{"type": "text/x-moz-place-container", "root": "placesRoot", "children": [
+		{"type": "text/x-moz-place-container", "title": "toolbar", "root": "toolbarFolder", "children": [
+			{"type": "text/x-moz-place-container", "title": "Recipes", "children": [
+				{"type": "text/x-moz-place", "title": "Soup", "uri": "https://soup.example.com", "dateAdded": 1700000000000000}
+			]}
+		]}
+	]}`
+	parsed, err = Parse("json", []byte(firefox))
+	require.NoError(t, err)
+	require.Len(t, parsed, 1)
+	assert.Equal(t, "Soup", parsed[0].Title)
+	assert.Equal(t, []string{"Recipes"}, parsed[0].Folders)
+	assert.Equal(t, time.Unix(1700000000, 0).UTC(), parsed[0].AddedAt)
+
+	_, err = Parse("json", []byte(`{"items": []}`))
+	assert.ErrorContains(t, err, "not a bookmark file")
+}
@@ -0,0 +1,393 @@
+package bundle
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+// Output formats.
+const (
+	FormatMarkdown = "markdown"
+	FormatJSON     = "json"
+)
+
+// maxNodes caps how many related entities a bundle walks, so a dense graph
+// cannot turn one command into hundreds of requests.
+const maxNodes = 50
+
+// maxContentChars caps each knowledge item's content, in runes, before budgeting.
+const maxContentChars = 4000
+
+// Options controls what goes into a bundle.
+type Options struct {
+	Depth         int
+	HistoryLimit  int
+	MaxTokens     int
+	IncludeScopes []string
+	ExcludeScopes []string
+}
+
+// DefaultOptions returns the options `nebula context` uses without flags.
+func DefaultOptions() Options {
+	return Options{Depth: 1, HistoryLimit: 10, MaxTokens: 4000}
+}
+
+// Related is an entity reached from the root, with the hop count and the
+// relationship that reached it.
+type Related struct {
+	Entity api.Entity `json:"entity"`
+	Hops   int        `json:"hops"`
+	Via    string     `json:"via"`
+}
+
+// Bundle is an entity with the records around it.
+type Bundle struct {
+	Entity        api.Entity         `json:"entity"`
+	Scopes        []string           `json:"scopes,omitempty"`
+	Related       []Related          `json:"related,omitempty"`
+	Relationships []api.Relationship `json:"relationships,omitempty"`
+	Knowledge     []api.Context      `json:"knowledge,omitempty"`
+	History       []api.AuditEntry   `json:"history,omitempty"`
+	Omitted       []string           `json:"omitted,omitempty"`
+	GeneratedAt   time.Time          `json:"generated_at"`
+
+	scopeNames map[string]string
+}
+
+// Build fetches the entity, walks its relationships opts.Depth hops, and
+// collects linked knowledge and recent history, dropping records whose
+// scopes the options exclude.
+func Build(client *api.Client, id string, opts Options) (*Bundle, error) {
+	if opts.Depth < 0 {
+		opts.Depth = 0
+	}
+	root, err := client.GetEntity(id)
+	if err != nil {
+		return nil, fmt.Errorf("get entity: %w", err)
+	}
+	b := &Bundle{Entity: *root, GeneratedAt: time.Now().UTC(), scopeNames: map[string]string{}}
+	if scopes, err := client.ListAuditScopes(); err == nil {
+		for _, scope := range scopes {
+			b.scopeNames[scope.ID] = scope.Name
+		}
+	}
+	b.Scopes = b.scopeLabels(root.PrivacyScopeIDs)
+	if !opts.allows(b.Scopes) {
+		return nil, fmt.Errorf("entity %s is outside the selected scopes", id)
+	}
+
+	seen := map[string]bool{root.ID: true}
+	seenRels := map[string]bool{}
+	seenKnowledge := map[string]bool{}
+	frontier := []string{root.ID}
+	for hop := 1; hop <= opts.Depth && len(frontier) > 0; hop++ {
+		var next []string
+		for _, current := range frontier {
+			rels, err := client.GetRelationships("entity", current)
+			if err != nil {
+				return nil, fmt.Errorf("get relationships: %w", err)
+			}
+			for _, rel := range rels {
+				otherType, otherID := rel.TargetType, rel.TargetID
+				if rel.TargetID == current {
+					otherType, otherID = rel.SourceType, rel.SourceID
+				}
+				switch otherType {
+				case "entity", "":
+					if seen[otherID] {
+						b.addRelationship(rel, seenRels)
+						continue
+					}
+					if len(seen) > maxNodes {
+						b.omit(fmt.Sprintf("entities beyond %d", maxNodes))
+						continue
+					}
+					seen[otherID] = true
+					entity, err := client.GetEntity(otherID)
+					if err != nil {
+						continue
+					}
+					if !opts.allows(b.scopeLabels(entity.PrivacyScopeIDs)) {
+						b.omit("out-of-scope entities")
+						continue
+					}
+					b.addRelationship(rel, seenRels)
+					b.Related = append(b.Related, Related{Entity: *entity, Hops: hop, Via: rel.Type})
+					next = append(next, otherID)
+				case "context":
+					if seenKnowledge[otherID] {
+						continue
+					}
+					seenKnowledge[otherID] = true
+					item, err := client.GetContext(otherID)
+					if err != nil {
+						continue
+					}
+					if !opts.allows(b.scopeLabels(item.PrivacyScopeIDs)) {
+						b.omit("out-of-scope knowledge")
+						continue
+					}
+					b.addRelationship(rel, seenRels)
+					b.Knowledge = append(b.Knowledge, *item)
+				default:
+					b.addRelationship(rel, seenRels)
+				}
+			}
+		}
+		frontier = next
+	}
+
+	if opts.HistoryLimit > 0 {
+		history, err := client.GetEntityHistory(root.ID, opts.HistoryLimit, 0)
+		if err == nil {
+			b.History = history
+		}
+	}
+	return b, nil
+}
+
+// allows reports whether a record with scopes passes the include and
+// exclude lists. Include keeps records with at least one listed scope.
+func (o Options) allows(scopes []string) bool {
+	for _, scope := range scopes {
+		for _, excluded := range o.ExcludeScopes {
+			if strings.EqualFold(scope, excluded) {
+				return false
+			}
+		}
+	}
+	if len(o.IncludeScopes) == 0 {
+		return true
+	}
+	for _, scope := range scopes {
+		for _, included := range o.IncludeScopes {
+			if strings.EqualFold(scope, included) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// scopeLabels resolves scope ids to names, keeping unknown ids as-is.
+func (b *Bundle) scopeLabels(ids []string) []string {
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if name := b.scopeNames[id]; name != "" {
+			out = append(out, name)
+		} else {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+// addRelationship records rel once.
+func (b *Bundle) addRelationship(rel api.Relationship, seen map[string]bool) {
+	if seen[rel.ID] {
+		return
+	}
+	seen[rel.ID] = true
+	b.Relationships = append(b.Relationships, rel)
+}
+
+// omit notes a kind of record left out of the bundle, once.
+func (b *Bundle) omit(note string) {
+	for _, existing := range b.Omitted {
+		if existing == note {
+			return
+		}
+	}
+	b.Omitted = append(b.Omitted, note)
+}
+
+// EstimateTokens approximates LLM tokens as one per four characters.
+func EstimateTokens(text string) int {
+	return (len(text) + 3) / 4
+}
+
+// Render formats the bundle, trimming history, then knowledge, then the
+// farthest related entities, then relationships until it fits maxTokens.
+// Zero means no budget.
+func (b *Bundle) Render(format string, maxTokens int) (string, error) {
+	trimmed := b.clone()
+	for {
+		out, err := trimmed.render(format)
+		if err != nil {
+			return "", err
+		}
+		if maxTokens <= 0 || EstimateTokens(out) <= maxTokens || !trimmed.trim() {
+			return out, nil
+		}
+	}
+}
+
+// clone copies the bundle so trimming leaves the original intact.
+func (b *Bundle) clone() *Bundle {
+	out := *b
+	out.Related = append([]Related(nil), b.Related...)
+	out.Relationships = append([]api.Relationship(nil), b.Relationships...)
+	out.Knowledge = append([]api.Context(nil), b.Knowledge...)
+	out.History = append([]api.AuditEntry(nil), b.History...)
+	out.Omitted = append([]string(nil), b.Omitted...)
+	for i, item := range out.Knowledge {
+		if item.Content == nil {
+			continue
+		}
+		if runes := []rune(*item.Content); len(runes) > maxContentChars {
+			content := string(runes[:maxContentChars]) + "…"
+			out.Knowledge[i].Content = &content
+		}
+	}
+	return &out
+}
+
+// trim drops the least important remaining record and reports whether it
+// could.
+func (b *Bundle) trim() bool {
+	switch {
+	case len(b.History) > 0:
+		b.History = b.History[:len(b.History)-1]
+		b.omit("older history")
+	case len(b.Knowledge) > 0:
+		b.Knowledge = b.Knowledge[:len(b.Knowledge)-1]
+		b.omit("some knowledge")
+	case len(b.Related) > 0:
+		sort.SliceStable(b.Related, func(i, j int) bool { return b.Related[i].Hops < b.Related[j].Hops })
+		b.Related = b.Related[:len(b.Related)-1]
+		b.omit("farther related entities")
+	case len(b.Relationships) > 0:
+		b.Relationships = b.Relationships[:len(b.Relationships)-1]
+		b.omit("some relationships")
+	default:
+		return false
+	}
+	return true
+}
+
+// render formats the bundle without budgeting.
+func (b *Bundle) render(format string) (string, error) {
+	switch format {
+	case FormatJSON:
+		data, err := json.MarshalIndent(b, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("marshal bundle: %w", err)
+		}
+		return string(data), nil
+	case FormatMarkdown, "":
+		return b.markdown(), nil
+	default:
+		return "", fmt.Errorf("unknown format %q (want markdown or json)", format)
+	}
+}
+
+// markdown renders the bundle as a prompt-ready document.
+func (b *Bundle) markdown() string {
+	var s strings.Builder
+	e := b.Entity
+	fmt.Fprintf(&s, "# %s\n\n", e.Name)
+	writeField(&s, "ID", e.ID)
+	writeField(&s, "Type", e.Type)
+	writeField(&s, "Status", e.Status)
+	writeField(&s, "Tags", strings.Join(e.Tags, ", "))
+	writeField(&s, "Scopes", strings.Join(b.Scopes, ", "))
+	writeMetadata(&s, e.Metadata)
+
+	if len(b.Related) > 0 {
+		s.WriteString("\n## Related entities\n\n")
+		for _, rel := range b.Related {
+			fmt.Fprintf(&s, "- %s (%s) via %s, %d hop(s)\n", rel.Entity.Name, orDash(rel.Entity.Type), orDash(rel.Via), rel.Hops)
+		}
+	}
+	if len(b.Relationships) > 0 {
+		s.WriteString("\n## Relationships\n\n")
+		for _, rel := range b.Relationships {
+			fmt.Fprintf(&s, "- %s -[%s]-> %s\n", endpointName(rel.SourceName, rel.SourceID), orDash(rel.Type), endpointName(rel.TargetName, rel.TargetID))
+		}
+	}
+	if len(b.Knowledge) > 0 {
+		s.WriteString("\n## Knowledge\n")
+		for _, item := range b.Knowledge {
+			fmt.Fprintf(&s, "\n### %s\n\n", item.Title)
+			if item.URL != nil && *item.URL != "" {
+				writeField(&s, "URL", *item.URL)
+			}
+			if item.Content != nil && strings.TrimSpace(*item.Content) != "" {
+				s.WriteString("\n")
+				s.WriteString(strings.TrimSpace(*item.Content))
+				s.WriteString("\n")
+			}
+		}
+	}
+	if len(b.History) > 0 {
+		s.WriteString("\n## Recent history\n\n")
+		for _, entry := range b.History {
+			line := fmt.Sprintf("- %s %s", entry.ChangedAt.UTC().Format("2006-01-02 15:04"), entry.Action)
+			if len(entry.ChangedFields) > 0 {
+				line += ": " + strings.Join(entry.ChangedFields, ", ")
+			}
+			if entry.ActorName != nil && *entry.ActorName != "" {
+				line += " by " + *entry.ActorName
+			}
+			s.WriteString(line + "\n")
+		}
+	}
+	if len(b.Omitted) > 0 {
+		fmt.Fprintf(&s, "\n_Omitted: %s._\n", strings.Join(b.Omitted, "; "))
+	}
+	return s.String()
+}
+
+// writeField writes one `- **Label:** value` line when value is set.
+func writeField(s *strings.Builder, label, value string) {
+	if strings.TrimSpace(value) == "" {
+		return
+	}
+	fmt.Fprintf(s, "- **%s:** %s\n", label, value)
+}
+
+// writeMetadata writes top-level metadata keys in order.
+func writeMetadata(s *strings.Builder, metadata api.JSONMap) {
+	if len(metadata) == 0 {
+		return
+	}
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	s.WriteString("\n## Metadata\n\n")
+	for _, key := range keys {
+		value := metadata[key]
+		text, ok := value.(string)
+		if !ok {
+			raw, err := json.Marshal(value)
+			if err != nil {
+				continue
+			}
+			text = string(raw)
+		}
+		fmt.Fprintf(s, "- **%s:** %s\n", key, text)
+	}
+}
+
+// endpointName prefers a relationship endpoint's name over its id.
+func endpointName(name, id string) string {
+	if strings.TrimSpace(name) != "" {
+		return name
+	}
+	return id
+}
+
+// orDash returns "-" for blank values.
+func orDash(value string) string {
+	if strings.TrimSpace(value) == "" {
+		return "-"
+	}
+	return value
+}
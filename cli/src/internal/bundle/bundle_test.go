@@ -0,0 +1,129 @@
+package bundle
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+// bundleGraph serves a root entity related to a colleague (who knows a
+// private contact) and to a linked paper.
+func bundleGraph(t *testing.T) *api.Client {
+	t.Helper()
+	entities := map[string]map[string]any{
+		"ent-root":    {"id": "ent-root", "name": "Nebula", "type": "project", "privacy_scope_ids": []string{"sc-pub"}, "tags": []string{"core"}, "metadata": map[string]any{"stage": "beta"}},
+		"ent-sam":     {"id": "ent-sam", "name": "Sam", "type": "person", "privacy_scope_ids": []string{"sc-pub"}},
+		"ent-private": {"id": "ent-private", "name": "Diary", "type": "note", "privacy_scope_ids": []string{"sc-priv"}},
+	}
+	rels := map[string][]map[string]any{
+		"ent-root": {
+			{"id": "rel-1", "source_type": "entity", "source_id": "ent-root", "source_name": "Nebula", "target_type": "entity", "target_id": "ent-sam", "target_name": "Sam", "relationship_type": "owned-by"},
+			{"id": "rel-2", "source_type": "context", "source_id": "ctx-1", "source_name": "Design doc", "target_type": "entity", "target_id": "ent-root", "relationship_type": "about"},
+		},
+		"ent-sam": {
+			{"id": "rel-1", "source_type": "entity", "source_id": "ent-root", "target_type": "entity", "target_id": "ent-sam", "relationship_type": "owned-by"},
+			{"id": "rel-3", "source_type": "entity", "source_id": "ent-sam", "target_type": "entity", "target_id": "ent-private", "relationship_type": "writes"},
+		},
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path
+		var data any
+		switch {
+		case path == "/api/audit/scopes":
+			data = []map[string]any{{"id": "sc-pub", "name": "public"}, {"id": "sc-priv", "name": "personal"}}
+		case strings.HasPrefix(path, "/api/relationships/entity/"):
+			data = rels[strings.TrimPrefix(path, "/api/relationships/entity/")]
+			if data == nil {
+				data = []any{}
+			}
+		case strings.HasSuffix(path, "/history"):
+			data = []map[string]any{
+				{"id": "a1", "action": "update", "changed_fields": []string{"status"}, "changed_at": "2026-10-01T10:00:00Z"},
+				{"id": "a2", "action": "insert", "changed_at": "2026-09-01T10:00:00Z"},
+			}
+		case path == "/api/context/ctx-1":
+			data = map[string]any{"id": "ctx-1", "title": "Design doc", "content": "Graph-first agent memory.", "privacy_scope_ids": []string{"sc-pub"}}
+		case strings.HasPrefix(path, "/api/entities/"):
+			entity, ok := entities[strings.TrimPrefix(path, "/api/entities/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			data = entity
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": data}))
+	}))
+	t.Cleanup(srv.Close)
+	return api.NewClient(srv.URL, "test-key")
+}
+
+// TestBuildWalksHopsAndFiltersScopes handles test build walks hops and filters scopes.
+func TestBuildWalksHopsAndFiltersScopes(t *testing.T) {
+	client := bundleGraph(t)
+
+	b, err := Build(client, "ent-root", Options{Depth: 2, HistoryLimit: 5})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"public"}, b.Scopes)
+	require.Len(t, b.Related, 2)
+	assert.Equal(t, "Sam", b.Related[0].Entity.Name)
+	assert.Equal(t, 1, b.Related[0].Hops)
+	assert.Equal(t, 2, b.Related[1].Hops)
+	require.Len(t, b.Knowledge, 1)
+	assert.Len(t, b.Relationships, 3)
+	assert.Len(t, b.History, 2)
+
+	b, err = Build(client, "ent-root", Options{Depth: 2, ExcludeScopes: []string{"personal"}})
+	require.NoError(t, err)
+	require.Len(t, b.Related, 1)
+	assert.Contains(t, b.Omitted, "out-of-scope entities")
+	assert.Empty(t, b.History)
+
+	_, err = Build(client, "ent-root", Options{IncludeScopes: []string{"personal"}})
+	assert.Error(t, err)
+}
+
+// TestRenderMarkdownAndBudget handles test render markdown and budget.
+func TestRenderMarkdownAndBudget(t *testing.T) {
+	client := bundleGraph(t)
+	b, err := Build(client, "ent-root", Options{Depth: 1, HistoryLimit: 5})
+	require.NoError(t, err)
+
+	out, err := b.Render(FormatMarkdown, 0)
+	require.NoError(t, err)
+	assert.Contains(t, out, "# Nebula")
+	assert.Contains(t, out, "- **stage:** beta")
+	assert.Contains(t, out, "- Sam (person) via owned-by, 1 hop(s)")
+	assert.Contains(t, out, "### Design doc")
+	assert.Contains(t, out, "2026-10-01 10:00 update: status")
+
+	full := EstimateTokens(out)
+	small, err := b.Render(FormatMarkdown, full-20)
+	require.NoError(t, err)
+	assert.LessOrEqual(t, EstimateTokens(small), full-20)
+	assert.Contains(t, small, "Omitted: older history")
+	assert.Len(t, b.History, 2, "budgeting leaves the bundle intact")
+
+	tiny, err := b.Render(FormatMarkdown, 1)
+	require.NoError(t, err)
+	assert.Contains(t, tiny, "# Nebula")
+	assert.NotContains(t, tiny, "Design doc")
+
+	raw, err := b.Render(FormatJSON, 0)
+	require.NoError(t, err)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal([]byte(raw), &decoded))
+	assert.Contains(t, decoded, "knowledge")
+
+	_, err = b.Render("yaml", 0)
+	assert.Error(t, err)
+}
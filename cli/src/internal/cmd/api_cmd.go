@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
 )
 
 // APICmd exposes a non-interactive command suite for full API/MCP-style operations.
@@ -35,6 +36,8 @@ Use --input or --input-file for create/update actions, and --param key=value for
 	cmd.AddCommand(apiKeysCmd())
 	cmd.AddCommand(apiAuditCmd())
 	cmd.AddCommand(apiTaxonomyCmd())
+	cmd.AddCommand(apiTagsCmd())
+	cmd.AddCommand(apiCollectionsCmd())
 	cmd.AddCommand(apiSearchCmd())
 	cmd.AddCommand(apiImportsCmd())
 	cmd.AddCommand(apiExportsCmd())
@@ -123,18 +126,29 @@ func apiEntitiesCmd() *cobra.Command {
 
 	var createInput string
 	var createInputFile string
+	var createTemplate string
 	create := &cobra.Command{
 		Use:   "create",
 		Short: "Create entity from JSON payload",
 		RunE: func(command *cobra.Command, _ []string) error {
-			raw, err := readInputJSON(createInput, createInputFile, true)
+			raw, err := readInputJSON(createInput, createInputFile, createTemplate == "")
 			if err != nil {
 				return err
 			}
 			var payload api.CreateEntityInput
-			if err := decodeJSONInput(raw, &payload); err != nil {
-				return err
+			if raw != nil {
+				if err := decodeJSONInput(raw, &payload); err != nil {
+					return err
+				}
 			}
+			if createTemplate != "" {
+				tmpl, err := loadCommandTemplate(createTemplate, config.TemplateKindEntity)
+				if err != nil {
+					return err
+				}
+				applyEntityTemplate(&payload, tmpl)
+			}
+			applyEntityDefaults(&payload, loadFormDefaults())
 			client, err := loadCommandClient(true)
 			if err != nil {
 				return err
@@ -147,6 +161,7 @@ func apiEntitiesCmd() *cobra.Command {
 		},
 	}
 	bindInputFlags(create, &createInput, &createInputFile)
+	create.Flags().StringVar(&createTemplate, "template", "", "config template that pre-fills type, tags, scopes, and metadata")
 
 	var updateInput string
 	var updateInputFile string
@@ -226,6 +241,7 @@ func apiEntitiesCmd() *cobra.Command {
 	history.Flags().IntVar(&historyOffset, "offset", 0, "history offset")
 
 	var revertAuditID string
+	var revertFields []string
 	revert := &cobra.Command{
 		Use:   "revert <id>",
 		Short: "Revert entity to a specific audit entry",
@@ -239,7 +255,7 @@ func apiEntitiesCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			item, err := client.RevertEntity(args[0], revertAuditID)
+			item, err := client.RevertEntity(args[0], revertAuditID, revertFields...)
 			if err != nil {
 				return fmt.Errorf("revert entity: %w", err)
 			}
@@ -247,6 +263,7 @@ func apiEntitiesCmd() *cobra.Command {
 		},
 	}
 	revert.Flags().StringVar(&revertAuditID, "audit-id", "", "audit entry id to restore")
+	revert.Flags().StringSliceVar(&revertFields, "fields", nil, "only restore these fields (default: all changed fields)")
 
 	var bulkTagsInput string
 	var bulkTagsInputFile string
@@ -353,18 +370,29 @@ func apiContextCmd() *cobra.Command {
 
 	var createInput string
 	var createInputFile string
+	var createTemplate string
 	create := &cobra.Command{
 		Use:   "create",
 		Short: "Create context item from JSON payload",
 		RunE: func(command *cobra.Command, _ []string) error {
-			raw, err := readInputJSON(createInput, createInputFile, true)
+			raw, err := readInputJSON(createInput, createInputFile, createTemplate == "")
 			if err != nil {
 				return err
 			}
 			var payload api.CreateContextInput
-			if err := decodeJSONInput(raw, &payload); err != nil {
-				return err
+			if raw != nil {
+				if err := decodeJSONInput(raw, &payload); err != nil {
+					return err
+				}
 			}
+			if createTemplate != "" {
+				tmpl, err := loadCommandTemplate(createTemplate, config.TemplateKindKnowledge)
+				if err != nil {
+					return err
+				}
+				applyContextTemplate(&payload, tmpl)
+			}
+			applyContextDefaults(&payload, loadFormDefaults())
 			client, err := loadCommandClient(true)
 			if err != nil {
 				return err
@@ -377,6 +405,7 @@ func apiContextCmd() *cobra.Command {
 		},
 	}
 	bindInputFlags(create, &createInput, &createInputFile)
+	create.Flags().StringVar(&createTemplate, "template", "", "config template that pre-fills source type, tags, scopes, and metadata")
 
 	var updateInput string
 	var updateInputFile string
@@ -1107,6 +1136,7 @@ func apiApprovalsCmd() *cobra.Command {
 				if err != nil {
 					return fmt.Errorf("approve request: %w", err)
 				}
+				notifyDecisionWebhooks(config.WebhookApprove, args[0], "", item)
 				return writeCleanJSON(command.OutOrStdout(), item)
 			}
 			var payload api.ApproveRequestInput
@@ -1117,6 +1147,7 @@ func apiApprovalsCmd() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("approve request: %w", err)
 			}
+			notifyDecisionWebhooks(config.WebhookApprove, args[0], "", item)
 			return writeCleanJSON(command.OutOrStdout(), item)
 		},
 	}
@@ -1140,12 +1171,58 @@ func apiApprovalsCmd() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("reject request: %w", err)
 			}
+			notifyDecisionWebhooks(config.WebhookReject, args[0], rejectNotes, item)
 			return writeCleanJSON(command.OutOrStdout(), item)
 		},
 	}
 	reject.Flags().StringVar(&rejectNotes, "notes", "", "review notes for rejection")
 
-	cmd.AddCommand(pending, get, diff, approve, reject)
+	comments := &cobra.Command{
+		Use:   "comments <id>",
+		Short: "List the comment thread of an approval",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			client, err := loadCommandClient(true)
+			if err != nil {
+				return err
+			}
+			items, err := client.ListApprovalComments(args[0])
+			if err != nil {
+				return fmt.Errorf("list approval comments: %w", err)
+			}
+			return writeCleanJSON(command.OutOrStdout(), items)
+		},
+	}
+
+	var commentBody string
+	var commentReplyTo string
+	comment := &cobra.Command{
+		Use:   "comment <id>",
+		Short: "Comment on an approval without reviewing it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			commentBody = strings.TrimSpace(commentBody)
+			if commentBody == "" {
+				return fmt.Errorf("missing --body")
+			}
+			client, err := loadCommandClient(true)
+			if err != nil {
+				return err
+			}
+			item, err := client.AddApprovalComment(args[0], api.CreateApprovalCommentInput{
+				Body:     commentBody,
+				ParentID: strings.TrimSpace(commentReplyTo),
+			})
+			if err != nil {
+				return fmt.Errorf("add approval comment: %w", err)
+			}
+			return writeCleanJSON(command.OutOrStdout(), item)
+		},
+	}
+	comment.Flags().StringVar(&commentBody, "body", "", "comment text")
+	comment.Flags().StringVar(&commentReplyTo, "reply-to", "", "comment id to reply to")
+
+	cmd.AddCommand(pending, get, diff, approve, reject, comments, comment)
 	return cmd
 }
 
@@ -1403,7 +1480,7 @@ func apiAuditCmd() *cobra.Command {
 	}
 	actors.Flags().StringVar(&actorType, "actor-type", "", "filter by actor type")
 
-	cmd.AddCommand(query, scopes, actors)
+	cmd.AddCommand(query, scopes, actors, apiAuditExportCmd(), apiAuditVerifyCmd())
 	return cmd
 }
 
@@ -1528,7 +1605,284 @@ func apiTaxonomyCmd() *cobra.Command {
 		},
 	}
 
-	cmd.AddCommand(list, create, update, archive, activate)
+	mergeScope := &cobra.Command{
+		Use:   "merge-scope <source-id> <target-id>",
+		Short: "Merge a privacy scope into another and archive the source",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(command *cobra.Command, args []string) error {
+			if args[0] == args[1] {
+				return fmt.Errorf("merge scope: source and target must differ")
+			}
+			client, err := loadCommandClient(true)
+			if err != nil {
+				return err
+			}
+			result, err := client.MergeScope(args[0], args[1])
+			if err != nil {
+				return fmt.Errorf("merge scope: %w", err)
+			}
+			return writeCleanJSON(command.OutOrStdout(), result)
+		},
+	}
+
+	cmd.AddCommand(list, create, update, archive, activate, mergeScope)
+	return cmd
+}
+
+func apiTagsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tags",
+		Short: "Tag operations across entities, knowledge, and files",
+	}
+
+	var search string
+	var limit int
+	var offset int
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List tags with usage counts",
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			client, err := loadCommandClient(true)
+			if err != nil {
+				return err
+			}
+			items, err := client.ListTags(search, limit, offset)
+			if err != nil {
+				return fmt.Errorf("list tags: %w", err)
+			}
+			return writeCleanJSON(command.OutOrStdout(), items)
+		},
+	}
+	list.Flags().StringVar(&search, "search", "", "tag search text")
+	list.Flags().IntVar(&limit, "limit", 500, "max rows")
+	list.Flags().IntVar(&offset, "offset", 0, "row offset")
+
+	rename := &cobra.Command{
+		Use:   "rename <from> <to>",
+		Short: "Rename a tag everywhere",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(command *cobra.Command, args []string) error {
+			if args[0] == args[1] {
+				return fmt.Errorf("rename tag: names must differ")
+			}
+			client, err := loadCommandClient(true)
+			if err != nil {
+				return err
+			}
+			result, err := client.RenameTag(args[0], args[1])
+			if err != nil {
+				return fmt.Errorf("rename tag: %w", err)
+			}
+			return writeCleanJSON(command.OutOrStdout(), result)
+		},
+	}
+
+	merge := &cobra.Command{
+		Use:   "merge <source> <target>",
+		Short: "Merge a tag into another and drop the source",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(command *cobra.Command, args []string) error {
+			if args[0] == args[1] {
+				return fmt.Errorf("merge tags: source and target must differ")
+			}
+			client, err := loadCommandClient(true)
+			if err != nil {
+				return err
+			}
+			result, err := client.MergeTags(args[0], args[1])
+			if err != nil {
+				return fmt.Errorf("merge tags: %w", err)
+			}
+			return writeCleanJSON(command.OutOrStdout(), result)
+		},
+	}
+
+	deleteUnused := &cobra.Command{
+		Use:   "delete-unused [tag...]",
+		Short: "Delete unused tags (all unused when none are given)",
+		RunE: func(command *cobra.Command, args []string) error {
+			client, err := loadCommandClient(true)
+			if err != nil {
+				return err
+			}
+			result, err := client.DeleteUnusedTags(args...)
+			if err != nil {
+				return fmt.Errorf("delete unused tags: %w", err)
+			}
+			return writeCleanJSON(command.OutOrStdout(), result)
+		},
+	}
+
+	cmd.AddCommand(list, rename, merge, deleteUnused)
+	return cmd
+}
+
+func apiCollectionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collections",
+		Short: "Named entity collections (pinboards)",
+	}
+
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List your collections with entity counts",
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, _ []string) error {
+			client, err := loadCommandClient(true)
+			if err != nil {
+				return err
+			}
+			items, err := client.ListCollections()
+			if err != nil {
+				return fmt.Errorf("list collections: %w", err)
+			}
+			return writeCleanJSON(command.OutOrStdout(), items)
+		},
+	}
+
+	get := &cobra.Command{
+		Use:   "get <id>",
+		Short: "Get a collection and its entities",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			client, err := loadCommandClient(true)
+			if err != nil {
+				return err
+			}
+			item, err := client.GetCollection(args[0])
+			if err != nil {
+				return fmt.Errorf("get collection: %w", err)
+			}
+			return writeCleanJSON(command.OutOrStdout(), item)
+		},
+	}
+
+	var description string
+	create := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a collection",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			input := api.CreateCollectionInput{Name: strings.TrimSpace(args[0])}
+			if input.Name == "" {
+				return fmt.Errorf("create collection: name is required")
+			}
+			if desc := strings.TrimSpace(description); desc != "" {
+				input.Description = &desc
+			}
+			client, err := loadCommandClient(true)
+			if err != nil {
+				return err
+			}
+			item, err := client.CreateCollection(input)
+			if err != nil {
+				return fmt.Errorf("create collection: %w", err)
+			}
+			return writeCleanJSON(command.OutOrStdout(), item)
+		},
+	}
+	create.Flags().StringVar(&description, "description", "", "collection description")
+
+	rename := &cobra.Command{
+		Use:   "rename <id> <name>",
+		Short: "Rename a collection",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(command *cobra.Command, args []string) error {
+			name := strings.TrimSpace(args[1])
+			if name == "" {
+				return fmt.Errorf("rename collection: name is required")
+			}
+			client, err := loadCommandClient(true)
+			if err != nil {
+				return err
+			}
+			item, err := client.UpdateCollection(args[0], api.UpdateCollectionInput{Name: &name})
+			if err != nil {
+				return fmt.Errorf("rename collection: %w", err)
+			}
+			return writeCleanJSON(command.OutOrStdout(), item)
+		},
+	}
+
+	del := &cobra.Command{
+		Use:   "delete <id>",
+		Short: "Delete a collection (its entities are kept)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			client, err := loadCommandClient(true)
+			if err != nil {
+				return err
+			}
+			if err := client.DeleteCollection(args[0]); err != nil {
+				return fmt.Errorf("delete collection: %w", err)
+			}
+			return writeCleanJSON(command.OutOrStdout(), map[string]any{"id": args[0], "deleted": true})
+		},
+	}
+
+	add := &cobra.Command{
+		Use:   "add <id> <entity-id>...",
+		Short: "Add entities to a collection",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(command *cobra.Command, args []string) error {
+			client, err := loadCommandClient(true)
+			if err != nil {
+				return err
+			}
+			result, err := client.AddCollectionEntities(args[0], args[1:])
+			if err != nil {
+				return fmt.Errorf("add to collection: %w", err)
+			}
+			return writeCleanJSON(command.OutOrStdout(), result)
+		},
+	}
+
+	remove := &cobra.Command{
+		Use:   "remove <id> <entity-id>",
+		Short: "Remove an entity from a collection",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(command *cobra.Command, args []string) error {
+			client, err := loadCommandClient(true)
+			if err != nil {
+				return err
+			}
+			if err := client.RemoveCollectionEntity(args[0], args[1]); err != nil {
+				return fmt.Errorf("remove from collection: %w", err)
+			}
+			return writeCleanJSON(command.OutOrStdout(), map[string]any{"id": args[0], "entity_id": args[1], "removed": true})
+		},
+	}
+
+	var outFile string
+	var encrypt bool
+	export := &cobra.Command{
+		Use:   "export <id>",
+		Short: "Export a collection's entities",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			path := strings.TrimSpace(outFile)
+			if encrypt && path == "" {
+				return fmt.Errorf("--encrypt requires --file")
+			}
+			client, err := loadCommandClient(true)
+			if err != nil {
+				return err
+			}
+			result, err := client.ExportEntities(api.QueryParams{"collection_id": args[0]})
+			if err != nil {
+				return fmt.Errorf("export collection: %w", err)
+			}
+			if path != "" {
+				return writeExportFile(command, path, result, encrypt)
+			}
+			return writeCleanJSON(command.OutOrStdout(), result)
+		},
+	}
+	export.Flags().StringVar(&outFile, "file", "", "write the export to this file instead of stdout")
+	export.Flags().BoolVar(&encrypt, "encrypt", false, "encrypt the --file with a passphrase (or $"+archivePassphraseEnv+")")
+
+	cmd.AddCommand(list, get, create, rename, del, add, remove, export)
 	return cmd
 }
 
@@ -1577,11 +1931,12 @@ func apiImportsCmd() *cobra.Command {
 	makeImportCmd := func(use string, short string, fn func(*api.Client, api.BulkImportRequest) (*api.BulkImportResult, error)) *cobra.Command {
 		var input string
 		var inputFile string
+		var dryRun bool
 		sub := &cobra.Command{
 			Use:   use,
 			Short: short,
 			RunE: func(command *cobra.Command, _ []string) error {
-				raw, err := readInputJSON(input, inputFile, true)
+				raw, err := readImportInput(command, input, inputFile)
 				if err != nil {
 					return err
 				}
@@ -1593,6 +1948,13 @@ func apiImportsCmd() *cobra.Command {
 				if err != nil {
 					return err
 				}
+				if dryRun {
+					report, err := client.ValidateImport(use, payload)
+					if err != nil {
+						return fmt.Errorf("validate %s import: %w", use, err)
+					}
+					return writeCleanJSON(command.OutOrStdout(), report)
+				}
 				result, err := fn(client, payload)
 				if err != nil {
 					return err
@@ -1601,6 +1963,7 @@ func apiImportsCmd() *cobra.Command {
 			},
 		}
 		bindInputFlags(sub, &input, &inputFile)
+		sub.Flags().BoolVar(&dryRun, "dry-run", false, "report what each row would do without importing")
 		return sub
 	}
 
@@ -1644,6 +2007,8 @@ func apiExportsCmd() *cobra.Command {
 
 	makeExportCmd := func(use string, short string, fn func(*api.Client, api.QueryParams) (*api.ExportResult, error)) *cobra.Command {
 		var rawParams []string
+		var outFile string
+		var encrypt bool
 		sub := &cobra.Command{
 			Use:   use,
 			Short: short,
@@ -1652,6 +2017,10 @@ func apiExportsCmd() *cobra.Command {
 				if err != nil {
 					return err
 				}
+				path := strings.TrimSpace(outFile)
+				if encrypt && path == "" {
+					return fmt.Errorf("--encrypt requires --file")
+				}
 				client, err := loadCommandClient(true)
 				if err != nil {
 					return err
@@ -1660,10 +2029,15 @@ func apiExportsCmd() *cobra.Command {
 				if err != nil {
 					return err
 				}
+				if path != "" {
+					return writeExportFile(command, path, result, encrypt)
+				}
 				return writeCleanJSON(command.OutOrStdout(), result)
 			},
 		}
 		bindParamFlags(sub, &rawParams)
+		sub.Flags().StringVar(&outFile, "file", "", "write the export to this file instead of stdout")
+		sub.Flags().BoolVar(&encrypt, "encrypt", false, "encrypt the --file with a passphrase (or $"+archivePassphraseEnv+")")
 		return sub
 	}
 
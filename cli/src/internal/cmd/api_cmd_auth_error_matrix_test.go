@@ -79,11 +79,17 @@ func TestAPICmdAuthRequiredMatrixErrorsWithoutConfig(t *testing.T) {
 		{"audit", "query"},
 		{"audit", "scopes"},
 		{"audit", "actors"},
+		{"audit", "export"},
 		{"taxonomy", "list", "scopes"},
 		{"taxonomy", "create", "scopes", "--input", `{"name":"demo-scope","description":"demo"}`},
 		{"taxonomy", "update", "scopes", "t1", "--input", `{"description":"updated"}`},
 		{"taxonomy", "archive", "scopes", "t1"},
 		{"taxonomy", "activate", "scopes", "t1"},
+		{"taxonomy", "merge-scope", "t1", "t2"},
+		{"tags", "list"},
+		{"tags", "rename", "infra", "infrastructure"},
+		{"tags", "merge", "ops", "infra"},
+		{"tags", "delete-unused"},
 		{"search", "semantic", "--query", "nebula"},
 		{"import", "entities", "--input", `{"format":"json","items":[]}`},
 		{"import", "context", "--input", `{"format":"json","items":[]}`},
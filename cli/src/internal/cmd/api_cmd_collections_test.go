@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPICollectionsCmdAgainstMockServer(t *testing.T) {
+	setupAPICommandAuth(t)
+
+	var exportQuery string
+	var added map[string][]string
+	shutdown := startDefaultAPIBaseServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data any
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/collections":
+			data = []map[string]any{{"id": "col-1", "name": "Q3 launch", "entity_count": 2}}
+		case "GET /api/collections/col-1":
+			data = map[string]any{"id": "col-1", "name": "Q3 launch", "entities": []map[string]any{}}
+		case "POST /api/collections":
+			data = map[string]any{"id": "col-2", "name": "Docs"}
+		case "PATCH /api/collections/col-1":
+			data = map[string]any{"id": "col-1", "name": "Q4 launch"}
+		case "POST /api/collections/col-1/entities":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&added))
+			data = map[string]any{"added": 2, "requested": 2}
+		case "DELETE /api/collections/col-1", "DELETE /api/collections/col-1/entities/ent-1":
+			data = map[string]any{}
+		case "GET /api/export/entities":
+			exportQuery = r.URL.RawQuery
+			data = map[string]any{"format": "json", "items": []map[string]any{}, "count": 0}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": data}))
+	}))
+	defer shutdown()
+
+	assert.Contains(t, runAPISubcommand(t, "collections", "list"), "Q3 launch")
+	runAPISubcommand(t, "collections", "get", "col-1")
+	assert.Contains(t, runAPISubcommand(t, "collections", "create", "Docs", "--description", "onboarding"), "col-2")
+	assert.Contains(t, runAPISubcommand(t, "collections", "rename", "col-1", "Q4 launch"), "Q4 launch")
+	runAPISubcommand(t, "collections", "add", "col-1", "ent-1", "ent-2")
+	assert.Equal(t, []string{"ent-1", "ent-2"}, added["entity_ids"])
+	runAPISubcommand(t, "collections", "remove", "col-1", "ent-1")
+	runAPISubcommand(t, "collections", "export", "col-1")
+	assert.Equal(t, "collection_id=col-1", exportQuery)
+	runAPISubcommand(t, "collections", "delete", "col-1")
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	"github.com/gravitrone/nebula-core/cli/internal/api"
 	"github.com/gravitrone/nebula-core/cli/internal/config"
 	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/gravitrone/nebula-core/cli/internal/webhooks"
 )
 
 // loadCommandClient builds an API client for non-interactive command flows.
@@ -23,7 +25,130 @@ func loadCommandClient(requireAuth bool) (*api.Client, error) {
 		}
 		return newDefaultClient(""), nil
 	}
-	return newDefaultClient(cfg.APIKey), nil
+	client := newDefaultClient(cfg.APIKey)
+	AttachTokenRefresh(client, cfg)
+	return client, nil
+}
+
+// notifyDecisionWebhooks delivers an approval decision to the configured
+// webhooks before the command exits, warning on stderr about failed ones.
+func notifyDecisionWebhooks(action, id, notes string, item *api.Approval) {
+	hooks := webhooks.Configured(action)
+	if len(hooks) == 0 {
+		return
+	}
+	for _, delivery := range webhooks.Deliver(hooks, webhooks.NewEvent(action, id, notes, item)) {
+		if !delivery.OK() {
+			fmt.Fprintf(os.Stderr, "webhook %s failed after %d attempt(s): %s\n", delivery.Webhook, delivery.Attempts, delivery.Error)
+		}
+	}
+}
+
+// loadCommandTemplate reads a named create template from the CLI config.
+func loadCommandTemplate(name, kind string) (config.Template, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return config.Template{}, fmt.Errorf("load template: %w", err)
+	}
+	tmpl, err := cfg.FindTemplate(name, kind)
+	if err != nil {
+		return config.Template{}, fmt.Errorf("load template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// applyEntityTemplate fills entity payload gaps from a template. Explicit
+// payload values win; tags and scopes are unioned.
+func applyEntityTemplate(payload *api.CreateEntityInput, tmpl config.Template) {
+	if strings.TrimSpace(payload.Type) == "" {
+		payload.Type = tmpl.Type
+	}
+	payload.Tags = mergeTemplateValues(tmpl.Tags, payload.Tags)
+	payload.Scopes = mergeTemplateValues(tmpl.Scopes, payload.Scopes)
+	payload.Metadata = mergeTemplateMetadata(tmpl.Metadata, payload.Metadata)
+}
+
+// applyContextTemplate fills context payload gaps from a template.
+func applyContextTemplate(payload *api.CreateContextInput, tmpl config.Template) {
+	if strings.TrimSpace(payload.SourceType) == "" {
+		payload.SourceType = tmpl.Type
+	}
+	payload.Tags = mergeTemplateValues(tmpl.Tags, payload.Tags)
+	payload.Scopes = mergeTemplateValues(tmpl.Scopes, payload.Scopes)
+	payload.Metadata = mergeTemplateMetadata(tmpl.Metadata, payload.Metadata)
+}
+
+// loadFormDefaults returns the configured create defaults, or none when the
+// config cannot be read.
+func loadFormDefaults() config.FormDefaults {
+	cfg, err := config.Load()
+	if err != nil {
+		return config.FormDefaults{}
+	}
+	return cfg.FormDefaults()
+}
+
+// applyEntityDefaults fills the entity type, status, and scopes the payload
+// and template left empty from the configured defaults.
+func applyEntityDefaults(payload *api.CreateEntityInput, defaults config.FormDefaults) {
+	if strings.TrimSpace(payload.Type) == "" {
+		payload.Type = defaults.EntityType
+	}
+	if strings.TrimSpace(payload.Status) == "" {
+		payload.Status = defaults.Status
+	}
+	if len(payload.Scopes) == 0 {
+		payload.Scopes = slices.Clone(defaults.Scopes)
+	}
+}
+
+// applyContextDefaults fills the source type and scopes the payload and
+// template left empty from the configured defaults.
+func applyContextDefaults(payload *api.CreateContextInput, defaults config.FormDefaults) {
+	if strings.TrimSpace(payload.SourceType) == "" {
+		payload.SourceType = defaults.KnowledgeType
+	}
+	if len(payload.Scopes) == 0 {
+		payload.Scopes = slices.Clone(defaults.Scopes)
+	}
+}
+
+// mergeTemplateValues appends payload values to template values without duplicates.
+func mergeTemplateValues(base, extra []string) []string {
+	seen := map[string]bool{}
+	var out []string
+	for _, list := range [][]string{base, extra} {
+		for _, item := range list {
+			item = strings.TrimSpace(item)
+			if item == "" || seen[item] {
+				continue
+			}
+			seen[item] = true
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// mergeTemplateMetadata overlays payload metadata on the template skeleton.
+func mergeTemplateMetadata(base, overlay map[string]any) map[string]any {
+	if len(base) == 0 {
+		return overlay
+	}
+	out := make(map[string]any, len(base)+len(overlay))
+	for key, value := range base {
+		out[key] = value
+	}
+	for key, value := range overlay {
+		baseMap, baseIsMap := out[key].(map[string]any)
+		overlayMap, overlayIsMap := value.(map[string]any)
+		if baseIsMap && overlayIsMap {
+			out[key] = mergeTemplateMetadata(baseMap, overlayMap)
+			continue
+		}
+		out[key] = value
+	}
+	return out
 }
 
 // writeCleanJSON renders predictable command output without banners.
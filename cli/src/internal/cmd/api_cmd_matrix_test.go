@@ -274,6 +274,12 @@ func TestAPICmdWriteMatrixAgainstMockServer(t *testing.T) {
 			_, _ = w.Write([]byte(`{"ok":true}`))
 		case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/api/taxonomy/") && strings.HasSuffix(r.URL.Path, "/archive"):
 			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "t1", "name": "entry", "is_active": false, "is_builtin": false, "metadata": map[string]any{}, "created_at": now, "updated_at": now}}))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/tags":
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{{"name": "infra", "entities": 1, "knowledge": 0, "files": 0}}}))
+		case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/api/tags/"):
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"entities_updated": 1, "knowledge_updated": 0, "files_updated": 0}}))
+		case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/api/taxonomy/scopes/") && strings.HasSuffix(r.URL.Path, "/merge"):
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"source_id": "t1", "target_id": "t2", "entities_updated": 1, "knowledge_updated": 0}}))
 		case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/api/taxonomy/") && strings.HasSuffix(r.URL.Path, "/activate"):
 			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "t1", "name": "entry", "is_active": true, "is_builtin": false, "metadata": map[string]any{}, "created_at": now, "updated_at": now}}))
 		case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/api/taxonomy/"):
@@ -339,11 +345,17 @@ func TestAPICmdWriteMatrixAgainstMockServer(t *testing.T) {
 	runAPISubcommand(t, "taxonomy", "update", "scopes", "t1", "--input", `{"description":"updated"}`)
 	runAPISubcommand(t, "taxonomy", "archive", "scopes", "t1")
 	runAPISubcommand(t, "taxonomy", "activate", "scopes", "t1")
+	runAPISubcommand(t, "taxonomy", "merge-scope", "t1", "t2")
+	runAPISubcommand(t, "tags", "list", "--search", "inf")
+	runAPISubcommand(t, "tags", "rename", "infra", "infrastructure")
+	runAPISubcommand(t, "tags", "merge", "ops", "infrastructure")
+	runAPISubcommand(t, "tags", "delete-unused", "stale")
 
 	runAPISubcommand(t, "import", "entities", "--input", `{"format":"json","items":[]}`)
 	runAPISubcommand(t, "import", "context", "--input", `{"format":"json","items":[]}`)
 	runAPISubcommand(t, "import", "relationships", "--input", `{"format":"json","items":[]}`)
 	runAPISubcommand(t, "import", "jobs", "--input", `{"format":"json","items":[]}`)
+	runAPISubcommand(t, "import", "context", "--input", `{"format":"markdown","data":"# Notes"}`, "--dry-run")
 
 	runAPISubcommand(t, "export", "entities", "--param", "format=json")
 	runAPISubcommand(t, "export", "context", "--param", "format=json")
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	"time"
 
 	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/webhooks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -121,6 +123,108 @@ func TestAPICmdEntitiesCreateFromInputFile(t *testing.T) {
 	assert.Contains(t, out.String(), "\"city\": \"warsaw\"")
 }
 
+func TestAPICmdEntitiesCreateAppliesTemplate(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	require.NoError(t, (&config.Config{
+		APIKey: "nbl_test",
+		Templates: map[string]config.Template{
+			"meeting": {
+				Type:     "meeting",
+				Tags:     []string{"sync"},
+				Scopes:   []string{"work"},
+				Metadata: map[string]any{"agenda": "", "owner": "alxx"},
+			},
+			"paper": {Kind: config.TemplateKindKnowledge, Type: "paper"},
+		},
+	}).Save())
+
+	var body map[string]any
+	now := time.Now().UTC()
+	shutdown := startDefaultAPIBaseServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/entities", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{"id": "ent-3", "name": "Weekly", "created_at": now, "updated_at": now},
+		}))
+	}))
+	t.Cleanup(shutdown)
+
+	var out bytes.Buffer
+	cmd := APICmd()
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{
+		"entities", "create", "--template", "meeting",
+		"--input", `{"name":"Weekly","tags":["team"],"metadata":{"owner":"sam"}}`,
+	})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "Weekly", body["name"])
+	assert.Equal(t, "meeting", body["type"])
+	assert.Equal(t, []any{"sync", "team"}, body["tags"])
+	assert.Equal(t, []any{"work"}, body["scopes"])
+	assert.Equal(t, map[string]any{"agenda": "", "owner": "sam"}, body["metadata"])
+
+	// Knowledge templates cannot seed entities, and unknown names fail early.
+	for _, name := range []string{"paper", "missing"} {
+		cmd = APICmd()
+		cmd.SetOut(&out)
+		cmd.SetErr(&out)
+		cmd.SetArgs([]string{"entities", "create", "--template", name})
+		err := cmd.Execute()
+		require.Error(t, err, name)
+		assert.Contains(t, err.Error(), "load template", name)
+	}
+}
+
+func TestAPICmdCreateFillsConfiguredDefaults(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	require.NoError(t, (&config.Config{
+		APIKey: "nbl_test",
+		Defaults: config.FormDefaults{
+			Scopes:        []string{"work"},
+			EntityType:    "project",
+			KnowledgeType: "paper",
+			Status:        "inactive",
+		},
+	}).Save())
+
+	var body map[string]any
+	now := time.Now().UTC()
+	shutdown := startDefaultAPIBaseServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body = nil
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{"id": "rec-1", "created_at": now, "updated_at": now},
+		}))
+	}))
+	t.Cleanup(shutdown)
+
+	run := func(args ...string) {
+		t.Helper()
+		var out bytes.Buffer
+		cmd := APICmd()
+		cmd.SetOut(&out)
+		cmd.SetErr(&out)
+		cmd.SetArgs(args)
+		require.NoError(t, cmd.Execute())
+	}
+
+	run("entities", "create", "--input", `{"name":"Nebula"}`)
+	assert.Equal(t, "project", body["type"])
+	assert.Equal(t, "inactive", body["status"])
+	assert.Equal(t, []any{"work"}, body["scopes"])
+
+	// Values in the payload win over the defaults.
+	run("entities", "create", "--input", `{"name":"Alex","type":"person","scopes":["public"]}`)
+	assert.Equal(t, "person", body["type"])
+	assert.Equal(t, "inactive", body["status"])
+	assert.Equal(t, []any{"public"}, body["scopes"])
+
+	run("context", "create", "--input", `{"title":"Attention"}`)
+	assert.Equal(t, "paper", body["source_type"])
+	assert.Equal(t, []any{"work"}, body["scopes"])
+}
+
 func TestAPICmdApprovalsRejectRequiresNotes(t *testing.T) {
 	t.Setenv("HOME", t.TempDir())
 	var out bytes.Buffer
@@ -134,6 +238,61 @@ func TestAPICmdApprovalsRejectRequiresNotes(t *testing.T) {
 	assert.Contains(t, err.Error(), "missing --notes")
 }
 
+func TestAPICmdApprovalsRejectFiresWebhooks(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	var hookBody string
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		hookBody = string(raw)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(hook.Close)
+	require.NoError(t, (&config.Config{
+		APIKey:   "nbl_test",
+		Username: "alxx",
+		Webhooks: []config.Webhook{
+			{Name: "tickets", URL: hook.URL, Template: "{{.Action}} {{.ApprovalID}} by {{.Reviewer}}: {{.Notes}}"},
+			{Name: "approvals-only", URL: hook.URL + "/never", Events: []string{"approve"}},
+		},
+	}).Save())
+
+	shutdown := startDefaultAPIBaseServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/approvals/ap-1/reject", r.URL.Path)
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{"id": "ap-1", "status": "rejected", "request_type": "create_entity"},
+		}))
+	}))
+	t.Cleanup(shutdown)
+
+	var out bytes.Buffer
+	cmd := APICmd()
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"approvals", "reject", "ap-1", "--notes", "duplicate"})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "reject ap-1 by alxx: duplicate", hookBody)
+
+	logged, err := webhooks.ReadLog(0)
+	require.NoError(t, err)
+	require.Len(t, logged, 1)
+	assert.Equal(t, "tickets", logged[0].Webhook)
+	assert.True(t, logged[0].OK())
+}
+
+func TestAPICmdApprovalsCommentRequiresBody(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	var out bytes.Buffer
+	cmd := APICmd()
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"approvals", "comment", "ap-1", "--body", "  "})
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing --body")
+}
+
 func TestAPICmdKeysLoginWorksWithoutConfig(t *testing.T) {
 	t.Setenv("HOME", t.TempDir())
 
@@ -0,0 +1,427 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+const (
+	// auditExportPageSize is the largest page /api/audit serves.
+	auditExportPageSize   = 200
+	auditSignatureEd25519 = "ed25519"
+)
+
+// auditVerifyReport summarizes an audit chain verification run.
+type auditVerifyReport struct {
+	File        string   `json:"file"`
+	Entries     int      `json:"entries"`
+	Verified    bool     `json:"verified"`
+	HeadEntryID string   `json:"head_entry_id,omitempty"`
+	HeadHash    string   `json:"head_hash,omitempty"`
+	Anchors     int      `json:"anchors"`
+	Signature   string   `json:"signature"`
+	Problems    []string `json:"problems,omitempty"`
+}
+
+// auditChainEntry is the part of an exported record the chain check needs.
+type auditChainEntry struct {
+	line      int
+	id        string
+	hash      string
+	prevHash  string
+	changedAt time.Time
+}
+
+// apiAuditExportCmd handles api audit export cmd.
+func apiAuditExportCmd() *cobra.Command {
+	var since, until, table, action, actorType, actorID, outFile string
+	var pageSize int
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export audit log entries as JSONL",
+		RunE: func(command *cobra.Command, _ []string) error {
+			params := api.QueryParams{}
+			if err := setAuditTimeParam(params, "since", since, false); err != nil {
+				return err
+			}
+			if err := setAuditTimeParam(params, "until", until, true); err != nil {
+				return err
+			}
+			for key, value := range map[string]string{
+				"table":      table,
+				"action":     action,
+				"actor_type": actorType,
+				"actor_id":   actorID,
+			} {
+				if value = strings.TrimSpace(value); value != "" {
+					params[key] = value
+				}
+			}
+			if pageSize <= 0 || pageSize > auditExportPageSize {
+				return fmt.Errorf("--page-size must be between 1 and %d", auditExportPageSize)
+			}
+
+			client, err := loadCommandClient(true)
+			if err != nil {
+				return err
+			}
+
+			out := command.OutOrStdout()
+			path := strings.TrimSpace(outFile)
+			if path != "" && path != "-" {
+				file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+				if err != nil {
+					return fmt.Errorf("open export file: %w", err)
+				}
+				defer func() { _ = file.Close() }()
+				out = file
+			}
+
+			count, err := exportAuditRecords(client, params, pageSize, out)
+			if err != nil {
+				return err
+			}
+			if out == command.OutOrStdout() {
+				return nil
+			}
+			return writeCleanJSON(command.OutOrStdout(), map[string]any{
+				"file":    path,
+				"entries": count,
+			})
+		},
+	}
+	cmd.Flags().StringVar(&since, "since", "", "include entries changed at or after (YYYY-MM-DD or RFC3339)")
+	cmd.Flags().StringVar(&until, "until", "", "include entries changed before (YYYY-MM-DD is inclusive)")
+	cmd.Flags().StringVar(&table, "table", "", "filter by table name")
+	cmd.Flags().StringVar(&action, "action", "", "filter by action")
+	cmd.Flags().StringVar(&actorType, "actor-type", "", "filter by actor type")
+	cmd.Flags().StringVar(&actorID, "actor-id", "", "filter by actor id")
+	cmd.Flags().StringVar(&outFile, "file", "", "write JSONL to this file instead of stdout")
+	cmd.Flags().IntVar(&pageSize, "page-size", auditExportPageSize, "entries fetched per request")
+	return cmd
+}
+
+// apiAuditVerifyCmd handles api audit verify cmd.
+func apiAuditVerifyCmd() *cobra.Command {
+	var offline bool
+	var publicKey string
+	cmd := &cobra.Command{
+		Use:   "verify <file>",
+		Short: "Verify the hash chain and signature of an audit export",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			file, err := os.Open(args[0])
+			if err != nil {
+				return fmt.Errorf("open audit export: %w", err)
+			}
+			defer func() { _ = file.Close() }()
+
+			report, err := verifyAuditChain(file)
+			if err != nil {
+				return err
+			}
+			report.File = args[0]
+
+			if offline {
+				report.Signature = "skipped"
+			} else if report.HeadHash != "" {
+				client, err := loadCommandClient(true)
+				if err != nil {
+					return err
+				}
+				integrity, err := client.GetAuditIntegrity(report.HeadEntryID)
+				if err != nil {
+					return fmt.Errorf("get audit integrity: %w", err)
+				}
+				if problem := checkAuditSignature(integrity, report.HeadHash, publicKey); problem != "" {
+					report.Signature = "invalid"
+					report.Problems = append(report.Problems, problem)
+				} else {
+					report.Signature = "valid"
+				}
+			}
+			report.Verified = len(report.Problems) == 0
+
+			if err := writeCleanJSON(command.OutOrStdout(), report); err != nil {
+				return err
+			}
+			if !report.Verified {
+				return fmt.Errorf("audit chain verification failed: %d problem(s)", len(report.Problems))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&offline, "offline", false, "only check the hash chain, skip the server signature")
+	cmd.Flags().StringVar(&publicKey, "public-key", "", "pin the expected base64 ed25519 signing key")
+	return cmd
+}
+
+// exportAuditRecords pages through the audit log, newest first, and writes one
+// record per line. Each page asks for the entries before the last one written,
+// so entries added during the export cannot shift pages.
+func exportAuditRecords(client *api.Client, params api.QueryParams, pageSize int, out io.Writer) (int, error) {
+	writer := bufio.NewWriter(out)
+	count := 0
+	type auditCursor struct {
+		ID        string `json:"id"`
+		ChangedAt string `json:"changed_at"`
+	}
+	var cursor auditCursor
+	for {
+		page := api.QueryParams{}
+		for key, value := range params {
+			page[key] = value
+		}
+		page["limit"] = strconv.Itoa(pageSize)
+		if cursor.ID != "" {
+			page["before_changed_at"] = cursor.ChangedAt
+			page["before_id"] = cursor.ID
+		}
+
+		records, err := client.QueryAuditRecords(page)
+		if err != nil {
+			return count, fmt.Errorf("query audit log: %w", err)
+		}
+		for _, record := range records {
+			var line bytes.Buffer
+			if err := json.Compact(&line, record); err != nil {
+				return count, fmt.Errorf("encode audit entry: %w", err)
+			}
+			line.WriteByte('\n')
+			if _, err := writer.Write(line.Bytes()); err != nil {
+				return count, fmt.Errorf("write audit export: %w", err)
+			}
+			count++
+		}
+		if len(records) < pageSize {
+			break
+		}
+		cursor = auditCursor{}
+		if err := json.Unmarshal(records[len(records)-1], &cursor); err != nil || cursor.ID == "" || cursor.ChangedAt == "" {
+			return count, fmt.Errorf("audit entry has no id or changed_at to page from")
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return count, fmt.Errorf("write audit export: %w", err)
+	}
+	return count, nil
+}
+
+// setAuditTimeParam normalizes a range bound, treating a bare date as a whole day.
+func setAuditTimeParam(params api.QueryParams, key, raw string, endOfDay bool) error {
+	value := strings.TrimSpace(raw)
+	if value == "" {
+		return nil
+	}
+	if ts, err := time.Parse(time.RFC3339, value); err == nil {
+		params[key] = ts.UTC().Format(time.RFC3339)
+		return nil
+	}
+	ts, err := time.ParseInLocation("2006-01-02", value, time.Local)
+	if err != nil {
+		return fmt.Errorf("--%s must be YYYY-MM-DD or RFC3339", key)
+	}
+	if endOfDay {
+		ts = ts.AddDate(0, 0, 1)
+	}
+	params[key] = ts.UTC().Format(time.RFC3339)
+	return nil
+}
+
+// auditChainFields lists the columns the server hashes, in hash order. metadata
+// is not covered because approvals link themselves to entries after the insert.
+var auditChainFields = []string{
+	"prev_hash", "id", "table_name", "record_id", "action", "changed_by_type",
+	"changed_by_id", "old_data", "new_data", "changed_fields", "change_reason",
+	"changed_at",
+}
+
+// auditEntryHash recomputes an entry hash the way the audit_log insert trigger
+// does: hex sha256 over each chained field as "<char length>:<value>", with
+// jsonb columns as the text the server returns and changed_at in UTC with
+// microseconds. prev_hash is hashed too, which is what links the chain.
+func auditEntryHash(record map[string]any) (string, error) {
+	var payload strings.Builder
+	for _, key := range auditChainFields {
+		value, err := auditChainValue(record, key)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", key, err)
+		}
+		payload.WriteString(strconv.Itoa(utf8.RuneCountInString(value)))
+		payload.WriteByte(':')
+		payload.WriteString(value)
+	}
+	sum := sha256.Sum256([]byte(payload.String()))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// auditChainValue renders one chained field as the database text it hashes.
+func auditChainValue(record map[string]any, key string) (string, error) {
+	switch value := record[key].(type) {
+	case nil:
+		return "", nil
+	case string:
+		if key != "changed_at" || value == "" {
+			return value, nil
+		}
+		ts, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return "", err
+		}
+		return ts.UTC().Format("2006-01-02T15:04:05.000000Z"), nil
+	case []any:
+		if key != "changed_fields" {
+			break
+		}
+		parts := make([]string, 0, len(value))
+		for _, item := range value {
+			part, ok := item.(string)
+			if !ok {
+				return "", fmt.Errorf("want a list of names")
+			}
+			parts = append(parts, part)
+		}
+		return strings.Join(parts, ","), nil
+	}
+	return "", fmt.Errorf("unexpected %T value", record[key])
+}
+
+// verifyAuditChain checks every entry hash and how entries link to each other.
+// Line order does not matter; filtered exports show up as extra anchors, one per
+// contiguous segment.
+func verifyAuditChain(in io.Reader) (auditVerifyReport, error) {
+	report := auditVerifyReport{Signature: "skipped"}
+	entries := []auditChainEntry{}
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+		dec := json.NewDecoder(bytes.NewReader(raw))
+		dec.UseNumber()
+		var record map[string]any
+		if err := dec.Decode(&record); err != nil {
+			return report, fmt.Errorf("line %d: decode audit entry: %w", lineNo, err)
+		}
+		entry := auditChainEntry{
+			line:     lineNo,
+			id:       auditRecordString(record, "id"),
+			hash:     auditRecordString(record, "hash"),
+			prevHash: auditRecordString(record, "prev_hash"),
+		}
+		entry.changedAt, _ = time.Parse(time.RFC3339Nano, auditRecordString(record, "changed_at"))
+		if entry.hash == "" {
+			report.Problems = append(report.Problems, fmt.Sprintf("line %d: entry %s has no hash", lineNo, entry.id))
+		} else if computed, err := auditEntryHash(record); err != nil {
+			return report, fmt.Errorf("line %d: hash audit entry: %w", lineNo, err)
+		} else if !strings.EqualFold(computed, entry.hash) {
+			report.Problems = append(report.Problems, fmt.Sprintf("line %d: entry %s hash mismatch", lineNo, entry.id))
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return report, fmt.Errorf("read audit export: %w", err)
+	}
+	report.Entries = len(entries)
+	if len(entries) == 0 {
+		report.Problems = append(report.Problems, "export contains no entries")
+		return report, nil
+	}
+
+	hashes := make(map[string]bool, len(entries))
+	referenced := make(map[string]int, len(entries))
+	for _, entry := range entries {
+		if entry.hash == "" {
+			continue
+		}
+		if hashes[entry.hash] {
+			report.Problems = append(report.Problems, fmt.Sprintf("line %d: duplicate hash for entry %s", entry.line, entry.id))
+		}
+		hashes[entry.hash] = true
+		if entry.prevHash != "" {
+			referenced[entry.prevHash]++
+		}
+	}
+	heads := []auditChainEntry{}
+	var latest time.Time
+	for _, entry := range entries {
+		if entry.prevHash == "" || !hashes[entry.prevHash] {
+			report.Anchors++
+		}
+		if referenced[entry.prevHash] > 1 && hashes[entry.prevHash] {
+			report.Problems = append(report.Problems, fmt.Sprintf("line %d: entry %s forks the chain", entry.line, entry.id))
+		}
+		if entry.hash != "" && referenced[entry.hash] == 0 {
+			heads = append(heads, entry)
+		}
+	}
+	if len(heads) > 1 && report.Anchors == 1 {
+		report.Problems = append(report.Problems, fmt.Sprintf("chain has %d heads, want 1", len(heads)))
+	}
+	// With gaps every segment has its own head; the newest one is what the server signs.
+	for _, head := range heads {
+		if report.HeadHash == "" || head.changedAt.After(latest) {
+			report.HeadEntryID = head.id
+			report.HeadHash = head.hash
+			latest = head.changedAt
+		}
+	}
+	return report, nil
+}
+
+// checkAuditSignature verifies the signed chain head against the export head.
+func checkAuditSignature(integrity *api.AuditIntegrity, headHash, pinnedKey string) string {
+	if integrity == nil {
+		return "server returned no integrity record"
+	}
+	if !strings.EqualFold(strings.TrimSpace(integrity.Algorithm), auditSignatureEd25519) {
+		return fmt.Sprintf("unsupported signature algorithm %q", integrity.Algorithm)
+	}
+	if !strings.EqualFold(integrity.Hash, headHash) {
+		return "server chain head does not match export head"
+	}
+	key := strings.TrimSpace(integrity.PublicKey)
+	if pinned := strings.TrimSpace(pinnedKey); pinned != "" {
+		if pinned != key {
+			return "server signing key does not match --public-key"
+		}
+	}
+	pub, err := base64.StdEncoding.DecodeString(key)
+	if err != nil || len(pub) != ed25519.PublicKeySize {
+		return "server signing key is not a base64 ed25519 key"
+	}
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(integrity.Signature))
+	if err != nil {
+		return "signature is not base64"
+	}
+	if !ed25519.Verify(ed25519.PublicKey(pub), []byte(integrity.Hash), sig) {
+		return "signature does not verify"
+	}
+	return ""
+}
+
+// auditRecordString reads a string field from a decoded audit record.
+func auditRecordString(record map[string]any, key string) string {
+	value, _ := record[key].(string)
+	return strings.TrimSpace(value)
+}
@@ -0,0 +1,218 @@
+package cmd
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// signedAuditChain builds a linked audit chain with each record hashed in place.
+func signedAuditChain(t *testing.T, n int) []map[string]any {
+	t.Helper()
+	records := make([]map[string]any, 0, n)
+	prev := ""
+	for i := 0; i < n; i++ {
+		record := map[string]any{
+			"id":         "audit-" + strconv.Itoa(i+1),
+			"table_name": "entities",
+			"record_id":  "ent-1",
+			"action":     "update",
+			"new_data":   `{"name": "<v` + strconv.Itoa(i) + `>", "rank": ` + strconv.Itoa(i) + `}`,
+			"changed_at": "2026-03-0" + strconv.Itoa(i+1) + "T10:00:00Z",
+		}
+		if prev != "" {
+			record["prev_hash"] = prev
+		}
+		hash, err := auditEntryHash(record)
+		require.NoError(t, err)
+		record["hash"] = hash
+		prev = hash
+		records = append(records, record)
+	}
+	return records
+}
+
+// auditPage serves records newest first, like /api/audit, honoring limit and
+// the before_changed_at/before_id cursor.
+func auditPage(records []map[string]any, r *http.Request) []map[string]any {
+	newest := make([]map[string]any, 0, len(records))
+	for i := len(records) - 1; i >= 0; i-- {
+		newest = append(newest, records[i])
+	}
+	start := 0
+	if before := r.URL.Query().Get("before_id"); before != "" {
+		for i, record := range newest {
+			if record["id"] == before {
+				start = i + 1
+			}
+		}
+	}
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	end := start + limit
+	if end > len(newest) {
+		end = len(newest)
+	}
+	return newest[start:end]
+}
+
+func TestAPIAuditExportAndVerifyRoundTrip(t *testing.T) {
+	setupAPICommandAuth(t)
+	pub, priv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	records := signedAuditChain(t, 3)
+	head := records[2]["hash"].(string)
+
+	var seenSince, seenUntil string
+	var cursors []string
+	shutdown := startDefaultAPIBaseServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/audit":
+			seenSince = r.URL.Query().Get("since")
+			seenUntil = r.URL.Query().Get("until")
+			cursors = append(cursors, r.URL.Query().Get("before_id")+"@"+r.URL.Query().Get("before_changed_at"))
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": auditPage(records, r)}))
+		case "/api/audit/integrity":
+			assert.Equal(t, "audit-3", r.URL.Query().Get("entry_id"))
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+				"entry_id":   "audit-3",
+				"hash":       head,
+				"algorithm":  "ed25519",
+				"signature":  base64.StdEncoding.EncodeToString(ed25519.Sign(priv, []byte(head))),
+				"public_key": base64.StdEncoding.EncodeToString(pub),
+			}}))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer shutdown()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	runAPISubcommand(t, "audit", "export", "--since", "2026-03-01T00:00:00Z", "--until", "2026-03-31T00:00:00Z", "--page-size", "2", "--file", path)
+	assert.Equal(t, "2026-03-01T00:00:00Z", seenSince)
+	assert.Equal(t, "2026-03-31T00:00:00Z", seenUntil)
+
+	assert.Equal(t, []string{"@", "audit-2@2026-03-02T10:00:00Z"}, cursors)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Len(t, strings.Split(strings.TrimSpace(string(data)), "\n"), 3)
+
+	out := runAPISubcommand(t, "audit", "verify", path, "--public-key", base64.StdEncoding.EncodeToString(pub))
+	var report auditVerifyReport
+	require.NoError(t, json.Unmarshal([]byte(out), &report))
+	assert.True(t, report.Verified)
+	assert.Equal(t, "valid", report.Signature)
+	assert.Equal(t, 1, report.Anchors)
+	assert.Equal(t, head, report.HeadHash)
+
+	otherPub, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	cmd := APICmd()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"audit", "verify", path, "--public-key", base64.StdEncoding.EncodeToString(otherPub)})
+	assert.ErrorContains(t, cmd.Execute(), "verification failed")
+}
+
+func TestAPIAuditExportUsesServerPageCapByDefault(t *testing.T) {
+	setupAPICommandAuth(t)
+	records := signedAuditChain(t, 3)
+	var limits []string
+	shutdown := startDefaultAPIBaseServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limits = append(limits, r.URL.Query().Get("limit"))
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": auditPage(records, r)}))
+	}))
+	defer shutdown()
+
+	var out bytes.Buffer
+	cmd := APICmd()
+	cmd.SetOut(&out)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"audit", "export"})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, []string{"200"}, limits)
+	assert.Len(t, strings.Split(strings.TrimSpace(out.String()), "\n"), 3)
+
+	cmd = APICmd()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"audit", "export", "--page-size", "500"})
+	assert.ErrorContains(t, cmd.Execute(), "--page-size must be between 1 and 200")
+	assert.Len(t, limits, 1, "an oversized page is refused before any request")
+}
+
+func TestAuditEntryHashMatchesServerTrigger(t *testing.T) {
+	// Expected value is sha256 over the length-prefixed fields as built by
+	// audit_entry_hash in 028_audit_hash_chain.sql.
+	record := map[string]any{
+		"id":              "9f0c1c1e-0000-4000-8000-000000000001",
+		"table_name":      "entities",
+		"record_id":       "ent-1",
+		"action":          "update",
+		"changed_by_type": "agent",
+		"changed_by_id":   nil,
+		"old_data":        `{"name": "Zoë"}`,
+		"new_data":        `{"name": "Zoë 2"}`,
+		"changed_fields":  []any{"name"},
+		"changed_at":      "2026-03-01T10:00:00.500000+00:00",
+		"metadata":        `{"approval_id": "later"}`,
+	}
+	hash, err := auditEntryHash(record)
+	require.NoError(t, err)
+	assert.Equal(t, "ffe8f02c16009461e58bc3dbf1803ff60574bcd7a7c962c21bbe2f79a2ab841d", hash)
+
+	record["new_data"] = map[string]any{"name": "Zoë 2"}
+	_, err = auditEntryHash(record)
+	assert.ErrorContains(t, err, "new_data")
+}
+
+func TestVerifyAuditChainDetectsTampering(t *testing.T) {
+	records := signedAuditChain(t, 3)
+	records[1]["action"] = "delete"
+
+	var buf bytes.Buffer
+	for _, record := range records {
+		require.NoError(t, json.NewEncoder(&buf).Encode(record))
+	}
+	report, err := verifyAuditChain(&buf)
+	require.NoError(t, err)
+	require.Len(t, report.Problems, 1)
+	assert.Contains(t, report.Problems[0], "audit-2 hash mismatch")
+}
+
+func TestVerifyAuditChainOrderIndependentAndGaps(t *testing.T) {
+	records := signedAuditChain(t, 4)
+
+	var buf bytes.Buffer
+	for _, idx := range []int{3, 0, 2} {
+		require.NoError(t, json.NewEncoder(&buf).Encode(records[idx]))
+	}
+	report, err := verifyAuditChain(&buf)
+	require.NoError(t, err)
+	assert.Empty(t, report.Problems)
+	assert.Equal(t, 3, report.Entries)
+	assert.Equal(t, 2, report.Anchors)
+	assert.Equal(t, "audit-4", report.HeadEntryID)
+
+	_, err = verifyAuditChain(strings.NewReader("{not json}\n"))
+	assert.ErrorContains(t, err, "line 1")
+}
+
+func TestSetAuditTimeParamDateBounds(t *testing.T) {
+	params := map[string]string{}
+	require.NoError(t, setAuditTimeParam(params, "until", "2026-03-31", true))
+	require.NoError(t, setAuditTimeParam(params, "since", "", false))
+	assert.NotContains(t, params, "since")
+	assert.NotEmpty(t, params["until"])
+	assert.ErrorContains(t, setAuditTimeParam(params, "since", "last week", false), "--since")
+}
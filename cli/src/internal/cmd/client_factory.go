@@ -1,9 +1,12 @@
 package cmd
 
 import (
+	"fmt"
+	"os"
 	"time"
 
 	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
 )
 
 // newDefaultClient builds API clients for command flows.
@@ -11,5 +14,9 @@ import (
 // Tests override this variable to route command calls into local test servers
 // without requiring the default localhost port to be free.
 var newDefaultClient = func(apiKey string, timeout ...time.Duration) *api.Client {
-	return api.NewDefaultClient(apiKey, timeout...)
+	client := api.NewClient(api.ResolveBaseURL(config.ActiveAPIURL()), apiKey, timeout...)
+	client.OnRateLimited(func(wait time.Duration) {
+		fmt.Fprintf(os.Stderr, "rate limited, retrying in %s\n", wait.Round(time.Second))
+	})
+	return client
 }
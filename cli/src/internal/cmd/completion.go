@@ -0,0 +1,277 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+const (
+	// completionCacheTTL is how long fetched candidates are reused before the
+	// next tab press hits the API again.
+	completionCacheTTL   = 5 * time.Minute
+	completionFetchLimit = 100
+)
+
+var completionNow = time.Now
+
+// taxonomyKinds are the kinds accepted by `nebula api taxonomy`.
+var taxonomyKinds = []string{"scopes", "entity-types", "relationship-types", "log-types"}
+
+// completionFunc completes positional args or flag values.
+type completionFunc = func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective)
+
+// dynamicCompletions maps command paths under the root to their argument
+// completers.
+var dynamicCompletions = map[string]completionFunc{
+	"api entities get":           completeArgs(1, completeEntities),
+	"api entities update":        completeArgs(1, completeEntities),
+	"api entities history":       completeArgs(1, completeEntities),
+	"api entities revert":        completeArgs(1, completeEntities),
+	"api context get":            completeArgs(1, completeContext),
+	"api context update":         completeArgs(1, completeContext),
+	"api context link":           completeArgs(1, completeContext),
+	"api tags rename":            completeArgs(1, completeTags),
+	"api tags merge":             completeArgs(2, completeTags),
+	"api taxonomy list":          completeArgs(1, completeStatic(taxonomyKinds)),
+	"api taxonomy create":        completeArgs(1, completeStatic(taxonomyKinds)),
+	"api taxonomy update":        completeTaxonomyEntry,
+	"api taxonomy archive":       completeTaxonomyEntry,
+	"api taxonomy activate":      completeTaxonomyEntry,
+	"api taxonomy merge-scope":   completeArgs(2, completeScopes),
+	"api entities query --param": completeQueryParam,
+	"api context query --param":  completeQueryParam,
+}
+
+type completionCacheEntry struct {
+	At    time.Time `json:"at"`
+	Items []string  `json:"items"`
+}
+
+// AttachCompletion adds `nebula completion` and registers dynamic completion
+// for entity, context, tag, and scope arguments.
+func AttachCompletion(root *cobra.Command) {
+	if root == nil {
+		return
+	}
+	root.CompletionOptions.DisableDefaultCmd = true
+	root.AddCommand(CompletionCmd())
+
+	for path, complete := range dynamicCompletions {
+		words := strings.Fields(path)
+		flag := ""
+		if last := words[len(words)-1]; strings.HasPrefix(last, "--") {
+			flag = strings.TrimPrefix(last, "--")
+			words = words[:len(words)-1]
+		}
+		target, _, err := root.Find(words)
+		if err != nil || target == nil || target.CommandPath() != root.Name()+" "+strings.Join(words, " ") {
+			continue
+		}
+		if flag != "" {
+			_ = target.RegisterFlagCompletionFunc(flag, complete)
+			continue
+		}
+		target.ValidArgsFunction = complete
+	}
+}
+
+// CompletionCmd returns the `nebula completion` command.
+func CompletionCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "completion <bash|zsh|fish>",
+		Short: "Generate shell completion script",
+		Long: strings.TrimSpace(`Print a completion script for your shell.
+
+  bash: source <(nebula completion bash)
+  zsh:  nebula completion zsh > "${fpath[1]}/_nebula"
+  fish: nebula completion fish > ~/.config/fish/completions/nebula.fish
+
+Entity, context, tag, and scope candidates are fetched from the API and
+cached for a few minutes.`),
+		Args:                  cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		ValidArgs:             []string{"bash", "zsh", "fish"},
+		DisableFlagsInUseLine: true,
+		RunE: func(command *cobra.Command, args []string) error {
+			root := command.Root()
+			out := command.OutOrStdout()
+			switch args[0] {
+			case "bash":
+				return root.GenBashCompletionV2(out, true)
+			case "zsh":
+				return root.GenZshCompletion(out)
+			case "fish":
+				return root.GenFishCompletion(out, true)
+			}
+			return fmt.Errorf("unsupported shell %q", args[0])
+		},
+	}
+}
+
+// completeArgs limits a candidate source to the first n positional args.
+func completeArgs(n int, source func(string) []string) completionFunc {
+	return func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) >= n {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return source(toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completeStatic completes from a fixed list.
+func completeStatic(items []string) func(string) []string {
+	return func(toComplete string) []string {
+		return filterCompletions(items, toComplete)
+	}
+}
+
+// completeTaxonomyEntry completes `<kind> <id>`, offering scope ids when the
+// kind is scopes.
+func completeTaxonomyEntry(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	switch {
+	case len(args) == 0:
+		return filterCompletions(taxonomyKinds, toComplete), cobra.ShellCompDirectiveNoFileComp
+	case len(args) == 1 && args[0] == "scopes":
+		return completeScopes(toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+	return nil, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeQueryParam completes --param keys, and tag names after tags=.
+func completeQueryParam(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if value, ok := strings.CutPrefix(toComplete, "tags="); ok {
+		items := completeTags(value)
+		for i, item := range items {
+			items[i] = "tags=" + item
+		}
+		return items, cobra.ShellCompDirectiveNoFileComp
+	}
+	keys := []string{"tags=", "type=", "source_type=", "search_text=", "limit=", "offset="}
+	return filterCompletions(keys, toComplete), cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+}
+
+// completeEntities offers entity ids described by name.
+func completeEntities(toComplete string) []string {
+	return filterCompletions(cachedCompletions("entities", func(client *api.Client) ([]string, error) {
+		items, err := client.QueryEntities(api.QueryParams{"limit": fmt.Sprint(completionFetchLimit)})
+		if err != nil {
+			return nil, err
+		}
+		out := make([]string, 0, len(items))
+		for _, item := range items {
+			out = append(out, completionCandidate(item.ID, item.Name))
+		}
+		return out, nil
+	}), toComplete)
+}
+
+// completeContext offers context item ids described by title.
+func completeContext(toComplete string) []string {
+	return filterCompletions(cachedCompletions("context", func(client *api.Client) ([]string, error) {
+		items, err := client.QueryContext(api.QueryParams{"limit": fmt.Sprint(completionFetchLimit)})
+		if err != nil {
+			return nil, err
+		}
+		out := make([]string, 0, len(items))
+		for _, item := range items {
+			out = append(out, completionCandidate(item.ID, item.Title))
+		}
+		return out, nil
+	}), toComplete)
+}
+
+// completeTags offers tag names described by usage.
+func completeTags(toComplete string) []string {
+	return filterCompletions(cachedCompletions("tags", func(client *api.Client) ([]string, error) {
+		items, err := client.ListTags("", completionFetchLimit, 0)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]string, 0, len(items))
+		for _, item := range items {
+			out = append(out, completionCandidate(item.Name, fmt.Sprintf("%d uses", item.Total())))
+		}
+		return out, nil
+	}), toComplete)
+}
+
+// completeScopes offers scope ids described by name.
+func completeScopes(toComplete string) []string {
+	return filterCompletions(cachedCompletions("scopes", func(client *api.Client) ([]string, error) {
+		items, err := client.ListTaxonomy("scopes", false, "", completionFetchLimit, 0)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]string, 0, len(items))
+		for _, item := range items {
+			out = append(out, completionCandidate(item.ID, item.Name))
+		}
+		return out, nil
+	}), toComplete)
+}
+
+// completionCandidate formats a value with the description shells show next
+// to it.
+func completionCandidate(value, desc string) string {
+	desc = strings.Join(strings.Fields(desc), " ")
+	if desc == "" {
+		return value
+	}
+	return value + "\t" + desc
+}
+
+// filterCompletions keeps candidates whose value starts with prefix.
+func filterCompletions(items []string, prefix string) []string {
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		value, _, _ := strings.Cut(item, "\t")
+		if strings.HasPrefix(value, prefix) {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// completionCachePath returns the completion cache file path.
+func completionCachePath() string {
+	return filepath.Join(filepath.Dir(config.Path()), "completion-cache")
+}
+
+// cachedCompletions returns the candidates cached under kind for the active
+// API, fetching them when the cache is missing or stale. A failed fetch falls
+// back to stale candidates, and completion stays silent when logged out.
+func cachedCompletions(kind string, fetch func(*api.Client) ([]string, error)) []string {
+	key := config.ActiveAPIURL() + " " + kind
+	cache := map[string]completionCacheEntry{}
+	if data, err := os.ReadFile(completionCachePath()); err == nil {
+		_ = json.Unmarshal(data, &cache)
+	}
+	entry, ok := cache[key]
+	if ok && completionNow().Sub(entry.At) < completionCacheTTL {
+		return entry.Items
+	}
+
+	client, err := loadCommandClient(true)
+	if err != nil {
+		return entry.Items
+	}
+	items, err := fetch(client)
+	if err != nil {
+		return entry.Items
+	}
+	cache[key] = completionCacheEntry{At: completionNow(), Items: items}
+	if data, err := json.Marshal(cache); err == nil {
+		if err := os.MkdirAll(filepath.Dir(completionCachePath()), 0700); err == nil {
+			_ = os.WriteFile(completionCachePath(), data, 0600)
+		}
+	}
+	return items
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+func completionTestRoot() *cobra.Command {
+	root := &cobra.Command{Use: "nebula"}
+	root.AddCommand(APICmd())
+	AttachCompletion(root)
+	return root
+}
+
+func runCompletion(t *testing.T, args ...string) string {
+	t.Helper()
+	var out bytes.Buffer
+	root := completionTestRoot()
+	root.SetOut(&out)
+	root.SetErr(&bytes.Buffer{})
+	root.SetArgs(args)
+	require.NoError(t, root.Execute())
+	return out.String()
+}
+
+func TestCompletionCmdGeneratesShellScripts(t *testing.T) {
+	assert.Contains(t, runCompletion(t, "completion", "bash"), "__start_nebula")
+	assert.Contains(t, runCompletion(t, "completion", "zsh"), "#compdef nebula")
+	assert.Contains(t, runCompletion(t, "completion", "fish"), "complete -c nebula")
+
+	root := completionTestRoot()
+	root.SetOut(&bytes.Buffer{})
+	root.SetErr(&bytes.Buffer{})
+	root.SetArgs([]string{"completion", "powershell"})
+	assert.Error(t, root.Execute())
+}
+
+func TestCompletionCompletesEntityIDsFromCache(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	require.NoError(t, (&config.Config{APIKey: "nbl_test", Username: "alxx"}).Save())
+
+	hits := 0
+	shutdown := startDefaultAPIBaseServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/entities", r.URL.Path)
+		hits++
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+			{"id": "ent-1", "name": "Luna  Cat"},
+			{"id": "other-2", "name": "Orbit"},
+		}}))
+	}))
+	t.Cleanup(shutdown)
+
+	out := runCompletion(t, cobra.ShellCompRequestCmd, "api", "entities", "get", "ent")
+	assert.Contains(t, out, "ent-1\tLuna Cat")
+	assert.NotContains(t, out, "other-2")
+
+	out = runCompletion(t, cobra.ShellCompRequestCmd, "api", "entities", "history", "")
+	assert.Contains(t, out, "other-2\tOrbit")
+	assert.Equal(t, 1, hits)
+
+	out = runCompletion(t, cobra.ShellCompRequestCmd, "api", "entities", "get", "ent-1", "")
+	assert.NotContains(t, out, "ent-1")
+}
+
+func TestCompletionCompletesTagParamsAndScopes(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	require.NoError(t, (&config.Config{APIKey: "nbl_test", Username: "alxx"}).Save())
+
+	shutdown := startDefaultAPIBaseServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/tags":
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+				{"name": "urgent", "entities": 2, "knowledge": 1},
+			}}))
+		case "/api/taxonomy/scopes":
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+				{"id": "scope-1", "name": "public"},
+			}}))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(shutdown)
+
+	out := runCompletion(t, cobra.ShellCompRequestCmd, "api", "context", "query", "--param", "tags=u")
+	assert.Contains(t, out, "tags=urgent\t3 uses")
+
+	out = runCompletion(t, cobra.ShellCompRequestCmd, "api", "entities", "query", "--param", "se")
+	assert.Contains(t, out, "search_text=")
+
+	out = runCompletion(t, cobra.ShellCompRequestCmd, "api", "taxonomy", "archive", "scopes", "")
+	assert.Contains(t, out, "scope-1\tpublic")
+}
+
+func TestCompletionStaysQuietWhenLoggedOut(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	out := runCompletion(t, cobra.ShellCompRequestCmd, "api", "tags", "rename", "")
+	assert.NotContains(t, out, "\t")
+}
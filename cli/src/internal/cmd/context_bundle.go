@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gravitrone/nebula-core/cli/internal/bundle"
+)
+
+// ContextCmd returns `nebula context <entity-id>`, which prints an entity and
+// the records around it as one prompt-ready bundle.
+func ContextCmd() *cobra.Command {
+	opts := bundle.DefaultOptions()
+	format := bundle.FormatMarkdown
+	cmd := &cobra.Command{
+		Use:   "context <entity-id>",
+		Short: "Bundle an entity with its relationships, knowledge, and history for LLM prompts",
+		Long: strings.TrimSpace(`Assemble an entity, the entities within --depth relationship hops, linked
+knowledge, and recent history into one markdown or JSON document. History,
+then knowledge, then the farthest entities, then relationships are dropped
+until the bundle fits --max-tokens.`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			client, err := loadCommandClient(true)
+			if err != nil {
+				return err
+			}
+			b, err := bundle.Build(client, args[0], opts)
+			if err != nil {
+				return fmt.Errorf("build context bundle: %w", err)
+			}
+			out, err := b.Render(format, opts.MaxTokens)
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(command.OutOrStdout(), strings.TrimRight(out, "\n"))
+			return err
+		},
+	}
+	cmd.Flags().IntVar(&opts.Depth, "depth", opts.Depth, "relationship hops to follow")
+	cmd.Flags().IntVar(&opts.HistoryLimit, "history", opts.HistoryLimit, "recent history entries to include (0 for none)")
+	cmd.Flags().IntVar(&opts.MaxTokens, "max-tokens", opts.MaxTokens, "approximate token budget (0 for no limit)")
+	cmd.Flags().StringVar(&format, "format", format, "bundle format: markdown or json")
+	cmd.Flags().StringSliceVar(&opts.IncludeScopes, "include-scope", nil, "only include records in these scopes (repeatable)")
+	cmd.Flags().StringSliceVar(&opts.ExcludeScopes, "exclude-scope", nil, "drop records in these scopes (repeatable)")
+	return cmd
+}
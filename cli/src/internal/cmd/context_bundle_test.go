@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+func TestContextCmdPrintsBundle(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	require.NoError(t, (&config.Config{APIKey: "nbl_test", Username: "alxx"}).Save())
+
+	shutdown := startDefaultAPIBaseServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data any = []any{}
+		if r.URL.Path == "/api/entities/ent-1" {
+			data = map[string]any{"id": "ent-1", "name": "Luna", "type": "person"}
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": data}))
+	}))
+	t.Cleanup(shutdown)
+
+	var out bytes.Buffer
+	cmd := ContextCmd()
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"ent-1", "--format", "json", "--history", "0"})
+	require.NoError(t, cmd.Execute())
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(out.Bytes(), &decoded))
+	assert.Equal(t, "Luna", decoded["entity"].(map[string]any)["name"])
+
+	out.Reset()
+	cmd = ContextCmd()
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"ent-1", "--format", "yaml"})
+	assert.Error(t, cmd.Execute())
+}
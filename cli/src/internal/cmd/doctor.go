@@ -78,6 +78,7 @@ func runDoctorChecks() []doctorCheck {
 	apiClient := newDefaultClient("")
 	if cfg != nil {
 		apiClient = newDefaultClient(cfg.APIKey, 1200*time.Millisecond)
+		AttachTokenRefresh(apiClient, cfg)
 	}
 	health, err := apiClient.Health()
 	if err != nil {
@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/x/term"
+	"github.com/spf13/cobra"
+
+	"github.com/gravitrone/nebula-core/cli/internal/archive"
+)
+
+// archivePassphraseEnv supplies the archive passphrase to scripts without a
+// prompt.
+const archivePassphraseEnv = "NEBULA_ARCHIVE_PASSPHRASE"
+
+// readArchivePassphrase returns the archive passphrase from the environment
+// or prompts for it. Tests replace it to skip the prompt.
+var readArchivePassphrase = func(command *cobra.Command, confirm bool) (string, error) {
+	if passphrase := os.Getenv(archivePassphraseEnv); passphrase != "" {
+		return passphrase, nil
+	}
+	passphrase, err := promptPassphrase(command, "Archive passphrase: ")
+	if err != nil {
+		return "", err
+	}
+	if passphrase == "" {
+		return "", fmt.Errorf("passphrase is required")
+	}
+	if confirm {
+		again, err := promptPassphrase(command, "Confirm passphrase: ")
+		if err != nil {
+			return "", err
+		}
+		if again != passphrase {
+			return "", fmt.Errorf("passphrases do not match")
+		}
+	}
+	return passphrase, nil
+}
+
+// promptPassphrase reads one passphrase, without echo on a terminal.
+func promptPassphrase(command *cobra.Command, prompt string) (string, error) {
+	_, _ = fmt.Fprint(command.ErrOrStderr(), prompt)
+	if command.InOrStdin() == os.Stdin && term.IsTerminal(os.Stdin.Fd()) {
+		raw, err := term.ReadPassword(os.Stdin.Fd())
+		_, _ = fmt.Fprintln(command.ErrOrStderr())
+		if err != nil {
+			return "", fmt.Errorf("read passphrase: %w", err)
+		}
+		return string(raw), nil
+	}
+	// Read a byte at a time so a confirmation line stays unread for the
+	// next prompt.
+	var line []byte
+	buf := make([]byte, 1)
+	for {
+		n, err := command.InOrStdin().Read(buf)
+		if n == 1 {
+			if buf[0] == '\n' {
+				break
+			}
+			line = append(line, buf[0])
+		}
+		if err == io.EOF && len(line) > 0 {
+			break
+		}
+		if err != nil {
+			return "", fmt.Errorf("read passphrase: %w", err)
+		}
+	}
+	return strings.TrimRight(string(line), "\r"), nil
+}
+
+// writeExportFile writes an export payload to path, sealing it first when
+// encrypt is set.
+func writeExportFile(command *cobra.Command, path string, value any, encrypt bool) error {
+	var buf bytes.Buffer
+	if err := encodeJSON(&buf, value, true); err != nil {
+		return err
+	}
+	data := buf.Bytes()
+	if encrypt {
+		passphrase, err := readArchivePassphrase(command, true)
+		if err != nil {
+			return err
+		}
+		if data, err = archive.Encrypt(data, passphrase); err != nil {
+			return fmt.Errorf("encrypt export: %w", err)
+		}
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("write export file: %w", err)
+	}
+	return writeCleanJSON(command.OutOrStdout(), map[string]any{
+		"file":      path,
+		"encrypted": encrypt,
+	})
+}
+
+// readImportInput reads an import payload like readInputJSON, decrypting
+// --input-file first when it is an encrypted archive.
+func readImportInput(command *cobra.Command, input string, inputFile string) (json.RawMessage, error) {
+	path := strings.TrimSpace(inputFile)
+	if path == "" || strings.TrimSpace(input) != "" {
+		return readInputJSON(input, inputFile, true)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read input file: %w", err)
+	}
+	if !archive.IsEncrypted(data) {
+		return readInputJSON(input, inputFile, true)
+	}
+	passphrase, err := readArchivePassphrase(command, false)
+	if err != nil {
+		return nil, err
+	}
+	plain, err := archive.Decrypt(data, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt %s: %w", path, err)
+	}
+	raw := bytes.TrimSpace(plain)
+	if !json.Valid(raw) {
+		return nil, fmt.Errorf("invalid JSON in encrypted --input-file")
+	}
+	return raw, nil
+}
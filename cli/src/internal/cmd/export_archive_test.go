@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/archive"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+func TestAPICmdEncryptedExportRoundTripsThroughImport(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(archivePassphraseEnv, "correct horse")
+	require.NoError(t, (&config.Config{APIKey: "nbl_test"}).Save())
+
+	var imported map[string]any
+	shutdown := startDefaultAPIBaseServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/export/entities":
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{"format": "json", "items": []any{map[string]any{"name": "Alpha"}}, "count": 1},
+			}))
+		case "/api/import/entities":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&imported))
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{"created": 1, "failed": 0},
+			}))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(shutdown)
+
+	path := filepath.Join(t.TempDir(), "entities.nebx")
+	var out bytes.Buffer
+	cmd := APICmd()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"export", "entities", "--file", path, "--encrypt"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, out.String(), `"encrypted": true`)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.True(t, archive.IsEncrypted(data))
+	assert.NotContains(t, string(data), "Alpha")
+
+	cmd = APICmd()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"import", "entities", "--input-file", path})
+	require.NoError(t, cmd.Execute())
+	require.NotNil(t, imported)
+	assert.Equal(t, "json", imported["format"])
+	assert.Len(t, imported["items"], 1)
+
+	t.Setenv(archivePassphraseEnv, "wrong")
+	cmd = APICmd()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"import", "entities", "--input-file", path})
+	err = cmd.Execute()
+	require.Error(t, err)
+	assert.ErrorIs(t, err, archive.ErrPassphrase)
+}
+
+func TestAPICmdEncryptRequiresFile(t *testing.T) {
+	cmd := APICmd()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"export", "entities", "--encrypt"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--encrypt requires --file")
+}
+
+func TestReadArchivePassphrasePromptsAndConfirms(t *testing.T) {
+	t.Setenv(archivePassphraseEnv, "")
+
+	cmd := APICmd()
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetIn(bytes.NewBufferString("hunter2\n"))
+	passphrase, err := readArchivePassphrase(cmd, false)
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", passphrase)
+
+	cmd.SetIn(bytes.NewBufferString("same\nsame\n"))
+	passphrase, err = readArchivePassphrase(cmd, true)
+	require.NoError(t, err)
+	assert.Equal(t, "same", passphrase)
+
+	cmd.SetIn(bytes.NewBufferString("one\ntwo\n"))
+	_, err = readArchivePassphrase(cmd, true)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "do not match")
+}
@@ -0,0 +1,155 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gravitrone/nebula-core/cli/internal/filecheck"
+)
+
+// FilesCmd returns the `nebula files` maintenance command group.
+func FilesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "files",
+		Short: "Maintain file records",
+	}
+	cmd.AddCommand(filesVerifyCmd())
+	return cmd
+}
+
+// filesVerifyCmd returns `nebula files verify`.
+func filesVerifyCmd() *cobra.Command {
+	var (
+		reregister bool
+		all        bool
+	)
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Recompute checksums of local files and report drift",
+		Long: strings.TrimSpace(`Hash every file record whose path is on this machine and compare it with
+the stored checksum. Stored checksums may name their algorithm ("sha256:...",
+"md5:...") or be a bare digest; records without one are hashed with sha256.
+Records on remote storage are skipped. With --reregister, drifted records get
+the new checksum and size. Exits non-zero while drifted, missing, or
+unreadable files remain.`),
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, _ []string) error {
+			client, err := loadCommandClient(true)
+			if err != nil {
+				return err
+			}
+			files, err := filecheck.Fetch(client)
+			if err != nil {
+				return fmt.Errorf("load files: %w", err)
+			}
+			results := filecheck.VerifyAll(files)
+			out := command.OutOrStdout()
+			writeVerifyReport(out, results, all)
+
+			counts := filecheck.Counts(results)
+			if reregister && counts[filecheck.StatusDrift] > 0 {
+				updated := 0
+				for _, result := range results {
+					if !result.Drifted() {
+						continue
+					}
+					if err := filecheck.Reregister(client, result); err != nil {
+						return fmt.Errorf("re-register %s: %w", result.File.ID, err)
+					}
+					updated++
+				}
+				counts[filecheck.StatusDrift] -= updated
+				if _, err := fmt.Fprintf(out, "\nre-registered %s\n", countNoun(updated, "file", "files")); err != nil {
+					return err
+				}
+			}
+			if failed := counts[filecheck.StatusDrift] + counts[filecheck.StatusMissing] + counts[filecheck.StatusError]; failed > 0 {
+				return fmt.Errorf("file integrity check failed: %s", countNoun(failed, "problem", "problems"))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&reregister, "reregister", false, "store the new checksum and size on drifted records")
+	cmd.Flags().BoolVar(&all, "all", false, "list every checked file, not only problems")
+	return cmd
+}
+
+// verifyStatusOrder is the order statuses appear in the summary line.
+var verifyStatusOrder = []filecheck.Status{
+	filecheck.StatusOK,
+	filecheck.StatusDrift,
+	filecheck.StatusMissing,
+	filecheck.StatusError,
+	filecheck.StatusUnchecked,
+	filecheck.StatusRemote,
+}
+
+// writeVerifyReport prints a summary line and a table of the files that need
+// attention, or of every local file with all.
+func writeVerifyReport(out io.Writer, results []filecheck.Result, all bool) {
+	if len(results) == 0 {
+		_, _ = fmt.Fprintln(out, "no file records found")
+		return
+	}
+	counts := filecheck.Counts(results)
+	parts := make([]string, 0, len(verifyStatusOrder))
+	for _, status := range verifyStatusOrder {
+		if n := counts[status]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", n, status))
+		}
+	}
+	_, _ = fmt.Fprintf(out, "checked %s: %s\n",
+		countNoun(len(results), "file", "files"), strings.Join(parts, ", "))
+
+	printed := false
+	for _, result := range results {
+		switch result.Status {
+		case filecheck.StatusRemote:
+			continue
+		case filecheck.StatusOK, filecheck.StatusUnchecked:
+			if !all {
+				continue
+			}
+		}
+		if !printed {
+			_, _ = fmt.Fprintf(out, "\n%-9s  %-36s  %-24s  %s\n", "STATUS", "ID", "FILENAME", "DETAIL")
+			printed = true
+		}
+		_, _ = fmt.Fprintf(out, "%-9s  %-36s  %-24s  %s\n",
+			result.Status, result.File.ID, clampVerifyCell(result.File.Filename, 24), verifyDetail(result))
+	}
+}
+
+// verifyDetail explains a result in one line.
+func verifyDetail(result filecheck.Result) string {
+	switch result.Status {
+	case filecheck.StatusDrift:
+		_, stored := filecheck.ParseChecksum(result.Stored)
+		return fmt.Sprintf("%s %s -> %s  %s", result.Algorithm, shortDigest(stored), shortDigest(result.Actual), result.Path)
+	case filecheck.StatusMissing:
+		return "not found at " + result.Path
+	case filecheck.StatusError:
+		return result.Err.Error()
+	}
+	return result.Path
+}
+
+// shortDigest trims a hex digest for display.
+func shortDigest(digest string) string {
+	if len(digest) > 12 {
+		return digest[:12]
+	}
+	return digest
+}
+
+// clampVerifyCell shortens a table cell to width runes.
+func clampVerifyCell(value string, width int) string {
+	runes := []rune(strings.TrimSpace(value))
+	if len(runes) <= width {
+		return string(runes)
+	}
+	return string(runes[:width-1]) + "…"
+}
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+func TestFilesVerifyReportsDriftAndReregisters(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	require.NoError(t, (&config.Config{APIKey: "nbl_test", Username: "alxx"}).Save())
+
+	dir := t.TempDir()
+	good := filepath.Join(dir, "good.txt")
+	changed := filepath.Join(dir, "changed.txt")
+	require.NoError(t, os.WriteFile(good, []byte("hello"), 0o600))
+	require.NoError(t, os.WriteFile(changed, []byte("hello, edited"), 0o600))
+	const helloSHA256 = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+
+	files := []map[string]any{
+		{"id": "f-good", "filename": "good.txt", "file_path": good, "checksum": "sha256:" + helloSHA256},
+		{"id": "f-drift", "filename": "changed.txt", "file_path": changed, "checksum": "sha256:" + helloSHA256},
+		{"id": "f-remote", "filename": "remote.txt", "uri": "s3://bucket/remote.txt", "checksum": helloSHA256},
+	}
+	var mu sync.Mutex
+	patches := map[string]map[string]any{}
+	shutdown := startDefaultAPIBaseServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			mu.Lock()
+			patches[r.URL.Path] = body
+			mu.Unlock()
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "f-drift"}}))
+			return
+		}
+		assert.Equal(t, "/api/files", r.URL.Path)
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": files}))
+	}))
+	defer shutdown()
+
+	run := func(args ...string) (string, error) {
+		var out bytes.Buffer
+		cmd := FilesCmd()
+		cmd.SetOut(&out)
+		cmd.SetErr(&out)
+		cmd.SetArgs(append([]string{"verify"}, args...))
+		err := cmd.Execute()
+		return out.String(), err
+	}
+
+	report, err := run()
+	require.ErrorContains(t, err, "1 problem")
+	assert.Contains(t, report, "checked 3 files: 1 ok, 1 drift, 1 remote")
+	assert.Contains(t, report, "f-drift")
+	assert.Contains(t, report, "sha256 2cf24dba5fb0 ->")
+	assert.NotContains(t, report, "f-good")
+	assert.NotContains(t, report, "f-remote")
+	assert.Empty(t, patches)
+
+	report, err = run("--all")
+	require.Error(t, err)
+	assert.Contains(t, report, "f-good")
+
+	report, err = run("--reregister")
+	require.NoError(t, err)
+	assert.Contains(t, report, "re-registered 1 file")
+	require.Contains(t, patches, "/api/files/f-drift")
+	assert.Equal(t, float64(len("hello, edited")), patches["/api/files/f-drift"]["size_bytes"])
+	assert.Regexp(t, `^sha256:[0-9a-f]{64}$`, patches["/api/files/f-drift"]["checksum"])
+	assert.NotEqual(t, "sha256:"+helloSHA256, patches["/api/files/f-drift"]["checksum"])
+}
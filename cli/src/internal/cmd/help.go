@@ -146,19 +146,84 @@ func helpRecipeCatalog() map[string][]string {
 			"nebula doctor --output json",
 			"nebula doctor --plain",
 		},
+		"nebula completion": {
+			"source <(nebula completion bash)",
+			"nebula completion zsh > \"${fpath[1]}/_nebula\"",
+			"nebula completion fish > ~/.config/fish/completions/nebula.fish",
+		},
+		"nebula context": {
+			"nebula context <entity-id> --depth 2 | pbcopy",
+			"nebula context <entity-id> --format json --max-tokens 8000",
+			"nebula context <entity-id> --exclude-scope personal",
+		},
+		"nebula open": {
+			"nebula open entity/<entity-id>",
+			"nebula open nebula://approval/<approval-id>",
+			"nebula open --register",
+		},
+		"nebula proxy": {
+			"nebula proxy tokens create scout --scopes public --allow 'read_*,create_context'",
+			"nebula proxy --listen 127.0.0.1:8766",
+			"curl -H \"Authorization: Bearer nbp_...\" http://127.0.0.1:8766/api/entities",
+		},
+		"nebula sync": {
+			"nebula sync",
+			"nebula sync --once",
+			"nebula sync --interval 15m",
+			"nebula sync notion --database <database-id> --scope internal=private",
+			"nebula sync confluence --space ENG --on-conflict remote --dry-run",
+		},
+		"nebula ingest": {
+			"nebula ingest git .",
+			"nebula ingest git ~/code/nebula --days 30 --scope work --dry-run",
+			"nebula ingest mbox ~/mail/decisions.mbox --subject \"RFC\" --since 2026-01-01",
+			"nebula ingest mbox --imap imaps://me@imap.example.com/Decisions --scope work",
+		},
+		"nebula report": {
+			"nebula report weekly",
+			"nebula report weekly --format html -o weekly.html",
+			"nebula report weekly --init-template",
+		},
+		"nebula knowledge": {
+			"nebula knowledge dedupe --dry-run",
+			"nebula knowledge dedupe",
+		},
+		"nebula jobs": {
+			"nebula jobs create --template weekly-report --param week=42",
+			"nebula jobs cancel <job-id> --reason \"superseded by v2\"",
+			"nebula jobs retry <job-id>",
+			"nebula jobs create --title \"Renew cert\" --due 2026-11-01",
+			"nebula jobs ics --days 14 -o ~/calendars/nebula.ics",
+		},
+		"nebula files": {
+			"nebula files verify",
+			"nebula files verify --all",
+			"nebula files verify --reregister",
+		},
+		"nebula update": {
+			"nebula update --check",
+			"nebula update",
+		},
+		"nebula plugins": {
+			"nebula plugins list",
+			"nebula api entities get <id> | nebula plugins <name>",
+		},
 		"nebula api": {
 			"nebula api entities query --param limit=5 --output json",
 			"nebula api approvals diff <approval-id> --only changed --output table",
 			"nebula api approvals diff <approval-id> --only section=metadata --max-lines 4 --plain",
+			"nebula api approvals comment <approval-id> --body \"Why merge these two?\"",
 		},
 		"nebula api entities": {
 			"nebula api entities query --param limit=10 --output table",
 			"nebula api entities create --input-file ./entity.json --output json",
+			"nebula api entities create --template meeting --input '{\"name\":\"Weekly sync\"}'",
 			"nebula api entities search --input '{\"board\":\"nebula-core\"}' --plain",
 		},
 		"nebula api context": {
 			"nebula api context query --param limit=10 --output table",
 			"nebula api context create --input-file ./context.json --output json",
+			"nebula api context create --template paper --input '{\"title\":\"Attention\"}'",
 			"nebula api context link <context-id> --entity-id <entity-id>",
 		},
 		"nebula api relationships": {
@@ -205,11 +270,27 @@ func helpRecipeCatalog() map[string][]string {
 			"nebula api audit query --param limit=50 --output table",
 			"nebula api audit scopes",
 			"nebula api audit actors --output json",
+			"nebula api audit export --since 2026-01-01 --until 2026-03-31 --file ./audit-q1.jsonl",
+			"nebula api audit verify ./audit-q1.jsonl",
 		},
 		"nebula api taxonomy": {
 			"nebula api taxonomy list scopes --limit 50 --output table",
 			"nebula api taxonomy create scopes --input-file ./scope.json",
 			"nebula api taxonomy update scopes <id> --input '{\"description\":\"updated\"}'",
+			"nebula api taxonomy update entity-types <id> --input '{\"value_schema\":{\"required\":[\"owner\"],\"properties\":{\"stage\":{\"type\":\"string\",\"enum\":[\"idea\",\"done\"]}}}}'",
+			"nebula api taxonomy merge-scope <source-id> <target-id>",
+		},
+		"nebula api tags": {
+			"nebula api tags list --search infra --output table",
+			"nebula api tags rename infra infrastructure",
+			"nebula api tags merge ops infrastructure",
+			"nebula api tags delete-unused",
+		},
+		"nebula api collections": {
+			"nebula api collections create \"Q3 launch\" --description \"launch plan and owners\"",
+			"nebula api collections add <collection-id> <entity-id> <entity-id>",
+			"nebula api entities query --param collection_id=<collection-id> --param search_text=roadmap",
+			"nebula api collections export <collection-id> --file q3-launch.json",
 		},
 		"nebula api search": {
 			"nebula api search semantic --query \"approval diff\" --limit 10",
@@ -217,10 +298,18 @@ func helpRecipeCatalog() map[string][]string {
 		"nebula api import": {
 			"nebula api import entities --input-file ./entities.json",
 			"nebula api import jobs --input-file ./jobs.json",
+			"nebula api import entities --input-file ./entities.nebx",
+			"nebula api import context --input-file ./notes.json --dry-run",
 		},
 		"nebula api export": {
 			"nebula api export entities --param limit=100 --output json",
 			"nebula api export snapshot --param format=json --plain",
+			"nebula api export entities --file ./entities.nebx --encrypt",
+		},
+		"nebula profile": {
+			"nebula profile add staging --api-url https://staging.example.com",
+			"nebula --profile staging login",
+			"nebula profile switch staging",
 		},
 		"nebula start": {
 			"nebula doctor",
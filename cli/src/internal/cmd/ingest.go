@@ -0,0 +1,234 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/gitingest"
+)
+
+// IngestCmd returns the `nebula ingest` command group.
+func IngestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ingest",
+		Short: "Pull project context from repositories and email into Nebula",
+	}
+	cmd.AddCommand(ingestGitCmd(), ingestMboxCmd())
+	return cmd
+}
+
+// ingestGitCmd returns `nebula ingest git`.
+func ingestGitCmd() *cobra.Command {
+	var (
+		days         int
+		contributors int
+		minCommits   int
+		commits      int
+		minLines     int
+		scopes       []string
+		excludeFile  string
+		dryRun       bool
+	)
+	cmd := &cobra.Command{
+		Use:   "git [path]",
+		Short: "Create or update entities and knowledge for a git repository",
+		Long: strings.TrimSpace(`Read a local git repository and write a project entity for it, person
+entities for its key contributors (linked with contributes-to), and a
+knowledge item for each recent notable commit: releases, breaking changes,
+features, and large changes (linked to the repo with about and to the author
+with created-by). Records are keyed by external ID, so re-running updates
+them instead of creating copies.
+
+Bots, generated files, and noisy commits are left out through the exclusion
+config, .nebula-ingest.yaml at the repo root by default:
+
+  exclude_authors: ["dependabot*", "*[bot]*"]
+  exclude_paths: ["vendor/", "*.lock"]
+  exclude_subjects: ["^chore", "^Merge"]
+
+Use --dry-run to see what would be written.`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			dir := "."
+			if len(args) == 1 {
+				dir = args[0]
+			}
+			if days < 0 || contributors < 0 || commits < 0 {
+				return fmt.Errorf("--days, --contributors, and --commits must not be negative")
+			}
+			root, err := gitingest.Root(dir)
+			if err != nil {
+				return err
+			}
+			if strings.TrimSpace(excludeFile) == "" {
+				excludeFile = filepath.Join(root, gitingest.ExclusionsFile)
+			}
+			exclusions, err := gitingest.LoadExclusions(excludeFile)
+			if err != nil {
+				return err
+			}
+			opts := gitingest.Options{
+				Contributors: contributors,
+				MinCommits:   minCommits,
+				Commits:      commits,
+				MinLines:     minLines,
+				Exclude:      exclusions,
+			}
+			if days > 0 {
+				opts.Since = time.Now().AddDate(0, 0, -days)
+			}
+			repo, err := gitingest.Scan(root, opts)
+			if err != nil {
+				return err
+			}
+			client, err := loadCommandClient(true)
+			if err != nil {
+				return err
+			}
+			out := command.OutOrStdout()
+			writeIngestScan(out, repo)
+			if dryRun {
+				return dryRunGitIngest(out, client, repo, scopes)
+			}
+			return runGitIngest(out, client, repo, scopes)
+		},
+	}
+	cmd.Flags().IntVar(&days, "days", 90, "consider commits from this many days back for knowledge (0 for all)")
+	cmd.Flags().IntVar(&contributors, "contributors", 10, "most key contributors to record")
+	cmd.Flags().IntVar(&minCommits, "min-commits", 3, "fewest commits that make a key contributor")
+	cmd.Flags().IntVar(&commits, "commits", 20, "most notable commits to record")
+	cmd.Flags().IntVar(&minLines, "min-lines", 300, "changed lines that make a commit notable on size alone")
+	cmd.Flags().StringSliceVar(&scopes, "scope", []string{"public"}, "scopes for the written records")
+	cmd.Flags().StringVar(&excludeFile, "exclude-file", "", "exclusion config (default <repo>/"+gitingest.ExclusionsFile+")")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "validate and report without writing")
+	return cmd
+}
+
+// writeIngestScan prints what the scan found.
+func writeIngestScan(out io.Writer, repo *gitingest.Repo) {
+	_, _ = fmt.Fprintf(out, "%s (%s @ %s): %s, %s\n", repo.Name, repo.Branch, shortCommit(repo.Head),
+		countNoun(len(repo.Contributors), "key contributor", "key contributors"),
+		countNoun(len(repo.Notable), "notable commit", "notable commits"))
+	for _, c := range repo.Contributors {
+		_, _ = fmt.Fprintf(out, "  contributor  %-24s %s (%s)\n", c.Name, c.Email, countNoun(c.Commits, "commit", "commits"))
+	}
+	for _, c := range repo.Notable {
+		_, _ = fmt.Fprintf(out, "  commit       %s %s  [%s]\n", shortCommit(c.Hash), c.Subject, c.Reason)
+	}
+}
+
+// dryRunGitIngest validates the entity and knowledge rows and reports what
+// the import would do. Relationships need the record IDs, so they are only
+// counted.
+func dryRunGitIngest(out io.Writer, client *api.Client, repo *gitingest.Repo, scopes []string) error {
+	steps := []struct {
+		label    string
+		resource string
+		items    []map[string]any
+	}{
+		{"entities", "entities", repo.EntityItems(scopes)},
+		{"knowledge", "context", repo.ContextItems(scopes)},
+	}
+	for _, step := range steps {
+		if len(step.items) == 0 {
+			continue
+		}
+		report, err := client.ValidateImport(step.resource, api.BulkImportRequest{Format: "json", Items: step.items})
+		if err != nil {
+			return fmt.Errorf("validate %s: %w", step.label, err)
+		}
+		_, _ = fmt.Fprintf(out, "dry run %s: %d to create, %d to update, %d invalid\n", step.label,
+			report.Counts["create"], report.Counts["update"], report.Counts["invalid"])
+		for _, row := range report.Rows {
+			for _, msg := range row.Errors {
+				_, _ = fmt.Fprintf(out, "  row %d %s: %s\n", row.Row, row.Label, msg)
+			}
+		}
+	}
+	links := len(repo.Contributors) + 2*len(repo.Notable)
+	_, _ = fmt.Fprintf(out, "dry run relationships: up to %d\n", links)
+	return nil
+}
+
+// runGitIngest imports entities, then knowledge, then the relationships
+// between them, using the IDs the first two imports return.
+func runGitIngest(out io.Writer, client *api.Client, repo *gitingest.Repo, scopes []string) error {
+	ids := map[string]string{}
+	entityItems := repo.EntityItems(scopes)
+	result, err := client.ImportEntities(api.BulkImportRequest{Format: "json", Items: entityItems})
+	if err != nil {
+		return fmt.Errorf("import entities: %w", err)
+	}
+	failed := writeIngestResult(out, "entities", result)
+	collectImportIDs(entityItems, result, ids)
+
+	if items := repo.ContextItems(scopes); len(items) > 0 {
+		result, err := client.ImportContext(api.BulkImportRequest{Format: "json", Items: items})
+		if err != nil {
+			return fmt.Errorf("import knowledge: %w", err)
+		}
+		failed += writeIngestResult(out, "knowledge", result)
+		collectImportIDs(items, result, ids)
+	}
+
+	if items := repo.RelationshipItems(ids); len(items) > 0 {
+		result, err := client.ImportRelationships(api.BulkImportRequest{Format: "json", Items: items})
+		if err != nil {
+			return fmt.Errorf("import relationships: %w", err)
+		}
+		failed += writeIngestResult(out, "relationships", result)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%s failed to import", countNoun(failed, "record", "records"))
+	}
+	return nil
+}
+
+// writeIngestResult prints one import summary and its errors, returning the
+// failure count.
+func writeIngestResult(out io.Writer, label string, result *api.BulkImportResult) int {
+	_, _ = fmt.Fprintf(out, "%s: %d created, %d updated, %d failed\n", label, result.Created, result.Updated, result.Failed)
+	for _, e := range result.Errors {
+		_, _ = fmt.Fprintf(out, "  row %d: %s\n", e.Row, e.Error)
+	}
+	return result.Failed
+}
+
+// collectImportIDs maps the external IDs of imported rows to the IDs of the
+// records they wrote. The result lists written records in row order and
+// leaves failed rows out.
+func collectImportIDs(items []map[string]any, result *api.BulkImportResult, ids map[string]string) {
+	failedRows := map[int]bool{}
+	for _, e := range result.Errors {
+		failedRows[e.Row] = true
+	}
+	written := 0
+	for i, item := range items {
+		if failedRows[i+1] {
+			continue
+		}
+		if written >= len(result.Items) {
+			return
+		}
+		record := result.Items[written]
+		written++
+		externalID, _ := item["external_id"].(string)
+		if id, ok := record["id"]; ok && externalID != "" {
+			ids[externalID] = fmt.Sprint(id)
+		}
+	}
+}
+
+// shortCommit abbreviates a commit hash for display.
+func shortCommit(hash string) string {
+	if len(hash) > 7 {
+		return hash[:7]
+	}
+	return hash
+}
@@ -0,0 +1,248 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/mailingest"
+)
+
+// imapPasswordEnv holds the IMAP password so it stays out of shell history.
+const imapPasswordEnv = "NEBULA_IMAP_PASSWORD"
+
+// ingestMboxCmd returns `nebula ingest mbox`.
+func ingestMboxCmd() *cobra.Command {
+	var (
+		imapURL string
+		from    string
+		subject string
+		since   string
+		limit   int
+		scopes  []string
+		dryRun  bool
+	)
+	cmd := &cobra.Command{
+		Use:   "mbox [file]",
+		Short: "Turn selected emails into knowledge linked by thread",
+		Long: strings.TrimSpace(`Read an mbox file, or a mailbox over IMAP with --imap, and write a knowledge
+item for each selected email, carrying its sender, recipients, and date in
+metadata. Replies are linked to the message they answer with a references
+relationship, so a decision made over email keeps its whole thread.
+
+Select emails with --from, --subject, and --since. Without --scope, each
+thread is shown and its scopes are asked for; the previous answer is the
+default, and s skips the thread. Records are keyed by Message-ID, so
+re-running updates them instead of creating copies.
+
+For IMAP, the password is read from ` + imapPasswordEnv + ` or prompted for.
+An imap:// URL must upgrade with STARTTLS before the password is sent; only
+localhost may log in over plain text.`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			if (len(args) == 1) == (strings.TrimSpace(imapURL) != "") {
+				return fmt.Errorf("pass an mbox file or --imap, not both or neither")
+			}
+			filter := mailingest.Filter{From: strings.TrimSpace(from), Subject: strings.TrimSpace(subject)}
+			if strings.TrimSpace(since) != "" {
+				ts, err := parseWindowFlag("since", since, false)
+				if err != nil {
+					return err
+				}
+				filter.Since = ts
+			}
+			msgs, skipped, err := loadMail(command, args, imapURL, filter, limit)
+			if err != nil {
+				return err
+			}
+			selected := msgs[:0]
+			for _, msg := range msgs {
+				if filter.Match(msg) {
+					selected = append(selected, msg)
+				}
+			}
+			threads := mailingest.Threads(selected)
+			out := command.OutOrStdout()
+			_, _ = fmt.Fprintf(out, "%s in %s selected", countNoun(len(selected), "email", "emails"),
+				countNoun(len(threads), "thread", "threads"))
+			if skipped > 0 {
+				_, _ = fmt.Fprintf(out, " (%d unreadable)", skipped)
+			}
+			_, _ = fmt.Fprintln(out)
+			if len(threads) == 0 {
+				return nil
+			}
+			client, err := loadCommandClient(true)
+			if err != nil {
+				return err
+			}
+			plan, err := planMailScopes(command, threads, scopes)
+			if err != nil {
+				return err
+			}
+			if dryRun {
+				return dryRunMailIngest(out, client, plan)
+			}
+			return runMailIngest(out, client, plan)
+		},
+	}
+	cmd.Flags().StringVar(&imapURL, "imap", "", "read from imaps://user@host[:port]/Mailbox instead of a file")
+	cmd.Flags().StringVar(&from, "from", "", "only emails whose sender contains this text")
+	cmd.Flags().StringVar(&subject, "subject", "", "only emails whose subject contains this text")
+	cmd.Flags().StringVar(&since, "since", "", "only emails sent at or after (YYYY-MM-DD or RFC3339)")
+	cmd.Flags().IntVar(&limit, "limit", 200, "most recent IMAP messages to fetch (0 for all)")
+	cmd.Flags().StringSliceVar(&scopes, "scope", nil, "scopes for every thread (default: ask per thread)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "validate and report without writing")
+	return cmd
+}
+
+// loadMail reads and parses the messages of an mbox file or IMAP mailbox.
+func loadMail(command *cobra.Command, args []string, imapURL string, filter mailingest.Filter, limit int) ([]mailingest.Message, int, error) {
+	if len(args) == 1 {
+		return mailingest.LoadMbox(args[0])
+	}
+	cfg, err := mailingest.ParseIMAPURL(imapURL)
+	if err != nil {
+		return nil, 0, err
+	}
+	if cfg.Username == "" {
+		return nil, 0, fmt.Errorf("--imap needs a user: imaps://user@%s/%s", cfg.Addr, cfg.Mailbox)
+	}
+	if cfg.Password == "" {
+		cfg.Password = os.Getenv(imapPasswordEnv)
+	}
+	if cfg.Password == "" {
+		cfg.Password, err = promptPassphrase(command, fmt.Sprintf("IMAP password for %s: ", cfg.Username))
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	raw, err := mailingest.FetchIMAP(cfg, filter, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	msgs, skipped := mailingest.ParseAll(raw)
+	return msgs, skipped, nil
+}
+
+// mailThreadPlan is a thread and the scopes chosen for it.
+type mailThreadPlan struct {
+	thread mailingest.Thread
+	scopes []string
+}
+
+// planMailScopes assigns scopes to each thread: the --scope flag when set,
+// otherwise the answer to a prompt per thread.
+func planMailScopes(command *cobra.Command, threads []mailingest.Thread, scopes []string) ([]mailThreadPlan, error) {
+	plan := make([]mailThreadPlan, 0, len(threads))
+	if len(scopes) > 0 {
+		for _, thread := range threads {
+			plan = append(plan, mailThreadPlan{thread: thread, scopes: scopes})
+		}
+		return plan, nil
+	}
+	in := bufio.NewReader(command.InOrStdin())
+	prompt := command.ErrOrStderr()
+	current := []string{"public"}
+	for i, thread := range threads {
+		first := thread.Messages[0]
+		_, _ = fmt.Fprintf(prompt, "[%d/%d] %q: %s from %s\n", i+1, len(threads), thread.Subject,
+			countNoun(len(thread.Messages), "email", "emails"), first.From)
+		_, _ = fmt.Fprintf(prompt, "scopes [%s] (s to skip): ", strings.Join(current, ","))
+		line, err := in.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return nil, fmt.Errorf("read scopes: %w", err)
+		}
+		if err == io.EOF && line == "" {
+			return nil, fmt.Errorf("read scopes: no answer for thread %q", thread.Subject)
+		}
+		answer := strings.TrimSpace(line)
+		if strings.EqualFold(answer, "s") {
+			continue
+		}
+		if answer != "" {
+			current = current[:0:0]
+			for _, scope := range strings.Split(answer, ",") {
+				if scope = strings.TrimSpace(scope); scope != "" {
+					current = append(current, scope)
+				}
+			}
+		}
+		plan = append(plan, mailThreadPlan{thread: thread, scopes: current})
+	}
+	return plan, nil
+}
+
+// mailPlanItems returns the knowledge rows of every planned thread.
+func mailPlanItems(plan []mailThreadPlan) []map[string]any {
+	var items []map[string]any
+	for _, p := range plan {
+		items = append(items, p.thread.ContextItems(p.scopes)...)
+	}
+	return items
+}
+
+// dryRunMailIngest validates the knowledge rows and reports what the import
+// would do. Reply links need the record IDs, so they are only counted.
+func dryRunMailIngest(out io.Writer, client *api.Client, plan []mailThreadPlan) error {
+	items := mailPlanItems(plan)
+	if len(items) == 0 {
+		_, _ = fmt.Fprintln(out, "no threads selected")
+		return nil
+	}
+	report, err := client.ValidateImport("context", api.BulkImportRequest{Format: "json", Items: items})
+	if err != nil {
+		return fmt.Errorf("validate knowledge: %w", err)
+	}
+	_, _ = fmt.Fprintf(out, "dry run knowledge: %d to create, %d to update, %d invalid\n",
+		report.Counts["create"], report.Counts["update"], report.Counts["invalid"])
+	for _, row := range report.Rows {
+		for _, msg := range row.Errors {
+			_, _ = fmt.Fprintf(out, "  row %d %s: %s\n", row.Row, row.Label, msg)
+		}
+	}
+	replies := 0
+	for _, p := range plan {
+		replies += len(p.thread.Messages) - 1
+	}
+	_, _ = fmt.Fprintf(out, "dry run relationships: up to %d\n", replies)
+	return nil
+}
+
+// runMailIngest imports the knowledge rows, then links replies to their
+// parents using the IDs the import returned.
+func runMailIngest(out io.Writer, client *api.Client, plan []mailThreadPlan) error {
+	items := mailPlanItems(plan)
+	if len(items) == 0 {
+		_, _ = fmt.Fprintln(out, "no threads selected")
+		return nil
+	}
+	result, err := client.ImportContext(api.BulkImportRequest{Format: "json", Items: items})
+	if err != nil {
+		return fmt.Errorf("import knowledge: %w", err)
+	}
+	failed := writeIngestResult(out, "knowledge", result)
+	ids := map[string]string{}
+	collectImportIDs(items, result, ids)
+
+	threads := make([]mailingest.Thread, 0, len(plan))
+	for _, p := range plan {
+		threads = append(threads, p.thread)
+	}
+	if links := mailingest.RelationshipItems(threads, ids); len(links) > 0 {
+		result, err := client.ImportRelationships(api.BulkImportRequest{Format: "json", Items: links})
+		if err != nil {
+			return fmt.Errorf("import relationships: %w", err)
+		}
+		failed += writeIngestResult(out, "relationships", result)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%s failed to import", countNoun(failed, "record", "records"))
+	}
+	return nil
+}
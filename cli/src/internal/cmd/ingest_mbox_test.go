@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+const ingestTestMbox = `From ada@example.com Mon Mar  2 09:00:00 2026
+Message-ID: <root@example.com>
+From: Ada <ada@example.com>
+Date: Mon, 02 Mar 2026 09:00:00 +0000
+Subject: Decision: drop v1
+
+We drop v1 in May.
+
+From grace@example.com Mon Mar  2 10:00:00 2026
+Message-ID: <reply@example.com>
+In-Reply-To: <root@example.com>
+From: Grace <grace@example.com>
+Date: Mon, 02 Mar 2026 10:00:00 +0000
+Subject: Re: Decision: drop v1
+
+Agreed.
+
+From bob@example.com Tue Mar  3 08:00:00 2026
+Message-ID: <lunch@example.com>
+From: Bob <bob@example.com>
+Date: Tue, 03 Mar 2026 08:00:00 +0000
+Subject: Lunch?
+
+Tacos?
+`
+
+func TestIngestMboxPromptsForScopesAndLinksReplies(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	require.NoError(t, (&config.Config{APIKey: "nbl_test", Username: "alxx"}).Save())
+	path := filepath.Join(t.TempDir(), "mail.mbox")
+	require.NoError(t, os.WriteFile(path, []byte(ingestTestMbox), 0o644))
+
+	bodies := map[string]map[string]any{}
+	shutdown := startDefaultAPIBaseServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		resource := strings.TrimPrefix(r.URL.Path, "/api/import/")
+		bodies[resource] = body
+		items, _ := body["items"].([]any)
+		written := make([]map[string]any, 0, len(items))
+		for i := range items {
+			written = append(written, map[string]any{"id": fmt.Sprintf("%s-%d", resource, i+1)})
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+			"created": len(items), "items": written,
+		}}))
+	}))
+	defer shutdown()
+
+	var out, prompts bytes.Buffer
+	cmd := IngestCmd()
+	cmd.SetOut(&out)
+	cmd.SetErr(&prompts)
+	cmd.SetIn(strings.NewReader("work, decisions\ns\n"))
+	cmd.SetArgs([]string{"mbox", path})
+	require.NoError(t, cmd.Execute())
+
+	assert.Contains(t, out.String(), "3 emails in 2 threads selected")
+	assert.Contains(t, out.String(), "knowledge: 2 created, 0 updated, 0 failed")
+	assert.Contains(t, out.String(), "relationships: 1 created")
+	assert.Contains(t, prompts.String(), `[1/2] "Decision: drop v1": 2 emails from Ada <ada@example.com>`)
+	assert.Contains(t, prompts.String(), "scopes [work,decisions] (s to skip): ")
+
+	items := bodies["context"]["items"].([]any)
+	require.Len(t, items, 2)
+	first := items[0].(map[string]any)
+	assert.Equal(t, "mail:root@example.com", first["external_id"])
+	assert.Equal(t, []any{"work", "decisions"}, first["scopes"])
+
+	link := bodies["relationships"]["items"].([]any)[0].(map[string]any)
+	assert.Equal(t, "context-2", link["source_id"])
+	assert.Equal(t, "context-1", link["target_id"])
+}
+
+func TestIngestMboxFiltersAndValidatesInput(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	path := filepath.Join(t.TempDir(), "mail.mbox")
+	require.NoError(t, os.WriteFile(path, []byte(ingestTestMbox), 0o644))
+
+	run := func(args ...string) (string, error) {
+		var out bytes.Buffer
+		cmd := IngestCmd()
+		cmd.SetOut(&out)
+		cmd.SetErr(&out)
+		cmd.SetArgs(append([]string{"mbox"}, args...))
+		err := cmd.Execute()
+		return out.String(), err
+	}
+
+	// Nothing selected, so no client is needed.
+	out, err := run(path, "--from", "nobody")
+	require.NoError(t, err)
+	assert.Contains(t, out, "0 emails in 0 threads selected")
+
+	_, err = run()
+	assert.ErrorContains(t, err, "pass an mbox file or --imap")
+	_, err = run(path, "--imap", "imaps://me@imap.example.com")
+	assert.ErrorContains(t, err, "pass an mbox file or --imap")
+	_, err = run(path, "--since", "March")
+	assert.ErrorContains(t, err, "invalid --since")
+	_, err = run("--imap", "imaps://imap.example.com/INBOX")
+	assert.ErrorContains(t, err, "--imap needs a user")
+}
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+// ingestTestRepo creates a repository with one contributor and one feature
+// commit.
+func ingestTestRepo(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	for _, args := range [][]string{
+		{"init", "-q", "-b", "main"},
+		{"remote", "add", "origin", "https://github.com/acme/widgets.git"},
+		{"commit", "-q", "--allow-empty", "-m", "Initial commit"},
+		{"commit", "-q", "--allow-empty", "-m", "feat: add scheduler"},
+	} {
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		cmd.Env = append(os.Environ(), "GIT_CONFIG_GLOBAL=/dev/null", "GIT_CONFIG_SYSTEM=/dev/null",
+			"GIT_AUTHOR_NAME=Ada", "GIT_AUTHOR_EMAIL=ada@example.com",
+			"GIT_COMMITTER_NAME=Ada", "GIT_COMMITTER_EMAIL=ada@example.com")
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	return dir
+}
+
+func TestIngestGitImportsEntitiesKnowledgeAndLinks(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	require.NoError(t, (&config.Config{APIKey: "nbl_test", Username: "alxx"}).Save())
+	dir := ingestTestRepo(t)
+
+	bodies := map[string]map[string]any{}
+	shutdown := startDefaultAPIBaseServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		resource := strings.TrimPrefix(r.URL.Path, "/api/import/")
+		bodies[resource] = body
+		items, _ := body["items"].([]any)
+		if strings.HasSuffix(resource, "/validate") {
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+				"total": len(items), "counts": map[string]int{"create": len(items)}, "rows": []any{},
+			}}))
+			return
+		}
+		written := make([]map[string]any, 0, len(items))
+		for i := range items {
+			written = append(written, map[string]any{"id": fmt.Sprintf("%s-%d", resource, i+1)})
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+			"created": len(items), "items": written,
+		}}))
+	}))
+	defer shutdown()
+
+	run := func(args ...string) string {
+		var out bytes.Buffer
+		cmd := IngestCmd()
+		cmd.SetOut(&out)
+		cmd.SetErr(&out)
+		cmd.SetArgs(append([]string{"git", dir, "--min-commits", "1"}, args...))
+		require.NoError(t, cmd.Execute())
+		return out.String()
+	}
+
+	out := run("--dry-run")
+	assert.Contains(t, out, "acme/widgets (main @ ")
+	assert.Contains(t, out, "1 key contributor, 1 notable commit")
+	assert.Contains(t, out, "dry run entities: 2 to create")
+	assert.Contains(t, out, "dry run knowledge: 1 to create")
+	assert.NotContains(t, bodies, "entities")
+
+	out = run("--scope", "work")
+	assert.Contains(t, out, "entities: 2 created, 0 updated, 0 failed")
+	assert.Contains(t, out, "knowledge: 1 created")
+	assert.Contains(t, out, "relationships: 3 created")
+
+	entities := bodies["entities"]["items"].([]any)
+	repo := entities[0].(map[string]any)
+	assert.Equal(t, "git:github.com/acme/widgets", repo["external_id"])
+	assert.Equal(t, "project", repo["type"])
+	assert.Equal(t, []any{"work"}, repo["scopes"])
+	assert.Equal(t, "git:person:ada@example.com", entities[1].(map[string]any)["external_id"])
+
+	links := bodies["relationships"]["items"].([]any)
+	contributes := links[0].(map[string]any)
+	assert.Equal(t, "entities-2", contributes["source_id"])
+	assert.Equal(t, "entities-1", contributes["target_id"])
+	about := links[1].(map[string]any)
+	assert.Equal(t, "context-1", about["source_id"])
+	assert.Equal(t, "about", about["relationship_type"])
+}
+
+func TestIngestGitReadsExclusionFile(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	require.NoError(t, (&config.Config{APIKey: "nbl_test", Username: "alxx"}).Save())
+	dir := ingestTestRepo(t)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".nebula-ingest.yaml"), []byte("exclude_subjects: [\"(\"]\n"), 0o644))
+
+	cmd := IngestCmd()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"git", dir, "--dry-run"})
+	assert.ErrorContains(t, cmd.Execute(), "invalid exclude_subjects pattern")
+}
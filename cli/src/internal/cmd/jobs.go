@@ -0,0 +1,198 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+// JobsCmd returns the `nebula jobs` command group.
+func JobsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "jobs",
+		Short: "Create, cancel, retry, and export jobs",
+	}
+	cmd.AddCommand(jobsCreateCmd(), jobsCancelCmd(), jobsRetryCmd(), jobsICSCmd())
+	return cmd
+}
+
+// jobsCreateCmd returns `nebula jobs create`.
+func jobsCreateCmd() *cobra.Command {
+	var (
+		templateName string
+		rawParams    []string
+		title        string
+		description  string
+		priority     string
+		assignee     string
+		due          string
+	)
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a job, optionally from a config job template",
+		Long: strings.TrimSpace(`Create a pending job. With --template, the named job template from the CLI
+config fills the title, description, priority, assignee, metadata, and
+subtasks, and each --param key=value fills its {{key}} placeholders. Flags
+given explicitly override the template.`),
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, _ []string) error {
+			params, err := parseQueryParams(rawParams)
+			if err != nil {
+				return err
+			}
+			input := api.CreateJobInput{Status: "pending"}
+			var subtasks []string
+			if strings.TrimSpace(templateName) != "" {
+				tmpl, err := loadCommandTemplate(templateName, config.TemplateKindJob)
+				if err != nil {
+					return err
+				}
+				values, err := tmpl.ResolveParams(params)
+				if err != nil {
+					return err
+				}
+				subtasks = applyJobTemplate(&input, tmpl.Expand(values))
+			} else if len(params) > 0 {
+				return fmt.Errorf("--param requires --template")
+			}
+			if value := strings.TrimSpace(title); value != "" {
+				input.Title = value
+			}
+			if value := strings.TrimSpace(description); value != "" {
+				input.Description = value
+			}
+			if value := strings.TrimSpace(priority); value != "" {
+				input.Priority = strings.ToLower(value)
+			}
+			if value := strings.TrimSpace(assignee); value != "" {
+				input.AssignedTo = value
+			}
+			if strings.TrimSpace(due) != "" {
+				value, err := parseDueFlag(due)
+				if err != nil {
+					return err
+				}
+				input.DueAt = value
+			}
+			if strings.TrimSpace(input.Title) == "" {
+				return fmt.Errorf("--title is required without a template title")
+			}
+
+			client, err := loadCommandClient(true)
+			if err != nil {
+				return err
+			}
+			job, err := client.CreateJob(input)
+			if err != nil {
+				return fmt.Errorf("create job: %w", err)
+			}
+			out := command.OutOrStdout()
+			if _, err := fmt.Fprintln(out, job.ID); err != nil {
+				return err
+			}
+			for _, subtask := range subtasks {
+				if _, err := client.CreateSubtask(job.ID, map[string]string{"title": subtask}); err != nil {
+					return fmt.Errorf("create subtask %q of %s: %w", subtask, job.ID, err)
+				}
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&templateName, "template", "", "job template from the CLI config")
+	cmd.Flags().StringArrayVar(&rawParams, "param", nil, "template param key=value (repeat)")
+	cmd.Flags().StringVar(&title, "title", "", "job title")
+	cmd.Flags().StringVar(&description, "description", "", "job description")
+	cmd.Flags().StringVar(&priority, "priority", "", "job priority (low/medium/high)")
+	cmd.Flags().StringVar(&assignee, "assignee", "", "assignee id or agent")
+	cmd.Flags().StringVar(&due, "due", "", "due date, YYYY-MM-DD (end of day) or RFC3339")
+	return cmd
+}
+
+// parseDueFlag normalizes a --due value to RFC3339 UTC. A bare date means the
+// end of that day in local time, as in the Jobs tab.
+func parseDueFlag(raw string) (string, error) {
+	value := strings.TrimSpace(raw)
+	if ts, err := time.Parse(time.RFC3339, value); err == nil {
+		return ts.UTC().Format(time.RFC3339), nil
+	}
+	if ts, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return ts.Add(24*time.Hour - time.Minute).UTC().Format(time.RFC3339), nil
+	}
+	return "", fmt.Errorf("invalid --due %q (expected YYYY-MM-DD or RFC3339)", raw)
+}
+
+// applyJobTemplate fills a job payload from an expanded template and returns
+// the subtasks to create under it.
+func applyJobTemplate(input *api.CreateJobInput, tmpl config.Template) []string {
+	input.Title = strings.TrimSpace(tmpl.Title)
+	input.Description = strings.TrimSpace(tmpl.Description)
+	input.Priority = strings.ToLower(strings.TrimSpace(tmpl.Priority))
+	if agent := strings.TrimSpace(tmpl.Agent); agent != "" {
+		input.JobType = "agent"
+		input.AssignedTo = agent
+	}
+	input.Metadata = tmpl.Metadata
+	return tmpl.Subtasks
+}
+
+// jobsCancelCmd returns `nebula jobs cancel`.
+func jobsCancelCmd() *cobra.Command {
+	var reason string
+	cmd := &cobra.Command{
+		Use:   "cancel <job-id>",
+		Short: "Cancel a job and record why",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			reason = strings.TrimSpace(reason)
+			if reason == "" {
+				return fmt.Errorf("--reason is required")
+			}
+			client, err := loadCommandClient(true)
+			if err != nil {
+				return err
+			}
+			id := strings.TrimSpace(args[0])
+			if _, err := client.CancelJob(id, reason); err != nil {
+				return fmt.Errorf("cancel job %s: %w", id, err)
+			}
+			_, err = fmt.Fprintf(command.OutOrStdout(), "cancelled %s: %s\n", id, reason)
+			return err
+		},
+	}
+	cmd.Flags().StringVar(&reason, "reason", "", "why the job is being cancelled (required)")
+	return cmd
+}
+
+// jobsRetryCmd returns `nebula jobs retry`.
+func jobsRetryCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "retry <job-id>",
+		Short: "Create a new job that retries an existing one",
+		Long: strings.TrimSpace(`Create a pending copy of a job. The retry keeps the title, description,
+priority, type, assignee and metadata, records retry_of and retry_attempt in
+its metadata, and is linked to the original job.`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			client, err := loadCommandClient(true)
+			if err != nil {
+				return err
+			}
+			id := strings.TrimSpace(args[0])
+			retry, err := client.RetryJob(id)
+			if retry != nil {
+				if _, werr := fmt.Fprintln(command.OutOrStdout(), retry.ID); werr != nil {
+					return werr
+				}
+			}
+			if err != nil {
+				return fmt.Errorf("retry job %s: %w", id, err)
+			}
+			return nil
+		},
+	}
+}
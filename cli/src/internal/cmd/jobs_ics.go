@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+const (
+	icsDefaultDays  = 30
+	icsJobLimit     = 100
+	icsEventLength  = 30 * time.Minute
+	icsTimestamp    = "20060102T150405Z"
+	icsLineMaxBytes = 75
+)
+
+// jobsICSCmd returns `nebula jobs ics`.
+func jobsICSCmd() *cobra.Command {
+	var days int
+	var output string
+	cmd := &cobra.Command{
+		Use:   "ics",
+		Short: "Export upcoming and overdue jobs as an iCalendar feed",
+		Long: strings.TrimSpace(`Write an iCalendar (.ics) feed of open jobs due within the next --days days,
+plus every open job already past its due date. Each job becomes a 30-minute
+event at its due time; overdue jobs are prefixed with [overdue]. Event UIDs
+are stable, so calendar apps that subscribe to the file update events in
+place. Prints to stdout unless --output is given.`),
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, _ []string) error {
+			if days < 0 {
+				return fmt.Errorf("--days must not be negative")
+			}
+			client, err := loadCommandClient(true)
+			if err != nil {
+				return err
+			}
+			now := time.Now()
+			jobs, err := client.QueryJobs(api.QueryParams{
+				"due_before": now.AddDate(0, 0, days).UTC().Format(time.RFC3339),
+				"limit":      strconv.Itoa(icsJobLimit),
+			})
+			if err != nil {
+				return fmt.Errorf("load jobs: %w", err)
+			}
+			due := dueOpenJobs(jobs)
+			if strings.TrimSpace(output) == "" {
+				return writeJobsICS(command.OutOrStdout(), due, now)
+			}
+			file, err := os.Create(output)
+			if err != nil {
+				return fmt.Errorf("create %s: %w", output, err)
+			}
+			if err := writeJobsICS(file, due, now); err != nil {
+				_ = file.Close()
+				return err
+			}
+			if err := file.Close(); err != nil {
+				return fmt.Errorf("write %s: %w", output, err)
+			}
+			_, err = fmt.Fprintf(command.ErrOrStderr(), "wrote %s to %s\n", countNoun(len(due), "job", "jobs"), output)
+			return err
+		},
+	}
+	cmd.Flags().IntVar(&days, "days", icsDefaultDays, "include jobs due within this many days")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "write the feed to this .ics file")
+	return cmd
+}
+
+// dueOpenJobs keeps open jobs with a due date, soonest first.
+func dueOpenJobs(jobs []api.Job) []api.Job {
+	out := make([]api.Job, 0, len(jobs))
+	for _, job := range jobs {
+		if job.DueAt == nil || job.DueAt.IsZero() || jobClosed(job) {
+			continue
+		}
+		out = append(out, job)
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].DueAt.Before(*out[j].DueAt) })
+	return out
+}
+
+// jobClosed reports whether a job no longer needs attention.
+func jobClosed(job api.Job) bool {
+	switch strings.ToLower(strings.TrimSpace(job.Status)) {
+	case "completed", "failed", api.JobStatusAbandoned:
+		return true
+	}
+	return false
+}
+
+// writeJobsICS writes jobs as an iCalendar feed with CRLF line endings.
+func writeJobsICS(out io.Writer, jobs []api.Job, now time.Time) error {
+	stamp := now.UTC().Format(icsTimestamp)
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Nebula//Jobs//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:Nebula jobs",
+	}
+	for _, job := range jobs {
+		due := job.DueAt.UTC()
+		summary := strings.TrimSpace(job.Title)
+		if due.Before(now) {
+			summary = "[overdue] " + summary
+		}
+		details := []string{"Status: " + job.Status}
+		if job.Priority != nil && strings.TrimSpace(*job.Priority) != "" {
+			details = append(details, "Priority: "+strings.TrimSpace(*job.Priority))
+		}
+		if job.Description != nil && strings.TrimSpace(*job.Description) != "" {
+			details = append(details, "", strings.TrimSpace(*job.Description))
+		}
+		lines = append(lines,
+			"BEGIN:VEVENT",
+			"UID:"+job.ID+"@nebula",
+			"DTSTAMP:"+stamp,
+			"DTSTART:"+due.Format(icsTimestamp),
+			"DTEND:"+due.Add(icsEventLength).Format(icsTimestamp),
+			"SUMMARY:"+icsEscape(summary),
+			"DESCRIPTION:"+icsEscape(strings.Join(details, "\n")),
+			"END:VEVENT",
+		)
+	}
+	lines = append(lines, "END:VCALENDAR")
+	var sb strings.Builder
+	for _, line := range lines {
+		sb.WriteString(icsFold(line))
+		sb.WriteString("\r\n")
+	}
+	_, err := io.WriteString(out, sb.String())
+	return err
+}
+
+// icsEscape escapes a TEXT value per RFC 5545.
+func icsEscape(text string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(text)
+}
+
+// icsFold splits a content line into 75-byte chunks joined by CRLF and a
+// space, without cutting UTF-8 sequences.
+func icsFold(line string) string {
+	if len(line) <= icsLineMaxBytes {
+		return line
+	}
+	var sb strings.Builder
+	width, limit := 0, icsLineMaxBytes
+	for _, r := range line {
+		size := utf8.RuneLen(r)
+		if width+size > limit {
+			// The leading space of a continuation line counts toward it.
+			sb.WriteString("\r\n ")
+			width, limit = 0, icsLineMaxBytes-1
+		}
+		sb.WriteRune(r)
+		width += size
+	}
+	return sb.String()
+}
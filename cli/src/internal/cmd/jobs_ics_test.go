@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+func TestJobsICSWritesOpenDueJobs(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	require.NoError(t, (&config.Config{APIKey: "nbl_test", Username: "alxx"}).Save())
+
+	now := time.Now().UTC()
+	var query string
+	shutdown := startDefaultAPIBaseServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+			{"id": "job-2", "title": "Ship release", "status": "pending", "due_at": now.Add(48 * time.Hour), "description": "Tag, build; publish"},
+			{"id": "job-1", "title": "Renew cert", "status": "in-progress", "priority": "high", "due_at": now.Add(-24 * time.Hour)},
+			{"id": "job-3", "title": "Old task", "status": "completed", "due_at": now.Add(-48 * time.Hour)},
+			{"id": "job-4", "title": "Someday", "status": "pending"},
+		}}))
+	}))
+	defer shutdown()
+
+	var out bytes.Buffer
+	cmd := JobsCmd()
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"ics", "--days", "7"})
+	require.NoError(t, cmd.Execute())
+
+	assert.Contains(t, query, "due_before=")
+	feed := out.String()
+	assert.True(t, strings.HasPrefix(feed, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(t, strings.HasSuffix(feed, "END:VCALENDAR\r\n"))
+	assert.Equal(t, 2, strings.Count(feed, "BEGIN:VEVENT"))
+	assert.Less(t, strings.Index(feed, "UID:job-1@nebula"), strings.Index(feed, "UID:job-2@nebula"))
+	assert.Contains(t, feed, "SUMMARY:[overdue] Renew cert\r\n")
+	assert.Contains(t, feed, "SUMMARY:Ship release\r\n")
+	assert.Contains(t, feed, `DESCRIPTION:Status: pending\n\nTag\, build\; publish`)
+	assert.Contains(t, feed, "DTSTART:"+now.Add(-24*time.Hour).Format(icsTimestamp))
+	assert.NotContains(t, feed, "Old task")
+	assert.NotContains(t, feed, "Someday")
+
+	path := filepath.Join(t.TempDir(), "jobs.ics")
+	out.Reset()
+	cmd = JobsCmd()
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"ics", "-o", path})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, out.String(), "wrote 2 jobs to "+path)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "SUMMARY:[overdue] Renew cert\r\n")
+}
+
+func TestICSFoldKeepsLinesUnder75Bytes(t *testing.T) {
+	line := "SUMMARY:" + strings.Repeat("é", 80)
+	folded := icsFold(line)
+	for _, part := range strings.Split(folded, "\r\n") {
+		assert.LessOrEqual(t, len(part), icsLineMaxBytes)
+	}
+	assert.Equal(t, line, strings.ReplaceAll(folded, "\r\n ", ""))
+}
+
+func TestParseDueFlag(t *testing.T) {
+	value, err := parseDueFlag("2026-11-01T09:30:00+02:00")
+	require.NoError(t, err)
+	assert.Equal(t, "2026-11-01T07:30:00Z", value)
+
+	value, err = parseDueFlag("2026-11-01")
+	require.NoError(t, err)
+	due, err := time.Parse(time.RFC3339, value)
+	require.NoError(t, err)
+	assert.Equal(t, "2026-11-01 23:59", due.Local().Format("2006-01-02 15:04"))
+
+	_, err = parseDueFlag("next week")
+	assert.ErrorContains(t, err, `invalid --due "next week"`)
+}
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+func TestJobsCancelAndRetry(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	require.NoError(t, (&config.Config{APIKey: "nbl_test", Username: "alxx"}).Save())
+
+	var statusBody map[string]any
+	var created map[string]any
+	shutdown := startDefaultAPIBaseServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/jobs/job-1/status" && r.Method == http.MethodPatch:
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&statusBody))
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "job-1"}}))
+		case r.URL.Path == "/api/jobs/job-1" && r.Method == http.MethodGet:
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+				"id": "job-1", "title": "Nightly import", "status": "failed",
+			}}))
+		case r.URL.Path == "/api/jobs" && r.Method == http.MethodPost:
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "job-2", "title": "Nightly import"}}))
+		case r.URL.Path == "/api/relationships" && r.Method == http.MethodPost:
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "rel-1"}}))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer shutdown()
+
+	run := func(args ...string) (string, error) {
+		var out bytes.Buffer
+		cmd := JobsCmd()
+		cmd.SetOut(&out)
+		cmd.SetErr(&out)
+		cmd.SetArgs(args)
+		err := cmd.Execute()
+		return out.String(), err
+	}
+
+	_, err := run("cancel", "job-1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--reason is required")
+
+	out, err := run("cancel", "job-1", "--reason", "superseded by v2")
+	require.NoError(t, err)
+	assert.Contains(t, out, "cancelled job-1: superseded by v2")
+	assert.Equal(t, "abandoned", statusBody["status"])
+	assert.Equal(t, "superseded by v2", statusBody["status_reason"])
+
+	out, err = run("retry", "job-1")
+	require.NoError(t, err)
+	assert.Equal(t, "job-2\n", out)
+	assert.Equal(t, "Nightly import", created["title"])
+}
+
+func TestJobsCreateFromTemplateWithParams(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	require.NoError(t, (&config.Config{
+		APIKey:   "nbl_test",
+		Username: "alxx",
+		Templates: map[string]config.Template{
+			"weekly-report": {
+				Kind:     config.TemplateKindJob,
+				Title:    "Weekly report W{{week}}",
+				Agent:    "reporter",
+				Subtasks: []string{"Collect W{{week}} metrics"},
+				Params:   []config.TemplateParam{{Name: "week", Required: true}},
+			},
+		},
+	}).Save())
+
+	var created map[string]any
+	var subtasks []string
+	shutdown := startDefaultAPIBaseServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/jobs":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "job-7"}}))
+		case "/api/jobs/job-7/subtasks":
+			var body map[string]string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			subtasks = append(subtasks, body["title"])
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "sub-1"}}))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer shutdown()
+
+	run := func(args ...string) (string, error) {
+		var out bytes.Buffer
+		cmd := JobsCmd()
+		cmd.SetOut(&out)
+		cmd.SetErr(&out)
+		cmd.SetArgs(append([]string{"create"}, args...))
+		err := cmd.Execute()
+		return out.String(), err
+	}
+
+	_, err := run("--template", "weekly-report")
+	assert.ErrorContains(t, err, `needs param "week"`)
+	_, err = run("--param", "week=42")
+	assert.ErrorContains(t, err, "--param requires --template")
+
+	out, err := run("--template", "weekly-report", "--param", "week=42", "--priority", "High")
+	require.NoError(t, err)
+	assert.Equal(t, "job-7\n", out)
+	assert.Equal(t, "Weekly report W42", created["title"])
+	assert.Equal(t, "high", created["priority"])
+	assert.Equal(t, "reporter", created["assigned_to"])
+	meta, _ := created["metadata"].(map[string]any)
+	assert.Equal(t, "weekly-report", meta["template"])
+	assert.Equal(t, []string{"Collect W42 metrics"}, subtasks)
+}
@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+// migrateAPIKeys moves plaintext config keys into the OS keyring.
+//
+// Tests override this variable so command runs never touch the real keyring.
+var migrateAPIKeys = config.MigrateAPIKeys
+
+// AttachKeyringMigration moves plaintext API keys into the OS keyring before
+// each command. Fresh logins store their key in the keyring directly, so one
+// pass per run covers existing configs. A failed migration is reported as a
+// warning and the command still runs with the plaintext key.
+func AttachKeyringMigration(command *cobra.Command) {
+	if command == nil {
+		return
+	}
+
+	prevPre := command.PersistentPreRunE
+	command.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if prevPre != nil {
+			if err := prevPre(cmd, args); err != nil {
+				return err
+			}
+		}
+		if _, err := migrateAPIKeys(); err != nil {
+			_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "warning: move api keys to keyring: %v\n", err)
+		}
+		return nil
+	}
+}
@@ -34,6 +34,7 @@ func keysListCmd() *cobra.Command {
 				return fmt.Errorf("not logged in: %w", err)
 			}
 			client := newDefaultClient(cfg.APIKey)
+			AttachTokenRefresh(client, cfg)
 
 			var keys []api.APIKey
 			if all {
@@ -87,6 +88,7 @@ func keysCreateCmd() *cobra.Command {
 				return fmt.Errorf("not logged in: %w", err)
 			}
 			client := newDefaultClient(cfg.APIKey)
+			AttachTokenRefresh(client, cfg)
 
 			resp, err := client.CreateKey(args[0])
 			if err != nil {
@@ -115,6 +117,7 @@ func keysRevokeCmd() *cobra.Command {
 				return fmt.Errorf("not logged in: %w", err)
 			}
 			client := newDefaultClient(cfg.APIKey)
+			AttachTokenRefresh(client, cfg)
 
 			if err := client.RevokeKey(args[0]); err != nil {
 				return fmt.Errorf("revoke key: %w", err)
@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/dedupe"
+)
+
+// KnowledgeCmd returns the `nebula knowledge` maintenance command group.
+func KnowledgeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "knowledge",
+		Short: "Maintain knowledge items",
+	}
+	cmd.AddCommand(knowledgeDedupeCmd())
+	return cmd
+}
+
+// knowledgeDedupeCmd returns `nebula knowledge dedupe`.
+func knowledgeDedupeCmd() *cobra.Command {
+	var dryRun bool
+	cmd := &cobra.Command{
+		Use:   "dedupe",
+		Short: "Find and archive duplicate knowledge by URL or content",
+		Long: strings.TrimSpace(`Group active knowledge that points at the same URL or carries the same
+content (ignoring case, punctuation, and whitespace). The oldest item in each
+group is kept and gains the others' tags; the rest are set inactive. Use
+--dry-run to only report the groups.`),
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, _ []string) error {
+			client, err := loadCommandClient(true)
+			if err != nil {
+				return err
+			}
+			items, err := dedupe.Fetch(client)
+			if err != nil {
+				return fmt.Errorf("load knowledge: %w", err)
+			}
+			groups := dedupe.Groups(items)
+			out := command.OutOrStdout()
+			writeDedupeReport(out, groups)
+			if dryRun || len(groups) == 0 {
+				return nil
+			}
+			archived := 0
+			for _, group := range groups {
+				n, err := archiveDuplicates(client, group)
+				archived += n
+				if err != nil {
+					return fmt.Errorf("archive duplicates of %s: %w", group.Items[0].ID, err)
+				}
+			}
+			_, err = fmt.Fprintf(out, "\narchived %s\n", countNoun(archived, "duplicate", "duplicates"))
+			return err
+		},
+	}
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "report duplicates without changing anything")
+	return cmd
+}
+
+// writeDedupeReport prints each group with the item that would be kept first.
+func writeDedupeReport(out io.Writer, groups []dedupe.Group) {
+	if len(groups) == 0 {
+		_, _ = fmt.Fprintln(out, "no duplicate knowledge found")
+		return
+	}
+	redundant := 0
+	for _, group := range groups {
+		redundant += len(group.Items) - 1
+	}
+	_, _ = fmt.Fprintf(out, "%s, %s\n",
+		countNoun(len(groups), "duplicate group", "duplicate groups"),
+		countNoun(redundant, "redundant item", "redundant items"))
+	for _, group := range groups {
+		key := group.Key
+		if group.Reason == dedupe.ReasonContent {
+			key = "sha256:" + key[:12]
+		}
+		_, _ = fmt.Fprintf(out, "\n%s %s\n", group.Reason, key)
+		for i, item := range group.Items {
+			mark := "dup "
+			if i == 0 {
+				mark = "keep"
+			}
+			_, _ = fmt.Fprintf(out, "  %s  %s  %s  %s\n", mark, item.ID, item.CreatedAt.Format("2006-01-02"), strings.TrimSpace(item.Title))
+		}
+	}
+}
+
+// archiveDuplicates merges the group's tags into its oldest item and sets
+// the rest inactive. It returns how many items were archived.
+func archiveDuplicates(client *api.Client, group dedupe.Group) (int, error) {
+	keep := group.Items[0]
+	tags := keep.Tags
+	for _, item := range group.Items[1:] {
+		tags = dedupe.MergeTags(tags, item.Tags)
+	}
+	if len(tags) > len(keep.Tags) {
+		if _, err := client.UpdateContext(keep.ID, api.UpdateContextInput{Tags: &tags}); err != nil {
+			return 0, err
+		}
+	}
+	archived := 0
+	for _, item := range group.Items[1:] {
+		status := "inactive"
+		if _, err := client.UpdateContext(item.ID, api.UpdateContextInput{Status: &status}); err != nil {
+			return archived, err
+		}
+		archived++
+	}
+	return archived, nil
+}
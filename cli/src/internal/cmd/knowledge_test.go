@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+func TestKnowledgeDedupeReportsAndArchives(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	require.NoError(t, (&config.Config{APIKey: "nbl_test", Username: "alxx"}).Save())
+
+	base := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	items := []map[string]any{
+		{"id": "k2", "title": "Docs copy", "url": "https://www.example.com/docs/", "tags": []string{"api"}, "created_at": base.Add(time.Hour)},
+		{"id": "k1", "title": "Docs", "url": "https://example.com/docs", "tags": []string{"docs"}, "created_at": base},
+		{"id": "k3", "title": "Note", "content": "Ship it!", "tags": []string{}, "created_at": base},
+		{"id": "k4", "title": "Note again", "content": "ship   it", "tags": []string{}, "created_at": base.Add(time.Minute)},
+		{"id": "k5", "title": "Unique", "content": "something else", "tags": []string{}, "created_at": base},
+	}
+	var mu sync.Mutex
+	patches := map[string]map[string]any{}
+	shutdown := startDefaultAPIBaseServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			mu.Lock()
+			patches[r.URL.Path] = body
+			mu.Unlock()
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "x", "tags": []string{}}}))
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": items}))
+	}))
+	defer shutdown()
+
+	run := func(args ...string) string {
+		var out bytes.Buffer
+		cmd := KnowledgeCmd()
+		cmd.SetOut(&out)
+		cmd.SetArgs(append([]string{"dedupe"}, args...))
+		require.NoError(t, cmd.Execute())
+		return out.String()
+	}
+
+	report := run("--dry-run")
+	assert.Contains(t, report, "2 duplicate groups, 2 redundant items")
+	assert.Contains(t, report, "url https://example.com/docs")
+	assert.Contains(t, report, "keep  k1")
+	assert.Contains(t, report, "dup   k2")
+	assert.Contains(t, report, "content sha256:")
+	assert.NotContains(t, report, "k5")
+	assert.Empty(t, patches)
+
+	assert.Contains(t, run(), "archived 2 duplicates")
+	assert.Equal(t, map[string]any{"tags": []any{"docs", "api"}}, patches["/api/context/k1"])
+	assert.Equal(t, map[string]any{"status": "inactive"}, patches["/api/context/k2"])
+	assert.Equal(t, map[string]any{"status": "inactive"}, patches["/api/context/k4"])
+	assert.NotContains(t, patches, "/api/context/k3")
+}
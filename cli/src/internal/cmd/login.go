@@ -2,10 +2,14 @@ package cmd
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"os/exec"
+	"runtime"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -35,15 +39,16 @@ func RunInteractiveLogin(in io.Reader, out io.Writer) error {
 		return fmt.Errorf("login failed: %w", err)
 	}
 
-	cfg := &config.Config{
-		APIKey:            resp.APIKey,
-		UserEntityID:      resp.EntityID,
-		Username:          resp.Username,
-		Theme:             "dark",
-		VimKeys:           true,
-		QuickstartPending: true,
-		PendingLimit:      500,
+	cfg, err := config.LoadForLogin()
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
 	}
+	cfg.APIKey = resp.APIKey
+	cfg.KeyInKeyring = true
+	cfg.UserEntityID = resp.EntityID
+	cfg.Username = resp.Username
+	cfg.RefreshToken = ""
+	cfg.TokenExpiresAt = time.Time{}
 
 	if err := cfg.Save(); err != nil {
 		return fmt.Errorf("save config: %w", err)
@@ -52,19 +57,161 @@ func RunInteractiveLogin(in io.Reader, out io.Writer) error {
 	renderCommandPanel(out, "Login Success", []components.TableRow{
 		{Label: "username", Value: resp.Username},
 		{Label: "entity_id", Value: resp.EntityID},
-		{Label: "api_url", Value: api.DefaultBaseURL},
+		{Label: "profile", Value: cfg.ProfileLabel()},
+		{Label: "api_url", Value: api.ResolveBaseURL(cfg.APIURL)},
 		{Label: "config", Value: config.Path()},
 	})
 	return nil
 }
 
+// defaultDevicePollInterval is used when the server does not send one.
+const defaultDevicePollInterval = 5 * time.Second
+
+// deviceLoginSleep and deviceLoginNow pace device login polling; tests stub them.
+var (
+	deviceLoginSleep = time.Sleep
+	deviceLoginNow   = time.Now
+)
+
+// openBrowser opens url in the user's browser.
+var openBrowser = func(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	return cmd.Start()
+}
+
+// RunDeviceLogin signs in through the server's SSO provider with the OAuth
+// device-code flow and persists the tokens.
+func RunDeviceLogin(out io.Writer, launchBrowser bool) error {
+	client := newDefaultClient("")
+	code, err := client.StartDeviceLogin()
+	if errors.Is(err, api.ErrSSOUnavailable) {
+		return fmt.Errorf("%w; run `nebula login` to sign in with an api key", err)
+	}
+	if err != nil {
+		return fmt.Errorf("start sso login: %w", err)
+	}
+
+	verifyURL := code.VerificationURIComplete
+	if verifyURL == "" {
+		verifyURL = code.VerificationURI
+	}
+	renderCommandPanel(out, "Nebula SSO Login", []components.TableRow{
+		{Label: "open", Value: verifyURL},
+		{Label: "code", Value: code.UserCode},
+	})
+	if launchBrowser {
+		if err := openBrowser(verifyURL); err != nil {
+			_, _ = fmt.Fprintln(out, "could not open a browser; open the link above manually.")
+		}
+	}
+	_, _ = fmt.Fprintln(out, "waiting for approval...")
+
+	token, err := pollDeviceToken(client, code)
+	if err != nil {
+		return fmt.Errorf("sso login failed: %w", err)
+	}
+
+	cfg, err := config.LoadForLogin()
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+	cfg.APIKey = token.AccessToken
+	cfg.KeyInKeyring = true
+	cfg.RefreshToken = token.RefreshToken
+	cfg.TokenExpiresAt = token.ExpiresAt(deviceLoginNow())
+	if token.EntityID != "" {
+		cfg.UserEntityID = token.EntityID
+	}
+	if token.Username != "" {
+		cfg.Username = token.Username
+	}
+	if err := cfg.Save(); err != nil {
+		return fmt.Errorf("save config: %w", err)
+	}
+
+	renderCommandPanel(out, "Login Success", []components.TableRow{
+		{Label: "username", Value: cfg.Username},
+		{Label: "entity_id", Value: cfg.UserEntityID},
+		{Label: "auth", Value: "sso"},
+		{Label: "profile", Value: cfg.ProfileLabel()},
+		{Label: "api_url", Value: api.ResolveBaseURL(cfg.APIURL)},
+		{Label: "config", Value: config.Path()},
+	})
+	return nil
+}
+
+// pollDeviceToken polls until the device login is approved, denied, or expires.
+func pollDeviceToken(client *api.Client, code *api.DeviceCode) (*api.OAuthToken, error) {
+	interval := time.Duration(code.Interval) * time.Second
+	if interval <= 0 {
+		interval = defaultDevicePollInterval
+	}
+	expiresIn := time.Duration(code.ExpiresIn) * time.Second
+	if expiresIn <= 0 {
+		expiresIn = 10 * time.Minute
+	}
+	deadline := deviceLoginNow().Add(expiresIn)
+
+	for deviceLoginNow().Before(deadline) {
+		deviceLoginSleep(interval)
+		token, err := client.PollDeviceToken(code.DeviceCode)
+		switch {
+		case err == nil:
+			return token, nil
+		case errors.Is(err, api.ErrAuthorizationPending):
+		case errors.Is(err, api.ErrSlowDown):
+			interval += defaultDevicePollInterval
+		default:
+			return nil, err
+		}
+	}
+	return nil, api.ErrDeviceCodeExpired
+}
+
+// AttachTokenRefresh loads the config's SSO tokens into client and saves
+// renewed tokens back to the config.
+func AttachTokenRefresh(client *api.Client, cfg *config.Config) {
+	if client == nil || !cfg.UsesSSO() {
+		return
+	}
+	client.SetOAuthToken(cfg.APIKey, cfg.RefreshToken, cfg.TokenExpiresAt)
+	client.OnTokenRefresh(func(token api.OAuthToken) {
+		cfg.APIKey = token.AccessToken
+		cfg.RefreshToken = token.RefreshToken
+		cfg.TokenExpiresAt = token.ExpiresAt(time.Now())
+		if err := cfg.Save(); err != nil {
+			fmt.Fprintf(os.Stderr, "save refreshed token: %v\n", err)
+		}
+	})
+}
+
 // LoginCmd returns the `nebula login` command.
 func LoginCmd() *cobra.Command {
-	return &cobra.Command{
+	var sso bool
+	var noBrowser bool
+	cmd := &cobra.Command{
 		Use:   "login",
 		Short: "Authenticate with a Nebula server",
 		RunE: func(_ *cobra.Command, _ []string) error {
+			if sso {
+				return RunDeviceLogin(os.Stdout, !noBrowser)
+			}
 			return RunInteractiveLogin(os.Stdin, os.Stdout)
 		},
 	}
+	cmd.Flags().BoolVar(&sso, "sso", false, "sign in through SSO with a device code")
+	cmd.Flags().BoolVar(&noBrowser, "no-browser", false, "print the SSO link instead of opening a browser")
+	// The Nebula server has no SSO provider yet, so the device login stays
+	// out of help until one ships.
+	_ = cmd.Flags().MarkHidden("sso")
+	_ = cmd.Flags().MarkHidden("no-browser")
+	return cmd
 }
@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubDeviceLogin replaces browser launch and poll pacing for a test.
+func stubDeviceLogin(t *testing.T) *[]string {
+	t.Helper()
+	opened := []string{}
+	prevOpen, prevSleep := openBrowser, deviceLoginSleep
+	openBrowser = func(url string) error {
+		opened = append(opened, url)
+		return nil
+	}
+	deviceLoginSleep = func(time.Duration) {}
+	t.Cleanup(func() {
+		openBrowser = prevOpen
+		deviceLoginSleep = prevSleep
+	})
+	return &opened
+}
+
+// TestRunDeviceLoginSavesSSOTokens handles test run device login saves ssotokens.
+func TestRunDeviceLoginSavesSSOTokens(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	opened := stubDeviceLogin(t)
+	polls := 0
+	shutdown := startDefaultAPIBaseServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/auth/device":
+			_, _ = io.WriteString(w, `{"data":{"device_code":"dev-1","user_code":"ABCD-EFGH","verification_uri":"https://sso.example/device","verification_uri_complete":"https://sso.example/device?code=ABCD-EFGH","expires_in":600,"interval":1}}`)
+		case "/api/auth/device/token":
+			polls++
+			if polls < 3 {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = io.WriteString(w, `{"error":"authorization_pending"}`)
+				return
+			}
+			_, _ = io.WriteString(w, `{"data":{"access_token":"at-1","refresh_token":"rt-1","expires_in":3600,"entity_id":"ent-1","username":"alxx"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(shutdown)
+
+	var out bytes.Buffer
+	require.NoError(t, RunDeviceLogin(&out, true))
+	assert.Equal(t, []string{"https://sso.example/device?code=ABCD-EFGH"}, *opened)
+	assert.Contains(t, out.String(), "ABCD-EFGH")
+	assert.Contains(t, out.String(), "waiting for approval")
+	assert.Equal(t, 3, polls)
+
+	loaded, err := config.Load()
+	require.NoError(t, err)
+	assert.Equal(t, "at-1", loaded.APIKey)
+	assert.Equal(t, "rt-1", loaded.RefreshToken)
+	assert.Equal(t, "alxx", loaded.Username)
+	assert.True(t, loaded.UsesSSO())
+	assert.False(t, loaded.TokenExpiresAt.IsZero())
+}
+
+// TestRunDeviceLoginStopsOnDenial handles test run device login stops on denial.
+func TestRunDeviceLoginStopsOnDenial(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	stubDeviceLogin(t)
+	shutdown := startDefaultAPIBaseServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/auth/device":
+			_, _ = io.WriteString(w, `{"data":{"device_code":"dev-1","user_code":"ABCD","verification_uri":"https://sso.example/device"}}`)
+		case "/api/auth/device/token":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = io.WriteString(w, `{"error":"access_denied"}`)
+		}
+	}))
+	t.Cleanup(shutdown)
+
+	err := RunDeviceLogin(io.Discard, false)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, api.ErrAccessDenied)
+}
+
+// TestRunDeviceLoginReportsServerWithoutSSO handles test run device login reports server without sso.
+func TestRunDeviceLoginReportsServerWithoutSSO(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	opened := stubDeviceLogin(t)
+	shutdown := startDefaultAPIBaseServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = io.WriteString(w, `{"detail":"Not Found"}`)
+	}))
+	t.Cleanup(shutdown)
+
+	err := RunDeviceLogin(io.Discard, true)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, api.ErrSSOUnavailable)
+	assert.Contains(t, err.Error(), "nebula login")
+	assert.Empty(t, *opened)
+	assert.True(t, LoginCmd().Flags().Lookup("sso").Hidden)
+}
+
+// TestAttachTokenRefreshPersistsRenewedTokens handles test attach token refresh persists renewed tokens.
+func TestAttachTokenRefreshPersistsRenewedTokens(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	cfg := &config.Config{APIKey: "at-1", RefreshToken: "rt-1", TokenExpiresAt: time.Now().Add(-time.Minute), Username: "alxx"}
+	require.NoError(t, cfg.Save())
+
+	shutdown := startDefaultAPIBaseServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/auth/token/refresh" {
+			_, _ = io.WriteString(w, `{"data":{"access_token":"at-2","refresh_token":"rt-2","expires_in":3600}}`)
+			return
+		}
+		_, _ = io.WriteString(w, `{"data":[]}`)
+	}))
+	t.Cleanup(shutdown)
+
+	client := newDefaultClient(cfg.APIKey)
+	AttachTokenRefresh(client, cfg)
+	_, err := client.ListKeys()
+	require.NoError(t, err)
+
+	loaded, err := config.Load()
+	require.NoError(t, err)
+	assert.Equal(t, "at-2", loaded.APIKey)
+	assert.Equal(t, "rt-2", loaded.RefreshToken)
+}
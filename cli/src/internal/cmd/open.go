@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gravitrone/nebula-core/cli/internal/ui"
+)
+
+// uriHandlerDesktopFile is the desktop entry that claims nebula:// links.
+const uriHandlerDesktopFile = "nebula-open.desktop"
+
+var (
+	uriHandlerGOOS  = runtime.GOOS
+	runXDGMimeCmd   = func(args ...string) error { return exec.Command("xdg-mime", args...).Run() }
+	userDataHomeDir = func() (string, error) {
+		if dir := strings.TrimSpace(os.Getenv("XDG_DATA_HOME")); dir != "" {
+			return dir, nil
+		}
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		return filepath.Join(home, ".local", "share"), nil
+	}
+)
+
+// OpenCmd returns `nebula open <kind>/<id>`, which starts the TUI on one
+// entity, approval, or job. launch runs the TUI with the parsed link.
+func OpenCmd(launch func(ui.DeepLink) error) *cobra.Command {
+	var register bool
+	cmd := &cobra.Command{
+		Use:   "open <kind>/<id>",
+		Short: "Open the TUI on an entity, approval, or job",
+		Long: strings.TrimSpace(`Start the TUI directly on a record detail view. Links look like
+entity/<id>, approval/<id>, or job/<id>, optionally behind nebula://
+so they can be pasted into chat or tickets. --register makes this command
+the desktop handler for nebula:// links (Linux, via xdg-mime).`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			if register {
+				return registerURIHandler(command.OutOrStdout())
+			}
+			if len(args) == 0 {
+				return fmt.Errorf("open requires a link like entity/<id>")
+			}
+			link, err := ui.ParseDeepLink(args[0])
+			if err != nil {
+				return err
+			}
+			return launch(link)
+		},
+	}
+	cmd.Flags().BoolVar(&register, "register", false, "register nebula open as the nebula:// link handler")
+	return cmd
+}
+
+// registerURIHandler installs a desktop entry for nebula:// and makes it the
+// default handler for the scheme.
+func registerURIHandler(out io.Writer) error {
+	if uriHandlerGOOS != "linux" {
+		return fmt.Errorf("nebula:// registration is only supported on linux; point your OS handler at `nebula open %%u`")
+	}
+	exe, err := executablePath()
+	if err != nil {
+		return fmt.Errorf("resolve nebula binary: %w", err)
+	}
+	dataDir, err := userDataHomeDir()
+	if err != nil {
+		return fmt.Errorf("resolve data dir: %w", err)
+	}
+	appsDir := filepath.Join(dataDir, "applications")
+	if err := os.MkdirAll(appsDir, 0o755); err != nil {
+		return fmt.Errorf("create applications dir: %w", err)
+	}
+	path := filepath.Join(appsDir, uriHandlerDesktopFile)
+	if err := os.WriteFile(path, []byte(uriHandlerDesktopEntry(exe)), 0o644); err != nil {
+		return fmt.Errorf("write desktop entry: %w", err)
+	}
+	scheme := "x-scheme-handler/" + ui.DeepLinkScheme
+	if err := runXDGMimeCmd("default", uriHandlerDesktopFile, scheme); err != nil {
+		return fmt.Errorf("xdg-mime default %s: %w", scheme, err)
+	}
+	_, err = fmt.Fprintf(out, "registered %s://  handler: %s\n", ui.DeepLinkScheme, path)
+	return err
+}
+
+// uriHandlerDesktopEntry renders the desktop entry that runs nebula open in a
+// terminal for each clicked link.
+func uriHandlerDesktopEntry(exe string) string {
+	return strings.Join([]string{
+		"[Desktop Entry]",
+		"Type=Application",
+		"Name=Nebula",
+		"Comment=Open nebula:// links in the Nebula TUI",
+		fmt.Sprintf("Exec=%q open %%u", exe),
+		"Terminal=true",
+		"NoDisplay=true",
+		"MimeType=x-scheme-handler/" + ui.DeepLinkScheme + ";",
+		"",
+	}, "\n")
+}
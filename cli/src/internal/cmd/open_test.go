@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/ui"
+)
+
+func TestOpenCmdParsesLinkAndLaunches(t *testing.T) {
+	var got []ui.DeepLink
+	launch := func(link ui.DeepLink) error {
+		got = append(got, link)
+		return nil
+	}
+
+	cmd := OpenCmd(launch)
+	cmd.SetArgs([]string{"nebula://approval/ap-1"})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, []ui.DeepLink{{Kind: "approval", ID: "ap-1"}}, got)
+
+	cmd = OpenCmd(launch)
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"widget/1"})
+	assert.ErrorContains(t, cmd.Execute(), "unknown link kind")
+
+	cmd = OpenCmd(launch)
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{})
+	assert.ErrorContains(t, cmd.Execute(), "requires a link")
+	assert.Len(t, got, 1)
+}
+
+func TestOpenCmdRegisterWritesDesktopEntry(t *testing.T) {
+	dataDir := t.TempDir()
+	origGOOS, origExe, origMime, origData := uriHandlerGOOS, executablePath, runXDGMimeCmd, userDataHomeDir
+	t.Cleanup(func() {
+		uriHandlerGOOS, executablePath, runXDGMimeCmd, userDataHomeDir = origGOOS, origExe, origMime, origData
+	})
+	uriHandlerGOOS = "linux"
+	executablePath = func() (string, error) { return "/usr/local/bin/nebula", nil }
+	userDataHomeDir = func() (string, error) { return dataDir, nil }
+	var mimeArgs []string
+	runXDGMimeCmd = func(args ...string) error {
+		mimeArgs = args
+		return nil
+	}
+
+	var out bytes.Buffer
+	cmd := OpenCmd(func(ui.DeepLink) error { return nil })
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"--register"})
+	require.NoError(t, cmd.Execute())
+
+	entry, err := os.ReadFile(filepath.Join(dataDir, "applications", uriHandlerDesktopFile))
+	require.NoError(t, err)
+	assert.Contains(t, string(entry), "Exec=\"/usr/local/bin/nebula\" open %u")
+	assert.Contains(t, string(entry), "MimeType=x-scheme-handler/nebula;")
+	assert.Equal(t, []string{"default", uriHandlerDesktopFile, "x-scheme-handler/nebula"}, mimeArgs)
+	assert.Contains(t, out.String(), "registered nebula://")
+
+	uriHandlerGOOS = "darwin"
+	cmd = OpenCmd(func(ui.DeepLink) error { return nil })
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"--register"})
+	assert.ErrorContains(t, cmd.Execute(), "only supported on linux")
+}
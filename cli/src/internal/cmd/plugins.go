@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/gravitrone/nebula-core/cli/internal/plugins"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// pluginsDir is where plugins are discovered. Tests point it at a temp dir.
+var pluginsDir = plugins.Dir
+
+// PluginsCmd returns the `nebula plugins` command group. Every executable in
+// the plugin directory becomes a subcommand that passes its args, stdin, and
+// output straight through.
+func PluginsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plugins",
+		Short: "List and run plugins from ~/.nebula/plugins",
+	}
+	cmd.AddCommand(pluginsListCmd())
+
+	found, _ := plugins.Discover(pluginsDir())
+	for _, plugin := range found {
+		if sub, _, err := cmd.Find([]string{plugin.Name}); err == nil && sub != cmd {
+			continue
+		}
+		cmd.AddCommand(pluginRunCmd(plugin))
+	}
+	return cmd
+}
+
+// pluginsListCmd handles plugins list cmd.
+func pluginsListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List discovered plugins",
+		RunE: func(command *cobra.Command, _ []string) error {
+			dir := pluginsDir()
+			found, err := plugins.Discover(dir)
+			if err != nil {
+				return err
+			}
+			rows := []components.TableRow{{Label: "dir", Value: dir}}
+			if len(found) == 0 {
+				rows = append(rows, components.TableRow{Label: "plugins", Value: "none"})
+			}
+			for _, plugin := range found {
+				rows = append(rows, components.TableRow{Label: plugin.Name, Value: plugin.Path})
+			}
+			renderCommandPanel(command.OutOrStdout(), "Plugins", rows)
+			return nil
+		},
+	}
+}
+
+// pluginRunCmd exposes one plugin as a subcommand. Flags are left for the
+// plugin to parse, and the selection kind is "stdin" since the caller pipes
+// in whatever it likes.
+func pluginRunCmd(plugin plugins.Plugin) *cobra.Command {
+	return &cobra.Command{
+		Use:                plugin.Name + " [args...]",
+		Short:              "Run plugin " + plugin.Path,
+		DisableFlagParsing: true,
+		RunE: func(command *cobra.Command, args []string) error {
+			return plugin.Passthrough("stdin", command.InOrStdin(), command.OutOrStdout(), command.ErrOrStderr(), args...)
+		},
+	}
+}
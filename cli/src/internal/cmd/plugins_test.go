@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPluginsCmdListsAndRunsPlugins(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	dir := t.TempDir()
+	script := "#!/bin/sh\necho \"args: $*\"\ncat\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "summarize"), []byte(script), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "list"), []byte(script), 0o755))
+	prev := pluginsDir
+	pluginsDir = func() string { return dir }
+	t.Cleanup(func() { pluginsDir = prev })
+
+	var out bytes.Buffer
+	cmd := PluginsCmd()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"list"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, out.String(), "summarize")
+
+	out.Reset()
+	cmd = PluginsCmd()
+	cmd.SetOut(&out)
+	cmd.SetIn(strings.NewReader(`{"id":"ent-1"}`))
+	cmd.SetArgs([]string{"summarize", "--short", "x"})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "args: --short x\n{\"id\":\"ent-1\"}", out.String())
+}
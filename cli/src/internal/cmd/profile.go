@@ -0,0 +1,171 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// AttachProfileFlag wires --profile and exports the selection before command execution.
+func AttachProfileFlag(command *cobra.Command) {
+	if command == nil {
+		return
+	}
+
+	var profile string
+	command.PersistentFlags().StringVar(
+		&profile,
+		"profile",
+		"",
+		"config profile to use for this run (default: active profile)",
+	)
+
+	prev := command.PersistentPreRunE
+	command.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if prev != nil {
+			if err := prev(cmd, args); err != nil {
+				return err
+			}
+		}
+		name := strings.TrimSpace(profile)
+		if name == "" {
+			return nil
+		}
+		return os.Setenv(config.ProfileEnv, name)
+	}
+}
+
+// ProfileCmd returns the `nebula profile` command group.
+func ProfileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "profile",
+		Short: "Manage environment profiles",
+	}
+	cmd.AddCommand(profileListCmd())
+	cmd.AddCommand(profileSwitchCmd())
+	cmd.AddCommand(profileAddCmd())
+	return cmd
+}
+
+// profileListCmd handles profile list cmd.
+func profileListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List configured profiles",
+		RunE: func(command *cobra.Command, _ []string) error {
+			cfg, err := config.LoadFile()
+			if err != nil {
+				return fmt.Errorf("not logged in: %w", err)
+			}
+			active := config.SelectedProfile(cfg)
+			if active == "" {
+				active = config.DefaultProfile
+			}
+
+			rows := make([]components.TableRow, 0, len(cfg.Profiles)+1)
+			for _, name := range cfg.ProfileNames() {
+				label := name
+				if name == active {
+					label = "* " + name
+				}
+				rows = append(rows, components.TableRow{
+					Label: label,
+					Value: profileSummary(profileEntry(cfg, name)),
+				})
+			}
+			renderCommandPanel(command.OutOrStdout(), "Profiles", rows)
+			return nil
+		},
+	}
+}
+
+// profileSwitchCmd handles profile switch cmd.
+func profileSwitchCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "switch <name>",
+		Short: "Set the active profile",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			cfg, err := config.LoadFile()
+			if err != nil {
+				return fmt.Errorf("not logged in: %w", err)
+			}
+			if err := cfg.UseProfile(args[0]); err != nil {
+				return err
+			}
+			if err := cfg.Save(); err != nil {
+				return fmt.Errorf("save config: %w", err)
+			}
+			name := strings.TrimSpace(args[0])
+			entry := profileEntry(cfg, name)
+			renderCommandPanel(command.OutOrStdout(), "Profile Switched", []components.TableRow{
+				{Label: "profile", Value: name},
+				{Label: "api_url", Value: api.ResolveBaseURL(entry.APIURL)},
+				{Label: "username", Value: safeDoctorValue(entry.Username, "not logged in")},
+			})
+			return nil
+		},
+	}
+}
+
+// profileAddCmd handles profile add cmd.
+func profileAddCmd() *cobra.Command {
+	var apiURL string
+	cmd := &cobra.Command{
+		Use:   "add <name>",
+		Short: "Add or update a profile",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			name := strings.TrimSpace(args[0])
+			url := strings.TrimSpace(apiURL)
+			if url == "" {
+				return fmt.Errorf("--api-url is required")
+			}
+			cfg, err := config.LoadFile()
+			if err != nil {
+				return fmt.Errorf("not logged in: %w", err)
+			}
+			entry := cfg.Profiles[name]
+			entry.APIURL = url
+			if err := cfg.SetProfile(name, entry); err != nil {
+				return err
+			}
+			if err := cfg.Save(); err != nil {
+				return fmt.Errorf("save config: %w", err)
+			}
+			renderCommandPanel(command.OutOrStdout(), "Profile Saved", []components.TableRow{
+				{Label: "profile", Value: name},
+				{Label: "api_url", Value: url},
+				{Label: "next", Value: fmt.Sprintf("nebula --profile %s login", name)},
+			})
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&apiURL, "api-url", "", "API base URL for this profile")
+	return cmd
+}
+
+// profileSummary renders a one-line profile description.
+func profileSummary(entry config.Profile) string {
+	user := safeDoctorValue(entry.Username, "not logged in")
+	return fmt.Sprintf("%s · %s", api.ResolveBaseURL(entry.APIURL), user)
+}
+
+// profileEntry returns the stored settings for a profile name.
+func profileEntry(cfg *config.Config, name string) config.Profile {
+	if name == config.DefaultProfile {
+		return config.Profile{
+			APIURL:       cfg.APIURL,
+			APIKey:       cfg.APIKey,
+			UserEntityID: cfg.UserEntityID,
+			Username:     cfg.Username,
+		}
+	}
+	return cfg.Profiles[name]
+}
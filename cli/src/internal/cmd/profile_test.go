@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+func TestProfileAddSwitchAndList(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(config.ProfileEnv, "")
+	require.NoError(t, (&config.Config{APIKey: "nbl_prod", Username: "alxx"}).Save())
+
+	cmd := ProfileCmd()
+	cmd.SetArgs([]string{"add", "staging"})
+	require.ErrorContains(t, cmd.Execute(), "--api-url")
+
+	var out bytes.Buffer
+	cmd = ProfileCmd()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"add", "staging", "--api-url", "https://staging.example.com"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, out.String(), "nebula --profile staging login")
+
+	cmd = ProfileCmd()
+	cmd.SetArgs([]string{"switch", "prod"})
+	require.ErrorContains(t, cmd.Execute(), `profile "prod" not found`)
+
+	out.Reset()
+	cmd = ProfileCmd()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"switch", "staging"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, out.String(), "https://staging.example.com")
+
+	stored, err := config.LoadFile()
+	require.NoError(t, err)
+	assert.Equal(t, "staging", stored.ActiveProfile)
+	assert.Equal(t, "nbl_prod", stored.APIKey)
+
+	out.Reset()
+	cmd = ProfileCmd()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"list"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, out.String(), "* staging")
+	assert.Contains(t, out.String(), "default")
+}
+
+func TestAttachProfileFlagExportsSelection(t *testing.T) {
+	t.Setenv(config.ProfileEnv, "")
+	root := &cobra.Command{Use: "nebula", RunE: func(_ *cobra.Command, _ []string) error { return nil }}
+	AttachProfileFlag(root)
+	root.SetArgs([]string{"--profile", "staging"})
+	require.NoError(t, root.Execute())
+	assert.Equal(t, "staging", os.Getenv(config.ProfileEnv))
+}
+
+func TestAttachKeyringMigrationRunsOncePerCommand(t *testing.T) {
+	calls := 0
+	prev := migrateAPIKeys
+	migrateAPIKeys = func() (int, error) {
+		calls++
+		return 0, nil
+	}
+	t.Cleanup(func() { migrateAPIKeys = prev })
+
+	root := &cobra.Command{Use: "nebula", RunE: func(_ *cobra.Command, _ []string) error { return nil }}
+	AttachKeyringMigration(root)
+	root.SetArgs([]string{})
+	require.NoError(t, root.Execute())
+	assert.Equal(t, 1, calls)
+}
+
+func TestAttachKeyringMigrationWarnsOnFailure(t *testing.T) {
+	prev := migrateAPIKeys
+	migrateAPIKeys = func() (int, error) {
+		return 0, errors.New("config is world readable")
+	}
+	t.Cleanup(func() { migrateAPIKeys = prev })
+
+	ran := false
+	root := &cobra.Command{Use: "nebula", RunE: func(_ *cobra.Command, _ []string) error {
+		ran = true
+		return nil
+	}}
+	var stderr bytes.Buffer
+	root.SetErr(&stderr)
+	AttachKeyringMigration(root)
+	root.SetArgs([]string{})
+	require.NoError(t, root.Execute())
+	assert.True(t, ran)
+	assert.Contains(t, stderr.String(), "warning: move api keys to keyring: config is world readable")
+}
@@ -0,0 +1,203 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/proxy"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// serveProxy runs the proxy listener. Tests replace it to skip the blocking
+// listen.
+var serveProxy = func(server *http.Server) error {
+	return server.ListenAndServe()
+}
+
+// ProxyCmd returns the `nebula proxy` command, which serves the local agent
+// proxy, and its token subcommands.
+func ProxyCmd() *cobra.Command {
+	var listen string
+	cmd := &cobra.Command{
+		Use:   "proxy",
+		Short: "Serve a local API proxy for agents with scoped sub-tokens",
+		Long: strings.TrimSpace(`Forward local agent requests to the Nebula API with your credentials.
+Agents authenticate with proxy tokens from 'nebula proxy tokens create', which
+limit them to the scopes and request types you grant. Every call is logged to
+~/.nebula/proxy.log.`),
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, _ []string) error {
+			cfg, err := config.Load()
+			if err != nil {
+				return fmt.Errorf("not logged in: %w", err)
+			}
+			store, err := proxy.LoadStore()
+			if err != nil {
+				return err
+			}
+			if len(store.Tokens) == 0 {
+				return fmt.Errorf("no proxy tokens; run 'nebula proxy tokens create <agent>' first")
+			}
+			logFile, err := proxy.OpenLog()
+			if err != nil {
+				return err
+			}
+			defer logFile.Close()
+
+			upstream := api.ResolveBaseURL(config.ActiveAPIURL())
+			handler := &proxy.Server{
+				Upstream: upstream,
+				APIKey:   cfg.APIKey,
+				Tokens:   store,
+				Log:      io.MultiWriter(logFile, command.ErrOrStderr()),
+				Client:   &http.Client{Timeout: 60 * time.Second},
+			}
+			renderCommandPanel(command.OutOrStdout(), "Proxy", []components.TableRow{
+				{Label: "listen", Value: "http://" + listen},
+				{Label: "upstream", Value: upstream},
+				{Label: "tokens", Value: fmt.Sprintf("%d", len(store.Tokens))},
+				{Label: "log", Value: proxy.LogPath()},
+			})
+			server := &http.Server{Addr: listen, Handler: handler, ReadHeaderTimeout: 10 * time.Second}
+			if err := serveProxy(server); err != nil && err != http.ErrServerClosed {
+				return fmt.Errorf("proxy: %w", err)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&listen, "listen", proxy.DefaultListen, "address to listen on")
+	cmd.AddCommand(proxyTokensCmd())
+	return cmd
+}
+
+// proxyTokensCmd returns the `nebula proxy tokens` group.
+func proxyTokensCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokens",
+		Short: "Manage proxy sub-tokens",
+	}
+	cmd.AddCommand(proxyTokensListCmd())
+	cmd.AddCommand(proxyTokensCreateCmd())
+	cmd.AddCommand(proxyTokensRevokeCmd())
+	return cmd
+}
+
+// proxyTokensListCmd handles proxy tokens list cmd.
+func proxyTokensListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List proxy tokens",
+		RunE: func(command *cobra.Command, _ []string) error {
+			store, err := proxy.LoadStore()
+			if err != nil {
+				return err
+			}
+			if len(store.Tokens) == 0 {
+				renderCommandMessage(command.OutOrStdout(), "Proxy Tokens", "No proxy tokens.")
+				return nil
+			}
+			rows := make([]components.TableRow, 0, len(store.Tokens))
+			for _, token := range store.Tokens {
+				rows = append(rows, components.TableRow{
+					Label: token.Agent,
+					Value: fmt.Sprintf("%s... · scopes %s · allow %s",
+						token.Prefix, listOrAny(token.Scopes), listOrDefault(token.RequestTypes, "read_*")),
+				})
+			}
+			renderCommandPanel(command.OutOrStdout(), "Proxy Tokens", rows)
+			return nil
+		},
+	}
+}
+
+// proxyTokensCreateCmd handles proxy tokens create cmd.
+func proxyTokensCreateCmd() *cobra.Command {
+	var scopes []string
+	var allow []string
+	cmd := &cobra.Command{
+		Use:   "create <agent>",
+		Short: "Issue a proxy token for a local agent",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			store, err := proxy.LoadStore()
+			if err != nil {
+				return err
+			}
+			secret, err := store.Issue(args[0], trimList(scopes), trimList(allow))
+			if err != nil {
+				return err
+			}
+			if err := store.Save(); err != nil {
+				return err
+			}
+			renderCommandPanel(command.OutOrStdout(), "Proxy Token Created", []components.TableRow{
+				{Label: "agent", Value: args[0]},
+				{Label: "token", Value: secret},
+				{Label: "scopes", Value: listOrAny(trimList(scopes))},
+				{Label: "allow", Value: listOrDefault(trimList(allow), "read_*")},
+				{Label: "note", Value: "save this token now, it is not shown again"},
+			})
+			return nil
+		},
+	}
+	cmd.Flags().StringSliceVar(&scopes, "scopes", nil, "privacy scopes the agent may read and write")
+	cmd.Flags().StringSliceVar(&allow, "allow", nil, "request types the agent may make, e.g. read_*,create_context")
+	return cmd
+}
+
+// proxyTokensRevokeCmd handles proxy tokens revoke cmd.
+func proxyTokensRevokeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke <agent>",
+		Short: "Revoke an agent's proxy token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			store, err := proxy.LoadStore()
+			if err != nil {
+				return err
+			}
+			if !store.Revoke(args[0]) {
+				return fmt.Errorf("no proxy token for %q", args[0])
+			}
+			if err := store.Save(); err != nil {
+				return err
+			}
+			renderCommandPanel(command.OutOrStdout(), "Proxy Tokens", []components.TableRow{
+				{Label: "status", Value: "revoked"},
+				{Label: "agent", Value: args[0]},
+			})
+			return nil
+		},
+	}
+}
+
+// trimList drops blank entries and surrounding whitespace.
+func trimList(items []string) []string {
+	var out []string
+	for _, item := range items {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// listOrAny joins scopes, naming an empty list "any".
+func listOrAny(items []string) string {
+	return listOrDefault(items, "any")
+}
+
+// listOrDefault joins items or returns fallback when there are none.
+func listOrDefault(items []string, fallback string) string {
+	if len(items) == 0 {
+		return fallback
+	}
+	return strings.Join(items, ",")
+}
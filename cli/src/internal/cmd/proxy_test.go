@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"bytes"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/proxy"
+)
+
+func TestProxyTokensCreateListRevoke(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	var out bytes.Buffer
+	cmd := ProxyCmd()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"tokens", "create", "scout", "--scopes", "public", "--allow", "read_*,create_context"})
+	require.NoError(t, cmd.Execute())
+	secret := regexp.MustCompile(`nbp_[0-9a-f]+`).FindString(out.String())
+	require.NotEmpty(t, secret)
+
+	store, err := proxy.LoadStore()
+	require.NoError(t, err)
+	token, ok := store.Lookup(secret)
+	require.True(t, ok)
+	assert.Equal(t, []string{"read_*", "create_context"}, token.RequestTypes)
+
+	out.Reset()
+	cmd = ProxyCmd()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"tokens", "list"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, out.String(), "scout")
+	assert.NotContains(t, out.String(), secret)
+
+	cmd = ProxyCmd()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"tokens", "revoke", "scout"})
+	require.NoError(t, cmd.Execute())
+	cmd = ProxyCmd()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"tokens", "revoke", "scout"})
+	assert.Error(t, cmd.Execute())
+}
+
+func TestProxyServeRequiresTokens(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	require.NoError(t, (&config.Config{APIKey: "nbl_test"}).Save())
+
+	served := ""
+	prev := serveProxy
+	serveProxy = func(server *http.Server) error {
+		served = server.Addr
+		return nil
+	}
+	t.Cleanup(func() { serveProxy = prev })
+
+	var out bytes.Buffer
+	cmd := ProxyCmd()
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no proxy tokens")
+
+	store := &proxy.Store{}
+	_, err = store.Issue("scout", nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, store.Save())
+
+	cmd = ProxyCmd()
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"--listen", "127.0.0.1:9911"})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "127.0.0.1:9911", served)
+	assert.Contains(t, out.String(), "proxy.log")
+}
@@ -0,0 +1,155 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gravitrone/nebula-core/cli/internal/report"
+)
+
+// ReportCmd returns the `nebula report` command group.
+func ReportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Generate digests of recent activity",
+	}
+	cmd.AddCommand(reportWeeklyCmd())
+	return cmd
+}
+
+// reportWeeklyCmd returns `nebula report weekly`.
+func reportWeeklyCmd() *cobra.Command {
+	var (
+		format       string
+		since        string
+		until        string
+		templatePath string
+		output       string
+		initTemplate bool
+	)
+	cmd := &cobra.Command{
+		Use:   "weekly",
+		Short: "Write a Markdown or HTML digest of the past week",
+		Long: strings.TrimSpace(`Write a digest of the past seven days, ready to post to a team channel: new
+entities, approvals handled, knowledge added, and job outcomes. Run it from
+cron or a scheduled job to post it every week. Prints to stdout unless
+--output is given.
+
+The digest is rendered from a Go template. --init-template writes the
+built-in one to ~/.nebula/reports/weekly.md.tmpl (or .html.tmpl for
+--format html); edit it there and later runs use it. --template renders a
+template from any other path.
+
+Approvals are read from the audit log, so they need an admin key; without
+one the digest notes that the section is unavailable.`),
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, _ []string) error {
+			format = strings.ToLower(strings.TrimSpace(format))
+			if format == "md" {
+				format = report.FormatMarkdown
+			}
+			if format != report.FormatMarkdown && format != report.FormatHTML {
+				return fmt.Errorf("--format must be markdown or html")
+			}
+			if initTemplate {
+				return initReportTemplate(command, format)
+			}
+			now := time.Now()
+			end := now
+			if strings.TrimSpace(until) != "" {
+				ts, err := parseWindowFlag("until", until, true)
+				if err != nil {
+					return err
+				}
+				end = ts
+			}
+			start := end.AddDate(0, 0, -7)
+			if strings.TrimSpace(since) != "" {
+				ts, err := parseWindowFlag("since", since, false)
+				if err != nil {
+					return err
+				}
+				start = ts
+			}
+			if !start.Before(end) {
+				return fmt.Errorf("--since must be before --until")
+			}
+			tmpl, err := report.LoadTemplate("weekly", format, strings.TrimSpace(templatePath))
+			if err != nil {
+				return err
+			}
+			client, err := loadCommandClient(true)
+			if err != nil {
+				return err
+			}
+			digest, err := report.Collect(client, start, end, now)
+			if err != nil {
+				return err
+			}
+			var body bytes.Buffer
+			if err := report.Render(&body, digest, format, tmpl); err != nil {
+				return err
+			}
+			if strings.TrimSpace(output) == "" {
+				_, err := command.OutOrStdout().Write(body.Bytes())
+				return err
+			}
+			if err := os.WriteFile(output, body.Bytes(), 0o644); err != nil {
+				return fmt.Errorf("write %s: %w", output, err)
+			}
+			_, err = fmt.Fprintf(command.ErrOrStderr(), "wrote weekly report to %s\n", output)
+			return err
+		},
+	}
+	cmd.Flags().StringVar(&format, "format", report.FormatMarkdown, "output format: markdown or html")
+	cmd.Flags().StringVar(&since, "since", "", "start of the window (YYYY-MM-DD or RFC3339; default 7 days before --until)")
+	cmd.Flags().StringVar(&until, "until", "", "end of the window, exclusive; a date includes that day (default now)")
+	cmd.Flags().StringVar(&templatePath, "template", "", "render this template file instead of the customized or built-in one")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "write the report to this file")
+	cmd.Flags().BoolVar(&initTemplate, "init-template", false, "write the built-in template to ~/.nebula/reports for editing")
+	return cmd
+}
+
+// initReportTemplate copies the built-in template to where later runs pick
+// it up, leaving an existing one alone.
+func initReportTemplate(command *cobra.Command, format string) error {
+	path := report.TemplatePath("weekly", format)
+	if _, err := os.Stat(path); err == nil {
+		return fmt.Errorf("%s already exists; edit it or remove it first", path)
+	}
+	tmpl, err := report.DefaultTemplate("weekly", format)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("create %s: %w", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, []byte(tmpl), 0o600); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	_, err = fmt.Fprintf(command.OutOrStdout(), "wrote %s\n", path)
+	return err
+}
+
+// parseWindowFlag reads a --since or --until value as a date or an RFC 3339
+// timestamp. With endOfDay, a date means the end of that day.
+func parseWindowFlag(name, raw string, endOfDay bool) (time.Time, error) {
+	value := strings.TrimSpace(raw)
+	if ts, err := time.Parse(time.RFC3339, value); err == nil {
+		return ts, nil
+	}
+	ts, err := time.ParseInLocation("2006-01-02", value, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --%s %q (expected YYYY-MM-DD or RFC3339)", name, raw)
+	}
+	if endOfDay {
+		ts = ts.AddDate(0, 0, 1)
+	}
+	return ts, nil
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/report"
+)
+
+func TestReportWeeklyRendersCustomTemplate(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	require.NoError(t, (&config.Config{APIKey: "nbl_test", Username: "alxx"}).Save())
+
+	now := time.Now().UTC()
+	shutdown := startDefaultAPIBaseServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data []map[string]any
+		switch r.URL.Path {
+		case "/api/entities":
+			data = []map[string]any{{"id": "ent-1", "name": "Acme", "type": "organization", "created_at": now.Add(-time.Hour)}}
+		case "/api/jobs":
+			data = []map[string]any{{"id": "job-1", "title": "Ship", "status": "completed", "updated_at": now.Add(-time.Hour)}}
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": data}))
+	}))
+	defer shutdown()
+
+	var out bytes.Buffer
+	cmd := ReportCmd()
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"weekly", "--init-template"})
+	require.NoError(t, cmd.Execute())
+	path := report.TemplatePath("weekly", report.FormatMarkdown)
+	assert.Equal(t, "wrote "+path+"\n", out.String())
+
+	cmd = ReportCmd()
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"weekly", "--init-template"})
+	assert.ErrorContains(t, cmd.Execute(), "already exists")
+
+	require.NoError(t, os.WriteFile(path, []byte("{{range .Entities}}{{.Name}} {{end}}{{counts .JobCounts}}\n"), 0o600))
+	output := filepath.Join(t.TempDir(), "weekly.md")
+	out.Reset()
+	cmd = ReportCmd()
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"weekly", "-o", output})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "wrote weekly report to "+output+"\n", out.String())
+	body, err := os.ReadFile(output)
+	require.NoError(t, err)
+	assert.Equal(t, "Acme completed 1\n", string(body))
+}
+
+func TestReportWeeklyRejectsBadWindow(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	for _, args := range [][]string{
+		{"weekly", "--since", "last week"},
+		{"weekly", "--since", "2026-10-10", "--until", "2026-10-01"},
+		{"weekly", "--format", "pdf"},
+	} {
+		cmd := ReportCmd()
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs(args)
+		assert.Error(t, cmd.Execute(), args)
+	}
+}
@@ -0,0 +1,204 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+const (
+	syncDefaultInterval = 5 * time.Minute
+	syncApprovalLimit   = 500
+	syncEntityPageSize  = 100
+	syncEntityCap       = 2000
+)
+
+// syncWait pauses between passes and reports false once ctx is done. Tests
+// replace it to stop the loop.
+var syncWait = func(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// syncSummary counts the changes one sync pass journaled.
+type syncSummary struct {
+	NewApprovals   int
+	NewEntities    int
+	EditedEntities int
+}
+
+// String renders the summary as one line.
+func (s syncSummary) String() string {
+	var parts []string
+	if s.NewApprovals > 0 {
+		parts = append(parts, countNoun(s.NewApprovals, "new approval", "new approvals"))
+	}
+	if s.NewEntities > 0 {
+		parts = append(parts, countNoun(s.NewEntities, "new entity", "new entities"))
+	}
+	if s.EditedEntities > 0 {
+		parts = append(parts, countNoun(s.EditedEntities, "edited entity", "edited entities"))
+	}
+	if len(parts) == 0 {
+		return "no changes"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// countNoun formats n with the singular or plural noun.
+func countNoun(n int, singular, plural string) string {
+	if n == 1 {
+		return "1 " + singular
+	}
+	return strconv.Itoa(n) + " " + plural
+}
+
+// SyncCmd returns the `nebula sync` command, which keeps the local cache and
+// change journal behind the TUI's "while you were away" digest.
+func SyncCmd() *cobra.Command {
+	var once bool
+	var interval time.Duration
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Keep a local cache and journal of remote changes for the TUI",
+		Long: strings.TrimSpace(`Poll pending approvals and entities, cache a snapshot in ~/.nebula/sync, and
+journal what is new or edited since the last pass. The next TUI launch shows
+the journal as a "What changed while you were away" digest. Runs until
+interrupted; use --once from cron or a service manager timer.`),
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, _ []string) error {
+			if interval <= 0 {
+				return fmt.Errorf("--interval must be positive")
+			}
+			client, err := loadCommandClient(true)
+			if err != nil {
+				return err
+			}
+			ctx, stop := signal.NotifyContext(command.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			out := command.OutOrStdout()
+			for {
+				state, err := config.LoadSyncState()
+				if err != nil {
+					return err
+				}
+				now := time.Now()
+				summary, err := runSyncPass(client, state, now)
+				if err != nil {
+					if once {
+						return err
+					}
+					fmt.Fprintf(command.ErrOrStderr(), "%s  sync failed: %v\n", now.Format(time.RFC3339), err)
+				} else {
+					if err := state.Save(); err != nil {
+						return err
+					}
+					fmt.Fprintf(out, "%s  %s\n", now.Format(time.RFC3339), summary)
+				}
+				if once || !syncWait(ctx, interval) {
+					return nil
+				}
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&once, "once", false, "run a single pass and exit")
+	cmd.Flags().DurationVar(&interval, "interval", syncDefaultInterval, "time between passes")
+	cmd.AddCommand(syncNotionCmd(), syncConfluenceCmd())
+	return cmd
+}
+
+// runSyncPass refreshes the cached snapshot and journals what changed since
+// the previous pass. The first pass only seeds the cache.
+func runSyncPass(client *api.Client, state *config.SyncState, now time.Time) (syncSummary, error) {
+	var summary syncSummary
+	approvals, err := client.GetPendingApprovalsWithParams(syncApprovalLimit, 0)
+	if err != nil {
+		return summary, fmt.Errorf("load approvals: %w", err)
+	}
+	entities, err := syncEntities(client)
+	if err != nil {
+		return summary, fmt.Errorf("load entities: %w", err)
+	}
+
+	seeded := !state.LastSync.IsZero()
+	pending := make(map[string]config.SyncRecord, len(approvals))
+	for _, item := range approvals {
+		name := approvalSyncName(item)
+		pending[item.ID] = config.SyncRecord{Name: name, Type: item.RequestType, UpdatedAt: item.CreatedAt}
+		if _, known := state.Approvals[item.ID]; seeded && !known {
+			summary.NewApprovals++
+			state.Record(config.SyncChange{Kind: config.SyncKindApproval, Action: config.SyncActionNew, ID: item.ID, Name: name, At: now})
+		}
+	}
+	state.Approvals = pending
+
+	if state.Entities == nil {
+		state.Entities = map[string]config.SyncRecord{}
+	}
+	for _, item := range entities {
+		updated := item.UpdatedAt
+		if updated.IsZero() {
+			updated = item.CreatedAt
+		}
+		prev, known := state.Entities[item.ID]
+		state.Entities[item.ID] = config.SyncRecord{Name: item.Name, Type: item.Type, UpdatedAt: updated}
+		switch {
+		case !seeded:
+		case !known:
+			summary.NewEntities++
+			state.Record(config.SyncChange{Kind: config.SyncKindEntity, Action: config.SyncActionNew, ID: item.ID, Name: item.Name, At: now})
+		case updated.After(prev.UpdatedAt):
+			summary.EditedEntities++
+			state.Record(config.SyncChange{Kind: config.SyncKindEntity, Action: config.SyncActionEdited, ID: item.ID, Name: item.Name, At: now})
+		}
+	}
+	state.LastSync = now
+	return summary, nil
+}
+
+// syncEntities pages through active entities up to syncEntityCap.
+func syncEntities(client *api.Client) ([]api.Entity, error) {
+	var all []api.Entity
+	for offset := 0; offset < syncEntityCap; offset += syncEntityPageSize {
+		items, err := client.QueryEntities(api.QueryParams{
+			"limit":  strconv.Itoa(syncEntityPageSize),
+			"offset": strconv.Itoa(offset),
+		})
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		if len(items) < syncEntityPageSize {
+			break
+		}
+	}
+	return all, nil
+}
+
+// approvalSyncName labels an approval in the journal.
+func approvalSyncName(item api.Approval) string {
+	by := strings.TrimSpace(item.AgentName)
+	if by == "" {
+		by = strings.TrimSpace(item.RequestedByName)
+	}
+	if by == "" {
+		return item.RequestType
+	}
+	return item.RequestType + " by " + by
+}
@@ -0,0 +1,205 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/connector"
+)
+
+// connectorFlags are the mapping and conflict flags shared by the knowledge
+// connectors.
+type connectorFlags struct {
+	tags         []string
+	scopes       []string
+	defaultScope []string
+	onConflict   string
+	dryRun       bool
+}
+
+// register adds the shared flags to cmd.
+func (f *connectorFlags) register(cmd *cobra.Command) {
+	cmd.Flags().StringArrayVar(&f.tags, "tag", nil, "rename a remote tag, from=to (repeat)")
+	cmd.Flags().StringArrayVar(&f.scopes, "scope", nil, "scope pages carrying a remote tag, tag=scope (repeat)")
+	cmd.Flags().StringSliceVar(&f.defaultScope, "default-scope", nil, "scopes for pages no --scope matches (default public)")
+	cmd.Flags().StringVar(&f.onConflict, "on-conflict", connector.ConflictSkip, "pages edited on both sides: skip, remote, or nebula")
+	cmd.Flags().BoolVar(&f.dryRun, "dry-run", false, "report what would sync without writing anywhere")
+}
+
+// options turns the flags into connector options.
+func (f *connectorFlags) options() (connector.Options, error) {
+	tagMap, err := parseMappingFlags("--tag", "from=to", f.tags)
+	if err != nil {
+		return connector.Options{}, err
+	}
+	scopeMap, err := parseMappingFlags("--scope", "tag=scope", f.scopes)
+	if err != nil {
+		return connector.Options{}, err
+	}
+	return connector.Options{
+		TagMap:     tagMap,
+		ScopeMap:   scopeMap,
+		Scopes:     f.defaultScope,
+		OnConflict: f.onConflict,
+		DryRun:     f.dryRun,
+	}, nil
+}
+
+// parseMappingFlags converts repeated key=value flags into a map.
+func parseMappingFlags(flag, shape string, raw []string) (map[string]string, error) {
+	mapping := map[string]string{}
+	for _, item := range raw {
+		key, value, ok := strings.Cut(item, "=")
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if !ok || key == "" || value == "" {
+			return nil, fmt.Errorf("invalid %s value %q (expected %s)", flag, item, shape)
+		}
+		mapping[key] = value
+	}
+	return mapping, nil
+}
+
+// flagOrEnv returns the flag value, falling back to the environment.
+func flagOrEnv(value, env string) string {
+	if value = strings.TrimSpace(value); value != "" {
+		return value
+	}
+	return strings.TrimSpace(os.Getenv(env))
+}
+
+// syncNotionCmd returns `nebula sync notion`.
+func syncNotionCmd() *cobra.Command {
+	var database, token string
+	var flags connectorFlags
+	cmd := &cobra.Command{
+		Use:   "notion",
+		Short: "Sync a Notion database with Knowledge both ways",
+		Long: strings.TrimSpace(`Pull every page of a Notion database into Knowledge and push edits made in
+Nebula back to Notion. Multi-select properties become tags. Pages edited on
+both sides since the last run are reported as conflicts unless --on-conflict
+picks a side. The page-to-item mapping is kept in ~/.nebula/sync-links.
+The integration token comes from --token or NOTION_TOKEN.`),
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, _ []string) error {
+			if strings.TrimSpace(database) == "" {
+				return fmt.Errorf("--database is required")
+			}
+			token = flagOrEnv(token, "NOTION_TOKEN")
+			if token == "" {
+				return fmt.Errorf("a Notion token is required (--token or NOTION_TOKEN)")
+			}
+			source := connector.NewNotion(token, strings.TrimSpace(database))
+			return runConnectorSync(command, source, flags)
+		},
+	}
+	cmd.Flags().StringVar(&database, "database", "", "Notion database ID")
+	cmd.Flags().StringVar(&token, "token", "", "Notion integration token")
+	flags.register(cmd)
+	return cmd
+}
+
+// syncConfluenceCmd returns `nebula sync confluence`.
+func syncConfluenceCmd() *cobra.Command {
+	var space, baseURL, email, token string
+	var flags connectorFlags
+	cmd := &cobra.Command{
+		Use:   "confluence",
+		Short: "Sync a Confluence space with Knowledge both ways",
+		Long: strings.TrimSpace(`Pull every page of a Confluence space into Knowledge and push edits made in
+Nebula back to Confluence. Labels become tags. Pages edited on both sides
+since the last run are reported as conflicts unless --on-conflict picks a
+side. The page-to-item mapping is kept in ~/.nebula/sync-links.
+Credentials come from the flags or CONFLUENCE_BASE_URL, CONFLUENCE_EMAIL,
+and CONFLUENCE_TOKEN.`),
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, _ []string) error {
+			if strings.TrimSpace(space) == "" {
+				return fmt.Errorf("--space is required")
+			}
+			baseURL = flagOrEnv(baseURL, "CONFLUENCE_BASE_URL")
+			email = flagOrEnv(email, "CONFLUENCE_EMAIL")
+			token = flagOrEnv(token, "CONFLUENCE_TOKEN")
+			if baseURL == "" || email == "" || token == "" {
+				return fmt.Errorf("Confluence base URL, email, and token are required (flags or CONFLUENCE_* env)")
+			}
+			source := connector.NewConfluence(baseURL, email, token, strings.TrimSpace(space))
+			return runConnectorSync(command, source, flags)
+		},
+	}
+	cmd.Flags().StringVar(&space, "space", "", "Confluence space key")
+	cmd.Flags().StringVar(&baseURL, "base-url", "", "site URL, e.g. https://acme.atlassian.net/wiki")
+	cmd.Flags().StringVar(&email, "email", "", "Atlassian account email")
+	cmd.Flags().StringVar(&token, "token", "", "Atlassian API token")
+	flags.register(cmd)
+	return cmd
+}
+
+// runConnectorSync runs one connector pass, saves the mapping table, and
+// prints the report. Conflicts and page errors fail the command.
+func runConnectorSync(command *cobra.Command, source connector.Source, flags connectorFlags) error {
+	opts, err := flags.options()
+	if err != nil {
+		return err
+	}
+	client, err := loadCommandClient(true)
+	if err != nil {
+		return err
+	}
+	links, err := config.LoadSyncLinks()
+	if err != nil {
+		return err
+	}
+	report, err := connector.Sync(client, source, links, opts)
+	if err != nil {
+		return err
+	}
+	if !opts.DryRun {
+		if err := links.Save(); err != nil {
+			return err
+		}
+	}
+	writeConnectorReport(command.OutOrStdout(), source, report, opts.DryRun)
+	if report.Failed() {
+		return fmt.Errorf("%s sync finished with conflicts or errors", source.Name())
+	}
+	return nil
+}
+
+// writeConnectorReport prints the action counts, then every page that was
+// not already in sync.
+func writeConnectorReport(out io.Writer, source connector.Source, report connector.Report, dryRun bool) {
+	counts := report.Counts()
+	var parts []string
+	for _, action := range connector.ActionOrder {
+		if counts[action] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[action], action))
+		}
+	}
+	summary := "no pages"
+	if len(parts) > 0 {
+		summary = strings.Join(parts, ", ")
+	}
+	prefix := ""
+	if dryRun {
+		prefix = "dry run: "
+	}
+	_, _ = fmt.Fprintf(out, "%s%s %s: %s\n", prefix, source.Name(), source.Container(), summary)
+	for _, result := range report.Results {
+		if result.Action == connector.ActionUnchanged {
+			continue
+		}
+		contextID := result.ContextID
+		if contextID == "" {
+			contextID = "-"
+		}
+		_, _ = fmt.Fprintf(out, "  %-9s  %s  %s  %s\n", result.Action, result.PageID, contextID, strings.TrimSpace(result.Title))
+		if result.Detail != "" {
+			_, _ = fmt.Fprintf(out, "             %s\n", result.Detail)
+		}
+	}
+}
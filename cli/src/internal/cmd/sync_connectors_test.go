@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+func TestSyncConfluenceCmdCreatesKnowledgeAndSavesLinks(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	require.NoError(t, (&config.Config{APIKey: "nbl_test", Username: "alxx"}).Save())
+
+	confluence := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"results":[{"id":"42","title":"Onboarding","version":{"number":1},
+			"body":{"storage":{"value":"<p>Welcome.</p>"}},
+			"metadata":{"labels":{"results":[{"name":"internal"}]}}}]}`))
+	}))
+	defer confluence.Close()
+
+	var created map[string]any
+	shutdown := startDefaultAPIBaseServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/context", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+			"id": "ctx-1", "title": created["title"], "content": created["content"],
+		}}))
+	}))
+	defer shutdown()
+
+	run := func(args ...string) (string, error) {
+		var out bytes.Buffer
+		cmd := SyncCmd()
+		cmd.SetOut(&out)
+		cmd.SetErr(&out)
+		cmd.SetArgs(append([]string{"confluence", "--space", "ENG", "--base-url", confluence.URL,
+			"--email", "ada@example.com", "--token", "secret", "--scope", "internal=private"}, args...))
+		err := cmd.Execute()
+		return out.String(), err
+	}
+
+	out, err := run("--dry-run")
+	require.NoError(t, err)
+	assert.Contains(t, out, "dry run: confluence ENG: 1 created")
+	assert.Nil(t, created)
+
+	out, err = run()
+	require.NoError(t, err)
+	assert.Contains(t, out, "confluence ENG: 1 created")
+	assert.Contains(t, out, "ctx-1  Onboarding")
+	assert.Equal(t, []any{"private"}, created["scopes"])
+	assert.Equal(t, "Welcome.", created["content"])
+
+	links, err := config.LoadSyncLinks()
+	require.NoError(t, err)
+	link, ok := links.Link("confluence", "42")
+	require.True(t, ok)
+	assert.Equal(t, "ctx-1", link.ContextID)
+	assert.Equal(t, "ENG", link.Container)
+}
+
+func TestSyncConnectorCmdsValidateFlags(t *testing.T) {
+	for _, env := range []string{"NOTION_TOKEN", "CONFLUENCE_BASE_URL", "CONFLUENCE_EMAIL", "CONFLUENCE_TOKEN"} {
+		t.Setenv(env, "")
+	}
+	cases := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"notion"}, "--database is required"},
+		{[]string{"notion", "--database", "db-1"}, "a Notion token is required"},
+		{[]string{"confluence", "--space", "ENG"}, "base URL, email, and token are required"},
+		{[]string{"notion", "--database", "db-1", "--token", "t", "--tag", "ops"}, `invalid --tag value "ops"`},
+	}
+	for _, tc := range cases {
+		cmd := SyncCmd()
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs(tc.args)
+		assert.ErrorContains(t, cmd.Execute(), tc.want, tc.args)
+	}
+}
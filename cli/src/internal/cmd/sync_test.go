@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+func TestSyncCmdJournalsChangesAfterFirstPass(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	require.NoError(t, (&config.Config{APIKey: "nbl_test", Username: "alxx"}).Save())
+
+	base := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	approvals := []map[string]any{{"id": "ap-1", "request_type": "create_entity", "agent_name": "scout", "created_at": base}}
+	entities := []map[string]any{{"id": "ent-1", "name": "Alpha", "type": "person", "updated_at": base}}
+	shutdown := startDefaultAPIBaseServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data any = []map[string]any{}
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/api/approvals/pending"):
+			data = approvals
+		case strings.HasPrefix(r.URL.Path, "/api/entities"):
+			data = entities
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": data}))
+	}))
+	defer shutdown()
+
+	run := func() string {
+		var out bytes.Buffer
+		cmd := SyncCmd()
+		cmd.SetOut(&out)
+		cmd.SetArgs([]string{"--once"})
+		require.NoError(t, cmd.Execute())
+		return out.String()
+	}
+
+	assert.Contains(t, run(), "no changes")
+	state, err := config.LoadSyncState()
+	require.NoError(t, err)
+	assert.Empty(t, state.Journal)
+	assert.Contains(t, state.Entities, "ent-1")
+
+	approvals = append(approvals, map[string]any{"id": "ap-2", "request_type": "update_entity", "requested_by_name": "Sam", "created_at": base})
+	entities[0]["updated_at"] = base.Add(time.Hour)
+	entities = append(entities, map[string]any{"id": "ent-2", "name": "Beta", "type": "project", "updated_at": base})
+	assert.Contains(t, run(), "1 new approval, 1 new entity, 1 edited entity")
+
+	state, err = config.LoadSyncState()
+	require.NoError(t, err)
+	require.Len(t, state.Journal, 3)
+	assert.Equal(t, config.SyncChange{Kind: config.SyncKindApproval, Action: config.SyncActionNew, ID: "ap-2", Name: "update_entity by Sam", At: state.Journal[0].At}, state.Journal[0])
+}
+
+func TestSyncCmdRejectsNonPositiveInterval(t *testing.T) {
+	cmd := SyncCmd()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"--interval", "0s"})
+	assert.ErrorContains(t, cmd.Execute(), "--interval must be positive")
+}
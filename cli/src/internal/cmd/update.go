@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/update"
+	"github.com/gravitrone/nebula-core/cli/internal/version"
+)
+
+// updateExecutablePath locates the binary to replace. Tests point it at a temp
+// file.
+var updateExecutablePath = update.Executable
+
+// UpdateCmd returns the `nebula update` command.
+func UpdateCmd() *cobra.Command {
+	var checkOnly, allowUnsigned bool
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Update the nebula CLI to the latest release",
+		Long: strings.TrimSpace(`Check the release endpoint for a newer nebula build, download the binary for
+this platform, verify it against the signed release checksums, and replace the
+running executable. Builds without a release key refuse to update unless
+--allow-unsigned is passed.
+Set ` + update.EndpointEnv + ` to use a different release endpoint.`),
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, _ []string) error {
+			ctx := command.Context()
+			release, err := update.Latest(ctx, update.Endpoint())
+			if err != nil {
+				return err
+			}
+			check := &config.UpdateCheck{CheckedAt: time.Now(), Latest: release.Version}
+			_ = check.Save()
+
+			out := command.OutOrStdout()
+			if !version.Newer(release.Version, version.Version) {
+				fmt.Fprintf(out, "nebula %s is up to date\n", version.Version)
+				return nil
+			}
+			if checkOnly {
+				fmt.Fprintf(out, "nebula %s is available (current %s)\n", release.Version, version.Version)
+				return nil
+			}
+
+			path, err := updateExecutablePath()
+			if err != nil {
+				return err
+			}
+			binary, signed, err := update.Download(ctx, release, allowUnsigned)
+			if errors.Is(err, update.ErrUnsigned) {
+				return fmt.Errorf("%w; install a release build or pass --allow-unsigned to trust the checksum alone", err)
+			}
+			if err != nil {
+				return err
+			}
+			if !signed {
+				fmt.Fprintln(command.ErrOrStderr(), "warning: this build has no release key; only the checksum was verified")
+			}
+			if err := update.Install(path, binary); err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "updated nebula %s -> %s\n", version.Version, release.Version)
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&checkOnly, "check", false, "only report whether a newer release exists")
+	cmd.Flags().BoolVar(&allowUnsigned, "allow-unsigned", false, "update without a release key, checking only the checksum")
+	return cmd
+}
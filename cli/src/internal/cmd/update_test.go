@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/update"
+)
+
+func TestUpdateCmdReplacesExecutable(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	binary := []byte("nebula-next")
+	name := update.BinaryName(runtime.GOOS, runtime.GOARCH)
+	sum := sha256.Sum256(binary)
+
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/latest":
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+				"tag_name": "v9.0.0",
+				"assets": []map[string]any{
+					{"name": name, "browser_download_url": srv.URL + "/bin"},
+					{"name": "checksums.txt", "browser_download_url": srv.URL + "/sums"},
+				},
+			}))
+		case "/bin":
+			_, _ = w.Write(binary)
+		case "/sums":
+			_, _ = fmt.Fprintf(w, "%s  %s\n", hex.EncodeToString(sum[:]), name)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	t.Setenv(update.EndpointEnv, srv.URL+"/latest")
+
+	exe := filepath.Join(t.TempDir(), "nebula")
+	require.NoError(t, os.WriteFile(exe, []byte("nebula-old"), 0o755))
+	prev := updateExecutablePath
+	updateExecutablePath = func() (string, error) { return exe, nil }
+	t.Cleanup(func() { updateExecutablePath = prev })
+
+	run := func(args ...string) (string, string, error) {
+		var out, errOut bytes.Buffer
+		cmd := UpdateCmd()
+		cmd.SetOut(&out)
+		cmd.SetErr(&errOut)
+		cmd.SetArgs(args)
+		err := cmd.Execute()
+		return out.String(), errOut.String(), err
+	}
+
+	out, _, err := run("--check")
+	require.NoError(t, err)
+	assert.Contains(t, out, "nebula v9.0.0 is available")
+	data, err := os.ReadFile(exe)
+	require.NoError(t, err)
+	assert.Equal(t, "nebula-old", string(data))
+
+	_, _, err = run()
+	require.ErrorIs(t, err, update.ErrUnsigned)
+	assert.ErrorContains(t, err, "--allow-unsigned")
+	data, err = os.ReadFile(exe)
+	require.NoError(t, err)
+	assert.Equal(t, "nebula-old", string(data))
+
+	out, errOut, err := run("--allow-unsigned")
+	require.NoError(t, err)
+	assert.Contains(t, out, "-> v9.0.0")
+	assert.Contains(t, errOut, "only the checksum was verified")
+	data, err = os.ReadFile(exe)
+	require.NoError(t, err)
+	assert.Equal(t, "nebula-next", string(data))
+
+	check, err := config.LoadUpdateCheck()
+	require.NoError(t, err)
+	assert.Equal(t, "v9.0.0", check.Latest)
+}
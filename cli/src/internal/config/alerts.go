@@ -0,0 +1,37 @@
+package config
+
+import "strings"
+
+// Approval alert modes for approval_alerts.
+const (
+	// ApprovalAlertsOn rings the terminal bell and flashes the Inbox tab.
+	ApprovalAlertsOn = "on"
+	// ApprovalAlertsFlash flashes the Inbox tab without the bell.
+	ApprovalAlertsFlash = "flash"
+	// ApprovalAlertsOff leaves high-priority approvals to the Inbox.
+	ApprovalAlertsOff = "off"
+)
+
+// ApprovalAlertMode returns how the TUI announces high-priority approvals.
+// Unset or unknown values mean ApprovalAlertsOn.
+func (c *Config) ApprovalAlertMode() string {
+	if c == nil {
+		return ApprovalAlertsOn
+	}
+	switch mode := strings.ToLower(strings.TrimSpace(c.ApprovalAlerts)); mode {
+	case ApprovalAlertsFlash, ApprovalAlertsOff:
+		return mode
+	}
+	return ApprovalAlertsOn
+}
+
+// NextApprovalAlertMode cycles on, flash, off.
+func NextApprovalAlertMode(mode string) string {
+	switch mode {
+	case ApprovalAlertsOn:
+		return ApprovalAlertsFlash
+	case ApprovalAlertsFlash:
+		return ApprovalAlertsOff
+	}
+	return ApprovalAlertsOn
+}
@@ -0,0 +1,20 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApprovalAlertMode(t *testing.T) {
+	var nilCfg *Config
+	assert.Equal(t, ApprovalAlertsOn, nilCfg.ApprovalAlertMode())
+	assert.Equal(t, ApprovalAlertsOn, (&Config{}).ApprovalAlertMode())
+	assert.Equal(t, ApprovalAlertsFlash, (&Config{ApprovalAlerts: " Flash "}).ApprovalAlertMode())
+	assert.Equal(t, ApprovalAlertsOff, (&Config{ApprovalAlerts: "off"}).ApprovalAlertMode())
+	assert.Equal(t, ApprovalAlertsOn, (&Config{ApprovalAlerts: "loud"}).ApprovalAlertMode())
+
+	assert.Equal(t, ApprovalAlertsFlash, NextApprovalAlertMode(ApprovalAlertsOn))
+	assert.Equal(t, ApprovalAlertsOff, NextApprovalAlertMode(ApprovalAlertsFlash))
+	assert.Equal(t, ApprovalAlertsOn, NextApprovalAlertMode(ApprovalAlertsOff))
+}
@@ -4,21 +4,66 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
 
 var marshalConfigYAML = yaml.Marshal
 
+// ProfileEnv selects a named profile for the current process.
+const ProfileEnv = "NEBULA_PROFILE"
+
+// DefaultProfile names the credentials stored at the top level of the config.
+const DefaultProfile = "default"
+
+// Profile holds the connection settings for one named environment.
+type Profile struct {
+	APIURL       string `yaml:"api_url,omitempty"`
+	APIKey       string `yaml:"api_key,omitempty"`
+	UserEntityID string `yaml:"user_entity_id,omitempty"`
+	Username     string `yaml:"username,omitempty"`
+	KeyInKeyring bool   `yaml:"api_key_in_keyring,omitempty"`
+
+	RefreshToken   string    `yaml:"refresh_token,omitempty"`
+	TokenExpiresAt time.Time `yaml:"token_expires_at,omitempty"`
+}
+
 // Config holds CLI configuration stored at ~/.nebula/config.
 type Config struct {
-	APIKey            string `yaml:"api_key"`
-	UserEntityID      string `yaml:"user_entity_id"`
-	Username          string `yaml:"username"`
-	Theme             string `yaml:"theme"`
-	VimKeys           bool   `yaml:"vim_keys"`
-	QuickstartPending bool   `yaml:"quickstart_pending,omitempty"`
-	PendingLimit      int    `yaml:"pending_limit,omitempty"`
+	APIURL            string                     `yaml:"api_url,omitempty"`
+	APIKey            string                     `yaml:"api_key"`
+	UserEntityID      string                     `yaml:"user_entity_id"`
+	Username          string                     `yaml:"username"`
+	Theme             string                     `yaml:"theme"`
+	VimKeys           bool                       `yaml:"vim_keys"`
+	Accessible        bool                       `yaml:"accessible,omitempty"`
+	QuickstartPending bool                       `yaml:"quickstart_pending,omitempty"`
+	PendingLimit      int                        `yaml:"pending_limit,omitempty"`
+	KeyInKeyring      bool                       `yaml:"api_key_in_keyring,omitempty"`
+	RefreshToken      string                     `yaml:"refresh_token,omitempty"`
+	TokenExpiresAt    time.Time                  `yaml:"token_expires_at,omitempty"`
+	ActiveProfile     string                     `yaml:"active_profile,omitempty"`
+	Profiles          map[string]Profile         `yaml:"profiles,omitempty"`
+	Templates         map[string]Template        `yaml:"templates,omitempty"`
+	AutoRefresh       map[string]string          `yaml:"auto_refresh,omitempty"`
+	ToastDuration     string                     `yaml:"toast_duration,omitempty"`
+	ApprovalAlerts    string                     `yaml:"approval_alerts,omitempty"`
+	Defaults          FormDefaults               `yaml:"defaults,omitempty"`
+	RequestTimeout    string                     `yaml:"request_timeout,omitempty"`
+	TableSort         map[string]string          `yaml:"table_sort,omitempty"`
+	TableColumns      map[string]string          `yaml:"table_columns,omitempty"`
+	NerdFont          bool                       `yaml:"nerd_font,omitempty"`
+	EntityTypes       map[string]EntityTypeStyle `yaml:"entity_types,omitempty"`
+	Webhooks          []Webhook                  `yaml:"webhooks,omitempty"`
+	Pins              []Pin                      `yaml:"pins,omitempty"`
+	Watches           []Watch                    `yaml:"watches,omitempty"`
+
+	// Profile is the named profile overlaid on the top-level fields, empty for default.
+	Profile string `yaml:"-"`
+	base    Profile
 }
 
 // Path returns the config file path.
@@ -29,6 +74,36 @@ func Path() string {
 
 // Load reads and parses the config file. Returns error if missing or insecure.
 func Load() (*Config, error) {
+	cfg, err := LoadFile()
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.applyProfile(SelectedProfile(cfg)); err != nil {
+		return nil, err
+	}
+	if cfg.KeyInKeyring && cfg.APIKey == "" {
+		key, err := keyring.Get(keyringService, keyringAccount(cfg.Profile))
+		if err != nil {
+			return nil, fmt.Errorf("read api_key from keyring: %w", err)
+		}
+		cfg.APIKey = key
+	}
+
+	if cfg.APIKey == "" {
+		if cfg.Profile != "" {
+			return nil, fmt.Errorf("config missing api_key for profile %q", cfg.Profile)
+		}
+		return nil, fmt.Errorf("config missing api_key")
+	}
+	if cfg.PendingLimit <= 0 {
+		cfg.PendingLimit = 500
+	}
+
+	return cfg, nil
+}
+
+// LoadFile reads the config file as stored, without applying a profile.
+func LoadFile() (*Config, error) {
 	path := Path()
 
 	info, err := os.Stat(path)
@@ -50,15 +125,142 @@ func Load() (*Config, error) {
 	if err := yaml.Unmarshal(data, &cfg); err != nil {
 		return nil, fmt.Errorf("parse config: %w", err)
 	}
+	return &cfg, nil
+}
+
+// LoadForLogin returns the config a login should update, creating defaults when missing.
+func LoadForLogin() (*Config, error) {
+	cfg := &Config{
+		Theme:             "dark",
+		QuickstartPending: true,
+		PendingLimit:      500,
+	}
+	if _, err := os.Stat(Path()); err == nil {
+		loaded, err := LoadFile()
+		if err != nil {
+			return nil, err
+		}
+		cfg = loaded
+	}
+	if err := cfg.applyProfile(SelectedProfile(cfg)); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
 
-	if cfg.APIKey == "" {
-		return nil, fmt.Errorf("config missing api_key")
+// ActiveAPIURL returns the API URL of the selected profile, empty when unset.
+func ActiveAPIURL() string {
+	cfg, err := LoadFile()
+	if err != nil {
+		return ""
 	}
-	if cfg.PendingLimit <= 0 {
-		cfg.PendingLimit = 500
+	if err := cfg.applyProfile(SelectedProfile(cfg)); err != nil {
+		return ""
+	}
+	return strings.TrimSpace(cfg.APIURL)
+}
+
+// SelectedProfile returns the profile chosen by NEBULA_PROFILE or the stored active profile.
+func SelectedProfile(cfg *Config) string {
+	if name := strings.TrimSpace(os.Getenv(ProfileEnv)); name != "" {
+		return name
+	}
+	if cfg == nil {
+		return ""
 	}
+	return strings.TrimSpace(cfg.ActiveProfile)
+}
 
-	return &cfg, nil
+// ProfileNames returns the default profile followed by named profiles in order.
+func (c *Config) ProfileNames() []string {
+	names := make([]string, 0, len(c.Profiles)+1)
+	for name := range c.Profiles {
+		if name == DefaultProfile {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return append([]string{DefaultProfile}, names...)
+}
+
+// HasProfiles reports whether any named profiles are configured.
+func (c *Config) HasProfiles() bool {
+	return c != nil && len(c.Profiles) > 0
+}
+
+// ProfileLabel returns the display name of the profile in use.
+func (c *Config) ProfileLabel() string {
+	if c == nil || c.Profile == "" {
+		return DefaultProfile
+	}
+	return c.Profile
+}
+
+// UseProfile marks a profile as the one selected when no override is given.
+func (c *Config) UseProfile(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" || name == DefaultProfile {
+		c.ActiveProfile = ""
+		return nil
+	}
+	if _, ok := c.Profiles[name]; !ok {
+		return fmt.Errorf("profile %q not found", name)
+	}
+	c.ActiveProfile = name
+	return nil
+}
+
+// SetProfile creates or replaces a named profile.
+func (c *Config) SetProfile(name string, profile Profile) error {
+	name = strings.TrimSpace(name)
+	if name == "" || name == DefaultProfile {
+		return fmt.Errorf("profile name %q is reserved", DefaultProfile)
+	}
+	if c.Profiles == nil {
+		c.Profiles = map[string]Profile{}
+	}
+	c.Profiles[name] = profile
+	return nil
+}
+
+// applyProfile overlays a named profile on the top-level connection fields.
+func (c *Config) applyProfile(name string) error {
+	if name == "" || name == DefaultProfile {
+		return nil
+	}
+	profile, ok := c.Profiles[name]
+	if !ok {
+		return fmt.Errorf("profile %q not found", name)
+	}
+	c.base = c.connection()
+	c.APIURL = profile.APIURL
+	c.APIKey = profile.APIKey
+	c.UserEntityID = profile.UserEntityID
+	c.Username = profile.Username
+	c.KeyInKeyring = profile.KeyInKeyring
+	c.RefreshToken = profile.RefreshToken
+	c.TokenExpiresAt = profile.TokenExpiresAt
+	c.Profile = name
+	return nil
+}
+
+// connection returns the top-level connection fields as a profile.
+func (c *Config) connection() Profile {
+	return Profile{
+		APIURL:         c.APIURL,
+		APIKey:         c.APIKey,
+		UserEntityID:   c.UserEntityID,
+		Username:       c.Username,
+		KeyInKeyring:   c.KeyInKeyring,
+		RefreshToken:   c.RefreshToken,
+		TokenExpiresAt: c.TokenExpiresAt,
+	}
+}
+
+// UsesSSO reports whether the active credentials came from an SSO login.
+func (c *Config) UsesSSO() bool {
+	return c != nil && c.RefreshToken != ""
 }
 
 // Save writes the config to disk with secure permissions.
@@ -73,10 +275,102 @@ func (c *Config) Save() error {
 		return fmt.Errorf("create config dir: %w", err)
 	}
 
-	data, err := marshalConfigYAML(c)
+	data, err := marshalConfigYAML(c.stored())
 	if err != nil {
 		return fmt.Errorf("marshal config: %w", err)
 	}
 
 	return os.WriteFile(path, data, 0600)
 }
+
+// stored returns the on-disk shape, moving overlaid profile fields back in place
+// and keyring-backed API keys out of the file.
+func (c *Config) stored() *Config {
+	out := *c
+	out.Profiles = make(map[string]Profile, len(c.Profiles))
+	for name, profile := range c.Profiles {
+		out.Profiles[name] = profile
+	}
+	if c.Profile != "" {
+		out.Profiles[c.Profile] = c.connection()
+		out.APIURL = c.base.APIURL
+		out.APIKey = c.base.APIKey
+		out.UserEntityID = c.base.UserEntityID
+		out.Username = c.base.Username
+		out.KeyInKeyring = c.base.KeyInKeyring
+		out.RefreshToken = c.base.RefreshToken
+		out.TokenExpiresAt = c.base.TokenExpiresAt
+		out.Profile = ""
+	}
+	if len(out.Profiles) == 0 {
+		out.Profiles = nil
+	}
+
+	out.APIKey, out.KeyInKeyring = storeKeyringSecret(DefaultProfile, out.APIKey, out.KeyInKeyring)
+	for name, profile := range out.Profiles {
+		profile.APIKey, profile.KeyInKeyring = storeKeyringSecret(name, profile.APIKey, profile.KeyInKeyring)
+		out.Profiles[name] = profile
+	}
+	return &out
+}
+
+// storeKeyringSecret moves a keyring-backed key out of the file, keeping it in
+// plaintext when the keyring write fails so the key is never lost.
+func storeKeyringSecret(profile, key string, inKeyring bool) (string, bool) {
+	if !inKeyring || key == "" {
+		return key, inKeyring
+	}
+	if !KeyringAvailable() {
+		return key, false
+	}
+	if err := keyring.Set(keyringService, keyringAccount(profile), key); err != nil {
+		return key, false
+	}
+	return "", true
+}
+
+// MigrateAPIKeys moves plaintext API keys into the OS keyring when one is available.
+func MigrateAPIKeys() (int, error) {
+	if !KeyringAvailable() {
+		return 0, nil
+	}
+	if _, err := os.Stat(Path()); err != nil {
+		return 0, nil
+	}
+	cfg, err := LoadFile()
+	if err != nil {
+		return 0, err
+	}
+	pending := 0
+	if cfg.APIKey != "" && !cfg.KeyInKeyring {
+		cfg.KeyInKeyring = true
+		pending++
+	}
+	for name, profile := range cfg.Profiles {
+		if profile.APIKey != "" && !profile.KeyInKeyring {
+			profile.KeyInKeyring = true
+			cfg.Profiles[name] = profile
+			pending++
+		}
+	}
+	if pending == 0 {
+		return 0, nil
+	}
+	if err := cfg.Save(); err != nil {
+		return 0, err
+	}
+	stored, err := LoadFile()
+	if err != nil {
+		return 0, err
+	}
+	migrated := 0
+	if stored.KeyInKeyring && stored.APIKey == "" && cfg.APIKey != "" {
+		migrated++
+	}
+	for name, profile := range stored.Profiles {
+		if profile.KeyInKeyring && profile.APIKey == "" && cfg.Profiles[name].APIKey != "" {
+			migrated++
+		}
+	}
+	return migrated, nil
+}
@@ -0,0 +1,86 @@
+package config
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// TestLoadAppliesSelectedProfileAndSaveKeepsDefault handles test load applies selected profile and save keeps default.
+func TestLoadAppliesSelectedProfileAndSaveKeepsDefault(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(ProfileEnv, "")
+
+	cfg := Config{
+		APIKey:   "prod-key",
+		Username: "alxx",
+		Profiles: map[string]Profile{
+			"staging": {APIURL: "https://staging.example.com", APIKey: "stg-key", Username: "alxx-stg"},
+		},
+	}
+	require.NoError(t, cfg.Save())
+
+	loaded, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, "prod-key", loaded.APIKey)
+	assert.Equal(t, DefaultProfile, loaded.ProfileLabel())
+
+	t.Setenv(ProfileEnv, "staging")
+	loaded, err = Load()
+	require.NoError(t, err)
+	assert.Equal(t, "stg-key", loaded.APIKey)
+	assert.Equal(t, "https://staging.example.com", loaded.APIURL)
+	assert.Equal(t, "staging", loaded.ProfileLabel())
+	assert.Equal(t, "https://staging.example.com", ActiveAPIURL())
+
+	loaded.APIKey = "stg-key-2"
+	require.NoError(t, loaded.Save())
+
+	data, err := os.ReadFile(Path())
+	require.NoError(t, err)
+	var stored Config
+	require.NoError(t, yaml.Unmarshal(data, &stored))
+	assert.Equal(t, "prod-key", stored.APIKey)
+	assert.Equal(t, "alxx", stored.Username)
+	assert.Equal(t, "stg-key-2", stored.Profiles["staging"].APIKey)
+
+	t.Setenv(ProfileEnv, "missing")
+	_, err = Load()
+	assert.ErrorContains(t, err, `profile "missing" not found`)
+}
+
+// TestUseProfileAndSetProfile handles test use profile and set profile.
+func TestUseProfileAndSetProfile(t *testing.T) {
+	t.Setenv(ProfileEnv, "")
+	cfg := &Config{}
+
+	assert.Error(t, cfg.SetProfile(DefaultProfile, Profile{}))
+	require.NoError(t, cfg.SetProfile("staging", Profile{APIURL: "https://staging"}))
+	assert.Equal(t, []string{DefaultProfile, "staging"}, cfg.ProfileNames())
+	assert.True(t, cfg.HasProfiles())
+
+	assert.Error(t, cfg.UseProfile("prod"))
+	require.NoError(t, cfg.UseProfile("staging"))
+	assert.Equal(t, "staging", SelectedProfile(cfg))
+	require.NoError(t, cfg.UseProfile(DefaultProfile))
+	assert.Equal(t, "", cfg.ActiveProfile)
+}
+
+// TestLoadForLoginReturnsDefaultsWhenMissing handles test load for login returns defaults when missing.
+func TestLoadForLoginReturnsDefaultsWhenMissing(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(ProfileEnv, "")
+
+	cfg, err := LoadForLogin()
+	require.NoError(t, err)
+	assert.Equal(t, "dark", cfg.Theme)
+	assert.True(t, cfg.QuickstartPending)
+	assert.Equal(t, 500, cfg.PendingLimit)
+
+	t.Setenv(ProfileEnv, "staging")
+	_, err = LoadForLogin()
+	assert.Error(t, err)
+}
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"slices"
+	"strings"
+)
+
+// FallbackScopes are the scopes a new record gets when neither the form nor
+// the configured defaults name any.
+var FallbackScopes = []string{"private"}
+
+// FormDefaults pre-fill the entity and knowledge create forms and fill the
+// gaps of headless create payloads. Status is the entity status.
+type FormDefaults struct {
+	Scopes        []string `yaml:"scopes,omitempty"`
+	EntityType    string   `yaml:"entity_type,omitempty"`
+	KnowledgeType string   `yaml:"knowledge_type,omitempty"`
+	Status        string   `yaml:"status,omitempty"`
+}
+
+// FormDefaults returns the configured create defaults, trimmed.
+func (c *Config) FormDefaults() FormDefaults {
+	if c == nil {
+		return FormDefaults{}
+	}
+	d := c.Defaults
+	var scopes []string
+	for _, scope := range d.Scopes {
+		if scope = strings.TrimSpace(scope); scope != "" && !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	return FormDefaults{
+		Scopes:        scopes,
+		EntityType:    strings.TrimSpace(d.EntityType),
+		KnowledgeType: strings.ToLower(strings.TrimSpace(d.KnowledgeType)),
+		Status:        strings.ToLower(strings.TrimSpace(d.Status)),
+	}
+}
+
+// ScopesOrFallback returns the default scopes, or FallbackScopes when none
+// are configured.
+func (d FormDefaults) ScopesOrFallback() []string {
+	if len(d.Scopes) > 0 {
+		return slices.Clone(d.Scopes)
+	}
+	return slices.Clone(FallbackScopes)
+}
@@ -0,0 +1,31 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormDefaultsNormalizes(t *testing.T) {
+	var nilCfg *Config
+	assert.Equal(t, FormDefaults{}, nilCfg.FormDefaults())
+	assert.Equal(t, []string{"private"}, FormDefaults{}.ScopesOrFallback())
+
+	cfg := &Config{Defaults: FormDefaults{
+		Scopes:        []string{" work ", "", "work", "public"},
+		EntityType:    " Project ",
+		KnowledgeType: " Paper",
+		Status:        "Inactive ",
+	}}
+	d := cfg.FormDefaults()
+	assert.Equal(t, FormDefaults{
+		Scopes:        []string{"work", "public"},
+		EntityType:    "Project",
+		KnowledgeType: "paper",
+		Status:        "inactive",
+	}, d)
+
+	scopes := d.ScopesOrFallback()
+	scopes[0] = "changed"
+	assert.Equal(t, []string{"work", "public"}, d.Scopes)
+}
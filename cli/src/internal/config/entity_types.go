@@ -0,0 +1,33 @@
+package config
+
+import (
+	"regexp"
+	"strings"
+)
+
+// EntityTypeStyle overrides how one entity type is drawn in the TUI. Color is
+// a hex code like "#7f57b4" or an ANSI color number; Icon is any short glyph.
+type EntityTypeStyle struct {
+	Color string `yaml:"color,omitempty"`
+	Icon  string `yaml:"icon,omitempty"`
+}
+
+var entityTypeColorPattern = regexp.MustCompile(`^(#[0-9a-fA-F]{6}|#[0-9a-fA-F]{3}|[0-9]{1,3})$`)
+
+// EntityTypeStyleFor returns the configured style for an entity type, with
+// an invalid color dropped so a typo falls back to the built-in color.
+func (c *Config) EntityTypeStyleFor(typ string) (EntityTypeStyle, bool) {
+	if c == nil {
+		return EntityTypeStyle{}, false
+	}
+	style, ok := c.EntityTypes[strings.ToLower(strings.TrimSpace(typ))]
+	if !ok {
+		return EntityTypeStyle{}, false
+	}
+	style.Color = strings.TrimSpace(style.Color)
+	if !entityTypeColorPattern.MatchString(style.Color) {
+		style.Color = ""
+	}
+	style.Icon = strings.TrimSpace(style.Icon)
+	return style, true
+}
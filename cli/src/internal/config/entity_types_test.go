@@ -0,0 +1,34 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestEntityTypeStyleForNormalizesEntries handles test entity type style for normalizes entries.
+func TestEntityTypeStyleForNormalizesEntries(t *testing.T) {
+	cfg := &Config{EntityTypes: map[string]EntityTypeStyle{
+		"person": {Color: " #ff8800 ", Icon: " P "},
+		"tool":   {Color: "orange", Icon: "T"},
+		"idea":   {Color: "212"},
+	}}
+
+	style, ok := cfg.EntityTypeStyleFor("Person")
+	assert.True(t, ok)
+	assert.Equal(t, EntityTypeStyle{Color: "#ff8800", Icon: "P"}, style)
+
+	style, ok = cfg.EntityTypeStyleFor("tool")
+	assert.True(t, ok)
+	assert.Equal(t, EntityTypeStyle{Icon: "T"}, style)
+
+	style, _ = cfg.EntityTypeStyleFor("idea")
+	assert.Equal(t, "212", style.Color)
+
+	_, ok = cfg.EntityTypeStyleFor("paper")
+	assert.False(t, ok)
+
+	var nilCfg *Config
+	_, ok = nilCfg.EntityTypeStyleFor("person")
+	assert.False(t, ok)
+}
@@ -0,0 +1,98 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// InboxState holds local inbox triage: snoozed approvals. It lives next to
+// the config so it survives restarts, but never leaves this machine.
+// Delegation is shared with other reviewers and lives on the server.
+type InboxState struct {
+	Snoozes map[string]time.Time `yaml:"snoozes,omitempty"`
+}
+
+// InboxStatePath returns the inbox state file path.
+func InboxStatePath() string {
+	return filepath.Join(filepath.Dir(Path()), "inbox")
+}
+
+// LoadInboxState reads the inbox state, returning an empty state when missing.
+func LoadInboxState() (*InboxState, error) {
+	state := &InboxState{}
+	data, err := os.ReadFile(InboxStatePath())
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return state, fmt.Errorf("read inbox state: %w", err)
+	}
+	if err := yaml.Unmarshal(data, state); err != nil {
+		return &InboxState{}, fmt.Errorf("parse inbox state: %w", err)
+	}
+	return state, nil
+}
+
+// Save writes the inbox state with the same permissions as the config.
+func (s *InboxState) Save() error {
+	path := InboxStatePath()
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("create config dir: %w", err)
+	}
+	data, err := yaml.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("marshal inbox state: %w", err)
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// Snooze hides an approval until the given time.
+func (s *InboxState) Snooze(id string, until time.Time) {
+	if s.Snoozes == nil {
+		s.Snoozes = map[string]time.Time{}
+	}
+	s.Snoozes[strings.TrimSpace(id)] = until
+}
+
+// Unsnooze brings an approval back into the inbox.
+func (s *InboxState) Unsnooze(id string) {
+	delete(s.Snoozes, strings.TrimSpace(id))
+}
+
+// SnoozedUntil returns when a snooze ends, if the approval is snoozed at now.
+func (s *InboxState) SnoozedUntil(id string, now time.Time) (time.Time, bool) {
+	if s == nil {
+		return time.Time{}, false
+	}
+	until, ok := s.Snoozes[strings.TrimSpace(id)]
+	if !ok || !until.After(now) {
+		return time.Time{}, false
+	}
+	return until, true
+}
+
+// Prune drops expired snoozes and entries for approvals no longer pending.
+// It reports whether anything changed.
+func (s *InboxState) Prune(pending []string, now time.Time) bool {
+	if s == nil {
+		return false
+	}
+	open := make(map[string]bool, len(pending))
+	for _, id := range pending {
+		open[strings.TrimSpace(id)] = true
+	}
+	changed := false
+	for id, until := range s.Snoozes {
+		if !until.After(now) || !open[id] {
+			delete(s.Snoozes, id)
+			changed = true
+		}
+	}
+	return changed
+}
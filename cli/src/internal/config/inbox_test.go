@@ -0,0 +1,55 @@
+package config
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestInboxStateRoundTrip handles test inbox state round trip.
+func TestInboxStateRoundTrip(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	empty, err := LoadInboxState()
+	require.NoError(t, err)
+	assert.Empty(t, empty.Snoozes)
+
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	state := &InboxState{}
+	state.Snooze("ap-1", now.Add(2*time.Hour))
+	require.NoError(t, state.Save())
+
+	info, err := os.Stat(InboxStatePath())
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	loaded, err := LoadInboxState()
+	require.NoError(t, err)
+	until, ok := loaded.SnoozedUntil("ap-1", now)
+	require.True(t, ok)
+	assert.True(t, until.Equal(now.Add(2*time.Hour)))
+	_, ok = loaded.SnoozedUntil("ap-1", now.Add(3*time.Hour))
+	assert.False(t, ok)
+}
+
+// TestInboxStatePrune handles test inbox state prune.
+func TestInboxStatePrune(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	state := &InboxState{}
+	state.Snooze("ap-1", now.Add(time.Hour))
+	state.Snooze("ap-2", now.Add(-time.Hour))
+	state.Snooze("ap-3", now.Add(time.Hour))
+
+	assert.True(t, state.Prune([]string{"ap-1", "ap-2"}, now))
+	assert.Len(t, state.Snoozes, 1)
+	assert.Contains(t, state.Snoozes, "ap-1")
+	assert.False(t, state.Prune([]string{"ap-1"}, now))
+
+	var missing *InboxState
+	_, ok := missing.SnoozedUntil("ap-1", now)
+	assert.False(t, ok)
+	assert.False(t, missing.Prune(nil, now))
+}
@@ -0,0 +1,90 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+
+	gokeyring "github.com/zalando/go-keyring"
+)
+
+// KeyringEnv disables OS keyring storage when set to "off".
+const KeyringEnv = "NEBULA_KEYRING"
+
+const keyringService = "nebula-cli"
+
+// keyringProbeAccount is looked up once to learn whether the keyring answers.
+const keyringProbeAccount = "nebula-cli-probe"
+
+// errKeyringNotFound reports a missing keyring entry.
+var errKeyringNotFound = errors.New("secret not found in keyring")
+
+// secretStore is the OS credential store used for API keys.
+type secretStore interface {
+	Available() bool
+	Get(service, account string) (string, error)
+	Set(service, account, secret string) error
+}
+
+var keyring secretStore = &systemKeyring{}
+
+// systemKeyring stores secrets in the macOS Keychain, the Secret Service
+// (GNOME Keyring, KWallet), or the Windows Credential Manager.
+type systemKeyring struct {
+	once      sync.Once
+	available bool
+}
+
+// Available reports whether the platform keyring answers a lookup. The probe
+// runs once per process.
+func (s *systemKeyring) Available() bool {
+	s.once.Do(func() {
+		// Without a session bus the Secret Service client would try to
+		// autolaunch one, which hangs on headless machines.
+		if runtime.GOOS == "linux" && strings.TrimSpace(os.Getenv("DBUS_SESSION_BUS_ADDRESS")) == "" {
+			return
+		}
+		_, err := gokeyring.Get(keyringService, keyringProbeAccount)
+		s.available = err == nil || errors.Is(err, gokeyring.ErrNotFound)
+	})
+	return s.available
+}
+
+// Get reads the secret stored for account.
+func (s *systemKeyring) Get(service, account string) (string, error) {
+	secret, err := gokeyring.Get(service, account)
+	if errors.Is(err, gokeyring.ErrNotFound) {
+		return "", errKeyringNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("keyring read: %w", err)
+	}
+	return secret, nil
+}
+
+// Set stores the secret for account, replacing any previous value.
+func (s *systemKeyring) Set(service, account, secret string) error {
+	if err := gokeyring.Set(service, account, secret); err != nil {
+		return fmt.Errorf("keyring write: %w", err)
+	}
+	return nil
+}
+
+// KeyringAvailable reports whether API keys can be kept in the OS keyring.
+func KeyringAvailable() bool {
+	if strings.EqualFold(strings.TrimSpace(os.Getenv(KeyringEnv)), "off") {
+		return false
+	}
+	return keyring.Available()
+}
+
+// keyringAccount names the keyring entry for a profile of the current config file.
+func keyringAccount(profile string) string {
+	if profile == "" {
+		profile = DefaultProfile
+	}
+	return profile + "@" + Path()
+}
@@ -0,0 +1,140 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// fakeKeyring is an in-memory secret store for tests.
+type fakeKeyring struct {
+	available bool
+	failSet   bool
+	secrets   map[string]string
+}
+
+// Available handles available.
+func (f *fakeKeyring) Available() bool { return f.available }
+
+// Get handles get.
+func (f *fakeKeyring) Get(service, account string) (string, error) {
+	secret, ok := f.secrets[service+"/"+account]
+	if !ok {
+		return "", errKeyringNotFound
+	}
+	return secret, nil
+}
+
+// Set handles set.
+func (f *fakeKeyring) Set(service, account, secret string) error {
+	if f.failSet {
+		return errors.New("locked")
+	}
+	f.secrets[service+"/"+account] = secret
+	return nil
+}
+
+// useFakeKeyring swaps the keyring backend for the test duration.
+func useFakeKeyring(t *testing.T, available bool) *fakeKeyring {
+	t.Helper()
+	fake := &fakeKeyring{available: available, secrets: map[string]string{}}
+	prev := keyring
+	keyring = fake
+	t.Cleanup(func() { keyring = prev })
+	return fake
+}
+
+// readStoredConfig reads the raw config file for assertions.
+func readStoredConfig(t *testing.T) Config {
+	t.Helper()
+	data, err := os.ReadFile(Path())
+	require.NoError(t, err)
+	var stored Config
+	require.NoError(t, yaml.Unmarshal(data, &stored))
+	return stored
+}
+
+// TestMigrateAPIKeysMovesPlaintextKeysIntoKeyring handles test migrate apikeys moves plaintext keys into keyring.
+func TestMigrateAPIKeysMovesPlaintextKeysIntoKeyring(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(ProfileEnv, "")
+	t.Setenv(KeyringEnv, "")
+	fake := useFakeKeyring(t, true)
+
+	cfg := Config{
+		APIKey:   "nbl_prod",
+		Username: "alxx",
+		Profiles: map[string]Profile{"staging": {APIURL: "https://staging", APIKey: "nbl_stg"}},
+	}
+	require.NoError(t, cfg.Save())
+
+	migrated, err := MigrateAPIKeys()
+	require.NoError(t, err)
+	assert.Equal(t, 2, migrated)
+
+	stored := readStoredConfig(t)
+	assert.Empty(t, stored.APIKey)
+	assert.True(t, stored.KeyInKeyring)
+	assert.Empty(t, stored.Profiles["staging"].APIKey)
+	assert.Len(t, fake.secrets, 2)
+
+	loaded, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, "nbl_prod", loaded.APIKey)
+
+	t.Setenv(ProfileEnv, "staging")
+	loaded, err = Load()
+	require.NoError(t, err)
+	assert.Equal(t, "nbl_stg", loaded.APIKey)
+
+	migrated, err = MigrateAPIKeys()
+	require.NoError(t, err)
+	assert.Equal(t, 0, migrated)
+}
+
+// TestSaveFallsBackToPlaintextWhenKeyringFails handles test save falls back to plaintext when keyring fails.
+func TestSaveFallsBackToPlaintextWhenKeyringFails(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(ProfileEnv, "")
+	t.Setenv(KeyringEnv, "")
+	fake := useFakeKeyring(t, true)
+	fake.failSet = true
+
+	cfg := Config{APIKey: "nbl_prod", KeyInKeyring: true}
+	require.NoError(t, cfg.Save())
+
+	stored := readStoredConfig(t)
+	assert.Equal(t, "nbl_prod", stored.APIKey)
+	assert.False(t, stored.KeyInKeyring)
+}
+
+// TestKeyringDisabledByEnvKeepsConfigStorage handles test keyring disabled by env keeps config storage.
+func TestKeyringDisabledByEnvKeepsConfigStorage(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(KeyringEnv, "off")
+	useFakeKeyring(t, true)
+
+	require.NoError(t, (&Config{APIKey: "nbl_prod"}).Save())
+	migrated, err := MigrateAPIKeys()
+	require.NoError(t, err)
+	assert.Equal(t, 0, migrated)
+	assert.Equal(t, "nbl_prod", readStoredConfig(t).APIKey)
+}
+
+// TestLoadReportsMissingKeyringEntry handles test load reports missing keyring entry.
+func TestLoadReportsMissingKeyringEntry(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(ProfileEnv, "")
+	useFakeKeyring(t, false)
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(Path()), 0700))
+	require.NoError(t, os.WriteFile(Path(), []byte("api_key_in_keyring: true\n"), 0600))
+
+	_, err := Load()
+	assert.ErrorContains(t, err, "keyring")
+}
@@ -0,0 +1,86 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// OfflineQueue holds entity edits saved while the server was unreachable,
+// waiting to be replayed on reconnect.
+type OfflineQueue struct {
+	Edits []OfflineEdit `yaml:"edits,omitempty"`
+}
+
+// OfflineEdit is one queued entity update. ExpectedUpdatedAt is the version
+// the edit was made against, so replay can detect a conflicting remote change.
+type OfflineEdit struct {
+	ID                string         `yaml:"id"`
+	EntityID          string         `yaml:"entity_id"`
+	EntityName        string         `yaml:"entity_name,omitempty"`
+	Status            *string        `yaml:"status,omitempty"`
+	Tags              *[]string      `yaml:"tags,omitempty"`
+	Metadata          map[string]any `yaml:"metadata,omitempty"`
+	Scopes            *[]string      `yaml:"scopes,omitempty"`
+	ExpectedUpdatedAt *time.Time     `yaml:"expected_updated_at,omitempty"`
+	QueuedAt          time.Time      `yaml:"queued_at"`
+}
+
+// OfflineQueuePath returns the offline edit queue file path.
+func OfflineQueuePath() string {
+	return filepath.Join(filepath.Dir(Path()), "offline")
+}
+
+// LoadOfflineQueue reads the offline queue, returning an empty queue when missing.
+func LoadOfflineQueue() (*OfflineQueue, error) {
+	queue := &OfflineQueue{}
+	data, err := os.ReadFile(OfflineQueuePath())
+	if errors.Is(err, os.ErrNotExist) {
+		return queue, nil
+	}
+	if err != nil {
+		return queue, fmt.Errorf("read offline queue: %w", err)
+	}
+	if err := yaml.Unmarshal(data, queue); err != nil {
+		return &OfflineQueue{}, fmt.Errorf("parse offline queue: %w", err)
+	}
+	return queue, nil
+}
+
+// Save writes the offline queue with the same permissions as the config.
+func (q *OfflineQueue) Save() error {
+	path := OfflineQueuePath()
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("create config dir: %w", err)
+	}
+	data, err := yaml.Marshal(q)
+	if err != nil {
+		return fmt.Errorf("marshal offline queue: %w", err)
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// Add queues an edit, assigning an ID when it has none.
+func (q *OfflineQueue) Add(edit OfflineEdit) OfflineEdit {
+	if edit.ID == "" {
+		edit.ID = strconv.FormatInt(edit.QueuedAt.UnixNano(), 36)
+	}
+	q.Edits = append(q.Edits, edit)
+	return edit
+}
+
+// Remove drops a queued edit by ID.
+func (q *OfflineQueue) Remove(id string) {
+	kept := q.Edits[:0]
+	for _, edit := range q.Edits {
+		if edit.ID != id {
+			kept = append(kept, edit)
+		}
+	}
+	q.Edits = kept
+}
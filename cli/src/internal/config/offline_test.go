@@ -0,0 +1,44 @@
+package config
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOfflineQueueRoundTrip handles test offline queue round trip.
+func TestOfflineQueueRoundTrip(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	empty, err := LoadOfflineQueue()
+	require.NoError(t, err)
+	assert.Empty(t, empty.Edits)
+
+	seen := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	status := "inactive"
+	tags := []string{"a", "b"}
+	queue := &OfflineQueue{}
+	first := queue.Add(OfflineEdit{EntityID: "ent-1", EntityName: "Alpha", Status: &status, Tags: &tags, ExpectedUpdatedAt: &seen, QueuedAt: seen})
+	queue.Add(OfflineEdit{EntityID: "ent-2", QueuedAt: seen.Add(time.Second)})
+	assert.NotEmpty(t, first.ID)
+	require.NoError(t, queue.Save())
+
+	info, err := os.Stat(OfflineQueuePath())
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	loaded, err := LoadOfflineQueue()
+	require.NoError(t, err)
+	require.Len(t, loaded.Edits, 2)
+	assert.Equal(t, "inactive", *loaded.Edits[0].Status)
+	assert.Equal(t, []string{"a", "b"}, *loaded.Edits[0].Tags)
+	assert.True(t, seen.Equal(*loaded.Edits[0].ExpectedUpdatedAt))
+	assert.Nil(t, loaded.Edits[1].Status)
+
+	loaded.Remove(first.ID)
+	require.Len(t, loaded.Edits, 1)
+	assert.Equal(t, "ent-2", loaded.Edits[0].EntityID)
+}
@@ -0,0 +1,58 @@
+package config
+
+import "strings"
+
+// Pin kinds.
+const (
+	PinEntity  = "entity"
+	PinContext = "context"
+)
+
+// Pin is an entity or knowledge item starred for quick access. Name is kept
+// so the palette can list pins without fetching them.
+type Pin struct {
+	Kind string `yaml:"kind"`
+	ID   string `yaml:"id"`
+	Name string `yaml:"name,omitempty"`
+}
+
+// IsPinned reports whether the item of kind with id is pinned.
+func (c *Config) IsPinned(kind, id string) bool {
+	if c == nil {
+		return false
+	}
+	for _, pin := range c.Pins {
+		if pin.Kind == kind && pin.ID == strings.TrimSpace(id) {
+			return true
+		}
+	}
+	return false
+}
+
+// TogglePin pins the item, or unpins it when already pinned. It reports
+// whether the item is pinned afterwards.
+func (c *Config) TogglePin(pin Pin) bool {
+	pin.ID = strings.TrimSpace(pin.ID)
+	for i, existing := range c.Pins {
+		if existing.Kind == pin.Kind && existing.ID == pin.ID {
+			c.Pins = append(c.Pins[:i:i], c.Pins[i+1:]...)
+			return false
+		}
+	}
+	c.Pins = append(c.Pins, pin)
+	return true
+}
+
+// PinnedIDs returns the ids pinned for kind.
+func (c *Config) PinnedIDs(kind string) map[string]bool {
+	ids := map[string]bool{}
+	if c == nil {
+		return ids
+	}
+	for _, pin := range c.Pins {
+		if pin.Kind == kind {
+			ids[pin.ID] = true
+		}
+	}
+	return ids
+}
@@ -0,0 +1,29 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTogglePin handles test toggle pin.
+func TestTogglePin(t *testing.T) {
+	cfg := &Config{}
+	assert.True(t, cfg.TogglePin(Pin{Kind: PinEntity, ID: "ent-1", Name: "Atlas"}))
+	assert.True(t, cfg.TogglePin(Pin{Kind: PinContext, ID: "ctx-1", Name: "Runbook"}))
+	assert.True(t, cfg.TogglePin(Pin{Kind: PinEntity, ID: "ent-2", Name: "Docs"}))
+
+	assert.True(t, cfg.IsPinned(PinEntity, "ent-1"))
+	assert.False(t, cfg.IsPinned(PinContext, "ent-1"))
+	assert.Equal(t, map[string]bool{"ent-1": true, "ent-2": true}, cfg.PinnedIDs(PinEntity))
+
+	assert.False(t, cfg.TogglePin(Pin{Kind: PinEntity, ID: "ent-1"}))
+	require.Len(t, cfg.Pins, 2)
+	assert.Equal(t, "ctx-1", cfg.Pins[0].ID)
+	assert.Equal(t, "ent-2", cfg.Pins[1].ID)
+
+	var missing *Config
+	assert.False(t, missing.IsPinned(PinEntity, "ent-1"))
+	assert.Empty(t, missing.PinnedIDs(PinEntity))
+}
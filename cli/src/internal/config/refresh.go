@@ -0,0 +1,93 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// AutoRefreshDefault is the auto_refresh key that applies to every tab
+// without its own entry.
+const AutoRefreshDefault = "default"
+
+// MinAutoRefresh is the shortest auto-refresh interval honored, so a typo
+// like "1s" cannot hammer the API.
+const MinAutoRefresh = 5 * time.Second
+
+// AutoRefreshInterval returns how often a tab reloads on its own, falling
+// back to the default entry. Zero means auto-refresh is off.
+func (c *Config) AutoRefreshInterval(tab string) time.Duration {
+	if c == nil {
+		return 0
+	}
+	raw, ok := c.AutoRefresh[strings.ToLower(strings.TrimSpace(tab))]
+	if !ok {
+		raw = c.AutoRefresh[AutoRefreshDefault]
+	}
+	interval, err := parseAutoRefresh(raw)
+	if err != nil {
+		return 0
+	}
+	return interval
+}
+
+// ParseAutoRefresh parses `inbox=30s jobs=1m` into auto_refresh entries.
+// "off" or an empty string clears every entry.
+func ParseAutoRefresh(raw string) (map[string]string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" || strings.EqualFold(raw, "off") {
+		return nil, nil
+	}
+	out := map[string]string{}
+	for _, part := range strings.FieldsFunc(raw, func(r rune) bool { return r == ',' || r == ' ' }) {
+		tab, value, ok := strings.Cut(part, "=")
+		if !ok {
+			tab, value = AutoRefreshDefault, part
+		}
+		tab = strings.ToLower(strings.TrimSpace(tab))
+		value = strings.TrimSpace(value)
+		if tab == "" {
+			return nil, fmt.Errorf("auto refresh %q: missing tab", part)
+		}
+		if _, err := parseAutoRefresh(value); err != nil {
+			return nil, fmt.Errorf("auto refresh %s: %w", tab, err)
+		}
+		out[tab] = value
+	}
+	return out, nil
+}
+
+// FormatAutoRefresh renders auto_refresh entries the way ParseAutoRefresh
+// reads them.
+func FormatAutoRefresh(entries map[string]string) string {
+	if len(entries) == 0 {
+		return "off"
+	}
+	tabs := make([]string, 0, len(entries))
+	for tab := range entries {
+		tabs = append(tabs, tab)
+	}
+	sort.Strings(tabs)
+	parts := make([]string, 0, len(tabs))
+	for _, tab := range tabs {
+		parts = append(parts, tab+"="+entries[tab])
+	}
+	return strings.Join(parts, " ")
+}
+
+// parseAutoRefresh parses one interval; "off" and "0" disable the tab.
+func parseAutoRefresh(raw string) (time.Duration, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" || raw == "0" || strings.EqualFold(raw, "off") {
+		return 0, nil
+	}
+	interval, err := time.ParseDuration(raw)
+	if err != nil {
+		return 0, fmt.Errorf("invalid interval %q", raw)
+	}
+	if interval < MinAutoRefresh {
+		return 0, fmt.Errorf("interval %s is below the %s minimum", interval, MinAutoRefresh)
+	}
+	return interval, nil
+}
@@ -0,0 +1,39 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAutoRefreshIntervalFallsBackToDefault handles test auto refresh interval falls back to default.
+func TestAutoRefreshIntervalFallsBackToDefault(t *testing.T) {
+	var nilCfg *Config
+	assert.Zero(t, nilCfg.AutoRefreshInterval("inbox"))
+
+	cfg := &Config{AutoRefresh: map[string]string{"default": "2m", "inbox": "30s", "jobs": "off", "logs": "1s"}}
+	assert.Equal(t, 30*time.Second, cfg.AutoRefreshInterval("Inbox"))
+	assert.Equal(t, 2*time.Minute, cfg.AutoRefreshInterval("entities"))
+	assert.Zero(t, cfg.AutoRefreshInterval("jobs"))
+	assert.Zero(t, cfg.AutoRefreshInterval("logs"))
+}
+
+// TestParseAutoRefreshRoundTrip handles test parse auto refresh round trip.
+func TestParseAutoRefreshRoundTrip(t *testing.T) {
+	entries, err := ParseAutoRefresh("inbox=30s, jobs=1m 5m")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"inbox": "30s", "jobs": "1m", "default": "5m"}, entries)
+	assert.Equal(t, "default=5m inbox=30s jobs=1m", FormatAutoRefresh(entries))
+
+	cleared, err := ParseAutoRefresh("off")
+	require.NoError(t, err)
+	assert.Nil(t, cleared)
+	assert.Equal(t, "off", FormatAutoRefresh(cleared))
+
+	_, err = ParseAutoRefresh("inbox=soon")
+	assert.ErrorContains(t, err, "invalid interval")
+	_, err = ParseAutoRefresh("inbox=2s")
+	assert.ErrorContains(t, err, "minimum")
+}
@@ -0,0 +1,107 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SyncJournalLimit caps how many unseen changes the journal keeps.
+const SyncJournalLimit = 500
+
+// Sync change kinds and actions recorded in the journal.
+const (
+	SyncKindApproval = "approval"
+	SyncKindEntity   = "entity"
+
+	SyncActionNew    = "new"
+	SyncActionEdited = "edited"
+)
+
+// SyncState is the local cache `nebula sync` maintains: a snapshot of pending
+// approvals and entities, plus a journal of remote changes the TUI has not
+// shown yet.
+type SyncState struct {
+	LastSync  time.Time             `yaml:"last_sync,omitempty"`
+	Approvals map[string]SyncRecord `yaml:"approvals,omitempty"`
+	Entities  map[string]SyncRecord `yaml:"entities,omitempty"`
+	Journal   []SyncChange          `yaml:"journal,omitempty"`
+}
+
+// SyncRecord is the cached summary of one remote record.
+type SyncRecord struct {
+	Name      string    `yaml:"name,omitempty"`
+	Type      string    `yaml:"type,omitempty"`
+	UpdatedAt time.Time `yaml:"updated_at,omitempty"`
+}
+
+// SyncChange is one journaled remote change.
+type SyncChange struct {
+	Kind   string    `yaml:"kind"`
+	Action string    `yaml:"action"`
+	ID     string    `yaml:"id"`
+	Name   string    `yaml:"name,omitempty"`
+	At     time.Time `yaml:"at"`
+}
+
+// SyncStatePath returns the sync cache file path.
+func SyncStatePath() string {
+	return filepath.Join(filepath.Dir(Path()), "sync")
+}
+
+// LoadSyncState reads the sync cache, returning an empty state when missing.
+func LoadSyncState() (*SyncState, error) {
+	state := &SyncState{}
+	data, err := os.ReadFile(SyncStatePath())
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return state, fmt.Errorf("read sync state: %w", err)
+	}
+	if err := yaml.Unmarshal(data, state); err != nil {
+		return &SyncState{}, fmt.Errorf("parse sync state: %w", err)
+	}
+	return state, nil
+}
+
+// Save writes the sync cache with the same permissions as the config.
+func (s *SyncState) Save() error {
+	path := SyncStatePath()
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("create config dir: %w", err)
+	}
+	data, err := yaml.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("marshal sync state: %w", err)
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// Record appends a change to the journal, dropping the oldest past the limit.
+func (s *SyncState) Record(change SyncChange) {
+	s.Journal = append(s.Journal, change)
+	if over := len(s.Journal) - SyncJournalLimit; over > 0 {
+		s.Journal = append([]SyncChange(nil), s.Journal[over:]...)
+	}
+}
+
+// ConsumeJournal drops changes recorded at or before until, so a digest that
+// was shown is not shown again while newer changes are kept.
+func (s *SyncState) ConsumeJournal(until time.Time) int {
+	kept := s.Journal[:0]
+	dropped := 0
+	for _, change := range s.Journal {
+		if change.At.After(until) {
+			kept = append(kept, change)
+			continue
+		}
+		dropped++
+	}
+	s.Journal = kept
+	return dropped
+}
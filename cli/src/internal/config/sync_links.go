@@ -0,0 +1,100 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SyncLinks is the local mapping table of the knowledge connectors
+// (`nebula sync notion`, `nebula sync confluence`): which Knowledge item each
+// remote page became, and a hash of what each side held at the last sync so
+// the next run can tell who changed what.
+type SyncLinks struct {
+	Links map[string]SyncLink `yaml:"links,omitempty"`
+}
+
+// SyncLink ties one remote page to one Knowledge item.
+type SyncLink struct {
+	Source     string    `yaml:"source"`
+	Container  string    `yaml:"container"`
+	RemoteID   string    `yaml:"remote_id"`
+	ContextID  string    `yaml:"context_id"`
+	RemoteHash string    `yaml:"remote_hash"`
+	LocalHash  string    `yaml:"local_hash"`
+	SyncedAt   time.Time `yaml:"synced_at"`
+}
+
+// SyncLinksPath returns the connector mapping table path.
+func SyncLinksPath() string {
+	return filepath.Join(filepath.Dir(Path()), "sync-links")
+}
+
+// LoadSyncLinks reads the mapping table, returning an empty one when missing.
+func LoadSyncLinks() (*SyncLinks, error) {
+	links := &SyncLinks{}
+	data, err := os.ReadFile(SyncLinksPath())
+	if errors.Is(err, os.ErrNotExist) {
+		return links, nil
+	}
+	if err != nil {
+		return links, fmt.Errorf("read sync links: %w", err)
+	}
+	if err := yaml.Unmarshal(data, links); err != nil {
+		return &SyncLinks{}, fmt.Errorf("parse sync links: %w", err)
+	}
+	return links, nil
+}
+
+// Save writes the mapping table with the same permissions as the config.
+func (s *SyncLinks) Save() error {
+	path := SyncLinksPath()
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("create config dir: %w", err)
+	}
+	data, err := yaml.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("marshal sync links: %w", err)
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// syncLinkKey keys a link by source and remote page. Page IDs are unique per
+// source, so the container is not part of the key.
+func syncLinkKey(source, remoteID string) string {
+	return source + ":" + remoteID
+}
+
+// Link returns the link of a remote page.
+func (s *SyncLinks) Link(source, remoteID string) (SyncLink, bool) {
+	link, ok := s.Links[syncLinkKey(source, remoteID)]
+	return link, ok
+}
+
+// Set stores a link, replacing any earlier one for the same page.
+func (s *SyncLinks) Set(link SyncLink) {
+	if s.Links == nil {
+		s.Links = map[string]SyncLink{}
+	}
+	s.Links[syncLinkKey(link.Source, link.RemoteID)] = link
+}
+
+// Remove drops the link of a remote page.
+func (s *SyncLinks) Remove(source, remoteID string) {
+	delete(s.Links, syncLinkKey(source, remoteID))
+}
+
+// InContainer lists the links of one source container.
+func (s *SyncLinks) InContainer(source, container string) []SyncLink {
+	var links []SyncLink
+	for _, link := range s.Links {
+		if link.Source == source && link.Container == container {
+			links = append(links, link)
+		}
+	}
+	return links
+}
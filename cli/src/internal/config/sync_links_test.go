@@ -0,0 +1,44 @@
+package config
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncLinksRoundTrip(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	empty, err := LoadSyncLinks()
+	require.NoError(t, err)
+	assert.Empty(t, empty.Links)
+
+	at := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	links := &SyncLinks{}
+	links.Set(SyncLink{Source: "notion", Container: "db-1", RemoteID: "page-1", ContextID: "ctx-1", RemoteHash: "r", LocalHash: "l", SyncedAt: at})
+	links.Set(SyncLink{Source: "notion", Container: "db-2", RemoteID: "page-2", ContextID: "ctx-2"})
+	links.Set(SyncLink{Source: "confluence", Container: "ENG", RemoteID: "page-1", ContextID: "ctx-3"})
+	require.NoError(t, links.Save())
+
+	info, err := os.Stat(SyncLinksPath())
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	loaded, err := LoadSyncLinks()
+	require.NoError(t, err)
+	link, ok := loaded.Link("notion", "page-1")
+	require.True(t, ok)
+	assert.Equal(t, "ctx-1", link.ContextID)
+	assert.True(t, link.SyncedAt.Equal(at))
+	other, ok := loaded.Link("confluence", "page-1")
+	require.True(t, ok)
+	assert.Equal(t, "ctx-3", other.ContextID)
+	assert.Len(t, loaded.InContainer("notion", "db-1"), 1)
+
+	loaded.Remove("notion", "page-1")
+	_, ok = loaded.Link("notion", "page-1")
+	assert.False(t, ok)
+}
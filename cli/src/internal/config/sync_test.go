@@ -0,0 +1,51 @@
+package config
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSyncStateRoundTripAndJournal handles test sync state round trip and journal.
+func TestSyncStateRoundTripAndJournal(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	empty, err := LoadSyncState()
+	require.NoError(t, err)
+	assert.True(t, empty.LastSync.IsZero())
+
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	state := &SyncState{
+		LastSync: now,
+		Entities: map[string]SyncRecord{"ent-1": {Name: "Alpha", Type: "person", UpdatedAt: now}},
+	}
+	state.Record(SyncChange{Kind: SyncKindApproval, Action: SyncActionNew, ID: "ap-1", At: now})
+	state.Record(SyncChange{Kind: SyncKindEntity, Action: SyncActionEdited, ID: "ent-1", At: now.Add(time.Minute)})
+	require.NoError(t, state.Save())
+
+	info, err := os.Stat(SyncStatePath())
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	loaded, err := LoadSyncState()
+	require.NoError(t, err)
+	assert.Equal(t, "Alpha", loaded.Entities["ent-1"].Name)
+	require.Len(t, loaded.Journal, 2)
+
+	assert.Equal(t, 1, loaded.ConsumeJournal(now))
+	require.Len(t, loaded.Journal, 1)
+	assert.Equal(t, "ent-1", loaded.Journal[0].ID)
+}
+
+// TestSyncStateJournalLimit handles test sync state journal limit.
+func TestSyncStateJournalLimit(t *testing.T) {
+	state := &SyncState{}
+	for i := 0; i < SyncJournalLimit+5; i++ {
+		state.Record(SyncChange{Kind: SyncKindEntity, ID: "ent", At: time.Unix(int64(i), 0)})
+	}
+	require.Len(t, state.Journal, SyncJournalLimit)
+	assert.Equal(t, int64(5), state.Journal[0].At.Unix())
+}
@@ -0,0 +1,182 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Template kinds select which create form a template applies to.
+const (
+	TemplateKindEntity    = "entity"
+	TemplateKindKnowledge = "knowledge"
+	TemplateKindJob       = "job"
+)
+
+// Template pre-fills a create form or `--template` payload. Type is the entity
+// type for entity templates and the source type for knowledge templates.
+type Template struct {
+	Kind     string         `yaml:"kind,omitempty"`
+	Type     string         `yaml:"type,omitempty"`
+	Tags     []string       `yaml:"tags,omitempty"`
+	Scopes   []string       `yaml:"scopes,omitempty"`
+	Metadata map[string]any `yaml:"metadata,omitempty"`
+
+	// Job templates only. Title, Description, Subtasks, and string metadata
+	// values may reference params as {{name}}; Agent is the default assignee.
+	Title       string          `yaml:"title,omitempty"`
+	Description string          `yaml:"description,omitempty"`
+	Priority    string          `yaml:"priority,omitempty"`
+	Agent       string          `yaml:"agent,omitempty"`
+	Subtasks    []string        `yaml:"subtasks,omitempty"`
+	Params      []TemplateParam `yaml:"params,omitempty"`
+
+	// Name is the key the template is stored under.
+	Name string `yaml:"-"`
+}
+
+// TemplateParam is a value a job template asks for before it is applied.
+type TemplateParam struct {
+	Name     string `yaml:"name"`
+	Prompt   string `yaml:"prompt,omitempty"`
+	Default  string `yaml:"default,omitempty"`
+	Required bool   `yaml:"required,omitempty"`
+}
+
+// Label returns the prompt shown for a param, falling back to its name.
+func (p TemplateParam) Label() string {
+	if prompt := strings.TrimSpace(p.Prompt); prompt != "" {
+		return prompt
+	}
+	return p.Name
+}
+
+// kind returns the template kind, defaulting to entity.
+func (t Template) kind() string {
+	kind := strings.ToLower(strings.TrimSpace(t.Kind))
+	if kind == "" {
+		return TemplateKindEntity
+	}
+	return kind
+}
+
+// TemplatesFor returns the templates of one kind sorted by name.
+func (c *Config) TemplatesFor(kind string) []Template {
+	if c == nil {
+		return nil
+	}
+	out := []Template{}
+	for name, tmpl := range c.Templates {
+		if tmpl.kind() != kind {
+			continue
+		}
+		tmpl.Name = name
+		out = append(out, tmpl)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// FindTemplate returns the named template, checking that it matches kind.
+func (c *Config) FindTemplate(name, kind string) (Template, error) {
+	name = strings.TrimSpace(name)
+	if c == nil {
+		return Template{}, fmt.Errorf("template %q not found", name)
+	}
+	tmpl, ok := c.Templates[name]
+	if !ok {
+		return Template{}, fmt.Errorf("template %q not found", name)
+	}
+	if tmpl.kind() != kind {
+		return Template{}, fmt.Errorf("template %q is a %s template, not %s", name, tmpl.kind(), kind)
+	}
+	tmpl.Name = name
+	return tmpl, nil
+}
+
+// ResolveParams fills param defaults into values and rejects unknown names
+// and missing required params.
+func (t Template) ResolveParams(values map[string]string) (map[string]string, error) {
+	known := make(map[string]bool, len(t.Params))
+	out := make(map[string]string, len(t.Params))
+	for _, param := range t.Params {
+		known[param.Name] = true
+		value := strings.TrimSpace(values[param.Name])
+		if value == "" {
+			value = strings.TrimSpace(param.Default)
+		}
+		if value == "" && param.Required {
+			return nil, fmt.Errorf("template %q needs param %q", t.Name, param.Name)
+		}
+		out[param.Name] = value
+	}
+	for name := range values {
+		if !known[name] {
+			return nil, fmt.Errorf("template %q has no param %q", t.Name, name)
+		}
+	}
+	return out, nil
+}
+
+// Expand returns the template with {{name}} placeholders replaced by values.
+// The template name and values are recorded in the metadata so jobs created
+// from it can be traced back.
+func (t Template) Expand(values map[string]string) Template {
+	out := t
+	out.Title = ExpandParams(t.Title, values)
+	out.Description = ExpandParams(t.Description, values)
+	out.Agent = ExpandParams(t.Agent, values)
+	out.Subtasks = make([]string, 0, len(t.Subtasks))
+	for _, subtask := range t.Subtasks {
+		if subtask = strings.TrimSpace(ExpandParams(subtask, values)); subtask != "" {
+			out.Subtasks = append(out.Subtasks, subtask)
+		}
+	}
+	out.Metadata = expandMetadata(t.Metadata, values)
+	if out.Metadata == nil {
+		out.Metadata = map[string]any{}
+	}
+	if t.Name != "" {
+		out.Metadata["template"] = t.Name
+	}
+	if len(values) > 0 {
+		params := make(map[string]any, len(values))
+		for name, value := range values {
+			params[name] = value
+		}
+		out.Metadata["template_params"] = params
+	}
+	return out
+}
+
+// ExpandParams replaces {{name}} placeholders in text. Unknown placeholders
+// are left as written.
+func ExpandParams(text string, values map[string]string) string {
+	if !strings.Contains(text, "{{") {
+		return text
+	}
+	for name, value := range values {
+		text = strings.ReplaceAll(text, "{{"+name+"}}", value)
+		text = strings.ReplaceAll(text, "{{ "+name+" }}", value)
+	}
+	return text
+}
+
+// expandMetadata copies metadata, expanding placeholders in string values.
+func expandMetadata(meta map[string]any, values map[string]string) map[string]any {
+	if meta == nil {
+		return nil
+	}
+	out := make(map[string]any, len(meta))
+	for key, value := range meta {
+		switch typed := value.(type) {
+		case string:
+			out[key] = ExpandParams(typed, values)
+		case map[string]any:
+			out[key] = expandMetadata(typed, values)
+		default:
+			out[key] = typed
+		}
+	}
+	return out
+}
@@ -0,0 +1,86 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTemplatesRoundTripAndFilterByKind handles test templates round trip and filter by kind.
+func TestTemplatesRoundTripAndFilterByKind(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(ProfileEnv, "")
+
+	cfg := Config{
+		APIKey: "key",
+		Templates: map[string]Template{
+			"meeting": {Type: "meeting", Tags: []string{"sync"}, Metadata: map[string]any{"agenda": map[string]any{"items": []any{}}}},
+			"bug":     {Kind: "Entity", Type: "issue"},
+			"paper":   {Kind: TemplateKindKnowledge, Type: "paper", Scopes: []string{"research"}},
+		},
+	}
+	require.NoError(t, cfg.Save())
+
+	loaded, err := Load()
+	require.NoError(t, err)
+
+	entity := loaded.TemplatesFor(TemplateKindEntity)
+	require.Len(t, entity, 2)
+	assert.Equal(t, "bug", entity[0].Name)
+	assert.Equal(t, "meeting", entity[1].Name)
+	assert.Equal(t, map[string]any{"items": []any{}}, entity[1].Metadata["agenda"])
+
+	knowledge := loaded.TemplatesFor(TemplateKindKnowledge)
+	require.Len(t, knowledge, 1)
+	assert.Equal(t, []string{"research"}, knowledge[0].Scopes)
+
+	tmpl, err := loaded.FindTemplate(" paper ", TemplateKindKnowledge)
+	require.NoError(t, err)
+	assert.Equal(t, "paper", tmpl.Name)
+
+	_, err = loaded.FindTemplate("paper", TemplateKindEntity)
+	assert.ErrorContains(t, err, "is a knowledge template")
+	_, err = loaded.FindTemplate("missing", TemplateKindEntity)
+	assert.ErrorContains(t, err, "not found")
+
+	var none *Config
+	assert.Empty(t, none.TemplatesFor(TemplateKindEntity))
+}
+
+// TestJobTemplateParamsResolveAndExpand handles test job template params resolve and expand.
+func TestJobTemplateParamsResolveAndExpand(t *testing.T) {
+	tmpl := Template{
+		Name:        "weekly-report",
+		Kind:        TemplateKindJob,
+		Title:       "Weekly report W{{week}}",
+		Description: "Summarise week {{ week }} for {{team}}",
+		Agent:       "reporter",
+		Subtasks:    []string{"Collect metrics for W{{week}}", "  "},
+		Metadata:    map[string]any{"report": map[string]any{"week": "{{week}}"}, "pages": 2},
+		Params: []TemplateParam{
+			{Name: "week", Prompt: "Week number", Required: true},
+			{Name: "team", Default: "core"},
+		},
+	}
+
+	_, err := tmpl.ResolveParams(nil)
+	assert.ErrorContains(t, err, `needs param "week"`)
+	_, err = tmpl.ResolveParams(map[string]string{"week": "42", "month": "10"})
+	assert.ErrorContains(t, err, `has no param "month"`)
+
+	values, err := tmpl.ResolveParams(map[string]string{"week": " 42 "})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"week": "42", "team": "core"}, values)
+	assert.Equal(t, "Week number", tmpl.Params[0].Label())
+	assert.Equal(t, "team", tmpl.Params[1].Label())
+
+	expanded := tmpl.Expand(values)
+	assert.Equal(t, "Weekly report W42", expanded.Title)
+	assert.Equal(t, "Summarise week 42 for core", expanded.Description)
+	assert.Equal(t, []string{"Collect metrics for W42"}, expanded.Subtasks)
+	assert.Equal(t, map[string]any{"week": "42"}, expanded.Metadata["report"])
+	assert.Equal(t, 2, expanded.Metadata["pages"])
+	assert.Equal(t, "weekly-report", expanded.Metadata["template"])
+	assert.Equal(t, "{{week}}", tmpl.Metadata["report"].(map[string]any)["week"])
+}
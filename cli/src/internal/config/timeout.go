@@ -0,0 +1,26 @@
+package config
+
+import (
+	"strings"
+	"time"
+)
+
+// DefaultRequestTimeout bounds one API request when request_timeout is unset.
+const DefaultRequestTimeout = 30 * time.Second
+
+// RequestTimeoutDuration returns the per-request timeout for the TUI client.
+// Invalid or non-positive values fall back to the default.
+func (c *Config) RequestTimeoutDuration() time.Duration {
+	if c == nil {
+		return DefaultRequestTimeout
+	}
+	raw := strings.TrimSpace(c.RequestTimeout)
+	if raw == "" {
+		return DefaultRequestTimeout
+	}
+	d, err := time.ParseDuration(raw)
+	if err != nil || d <= 0 {
+		return DefaultRequestTimeout
+	}
+	return d
+}
@@ -0,0 +1,18 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRequestTimeoutDuration handles test request timeout duration.
+func TestRequestTimeoutDuration(t *testing.T) {
+	var nilCfg *Config
+	assert.Equal(t, DefaultRequestTimeout, nilCfg.RequestTimeoutDuration())
+	assert.Equal(t, DefaultRequestTimeout, (&Config{}).RequestTimeoutDuration())
+	assert.Equal(t, 5*time.Second, (&Config{RequestTimeout: "5s"}).RequestTimeoutDuration())
+	assert.Equal(t, DefaultRequestTimeout, (&Config{RequestTimeout: "0"}).RequestTimeoutDuration())
+	assert.Equal(t, DefaultRequestTimeout, (&Config{RequestTimeout: "slow"}).RequestTimeoutDuration())
+}
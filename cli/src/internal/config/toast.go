@@ -0,0 +1,29 @@
+package config
+
+import (
+	"strings"
+	"time"
+)
+
+// DefaultToastDuration is how long a toast stays up when toast_duration is unset.
+const DefaultToastDuration = 2500 * time.Millisecond
+
+// ToastTimeout returns how long toasts stay on screen. Zero ("off" or "0")
+// means toasts are only recorded in the notification area.
+func (c *Config) ToastTimeout() time.Duration {
+	if c == nil {
+		return DefaultToastDuration
+	}
+	raw := strings.TrimSpace(c.ToastDuration)
+	if raw == "" {
+		return DefaultToastDuration
+	}
+	if raw == "0" || strings.EqualFold(raw, "off") {
+		return 0
+	}
+	d, err := time.ParseDuration(raw)
+	if err != nil || d < 0 {
+		return DefaultToastDuration
+	}
+	return d
+}
@@ -0,0 +1,19 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestToastTimeout handles test toast timeout.
+func TestToastTimeout(t *testing.T) {
+	var nilCfg *Config
+	assert.Equal(t, DefaultToastDuration, nilCfg.ToastTimeout())
+	assert.Equal(t, DefaultToastDuration, (&Config{}).ToastTimeout())
+	assert.Equal(t, 6*time.Second, (&Config{ToastDuration: "6s"}).ToastTimeout())
+	assert.Zero(t, (&Config{ToastDuration: "off"}).ToastTimeout())
+	assert.Zero(t, (&Config{ToastDuration: "0"}).ToastTimeout())
+	assert.Equal(t, DefaultToastDuration, (&Config{ToastDuration: "soon"}).ToastTimeout())
+}
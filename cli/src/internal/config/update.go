@@ -0,0 +1,51 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// UpdateCheck caches the last release check so the TUI asks at most once a day.
+type UpdateCheck struct {
+	CheckedAt time.Time `yaml:"checked_at,omitempty"`
+	Latest    string    `yaml:"latest,omitempty"`
+}
+
+// UpdateCheckPath returns the release check cache path.
+func UpdateCheckPath() string {
+	return filepath.Join(filepath.Dir(Path()), "update-check")
+}
+
+// LoadUpdateCheck reads the release check cache, empty when missing.
+func LoadUpdateCheck() (*UpdateCheck, error) {
+	check := &UpdateCheck{}
+	data, err := os.ReadFile(UpdateCheckPath())
+	if errors.Is(err, os.ErrNotExist) {
+		return check, nil
+	}
+	if err != nil {
+		return check, fmt.Errorf("read update check: %w", err)
+	}
+	if err := yaml.Unmarshal(data, check); err != nil {
+		return &UpdateCheck{}, fmt.Errorf("parse update check: %w", err)
+	}
+	return check, nil
+}
+
+// Save writes the release check cache.
+func (c *UpdateCheck) Save() error {
+	path := UpdateCheckPath()
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("create config dir: %w", err)
+	}
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("marshal update check: %w", err)
+	}
+	return os.WriteFile(path, data, 0600)
+}
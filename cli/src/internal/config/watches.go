@@ -0,0 +1,60 @@
+package config
+
+import (
+	"strings"
+	"time"
+)
+
+// Watch is an entity or knowledge item whose audit events are surfaced as
+// notifications. Kind is PinEntity or PinContext. Events at or before SeenAt
+// have been read.
+type Watch struct {
+	Kind   string    `yaml:"kind"`
+	ID     string    `yaml:"id"`
+	Name   string    `yaml:"name,omitempty"`
+	SeenAt time.Time `yaml:"seen_at,omitempty"`
+}
+
+// IsWatched reports whether the item of kind with id is watched.
+func (c *Config) IsWatched(kind, id string) bool {
+	if c == nil {
+		return false
+	}
+	for _, watch := range c.Watches {
+		if watch.Kind == kind && watch.ID == strings.TrimSpace(id) {
+			return true
+		}
+	}
+	return false
+}
+
+// ToggleWatch watches the item from now on, or stops watching it when already
+// watched. It reports whether the item is watched afterwards.
+func (c *Config) ToggleWatch(watch Watch, now time.Time) bool {
+	watch.ID = strings.TrimSpace(watch.ID)
+	for i, existing := range c.Watches {
+		if existing.Kind == watch.Kind && existing.ID == watch.ID {
+			c.Watches = append(c.Watches[:i:i], c.Watches[i+1:]...)
+			return false
+		}
+	}
+	watch.SeenAt = now
+	c.Watches = append(c.Watches, watch)
+	return true
+}
+
+// MarkWatchSeen marks the events of a watched item up to at as read. It
+// reports whether anything changed; SeenAt never moves backwards.
+func (c *Config) MarkWatchSeen(kind, id string, at time.Time) bool {
+	if c == nil {
+		return false
+	}
+	for i := range c.Watches {
+		watch := &c.Watches[i]
+		if watch.Kind == kind && watch.ID == id && at.After(watch.SeenAt) {
+			watch.SeenAt = at
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,31 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestToggleWatch handles test toggle watch.
+func TestToggleWatch(t *testing.T) {
+	cfg := &Config{}
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	assert.True(t, cfg.ToggleWatch(Watch{Kind: PinEntity, ID: " ent-1 ", Name: "Atlas"}, now))
+	require.Len(t, cfg.Watches, 1)
+	assert.Equal(t, "ent-1", cfg.Watches[0].ID)
+	assert.Equal(t, now, cfg.Watches[0].SeenAt)
+	assert.True(t, cfg.IsWatched(PinEntity, "ent-1"))
+	assert.False(t, cfg.IsWatched(PinContext, "ent-1"))
+
+	assert.True(t, cfg.MarkWatchSeen(PinEntity, "ent-1", now.Add(time.Hour)))
+	assert.False(t, cfg.MarkWatchSeen(PinEntity, "ent-1", now), "seen time never moves back")
+	assert.Equal(t, now.Add(time.Hour), cfg.Watches[0].SeenAt)
+
+	assert.False(t, cfg.ToggleWatch(Watch{Kind: PinEntity, ID: "ent-1"}, now))
+	assert.Empty(t, cfg.Watches)
+
+	var missing *Config
+	assert.False(t, missing.IsWatched(PinEntity, "ent-1"))
+}
@@ -0,0 +1,89 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"text/template"
+)
+
+// Webhook actions a hook can subscribe to.
+const (
+	WebhookApprove = "approve"
+	WebhookReject  = "reject"
+)
+
+// Webhook posts approval decisions made from this CLI to an external URL.
+// Template is a text/template rendered against the decision; when empty the
+// decision is sent as JSON. Events limits the hook to approve or reject, and
+// an empty list fires on both.
+type Webhook struct {
+	Name     string            `yaml:"name"`
+	URL      string            `yaml:"url"`
+	Events   []string          `yaml:"events,omitempty"`
+	Template string            `yaml:"template,omitempty"`
+	Headers  map[string]string `yaml:"headers,omitempty"`
+	Disabled bool              `yaml:"disabled,omitempty"`
+}
+
+// Fires reports whether the hook is enabled for the given action.
+func (w Webhook) Fires(action string) bool {
+	if w.Disabled {
+		return false
+	}
+	if len(w.Events) == 0 {
+		return true
+	}
+	for _, event := range w.Events {
+		if strings.EqualFold(strings.TrimSpace(event), action) {
+			return true
+		}
+	}
+	return false
+}
+
+// Label returns the hook name, falling back to its host.
+func (w Webhook) Label() string {
+	if name := strings.TrimSpace(w.Name); name != "" {
+		return name
+	}
+	if parsed, err := url.Parse(w.URL); err == nil && parsed.Host != "" {
+		return parsed.Host
+	}
+	return w.URL
+}
+
+// Validate checks the URL, events, and template of a hook.
+func (w Webhook) Validate() error {
+	parsed, err := url.Parse(strings.TrimSpace(w.URL))
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return fmt.Errorf("webhook %s: url must be an http(s) URL", w.Label())
+	}
+	for _, event := range w.Events {
+		switch strings.ToLower(strings.TrimSpace(event)) {
+		case WebhookApprove, WebhookReject:
+		default:
+			return fmt.Errorf("webhook %s: unknown event %q (want approve or reject)", w.Label(), event)
+		}
+	}
+	if w.Template != "" {
+		if _, err := template.New(w.Label()).Parse(w.Template); err != nil {
+			return fmt.Errorf("webhook %s: template: %w", w.Label(), err)
+		}
+	}
+	return nil
+}
+
+// ActiveWebhooks returns the configured hooks that fire on action.
+func (c *Config) ActiveWebhooks(action string) []Webhook {
+	if c == nil {
+		return nil
+	}
+	var out []Webhook
+	for _, hook := range c.Webhooks {
+		if hook.Fires(action) {
+			out = append(out, hook)
+		}
+	}
+	return out
+}
@@ -0,0 +1,34 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWebhookFiresAndValidate handles test webhook fires and validate.
+func TestWebhookFiresAndValidate(t *testing.T) {
+	both := Webhook{Name: "slack", URL: "https://hooks.example.com/x"}
+	assert.True(t, both.Fires(WebhookApprove))
+	assert.True(t, both.Fires(WebhookReject))
+	require.NoError(t, both.Validate())
+
+	rejectOnly := Webhook{URL: "https://tickets.example.com/hook", Events: []string{"Reject"}}
+	assert.False(t, rejectOnly.Fires(WebhookApprove))
+	assert.True(t, rejectOnly.Fires(WebhookReject))
+	assert.Equal(t, "tickets.example.com", rejectOnly.Label())
+
+	disabled := Webhook{URL: "https://hooks.example.com/x", Disabled: true}
+	assert.False(t, disabled.Fires(WebhookApprove))
+
+	assert.Error(t, Webhook{URL: "ftp://example.com"}.Validate())
+	assert.Error(t, Webhook{URL: "https://example.com", Events: []string{"archive"}}.Validate())
+	assert.Error(t, Webhook{URL: "https://example.com", Template: "{{ .Action "}.Validate())
+
+	cfg := &Config{Webhooks: []Webhook{both, rejectOnly, disabled}}
+	assert.Len(t, cfg.ActiveWebhooks(WebhookApprove), 1)
+	assert.Len(t, cfg.ActiveWebhooks(WebhookReject), 2)
+	var missing *Config
+	assert.Empty(t, missing.ActiveWebhooks(WebhookApprove))
+}
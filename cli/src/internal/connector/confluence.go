@@ -0,0 +1,213 @@
+package connector
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// confluencePageSize is how many pages one content request returns.
+const confluencePageSize = 50
+
+// Confluence reads the pages of one Confluence space. Storage-format bodies
+// are flattened to Markdown-style text; labels become tags.
+type Confluence struct {
+	BaseURL string
+	Email   string
+	Token   string
+	Space   string
+	HTTP    *http.Client
+}
+
+// NewConfluence returns a Confluence source for space on the site at
+// baseURL, authenticating with an Atlassian account email and API token.
+func NewConfluence(baseURL, email, token, space string) *Confluence {
+	return &Confluence{
+		BaseURL: strings.TrimRight(baseURL, "/"),
+		Email:   email,
+		Token:   token,
+		Space:   space,
+		HTTP:    &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Name implements Source.
+func (c *Confluence) Name() string { return "confluence" }
+
+// Container implements Source.
+func (c *Confluence) Container() string { return c.Space }
+
+// confluencePage is a page of the content API.
+type confluencePage struct {
+	ID      string `json:"id"`
+	Title   string `json:"title"`
+	Version struct {
+		Number int `json:"number"`
+	} `json:"version"`
+	Body struct {
+		Storage struct {
+			Value string `json:"value"`
+		} `json:"storage"`
+	} `json:"body"`
+	Metadata struct {
+		Labels struct {
+			Results []struct {
+				Name string `json:"name"`
+			} `json:"results"`
+		} `json:"labels"`
+	} `json:"metadata"`
+	Links struct {
+		WebUI string `json:"webui"`
+	} `json:"_links"`
+}
+
+// List implements Source.
+func (c *Confluence) List() ([]Page, error) {
+	var pages []Page
+	for start := 0; ; start += confluencePageSize {
+		query := url.Values{}
+		query.Set("spaceKey", c.Space)
+		query.Set("type", "page")
+		query.Set("expand", "body.storage,version,metadata.labels")
+		query.Set("limit", strconv.Itoa(confluencePageSize))
+		query.Set("start", strconv.Itoa(start))
+		var list struct {
+			Results []confluencePage `json:"results"`
+			Size    int              `json:"size"`
+		}
+		if err := c.do(http.MethodGet, "/rest/api/content?"+query.Encode(), nil, &list); err != nil {
+			return nil, err
+		}
+		for _, row := range list.Results {
+			pages = append(pages, c.page(row))
+		}
+		if len(list.Results) < confluencePageSize {
+			return pages, nil
+		}
+	}
+}
+
+// page converts a content API page.
+func (c *Confluence) page(row confluencePage) Page {
+	page := Page{
+		ID:      row.ID,
+		Title:   row.Title,
+		Content: storageText(row.Body.Storage.Value),
+		Version: strconv.Itoa(row.Version.Number),
+	}
+	if row.Links.WebUI != "" {
+		page.URL = c.BaseURL + row.Links.WebUI
+	}
+	for _, label := range row.Metadata.Labels.Results {
+		page.Tags = append(page.Tags, label.Name)
+	}
+	return page
+}
+
+// Push implements Source. Confluence rejects writes that do not name the
+// next version, so page.Version must be the version the page was read at.
+func (c *Confluence) Push(page Page) (Page, error) {
+	version, err := strconv.Atoi(page.Version)
+	if err != nil {
+		return Page{}, fmt.Errorf("page %s has no version", page.ID)
+	}
+	body := map[string]any{
+		"id":      page.ID,
+		"type":    "page",
+		"title":   page.Title,
+		"version": map[string]any{"number": version + 1},
+		"body": map[string]any{
+			"storage": map[string]any{"value": storageHTML(page.Content), "representation": "storage"},
+		},
+	}
+	var updated confluencePage
+	if err := c.do(http.MethodPut, "/rest/api/content/"+url.PathEscape(page.ID), body, &updated); err != nil {
+		return Page{}, err
+	}
+	page.Version = strconv.Itoa(version + 1)
+	if updated.Version.Number > 0 {
+		page.Version = strconv.Itoa(updated.Version.Number)
+	}
+	return page, nil
+}
+
+// do sends one Confluence API request and decodes the response into out.
+func (c *Confluence) do(method, path string, body, out any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("marshal body: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, c.BaseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.Email, c.Token)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return doJSON(c.HTTP, req, "confluence", out)
+}
+
+var (
+	storageHeading = regexp.MustCompile(`(?is)<h([1-6])[^>]*>(.*?)</h[1-6]>`)
+	storageItem    = regexp.MustCompile(`(?is)<li[^>]*>(.*?)</li>`)
+	storageBreak   = regexp.MustCompile(`(?i)<br\s*/?>`)
+	storageBlock   = regexp.MustCompile(`(?i)</?(p|div|ul|ol|table|tr|blockquote|pre)[^>]*>`)
+	storageTag     = regexp.MustCompile(`(?s)<[^>]+>`)
+	blankLines     = regexp.MustCompile(`\n{3,}`)
+)
+
+// storageText flattens Confluence storage-format HTML to text: headings
+// become # lines, list items - lines, and other markup is dropped.
+func storageText(body string) string {
+	text := storageHeading.ReplaceAllStringFunc(body, func(match string) string {
+		parts := storageHeading.FindStringSubmatch(match)
+		level, _ := strconv.Atoi(parts[1])
+		return "\n\n" + strings.Repeat("#", level) + " " + parts[2] + "\n\n"
+	})
+	text = storageItem.ReplaceAllString(text, "\n\n- $1\n\n")
+	text = storageBreak.ReplaceAllString(text, "\n")
+	text = storageBlock.ReplaceAllString(text, "\n\n")
+	text = html.UnescapeString(storageTag.ReplaceAllString(text, ""))
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	return strings.TrimSpace(blankLines.ReplaceAllString(strings.Join(lines, "\n"), "\n\n"))
+}
+
+// storageHTML turns text back into storage-format HTML, reading the line
+// prefixes storageText writes.
+func storageHTML(content string) string {
+	var sb strings.Builder
+	for _, para := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n\n") {
+		para = strings.Trim(para, "\n")
+		if strings.TrimSpace(para) == "" {
+			continue
+		}
+		if level := strings.IndexFunc(para, func(r rune) bool { return r != '#' }); level > 0 && level <= 6 && strings.HasPrefix(para[level:], " ") {
+			fmt.Fprintf(&sb, "<h%d>%s</h%d>", level, html.EscapeString(para[level+1:]), level)
+			continue
+		}
+		if strings.HasPrefix(para, "- ") {
+			fmt.Fprintf(&sb, "<ul><li>%s</li></ul>", html.EscapeString(para[2:]))
+			continue
+		}
+		escaped := html.EscapeString(para)
+		fmt.Fprintf(&sb, "<p>%s</p>", strings.ReplaceAll(escaped, "\n", "<br/>"))
+	}
+	return sb.String()
+}
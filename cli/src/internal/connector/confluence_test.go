@@ -0,0 +1,57 @@
+package connector
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfluenceListsPagesAndPushesEdits(t *testing.T) {
+	var put map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "ada@example.com", user)
+		assert.Equal(t, "secret", pass)
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/wiki/rest/api/content":
+			assert.Equal(t, "ENG", r.URL.Query().Get("spaceKey"))
+			assert.Equal(t, "0", r.URL.Query().Get("start"))
+			_, _ = w.Write([]byte(`{"results":[{"id":"42","title":"Onboarding",
+				"version":{"number":3},
+				"body":{"storage":{"value":"<h2>Setup</h2><p>Install &amp; run.<br/>Then log in.</p><ul><li>Laptop</li><li><strong>VPN</strong></li></ul>"}},
+				"metadata":{"labels":{"results":[{"name":"howto"}]}},
+				"_links":{"webui":"/spaces/ENG/pages/42"}}],"size":1}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/wiki/rest/api/content/42":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&put))
+			_, _ = w.Write([]byte(`{"id":"42","version":{"number":4}}`))
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	confluence := NewConfluence(srv.URL+"/wiki/", "ada@example.com", "secret", "ENG")
+	pages, err := confluence.List()
+	require.NoError(t, err)
+	require.Len(t, pages, 1)
+	page := pages[0]
+	assert.Equal(t, "Onboarding", page.Title)
+	assert.Equal(t, "## Setup\n\nInstall & run.\nThen log in.\n\n- Laptop\n\n- VPN", page.Content)
+	assert.Equal(t, []string{"howto"}, page.Tags)
+	assert.Equal(t, srv.URL+"/wiki/spaces/ENG/pages/42", page.URL)
+	assert.Equal(t, "3", page.Version)
+
+	page.Content = "## Setup\n\nInstall <tools>.\n\n- Laptop"
+	pushed, err := confluence.Push(page)
+	require.NoError(t, err)
+	assert.Equal(t, "4", pushed.Version)
+	assert.Equal(t, float64(4), put["version"].(map[string]any)["number"])
+	storage := put["body"].(map[string]any)["storage"].(map[string]any)
+	assert.Equal(t, "<h2>Setup</h2><p>Install &lt;tools&gt;.</p><ul><li>Laptop</li></ul>", storage["value"])
+	assert.Equal(t, HashPage(page.Title, page.Content), HashPage(page.Title, storageText(storage["value"].(string))))
+}
@@ -0,0 +1,381 @@
+// Package connector syncs pages of an external knowledge tool (Notion,
+// Confluence) with Knowledge items both ways. Remote pages are pulled in as
+// Knowledge, edits made in Nebula are pushed back, and pages edited on both
+// sides since the last run are reported as conflicts. The page-to-item
+// mapping lives in config.SyncLinks on this machine.
+package connector
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+// Page is one remote page, reduced to what Knowledge stores.
+type Page struct {
+	ID      string
+	Title   string
+	Content string
+	URL     string
+	Tags    []string
+	// Version is the remote revision the page was read at, where the source
+	// needs it to write the page back.
+	Version string
+}
+
+// Source is a remote knowledge tool scoped to one database or space.
+type Source interface {
+	// Name is the source type Knowledge items are created with.
+	Name() string
+	// Container is the database or space the source reads.
+	Container() string
+	// List returns every page of the container.
+	List() ([]Page, error)
+	// Push writes the title and content of page back and returns the page as
+	// stored remotely.
+	Push(page Page) (Page, error)
+}
+
+// Conflict policies for pages edited on both sides.
+const (
+	ConflictSkip   = "skip"
+	ConflictRemote = "remote"
+	ConflictNebula = "nebula"
+)
+
+// Options control how pages map onto Knowledge items.
+type Options struct {
+	// TagMap renames remote tags; tags without an entry are kept as they are.
+	TagMap map[string]string
+	// ScopeMap gives the scope of pages carrying a remote tag.
+	ScopeMap map[string]string
+	// Scopes apply to pages no ScopeMap entry matches; public when empty.
+	Scopes []string
+	// OnConflict is skip, remote, or nebula.
+	OnConflict string
+	// DryRun reports what would happen without writing anywhere.
+	DryRun bool
+	// Now stamps the links; time.Now when zero.
+	Now time.Time
+}
+
+// Action is what a sync did with one page.
+type Action string
+
+// Sync actions.
+const (
+	ActionCreated   Action = "created"
+	ActionPulled    Action = "pulled"
+	ActionPushed    Action = "pushed"
+	ActionUnchanged Action = "unchanged"
+	ActionConflict  Action = "conflict"
+	ActionMissing   Action = "missing"
+	ActionError     Action = "error"
+)
+
+// ActionOrder is the order actions appear in summaries.
+var ActionOrder = []Action{
+	ActionCreated, ActionPulled, ActionPushed, ActionUnchanged,
+	ActionConflict, ActionMissing, ActionError,
+}
+
+// Result is the outcome for one page.
+type Result struct {
+	Action    Action
+	PageID    string
+	ContextID string
+	Title     string
+	Detail    string
+}
+
+// Report lists the outcome of every page, in remote order.
+type Report struct {
+	Results []Result
+}
+
+// Counts tallies results by action.
+func (r Report) Counts() map[Action]int {
+	counts := map[Action]int{}
+	for _, result := range r.Results {
+		counts[result.Action]++
+	}
+	return counts
+}
+
+// Failed reports whether any page errored or was left in conflict.
+func (r Report) Failed() bool {
+	counts := r.Counts()
+	return counts[ActionError]+counts[ActionConflict] > 0
+}
+
+// Sync reconciles every page of source with its Knowledge item and updates
+// links in place. Per-page failures are reported, not returned; only a
+// failure to list the source is an error.
+func Sync(client *api.Client, source Source, links *config.SyncLinks, opts Options) (Report, error) {
+	var report Report
+	if opts.OnConflict == "" {
+		opts.OnConflict = ConflictSkip
+	}
+	switch opts.OnConflict {
+	case ConflictSkip, ConflictRemote, ConflictNebula:
+	default:
+		return report, fmt.Errorf("unknown conflict policy %q (use skip, remote, or nebula)", opts.OnConflict)
+	}
+	if opts.Now.IsZero() {
+		opts.Now = time.Now()
+	}
+	pages, err := source.List()
+	if err != nil {
+		return report, fmt.Errorf("list %s pages: %w", source.Name(), err)
+	}
+
+	s := syncer{client: client, source: source, links: links, opts: opts}
+	seen := map[string]bool{}
+	for _, page := range pages {
+		seen[page.ID] = true
+		result := s.page(page)
+		if result.Title == "" {
+			result.Title = page.Title
+		}
+		report.Results = append(report.Results, result)
+	}
+
+	var missing []config.SyncLink
+	for _, link := range links.InContainer(source.Name(), source.Container()) {
+		if !seen[link.RemoteID] {
+			missing = append(missing, link)
+		}
+	}
+	sort.Slice(missing, func(i, j int) bool { return missing[i].RemoteID < missing[j].RemoteID })
+	for _, link := range missing {
+		report.Results = append(report.Results, Result{
+			Action:    ActionMissing,
+			PageID:    link.RemoteID,
+			ContextID: link.ContextID,
+			Detail:    "page is gone remotely; the Knowledge item was kept",
+		})
+	}
+	return report, nil
+}
+
+// syncer carries one Sync run.
+type syncer struct {
+	client *api.Client
+	source Source
+	links  *config.SyncLinks
+	opts   Options
+}
+
+// page reconciles one remote page.
+func (s syncer) page(page Page) Result {
+	result := Result{PageID: page.ID, Title: page.Title}
+	link, linked := s.links.Link(s.source.Name(), page.ID)
+	if !linked {
+		return s.create(page, result)
+	}
+	result.ContextID = link.ContextID
+
+	local, err := s.client.GetContext(link.ContextID)
+	var apiErr *api.Error
+	if errors.As(err, &apiErr) && apiErr.Status == http.StatusNotFound {
+		result = s.create(page, result)
+		if result.Action == ActionCreated {
+			result.Detail = "the linked Knowledge item was deleted; created a new one"
+		}
+		return result
+	}
+	if err != nil {
+		return failed(result, fmt.Errorf("load knowledge %s: %w", link.ContextID, err))
+	}
+
+	remoteChanged := HashPage(page.Title, page.Content) != link.RemoteHash
+	localChanged := HashPage(local.Title, contentOf(local)) != link.LocalHash
+	switch {
+	case !remoteChanged && !localChanged:
+		result.Action = ActionUnchanged
+		return result
+	case remoteChanged && localChanged:
+		switch s.opts.OnConflict {
+		case ConflictRemote:
+			result = s.pull(page, local, link, result)
+			result.Detail = "edited on both sides; kept the remote version"
+		case ConflictNebula:
+			result = s.push(page, local, link, result)
+			result.Detail = "edited on both sides; kept the Nebula version"
+		default:
+			result.Action = ActionConflict
+			result.Detail = "edited on both sides since the last sync; rerun with --on-conflict remote or nebula"
+		}
+		return result
+	case remoteChanged:
+		return s.pull(page, local, link, result)
+	default:
+		return s.push(page, local, link, result)
+	}
+}
+
+// create adds a Knowledge item for a page seen for the first time.
+func (s syncer) create(page Page, result Result) Result {
+	result.Action = ActionCreated
+	if s.opts.DryRun {
+		return result
+	}
+	tags, scopes := s.mapTags(page.Tags)
+	created, err := s.client.CreateContext(api.CreateContextInput{
+		Title:      page.Title,
+		URL:        page.URL,
+		SourceType: s.source.Name(),
+		Content:    page.Content,
+		Scopes:     scopes,
+		Tags:       tags,
+		Metadata:   s.metadata(page),
+	})
+	if err != nil {
+		return failed(result, fmt.Errorf("create knowledge: %w", err))
+	}
+	result.ContextID = created.ID
+	s.link(page, created.ID, HashPage(created.Title, contentOf(created)))
+	return result
+}
+
+// pull copies a remote edit onto the Knowledge item, refusing if the item
+// changed after it was read.
+func (s syncer) pull(page Page, local *api.Context, link config.SyncLink, result Result) Result {
+	result.Action = ActionPulled
+	if s.opts.DryRun {
+		return result
+	}
+	tags, scopes := s.mapTags(page.Tags)
+	updatedAt := local.UpdatedAt
+	input := api.UpdateContextInput{
+		Title:    &page.Title,
+		Content:  &page.Content,
+		Tags:     &tags,
+		Scopes:   &scopes,
+		Metadata: s.metadata(page),
+	}
+	if !updatedAt.IsZero() {
+		input.ExpectedUpdatedAt = &updatedAt
+	}
+	updated, err := s.client.UpdateContext(link.ContextID, input)
+	if errors.Is(err, api.ErrConflict) {
+		result.Action = ActionConflict
+		result.Detail = "the Knowledge item changed during the sync; rerun to pick it up"
+		return result
+	}
+	if err != nil {
+		return failed(result, fmt.Errorf("update knowledge %s: %w", link.ContextID, err))
+	}
+	s.link(page, link.ContextID, HashPage(updated.Title, contentOf(updated)))
+	return result
+}
+
+// push writes a Nebula edit back to the remote page.
+func (s syncer) push(page Page, local *api.Context, link config.SyncLink, result Result) Result {
+	result.Action = ActionPushed
+	result.Title = local.Title
+	if s.opts.DryRun {
+		return result
+	}
+	page.Title = local.Title
+	page.Content = contentOf(local)
+	pushed, err := s.source.Push(page)
+	if err != nil {
+		return failed(result, fmt.Errorf("push to %s: %w", s.source.Name(), err))
+	}
+	s.link(pushed, link.ContextID, HashPage(local.Title, contentOf(local)))
+	return result
+}
+
+// link records that page and Knowledge item agree as of now.
+func (s syncer) link(page Page, contextID, localHash string) {
+	s.links.Set(config.SyncLink{
+		Source:     s.source.Name(),
+		Container:  s.source.Container(),
+		RemoteID:   page.ID,
+		ContextID:  contextID,
+		RemoteHash: HashPage(page.Title, page.Content),
+		LocalHash:  localHash,
+		SyncedAt:   s.opts.Now,
+	})
+}
+
+// mapTags turns remote tags into Knowledge tags and scopes.
+func (s syncer) mapTags(remote []string) ([]string, []string) {
+	tags := []string{}
+	var scopes []string
+	seenTag := map[string]bool{}
+	seenScope := map[string]bool{}
+	for _, tag := range remote {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		if scope, ok := s.opts.ScopeMap[tag]; ok && !seenScope[scope] {
+			seenScope[scope] = true
+			scopes = append(scopes, scope)
+		}
+		if mapped, ok := s.opts.TagMap[tag]; ok {
+			tag = mapped
+		}
+		if tag != "" && !seenTag[tag] {
+			seenTag[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+	if len(scopes) == 0 {
+		scopes = append(scopes, s.opts.Scopes...)
+	}
+	if len(scopes) == 0 {
+		scopes = []string{"public"}
+	}
+	return tags, scopes
+}
+
+// metadata records where a Knowledge item was synced from.
+func (s syncer) metadata(page Page) map[string]any {
+	return map[string]any{
+		"sync": map[string]any{
+			"source":    s.source.Name(),
+			"container": s.source.Container(),
+			"page_id":   page.ID,
+		},
+	}
+}
+
+// failed marks result as errored.
+func failed(result Result, err error) Result {
+	result.Action = ActionError
+	result.Detail = err.Error()
+	return result
+}
+
+// contentOf returns the content of a Knowledge item, empty when unset.
+func contentOf(item *api.Context) string {
+	if item == nil || item.Content == nil {
+		return ""
+	}
+	return *item.Content
+}
+
+// HashPage fingerprints a title and body, ignoring trailing whitespace and
+// line ending differences the sources introduce on round trips.
+func HashPage(title, content string) string {
+	normalize := func(text string) string {
+		lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimRight(line, " \t")
+		}
+		return strings.TrimSpace(strings.Join(lines, "\n"))
+	}
+	sum := sha256.Sum256([]byte(normalize(title) + "\x00" + normalize(content)))
+	return hex.EncodeToString(sum[:])
+}
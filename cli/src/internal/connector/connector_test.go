@@ -0,0 +1,218 @@
+package connector
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+// fakeSource is an in-memory Source.
+type fakeSource struct {
+	pages  []Page
+	pushed []Page
+}
+
+func (f *fakeSource) Name() string          { return "notion" }
+func (f *fakeSource) Container() string     { return "db-1" }
+func (f *fakeSource) List() ([]Page, error) { return f.pages, nil }
+
+func (f *fakeSource) Push(page Page) (Page, error) {
+	f.pushed = append(f.pushed, page)
+	for i := range f.pages {
+		if f.pages[i].ID == page.ID {
+			f.pages[i].Title, f.pages[i].Content = page.Title, page.Content
+		}
+	}
+	return page, nil
+}
+
+// fakeKnowledge serves the context endpoints Sync uses from memory.
+type fakeKnowledge struct {
+	mu      sync.Mutex
+	items   map[string]map[string]any
+	next    int
+	creates []map[string]any
+	// conflictOnUpdate answers every update with a 409.
+	conflictOnUpdate bool
+}
+
+func newFakeKnowledge(t *testing.T) (*fakeKnowledge, *api.Client) {
+	t.Helper()
+	f := &fakeKnowledge{items: map[string]map[string]any{}}
+	srv := httptest.NewServer(http.HandlerFunc(f.serve))
+	t.Cleanup(srv.Close)
+	return f, api.NewClient(srv.URL, "nbl_test")
+}
+
+func (f *fakeKnowledge) serve(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	id := strings.TrimPrefix(r.URL.Path, "/api/context/")
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/api/context":
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		f.creates = append(f.creates, body)
+		f.next++
+		body["id"] = fmt.Sprintf("ctx-%d", f.next)
+		body["updated_at"] = time.Now().UTC()
+		f.items[body["id"].(string)] = body
+		writeData(w, body)
+	case r.Method == http.MethodGet:
+		item, ok := f.items[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"detail":{"error":{"code":"NOT_FOUND","message":"not found"}}}`))
+			return
+		}
+		writeData(w, item)
+	case r.Method == http.MethodPatch:
+		if f.conflictOnUpdate {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"detail":{"error":{"code":"CONFLICT","message":"changed"}}}`))
+			return
+		}
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		item := f.items[id]
+		for _, key := range []string{"title", "content", "tags"} {
+			if value, ok := body[key]; ok {
+				item[key] = value
+			}
+		}
+		item["updated_at"] = time.Now().UTC()
+		writeData(w, item)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func writeData(w http.ResponseWriter, data any) {
+	_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+}
+
+func TestSyncCreatesThenPullsPushesAndFlagsConflicts(t *testing.T) {
+	knowledge, client := newFakeKnowledge(t)
+	source := &fakeSource{pages: []Page{
+		{ID: "p1", Title: "Runbook", Content: "Restart the queue.", Tags: []string{"Ops", "Secret"}},
+		{ID: "p2", Title: "Glossary", Content: "Terms."},
+	}}
+	links := &config.SyncLinks{}
+	opts := Options{
+		TagMap:   map[string]string{"Ops": "ops"},
+		ScopeMap: map[string]string{"Secret": "private"},
+		Scopes:   []string{"work"},
+	}
+
+	report, err := Sync(client, source, links, opts)
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.Counts()[ActionCreated])
+	require.Len(t, knowledge.creates, 2)
+	assert.Equal(t, []any{"ops", "Secret"}, knowledge.creates[0]["tags"])
+	assert.Equal(t, []any{"private"}, knowledge.creates[0]["scopes"])
+	assert.Equal(t, []any{"work"}, knowledge.creates[1]["scopes"])
+	assert.Equal(t, "notion", knowledge.creates[0]["source_type"])
+	link, ok := links.Link("notion", "p1")
+	require.True(t, ok)
+	assert.Equal(t, "ctx-1", link.ContextID)
+
+	report, err = Sync(client, source, links, opts)
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.Counts()[ActionUnchanged])
+
+	// Remote edit on p1, Nebula edit on p2.
+	source.pages[0].Content = "Restart the queue, then the workers."
+	knowledge.items["ctx-2"]["content"] = "Terms, defined."
+	report, err = Sync(client, source, links, opts)
+	require.NoError(t, err)
+	assert.Equal(t, ActionPulled, report.Results[0].Action)
+	assert.Equal(t, "Restart the queue, then the workers.", knowledge.items["ctx-1"]["content"])
+	assert.Equal(t, ActionPushed, report.Results[1].Action)
+	require.Len(t, source.pushed, 1)
+	assert.Equal(t, "Terms, defined.", source.pushed[0].Content)
+
+	report, err = Sync(client, source, links, opts)
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.Counts()[ActionUnchanged])
+
+	// Both sides edit p1.
+	source.pages[0].Content = "remote edit"
+	knowledge.items["ctx-1"]["content"] = "nebula edit"
+	report, err = Sync(client, source, links, opts)
+	require.NoError(t, err)
+	assert.Equal(t, ActionConflict, report.Results[0].Action)
+	assert.True(t, report.Failed())
+
+	opts.OnConflict = ConflictNebula
+	report, err = Sync(client, source, links, opts)
+	require.NoError(t, err)
+	assert.Equal(t, ActionPushed, report.Results[0].Action)
+	assert.Equal(t, "nebula edit", source.pages[0].Content)
+	assert.False(t, report.Failed())
+}
+
+func TestSyncReportsMissingPagesAndRecreatesDeletedItems(t *testing.T) {
+	knowledge, client := newFakeKnowledge(t)
+	source := &fakeSource{pages: []Page{{ID: "p1", Title: "A"}, {ID: "p2", Title: "B"}}}
+	links := &config.SyncLinks{}
+
+	_, err := Sync(client, source, links, Options{})
+	require.NoError(t, err)
+
+	source.pages = source.pages[:1]
+	delete(knowledge.items, "ctx-1")
+	report, err := Sync(client, source, links, Options{})
+	require.NoError(t, err)
+	require.Len(t, report.Results, 2)
+	assert.Equal(t, ActionCreated, report.Results[0].Action)
+	assert.Contains(t, report.Results[0].Detail, "deleted")
+	assert.Equal(t, "ctx-3", report.Results[0].ContextID)
+	assert.Equal(t, ActionMissing, report.Results[1].Action)
+	assert.Equal(t, "p2", report.Results[1].PageID)
+}
+
+func TestSyncTreatsStaleUpdateAsConflict(t *testing.T) {
+	knowledge, client := newFakeKnowledge(t)
+	source := &fakeSource{pages: []Page{{ID: "p1", Title: "A", Content: "one"}}}
+	links := &config.SyncLinks{}
+	_, err := Sync(client, source, links, Options{})
+	require.NoError(t, err)
+
+	source.pages[0].Content = "two"
+	knowledge.conflictOnUpdate = true
+	report, err := Sync(client, source, links, Options{})
+	require.NoError(t, err)
+	assert.Equal(t, ActionConflict, report.Results[0].Action)
+	assert.Contains(t, report.Results[0].Detail, "changed during the sync")
+}
+
+func TestSyncDryRunWritesNothing(t *testing.T) {
+	knowledge, client := newFakeKnowledge(t)
+	source := &fakeSource{pages: []Page{{ID: "p1", Title: "A"}}}
+	links := &config.SyncLinks{}
+
+	report, err := Sync(client, source, links, Options{DryRun: true})
+	require.NoError(t, err)
+	assert.Equal(t, ActionCreated, report.Results[0].Action)
+	assert.Empty(t, knowledge.creates)
+	assert.Empty(t, links.Links)
+
+	_, err = Sync(client, source, links, Options{OnConflict: "newest"})
+	assert.ErrorContains(t, err, "unknown conflict policy")
+}
+
+func TestHashPageIgnoresLineEndingsAndTrailingSpace(t *testing.T) {
+	assert.Equal(t, HashPage("T", "a\nb"), HashPage("T ", "a  \r\nb\n"))
+	assert.NotEqual(t, HashPage("T", "a"), HashPage("T", "b"))
+}
@@ -0,0 +1,361 @@
+package connector
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// NotionAPIURL is the Notion REST API base.
+const NotionAPIURL = "https://api.notion.com/v1"
+
+// notionVersion pins the Notion API version the requests are written for.
+const notionVersion = "2022-06-28"
+
+// notionTextLimit is the longest text Notion accepts in one rich text item.
+const notionTextLimit = 2000
+
+// notionBlockBatch is the most blocks Notion appends in one request.
+const notionBlockBatch = 100
+
+// Notion reads the pages of one Notion database. Page text is flattened to
+// Markdown-style lines; multi-select properties become tags.
+type Notion struct {
+	Token    string
+	Database string
+	BaseURL  string
+	HTTP     *http.Client
+
+	// titleProps remembers the title property of each listed page, which
+	// is needed to rename it.
+	titleProps map[string]string
+}
+
+// NewNotion returns a Notion source for database.
+func NewNotion(token, database string) *Notion {
+	return &Notion{
+		Token:    token,
+		Database: database,
+		BaseURL:  NotionAPIURL,
+		HTTP:     &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Name implements Source.
+func (n *Notion) Name() string { return "notion" }
+
+// Container implements Source.
+func (n *Notion) Container() string { return n.Database }
+
+// notionRichText is a run of Notion text.
+type notionRichText struct {
+	PlainText string `json:"plain_text"`
+}
+
+// notionProperty is a page property; only title and multi-select values are
+// read.
+type notionProperty struct {
+	Type        string           `json:"type"`
+	Title       []notionRichText `json:"title"`
+	MultiSelect []struct {
+		Name string `json:"name"`
+	} `json:"multi_select"`
+}
+
+// notionPage is a database row.
+type notionPage struct {
+	ID             string                    `json:"id"`
+	URL            string                    `json:"url"`
+	LastEditedTime string                    `json:"last_edited_time"`
+	Archived       bool                      `json:"archived"`
+	Properties     map[string]notionProperty `json:"properties"`
+}
+
+// notionBlock is a content block of a page.
+type notionBlock struct {
+	ID   string                     `json:"id"`
+	Type string                     `json:"type"`
+	Raw  map[string]json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON keeps the type-specific payload for text extraction.
+func (b *notionBlock) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	b.Raw = raw
+	_ = json.Unmarshal(raw["id"], &b.ID)
+	_ = json.Unmarshal(raw["type"], &b.Type)
+	return nil
+}
+
+// text returns the plain text of a block's rich text.
+func (b notionBlock) text() string {
+	var payload struct {
+		RichText []notionRichText `json:"rich_text"`
+	}
+	_ = json.Unmarshal(b.Raw[b.Type], &payload)
+	var sb strings.Builder
+	for _, run := range payload.RichText {
+		sb.WriteString(run.PlainText)
+	}
+	return sb.String()
+}
+
+// notionList is a page of a paginated Notion list.
+type notionList[T any] struct {
+	Results    []T    `json:"results"`
+	HasMore    bool   `json:"has_more"`
+	NextCursor string `json:"next_cursor"`
+}
+
+// List implements Source.
+func (n *Notion) List() ([]Page, error) {
+	n.titleProps = map[string]string{}
+	var pages []Page
+	cursor := ""
+	for {
+		body := map[string]any{"page_size": 100}
+		if cursor != "" {
+			body["start_cursor"] = cursor
+		}
+		var list notionList[notionPage]
+		if err := n.do(http.MethodPost, "/databases/"+url.PathEscape(n.Database)+"/query", body, &list); err != nil {
+			return nil, err
+		}
+		for _, row := range list.Results {
+			if row.Archived {
+				continue
+			}
+			page, err := n.page(row)
+			if err != nil {
+				return nil, err
+			}
+			pages = append(pages, page)
+		}
+		if !list.HasMore || list.NextCursor == "" {
+			return pages, nil
+		}
+		cursor = list.NextCursor
+	}
+}
+
+// page reads the title, tags, and text of a database row.
+func (n *Notion) page(row notionPage) (Page, error) {
+	page := Page{ID: row.ID, URL: row.URL, Version: row.LastEditedTime}
+	for name, prop := range row.Properties {
+		switch prop.Type {
+		case "title":
+			n.titleProps[row.ID] = name
+			for _, run := range prop.Title {
+				page.Title += run.PlainText
+			}
+		case "multi_select":
+			for _, option := range prop.MultiSelect {
+				page.Tags = append(page.Tags, option.Name)
+			}
+		}
+	}
+	blocks, err := n.blocks(row.ID)
+	if err != nil {
+		return Page{}, err
+	}
+	page.Content = notionBlocksText(blocks)
+	return page, nil
+}
+
+// blocks lists the top-level content blocks of a page.
+func (n *Notion) blocks(pageID string) ([]notionBlock, error) {
+	var blocks []notionBlock
+	cursor := ""
+	for {
+		path := "/blocks/" + url.PathEscape(pageID) + "/children?page_size=100"
+		if cursor != "" {
+			path += "&start_cursor=" + url.QueryEscape(cursor)
+		}
+		var list notionList[notionBlock]
+		if err := n.do(http.MethodGet, path, nil, &list); err != nil {
+			return nil, fmt.Errorf("read page %s: %w", pageID, err)
+		}
+		blocks = append(blocks, list.Results...)
+		if !list.HasMore || list.NextCursor == "" {
+			return blocks, nil
+		}
+		cursor = list.NextCursor
+	}
+}
+
+// notionLinePrefixes maps text block types to the line prefix they read as.
+var notionLinePrefixes = map[string]string{
+	"paragraph":          "",
+	"heading_1":          "# ",
+	"heading_2":          "## ",
+	"heading_3":          "### ",
+	"bulleted_list_item": "- ",
+	"numbered_list_item": "1. ",
+	"quote":              "> ",
+	"to_do":              "- [ ] ",
+}
+
+// notionBlocksText flattens text blocks to lines, one blank line apart.
+// Blocks without text (images, embeds, ...) are skipped.
+func notionBlocksText(blocks []notionBlock) string {
+	lines := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		if block.Type == "code" {
+			lines = append(lines, "```\n"+block.text()+"\n```")
+			continue
+		}
+		prefix, ok := notionLinePrefixes[block.Type]
+		if !ok {
+			continue
+		}
+		lines = append(lines, prefix+block.text())
+	}
+	return strings.Join(lines, "\n\n")
+}
+
+// Push implements Source. The page title is renamed and its top-level
+// blocks are replaced with the content, one block per paragraph.
+func (n *Notion) Push(page Page) (Page, error) {
+	prop, ok := n.titleProps[page.ID]
+	if !ok {
+		return Page{}, fmt.Errorf("page %s was not listed", page.ID)
+	}
+	var updated notionPage
+	err := n.do(http.MethodPatch, "/pages/"+url.PathEscape(page.ID), map[string]any{
+		"properties": map[string]any{prop: map[string]any{"title": notionText(page.Title)}},
+	}, &updated)
+	if err != nil {
+		return Page{}, err
+	}
+	old, err := n.blocks(page.ID)
+	if err != nil {
+		return Page{}, err
+	}
+	for _, block := range old {
+		if err := n.do(http.MethodDelete, "/blocks/"+url.PathEscape(block.ID), nil, nil); err != nil {
+			return Page{}, fmt.Errorf("clear page %s: %w", page.ID, err)
+		}
+	}
+	children := notionContentBlocks(page.Content)
+	for start := 0; start < len(children); start += notionBlockBatch {
+		end := min(start+notionBlockBatch, len(children))
+		err := n.do(http.MethodPatch, "/blocks/"+url.PathEscape(page.ID)+"/children",
+			map[string]any{"children": children[start:end]}, nil)
+		if err != nil {
+			return Page{}, fmt.Errorf("write page %s: %w", page.ID, err)
+		}
+	}
+	if updated.LastEditedTime != "" {
+		page.Version = updated.LastEditedTime
+	}
+	return page, nil
+}
+
+// notionContentBlocks turns content into blocks, one per paragraph, reading
+// back the prefixes notionBlocksText writes.
+func notionContentBlocks(content string) []map[string]any {
+	var blocks []map[string]any
+	for _, para := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n\n") {
+		para = strings.Trim(para, "\n")
+		if strings.TrimSpace(para) == "" {
+			continue
+		}
+		kind, text := "paragraph", para
+		switch {
+		case strings.HasPrefix(para, "```\n") && strings.HasSuffix(para, "\n```"):
+			kind, text = "code", strings.TrimSuffix(strings.TrimPrefix(para, "```\n"), "\n```")
+		case strings.HasPrefix(para, "### "):
+			kind, text = "heading_3", para[4:]
+		case strings.HasPrefix(para, "## "):
+			kind, text = "heading_2", para[3:]
+		case strings.HasPrefix(para, "# "):
+			kind, text = "heading_1", para[2:]
+		case strings.HasPrefix(para, "- [ ] "):
+			kind, text = "to_do", para[6:]
+		case strings.HasPrefix(para, "- "):
+			kind, text = "bulleted_list_item", para[2:]
+		case strings.HasPrefix(para, "1. "):
+			kind, text = "numbered_list_item", para[3:]
+		case strings.HasPrefix(para, "> "):
+			kind, text = "quote", para[2:]
+		}
+		payload := map[string]any{"rich_text": notionText(text)}
+		if kind == "code" {
+			payload["language"] = "plain text"
+		}
+		blocks = append(blocks, map[string]any{"object": "block", "type": kind, kind: payload})
+	}
+	return blocks
+}
+
+// notionText splits text into rich text items under Notion's length limit.
+func notionText(text string) []map[string]any {
+	runes := []rune(text)
+	items := []map[string]any{}
+	for start := 0; start < len(runes); start += notionTextLimit {
+		end := min(start+notionTextLimit, len(runes))
+		items = append(items, map[string]any{"type": "text", "text": map[string]any{"content": string(runes[start:end])}})
+	}
+	return items
+}
+
+// do sends one Notion API request and decodes the response into out.
+func (n *Notion) do(method, path string, body, out any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("marshal body: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, strings.TrimRight(n.BaseURL, "/")+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+n.Token)
+	req.Header.Set("Notion-Version", notionVersion)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return doJSON(n.HTTP, req, "notion", out)
+}
+
+// doJSON runs req and decodes a JSON response into out, turning error
+// statuses into errors that carry the service's message.
+func doJSON(client *http.Client, req *http.Request, service string, out any) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s request failed: %w", service, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("read %s response: %w", service, err)
+	}
+	if resp.StatusCode >= 400 {
+		var payload struct {
+			Message string `json:"message"`
+		}
+		msg := strings.TrimSpace(string(data))
+		if json.Unmarshal(data, &payload) == nil && payload.Message != "" {
+			msg = payload.Message
+		}
+		return fmt.Errorf("%s HTTP %d: %s", service, resp.StatusCode, msg)
+	}
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("decode %s response: %w", service, err)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package connector
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotionListsPagesAndPushesEdits(t *testing.T) {
+	var deleted []string
+	var appended []map[string]any
+	var renamed map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		assert.Equal(t, notionVersion, r.Header.Get("Notion-Version"))
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/databases/db-1/query":
+			_, _ = w.Write([]byte(`{"results":[
+				{"id":"p1","url":"https://notion.so/p1","last_edited_time":"2026-10-01T00:00:00Z",
+				 "properties":{"Name":{"type":"title","title":[{"plain_text":"Run"},{"plain_text":"book"}]},
+				               "Tags":{"type":"multi_select","multi_select":[{"name":"ops"}]}}},
+				{"id":"p2","archived":true,"properties":{}}
+			],"has_more":false}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/blocks/p1/children":
+			_, _ = w.Write([]byte(`{"results":[
+				{"id":"b1","type":"heading_2","heading_2":{"rich_text":[{"plain_text":"Steps"}]}},
+				{"id":"b2","type":"bulleted_list_item","bulleted_list_item":{"rich_text":[{"plain_text":"Drain"}]}},
+				{"id":"b3","type":"image","image":{}},
+				{"id":"b4","type":"code","code":{"rich_text":[{"plain_text":"make restart"}]}}
+			],"has_more":false}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/pages/p1":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&renamed))
+			_, _ = w.Write([]byte(`{"id":"p1","last_edited_time":"2026-10-02T00:00:00Z"}`))
+		case r.Method == http.MethodDelete:
+			deleted = append(deleted, r.URL.Path)
+			_, _ = w.Write([]byte(`{}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/blocks/p1/children":
+			var body struct {
+				Children []map[string]any `json:"children"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			appended = append(appended, body.Children...)
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	notion := NewNotion("secret", "db-1")
+	notion.BaseURL = srv.URL
+	pages, err := notion.List()
+	require.NoError(t, err)
+	require.Len(t, pages, 1)
+	page := pages[0]
+	assert.Equal(t, "Runbook", page.Title)
+	assert.Equal(t, []string{"ops"}, page.Tags)
+	assert.Equal(t, "https://notion.so/p1", page.URL)
+	assert.Equal(t, "## Steps\n\n- Drain\n\n```\nmake restart\n```", page.Content)
+
+	page.Title = "Runbook v2"
+	page.Content = "# Steps\n\nDrain first.\n\n- Restart"
+	pushed, err := notion.Push(page)
+	require.NoError(t, err)
+	assert.Equal(t, "2026-10-02T00:00:00Z", pushed.Version)
+	assert.Contains(t, renamed["properties"], "Name")
+	assert.Equal(t, []string{"/blocks/b1", "/blocks/b2", "/blocks/b3", "/blocks/b4"}, deleted)
+	require.Len(t, appended, 3)
+	assert.Equal(t, "heading_1", appended[0]["type"])
+	assert.Equal(t, "paragraph", appended[1]["type"])
+	assert.Equal(t, "bulleted_list_item", appended[2]["type"])
+}
+
+func TestNotionReportsAPIErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"object":"error","message":"API token is invalid."}`))
+	}))
+	defer srv.Close()
+
+	notion := NewNotion("bad", "db-1")
+	notion.BaseURL = srv.URL
+	_, err := notion.List()
+	assert.EqualError(t, err, "notion HTTP 401: API token is invalid.")
+
+	_, err = notion.Push(Page{ID: "unknown"})
+	assert.ErrorContains(t, err, "was not listed")
+}
+
+func TestNotionTextSplitsLongRuns(t *testing.T) {
+	long := make([]rune, notionTextLimit+5)
+	for i := range long {
+		long[i] = 'é'
+	}
+	items := notionText(string(long))
+	require.Len(t, items, 2)
+	assert.Len(t, []rune(items[1]["text"].(map[string]any)["content"].(string)), 5)
+}
@@ -0,0 +1,195 @@
+// Package dedupe finds knowledge items that duplicate each other, either by
+// pointing at the same URL or by carrying the same content.
+package dedupe
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+const (
+	// PageSize is the page size used when scanning knowledge.
+	PageSize = 100
+	// MaxItems caps how many knowledge items one scan reads.
+	MaxItems = 5000
+)
+
+// Duplicate reasons reported on a Group.
+const (
+	ReasonURL     = "url"
+	ReasonContent = "content"
+)
+
+// Group is a set of knowledge items that duplicate each other, oldest first.
+type Group struct {
+	Reason string
+	Key    string
+	Items  []api.Context
+}
+
+// Fetch pages through active knowledge up to MaxItems.
+func Fetch(client *api.Client) ([]api.Context, error) {
+	var all []api.Context
+	for offset := 0; offset < MaxItems; offset += PageSize {
+		items, err := client.QueryContext(api.QueryParams{
+			"limit":  strconv.Itoa(PageSize),
+			"offset": strconv.Itoa(offset),
+		})
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		if len(items) < PageSize {
+			break
+		}
+	}
+	return all, nil
+}
+
+// NormalizeURL reduces a URL to the form used for comparison: lowercase
+// scheme and host without "www.", no fragment, default port, tracking
+// parameters, or trailing slash. Unparseable input is only trimmed.
+func NormalizeURL(raw string) string {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return ""
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return strings.TrimRight(strings.ToLower(raw), "/")
+	}
+	scheme := strings.ToLower(u.Scheme)
+	if scheme == "http" {
+		scheme = "https"
+	}
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	if port := u.Port(); port != "" && port != "80" && port != "443" {
+		host += ":" + port
+	}
+	query := u.Query()
+	for key := range query {
+		if strings.HasPrefix(strings.ToLower(key), "utm_") {
+			query.Del(key)
+		}
+	}
+	out := scheme + "://" + host + strings.TrimRight(u.EscapedPath(), "/")
+	if encoded := query.Encode(); encoded != "" {
+		out += "?" + encoded
+	}
+	return out
+}
+
+// ContentHash hashes content after folding case, punctuation, and
+// whitespace, so near-identical copies hash the same. Empty content has no
+// hash.
+func ContentHash(content string) string {
+	words := strings.FieldsFunc(strings.ToLower(content), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(strings.Join(words, " ")))
+	return hex.EncodeToString(sum[:])
+}
+
+// MatchURL returns the items whose URL normalizes to the same value as raw.
+func MatchURL(items []api.Context, raw string) []api.Context {
+	want := NormalizeURL(raw)
+	if want == "" {
+		return nil
+	}
+	var out []api.Context
+	for _, item := range items {
+		if item.URL != nil && NormalizeURL(*item.URL) == want {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// Groups finds duplicate sets, URL matches first. An item already grouped by
+// URL is not grouped again by content.
+func Groups(items []api.Context) []Group {
+	sorted := append([]api.Context(nil), items...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].CreatedAt.Before(sorted[j].CreatedAt) })
+
+	byURL := collect(sorted, func(item api.Context) string {
+		if item.URL == nil {
+			return ""
+		}
+		return NormalizeURL(*item.URL)
+	})
+	grouped := map[string]bool{}
+	groups := build(ReasonURL, byURL, grouped)
+
+	byContent := collect(sorted, func(item api.Context) string {
+		if grouped[item.ID] || item.Content == nil {
+			return ""
+		}
+		return ContentHash(*item.Content)
+	})
+	return append(groups, build(ReasonContent, byContent, grouped)...)
+}
+
+// keyedItems keeps items by key along with the order keys were first seen.
+type keyedItems struct {
+	order []string
+	items map[string][]api.Context
+}
+
+// collect buckets items by key, skipping empty keys.
+func collect(items []api.Context, key func(api.Context) string) keyedItems {
+	out := keyedItems{items: map[string][]api.Context{}}
+	for _, item := range items {
+		k := key(item)
+		if k == "" {
+			continue
+		}
+		if _, ok := out.items[k]; !ok {
+			out.order = append(out.order, k)
+		}
+		out.items[k] = append(out.items[k], item)
+	}
+	return out
+}
+
+// build turns buckets with more than one item into groups and marks their
+// items as grouped.
+func build(reason string, buckets keyedItems, grouped map[string]bool) []Group {
+	var groups []Group
+	for _, key := range buckets.order {
+		items := buckets.items[key]
+		if len(items) < 2 {
+			continue
+		}
+		for _, item := range items {
+			grouped[item.ID] = true
+		}
+		groups = append(groups, Group{Reason: reason, Key: key, Items: items})
+	}
+	return groups
+}
+
+// MergeTags returns base with any tags from extra it lacks, in order.
+func MergeTags(base, extra []string) []string {
+	out := append([]string{}, base...)
+	seen := map[string]bool{}
+	for _, tag := range base {
+		seen[strings.ToLower(tag)] = true
+	}
+	for _, tag := range extra {
+		if key := strings.ToLower(tag); !seen[key] {
+			seen[key] = true
+			out = append(out, tag)
+		}
+	}
+	return out
+}
@@ -0,0 +1,71 @@
+package dedupe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+func ptr(s string) *string { return &s }
+
+func TestNormalizeURL(t *testing.T) {
+	for raw, want := range map[string]string{
+		"https://Example.com/docs/":                 "https://example.com/docs",
+		"http://www.example.com/docs#intro":         "https://example.com/docs",
+		"https://example.com:443/docs?utm_source=x": "https://example.com/docs",
+		"https://example.com:8080/a?b=1":            "https://example.com:8080/a?b=1",
+		"  example.com/Docs/ ":                      "example.com/docs",
+		"":                                          "",
+	} {
+		assert.Equal(t, want, NormalizeURL(raw), raw)
+	}
+}
+
+func TestContentHashFoldsCaseAndPunctuation(t *testing.T) {
+	a := ContentHash("Hello, World!\n\nThis is   a note.")
+	b := ContentHash("hello world this is a note")
+	assert.NotEmpty(t, a)
+	assert.Equal(t, a, b)
+	assert.NotEqual(t, a, ContentHash("hello world this is another note"))
+	assert.Empty(t, ContentHash(" ... "))
+}
+
+func TestGroupsByURLThenContent(t *testing.T) {
+	base := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	items := []api.Context{
+		{ID: "k3", URL: ptr("https://example.com/a/"), Content: ptr("same text"), CreatedAt: base.Add(2 * time.Hour)},
+		{ID: "k1", URL: ptr("http://www.example.com/a"), Content: ptr("Same text."), CreatedAt: base},
+		{ID: "k2", Content: ptr("same   TEXT"), CreatedAt: base.Add(time.Hour)},
+		{ID: "k4", Content: ptr("other"), CreatedAt: base},
+		{ID: "k5", Content: ptr("notes on go"), CreatedAt: base},
+		{ID: "k6", Content: ptr("Notes on Go!"), CreatedAt: base.Add(time.Minute)},
+	}
+
+	groups := Groups(items)
+	require.Len(t, groups, 2)
+	assert.Equal(t, ReasonURL, groups[0].Reason)
+	assert.Equal(t, "https://example.com/a", groups[0].Key)
+	assert.Equal(t, []string{"k1", "k3"}, ids(groups[0].Items))
+	// k2 shares content with k1/k3 but they are already grouped by URL.
+	assert.Equal(t, ReasonContent, groups[1].Reason)
+	assert.Equal(t, []string{"k5", "k6"}, ids(groups[1].Items))
+
+	assert.Equal(t, []string{"k3", "k1"}, ids(MatchURL(items, "https://EXAMPLE.com/a")))
+	assert.Empty(t, MatchURL(items, ""))
+}
+
+func TestMergeTags(t *testing.T) {
+	assert.Equal(t, []string{"go", "Docs", "api"}, MergeTags([]string{"go", "Docs"}, []string{"docs", "api", "GO"}))
+}
+
+func ids(items []api.Context) []string {
+	out := make([]string, len(items))
+	for i, item := range items {
+		out[i] = item.ID
+	}
+	return out
+}
@@ -0,0 +1,486 @@
+// Package exporter streams paginated exports to disk. Pages are appended to
+// a partial file next to the destination and checkpointed one at a time, so
+// an interrupted export resumes from the last completed page and the
+// destination only appears once the export is whole.
+package exporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/archive"
+)
+
+// DefaultPageSize is the number of rows requested per page.
+const DefaultPageSize = 500
+
+// snapshotSections are the resources a snapshot is assembled from, in the
+// order they appear in the file.
+var snapshotSections = []string{"entities", "context", "relationships", "jobs"}
+
+// Spec describes one export.
+type Spec struct {
+	Resource string
+	Format   string
+	Path     string
+	Filter   api.QueryParams
+	PageSize int
+}
+
+// State is the checkpoint saved after every completed page. Bytes is the
+// size of the partial file at that point; anything past it is a page that
+// did not finish and is dropped on resume.
+type State struct {
+	Resource       string            `json:"resource"`
+	Format         string            `json:"format"`
+	Filter         map[string]string `json:"filter,omitempty"`
+	PageSize       int               `json:"page_size"`
+	Section        int               `json:"section"`
+	Offset         int               `json:"offset"`
+	SectionOpen    bool              `json:"section_open"`
+	SectionRecords int               `json:"section_records"`
+	Records        int               `json:"records"`
+	Totals         []int             `json:"totals"`
+	Bytes          int64             `json:"bytes"`
+}
+
+// Progress is a snapshot of a running export.
+type Progress struct {
+	Records int
+	Total   int
+	Resumed int
+	Elapsed time.Duration
+	// Rate is records per second written since the export (re)started.
+	Rate float64
+	// ETA is the estimated time left, or -1 while it cannot be estimated.
+	ETA  time.Duration
+	Done bool
+}
+
+// Fraction returns how much of the export is written, from 0 to 1.
+func (p Progress) Fraction() float64 {
+	if p.Done {
+		return 1
+	}
+	if p.Total <= 0 {
+		return 0
+	}
+	return min(1, float64(p.Records)/float64(p.Total))
+}
+
+// Exporter writes one export page by page.
+type Exporter struct {
+	client   *api.Client
+	spec     Spec
+	sections []string
+	state    State
+	file     *os.File
+	started  time.Time
+	resumed  int
+}
+
+// PartialPath is where an export is written until it completes.
+func PartialPath(path string) string {
+	return path + ".partial"
+}
+
+// StatePath is where the checkpoint of an unfinished export is kept.
+func StatePath(path string) string {
+	return path + ".partial.state"
+}
+
+// Open starts the export described by spec, or resumes it when a checkpoint
+// for the same resource, format, filter, and page size is found next to the
+// destination.
+func Open(client *api.Client, spec Spec) (*Exporter, error) {
+	spec.Path = strings.TrimSpace(spec.Path)
+	if spec.Path == "" {
+		return nil, fmt.Errorf("export path is required")
+	}
+	if spec.PageSize <= 0 {
+		spec.PageSize = DefaultPageSize
+	}
+	var sections []string
+	switch spec.Resource {
+	case "entities", "context", "relationships", "jobs":
+		sections = []string{spec.Resource}
+	case "snapshot":
+		if spec.Format != "json" {
+			return nil, fmt.Errorf("snapshot export supports json only")
+		}
+		sections = snapshotSections
+	default:
+		return nil, fmt.Errorf("unknown export resource %q", spec.Resource)
+	}
+	if spec.Format != "json" && spec.Format != "csv" {
+		return nil, fmt.Errorf("unknown export format %q", spec.Format)
+	}
+
+	e := &Exporter{client: client, spec: spec, sections: sections, started: time.Now()}
+	resumed, err := e.resume()
+	if err != nil {
+		return nil, err
+	}
+	if resumed {
+		return e, nil
+	}
+	if err := e.start(); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// resume reopens the partial file when a matching checkpoint exists.
+func (e *Exporter) resume() (bool, error) {
+	raw, err := os.ReadFile(StatePath(e.spec.Path))
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("read export checkpoint: %w", err)
+	}
+	var state State
+	if json.Unmarshal(raw, &state) != nil || !e.matches(state) {
+		return false, nil
+	}
+	file, err := os.OpenFile(PartialPath(e.spec.Path), os.O_RDWR, 0o600)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("open partial export: %w", err)
+	}
+	info, err := file.Stat()
+	if err == nil && info.Size() < state.Bytes {
+		_ = file.Close()
+		return false, nil
+	}
+	if err == nil {
+		err = file.Truncate(state.Bytes)
+	}
+	if err == nil {
+		_, err = file.Seek(state.Bytes, 0)
+	}
+	if err != nil {
+		_ = file.Close()
+		return false, fmt.Errorf("rewind partial export: %w", err)
+	}
+	e.file = file
+	e.state = state
+	e.resumed = state.Records
+	return true, nil
+}
+
+// matches reports whether a checkpoint belongs to this export.
+func (e *Exporter) matches(state State) bool {
+	filter := map[string]string(e.spec.Filter)
+	if len(filter) == 0 {
+		filter = nil
+	}
+	return state.Resource == e.spec.Resource &&
+		state.Format == e.spec.Format &&
+		state.PageSize == e.spec.PageSize &&
+		len(state.Totals) == len(e.sections) &&
+		maps.Equal(state.Filter, filter)
+}
+
+// start counts every section and creates an empty partial file.
+func (e *Exporter) start() error {
+	totals := make([]int, len(e.sections))
+	for i, section := range e.sections {
+		page, err := e.fetch(section, 0, 1)
+		if err != nil {
+			return fmt.Errorf("count %s: %w", section, err)
+		}
+		totals[i] = page.Total
+	}
+	file, err := os.OpenFile(PartialPath(e.spec.Path), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("create partial export: %w", err)
+	}
+	e.file = file
+	e.state = State{
+		Resource: e.spec.Resource,
+		Format:   e.spec.Format,
+		PageSize: e.spec.PageSize,
+		Totals:   totals,
+	}
+	if len(e.spec.Filter) > 0 {
+		e.state.Filter = maps.Clone(map[string]string(e.spec.Filter))
+	}
+	if err := e.saveState(e.state); err != nil {
+		_ = e.Close()
+		return err
+	}
+	return nil
+}
+
+// fetch requests one page of a section.
+func (e *Exporter) fetch(section string, offset, limit int) (*api.ExportResult, error) {
+	params := api.QueryParams{
+		"format": e.spec.Format,
+		"limit":  strconv.Itoa(limit),
+		"offset": strconv.Itoa(offset),
+	}
+	if e.spec.Resource != "snapshot" {
+		for k, v := range e.spec.Filter {
+			params[k] = v
+		}
+	}
+	switch section {
+	case "entities":
+		return e.client.ExportEntities(params)
+	case "context":
+		return e.client.ExportContextItems(params)
+	case "relationships":
+		return e.client.ExportRelationships(params)
+	case "jobs":
+		return e.client.ExportJobs(params)
+	}
+	return nil, fmt.Errorf("unknown export section %q", section)
+}
+
+// Resumed returns how many records were already written before this run.
+func (e *Exporter) Resumed() int {
+	return e.resumed
+}
+
+// Complete reports whether every page has been written.
+func (e *Exporter) Complete() bool {
+	return e.state.Section >= len(e.sections)
+}
+
+// Next fetches and appends the next page, then checkpoints. It reports
+// whether the export is complete.
+func (e *Exporter) Next() (bool, error) {
+	if e.Complete() {
+		return true, nil
+	}
+	if e.file == nil {
+		return false, fmt.Errorf("export is closed")
+	}
+	section := e.sections[e.state.Section]
+	page, err := e.fetch(section, e.state.Offset, e.state.PageSize)
+	if err != nil {
+		return false, fmt.Errorf("export %s at offset %d: %w", section, e.state.Offset, err)
+	}
+
+	next := e.state
+	var buf bytes.Buffer
+	if !next.SectionOpen {
+		e.openSection(&buf, next.Section)
+		next.SectionOpen = true
+	}
+	written, err := e.appendPage(&buf, page, next.SectionRecords)
+	if err != nil {
+		return false, err
+	}
+	next.SectionRecords += written
+	next.Records += written
+	last := page.Count == 0 ||
+		(page.Count < next.PageSize && next.Offset+next.PageSize >= next.Totals[next.Section])
+	next.Offset += next.PageSize
+	if last {
+		e.closeSection(&buf, next.SectionRecords)
+		next.Section++
+		next.Offset = 0
+		next.SectionOpen = false
+		next.SectionRecords = 0
+		if next.Section == len(e.sections) && e.spec.Resource == "snapshot" {
+			buf.WriteString("\n}")
+		}
+	}
+
+	n, err := e.file.Write(buf.Bytes())
+	if err == nil {
+		err = e.file.Sync()
+	}
+	if err != nil {
+		return false, fmt.Errorf("write partial export: %w", err)
+	}
+	next.Bytes += int64(n)
+	if err := e.saveState(next); err != nil {
+		return false, err
+	}
+	e.state = next
+	return e.Complete(), nil
+}
+
+// indents returns the indentation of items and of the closing bracket for
+// JSON arrays; snapshot arrays sit one level deeper.
+func (e *Exporter) indents() (item, closing string) {
+	if e.spec.Resource == "snapshot" {
+		return "    ", "  "
+	}
+	return "  ", ""
+}
+
+// openSection writes what precedes the first record of a section.
+func (e *Exporter) openSection(buf *bytes.Buffer, section int) {
+	if e.spec.Format != "json" {
+		return
+	}
+	if e.spec.Resource != "snapshot" {
+		buf.WriteString("[")
+		return
+	}
+	if section == 0 {
+		buf.WriteString("{\n  \"format\": \"json\"")
+	}
+	fmt.Fprintf(buf, ",\n  %q: [", e.sections[section])
+}
+
+// appendPage writes a page's records. A CSV page repeats the header, which
+// is kept only for the first page with rows.
+func (e *Exporter) appendPage(buf *bytes.Buffer, page *api.ExportResult, written int) (int, error) {
+	if e.spec.Format == "csv" {
+		content := page.Content
+		if written > 0 {
+			if _, rest, ok := strings.Cut(content, "\n"); ok {
+				content = rest
+			} else {
+				content = ""
+			}
+		}
+		buf.WriteString(content)
+		return page.Count, nil
+	}
+	indent, _ := e.indents()
+	for i, item := range page.Items {
+		raw, err := json.MarshalIndent(item, indent, "  ")
+		if err != nil {
+			return 0, fmt.Errorf("encode export record: %w", err)
+		}
+		if written+i > 0 {
+			buf.WriteString(",")
+		}
+		buf.WriteString("\n" + indent)
+		buf.Write(raw)
+	}
+	return len(page.Items), nil
+}
+
+// closeSection writes what follows the last record of a section.
+func (e *Exporter) closeSection(buf *bytes.Buffer, written int) {
+	if e.spec.Format != "json" {
+		return
+	}
+	_, closing := e.indents()
+	if written > 0 {
+		buf.WriteString("\n" + closing)
+	}
+	buf.WriteString("]")
+}
+
+// saveState replaces the checkpoint file atomically.
+func (e *Exporter) saveState(state State) error {
+	raw, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("encode export checkpoint: %w", err)
+	}
+	if err := writeFileAtomic(StatePath(e.spec.Path), raw, 0o600); err != nil {
+		return fmt.Errorf("save export checkpoint: %w", err)
+	}
+	return nil
+}
+
+// Progress reports how far the export has come.
+func (e *Exporter) Progress() Progress {
+	p := Progress{
+		Records: e.state.Records,
+		Resumed: e.resumed,
+		Elapsed: time.Since(e.started),
+		ETA:     -1,
+		Done:    e.Complete(),
+	}
+	for _, total := range e.state.Totals {
+		p.Total += total
+	}
+	if seconds := p.Elapsed.Seconds(); seconds > 0 {
+		p.Rate = float64(p.Records-p.Resumed) / seconds
+	}
+	switch {
+	case p.Done:
+		p.ETA = 0
+	case p.Rate > 0 && p.Total > 0:
+		remaining := max(p.Total-p.Records, 0)
+		p.ETA = time.Duration(float64(remaining) / p.Rate * float64(time.Second))
+	}
+	return p
+}
+
+// Finish moves a complete export into place, sealing it into an encrypted
+// archive when passphrase is set, and removes the checkpoint.
+func (e *Exporter) Finish(passphrase string) error {
+	if !e.Complete() {
+		return fmt.Errorf("export is not complete")
+	}
+	if err := e.Close(); err != nil {
+		return err
+	}
+	partial := PartialPath(e.spec.Path)
+	if passphrase != "" {
+		data, err := os.ReadFile(partial)
+		if err != nil {
+			return fmt.Errorf("read partial export: %w", err)
+		}
+		sealed, err := archive.Encrypt(data, passphrase)
+		if err != nil {
+			return err
+		}
+		if err := writeFileAtomic(e.spec.Path, sealed, 0o600); err != nil {
+			return fmt.Errorf("write export: %w", err)
+		}
+		if err := os.Remove(partial); err != nil {
+			return fmt.Errorf("remove partial export: %w", err)
+		}
+	} else {
+		if err := os.Chmod(partial, 0o644); err != nil {
+			return fmt.Errorf("write export: %w", err)
+		}
+		if err := os.Rename(partial, e.spec.Path); err != nil {
+			return fmt.Errorf("write export: %w", err)
+		}
+	}
+	if err := os.Remove(StatePath(e.spec.Path)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("remove export checkpoint: %w", err)
+	}
+	return nil
+}
+
+// Close stops writing and keeps the partial file and checkpoint so the
+// export can be resumed.
+func (e *Exporter) Close() error {
+	if e.file == nil {
+		return nil
+	}
+	err := e.file.Close()
+	e.file = nil
+	if err != nil {
+		return fmt.Errorf("close partial export: %w", err)
+	}
+	return nil
+}
+
+// writeFileAtomic writes data to a temporary file beside path and renames
+// it over path.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, perm); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+package exporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/archive"
+)
+
+// pagedServer serves rows per resource with limit/offset paging. fail, when
+// set, rejects a request before it is served.
+func pagedServer(t *testing.T, rows map[string]int, fail func(r *http.Request) bool) *api.Client {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail != nil && fail(r) {
+			http.Error(w, `{"error":{"code":"UNAVAILABLE","message":"down"}}`, http.StatusServiceUnavailable)
+			return
+		}
+		resource := strings.TrimPrefix(r.URL.Path, "/api/export/")
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		total := rows[resource]
+		var items []map[string]any
+		for i := offset; i < total && i < offset+limit; i++ {
+			items = append(items, map[string]any{"id": fmt.Sprintf("%s-%d", resource, i), "name": "row " + strconv.Itoa(i)})
+		}
+		data := map[string]any{"format": r.URL.Query().Get("format"), "count": len(items)}
+		if offset == 0 {
+			data["total"] = total
+		}
+		if r.URL.Query().Get("format") == "csv" {
+			content := ""
+			if len(items) > 0 {
+				content = "id,name\r\n"
+				for _, item := range items {
+					content += fmt.Sprintf("%s,%s\r\n", item["id"], item["name"])
+				}
+			}
+			data["content"] = content
+		} else {
+			data["items"] = items
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": data}))
+	}))
+	t.Cleanup(srv.Close)
+	return api.NewClient(srv.URL, "nbl_test")
+}
+
+// runAll drives an export to completion.
+func runAll(t *testing.T, e *Exporter) {
+	t.Helper()
+	for i := 0; i < 100; i++ {
+		done, err := e.Next()
+		require.NoError(t, err)
+		if done {
+			return
+		}
+	}
+	t.Fatal("export did not complete")
+}
+
+func TestExportWritesEveryPageAndMovesIntoPlace(t *testing.T) {
+	client := pagedServer(t, map[string]int{"entities": 5}, nil)
+	path := filepath.Join(t.TempDir(), "entities.json")
+
+	e, err := Open(client, Spec{Resource: "entities", Format: "json", Path: path, PageSize: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 5, e.Progress().Total)
+	runAll(t, e)
+	progress := e.Progress()
+	assert.Equal(t, 5, progress.Records)
+	assert.Equal(t, 1.0, progress.Fraction())
+	require.NoError(t, e.Finish(""))
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var items []map[string]any
+	require.NoError(t, json.Unmarshal(raw, &items))
+	require.Len(t, items, 5)
+	assert.Equal(t, "entities-4", items[4]["id"])
+	assert.NoFileExists(t, PartialPath(path))
+	assert.NoFileExists(t, StatePath(path))
+}
+
+func TestExportResumesFromLastCompletedPage(t *testing.T) {
+	down := true
+	client := pagedServer(t, map[string]int{"jobs": 7}, func(r *http.Request) bool {
+		return down && r.URL.Query().Get("offset") == "4"
+	})
+	path := filepath.Join(t.TempDir(), "jobs.json")
+	spec := Spec{Resource: "jobs", Format: "json", Path: path, PageSize: 2}
+
+	e, err := Open(client, spec)
+	require.NoError(t, err)
+	_, err = e.Next()
+	require.NoError(t, err)
+	_, err = e.Next()
+	require.NoError(t, err)
+	_, err = e.Next()
+	require.ErrorContains(t, err, "offset 4")
+	require.NoError(t, e.Close())
+	assert.NoFileExists(t, path)
+
+	// A torn write past the checkpoint is dropped on resume.
+	f, err := os.OpenFile(PartialPath(path), os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = f.WriteString(",\n  {\"id\": \"torn")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	down = false
+	e, err = Open(client, spec)
+	require.NoError(t, err)
+	assert.Equal(t, 4, e.Resumed())
+	runAll(t, e)
+	require.NoError(t, e.Finish(""))
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var items []map[string]any
+	require.NoError(t, json.Unmarshal(raw, &items))
+	require.Len(t, items, 7)
+	for i, item := range items {
+		assert.Equal(t, fmt.Sprintf("jobs-%d", i), item["id"])
+	}
+}
+
+func TestExportRestartsWhenCheckpointDoesNotMatch(t *testing.T) {
+	client := pagedServer(t, map[string]int{"entities": 3, "context": 3}, nil)
+	path := filepath.Join(t.TempDir(), "out.json")
+
+	e, err := Open(client, Spec{Resource: "entities", Format: "json", Path: path, PageSize: 2})
+	require.NoError(t, err)
+	_, err = e.Next()
+	require.NoError(t, err)
+	require.NoError(t, e.Close())
+
+	e, err = Open(client, Spec{Resource: "context", Format: "json", Path: path, PageSize: 2})
+	require.NoError(t, err)
+	assert.Zero(t, e.Resumed())
+	runAll(t, e)
+	require.NoError(t, e.Finish(""))
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(raw), "context-0")
+	assert.NotContains(t, string(raw), "entities-0")
+}
+
+func TestExportCSVKeepsOneHeader(t *testing.T) {
+	client := pagedServer(t, map[string]int{"relationships": 3}, nil)
+	path := filepath.Join(t.TempDir(), "rels.csv")
+
+	e, err := Open(client, Spec{Resource: "relationships", Format: "csv", Path: path, PageSize: 2})
+	require.NoError(t, err)
+	runAll(t, e)
+	require.NoError(t, e.Finish(""))
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "id,name\r\nrelationships-0,row 0\r\nrelationships-1,row 1\r\nrelationships-2,row 2\r\n", string(raw))
+}
+
+func TestExportSnapshotAssemblesSectionsAndEncrypts(t *testing.T) {
+	client := pagedServer(t, map[string]int{"entities": 3, "context": 0, "relationships": 1, "jobs": 2}, nil)
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+
+	e, err := Open(client, Spec{Resource: "snapshot", Format: "json", Path: path, PageSize: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 6, e.Progress().Total)
+	runAll(t, e)
+	require.NoError(t, e.Finish("correct horse"))
+
+	sealed, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.True(t, archive.IsEncrypted(sealed))
+	raw, err := archive.Decrypt(sealed, "correct horse")
+	require.NoError(t, err)
+	var snapshot map[string]any
+	require.NoError(t, json.Unmarshal(raw, &snapshot))
+	assert.Equal(t, "json", snapshot["format"])
+	assert.Len(t, snapshot["entities"], 3)
+	assert.Empty(t, snapshot["context"])
+	assert.Len(t, snapshot["relationships"], 1)
+	assert.Len(t, snapshot["jobs"], 2)
+	assert.NoFileExists(t, PartialPath(path))
+
+	_, err = Open(client, Spec{Resource: "snapshot", Format: "csv", Path: path})
+	require.ErrorContains(t, err, "json only")
+}
@@ -0,0 +1,311 @@
+// Package filecheck recomputes checksums for file records whose paths are on
+// this machine and reports the ones that no longer match what was stored. It
+// also probes local files to fill in new records.
+package filecheck
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+const (
+	// PageSize is the page size used when scanning files.
+	PageSize = 100
+	// MaxFiles caps how many file records one scan reads.
+	MaxFiles = 5000
+)
+
+// DefaultAlgorithm is used for records without a stored checksum.
+const DefaultAlgorithm = "sha256"
+
+// Status is the outcome of checking one file.
+type Status string
+
+// Check outcomes.
+const (
+	StatusOK        Status = "ok"
+	StatusDrift     Status = "drift"
+	StatusMissing   Status = "missing"
+	StatusUnchecked Status = "unchecked"
+	StatusRemote    Status = "remote"
+	StatusError     Status = "error"
+)
+
+// Result is the outcome of checking one file record.
+type Result struct {
+	File      api.File
+	Path      string
+	Algorithm string
+	Stored    string
+	Actual    string
+	Size      int64
+	Status    Status
+	Err       error
+}
+
+// Drifted reports whether the file on disk no longer matches its record.
+func (r Result) Drifted() bool {
+	return r.Status == StatusDrift
+}
+
+// Fetch pages through file records up to MaxFiles.
+func Fetch(client *api.Client) ([]api.File, error) {
+	var all []api.File
+	for offset := 0; offset < MaxFiles; offset += PageSize {
+		items, err := client.QueryFiles(api.QueryParams{
+			"limit":  strconv.Itoa(PageSize),
+			"offset": strconv.Itoa(offset),
+		})
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		if len(items) < PageSize {
+			break
+		}
+	}
+	return all, nil
+}
+
+// LocalPath returns the on-disk path of a file record, accepting plain paths
+// and file:// URIs. Records that live elsewhere (s3://, https://, ...)
+// report false.
+func LocalPath(f api.File) (string, bool) {
+	raw := strings.TrimSpace(f.FilePath)
+	if raw == "" {
+		raw = strings.TrimSpace(f.URI)
+	}
+	return ExpandPath(raw)
+}
+
+// ExpandPath turns a typed path or file:// URI into a clean local path,
+// expanding a leading ~. Other URI schemes report false.
+func ExpandPath(raw string) (string, bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", false
+	}
+	if strings.Contains(raw, "://") {
+		parsed, err := url.Parse(raw)
+		if err != nil || !strings.EqualFold(parsed.Scheme, "file") || parsed.Path == "" {
+			return "", false
+		}
+		raw = parsed.Path
+	}
+	if raw == "~" || strings.HasPrefix(raw, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", false
+		}
+		raw = filepath.Join(home, strings.TrimPrefix(raw, "~"))
+	}
+	return filepath.Clean(raw), true
+}
+
+// ParseChecksum splits a stored checksum into its algorithm and lowercase
+// hex digest. "sha256:ab12..." names the algorithm; a bare digest is
+// identified by its length.
+func ParseChecksum(stored string) (algorithm, digest string) {
+	stored = strings.TrimSpace(stored)
+	if stored == "" {
+		return "", ""
+	}
+	if prefix, rest, ok := strings.Cut(stored, ":"); ok {
+		algorithm = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(prefix), "-", ""))
+		return algorithm, strings.ToLower(strings.TrimSpace(rest))
+	}
+	digest = strings.ToLower(stored)
+	switch len(digest) {
+	case 32:
+		return "md5", digest
+	case 40:
+		return "sha1", digest
+	case 128:
+		return "sha512", digest
+	}
+	return DefaultAlgorithm, digest
+}
+
+// FormatChecksum renders a digest the way stored was written: prefixed when
+// stored was prefixed or empty, bare otherwise.
+func FormatChecksum(stored, algorithm, digest string) string {
+	if strings.TrimSpace(stored) != "" && !strings.Contains(stored, ":") {
+		return digest
+	}
+	return algorithm + ":" + digest
+}
+
+// newHash returns the hash for an algorithm name.
+func newHash(algorithm string) (hash.Hash, error) {
+	switch algorithm {
+	case "md5":
+		return md5.New(), nil
+	case "sha1":
+		return sha1.New(), nil
+	case "sha256":
+		return sha256.New(), nil
+	case "sha512":
+		return sha512.New(), nil
+	}
+	return nil, fmt.Errorf("unsupported checksum algorithm %q", algorithm)
+}
+
+// Checksum hashes the file at path and returns its hex digest and size.
+func Checksum(path, algorithm string) (string, int64, error) {
+	h, err := newHash(algorithm)
+	if err != nil {
+		return "", 0, err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return "", 0, err
+	}
+	defer func() { _ = file.Close() }()
+	size, err := io.Copy(h, file)
+	if err != nil {
+		return "", 0, fmt.Errorf("read %s: %w", path, err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), size, nil
+}
+
+// Probe describes a local file for a new file record.
+type Probe struct {
+	MimeType string
+	Size     int64
+	Checksum string
+}
+
+// ProbeFile detects the MIME type of the file at path, from its extension
+// or else its first bytes, and computes its size and sha256 checksum.
+func ProbeFile(raw string) (Probe, error) {
+	path, ok := ExpandPath(raw)
+	if !ok {
+		return Probe{}, fmt.Errorf("%s is not a local path", strings.TrimSpace(raw))
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return Probe{}, err
+	}
+	if info.IsDir() {
+		return Probe{}, fmt.Errorf("%s is a directory", path)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return Probe{}, err
+	}
+	defer func() { _ = file.Close() }()
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return Probe{}, fmt.Errorf("read %s: %w", path, err)
+	}
+	head = head[:n]
+	h := sha256.New()
+	h.Write(head)
+	rest, err := io.Copy(h, file)
+	if err != nil {
+		return Probe{}, fmt.Errorf("read %s: %w", path, err)
+	}
+
+	mimeType := mime.TypeByExtension(strings.ToLower(filepath.Ext(path)))
+	if mimeType == "" {
+		mimeType = http.DetectContentType(head)
+	}
+	if media, _, err := mime.ParseMediaType(mimeType); err == nil {
+		mimeType = media
+	}
+	return Probe{
+		MimeType: mimeType,
+		Size:     int64(n) + rest,
+		Checksum: DefaultAlgorithm + ":" + hex.EncodeToString(h.Sum(nil)),
+	}, nil
+}
+
+// Verify checks one file record against the file on disk.
+func Verify(f api.File) Result {
+	result := Result{File: f}
+	if f.Checksum != nil {
+		result.Stored = strings.TrimSpace(*f.Checksum)
+	}
+	path, ok := LocalPath(f)
+	if !ok {
+		result.Status = StatusRemote
+		return result
+	}
+	result.Path = path
+
+	algorithm, stored := ParseChecksum(result.Stored)
+	if algorithm == "" {
+		algorithm = DefaultAlgorithm
+	}
+	result.Algorithm = algorithm
+	digest, size, err := Checksum(path, algorithm)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		result.Status = StatusMissing
+		return result
+	case err != nil:
+		result.Status = StatusError
+		result.Err = err
+		return result
+	}
+	result.Actual = digest
+	result.Size = size
+	switch {
+	case stored == "":
+		result.Status = StatusUnchecked
+	case stored == digest:
+		result.Status = StatusOK
+	default:
+		result.Status = StatusDrift
+	}
+	return result
+}
+
+// VerifyAll checks every record in order.
+func VerifyAll(files []api.File) []Result {
+	results := make([]Result, len(files))
+	for i, f := range files {
+		results[i] = Verify(f)
+	}
+	return results
+}
+
+// Counts tallies results by status.
+func Counts(results []Result) map[Status]int {
+	counts := map[Status]int{}
+	for _, r := range results {
+		counts[r.Status]++
+	}
+	return counts
+}
+
+// Reregister stores the checksum and size computed for a drifted or
+// unchecked file on its record.
+func Reregister(client *api.Client, r Result) error {
+	if r.Actual == "" {
+		return fmt.Errorf("file %s has no computed checksum", r.File.ID)
+	}
+	checksum := FormatChecksum(r.Stored, r.Algorithm, r.Actual)
+	size := r.Size
+	_, err := client.UpdateFile(r.File.ID, api.UpdateFileInput{Checksum: &checksum, SizeBytes: &size})
+	return err
+}
@@ -0,0 +1,113 @@
+package filecheck
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+// helloSHA256 is the sha256 digest of "hello".
+const helloSHA256 = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+
+func TestParseChecksum(t *testing.T) {
+	cases := map[string][2]string{
+		"sha256:ABCD":                      {"sha256", "abcd"},
+		"SHA-1:abcd":                       {"sha1", "abcd"},
+		"5d41402abc4b2a76b9719d911017c592": {"md5", "5d41402abc4b2a76b9719d911017c592"},
+		helloSHA256:                        {"sha256", helloSHA256},
+		"":                                 {"", ""},
+	}
+	for input, want := range cases {
+		algorithm, digest := ParseChecksum(input)
+		assert.Equal(t, want[0], algorithm, input)
+		assert.Equal(t, want[1], digest, input)
+	}
+}
+
+func TestLocalPath(t *testing.T) {
+	path, ok := LocalPath(api.File{FilePath: "/vault/a.txt"})
+	assert.True(t, ok)
+	assert.Equal(t, "/vault/a.txt", path)
+
+	path, ok = LocalPath(api.File{URI: "file:///vault/b.txt"})
+	assert.True(t, ok)
+	assert.Equal(t, "/vault/b.txt", path)
+
+	_, ok = LocalPath(api.File{FilePath: "s3://bucket/c.txt"})
+	assert.False(t, ok)
+	_, ok = LocalPath(api.File{})
+	assert.False(t, ok)
+}
+
+func TestVerifyStatuses(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "hello.txt")
+	require.NoError(t, os.WriteFile(path, []byte("hello"), 0o600))
+	sum := func(s string) *string { return &s }
+
+	ok := Verify(api.File{ID: "f1", FilePath: path, Checksum: sum("sha256:" + helloSHA256)})
+	assert.Equal(t, StatusOK, ok.Status)
+	assert.Equal(t, int64(5), ok.Size)
+
+	bare := Verify(api.File{ID: "f2", FilePath: path, Checksum: sum("5d41402abc4b2a76b9719d911017c592")})
+	assert.Equal(t, StatusOK, bare.Status, "bare md5 digests are recognized by length")
+
+	drift := Verify(api.File{ID: "f3", FilePath: path, Checksum: sum("sha256:" + helloSHA256[:60] + "0000")})
+	assert.True(t, drift.Drifted())
+	assert.Equal(t, helloSHA256, drift.Actual)
+
+	unchecked := Verify(api.File{ID: "f4", FilePath: path})
+	assert.Equal(t, StatusUnchecked, unchecked.Status)
+	assert.Equal(t, DefaultAlgorithm, unchecked.Algorithm)
+
+	missing := Verify(api.File{ID: "f5", FilePath: filepath.Join(dir, "gone.txt"), Checksum: sum(helloSHA256)})
+	assert.Equal(t, StatusMissing, missing.Status)
+
+	remote := Verify(api.File{ID: "f6", URI: "https://example.com/a.txt"})
+	assert.Equal(t, StatusRemote, remote.Status)
+
+	unknown := Verify(api.File{ID: "f7", FilePath: path, Checksum: sum("crc32:abcd")})
+	assert.Equal(t, StatusError, unknown.Status)
+	assert.Error(t, unknown.Err)
+
+	counts := Counts([]Result{ok, bare, drift, unchecked, missing, remote, unknown})
+	assert.Equal(t, 2, counts[StatusOK])
+	assert.Equal(t, 1, counts[StatusDrift])
+}
+
+func TestFormatChecksumKeepsStoredStyle(t *testing.T) {
+	assert.Equal(t, "sha256:ab", FormatChecksum("sha256:cd", "sha256", "ab"))
+	assert.Equal(t, "ab", FormatChecksum("cd", "sha256", "ab"))
+	assert.Equal(t, "sha256:ab", FormatChecksum("", "sha256", "ab"))
+}
+
+func TestProbeFileFillsMimeSizeAndChecksum(t *testing.T) {
+	dir := t.TempDir()
+	text := filepath.Join(dir, "notes.txt")
+	require.NoError(t, os.WriteFile(text, []byte("hello"), 0o600))
+
+	probe, err := ProbeFile(text)
+	require.NoError(t, err)
+	assert.Equal(t, "text/plain", probe.MimeType)
+	assert.Equal(t, int64(5), probe.Size)
+	assert.Equal(t, "sha256:"+helloSHA256, probe.Checksum)
+
+	// Without a known extension the content decides.
+	png := filepath.Join(dir, "image")
+	require.NoError(t, os.WriteFile(png, []byte("\x89PNG\r\n\x1a\n0000"), 0o600))
+	probe, err = ProbeFile("file://" + png)
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", probe.MimeType)
+
+	_, err = ProbeFile(dir)
+	assert.ErrorContains(t, err, "is a directory")
+	_, err = ProbeFile(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+	_, err = ProbeFile("s3://bucket/key")
+	assert.ErrorContains(t, err, "not a local path")
+}
@@ -0,0 +1,416 @@
+// Package gitingest reads a local git repository into the records
+// `nebula ingest git` writes: a project entity for the repo, person entities
+// for its key contributors, and knowledge items for recent notable commits.
+package gitingest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ExclusionsFile is the per-repo exclusion config, read from the repo root.
+const ExclusionsFile = ".nebula-ingest.yaml"
+
+// Exclusions keep bots, generated files, and noise out of the ingest.
+type Exclusions struct {
+	// Authors are globs matched against contributor names and emails.
+	Authors []string `yaml:"exclude_authors"`
+	// Paths are globs matched against changed files; a trailing slash
+	// matches a whole directory. Excluded files do not count toward a
+	// commit's size, and commits touching only them are skipped.
+	Paths []string `yaml:"exclude_paths"`
+	// Subjects are regular expressions matched against commit subjects.
+	Subjects []string `yaml:"exclude_subjects"`
+
+	subjects []*regexp.Regexp
+}
+
+// LoadExclusions reads an exclusion config, returning none when missing.
+func LoadExclusions(file string) (Exclusions, error) {
+	var ex Exclusions
+	data, err := os.ReadFile(file)
+	if errors.Is(err, os.ErrNotExist) {
+		return ex, nil
+	}
+	if err != nil {
+		return ex, fmt.Errorf("read %s: %w", file, err)
+	}
+	if err := yaml.Unmarshal(data, &ex); err != nil {
+		return ex, fmt.Errorf("parse %s: %w", file, err)
+	}
+	return ex, ex.compile()
+}
+
+// compile parses the subject patterns.
+func (e *Exclusions) compile() error {
+	e.subjects = nil
+	for _, pattern := range e.Subjects {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("invalid exclude_subjects pattern %q: %w", pattern, err)
+		}
+		e.subjects = append(e.subjects, re)
+	}
+	return nil
+}
+
+// author reports whether a contributor is excluded.
+func (e Exclusions) author(name, email string) bool {
+	for _, pattern := range e.Authors {
+		for _, value := range []string{name, email} {
+			if ok, _ := path.Match(strings.ToLower(pattern), strings.ToLower(value)); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// file reports whether a changed file is excluded.
+func (e Exclusions) file(name string) bool {
+	for _, pattern := range e.Paths {
+		if dir, ok := strings.CutSuffix(pattern, "/"); ok {
+			if name == dir || strings.HasPrefix(name, dir+"/") {
+				return true
+			}
+			continue
+		}
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+		if ok, _ := path.Match(pattern, path.Base(name)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// subject reports whether a commit subject is excluded.
+func (e Exclusions) subject(subject string) bool {
+	for _, re := range e.subjects {
+		if re.MatchString(subject) {
+			return true
+		}
+	}
+	return false
+}
+
+// Options control what a scan picks up.
+type Options struct {
+	// Since bounds the commits considered for knowledge items.
+	Since time.Time
+	// Contributors caps how many key contributors are kept.
+	Contributors int
+	// MinCommits is the fewest commits that make a key contributor.
+	MinCommits int
+	// Commits caps how many notable commits are kept, newest first.
+	Commits int
+	// MinLines is the smallest change, in added plus deleted lines outside
+	// excluded paths, that makes a commit notable on size alone.
+	MinLines int
+	Exclude  Exclusions
+}
+
+// Repo is what a scan found.
+type Repo struct {
+	Root   string
+	Name   string
+	Remote string
+	// WebURL is the browsable https URL of the remote, empty when unknown.
+	WebURL string
+	// Key identifies the repo across machines: the remote host and path, or
+	// the local root when there is no remote.
+	Key          string
+	Branch       string
+	Head         string
+	TotalCommits int
+	LastCommitAt time.Time
+	Contributors []Contributor
+	Notable      []Commit
+}
+
+// Contributor is a key contributor of the repo.
+type Contributor struct {
+	Name    string
+	Email   string
+	Commits int
+}
+
+// Commit is a notable commit.
+type Commit struct {
+	Hash    string
+	Author  string
+	Email   string
+	At      time.Time
+	Subject string
+	Body    string
+	Tags    []string
+	Files   int
+	Added   int
+	Deleted int
+	// Reason says why the commit is notable.
+	Reason string
+}
+
+// CommitURL returns the web URL of a commit, empty without a web remote.
+func (r *Repo) CommitURL(hash string) string {
+	if r.WebURL == "" {
+		return ""
+	}
+	if strings.Contains(r.WebURL, "gitlab") {
+		return r.WebURL + "/-/commit/" + hash
+	}
+	return r.WebURL + "/commit/" + hash
+}
+
+// Root returns the top-level directory of the repository containing dir.
+func Root(dir string) (string, error) {
+	root, err := git(dir, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return "", fmt.Errorf("%s is not a git repository: %w", dir, err)
+	}
+	return root, nil
+}
+
+// Scan reads the repository containing dir.
+func Scan(dir string, opts Options) (*Repo, error) {
+	if err := opts.Exclude.compile(); err != nil {
+		return nil, err
+	}
+	root, err := Root(dir)
+	if err != nil {
+		return nil, err
+	}
+	repo := &Repo{Root: root, Name: filepath.Base(root), Key: "local:" + root}
+	if remote, err := git(root, "remote", "get-url", "origin"); err == nil && remote != "" {
+		repo.Remote = remote
+		if host, repoPath := parseRemote(remote); host != "" {
+			repo.Key = host + "/" + repoPath
+			repo.WebURL = "https://" + repo.Key
+			repo.Name = repoPath
+		}
+	}
+	repo.Branch, _ = git(root, "rev-parse", "--abbrev-ref", "HEAD")
+	repo.Head, err = git(root, "rev-parse", "HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("%s has no commits", root)
+	}
+	if count, err := git(root, "rev-list", "--count", "--no-merges", "HEAD"); err == nil {
+		repo.TotalCommits, _ = strconv.Atoi(count)
+	}
+	if last, err := git(root, "log", "-1", "--format=%aI"); err == nil {
+		repo.LastCommitAt, _ = time.Parse(time.RFC3339, last)
+	}
+	if repo.Contributors, err = contributors(root, opts); err != nil {
+		return nil, err
+	}
+	if repo.Notable, err = notableCommits(root, opts); err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+// contributors ranks authors by commit count, honoring .mailmap.
+func contributors(root string, opts Options) ([]Contributor, error) {
+	out, err := git(root, "shortlog", "-sne", "--no-merges", "HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("list contributors: %w", err)
+	}
+	// shortlog tells emails apart by case; people are merged by lowercase
+	// email, keeping the name of their busiest identity.
+	var list []Contributor
+	index := map[string]int{}
+	for _, line := range strings.Split(out, "\n") {
+		count, ident, ok := strings.Cut(strings.TrimSpace(line), "\t")
+		if !ok {
+			continue
+		}
+		n, _ := strconv.Atoi(strings.TrimSpace(count))
+		name, email := splitIdent(ident)
+		if opts.Exclude.author(name, email) {
+			continue
+		}
+		if i, seen := index[email]; seen {
+			list[i].Commits += n
+			continue
+		}
+		index[email] = len(list)
+		list = append(list, Contributor{Name: name, Email: email, Commits: n})
+	}
+	kept := list[:0]
+	for _, c := range list {
+		if c.Commits >= opts.MinCommits {
+			kept = append(kept, c)
+		}
+	}
+	list = kept
+	sort.SliceStable(list, func(i, j int) bool { return list[i].Commits > list[j].Commits })
+	if opts.Contributors > 0 && len(list) > opts.Contributors {
+		list = list[:opts.Contributors]
+	}
+	return list, nil
+}
+
+// splitIdent splits "Name <email>".
+func splitIdent(ident string) (string, string) {
+	name, rest, ok := strings.Cut(ident, " <")
+	if !ok {
+		return strings.TrimSpace(ident), ""
+	}
+	return strings.TrimSpace(name), strings.ToLower(strings.TrimSuffix(strings.TrimSpace(rest), ">"))
+}
+
+// commitFormat separates log records with RS and fields with US; the trailing
+// US ends the body before --numstat output starts.
+const commitFormat = "%x1e%H%x1f%aN%x1f%aE%x1f%aI%x1f%D%x1f%s%x1f%b%x1f"
+
+// breakingSubject matches conventional-commit subjects marked breaking.
+var breakingSubject = regexp.MustCompile(`^[a-z]+(\([^)]*\))?!:`)
+
+// featureSubject matches conventional-commit feature subjects.
+var featureSubject = regexp.MustCompile(`^feat(\([^)]*\))?:`)
+
+// notableCommits picks recent commits worth a knowledge item: releases,
+// breaking changes, features, and large changes.
+func notableCommits(root string, opts Options) ([]Commit, error) {
+	args := []string{"log", "--no-merges", "--numstat", "--format=" + commitFormat}
+	if !opts.Since.IsZero() {
+		args = append(args, "--since="+opts.Since.Format(time.RFC3339))
+	}
+	out, err := git(root, args...)
+	if err != nil {
+		return nil, fmt.Errorf("read commits: %w", err)
+	}
+	var notable []Commit
+	for _, record := range strings.Split(out, "\x1e") {
+		fields := strings.Split(record, "\x1f")
+		if len(fields) < 8 {
+			continue
+		}
+		commit := Commit{
+			Hash:    fields[0],
+			Author:  fields[1],
+			Email:   strings.ToLower(fields[2]),
+			Subject: strings.TrimSpace(fields[5]),
+			Body:    strings.TrimSpace(fields[6]),
+			Tags:    refTags(fields[4]),
+		}
+		commit.At, _ = time.Parse(time.RFC3339, fields[3])
+		if opts.Exclude.author(commit.Author, commit.Email) || opts.Exclude.subject(commit.Subject) {
+			continue
+		}
+		if !commit.countChanges(fields[7], opts.Exclude) {
+			continue
+		}
+		commit.Reason = commit.notability(opts.MinLines)
+		if commit.Reason == "" {
+			continue
+		}
+		notable = append(notable, commit)
+		if opts.Commits > 0 && len(notable) == opts.Commits {
+			break
+		}
+	}
+	return notable, nil
+}
+
+// countChanges tallies --numstat lines outside excluded paths. It reports
+// false when every changed file is excluded.
+func (c *Commit) countChanges(numstat string, ex Exclusions) bool {
+	total, kept := 0, 0
+	for _, line := range strings.Split(strings.TrimSpace(numstat), "\n") {
+		parts := strings.SplitN(line, "\t", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		total++
+		if ex.file(parts[2]) {
+			continue
+		}
+		kept++
+		added, _ := strconv.Atoi(parts[0])
+		deleted, _ := strconv.Atoi(parts[1])
+		c.Added += added
+		c.Deleted += deleted
+	}
+	c.Files = kept
+	return total == 0 || kept > 0
+}
+
+// notability returns why a commit is notable, or "" when it is not.
+func (c Commit) notability(minLines int) string {
+	switch {
+	case len(c.Tags) > 0:
+		return "release " + strings.Join(c.Tags, ", ")
+	case breakingSubject.MatchString(c.Subject) || strings.Contains(c.Body, "BREAKING CHANGE"):
+		return "breaking change"
+	case featureSubject.MatchString(c.Subject):
+		return "feature"
+	case minLines > 0 && c.Added+c.Deleted >= minLines:
+		return fmt.Sprintf("large change (%d lines)", c.Added+c.Deleted)
+	}
+	return ""
+}
+
+// refTags pulls tag names out of a %D decoration list.
+func refTags(decorations string) []string {
+	var tags []string
+	for _, ref := range strings.Split(decorations, ",") {
+		if tag, ok := strings.CutPrefix(strings.TrimSpace(ref), "tag: "); ok {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// scpRemote matches scp-style remotes like git@github.com:owner/repo.git.
+var scpRemote = regexp.MustCompile(`^[\w.-]+@([\w.-]+):(.+)$`)
+
+// parseRemote returns the host and repo path of a remote URL, or empty
+// strings for local remotes.
+func parseRemote(remote string) (string, string) {
+	var host, repoPath string
+	if m := scpRemote.FindStringSubmatch(remote); m != nil {
+		host, repoPath = m[1], m[2]
+	} else if _, rest, ok := strings.Cut(remote, "://"); ok {
+		if at := strings.LastIndex(rest, "@"); at >= 0 {
+			rest = rest[at+1:]
+		}
+		host, repoPath, _ = strings.Cut(rest, "/")
+		if h, _, ok := strings.Cut(host, ":"); ok {
+			host = h
+		}
+	}
+	repoPath = strings.Trim(strings.TrimSuffix(strings.TrimSpace(repoPath), ".git"), "/")
+	if host == "" || repoPath == "" || strings.HasPrefix(remote, "file://") {
+		return "", ""
+	}
+	return strings.ToLower(host), repoPath
+}
+
+// git runs a git command in dir and returns its trimmed stdout.
+func git(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("git %s: %s", args[0], msg)
+		}
+		return "", fmt.Errorf("git %s: %w", args[0], err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
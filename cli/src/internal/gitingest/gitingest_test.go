@@ -0,0 +1,135 @@
+package gitingest
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testRepo builds a small repository with a few authors and commit kinds.
+func testRepo(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	run := func(env []string, args ...string) {
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		cmd.Env = append(os.Environ(), append([]string{
+			"GIT_CONFIG_GLOBAL=/dev/null", "GIT_CONFIG_SYSTEM=/dev/null",
+		}, env...)...)
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	commit := func(name, email, file, body, subject string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, file)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, file), []byte(body), 0o644))
+		run(nil, "add", "-A")
+		run([]string{
+			"GIT_AUTHOR_NAME=" + name, "GIT_AUTHOR_EMAIL=" + email,
+			"GIT_COMMITTER_NAME=" + name, "GIT_COMMITTER_EMAIL=" + email,
+		}, "commit", "-q", "-m", subject)
+	}
+	run(nil, "init", "-q", "-b", "main")
+	run(nil, "remote", "add", "origin", "git@github.com:acme/widgets.git")
+	commit("Ada", "ada@example.com", "README.md", "hi\n", "Initial commit")
+	commit("Ada", "Ada@Example.com", "main.go", "package main\n", "feat(cli): add main")
+	commit("Grace", "grace@example.com", "api.go", "package api\n", "refactor!: drop v1 API")
+	commit("Bot", "bot@example.com", "go.sum", strings.Repeat("x\n", 500), "chore: bump deps")
+	commit("Grace", "grace@example.com", "big.go", strings.Repeat("y\n", 400), "Rewrite scheduler")
+	commit("Grace", "grace@example.com", "vendor/lib.go", strings.Repeat("z\n", 400), "Vendor lib")
+	run(nil, "tag", "v1.0.0")
+	return dir
+}
+
+func TestScanFindsContributorsAndNotableCommits(t *testing.T) {
+	dir := testRepo(t)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ExclusionsFile), []byte(
+		"exclude_authors: [\"bot@*\"]\nexclude_paths: [\"vendor/\", \"*.sum\"]\nexclude_subjects: [\"^chore\"]\n"), 0o644))
+	exclusions, err := LoadExclusions(filepath.Join(dir, ExclusionsFile))
+	require.NoError(t, err)
+
+	repo, err := Scan(filepath.Join(dir), Options{Contributors: 5, MinCommits: 1, Commits: 10, MinLines: 300, Exclude: exclusions})
+	require.NoError(t, err)
+	assert.Equal(t, "acme/widgets", repo.Name)
+	assert.Equal(t, "github.com/acme/widgets", repo.Key)
+	assert.Equal(t, "https://github.com/acme/widgets", repo.WebURL)
+	assert.Equal(t, "main", repo.Branch)
+	assert.Equal(t, 6, repo.TotalCommits)
+
+	require.Len(t, repo.Contributors, 2)
+	assert.Equal(t, Contributor{Name: "Grace", Email: "grace@example.com", Commits: 3}, repo.Contributors[0])
+	assert.Equal(t, "ada@example.com", repo.Contributors[1].Email)
+
+	subjects := map[string]string{}
+	for _, c := range repo.Notable {
+		subjects[c.Subject] = c.Reason
+	}
+	// The tagged vendor commit only touches excluded paths, so it is skipped.
+	assert.Equal(t, map[string]string{
+		"feat(cli): add main":    "feature",
+		"refactor!: drop v1 API": "breaking change",
+		"Rewrite scheduler":      "large change (400 lines)",
+	}, subjects)
+	assert.Equal(t, "Rewrite scheduler", repo.Notable[0].Subject)
+
+	items := repo.ContextItems([]string{"work"})
+	require.Len(t, items, 3)
+	assert.Equal(t, "https://github.com/acme/widgets/commit/"+repo.Notable[0].Hash, items[0]["url"])
+	assert.Equal(t, repo.CommitExternalID(repo.Notable[0].Hash), items[0]["external_id"])
+}
+
+func TestScanKeepsReleasesAndRespectsLimits(t *testing.T) {
+	dir := testRepo(t)
+	repo, err := Scan(dir, Options{Contributors: 1, MinCommits: 2, Commits: 1})
+	require.NoError(t, err)
+	require.Len(t, repo.Contributors, 1)
+	assert.Equal(t, "Grace", repo.Contributors[0].Name)
+	require.Len(t, repo.Notable, 1)
+	assert.Equal(t, "release v1.0.0", repo.Notable[0].Reason)
+
+	repo, err = Scan(dir, Options{Since: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+	assert.Empty(t, repo.Notable)
+
+	_, err = Scan(t.TempDir(), Options{})
+	assert.ErrorContains(t, err, "not a git repository")
+}
+
+func TestRelationshipItemsSkipUnknownRecords(t *testing.T) {
+	repo := &Repo{
+		Key:          "github.com/acme/widgets",
+		Contributors: []Contributor{{Name: "Ada", Email: "ada@example.com", Commits: 4}, {Name: "Bo", Email: "bo@example.com"}},
+		Notable:      []Commit{{Hash: "abc", Email: "ada@example.com"}},
+	}
+	assert.Nil(t, repo.RelationshipItems(map[string]string{}))
+
+	items := repo.RelationshipItems(map[string]string{
+		repo.RepoExternalID():                    "ent-repo",
+		ContributorExternalID("ada@example.com"): "ent-ada",
+		repo.CommitExternalID("abc"):             "ctx-1",
+	})
+	require.Len(t, items, 3)
+	assert.Equal(t, "contributes-to", items[0]["relationship_type"])
+	assert.Equal(t, "ent-ada", items[0]["source_id"])
+	assert.Equal(t, "about", items[1]["relationship_type"])
+	assert.Equal(t, "created-by", items[2]["relationship_type"])
+	assert.Equal(t, "ent-ada", items[2]["target_id"])
+}
+
+func TestParseRemote(t *testing.T) {
+	cases := map[string][2]string{
+		"git@github.com:acme/widgets.git":             {"github.com", "acme/widgets"},
+		"https://token@GitLab.com/group/sub/repo.git": {"gitlab.com", "group/sub/repo"},
+		"ssh://git@git.example.com:2222/team/tool":    {"git.example.com", "team/tool"},
+		"file:///srv/repos/tool.git":                  {"", ""},
+		"/srv/repos/tool.git":                         {"", ""},
+	}
+	for remote, want := range cases {
+		host, repoPath := parseRemote(remote)
+		assert.Equal(t, want, [2]string{host, repoPath}, remote)
+	}
+}
@@ -0,0 +1,160 @@
+package gitingest
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// externalIDPrefix keeps git-ingested external IDs apart from other imports.
+const externalIDPrefix = "git:"
+
+// RepoExternalID is the external ID of the repo's project entity.
+func (r *Repo) RepoExternalID() string {
+	return externalIDPrefix + r.Key
+}
+
+// ContributorExternalID is the external ID of a contributor's person entity.
+// People are keyed by email so one person spans every repo they commit to.
+func ContributorExternalID(email string) string {
+	return externalIDPrefix + "person:" + strings.ToLower(email)
+}
+
+// CommitExternalID is the external ID of a commit's knowledge item.
+func (r *Repo) CommitExternalID(hash string) string {
+	return externalIDPrefix + r.Key + "@" + hash
+}
+
+// EntityItems returns the import rows for the repo and its contributors, the
+// repo first. Rows carry external IDs, so re-running updates them in place.
+func (r *Repo) EntityItems(scopes []string) []map[string]any {
+	items := []map[string]any{{
+		"external_id": r.RepoExternalID(),
+		"name":        r.Name,
+		"type":        "project",
+		"scopes":      scopes,
+		"tags":        []string{"git", "repository"},
+		"metadata":    r.metadata(),
+	}}
+	for _, c := range r.Contributors {
+		items = append(items, map[string]any{
+			"external_id": ContributorExternalID(c.Email),
+			"name":        c.Name,
+			"type":        "person",
+			"scopes":      scopes,
+			"tags":        []string{"git-contributor"},
+			"metadata":    map[string]any{"git": map[string]any{"email": c.Email}},
+		})
+	}
+	return items
+}
+
+// metadata describes the repo for agents reading the project entity.
+func (r *Repo) metadata() map[string]any {
+	git := map[string]any{
+		"path":          r.Root,
+		"branch":        r.Branch,
+		"head":          r.Head,
+		"commits":       r.TotalCommits,
+		"ingested_from": "nebula ingest git",
+	}
+	meta := map[string]any{"git": git}
+	if r.Remote != "" {
+		git["remote"] = r.Remote
+		meta["repository"] = r.Remote
+	}
+	if r.WebURL != "" {
+		git["url"] = r.WebURL
+		meta["repository"] = r.WebURL
+	}
+	if !r.LastCommitAt.IsZero() {
+		git["last_commit_at"] = r.LastCommitAt.UTC().Format(time.RFC3339)
+	}
+	return meta
+}
+
+// ContextItems returns the knowledge import rows for the notable commits.
+func (r *Repo) ContextItems(scopes []string) []map[string]any {
+	items := make([]map[string]any, 0, len(r.Notable))
+	for _, c := range r.Notable {
+		item := map[string]any{
+			"external_id": r.CommitExternalID(c.Hash),
+			"title":       fmt.Sprintf("%s: %s", r.Name, c.Subject),
+			"source_type": "git-commit",
+			"content":     r.commitContent(c),
+			"scopes":      scopes,
+			"tags":        []string{"git", "commit"},
+			"metadata": map[string]any{"git": map[string]any{
+				"repo":   r.Key,
+				"hash":   c.Hash,
+				"author": c.Email,
+				"at":     c.At.UTC().Format(time.RFC3339),
+				"reason": c.Reason,
+			}},
+		}
+		if url := r.CommitURL(c.Hash); url != "" {
+			item["url"] = url
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// commitContent renders a commit as a short knowledge body.
+func (r *Repo) commitContent(c Commit) string {
+	lines := []string{
+		c.Subject,
+		"",
+		fmt.Sprintf("Commit %s in %s by %s <%s> on %s.", shortHash(c.Hash), r.Name, c.Author, c.Email, c.At.UTC().Format("2006-01-02")),
+		fmt.Sprintf("Notable as: %s. %d files changed, +%d -%d.", c.Reason, c.Files, c.Added, c.Deleted),
+	}
+	if c.Body != "" {
+		lines = append(lines, "", c.Body)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// RelationshipItems links contributors to the repo and commits to the repo
+// and their authors. ids maps external IDs to the record IDs the entity and
+// knowledge imports returned; links with an unknown end are left out.
+func (r *Repo) RelationshipItems(ids map[string]string) []map[string]any {
+	repoID := ids[r.RepoExternalID()]
+	if repoID == "" {
+		return nil
+	}
+	var items []map[string]any
+	link := func(externalID, sourceType, sourceID, relType, targetType, targetID string, props map[string]any) {
+		if sourceID == "" || targetID == "" {
+			return
+		}
+		items = append(items, map[string]any{
+			"external_id":       externalID,
+			"source_type":       sourceType,
+			"source_id":         sourceID,
+			"target_type":       targetType,
+			"target_id":         targetID,
+			"relationship_type": relType,
+			"properties":        props,
+		})
+	}
+	for _, c := range r.Contributors {
+		link(r.RepoExternalID()+":contributes-to:"+c.Email,
+			"entity", ids[ContributorExternalID(c.Email)], "contributes-to", "entity", repoID,
+			map[string]any{"commits": c.Commits})
+	}
+	for _, c := range r.Notable {
+		commitID := ids[r.CommitExternalID(c.Hash)]
+		link(r.CommitExternalID(c.Hash)+":about", "context", commitID, "about", "entity", repoID, map[string]any{})
+		link(r.CommitExternalID(c.Hash)+":created-by", "context", commitID, "created-by", "entity",
+			ids[ContributorExternalID(c.Email)], map[string]any{})
+	}
+	return items
+}
+
+// shortHash abbreviates a commit hash.
+func shortHash(hash string) string {
+	if len(hash) > 10 {
+		return hash[:10]
+	}
+	return hash
+}
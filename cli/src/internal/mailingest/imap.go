@@ -0,0 +1,283 @@
+package mailingest
+
+import (
+	"bufio"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// IMAPConfig locates a mailbox on an IMAP server.
+type IMAPConfig struct {
+	Addr     string
+	TLS      bool
+	Username string
+	Password string
+	Mailbox  string
+	// AllowPlaintext lets a plain connection log in without STARTTLS. Only
+	// ParseIMAPURL sets it, and only for loopback hosts.
+	AllowPlaintext bool
+	// TLSConfig is used for imaps:// and STARTTLS; nil verifies the host.
+	TLSConfig *tls.Config
+	// Dial opens the connection; nil dials TCP (with TLS when TLS is set).
+	Dial func(addr string) (net.Conn, error)
+}
+
+// maxIMAPLiteral caps a single literal read from the server so a hostile or
+// broken server cannot make the client allocate without bound.
+const maxIMAPLiteral = 64 << 20
+
+// ParseIMAPURL reads imaps://user@host[:port]/Mailbox (or imap://, which
+// upgrades with STARTTLS and only falls back to plaintext on loopback). The
+// mailbox defaults to INBOX; a password in the URL is honoured but better
+// left to the environment.
+func ParseIMAPURL(raw string) (IMAPConfig, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return IMAPConfig{}, fmt.Errorf("invalid IMAP URL: %w", err)
+	}
+	cfg := IMAPConfig{Mailbox: strings.TrimPrefix(u.Path, "/")}
+	port := "993"
+	switch u.Scheme {
+	case "imaps":
+		cfg.TLS = true
+	case "imap":
+		port = "143"
+		cfg.AllowPlaintext = isLoopbackHost(u.Hostname())
+	default:
+		return IMAPConfig{}, fmt.Errorf("invalid IMAP URL %q: use imaps://user@host/Mailbox", raw)
+	}
+	if u.Hostname() == "" {
+		return IMAPConfig{}, fmt.Errorf("invalid IMAP URL %q: missing host", raw)
+	}
+	if u.Port() != "" {
+		port = u.Port()
+	}
+	cfg.Addr = net.JoinHostPort(u.Hostname(), port)
+	if u.User != nil {
+		cfg.Username = u.User.Username()
+		cfg.Password, _ = u.User.Password()
+	}
+	if cfg.Mailbox == "" {
+		cfg.Mailbox = "INBOX"
+	}
+	return cfg, nil
+}
+
+// FetchIMAP downloads the messages of a mailbox that match the filter's
+// server-side criteria, newest limit messages only when limit > 0. Messages
+// are fetched with BODY.PEEK so they stay unread.
+func FetchIMAP(cfg IMAPConfig, filter Filter, limit int) ([][]byte, error) {
+	tlsConfig := cfg.TLSConfig
+	if tlsConfig == nil {
+		host, _, _ := net.SplitHostPort(cfg.Addr)
+		tlsConfig = &tls.Config{ServerName: host}
+	}
+	dial := cfg.Dial
+	if dial == nil {
+		dial = func(addr string) (net.Conn, error) {
+			dialer := &net.Dialer{Timeout: 30 * time.Second}
+			if cfg.TLS {
+				return tls.DialWithDialer(dialer, "tcp", addr, tlsConfig)
+			}
+			return dialer.Dial("tcp", addr)
+		}
+	}
+	conn, err := dial(cfg.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("connect to %s: %w", cfg.Addr, err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	c := &imapConn{conn: conn, r: bufio.NewReader(conn)}
+	greeting, err := c.r.ReadString('\n')
+	if err != nil {
+		return nil, fmt.Errorf("read IMAP greeting: %w", err)
+	}
+	if !strings.HasPrefix(greeting, "* OK") && !strings.HasPrefix(greeting, "* PREAUTH") {
+		return nil, fmt.Errorf("unexpected IMAP greeting: %s", strings.TrimSpace(greeting))
+	}
+	if !strings.HasPrefix(greeting, "* PREAUTH") {
+		if !cfg.TLS {
+			upgraded, err := c.startTLS(tlsConfig)
+			switch {
+			case err != nil:
+				return nil, fmt.Errorf("IMAP STARTTLS: %w", err)
+			case upgraded != nil:
+				c, conn = upgraded, upgraded.conn
+			case !cfg.AllowPlaintext:
+				return nil, fmt.Errorf("%s does not offer STARTTLS; refusing to send the password in cleartext, use imaps://", cfg.Addr)
+			}
+		}
+		if _, err := c.command("LOGIN %s %s", imapQuote(cfg.Username), imapQuote(cfg.Password)); err != nil {
+			return nil, fmt.Errorf("IMAP login: %w", err)
+		}
+	}
+	if _, err := c.command("SELECT %s", imapQuote(cfg.Mailbox)); err != nil {
+		return nil, fmt.Errorf("select %s: %w", cfg.Mailbox, err)
+	}
+	responses, err := c.command("UID SEARCH %s", searchCriteria(filter))
+	if err != nil {
+		return nil, fmt.Errorf("IMAP search: %w", err)
+	}
+	uids := searchUIDs(responses)
+	if limit > 0 && len(uids) > limit {
+		uids = uids[len(uids)-limit:]
+	}
+	var messages [][]byte
+	for _, uid := range uids {
+		responses, err := c.command("UID FETCH %d BODY.PEEK[]", uid)
+		if err != nil {
+			return nil, fmt.Errorf("fetch message %d: %w", uid, err)
+		}
+		for _, resp := range responses {
+			if len(resp.literals) > 0 && strings.Contains(resp.line, "FETCH") {
+				messages = append(messages, resp.literals[0])
+			}
+		}
+	}
+	_, _ = c.command("LOGOUT")
+	return messages, nil
+}
+
+// searchCriteria renders the server-side part of a filter. IMAP dates have
+// day granularity, so the local filter still applies the exact cutoff.
+func searchCriteria(filter Filter) string {
+	var parts []string
+	if !filter.Since.IsZero() {
+		parts = append(parts, "SINCE "+filter.Since.Format("2-Jan-2006"))
+	}
+	if filter.From != "" {
+		parts = append(parts, "FROM "+imapQuote(filter.From))
+	}
+	if filter.Subject != "" {
+		parts = append(parts, "SUBJECT "+imapQuote(filter.Subject))
+	}
+	if len(parts) == 0 {
+		return "ALL"
+	}
+	return strings.Join(parts, " ")
+}
+
+// searchUIDs reads the UIDs of SEARCH responses in ascending order.
+func searchUIDs(responses []imapResponse) []int {
+	var uids []int
+	for _, resp := range responses {
+		fields := strings.Fields(resp.line)
+		if len(fields) < 2 || fields[0] != "*" || !strings.EqualFold(fields[1], "SEARCH") {
+			continue
+		}
+		for _, field := range fields[2:] {
+			if uid, err := strconv.Atoi(field); err == nil {
+				uids = append(uids, uid)
+			}
+		}
+	}
+	sort.Ints(uids)
+	return uids
+}
+
+// imapQuote renders s as an IMAP quoted string.
+func imapQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
+
+// isLoopbackHost reports whether host names this machine.
+func isLoopbackHost(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// imapConn is a minimal IMAP4rev1 client: enough to log in, search, and
+// fetch whole messages.
+type imapConn struct {
+	conn net.Conn
+	r    *bufio.Reader
+	tag  int
+}
+
+// startTLS upgrades a plain connection with STARTTLS and returns a client
+// on the encrypted stream, or nil when the server refuses the command.
+func (c *imapConn) startTLS(config *tls.Config) (*imapConn, error) {
+	if _, err := c.command("STARTTLS"); err != nil {
+		return nil, nil
+	}
+	tlsConn := tls.Client(c.conn, config)
+	if err := tlsConn.Handshake(); err != nil {
+		return nil, fmt.Errorf("TLS handshake: %w", err)
+	}
+	return &imapConn{conn: tlsConn, r: bufio.NewReader(tlsConn), tag: c.tag}, nil
+}
+
+// imapResponse is one untagged response line with its literals.
+type imapResponse struct {
+	line     string
+	literals [][]byte
+}
+
+// literalPattern matches the {n} literal announcement ending a line.
+var literalPattern = regexp.MustCompile(`\{(\d+)\}\r?\n$`)
+
+// command sends a tagged command and returns the untagged responses once
+// the server completes it, or an error unless it completed with OK.
+func (c *imapConn) command(format string, args ...any) ([]imapResponse, error) {
+	c.tag++
+	tag := fmt.Sprintf("a%d", c.tag)
+	if _, err := fmt.Fprintf(c.conn, "%s %s\r\n", tag, fmt.Sprintf(format, args...)); err != nil {
+		return nil, err
+	}
+	var responses []imapResponse
+	for {
+		resp, err := c.readResponse()
+		if err != nil {
+			return nil, err
+		}
+		if strings.HasPrefix(resp.line, tag+" ") {
+			status := strings.TrimSpace(strings.TrimPrefix(resp.line, tag+" "))
+			if !strings.HasPrefix(strings.ToUpper(status), "OK") {
+				return nil, fmt.Errorf("server replied %s", status)
+			}
+			return responses, nil
+		}
+		responses = append(responses, resp)
+	}
+}
+
+// readResponse reads one response line, following any literals it carries.
+func (c *imapConn) readResponse() (imapResponse, error) {
+	var resp imapResponse
+	var line strings.Builder
+	for {
+		part, err := c.r.ReadString('\n')
+		if err != nil {
+			return resp, fmt.Errorf("read IMAP response: %w", err)
+		}
+		match := literalPattern.FindStringSubmatch(part)
+		if match == nil {
+			line.WriteString(strings.TrimRight(part, "\r\n"))
+			resp.line = line.String()
+			return resp, nil
+		}
+		size, err := strconv.Atoi(match[1])
+		if err != nil || size > maxIMAPLiteral {
+			return resp, fmt.Errorf("IMAP literal of %s bytes exceeds the %d byte limit", match[1], maxIMAPLiteral)
+		}
+		literal := make([]byte, size)
+		if _, err := io.ReadFull(c.r, literal); err != nil {
+			return resp, fmt.Errorf("read IMAP literal: %w", err)
+		}
+		resp.literals = append(resp.literals, literal)
+		line.WriteString(strings.TrimSuffix(part, match[0]))
+	}
+}
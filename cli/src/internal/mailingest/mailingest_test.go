@@ -0,0 +1,298 @@
+package mailingest
+
+import (
+	"bufio"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMbox = `From ada@example.com Mon Mar  2 09:00:00 2026
+Message-ID: <root@example.com>
+From: Ada Lovelace <Ada@Example.com>
+To: team@example.com
+Date: Mon, 02 Mar 2026 09:00:00 +0000
+Subject: =?UTF-8?Q?Decision:_drop_v1_API?=
+Content-Type: text/plain; charset=utf-8
+Content-Transfer-Encoding: quoted-printable
+
+We drop the v1 API in May.=0A
+>From now on, v2 only.
+
+From grace@example.com Mon Mar  2 10:00:00 2026
+Message-ID: <reply@example.com>
+In-Reply-To: <root@example.com>
+References: <root@example.com>
+From: grace@example.com
+To: Ada Lovelace <ada@example.com>
+Cc: team@example.com
+Date: Mon, 02 Mar 2026 10:00:00 +0000
+Subject: Re: Decision: drop v1 API
+Content-Type: multipart/alternative; boundary="b1"
+
+--b1
+Content-Type: text/html; charset=utf-8
+
+<p>Agreed.</p>
+--b1
+Content-Type: text/plain; charset=utf-8
+Content-Transfer-Encoding: base64
+
+QWdyZWVkLCBzaGlw
+IGl0Lg==
+--b1--
+
+From bob@example.com Tue Mar  3 08:00:00 2026
+Message-ID: <other@example.com>
+From: Bob <bob@example.com>
+Date: Tue, 03 Mar 2026 08:00:00 +0000
+Subject: Lunch?
+Content-Type: text/html
+
+<html><head><style>p{}</style></head><body><p>Tacos &amp; tea?</p></body></html>
+`
+
+func TestLoadMboxParsesAndThreadsMessages(t *testing.T) {
+	raw, err := ReadMbox(strings.NewReader(testMbox))
+	require.NoError(t, err)
+	msgs, skipped := ParseAll(raw)
+	assert.Zero(t, skipped)
+	require.Len(t, msgs, 3)
+
+	root := msgs[0]
+	assert.Equal(t, "root@example.com", root.ID)
+	assert.Equal(t, "Ada Lovelace <ada@example.com>", root.From)
+	assert.Equal(t, "Decision: drop v1 API", root.Subject)
+	assert.Equal(t, "We drop the v1 API in May.\n\nFrom now on, v2 only.", root.Body)
+
+	reply := msgs[1]
+	assert.Equal(t, "root@example.com", reply.Parent())
+	assert.Equal(t, []string{"team@example.com"}, reply.Cc)
+	assert.Equal(t, "Agreed, ship it.", reply.Body)
+	assert.Equal(t, "Tacos & tea?", msgs[2].Body)
+
+	threads := Threads(msgs)
+	require.Len(t, threads, 2)
+	assert.Equal(t, "Decision: drop v1 API", threads[0].Subject)
+	assert.Len(t, threads[0].Messages, 2)
+
+	filter := Filter{From: "ADA", Since: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)}
+	assert.True(t, filter.Match(root))
+	assert.False(t, filter.Match(reply))
+	assert.False(t, Filter{Since: time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC)}.Match(root))
+
+	_, err = ReadMbox(strings.NewReader("Subject: not an mbox\n"))
+	assert.ErrorContains(t, err, "not an mbox file")
+}
+
+func TestThreadItemsAndReplyLinks(t *testing.T) {
+	raw, err := ReadMbox(strings.NewReader(testMbox))
+	require.NoError(t, err)
+	msgs, _ := ParseAll(raw)
+	threads := Threads(msgs)
+
+	items := threads[0].ContextItems([]string{"work"})
+	require.Len(t, items, 2)
+	assert.Equal(t, "mail:root@example.com", items[0]["external_id"])
+	assert.Equal(t, "email", items[0]["source_type"])
+	email := items[1]["metadata"].(map[string]any)["email"].(map[string]any)
+	assert.Equal(t, "root@example.com", email["thread"])
+	assert.Equal(t, "root@example.com", email["in_reply_to"])
+	assert.Equal(t, "2026-03-02T10:00:00Z", email["date"])
+
+	assert.Nil(t, RelationshipItems(threads, map[string]string{ExternalID("reply@example.com"): "ctx-2"}))
+	links := RelationshipItems(threads, map[string]string{
+		ExternalID("root@example.com"):  "ctx-1",
+		ExternalID("reply@example.com"): "ctx-2",
+	})
+	require.Len(t, links, 1)
+	assert.Equal(t, "ctx-2", links[0]["source_id"])
+	assert.Equal(t, "ctx-1", links[0]["target_id"])
+	assert.Equal(t, "references", links[0]["relationship_type"])
+}
+
+const testIMAPMessage = "Message-ID: <m1@example.com>\r\nFrom: ada@example.com\r\nSubject: Plan\r\n\r\nShip it.\r\n"
+
+// serveIMAP plays a small IMAP server on conn and records every command it
+// reads. STARTTLS is refused unless tlsConfig is set, in which case the rest
+// of the session runs over TLS.
+func serveIMAP(conn net.Conn, tlsConfig *tls.Config, fetch string, commands chan<- string) {
+	// Close the pipe itself: a TLS close_notify would block on the unbuffered
+	// pipe while the client sends its own.
+	defer func(raw net.Conn) { _ = raw.Close() }(conn)
+	r := bufio.NewReader(conn)
+	_, _ = fmt.Fprint(conn, "* OK ready\r\n")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		commands <- line
+		tag, cmd, _ := strings.Cut(line, " ")
+		switch {
+		case cmd == "STARTTLS" && tlsConfig == nil:
+			_, _ = fmt.Fprintf(conn, "%s BAD STARTTLS not supported\r\n", tag)
+			continue
+		case cmd == "STARTTLS":
+			_, _ = fmt.Fprintf(conn, "%s OK begin TLS\r\n", tag)
+			conn = tls.Server(conn, tlsConfig)
+			r = bufio.NewReader(conn)
+			continue
+		case strings.HasPrefix(cmd, "UID SEARCH"):
+			_, _ = fmt.Fprint(conn, "* SEARCH 7 3\r\n")
+		case strings.HasPrefix(cmd, "UID FETCH 3"):
+			_, _ = fmt.Fprint(conn, fetch)
+		case strings.HasPrefix(cmd, "UID FETCH 7"):
+			_, _ = fmt.Fprint(conn, "* 2 FETCH (UID 7 FLAGS (\\Seen))\r\n")
+		}
+		_, _ = fmt.Fprintf(conn, "%s OK done\r\n", tag)
+		if cmd == "LOGOUT" {
+			return
+		}
+	}
+}
+
+// fetchResponse answers UID FETCH 3 with the test message.
+func fetchResponse() string {
+	return fmt.Sprintf("* 1 FETCH (UID 3 BODY[] {%d}\r\n%s)\r\n", len(testIMAPMessage), testIMAPMessage)
+}
+
+// startIMAP runs serveIMAP on one end of a pipe and returns the other end
+// with a func that waits for the session to end and lists the commands sent.
+func startIMAP(tlsConfig *tls.Config, fetch string) (net.Conn, func() []string) {
+	client, server := net.Pipe()
+	commands := make(chan string, 10)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		serveIMAP(server, tlsConfig, fetch, commands)
+	}()
+	return client, func() []string {
+		<-done
+		close(commands)
+		var sent []string
+		for line := range commands {
+			sent = append(sent, line)
+		}
+		return sent
+	}
+}
+
+func TestFetchIMAPSearchesAndFetchesMessages(t *testing.T) {
+	client, sent := startIMAP(nil, fetchResponse())
+
+	cfg, err := ParseIMAPURL("imaps://ada@imap.example.com/Decisions")
+	require.NoError(t, err)
+	assert.Equal(t, "imap.example.com:993", cfg.Addr)
+	cfg.Password = `p"w`
+	cfg.Dial = func(string) (net.Conn, error) { return client, nil }
+
+	raw, err := FetchIMAP(cfg, Filter{From: "ada", Since: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)}, 0)
+	require.NoError(t, err)
+	require.Len(t, raw, 1)
+	msg, err := Parse(raw[0])
+	require.NoError(t, err)
+	assert.Equal(t, "m1@example.com", msg.ID)
+	assert.Equal(t, "Ship it.", msg.Body)
+
+	assert.Equal(t, []string{
+		`a1 LOGIN "ada" "p\"w"`,
+		`a2 SELECT "Decisions"`,
+		`a3 UID SEARCH SINCE 2-Mar-2026 FROM "ada"`,
+		"a4 UID FETCH 3 BODY.PEEK[]",
+		"a5 UID FETCH 7 BODY.PEEK[]",
+		"a6 LOGOUT",
+	}, sent())
+
+	_, err = ParseIMAPURL("https://imap.example.com")
+	assert.ErrorContains(t, err, "invalid IMAP URL")
+}
+
+// testTLSConfigs returns a server config with a test certificate and a
+// client config that trusts it for example.com.
+func testTLSConfigs(t *testing.T) (*tls.Config, *tls.Config) {
+	t.Helper()
+	srv := httptest.NewUnstartedServer(nil)
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+	roots := x509.NewCertPool()
+	roots.AddCert(srv.Certificate())
+	return &tls.Config{Certificates: srv.TLS.Certificates}, &tls.Config{RootCAs: roots, ServerName: "example.com"}
+}
+
+func TestFetchIMAPUpgradesPlainConnectionWithSTARTTLS(t *testing.T) {
+	serverTLS, clientTLS := testTLSConfigs(t)
+	client, sent := startIMAP(serverTLS, fetchResponse())
+
+	cfg, err := ParseIMAPURL("imap://ada@imap.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "imap.example.com:143", cfg.Addr)
+	assert.False(t, cfg.AllowPlaintext)
+	cfg.Password = "pw"
+	cfg.TLSConfig = clientTLS
+	cfg.Dial = func(string) (net.Conn, error) { return client, nil }
+
+	raw, err := FetchIMAP(cfg, Filter{}, 0)
+	require.NoError(t, err)
+	require.Len(t, raw, 1)
+	assert.Equal(t, testIMAPMessage, string(raw[0]))
+	assert.Equal(t, []string{
+		"a1 STARTTLS",
+		`a2 LOGIN "ada" "pw"`,
+		`a3 SELECT "INBOX"`,
+		"a4 UID SEARCH ALL",
+		"a5 UID FETCH 3 BODY.PEEK[]",
+		"a6 UID FETCH 7 BODY.PEEK[]",
+		"a7 LOGOUT",
+	}, sent())
+}
+
+func TestFetchIMAPRefusesCleartextLoginWithoutSTARTTLS(t *testing.T) {
+	client, sent := startIMAP(nil, fetchResponse())
+
+	cfg, err := ParseIMAPURL("imap://ada@imap.example.com")
+	require.NoError(t, err)
+	cfg.Password = "pw"
+	cfg.Dial = func(string) (net.Conn, error) { return client, nil }
+
+	_, err = FetchIMAP(cfg, Filter{}, 0)
+	assert.ErrorContains(t, err, "refusing to send the password in cleartext")
+	assert.Equal(t, []string{"a1 STARTTLS"}, sent())
+}
+
+func TestFetchIMAPAllowsPlaintextOnLoopback(t *testing.T) {
+	for _, host := range []string{"localhost", "127.0.0.1", "[::1]"} {
+		client, sent := startIMAP(nil, fetchResponse())
+
+		cfg, err := ParseIMAPURL("imap://ada@" + host + "/Decisions")
+		require.NoError(t, err)
+		assert.True(t, cfg.AllowPlaintext, host)
+		cfg.Password = "pw"
+		cfg.Dial = func(string) (net.Conn, error) { return client, nil }
+
+		raw, err := FetchIMAP(cfg, Filter{}, 0)
+		require.NoError(t, err, host)
+		assert.Len(t, raw, 1, host)
+		assert.Equal(t, `a2 LOGIN "ada" "pw"`, sent()[1], host)
+	}
+}
+
+func TestFetchIMAPRejectsOversizedLiteral(t *testing.T) {
+	client, _ := startIMAP(nil, fmt.Sprintf("* 1 FETCH (UID 3 BODY[] {%d}\r\n", maxIMAPLiteral+1))
+
+	cfg, err := ParseIMAPURL("imaps://ada@imap.example.com")
+	require.NoError(t, err)
+	cfg.Dial = func(string) (net.Conn, error) { return client, nil }
+
+	_, err = FetchIMAP(cfg, Filter{}, 0)
+	assert.ErrorContains(t, err, "exceeds the")
+}
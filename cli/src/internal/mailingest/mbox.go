@@ -0,0 +1,86 @@
+package mailingest
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+)
+
+// escapedFrom matches a body line mboxrd quoted with one or more '>'.
+var escapedFrom = regexp.MustCompile(`^>+From `)
+
+// ReadMbox splits an mbox stream into raw messages. Lines starting "From "
+// separate messages; body lines quoted as ">From " lose one '>'.
+func ReadMbox(r io.Reader) ([][]byte, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	var (
+		messages [][]byte
+		current  *bytes.Buffer
+	)
+	flush := func() {
+		if current != nil && len(bytes.TrimSpace(current.Bytes())) > 0 {
+			messages = append(messages, current.Bytes())
+		}
+	}
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if bytes.HasPrefix(line, []byte("From ")) {
+			flush()
+			current = &bytes.Buffer{}
+			continue
+		}
+		if current == nil {
+			// Content before the first separator is not an mbox.
+			return nil, fmt.Errorf("not an mbox file: expected a \"From \" line first")
+		}
+		if escapedFrom.Match(line) {
+			line = line[1:]
+		}
+		current.Write(bytes.TrimSuffix(line, []byte("\r")))
+		current.WriteByte('\n')
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read mbox: %w", err)
+	}
+	flush()
+	return messages, nil
+}
+
+// LoadMbox reads and parses every message of an mbox file. Messages that do
+// not parse are counted in skipped.
+func LoadMbox(path string) (msgs []Message, skipped int, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, 0, fmt.Errorf("open mbox: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+	raw, err := ReadMbox(file)
+	if err != nil {
+		return nil, 0, err
+	}
+	msgs, skipped = ParseAll(raw)
+	return msgs, skipped, nil
+}
+
+// ParseAll parses raw messages, dropping those that fail to parse and
+// duplicates of a message ID already seen.
+func ParseAll(raw [][]byte) (msgs []Message, skipped int) {
+	seen := map[string]bool{}
+	for _, data := range raw {
+		msg, err := Parse(data)
+		if err != nil {
+			skipped++
+			continue
+		}
+		if seen[msg.ID] {
+			continue
+		}
+		seen[msg.ID] = true
+		msgs = append(msgs, msg)
+	}
+	return msgs, skipped
+}
@@ -0,0 +1,362 @@
+// Package mailingest turns email, read from an mbox file or an IMAP mailbox,
+// into the knowledge items and thread relationships `nebula ingest mbox`
+// writes.
+package mailingest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Message is one parsed email.
+type Message struct {
+	ID         string
+	From       string
+	To         []string
+	Cc         []string
+	Date       time.Time
+	Subject    string
+	InReplyTo  string
+	References []string
+	Body       string
+}
+
+// Parent returns the ID of the message this one replies to, if any.
+func (m Message) Parent() string {
+	if m.InReplyTo != "" {
+		return m.InReplyTo
+	}
+	if len(m.References) > 0 {
+		return m.References[len(m.References)-1]
+	}
+	return ""
+}
+
+// headerDecoder decodes RFC 2047 encoded words in headers.
+var headerDecoder = mime.WordDecoder{CharsetReader: charsetReader}
+
+// Parse reads one RFC 5322 message.
+func Parse(raw []byte) (Message, error) {
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return Message{}, fmt.Errorf("parse message: %w", err)
+	}
+	header := msg.Header
+	out := Message{
+		ID:         firstMessageID(header.Get("Message-Id")),
+		From:       addressList(header.Get("From")),
+		To:         addresses(header.Get("To")),
+		Cc:         addresses(header.Get("Cc")),
+		Subject:    decodeHeader(header.Get("Subject")),
+		InReplyTo:  firstMessageID(header.Get("In-Reply-To")),
+		References: messageIDs(header.Get("References")),
+	}
+	if date, err := header.Date(); err == nil {
+		out.Date = date
+	}
+	body, err := textBody(header.Get("Content-Type"), header.Get("Content-Transfer-Encoding"), msg.Body)
+	if err != nil {
+		return Message{}, err
+	}
+	out.Body = strings.TrimSpace(body)
+	if out.ID == "" {
+		// Without a Message-ID, derive a stable one so re-runs still update.
+		out.ID = fmt.Sprintf("%s/%s/%s", out.From, out.Date.UTC().Format(time.RFC3339), out.Subject)
+	}
+	return out, nil
+}
+
+// decodeHeader decodes encoded words, keeping the raw value on failure.
+func decodeHeader(value string) string {
+	decoded, err := headerDecoder.DecodeHeader(value)
+	if err != nil {
+		return strings.TrimSpace(value)
+	}
+	return strings.TrimSpace(decoded)
+}
+
+// addresses parses an address list into "Name <email>" strings.
+func addresses(value string) []string {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+	parser := mail.AddressParser{WordDecoder: &headerDecoder}
+	list, err := parser.ParseList(value)
+	if err != nil {
+		return []string{decodeHeader(value)}
+	}
+	out := make([]string, 0, len(list))
+	for _, addr := range list {
+		if addr.Name == "" {
+			out = append(out, strings.ToLower(addr.Address))
+			continue
+		}
+		out = append(out, fmt.Sprintf("%s <%s>", addr.Name, strings.ToLower(addr.Address)))
+	}
+	return out
+}
+
+// addressList formats the first address of a header.
+func addressList(value string) string {
+	if list := addresses(value); len(list) > 0 {
+		return list[0]
+	}
+	return ""
+}
+
+// messageIDPattern matches one <message-id>.
+var messageIDPattern = regexp.MustCompile(`<[^<>\s]+>`)
+
+// messageIDs lists the message IDs of a header, angle brackets stripped.
+func messageIDs(value string) []string {
+	var ids []string
+	for _, id := range messageIDPattern.FindAllString(value, -1) {
+		ids = append(ids, strings.Trim(id, "<>"))
+	}
+	return ids
+}
+
+// firstMessageID returns the first message ID of a header.
+func firstMessageID(value string) string {
+	if ids := messageIDs(value); len(ids) > 0 {
+		return ids[0]
+	}
+	return strings.Trim(strings.TrimSpace(value), "<>")
+}
+
+// textBody returns the readable text of a body, preferring text/plain parts
+// and falling back to HTML with the tags stripped.
+func textBody(contentType, encoding string, body io.Reader) (string, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType, params = "text/plain", map[string]string{}
+	}
+	if strings.HasPrefix(mediaType, "multipart/") {
+		return multipartText(params["boundary"], body)
+	}
+	if !strings.HasPrefix(mediaType, "text/") {
+		return "", nil
+	}
+	data, err := io.ReadAll(decodeTransfer(encoding, body))
+	if err != nil {
+		return "", fmt.Errorf("read body: %w", err)
+	}
+	text := decodeCharset(params["charset"], data)
+	if mediaType == "text/html" {
+		text = htmlText(text)
+	}
+	return text, nil
+}
+
+// multipartText picks the best text part of a multipart body.
+func multipartText(boundary string, body io.Reader) (string, error) {
+	if boundary == "" {
+		return "", nil
+	}
+	reader := multipart.NewReader(body, boundary)
+	var plain, htmlPart string
+	for {
+		part, err := reader.NextRawPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", fmt.Errorf("read multipart body: %w", err)
+		}
+		if strings.HasPrefix(strings.ToLower(part.Header.Get("Content-Disposition")), "attachment") {
+			continue
+		}
+		contentType := part.Header.Get("Content-Type")
+		if contentType == "" {
+			contentType = "text/plain"
+		}
+		text, err := textBody(contentType, part.Header.Get("Content-Transfer-Encoding"), part)
+		if err != nil {
+			return "", err
+		}
+		mediaType, _, _ := mime.ParseMediaType(contentType)
+		switch {
+		case plain == "" && (mediaType == "text/plain" || strings.HasPrefix(mediaType, "multipart/")):
+			plain = text
+		case htmlPart == "" && mediaType == "text/html":
+			htmlPart = text
+		}
+	}
+	if strings.TrimSpace(plain) != "" {
+		return plain, nil
+	}
+	return htmlPart, nil
+}
+
+// decodeTransfer undoes a Content-Transfer-Encoding.
+func decodeTransfer(encoding string, body io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "quoted-printable":
+		return quotedprintable.NewReader(body)
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, &base64Cleaner{r: bufio.NewReader(body)})
+	}
+	return body
+}
+
+// base64Cleaner drops the line breaks base64 bodies are wrapped with.
+type base64Cleaner struct{ r *bufio.Reader }
+
+func (c *base64Cleaner) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		b, err := c.r.ReadByte()
+		if err != nil {
+			if n > 0 {
+				return n, nil
+			}
+			return 0, err
+		}
+		if b == '\r' || b == '\n' || b == ' ' || b == '\t' {
+			continue
+		}
+		p[n] = b
+		n++
+	}
+	return n, nil
+}
+
+// charsetReader converts the Latin-1 family to UTF-8; UTF-8 and ASCII pass
+// through.
+func charsetReader(charset string, input io.Reader) (io.Reader, error) {
+	data, err := io.ReadAll(input)
+	if err != nil {
+		return nil, err
+	}
+	return strings.NewReader(decodeCharset(charset, data)), nil
+}
+
+// decodeCharset converts data to UTF-8. Only Latin-1 needs converting among
+// the charsets handled; others are assumed UTF-8 compatible.
+func decodeCharset(charset string, data []byte) string {
+	switch strings.ToLower(strings.TrimSpace(charset)) {
+	case "iso-8859-1", "latin1", "windows-1252", "cp1252":
+		runes := make([]rune, len(data))
+		for i, b := range data {
+			runes[i] = rune(b)
+		}
+		return string(runes)
+	}
+	return string(bytes.ToValidUTF8(data, []byte("�")))
+}
+
+var (
+	htmlBreak  = regexp.MustCompile(`(?i)<br\s*/?>|</(p|div|li|tr|h[1-6])>`)
+	htmlTag    = regexp.MustCompile(`(?s)<[^>]*>`)
+	htmlHidden = regexp.MustCompile(`(?is)<(style|script|head)[^>]*>.*?</(style|script|head)>`)
+	blankRun   = regexp.MustCompile(`\n{3,}`)
+)
+
+// htmlText reduces an HTML body to text.
+func htmlText(body string) string {
+	text := htmlHidden.ReplaceAllString(body, "")
+	text = htmlBreak.ReplaceAllString(text, "\n")
+	text = html.UnescapeString(htmlTag.ReplaceAllString(text, ""))
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	return blankRun.ReplaceAllString(strings.Join(lines, "\n"), "\n\n")
+}
+
+// Filter selects messages.
+type Filter struct {
+	// From and Subject match case-insensitive substrings.
+	From    string
+	Subject string
+	Since   time.Time
+}
+
+// Match reports whether msg passes the filter.
+func (f Filter) Match(msg Message) bool {
+	if f.From != "" && !strings.Contains(strings.ToLower(msg.From), strings.ToLower(f.From)) {
+		return false
+	}
+	if f.Subject != "" && !strings.Contains(strings.ToLower(msg.Subject), strings.ToLower(f.Subject)) {
+		return false
+	}
+	if !f.Since.IsZero() && msg.Date.Before(f.Since) {
+		return false
+	}
+	return true
+}
+
+// Thread is a conversation: a root message and its replies, oldest first.
+type Thread struct {
+	Subject  string
+	Messages []Message
+}
+
+// Threads groups messages into conversations by their reply headers.
+// Messages whose parent is missing start their own thread unless a shared
+// References root ties them together.
+func Threads(msgs []Message) []Thread {
+	byID := map[string]int{}
+	for i, msg := range msgs {
+		byID[msg.ID] = i
+	}
+	root := make([]string, len(msgs))
+	var find func(i int, depth int) string
+	find = func(i int, depth int) string {
+		if root[i] != "" {
+			return root[i]
+		}
+		msg := msgs[i]
+		result := msg.ID
+		if len(msg.References) > 0 {
+			result = msg.References[0]
+		}
+		if parent, ok := byID[msg.Parent()]; ok && parent != i && depth < len(msgs) {
+			result = find(parent, depth+1)
+		}
+		root[i] = result
+		return result
+	}
+	order := []string{}
+	groups := map[string][]Message{}
+	for i := range msgs {
+		key := find(i, 0)
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], msgs[i])
+	}
+	threads := make([]Thread, 0, len(order))
+	for _, key := range order {
+		members := groups[key]
+		sort.SliceStable(members, func(i, j int) bool { return members[i].Date.Before(members[j].Date) })
+		threads = append(threads, Thread{Subject: threadSubject(members[0].Subject), Messages: members})
+	}
+	sort.SliceStable(threads, func(i, j int) bool {
+		return threads[i].Messages[0].Date.Before(threads[j].Messages[0].Date)
+	})
+	return threads
+}
+
+// replyPrefix matches reply and forward subject prefixes.
+var replyPrefix = regexp.MustCompile(`(?i)^((re|fwd?|aw|sv)(\[\d+\])?:\s*)+`)
+
+// threadSubject strips reply prefixes from a subject.
+func threadSubject(subject string) string {
+	if s := strings.TrimSpace(replyPrefix.ReplaceAllString(subject, "")); s != "" {
+		return s
+	}
+	return "(no subject)"
+}
@@ -0,0 +1,100 @@
+package mailingest
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// externalIDPrefix keeps mail-ingested external IDs apart from other imports.
+const externalIDPrefix = "mail:"
+
+// ExternalID is the external ID of a message's knowledge item. Messages are
+// keyed by Message-ID, so the same email ingested from an mbox export and
+// from IMAP updates one record.
+func ExternalID(messageID string) string {
+	return externalIDPrefix + messageID
+}
+
+// ContextItems returns the knowledge import rows for a thread's messages.
+func (t Thread) ContextItems(scopes []string) []map[string]any {
+	root := t.Messages[0].ID
+	items := make([]map[string]any, 0, len(t.Messages))
+	for _, msg := range t.Messages {
+		email := map[string]any{
+			"message_id": msg.ID,
+			"from":       msg.From,
+			"to":         msg.To,
+			"thread":     root,
+			"subject":    msg.Subject,
+		}
+		if len(msg.Cc) > 0 {
+			email["cc"] = msg.Cc
+		}
+		if !msg.Date.IsZero() {
+			email["date"] = msg.Date.UTC().Format(time.RFC3339)
+		}
+		if msg.InReplyTo != "" {
+			email["in_reply_to"] = msg.InReplyTo
+		}
+		if len(msg.References) > 0 {
+			email["references"] = msg.References
+		}
+		title := strings.TrimSpace(msg.Subject)
+		if title == "" {
+			title = t.Subject
+		}
+		items = append(items, map[string]any{
+			"external_id": ExternalID(msg.ID),
+			"title":       title,
+			"source_type": "email",
+			"content":     messageContent(msg),
+			"scopes":      scopes,
+			"tags":        []string{"email"},
+			"metadata":    map[string]any{"email": email},
+		})
+	}
+	return items
+}
+
+// messageContent renders a message as a knowledge body with a short header.
+func messageContent(msg Message) string {
+	header := "From " + msg.From
+	if !msg.Date.IsZero() {
+		header += " on " + msg.Date.UTC().Format("2006-01-02 15:04 MST")
+	}
+	lines := []string{header + "."}
+	if len(msg.To) > 0 {
+		lines = append(lines, "To "+strings.Join(msg.To, ", ")+".")
+	}
+	if msg.Body != "" {
+		lines = append(lines, "", msg.Body)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// RelationshipItems links each reply to the message it answers with a
+// references relationship. ids maps external IDs to the knowledge record IDs
+// the import returned; replies whose parent was not ingested are left out.
+func RelationshipItems(threads []Thread, ids map[string]string) []map[string]any {
+	var items []map[string]any
+	for _, thread := range threads {
+		for _, msg := range thread.Messages {
+			parent := msg.Parent()
+			sourceID, targetID := ids[ExternalID(msg.ID)], ids[ExternalID(parent)]
+			if parent == "" || sourceID == "" || targetID == "" {
+				continue
+			}
+			items = append(items, map[string]any{
+				"external_id":       fmt.Sprintf("%s:reply-to:%s", ExternalID(msg.ID), parent),
+				"source_type":       "context",
+				"source_id":         sourceID,
+				"target_type":       "context",
+				"target_id":         targetID,
+				"relationship_type": "references",
+				"properties":        map[string]any{"kind": "email-reply", "thread": thread.Messages[0].ID},
+			})
+		}
+	}
+	return items
+}
@@ -0,0 +1,117 @@
+package plugins
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+// RunTimeout caps how long a plugin launched from the TUI may run.
+const RunTimeout = 30 * time.Second
+
+// Plugin is one discovered executable.
+type Plugin struct {
+	Name string
+	Path string
+}
+
+// Selection is what a plugin is run against. Kind names the record type
+// ("entity", "approval", ...) and Data is its JSON, sent on stdin.
+type Selection struct {
+	Kind string
+	Data []byte
+}
+
+// Dir returns the plugin directory next to the config file.
+func Dir() string {
+	return filepath.Join(filepath.Dir(config.Path()), "plugins")
+}
+
+// Discover lists the executables in dir, sorted by name. A missing directory
+// yields no plugins. Names drop the file extension, and hidden files,
+// directories, and non-executables are skipped.
+func Discover(dir string) ([]Plugin, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read plugins: %w", err)
+	}
+	seen := map[string]bool{}
+	var out []Plugin
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.HasPrefix(name, ".") || entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || !info.Mode().IsRegular() || info.Mode().Perm()&0o111 == 0 {
+			continue
+		}
+		short := strings.TrimSuffix(name, filepath.Ext(name))
+		if short == "" || seen[short] {
+			continue
+		}
+		seen[short] = true
+		out = append(out, Plugin{Name: short, Path: filepath.Join(dir, name)})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+// Command builds the process for a plugin run. The selection arrives on stdin
+// and its kind in NEBULA_SELECTION_KIND, next to NEBULA_PLUGIN and
+// NEBULA_API_URL.
+func (p Plugin) Command(ctx context.Context, sel Selection, args ...string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, p.Path, args...)
+	cmd.Env = append(os.Environ(),
+		"NEBULA_PLUGIN="+p.Name,
+		"NEBULA_SELECTION_KIND="+sel.Kind,
+		"NEBULA_API_URL="+api.ResolveBaseURL(config.ActiveAPIURL()),
+	)
+	cmd.Stdin = bytes.NewReader(sel.Data)
+	return cmd
+}
+
+// Run runs the plugin against sel and returns its trimmed output. Failures
+// carry the plugin's stderr when it wrote any.
+func (p Plugin) Run(sel Selection) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), RunTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := p.Command(ctx, sel)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("plugin %s: %s", p.Name, msg)
+		}
+		return "", fmt.Errorf("plugin %s: %w", p.Name, err)
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// Passthrough runs the plugin attached to the given streams, for CLI use.
+func (p Plugin) Passthrough(kind string, stdin io.Reader, stdout, stderr io.Writer, args ...string) error {
+	cmd := p.Command(context.Background(), Selection{Kind: kind}, args...)
+	cmd.Stdin = stdin
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("plugin %s: %w", p.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package plugins
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writePlugin(t *testing.T, dir, name, script string, mode os.FileMode) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(script), mode))
+}
+
+// TestDiscoverSkipsNonExecutables handles test discover skips non executables.
+func TestDiscoverSkipsNonExecutables(t *testing.T) {
+	dir := t.TempDir()
+	writePlugin(t, dir, "triage.sh", "#!/bin/sh\n", 0o755)
+	writePlugin(t, dir, "archive", "#!/bin/sh\n", 0o700)
+	writePlugin(t, dir, "notes.txt", "not a plugin", 0o644)
+	writePlugin(t, dir, ".hidden", "#!/bin/sh\n", 0o755)
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "lib"), 0o755))
+
+	found, err := Discover(dir)
+	require.NoError(t, err)
+	require.Len(t, found, 2)
+	assert.Equal(t, "archive", found[0].Name)
+	assert.Equal(t, "triage", found[1].Name)
+	assert.Equal(t, filepath.Join(dir, "triage.sh"), found[1].Path)
+
+	missing, err := Discover(filepath.Join(dir, "missing"))
+	require.NoError(t, err)
+	assert.Empty(t, missing)
+}
+
+// TestRunPassesSelectionOnStdin handles test run passes selection on stdin.
+func TestRunPassesSelectionOnStdin(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	dir := t.TempDir()
+	writePlugin(t, dir, "echo", "#!/bin/sh\necho \"$NEBULA_PLUGIN $NEBULA_SELECTION_KIND\"\ncat\n", 0o755)
+	writePlugin(t, dir, "fail", "#!/bin/sh\necho 'no selection' >&2\nexit 3\n", 0o755)
+
+	out, err := Plugin{Name: "echo", Path: filepath.Join(dir, "echo")}.Run(Selection{Kind: "entity", Data: []byte(`{"id":"ent-1"}`)})
+	require.NoError(t, err)
+	assert.Equal(t, "echo entity\n{\"id\":\"ent-1\"}", out)
+
+	_, err = Plugin{Name: "fail", Path: filepath.Join(dir, "fail")}.Run(Selection{Kind: "none"})
+	require.Error(t, err)
+	assert.Equal(t, "plugin fail: no selection", err.Error())
+}
@@ -0,0 +1,385 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+// DefaultListen is where the proxy listens unless told otherwise. It stays on
+// loopback so the forwarded credentials never leave the machine.
+const DefaultListen = "127.0.0.1:8766"
+
+// maxBodyBytes caps request bodies the proxy inspects and forwards.
+const maxBodyBytes = 10 << 20
+
+// scopeReloadInterval limits how often an unknown scope id reloads the
+// scope names from upstream.
+const scopeReloadInterval = time.Minute
+
+// Call is one logged proxy request.
+type Call struct {
+	At          time.Time `json:"at"`
+	Agent       string    `json:"agent,omitempty"`
+	Method      string    `json:"method"`
+	Path        string    `json:"path"`
+	RequestType string    `json:"request_type,omitempty"`
+	Status      int       `json:"status"`
+	DurationMS  int64     `json:"duration_ms"`
+	Denied      string    `json:"denied,omitempty"`
+}
+
+// Server forwards agent requests to the Nebula API with the owner's key after
+// checking the agent's sub-token.
+type Server struct {
+	Upstream string
+	APIKey   string
+	Tokens   *Store
+	Log      io.Writer
+	Client   *http.Client
+
+	mu sync.Mutex
+
+	scopeMu        sync.Mutex
+	scopeNames     map[string]string
+	scopesLoadedAt time.Time
+}
+
+// LogPath returns the call log path next to the config file.
+func LogPath() string {
+	return filepath.Join(filepath.Dir(config.Path()), "proxy.log")
+}
+
+// OpenLog opens the call log for appending.
+func OpenLog() (*os.File, error) {
+	path := LogPath()
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("create config dir: %w", err)
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("open proxy log: %w", err)
+	}
+	return file, nil
+}
+
+// RequestType names what a request does, as `<verb>_<resource>`: GETs are
+// read_entity, collection POSTs create_entity, PATCH and PUT update_entity,
+// DELETE delete_entity, and POSTs to a sub-path take its last segment, so
+// /api/approvals/<id>/approve is approve_approval.
+func RequestType(method, urlPath string) string {
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(urlPath, "/api"), "/"), "/")
+	if len(segments) == 0 || segments[0] == "" {
+		return ""
+	}
+	resource := singular(segments[0])
+	verb := ""
+	switch method {
+	case http.MethodGet, http.MethodHead:
+		verb = "read"
+	case http.MethodPatch, http.MethodPut:
+		verb = "update"
+	case http.MethodDelete:
+		verb = "delete"
+	case http.MethodPost:
+		verb = "create"
+		if len(segments) > 1 {
+			verb = segments[len(segments)-1]
+		}
+	default:
+		return ""
+	}
+	return verb + "_" + resource
+}
+
+// singular turns a collection name into its record name.
+func singular(name string) string {
+	switch {
+	case strings.HasSuffix(name, "ies"):
+		return strings.TrimSuffix(name, "ies") + "y"
+	case strings.HasSuffix(name, "s") && !strings.HasSuffix(name, "ss"):
+		return strings.TrimSuffix(name, "s")
+	}
+	return name
+}
+
+// ServeHTTP checks the sub-token, allowlist, and scopes, then forwards.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	call := Call{At: start.UTC(), Method: r.Method, Path: r.URL.Path}
+	defer func() {
+		call.DurationMS = time.Since(start).Milliseconds()
+		s.record(call)
+	}()
+
+	deny := func(status int, reason string) {
+		call.Status = status
+		call.Denied = reason
+		writeError(w, status, reason)
+	}
+
+	token, ok := s.Tokens.Lookup(strings.TrimSpace(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")))
+	if !ok {
+		deny(http.StatusUnauthorized, "invalid proxy token")
+		return
+	}
+	call.Agent = token.Agent
+
+	if !strings.HasPrefix(r.URL.Path, "/api/") {
+		deny(http.StatusNotFound, "only /api paths are proxied")
+		return
+	}
+	call.RequestType = RequestType(r.Method, r.URL.Path)
+	if call.RequestType == "" || !token.AllowsType(call.RequestType) {
+		deny(http.StatusForbidden, fmt.Sprintf("request type %s not allowed for %s", call.RequestType, token.Agent))
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodyBytes+1))
+	if err != nil || len(body) > maxBodyBytes {
+		deny(http.StatusRequestEntityTooLarge, "request body too large")
+		return
+	}
+	if scopes := requestScopes(r, body); !token.AllowsScopes(scopes) {
+		deny(http.StatusForbidden, fmt.Sprintf("scopes %s not granted to %s", strings.Join(scopes, ","), token.Agent))
+		return
+	}
+
+	status, respBody, header, err := s.forward(r, body)
+	if err != nil {
+		deny(http.StatusBadGateway, err.Error())
+		return
+	}
+	if status < 300 && len(token.Scopes) > 0 && strings.Contains(header.Get("Content-Type"), "json") {
+		resolve := func(id string) (string, bool) { return s.scopeName(r.Context(), id) }
+		filtered, visible := filterResponse(respBody, token, resolve)
+		if !visible {
+			deny(http.StatusForbidden, fmt.Sprintf("record outside scopes of %s", token.Agent))
+			return
+		}
+		respBody = filtered
+	}
+	call.Status = status
+	if ct := header.Get("Content-Type"); ct != "" {
+		w.Header().Set("Content-Type", ct)
+	}
+	w.WriteHeader(status)
+	_, _ = w.Write(respBody)
+}
+
+// forward replays the request upstream with the owner's API key.
+func (s *Server) forward(r *http.Request, body []byte) (int, []byte, http.Header, error) {
+	target := strings.TrimRight(s.Upstream, "/") + r.URL.Path
+	if r.URL.RawQuery != "" {
+		target += "?" + r.URL.RawQuery
+	}
+	req, err := http.NewRequestWithContext(r.Context(), r.Method, target, bytes.NewReader(body))
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("build upstream request: %w", err)
+	}
+	if ct := r.Header.Get("Content-Type"); ct != "" {
+		req.Header.Set("Content-Type", ct)
+	}
+	req.Header.Set("Authorization", "Bearer "+s.APIKey)
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("upstream: %w", err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("read upstream: %w", err)
+	}
+	return resp.StatusCode, data, resp.Header, nil
+}
+
+// scopeName resolves a privacy scope id to its name. Names come from
+// /api/taxonomy/scopes with the owner's key and are reloaded when an id is
+// missing, at most once per scopeReloadInterval.
+func (s *Server) scopeName(ctx context.Context, id string) (string, bool) {
+	s.scopeMu.Lock()
+	defer s.scopeMu.Unlock()
+	if name, ok := s.scopeNames[id]; ok {
+		return name, true
+	}
+	if !s.scopesLoadedAt.IsZero() && time.Since(s.scopesLoadedAt) < scopeReloadInterval {
+		return "", false
+	}
+	s.scopesLoadedAt = time.Now()
+	if names, err := s.loadScopeNames(ctx); err == nil {
+		s.scopeNames = names
+	}
+	name, ok := s.scopeNames[id]
+	return name, ok
+}
+
+// loadScopeNames fetches every scope, inactive ones included, keyed by id.
+func (s *Server) loadScopeNames(ctx context.Context) (map[string]string, error) {
+	target := strings.TrimRight(s.Upstream, "/") + "/api/taxonomy/scopes?include_inactive=true&limit=1000"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, fmt.Errorf("build scope request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+s.APIKey)
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("load scopes: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("load scopes: status %d", resp.StatusCode)
+	}
+	var payload struct {
+		Data []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return nil, fmt.Errorf("decode scopes: %w", err)
+	}
+	names := make(map[string]string, len(payload.Data))
+	for _, scope := range payload.Data {
+		names[scope.ID] = scope.Name
+	}
+	return names, nil
+}
+
+// record writes a call to the log as one JSON line.
+func (s *Server) record(call Call) {
+	if s.Log == nil {
+		return
+	}
+	line, err := json.Marshal(call)
+	if err != nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, _ = s.Log.Write(append(line, '\n'))
+}
+
+// requestScopes collects the scopes a request names in its body or query.
+func requestScopes(r *http.Request, body []byte) []string {
+	var scopes []string
+	for _, raw := range r.URL.Query()["scopes"] {
+		for _, scope := range strings.Split(raw, ",") {
+			if scope = strings.TrimSpace(scope); scope != "" {
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+	var payload map[string]any
+	if len(body) > 0 && json.Unmarshal(body, &payload) == nil {
+		scopes = append(scopes, stringList(payload["scopes"])...)
+		scopes = append(scopes, stringList(payload["privacy_scopes"])...)
+	}
+	return scopes
+}
+
+// filterResponse drops listed records outside the token scopes. A single
+// record outside them, or a body that is not a JSON object, makes the whole
+// response invisible. resolve maps privacy scope ids to names.
+func filterResponse(body []byte, token Token, resolve func(id string) (string, bool)) ([]byte, bool) {
+	var envelope map[string]any
+	if json.Unmarshal(body, &envelope) != nil {
+		return body, false
+	}
+	switch data := envelope["data"].(type) {
+	case []any:
+		kept := make([]any, 0, len(data))
+		for _, item := range data {
+			if recordVisible(item, token, resolve) {
+				kept = append(kept, item)
+			}
+		}
+		if len(kept) == len(data) {
+			return body, true
+		}
+		envelope["data"] = kept
+	case map[string]any:
+		return body, recordVisible(data, token, resolve)
+	default:
+		return body, true
+	}
+	out, err := json.Marshal(envelope)
+	if err != nil {
+		return body, true
+	}
+	return out, true
+}
+
+// scopeFields are the record fields that carry privacy scopes.
+var scopeFields = []string{"scopes", "privacy_scopes", "privacy_scope_ids"}
+
+// recordVisible reports whether a record's scopes fit the token. Scope ids
+// in privacy_scope_ids are resolved to names; a record with an id that does
+// not resolve is hidden. Records that carry no scope field at all, such as
+// audit entries and search hits, are hidden too, since nothing shows which
+// scopes they belong to. The caller only filters for scoped tokens.
+func recordVisible(item any, token Token, resolve func(id string) (string, bool)) bool {
+	record, ok := item.(map[string]any)
+	if !ok {
+		return false
+	}
+	scoped := false
+	for _, field := range scopeFields {
+		if _, ok := record[field]; ok {
+			scoped = true
+		}
+	}
+	if !scoped {
+		return false
+	}
+	scopes := append(stringList(record["scopes"]), stringList(record["privacy_scopes"])...)
+	for _, id := range stringList(record["privacy_scope_ids"]) {
+		name, ok := resolve(id)
+		if !ok {
+			return false
+		}
+		scopes = append(scopes, name)
+	}
+	return token.AllowsScopes(scopes)
+}
+
+// stringList reads a JSON string array.
+func stringList(value any) []string {
+	items, ok := value.([]any)
+	if !ok {
+		return nil
+	}
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		if text, ok := item.(string); ok {
+			out = append(out, text)
+		}
+	}
+	return out
+}
+
+// writeError writes a JSON error in the API's error shape.
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"detail": map[string]string{"code": "PROXY_DENIED", "message": message},
+	})
+}
@@ -0,0 +1,192 @@
+package proxy
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRequestType handles test request type.
+func TestRequestType(t *testing.T) {
+	cases := map[string]string{
+		"GET /api/entities":                "read_entity",
+		"GET /api/entities/ent-1":          "read_entity",
+		"POST /api/context":                "create_context",
+		"PATCH /api/entities/ent-1":        "update_entity",
+		"DELETE /api/relationships/rel-1":  "delete_relationship",
+		"POST /api/approvals/ap-1/approve": "approve_approval",
+		"POST /api/entities/bulk/scopes":   "scopes_entity",
+		"GET /api":                         "",
+		"OPTIONS /api/entities":            "",
+	}
+	for input, want := range cases {
+		method, path, _ := strings.Cut(input, " ")
+		assert.Equal(t, want, RequestType(method, path), input)
+	}
+}
+
+// TestStoreIssueLookupRevoke handles test store issue lookup revoke.
+func TestStoreIssueLookupRevoke(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	store := &Store{}
+	secret, err := store.Issue("scout", []string{"public"}, []string{"read_*"})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(secret, TokenPrefix))
+	require.NoError(t, store.Save())
+
+	loaded, err := LoadStore()
+	require.NoError(t, err)
+	token, ok := loaded.Lookup(secret)
+	require.True(t, ok)
+	assert.Equal(t, "scout", token.Agent)
+	assert.NotContains(t, token.Hash, secret)
+	_, ok = loaded.Lookup(secret + "x")
+	assert.False(t, ok)
+
+	again, err := loaded.Issue("scout", nil, nil)
+	require.NoError(t, err)
+	assert.Len(t, loaded.Tokens, 1, "reissuing replaces the old token")
+	_, ok = loaded.Lookup(secret)
+	assert.False(t, ok)
+	_, ok = loaded.Lookup(again)
+	assert.True(t, ok)
+
+	assert.True(t, loaded.Revoke("scout"))
+	assert.False(t, loaded.Revoke("scout"))
+	_, err = loaded.Issue("bad", nil, []string{"read_["})
+	assert.Error(t, err)
+}
+
+// TestTokenAllows handles test token allows.
+func TestTokenAllows(t *testing.T) {
+	readOnly := Token{}
+	assert.True(t, readOnly.AllowsType("read_entity"))
+	assert.False(t, readOnly.AllowsType("create_entity"))
+
+	writer := Token{RequestTypes: []string{"read_*", "create_context"}, Scopes: []string{"public", "work"}}
+	assert.True(t, writer.AllowsType("create_context"))
+	assert.False(t, writer.AllowsType("delete_context"))
+	assert.True(t, writer.AllowsScopes([]string{"work"}))
+	assert.False(t, writer.AllowsScopes([]string{"work", "personal"}))
+	assert.True(t, readOnly.AllowsScopes([]string{"personal"}))
+}
+
+// TestServerEnforcesTokensAndScopes handles test server enforces tokens and scopes.
+func TestServerEnforcesTokensAndScopes(t *testing.T) {
+	var upstreamAuth string
+	var upstreamBody string
+	scopeLoads := 0
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/taxonomy/scopes" {
+			scopeLoads++
+			assert.Equal(t, "Bearer nbl_owner", r.Header.Get("Authorization"))
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+				{"id": "scope-public", "name": "public"},
+				{"id": "scope-personal", "name": "personal"},
+			}})
+			return
+		}
+		upstreamAuth = r.Header.Get("Authorization")
+		raw, _ := io.ReadAll(r.Body)
+		upstreamBody = string(raw)
+		switch r.URL.Path {
+		case "/api/entities":
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+				{"id": "ent-1", "privacy_scope_ids": []string{"scope-public"}},
+				{"id": "ent-2", "privacy_scope_ids": []string{"scope-personal"}},
+				{"id": "ent-3", "privacy_scope_ids": []string{"scope-unknown"}},
+			}})
+		case "/api/entities/ent-2":
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "ent-2", "privacy_scope_ids": []string{"scope-personal"}}})
+		case "/api/audit":
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+				{"id": "aud-1", "table_name": "entities", "old_data": map[string]any{"name": "Secret"}, "new_data": map[string]any{"name": "Renamed"}},
+			}})
+		case "/api/search":
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+				{"kind": "entity", "id": "ent-2", "title": "Secret"},
+				{"kind": "entity", "id": "ent-1", "title": "Public", "privacy_scope_ids": []string{"scope-public"}},
+			}})
+		case "/api/audit/stats":
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"total": 4}})
+		default:
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "ctx-1", "privacy_scope_ids": []string{"scope-public"}}})
+		}
+	}))
+	defer upstream.Close()
+
+	store := &Store{}
+	secret, err := store.Issue("scout", []string{"public"}, []string{"read_*", "create_context"})
+	require.NoError(t, err)
+	var log bytes.Buffer
+	server := &Server{Upstream: upstream.URL, APIKey: "nbl_owner", Tokens: store, Log: &log}
+
+	call := func(method, path, body, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := call(http.MethodGet, "/api/entities", "", "")
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = call(http.MethodGet, "/api/entities", "", secret)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "Bearer nbl_owner", upstreamAuth)
+	assert.Contains(t, rec.Body.String(), "ent-1")
+	assert.NotContains(t, rec.Body.String(), "ent-2")
+	assert.NotContains(t, rec.Body.String(), "ent-3", "unresolved scope ids are denied")
+
+	rec = call(http.MethodGet, "/api/entities/ent-2", "", secret)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = call(http.MethodDelete, "/api/entities/ent-1", "", secret)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Contains(t, rec.Body.String(), "delete_entity not allowed")
+
+	rec = call(http.MethodPost, "/api/context", `{"title":"x","scopes":["personal"]}`, secret)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = call(http.MethodPost, "/api/context", `{"title":"x","scopes":["public"]}`, secret)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"title":"x","scopes":["public"]}`, upstreamBody)
+
+	assert.Equal(t, 1, scopeLoads, "a missing id reloads scopes at most once per interval")
+
+	lines := strings.Split(strings.TrimSpace(log.String()), "\n")
+	require.Len(t, lines, 6)
+	var last Call
+	require.NoError(t, json.Unmarshal([]byte(lines[5]), &last))
+	assert.Equal(t, "scout", last.Agent)
+	assert.Equal(t, "create_context", last.RequestType)
+	assert.Equal(t, http.StatusOK, last.Status)
+	var denied Call
+	require.NoError(t, json.Unmarshal([]byte(lines[3]), &denied))
+	assert.NotEmpty(t, denied.Denied)
+
+	// Records without scope fields are hidden from scoped tokens.
+	rec = call(http.MethodGet, "/api/audit", "", secret)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "Secret")
+	assert.JSONEq(t, `{"data":[]}`, rec.Body.String())
+
+	rec = call(http.MethodGet, "/api/search", "", secret)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "Secret")
+	assert.Contains(t, rec.Body.String(), "ent-1")
+
+	rec = call(http.MethodGet, "/api/audit/stats", "", secret)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
@@ -0,0 +1,171 @@
+package proxy
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+// TokenPrefix marks proxy sub-tokens so they are never mistaken for API keys.
+const TokenPrefix = "nbp_"
+
+// Token is a proxy sub-token issued to one local agent. Only the hash of
+// the secret is stored.
+type Token struct {
+	Agent        string    `yaml:"agent"`
+	Hash         string    `yaml:"hash"`
+	Prefix       string    `yaml:"prefix"`
+	Scopes       []string  `yaml:"scopes,omitempty"`
+	RequestTypes []string  `yaml:"request_types,omitempty"`
+	CreatedAt    time.Time `yaml:"created_at"`
+}
+
+// Store holds every issued proxy token.
+type Store struct {
+	Tokens []Token `yaml:"tokens,omitempty"`
+}
+
+// StorePath returns the token store path next to the config file.
+func StorePath() string {
+	return filepath.Join(filepath.Dir(config.Path()), "proxy")
+}
+
+// LoadStore reads the token store, returning an empty store when missing.
+func LoadStore() (*Store, error) {
+	store := &Store{}
+	data, err := os.ReadFile(StorePath())
+	if errors.Is(err, os.ErrNotExist) {
+		return store, nil
+	}
+	if err != nil {
+		return store, fmt.Errorf("read proxy tokens: %w", err)
+	}
+	if err := yaml.Unmarshal(data, store); err != nil {
+		return &Store{}, fmt.Errorf("parse proxy tokens: %w", err)
+	}
+	return store, nil
+}
+
+// Save writes the token store with the same permissions as the config.
+func (s *Store) Save() error {
+	path := StorePath()
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("create config dir: %w", err)
+	}
+	data, err := yaml.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("marshal proxy tokens: %w", err)
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// Issue creates a token for agent, replacing any token it already had, and
+// returns the secret. The secret is not stored and cannot be shown again.
+func (s *Store) Issue(agent string, scopes, requestTypes []string) (string, error) {
+	agent = strings.TrimSpace(agent)
+	if agent == "" {
+		return "", fmt.Errorf("agent name is required")
+	}
+	for _, pattern := range requestTypes {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return "", fmt.Errorf("invalid request type pattern %q", pattern)
+		}
+	}
+	raw := make([]byte, 24)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("generate token: %w", err)
+	}
+	secret := TokenPrefix + hex.EncodeToString(raw)
+	s.Revoke(agent)
+	s.Tokens = append(s.Tokens, Token{
+		Agent:        agent,
+		Hash:         hashToken(secret),
+		Prefix:       secret[:len(TokenPrefix)+6],
+		Scopes:       scopes,
+		RequestTypes: requestTypes,
+		CreatedAt:    time.Now().UTC(),
+	})
+	sort.Slice(s.Tokens, func(i, j int) bool { return s.Tokens[i].Agent < s.Tokens[j].Agent })
+	return secret, nil
+}
+
+// Revoke removes the token of agent and reports whether one existed.
+func (s *Store) Revoke(agent string) bool {
+	agent = strings.TrimSpace(agent)
+	kept := s.Tokens[:0]
+	found := false
+	for _, token := range s.Tokens {
+		if token.Agent == agent {
+			found = true
+			continue
+		}
+		kept = append(kept, token)
+	}
+	s.Tokens = kept
+	return found
+}
+
+// Lookup returns the token matching secret.
+func (s *Store) Lookup(secret string) (Token, bool) {
+	if s == nil || !strings.HasPrefix(secret, TokenPrefix) {
+		return Token{}, false
+	}
+	hash := hashToken(secret)
+	for _, token := range s.Tokens {
+		if subtle.ConstantTimeCompare([]byte(token.Hash), []byte(hash)) == 1 {
+			return token, true
+		}
+	}
+	return Token{}, false
+}
+
+// AllowsType reports whether the token may make requests of requestType.
+// An empty allowlist allows reads only.
+func (t Token) AllowsType(requestType string) bool {
+	if len(t.RequestTypes) == 0 {
+		return strings.HasPrefix(requestType, "read_")
+	}
+	for _, pattern := range t.RequestTypes {
+		if ok, _ := path.Match(pattern, requestType); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsScopes reports whether every scope is granted to the token. A token
+// without scopes is not limited by scope.
+func (t Token) AllowsScopes(scopes []string) bool {
+	if len(t.Scopes) == 0 {
+		return true
+	}
+	granted := make(map[string]bool, len(t.Scopes))
+	for _, scope := range t.Scopes {
+		granted[scope] = true
+	}
+	for _, scope := range scopes {
+		if !granted[scope] {
+			return false
+		}
+	}
+	return true
+}
+
+// hashToken returns the stored form of a secret.
+func hashToken(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,134 @@
+package report
+
+import (
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+// Formats a digest renders to.
+const (
+	FormatMarkdown = "markdown"
+	FormatHTML     = "html"
+)
+
+//go:embed templates
+var defaultTemplates embed.FS
+
+// templateExt is the file extension of each format's template.
+var templateExt = map[string]string{
+	FormatMarkdown: ".md.tmpl",
+	FormatHTML:     ".html.tmpl",
+}
+
+// DefaultTemplate returns the built-in template of a report.
+func DefaultTemplate(name, format string) (string, error) {
+	ext, ok := templateExt[format]
+	if !ok {
+		return "", fmt.Errorf("unknown report format %q (use markdown or html)", format)
+	}
+	data, err := defaultTemplates.ReadFile("templates/" + name + ext)
+	if err != nil {
+		return "", fmt.Errorf("no built-in %s template for %s", format, name)
+	}
+	return string(data), nil
+}
+
+// TemplatePath is where a customized template of a report lives:
+// ~/.nebula/reports/<name>.md.tmpl or .html.tmpl.
+func TemplatePath(name, format string) string {
+	return filepath.Join(filepath.Dir(config.Path()), "reports", name+templateExt[format])
+}
+
+// LoadTemplate returns the template at path, or the customized template in
+// TemplatePath when path is empty, falling back to the built-in one.
+func LoadTemplate(name, format, path string) (string, error) {
+	if _, ok := templateExt[format]; !ok {
+		return DefaultTemplate(name, format)
+	}
+	explicit := path != ""
+	if !explicit {
+		path = TemplatePath(name, format)
+	}
+	data, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		return string(data), nil
+	case os.IsNotExist(err) && !explicit:
+		return DefaultTemplate(name, format)
+	}
+	return "", fmt.Errorf("read report template: %w", err)
+}
+
+// funcs are the helpers report templates can call.
+var funcs = map[string]any{
+	"date":     func(t time.Time) string { return t.Local().Format("Mon Jan 2") },
+	"datetime": func(t time.Time) string { return t.Local().Format("Mon Jan 2 15:04") },
+	"human":    human,
+	"status": func(s string) string {
+		s = human(s)
+		if s == "" {
+			return s
+		}
+		return strings.ToUpper(s[:1]) + s[1:]
+	},
+	"tags": func(tags []string) string {
+		out := make([]string, 0, len(tags))
+		for _, tag := range tags {
+			out = append(out, "#"+tag)
+		}
+		return strings.Join(out, " ")
+	},
+	"counts": func(counts map[string]int) string {
+		keys := make([]string, 0, len(counts))
+		for key := range counts {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		parts := make([]string, 0, len(keys))
+		for _, key := range keys {
+			parts = append(parts, fmt.Sprintf("%s %d", human(key), counts[key]))
+		}
+		return strings.Join(parts, " · ")
+	},
+}
+
+// human turns a slug such as "approved-failed" into words.
+func human(s string) string {
+	return strings.TrimSpace(strings.NewReplacer("-", " ", "_", " ").Replace(s))
+}
+
+// Render executes tmpl against the digest. HTML templates escape what they
+// insert; Markdown templates insert text as is.
+func Render(out io.Writer, d *Digest, format, tmpl string) error {
+	switch format {
+	case FormatMarkdown:
+		t, err := template.New("report").Funcs(funcs).Option("missingkey=zero").Parse(tmpl)
+		if err != nil {
+			return fmt.Errorf("parse report template: %w", err)
+		}
+		if err := t.Execute(out, d); err != nil {
+			return fmt.Errorf("render report: %w", err)
+		}
+	case FormatHTML:
+		t, err := htmltemplate.New("report").Funcs(funcs).Option("missingkey=zero").Parse(tmpl)
+		if err != nil {
+			return fmt.Errorf("parse report template: %w", err)
+		}
+		if err := t.Execute(out, d); err != nil {
+			return fmt.Errorf("render report: %w", err)
+		}
+	default:
+		return fmt.Errorf("unknown report format %q (use markdown or html)", format)
+	}
+	return nil
+}
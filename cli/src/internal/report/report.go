@@ -0,0 +1,229 @@
+// Package report builds the weekly digest `nebula report weekly` renders:
+// new entities, approvals handled, knowledge added, and job outcomes over a
+// time window.
+package report
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+const (
+	// pageSize is how many records one list request asks for.
+	pageSize = 100
+	// maxPages caps how far back a section pages before giving up.
+	maxPages = 20
+	// jobLimit is the most closed jobs the jobs endpoint returns at once.
+	jobLimit = 100
+	// auditPageSize is the largest page the audit log serves.
+	auditPageSize = 200
+)
+
+// Digest is the data a report template renders.
+type Digest struct {
+	Since       time.Time
+	Until       time.Time
+	GeneratedAt time.Time
+
+	Entities  []Entity
+	Approvals []Approval
+	Knowledge []Knowledge
+	Jobs      []Job
+
+	// ApprovalCounts and JobCounts tally the sections by status.
+	ApprovalCounts map[string]int
+	JobCounts      map[string]int
+	// Notes explains sections that are partial or unavailable.
+	Notes []string
+}
+
+// Entity is an entity created in the window.
+type Entity struct {
+	ID        string
+	Name      string
+	Type      string
+	Tags      []string
+	CreatedAt time.Time
+}
+
+// Approval is an approval request decided in the window.
+type Approval struct {
+	ID        string
+	Type      string
+	Subject   string
+	Status    string
+	Reviewer  string
+	Notes     string
+	DecidedAt time.Time
+}
+
+// Knowledge is a knowledge item added in the window.
+type Knowledge struct {
+	ID         string
+	Title      string
+	URL        string
+	SourceType string
+	Tags       []string
+	CreatedAt  time.Time
+}
+
+// Job is a job that was closed in the window.
+type Job struct {
+	ID       string
+	Title    string
+	Status   string
+	Reason   string
+	ClosedAt time.Time
+}
+
+// Collect gathers the digest for [since, until).
+func Collect(client *api.Client, since, until, now time.Time) (*Digest, error) {
+	d := &Digest{
+		Since:          since,
+		Until:          until,
+		GeneratedAt:    now,
+		ApprovalCounts: map[string]int{},
+		JobCounts:      map[string]int{},
+	}
+	inWindow := func(at time.Time) bool { return !at.Before(since) && at.Before(until) }
+
+	// Lists come newest first, so paging stops at the first record older
+	// than the window.
+	params := func(page int) api.QueryParams {
+		return api.QueryParams{"limit": strconv.Itoa(pageSize), "offset": strconv.Itoa(page * pageSize)}
+	}
+	for page := 0; ; page++ {
+		if page == maxPages {
+			d.Notes = append(d.Notes, fmt.Sprintf("Only the newest %d entities were checked.", maxPages*pageSize))
+			break
+		}
+		p := params(page)
+		p["updated_after"] = since.UTC().Format(time.RFC3339)
+		rows, err := client.QueryEntities(p)
+		if err != nil {
+			return nil, fmt.Errorf("list entities: %w", err)
+		}
+		older := false
+		for _, e := range rows {
+			older = older || e.CreatedAt.Before(since)
+			if inWindow(e.CreatedAt) {
+				d.Entities = append(d.Entities, Entity{ID: e.ID, Name: e.Name, Type: e.Type, Tags: e.Tags, CreatedAt: e.CreatedAt})
+			}
+		}
+		if older || len(rows) < pageSize {
+			break
+		}
+	}
+
+	for page := 0; ; page++ {
+		if page == maxPages {
+			d.Notes = append(d.Notes, fmt.Sprintf("Only the newest %d knowledge items were checked.", maxPages*pageSize))
+			break
+		}
+		rows, err := client.QueryContext(params(page))
+		if err != nil {
+			return nil, fmt.Errorf("list knowledge: %w", err)
+		}
+		older := false
+		for _, c := range rows {
+			older = older || c.CreatedAt.Before(since)
+			if !inWindow(c.CreatedAt) {
+				continue
+			}
+			item := Knowledge{ID: c.ID, Title: c.Title, SourceType: c.SourceType, Tags: c.Tags, CreatedAt: c.CreatedAt}
+			if c.URL != nil {
+				item.URL = *c.URL
+			}
+			d.Knowledge = append(d.Knowledge, item)
+		}
+		if older || len(rows) < pageSize {
+			break
+		}
+	}
+
+	jobs, err := client.QueryJobs(api.QueryParams{"status_names": "completed,failed,cancelled", "limit": strconv.Itoa(jobLimit)})
+	if err != nil {
+		return nil, fmt.Errorf("list jobs: %w", err)
+	}
+	if len(jobs) == jobLimit {
+		d.Notes = append(d.Notes, fmt.Sprintf("Only the newest %d closed jobs were checked.", jobLimit))
+	}
+	for _, j := range jobs {
+		if !inWindow(j.UpdatedAt) {
+			continue
+		}
+		job := Job{ID: j.ID, Title: j.Title, Status: j.Status, ClosedAt: j.UpdatedAt}
+		if j.StatusReason != nil {
+			job.Reason = *j.StatusReason
+		}
+		d.Jobs = append(d.Jobs, job)
+		d.JobCounts[j.Status]++
+	}
+	sort.SliceStable(d.Jobs, func(a, b int) bool { return d.Jobs[a].ClosedAt.After(d.Jobs[b].ClosedAt) })
+
+	if err := d.collectApprovals(client); err != nil {
+		if api.ClassifyError(err) != api.ErrorKindScope {
+			return nil, fmt.Errorf("list approvals: %w", err)
+		}
+		d.Notes = append(d.Notes, "Approvals are read from the audit log, which needs admin access.")
+	}
+	return d, nil
+}
+
+// collectApprovals reads approval decisions from the audit log: updates that
+// moved a request out of pending.
+func (d *Digest) collectApprovals(client *api.Client) error {
+	for page := 0; page < maxPages; page++ {
+		entries, err := client.QueryAuditLog(api.QueryParams{
+			"table":  "approval_requests",
+			"action": "update",
+			"since":  d.Since.UTC().Format(time.RFC3339),
+			"until":  d.Until.UTC().Format(time.RFC3339),
+			"limit":  strconv.Itoa(auditPageSize),
+			"offset": strconv.Itoa(page * auditPageSize),
+		})
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			status := auditString(entry.NewData, "status")
+			if auditString(entry.OldData, "status") != "pending" || status == "" || status == "pending" {
+				continue
+			}
+			approval := Approval{
+				ID:        entry.RecordID,
+				Type:      auditString(entry.NewData, "request_type"),
+				Status:    status,
+				Notes:     auditString(entry.NewData, "review_notes"),
+				DecidedAt: entry.ChangedAt,
+			}
+			if entry.ActorName != nil {
+				approval.Reviewer = *entry.ActorName
+			}
+			if details, ok := entry.NewData["change_details"].(map[string]any); ok {
+				approval.Subject = auditString(details, "name")
+				if approval.Subject == "" {
+					approval.Subject = auditString(details, "title")
+				}
+			}
+			d.Approvals = append(d.Approvals, approval)
+			d.ApprovalCounts[status]++
+		}
+		if len(entries) < auditPageSize {
+			return nil
+		}
+	}
+	d.Notes = append(d.Notes, fmt.Sprintf("Only the newest %d approval changes were checked.", maxPages*auditPageSize))
+	return nil
+}
+
+// auditString reads a string field of an audit snapshot.
+func auditString(data map[string]any, key string) string {
+	value, _ := data[key].(string)
+	return strings.TrimSpace(value)
+}
@@ -0,0 +1,158 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+// digestServer serves one page of each list the digest reads. auditStatus,
+// when set, answers the audit log with that status instead.
+func digestServer(t *testing.T, now time.Time, auditStatus int) *api.Client {
+	t.Helper()
+	day := 24 * time.Hour
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data any
+		switch r.URL.Path {
+		case "/api/entities":
+			assert.NotEmpty(t, r.URL.Query().Get("updated_after"))
+			data = []map[string]any{
+				{"id": "ent-2", "name": "Acme", "type": "organization", "tags": []string{"client"}, "created_at": now.Add(-day)},
+				{"id": "ent-1", "name": "Old Co", "type": "organization", "created_at": now.Add(-30 * day)},
+			}
+		case "/api/context":
+			data = []map[string]any{
+				{"id": "ctx-1", "title": "Postgres tuning", "url": "https://example.com/pg", "source_type": "article", "created_at": now.Add(-2 * day)},
+				{"id": "ctx-0", "title": "Ancient", "source_type": "note", "created_at": now.Add(-20 * day)},
+			}
+		case "/api/jobs":
+			assert.Equal(t, "completed,failed,cancelled", r.URL.Query().Get("status_names"))
+			data = []map[string]any{
+				{"id": "job-1", "title": "Ship release", "status": "completed", "updated_at": now.Add(-3 * day)},
+				{"id": "job-2", "title": "Migrate DB", "status": "failed", "status_reason": "lock timeout", "updated_at": now.Add(-day)},
+				{"id": "job-0", "title": "Last month", "status": "completed", "updated_at": now.Add(-40 * day)},
+			}
+		case "/api/audit":
+			if auditStatus != 0 {
+				w.WriteHeader(auditStatus)
+				_, _ = w.Write([]byte(`{"error":{"code":"FORBIDDEN","message":"admin scope required"}}`))
+				return
+			}
+			assert.Equal(t, "approval_requests", r.URL.Query().Get("table"))
+			data = []map[string]any{
+				{
+					"record_id": "apr-1", "action": "update", "actor_name": "alxx", "changed_at": now.Add(-day),
+					"old_data": map[string]any{"status": "pending"},
+					"new_data": map[string]any{
+						"status": "approved", "request_type": "create_entity", "review_notes": "looks good",
+						"change_details": map[string]any{"name": "Acme"},
+					},
+				},
+				{
+					"record_id": "apr-2", "action": "update", "changed_at": now.Add(-day),
+					"old_data": map[string]any{"status": "approved"},
+					"new_data": map[string]any{"status": "approved-failed"},
+				},
+			}
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": data}))
+	}))
+	t.Cleanup(srv.Close)
+	return api.NewClient(srv.URL, "nbl_test")
+}
+
+func TestCollectKeepsRecordsInWindow(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	client := digestServer(t, now, 0)
+
+	d, err := Collect(client, now.AddDate(0, 0, -7), now, now)
+	require.NoError(t, err)
+
+	require.Len(t, d.Entities, 1)
+	assert.Equal(t, "Acme", d.Entities[0].Name)
+	require.Len(t, d.Knowledge, 1)
+	assert.Equal(t, "https://example.com/pg", d.Knowledge[0].URL)
+	require.Len(t, d.Jobs, 2)
+	assert.Equal(t, "job-2", d.Jobs[0].ID)
+	assert.Equal(t, "lock timeout", d.Jobs[0].Reason)
+	assert.Equal(t, map[string]int{"completed": 1, "failed": 1}, d.JobCounts)
+	require.Len(t, d.Approvals, 1)
+	assert.Equal(t, Approval{
+		ID: "apr-1", Type: "create_entity", Subject: "Acme", Status: "approved",
+		Reviewer: "alxx", Notes: "looks good", DecidedAt: now.Add(-24 * time.Hour),
+	}, d.Approvals[0])
+	assert.Equal(t, map[string]int{"approved": 1}, d.ApprovalCounts)
+	assert.Empty(t, d.Notes)
+}
+
+func TestCollectNotesApprovalsWithoutAdmin(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	client := digestServer(t, now, http.StatusForbidden)
+
+	d, err := Collect(client, now.AddDate(0, 0, -7), now, now)
+	require.NoError(t, err)
+	assert.Empty(t, d.Approvals)
+	assert.Equal(t, []string{"Approvals are read from the audit log, which needs admin access."}, d.Notes)
+}
+
+func TestRenderDefaultTemplates(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	d := &Digest{
+		Since:       now.AddDate(0, 0, -7),
+		Until:       now,
+		GeneratedAt: now,
+		Entities:    []Entity{{Name: "R&D <team>", Type: "team", Tags: []string{"eng"}}},
+		Jobs:        []Job{{ID: "job-1", Title: "Ship", Status: "completed"}},
+		JobCounts:   map[string]int{"completed": 1},
+	}
+
+	tmpl, err := DefaultTemplate("weekly", FormatMarkdown)
+	require.NoError(t, err)
+	var md bytes.Buffer
+	require.NoError(t, Render(&md, d, FormatMarkdown, tmpl))
+	assert.Contains(t, md.String(), "- **R&D <team>** (team) #eng\n")
+	assert.Contains(t, md.String(), "- Completed: Ship (`job-1`)")
+	assert.Contains(t, md.String(), "## Knowledge added\n\n_None this week._")
+
+	tmpl, err = DefaultTemplate("weekly", FormatHTML)
+	require.NoError(t, err)
+	var html bytes.Buffer
+	require.NoError(t, Render(&html, d, FormatHTML, tmpl))
+	assert.Contains(t, html.String(), "<strong>R&amp;D &lt;team&gt;</strong>")
+	assert.NotContains(t, html.String(), "<team>")
+
+	assert.Error(t, Render(&md, d, FormatMarkdown, "{{.Missing"))
+}
+
+func TestLoadTemplatePrefersCustomFile(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	builtin, err := DefaultTemplate("weekly", FormatMarkdown)
+	require.NoError(t, err)
+	got, err := LoadTemplate("weekly", FormatMarkdown, "")
+	require.NoError(t, err)
+	assert.Equal(t, builtin, got)
+
+	path := TemplatePath("weekly", FormatMarkdown)
+	assert.Equal(t, "weekly.md.tmpl", filepath.Base(path))
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+	require.NoError(t, os.WriteFile(path, []byte("custom {{len .Jobs}}"), 0o600))
+	got, err = LoadTemplate("weekly", FormatMarkdown, "")
+	require.NoError(t, err)
+	assert.Equal(t, "custom {{len .Jobs}}", got)
+
+	_, err = LoadTemplate("weekly", FormatMarkdown, filepath.Join(t.TempDir(), "missing.tmpl"))
+	assert.Error(t, err)
+}
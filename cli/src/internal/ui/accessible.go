@@ -0,0 +1,100 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// accessibleSavedMsg reports the screen reader setting after it was saved.
+type accessibleSavedMsg struct{ enabled bool }
+
+// accessibleEnabled reports whether the screen-reader friendly layout is on.
+func (a App) accessibleEnabled() bool {
+	return a.config != nil && a.config.Accessible
+}
+
+// renderAccessibleHeader renders the linear header used instead of the banner
+// and tab bar: app name, active tab, and a status line for the current view.
+func (a App) renderAccessibleHeader() string {
+	lines := []string{"Nebula"}
+	if indicator := a.renderProfileIndicator(); indicator != "" {
+		lines = append(lines, components.SanitizeOneLine(indicator))
+	}
+	for _, status := range []string{a.renderOperationsStatus(), a.renderRateLimitStatus(), a.renderRefreshStatus(), a.renderVimStatus(), a.renderNotificationStatus()} {
+		if status = strings.TrimSpace(components.SanitizeOneLine(status)); status != "" {
+			lines = append(lines, status)
+		}
+	}
+	tab := fmt.Sprintf("Tab: %s, %d of %d", tabNames[a.tab], a.tab+1, len(tabNames))
+	if a.tabNav {
+		tab += ", tab bar focused"
+	}
+	lines = append(lines, tab)
+	if crumbs := a.renderBreadcrumbs(); crumbs != "" {
+		lines = append(lines, "Path: "+components.SanitizeOneLine(crumbs))
+	}
+	lines = append(lines, "Status: "+a.accessibleLocation())
+	return strings.Join(lines, "\n")
+}
+
+// accessibleLocation describes the focused screen in words, so view changes
+// are announced as a changed line rather than a changed color.
+func (a App) accessibleLocation() string {
+	switch {
+	case a.sessionExpired:
+		return "session expired"
+	case a.quitConfirm:
+		return "quit confirmation"
+	case a.helpOpen:
+		return "help"
+	case a.paletteOpen:
+		return "command palette"
+	case a.verbPlan != nil:
+		return "command confirmation"
+	case a.importExportOpen:
+		return "import and export"
+	case a.opsOpen:
+		return "operations"
+	case a.notifOpen:
+		return "notifications"
+	case a.awayOpen:
+		return "away digest"
+	case a.reconcileOpen:
+		return "offline sync"
+	case a.trashOpen:
+		return "trash"
+	case a.columnPicker != nil:
+		return "column picker"
+	case a.onboarding:
+		return "onboarding"
+	case a.quickstartOpen:
+		return "quickstart"
+	case a.startupChecking:
+		return "running startup checks"
+	}
+	if entry, ok := a.navEntryFor(); ok {
+		kind, _, _ := strings.Cut(entry.key, ":")
+		if label := strings.TrimSpace(components.SanitizeOneLine(entry.label)); label != "" {
+			return kind + " detail, " + label
+		}
+		return kind + " detail"
+	}
+	if a.tab == tabInbox && a.inbox.detail != nil {
+		return "approval detail, " + components.SanitizeOneLine(a.inbox.detail.RequestType)
+	}
+	return strings.ToLower(tabNames[a.tab]) + " list"
+}
+
+// useAccessibleStyles makes the tab styles that mark the active choice by color
+// also say so in text, returning a func that restores the originals.
+func useAccessibleStyles() func() {
+	active, focus := TabActiveStyle, TabFocusStyle
+	mark := func(s string) string { return s + " (selected)" }
+	TabActiveStyle = active.Transform(mark)
+	TabFocusStyle = focus.Transform(mark)
+	return func() {
+		TabActiveStyle, TabFocusStyle = active, focus
+	}
+}
@@ -0,0 +1,61 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestAccessibleViewUsesLinearHeaderAndAnnouncements(t *testing.T) {
+	app := NewApp(nil, &config.Config{Accessible: true})
+	app.width = 100
+	app.height = 40
+	model, _ := app.applySearchSelection(searchSelectionMsg{kind: "entity", entity: &api.Entity{ID: "ent-1", Name: "Alpha", Type: "person"}})
+	app = model.(App)
+	app.setToast("success", "Saved Alpha.")
+
+	view := components.SanitizeText(app.View())
+	lines := strings.Split(view, "\n")
+	require.GreaterOrEqual(t, len(lines), 4)
+	assert.Equal(t, "Nebula", lines[0])
+	assert.Contains(t, view, "Tab: Entities, 2 of 14")
+	assert.Contains(t, view, "Status: entity detail, Alpha")
+	assert.Contains(t, view, "Keys: ")
+	assert.NotContains(t, view, "╭")
+	assert.NotContains(t, view, "██")
+	assert.Less(t, strings.Index(view, "Success:"), strings.Index(view, "Name: Alpha"))
+
+	// Rendering must not leak accessible mode into later plain renders.
+	assert.False(t, components.AccessibleMode())
+	app.config.Accessible = false
+	assert.Contains(t, components.SanitizeText(app.View()), "╭")
+}
+
+func TestAccessibleLocationDescribesOverlays(t *testing.T) {
+	app := NewApp(nil, &config.Config{Accessible: true})
+	assert.Equal(t, "inbox list", app.accessibleLocation())
+	app.paletteOpen = true
+	assert.Equal(t, "command palette", app.accessibleLocation())
+}
+
+func TestProfileToggleAccessibleSavesConfig(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	cfg := &config.Config{APIKey: "test-key"}
+	model := NewProfileModel(nil, cfg)
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("A")})
+	require.NotNil(t, cmd)
+	assert.Equal(t, accessibleSavedMsg{enabled: true}, cmd())
+	assert.True(t, cfg.Accessible)
+
+	loaded, err := config.LoadFile()
+	require.NoError(t, err)
+	assert.True(t, loaded.Accessible)
+}
@@ -0,0 +1,388 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+const (
+	// activityAuditLimit caps how many of the user's audit entries the feed
+	// reads.
+	activityAuditLimit = 200
+	// activityMentionLimit caps the entity and knowledge mention searches.
+	activityMentionLimit = 50
+)
+
+type activityKind string
+
+const (
+	activityApproval  activityKind = "approval"
+	activityEdit      activityKind = "edit"
+	activityKnowledge activityKind = "knowledge"
+	activityMention   activityKind = "mention"
+)
+
+type activityLoadedMsg struct {
+	items  []activityItem
+	queued time.Time
+}
+
+// activityItem is one row of the feed. Repeated actions on the same record
+// in a day collapse into one row with a count.
+type activityItem struct {
+	kind  activityKind
+	at    time.Time
+	verb  string
+	title string
+	link  string
+	id    string
+	count int
+}
+
+// ActivityModel is the Activity tab: the signed-in user's own approvals,
+// edits, and knowledge, plus records that mention them, grouped by day.
+// Unlike History it reads as a personal feed rather than the raw audit log.
+type ActivityModel struct {
+	client      *api.Client
+	config      *config.Config
+	items       []activityItem
+	list        *components.List
+	loading     bool
+	loadLatency time.Duration
+	errText     string
+	width       int
+	height      int
+}
+
+// NewActivityModel builds the activity feed UI model.
+func NewActivityModel(client *api.Client, cfg *config.Config) ActivityModel {
+	return ActivityModel{client: client, config: cfg, list: components.NewList(15)}
+}
+
+// Init handles init.
+func (m ActivityModel) Init() tea.Cmd {
+	m.loading = true
+	return m.loadActivity()
+}
+
+// Update updates update.
+func (m ActivityModel) Update(msg tea.Msg) (ActivityModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case activityLoadedMsg:
+		m.loading = false
+		m.loadLatency = loadElapsed(msg.queued)
+		m.errText = ""
+		m.items = msg.items
+		labels := make([]string, len(m.items))
+		for i, item := range m.items {
+			labels[i] = item.verb + " " + item.title
+		}
+		m.list.SetItems(labels)
+	case errMsg:
+		m.loading = false
+		m.errText = msg.err.Error()
+	case tea.KeyMsg:
+		switch {
+		case isDown(msg):
+			m.list.Down()
+		case isUp(msg):
+			m.list.Up()
+		case isEnter(msg):
+			return m, m.openSelected()
+		}
+	}
+	return m, nil
+}
+
+// identity returns the signed-in user's entity id and username.
+func (m ActivityModel) identity() (string, string) {
+	if m.config == nil {
+		return "", ""
+	}
+	return strings.TrimSpace(m.config.UserEntityID), strings.TrimSpace(m.config.Username)
+}
+
+// loadActivity reads the user's audit entries and searches for mentions of
+// their username. Without a signed-in user the feed loads empty.
+func (m ActivityModel) loadActivity() tea.Cmd {
+	userID, username := m.identity()
+	client := m.client
+	queued := time.Now()
+	return func() tea.Msg {
+		if client == nil || (userID == "" && username == "") {
+			return activityLoadedMsg{queued: queued}
+		}
+		now := time.Now()
+		var items []activityItem
+		if userID != "" {
+			entries, err := client.QueryAuditLog(api.QueryParams{
+				"actor_type": "entity",
+				"actor_id":   userID,
+				"limit":      strconv.Itoa(activityAuditLimit),
+			})
+			if err != nil {
+				return loadFailed(tabActivity, err, m.loadActivity())
+			}
+			items = activityFromAudit(entries, now)
+		}
+		if username != "" {
+			mentions, err := loadActivityMentions(client, username, userID)
+			if err != nil {
+				return loadFailed(tabActivity, err, m.loadActivity())
+			}
+			items = append(items, withoutOwnRecords(mentions, items)...)
+		}
+		return activityLoadedMsg{items: groupActivity(items), queued: queued}
+	}
+}
+
+// activityFromAudit keeps the audit entries the feed shows: approvals the
+// user decided, entities they changed, and knowledge they added today.
+func activityFromAudit(entries []api.AuditEntry, now time.Time) []activityItem {
+	var items []activityItem
+	for _, entry := range entries {
+		switch entry.TableName {
+		case "approval_requests":
+			status := auditString(entry.NewData, "status")
+			if entry.Action != "update" || auditString(entry.OldData, "status") != "pending" || status == "pending" || status == "" {
+				continue
+			}
+			title := humanizeApprovalType(auditString(entry.NewData, "request_type"))
+			if details, ok := entry.NewData["change_details"].(map[string]any); ok {
+				if name := firstNonEmpty(auditString(details, "name"), auditString(details, "title")); name != "" {
+					title += ": " + name
+				}
+			}
+			items = append(items, activityItem{
+				kind: activityApproval, at: entry.ChangedAt, verb: strings.ReplaceAll(status, "-", " "),
+				title: title, link: "approval", id: entry.RecordID,
+			})
+		case "entities":
+			verb := map[string]string{"insert": "created", "update": "edited", "delete": "deleted"}[entry.Action]
+			if verb == "" {
+				continue
+			}
+			items = append(items, activityItem{
+				kind: activityEdit, at: entry.ChangedAt, verb: verb,
+				title: firstNonEmpty(auditString(entry.NewData, "name"), auditString(entry.OldData, "name"), shortID(entry.RecordID)),
+				link:  "entity", id: entry.RecordID,
+			})
+		case "context_items":
+			if entry.Action != "insert" || !sameDay(entry.ChangedAt, now) {
+				continue
+			}
+			items = append(items, activityItem{
+				kind: activityKnowledge, at: entry.ChangedAt, verb: "added",
+				title: firstNonEmpty(auditString(entry.NewData, "title"), shortID(entry.RecordID)),
+				link:  "context", id: entry.RecordID,
+			})
+		}
+	}
+	return items
+}
+
+// loadActivityMentions finds entities and knowledge whose metadata or notes
+// contain username. Search is full text, so hits are checked for the literal
+// name; the user's own entity is skipped.
+func loadActivityMentions(client *api.Client, username, userID string) ([]activityItem, error) {
+	params := api.QueryParams{"search_text": username, "limit": strconv.Itoa(activityMentionLimit)}
+	entities, err := client.QueryEntities(params)
+	if err != nil {
+		return nil, err
+	}
+	contexts, err := client.QueryContext(params)
+	if err != nil {
+		return nil, err
+	}
+	var items []activityItem
+	for _, entity := range entities {
+		if entity.ID == userID || !mentionsUser(username, metadataText(entity.Metadata)) {
+			continue
+		}
+		items = append(items, activityItem{
+			kind: activityMention, at: latestTime(entity.UpdatedAt, entity.CreatedAt), verb: "mentioned in",
+			title: entity.Name, link: "entity", id: entity.ID,
+		})
+	}
+	for _, item := range contexts {
+		content := ""
+		if item.Content != nil {
+			content = *item.Content
+		}
+		if !mentionsUser(username, content+" "+metadataText(item.Metadata)) {
+			continue
+		}
+		items = append(items, activityItem{
+			kind: activityMention, at: latestTime(item.UpdatedAt, item.CreatedAt), verb: "mentioned in",
+			title: item.Title, link: "context", id: item.ID,
+		})
+	}
+	return items, nil
+}
+
+// withoutOwnRecords drops mentions of records the feed already lists because
+// the user changed them.
+func withoutOwnRecords(mentions, own []activityItem) []activityItem {
+	seen := map[string]bool{}
+	for _, item := range own {
+		seen[item.link+"/"+item.id] = true
+	}
+	out := mentions[:0]
+	for _, item := range mentions {
+		if !seen[item.link+"/"+item.id] {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// groupActivity collapses repeated actions on one record within a day and
+// sorts the feed newest first.
+func groupActivity(items []activityItem) []activityItem {
+	sort.SliceStable(items, func(i, j int) bool { return items[i].at.After(items[j].at) })
+	index := map[string]int{}
+	out := make([]activityItem, 0, len(items))
+	for _, item := range items {
+		key := strings.Join([]string{item.verb, item.link, item.id, item.at.Local().Format("2006-01-02")}, "|")
+		if i, ok := index[key]; ok {
+			out[i].count++
+			continue
+		}
+		item.count = 1
+		index[key] = len(out)
+		out = append(out, item)
+	}
+	return out
+}
+
+// openSelected opens the selected record in its own tab.
+func (m ActivityModel) openSelected() tea.Cmd {
+	idx := m.list.Selected()
+	if m.client == nil || idx < 0 || idx >= len(m.items) {
+		return nil
+	}
+	item := m.items[idx]
+	if item.link != "context" {
+		return loadDeepLink(m.client, DeepLink{Kind: item.link, ID: item.id})
+	}
+	client := m.client
+	return func() tea.Msg {
+		found, err := client.GetContext(item.id)
+		if err != nil {
+			return errMsg{fmt.Errorf("open context/%s: %w", item.id, err)}
+		}
+		return searchSelectionMsg{kind: "context", context: found}
+	}
+}
+
+// View renders the feed grouped by day.
+func (m ActivityModel) View() string {
+	userID, username := m.identity()
+	if userID == "" && username == "" {
+		return components.Indent(components.Box(MutedStyle.Render("Log in to see your activity."), m.width), 1)
+	}
+	if m.loading && len(m.items) == 0 {
+		return renderLoadingList("activity", 0, m.list, m.width, nil)
+	}
+	if m.errText != "" {
+		return components.Indent(components.ErrorBox("Error", m.errText, m.width), 1)
+	}
+	if len(m.items) == 0 {
+		return components.Indent(components.Box(MutedStyle.Render("No activity yet."), m.width), 1)
+	}
+
+	now := time.Now()
+	contentWidth := components.BoxContentWidth(m.width)
+	var b strings.Builder
+	b.WriteString(MutedStyle.Render(activitySummary(m.items)) + renderLoadLatency(m.loadLatency))
+	lastDay := ""
+	for rel := range m.list.Visible() {
+		abs := m.list.RelToAbs(rel)
+		item := m.items[abs]
+		if day := activityDayLabel(item.at, now); day != lastDay {
+			b.WriteString("\n\n" + MetaKeyStyle.Render(day))
+			lastDay = day
+		}
+		title := item.title
+		if item.count > 1 {
+			title += fmt.Sprintf(" ×%d", item.count)
+		}
+		prefix := fmt.Sprintf("%s  %-12s ", item.at.Local().Format("15:04"), item.verb)
+		line := prefix + components.ClampTextWidthEllipsis(components.SanitizeOneLine(title), max(contentWidth-len(prefix)-4, 8))
+		if m.list.IsSelected(abs) {
+			b.WriteString("\n" + SelectedStyle.Render("> "+line))
+		} else {
+			b.WriteString("\n  " + line)
+		}
+	}
+	return components.Indent(components.TitledBox("Activity", b.String(), m.width), 1)
+}
+
+// activitySummary counts the feed by kind.
+func activitySummary(items []activityItem) string {
+	counts := map[activityKind]int{}
+	for _, item := range items {
+		counts[item.kind]++
+	}
+	return fmt.Sprintf("%d approvals decided · %d entity changes · %d knowledge added today · %d mentions",
+		counts[activityApproval], counts[activityEdit], counts[activityKnowledge], counts[activityMention])
+}
+
+// activityDayLabel names the day a feed row falls on.
+func activityDayLabel(at, now time.Time) string {
+	switch {
+	case sameDay(at, now):
+		return "Today"
+	case sameDay(at, now.AddDate(0, 0, -1)):
+		return "Yesterday"
+	}
+	return at.Local().Format("Mon Jan 02")
+}
+
+// sameDay reports whether a and b fall on the same local calendar day.
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.Local().Date()
+	by, bm, bd := b.Local().Date()
+	return ay == by && am == bm && ad == bd
+}
+
+// latestTime returns the later of two timestamps.
+func latestTime(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
+
+// auditString reads a string field from audit row data.
+func auditString(data map[string]any, key string) string {
+	value, _ := data[key].(string)
+	return strings.TrimSpace(value)
+}
+
+// metadataText flattens metadata to text for mention matching.
+func metadataText(metadata map[string]any) string {
+	if len(metadata) == 0 {
+		return ""
+	}
+	raw, err := json.Marshal(metadata)
+	if err != nil {
+		return ""
+	}
+	return string(raw)
+}
+
+// mentionsUser reports whether text contains username, ignoring case.
+func mentionsUser(username, text string) bool {
+	return username != "" && strings.Contains(strings.ToLower(text), strings.ToLower(username))
+}
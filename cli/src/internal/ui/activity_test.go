@@ -0,0 +1,110 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestActivityFeedGroupsOwnActionsAndMentionsByDay(t *testing.T) {
+	now := time.Now()
+	yesterday := now.AddDate(0, 0, -1)
+	var auditQuery string
+	_, client := contextTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var data any
+		switch r.URL.Path {
+		case "/api/audit":
+			auditQuery = r.URL.RawQuery
+			data = []map[string]any{
+				{"id": "a1", "table_name": "approval_requests", "record_id": "ap-1", "action": "update",
+					"old_data":   map[string]any{"status": "pending"},
+					"new_data":   map[string]any{"status": "approved", "request_type": "create_entity", "change_details": map[string]any{"name": "Atlas"}},
+					"changed_at": now},
+				{"id": "a2", "table_name": "entities", "record_id": "ent-1", "action": "update",
+					"new_data": map[string]any{"name": "Atlas"}, "changed_at": now.Add(-time.Minute)},
+				{"id": "a3", "table_name": "entities", "record_id": "ent-1", "action": "update",
+					"new_data": map[string]any{"name": "Atlas"}, "changed_at": now.Add(-2 * time.Minute)},
+				{"id": "a4", "table_name": "context_items", "record_id": "ctx-1", "action": "insert",
+					"new_data": map[string]any{"title": "Runbook"}, "changed_at": now},
+				{"id": "a5", "table_name": "context_items", "record_id": "ctx-old", "action": "insert",
+					"new_data": map[string]any{"title": "Old note"}, "changed_at": yesterday},
+				{"id": "a6", "table_name": "jobs", "record_id": "job-1", "action": "update", "changed_at": now},
+			}
+		case "/api/entities":
+			data = []map[string]any{
+				{"id": "me", "name": "alx", "metadata": map[string]any{"handle": "alx"}},
+				{"id": "ent-2", "name": "Roadmap", "metadata": map[string]any{"owner": "ALX"}, "updated_at": yesterday},
+				{"id": "ent-3", "name": "Stemmed hit", "metadata": map[string]any{"note": "other"}},
+			}
+		case "/api/context":
+			data = []map[string]any{
+				{"id": "ctx-1", "title": "Runbook", "content": "ping alx", "created_at": now},
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": data}))
+	})
+
+	model := NewActivityModel(client, &config.Config{UserEntityID: "me", Username: "alx"})
+	model.width = 120
+	model, _ = model.Update(model.Init()())
+	assert.Contains(t, auditQuery, "actor_id=me")
+
+	require.Len(t, model.items, 4)
+	assert.Equal(t, "approved", model.items[0].verb)
+	assert.Equal(t, 2, model.items[2].count)
+	assert.Equal(t, activityMention, model.items[3].kind)
+
+	view := components.SanitizeText(model.View())
+	assert.Contains(t, view, "1 approvals decided · 1 entity changes · 1 knowledge added today · 1 mentions")
+	assert.Contains(t, view, "Today")
+	assert.Contains(t, view, "Yesterday")
+	assert.Contains(t, view, "Create Entity: Atlas")
+	assert.Contains(t, view, "Atlas ×2")
+	assert.Contains(t, view, "mentioned in Roadmap")
+	assert.NotContains(t, view, "Old note")
+	assert.NotContains(t, view, "Stemmed hit")
+}
+
+func TestActivityEnterOpensSelectedRecord(t *testing.T) {
+	_, client := contextTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/context/ctx-1", r.URL.Path)
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "ctx-1", "title": "Runbook"}}))
+	})
+	model := NewActivityModel(client, &config.Config{Username: "alx"})
+	model, _ = model.Update(activityLoadedMsg{items: []activityItem{
+		{kind: activityKnowledge, at: time.Now(), verb: "added", title: "Runbook", link: "context", id: "ctx-1", count: 1},
+	}})
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	msg, ok := cmd().(searchSelectionMsg)
+	require.True(t, ok)
+	assert.Equal(t, "context", msg.kind)
+	assert.Equal(t, "ctx-1", msg.context.ID)
+}
+
+func TestActivityWithoutIdentityAsksToLogIn(t *testing.T) {
+	model := NewActivityModel(nil, &config.Config{})
+	model.width = 80
+	model, _ = model.Update(model.Init()())
+	assert.Contains(t, components.SanitizeText(model.View()), "Log in to see your activity.")
+	assert.Empty(t, model.items)
+}
+
+func TestActivityDayLabel(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.Local)
+	assert.Equal(t, "Today", activityDayLabel(now.Add(-time.Hour), now))
+	assert.Equal(t, "Yesterday", activityDayLabel(now.AddDate(0, 0, -1), now))
+	assert.Equal(t, "Mon Oct 12", activityDayLabel(now.AddDate(0, 0, -4), now))
+}
@@ -1,6 +1,8 @@
 package ui
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -10,26 +12,31 @@ import (
 
 	"github.com/gravitrone/nebula-core/cli/internal/api"
 	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/plugins"
 	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
 )
 
 // --- Tab Constants ---
 
 const (
-	tabInbox     = 0
-	tabEntities  = 1
-	tabRelations = 2
-	tabKnow      = 3
-	tabJobs      = 4
-	tabLogs      = 5
-	tabFiles     = 6
-	tabProtocols = 7
-	tabHistory   = 8
-	tabProfile   = 9
-	tabCount     = 10
+	tabInbox       = 0
+	tabEntities    = 1
+	tabRelations   = 2
+	tabKnow        = 3
+	tabJobs        = 4
+	tabLogs        = 5
+	tabFiles       = 6
+	tabProtocols   = 7
+	tabHistory     = 8
+	tabProfile     = 9
+	tabDashboard   = 10
+	tabStatus      = 11
+	tabActivity    = 12
+	tabCollections = 13
+	tabCount       = 14
 )
 
-var tabNames = []string{"Inbox", "Entities", "Relationships", "Context", "Jobs", "Logs", "Files", "Protocols", "History", "Settings"}
+var tabNames = []string{"Inbox", "Entities", "Relationships", "Context", "Jobs", "Logs", "Files", "Protocols", "History", "Settings", "Dashboard", "Status", "Activity", "Collections"}
 
 // --- Messages ---
 
@@ -103,10 +110,11 @@ type App struct {
 	helpOpen          bool
 	quitConfirm       bool
 	showRecoveryHints bool
+	errKind           api.ErrorKind
 	recoveryCommand   string
 
 	onboarding     bool
-	onboardingName string
+	onboardingName components.TextInput
 	onboardingBusy bool
 
 	quickstartOpen bool
@@ -117,37 +125,104 @@ type App struct {
 	toast           *appToast
 
 	paletteOpen          bool
-	paletteQuery         string
+	paletteQuery         components.TextInput
 	paletteIndex         int
 	paletteActions       []paletteAction
+	plugins              []plugins.Plugin
 	paletteFiltered      []paletteAction
 	paletteSearchQuery   string
 	paletteSearchLoading bool
 	paletteSelections    map[string]paletteSelection
+	searchTags           []string
+	searchScopes         []string
+	paletteVerb          *paletteVerb
+	verbPlan             *paletteVerbPlan
+
+	ops       []operation
+	opsNextID int
+	opsOpen   bool
+	opsIndex  int
+
+	notifications []appNotification
+	notifUnseen   int
+	notifOpen     bool
+	notifIndex    int
+
+	loadFailures map[int]loadFailure
+	tabCancels   map[int]context.CancelFunc
+
+	away     []config.SyncChange
+	awayOpen bool
+
+	watchEvents []watchEvent
+	watchOpen   bool
+	watchIndex  int
+
+	// alertSeen holds the pending approval IDs of the last alert poll, nil
+	// until the first poll.
+	alertSeen      map[string]bool
+	inboxAttention bool
+	inboxFlash     int
+
+	updateAvailable string
+
+	offlinePending    int
+	offlineRetryArmed bool
+	reconcile         []offlineResult
+	reconcileOpen     bool
+
+	columnPicker *columnPicker
+
+	rateLimits       chan time.Duration
+	rateLimitedUntil time.Time
+
+	sessionExpiry    chan chan bool
+	sessionWaiters   []chan bool
+	sessionExpired   bool
+	sessionRelogging bool
+	sessionErr       string
+
+	refreshing bool
+	refreshGen int
+
+	vim   vimState
+	macro macroState
 
 	importExportOpen bool
+	trashOpen        bool
 	bodyScroll       int
 	bodyViewKey      string
-
-	inbox     InboxModel
-	entities  EntitiesModel
-	rels      RelationshipsModel
-	know      ContextModel
-	jobs      JobsModel
-	logs      LogsModel
-	files     FilesModel
-	protocols ProtocolsModel
-	history   HistoryModel
-	profile   ProfileModel
-	impex     ImportExportModel
+	nav              navHistory
+	deepLink         *DeepLink
+
+	inbox       InboxModel
+	entities    EntitiesModel
+	rels        RelationshipsModel
+	know        ContextModel
+	jobs        JobsModel
+	logs        LogsModel
+	files       FilesModel
+	protocols   ProtocolsModel
+	history     HistoryModel
+	dashboard   DashboardModel
+	status      StatusModel
+	activity    ActivityModel
+	collections CollectionsModel
+	profile     ProfileModel
+	impex       ImportExportModel
+	trash       TrashModel
 }
 
 // NewApp creates the root application model.
 func NewApp(client *api.Client, cfg *config.Config) App {
 	inbox := NewInboxModel(client)
 	inbox.confirmBulk = true
+	jobs := NewJobsModel(client)
 	if cfg != nil {
 		inbox.SetPendingLimit(cfg.PendingLimit)
+		inbox.SetCurrentUser(cfg.UserEntityID)
+		inbox.SetTriage(loadInboxTriage())
+		jobs.SetCurrentUser(cfg.UserEntityID)
 	}
 	onboarding := cfg == nil
 	quickstartPending := cfg != nil && cfg.QuickstartPending
@@ -167,17 +242,45 @@ func NewApp(client *api.Client, cfg *config.Config) App {
 			Taxonomy: "checking",
 		},
 		paletteActions: defaultPaletteActions(),
+		rateLimits:     watchRateLimits(client),
+		sessionExpiry:  watchSessionExpiry(client),
 		inbox:          inbox,
 		entities:       NewEntitiesModel(client),
 		rels:           NewRelationshipsModel(client),
 		know:           NewContextModel(client),
-		jobs:           NewJobsModel(client),
+		jobs:           jobs,
 		logs:           NewLogsModel(client),
 		files:          NewFilesModel(client),
 		protocols:      NewProtocolsModel(client),
 		history:        NewHistoryModel(client),
+		dashboard:      NewDashboardModel(client),
+		status:         NewStatusModel(client, cfg),
+		activity:       NewActivityModel(client, cfg),
+		collections:    NewCollectionsModel(client),
 		profile:        NewProfileModel(client, cfg),
 		impex:          NewImportExportModel(client),
+		trash:          NewTrashModel(client),
+	}
+	app.bindTabContexts()
+	app.plugins = discoverPlugins()
+	app.paletteActions = append(app.paletteActions, pluginPaletteActions(app.plugins)...)
+	configureEntityTypes(cfg)
+	if cfg != nil {
+		app.entities.addTemplate.templates = cfg.TemplatesFor(config.TemplateKindEntity)
+		app.know.template.templates = cfg.TemplatesFor(config.TemplateKindKnowledge)
+		app.jobs.addTemplate.templates = cfg.TemplatesFor(config.TemplateKindJob)
+		app.know.sort = parseTableSort(cfg.TableSort["context"], contextSortColumns)
+		app.files.sort = parseTableSort(cfg.TableSort["files"], fileSortColumns)
+		app.history.sort = parseTableSort(cfg.TableSort["history"], historySortColumns)
+		app.know.columns = contextColumnSet.parse(cfg.TableColumns["context"])
+		app.files.columns = fileColumnSet.parse(cfg.TableColumns["files"])
+		app.inbox.columns = inboxColumnSet.parse(cfg.TableColumns["inbox"])
+		app.entities.SetFormDefaults(cfg.FormDefaults())
+		app.know.SetFormDefaults(cfg.FormDefaults())
+		app.applyPins()
+	}
+	if cfg.UsesSSO() {
+		app.recoveryCommand = "nebula login --sso"
 	}
 	app.bodyViewKey = app.viewStateKey()
 	return app
@@ -188,15 +291,26 @@ func (a App) Init() tea.Cmd {
 	if a.onboarding {
 		return nil
 	}
-	cmds := []tea.Cmd{a.inbox.Init()}
+	cmds := []tea.Cmd{a.inbox.Init(), loadVocabulary(a.client), waitForRateLimit(a.rateLimits), waitForSessionExpiry(a.sessionExpiry), a.autoRefreshCmd(), loadAwayDigest, replayOfflineEdits(a.client), checkForUpdate, a.pollWatchesCmd(), watchTickCmd(), a.pollApprovalAlertsCmd(), approvalAlertTickCmd()}
 	if a.startupChecking {
 		cmds = append(cmds, a.runStartupCheckCmd())
 	}
+	if a.deepLink != nil {
+		cmds = append(cmds, loadDeepLink(a.client, *a.deepLink))
+	}
 	return tea.Batch(cmds...)
 }
 
 // Update updates update.
 func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && a.vimEnabled() {
+		return a.updateRecorded(msg)
+	}
+	return a.update(msg)
+}
+
+// update applies one message.
+func (a App) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	prevViewKey := a.viewStateKey()
 
 	switch msg := msg.(type) {
@@ -221,95 +335,169 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.protocols.height = msg.Height
 		a.history.width = msg.Width
 		a.history.height = msg.Height
+		a.dashboard.width = msg.Width
+		a.dashboard.height = msg.Height
+		a.status.width = msg.Width
+		a.status.height = msg.Height
+		a.activity.width = msg.Width
+		a.activity.height = msg.Height
+		a.collections.width = msg.Width
+		a.collections.height = msg.Height
 		a.profile.width = msg.Width
 		a.profile.height = msg.Height
 		a.impex.width = msg.Width
 		a.impex.height = msg.Height
+		a.trash.width = msg.Width
+		a.trash.height = msg.Height
 		return a, nil
 
 	case errMsg:
-		a.err = msg.err.Error()
-		a.lastErrCode, a.lastErrMsg = parseErrorCodeAndMessage(a.err)
-		a.showRecoveryHints = shouldShowRecoveryHints(a.lastErrCode, a.lastErrMsg)
+		if isCancelled(msg.err) {
+			return a, nil
+		}
+		a.setError(msg.err)
+		a.notify("error", a.err)
+		return a, nil
+	case loadFailedMsg:
+		return a.handleLoadFailed(msg)
+	case awayDigestLoadedMsg:
+		a.applyAwayDigest(msg)
+		return a, nil
+	case updateAvailableMsg:
+		a.updateAvailable = msg.version
 		return a, nil
+	case offlineEditQueuedMsg:
+		return a, a.handleOfflineQueued(msg)
+	case offlineReplayTickMsg:
+		a.offlineRetryArmed = false
+		return a, replayOfflineEdits(a.client)
+	case offlineReplayedMsg:
+		return a, a.handleOfflineReplayed(msg)
 	case clearToastMsg:
 		a.toast = nil
 		return a, nil
+	case pluginDoneMsg:
+		return a, a.handlePluginDone(msg)
+	case vocabularyLoadedMsg:
+		a.applyVocabulary(msg)
+		if msg.err != nil {
+			return a, a.setToast("warning", "Autocomplete incomplete: "+msg.err.Error())
+		}
+		return a, nil
+	case taxonomyActionDoneMsg:
+		// Taxonomy edits change relationship type rules, so refresh them too.
+		var cmd tea.Cmd
+		a.profile, cmd = a.profile.Update(msg)
+		return a, tea.Batch(cmd, loadVocabulary(a.client))
 	case reloginDoneMsg:
+		if a.sessionExpired {
+			return a, a.handleSessionRelogin(msg)
+		}
 		if msg.err != nil {
-			a.err = fmt.Sprintf("re-login failed: %v", msg.err)
-			a.lastErrCode, a.lastErrMsg = parseErrorCodeAndMessage(a.err)
-			a.showRecoveryHints = shouldShowRecoveryHints(a.lastErrCode, a.lastErrMsg)
+			a.setError(fmt.Errorf("re-login failed: %w", msg.err))
 			return a, nil
 		}
 		if a.config != nil {
 			a.config.APIKey = msg.apiKey
 			if err := a.config.Save(); err != nil {
-				a.err = fmt.Sprintf("save config: %v", err)
-				a.lastErrCode, a.lastErrMsg = parseErrorCodeAndMessage(a.err)
-				a.showRecoveryHints = shouldShowRecoveryHints(a.lastErrCode, a.lastErrMsg)
+				a.setError(fmt.Errorf("save config: %w", err))
 				return a, nil
 			}
 		}
 		if a.client != nil {
 			a.client.SetAPIKey(msg.apiKey)
 		}
-		a.err = ""
-		a.lastErrCode = ""
-		a.lastErrMsg = ""
-		a.showRecoveryHints = false
+		a.clearError()
 		return a, a.setToast("success", "Re-login complete. API key refreshed.")
 	case onboardingLoginDoneMsg:
 		a.onboardingBusy = false
 		if msg.err != nil {
-			a.err = fmt.Sprintf("login failed: %v", msg.err)
-			a.lastErrCode, a.lastErrMsg = parseErrorCodeAndMessage(a.err)
-			a.showRecoveryHints = shouldShowRecoveryHints(a.lastErrCode, a.lastErrMsg)
+			a.setError(fmt.Errorf("login failed: %w", msg.err))
 			return a, nil
 		}
 		if msg.resp == nil {
-			a.err = "login failed: empty response"
+			a.setError(errors.New("login failed: empty response"))
 			return a, nil
 		}
-		cfg := &config.Config{
-			APIKey:            msg.resp.APIKey,
-			UserEntityID:      msg.resp.EntityID,
-			Username:          msg.resp.Username,
-			Theme:             "dark",
-			VimKeys:           true,
-			QuickstartPending: true,
-			PendingLimit:      500,
+		cfg, err := config.LoadForLogin()
+		if err != nil {
+			a.setError(fmt.Errorf("load config: %w", err))
+			return a, nil
 		}
+		cfg.APIKey = msg.resp.APIKey
+		cfg.KeyInKeyring = true
+		cfg.UserEntityID = msg.resp.EntityID
+		cfg.Username = msg.resp.Username
+		cfg.RefreshToken = ""
+		cfg.TokenExpiresAt = time.Time{}
 		if err := cfg.Save(); err != nil {
-			a.err = fmt.Sprintf("save config: %v", err)
+			a.setError(fmt.Errorf("save config: %w", err))
 			return a, nil
 		}
 		a.config = cfg
 		if a.client == nil {
-			a.client = api.NewDefaultClient(cfg.APIKey)
+			a.client = api.NewClient(api.ResolveBaseURL(cfg.APIURL), cfg.APIKey, cfg.RequestTimeoutDuration())
+			a.rateLimits = watchRateLimits(a.client)
+			a.sessionExpiry = watchSessionExpiry(a.client)
 		} else {
 			a.client.SetAPIKey(cfg.APIKey)
 		}
-		a.profile.client = a.client
+		a.bindTabContexts()
 		a.profile.config = cfg
+		a.activity.config = cfg
+		a.applyPins()
 		a.inbox.SetPendingLimit(cfg.PendingLimit)
+		a.inbox.SetCurrentUser(cfg.UserEntityID)
+		a.inbox.SetTriage(loadInboxTriage())
+		a.jobs.SetCurrentUser(cfg.UserEntityID)
+		a.entities.SetFormDefaults(cfg.FormDefaults())
+		a.know.SetFormDefaults(cfg.FormDefaults())
 		a.onboarding = false
-		a.onboardingName = ""
+		a.onboardingName.Reset()
 		a.quickstartOpen = cfg.QuickstartPending
-		a.err = ""
-		a.lastErrCode = ""
-		a.lastErrMsg = ""
-		a.showRecoveryHints = false
+		a.clearError()
 		a.startupChecking = true
 		a.startup = startupSummary{
 			API:      "checking",
 			Auth:     "checking",
 			Taxonomy: "checking",
 		}
-		return a, tea.Batch(a.inbox.Init(), a.runStartupCheckCmd(), a.setToast("success", "Logged in. Welcome to Nebula."))
+		return a, tea.Batch(a.inbox.Init(), a.runStartupCheckCmd(), waitForRateLimit(a.rateLimits), waitForSessionExpiry(a.sessionExpiry), a.autoRefreshCmd(), watchTickCmd(), a.pollApprovalAlertsCmd(), approvalAlertTickCmd(), a.setToast("success", "Logged in. Welcome to Nebula."))
 	case pendingLimitSavedMsg:
 		a.inbox.SetPendingLimit(msg.limit)
 		return a, nil
+	case tableSortChangedMsg:
+		return a, a.saveTableSort(msg)
+	case tableColumnsSavedMsg:
+		return a, a.saveTableColumns(msg)
+	case accessibleSavedMsg:
+		if msg.enabled {
+			return a, a.setToast("success", "Screen reader mode on.")
+		}
+		return a, a.setToast("info", "Screen reader mode off.")
+	case formDefaultsSavedMsg:
+		a.profile, _ = a.profile.Update(msg)
+		a.entities.SetFormDefaults(msg.defaults)
+		a.know.SetFormDefaults(msg.defaults)
+		return a, a.setToast("success", "Create defaults saved.")
+	case approvalAlertsSavedMsg:
+		a.alertSeen = nil
+		switch msg.mode {
+		case config.ApprovalAlertsOn:
+			return a, tea.Batch(a.pollApprovalAlertsCmd(), a.setToast("success", "Approval alerts on: bell and tab flash."))
+		case config.ApprovalAlertsFlash:
+			return a, tea.Batch(a.pollApprovalAlertsCmd(), a.setToast("success", "Approval alerts: tab flash only."))
+		}
+		a.inboxAttention = false
+		a.inboxFlash = 0
+		return a, a.setToast("info", "Approval alerts off.")
+	case vimKeysSavedMsg:
+		a.vim = vimState{}
+		a.macro = macroState{}
+		if msg.enabled {
+			return a, a.setToast("success", "Vim keys on.")
+		}
+		return a, a.setToast("info", "Vim keys off.")
 	case startupCheckedMsg:
 		a.startupChecking = false
 		a.startup.Done = true
@@ -323,20 +511,11 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		switch a.startup.Auth {
 		case "invalid":
-			a.err = "INVALID_API_KEY: Invalid API key"
-			a.lastErrCode = "INVALID_API_KEY"
-			a.lastErrMsg = "Invalid API key"
-			a.showRecoveryHints = true
+			a.setError(errors.New("INVALID_API_KEY: Invalid API key"))
 		case "multi_api_conflict":
-			a.err = "MULTIPLE_API_INSTANCES_DETECTED: multiple api instances detected"
-			a.lastErrCode = "MULTIPLE_API_INSTANCES_DETECTED"
-			a.lastErrMsg = "multiple api instances detected"
-			a.showRecoveryHints = false
+			a.setError(errors.New("MULTIPLE_API_INSTANCES_DETECTED: multiple api instances detected"))
 		default:
-			a.err = ""
-			a.lastErrCode = ""
-			a.lastErrMsg = ""
-			a.showRecoveryHints = false
+			a.clearError()
 		}
 		level, text := startupToastCopy(a.startup)
 		return a, a.setToast(level, text)
@@ -358,13 +537,25 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			return a, cmd
 		}
+	case exportProgressMsg:
+		if !a.importExportOpen {
+			_ = msg.exp.Close()
+			return a, nil
+		}
+		var cmd tea.Cmd
+		a.impex, cmd = a.impex.Update(msg)
+		return a, cmd
+	case trashLoadedMsg:
+		var cmd tea.Cmd
+		a.trash, cmd = a.trash.Update(msg)
+		return a, cmd
 	case paletteSearchLoadedMsg:
 		if msg.query != a.paletteSearchQuery {
 			return a, nil
 		}
 		a.paletteSearchLoading = false
 		a.paletteFiltered, a.paletteSelections = buildSearchPaletteActions(
-			msg.query,
+			parseSearchQuery(msg.query).text,
 			msg.entities,
 			msg.context,
 			msg.jobs,
@@ -377,6 +568,54 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return a, nil
 	case searchSelectionMsg:
 		return a.applySearchSelection(msg)
+	case pinToggledMsg:
+		return a, a.togglePin(msg.pin)
+	case watchToggledMsg:
+		return a, a.toggleWatch(msg.watch)
+	case watchTickMsg:
+		return a, tea.Batch(a.pollWatchesCmd(), watchTickCmd())
+	case watchEventsMsg:
+		return a, a.applyWatchEvents(msg.events)
+	case approvalAlertTickMsg:
+		return a, tea.Batch(a.pollApprovalAlertsCmd(), approvalAlertTickCmd())
+	case approvalAlertsMsg:
+		return a, a.applyApprovalAlerts(msg.items)
+	case approvalFlashMsg:
+		return a, a.advanceApprovalFlash()
+	case collectionFilterMsg:
+		return a.applyCollectionFilter(msg.collection)
+	case collectionExportMsg:
+		a.tabNav = false
+		a.importExportOpen = true
+		a.impex.StartCollectionExport(msg.collection)
+		return a, nil
+	case deepLinkLoadedMsg:
+		return a.applyDeepLink(msg)
+	case paletteVerbResolvedMsg:
+		return a, a.handlePaletteVerbResolved(msg)
+	case operationQueuedMsg:
+		return a, a.startOperation(msg)
+	case operationStepDoneMsg:
+		return a, a.finishOperationStep(msg)
+	case rateLimitedMsg:
+		return a, a.handleRateLimited(msg)
+	case rateLimitTickMsg:
+		return a, a.handleRateLimitTick()
+	case sessionExpiredMsg:
+		return a, a.handleSessionExpired(msg)
+	case sessionReloadedMsg:
+		return a, a.handleSessionReloaded(msg)
+	case autoRefreshTickMsg:
+		return a, a.handleAutoRefreshTick(msg)
+	case splitPaneLoadedMsg:
+		if msg.kind == splitPaneRelationship {
+			return a, a.updateTab(tabRelations, msg)
+		}
+		return a, a.updateTab(tabEntities, msg)
+	case recordCommentsLoadedMsg:
+		return a, a.updateTab(commentTab(msg.target), msg)
+	case recordCommentSavedMsg:
+		return a, a.updateTab(commentTab(msg.target), msg)
 
 	case tea.KeyMsg:
 		if a.onboarding {
@@ -390,6 +629,9 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			return a, cmd
 		}
+		if a.sessionExpired {
+			return a.handleSessionKeys(msg)
+		}
 		if a.quitConfirm {
 			switch {
 			case isKey(msg, "y"), isEnter(msg):
@@ -408,6 +650,51 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if a.paletteOpen {
 			return a.handlePaletteKeys(msg)
 		}
+		if a.verbPlan != nil {
+			return a.handleVerbConfirmKeys(msg)
+		}
+		if a.opsOpen {
+			return a.handleOperationsKeys(msg)
+		}
+		if a.notifOpen {
+			return a.handleNotificationKeys(msg)
+		}
+		if a.watchOpen {
+			return a.handleWatchKeys(msg)
+		}
+		if a.awayOpen {
+			return a.handleAwayKeys(msg)
+		}
+		if a.reconcileOpen {
+			return a.handleReconcileKeys(msg)
+		}
+		if a.trashOpen {
+			var cmd tea.Cmd
+			a.trash, cmd = a.trash.Update(msg)
+			if a.trash.closed {
+				a.trashOpen = false
+			}
+			return a, cmd
+		}
+		if a.columnPicker != nil {
+			return a.handleColumnPickerKeys(msg)
+		}
+		if a.tab == tabKnow && a.know.view == contextViewDetail && a.know.pager != nil && !isKey(msg, "ctrl+c") {
+			// The pager reads the whole keyboard, including search text.
+			return a, a.updateTab(tabKnow, msg)
+		}
+		if a.tab == tabEntities && a.entities.queryForm != nil && !isKey(msg, "ctrl+c") {
+			// The query builder takes digits and letters as field text.
+			return a, a.updateTab(tabEntities, msg)
+		}
+		if a.tab == tabFiles && a.files.attach != nil && !isKey(msg, "ctrl+c") {
+			// The attach picker takes letters as search text.
+			return a, a.updateTab(tabFiles, msg)
+		}
+		if a.commentsFocused() && !isKey(msg, "ctrl+c") {
+			// The comment thread takes letters as comment text and commands.
+			return a, a.updateTab(a.tab, msg)
+		}
 		if a.quickstartOpen {
 			return a.handleQuickstartKeys(msg)
 		}
@@ -419,14 +706,20 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				a.profile.section = 0
 				return a.switchTab(tabProfile)
 			case isKey(msg, "c"):
-				return a, a.setToast("info", a.recoveryCommand)
+				return a, a.setToast("info", a.errorRemedy().command)
 			}
 		}
 		if a.err != "" {
-			a.err = ""
-			a.lastErrCode = ""
-			a.lastErrMsg = ""
-			a.showRecoveryHints = false
+			a.clearError()
+		}
+
+		if a.vimEnabled() {
+			if model, cmd, ok := a.handleMacroKeys(msg); ok {
+				return model, cmd
+			}
+			if model, cmd, ok := a.handleVimKeys(msg); ok {
+				return model, cmd
+			}
 		}
 
 		// Global keys
@@ -442,6 +735,40 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return a, tea.Quit
 		}
 
+		if isKey(msg, "ctrl+o") {
+			a.opsOpen = true
+			a.opsIndex = 0
+			return a, nil
+		}
+
+		if isKey(msg, "ctrl+x") {
+			return a, a.openTrash()
+		}
+
+		if isKey(msg, "N") && a.browsing() {
+			a.openNotifications()
+			return a, nil
+		}
+
+		if isKey(msg, "W") && a.browsing() {
+			a.openWatches()
+			return a, nil
+		}
+
+		if isKey(msg, "r") && a.browsing() {
+			if cmd, ok := a.retryLoad(); ok {
+				return a, cmd
+			}
+		}
+
+		if isKey(msg, "ctrl+k") && a.openColumnPicker() {
+			return a, nil
+		}
+
+		if isKey(msg, "ctrl+r") || (isKey(msg, "R") && !a.hasUnsaved()) {
+			return a, a.refreshTab(a.tab)
+		}
+
 		// Command palette
 		if isKey(msg, "/") {
 			a.bodyScroll = 0
@@ -467,6 +794,14 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return app, cmd
 		}
 
+		// Record history: [ back, ] forward.
+		if isKey(msg, "[", "]") && !a.tabNav && a.navKeysAllowed() {
+			if isKey(msg, "[") {
+				return a.navigate(-1)
+			}
+			return a.navigate(1)
+		}
+
 		// Arrow tab navigation until user enters content with Down
 		if a.tabNav {
 			if isKey(msg, "left") {
@@ -505,8 +840,26 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	}
 
 	// Delegate to active tab
+	if a.refreshing {
+		a.settleRefresh(msg)
+	}
+	cmd := a.updateTab(a.tab, msg)
+	a.recordNav()
+	toastCmd := a.toastCmdForMsg(msg)
+	a.resetBodyScrollOnViewChange(prevViewKey)
+	if toastCmd != nil && cmd != nil {
+		return a, tea.Batch(cmd, toastCmd)
+	}
+	if toastCmd != nil {
+		return a, toastCmd
+	}
+	return a, cmd
+}
+
+// updateTab delivers msg to one tab model.
+func (a *App) updateTab(tab int, msg tea.Msg) tea.Cmd {
 	var cmd tea.Cmd
-	switch a.tab {
+	switch tab {
 	case tabInbox:
 		a.inbox, cmd = a.inbox.Update(msg)
 	case tabEntities:
@@ -527,28 +880,43 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.history, cmd = a.history.Update(msg)
 	case tabProfile:
 		a.profile, cmd = a.profile.Update(msg)
+	case tabDashboard:
+		a.dashboard, cmd = a.dashboard.Update(msg)
+	case tabStatus:
+		a.status, cmd = a.status.Update(msg)
+	case tabActivity:
+		a.activity, cmd = a.activity.Update(msg)
+	case tabCollections:
+		a.collections, cmd = a.collections.Update(msg)
 	}
-	toastCmd := a.toastCmdForMsg(msg)
-	a.resetBodyScrollOnViewChange(prevViewKey)
-	if toastCmd != nil && cmd != nil {
-		return a, tea.Batch(cmd, toastCmd)
-	}
-	if toastCmd != nil {
-		return a, toastCmd
-	}
-	return a, cmd
+	return cmd
 }
 
 // View handles view.
 func (a App) View() string {
 	components.SetTableGridActiveRowsEnabled(a.rowHighlightEnabled())
 	defer components.SetTableGridActiveRowsEnabled(true)
+	accessible := a.accessibleEnabled()
+	components.SetAccessibleMode(accessible)
+	defer components.SetAccessibleMode(false)
+	if accessible {
+		defer useAccessibleStyles()()
+	}
+	// Screen readers follow text linearly, so accessible mode skips centering.
+	layoutWidth := a.width
+	if accessible {
+		layoutWidth = 0
+	}
 
-	banner := centerBlockUniform(RenderBanner(), a.width)
-	tabs := centerBlockUniform(a.renderTabs(), a.width)
+	var banner, tabs string
+	if accessible {
+		tabs = a.renderAccessibleHeader()
+	} else {
+		banner, tabs = a.renderBannerAndTabs()
+	}
 	startupPanel := ""
 	if a.startupChecking {
-		startupPanel = "\n\n" + centerBlockUniform(a.renderStartupPanel(), a.width)
+		startupPanel = "\n\n" + centerBlockUniform(a.renderStartupPanel(), layoutWidth)
 	}
 
 	var content string
@@ -573,45 +941,88 @@ func (a App) View() string {
 		content = a.history.View()
 	case tabProfile:
 		content = a.profile.View()
-	}
-	content = centerBlockUniform(content, a.width)
-
-	if a.quitConfirm {
+	case tabDashboard:
+		content = a.dashboard.View()
+	case tabStatus:
+		content = a.status.View()
+	case tabActivity:
+		content = a.activity.View()
+	case tabCollections:
+		content = a.collections.View()
+	}
+	if failure := a.renderLoadFailure(); failure != "" {
+		content = components.Indent(failure, 1) + "\n" + content
+	}
+	content = centerBlockUniform(content, layoutWidth)
+
+	if a.sessionExpired {
+		content = a.renderSessionExpired()
+		content = centerBlockUniform(content, layoutWidth)
+	} else if a.quitConfirm {
 		content = a.renderQuitConfirm()
-		content = centerBlockUniform(content, a.width)
+		content = centerBlockUniform(content, layoutWidth)
 	} else if a.helpOpen {
 		content = a.renderHelp()
-		content = centerBlockUniform(content, a.width)
+		content = centerBlockUniform(content, layoutWidth)
 	} else if a.paletteOpen {
 		content = a.renderPalette()
-		content = centerBlockUniform(content, a.width)
+		content = centerBlockUniform(content, layoutWidth)
+	} else if a.verbPlan != nil {
+		content = a.renderVerbConfirm()
+		content = centerBlockUniform(content, layoutWidth)
 	} else if a.importExportOpen {
 		content = a.impex.View()
-		content = centerBlockUniform(content, a.width)
+		content = centerBlockUniform(content, layoutWidth)
+	} else if a.opsOpen {
+		content = a.renderOperations()
+		content = centerBlockUniform(content, layoutWidth)
+	} else if a.notifOpen {
+		content = a.renderNotifications()
+		content = centerBlockUniform(content, layoutWidth)
+	} else if a.watchOpen {
+		content = a.renderWatches()
+		content = centerBlockUniform(content, layoutWidth)
+	} else if a.awayOpen {
+		content = a.renderAwayDigest()
+		content = centerBlockUniform(content, layoutWidth)
+	} else if a.reconcileOpen {
+		content = a.renderReconcile()
+		content = centerBlockUniform(content, layoutWidth)
+	} else if a.trashOpen {
+		content = a.trash.View()
+		content = centerBlockUniform(content, layoutWidth)
+	} else if a.columnPicker != nil {
+		content = a.renderColumnPicker()
+		content = centerBlockUniform(content, layoutWidth)
 	} else if a.onboarding {
 		content = a.renderOnboarding()
-		content = centerBlockUniform(content, a.width)
+		content = centerBlockUniform(content, layoutWidth)
 	} else if a.quickstartOpen {
 		content = a.renderQuickstart()
-		content = centerBlockUniform(content, a.width)
+		content = centerBlockUniform(content, layoutWidth)
 	}
 
 	hints := components.StatusBar(a.statusHints(), a.width)
 
 	feedback := ""
 	if a.err != "" {
-		message := a.err
-		if a.showRecoveryHints {
-			message += "\n\nRecovery: [r] re-login  [s] settings  [c] show command"
+		message := clampFeedback(a.err, components.BoxContentWidth(a.width))
+		if remedy := a.errorRemedy(); remedy.hint != "" {
+			message += "\n\n" + remedy.hint
 		}
-		if shouldShowMultiAPIRecoveryHint(a.lastErrCode, a.lastErrMsg, a.err) {
-			message += "\n\nRecovery: stop duplicate API processes and restart with `nebula start`."
-		}
-		feedback = centerBlockUniform(components.ErrorBox("Error", message, a.width), a.width)
+		feedback = centerBlockUniform(components.ErrorBox("Error", message, a.width), layoutWidth)
 	} else if a.toast != nil {
-		feedback = centerBlockUniform(a.renderToast(), a.width)
+		feedback = centerBlockUniform(a.renderToast(), layoutWidth)
 	}
 	top := fmt.Sprintf("%s\n%s%s", banner, tabs, startupPanel)
+	if accessible {
+		// Announce errors and notices right under the status line.
+		top = tabs + startupPanel
+		if feedback != "" {
+			top += "\n" + feedback
+			feedback = ""
+		}
+	}
 	body := content
 	if a.height > 0 && !a.helpOpen && !a.quitConfirm && !a.paletteOpen && !a.importExportOpen {
 		reservedFeedbackLines := 0
@@ -634,6 +1045,53 @@ func (a App) View() string {
 	return fmt.Sprintf("%s\n\n%s\n\n%s", top, body, hints)
 }
 
+// renderBannerAndTabs renders the centered banner, status lines, and tab bar.
+func (a App) renderBannerAndTabs() (string, string) {
+	banner := centerBlockUniform(RenderBanner(), a.width)
+	if indicator := a.renderProfileIndicator(); indicator != "" {
+		banner += centerBlockUniform(indicator, a.width) + "\n"
+	}
+	if status := a.renderOperationsStatus(); status != "" {
+		banner += centerBlockUniform(status, a.width) + "\n"
+	}
+	if status := a.renderOfflineStatus(); status != "" {
+		banner += centerBlockUniform(status, a.width) + "\n"
+	}
+	if status := a.renderRateLimitStatus(); status != "" {
+		banner += centerBlockUniform(status, a.width) + "\n"
+	}
+	if status := a.renderRefreshStatus(); status != "" {
+		banner += centerBlockUniform(status, a.width) + "\n"
+	}
+	if status := a.renderVimStatus(); status != "" {
+		banner += centerBlockUniform(status, a.width) + "\n"
+	}
+	if status := a.renderNotificationStatus(); status != "" {
+		banner += centerBlockUniform(status, a.width) + "\n"
+	}
+	if status := a.renderUpdateStatus(); status != "" {
+		banner += centerBlockUniform(status, a.width) + "\n"
+	}
+	if crumbs := a.renderBreadcrumbs(); crumbs != "" {
+		banner += centerBlockUniform(crumbs, a.width) + "\n"
+	}
+	tabs := centerBlockUniform(a.renderTabs(), a.width)
+	return banner, tabs
+}
+
+// renderProfileIndicator renders the active profile line shown under the banner.
+func (a App) renderProfileIndicator() string {
+	if !a.config.HasProfiles() && (a.config == nil || a.config.Profile == "") {
+		return ""
+	}
+	label := a.config.ProfileLabel()
+	text := fmt.Sprintf("profile: %s · %s", label, api.ResolveBaseURL(a.config.APIURL))
+	if label == config.DefaultProfile {
+		return MutedStyle.Render(text)
+	}
+	return WarningStyle.Render(text)
+}
+
 // rowHighlightEnabled handles row highlight enabled.
 func (a App) rowHighlightEnabled() bool {
 	if a.tabNav {
@@ -641,7 +1099,7 @@ func (a App) rowHighlightEnabled() bool {
 	}
 	switch a.tab {
 	case tabInbox:
-		return !a.inbox.filtering && !a.inbox.rejecting && !a.inbox.confirming && !a.inbox.rejectPreview && a.inbox.detail == nil
+		return !a.inbox.filtering && a.inbox.triagePrompt == triagePromptNone && !a.inbox.rejecting && !a.inbox.confirming && !a.inbox.rejectPreview && a.inbox.detail == nil
 	case tabEntities:
 		if (a.entities.addMeta.Active || a.entities.editMeta.Active) && !a.entities.modeFocus {
 			return true
@@ -677,14 +1135,18 @@ func (a App) rowHighlightEnabled() bool {
 		}
 		return !a.files.modeFocus && !a.files.filtering && a.files.view == filesViewList
 	case tabProtocols:
-		if a.protocols.addMeta.Active || a.protocols.editMeta.Active {
+		if a.protocols.addMeta.Active || a.protocols.editMeta.Active || a.protocols.dryRunInput.Active {
 			return true
 		}
 		return !a.protocols.modeFocus && !a.protocols.filtering && a.protocols.view == protocolsViewList
 	case tabHistory:
 		return !a.history.filtering && a.history.view == historyViewList
+	case tabActivity:
+		return true
+	case tabCollections:
+		return a.collections.view == collectionsViewList && a.collections.prompt == "" && !a.collections.confirmDelete
 	case tabProfile:
-		if a.profile.sectionFocus || a.profile.creating || a.profile.editAPIKey || a.profile.editPendingLimit || a.profile.createdKey != "" || a.profile.agentDetail != nil {
+		if a.profile.sectionFocus || a.profile.creating || a.profile.editAPIKey || a.profile.editPendingLimit || a.profile.editDefaults || a.profile.createdKey != "" || a.profile.agentDetail != nil {
 			return false
 		}
 		return true
@@ -697,16 +1159,40 @@ func (a *App) switchTab(newTab int) (App, tea.Cmd) {
 	oldTab := a.tab
 	a.tab = newTab
 	a.bodyScroll = 0
+	if newTab == tabInbox {
+		a.inboxAttention = false
+		a.inboxFlash = 0
+	}
 	a.bodyViewKey = a.viewStateKey()
 	if oldTab != newTab {
 		a.clearContentFocus()
 		// Enter new tabs at top-nav focus so row highlights do not leak across tabs.
 		a.tabNav = true
-		return *a, a.initTab(newTab)
+		a.refreshing = false
+		a.cancelTabRequests(oldTab)
+		a.clearLoadFailure(newTab)
+		return *a, tea.Batch(a.initTab(newTab), a.scheduleAutoRefresh())
 	}
 	return *a, nil
 }
 
+// applyVocabulary shares the tag and relationship type vocabularies with every form.
+func (a *App) applyVocabulary(msg vocabularyLoadedMsg) {
+	tags := mergeVocabulary(msg.tags, nil)
+	a.entities.tagOptions = tags
+	a.know.tagOptions = tags
+	a.files.tagOptions = tags
+	a.logs.tagOptions = tags
+	a.protocols.tagOptions = tags
+	a.entities.relTypeVocab = mergeVocabulary(msg.relTypes, nil)
+	a.entities.relTypeRules = msg.relTypeRules
+	a.rels.typeVocab = mergeVocabulary(msg.relTypes, nil)
+	a.rels.typeRules = msg.relTypeRules
+	a.rels.typeOptions = mergeVocabulary(a.rels.typeOptions, a.rels.typeVocab)
+	a.searchTags = tags
+	a.searchScopes = mergeVocabulary(msg.scopes, nil)
+}
+
 // clearContentFocus handles clear content focus.
 func (a *App) clearContentFocus() {
 	a.entities.modeFocus = false
@@ -730,6 +1216,9 @@ func (a *App) resetBodyScrollOnViewChange(prevViewKey string) {
 
 // viewStateKey handles view state key.
 func (a App) viewStateKey() string {
+	if a.sessionExpired {
+		return "session-expired"
+	}
 	if a.helpOpen {
 		return "help"
 	}
@@ -739,9 +1228,33 @@ func (a App) viewStateKey() string {
 	if a.paletteOpen {
 		return "palette"
 	}
+	if a.verbPlan != nil {
+		return "verb-confirm"
+	}
 	if a.importExportOpen {
 		return "import-export"
 	}
+	if a.opsOpen {
+		return "operations"
+	}
+	if a.notifOpen {
+		return "notifications"
+	}
+	if a.watchOpen {
+		return "watches"
+	}
+	if a.awayOpen {
+		return "away"
+	}
+	if a.reconcileOpen {
+		return "reconcile"
+	}
+	if a.trashOpen {
+		return "trash"
+	}
+	if a.columnPicker != nil {
+		return "columns"
+	}
 	if a.onboarding {
 		return "onboarding"
 	}
@@ -759,6 +1272,10 @@ func (a App) viewStateKey() string {
 			return base + ":inbox:reject"
 		case a.inbox.confirming:
 			return base + ":inbox:confirm"
+		case a.inbox.triagePrompt != triagePromptNone:
+			return base + ":inbox:" + string(a.inbox.triagePrompt)
+		case a.inbox.commenting && a.inbox.detail != nil:
+			return base + ":inbox:comment"
 		case a.inbox.detail != nil:
 			return base + ":inbox:detail"
 		default:
@@ -772,6 +1289,9 @@ func (a App) viewStateKey() string {
 	case tabRelations:
 		return fmt.Sprintf("%s:rels:%d:mode=%t:filter=%t", base, a.rels.view, a.rels.modeFocus, a.rels.filtering)
 	case tabKnow:
+		if a.know.view == contextViewDetail && a.know.pager != nil {
+			return base + ":context:content"
+		}
 		return fmt.Sprintf("%s:context:%d:mode=%t:filter=%t", base, a.know.view, a.know.modeFocus, a.know.filtering)
 	case tabJobs:
 		if a.jobs.changingSt {
@@ -780,16 +1300,42 @@ func (a App) viewStateKey() string {
 		if a.jobs.creatingSubtask {
 			return base + ":jobs:subtask"
 		}
+		if a.jobs.checklistEdit {
+			return base + ":jobs:checklist"
+		}
+		if a.jobs.cancelling {
+			return base + ":jobs:cancel"
+		}
+		if a.jobs.tmplPending != nil {
+			return fmt.Sprintf("%s:jobs:template:%d", base, a.jobs.tmplParamIdx)
+		}
 		return fmt.Sprintf("%s:jobs:%d:mode=%t:filter=%t", base, a.jobs.view, a.jobs.modeFocus, a.jobs.filtering)
 	case tabLogs:
 		return fmt.Sprintf("%s:logs:%d:mode=%t:filter=%t", base, a.logs.view, a.logs.modeFocus, a.logs.filtering)
 	case tabFiles:
+		if a.files.attach != nil {
+			return base + ":files:attach"
+		}
 		return fmt.Sprintf("%s:files:%d:mode=%t:filter=%t", base, a.files.view, a.files.modeFocus, a.files.filtering)
 	case tabProtocols:
 		return fmt.Sprintf("%s:protocols:%d:mode=%t:filter=%t", base, a.protocols.view, a.protocols.modeFocus, a.protocols.filtering)
 	case tabHistory:
 		return fmt.Sprintf("%s:history:%d", base, a.history.view)
+	case tabDashboard:
+		return base + ":dashboard"
+	case tabStatus:
+		return base + ":status"
+	case tabActivity:
+		return base + ":activity"
+	case tabCollections:
+		return fmt.Sprintf("%s:collections:%d:prompt=%t:delete=%t", base, a.collections.view, a.collections.prompt != "", a.collections.confirmDelete)
 	case tabProfile:
+		if a.profile.permEditing {
+			return fmt.Sprintf("%s:settings:%d:permissions", base, a.profile.section)
+		}
+		if a.profile.editDefaults {
+			return fmt.Sprintf("%s:settings:%d:defaults", base, a.profile.section)
+		}
 		if a.profile.sectionFocus {
 			return fmt.Sprintf("%s:settings:%d:sections", base, a.profile.section)
 		}
@@ -819,7 +1365,8 @@ func (a App) tabWantsArrows() bool {
 	case tabProfile:
 		return a.profile.creating ||
 			a.profile.createdKey != "" ||
-			a.profile.taxPromptMode != taxPromptNone
+			a.profile.taxPromptMode != taxPromptNone ||
+			a.profile.tagPromptMode != tagPromptNone
 	}
 	return false
 }
@@ -829,6 +1376,13 @@ func (a App) renderTabs() string {
 	segments := make([]string, 0, len(tabNames))
 	for i, name := range tabNames {
 		label := name
+		if i == tabInbox {
+			label = a.inboxTabLabel(name)
+		}
+		if i == tabInbox && i != a.tab && a.inboxTabFlashing() {
+			segments = append(segments, TabAlertStyle.Render(label))
+			continue
+		}
 		if i == a.tab {
 			if a.tabNav {
 				segments = append(segments, TabFocusStyle.Render(label))
@@ -865,12 +1419,39 @@ func (a App) initTab(tab int) tea.Cmd {
 		return a.history.Init()
 	case tabProfile:
 		return a.profile.Init()
+	case tabDashboard:
+		return a.dashboard.Init()
+	case tabStatus:
+		return a.status.Init()
+	case tabActivity:
+		return a.activity.Init()
+	case tabCollections:
+		return a.collections.Init()
 	}
 	return nil
 }
 
 // statusHints handles status hints.
 func (a App) statusHints() []string {
+	if a.verbPlan != nil {
+		return []string{
+			components.Hint("enter", "Run"),
+			components.Hint("esc", "Cancel"),
+			components.Hint("y/n", "Aliases"),
+		}
+	}
+	if a.sessionExpired {
+		if a.config.UsesSSO() {
+			return []string{
+				components.Hint("r", "Retry"),
+				components.Hint("esc", "Cancel Requests"),
+			}
+		}
+		return []string{
+			components.Hint("r", "Re-login"),
+			components.Hint("esc", "Cancel Requests"),
+		}
+	}
 	if a.quitConfirm {
 		return []string{
 			components.Hint("enter", "Confirm"),
@@ -883,6 +1464,64 @@ func (a App) statusHints() []string {
 			components.Hint("esc", "Back"),
 		}
 	}
+	if a.opsOpen {
+		return []string{
+			components.Hint("↑/↓", "Select"),
+			components.Hint("r", "Retry Failed"),
+			components.Hint("x", "Clear Finished"),
+			components.Hint("esc", "Back"),
+		}
+	}
+	if a.notifOpen {
+		return []string{
+			components.Hint("↑/↓", "Select"),
+			components.Hint("x", "Clear"),
+			components.Hint("esc", "Back"),
+		}
+	}
+	if a.watchOpen {
+		return []string{
+			components.Hint("↑/↓", "Select"),
+			components.Hint("enter", "Open"),
+			components.Hint("x", "Mark All Read"),
+			components.Hint("esc", "Back"),
+		}
+	}
+	if a.awayOpen {
+		return []string{
+			components.Hint("enter", "Open Inbox"),
+			components.Hint("esc", "Dismiss"),
+		}
+	}
+	if a.reconcileOpen {
+		return []string{components.Hint("esc", "Dismiss")}
+	}
+	if a.trashOpen {
+		if a.trash.confirmDelete {
+			return []string{
+				components.Hint("enter", "Delete Forever"),
+				components.Hint("esc", "Cancel"),
+			}
+		}
+		return []string{
+			components.Hint("↑/↓", "Select"),
+			components.Hint("space", "Mark"),
+			components.Hint("b", "Mark All"),
+			components.Hint("r", "Restore"),
+			components.Hint("D", "Delete Forever"),
+			components.Hint("esc", "Back"),
+		}
+	}
+	if a.columnPicker != nil {
+		return []string{
+			components.Hint("↑/↓", "Select"),
+			components.Hint("space", "Show/Hide"),
+			components.Hint("shift+↑/↓", "Reorder"),
+			components.Hint("r", "Reset"),
+			components.Hint("enter", "Apply"),
+			components.Hint("esc", "Cancel"),
+		}
+	}
 	if a.onboarding {
 		if a.onboardingBusy {
 			return []string{
@@ -904,6 +1543,9 @@ func (a App) statusHints() []string {
 		}
 	}
 	hints := a.statusHintsForTab()
+	if len(a.ops) > 0 {
+		hints = append(hints, components.Hint("ctrl+o", "Operations"))
+	}
 	if a.showRecoveryHints {
 		hints = append(hints,
 			components.Hint("r", "Re-login"),
@@ -919,10 +1561,28 @@ func (a App) statusHintsForTab() []string {
 	base := []string{
 		components.Hint("1-9/0", "Tabs"),
 		components.Hint("/", "Command"),
+		components.Hint("R", "Refresh"),
 		components.Hint("?", "Help"),
 		components.Hint("q", "Quit"),
 		components.Hint("ctrl+u/d", "View"),
 	}
+	if len(a.nav.entries) > 1 && a.navKeysAllowed() {
+		base = append(base, components.Hint("[/]", "Back/Fwd"))
+	}
+	if len(a.notifications) > 0 {
+		label := "Notifications"
+		if a.notifUnseen > 0 {
+			label = fmt.Sprintf("Notifications (%d)", a.notifUnseen)
+		}
+		// Keep it next to Command so narrow status bars do not clip it.
+		base = append(base[:2], append([]string{components.Hint("N", label)}, base[2:]...)...)
+	}
+	if n := len(a.watchEvents); n > 0 {
+		base = append(base[:2], append([]string{components.Hint("W", fmt.Sprintf("Watching (%d)", n))}, base[2:]...)...)
+	}
+	if _, failed := a.loadFailures[a.tab]; failed && a.browsing() {
+		base = append([]string{components.Hint("r", "Retry")}, base...)
+	}
 
 	switch a.tab {
 	case tabInbox:
@@ -939,18 +1599,35 @@ func (a App) statusHintsForTab() []string {
 				components.Hint("esc", "Clear"),
 			)
 		}
-		if a.inbox.rejecting {
+		if a.inbox.commenting && a.inbox.detail != nil {
+			return append(base,
+				components.Hint("enter", "Send"),
+				components.Hint("tab", "Reply To"),
+				components.Hint("esc", "Cancel"),
+			)
+		}
+		if a.inbox.rejecting || a.inbox.triagePrompt != triagePromptNone {
 			return append(base,
 				components.Hint("enter", "Submit"),
 				components.Hint("esc", "Cancel"),
 			)
 		}
 		if a.inbox.detail != nil {
-			return append(base,
+			hints := append(base,
 				components.Hint("a", "Approve"),
 				components.Hint("r", "Reject"),
-				components.Hint("esc", "Back"),
+				components.Hint("m", "Comment"),
+				components.Hint("z", "Snooze"),
+				components.Hint("d", "Delegate"),
 			)
+			if _, ok := approvalLiveRecordTarget(*a.inbox.detail); ok {
+				label := "Compare"
+				if a.inbox.comparing {
+					label = "Changes"
+				}
+				hints = append(hints, components.Hint("c", label))
+			}
+			return append(hints, components.Hint("esc", "Back"))
 		}
 		return append(base,
 			components.Hint("↑/↓", "Scroll"),
@@ -960,12 +1637,43 @@ func (a App) statusHintsForTab() []string {
 			components.Hint("a", "Approve"),
 			components.Hint("r", "Reject"),
 			components.Hint("enter", "Details"),
+			components.Hint("z", "Snooze"),
+			components.Hint("d", "Delegate"),
 			components.Hint("f", "Filter"),
+			components.Hint("T", "Time Range"),
+			components.Hint("ctrl+k", "Columns"),
 		)
 	case tabEntities:
+		if a.entities.collectionPick != nil {
+			return append(base,
+				components.Hint("↑/↓", "Collection"),
+				components.Hint("enter", "Add"),
+				components.Hint("esc", "Cancel"),
+			)
+		}
+		if a.entities.bulkPreview != nil {
+			return append(base,
+				components.Hint("enter", "Commit"),
+				components.Hint("esc", "Back"),
+			)
+		}
 		if a.entities.bulkPrompt != "" {
 			return append(base,
-				components.Hint("enter", "Apply"),
+				components.Hint("enter", "Preview"),
+				components.Hint("esc", "Cancel"),
+			)
+		}
+		if a.entities.bulkEdit.open {
+			if a.entities.bulkEdit.preview != nil {
+				return append(base,
+					components.Hint("enter", "Commit"),
+					components.Hint("esc", "Back"),
+				)
+			}
+			return append(base,
+				components.Hint("↑/↓", "Fields"),
+				components.Hint("←/→", "Cycle"),
+				components.Hint("enter", "Preview"),
 				components.Hint("esc", "Cancel"),
 			)
 		}
@@ -975,8 +1683,19 @@ func (a App) statusHintsForTab() []string {
 				components.Hint("esc", "Clear"),
 			)
 		}
+		if a.entities.queryForm != nil {
+			return append(base,
+				components.Hint("↑/↓", "Fields"),
+				components.Hint("enter", "Apply"),
+				components.Hint("ctrl+r", "Reset"),
+				components.Hint("esc", "Cancel"),
+			)
+		}
 		switch a.entities.view {
 		case entitiesViewDetail:
+			if a.commentsFocused() {
+				return a.entities.comments.hints()
+			}
 			if a.entities.metaExpanded {
 				return append(base,
 					components.Hint("↑/↓", "Meta Row"),
@@ -997,6 +1716,11 @@ func (a App) statusHintsForTab() []string {
 				components.Hint("h", "History"),
 				components.Hint("r", "Relationships"),
 				components.Hint("m", "Metadata"),
+				components.Hint("x", "Copy Bundle"),
+				components.Hint("C", "Comments"),
+				components.Hint("*", "Pin"),
+				components.Hint("w", "Watch"),
+				components.Hint("p", "View As Scope"),
 				components.Hint("d", "Archive"),
 				components.Hint("esc", "Back"),
 			)
@@ -1011,6 +1735,7 @@ func (a App) statusHintsForTab() []string {
 		case entitiesViewRelationships:
 			return append(base,
 				components.Hint("↑/↓", "Scroll"),
+				components.Hint("enter", "Open"),
 				components.Hint("n", "New"),
 				components.Hint("e", "Edit"),
 				components.Hint("d", "Archive"),
@@ -1029,6 +1754,7 @@ func (a App) statusHintsForTab() []string {
 			)
 		case entitiesViewRelateType:
 			return append(base,
+				components.Hint("tab", "Complete"),
 				components.Hint("enter", "Create"),
 				components.Hint("esc", "Back"),
 			)
@@ -1041,16 +1767,43 @@ func (a App) statusHintsForTab() []string {
 				components.Hint("esc", "Cancel"),
 			)
 		case entitiesViewAdd:
-			return append(base,
+			if a.entities.addTemplate.open {
+				return append(base,
+					components.Hint("←/→", "Template"),
+					components.Hint("enter", "Apply"),
+					components.Hint("esc", "Cancel"),
+				)
+			}
+			hints := append(base,
 				components.Hint("↑/↓", "Fields"),
 				components.Hint("←/→", "Cycle"),
 				components.Hint("space", "Select"),
 				components.Hint("ctrl+s", "Save"),
 				components.Hint("esc", "Back"),
 			)
+			if len(a.entities.addTemplate.templates) > 0 {
+				hints = append(hints, components.Hint("ctrl+t", "Template"))
+			}
+			return hints
+		case entitiesViewDuplicates:
+			return append(base,
+				components.Hint("↑/↓", "Scroll"),
+				components.Hint("enter", "Open Existing"),
+				components.Hint("c", "Create Anyway"),
+				components.Hint("esc", "Back"),
+			)
+		case entitiesViewEditConflict:
+			return append(base, editConflictHints()...)
 		case entitiesViewHistory:
 			return append(base,
 				components.Hint("↑/↓", "Scroll"),
+				components.Hint("t", "As Of"),
+				components.Hint("enter", "Revert"),
+				components.Hint("esc", "Back"),
+			)
+		case entitiesViewTimeTravel:
+			return append(base,
+				components.Hint("d", "Diff vs Now"),
 				components.Hint("enter", "Revert"),
 				components.Hint("esc", "Back"),
 			)
@@ -1060,6 +1813,15 @@ func (a App) statusHintsForTab() []string {
 				components.Hint("esc", "Back"),
 			)
 		case entitiesViewConfirm:
+			if a.entities.confirmKind == "entity-revert" && len(a.entities.revertFields) > 0 {
+				return append(base,
+					components.Hint("↑/↓", "Fields"),
+					components.Hint("space", "Toggle"),
+					components.Hint("a", "All"),
+					components.Hint("enter", "Revert"),
+					components.Hint("esc", "Cancel"),
+				)
+			}
 			return append(base,
 				components.Hint("enter", "Confirm"),
 				components.Hint("esc", "Cancel"),
@@ -1071,17 +1833,31 @@ func (a App) statusHintsForTab() []string {
 				components.Hint("tab", "Complete"),
 				components.Hint("enter", "Details"),
 				components.Hint("f", "Filter"),
+				components.Hint("F", "Advanced Filters"),
+				components.Hint("ctrl+p", "Detail Pane"),
+				components.Hint("ctrl+b", "Select Matching"),
+				components.Hint("ctrl+a", "Archived"),
+				components.Hint("ctrl+x", "Trash"),
+				components.Hint("*", "Pin"),
+				components.Hint("w", "Watch"),
 			)
-			if strings.TrimSpace(a.entities.searchBuf) == "" {
+			if strings.TrimSpace(a.entities.searchBuf.Value) == "" {
 				hints = append(hints, components.Hint("space", "Select"))
 			}
 			if a.entities.bulkCount() > 0 {
 				hints = append(hints,
 					components.Hint("t", "Tags"),
 					components.Hint("p", "Scopes"),
+					components.Hint("E", "Bulk Edit"),
+					components.Hint("C", "Collection"),
 					components.Hint("c", "Clear"),
 				)
 			}
+			if !a.entities.query.empty() && strings.TrimSpace(a.entities.searchBuf.Value) == "" {
+				hints = append(hints, components.Hint("esc", "Clear Filters"))
+			} else if a.entities.collection != nil && strings.TrimSpace(a.entities.searchBuf.Value) == "" {
+				hints = append(hints, components.Hint("esc", "All Entities"))
+			}
 			return hints
 		}
 	case tabRelations:
@@ -1094,6 +1870,7 @@ func (a App) statusHintsForTab() []string {
 		switch a.rels.view {
 		case relsViewDetail:
 			return append(base,
+				components.Hint("s/t", "Source/Target"),
 				components.Hint("e", "Edit"),
 				components.Hint("d", "Archive"),
 				components.Hint("esc", "Back"),
@@ -1121,6 +1898,7 @@ func (a App) statusHintsForTab() []string {
 		case relsViewCreateType:
 			return append(base,
 				components.Hint("↑/↓", "Scroll"),
+				components.Hint("tab", "Complete"),
 				components.Hint("enter", "Create"),
 				components.Hint("esc", "Back"),
 			)
@@ -1130,6 +1908,9 @@ func (a App) statusHintsForTab() []string {
 				components.Hint("enter", "Details"),
 				components.Hint("n", "New"),
 				components.Hint("f", "Filter"),
+				components.Hint("ctrl+p", "Detail Pane"),
+				components.Hint("ctrl+a", "Archived"),
+				components.Hint("ctrl+x", "Trash"),
 			)
 		}
 	case tabKnow:
@@ -1148,27 +1929,116 @@ func (a App) statusHintsForTab() []string {
 		}
 		switch a.know.view {
 		case contextViewList:
-			return append(base,
+			if a.know.bulk.prompt != "" {
+				return append(base,
+					components.Hint("enter", "Apply"),
+					components.Hint("esc", "Cancel"),
+				)
+			}
+			hints := append(base,
 				components.Hint("↑/↓", "Scroll"),
 				components.Hint("enter", "Details"),
+				components.Hint("space", "Select"),
 				components.Hint("f", "Filter"),
-				components.Hint("esc", "Back"),
+				components.Hint("o/O", "Sort"),
+				components.Hint("ctrl+k", "Columns"),
+				components.Hint("*", "Pin"),
+				components.Hint("w", "Watch"),
 			)
+			if a.know.bulk.count() > 0 {
+				return append(hints,
+					components.Hint("t", "Tags"),
+					components.Hint("p", "Scopes"),
+					components.Hint("s", "Status"),
+					components.Hint("c", "Clear"),
+				)
+			}
+			return append(hints, components.Hint("esc", "Back"))
 		case contextViewDetail:
+			if p := a.know.pager; p != nil {
+				// The pager takes over q and /, so the global hints do not apply.
+				if p.searching {
+					return []string{
+						components.Hint("enter", "Keep Search"),
+						components.Hint("esc", "Clear"),
+					}
+				}
+				return []string{
+					components.Hint("↑/↓", "Scroll"),
+					components.Hint("space/b", "Page"),
+					components.Hint("g/G", "Top/Bottom"),
+					components.Hint("/", "Search"),
+					components.Hint("n/N", "Next/Prev"),
+					components.Hint("esc/q", "Close"),
+				}
+			}
+			if a.commentsFocused() {
+				return a.know.comments.hints()
+			}
 			return append(base,
 				components.Hint("m", "Metadata"),
 				components.Hint("c", "Content"),
 				components.Hint("v", "Source"),
+				components.Hint("l", "Links"),
+				components.Hint("C", "Comments"),
+				components.Hint("*", "Pin"),
+				components.Hint("w", "Watch"),
+				components.Hint("p", "View As Scope"),
 				components.Hint("esc", "Back"),
 			)
-		default:
+		case contextViewLinks:
+			if a.know.unlinkConfirm {
+				return append(base,
+					components.Hint("y", "Unlink"),
+					components.Hint("n", "Cancel"),
+				)
+			}
 			return append(base,
+				components.Hint("↑/↓", "Scroll"),
+				components.Hint("a", "Link Entity"),
+				components.Hint("d", "Unlink"),
+				components.Hint("esc", "Back"),
+			)
+		case contextViewEditConflict:
+			return append(base, editConflictHints()...)
+		case contextViewDuplicate:
+			return append(base,
+				components.Hint("o", "Open Existing"),
+				components.Hint("s", "Save Anyway"),
+				components.Hint("m", "Merge Tags"),
+				components.Hint("esc", "Back"),
+			)
+		default:
+			if a.know.template.open {
+				return append(base,
+					components.Hint("←/→", "Template"),
+					components.Hint("enter", "Apply"),
+					components.Hint("esc", "Cancel"),
+				)
+			}
+			if a.know.urlPreview != nil {
+				return append(base,
+					components.Hint("y", "Apply Fetched"),
+					components.Hint("n", "Discard"),
+				)
+			}
+			hints := append(base,
 				components.Hint("↑/↓", "Fields"),
 				components.Hint("←/→", "Cycle"),
 				components.Hint("space", "Select"),
 				components.Hint("ctrl+s", "Save"),
 				components.Hint("esc", "Cancel"),
 			)
+			if len(a.know.template.templates) > 0 {
+				hints = append(hints, components.Hint("ctrl+t", "Template"))
+			}
+			if a.know.focus == fieldURL {
+				hints = append(hints, components.Hint("enter", "Fetch URL"))
+			}
+			if a.know.focus == fieldTags && a.know.tagBuf.Value == "" && len(a.know.contentTagSuggestions()) > 0 {
+				hints = append(hints, components.Hint("enter", "Toggle Suggestion"))
+			}
+			return hints
 		}
 	case tabJobs:
 		if a.jobs.filtering {
@@ -1177,21 +2047,41 @@ func (a App) statusHintsForTab() []string {
 				components.Hint("esc", "Clear"),
 			)
 		}
-		if a.jobs.view == jobsViewAdd || a.jobs.view == jobsViewEdit {
+		if a.jobs.view == jobsViewAdd && a.jobs.tmplPending != nil {
+			return append(base,
+				components.Hint("enter", "Next"),
+				components.Hint("esc", "Cancel"),
+			)
+		}
+		if a.jobs.view == jobsViewAdd && a.jobs.addTemplate.open {
 			return append(base,
+				components.Hint("←/→", "Template"),
+				components.Hint("enter", "Apply"),
+				components.Hint("esc", "Cancel"),
+			)
+		}
+		if a.jobs.view == jobsViewAdd || a.jobs.view == jobsViewEdit {
+			hints := append(base,
 				components.Hint("↑/↓", "Fields"),
 				components.Hint("←/→", "Cycle"),
 				components.Hint("space", "Select"),
 				components.Hint("ctrl+s", "Save"),
 				components.Hint("esc", "Cancel"),
 			)
+			if a.jobs.view == jobsViewAdd && len(a.jobs.addTemplate.templates) > 0 {
+				hints = append(hints, components.Hint("ctrl+t", "Template"))
+			}
+			return hints
 		}
 		if a.jobs.detail != nil {
 			return append(base,
 				components.Hint("s", "Status"),
+				components.Hint("c", "Checklist"),
 				components.Hint("n", "Subtask"),
 				components.Hint("l", "Link"),
 				components.Hint("u", "Unlink"),
+				components.Hint("x", "Cancel Job"),
+				components.Hint("y", "Retry"),
 				components.Hint("esc", "Back"),
 			)
 		}
@@ -1228,11 +2118,30 @@ func (a App) statusHintsForTab() []string {
 				components.Hint("esc", "Back"),
 			)
 		default:
+			if a.logs.groupBy != "" {
+				hints := []string{
+					components.Hint("↑/↓", "Scroll"),
+					components.Hint("enter", "Expand/Open"),
+				}
+				if a.logs.groupBy == logGroupByJob {
+					hints = append(hints, components.Hint("J", "Jump to Job"))
+				}
+				return append(append(base, hints...),
+					components.Hint("G", "Group"),
+					components.Hint("L", "Level"),
+					components.Hint("F", "Follow"),
+					components.Hint("esc", "Ungroup"),
+				)
+			}
 			return append(base,
 				components.Hint("↑/↓", "Scroll"),
 				components.Hint("tab", "Complete"),
 				components.Hint("enter", "Details"),
 				components.Hint("f", "Filter"),
+				components.Hint("T", "Time Range"),
+				components.Hint("G", "Group"),
+				components.Hint("L", "Level"),
+				components.Hint("F", "Follow"),
 			)
 		}
 	case tabFiles:
@@ -1242,28 +2151,73 @@ func (a App) statusHintsForTab() []string {
 				components.Hint("esc", "Clear"),
 			)
 		}
+		if a.files.attach != nil {
+			return append(base,
+				components.Hint("↑/↓", "Select"),
+				components.Hint("enter", "Attach"),
+				components.Hint("esc", "Cancel"),
+			)
+		}
 		switch a.files.view {
 		case filesViewDetail:
 			return append(base,
 				components.Hint("e", "Edit"),
+				components.Hint("a", "Attach"),
 				components.Hint("m", "Metadata"),
 				components.Hint("esc", "Back"),
 			)
-		case filesViewAdd, filesViewEdit:
+		case filesViewAdd:
 			return append(base,
 				components.Hint("↑/↓", "Fields"),
 				components.Hint("←/→", "Cycle"),
 				components.Hint("space", "Select"),
+				components.Hint("ctrl+f", "Probe Path"),
 				components.Hint("ctrl+s", "Save"),
 				components.Hint("esc", "Back"),
 			)
-		default:
+		case filesViewEdit:
+			return append(base,
+				components.Hint("↑/↓", "Fields"),
+				components.Hint("←/→", "Cycle"),
+				components.Hint("space", "Select"),
+				components.Hint("ctrl+s", "Save"),
+				components.Hint("esc", "Back"),
+			)
+		case filesViewVerify:
 			return append(base,
+				components.Hint("↑/↓", "Scroll"),
+				components.Hint("enter", "Open"),
+				components.Hint("u", "Re-register Drifted"),
+				components.Hint("r", "Check Again"),
+				components.Hint("esc", "Back"),
+			)
+		default:
+			if a.files.bulk.prompt != "" {
+				return append(base,
+					components.Hint("enter", "Apply"),
+					components.Hint("esc", "Cancel"),
+				)
+			}
+			hints := append(base,
 				components.Hint("↑/↓", "Scroll"),
 				components.Hint("tab", "Complete"),
 				components.Hint("enter", "Details"),
 				components.Hint("f", "Filter"),
+				components.Hint("o/O", "Sort"),
+				components.Hint("V", "Verify"),
+				components.Hint("ctrl+k", "Columns"),
 			)
+			if strings.TrimSpace(a.files.searchBuf.Value) == "" {
+				hints = append(hints, components.Hint("space", "Select"))
+			}
+			if a.files.bulk.count() > 0 {
+				hints = append(hints,
+					components.Hint("t", "Tags"),
+					components.Hint("s", "Status"),
+					components.Hint("c", "Clear"),
+				)
+			}
+			return hints
 		}
 	case tabProtocols:
 		if a.protocols.filtering {
@@ -1276,6 +2230,36 @@ func (a App) statusHintsForTab() []string {
 		case protocolsViewDetail:
 			return append(base,
 				components.Hint("e", "Edit"),
+				components.Hint("h", "Versions"),
+				components.Hint("t", "Test"),
+				components.Hint("esc", "Back"),
+			)
+		case protocolsViewDryRun:
+			if a.protocols.dryRunInput.Active {
+				return base
+			}
+			return append(base,
+				components.Hint("i", "Input"),
+				components.Hint("r", "Run"),
+				components.Hint("esc", "Back"),
+			)
+		case protocolsViewVersions:
+			switch {
+			case a.protocols.rollbackConfirm:
+				return append(base,
+					components.Hint("enter", "Roll Back"),
+					components.Hint("esc", "Cancel"),
+				)
+			case a.protocols.versionDiff != nil:
+				return append(base,
+					components.Hint("esc", "Back"),
+				)
+			}
+			return append(base,
+				components.Hint("↑/↓", "Scroll"),
+				components.Hint("space", "Mark"),
+				components.Hint("enter", "Diff"),
+				components.Hint("r", "Roll Back"),
 				components.Hint("esc", "Back"),
 			)
 		case protocolsViewEdit, protocolsViewAdd:
@@ -1312,16 +2296,84 @@ func (a App) statusHintsForTab() []string {
 				components.Hint("esc", "Back"),
 			)
 		}
+		if a.history.view == historyViewSummary {
+			return append(base,
+				components.Hint("T", "Time Range"),
+				components.Hint("esc", "Back"),
+			)
+		}
 		return append(base,
 			components.Hint("↑/↓", "Scroll"),
 			components.Hint("enter", "Details"),
 			components.Hint("f", "Filter"),
+			components.Hint("T", "Time Range"),
+			components.Hint("o/O", "Sort"),
 			components.Hint("s", "Scopes"),
 			components.Hint("a", "Actors"),
+			components.Hint("S", "Summary"),
+		)
+	case tabActivity:
+		return append(base,
+			components.Hint("↑/↓", "Scroll"),
+			components.Hint("enter", "Open"),
+		)
+	case tabCollections:
+		c := a.collections
+		if c.prompt != "" {
+			return append(base,
+				components.Hint("enter", "Save"),
+				components.Hint("esc", "Cancel"),
+			)
+		}
+		if c.confirmDelete {
+			return append(base,
+				components.Hint("y", "Delete"),
+				components.Hint("n", "Cancel"),
+			)
+		}
+		if c.view == collectionsViewDetail {
+			return append(base,
+				components.Hint("↑/↓", "Scroll"),
+				components.Hint("enter", "Open"),
+				components.Hint("d", "Remove"),
+				components.Hint("f", "Filter Entities"),
+				components.Hint("x", "Export"),
+				components.Hint("esc", "Back"),
+			)
+		}
+		return append(base,
+			components.Hint("↑/↓", "Scroll"),
+			components.Hint("enter", "Open"),
+			components.Hint("n", "New"),
+			components.Hint("e", "Rename"),
+			components.Hint("d", "Delete"),
+			components.Hint("f", "Filter Entities"),
+			components.Hint("x", "Export"),
 		)
 	case tabProfile:
+		if a.profile.permEditing && a.profile.permConfirm {
+			return append(base,
+				components.Hint("enter", "Apply"),
+				components.Hint("esc", "Back"),
+			)
+		}
+		if a.profile.permEditing {
+			return append(base,
+				components.Hint("↑/↓", "Field"),
+				components.Hint("ctrl+s", "Review"),
+				components.Hint("esc", "Cancel"),
+			)
+		}
+		if a.profile.editDefaults {
+			return append(base,
+				components.Hint("↑/↓", "Field"),
+				components.Hint("enter", "Save"),
+				components.Hint("esc", "Cancel"),
+			)
+		}
 		if a.profile.agentDetail != nil {
 			return append(base,
+				components.Hint("e", "Permissions"),
 				components.Hint("esc", "Back"),
 			)
 		}
@@ -1330,6 +2382,10 @@ func (a App) statusHintsForTab() []string {
 			components.Hint("←/→", "Section"),
 			components.Hint("k", "API Key"),
 			components.Hint("p", "Queue Limit"),
+			components.Hint("v", "Vim Keys"),
+			components.Hint("A", "Screen Reader"),
+			components.Hint("b", "Approval Alerts"),
+			components.Hint("c", "Create Defaults"),
 		}
 		switch a.profile.section {
 		case 0:
@@ -1342,8 +2398,38 @@ func (a App) statusHintsForTab() []string {
 				components.Hint("enter", "Details"),
 				components.Hint("t", "Toggle Trust"),
 			)
+		case profileSectionTags:
+			switch a.profile.tagPromptMode {
+			case tagPromptNone:
+				hints = append(hints,
+					components.Hint("r", "Rename"),
+					components.Hint("m", "Merge"),
+					components.Hint("d", "Delete Unused"),
+					components.Hint("f", "Filter"),
+				)
+			case tagPromptDeleteConfirm:
+				hints = append(hints,
+					components.Hint("enter", "Delete"),
+					components.Hint("esc", "Cancel"),
+				)
+			default:
+				hints = append(hints,
+					components.Hint("enter", "Apply"),
+					components.Hint("esc", "Cancel"),
+				)
+			}
+		case profileSectionWebhooks:
+			hints = append(hints,
+				components.Hint("r", "Reload Log"),
+				components.Hint("x", "Send Test"),
+			)
 		default:
-			if a.profile.taxPromptMode != taxPromptNone {
+			if a.profile.taxPromptMode == taxPromptMergeConfirm {
+				hints = append(hints,
+					components.Hint("enter", "Merge"),
+					components.Hint("esc", "Cancel"),
+				)
+			} else if a.profile.taxPromptMode != taxPromptNone {
 				hints = append(hints,
 					components.Hint("enter", "Apply"),
 					components.Hint("esc", "Cancel"),
@@ -1358,6 +2444,15 @@ func (a App) statusHintsForTab() []string {
 					components.Hint("f", "Filter"),
 					components.Hint("i", "Inactive"),
 				)
+				if a.profile.taxonomyKindPath() == "scopes" {
+					hints = append(hints, components.Hint("m", "Merge"))
+				}
+				if a.profile.taxonomyKindPath() == "entity-types" {
+					hints = append(hints, components.Hint("s", "Schema"))
+				}
+				if a.profile.taxonomyKindPath() == "relationship-types" {
+					hints = append(hints, components.Hint("s", "Rules"))
+				}
 			}
 		}
 		return append(base, hints...)
@@ -1373,6 +2468,9 @@ func (a App) renderTips() string {
 // renderHelp renders render help.
 func (a App) renderHelp() string {
 	hints := a.statusHintsForTab()
+	if a.vimEnabled() {
+		hints = append(hints, vimHints()...)
+	}
 	lines := make([]string, 0, len(hints)+2)
 	lines = append(lines, MutedStyle.Render("esc to close"))
 	lines = append(lines, "")
@@ -1386,6 +2484,9 @@ func (a App) renderHelp() string {
 // renderQuitConfirm renders render quit confirm.
 func (a App) renderQuitConfirm() string {
 	body := "You have unsaved changes. Quit anyway?"
+	if running, _ := a.operationCounts(); running > 0 {
+		body = fmt.Sprintf("Background operations still running: %d. Quit anyway?", running)
+	}
 	return components.Indent(components.ConfirmDialog("Quit", body), 1)
 }
 
@@ -1397,10 +2498,12 @@ func (a App) runStartupCheckCmd() tea.Cmd {
 			checkClient = a.client.WithTimeout(700 * time.Millisecond)
 		} else {
 			apiKey := ""
+			baseURL := ""
 			if a.config != nil {
 				apiKey = a.config.APIKey
+				baseURL = a.config.APIURL
 			}
-			checkClient = api.NewDefaultClient(apiKey, 700*time.Millisecond)
+			checkClient = api.NewClient(api.ResolveBaseURL(baseURL), apiKey, 700*time.Millisecond)
 		}
 
 		msg := startupCheckedMsg{}
@@ -1425,6 +2528,11 @@ func (a *App) reloginCmd() tea.Cmd {
 			return errMsg{err: fmt.Errorf("re-login unavailable; run nebula login")}
 		}
 	}
+	if a.config.UsesSSO() {
+		return func() tea.Msg {
+			return errMsg{err: fmt.Errorf("sso session expired; run nebula login --sso")}
+		}
+	}
 	username := strings.TrimSpace(a.config.Username)
 	if username == "" {
 		return func() tea.Msg {
@@ -1442,11 +2550,18 @@ func (a *App) reloginCmd() tea.Cmd {
 
 // setToast sets set toast.
 func (a *App) setToast(level, text string) tea.Cmd {
+	text = components.SanitizeOneLine(text)
+	a.notify(level, text)
+	timeout := a.config.ToastTimeout()
+	if timeout <= 0 {
+		a.toast = nil
+		return nil
+	}
 	a.toast = &appToast{
 		level: level,
-		text:  components.SanitizeOneLine(text),
+		text:  text,
 	}
-	return tea.Tick(2500*time.Millisecond, func(time.Time) tea.Msg {
+	return tea.Tick(timeout, func(time.Time) tea.Msg {
 		return clearToastMsg{}
 	})
 }
@@ -1548,6 +2663,10 @@ func (a *App) toastCmdForMsg(msg tea.Msg) tea.Cmd {
 		level, text = "success", "Job status updated."
 	case subtaskCreatedMsg:
 		level, text = "success", "Subtask created."
+	case jobCancelledMsg:
+		level, text = "success", "Job cancelled."
+	case jobRetriedMsg:
+		level, text = "success", fmt.Sprintf("Retry job %s created.", typed.job.ID)
 	case logCreatedMsg, logUpdatedMsg:
 		level, text = "success", "Log saved."
 	case fileCreatedMsg, fileUpdatedMsg:
@@ -1556,6 +2675,14 @@ func (a *App) toastCmdForMsg(msg tea.Msg) tea.Cmd {
 		level, text = "success", "Protocol saved."
 	case entityMetadataCopiedMsg:
 		level, text = "success", fmt.Sprintf("Copied %d metadata value(s).", typed.count)
+	case entityBundleCopiedMsg:
+		level, text = "success", fmt.Sprintf("Copied context bundle (~%d tokens).", typed.tokens)
+	case inboxTriageSavedMsg:
+		level, text = "success", typed.notice
+	case collectionSavedMsg:
+		level, text = "success", typed.notice
+	case entityCollectionAddedMsg:
+		level, text = "success", typed.notice
 	}
 	if text == "" {
 		return nil
@@ -1609,25 +2736,16 @@ func (a *App) handleOnboardingKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return *a, nil
 	}
 	if isEnter(msg) {
-		username := strings.TrimSpace(a.onboardingName)
+		username := strings.TrimSpace(a.onboardingName.Value)
 		if username == "" {
-			a.err = "username is required"
+			a.setError(errors.New("username is required"))
 			return *a, nil
 		}
-		a.err = ""
+		a.clearError()
 		a.onboardingBusy = true
 		return *a, a.onboardingLoginCmd(username)
 	}
-	if isKey(msg, "backspace", "ctrl+h", "delete", "backspace2") {
-		runes := []rune(a.onboardingName)
-		if len(runes) > 0 {
-			a.onboardingName = string(runes[:len(runes)-1])
-		}
-		return *a, nil
-	}
-	if msg.Type == tea.KeyRunes {
-		a.onboardingName += msg.String()
-	}
+	a.onboardingName.HandleKey(msg)
 	return *a, nil
 }
 
@@ -1646,13 +2764,9 @@ func (a *App) onboardingLoginCmd(username string) tea.Cmd {
 
 // renderOnboarding renders render onboarding.
 func (a App) renderOnboarding() string {
-	prompt := components.SanitizeOneLine(strings.TrimSpace(a.onboardingName))
-	if prompt == "" {
-		prompt = ""
-	}
-	line := "> " + prompt
+	line := "> " + components.SanitizeOneLine(strings.TrimSpace(a.onboardingName.Value))
 	if !a.onboardingBusy {
-		line += "█"
+		line = "> " + a.onboardingName.View(AccentStyle, 0)
 	}
 	status := MutedStyle.Render("Enter your Nebula username to create or resume your local session.")
 	hint := MutedStyle.Render("Press Enter to login.")
@@ -1677,9 +2791,7 @@ func (a *App) finishQuickstart(skipped bool) (tea.Model, tea.Cmd) {
 	if a.config != nil {
 		a.config.QuickstartPending = false
 		if err := a.config.Save(); err != nil {
-			a.err = fmt.Sprintf("save config: %v", err)
-			a.lastErrCode, a.lastErrMsg = parseErrorCodeAndMessage(a.err)
-			a.showRecoveryHints = shouldShowRecoveryHints(a.lastErrCode, a.lastErrMsg)
+			a.setError(fmt.Errorf("save config: %w", err))
 			return *a, nil
 		}
 	}
@@ -1744,29 +2856,6 @@ func parseErrorCodeAndMessage(errText string) (string, string) {
 	return code, strings.TrimSpace(parts[1])
 }
 
-// shouldShowRecoveryHints handles should show recovery hints.
-func shouldShowRecoveryHints(code, msg string) bool {
-	normalized := strings.ToUpper(strings.TrimSpace(code))
-	switch normalized {
-	case "FORBIDDEN":
-		lower := strings.ToLower(msg)
-		return strings.Contains(lower, "scope") || strings.Contains(lower, "admin")
-	case "INVALID_API_KEY", "AUTH_REQUIRED", "UNAUTHORIZED":
-		return true
-	}
-	lower := strings.ToLower(msg)
-	if strings.Contains(lower, "invalid api key") ||
-		strings.Contains(lower, "invalid_api_key") ||
-		strings.Contains(lower, "auth_required") ||
-		strings.Contains(lower, "not logged in") {
-		return true
-	}
-	if strings.Contains(lower, "missing or invalid authorization") || strings.Contains(lower, "http 401") {
-		return true
-	}
-	return false
-}
-
 // shouldShowMultiAPIRecoveryHint handles should show multi api recovery hint.
 func shouldShowMultiAPIRecoveryHint(code, msg, errText string) bool {
 	normalized := strings.ToUpper(strings.TrimSpace(code))
@@ -1877,17 +2966,18 @@ func startupStatusColor(status string) string {
 func (a *App) openPaletteCommand() {
 	a.paletteOpen = true
 	// Open in explicit command mode. Users can backspace this to switch to search mode.
-	a.paletteQuery = "/"
+	a.paletteQuery.SetValue("/")
 	a.paletteIndex = 0
 	a.paletteSearchQuery = ""
 	a.paletteSearchLoading = false
 	a.paletteSelections = nil
-	a.paletteFiltered = filterPalette(a.paletteActions, "")
+	a.paletteVerb = nil
+	a.paletteFiltered = append(a.pinnedPaletteActions(""), filterPalette(a.paletteActions, "")...)
 }
 
 // paletteCommandMode handles palette command mode.
 func (a App) paletteCommandMode() bool {
-	query := strings.TrimSpace(a.paletteQuery)
+	query := strings.TrimSpace(a.paletteQuery.Value)
 	return strings.HasPrefix(query, "/")
 }
 
@@ -1901,9 +2991,11 @@ func (a App) renderPalette() string {
 		prompt = "Command"
 	}
 
-	query := components.SanitizeOneLine(a.paletteQuery)
+	// The input keeps its cursor while the shown text drops the leading slash.
+	query := a.paletteQuery
+	query.Value = components.SanitizeOneLine(query.Value)
 	if commandMode {
-		query = strings.TrimLeft(query, "/")
+		query.Value = strings.TrimLeft(query.Value, "/")
 	}
 
 	var b strings.Builder
@@ -1911,9 +3003,12 @@ func (a App) renderPalette() string {
 	if queryWidth < 10 {
 		queryWidth = 10
 	}
-	query = components.ClampTextWidthEllipsis(query, queryWidth)
-	b.WriteString(MetaKeyStyle.Render(prompt) + MetaPunctStyle.Render(": ") + SelectedStyle.Render(query))
-	b.WriteString(AccentStyle.Render("█"))
+	b.WriteString(MetaKeyStyle.Render(prompt) + MetaPunctStyle.Render(": ") + query.View(AccentStyle, queryWidth))
+	if !commandMode {
+		if completion := searchCompletion(a.paletteQuery.Value, a.searchTags, a.searchScopes); completion != "" {
+			b.WriteString("  " + MutedStyle.Render("tab: "+components.SanitizeOneLine(completion)))
+		}
+	}
 	b.WriteString("\n\n")
 
 	items := a.paletteFiltered
@@ -1921,13 +3016,16 @@ func (a App) renderPalette() string {
 		b.WriteString(MutedStyle.Render("Searching..."))
 	} else if len(items) == 0 {
 		if commandMode {
-			b.WriteString(MutedStyle.Render("No matching actions."))
-		} else if strings.TrimSpace(a.paletteQuery) == "" {
-			b.WriteString(MutedStyle.Render("Type to search, or prefix with / for commands."))
+			b.WriteString(MutedStyle.Render("No matching actions. Try: approve all from agent:<name> · archive entity <name> · tag <entity> +tag"))
+		} else if strings.TrimSpace(a.paletteQuery.Value) == "" {
+			b.WriteString(MutedStyle.Render("Type to search, or prefix with / for commands. Filter with tag:, scope:, and type:."))
 		} else {
 			b.WriteString(MutedStyle.Render("No search results."))
 		}
 	} else {
+		if !commandMode && strings.TrimSpace(a.paletteQuery.Value) == "" {
+			b.WriteString(MetaKeyStyle.Render("Pinned") + "\n\n")
+		}
 		contentWidth := components.BoxContentWidth(a.width)
 		sepWidth := 1
 		if br := lipgloss.RoundedBorder().Left; br != "" {
@@ -1979,26 +3077,33 @@ func (a App) renderPalette() string {
 
 // refreshPaletteFiltered handles refresh palette filtered.
 func (a *App) refreshPaletteFiltered() tea.Cmd {
-	a.paletteQuery = components.SanitizeOneLine(a.paletteQuery)
+	// Keep one trailing space so multi-word commands can be typed.
+	trailingSpace := strings.HasSuffix(a.paletteQuery.Value, " ")
+	a.paletteQuery.Value = components.SanitizeOneLine(a.paletteQuery.Value)
+	if trailingSpace && a.paletteQuery.Value != "" {
+		a.paletteQuery.Value += " "
+	}
 
 	if a.paletteCommandMode() {
-		query := strings.TrimSpace(strings.TrimLeft(a.paletteQuery, "/"))
+		query := strings.TrimSpace(strings.TrimLeft(a.paletteQuery.Value, "/"))
 		a.paletteSearchQuery = ""
 		a.paletteSearchLoading = false
 		a.paletteSelections = nil
-		a.paletteFiltered = filterPalette(a.paletteActions, query)
+		verbs, verb := paletteVerbActions(query)
+		a.paletteVerb = verb
+		a.paletteFiltered = append(append(verbs, a.pinnedPaletteActions(query)...), filterPalette(a.paletteActions, query)...)
 		if a.paletteIndex >= len(a.paletteFiltered) {
 			a.paletteIndex = 0
 		}
 		return nil
 	}
 
-	query := strings.TrimSpace(a.paletteQuery)
+	query := strings.TrimSpace(a.paletteQuery.Value)
 	if query == "" {
 		a.paletteSearchQuery = ""
 		a.paletteSearchLoading = false
 		a.paletteSelections = nil
-		a.paletteFiltered = nil
+		a.paletteFiltered = a.pinnedPaletteActions("")
 		a.paletteIndex = 0
 		return nil
 	}
@@ -2023,29 +3128,32 @@ func (a App) loadPaletteSearch(query string) tea.Cmd {
 	if a.client == nil {
 		return nil
 	}
+	q := parseSearchQuery(query)
 	return func() tea.Msg {
-		entities, err := a.client.QueryEntities(api.QueryParams{
-			"search_text": query,
-			"limit":       "8",
-		})
-		if err != nil {
-			return errMsg{err}
+		var entities []api.Entity
+		var contextItems []api.Context
+		var jobs []api.Job
+		var err error
+		if q.includes("entity") {
+			if entities, err = a.client.QueryEntities(q.params("entity", "8")); err != nil {
+				return errMsg{err}
+			}
+			entities = q.matchesEntityTypes(entities)
 		}
-
-		contextItems, err := a.client.QueryContext(api.QueryParams{
-			"search_text": query,
-			"limit":       "8",
-		})
-		if err != nil {
-			return errMsg{err}
+		if q.includes("context") {
+			if contextItems, err = a.client.QueryContext(q.params("context", "8")); err != nil {
+				return errMsg{err}
+			}
 		}
-
-		jobs, err := a.client.QueryJobs(api.QueryParams{
-			"search_text": query,
-			"limit":       "8",
-		})
-		if err != nil {
-			return errMsg{err}
+		if q.includes("job") {
+			if jobs, err = a.client.QueryJobs(q.params("job", "8")); err != nil {
+				return errMsg{err}
+			}
+		}
+		// Relationships, logs, files, and protocols have no server search;
+		// filters narrow results to the kinds above.
+		if q.filtered() {
+			return paletteSearchLoadedMsg{query: query, entities: entities, context: contextItems, jobs: jobs}
 		}
 		rels, err := a.client.QueryRelationships(api.QueryParams{
 			"limit": "100",
@@ -2144,7 +3252,7 @@ func (a App) handlePaletteKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 		action := a.paletteFiltered[a.paletteIndex]
 		a.paletteOpen = false
-		a.paletteQuery = ""
+		a.paletteQuery.Reset()
 		return a.runPaletteAction(action)
 	case isUp(msg):
 		if a.paletteIndex > 0 {
@@ -2154,15 +3262,16 @@ func (a App) handlePaletteKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if a.paletteIndex < len(a.paletteFiltered)-1 {
 			a.paletteIndex++
 		}
-	case isKey(msg, "backspace"):
-		if len(a.paletteQuery) > 0 {
-			r := []rune(a.paletteQuery)
-			a.paletteQuery = string(r[:len(r)-1])
+	case isKey(msg, "tab"):
+		if a.paletteCommandMode() {
+			return a, nil
+		}
+		if completion := searchCompletion(a.paletteQuery.Value, a.searchTags, a.searchScopes); completion != "" {
+			a.paletteQuery.SetValue(completion)
 			return a, a.refreshPaletteFiltered()
 		}
 	default:
-		if msg.Type == tea.KeyRunes && len(msg.Runes) > 0 {
-			a.paletteQuery += string(msg.Runes)
+		if editChanged(&a.paletteQuery, msg) {
 			return a, a.refreshPaletteFiltered()
 		}
 	}
@@ -2210,8 +3319,13 @@ func (a *App) runPaletteAction(action paletteAction) (tea.Model, tea.Cmd) {
 			a.protocols.view = protocolsViewDetail
 			a.protocols.detail = &protocol
 		}
+		a.recordNav()
 		return *a, nil
 	}
+	if rest, ok := strings.CutPrefix(action.ID, pinPalettePrefix); ok {
+		kind, id, _ := strings.Cut(rest, ":")
+		return *a, openPin(a.client, kind, id)
+	}
 
 	switch action.ID {
 	case "tab:inbox":
@@ -2226,6 +3340,14 @@ func (a *App) runPaletteAction(action paletteAction) (tea.Model, tea.Cmd) {
 		return a.switchTab(tabJobs)
 	case "tab:history":
 		return a.switchTab(tabHistory)
+	case "tab:dashboard":
+		return a.switchTab(tabDashboard)
+	case "tab:status":
+		return a.switchTab(tabStatus)
+	case "tab:activity":
+		return a.switchTab(tabActivity)
+	case "tab:collections":
+		return a.switchTab(tabCollections)
 	case "tab:settings", "tab:profile":
 		return a.switchTab(tabProfile)
 	case "profile:keys":
@@ -2240,6 +3362,14 @@ func (a *App) runPaletteAction(action paletteAction) (tea.Model, tea.Cmd) {
 		a.tab = tabProfile
 		a.profile.section = 2
 		return *a, nil
+	case "profile:tags":
+		a.tab = tabProfile
+		a.profile.section = profileSectionTags
+		return *a, a.profile.enterTagsSection()
+	case "profile:webhooks":
+		a.tab = tabProfile
+		a.profile.section = profileSectionWebhooks
+		return *a, a.profile.enterWebhooksSection()
 	case "ops:import":
 		a.tabNav = false
 		a.importExportOpen = true
@@ -2250,6 +3380,29 @@ func (a *App) runPaletteAction(action paletteAction) (tea.Model, tea.Cmd) {
 		a.importExportOpen = true
 		a.impex.Start(exportMode)
 		return *a, nil
+	case "ops:queue":
+		a.opsOpen = true
+		a.opsIndex = 0
+		return *a, nil
+	case "ops:trash":
+		return *a, a.openTrash()
+	case "ops:notifications":
+		a.openNotifications()
+		return *a, nil
+	case "ops:watches":
+		a.openWatches()
+		return *a, nil
+	case "ops:verify-files":
+		a.tab = tabFiles
+		a.tabNav = false
+		var cmd tea.Cmd
+		a.files, cmd = a.files.startVerify()
+		return *a, cmd
+	case "verb:run":
+		if a.paletteVerb == nil {
+			return *a, nil
+		}
+		return *a, resolvePaletteVerb(a.client, *a.paletteVerb)
 	case "quit":
 		if a.hasUnsaved() {
 			a.quitConfirm = true
@@ -2257,12 +3410,33 @@ func (a *App) runPaletteAction(action paletteAction) (tea.Model, tea.Cmd) {
 		}
 		return *a, tea.Quit
 	}
+	if name, ok := strings.CutPrefix(action.ID, pluginActionPrefix); ok {
+		return a.runPlugin(name)
+	}
 	return *a, nil
 }
 
+// applyCollectionFilter shows the Entities list narrowed to a collection.
+func (a *App) applyCollectionFilter(collection api.Collection) (tea.Model, tea.Cmd) {
+	a.entities.collection = &collection
+	a.entities.view = entitiesViewList
+	a.entities.detail = nil
+	a.entities.searchBuf.Reset()
+	a.entities.clearBulkSelection()
+	if a.tab == tabEntities {
+		a.tabNav = false
+		a.entities.loading = true
+		return *a, a.entities.loadEntities("")
+	}
+	model, cmd := a.switchTab(tabEntities)
+	model.tabNav = false
+	return model, cmd
+}
+
 // applySearchSelection handles apply search selection.
 func (a *App) applySearchSelection(msg searchSelectionMsg) (tea.Model, tea.Cmd) {
 	a.tabNav = false
+	var cmd tea.Cmd
 	switch msg.kind {
 	case "entity":
 		if msg.entity != nil {
@@ -2270,6 +3444,7 @@ func (a *App) applySearchSelection(msg searchSelectionMsg) (tea.Model, tea.Cmd)
 			a.tab = tabEntities
 			a.entities.detail = &entity
 			a.entities.view = entitiesViewDetail
+			cmd = a.entities.prefetchDetail(entity.ID)
 		}
 	case "context":
 		if msg.context != nil {
@@ -2313,31 +3488,38 @@ func (a *App) applySearchSelection(msg searchSelectionMsg) (tea.Model, tea.Cmd)
 			a.protocols.detail = &protocol
 		}
 	}
-	return *a, nil
+	a.recordNav()
+	return *a, cmd
 }
 
 // hasUnsaved handles has unsaved.
 func (a App) hasUnsaved() bool {
-	if a.inbox.rejecting {
+	if running, _ := a.operationCounts(); running > 0 {
+		return true
+	}
+	if a.inbox.rejecting || (a.inbox.commenting && strings.TrimSpace(a.inbox.commentBuf.Value) != "") {
 		return true
 	}
 	switch a.entities.view {
-	case entitiesViewEdit, entitiesViewRelEdit, entitiesViewRelateSearch, entitiesViewRelateSelect, entitiesViewRelateType:
+	case entitiesViewEdit, entitiesViewRelEdit, entitiesViewRelateSearch, entitiesViewRelateSelect, entitiesViewRelateType, entitiesViewDuplicates, entitiesViewEditConflict:
 		return true
 	}
 	switch a.rels.view {
 	case relsViewEdit, relsViewCreateSourceSearch, relsViewCreateSourceSelect, relsViewCreateTargetSearch, relsViewCreateTargetSelect, relsViewCreateType:
 		return true
 	}
+	if a.know.view == contextViewEditConflict || a.know.view == contextViewDuplicate {
+		return true
+	}
 	if a.know.view == contextViewAdd && !a.know.saved && !a.know.saving {
 		if contextHasInput(a.know) {
 			return true
 		}
 	}
-	if a.jobs.changingSt || a.jobs.creatingSubtask {
+	if a.jobs.changingSt || a.jobs.creatingSubtask || a.jobs.checklistEdit || a.jobs.cancelling || a.jobs.tmplPending != nil {
 		return true
 	}
-	if a.profile.creating {
+	if a.profile.creating || a.profile.permEditing || a.profile.editDefaults {
 		return true
 	}
 	if a.profile.taxPromptMode != taxPromptNone {
@@ -2349,14 +3531,14 @@ func (a App) hasUnsaved() bool {
 // contextHasInput handles context has input.
 func contextHasInput(m ContextModel) bool {
 	for _, f := range m.fields {
-		if strings.TrimSpace(f.value) != "" {
+		if strings.TrimSpace(f.Value) != "" {
 			return true
 		}
 	}
-	if len(m.tags) > 0 || strings.TrimSpace(m.tagBuf) != "" {
+	if len(m.tags) > 0 || strings.TrimSpace(m.tagBuf.Value) != "" {
 		return true
 	}
-	if len(m.linkEntities) > 0 || strings.TrimSpace(m.linkQuery) != "" {
+	if len(m.linkEntities) > 0 || strings.TrimSpace(m.linkQuery.Value) != "" {
 		return true
 	}
 	return false
@@ -2371,12 +3553,23 @@ func defaultPaletteActions() []paletteAction {
 		{ID: "tab:context", Label: "Context", Desc: "Add context"},
 		{ID: "tab:jobs", Label: "Jobs", Desc: "View jobs"},
 		{ID: "tab:history", Label: "History", Desc: "Audit log"},
+		{ID: "tab:dashboard", Label: "Dashboard", Desc: "Counts and trends"},
+		{ID: "tab:status", Label: "Status", Desc: "Server health, versions, and latency"},
+		{ID: "tab:activity", Label: "Activity", Desc: "Your approvals, edits, and mentions"},
+		{ID: "tab:collections", Label: "Collections", Desc: "Named groups of pinned entities"},
 		{ID: "tab:settings", Label: "Settings", Desc: "Config, keys, and agents"},
 		{ID: "ops:import", Label: "Import", Desc: "Bulk import from file"},
 		{ID: "ops:export", Label: "Export", Desc: "Export data to file"},
+		{ID: "ops:queue", Label: "Operations", Desc: "Background job progress and failures"},
+		{ID: "ops:trash", Label: "Trash", Desc: "Restore or delete archived records"},
+		{ID: "ops:notifications", Label: "Notifications", Desc: "Recent toasts and errors"},
+		{ID: "ops:watches", Label: "Watching", Desc: "Unread changes to watched records"},
+		{ID: "ops:verify-files", Label: "Verify Files", Desc: "Recompute checksums of local files"},
 		{ID: "profile:keys", Label: "Settings: API keys", Desc: "Manage keys"},
 		{ID: "profile:agents", Label: "Settings: agents", Desc: "Manage agents"},
 		{ID: "profile:taxonomy", Label: "Settings: taxonomy", Desc: "Manage scopes and types"},
+		{ID: "profile:tags", Label: "Settings: tags", Desc: "Rename, merge, and prune tags"},
+		{ID: "profile:webhooks", Label: "Settings: webhooks", Desc: "Webhook deliveries for approval decisions"},
 		{ID: "quit", Label: "Quit", Desc: "Exit CLI"},
 	}
 }
@@ -2448,7 +3641,7 @@ func centerBlockUniform(s string, width int) string {
 func (a App) canExitToTabNav() bool {
 	switch a.tab {
 	case tabInbox:
-		if a.inbox.detail != nil || a.inbox.rejecting || a.inbox.confirming || a.inbox.rejectPreview {
+		if a.inbox.detail != nil || a.inbox.rejecting || a.inbox.confirming || a.inbox.rejectPreview || a.inbox.triagePrompt != triagePromptNone {
 			return false
 		}
 		return a.inbox.list == nil || a.inbox.list.Selected() == 0
@@ -2498,6 +3691,12 @@ func (a App) canExitToTabNav() bool {
 			return false
 		}
 		return a.history.list == nil || a.history.list.Selected() == 0
+	case tabDashboard, tabStatus:
+		return true
+	case tabActivity:
+		return a.activity.list.Selected() == 0
+	case tabCollections:
+		return a.collections.view == collectionsViewList && a.collections.list.Selected() == 0
 	case tabProfile:
 		if a.profile.creating || a.profile.createdKey != "" || a.profile.agentDetail != nil {
 			return false
@@ -2511,6 +3710,12 @@ func (a App) canExitToTabNav() bool {
 		if a.profile.section == 1 {
 			return a.profile.agentList == nil || a.profile.agentList.Selected() == 0
 		}
+		if a.profile.section == profileSectionTags {
+			return a.profile.tagList == nil || a.profile.tagList.Selected() == 0
+		}
+		if a.profile.section == profileSectionWebhooks {
+			return a.profile.webhookList == nil || a.profile.webhookList.Selected() == 0
+		}
 		return a.profile.taxList == nil || a.profile.taxList.Selected() == 0
 	}
 	return false
@@ -2556,9 +3761,11 @@ func (a *App) focusModeLineForActiveTab() bool {
 		}
 	case tabProfile:
 		if a.profile.taxPromptMode == taxPromptNone &&
+			a.profile.tagPromptMode == tagPromptNone &&
 			!a.profile.creating &&
 			!a.profile.editAPIKey &&
 			!a.profile.editPendingLimit &&
+			!a.profile.editDefaults &&
 			a.profile.createdKey == "" &&
 			a.profile.agentDetail == nil {
 			a.profile.sectionFocus = true
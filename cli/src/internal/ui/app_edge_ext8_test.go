@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
 	"github.com/gravitrone/nebula-core/cli/internal/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -60,9 +61,9 @@ func TestParseErrorCodeAndMessageAdditionalBranches(t *testing.T) {
 	assert.Equal(t, "BAD-CODE: nope", msg)
 }
 
-func TestShouldShowRecoveryHintsAdditionalBranches(t *testing.T) {
-	assert.True(t, shouldShowRecoveryHints("", "HTTP 401 unauthorized"))
-	assert.False(t, shouldShowRecoveryHints("", "some unrelated error"))
+func TestHasRecoveryKeysAdditionalBranches(t *testing.T) {
+	assert.True(t, hasRecoveryKeys(api.ClassifyErrorText("HTTP 401 unauthorized")))
+	assert.False(t, hasRecoveryKeys(api.ClassifyErrorText("some unrelated error")))
 }
 
 func TestOnboardingLoginCmdNilClientBranch(t *testing.T) {
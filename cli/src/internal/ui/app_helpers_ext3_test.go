@@ -176,11 +176,11 @@ func TestHandleOnboardingKeysQuitBusyAndEnterBranches(t *testing.T) {
 	assert.True(t, ok)
 
 	app.onboardingBusy = true
-	app.onboardingName = "alxx"
+	app.onboardingName.Value = "alxx"
 	model, cmd = app.handleOnboardingKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
 	require.Nil(t, cmd)
 	updated := model.(App)
-	assert.Equal(t, "alxx", updated.onboardingName)
+	assert.Equal(t, "alxx", updated.onboardingName.Value)
 
 	app.onboardingBusy = false
 	model, cmd = app.handleOnboardingKeys(tea.KeyMsg{Type: tea.KeyEnter})
@@ -197,7 +197,7 @@ func TestRefreshPaletteFilteredBranches(t *testing.T) {
 		{ID: "tab:jobs", Label: "Jobs", Desc: "tasks"},
 	}
 
-	app.paletteQuery = "/job"
+	app.paletteQuery.Value = "/job"
 	app.paletteSearchQuery = "old"
 	app.paletteSearchLoading = true
 	app.paletteSelections = map[string]paletteSelection{"x": {}}
@@ -211,7 +211,7 @@ func TestRefreshPaletteFilteredBranches(t *testing.T) {
 	assert.Equal(t, "tab:jobs", app.paletteFiltered[0].ID)
 	assert.Equal(t, 0, app.paletteIndex)
 
-	app.paletteQuery = "   "
+	app.paletteQuery.Value = "   "
 	app.paletteFiltered = []paletteAction{{ID: "tab:inbox"}}
 	app.paletteSelections = map[string]paletteSelection{"x": {}}
 	app.paletteSearchQuery = "stale"
@@ -225,7 +225,7 @@ func TestRefreshPaletteFilteredBranches(t *testing.T) {
 	assert.Nil(t, app.paletteFiltered)
 	assert.Equal(t, 0, app.paletteIndex)
 
-	app.paletteQuery = "alpha"
+	app.paletteQuery.Value = "alpha"
 	app.paletteSearchQuery = "alpha"
 	app.paletteFiltered = []paletteAction{{ID: "a"}}
 	app.paletteIndex = 5
@@ -236,7 +236,7 @@ func TestRefreshPaletteFilteredBranches(t *testing.T) {
 
 func TestRefreshPaletteFilteredTriggersSearchLoading(t *testing.T) {
 	app := NewApp(nil, &config.Config{})
-	app.paletteQuery = "alpha"
+	app.paletteQuery.Value = "alpha"
 	app.paletteSearchQuery = "beta"
 	app.paletteFiltered = []paletteAction{{ID: "old"}}
 	app.paletteSelections = map[string]paletteSelection{"old": {}}
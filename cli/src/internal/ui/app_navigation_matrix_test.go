@@ -104,7 +104,7 @@ func TestHasUnsavedMatrix(t *testing.T) {
 
 	app = NewApp(nil, &config.Config{})
 	app.know.view = contextViewAdd
-	app.know.fields[fieldTitle].value = "draft"
+	app.know.fields[fieldTitle].Value = "draft"
 	assert.True(t, app.hasUnsaved())
 
 	app = NewApp(nil, &config.Config{})
@@ -124,11 +124,11 @@ func TestContextHasInputMatrix(t *testing.T) {
 	model := NewContextModel(nil)
 	assert.False(t, contextHasInput(model))
 
-	model.fields[fieldTitle].value = "hello"
+	model.fields[fieldTitle].Value = "hello"
 	assert.True(t, contextHasInput(model))
 
 	model = NewContextModel(nil)
-	model.tagBuf = "tag-1"
+	model.tagBuf.Value = "tag-1"
 	assert.True(t, contextHasInput(model))
 
 	model = NewContextModel(nil)
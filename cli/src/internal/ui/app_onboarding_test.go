@@ -30,15 +30,15 @@ func TestHandleOnboardingKeysRequiresUsername(t *testing.T) {
 func TestHandleOnboardingKeysEditsUsernameBuffer(t *testing.T) {
 	app := NewApp(nil, nil)
 	app.onboarding = true
-	app.onboardingName = "ab"
+	app.onboardingName.Value = "ab"
 
 	model, _ := app.handleOnboardingKeys(tea.KeyMsg{Type: tea.KeyBackspace})
 	updated := model.(App)
-	assert.Equal(t, "a", updated.onboardingName)
+	assert.Equal(t, "a", updated.onboardingName.Value)
 
 	model, _ = updated.handleOnboardingKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("z")})
 	updated = model.(App)
-	assert.Equal(t, "az", updated.onboardingName)
+	assert.Equal(t, "az", updated.onboardingName.Value)
 }
 
 // TestOnboardingLoginCmdReturnsLoginPayload handles test onboarding login cmd returns login payload.
@@ -80,7 +80,7 @@ func TestRenderOnboardingSanitizesAndShowsBusyState(t *testing.T) {
 	app := NewApp(nil, nil)
 	app.width = 80
 	app.onboarding = true
-	app.onboardingName = "a\x1b]0;evil\x07\u202E"
+	app.onboardingName.Value = "a\x1b]0;evil\x07\u202E"
 
 	out := app.renderOnboarding()
 	clean := stripANSI(out)
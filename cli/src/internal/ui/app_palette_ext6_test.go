@@ -15,20 +15,20 @@ func TestRenderPaletteStateAndFallbackBranches(t *testing.T) {
 	app := NewApp(nil, &config.Config{})
 	app.width = 18
 
-	app.paletteQuery = "/"
+	app.paletteQuery.Value = "/"
 	app.paletteFiltered = nil
 	app.paletteSearchLoading = false
 	out := components.SanitizeText(app.renderPalette())
 	assert.Contains(t, out, "Command")
 	assert.Contains(t, out, "No matching")
 
-	app.paletteQuery = ""
+	app.paletteQuery.Value = ""
 	out = components.SanitizeText(app.renderPalette())
 	assert.Contains(t, out, "Search")
 	assert.Contains(t, out, "Type to")
 	assert.Contains(t, out, "search, or")
 
-	app.paletteQuery = "abc"
+	app.paletteQuery.Value = "abc"
 	out = components.SanitizeText(app.renderPalette())
 	assert.Contains(t, out, "No search")
 	assert.Contains(t, out, "results")
@@ -41,7 +41,7 @@ func TestRenderPaletteStateAndFallbackBranches(t *testing.T) {
 func TestRenderPaletteTableBranchesOnSmallWidth(t *testing.T) {
 	app := NewApp(nil, &config.Config{})
 	app.width = 22
-	app.paletteQuery = "plain"
+	app.paletteQuery.Value = "plain"
 	app.paletteFiltered = []paletteAction{
 		{ID: "x", Label: "", Desc: ""},
 	}
@@ -94,10 +94,10 @@ func TestHandlePaletteKeysEdgeBranches(t *testing.T) {
 	assert.Nil(t, cmd)
 
 	updated.paletteOpen = true
-	updated.paletteQuery = "/x"
+	updated.paletteQuery.Value = "/x"
 	model, cmd = updated.handlePaletteKeys(tea.KeyMsg{Type: tea.KeyBackspace})
 	updated = model.(App)
-	assert.Equal(t, "/", updated.paletteQuery)
+	assert.Equal(t, "/", updated.paletteQuery.Value)
 	assert.Nil(t, cmd)
 }
 
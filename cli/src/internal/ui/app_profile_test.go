@@ -0,0 +1,22 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+func TestAppProfileIndicatorShowsNamedProfile(t *testing.T) {
+	app := NewApp(nil, &config.Config{APIKey: "nbl"})
+	assert.Equal(t, "", app.renderProfileIndicator())
+
+	app.config.Profiles = map[string]config.Profile{"staging": {APIURL: "https://staging"}}
+	assert.Contains(t, stripANSI(app.renderProfileIndicator()), "profile: default · http://127.0.0.1:8765")
+
+	app.config.Profile = "staging"
+	app.config.APIURL = "https://staging"
+	app.width = 120
+	assert.Contains(t, stripANSI(app.View()), "profile: staging · https://staging")
+}
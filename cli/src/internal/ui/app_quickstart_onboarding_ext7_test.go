@@ -43,27 +43,27 @@ func TestHandleQuickstartKeysNavigationBoundaryMatrix(t *testing.T) {
 func TestHandleOnboardingKeysBusyQuitAndEnterBranches(t *testing.T) {
 	app := NewApp(nil, nil)
 	app.onboarding = true
-	app.onboardingName = "alxx"
+	app.onboardingName.Value = "alxx"
 	app.onboardingBusy = true
 
 	model, cmd := app.handleOnboardingKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'z'}})
 	updated := model.(App)
 	require.Nil(t, cmd)
-	assert.Equal(t, "alxx", updated.onboardingName)
+	assert.Equal(t, "alxx", updated.onboardingName.Value)
 
 	updated.onboardingBusy = false
 	model, cmd = updated.handleOnboardingKeys(tea.KeyMsg{Type: tea.KeyBackspace})
 	updated = model.(App)
 	require.Nil(t, cmd)
-	assert.Equal(t, "alx", updated.onboardingName)
+	assert.Equal(t, "alx", updated.onboardingName.Value)
 
-	updated.onboardingName = ""
+	updated.onboardingName.Value = ""
 	model, cmd = updated.handleOnboardingKeys(tea.KeyMsg{Type: tea.KeyBackspace})
 	updated = model.(App)
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.onboardingName)
+	assert.Equal(t, "", updated.onboardingName.Value)
 
-	updated.onboardingName = "nebula-user"
+	updated.onboardingName.Value = "nebula-user"
 	model, cmd = updated.handleOnboardingKeys(tea.KeyMsg{Type: tea.KeyEnter})
 	updated = model.(App)
 	require.NotNil(t, cmd)
@@ -227,13 +227,11 @@ func TestStartupParsingHelpers(t *testing.T) {
 	assert.Equal(t, "", code)
 	assert.Contains(t, msg, "HTTP 500")
 
-	assert.True(t, shouldShowRecoveryHints("FORBIDDEN", "scope missing"))
-	assert.True(t, shouldShowRecoveryHints("FORBIDDEN", "admin required"))
-	assert.True(t, shouldShowRecoveryHints("INVALID_API_KEY", "bad token"))
-	assert.True(t, shouldShowRecoveryHints("", "invalid api key"))
-	assert.True(t, shouldShowRecoveryHints("", "invalid_api_key: token expired"))
-	assert.True(t, shouldShowRecoveryHints("", "auth_required: login required"))
-	assert.False(t, shouldShowRecoveryHints("NOT_FOUND", "admin required"))
+	assert.True(t, hasRecoveryKeys(api.ClassifyErrorText("FORBIDDEN: scope missing")))
+	assert.True(t, hasRecoveryKeys(api.ClassifyErrorText("INVALID_API_KEY: bad token")))
+	assert.True(t, hasRecoveryKeys(api.ClassifyErrorText("invalid_api_key: token expired")))
+	assert.True(t, hasRecoveryKeys(api.ClassifyErrorText("auth_required: login required")))
+	assert.False(t, hasRecoveryKeys(api.ClassifyErrorText("NOT_FOUND: admin required")))
 
 	assert.Equal(t, "ok", classifyStartupAPI(""))
 	assert.Equal(t, "timeout", classifyStartupAPI("deadline exceeded"))
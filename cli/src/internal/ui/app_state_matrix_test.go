@@ -130,7 +130,15 @@ func TestStatusHintsForEntitiesStates(t *testing.T) {
 			setup: func(a *App) {
 				a.entities.bulkPrompt = "tags"
 			},
-			want: []string{"apply", "cancel"},
+			want: []string{"preview", "cancel"},
+		},
+		{
+			name: "bulk preview",
+			setup: func(a *App) {
+				a.entities.bulkPrompt = "tags"
+				a.entities.bulkPreview = &bulkSetPreview{}
+			},
+			want: []string{"commit", "back"},
 		},
 		{
 			name: "filtering",
@@ -187,7 +195,7 @@ func TestStatusHintsForEntitiesStates(t *testing.T) {
 			name: "list with search text",
 			setup: func(a *App) {
 				a.entities.view = entitiesViewList
-				a.entities.searchBuf = "alpha"
+				a.entities.searchBuf.Value = "alpha"
 			},
 			want: []string{"scroll", "complete", "details", "filter"},
 		},
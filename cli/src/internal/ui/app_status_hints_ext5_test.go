@@ -62,7 +62,7 @@ func TestStatusHintsForEntitiesAdditionalViews(t *testing.T) {
 			name: "list empty search includes select",
 			setup: func(a *App) {
 				a.entities.view = entitiesViewList
-				a.entities.searchBuf = ""
+				a.entities.searchBuf.Value = ""
 			},
 			want: []string{"scroll", "complete", "details", "filter", "select"},
 		},
@@ -323,9 +323,9 @@ func TestPaletteModeSwitchesBetweenCommandAndSearch(t *testing.T) {
 	app.openPaletteCommand()
 
 	require.True(t, app.paletteCommandMode())
-	assert.Equal(t, "/", app.paletteQuery)
+	assert.Equal(t, "/", app.paletteQuery.Value)
 
-	app.paletteQuery = "alpha"
+	app.paletteQuery.Value = "alpha"
 	require.False(t, app.paletteCommandMode())
 
 	app.paletteSearchQuery = "alpha"
@@ -366,7 +366,7 @@ func TestHelpToggle(t *testing.T) {
 func TestQuitConfirmWhenUnsaved(t *testing.T) {
 	app := NewApp(nil, &config.Config{})
 	app.know.view = contextViewAdd
-	app.know.fields[fieldTitle].value = "draft"
+	app.know.fields[fieldTitle].Value = "draft"
 
 	model, cmd := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
 	updated := model.(App)
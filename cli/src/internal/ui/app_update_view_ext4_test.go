@@ -115,7 +115,7 @@ func TestUpdateKeyFlowRecoveryAndGlobalBranches(t *testing.T) {
 	app.onboarding = true
 	model, _ := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
 	updated := model.(App)
-	assert.Equal(t, "a", updated.onboardingName)
+	assert.Equal(t, "a", updated.onboardingName.Value)
 
 	app = NewApp(nil, &config.Config{})
 	app.importExportOpen = true
@@ -169,7 +169,7 @@ func TestUpdateKeyFlowRecoveryAndGlobalBranches(t *testing.T) {
 	updated.quickstartOpen = false
 	updated.err = ""
 	updated.know.view = contextViewAdd
-	updated.know.fields[fieldTitle].value = "draft"
+	updated.know.fields[fieldTitle].Value = "draft"
 	model, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
 	updated = model.(App)
 	assert.True(t, updated.quitConfirm)
@@ -74,7 +74,7 @@ func TestAppHelpAndQuitConfirmViewsRender(t *testing.T) {
 	model, _ = app.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
 	app = model.(App)
 	app.know.view = contextViewAdd
-	app.know.fields[fieldTitle].value = "draft"
+	app.know.fields[fieldTitle].Value = "draft"
 
 	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
 	app = model.(App)
@@ -0,0 +1,161 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+const (
+	// approvalPriorityHigh marks an approval the agent flagged as urgent.
+	approvalPriorityHigh = "high"
+	// approvalAlertPollInterval is how often pending approvals are checked
+	// for new high-priority requests, whichever tab is open.
+	approvalAlertPollInterval = 30 * time.Second
+	// approvalFlashFrames is how many times the Inbox tab label toggles.
+	approvalFlashFrames = 8
+	// approvalFlashInterval is how long each flash frame lasts.
+	approvalFlashInterval = 400 * time.Millisecond
+)
+
+// bellOut receives the terminal bell. The renderer owns stdout, so the bell
+// goes to stderr, which is the same terminal.
+var bellOut io.Writer = os.Stderr
+
+// approvalAlertTickMsg re-polls pending approvals for alerts.
+type approvalAlertTickMsg struct{}
+
+// approvalAlertsMsg carries the pending approvals a poll found.
+type approvalAlertsMsg struct {
+	items []api.Approval
+}
+
+// approvalFlashMsg advances the Inbox tab flash by one frame.
+type approvalFlashMsg struct{}
+
+// approvalAlertsSavedMsg reports the approval_alerts setting after it was saved.
+type approvalAlertsSavedMsg struct{ mode string }
+
+// isHighPriority reports whether an approval was flagged as urgent.
+func isHighPriority(a api.Approval) bool {
+	return strings.EqualFold(strings.TrimSpace(a.Priority), approvalPriorityHigh)
+}
+
+// approvalPriorityLabel returns an approval's priority, normal when unset.
+func approvalPriorityLabel(a api.Approval) string {
+	if priority := strings.ToLower(strings.TrimSpace(components.SanitizeOneLine(a.Priority))); priority != "" {
+		return priority
+	}
+	return "normal"
+}
+
+// approvalPriorityStyle colors the priority column so high stands out.
+func approvalPriorityStyle(label string) lipgloss.Style {
+	if label == approvalPriorityHigh {
+		return WarningStyle
+	}
+	return lipgloss.NewStyle()
+}
+
+// approvalAlertTickCmd schedules the next alert poll.
+func approvalAlertTickCmd() tea.Cmd {
+	return tea.Tick(approvalAlertPollInterval, func(time.Time) tea.Msg { return approvalAlertTickMsg{} })
+}
+
+// pollApprovalAlertsCmd reads the pending approvals, unless alerts are off.
+// Failed polls are dropped; the next tick tries again.
+func (a App) pollApprovalAlertsCmd() tea.Cmd {
+	if a.client == nil || a.config == nil || a.config.ApprovalAlertMode() == config.ApprovalAlertsOff {
+		return nil
+	}
+	client := a.client
+	return func() tea.Msg {
+		items, err := client.GetPendingApprovals()
+		if err != nil {
+			return nil
+		}
+		return approvalAlertsMsg{items: items}
+	}
+}
+
+// applyApprovalAlerts alerts on high-priority approvals not seen by an
+// earlier poll: a toast, the terminal bell, and a flashing Inbox tab that
+// stays marked until the Inbox is opened. The first poll only records what is
+// already pending.
+func (a *App) applyApprovalAlerts(items []api.Approval) tea.Cmd {
+	mode := a.config.ApprovalAlertMode()
+	if mode == config.ApprovalAlertsOff {
+		return nil
+	}
+	primed := a.alertSeen != nil
+	seen := make(map[string]bool, len(items))
+	var fresh []api.Approval
+	for _, item := range items {
+		seen[item.ID] = true
+		if primed && !a.alertSeen[item.ID] && isHighPriority(item) {
+			fresh = append(fresh, item)
+		}
+	}
+	a.alertSeen = seen
+	if len(fresh) == 0 {
+		return nil
+	}
+
+	if mode == config.ApprovalAlertsOn {
+		_, _ = io.WriteString(bellOut, "\a")
+	}
+	text := fmt.Sprintf("%d high-priority approvals waiting.", len(fresh))
+	if len(fresh) == 1 {
+		text = fmt.Sprintf("High-priority approval: %s from %s.", approvalTitle(fresh[0]), approvalWhoLabel(fresh[0]))
+	}
+	cmds := []tea.Cmd{a.setToast("warning", text)}
+	if a.tab != tabInbox {
+		a.inboxAttention = true
+		if a.inboxFlash == 0 {
+			cmds = append(cmds, approvalFlashCmd())
+		}
+		a.inboxFlash = approvalFlashFrames
+	}
+	return tea.Batch(cmds...)
+}
+
+// approvalFlashCmd schedules the next flash frame.
+func approvalFlashCmd() tea.Cmd {
+	return tea.Tick(approvalFlashInterval, func(time.Time) tea.Msg { return approvalFlashMsg{} })
+}
+
+// advanceApprovalFlash steps the Inbox tab flash, stopping after the last frame.
+func (a *App) advanceApprovalFlash() tea.Cmd {
+	if a.inboxFlash == 0 {
+		return nil
+	}
+	a.inboxFlash--
+	if a.inboxFlash == 0 {
+		return nil
+	}
+	return approvalFlashCmd()
+}
+
+// inboxTabLabel renders the Inbox tab name, marked while a high-priority
+// approval waits unseen.
+func (a App) inboxTabLabel(name string) string {
+	if a.inboxAttention {
+		return name + " !"
+	}
+	return name
+}
+
+// inboxTabFlashing reports whether the Inbox tab shows its alert style in the
+// current flash frame.
+func (a App) inboxTabFlashing() bool {
+	return a.inboxFlash > 0 && a.inboxFlash%2 == 0
+}
@@ -0,0 +1,103 @@
+package ui
+
+import (
+	"bytes"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+func captureBell(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	prev := bellOut
+	bellOut = &buf
+	t.Cleanup(func() { bellOut = prev })
+	return &buf
+}
+
+func TestApprovalAlertsRingAndFlashForNewHighPriority(t *testing.T) {
+	bell := captureBell(t)
+	app := NewApp(nil, &config.Config{})
+	app.tab = tabEntities
+
+	normal := api.Approval{ID: "apr-1", ChangeDetails: api.JSONMap{"name": "Routine"}}
+	urgent := api.Approval{ID: "apr-2", Priority: "high", AgentName: "deploy-bot", ChangeDetails: api.JSONMap{"name": "Rotate keys"}}
+
+	// The first poll only records what is already pending.
+	model, _ := app.Update(approvalAlertsMsg{items: []api.Approval{urgent}})
+	app = model.(App)
+	assert.Empty(t, bell.String())
+	assert.False(t, app.inboxAttention)
+
+	later := api.Approval{ID: "apr-3", Priority: "high", AgentName: "deploy-bot", ChangeDetails: api.JSONMap{"name": "Restart db"}}
+	model, cmd := app.Update(approvalAlertsMsg{items: []api.Approval{normal, urgent, later}})
+	app = model.(App)
+	require.NotNil(t, cmd)
+	assert.Equal(t, "\a", bell.String())
+	assert.True(t, app.inboxAttention)
+	assert.Equal(t, approvalFlashFrames, app.inboxFlash)
+	require.NotNil(t, app.toast)
+	assert.Equal(t, "High-priority approval: Restart db from deploy-bot.", app.toast.text)
+	assert.Contains(t, app.renderTabs(), "Inbox !")
+
+	model, _ = app.Update(approvalFlashMsg{})
+	app = model.(App)
+	assert.Equal(t, approvalFlashFrames-1, app.inboxFlash)
+
+	// Seen approvals do not alert again.
+	model, _ = app.Update(approvalAlertsMsg{items: []api.Approval{normal, urgent, later}})
+	app = model.(App)
+	assert.Equal(t, "\a", bell.String())
+
+	app, _ = app.switchTab(tabInbox)
+	assert.False(t, app.inboxAttention)
+	assert.Zero(t, app.inboxFlash)
+	assert.NotContains(t, app.renderTabs(), "Inbox !")
+}
+
+func TestApprovalAlertModes(t *testing.T) {
+	bell := captureBell(t)
+	app := NewApp(nil, &config.Config{ApprovalAlerts: config.ApprovalAlertsFlash})
+	app.tab = tabJobs
+	urgent := api.Approval{ID: "apr-1", Priority: "high"}
+
+	app.applyApprovalAlerts(nil)
+	app.applyApprovalAlerts([]api.Approval{urgent})
+	assert.Empty(t, bell.String())
+	assert.True(t, app.inboxAttention)
+
+	app = NewApp(nil, &config.Config{ApprovalAlerts: config.ApprovalAlertsOff})
+	app.tab = tabJobs
+	app.applyApprovalAlerts(nil)
+	assert.Nil(t, app.applyApprovalAlerts([]api.Approval{urgent}))
+	assert.False(t, app.inboxAttention)
+	assert.Nil(t, app.pollApprovalAlertsCmd())
+}
+
+func TestSettingsCyclesApprovalAlerts(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	cfg := &config.Config{APIKey: "nbl_test"}
+	model := NewProfileModel(nil, cfg)
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("b")})
+	require.NotNil(t, cmd)
+	msg, ok := cmd().(approvalAlertsSavedMsg)
+	require.True(t, ok)
+	assert.Equal(t, config.ApprovalAlertsFlash, msg.mode)
+	assert.Equal(t, config.ApprovalAlertsFlash, cfg.ApprovalAlerts)
+	assert.Equal(t, "tab flash", approvalAlertsLabel(cfg))
+}
+
+func TestInboxMarksHighPriorityApprovals(t *testing.T) {
+	urgent := api.Approval{ID: "apr-1", Priority: "high", ChangeDetails: api.JSONMap{"name": "Rotate keys"}}
+	assert.Equal(t, "high", approvalPriorityLabel(urgent))
+	assert.Equal(t, "normal", approvalPriorityLabel(api.Approval{}))
+	assert.Contains(t, renderApprovalPreview(urgent, false, 60), "Priority")
+	assert.NotContains(t, renderApprovalPreview(api.Approval{ID: "apr-2"}, false, 60), "Priority")
+}
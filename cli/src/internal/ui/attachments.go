@@ -0,0 +1,234 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// attachmentRelType is the relationship type that attaches a file to the
+// entity, context item, or job that owns it. The edge runs file -> owner.
+const attachmentRelType = "attachment"
+
+// attachPickerLimit caps how many targets the attach picker lists.
+const attachPickerLimit = 8
+
+// fileAttachPicker is the Files detail search for a record to attach the
+// file to.
+type fileAttachPicker struct {
+	query   components.TextInput
+	results []relationshipCreateCandidate
+	index   int
+	loading bool
+	saving  bool
+	errText string
+}
+
+type fileAttachResultsMsg struct {
+	query string
+	items []relationshipCreateCandidate
+	err   error
+}
+
+type fileAttachedMsg struct {
+	fileID string
+	target relationshipCreateCandidate
+	err    error
+}
+
+// fileAttachments returns the attachment edges that point at a record.
+func fileAttachments(ownerType, ownerID string, rels []api.Relationship) []api.Relationship {
+	var out []api.Relationship
+	for _, rel := range rels {
+		if rel.Type == attachmentRelType && rel.SourceType == "file" &&
+			rel.TargetType == ownerType && rel.TargetID == ownerID {
+			out = append(out, rel)
+		}
+	}
+	return out
+}
+
+// renderAttachments renders the files attached to a record, or "" when there
+// are none.
+func renderAttachments(ownerType, ownerID string, rels []api.Relationship, width int) string {
+	attached := fileAttachments(ownerType, ownerID, rels)
+	if len(attached) == 0 {
+		return ""
+	}
+	contentWidth := components.BoxContentWidth(width)
+	if contentWidth <= 0 {
+		contentWidth = 72
+	}
+	lines := []string{MetaKeyStyle.Render(fmt.Sprintf("Attachments (%d)", len(attached)))}
+	for _, rel := range attached {
+		name := components.SanitizeOneLine(strings.TrimSpace(rel.SourceName))
+		if name == "" {
+			name = shortID(rel.SourceID)
+		}
+		line := name
+		if !rel.CreatedAt.IsZero() {
+			line += MutedStyle.Render("  " + formatLocalTimeCompact(rel.CreatedAt))
+		}
+		lines = append(lines, "  "+components.ClampTextWidthEllipsis(line, contentWidth-2))
+	}
+	return components.TitledBox("Attachments", strings.Join(lines, "\n"), width)
+}
+
+// openAttach starts the attach picker on the file detail.
+func (m *FilesModel) openAttach() {
+	if m.detail == nil {
+		return
+	}
+	m.attach = &fileAttachPicker{}
+}
+
+// handleAttachKeys handles keys while the attach picker is open. Typing
+// searches entities, knowledge, and jobs; enter attaches the selection.
+func (m FilesModel) handleAttachKeys(msg tea.KeyMsg) (FilesModel, tea.Cmd) {
+	p := m.attach
+	if p.saving {
+		return m, nil
+	}
+	switch {
+	case isBack(msg):
+		m.attach = nil
+		return m, nil
+	case isUp(msg):
+		if p.index > 0 {
+			p.index--
+		}
+		return m, nil
+	case isDown(msg):
+		if p.index < len(p.results)-1 {
+			p.index++
+		}
+		return m, nil
+	case isEnter(msg):
+		if p.index >= len(p.results) || m.detail == nil {
+			return m, nil
+		}
+		p.saving = true
+		p.errText = ""
+		return m, m.attachFile(m.detail.ID, p.results[p.index])
+	case isKey(msg, "cmd+backspace", "cmd+delete", "ctrl+u"):
+		p.query.Reset()
+	default:
+		before := p.query.Value
+		if !p.query.HandleKey(msg) || p.query.Value == before {
+			return m, nil
+		}
+	}
+	p.index = 0
+	if strings.TrimSpace(p.query.Value) == "" {
+		p.results = nil
+		p.loading = false
+		return m, nil
+	}
+	p.loading = true
+	return m, m.searchAttachTargets(p.query.Value)
+}
+
+// applyAttachResults shows search results when they match the current query.
+func (m *FilesModel) applyAttachResults(msg fileAttachResultsMsg) {
+	p := m.attach
+	if p == nil || msg.query != p.query.Value {
+		return
+	}
+	p.loading = false
+	p.errText = ""
+	if msg.err != nil {
+		p.errText = msg.err.Error()
+		return
+	}
+	p.results = msg.items
+	if len(p.results) > attachPickerLimit {
+		p.results = p.results[:attachPickerLimit]
+	}
+	p.index = min(p.index, max(len(p.results)-1, 0))
+}
+
+// searchAttachTargets finds entities, context items, and jobs matching query.
+func (m FilesModel) searchAttachTargets(query string) tea.Cmd {
+	client := m.client
+	if client == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		params := api.QueryParams{"search_text": strings.TrimSpace(query)}
+		entities, err := client.QueryEntities(params)
+		if err != nil {
+			return fileAttachResultsMsg{query: query, err: err}
+		}
+		contextItems, err := client.QueryContext(params)
+		if err != nil {
+			return fileAttachResultsMsg{query: query, err: err}
+		}
+		jobs, err := client.QueryJobs(params)
+		if err != nil {
+			return fileAttachResultsMsg{query: query, err: err}
+		}
+		candidates := combineCreateCandidates(entities, contextItems, jobs)
+		return fileAttachResultsMsg{query: query, items: filterCreateCandidatesByQuery(candidates, query)}
+	}
+}
+
+// attachFile links the file to target with an attachment relationship.
+func (m FilesModel) attachFile(fileID string, target relationshipCreateCandidate) tea.Cmd {
+	client := m.client
+	if client == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		_, err := client.CreateRelationship(api.CreateRelationshipInput{
+			SourceType: "file",
+			SourceID:   fileID,
+			TargetType: target.NodeType,
+			TargetID:   target.ID,
+			Type:       attachmentRelType,
+		})
+		if err != nil {
+			err = fmt.Errorf("attach file: %w", err)
+		}
+		return fileAttachedMsg{fileID: fileID, target: target, err: err}
+	}
+}
+
+// renderAttach renders the attach picker.
+func (m FilesModel) renderAttach() string {
+	p := m.attach
+	contentWidth := components.BoxContentWidth(m.width)
+	if contentWidth <= 0 {
+		contentWidth = 72
+	}
+	title := "Attach To"
+	if m.detail != nil {
+		title = "Attach " + components.SanitizeOneLine(m.detail.Filename) + " To"
+	}
+	lines := []string{components.InputDialog(title, p.query)}
+	switch {
+	case p.saving:
+		lines = append(lines, MutedStyle.Render("Attaching..."))
+	case p.loading:
+		lines = append(lines, MutedStyle.Render("Searching..."))
+	case strings.TrimSpace(p.query.Value) == "":
+		lines = append(lines, MutedStyle.Render("Type to search entities, knowledge, and jobs."))
+	case len(p.results) == 0:
+		lines = append(lines, MutedStyle.Render("No matches."))
+	}
+	for i, candidate := range p.results {
+		marker := "  "
+		if i == p.index {
+			marker = AccentStyle.Render("> ")
+		}
+		line := components.SanitizeOneLine(formatCreateCandidateLine(candidate))
+		lines = append(lines, marker+components.ClampTextWidthEllipsis(line, contentWidth-2))
+	}
+	if p.errText != "" {
+		lines = append(lines, ErrorStyle.Render(components.SanitizeOneLine(p.errText)))
+	}
+	return components.TitledBox("Attach", strings.Join(lines, "\n"), m.width)
+}
@@ -0,0 +1,95 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestFilesAttachSearchesAndCreatesAttachment(t *testing.T) {
+	var created api.CreateRelationshipInput
+	_, client := contextTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var data any
+		switch {
+		case r.URL.Path == "/api/entities":
+			assert.Equal(t, "atlas", r.URL.Query().Get("search_text"))
+			data = []map[string]any{{"id": "ent-1", "name": "Atlas", "type": "project", "status": "active"}}
+		case r.URL.Path == "/api/context", r.URL.Path == "/api/jobs":
+			data = []map[string]any{}
+		case r.URL.Path == "/api/relationships" && r.Method == http.MethodPost:
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			data = map[string]any{"id": "rel-1"}
+		case r.URL.Path == "/api/relationships/file/file-1":
+			data = []map[string]any{{
+				"id": "rel-1", "source_type": "file", "source_id": "file-1", "source_name": "spec.pdf",
+				"target_type": "entity", "target_id": "ent-1", "target_name": "Atlas",
+				"relationship_type": "attachment",
+			}}
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": data}))
+	})
+	model := NewFilesModel(client)
+	model.width = 100
+	model.detail = &api.File{ID: "file-1", Filename: "spec.pdf"}
+	model.view = filesViewDetail
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	assert.Nil(t, cmd)
+	require.NotNil(t, model.attach)
+
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("atlas")})
+	require.NotNil(t, cmd)
+	model, _ = model.Update(cmd())
+	require.Len(t, model.attach.results, 1)
+	assert.Contains(t, components.SanitizeText(model.View()), "Atlas")
+
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	model, cmd = model.Update(cmd())
+	assert.Nil(t, model.attach)
+	assert.Equal(t, api.CreateRelationshipInput{
+		SourceType: "file", SourceID: "file-1", TargetType: "entity", TargetID: "ent-1", Type: "attachment",
+	}, created)
+
+	require.NotNil(t, cmd)
+	model, _ = model.Update(cmd())
+	require.Len(t, model.detailRels, 1)
+}
+
+func TestAttachPickerDropsStaleResults(t *testing.T) {
+	model := NewFilesModel(nil)
+	model.detail = &api.File{ID: "file-1"}
+	model.view = filesViewDetail
+	model.openAttach()
+	model.attach.query.Value = "atlas"
+
+	model.applyAttachResults(fileAttachResultsMsg{query: "atl", items: []relationshipCreateCandidate{{ID: "ent-1"}}})
+	assert.Empty(t, model.attach.results)
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Nil(t, model.attach)
+	assert.Equal(t, filesViewDetail, model.view)
+}
+
+func TestRenderAttachmentsListsFilesAttachedToRecord(t *testing.T) {
+	rels := []api.Relationship{
+		{SourceType: "file", SourceID: "file-1", SourceName: "spec.pdf", TargetType: "job", TargetID: "job-1", Type: "attachment"},
+		{SourceType: "file", SourceID: "file-2", SourceName: "notes.md", TargetType: "job", TargetID: "job-2", Type: "attachment"},
+		{SourceType: "job", SourceID: "job-1", TargetType: "file", TargetID: "file-3", Type: "has-file"},
+	}
+	out := components.SanitizeText(renderAttachments("job", "job-1", rels, 80))
+	assert.Contains(t, out, "Attachments (1)")
+	assert.Contains(t, out, "spec.pdf")
+	assert.NotContains(t, out, "notes.md")
+
+	assert.Empty(t, renderAttachments("entity", "ent-1", rels, 80))
+}
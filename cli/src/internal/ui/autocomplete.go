@@ -0,0 +1,214 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+// maxInlineSuggestions caps the alternatives shown under a tag field.
+const maxInlineSuggestions = 3
+
+// vocabularyLoadedMsg carries the tag, scope, and relationship type
+// vocabularies used for autocomplete, plus the relationship type rules used
+// for validation. err joins the lookups that failed.
+type vocabularyLoadedMsg struct {
+	tags         []string
+	scopes       []string
+	relTypes     []string
+	relTypeRules relationshipTypeRules
+	err          error
+}
+
+// loadVocabulary fetches existing tags, scopes, and relationship types. A
+// failed lookup leaves its vocabulary empty and is reported in err, except
+// for scope errors: scope and relationship type lists are admin only, so a
+// key without the admin scope just goes without them.
+func loadVocabulary(client *api.Client) tea.Cmd {
+	if client == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		msg := vocabularyLoadedMsg{}
+		var errs []error
+		fail := func(what string, err error) {
+			if api.ClassifyError(err) != api.ErrorKindScope {
+				errs = append(errs, fmt.Errorf("%s: %w", what, err))
+			}
+		}
+		if tags, err := client.ListTags("", 1000, 0); err != nil {
+			fail("tags", err)
+		} else {
+			for _, tag := range tags {
+				msg.tags = append(msg.tags, tag.Name)
+			}
+		}
+		if scopes, err := client.ListAuditScopes(); err != nil {
+			fail("scopes", err)
+		} else {
+			for _, scope := range scopes {
+				msg.scopes = append(msg.scopes, scope.Name)
+			}
+		}
+		if types, err := client.ListTaxonomy("relationship-types", false, "", 200, 0); err != nil {
+			fail("relationship types", err)
+		} else {
+			for _, typ := range types {
+				msg.relTypes = append(msg.relTypes, typ.Name)
+			}
+			msg.relTypeRules = newRelationshipTypeRules(types)
+		}
+		msg.err = errors.Join(errs...)
+		return msg
+	}
+}
+
+// suggestKey folds case and drops punctuation so "go-lang" and "golang" collide.
+func suggestKey(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// isSubsequence reports whether every rune of needle appears in hay in order.
+func isSubsequence(needle, hay string) bool {
+	if needle == "" {
+		return true
+	}
+	rs := []rune(needle)
+	i := 0
+	for _, r := range hay {
+		if r == rs[i] {
+			i++
+			if i == len(rs) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// rankSuggestions orders options by how well they match query: exact, prefix,
+// substring, then fuzzy subsequence. Options in exclude are skipped.
+func rankSuggestions(options []string, query string, exclude []string) []string {
+	skip := map[string]bool{}
+	for _, item := range exclude {
+		skip[strings.ToLower(strings.TrimSpace(item))] = true
+	}
+	q := strings.ToLower(strings.TrimSpace(query))
+	qKey := suggestKey(q)
+
+	type ranked struct {
+		value string
+		rank  int
+		index int
+	}
+	seen := map[string]bool{}
+	matches := []ranked{}
+	for i, opt := range options {
+		value := strings.TrimSpace(opt)
+		lower := strings.ToLower(value)
+		if value == "" || skip[lower] || seen[lower] {
+			continue
+		}
+		seen[lower] = true
+		key := suggestKey(lower)
+		rank := -1
+		switch {
+		case q == "":
+			rank = 0
+		case lower == q || (qKey != "" && key == qKey):
+			rank = 0
+		case strings.HasPrefix(lower, q) || (qKey != "" && strings.HasPrefix(key, qKey)):
+			rank = 1
+		case strings.Contains(lower, q) || (qKey != "" && strings.Contains(key, qKey)):
+			rank = 2
+		case qKey != "" && isSubsequence(qKey, key):
+			rank = 3
+		}
+		if rank >= 0 {
+			matches = append(matches, ranked{value: value, rank: rank, index: i})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].rank != matches[j].rank {
+			return matches[i].rank < matches[j].rank
+		}
+		return matches[i].index < matches[j].index
+	})
+	out := make([]string, len(matches))
+	for i, match := range matches {
+		out[i] = match.value
+	}
+	return out
+}
+
+// completeTag returns the best completion for buf, or buf when nothing matches.
+func completeTag(options []string, buf string, chosen []string) string {
+	if strings.TrimSpace(buf) == "" {
+		return buf
+	}
+	if matches := rankSuggestions(options, buf, chosen); len(matches) > 0 {
+		return matches[0]
+	}
+	return buf
+}
+
+// canonicalTag swaps a near-duplicate of an existing tag for the existing spelling.
+func canonicalTag(options []string, tag string) string {
+	key := suggestKey(tag)
+	if key == "" {
+		return tag
+	}
+	for _, opt := range options {
+		if suggestKey(opt) == key {
+			return normalizeTag(opt)
+		}
+	}
+	return tag
+}
+
+// renderTagSuggestions renders the muted completion hint shown after a tag buffer.
+func renderTagSuggestions(options []string, buf string, chosen []string) string {
+	if strings.TrimSpace(buf) == "" {
+		return ""
+	}
+	matches := rankSuggestions(options, buf, chosen)
+	if len(matches) == 0 {
+		return ""
+	}
+	if len(matches) > maxInlineSuggestions {
+		matches = matches[:maxInlineSuggestions]
+	}
+	if len(matches) == 1 && strings.EqualFold(matches[0], strings.TrimSpace(buf)) {
+		return ""
+	}
+	return " " + MutedStyle.Render("tab → "+strings.Join(matches, " · "))
+}
+
+// mergeVocabulary appends extra values not already present, case-insensitively.
+func mergeVocabulary(base []string, extra []string) []string {
+	seen := map[string]bool{}
+	out := make([]string, 0, len(base)+len(extra))
+	for _, list := range [][]string{base, extra} {
+		for _, item := range list {
+			value := strings.ToLower(strings.TrimSpace(item))
+			if value == "" || seen[value] {
+				continue
+			}
+			seen[value] = true
+			out = append(out, value)
+		}
+	}
+	return out
+}
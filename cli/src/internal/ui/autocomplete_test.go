@@ -0,0 +1,150 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRankSuggestionsOrdersPrefixBeforeFuzzy(t *testing.T) {
+	options := []string{"backend", "golang", "go-tools", "cargo", "gallery-ops"}
+
+	assert.Equal(t, []string{"golang", "go-tools", "cargo", "gallery-ops"}, rankSuggestions(options, "go", nil))
+	assert.Equal(t, []string{"golang"}, rankSuggestions(options, "go-lang", nil))
+	assert.Equal(t, []string{"go-tools"}, rankSuggestions(options, "gotools", nil))
+	assert.Equal(t, []string{"go-tools", "cargo", "gallery-ops"}, rankSuggestions(options, "go", []string{"golang"}))
+	assert.Equal(t, []string{"backend"}, rankSuggestions(options, "bkd", nil))
+	assert.Empty(t, rankSuggestions(options, "zzz", nil))
+}
+
+func TestCanonicalTagReusesExistingSpelling(t *testing.T) {
+	options := []string{"golang", "ml-ops"}
+	assert.Equal(t, "golang", canonicalTag(options, "go-lang"))
+	assert.Equal(t, "ml-ops", canonicalTag(options, "mlops"))
+	assert.Equal(t, "rust", canonicalTag(options, "rust"))
+	assert.Equal(t, "", canonicalTag(options, ""))
+}
+
+func TestEntityAddTagTabCompletesAndCanonicalizes(t *testing.T) {
+	model := NewEntitiesModel(nil)
+	model.view = entitiesViewAdd
+	model.addFocus = addFieldTags
+	model.tagOptions = []string{"golang", "gopher", "infra"}
+
+	for _, ch := range "gol" {
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{ch}})
+	}
+	assert.Contains(t, components.SanitizeText(model.renderAddTags(true)), "tab → golang")
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	assert.Equal(t, "golang", model.addTagBuf.Value)
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeySpace})
+	assert.Equal(t, []string{"golang"}, model.addTags)
+
+	// A near-duplicate spelling collapses onto the existing tag.
+	for _, ch := range "Go_Lang" {
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{ch}})
+	}
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeySpace})
+	assert.Equal(t, []string{"golang"}, model.addTags)
+
+	// Tags already chosen are not suggested again.
+	for _, ch := range "go" {
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{ch}})
+	}
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	assert.Equal(t, "gopher", model.addTagBuf.Value)
+}
+
+func TestRelationshipCreateTypeTabCompletesFromVocabulary(t *testing.T) {
+	model := NewRelationshipsModel(nil)
+	model.view = relsViewCreateType
+	model.typeVocab = []string{"depends-on", "owned-by"}
+	model, _ = model.Update(relTabLoadedMsg{items: []api.Relationship{{Type: "Works-With"}}})
+	assert.Equal(t, []string{"works-with", "depends-on", "owned-by"}, model.typeOptions)
+
+	model.createType.Value = "ownd"
+	model.updateTypeSuggestions()
+	updated, cmd := model.handleCreateKeys(tea.KeyMsg{Type: tea.KeyTab})
+	assert.Nil(t, cmd)
+	assert.Equal(t, "owned-by", updated.createType.Value)
+}
+
+func TestEntityRelateTypeSuggestsTaxonomyTypes(t *testing.T) {
+	model := NewEntitiesModel(nil)
+	model.width = 100
+	model.view = entitiesViewRelateType
+	model.relateTarget = &api.Entity{ID: "ent-2"}
+	model.detailRels = []api.Relationship{{Type: "mentors"}}
+	model.relTypeVocab = []string{"depends-on", "member-of"}
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	out := components.SanitizeText(model.View())
+	assert.Contains(t, out, "tab → mentors · member-of")
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	assert.Equal(t, "mentors", model.relateType.Value)
+}
+
+func TestAppLoadsVocabularyIntoForms(t *testing.T) {
+	_, client := testProfileTaxonomyClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/tags":
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+				{"name": "golang", "entities": 2},
+			}}))
+		case "/api/taxonomy/relationship-types":
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+				{"id": "rt-1", "name": "depends-on", "is_active": true},
+			}}))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	app := NewApp(client, &config.Config{APIKey: "test-key"})
+	msg := loadVocabulary(client)()
+	model, _ := app.Update(msg)
+	app = model.(App)
+
+	assert.Equal(t, []string{"golang"}, app.entities.tagOptions)
+	assert.Equal(t, []string{"golang"}, app.know.tagOptions)
+	assert.Equal(t, []string{"golang"}, app.protocols.tagOptions)
+	assert.Equal(t, []string{"depends-on"}, app.entities.relTypeVocab)
+	assert.Equal(t, []string{"depends-on"}, app.rels.typeOptions)
+
+	assert.Nil(t, loadVocabulary(nil))
+}
+
+func TestAppWarnsWhenVocabularyFailsToLoad(t *testing.T) {
+	_, client := testProfileTaxonomyClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/tags":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"detail": map[string]any{
+				"error": map[string]any{"code": "FORBIDDEN", "message": "Admin scope required"},
+			}}))
+		}
+	})
+
+	msg := loadVocabulary(client)().(vocabularyLoadedMsg)
+	require.Error(t, msg.err)
+	assert.Contains(t, msg.err.Error(), "tags")
+	assert.NotContains(t, msg.err.Error(), "relationship types")
+
+	app := NewApp(client, &config.Config{APIKey: "test-key"})
+	model, _ := app.Update(msg)
+	app = model.(App)
+	require.NotNil(t, app.toast)
+	assert.Equal(t, "warning", app.toast.level)
+	assert.Contains(t, app.toast.text, "Autocomplete incomplete")
+}
@@ -0,0 +1,130 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// awayDigestRows caps how many journaled changes the digest lists.
+const awayDigestRows = 12
+
+// awayDigestLoadedMsg carries the changes `nebula sync` journaled since the
+// digest was last shown.
+type awayDigestLoadedMsg struct{ changes []config.SyncChange }
+
+// loadAwayDigest reads the sync journal. A missing or unreadable cache means
+// there is nothing to show.
+func loadAwayDigest() tea.Msg {
+	state, err := config.LoadSyncState()
+	if err != nil {
+		return awayDigestLoadedMsg{}
+	}
+	return awayDigestLoadedMsg{changes: state.Journal}
+}
+
+// consumeAwayDigest drops the shown changes from the journal, keeping any the
+// sync daemon recorded after until.
+func consumeAwayDigest(until time.Time) tea.Cmd {
+	return func() tea.Msg {
+		state, err := config.LoadSyncState()
+		if err != nil {
+			return nil
+		}
+		if state.ConsumeJournal(until) > 0 {
+			if err := state.Save(); err != nil {
+				return errMsg{err}
+			}
+		}
+		return nil
+	}
+}
+
+// applyAwayDigest opens the digest when the journal has changes.
+func (a *App) applyAwayDigest(msg awayDigestLoadedMsg) {
+	if len(msg.changes) == 0 || a.onboarding {
+		return
+	}
+	a.away = msg.changes
+	a.awayOpen = true
+	a.notify("info", "While you were away: "+awayDigestSummary(a.away))
+}
+
+// handleAwayKeys closes the digest and marks its changes as seen; enter also
+// jumps to the inbox.
+func (a App) handleAwayKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if !isBack(msg) && !isEnter(msg) {
+		return a, nil
+	}
+	a.awayOpen = false
+	var until time.Time
+	for _, change := range a.away {
+		if change.At.After(until) {
+			until = change.At
+		}
+	}
+	a.away = nil
+	consume := consumeAwayDigest(until)
+	if isEnter(msg) {
+		model, cmd := a.switchTab(tabInbox)
+		return model, tea.Batch(cmd, consume)
+	}
+	return a, consume
+}
+
+// awayDigestSummary counts the journal as "N new approvals, M edited entities".
+func awayDigestSummary(changes []config.SyncChange) string {
+	counts := map[string]int{}
+	for _, change := range changes {
+		counts[change.Action+" "+change.Kind]++
+	}
+	var parts []string
+	for _, key := range []struct{ key, one, many string }{
+		{config.SyncActionNew + " " + config.SyncKindApproval, "new approval", "new approvals"},
+		{config.SyncActionNew + " " + config.SyncKindEntity, "new entity", "new entities"},
+		{config.SyncActionEdited + " " + config.SyncKindEntity, "edited entity", "edited entities"},
+	} {
+		switch n := counts[key.key]; {
+		case n == 1:
+			parts = append(parts, "1 "+key.one)
+		case n > 1:
+			parts = append(parts, fmt.Sprintf("%d %s", n, key.many))
+		}
+	}
+	if len(parts) == 0 {
+		return "no changes"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// renderAwayDigest renders the "while you were away" panel, newest first.
+func (a App) renderAwayDigest() string {
+	contentWidth := components.BoxContentWidth(a.width)
+	if contentWidth <= 0 {
+		contentWidth = 72
+	}
+	lines := []string{
+		AccentStyle.Render("What changed while you were away"),
+		NormalStyle.Render(awayDigestSummary(a.away)),
+		"",
+	}
+	for i := len(a.away) - 1; i >= 0 && len(a.away)-i <= awayDigestRows; i-- {
+		change := a.away[i]
+		label := change.Action + " " + change.Kind
+		name := components.SanitizeOneLine(change.Name)
+		if name == "" {
+			name = change.ID
+		}
+		prefix := fmt.Sprintf("%s  %-15s ", formatLocalTimeCompact(change.At), label)
+		lines = append(lines, MutedStyle.Render(prefix)+components.ClampTextWidthEllipsis(name, contentWidth-len(prefix)))
+	}
+	if hidden := len(a.away) - awayDigestRows; hidden > 0 {
+		lines = append(lines, MutedStyle.Render(fmt.Sprintf("...and %d more", hidden)))
+	}
+	return components.Indent(components.TitledBox("Away Digest", strings.Join(lines, "\n"), a.width), 1)
+}
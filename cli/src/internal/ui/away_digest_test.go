@@ -0,0 +1,63 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestAwayDigestShowsJournalAndConsumesOnDismiss(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	at := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	state := &config.SyncState{LastSync: at}
+	state.Record(config.SyncChange{Kind: config.SyncKindApproval, Action: config.SyncActionNew, ID: "ap-1", Name: "create_entity by scout", At: at})
+	state.Record(config.SyncChange{Kind: config.SyncKindApproval, Action: config.SyncActionNew, ID: "ap-2", At: at})
+	state.Record(config.SyncChange{Kind: config.SyncKindEntity, Action: config.SyncActionEdited, ID: "ent-1", Name: "Alpha", At: at})
+	require.NoError(t, state.Save())
+
+	app := NewApp(nil, &config.Config{})
+	app.width = 100
+	app.tabNav = false
+	model, _ := app.Update(loadAwayDigest())
+	app = model.(App)
+	require.True(t, app.awayOpen)
+
+	view := components.SanitizeText(app.View())
+	assert.Contains(t, view, "What changed while you were away")
+	assert.Contains(t, view, "2 new approvals, 1 edited entity")
+	assert.Contains(t, view, "create_entity by scout")
+	assert.Contains(t, view, "Alpha")
+	assert.Equal(t, "away digest", app.accessibleLocation())
+
+	// A journal entry written while the digest was open survives dismissal.
+	later, err := config.LoadSyncState()
+	require.NoError(t, err)
+	later.Record(config.SyncChange{Kind: config.SyncKindEntity, Action: config.SyncActionNew, ID: "ent-2", At: at.Add(time.Minute)})
+	require.NoError(t, later.Save())
+
+	model, cmd := app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	app = model.(App)
+	assert.False(t, app.awayOpen)
+	require.NotNil(t, cmd)
+	assert.Nil(t, cmd())
+
+	remaining, err := config.LoadSyncState()
+	require.NoError(t, err)
+	require.Len(t, remaining.Journal, 1)
+	assert.Equal(t, "ent-2", remaining.Journal[0].ID)
+}
+
+func TestAwayDigestStaysClosedWithoutChanges(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	app := NewApp(nil, &config.Config{})
+	model, _ := app.Update(loadAwayDigest())
+	app = model.(App)
+	assert.False(t, app.awayOpen)
+	assert.Empty(t, app.notifications)
+}
@@ -0,0 +1,416 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+type collectionsView int
+
+const (
+	collectionsViewList collectionsView = iota
+	collectionsViewDetail
+)
+
+type collectionsLoadedMsg struct {
+	items  []api.Collection
+	queued time.Time
+}
+
+type collectionLoadedMsg struct {
+	collection *api.Collection
+}
+
+// collectionSavedMsg reports a create, rename, or delete; the list reloads.
+type collectionSavedMsg struct {
+	notice string
+}
+
+// collectionFilterMsg asks the app to show the Entities tab filtered to a
+// collection.
+type collectionFilterMsg struct {
+	collection api.Collection
+}
+
+// collectionExportMsg asks the app to open the export wizard for a collection.
+type collectionExportMsg struct {
+	collection api.Collection
+}
+
+// CollectionsModel is the Collections tab: named groups of entities the user
+// pins together, browsable and usable as an Entities filter or export unit.
+type CollectionsModel struct {
+	client        *api.Client
+	items         []api.Collection
+	list          *components.List
+	view          collectionsView
+	detail        *api.Collection
+	detailList    *components.List
+	prompt        string
+	promptBuf     components.TextInput
+	confirmDelete bool
+	loading       bool
+	loadLatency   time.Duration
+	errText       string
+	width         int
+	height        int
+}
+
+// NewCollectionsModel builds the collections UI model.
+func NewCollectionsModel(client *api.Client) CollectionsModel {
+	return CollectionsModel{
+		client:     client,
+		list:       components.NewList(15),
+		detailList: components.NewList(15),
+	}
+}
+
+// Init handles init.
+func (m CollectionsModel) Init() tea.Cmd {
+	m.loading = true
+	return m.loadCollections()
+}
+
+// loadCollections fetches the user's collections.
+func (m CollectionsModel) loadCollections() tea.Cmd {
+	client := m.client
+	queued := time.Now()
+	return func() tea.Msg {
+		if client == nil {
+			return collectionsLoadedMsg{queued: queued}
+		}
+		items, err := client.ListCollections()
+		if err != nil {
+			return loadFailed(tabCollections, err, m.loadCollections())
+		}
+		return collectionsLoadedMsg{items: items, queued: queued}
+	}
+}
+
+// loadCollection fetches one collection with its entities.
+func (m CollectionsModel) loadCollection(id string) tea.Cmd {
+	client := m.client
+	return func() tea.Msg {
+		collection, err := client.GetCollection(id)
+		if err != nil {
+			return errMsg{fmt.Errorf("load collection: %w", err)}
+		}
+		return collectionLoadedMsg{collection: collection}
+	}
+}
+
+// Update updates update.
+func (m CollectionsModel) Update(msg tea.Msg) (CollectionsModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case collectionsLoadedMsg:
+		m.loading = false
+		m.loadLatency = loadElapsed(msg.queued)
+		m.errText = ""
+		m.items = msg.items
+		labels := make([]string, len(m.items))
+		for i, item := range m.items {
+			labels[i] = item.Name
+		}
+		m.list.SetItems(labels)
+		return m, nil
+	case collectionLoadedMsg:
+		m.errText = ""
+		m.detail = msg.collection
+		m.view = collectionsViewDetail
+		labels := make([]string, len(m.detail.Entities))
+		for i, entity := range m.detail.Entities {
+			labels[i] = entity.Name
+		}
+		m.detailList.SetItems(labels)
+		return m, nil
+	case collectionSavedMsg:
+		m.loading = true
+		return m, m.loadCollections()
+	case errMsg:
+		m.loading = false
+		m.errText = msg.err.Error()
+		return m, nil
+	case tea.KeyMsg:
+		if m.prompt != "" {
+			return m.handlePromptKeys(msg)
+		}
+		if m.confirmDelete {
+			return m.handleDeleteKeys(msg)
+		}
+		if m.view == collectionsViewDetail {
+			return m.handleDetailKeys(msg)
+		}
+		return m.handleListKeys(msg)
+	}
+	return m, nil
+}
+
+// selected returns the collection under the cursor.
+func (m CollectionsModel) selected() *api.Collection {
+	idx := m.list.Selected()
+	if idx < 0 || idx >= len(m.items) {
+		return nil
+	}
+	return &m.items[idx]
+}
+
+// handleListKeys handles handle list keys.
+func (m CollectionsModel) handleListKeys(msg tea.KeyMsg) (CollectionsModel, tea.Cmd) {
+	switch {
+	case isDown(msg):
+		m.list.Down()
+	case isUp(msg):
+		m.list.Up()
+	case isKey(msg, "n"):
+		m.prompt = "New Collection"
+		m.promptBuf.Reset()
+	case isEnter(msg):
+		if c := m.selected(); c != nil && m.client != nil {
+			m.detailList.SetItems(nil)
+			return m, m.loadCollection(c.ID)
+		}
+	case isKey(msg, "e"):
+		if c := m.selected(); c != nil {
+			m.prompt = "Rename Collection"
+			m.promptBuf.SetValue(c.Name)
+		}
+	case isKey(msg, "d"):
+		if m.selected() != nil {
+			m.confirmDelete = true
+		}
+	case isKey(msg, "f"):
+		if c := m.selected(); c != nil {
+			collection := *c
+			return m, func() tea.Msg { return collectionFilterMsg{collection: collection} }
+		}
+	case isKey(msg, "x"):
+		if c := m.selected(); c != nil {
+			collection := *c
+			return m, func() tea.Msg { return collectionExportMsg{collection: collection} }
+		}
+	}
+	return m, nil
+}
+
+// handleDetailKeys handles handle detail keys.
+func (m CollectionsModel) handleDetailKeys(msg tea.KeyMsg) (CollectionsModel, tea.Cmd) {
+	collection := *m.detail
+	switch {
+	case isBack(msg):
+		m.view = collectionsViewList
+		m.detail = nil
+		m.loading = true
+		return m, m.loadCollections()
+	case isDown(msg):
+		m.detailList.Down()
+	case isUp(msg):
+		m.detailList.Up()
+	case isEnter(msg):
+		if idx := m.detailList.Selected(); idx >= 0 && idx < len(collection.Entities) {
+			entity := collection.Entities[idx]
+			return m, func() tea.Msg { return searchSelectionMsg{kind: "entity", entity: &entity} }
+		}
+	case isKey(msg, "d"):
+		if idx := m.detailList.Selected(); idx >= 0 && idx < len(collection.Entities) && m.client != nil {
+			return m, m.removeEntity(collection.ID, collection.Entities[idx].ID)
+		}
+	case isKey(msg, "f"):
+		return m, func() tea.Msg { return collectionFilterMsg{collection: collection} }
+	case isKey(msg, "x"):
+		return m, func() tea.Msg { return collectionExportMsg{collection: collection} }
+	}
+	return m, nil
+}
+
+// handlePromptKeys edits the name for a new or renamed collection.
+func (m CollectionsModel) handlePromptKeys(msg tea.KeyMsg) (CollectionsModel, tea.Cmd) {
+	switch {
+	case isBack(msg):
+		m.prompt = ""
+		m.promptBuf.Reset()
+	case isEnter(msg):
+		name := strings.TrimSpace(m.promptBuf.Value)
+		if name == "" {
+			return m, nil
+		}
+		rename := m.prompt == "Rename Collection"
+		m.prompt = ""
+		m.promptBuf.Reset()
+		if m.client == nil {
+			return m, nil
+		}
+		if rename {
+			if c := m.selected(); c != nil {
+				return m, m.renameCollection(c.ID, name)
+			}
+			return m, nil
+		}
+		return m, m.createCollection(name)
+	case isKey(msg, "cmd+backspace", "cmd+delete", "ctrl+u"):
+		m.promptBuf.Reset()
+	default:
+		m.promptBuf.HandleKey(msg)
+	}
+	return m, nil
+}
+
+// handleDeleteKeys confirms deleting the selected collection.
+func (m CollectionsModel) handleDeleteKeys(msg tea.KeyMsg) (CollectionsModel, tea.Cmd) {
+	switch {
+	case isKey(msg, "y"), isEnter(msg):
+		m.confirmDelete = false
+		if c := m.selected(); c != nil && m.client != nil {
+			return m, m.deleteCollection(c.ID, c.Name)
+		}
+	case isKey(msg, "n"), isBack(msg):
+		m.confirmDelete = false
+	}
+	return m, nil
+}
+
+// createCollection creates a collection named name.
+func (m CollectionsModel) createCollection(name string) tea.Cmd {
+	client := m.client
+	return func() tea.Msg {
+		if _, err := client.CreateCollection(api.CreateCollectionInput{Name: name}); err != nil {
+			return errMsg{fmt.Errorf("create collection: %w", err)}
+		}
+		return collectionSavedMsg{notice: fmt.Sprintf("Collection %q created.", name)}
+	}
+}
+
+// renameCollection renames collection id to name.
+func (m CollectionsModel) renameCollection(id, name string) tea.Cmd {
+	client := m.client
+	return func() tea.Msg {
+		if _, err := client.UpdateCollection(id, api.UpdateCollectionInput{Name: &name}); err != nil {
+			return errMsg{fmt.Errorf("rename collection: %w", err)}
+		}
+		return collectionSavedMsg{notice: fmt.Sprintf("Collection renamed to %q.", name)}
+	}
+}
+
+// deleteCollection deletes collection id. Its entities are kept.
+func (m CollectionsModel) deleteCollection(id, name string) tea.Cmd {
+	client := m.client
+	return func() tea.Msg {
+		if err := client.DeleteCollection(id); err != nil {
+			return errMsg{fmt.Errorf("delete collection: %w", err)}
+		}
+		return collectionSavedMsg{notice: fmt.Sprintf("Collection %q deleted.", name)}
+	}
+}
+
+// removeEntity takes an entity out of a collection and reloads it.
+func (m CollectionsModel) removeEntity(id, entityID string) tea.Cmd {
+	client := m.client
+	return func() tea.Msg {
+		if err := client.RemoveCollectionEntity(id, entityID); err != nil {
+			return errMsg{fmt.Errorf("remove from collection: %w", err)}
+		}
+		collection, err := client.GetCollection(id)
+		if err != nil {
+			return errMsg{fmt.Errorf("load collection: %w", err)}
+		}
+		return collectionLoadedMsg{collection: collection}
+	}
+}
+
+// View renders the collections list, a collection's entities, or a prompt.
+func (m CollectionsModel) View() string {
+	if m.prompt != "" {
+		return components.Indent(components.InputDialog(m.prompt, m.promptBuf), 1)
+	}
+	if m.confirmDelete {
+		if c := m.selected(); c != nil {
+			body := fmt.Sprintf("Delete collection %q? Its %d entities are kept.\n\n%s",
+				components.SanitizeOneLine(c.Name), c.EntityCount, MutedStyle.Render("y to delete, n to cancel"))
+			return components.Indent(components.TitledBox("Delete Collection", body, m.width), 1)
+		}
+	}
+	if m.errText != "" {
+		return components.Indent(components.ErrorBox("Error", m.errText, m.width), 1)
+	}
+	if m.view == collectionsViewDetail && m.detail != nil {
+		return components.Indent(m.renderDetail(), 1)
+	}
+	if m.loading && len(m.items) == 0 {
+		return renderLoadingList("collections", 0, m.list, m.width, nil)
+	}
+	if len(m.items) == 0 {
+		return components.Indent(components.EmptyStateBox(
+			"Collections",
+			"No collections yet.",
+			[]string{"Press n to create one", "Select entities with space, then press C to add them"},
+			m.width,
+		), 1)
+	}
+	return components.Indent(m.renderList(), 1)
+}
+
+// renderList renders the collections table.
+func (m CollectionsModel) renderList() string {
+	contentWidth := components.BoxContentWidth(m.width)
+	countWidth := 8
+	nameWidth := max(contentWidth/3, 12)
+	descWidth := max(contentWidth-nameWidth-countWidth-2, 10)
+	cols := []components.TableColumn{
+		{Header: "Name", Width: nameWidth, Align: lipgloss.Left},
+		{Header: "Entities", Width: countWidth, Align: lipgloss.Right},
+		{Header: "Description", Width: descWidth, Align: lipgloss.Left},
+	}
+	activeRow := -1
+	var rows [][]string
+	for rel := range m.list.Visible() {
+		abs := m.list.RelToAbs(rel)
+		item := m.items[abs]
+		if m.list.IsSelected(abs) {
+			activeRow = len(rows)
+		}
+		desc := ""
+		if item.Description != nil {
+			desc = *item.Description
+		}
+		rows = append(rows, []string{
+			components.ClampTextWidthEllipsis(components.SanitizeOneLine(item.Name), nameWidth),
+			fmt.Sprintf("%d", item.EntityCount),
+			components.ClampTextWidthEllipsis(components.SanitizeOneLine(desc), descWidth),
+		})
+	}
+	countLine := MutedStyle.Render(fmt.Sprintf("%d collections", len(m.items))) + renderLoadLatency(m.loadLatency)
+	table := components.TableGridWithActiveRow(cols, rows, contentWidth, activeRow)
+	return components.TitledBox("Collections", countLine+"\n\n"+table, m.width)
+}
+
+// renderDetail renders a collection's entities.
+func (m CollectionsModel) renderDetail() string {
+	c := m.detail
+	contentWidth := components.BoxContentWidth(m.width)
+	var b strings.Builder
+	if c.Description != nil && strings.TrimSpace(*c.Description) != "" {
+		b.WriteString(MutedStyle.Render(components.SanitizeOneLine(*c.Description)) + "\n\n")
+	}
+	if len(c.Entities) == 0 {
+		b.WriteString(MutedStyle.Render("No entities you can see. Add some from Entities with space, then C."))
+		return components.TitledBox(components.SanitizeOneLine(c.Name), b.String(), m.width)
+	}
+	for rel := range m.detailList.Visible() {
+		abs := m.detailList.RelToAbs(rel)
+		entity := c.Entities[abs]
+		line := fmt.Sprintf("%s  %s", components.SanitizeOneLine(entity.Name), MutedStyle.Render(components.SanitizeOneLine(entity.Type)))
+		if m.detailList.IsSelected(abs) {
+			b.WriteString(SelectedStyle.Render("> "+components.ClampTextWidthEllipsis(components.SanitizeOneLine(entity.Name), contentWidth-4)) + "\n")
+			continue
+		}
+		b.WriteString("  " + line + "\n")
+	}
+	title := fmt.Sprintf("%s · %d entities", components.SanitizeOneLine(c.Name), len(c.Entities))
+	return components.TitledBox(title, strings.TrimRight(b.String(), "\n"), m.width)
+}
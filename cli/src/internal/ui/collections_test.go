@@ -0,0 +1,189 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestCollectionsListOpensDetailAndRemovesEntity(t *testing.T) {
+	removed := ""
+	members := []map[string]any{
+		{"id": "ent-1", "name": "Launch plan", "type": "project"},
+		{"id": "ent-2", "name": "Docs site", "type": "project"},
+	}
+	_, client := contextTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var data any
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/collections":
+			data = []map[string]any{{"id": "col-1", "name": "Q3 launch", "entity_count": 2}}
+		case r.Method == http.MethodGet && r.URL.Path == "/api/collections/col-1":
+			data = map[string]any{"id": "col-1", "name": "Q3 launch", "entities": members}
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/collections/col-1/entities/ent-1":
+			removed = "ent-1"
+			members = members[1:]
+			data = map[string]any{"removed": true}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": data}))
+	})
+
+	model := NewCollectionsModel(client)
+	model.width = 100
+	model, _ = model.Update(model.Init()())
+	assert.Contains(t, components.SanitizeText(model.View()), "Q3 launch")
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	model, _ = model.Update(cmd())
+	assert.Equal(t, collectionsViewDetail, model.view)
+	view := components.SanitizeText(model.View())
+	assert.Contains(t, view, "Launch plan")
+	assert.Contains(t, view, "Docs site")
+
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	require.NotNil(t, cmd)
+	model, _ = model.Update(cmd())
+	assert.Equal(t, "ent-1", removed)
+	assert.NotContains(t, components.SanitizeText(model.View()), "Launch plan")
+
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	require.NotNil(t, cmd)
+	filter, ok := cmd().(collectionFilterMsg)
+	require.True(t, ok)
+	assert.Equal(t, "col-1", filter.collection.ID)
+}
+
+func TestCollectionsCreateFromPrompt(t *testing.T) {
+	var created string
+	_, client := contextTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/api/collections" {
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			created, _ = body["name"].(string)
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "col-1", "name": created}}))
+	})
+
+	model := NewCollectionsModel(client)
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	require.Equal(t, "New Collection", model.prompt)
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Pins")})
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	saved, ok := cmd().(collectionSavedMsg)
+	require.True(t, ok)
+	assert.Equal(t, "Pins", created)
+	assert.Contains(t, saved.notice, "Pins")
+	assert.Empty(t, model.prompt)
+}
+
+func TestEntitiesAddSelectionToNewCollection(t *testing.T) {
+	var added []string
+	_, client := testEntitiesClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var data any
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/collections":
+			data = []map[string]any{{"id": "col-1", "name": "Onboarding", "entity_count": 4}}
+		case r.Method == http.MethodPost && r.URL.Path == "/api/collections":
+			data = map[string]any{"id": "col-2", "name": "Q3 launch"}
+		case r.Method == http.MethodPost && r.URL.Path == "/api/collections/col-2/entities":
+			var body struct {
+				EntityIDs []string `json:"entity_ids"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			added = body.EntityIDs
+			data = map[string]any{"added": 1, "requested": 2}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": data}))
+	})
+
+	model := NewEntitiesModel(client)
+	model.width = 100
+	model.bulkSelected = map[string]bool{"ent-1": true, "ent-2": true}
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("C")})
+	require.NotNil(t, cmd)
+	require.NotNil(t, model.collectionPick)
+	model, _ = model.Update(cmd())
+	assert.Contains(t, components.SanitizeText(model.View()), "Onboarding (4)")
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Q3")})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeySpace})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("launch")})
+	assert.Contains(t, components.SanitizeText(model.View()), `enter creates "Q3 launch"`)
+
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	assert.Nil(t, model.collectionPick)
+	msg, ok := cmd().(entityCollectionAddedMsg)
+	require.True(t, ok)
+	assert.ElementsMatch(t, []string{"ent-1", "ent-2"}, added)
+	assert.Equal(t, `Added 1 entities to "Q3 launch". 1 were already in it.`, msg.notice)
+
+	model, _ = model.Update(msg)
+	assert.Zero(t, model.bulkCount())
+}
+
+func TestCollectionFilterNarrowsEntitiesUntilEsc(t *testing.T) {
+	var queries []string
+	_, client := testEntitiesClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/entities" {
+			queries = append(queries, r.URL.Query().Get("collection_id"))
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []any{}}))
+	})
+	app := NewApp(client, &config.Config{})
+	app.tab = tabEntities
+
+	model, cmd := app.Update(collectionFilterMsg{collection: api.Collection{ID: "col-1", Name: "Q3 launch"}})
+	app = model.(App)
+	require.NotNil(t, cmd)
+	model, _ = app.Update(cmd())
+	app = model.(App)
+	assert.Equal(t, []string{"col-1"}, queries)
+	assert.Contains(t, components.SanitizeText(app.entities.View()), "No entities in collection Q3 launch match.")
+
+	app.entities, cmd = app.entities.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	require.NotNil(t, cmd)
+	cmd()
+	assert.Nil(t, app.entities.collection)
+	assert.Equal(t, []string{"col-1", ""}, queries)
+}
+
+func TestCollectionExportSendsCollectionFilter(t *testing.T) {
+	var query string
+	_, client := contextTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/export/entities", r.URL.Path)
+		query = r.URL.Query().Get("collection_id")
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+			"format": "json", "items": []map[string]any{{"id": "ent-1"}}, "count": 1,
+		}}))
+	})
+
+	model := NewImportExportModel(client)
+	model.StartCollectionExport(api.Collection{ID: "col-1", Name: "Q3 launch"})
+	require.Len(t, model.resources, 1)
+	assert.Equal(t, "Collection: Q3 launch", model.resources[0].label)
+
+	model.path.Value = filepath.Join(t.TempDir(), "q3.json")
+	model.step = stepRunning
+	model = runImportExportCmds(model, model.run())
+	assert.Equal(t, "col-1", query)
+	assert.Empty(t, model.errText)
+	assert.Contains(t, model.summary, "Exported 1 entities")
+}
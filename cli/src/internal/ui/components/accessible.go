@@ -0,0 +1,74 @@
+package components
+
+import "strings"
+
+var accessibleMode bool
+
+// SetAccessibleMode toggles screen-reader friendly rendering globally. When on,
+// boxes drop their borders, tables render as "Label: value" lines, and state
+// that is normally shown only by color gets a text marker instead.
+func SetAccessibleMode(enabled bool) {
+	accessibleMode = enabled
+}
+
+// AccessibleMode reports whether screen-reader friendly rendering is on.
+func AccessibleMode() bool {
+	return accessibleMode
+}
+
+// accessibleSection renders a plain "Title:" heading above content.
+func accessibleSection(title, content string) string {
+	title = strings.TrimSpace(SanitizeOneLine(title))
+	if title == "" {
+		return content
+	}
+	if strings.TrimSpace(content) == "" {
+		return title + ":"
+	}
+	return title + ":\n" + content
+}
+
+// accessibleTable renders key-value rows as one "Label: value" line each.
+func accessibleTable(title string, rows []TableRow) string {
+	lines := make([]string, 0, len(rows))
+	for _, r := range rows {
+		label := strings.TrimSpace(SanitizeOneLine(r.Label))
+		value := strings.TrimSpace(SanitizeOneLine(r.Value))
+		if label == "" {
+			lines = append(lines, value)
+			continue
+		}
+		lines = append(lines, label+": "+value)
+	}
+	return accessibleSection(title, strings.Join(lines, "\n"))
+}
+
+// accessibleGrid renders each grid row as one line of "Header: cell" pairs.
+// The active row is announced with a "selected" prefix instead of a color.
+func accessibleGrid(columns []TableColumn, rows [][]string, activeRow int) string {
+	lines := make([]string, 0, len(rows))
+	for i, row := range rows {
+		parts := make([]string, 0, len(columns))
+		for c, col := range columns {
+			if c >= len(row) {
+				break
+			}
+			cell := strings.TrimSpace(SanitizeOneLine(row[c]))
+			if cell == "" {
+				continue
+			}
+			header := strings.TrimSpace(SanitizeOneLine(col.Header))
+			if header == "" {
+				parts = append(parts, cell)
+				continue
+			}
+			parts = append(parts, header+": "+cell)
+		}
+		prefix := ""
+		if i == activeRow {
+			prefix = "selected, "
+		}
+		lines = append(lines, prefix+strings.Join(parts, "; "))
+	}
+	return strings.Join(lines, "\n")
+}
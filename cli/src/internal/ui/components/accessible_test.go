@@ -0,0 +1,45 @@
+package components
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessibleModeRendersLinearText(t *testing.T) {
+	SetAccessibleMode(true)
+	t.Cleanup(func() { SetAccessibleMode(false) })
+
+	assert.True(t, AccessibleMode())
+	assert.Equal(t, "body", Box("body", 80))
+	assert.Equal(t, "Details:\nbody", TitledBox("Details", "body", 80))
+	assert.Equal(t, "Error: boom", ErrorBox("Error", "boom", 80))
+	assert.Equal(t, "Entity:\nName: Alpha\nType: person", Table("Entity", []TableRow{
+		{Label: "Name", Value: "Alpha"},
+		{Label: "Type", Value: "person", ValueColor: "#ff0000"},
+	}, 80))
+
+	grid := TableGridWithActiveRow(
+		[]TableColumn{{Header: ""}, {Header: "Name"}, {Header: "Type"}},
+		[][]string{{"[ ]", "Alpha", "person"}, {"[X]", "Acme", ""}},
+		80,
+		1,
+	)
+	assert.Equal(t, "[ ]; Name: Alpha; Type: person\nselected, [X]; Name: Acme", grid)
+
+	assert.Equal(t, "Keys: enter Open, esc Back", StatusBar([]string{Hint("enter", "Open"), Hint("esc", "Back")}, 80))
+	assert.Equal(t, "Rename:\nInput: abc\nenter: submit, esc: cancel", InputDialog("Rename", NewTextInput("abc")))
+	assert.NotContains(t, ConfirmDialog("Archive", "Archive Alpha?"), "╭")
+}
+
+func TestAccessibleGridHonorsHiddenActiveRow(t *testing.T) {
+	SetAccessibleMode(true)
+	SetTableGridActiveRowsEnabled(false)
+	t.Cleanup(func() {
+		SetAccessibleMode(false)
+		SetTableGridActiveRowsEnabled(true)
+	})
+
+	grid := TableGridWithActiveRow([]TableColumn{{Header: "Name"}}, [][]string{{"Alpha"}}, 80, 0)
+	assert.Equal(t, "Name: Alpha", grid)
+}
@@ -80,6 +80,9 @@ func safeBoxWidth(width int) int {
 
 // renderBox renders render box.
 func renderBox(style lipgloss.Style, targetWidth int, content string) string {
+	if accessibleMode {
+		return content
+	}
 	width := safeBoxWidth(targetWidth)
 	if width <= 0 {
 		return style.Render(content)
@@ -148,6 +151,12 @@ func ActiveBox(content string, width int) string {
 
 // ErrorBox renders a red bordered box for errors.
 func ErrorBox(title, message string, width int) string {
+	if accessibleMode {
+		if title == "" {
+			return message
+		}
+		return SanitizeOneLine(title) + ": " + message
+	}
 	header := ""
 	if title != "" {
 		header = errorHeaderStyle.Render(title) + "\n\n"
@@ -192,8 +201,12 @@ func TitledBoxWithHeaderStyle(title, content string, width int, headerStyle lipg
 }
 
 // titledBoxWithStyle renders the standard closed border variant.
-// Title parameters are intentionally ignored to keep top borders fully closed.
-func titledBoxWithStyle(_ string, content string, width int, boxStyle, _ lipgloss.Style, _ lipgloss.Color) string {
+// Title parameters are intentionally ignored to keep top borders fully closed,
+// except in accessible mode where the title becomes a plain heading.
+func titledBoxWithStyle(title string, content string, width int, boxStyle, _ lipgloss.Style, _ lipgloss.Color) string {
+	if accessibleMode {
+		return accessibleSection(title, content)
+	}
 	return renderBox(boxStyle, width, content)
 }
 
@@ -255,6 +268,9 @@ func Table(title string, rows []TableRow, width int) string {
 	if len(rows) == 0 {
 		return ""
 	}
+	if accessibleMode {
+		return accessibleTable(title, rows)
+	}
 
 	// Find max label width for alignment
 	maxLabel := 0
@@ -427,6 +443,10 @@ type DiffRow struct {
 	Label string
 	From  string
 	To    string
+	// Old and New optionally carry the structured values; DiffView expands
+	// nested maps per key when both are set.
+	Old any
+	New any
 }
 
 // DiffTable renders a tabular before/after diff using Nebula table grid styling.
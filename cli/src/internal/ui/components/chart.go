@@ -0,0 +1,81 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// sparkBlocks are the glyphs a sparkline draws with, lowest first.
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+var (
+	chartBarStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("#7f57b4"))
+	chartTrackStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#273540"))
+	chartLabelStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#d7d9da"))
+	chartValueStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#9ba0bf"))
+)
+
+// BarRow is one labeled bar in a BarChart.
+type BarRow struct {
+	Label string
+	Value int
+}
+
+// Sparkline draws values as one glyph each, scaled to the largest value.
+// Zero stays on the baseline so any nonzero value is visibly higher.
+func Sparkline(values []int) string {
+	peak := 0
+	for _, v := range values {
+		if v > peak {
+			peak = v
+		}
+	}
+	var b strings.Builder
+	for _, v := range values {
+		idx := 0
+		if v > 0 && peak > 0 {
+			idx = (v*(len(sparkBlocks)-1) + peak - 1) / peak
+		}
+		b.WriteRune(sparkBlocks[idx])
+	}
+	return chartBarStyle.Render(b.String())
+}
+
+// BarChart draws horizontal bars scaled to the largest value, each with its
+// label on the left and its value on the right, fit to width.
+func BarChart(rows []BarRow, width int) string {
+	if len(rows) == 0 {
+		return ""
+	}
+	labelWidth, valueWidth, peak := 0, 0, 0
+	for _, row := range rows {
+		labelWidth = max(labelWidth, lipgloss.Width(SanitizeOneLine(row.Label)))
+		valueWidth = max(valueWidth, len(fmt.Sprintf("%d", row.Value)))
+		peak = max(peak, row.Value)
+	}
+	if width <= 0 {
+		width = 60
+	}
+	labelWidth = min(labelWidth, max(width/3, 8))
+	barWidth := width - labelWidth - valueWidth - 2
+	if barWidth < 4 {
+		barWidth = 4
+	}
+
+	lines := make([]string, 0, len(rows))
+	for _, row := range rows {
+		filled := 0
+		if peak > 0 && row.Value > 0 {
+			filled = max(1, row.Value*barWidth/peak)
+		}
+		label := ClampTextWidthEllipsis(SanitizeOneLine(row.Label), labelWidth)
+		label += strings.Repeat(" ", labelWidth-lipgloss.Width(label))
+		lines = append(lines, chartLabelStyle.Render(label)+" "+
+			chartBarStyle.Render(strings.Repeat("█", filled))+
+			chartTrackStyle.Render(strings.Repeat("·", barWidth-filled))+" "+
+			chartValueStyle.Render(fmt.Sprintf("%*d", valueWidth, row.Value)))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,29 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSparklineScalesToPeak handles test sparkline scales to peak.
+func TestSparklineScalesToPeak(t *testing.T) {
+	assert.Equal(t, "▁▂▅█", SanitizeText(Sparkline([]int{0, 1, 4, 7})))
+	assert.Equal(t, "▁▁▁", SanitizeText(Sparkline([]int{0, 0, 0})))
+	assert.Equal(t, "", SanitizeText(Sparkline(nil)))
+}
+
+// TestBarChartScalesBarsAndAlignsValues handles test bar chart scales bars and aligns values.
+func TestBarChartScalesBarsAndAlignsValues(t *testing.T) {
+	out := SanitizeText(BarChart([]BarRow{{Label: "alpha", Value: 10}, {Label: "b", Value: 5}, {Label: "none", Value: 0}}, 30))
+	lines := strings.Split(out, "\n")
+	assert.Len(t, lines, 3)
+	assert.Equal(t, 30, len([]rune(lines[0])))
+	assert.Equal(t, 21, strings.Count(lines[0], "█"))
+	assert.Equal(t, 10, strings.Count(lines[1], "█"))
+	assert.Equal(t, 0, strings.Count(lines[2], "█"))
+	assert.True(t, strings.HasPrefix(lines[1], "b     "))
+	assert.True(t, strings.HasSuffix(lines[1], " 5"))
+	assert.Equal(t, "", BarChart(nil, 30))
+}
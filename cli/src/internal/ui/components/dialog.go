@@ -12,8 +12,15 @@ var dialogStyle = lipgloss.NewStyle().
 	Padding(1, 2).
 	Width(40)
 
+// inputDialogFieldWidth is the room left for the input after the dialog
+// border, padding, and "> " prompt.
+const inputDialogFieldWidth = 34
+
 // ConfirmDialog renders a yes/no confirmation.
 func ConfirmDialog(title, message string) string {
+	if accessibleMode {
+		return accessibleSection(title, message+"\nenter: confirm, esc: cancel, y/n: alias")
+	}
 	header := lipgloss.NewStyle().
 		Foreground(lipgloss.Color("#7f57b4")).
 		Bold(true).
@@ -30,8 +37,11 @@ func ConfirmDialog(title, message string) string {
 	return dialogStyle.Render(header + "\n\n" + body + hint)
 }
 
-// InputDialog renders a text input prompt.
-func InputDialog(title, input string) string {
+// InputDialog renders a text input prompt with the input's cursor.
+func InputDialog(title string, input TextInput) string {
+	if accessibleMode {
+		return accessibleSection(title, "Input: "+input.Value+"\nenter: submit, esc: cancel")
+	}
 	header := lipgloss.NewStyle().
 		Foreground(lipgloss.Color("#7f57b4")).
 		Bold(true).
@@ -39,7 +49,7 @@ func InputDialog(title, input string) string {
 
 	field := lipgloss.NewStyle().
 		Foreground(lipgloss.Color("#436b77")).
-		Render("> " + input + "█")
+		Render("> " + input.View(lipgloss.NewStyle(), inputDialogFieldWidth))
 
 	hint := lipgloss.NewStyle().
 		Foreground(lipgloss.Color("#9ba0bf")).
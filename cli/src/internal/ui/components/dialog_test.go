@@ -21,7 +21,7 @@ func TestConfirmDialogIncludesTitleMessageAndHints(t *testing.T) {
 
 // TestInputDialogIncludesTitleInputAndHints handles test input dialog includes title input and hints.
 func TestInputDialogIncludesTitleInputAndHints(t *testing.T) {
-	out := InputDialog("Filter", "hello")
+	out := InputDialog("Filter", NewTextInput("hello"))
 	clean := SanitizeText(out)
 
 	assert.Contains(t, clean, "Filter")
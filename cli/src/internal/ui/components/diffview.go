@@ -0,0 +1,285 @@
+package components
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// DiffViewSideBySideMinWidth is the content width at which DiffView splits
+// before and after into columns instead of stacking them.
+const DiffViewSideBySideMinWidth = 96
+
+// diffWordTokenLimit caps word-level diffing; longer values highlight whole lines.
+const diffWordTokenLimit = 600
+
+const diffViewMaxLines = 6
+
+var (
+	diffWordRemovedStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("#ffe4e8")).
+				Background(lipgloss.Color("#7a2333")).
+				Bold(true)
+	diffWordAddedStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("#e3fff3")).
+				Background(lipgloss.Color("#1f5a44")).
+				Bold(true)
+	diffCollapsedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#9ba0bf")).Italic(true)
+	diffGutterStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("#4b4f6b"))
+)
+
+// diffSegment is one run of text in a word diff.
+type diffSegment struct {
+	text    string
+	changed bool
+}
+
+// diffViewRow is a DiffRow after nested values are expanded.
+type diffViewRow struct {
+	DiffRow
+	collapsed int
+}
+
+// DiffView renders before/after changes with word-level highlighting. Wide
+// terminals get a side-by-side layout; rows carrying structured Old/New maps
+// are expanded per nested key with unchanged keys collapsed.
+func DiffView(title string, rows []DiffRow, width int) string {
+	if len(rows) == 0 {
+		return ""
+	}
+	contentWidth := BoxContentWidth(width)
+	if contentWidth <= 0 {
+		contentWidth = 72
+	}
+	if contentWidth < 44 {
+		contentWidth = 44
+	}
+
+	expanded := make([]diffViewRow, 0, len(rows))
+	for _, row := range rows {
+		expanded = append(expanded, expandDiffRow(row)...)
+	}
+
+	blocks := make([]string, 0, len(expanded))
+	for _, row := range expanded {
+		blocks = append(blocks, renderDiffViewRow(row, contentWidth))
+	}
+	return TitledBox(title, strings.Join(blocks, "\n\n"), width)
+}
+
+// renderDiffViewRow renders one field header and its before/after values.
+func renderDiffViewRow(row diffViewRow, width int) string {
+	label := diffLabelStyle.Render(SanitizeOneLine(row.Label))
+	if row.collapsed > 0 {
+		noun := "keys"
+		if row.collapsed == 1 {
+			noun = "key"
+		}
+		return label + "  " + diffCollapsedStyle.Render(fmt.Sprintf("… %d unchanged %s", row.collapsed, noun))
+	}
+
+	kind := diffChangeKind(row.From, row.To)
+	header := label + "  " + styleDiffCellByHeader("change", kind, kind)
+	before, after := wordDiffSegments(normalizeDiffRawValue(row.From), normalizeDiffRawValue(row.To))
+
+	if width >= DiffViewSideBySideMinWidth {
+		colWidth := (width - 3) / 2
+		left := renderDiffSide("- ", before, gridDiffBeforeStyle, diffWordRemovedStyle, colWidth)
+		right := renderDiffSide("+ ", after, gridDiffAfterStyle, diffWordAddedStyle, colWidth)
+		gutter := diffGutterStyle.Render(strings.TrimSuffix(strings.Repeat(" │ \n", maxInt(lipgloss.Height(left), lipgloss.Height(right))), "\n"))
+		return header + "\n" + lipgloss.JoinHorizontal(lipgloss.Top, left, gutter, right)
+	}
+	return header + "\n" +
+		renderDiffSide("- ", before, gridDiffBeforeStyle, diffWordRemovedStyle, width) + "\n" +
+		renderDiffSide("+ ", after, gridDiffAfterStyle, diffWordAddedStyle, width)
+}
+
+// renderDiffSide wraps one side of a diff, highlighting changed words.
+func renderDiffSide(prefix string, segments []diffSegment, base, highlight lipgloss.Style, width int) string {
+	var b strings.Builder
+	for _, seg := range segments {
+		style := base
+		if seg.changed && strings.TrimSpace(seg.text) != "" {
+			style = highlight
+		}
+		// Style each line separately so wrapping never splits an escape sequence.
+		parts := strings.Split(seg.text, "\n")
+		for i, part := range parts {
+			if i > 0 {
+				b.WriteString("\n")
+			}
+			if part != "" {
+				b.WriteString(style.Render(part))
+			}
+		}
+	}
+
+	bodyWidth := maxInt(6, width-lipgloss.Width(prefix))
+	wrapped := strings.Split(lipgloss.NewStyle().Width(bodyWidth).Render(b.String()), "\n")
+	if !diffFullModeEnabled() && len(wrapped) > diffViewMaxLines {
+		hidden := len(wrapped) - diffViewMaxLines
+		wrapped = append(wrapped[:diffViewMaxLines], diffCollapsedStyle.Render(fmt.Sprintf("... (+%d more lines)", hidden)))
+	}
+	indent := strings.Repeat(" ", lipgloss.Width(prefix))
+	for i, line := range wrapped {
+		lead := indent
+		if i == 0 {
+			lead = base.Render(prefix)
+		}
+		wrapped[i] = padRight(lead+line, width)
+	}
+	return strings.Join(wrapped, "\n")
+}
+
+// wordDiffSegments splits two values into kept and changed word runs.
+func wordDiffSegments(from, to string) ([]diffSegment, []diffSegment) {
+	from = SanitizeText(from)
+	to = SanitizeText(to)
+	if from == to {
+		return []diffSegment{{text: from}}, []diffSegment{{text: to}}
+	}
+	if from == "None" || to == "None" {
+		return []diffSegment{{text: from, changed: from != "None"}}, []diffSegment{{text: to, changed: to != "None"}}
+	}
+	a, b := diffTokens(from), diffTokens(to)
+	if len(a)*len(b) > diffWordTokenLimit*diffWordTokenLimit {
+		return []diffSegment{{text: from, changed: true}}, []diffSegment{{text: to, changed: true}}
+	}
+
+	// Longest common subsequence over word and whitespace tokens.
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = maxInt(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var before, after []diffSegment
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			before = appendDiffSegment(before, a[i], false)
+			after = appendDiffSegment(after, b[j], false)
+			i++
+			j++
+		case j < len(b) && (i == len(a) || lcs[i][j+1] >= lcs[i+1][j]):
+			after = appendDiffSegment(after, b[j], true)
+			j++
+		default:
+			before = appendDiffSegment(before, a[i], true)
+			i++
+		}
+	}
+	return before, after
+}
+
+// appendDiffSegment merges adjacent tokens with the same change state.
+func appendDiffSegment(segments []diffSegment, text string, changed bool) []diffSegment {
+	if n := len(segments); n > 0 && segments[n-1].changed == changed {
+		segments[n-1].text += text
+		return segments
+	}
+	return append(segments, diffSegment{text: text, changed: changed})
+}
+
+// diffTokens splits text into alternating word and whitespace tokens.
+func diffTokens(text string) []string {
+	tokens := []string{}
+	start := 0
+	inSpace := false
+	for idx, r := range text {
+		space := r == ' ' || r == '\t' || r == '\n'
+		if idx > 0 && space != inSpace {
+			tokens = append(tokens, text[start:idx])
+			start = idx
+		}
+		inSpace = space
+	}
+	if start < len(text) {
+		tokens = append(tokens, text[start:])
+	}
+	return tokens
+}
+
+// expandDiffRow turns a row with nested Old/New maps into one row per changed
+// key, collapsing unchanged keys unless NEBULA_DIFF_FULL is set.
+func expandDiffRow(row DiffRow) []diffViewRow {
+	oldMap, oldOK := normalizeStructuredValue(row.Old).(map[string]any)
+	newMap, newOK := normalizeStructuredValue(row.New).(map[string]any)
+	if !oldOK || !newOK {
+		return []diffViewRow{{DiffRow: row}}
+	}
+	out := expandDiffMaps(row.Label, oldMap, newMap)
+	if len(out) == 0 {
+		return []diffViewRow{{DiffRow: row}}
+	}
+	return out
+}
+
+// expandDiffMaps walks two maps, emitting changed leaves and collapsed counts.
+func expandDiffMaps(prefix string, before, after map[string]any) []diffViewRow {
+	keys := make([]string, 0, len(before)+len(after))
+	for key := range before {
+		keys = append(keys, key)
+	}
+	for key := range after {
+		if _, ok := before[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	rows := []diffViewRow{}
+	unchanged := 0
+	for _, key := range keys {
+		label := prefix + "." + key
+		from, to := before[key], after[key]
+		if reflect.DeepEqual(from, to) {
+			if diffFullModeEnabled() {
+				value := formatDiffViewValue(from)
+				rows = append(rows, diffViewRow{DiffRow: DiffRow{Label: label, From: value, To: value}})
+				continue
+			}
+			unchanged++
+			continue
+		}
+		fromMap, fromOK := from.(map[string]any)
+		toMap, toOK := to.(map[string]any)
+		if fromOK && toOK {
+			rows = append(rows, expandDiffMaps(label, fromMap, toMap)...)
+			continue
+		}
+		rows = append(rows, diffViewRow{DiffRow: DiffRow{
+			Label: label,
+			From:  formatDiffViewValue(from),
+			To:    formatDiffViewValue(to),
+		}})
+	}
+	if unchanged > 0 {
+		rows = append(rows, diffViewRow{DiffRow: DiffRow{Label: prefix}, collapsed: unchanged})
+	}
+	return rows
+}
+
+// formatDiffViewValue renders a nested value the way metadata tables do.
+func formatDiffViewValue(value any) string {
+	if value == nil {
+		return "None"
+	}
+	lines := renderMetadataValueLines(value, 0)
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
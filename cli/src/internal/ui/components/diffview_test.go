@@ -0,0 +1,109 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// joinSegments renders segments with changed runs wrapped in brackets.
+func joinSegments(segments []diffSegment) string {
+	var b strings.Builder
+	for _, seg := range segments {
+		if seg.changed {
+			b.WriteString("[" + seg.text + "]")
+			continue
+		}
+		b.WriteString(seg.text)
+	}
+	return b.String()
+}
+
+// TestWordDiffSegmentsHighlightsChangedWords handles test word diff segments highlights changed words.
+func TestWordDiffSegmentsHighlightsChangedWords(t *testing.T) {
+	before, after := wordDiffSegments("ship the beta build today", "ship the stable build tomorrow")
+	assert.Equal(t, "ship the [beta] build [today]", joinSegments(before))
+	assert.Equal(t, "ship the [stable] build [tomorrow]", joinSegments(after))
+
+	before, after = wordDiffSegments("None", "added value")
+	assert.Equal(t, "None", joinSegments(before))
+	assert.Equal(t, "[added value]", joinSegments(after))
+
+	before, after = wordDiffSegments("same", "same")
+	assert.Equal(t, "same", joinSegments(before))
+	assert.Equal(t, "same", joinSegments(after))
+}
+
+// TestDiffViewSwitchesLayoutByWidth handles test diff view switches layout by width.
+func TestDiffViewSwitchesLayoutByWidth(t *testing.T) {
+	rows := []DiffRow{{Label: "status", From: "pending review", To: "approved review"}}
+
+	wide := stripANSI(DiffView("Changes", rows, 140))
+	require.NotEmpty(t, wide)
+	var sideBySide bool
+	for _, line := range strings.Split(wide, "\n") {
+		if strings.Contains(line, "- pending review") && strings.Contains(line, "+ approved review") {
+			sideBySide = true
+			assert.Contains(t, line, "│")
+		}
+	}
+	assert.True(t, sideBySide, wide)
+
+	narrow := stripANSI(DiffView("Changes", rows, 60))
+	for _, line := range strings.Split(narrow, "\n") {
+		assert.False(t, strings.Contains(line, "- pending") && strings.Contains(line, "+ approved"), line)
+		assert.LessOrEqual(t, lipgloss.Width(line), 60)
+	}
+	assert.Contains(t, narrow, "updated")
+
+	assert.Equal(t, "", DiffView("Changes", nil, 80))
+}
+
+// TestDiffViewCollapsesUnchangedNestedKeys handles test diff view collapses unchanged nested keys.
+func TestDiffViewCollapsesUnchangedNestedKeys(t *testing.T) {
+	t.Setenv("NEBULA_DIFF_FULL", "")
+	before := map[string]any{
+		"owner":  "ops",
+		"region": "eu",
+		"limits": map[string]any{"cpu": 2, "memory": "4g"},
+	}
+	after := map[string]any{
+		"owner":  "ops",
+		"region": "eu",
+		"limits": map[string]any{"cpu": 4, "memory": "4g"},
+	}
+	rows := []DiffRow{{Label: "metadata", From: "x", To: "y", Old: before, New: after}}
+
+	expanded := expandDiffRow(rows[0])
+	require.Len(t, expanded, 3)
+	assert.Equal(t, "metadata.limits.cpu", expanded[0].Label)
+	assert.Equal(t, "2", expanded[0].From)
+	assert.Equal(t, "4", expanded[0].To)
+	assert.Equal(t, 1, expanded[1].collapsed)
+	assert.Equal(t, 2, expanded[2].collapsed)
+
+	out := stripANSI(DiffView("Changes", rows, 80))
+	assert.Contains(t, out, "metadata.limits.cpu")
+	assert.Contains(t, out, "… 2 unchanged keys")
+	assert.NotContains(t, out, "region")
+
+	t.Setenv("NEBULA_DIFF_FULL", "1")
+	full := stripANSI(DiffView("Changes", rows, 80))
+	assert.Contains(t, full, "metadata.region")
+	assert.NotContains(t, full, "unchanged")
+}
+
+// TestDiffViewExpandsJSONEncodedStrings handles test diff view expands json encoded strings.
+func TestDiffViewExpandsJSONEncodedStrings(t *testing.T) {
+	row := DiffRow{Label: "metadata", Old: `{"a":1,"b":2}`, New: `{"a":1,"b":3}`}
+	expanded := expandDiffRow(row)
+	require.Len(t, expanded, 2)
+	assert.Equal(t, "metadata.b", expanded[0].Label)
+
+	plain := expandDiffRow(DiffRow{Label: "name", From: "a", To: "b", Old: "a", New: "b"})
+	require.Len(t, plain, 1)
+	assert.Equal(t, "name", plain[0].Label)
+}
@@ -0,0 +1,56 @@
+package components
+
+// rowCacheLimit bounds how many rows a cache keeps before it starts over.
+const rowCacheLimit = 10000
+
+// RowCache memoizes rendered rows by item ID for one render width, so moving
+// the cursor through a long list only formats rows that changed. A nil cache
+// renders every row.
+type RowCache struct {
+	width int
+	rows  map[string]cachedRow
+}
+
+type cachedRow struct {
+	version string
+	cells   []string
+}
+
+// NewRowCache creates an empty row cache.
+func NewRowCache() *RowCache {
+	return &RowCache{rows: map[string]cachedRow{}}
+}
+
+// Row returns the cells for id, calling render only when the row is missing,
+// its version changed, or width differs from the cached rows.
+func (c *RowCache) Row(id, version string, width int, render func() []string) []string {
+	if c == nil || id == "" {
+		return render()
+	}
+	if width != c.width || len(c.rows) >= rowCacheLimit {
+		c.Reset()
+		c.width = width
+	}
+	if row, ok := c.rows[id]; ok && row.version == version {
+		return row.cells
+	}
+	cells := render()
+	c.rows[id] = cachedRow{version: version, cells: cells}
+	return cells
+}
+
+// Len returns the number of cached rows.
+func (c *RowCache) Len() int {
+	if c == nil {
+		return 0
+	}
+	return len(c.rows)
+}
+
+// Reset drops every cached row.
+func (c *RowCache) Reset() {
+	if c == nil {
+		return
+	}
+	c.rows = map[string]cachedRow{}
+}
@@ -0,0 +1,45 @@
+package components
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRowCacheRendersOnlyChangedRows handles test row cache renders only changed rows.
+func TestRowCacheRendersOnlyChangedRows(t *testing.T) {
+	cache := NewRowCache()
+	renders := 0
+	render := func(text string) func() []string {
+		return func() []string {
+			renders++
+			return []string{text}
+		}
+	}
+
+	assert.Equal(t, []string{"a"}, cache.Row("1", "v1", 80, render("a")))
+	assert.Equal(t, []string{"a"}, cache.Row("1", "v1", 80, render("stale")))
+	assert.Equal(t, 1, renders)
+
+	assert.Equal(t, []string{"b"}, cache.Row("1", "v2", 80, render("b")))
+	assert.Equal(t, 2, renders)
+
+	cache.Row("2", "v1", 80, render("c"))
+	assert.Equal(t, 2, cache.Len())
+
+	// A width change re-renders everything.
+	assert.Equal(t, []string{"d"}, cache.Row("1", "v2", 100, render("d")))
+	assert.Equal(t, 1, cache.Len())
+}
+
+// TestRowCacheNilAndEmptyIDAlwaysRender handles test row cache nil and empty id always render.
+func TestRowCacheNilAndEmptyIDAlwaysRender(t *testing.T) {
+	var cache *RowCache
+	assert.Equal(t, []string{"x"}, cache.Row("1", "v", 80, func() []string { return []string{"x"} }))
+	assert.Zero(t, cache.Len())
+	cache.Reset()
+
+	cache = NewRowCache()
+	cache.Row("", "v", 80, func() []string { return []string{"x"} })
+	assert.Zero(t, cache.Len())
+}
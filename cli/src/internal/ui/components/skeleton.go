@@ -0,0 +1,55 @@
+package components
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// skeletonDefaultRows is shown before a list has ever loaded.
+	skeletonDefaultRows = 5
+	// skeletonMaxRows caps placeholder rows to one screen of list.
+	skeletonMaxRows = 15
+)
+
+var (
+	skeletonBarStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#273540"))
+	staleStyle       = lipgloss.NewStyle().Foreground(lipgloss.Color("#5c6370"))
+)
+
+// skeletonWidths are the bar lengths of successive rows, as a share of the
+// row width, so placeholders read as rows of varying text.
+var skeletonWidths = []float64{0.72, 0.55, 0.64, 0.48, 0.68, 0.6}
+
+// Skeleton renders a loading label over placeholder rows. rows is the last
+// known list length, so the layout keeps its height while data loads.
+func Skeleton(label string, rows, width int) string {
+	if rows <= 0 {
+		rows = skeletonDefaultRows
+	}
+	if rows > skeletonMaxRows {
+		rows = skeletonMaxRows
+	}
+	rowWidth := BoxContentWidth(width)
+	lines := make([]string, 0, rows+1)
+	lines = append(lines, boxMutedStyle.Render(SanitizeOneLine(label)))
+	for i := 0; i < rows; i++ {
+		bar := int(float64(rowWidth) * skeletonWidths[i%len(skeletonWidths)])
+		if bar < 4 {
+			bar = 4
+		}
+		lines = append(lines, skeletonBarStyle.Render(strings.Repeat("░", bar)))
+	}
+	return Box(strings.Join(lines, "\n"), width)
+}
+
+// Stale greys out a view that is being refreshed and puts label above it, so
+// routine reloads keep the previous data on screen instead of blanking.
+func Stale(label, view string) string {
+	lines := strings.Split(SanitizeText(view), "\n")
+	for i, line := range lines {
+		lines[i] = staleStyle.Render(line)
+	}
+	return boxMutedStyle.Render("  "+SanitizeOneLine(label)) + "\n" + strings.Join(lines, "\n")
+}
@@ -0,0 +1,33 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSkeletonSizesRowsToLastKnownLength(t *testing.T) {
+	count := func(view string) int {
+		n := 0
+		for _, line := range strings.Split(SanitizeText(view), "\n") {
+			if strings.Contains(line, "░") {
+				n++
+			}
+		}
+		return n
+	}
+
+	view := Skeleton("Loading entities...", 3, 60)
+	assert.Contains(t, SanitizeText(view), "Loading entities...")
+	assert.Equal(t, 3, count(view))
+	assert.Equal(t, skeletonDefaultRows, count(Skeleton("Loading...", 0, 60)))
+	assert.Equal(t, skeletonMaxRows, count(Skeleton("Loading...", 400, 60)))
+}
+
+func TestStaleKeepsContentUnderLabel(t *testing.T) {
+	view := Stale("Refreshing jobs...", "\x1b[1mjob-1\x1b[0m\njob-2")
+	plain := SanitizeText(view)
+	assert.True(t, strings.HasPrefix(plain, "  Refreshing jobs...\n"))
+	assert.Contains(t, plain, "job-1\njob-2")
+}
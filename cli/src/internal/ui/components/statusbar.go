@@ -1,6 +1,10 @@
 package components
 
-import "github.com/charmbracelet/lipgloss"
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
 
 var (
 	hintDescStyle = lipgloss.NewStyle().
@@ -20,6 +24,9 @@ var (
 
 // StatusBar renders the bottom hint bar separated from content by a border line.
 func StatusBar(hints []string, width int) string {
+	if accessibleMode {
+		return "Keys: " + strings.Join(hints, ", ")
+	}
 	segments := make([]string, 0, len(hints))
 	for _, h := range hints {
 		segments = append(segments, segmentStyle.Render(h))
@@ -39,6 +46,9 @@ func StatusBar(hints []string, width int) string {
 
 // Hint formats a single keybind hint like "↑/↓ Scroll".
 func Hint(key, desc string) string {
+	if accessibleMode {
+		return key + " " + desc
+	}
 	keyText := keyCapStyle.Render(key)
 	return hintDescStyle.Render(desc+" ") + keyText
 }
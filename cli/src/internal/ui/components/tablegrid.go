@@ -14,6 +14,9 @@ type TableColumn struct {
 	Header string
 	Width  int
 	Align  lipgloss.Position
+	// CellStyle, when set, styles body cells by their text. Active rows keep
+	// the highlight instead.
+	CellStyle func(text string) lipgloss.Style
 }
 
 const (
@@ -69,6 +72,9 @@ func TableGridWithActiveRow(columns []TableColumn, rows [][]string, tableWidth i
 	if !tableGridActiveRowsEnabled {
 		activeRow = -1
 	}
+	if accessibleMode {
+		return accessibleGrid(columns, rows, activeRow)
+	}
 	if tableWidth <= 0 {
 		return ""
 	}
@@ -238,6 +244,8 @@ func renderGridRow(columns []TableColumn, cells []string, sep string, tableWidth
 			rendered = headerStyle.Inline(true).Render(rendered)
 		} else if active {
 			rendered = cellStyle.Inline(true).Render(rendered)
+		} else if col.CellStyle != nil {
+			rendered = col.CellStyle(text).Inline(true).Render(rendered)
 		}
 		if !header {
 			rendered = highlightSelectionMarkers(rendered)
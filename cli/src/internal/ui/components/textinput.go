@@ -0,0 +1,244 @@
+package components
+
+import (
+	"strings"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/rivo/uniseg"
+)
+
+// TextInput is a single-line text field. It edits by grapheme cluster, so
+// accented letters, CJK, and emoji sequences are never split, and it keeps a
+// cursor that moves left and right.
+//
+// Value may be assigned directly. The cursor keeps its distance from the end
+// of the text, so a freshly assigned value leaves it at the end.
+type TextInput struct {
+	Value string
+	// tail is how many clusters sit after the cursor.
+	tail int
+}
+
+// NewTextInput returns an input holding value with the cursor at the end.
+func NewTextInput(value string) TextInput {
+	return TextInput{Value: value}
+}
+
+// oneLine flattens the line breaks and tabs SanitizeText keeps.
+var oneLine = strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ", "\t", " ")
+
+// splitClusters splits s into grapheme clusters and their display widths.
+func splitClusters(s string) ([]string, []int) {
+	var clusters []string
+	var widths []int
+	state := -1
+	for s != "" {
+		var cluster string
+		var width int
+		cluster, s, width, state = uniseg.FirstGraphemeClusterInString(s, state)
+		clusters = append(clusters, cluster)
+		widths = append(widths, width)
+	}
+	return clusters, widths
+}
+
+// cursorIn returns the cursor position among count clusters.
+func (t TextInput) cursorIn(count int) int {
+	return count - min(max(t.tail, 0), count)
+}
+
+// Cursor returns the cursor position, counted in clusters from the start.
+func (t TextInput) Cursor() int {
+	return t.cursorIn(uniseg.GraphemeClusterCount(t.Value))
+}
+
+// SetValue replaces the text and moves the cursor to the end.
+func (t *TextInput) SetValue(value string) {
+	t.Value = value
+	t.tail = 0
+}
+
+// Reset clears the text.
+func (t *TextInput) Reset() {
+	t.SetValue("")
+}
+
+// edit replaces the text with the clusters before the cursor, then insert,
+// then the clusters from the cursor plus skip on.
+func (t *TextInput) edit(before, skip int, insert string) {
+	clusters, _ := splitClusters(t.Value)
+	pos := t.cursorIn(len(clusters))
+	from := max(pos-before, 0)
+	to := min(pos+skip, len(clusters))
+	t.Value = strings.Join(clusters[:from], "") + insert + strings.Join(clusters[to:], "")
+	t.tail = len(clusters) - to
+}
+
+// Insert types s at the cursor. Line breaks and tabs become spaces and other
+// control characters are dropped, so the text stays on one line.
+func (t *TextInput) Insert(s string) {
+	s = strings.Map(func(r rune) rune {
+		if r == '\n' || r == '\t' {
+			return ' '
+		}
+		if _, ok := bidiControls[r]; ok || unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, lineBreaks.Replace(s))
+	if s != "" {
+		t.edit(0, 0, s)
+	}
+}
+
+// lineBreaks normalizes CRLF and lone CR, which terminals send for pasted
+// newlines, to LF.
+var lineBreaks = strings.NewReplacer("\r\n", "\n", "\r", "\n")
+
+// FoldLines joins pasted multi-line text into one line: each line is trimmed,
+// blank lines are dropped, and the rest are joined with single spaces, so a
+// URL with a trailing newline or indented JSON pastes cleanly. Text without
+// line breaks is returned unchanged.
+func FoldLines(s string) string {
+	s = lineBreaks.Replace(s)
+	if !strings.Contains(s, "\n") {
+		return s
+	}
+	var lines []string
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, " ")
+}
+
+// Backspace deletes the cluster before the cursor.
+func (t *TextInput) Backspace() {
+	t.edit(1, 0, "")
+}
+
+// Delete deletes the cluster under the cursor.
+func (t *TextInput) Delete() {
+	t.edit(0, 1, "")
+}
+
+// DeleteWord deletes the word before the cursor and the spaces after it.
+func (t *TextInput) DeleteWord() {
+	clusters, _ := splitClusters(t.Value)
+	pos := t.cursorIn(len(clusters))
+	n := 0
+	for pos-n > 0 && strings.TrimSpace(clusters[pos-n-1]) == "" {
+		n++
+	}
+	for pos-n > 0 && strings.TrimSpace(clusters[pos-n-1]) != "" {
+		n++
+	}
+	t.edit(n, 0, "")
+}
+
+// Left moves the cursor one cluster left.
+func (t *TextInput) Left() {
+	count := uniseg.GraphemeClusterCount(t.Value)
+	t.tail = min(max(t.tail, 0)+1, count)
+}
+
+// Right moves the cursor one cluster right.
+func (t *TextInput) Right() {
+	count := uniseg.GraphemeClusterCount(t.Value)
+	t.tail = max(min(t.tail, count)-1, 0)
+}
+
+// Home moves the cursor to the start.
+func (t *TextInput) Home() {
+	t.tail = uniseg.GraphemeClusterCount(t.Value)
+}
+
+// End moves the cursor to the end.
+func (t *TextInput) End() {
+	t.tail = 0
+}
+
+// HandleKey applies an editing key and reports whether it was one. Typed and
+// pasted text, space, backspace, delete, ctrl+w, left, right, home, and end
+// are editing keys; everything else is left to the caller. A bracketed paste
+// arrives as one message and is inserted in one edit, with FoldLines applied.
+func (t *TextInput) HandleKey(msg tea.KeyMsg) bool {
+	switch msg.Type {
+	case tea.KeyRunes:
+		if msg.Paste {
+			t.Insert(FoldLines(string(msg.Runes)))
+			break
+		}
+		if msg.Alt {
+			return false
+		}
+		t.Insert(string(msg.Runes))
+	case tea.KeySpace:
+		t.Insert(" ")
+	case tea.KeyBackspace, tea.KeyCtrlH:
+		t.Backspace()
+	case tea.KeyDelete:
+		// At the end of the text delete works as backspace, as it always has.
+		if t.Cursor() == uniseg.GraphemeClusterCount(t.Value) {
+			t.Backspace()
+		} else {
+			t.Delete()
+		}
+	case tea.KeyCtrlW:
+		t.DeleteWord()
+	case tea.KeyLeft:
+		t.Left()
+	case tea.KeyRight:
+		t.Right()
+	case tea.KeyHome:
+		t.Home()
+	case tea.KeyEnd:
+		t.End()
+	default:
+		return false
+	}
+	return true
+}
+
+// View renders the text with the cursor drawn in style: a block at the end,
+// or the cluster under it in reverse. When width is positive the text
+// scrolls so the cursor stays inside width terminal cells; wide CJK and emoji
+// clusters count as two. Escape sequences and control characters in an
+// assigned value are not drawn.
+func (t TextInput) View(style lipgloss.Style, width int) string {
+	clusters, widths := splitClusters(oneLine.Replace(SanitizeText(t.Value)))
+	pos := t.cursorIn(len(clusters))
+	cursor := style.Render("█")
+	cursorWidth := 1
+	after := pos
+	if pos < len(clusters) {
+		cursor = style.Reverse(true).Render(clusters[pos])
+		cursorWidth = max(widths[pos], 1)
+		after = pos + 1
+	}
+
+	start, end := 0, len(clusters)
+	if width > 0 {
+		budget := width - cursorWidth
+		start = pos
+		for start > 0 && widths[start-1] <= budget {
+			budget -= widths[start-1]
+			start--
+		}
+		end = after
+		for end < len(clusters) && widths[end] <= budget {
+			budget -= widths[end]
+			end++
+		}
+	}
+	return strings.Join(clusters[start:pos], "") + cursor + strings.Join(clusters[after:end], "")
+}
+
+// Masked returns a copy that shows one asterisk per cluster, with the same
+// cursor, for rendering secrets.
+func (t TextInput) Masked() TextInput {
+	return TextInput{Value: strings.Repeat("*", uniseg.GraphemeClusterCount(t.Value)), tail: t.tail}
+}
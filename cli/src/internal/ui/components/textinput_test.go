@@ -0,0 +1,105 @@
+package components
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+)
+
+func typeKeys(t *TextInput, keys ...tea.KeyMsg) {
+	for _, k := range keys {
+		t.HandleKey(k)
+	}
+}
+
+func runes(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func TestTextInputEditsByCluster(t *testing.T) {
+	in := NewTextInput("zażółć")
+	in.Backspace()
+	assert.Equal(t, "zażół", in.Value)
+
+	// A family emoji is one cluster built from several runes.
+	in.SetValue("hi 👨‍👩‍👧")
+	in.Backspace()
+	assert.Equal(t, "hi ", in.Value)
+
+	in.SetValue("東京")
+	in.Left()
+	in.Backspace()
+	assert.Equal(t, "京", in.Value)
+	assert.Equal(t, 0, in.Cursor())
+}
+
+func TestTextInputCursorMovement(t *testing.T) {
+	var in TextInput
+	typeKeys(&in, runes("helo"), tea.KeyMsg{Type: tea.KeyLeft}, runes("l"))
+	assert.Equal(t, "hello", in.Value)
+	assert.Equal(t, 4, in.Cursor())
+
+	typeKeys(&in, tea.KeyMsg{Type: tea.KeyHome}, tea.KeyMsg{Type: tea.KeyDelete}, runes("H"))
+	assert.Equal(t, "Hello", in.Value)
+	typeKeys(&in, tea.KeyMsg{Type: tea.KeyLeft}, tea.KeyMsg{Type: tea.KeyLeft})
+	assert.Equal(t, 0, in.Cursor())
+
+	typeKeys(&in, tea.KeyMsg{Type: tea.KeyEnd}, tea.KeyMsg{Type: tea.KeySpace}, runes("big world"))
+	typeKeys(&in, tea.KeyMsg{Type: tea.KeyCtrlW})
+	assert.Equal(t, "Hello big ", in.Value)
+	in.DeleteWord()
+	assert.Equal(t, "Hello ", in.Value)
+	typeKeys(&in, tea.KeyMsg{Type: tea.KeyRight})
+	assert.Equal(t, 6, in.Cursor())
+
+	assert.False(t, in.HandleKey(tea.KeyMsg{Type: tea.KeyEnter}))
+	assert.False(t, in.HandleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x"), Alt: true}))
+}
+
+func TestTextInputKeepsOneLine(t *testing.T) {
+	var in TextInput
+	in.Insert("a\r\nb\tc\x1b[31m")
+	assert.Equal(t, "a b c[31m", in.Value)
+
+	// Clearing the value directly leaves no stale cursor behind.
+	in.Home()
+	in.Value = ""
+	in.Insert("ab")
+	in.Insert("c")
+	assert.Equal(t, "abc", in.Value)
+}
+
+func TestTextInputPasteInsertsOnceAndFoldsLines(t *testing.T) {
+	in := NewTextInput("see ")
+	in.Left()
+	paste := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("https://example.com/a?b=1\r"), Paste: true}
+	assert.True(t, in.HandleKey(paste))
+	assert.Equal(t, "seehttps://example.com/a?b=1 ", in.Value)
+
+	in.SetValue("")
+	in.HandleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("{\r\n  \"a\": 1,\r\n\r\n  \"b\": 2\n}\n"), Paste: true})
+	assert.Equal(t, `{ "a": 1, "b": 2 }`, in.Value)
+
+	// Alt is not a paste modifier, so an alt paste still inserts.
+	in.SetValue("")
+	in.HandleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x"), Alt: true, Paste: true})
+	assert.Equal(t, "x", in.Value)
+
+	assert.Equal(t, "one line", FoldLines("one line"))
+	assert.Equal(t, "a b", FoldLines("a\rb"))
+}
+
+func TestTextInputViewScrollsByCellWidth(t *testing.T) {
+	style := lipgloss.NewStyle()
+	in := NewTextInput("abc")
+	assert.Equal(t, "abc█", in.View(style, 0))
+	in.Left()
+	assert.Equal(t, "abc", in.View(style, 0))
+
+	in.SetValue("日本語テキスト")
+	assert.Equal(t, "キスト█", in.View(style, 7))
+	in.Home()
+	assert.Equal(t, "日本語", in.View(style, 6))
+}
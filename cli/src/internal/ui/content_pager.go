@@ -0,0 +1,247 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// contentPagerChrome is the height taken by the banner, tabs, detail header,
+// and hints around the pager.
+const contentPagerChrome = 18
+
+// contentPager scrolls full markdown content with in-content search.
+type contentPager struct {
+	source    string
+	width     int
+	lines     []string
+	plain     []string
+	offset    int
+	searching bool
+	query     components.TextInput
+	matches   []int
+	match     int
+}
+
+// newContentPager opens a pager over markdown source.
+func newContentPager(source string) *contentPager {
+	return &contentPager{source: source, width: -1}
+}
+
+// layout renders the content for width, keeping the scroll position when the
+// terminal is resized.
+func (p *contentPager) layout(width int) {
+	if width == p.width {
+		return
+	}
+	p.width = width
+	p.lines = renderMarkdown(p.source, width)
+	p.plain = make([]string, len(p.lines))
+	for i, line := range p.lines {
+		p.plain[i] = components.SanitizeText(line)
+	}
+	p.findMatches()
+	p.clamp(0)
+}
+
+// contentPagerPageSize returns how many content lines fit the terminal height.
+func contentPagerPageSize(height int) int {
+	if height <= 0 {
+		return 20
+	}
+	if size := height - contentPagerChrome; size > 8 {
+		return size
+	}
+	return 8
+}
+
+// clamp keeps the offset within the content for a page of size.
+func (p *contentPager) clamp(size int) {
+	maxOffset := len(p.lines) - size
+	if maxOffset < 0 {
+		maxOffset = 0
+	}
+	if p.offset > maxOffset {
+		p.offset = maxOffset
+	}
+	if p.offset < 0 {
+		p.offset = 0
+	}
+}
+
+// findMatches lists the lines containing the query, case-insensitively.
+func (p *contentPager) findMatches() {
+	p.matches = nil
+	query := strings.ToLower(strings.TrimSpace(p.query.Value))
+	if query == "" {
+		return
+	}
+	for i, line := range p.plain {
+		if strings.Contains(strings.ToLower(line), query) {
+			p.matches = append(p.matches, i)
+		}
+	}
+	if p.match >= len(p.matches) {
+		p.match = 0
+	}
+}
+
+// jumpToMatch moves to the match at idx, wrapping, and scrolls it into view.
+func (p *contentPager) jumpToMatch(idx, size int) {
+	if len(p.matches) == 0 {
+		return
+	}
+	p.match = (idx%len(p.matches) + len(p.matches)) % len(p.matches)
+	line := p.matches[p.match]
+	if line < p.offset || line >= p.offset+size {
+		p.offset = line - size/3
+	}
+	p.clamp(size)
+}
+
+// handleKey scrolls or searches. It returns false once the pager is closed.
+func (p *contentPager) handleKey(msg tea.KeyMsg, size int) bool {
+	if p.searching {
+		p.handleSearchKey(msg, size)
+		return true
+	}
+	switch {
+	case isBack(msg), isKey(msg, "q", "c"):
+		return false
+	case isUp(msg):
+		p.offset--
+	case isDown(msg):
+		p.offset++
+	case isSpace(msg), isKey(msg, "pgdown", "f", "ctrl+d"):
+		p.offset += size
+	case isKey(msg, "b", "pgup", "ctrl+u"):
+		p.offset -= size
+	case isKey(msg, "g", "home"):
+		p.offset = 0
+	case isKey(msg, "G", "end"):
+		p.offset = len(p.lines)
+	case isKey(msg, "/"):
+		p.searching = true
+		p.query.Reset()
+		p.matches = nil
+	case isKey(msg, "n"):
+		p.jumpToMatch(p.match+1, size)
+	case isKey(msg, "N"):
+		p.jumpToMatch(p.match-1, size)
+	}
+	p.clamp(size)
+	return true
+}
+
+// handleSearchKey edits the query and jumps to the first match at or below
+// the top of the page.
+func (p *contentPager) handleSearchKey(msg tea.KeyMsg, size int) {
+	switch {
+	case isEnter(msg):
+		p.searching = false
+		return
+	case isBack(msg):
+		p.searching = false
+		p.query.Reset()
+		p.matches = nil
+		return
+	case !editChanged(&p.query, msg):
+		return
+	}
+	p.findMatches()
+	for i, line := range p.matches {
+		if line >= p.offset {
+			p.jumpToMatch(i, size)
+			return
+		}
+	}
+	p.jumpToMatch(0, size)
+}
+
+// status summarizes the scroll position and search state.
+func (p *contentPager) status(size int) string {
+	total := len(p.lines)
+	end := p.offset + size
+	if end > total {
+		end = total
+	}
+	pct := 100
+	if total > size {
+		pct = end * 100 / total
+	}
+	text := fmt.Sprintf("lines %d-%d of %d · %d%%", p.offset+1, end, total, pct)
+	if total == 0 {
+		text = "empty"
+	}
+	switch {
+	case p.searching:
+		text += " · /"
+	case strings.TrimSpace(p.query.Value) != "" && len(p.matches) == 0:
+		text += fmt.Sprintf(" · no match for %q", p.query.Value)
+	case len(p.matches) > 0:
+		text += fmt.Sprintf(" · match %d/%d for %q", p.match+1, len(p.matches), p.query.Value)
+	}
+	return text
+}
+
+// render draws the visible page inside a box of width, highlighting search
+// matches.
+func (p *contentPager) render(width, height int) string {
+	size := contentPagerPageSize(height)
+	p.layout(components.BoxContentWidth(width))
+	p.clamp(size)
+
+	current := -1
+	if len(p.matches) > 0 {
+		current = p.matches[p.match]
+	}
+	matched := map[int]bool{}
+	for _, line := range p.matches {
+		matched[line] = true
+	}
+
+	header := MetaKeyStyle.Render("Content") + MutedStyle.Render("  "+p.status(size))
+	if p.searching {
+		header += p.query.View(AccentStyle, 0)
+	}
+	lines := []string{header, ""}
+	for i := p.offset; i < len(p.lines) && i < p.offset+size; i++ {
+		if matched[i] {
+			lines = append(lines, highlightMatches(p.plain[i], p.query.Value, i == current))
+			continue
+		}
+		lines = append(lines, p.lines[i])
+	}
+	return components.TitledBox("Content", strings.Join(lines, "\n"), width)
+}
+
+// highlightMatches marks each case-insensitive occurrence of query in a plain
+// line. The current match line is drawn selected.
+func highlightMatches(line, query string, current bool) string {
+	base := NormalStyle
+	if current {
+		base = SelectedStyle
+	}
+	query = strings.TrimSpace(query)
+	lower := strings.ToLower(line)
+	needle := strings.ToLower(query)
+	if needle == "" || len(lower) != len(line) {
+		return base.Render(line)
+	}
+	var b strings.Builder
+	for {
+		idx := strings.Index(lower, needle)
+		if idx < 0 {
+			b.WriteString(base.Render(line))
+			break
+		}
+		b.WriteString(base.Render(line[:idx]))
+		b.WriteString(SearchMatchStyle.Render(line[idx : idx+len(needle)]))
+		line = line[idx+len(needle):]
+		lower = lower[idx+len(needle):]
+	}
+	return b.String()
+}
@@ -0,0 +1,131 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func plainLines(lines []string) []string {
+	out := make([]string, len(lines))
+	for i, line := range lines {
+		out[i] = components.SanitizeText(line)
+	}
+	return out
+}
+
+func TestRenderMarkdownBlocks(t *testing.T) {
+	src := strings.Join([]string{
+		"# Overview",
+		"",
+		"Agents share **context** with `nebula` via [docs](https://example.com).",
+		"",
+		"- first item",
+		"1. numbered",
+		"> quoted",
+		"---",
+		"```",
+		"func main() {}",
+		"```",
+	}, "\n")
+	lines := plainLines(renderMarkdown(src, 80))
+
+	assert.Equal(t, "Overview", lines[0])
+	assert.Equal(t, "", lines[1])
+	assert.Equal(t, "Agents share context with nebula via docs (https://example.com).", lines[2])
+	assert.Contains(t, lines, "• first item")
+	assert.Contains(t, lines, "1. numbered")
+	assert.Contains(t, lines, "│ quoted")
+	assert.Contains(t, lines, strings.Repeat("─", 80))
+	assert.Equal(t, "  func main() {}", lines[len(lines)-1])
+}
+
+func TestRenderMarkdownWrapsToWidth(t *testing.T) {
+	src := "- " + strings.Repeat("word ", 30) + "\n\n" + strings.Repeat("x", 50)
+	lines := renderMarkdown(src, 24)
+	require.Greater(t, len(lines), 4)
+	for _, line := range lines {
+		assert.LessOrEqual(t, lipgloss.Width(line), 24)
+	}
+	plain := plainLines(lines)
+	assert.True(t, strings.HasPrefix(plain[0], "• word"))
+	assert.True(t, strings.HasPrefix(plain[1], "  word"))
+	assert.Equal(t, strings.Repeat("x", 24), plain[len(plain)-3])
+}
+
+func TestContentPagerScrollsAndSearches(t *testing.T) {
+	var parts []string
+	for i := 1; i <= 60; i++ {
+		parts = append(parts, fmt.Sprintf("Paragraph %d.", i))
+	}
+	parts[44] = "Paragraph with the Needle inside."
+	p := newContentPager(strings.Join(parts, "\n\n"))
+	p.layout(60)
+	size := 10
+
+	assert.True(t, p.handleKey(tea.KeyMsg{Type: tea.KeyDown}, size))
+	assert.Equal(t, 1, p.offset)
+	p.handleKey(tea.KeyMsg{Type: tea.KeySpace}, size)
+	assert.Equal(t, 11, p.offset)
+	p.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'G'}}, size)
+	assert.Equal(t, len(p.lines)-size, p.offset)
+	p.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}}, size)
+	assert.Equal(t, 0, p.offset)
+
+	p.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}}, size)
+	require.True(t, p.searching)
+	for _, r := range "needle" {
+		p.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}}, size)
+	}
+	p.handleKey(tea.KeyMsg{Type: tea.KeyEnter}, size)
+	assert.False(t, p.searching)
+	require.Len(t, p.matches, 1)
+	line := p.matches[0]
+	assert.True(t, line >= p.offset && line < p.offset+size)
+	assert.Contains(t, p.status(size), `match 1/1 for "needle"`)
+
+	assert.False(t, p.handleKey(tea.KeyMsg{Type: tea.KeyEsc}, size))
+}
+
+func TestContextDetailOpensContentPager(t *testing.T) {
+	content := "# Notes\n\n" + strings.Repeat("Long knowledge body text. ", 40)
+	app := NewApp(nil, &config.Config{})
+	model, _ := app.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	app = model.(App)
+	app.tab = tabKnow
+	app.know.view = contextViewDetail
+	app.know.detail = &api.Context{ID: "ctx-1", Name: "Alpha", Content: &content}
+
+	assert.Contains(t, components.SanitizeText(app.View()), "c to read the full content")
+
+	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	app = model.(App)
+	require.NotNil(t, app.know.pager)
+	view := components.SanitizeText(app.View())
+	assert.Contains(t, view, "Notes")
+	assert.Contains(t, view, "lines 1-")
+
+	// Search text is not taken by the global palette or quit keys.
+	for _, key := range []string{"/", "q"} {
+		model, _ = app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		app = model.(App)
+	}
+	assert.False(t, app.paletteOpen)
+	assert.True(t, app.know.pager.searching)
+	assert.Equal(t, "q", app.know.pager.query.Value)
+
+	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	app = model.(App)
+	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	app = model.(App)
+	assert.Nil(t, app.know.pager)
+	assert.Equal(t, contextViewDetail, app.know.view)
+}
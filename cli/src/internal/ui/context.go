@@ -3,11 +3,13 @@ package ui
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
 	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
 )
 
@@ -15,13 +17,20 @@ import (
 
 type contextSavedMsg struct{}
 type contextLinkResultsMsg struct{ items []api.Entity }
-type contextListLoadedMsg struct{ items []api.Context }
-type contextScopesLoadedMsg struct{ names map[string]string }
+type contextListLoadedMsg struct {
+	items  []api.Context
+	queued time.Time
+}
+type contextScopesLoadedMsg struct {
+	names map[string]string
+	info  map[string]scopeInfo
+}
 type contextDetailLoadedMsg struct {
 	item          api.Context
 	relationships []api.Relationship
 }
 type contextUpdatedMsg struct{ item api.Context }
+type contextBulkUpdatedMsg struct{}
 
 // --- Constants ---
 
@@ -44,6 +53,9 @@ const (
 	contextViewList
 	contextViewDetail
 	contextViewEdit
+	contextViewEditConflict
+	contextViewLinks
+	contextViewDuplicate
 )
 
 // Field indices
@@ -80,7 +92,8 @@ type ContextModel struct {
 	typeIdx             int
 	typeSelecting       bool
 	scopeOptions        []string
-	scopeIdx            int
+	scopeInfo           map[string]scopeInfo
+	scopePicker         scopePicker
 	scopeSelecting      bool
 	focus               int
 	modeFocus           bool
@@ -88,24 +101,36 @@ type ContextModel struct {
 	saving              bool
 	view                contextView
 	errText             string
+	fieldErrs           fieldErrors
 	tags                []string
-	tagBuf              string
+	tagBuf              components.TextInput
+	tagOptions          []string
+	tagSuggestIdx       int
 	scopes              []string
 	scopeBuf            string
 	linkSearching       bool
 	linkLoading         bool
-	linkQuery           string
+	linkQuery           components.TextInput
 	linkResults         []api.Entity
 	linkList            *components.List
 	linkEntities        []api.Entity
 	list                *components.List
+	sort                tableSort
+	pinned              map[string]bool
+	columns             tableLayout
 	allItems            []api.Context
 	items               []api.Context
 	filtering           bool
-	filterBuf           string
+	filterBuf           components.TextInput
+	bulk                libraryBulk
 	loadingList         bool
+	loadLatency         time.Duration
 	detail              *api.Context
 	detailRelationships []api.Relationship
+	comments            recordCommentThread
+	linkIdx             int
+	linkSaving          bool
+	unlinkConfirm       bool
 	contextEditFields   []formField
 	editFocus           int
 	editTypeIdx         int
@@ -113,23 +138,35 @@ type ContextModel struct {
 	editScopeSelecting  bool
 	editStatusIdx       int
 	editTags            []string
-	editTagBuf          string
+	editTagBuf          components.TextInput
 	editScopes          []string
 	editScopeBuf        string
 	editMeta            MetadataEditor
 	editSaving          bool
+	editBase            *api.Context
+	editConflict        *editConflict
+	conflictMine        api.UpdateContextInput
+	conflictTheirs      *api.Context
+	dupPending          *api.CreateContextInput
+	dupLinkIDs          []string
+	dupExisting         *api.Context
 	metaEditor          MetadataEditor
+	template            templatePicker
+	formDefaults        config.FormDefaults
+	urlFetching         bool
+	urlPreview          *urlPreview
 	metaExpanded        bool
-	contentExpanded     bool
+	pager               *contentPager
 	sourcePathExpanded  bool
 	scopeNames          map[string]string
+	viewAsScope         string
 	width               int
 	height              int
 }
 
 type formField struct {
 	label string
-	value string
+	components.TextInput
 }
 
 // NewContextModel builds the context UI model.
@@ -169,21 +206,21 @@ func (m ContextModel) Init() tea.Cmd {
 	m.modeFocus = false
 	m.typeIdx = 0
 	m.typeSelecting = false
-	m.scopeIdx = 0
+	m.scopePicker.Reset()
 	m.scopeSelecting = false
 	m.view = contextViewAdd
 	m.tags = nil
-	m.tagBuf = ""
+	m.tagBuf.Reset()
 	m.scopes = nil
 	m.scopeBuf = ""
 	m.linkSearching = false
 	m.linkLoading = false
-	m.linkQuery = ""
+	m.linkQuery.Reset()
 	m.linkResults = nil
 	m.linkEntities = nil
 	m.allItems = nil
 	m.filtering = false
-	m.filterBuf = ""
+	m.filterBuf.Reset()
 	m.detail = nil
 	m.loadingList = false
 	m.editFocus = 0
@@ -192,14 +229,15 @@ func (m ContextModel) Init() tea.Cmd {
 	m.editScopeSelecting = false
 	m.editStatusIdx = statusIndex(contextStatusOptions, "active")
 	m.editTags = nil
-	m.editTagBuf = ""
+	m.editTagBuf.Reset()
 	m.editScopes = nil
 	m.editScopeBuf = ""
 	m.editMeta.Reset()
 	m.editSaving = false
 	m.metaEditor.Reset()
+	m.template.Reset()
 	m.metaExpanded = false
-	m.contentExpanded = false
+	m.pager = nil
 	m.sourcePathExpanded = false
 	if m.scopeNames == nil {
 		m.scopeNames = map[string]string{}
@@ -211,7 +249,7 @@ func (m ContextModel) Init() tea.Cmd {
 		m.list.SetItems(nil)
 	}
 	for i := range m.fields {
-		m.fields[i].value = ""
+		m.fields[i].Reset()
 	}
 	return m.loadScopeNames()
 }
@@ -223,10 +261,15 @@ func (m ContextModel) Update(msg tea.Msg) (ContextModel, tea.Cmd) {
 		m.saving = false
 		m.saved = true
 		return m, nil
+	case fieldErrorsMsg:
+		m.saving = false
+		m.showFieldErrors(msg)
+		return m, nil
 
 	case errMsg:
 		m.saving = false
 		m.editSaving = false
+		m.linkSaving = false
 		m.errText = msg.err.Error()
 		return m, nil
 	case contextLinkResultsMsg:
@@ -243,6 +286,7 @@ func (m ContextModel) Update(msg tea.Msg) (ContextModel, tea.Cmd) {
 
 	case contextListLoadedMsg:
 		m.loadingList = false
+		m.loadLatency = loadElapsed(msg.queued)
 		m.allItems = append([]api.Context{}, msg.items...)
 		m.applyContextFilter()
 		return m, nil
@@ -254,18 +298,44 @@ func (m ContextModel) Update(msg tea.Msg) (ContextModel, tea.Cmd) {
 			m.scopeNames[id] = name
 		}
 		m.scopeOptions = scopeNameList(m.scopeNames)
+		if msg.info != nil {
+			m.scopeInfo = msg.info
+		}
 		m.metaEditor.SetScopeOptions(m.scopeOptions)
+		m.metaEditor.SetScopeInfo(m.scopeInfo)
 		m.editMeta.SetScopeOptions(m.scopeOptions)
+		m.editMeta.SetScopeInfo(m.scopeInfo)
 		return m, nil
 	case contextDetailLoadedMsg:
 		m.detail = &msg.item
 		m.detailRelationships = msg.relationships
+		if !m.comments.shows(msg.item.ID) {
+			m.comments = newRecordCommentThread(api.CommentOnContext, msg.item.ID)
+			return m, m.comments.load(m.client)
+		}
+		return m, nil
+	case recordCommentsLoadedMsg, recordCommentSavedMsg:
+		m.comments.apply(msg)
 		return m, nil
+	case contextEditConflictMsg:
+		m.showEditConflict(msg)
+		return m, nil
+	case contextDuplicateFoundMsg:
+		m.showDuplicate(msg)
+		return m, nil
+	case contextURLFetchedMsg:
+		return m.handleURLFetched(msg), nil
+	case contextLinksUpdatedMsg:
+		return m.handleLinksUpdated(msg), nil
 	case contextUpdatedMsg:
 		m.editSaving = false
 		m.detail = &msg.item
 		m.view = contextViewDetail
 		return m, nil
+	case contextBulkUpdatedMsg:
+		m.bulk.clear()
+		m.loadingList = true
+		return m, m.loadContextList()
 
 	case tea.KeyMsg:
 		if m.metaEditor.Active {
@@ -282,15 +352,53 @@ func (m ContextModel) Update(msg tea.Msg) (ContextModel, tea.Cmd) {
 		if m.view == contextViewEdit {
 			return m.handleEditKeys(msg)
 		}
+		if m.view == contextViewEditConflict {
+			return m.handleEditConflictKeys(msg)
+		}
+		if m.view == contextViewDuplicate {
+			return m.handleDuplicateKeys(msg)
+		}
+		if m.view == contextViewDetail && m.pager != nil {
+			if !m.pager.handleKey(msg, contentPagerPageSize(m.height)) {
+				m.pager = nil
+			}
+			return m, nil
+		}
 		if m.view == contextViewDetail {
 			return m.handleDetailKeys(msg)
 		}
 		if m.linkSearching {
 			return m.handleLinkSearch(msg)
 		}
+		if m.view == contextViewLinks {
+			return m.handleLinksKeys(msg)
+		}
 		if m.modeFocus {
 			return m.handleModeKeys(msg)
 		}
+		if m.urlPreview != nil {
+			return m.handleURLPreviewKeys(msg)
+		}
+		if m.template.open {
+			switch {
+			case isKey(msg, "left"):
+				m.template.Move(-1)
+			case isKey(msg, "right"):
+				m.template.Move(1)
+			case isEnter(msg), isSpace(msg):
+				if tmpl, ok := m.template.Pick(); ok {
+					m.applyTemplate(tmpl)
+				}
+			case isBack(msg), isKey(msg, "ctrl+t"):
+				m.template.open = false
+			}
+			return m, nil
+		}
+		if isKey(msg, "ctrl+t") && m.template.Toggle() {
+			m.typeSelecting = false
+			m.scopeSelecting = false
+			return m, nil
+		}
 		// Type selector field - press space to enter, then space/left/right to cycle
 		if m.focus == fieldType {
 			if m.typeSelecting {
@@ -311,28 +419,14 @@ func (m ContextModel) Update(msg tea.Msg) (ContextModel, tea.Cmd) {
 			}
 		}
 		if m.focus == fieldScopes && m.scopeSelecting {
-			switch {
-			case isKey(msg, "left"):
-				if len(m.scopeOptions) > 0 {
-					m.scopeIdx = (m.scopeIdx - 1 + len(m.scopeOptions)) % len(m.scopeOptions)
-				}
-				return m, nil
-			case isKey(msg, "right"):
-				if len(m.scopeOptions) > 0 {
-					m.scopeIdx = (m.scopeIdx + 1) % len(m.scopeOptions)
-				}
-				return m, nil
-			case isSpace(msg):
-				if len(m.scopeOptions) > 0 {
-					scope := m.scopeOptions[m.scopeIdx]
-					m.scopes = toggleScope(m.scopes, scope)
-				}
-				return m, nil
-			case isEnter(msg), isBack(msg):
-				m.scopeSelecting = false
+			if scopes, open, ok := m.scopePicker.HandleKey(msg, m.scopeOptions, m.scopes, m.scopeInfo); ok {
+				m.scopes, m.scopeSelecting = scopes, open
 				return m, nil
 			}
 		}
+		if (m.focus == fieldTitle || m.focus == fieldURL || m.focus == fieldNotes) && m.fields[m.focus].HandleKey(msg) {
+			return m, nil
+		}
 
 		switch {
 		case isDown(msg):
@@ -351,13 +445,15 @@ func (m ContextModel) Update(msg tea.Msg) (ContextModel, tea.Cmd) {
 			m.focus = (m.focus - 1 + fieldCount) % fieldCount
 		case isKey(msg, "ctrl+s"):
 			return m.save()
+		case m.focus == fieldURL && isEnter(msg):
+			return m.startURLFetch()
 		case isBack(msg):
 			m.resetForm()
 		case isKey(msg, "backspace"):
 			switch m.focus {
 			case fieldTags:
-				if len(m.tagBuf) > 0 {
-					m.tagBuf = m.tagBuf[:len(m.tagBuf)-1]
+				if m.tagBuf.Value != "" {
+					m.tagBuf.Backspace()
 				} else if len(m.tags) > 0 {
 					m.tags = m.tags[:len(m.tags)-1]
 				}
@@ -369,27 +465,28 @@ func (m ContextModel) Update(msg tea.Msg) (ContextModel, tea.Cmd) {
 				if len(m.linkEntities) > 0 {
 					m.linkEntities = m.linkEntities[:len(m.linkEntities)-1]
 				}
-			default:
-				if m.focus != fieldType {
-					f := &m.fields[m.focus]
-					if len(f.value) > 0 {
-						f.value = f.value[:len(f.value)-1]
-					}
-				}
 			}
 		default:
 			if m.focus == fieldTags {
+				suggestions := m.contentTagSuggestions()
 				switch {
+				case m.tagBuf.Value == "" && len(suggestions) > 0 && isKey(msg, "left"):
+					m.tagSuggestIdx = (m.tagSuggestIdx - 1 + len(suggestions)) % len(suggestions)
+				case m.tagBuf.Value == "" && len(suggestions) > 0 && isKey(msg, "right"):
+					m.tagSuggestIdx = (m.tagSuggestIdx + 1) % len(suggestions)
+				case m.tagBuf.Value == "" && len(suggestions) > 0 && isEnter(msg):
+					m.tags = toggleTag(m.tags, suggestions[min(m.tagSuggestIdx, len(suggestions)-1)])
+				case pasteList(&m.tagBuf, msg, m.commitTag):
+				case isKey(msg, "tab"):
+					m.tagBuf.SetValue(completeTag(m.tagOptions, m.tagBuf.Value, m.tags))
 				case isSpace(msg) || isKey(msg, ",") || isEnter(msg):
 					m.commitTag()
 				default:
-					ch := msg.String()
-					if len(ch) == 1 && ch != "," {
-						m.tagBuf += ch
-					}
+					m.tagBuf.HandleKey(msg)
 				}
 			} else if m.focus == fieldScopes {
 				if isSpace(msg) {
+					m.scopePicker.Reset()
 					m.scopeSelecting = true
 				}
 			} else if m.focus == fieldEntities {
@@ -400,18 +497,12 @@ func (m ContextModel) Update(msg tea.Msg) (ContextModel, tea.Cmd) {
 				if isEnter(msg) {
 					m.metaEditor.Active = true
 				}
-			} else if m.focus != fieldType {
-				ch := msg.String()
-				if len(ch) == 1 || ch == " " {
-					m.fields[m.focus].value += ch
-				}
 			}
 		}
 		if m.focus == fieldEntities && !m.linkSearching {
-			ch := msg.String()
-			if len(ch) == 1 || ch == " " {
+			if text, ok := typedText(msg); ok {
 				m.startLinkSearch()
-				m.linkQuery += ch
+				m.linkQuery.Insert(text)
 				return m, m.updateLinkSearch()
 			}
 		}
@@ -443,6 +534,9 @@ func (m ContextModel) View() string {
 	if m.filtering && m.view == contextViewList {
 		return components.Indent(components.InputDialog("Filter Context", m.filterBuf), 1)
 	}
+	if m.bulk.prompt != "" && m.view == contextViewList {
+		return components.Indent(components.InputDialog(m.bulk.prompt, m.bulk.buf), 1)
+	}
 
 	modeLine := m.renderModeLine()
 	var body string
@@ -450,9 +544,19 @@ func (m ContextModel) View() string {
 	case contextViewList:
 		body = m.renderList()
 	case contextViewDetail:
-		body = m.renderDetail()
+		if m.pager != nil {
+			body = m.pager.render(m.width, m.height)
+		} else {
+			body = m.renderDetail()
+		}
 	case contextViewEdit:
 		body = m.renderEdit()
+	case contextViewLinks:
+		body = m.renderLinks()
+	case contextViewEditConflict:
+		body = m.editConflict.render("context", m.editSaving, m.errText, m.width)
+	case contextViewDuplicate:
+		body = m.renderDuplicate()
 	default:
 		body = m.renderAdd()
 	}
@@ -465,6 +569,10 @@ func (m ContextModel) View() string {
 // renderAdd renders render add.
 func (m ContextModel) renderAdd() string {
 	var b strings.Builder
+	if picker := m.template.Render(); picker != "" {
+		b.WriteString(picker)
+		b.WriteString("\n\n")
+	}
 	for i, f := range m.fields {
 		label := f.label
 
@@ -503,11 +611,16 @@ func (m ContextModel) renderAdd() string {
 				b.WriteString("\n")
 				b.WriteString(NormalStyle.Render("  " + m.renderTags(false)))
 			}
+			suggestions := m.contentTagSuggestions()
+			if chips := renderTagChips(suggestions, m.tags, min(m.tagSuggestIdx, len(suggestions)-1), i == m.focus && m.tagBuf.Value == ""); chips != "" {
+				b.WriteString("\n  ")
+				b.WriteString(chips)
+			}
 		case fieldScopes:
 			if i == m.focus && m.scopeSelecting {
 				b.WriteString(SelectedStyle.Render("  " + label + ":"))
 				b.WriteString("\n")
-				b.WriteString(NormalStyle.Render("  " + renderScopeOptions(m.scopes, m.scopeOptions, m.scopeIdx)))
+				b.WriteString(m.scopePicker.Render(m.scopeOptions, m.scopes, m.scopeInfo, m.width))
 			} else if i == m.focus {
 				b.WriteString(SelectedStyle.Render("  " + label + ":"))
 				b.WriteString("\n")
@@ -539,17 +652,24 @@ func (m ContextModel) renderAdd() string {
 		case m.focus:
 			b.WriteString(SelectedStyle.Render("  " + label + ":"))
 			b.WriteString("\n")
-			b.WriteString(NormalStyle.Render("  " + f.value))
-			b.WriteString(AccentStyle.Render("█"))
+			b.WriteString(NormalStyle.Render("  " + renderInput(f.TextInput, m.width)))
 		default:
 			b.WriteString(MutedStyle.Render("  " + label + ":"))
 			b.WriteString("\n")
-			val := f.value
+			val := f.Value
 			if val == "" {
 				val = "-"
 			}
 			b.WriteString(NormalStyle.Render("  " + val))
 		}
+		b.WriteString(renderFieldError(m.fieldErrs, i))
+
+		if i == fieldURL {
+			if preview := m.renderURLPreview(); preview != "" {
+				b.WriteString("\n\n")
+				b.WriteString(preview)
+			}
+		}
 
 		if i < fieldCount-1 {
 			b.WriteString("\n\n")
@@ -616,7 +736,7 @@ func (m ContextModel) renderEdit() string {
 			if i == m.editFocus && m.editScopeSelecting {
 				b.WriteString(SelectedStyle.Render("  " + label + ":"))
 				b.WriteString("\n")
-				b.WriteString(NormalStyle.Render("  " + renderScopeOptions(m.editScopes, m.scopeOptions, m.scopeIdx)))
+				b.WriteString(m.scopePicker.Render(m.scopeOptions, m.editScopes, m.scopeInfo, m.width))
 			} else if i == m.editFocus {
 				b.WriteString(SelectedStyle.Render("  " + label + ":"))
 				b.WriteString("\n")
@@ -639,12 +759,11 @@ func (m ContextModel) renderEdit() string {
 			if i == m.editFocus {
 				b.WriteString(SelectedStyle.Render("  " + label + ":"))
 				b.WriteString("\n")
-				b.WriteString(NormalStyle.Render("  " + f.value))
-				b.WriteString(AccentStyle.Render("█"))
+				b.WriteString(NormalStyle.Render("  " + renderInput(f.TextInput, m.width)))
 			} else {
 				b.WriteString(MutedStyle.Render("  " + label + ":"))
 				b.WriteString("\n")
-				val := f.value
+				val := f.Value
 				if val == "" {
 					val = "-"
 				}
@@ -718,14 +837,14 @@ func (m ContextModel) toggleMode() (ContextModel, tea.Cmd) {
 	m.modeFocus = false
 	m.detail = nil
 	m.metaExpanded = false
-	m.contentExpanded = false
+	m.pager = nil
 	m.sourcePathExpanded = false
 	if m.view == contextViewAdd {
 		m.view = contextViewList
 		m.loadingList = true
 		return m, m.loadContextList()
 	}
-	if m.view == contextViewDetail || m.view == contextViewEdit {
+	if m.view == contextViewDetail || m.view == contextViewEdit || m.view == contextViewLinks {
 		m.view = contextViewList
 		return m, nil
 	}
@@ -738,6 +857,9 @@ func (m ContextModel) handleListKeys(msg tea.KeyMsg) (ContextModel, tea.Cmd) {
 	if m.filtering {
 		return m.handleFilterInput(msg)
 	}
+	if m.bulk.prompt != "" {
+		return m.handleBulkPromptKeys(msg)
+	}
 	switch {
 	case isDown(msg):
 		m.list.Down()
@@ -758,17 +880,122 @@ func (m ContextModel) handleListKeys(msg tea.KeyMsg) (ContextModel, tea.Cmd) {
 			}
 			m.detail = &item
 			m.view = contextViewDetail
+			m.comments = recordCommentThread{}
 			return m, m.loadContextDetail(itemID)
 		}
+	case isSpace(msg):
+		if idx := m.list.Selected(); idx < len(m.items) {
+			m.bulk.toggle(m.items[idx].ID)
+		}
+	case isKey(msg, "t") && m.bulk.count() > 0:
+		m.bulk.open(bulkTargetTags)
+	case isKey(msg, "p") && m.bulk.count() > 0:
+		m.bulk.open(bulkTargetScopes)
+	case isKey(msg, "s") && m.bulk.count() > 0:
+		m.bulk.open(bulkTargetStatus)
+	case isKey(msg, "c") && m.bulk.count() > 0:
+		m.bulk.clear()
 	case isKey(msg, "f"):
 		m.filtering = true
 		return m, nil
+	case isKey(msg, "o"):
+		m.sort = m.sort.next(contextSortColumns)
+		m.applyContextFilter()
+		return m, m.sort.changed("context")
+	case isKey(msg, "O"):
+		m.sort = m.sort.reversed()
+		m.applyContextFilter()
+		return m, m.sort.changed("context")
+	case isKey(msg, "*"):
+		if idx := m.list.Selected(); idx < len(m.items) {
+			item := m.items[idx]
+			return m, togglePinCmd(config.PinContext, item.ID, contextTitle(item))
+		}
+	case isKey(msg, "w"):
+		if idx := m.list.Selected(); idx < len(m.items) {
+			item := m.items[idx]
+			return m, toggleWatchCmd(config.PinContext, item.ID, contextTitle(item))
+		}
 	case isBack(msg):
+		if m.bulk.count() > 0 {
+			m.bulk.clear()
+			return m, nil
+		}
 		m.view = contextViewAdd
 	}
 	return m, nil
 }
 
+// handleBulkPromptKeys edits the bulk prompt and queues the update on enter.
+func (m ContextModel) handleBulkPromptKeys(msg tea.KeyMsg) (ContextModel, tea.Cmd) {
+	spec, submit, err := m.bulk.handleKey(msg)
+	if err != nil {
+		return m, func() tea.Msg { return errMsg{err} }
+	}
+	if !submit {
+		return m, nil
+	}
+	cmd, err := m.bulkUpdate(spec)
+	if err != nil {
+		return m, func() tea.Msg { return errMsg{err} }
+	}
+	m.bulk.close()
+	return m, cmd
+}
+
+// bulkUpdate queues one update per selected context item that spec changes.
+func (m ContextModel) bulkUpdate(spec bulkInput) (tea.Cmd, error) {
+	target := m.bulk.target
+	var status string
+	var err error
+	if target == bulkTargetStatus {
+		status, err = bulkStatusValue(spec, contextStatusOptions)
+	} else {
+		err = checkBulkSpec(target, spec)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	client := m.client
+	var steps []operationStep
+	for _, item := range m.allItems {
+		if !m.bulk.selected[item.ID] {
+			continue
+		}
+		input := api.UpdateContextInput{}
+		switch target {
+		case bulkTargetStatus:
+			if item.Status == status {
+				continue
+			}
+			input.Status = &status
+		case bulkTargetScopes:
+			scopes, changed := bulkSetUpdate(target, spec, m.scopeNamesFromIDs(item.PrivacyScopeIDs))
+			if !changed {
+				continue
+			}
+			input.Scopes = &scopes
+		default:
+			tags, changed := bulkSetUpdate(target, spec, item.Tags)
+			if !changed {
+				continue
+			}
+			input.Tags = &tags
+		}
+		id := item.ID
+		steps = append(steps, operationStep{
+			label: contextTitle(item),
+			run: func() ([]string, error) {
+				_, err := client.UpdateContext(id, input)
+				return nil, err
+			},
+		})
+	}
+	label := fmt.Sprintf("Bulk %s on %d context items", bulkSetNoun(target), len(steps))
+	return queueOperation(label, tabKnow, steps, contextBulkUpdatedMsg{}), nil
+}
+
 // handleFilterInput handles handle filter input.
 func (m ContextModel) handleFilterInput(msg tea.KeyMsg) (ContextModel, tea.Cmd) {
 	switch {
@@ -776,20 +1003,12 @@ func (m ContextModel) handleFilterInput(msg tea.KeyMsg) (ContextModel, tea.Cmd)
 		m.filtering = false
 	case isBack(msg):
 		m.filtering = false
-		m.filterBuf = ""
+		m.filterBuf.Reset()
 		m.applyContextFilter()
-	case isKey(msg, "backspace", "delete"):
-		if len(m.filterBuf) > 0 {
-			m.filterBuf = m.filterBuf[:len(m.filterBuf)-1]
-			m.applyContextFilter()
-		}
+	case isSpace(msg) && m.filterBuf.Value == "":
+		return m, nil
 	default:
-		ch := msg.String()
-		if len(ch) == 1 || ch == " " {
-			if ch == " " && m.filterBuf == "" {
-				return m, nil
-			}
-			m.filterBuf += ch
+		if editChanged(&m.filterBuf, msg) {
 			m.applyContextFilter()
 		}
 	}
@@ -798,15 +1017,20 @@ func (m ContextModel) handleFilterInput(msg tea.KeyMsg) (ContextModel, tea.Cmd)
 
 // handleDetailKeys handles handle detail keys.
 func (m ContextModel) handleDetailKeys(msg tea.KeyMsg) (ContextModel, tea.Cmd) {
+	if m.comments.focused && m.detail != nil && m.comments.shows(m.detail.ID) {
+		return m, m.comments.handleKeys(m.client, msg)
+	}
 	switch {
 	case isUp(msg):
 		m.modeFocus = true
 	case isBack(msg):
+		m.comments.focused = false
 		m.detail = nil
 		m.detailRelationships = nil
 		m.metaExpanded = false
-		m.contentExpanded = false
+		m.pager = nil
 		m.sourcePathExpanded = false
+		m.viewAsScope = ""
 		m.view = contextViewList
 	case isKey(msg, "e"):
 		m.startEdit()
@@ -814,9 +1038,33 @@ func (m ContextModel) handleDetailKeys(msg tea.KeyMsg) (ContextModel, tea.Cmd) {
 	case isKey(msg, "m"):
 		m.metaExpanded = !m.metaExpanded
 	case isKey(msg, "c"):
-		m.contentExpanded = !m.contentExpanded
+		if k := m.detail; k != nil && k.Content != nil && strings.TrimSpace(*k.Content) != "" {
+			m.pager = newContentPager(*k.Content)
+		}
 	case isKey(msg, "v"):
 		m.sourcePathExpanded = !m.sourcePathExpanded
+	case isKey(msg, "C"):
+		if m.detail == nil {
+			return m, nil
+		}
+		m.comments.focused = true
+		if !m.comments.shows(m.detail.ID) {
+			m.comments = newRecordCommentThread(api.CommentOnContext, m.detail.ID)
+			m.comments.focused = true
+			return m, m.comments.load(m.client)
+		}
+	case isKey(msg, "l"):
+		m.openLinks()
+	case isKey(msg, "p"):
+		m.viewAsScope = nextPreviewScope(m.scopeOptions, m.viewAsScope)
+	case isKey(msg, "*"):
+		if k := m.detail; k != nil {
+			return m, togglePinCmd(config.PinContext, k.ID, contextTitle(*k))
+		}
+	case isKey(msg, "w"):
+		if k := m.detail; k != nil {
+			return m, toggleWatchCmd(config.PinContext, k.ID, contextTitle(*k))
+		}
 	}
 	return m, nil
 }
@@ -848,25 +1096,8 @@ func (m ContextModel) handleEditKeys(msg tea.KeyMsg) (ContextModel, tea.Cmd) {
 		}
 	}
 	if m.editFocus == contextEditFieldScopes && m.editScopeSelecting {
-		switch {
-		case isKey(msg, "left"):
-			if len(m.scopeOptions) > 0 {
-				m.scopeIdx = (m.scopeIdx - 1 + len(m.scopeOptions)) % len(m.scopeOptions)
-			}
-			return m, nil
-		case isKey(msg, "right"):
-			if len(m.scopeOptions) > 0 {
-				m.scopeIdx = (m.scopeIdx + 1) % len(m.scopeOptions)
-			}
-			return m, nil
-		case isSpace(msg):
-			if len(m.scopeOptions) > 0 {
-				scope := m.scopeOptions[m.scopeIdx]
-				m.editScopes = toggleScope(m.editScopes, scope)
-			}
-			return m, nil
-		case isEnter(msg), isBack(msg):
-			m.editScopeSelecting = false
+		if scopes, open, ok := m.scopePicker.HandleKey(msg, m.scopeOptions, m.editScopes, m.scopeInfo); ok {
+			m.editScopes, m.editScopeSelecting = scopes, open
 			return m, nil
 		}
 	}
@@ -881,6 +1112,13 @@ func (m ContextModel) handleEditKeys(msg tea.KeyMsg) (ContextModel, tea.Cmd) {
 		}
 	}
 
+	switch m.editFocus {
+	case contextEditFieldTitle, contextEditFieldURL, contextEditFieldNotes:
+		if m.contextEditFields[m.editFocus].HandleKey(msg) {
+			return m, nil
+		}
+	}
+
 	switch {
 	case isDown(msg):
 		m.editTypeSelecting = false
@@ -902,8 +1140,8 @@ func (m ContextModel) handleEditKeys(msg tea.KeyMsg) (ContextModel, tea.Cmd) {
 	case isKey(msg, "backspace"):
 		switch m.editFocus {
 		case contextEditFieldTags:
-			if len(m.editTagBuf) > 0 {
-				m.editTagBuf = m.editTagBuf[:len(m.editTagBuf)-1]
+			if m.editTagBuf.Value != "" {
+				m.editTagBuf.Backspace()
 			} else if len(m.editTags) > 0 {
 				m.editTags = m.editTags[:len(m.editTags)-1]
 			}
@@ -911,41 +1149,28 @@ func (m ContextModel) handleEditKeys(msg tea.KeyMsg) (ContextModel, tea.Cmd) {
 			if len(m.editScopes) > 0 {
 				m.editScopes = m.editScopes[:len(m.editScopes)-1]
 			}
-		default:
-			if m.editFocus != contextEditFieldType && m.editFocus != contextEditFieldStatus {
-				f := &m.contextEditFields[m.editFocus]
-				if len(f.value) > 0 {
-					f.value = f.value[:len(f.value)-1]
-				}
-			}
 		}
 	default:
 		switch m.editFocus {
 		case contextEditFieldTags:
 			switch {
+			case pasteList(&m.editTagBuf, msg, m.commitEditTag):
+			case isKey(msg, "tab"):
+				m.editTagBuf.SetValue(completeTag(m.tagOptions, m.editTagBuf.Value, m.editTags))
 			case isSpace(msg) || isKey(msg, ",") || isEnter(msg):
 				m.commitEditTag()
 			default:
-				ch := msg.String()
-				if len(ch) == 1 && ch != "," {
-					m.editTagBuf += ch
-				}
+				m.editTagBuf.HandleKey(msg)
 			}
 		case contextEditFieldScopes:
 			if isSpace(msg) {
+				m.scopePicker.Reset()
 				m.editScopeSelecting = true
 			}
 		case contextEditFieldMeta:
 			if isEnter(msg) {
 				m.editMeta.Active = true
 			}
-		default:
-			if m.editFocus != contextEditFieldType && m.editFocus != contextEditFieldStatus {
-				ch := msg.String()
-				if len(ch) == 1 || ch == " " {
-					m.contextEditFields[m.editFocus].value += ch
-				}
-			}
 		}
 	}
 	return m, nil
@@ -954,9 +1179,13 @@ func (m ContextModel) handleEditKeys(msg tea.KeyMsg) (ContextModel, tea.Cmd) {
 // renderList renders render list.
 func (m ContextModel) renderList() string {
 	if m.loadingList {
-		return components.Box(MutedStyle.Render("Loading context..."), m.width)
+		return renderLoadingList("context", len(m.items), m.list, m.width, m.renderListBody)
 	}
+	return m.renderListBody()
+}
 
+// renderListBody renders the list without the loading state.
+func (m ContextModel) renderListBody() string {
 	if len(m.items) == 0 {
 		return components.EmptyStateBox(
 			"Context",
@@ -978,30 +1207,7 @@ func (m ContextModel) renderList() string {
 		tableWidth = contentWidth - previewWidth - gap
 	}
 
-	sepWidth := 1
-	if b := lipgloss.RoundedBorder().Left; b != "" {
-		sepWidth = lipgloss.Width(b)
-	}
-
-	// 4 columns -> 3 separators.
-	availableCols := tableWidth - (3 * sepWidth)
-	if availableCols < 30 {
-		availableCols = 30
-	}
-
-	typeWidth := 10
-	statusWidth := 11
-	atWidth := compactTimeColumnWidth
-	titleWidth := availableCols - (typeWidth + statusWidth + atWidth)
-	if titleWidth < 12 {
-		titleWidth = 12
-	}
-	cols := []components.TableColumn{
-		{Header: "Title", Width: titleWidth, Align: lipgloss.Left},
-		{Header: "Type", Width: typeWidth, Align: lipgloss.Left},
-		{Header: "Status", Width: statusWidth, Align: lipgloss.Left},
-		{Header: "At", Width: atWidth, Align: lipgloss.Left},
-	}
+	cols, defs := contextColumnSet.columns(m.columns, tableWidth, m.sort)
 
 	tableRows := make([][]string, 0, len(visible))
 	activeRowRel := -1
@@ -1017,40 +1223,37 @@ func (m ContextModel) renderList() string {
 		}
 		k := m.items[absIdx]
 
-		title := components.ClampTextWidthEllipsis(components.SanitizeOneLine(contextTitle(k)), titleWidth)
-		typ := strings.TrimSpace(components.SanitizeOneLine(k.SourceType))
-		if typ == "" {
-			typ = "note"
-		}
-		status := strings.TrimSpace(components.SanitizeOneLine(k.Status))
-		if status == "" {
-			status = "-"
-		}
-		at := k.UpdatedAt
-		if at.IsZero() {
-			at = k.CreatedAt
-		}
-		when := formatLocalTimeCompact(at)
-
 		if m.list.IsSelected(absIdx) {
 			activeRowRel = len(tableRows)
 		}
-		tableRows = append(tableRows, []string{
-			title,
-			components.ClampTextWidthEllipsis(typ, typeWidth),
-			components.ClampTextWidthEllipsis(status, statusWidth),
-			when,
-		})
+		row := make([]string, len(defs))
+		for c, d := range defs {
+			value := contextColumnValue(k, d.key)
+			if d.key == "title" && m.pinned[k.ID] {
+				value = pinMark + " " + value
+			}
+			if box := m.bulk.checkbox(k.ID); c == 0 && box != "" {
+				value = box + " " + value
+			}
+			row[c] = components.ClampTextWidthEllipsis(value, d.width)
+		}
+		tableRows = append(tableRows, row)
 	}
 	if m.modeFocus {
 		activeRowRel = -1
 	}
 
 	countLine := fmt.Sprintf("%d total", len(m.items))
-	if query := strings.TrimSpace(m.filterBuf); query != "" {
+	if selected := m.bulk.count(); selected > 0 {
+		countLine = fmt.Sprintf("%s · selected: %d", countLine, selected)
+	}
+	if query := strings.TrimSpace(m.filterBuf.Value); query != "" {
 		countLine = fmt.Sprintf("%s · filter: %s", countLine, query)
 	}
-	countLine = MutedStyle.Render(countLine)
+	if sortLabel := m.sort.label(); sortLabel != "" {
+		countLine = fmt.Sprintf("%s · %s", countLine, sortLabel)
+	}
+	countLine = MutedStyle.Render(countLine) + renderLoadLatency(m.loadLatency)
 
 	table := components.TableGridWithActiveRow(cols, tableRows, tableWidth, activeRowRel)
 	preview := ""
@@ -1077,6 +1280,17 @@ func (m ContextModel) renderDetail() string {
 	}
 
 	k := m.detail
+	var banner string
+	if m.viewAsScope != "" {
+		preview := previewAsScope(m.scopeNamesFromIDs(k.PrivacyScopeIDs), k.Metadata, m.viewAsScope)
+		banner = renderScopePreviewBanner(m.viewAsScope, preview, m.width)
+		if !preview.visible {
+			return banner
+		}
+		redacted := *k
+		redacted.Metadata = preview.metadata
+		k = &redacted
+	}
 	rows := []components.TableRow{
 		{Label: "ID", Value: k.ID},
 		{Label: "Title", Value: contextTitle(*k)},
@@ -1109,10 +1323,13 @@ func (m ContextModel) renderDetail() string {
 	}
 
 	sections := []string{components.Table("Context", rows, m.width)}
+	if banner != "" {
+		sections = append([]string{banner}, sections...)
+	}
 	if k.Content != nil && strings.TrimSpace(*k.Content) != "" {
 		content := strings.TrimSpace(components.SanitizeText(*k.Content))
-		if !m.contentExpanded {
-			content = truncateString(content, 220)
+		if len([]rune(content)) > 220 {
+			content = truncateString(content, 220) + "\n\n" + MutedStyle.Render("c to read the full content")
 		}
 		sections = append(sections, components.TitledBox("Content", content, m.width))
 	}
@@ -1120,8 +1337,14 @@ func (m ContextModel) renderDetail() string {
 		metaTable := renderMetadataBlock(map[string]any(k.Metadata), m.width, m.metaExpanded)
 		sections = append(sections, metaTable)
 	}
-	if len(m.detailRelationships) > 0 {
+	if len(m.detailRelationships) > 0 && banner == "" {
 		sections = append(sections, renderRelationshipSummaryTable("context", k.ID, m.detailRelationships, 6, m.width))
+		if attachments := renderAttachments("context", k.ID, m.detailRelationships, m.width); attachments != "" {
+			sections = append(sections, attachments)
+		}
+	}
+	if m.comments.shows(k.ID) && banner == "" {
+		sections = append(sections, m.comments.view(m.width))
 	}
 
 	return strings.Join(sections, "\n\n")
@@ -1195,28 +1418,31 @@ func (m *ContextModel) startEdit() {
 		return
 	}
 	k := m.detail
-	m.contextEditFields[contextEditFieldTitle].value = contextTitle(*k)
+	m.contextEditFields[contextEditFieldTitle].SetValue(contextTitle(*k))
 	if k.URL != nil {
-		m.contextEditFields[contextEditFieldURL].value = *k.URL
+		m.contextEditFields[contextEditFieldURL].SetValue(*k.URL)
 	} else {
-		m.contextEditFields[contextEditFieldURL].value = ""
+		m.contextEditFields[contextEditFieldURL].Reset()
 	}
-	m.contextEditFields[contextEditFieldNotes].value = ""
+	m.contextEditFields[contextEditFieldNotes].Reset()
 	if k.Content != nil {
-		m.contextEditFields[contextEditFieldNotes].value = *k.Content
+		m.contextEditFields[contextEditFieldNotes].SetValue(*k.Content)
 	}
 	m.editTypeIdx = statusIndex(contextTypes, k.SourceType)
 	m.editStatusIdx = statusIndex(contextStatusOptions, k.Status)
 	m.editTags = append([]string{}, k.Tags...)
-	m.editTagBuf = ""
+	m.editTagBuf.Reset()
 	m.editScopes = m.scopeNamesFromIDs(k.PrivacyScopeIDs)
 	m.editScopeBuf = ""
 	m.editScopeSelecting = false
-	m.scopeIdx = 0
+	m.scopePicker.Reset()
 	m.editMeta.Load(map[string]any(k.Metadata))
 	m.editMeta.Active = false
 	m.editSaving = false
 	m.editFocus = 0
+	base := *k
+	m.editBase = &base
+	m.editConflict = nil
 }
 
 // saveEdit handles save edit.
@@ -1225,9 +1451,9 @@ func (m ContextModel) saveEdit() (ContextModel, tea.Cmd) {
 		return m, nil
 	}
 	m.commitEditTag()
-	title := strings.TrimSpace(m.contextEditFields[contextEditFieldTitle].value)
-	url := strings.TrimSpace(m.contextEditFields[contextEditFieldURL].value)
-	content := strings.TrimSpace(m.contextEditFields[contextEditFieldNotes].value)
+	title := strings.TrimSpace(m.contextEditFields[contextEditFieldTitle].Value)
+	url := strings.TrimSpace(m.contextEditFields[contextEditFieldURL].Value)
+	content := strings.TrimSpace(m.contextEditFields[contextEditFieldNotes].Value)
 	sourceType := contextTypes[m.editTypeIdx]
 	status := contextStatusOptions[m.editStatusIdx]
 	tags := normalizeBulkTags(m.editTags)
@@ -1249,12 +1475,29 @@ func (m ContextModel) saveEdit() (ContextModel, tea.Cmd) {
 		Scopes:     &scopes,
 		Metadata:   meta,
 	}
+	if m.editBase != nil && !m.editBase.UpdatedAt.IsZero() {
+		seen := m.editBase.UpdatedAt
+		input.ExpectedUpdatedAt = &seen
+	}
 
 	m.editSaving = true
-	return m, func() tea.Msg {
-		updated, err := m.client.UpdateContext(m.detail.ID, input)
+	return m, m.submitEdit(m.detail.ID, input)
+}
+
+// submitEdit saves a context edit. A stale-record conflict loads the live
+// context for the merge dialog.
+func (m ContextModel) submitEdit(id string, input api.UpdateContextInput) tea.Cmd {
+	return func() tea.Msg {
+		updated, err := m.client.UpdateContext(id, input)
 		if err != nil {
-			return errMsg{err}
+			if !isEditConflict(err) {
+				return errMsg{err}
+			}
+			theirs, getErr := m.client.GetContext(id)
+			if getErr != nil {
+				return errMsg{err}
+			}
+			return contextEditConflictMsg{input: input, theirs: *theirs}
 		}
 		return contextUpdatedMsg{item: *updated}
 	}
@@ -1263,18 +1506,19 @@ func (m ContextModel) saveEdit() (ContextModel, tea.Cmd) {
 // --- Helpers ---
 
 func (m ContextModel) loadContextList() tea.Cmd {
+	queued := time.Now()
 	return func() tea.Msg {
 		items, err := m.client.QueryContext(api.QueryParams{})
 		if err != nil {
-			return errMsg{err}
+			return loadFailed(tabKnow, err, m.loadContextList())
 		}
-		return contextListLoadedMsg{items: items}
+		return contextListLoadedMsg{items: items, queued: queued}
 	}
 }
 
 // applyContextFilter handles apply context filter.
 func (m *ContextModel) applyContextFilter() {
-	query := strings.ToLower(strings.TrimSpace(m.filterBuf))
+	query := strings.ToLower(strings.TrimSpace(m.filterBuf.Value))
 	if query == "" {
 		m.items = append([]api.Context{}, m.allItems...)
 	} else {
@@ -1295,6 +1539,8 @@ func (m *ContextModel) applyContextFilter() {
 		}
 		m.items = filtered
 	}
+	sortRows(m.items, m.sort, compareContext)
+	pinnedFirst(m.items, m.pinned, func(k api.Context) string { return k.ID })
 	labels := make([]string, len(m.items))
 	for i, item := range m.items {
 		labels[i] = formatContextLine(item)
@@ -1388,7 +1634,7 @@ func (m ContextModel) loadScopeNames() tea.Cmd {
 		for _, scope := range scopes {
 			names[scope.ID] = scope.Name
 		}
-		return contextScopesLoadedMsg{names: names}
+		return contextScopesLoadedMsg{names: names, info: scopeInfoByName(scopes)}
 	}
 }
 
@@ -1428,41 +1674,62 @@ func (m ContextModel) scopeNamesFromIDs(ids []string) []string {
 func (m *ContextModel) resetForm() {
 	m.saved = false
 	m.errText = ""
+	m.fieldErrs = nil
 	m.typeSelecting = false
 	m.focus = 0
 	m.modeFocus = false
 	m.typeIdx = 0
-	m.scopeIdx = 0
+	m.scopePicker.Reset()
 	m.scopeSelecting = false
 	m.tags = nil
-	m.tagBuf = ""
+	m.tagBuf.Reset()
+	m.tagSuggestIdx = 0
 	m.scopes = nil
 	m.scopeBuf = ""
 	m.linkSearching = false
 	m.linkLoading = false
-	m.linkQuery = ""
+	m.linkQuery.Reset()
 	m.linkResults = nil
 	m.linkEntities = nil
 	m.metaEditor.Reset()
+	m.template.Reset()
+	m.urlFetching = false
+	m.urlPreview = nil
 	if m.linkList != nil {
 		m.linkList.SetItems(nil)
 	}
 	for i := range m.fields {
-		m.fields[i].value = ""
+		m.fields[i].Reset()
 	}
+	m.applyFormDefaults()
+}
+
+// applyTemplate pre-fills the add form from a knowledge template.
+func (m *ContextModel) applyTemplate(tmpl config.Template) {
+	typ := strings.ToLower(strings.TrimSpace(tmpl.Type))
+	for i, option := range contextTypes {
+		if option == typ {
+			m.typeIdx = i
+			break
+		}
+	}
+	m.tags = mergeTemplateTags(m.tags, tmpl.Tags)
+	m.scopes = mergeTemplateScopes(m.scopes, tmpl.Scopes)
+	m.metaEditor.Buffer = templateMetadataBuffer(m.metaEditor.Buffer, tmpl.Metadata)
 }
 
 // save handles save.
 func (m ContextModel) save() (ContextModel, tea.Cmd) {
-	title := strings.TrimSpace(m.fields[fieldTitle].value)
+	m.fieldErrs = nil
+	title := strings.TrimSpace(m.fields[fieldTitle].Value)
 	if title == "" {
 		m.errText = "Title is required"
 		return m, nil
 	}
 
-	url := strings.TrimSpace(m.fields[fieldURL].value)
+	url := strings.TrimSpace(m.fields[fieldURL].Value)
 	sourceType := contextTypes[m.typeIdx]
-	notes := strings.TrimSpace(m.fields[fieldNotes].value)
+	notes := strings.TrimSpace(m.fields[fieldNotes].Value)
 
 	m.commitTag()
 
@@ -1475,7 +1742,7 @@ func (m ContextModel) save() (ContextModel, tea.Cmd) {
 
 	scopes := normalizeBulkScopes(m.scopes)
 	if len(scopes) == 0 {
-		scopes = []string{"private"}
+		scopes = m.formDefaults.ScopesOrFallback()
 	}
 
 	input := api.CreateContextInput{
@@ -1494,23 +1761,41 @@ func (m ContextModel) save() (ContextModel, tea.Cmd) {
 	}
 
 	m.saving = true
-	return m, func() tea.Msg {
-		created, err := m.client.CreateContext(input)
-		if err != nil {
-			return errMsg{err}
-		}
-		for _, id := range linkIDs {
-			if err := m.client.LinkContext(created.ID, id); err != nil {
-				return errMsg{err}
-			}
-		}
-		return contextSavedMsg{}
+	return m, m.createContextChecked(input, linkIDs)
+}
+
+// contextFieldIndex maps create-context JSON fields to add form fields.
+var contextFieldIndex = map[string]int{
+	"title":       fieldTitle,
+	"url":         fieldURL,
+	"source_type": fieldType,
+	"tags":        fieldTags,
+	"scopes":      fieldScopes,
+	"content":     fieldNotes,
+	"metadata":    fieldMeta,
+}
+
+// showFieldErrors puts a rejected save's field errors under their fields
+// and focuses the first one.
+func (m *ContextModel) showFieldErrors(msg fieldErrorsMsg) {
+	errs, first, rest := mapFieldErrors(msg.fields, contextFieldIndex)
+	m.view = contextViewAdd
+	m.modeFocus = false
+	m.fieldErrs = errs
+	if first >= 0 {
+		m.focus = first
 	}
+	m.errText = rest
+}
+
+// contentTagSuggestions suggests tags from the form's title, URL, and notes.
+func (m ContextModel) contentTagSuggestions() []string {
+	return suggestContentTags(m.tagOptions, m.fields[fieldTitle].Value, m.fields[fieldURL].Value, m.fields[fieldNotes].Value)
 }
 
 // renderTags renders render tags.
 func (m *ContextModel) renderTags(focused bool) string {
-	if len(m.tags) == 0 && m.tagBuf == "" && !focused {
+	if len(m.tags) == 0 && m.tagBuf.Value == "" && !focused {
 		return "-"
 	}
 
@@ -1525,22 +1810,20 @@ func (m *ContextModel) renderTags(focused bool) string {
 		if b.Len() > 0 {
 			b.WriteString(" ")
 		}
-		if m.tagBuf != "" {
-			b.WriteString(m.tagBuf)
-		}
-		b.WriteString(AccentStyle.Render("█"))
-	} else if m.tagBuf != "" {
+		b.WriteString(m.tagBuf.View(AccentStyle, 0))
+		b.WriteString(renderTagSuggestions(m.tagOptions, m.tagBuf.Value, m.tags))
+	} else if m.tagBuf.Value != "" {
 		if b.Len() > 0 {
 			b.WriteString(" ")
 		}
-		b.WriteString(MutedStyle.Render(m.tagBuf))
+		b.WriteString(MutedStyle.Render(m.tagBuf.Value))
 	}
 	return b.String()
 }
 
 // renderEditTags renders render edit tags.
 func (m *ContextModel) renderEditTags(focused bool) string {
-	if len(m.editTags) == 0 && m.editTagBuf == "" && !focused {
+	if len(m.editTags) == 0 && m.editTagBuf.Value == "" && !focused {
 		return "-"
 	}
 
@@ -1555,15 +1838,13 @@ func (m *ContextModel) renderEditTags(focused bool) string {
 		if b.Len() > 0 {
 			b.WriteString(" ")
 		}
-		if m.editTagBuf != "" {
-			b.WriteString(m.editTagBuf)
-		}
-		b.WriteString(AccentStyle.Render("█"))
-	} else if m.editTagBuf != "" {
+		b.WriteString(m.editTagBuf.View(AccentStyle, 0))
+		b.WriteString(renderTagSuggestions(m.tagOptions, m.editTagBuf.Value, m.editTags))
+	} else if m.editTagBuf.Value != "" {
 		if b.Len() > 0 {
 			b.WriteString(" ")
 		}
-		b.WriteString(MutedStyle.Render(m.editTagBuf))
+		b.WriteString(MutedStyle.Render(m.editTagBuf.Value))
 	}
 	return b.String()
 }
@@ -1601,7 +1882,7 @@ func (m *ContextModel) renderLinkedEntities(focused bool) string {
 func (m *ContextModel) startLinkSearch() {
 	m.linkSearching = true
 	m.linkLoading = false
-	m.linkQuery = ""
+	m.linkQuery.Reset()
 	m.linkResults = nil
 	if m.linkList != nil {
 		m.linkList.SetItems(nil)
@@ -1614,7 +1895,7 @@ func (m ContextModel) handleLinkSearch(msg tea.KeyMsg) (ContextModel, tea.Cmd) {
 	case isBack(msg):
 		m.linkSearching = false
 		m.linkLoading = false
-		m.linkQuery = ""
+		m.linkQuery.Reset()
 		m.linkResults = nil
 		if m.linkList != nil {
 			m.linkList.SetItems(nil)
@@ -1628,26 +1909,33 @@ func (m ContextModel) handleLinkSearch(msg tea.KeyMsg) (ContextModel, tea.Cmd) {
 			m.linkList.Up()
 		}
 	case isEnter(msg):
+		var picked *api.Entity
 		if m.linkList != nil {
 			if idx := m.linkList.Selected(); idx < len(m.linkResults) {
-				m.addLinkedEntity(m.linkResults[idx])
+				picked = &m.linkResults[idx]
 			}
 		}
 		m.linkSearching = false
 		m.linkLoading = false
-		m.linkQuery = ""
+		m.linkQuery.Reset()
 		m.linkResults = nil
 		if m.linkList != nil {
 			m.linkList.SetItems(nil)
 		}
+		if picked != nil && m.view == contextViewLinks {
+			return m.linkDetailEntity(*picked)
+		}
+		if picked != nil {
+			m.addLinkedEntity(*picked)
+		}
 	case isKey(msg, "backspace"):
-		if len(m.linkQuery) > 0 {
-			m.linkQuery = m.linkQuery[:len(m.linkQuery)-1]
+		if m.linkQuery.Value != "" {
+			m.linkQuery.Backspace()
 			return m, m.updateLinkSearch()
 		}
 	case isKey(msg, "cmd+backspace", "cmd+delete", "ctrl+u"):
-		if m.linkQuery != "" {
-			m.linkQuery = ""
+		if m.linkQuery.Value != "" {
+			m.linkQuery.Reset()
 			m.linkResults = nil
 			if m.linkList != nil {
 				m.linkList.SetItems(nil)
@@ -1655,14 +1943,13 @@ func (m ContextModel) handleLinkSearch(msg tea.KeyMsg) (ContextModel, tea.Cmd) {
 			return m, nil
 		}
 	default:
-		if len(msg.String()) == 1 || msg.String() == " " {
+		if editChanged(&m.linkQuery, msg) {
 			if len(m.linkResults) > 0 {
 				m.linkResults = nil
 				if m.linkList != nil {
 					m.linkList.SetItems(nil)
 				}
 			}
-			m.linkQuery += msg.String()
 			return m, m.updateLinkSearch()
 		}
 	}
@@ -1672,12 +1959,11 @@ func (m ContextModel) handleLinkSearch(msg tea.KeyMsg) (ContextModel, tea.Cmd) {
 // renderLinkSearch renders render link search.
 func (m ContextModel) renderLinkSearch() string {
 	var b strings.Builder
-	b.WriteString(MetaKeyStyle.Render("Search") + MetaPunctStyle.Render(": ") + SelectedStyle.Render(components.SanitizeText(m.linkQuery)))
-	b.WriteString(AccentStyle.Render("█"))
+	b.WriteString(MetaKeyStyle.Render("Search") + MetaPunctStyle.Render(": ") + m.linkQuery.View(AccentStyle, 0))
 	b.WriteString("\n\n")
 	if m.linkLoading {
 		b.WriteString(MutedStyle.Render("Searching..."))
-	} else if strings.TrimSpace(m.linkQuery) == "" {
+	} else if strings.TrimSpace(m.linkQuery.Value) == "" {
 		b.WriteString(MutedStyle.Render("Type to search."))
 	} else if len(m.linkResults) == 0 {
 		b.WriteString(MutedStyle.Render("No matches."))
@@ -1832,7 +2118,7 @@ func (m ContextModel) searchLinkEntities(query string) tea.Cmd {
 
 // updateLinkSearch updates update link search.
 func (m *ContextModel) updateLinkSearch() tea.Cmd {
-	query := strings.TrimSpace(m.linkQuery)
+	query := strings.TrimSpace(m.linkQuery.Value)
 	if query == "" {
 		m.linkLoading = false
 		m.linkResults = nil
@@ -1857,26 +2143,26 @@ func (m *ContextModel) addLinkedEntity(entity api.Entity) {
 
 // commitTag handles commit tag.
 func (m *ContextModel) commitTag() {
-	raw := strings.TrimSpace(m.tagBuf)
+	raw := strings.TrimSpace(m.tagBuf.Value)
 	if raw == "" {
-		m.tagBuf = ""
+		m.tagBuf.Reset()
 		return
 	}
 
-	tag := normalizeTag(raw)
+	tag := canonicalTag(m.tagOptions, normalizeTag(raw))
 	if tag == "" {
-		m.tagBuf = ""
+		m.tagBuf.Reset()
 		return
 	}
 
 	for _, t := range m.tags {
 		if t == tag {
-			m.tagBuf = ""
+			m.tagBuf.Reset()
 			return
 		}
 	}
 	m.tags = append(m.tags, tag)
-	m.tagBuf = ""
+	m.tagBuf.Reset()
 }
 
 // commitScope handles commit scope.
@@ -1905,26 +2191,26 @@ func (m *ContextModel) commitScope() {
 
 // commitEditTag handles commit edit tag.
 func (m *ContextModel) commitEditTag() {
-	raw := strings.TrimSpace(m.editTagBuf)
+	raw := strings.TrimSpace(m.editTagBuf.Value)
 	if raw == "" {
-		m.editTagBuf = ""
+		m.editTagBuf.Reset()
 		return
 	}
 
-	tag := normalizeTag(raw)
+	tag := canonicalTag(m.tagOptions, normalizeTag(raw))
 	if tag == "" {
-		m.editTagBuf = ""
+		m.editTagBuf.Reset()
 		return
 	}
 
 	for _, t := range m.editTags {
 		if t == tag {
-			m.editTagBuf = ""
+			m.editTagBuf.Reset()
 			return
 		}
 	}
 	m.editTags = append(m.editTags, tag)
-	m.editTagBuf = ""
+	m.editTagBuf.Reset()
 }
 
 // commitEditScope handles commit edit scope.
@@ -1969,3 +2255,59 @@ func normalizeScope(s string) string {
 	s = strings.Join(strings.Fields(s), "-")
 	return s
 }
+
+// contextSortColumns are the columns `o` cycles through in the library.
+var contextSortColumns = []string{"at", "title", "type", "status"}
+
+// compareContext orders context items by a library column.
+func compareContext(column string, a, b api.Context) int {
+	switch column {
+	case "title":
+		return compareText(contextTitle(a), contextTitle(b))
+	case "type":
+		return compareText(a.SourceType, b.SourceType)
+	case "status":
+		return compareText(a.Status, b.Status)
+	}
+	return compareTime(contextSortTime(a), contextSortTime(b))
+}
+
+// contextSortTime is the time shown in the library's At column.
+func contextSortTime(k api.Context) time.Time {
+	if k.UpdatedAt.IsZero() {
+		return k.CreatedAt
+	}
+	return k.UpdatedAt
+}
+
+// contextColumnValue returns the table cell text for one context column.
+func contextColumnValue(k api.Context, key string) string {
+	switch key {
+	case "title":
+		return components.SanitizeOneLine(contextTitle(k))
+	case "type":
+		if typ := strings.TrimSpace(components.SanitizeOneLine(k.SourceType)); typ != "" {
+			return typ
+		}
+		return "note"
+	case "status":
+		if status := strings.TrimSpace(components.SanitizeOneLine(k.Status)); status != "" {
+			return status
+		}
+	case "tags":
+		if len(k.Tags) > 0 {
+			return components.SanitizeOneLine(strings.Join(k.Tags, ", "))
+		}
+	case "url":
+		if k.URL != nil && strings.TrimSpace(*k.URL) != "" {
+			return components.SanitizeOneLine(strings.TrimSpace(*k.URL))
+		}
+	case "at":
+		at := k.UpdatedAt
+		if at.IsZero() {
+			at = k.CreatedAt
+		}
+		return formatLocalTimeCompact(at)
+	}
+	return "-"
+}
@@ -0,0 +1,123 @@
+package ui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+type contextEditConflictMsg struct {
+	input  api.UpdateContextInput
+	theirs api.Context
+}
+
+// showEditConflict opens the merge dialog for a save that hit a newer record.
+func (m *ContextModel) showEditConflict(msg contextEditConflictMsg) {
+	m.editSaving = false
+	m.errText = ""
+	theirs := msg.theirs
+	m.conflictMine = msg.input
+	m.conflictTheirs = &theirs
+	m.editConflict = newEditConflict(m.contextConflictFields())
+	m.view = contextViewEditConflict
+}
+
+// contextConflictFields lines up base, mine, and theirs for each editable field.
+func (m ContextModel) contextConflictFields() []conflictField {
+	base := api.Context{}
+	if m.editBase != nil {
+		base = *m.editBase
+	}
+	theirs := *m.conflictTheirs
+	mine := m.conflictMine
+
+	field := func(key, label, baseVal, theirsVal string, mineVal *string) conflictField {
+		f := conflictField{key: key, label: label, base: baseVal, mine: baseVal, theirs: theirsVal}
+		if mineVal != nil {
+			f.mine = *mineVal
+		}
+		return f
+	}
+	list := func(key, label string, baseVal, theirsVal []string, mineVal *[]string) conflictField {
+		f := conflictField{key: key, label: label, base: strings.Join(baseVal, ", "), theirs: strings.Join(theirsVal, ", ")}
+		f.mine = f.base
+		if mineVal != nil {
+			f.mine = strings.Join(*mineVal, ", ")
+		}
+		return f
+	}
+
+	return []conflictField{
+		field("title", "Title", contextTitle(base), contextTitle(theirs), mine.Title),
+		field("url", "URL", derefString(base.URL), derefString(theirs.URL), mine.URL),
+		field("source_type", "Type", base.SourceType, theirs.SourceType, mine.SourceType),
+		field("content", "Content", derefString(base.Content), derefString(theirs.Content), mine.Content),
+		field("status", "Status", base.Status, theirs.Status, mine.Status),
+		list("tags", "Tags", base.Tags, theirs.Tags, mine.Tags),
+		list("scopes", "Scopes", m.scopeNamesFromIDs(base.PrivacyScopeIDs), m.scopeNamesFromIDs(theirs.PrivacyScopeIDs), mine.Scopes),
+		{
+			key:    "metadata",
+			label:  "Metadata",
+			base:   conflictMetadata(base.Metadata),
+			mine:   conflictMetadata(mine.Metadata),
+			theirs: conflictMetadata(theirs.Metadata),
+		},
+	}
+}
+
+// mergedContextEdit builds the update for the chosen sides, checked against
+// the live record's timestamp.
+func (m ContextModel) mergedContextEdit() api.UpdateContextInput {
+	theirs := *m.conflictTheirs
+	input := m.conflictMine
+	c := m.editConflict
+	keep := func(key, value string, target **string) {
+		if c.useTheirs(key) {
+			v := value
+			*target = &v
+		}
+	}
+	keep("title", contextTitle(theirs), &input.Title)
+	keep("url", derefString(theirs.URL), &input.URL)
+	keep("source_type", theirs.SourceType, &input.SourceType)
+	keep("content", derefString(theirs.Content), &input.Content)
+	keep("status", theirs.Status, &input.Status)
+	if c.useTheirs("tags") {
+		tags := append([]string{}, theirs.Tags...)
+		input.Tags = &tags
+	}
+	if c.useTheirs("scopes") {
+		scopes := m.scopeNamesFromIDs(theirs.PrivacyScopeIDs)
+		input.Scopes = &scopes
+	}
+	if c.useTheirs("metadata") {
+		input.Metadata = nil
+	}
+	if !theirs.UpdatedAt.IsZero() {
+		seen := theirs.UpdatedAt
+		input.ExpectedUpdatedAt = &seen
+	}
+	return input
+}
+
+// handleEditConflictKeys handles keys in the merge dialog.
+func (m ContextModel) handleEditConflictKeys(msg tea.KeyMsg) (ContextModel, tea.Cmd) {
+	if m.editSaving || m.editConflict == nil || m.conflictTheirs == nil {
+		return m, nil
+	}
+	switch m.editConflict.handleKey(msg) {
+	case conflictSave:
+		input := m.mergedContextEdit()
+		// The merge becomes the new base if the record moves again.
+		theirs := *m.conflictTheirs
+		m.editBase = &theirs
+		m.editSaving = true
+		m.errText = ""
+		return m, m.submitEdit(m.conflictTheirs.ID, input)
+	case conflictCancel:
+		m.view = contextViewEdit
+	}
+	return m, nil
+}
@@ -0,0 +1,149 @@
+package ui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/dedupe"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+type contextDuplicateFoundMsg struct {
+	input    api.CreateContextInput
+	linkIDs  []string
+	existing api.Context
+}
+
+// createContextChecked looks for knowledge with the same URL before creating
+// one. The lookup is advisory: when it fails the item is created anyway.
+func (m ContextModel) createContextChecked(input api.CreateContextInput, linkIDs []string) tea.Cmd {
+	return func() tea.Msg {
+		if strings.TrimSpace(input.URL) != "" {
+			if items, err := dedupe.Fetch(m.client); err == nil {
+				if matches := dedupe.MatchURL(items, input.URL); len(matches) > 0 {
+					return contextDuplicateFoundMsg{input: input, linkIDs: linkIDs, existing: matches[0]}
+				}
+			}
+		}
+		return m.createContext(input, linkIDs)()
+	}
+}
+
+// createContext creates a knowledge item and links it, without a duplicate check.
+func (m ContextModel) createContext(input api.CreateContextInput, linkIDs []string) tea.Cmd {
+	return func() tea.Msg {
+		created, err := m.client.CreateContext(input)
+		if err != nil {
+			return saveFailed(err)
+		}
+		for _, id := range linkIDs {
+			if err := m.client.LinkContext(created.ID, id); err != nil {
+				return errMsg{err}
+			}
+		}
+		return contextSavedMsg{}
+	}
+}
+
+// mergeIntoExisting adds the pending item's tags and links to the existing
+// item instead of creating a second copy.
+func (m ContextModel) mergeIntoExisting(existing api.Context, input api.CreateContextInput, linkIDs []string) tea.Cmd {
+	tags := dedupe.MergeTags(existing.Tags, input.Tags)
+	return func() tea.Msg {
+		updated := &existing
+		if len(tags) > len(existing.Tags) {
+			var err error
+			updated, err = m.client.UpdateContext(existing.ID, api.UpdateContextInput{Tags: &tags})
+			if err != nil {
+				return errMsg{err}
+			}
+		}
+		for _, id := range linkIDs {
+			if err := m.client.LinkContext(existing.ID, id); err != nil {
+				return errMsg{err}
+			}
+		}
+		return contextUpdatedMsg{item: *updated}
+	}
+}
+
+// showDuplicate switches to the duplicate warning for a pending create.
+func (m *ContextModel) showDuplicate(msg contextDuplicateFoundMsg) {
+	input := msg.input
+	existing := msg.existing
+	m.saving = false
+	m.dupPending = &input
+	m.dupLinkIDs = msg.linkIDs
+	m.dupExisting = &existing
+	m.view = contextViewDuplicate
+}
+
+// clearDuplicate drops the pending create and its match.
+func (m *ContextModel) clearDuplicate() {
+	m.dupPending = nil
+	m.dupLinkIDs = nil
+	m.dupExisting = nil
+}
+
+// handleDuplicateKeys handles keys in the duplicate warning.
+func (m ContextModel) handleDuplicateKeys(msg tea.KeyMsg) (ContextModel, tea.Cmd) {
+	if m.dupPending == nil || m.dupExisting == nil {
+		m.view = contextViewAdd
+		return m, nil
+	}
+	input, linkIDs, existing := *m.dupPending, m.dupLinkIDs, *m.dupExisting
+	switch {
+	case isKey(msg, "o"), isEnter(msg):
+		m.clearDuplicate()
+		m.resetForm()
+		m.detail = &existing
+		m.view = contextViewDetail
+		return m, m.loadContextDetail(existing.ID)
+	case isKey(msg, "s"):
+		m.clearDuplicate()
+		m.view = contextViewAdd
+		m.saving = true
+		return m, m.createContext(input, linkIDs)
+	case isKey(msg, "m"):
+		m.clearDuplicate()
+		m.resetForm()
+		m.detail = &existing
+		m.editSaving = true
+		m.view = contextViewDetail
+		return m, m.mergeIntoExisting(existing, input, linkIDs)
+	case isBack(msg):
+		m.clearDuplicate()
+		m.view = contextViewAdd
+	}
+	return m, nil
+}
+
+// renderDuplicate renders the same-URL warning.
+func (m ContextModel) renderDuplicate() string {
+	if m.dupExisting == nil {
+		return ""
+	}
+	existing := *m.dupExisting
+	contentWidth := components.BoxContentWidth(m.width)
+	url := ""
+	if existing.URL != nil {
+		url = *existing.URL
+	}
+	tags := "-"
+	if len(existing.Tags) > 0 {
+		tags = strings.Join(existing.Tags, ", ")
+	}
+	rows := []string{
+		WarningStyle.Render("This URL is already saved"),
+		"",
+		MutedStyle.Render("Title:   ") + components.ClampTextWidthEllipsis(components.SanitizeOneLine(contextTitle(existing)), contentWidth-9),
+		MutedStyle.Render("URL:     ") + components.ClampTextWidthEllipsis(components.SanitizeOneLine(url), contentWidth-9),
+		MutedStyle.Render("Tags:    ") + components.ClampTextWidthEllipsis(components.SanitizeOneLine(tags), contentWidth-9),
+		MutedStyle.Render("Added:   ") + formatLocalTimeCompact(existing.CreatedAt),
+		"",
+		MutedStyle.Render("o opens the existing item  ·  s saves anyway  ·  m merges tags into it  ·  esc edits the form"),
+	}
+	return components.TitledBox("Possible Duplicate", strings.Join(rows, "\n"), m.width)
+}
@@ -0,0 +1,106 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// duplicateContextClient serves one existing item at example.com/docs and
+// records creates and tag updates.
+func duplicateContextClient(t *testing.T, created *int, patched *api.UpdateContextInput) *api.Client {
+	_, client := contextTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/context" && r.Method == http.MethodGet:
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+				{"id": "ctx-old", "title": "Docs", "url": "https://www.example.com/docs/", "tags": []string{"docs"}},
+			}}))
+		case r.URL.Path == "/api/context" && r.Method == http.MethodPost:
+			*created++
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "ctx-new", "tags": []string{}}}))
+		case r.URL.Path == "/api/context/ctx-old" && r.Method == http.MethodPatch:
+			require.NoError(t, json.NewDecoder(r.Body).Decode(patched))
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+				"id": "ctx-old", "title": "Docs", "tags": *patched.Tags,
+			}}))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	return client
+}
+
+func TestContextSaveWarnsOnDuplicateURL(t *testing.T) {
+	var created int
+	var patched api.UpdateContextInput
+	model := NewContextModel(duplicateContextClient(t, &created, &patched))
+	model.width = 100
+	model.fields[fieldTitle].Value = "Docs again"
+	model.fields[fieldURL].Value = "https://example.com/docs"
+	model.tags = []string{"Docs", "api"}
+
+	model, cmd := model.save()
+	require.NotNil(t, cmd)
+	model, _ = model.Update(cmd())
+	require.Equal(t, contextViewDuplicate, model.view)
+	assert.False(t, model.saving)
+	view := components.SanitizeText(model.View())
+	assert.Contains(t, view, "This URL is already saved")
+	assert.Contains(t, view, "https://www.example.com/docs/")
+
+	// Merge adds only the missing tag to the existing item.
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	require.NotNil(t, cmd)
+	model, _ = model.Update(cmd())
+	assert.Equal(t, contextViewDetail, model.view)
+	require.NotNil(t, patched.Tags)
+	assert.Equal(t, []string{"docs", "api"}, *patched.Tags)
+	assert.Equal(t, "ctx-old", model.detail.ID)
+	assert.Zero(t, created)
+}
+
+func TestContextDuplicateSaveAnywayAndOpenExisting(t *testing.T) {
+	var created int
+	var patched api.UpdateContextInput
+	client := duplicateContextClient(t, &created, &patched)
+
+	model := NewContextModel(client)
+	model.fields[fieldTitle].Value = "Docs again"
+	model.fields[fieldURL].Value = "http://example.com/docs#top"
+	model, cmd := model.save()
+	model, _ = model.Update(cmd())
+	require.Equal(t, contextViewDuplicate, model.view)
+
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	require.NotNil(t, cmd)
+	assert.IsType(t, contextSavedMsg{}, cmd())
+	assert.Equal(t, 1, created)
+
+	model = NewContextModel(client)
+	model.fields[fieldTitle].Value = "Docs again"
+	model.fields[fieldURL].Value = "https://example.com/docs"
+	model, cmd = model.save()
+	model, _ = model.Update(cmd())
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o")})
+	require.NotNil(t, cmd)
+	assert.Equal(t, contextViewDetail, model.view)
+	assert.Equal(t, "ctx-old", model.detail.ID)
+	assert.Empty(t, model.fields[fieldURL].Value)
+}
+
+func TestContextSaveWithoutURLSkipsDuplicateCheck(t *testing.T) {
+	var created int
+	var patched api.UpdateContextInput
+	model := NewContextModel(duplicateContextClient(t, &created, &patched))
+	model.fields[fieldTitle].Value = "Plain note"
+	_, cmd := model.save()
+	assert.IsType(t, contextSavedMsg{}, cmd())
+	assert.Equal(t, 1, created)
+}
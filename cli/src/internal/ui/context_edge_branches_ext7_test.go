@@ -14,32 +14,32 @@ import (
 func TestContextUpdateDelegatesToActiveMetadataEditors(t *testing.T) {
 	model := NewContextModel(nil)
 	model.focus = fieldTitle
-	model.fields[fieldTitle].value = "keep"
+	model.fields[fieldTitle].Value = "keep"
 	model.metaEditor.Active = true
 
 	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
 	require.Nil(t, cmd)
-	assert.Equal(t, "keep", updated.fields[fieldTitle].value)
+	assert.Equal(t, "keep", updated.fields[fieldTitle].Value)
 
 	model = NewContextModel(nil)
 	model.focus = fieldTitle
-	model.fields[fieldTitle].value = "keep"
+	model.fields[fieldTitle].Value = "keep"
 	model.editMeta.Active = true
 
 	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
 	require.Nil(t, cmd)
-	assert.Equal(t, "keep", updated.fields[fieldTitle].value)
+	assert.Equal(t, "keep", updated.fields[fieldTitle].Value)
 }
 
 func TestContextUpdateBackspaceTagBufferBeforeRemovingTags(t *testing.T) {
 	model := NewContextModel(nil)
 	model.focus = fieldTags
 	model.tags = []string{"alpha"}
-	model.tagBuf = "xy"
+	model.tagBuf.Value = "xy"
 
 	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "x", updated.tagBuf)
+	assert.Equal(t, "x", updated.tagBuf.Value)
 	assert.Equal(t, []string{"alpha"}, updated.tags)
 }
 
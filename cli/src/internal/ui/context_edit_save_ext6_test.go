@@ -28,9 +28,9 @@ func TestContextHandleListKeysFilteringDispatchAndTopUpFocus(t *testing.T) {
 
 func TestContextStartEditNilDetailAndNilURLBranch(t *testing.T) {
 	model := NewContextModel(nil)
-	model.contextEditFields[contextEditFieldURL].value = "keep"
+	model.contextEditFields[contextEditFieldURL].Value = "keep"
 	model.startEdit()
-	assert.Equal(t, "keep", model.contextEditFields[contextEditFieldURL].value)
+	assert.Equal(t, "keep", model.contextEditFields[contextEditFieldURL].Value)
 
 	model.detail = &api.Context{
 		ID:         "ctx-1",
@@ -41,7 +41,7 @@ func TestContextStartEditNilDetailAndNilURLBranch(t *testing.T) {
 		Content:    nil,
 	}
 	model.startEdit()
-	assert.Equal(t, "", model.contextEditFields[contextEditFieldURL].value)
+	assert.Equal(t, "", model.contextEditFields[contextEditFieldURL].Value)
 }
 
 func TestContextSaveEditNilDetailAndUpdateErrorBranch(t *testing.T) {
@@ -0,0 +1,275 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+const (
+	urlFetchTimeout  = 10 * time.Second
+	urlFetchMaxBytes = 1 << 20
+)
+
+// urlFetchClient fetches pages for the context add form.
+var urlFetchClient = &http.Client{Timeout: urlFetchTimeout}
+
+// urlPreview is the page metadata found at a pasted URL.
+type urlPreview struct {
+	title       string
+	description string
+	siteName    string
+	published   string
+	kind        string
+}
+
+type contextURLFetchedMsg struct {
+	url     string
+	preview urlPreview
+	err     error
+}
+
+var (
+	htmlMetaTagPattern  = regexp.MustCompile(`(?is)<meta\s[^>]*>`)
+	htmlAttrPattern     = regexp.MustCompile(`(?is)([a-z][a-z0-9:_-]*)\s*=\s*("[^"]*"|'[^']*'|[^\s"'>]+)`)
+	htmlTitlePattern    = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+	htmlWhitespaceRunes = regexp.MustCompile(`\s+`)
+)
+
+// fetchURLPreview downloads a page and reads its title and metadata.
+func fetchURLPreview(raw string) tea.Cmd {
+	return func() tea.Msg {
+		preview, err := loadURLPreview(raw)
+		return contextURLFetchedMsg{url: raw, preview: preview, err: err}
+	}
+}
+
+// loadURLPreview fetches raw and parses the page head. Only http and https
+// URLs are fetched, and at most urlFetchMaxBytes of the body is read.
+func loadURLPreview(raw string) (urlPreview, error) {
+	parsed, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return urlPreview{}, errors.New("enter an http or https URL to fetch")
+	}
+	req, err := http.NewRequest(http.MethodGet, parsed.String(), nil)
+	if err != nil {
+		return urlPreview{}, err
+	}
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+	req.Header.Set("User-Agent", "nebula-cli")
+	resp, err := urlFetchClient.Do(req)
+	if err != nil {
+		return urlPreview{}, fmt.Errorf("fetch %s: %w", parsed.Host, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode >= 400 {
+		return urlPreview{}, fmt.Errorf("fetch %s: %s", parsed.Host, resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, urlFetchMaxBytes))
+	if err != nil {
+		return urlPreview{}, fmt.Errorf("read %s: %w", parsed.Host, err)
+	}
+	preview := parseURLPreview(string(body))
+	if preview.title == "" && preview.description == "" && preview.siteName == "" && preview.published == "" {
+		return preview, fmt.Errorf("no page metadata found at %s", parsed.Host)
+	}
+	return preview, nil
+}
+
+// parseURLPreview reads Open Graph, Twitter, and standard meta tags, falling
+// back to <title> for the page title.
+func parseURLPreview(page string) urlPreview {
+	meta := map[string]string{}
+	for _, tag := range htmlMetaTagPattern.FindAllString(page, -1) {
+		attrs := map[string]string{}
+		for _, match := range htmlAttrPattern.FindAllStringSubmatch(tag, -1) {
+			attrs[strings.ToLower(match[1])] = cleanHTMLText(strings.Trim(match[2], `"'`))
+		}
+		content := attrs["content"]
+		if content == "" {
+			continue
+		}
+		for _, key := range []string{attrs["property"], attrs["name"], attrs["itemprop"]} {
+			key = strings.ToLower(strings.TrimSpace(key))
+			if _, seen := meta[key]; key != "" && !seen {
+				meta[key] = content
+			}
+		}
+	}
+	first := func(keys ...string) string {
+		for _, key := range keys {
+			if value := meta[key]; value != "" {
+				return value
+			}
+		}
+		return ""
+	}
+
+	preview := urlPreview{
+		title:       first("og:title", "twitter:title"),
+		description: first("og:description", "description", "twitter:description"),
+		siteName:    first("og:site_name", "application-name", "twitter:site"),
+		published:   formatPublishedDate(first("article:published_time", "datepublished", "pubdate", "publish_date", "date", "dc.date")),
+		kind:        strings.ToLower(first("og:type")),
+	}
+	if preview.title == "" {
+		if match := htmlTitlePattern.FindStringSubmatch(page); match != nil {
+			preview.title = cleanHTMLText(match[1])
+		}
+	}
+	return preview
+}
+
+// cleanHTMLText unescapes entities and collapses whitespace.
+func cleanHTMLText(text string) string {
+	text = html.UnescapeString(text)
+	return strings.TrimSpace(htmlWhitespaceRunes.ReplaceAllString(text, " "))
+}
+
+// formatPublishedDate shortens common timestamp layouts to a date.
+func formatPublishedDate(raw string) string {
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05Z0700", "2006-01-02T15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, raw); err == nil {
+			return t.Format("2006-01-02")
+		}
+	}
+	return raw
+}
+
+// contextType maps the page's og:type onto a context type, if one fits.
+func (p urlPreview) contextType() string {
+	switch {
+	case p.kind == "article":
+		return "article"
+	case strings.HasPrefix(p.kind, "video"):
+		return "video"
+	}
+	return ""
+}
+
+// metadata returns the fetched fields stored on the context.
+func (p urlPreview) metadata() map[string]any {
+	meta := map[string]any{}
+	if p.description != "" {
+		meta["description"] = p.description
+	}
+	if p.siteName != "" {
+		meta["site_name"] = p.siteName
+	}
+	if p.published != "" {
+		meta["published_at"] = p.published
+	}
+	return meta
+}
+
+// startURLFetch fetches the URL field, or reports why it cannot.
+func (m ContextModel) startURLFetch() (ContextModel, tea.Cmd) {
+	raw := strings.TrimSpace(m.fields[fieldURL].Value)
+	if raw == "" {
+		m.errText = "Enter a URL to fetch"
+		return m, nil
+	}
+	m.errText = ""
+	m.urlFetching = true
+	m.urlPreview = nil
+	return m, fetchURLPreview(raw)
+}
+
+// handleURLFetched stores a fetch result for review, dropping results for a
+// URL the user has since changed.
+func (m ContextModel) handleURLFetched(msg contextURLFetchedMsg) ContextModel {
+	if strings.TrimSpace(m.fields[fieldURL].Value) != msg.url {
+		return m
+	}
+	m.urlFetching = false
+	if msg.err != nil {
+		m.errText = msg.err.Error()
+		return m
+	}
+	preview := msg.preview
+	m.urlPreview = &preview
+	return m
+}
+
+// handleURLPreviewKeys applies or discards the fetched preview.
+func (m ContextModel) handleURLPreviewKeys(msg tea.KeyMsg) (ContextModel, tea.Cmd) {
+	switch {
+	case isKey(msg, "y"), isEnter(msg):
+		m.applyURLPreview(*m.urlPreview)
+		m.urlPreview = nil
+	case isKey(msg, "n"), isBack(msg):
+		m.urlPreview = nil
+	}
+	return m, nil
+}
+
+// applyURLPreview fills an empty title, a still-default type, and metadata
+// keys that are not already set.
+func (m *ContextModel) applyURLPreview(p urlPreview) {
+	if strings.TrimSpace(m.fields[fieldTitle].Value) == "" && p.title != "" {
+		m.fields[fieldTitle].SetValue(p.title)
+	}
+	if typ := p.contextType(); typ != "" && m.typeIdx == 0 {
+		for i, option := range contextTypes {
+			if option == typ {
+				m.typeIdx = i
+			}
+		}
+	}
+	meta := p.metadata()
+	if len(meta) == 0 {
+		return
+	}
+	current, err := parseMetadataInput(m.metaEditor.Buffer)
+	if err != nil {
+		return
+	}
+	for key, value := range current {
+		meta[key] = value
+	}
+	m.metaEditor.Buffer = metadataToInput(meta)
+}
+
+// renderURLPreview renders the fetched metadata awaiting confirmation.
+func (m ContextModel) renderURLPreview() string {
+	if m.urlFetching {
+		return MutedStyle.Render("  Fetching page details...")
+	}
+	if m.urlPreview == nil {
+		return ""
+	}
+	p := m.urlPreview
+	width := previewBoxContentWidth(preferredPreviewWidth(components.BoxContentWidth(m.width)))
+	lines := []string{MetaKeyStyle.Render("Fetched from URL")}
+	for _, row := range [][2]string{
+		{"Title", p.title},
+		{"Site", p.siteName},
+		{"Published", p.published},
+		{"Type", p.contextType()},
+	} {
+		if row[1] != "" {
+			lines = append(lines, renderPreviewRow(row[0], row[1], width))
+		}
+	}
+	if p.title != "" && strings.TrimSpace(m.fields[fieldTitle].Value) != "" {
+		lines = append(lines, MutedStyle.Render("keeps current title"))
+	}
+	if p.description != "" {
+		lines = append(lines, "", MetaKeyStyle.Render("Summary"))
+		for _, part := range wrapPreviewText(p.description, width) {
+			lines = append(lines, MetaValueStyle.Render(part))
+		}
+	}
+	lines = append(lines, "", MutedStyle.Render("y apply · n discard"))
+	return renderPreviewBox(padPreviewLines(lines, width), preferredPreviewWidth(components.BoxContentWidth(m.width)))
+}
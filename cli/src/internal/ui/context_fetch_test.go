@@ -0,0 +1,119 @@
+package ui
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const fetchTestPage = `<!doctype html><html><head>
+<title>Fallback Title</title>
+<meta property="og:title" content="Scaling Agents &amp; Context">
+<meta property="og:type" content="article">
+<meta name="description" content="How   agents share
+ context.">
+<meta content="Nebula Blog" property="og:site_name">
+<meta property="article:published_time" content="2026-03-14T09:30:00Z">
+</head><body></body></html>`
+
+func TestParseURLPreviewReadsMetaTags(t *testing.T) {
+	preview := parseURLPreview(fetchTestPage)
+	assert.Equal(t, "Scaling Agents & Context", preview.title)
+	assert.Equal(t, "How agents share context.", preview.description)
+	assert.Equal(t, "Nebula Blog", preview.siteName)
+	assert.Equal(t, "2026-03-14", preview.published)
+	assert.Equal(t, "article", preview.contextType())
+
+	fallback := parseURLPreview(`<html><head><title> Plain  Page </title></head></html>`)
+	assert.Equal(t, "Plain Page", fallback.title)
+	assert.Empty(t, fallback.contextType())
+}
+
+func TestContextAddFetchesAndAppliesURLPreview(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "nebula-cli", r.Header.Get("User-Agent"))
+		_, _ = w.Write([]byte(fetchTestPage))
+	}))
+	defer srv.Close()
+
+	model := NewContextModel(nil)
+	model.width = 120
+	model.view = contextViewAdd
+	model.focus = fieldURL
+	model.fields[fieldURL].Value = srv.URL
+	model.metaEditor.Buffer = "site_name: Mine"
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	assert.True(t, model.urlFetching)
+	assert.Contains(t, components.SanitizeText(model.View()), "Fetching page details")
+
+	model, _ = model.Update(cmd())
+	require.NotNil(t, model.urlPreview)
+	view := components.SanitizeText(model.View())
+	assert.Contains(t, view, "Fetched from URL")
+	assert.Contains(t, view, "Nebula Blog")
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	assert.Nil(t, model.urlPreview)
+	assert.Equal(t, "Scaling Agents & Context", model.fields[fieldTitle].Value)
+	assert.Equal(t, "article", contextTypes[model.typeIdx])
+	meta, err := parseMetadataInput(model.metaEditor.Buffer)
+	require.NoError(t, err)
+	assert.Equal(t, "Mine", meta["site_name"])
+	assert.Equal(t, "How agents share context.", meta["description"])
+	assert.Equal(t, "2026-03-14", meta["published_at"])
+}
+
+func TestContextAddURLFetchKeepsTitleAndDiscards(t *testing.T) {
+	model := NewContextModel(nil)
+	model.width = 160
+	model.view = contextViewAdd
+	model.fields[fieldTitle].Value = "My Title"
+	model.fields[fieldURL].Value = "https://example.com"
+	model, _ = model.Update(contextURLFetchedMsg{url: "https://example.com", preview: urlPreview{title: "Theirs"}})
+	require.NotNil(t, model.urlPreview)
+	assert.Contains(t, components.SanitizeText(model.View()), "keeps current title")
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	assert.Nil(t, model.urlPreview)
+	assert.Equal(t, "My Title", model.fields[fieldTitle].Value)
+
+	model.applyURLPreview(urlPreview{title: "Theirs"})
+	assert.Equal(t, "My Title", model.fields[fieldTitle].Value)
+}
+
+func TestContextAddURLFetchErrorsAndStaleResults(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	}))
+	defer srv.Close()
+
+	_, err := loadURLPreview(srv.URL)
+	assert.ErrorContains(t, err, "404")
+	_, err = loadURLPreview("ftp://example.com/file")
+	assert.ErrorContains(t, err, "http or https")
+
+	model := NewContextModel(nil)
+	model.view = contextViewAdd
+	model.focus = fieldURL
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Nil(t, cmd)
+	assert.Equal(t, "Enter a URL to fetch", model.errText)
+
+	model.fields[fieldURL].Value = srv.URL
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	model, _ = model.Update(cmd())
+	assert.False(t, model.urlFetching)
+	assert.Contains(t, model.errText, "404")
+
+	model.fields[fieldURL].Value = "https://changed.example"
+	model, _ = model.Update(contextURLFetchedMsg{url: srv.URL, preview: urlPreview{title: "Old"}})
+	assert.Nil(t, model.urlPreview)
+}
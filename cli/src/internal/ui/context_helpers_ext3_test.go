@@ -138,19 +138,19 @@ func TestContextCommitEditTagBranches(t *testing.T) {
 	model := NewContextModel(nil)
 	model.editTags = []string{"alpha-tag"}
 
-	model.editTagBuf = "   "
+	model.editTagBuf.Value = "   "
 	model.commitEditTag()
-	assert.Equal(t, "", model.editTagBuf)
+	assert.Equal(t, "", model.editTagBuf.Value)
 	assert.Equal(t, []string{"alpha-tag"}, model.editTags)
 
-	model.editTagBuf = "#Alpha Tag"
+	model.editTagBuf.Value = "#Alpha Tag"
 	model.commitEditTag()
-	assert.Equal(t, "", model.editTagBuf)
+	assert.Equal(t, "", model.editTagBuf.Value)
 	assert.Equal(t, []string{"alpha-tag"}, model.editTags)
 
-	model.editTagBuf = "Beta_Tag"
+	model.editTagBuf.Value = "Beta_Tag"
 	model.commitEditTag()
-	assert.Equal(t, "", model.editTagBuf)
+	assert.Equal(t, "", model.editTagBuf.Value)
 	assert.Equal(t, []string{"alpha-tag", "beta-tag"}, model.editTags)
 }
 
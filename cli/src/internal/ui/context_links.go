@@ -0,0 +1,201 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// contextLinksUpdatedMsg carries the detail relationships after a link or
+// unlink.
+type contextLinksUpdatedMsg struct {
+	relationships []api.Relationship
+}
+
+// detailLinks returns the active relationships between the open context and
+// entities.
+func (m ContextModel) detailLinks() []api.Relationship {
+	if m.detail == nil {
+		return nil
+	}
+	var links []api.Relationship
+	for _, rel := range m.detailRelationships {
+		if contextLinkEntityID(m.detail.ID, rel) == "" {
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(rel.Status)) {
+		case "inactive", "archived":
+			continue
+		}
+		links = append(links, rel)
+	}
+	return links
+}
+
+// contextLinkEntityID returns the entity on the far side of rel from the
+// context, or "" when rel does not join the context to an entity.
+func contextLinkEntityID(contextID string, rel api.Relationship) string {
+	sourceType := strings.ToLower(strings.TrimSpace(rel.SourceType))
+	targetType := strings.ToLower(strings.TrimSpace(rel.TargetType))
+	switch {
+	case sourceType == "context" && rel.SourceID == contextID && targetType == "entity":
+		return rel.TargetID
+	case targetType == "context" && rel.TargetID == contextID && sourceType == "entity":
+		return rel.SourceID
+	}
+	return ""
+}
+
+// openLinks switches the detail view to its linked entities.
+func (m *ContextModel) openLinks() {
+	m.view = contextViewLinks
+	m.linkIdx = 0
+	m.unlinkConfirm = false
+	m.errText = ""
+}
+
+// handleLinksKeys moves through the linked entities, starts a search to link
+// another, and unlinks the selected one after confirmation.
+func (m ContextModel) handleLinksKeys(msg tea.KeyMsg) (ContextModel, tea.Cmd) {
+	links := m.detailLinks()
+	if m.unlinkConfirm {
+		switch {
+		case isKey(msg, "y"), isEnter(msg):
+			m.unlinkConfirm = false
+			if m.linkIdx < len(links) {
+				return m, m.unlinkEntity(links[m.linkIdx])
+			}
+		case isKey(msg, "n"), isBack(msg):
+			m.unlinkConfirm = false
+		}
+		return m, nil
+	}
+	if m.linkSaving {
+		return m, nil
+	}
+	switch {
+	case isBack(msg):
+		m.view = contextViewDetail
+		m.errText = ""
+	case isUp(msg):
+		if m.linkIdx > 0 {
+			m.linkIdx--
+		}
+	case isDown(msg):
+		if m.linkIdx < len(links)-1 {
+			m.linkIdx++
+		}
+	case isKey(msg, "a", "l"):
+		m.errText = ""
+		m.startLinkSearch()
+	case isKey(msg, "d", "x"):
+		if m.linkIdx < len(links) {
+			m.unlinkConfirm = true
+		}
+	}
+	return m, nil
+}
+
+// linkDetailEntity links entity to the open context unless it is already
+// linked.
+func (m ContextModel) linkDetailEntity(entity api.Entity) (ContextModel, tea.Cmd) {
+	for _, rel := range m.detailLinks() {
+		if contextLinkEntityID(m.detail.ID, rel) == entity.ID {
+			m.errText = fmt.Sprintf("%s is already linked", firstNonEmpty(components.SanitizeOneLine(entity.Name), shortID(entity.ID)))
+			return m, nil
+		}
+	}
+	contextID := m.detail.ID
+	m.errText = ""
+	m.linkSaving = true
+	return m, func() tea.Msg {
+		if err := m.client.LinkContext(contextID, entity.ID); err != nil {
+			return errMsg{err}
+		}
+		return m.reloadLinks(contextID)
+	}
+}
+
+// unlinkEntity archives rel the same way the relationships tab does.
+func (m *ContextModel) unlinkEntity(rel api.Relationship) tea.Cmd {
+	contextID := m.detail.ID
+	m.linkSaving = true
+	return func() tea.Msg {
+		status := "inactive"
+		if _, err := m.client.UpdateRelationship(rel.ID, api.UpdateRelationshipInput{Status: &status}); err != nil {
+			return errMsg{err}
+		}
+		return m.reloadLinks(contextID)
+	}
+}
+
+// reloadLinks fetches the context's relationships after a change.
+func (m ContextModel) reloadLinks(contextID string) tea.Msg {
+	rels, err := m.client.GetRelationships("context", contextID)
+	if err != nil {
+		return errMsg{err}
+	}
+	return contextLinksUpdatedMsg{relationships: rels}
+}
+
+// handleLinksUpdated stores the reloaded relationships and keeps the cursor
+// on a valid row.
+func (m ContextModel) handleLinksUpdated(msg contextLinksUpdatedMsg) ContextModel {
+	m.linkSaving = false
+	m.detailRelationships = msg.relationships
+	if n := len(m.detailLinks()); m.linkIdx >= n {
+		m.linkIdx = n - 1
+	}
+	if m.linkIdx < 0 {
+		m.linkIdx = 0
+	}
+	return m
+}
+
+// renderLinks renders the linked entities of the open context.
+func (m ContextModel) renderLinks() string {
+	links := m.detailLinks()
+	if m.unlinkConfirm && m.linkIdx < len(links) {
+		rel := links[m.linkIdx]
+		_, node := relationshipDirectionAndEndpoint("context", m.detail.ID, rel)
+		return components.ConfirmDialog("Unlink Entity", fmt.Sprintf("Unlink %s (%s) from this context?", node, firstNonEmpty(rel.Type, "-")))
+	}
+
+	contentWidth := components.BoxContentWidth(m.width)
+	lines := []string{
+		MetaKeyStyle.Render("Linked Entities") + MutedStyle.Render("  "+components.ClampTextWidthEllipsis(contextTitle(*m.detail), 40)),
+		"",
+	}
+	switch {
+	case m.linkSaving:
+		lines = append(lines, MutedStyle.Render("Saving..."))
+	case len(links) == 0:
+		lines = append(lines, MutedStyle.Render("No linked entities. Press a to link one."))
+	default:
+		relWidth, dirWidth, nodeWidth := relationshipSummaryColumnWidths(contentWidth)
+		cols := []components.TableColumn{
+			{Header: "Rel", Width: relWidth, Align: lipgloss.Left},
+			{Header: "Direction", Width: dirWidth, Align: lipgloss.Left},
+			{Header: "Entity", Width: nodeWidth, Align: lipgloss.Left},
+		}
+		rows := make([][]string, 0, len(links))
+		for _, rel := range links {
+			dir, node := relationshipDirectionAndEndpoint("context", m.detail.ID, rel)
+			rows = append(rows, []string{
+				firstNonEmpty(strings.TrimSpace(components.SanitizeOneLine(rel.Type)), "-"),
+				dir,
+				node,
+			})
+		}
+		lines = append(lines, components.TableGridWithActiveRow(cols, rows, contentWidth, m.linkIdx))
+	}
+	if m.errText != "" {
+		lines = append(lines, "", ErrorStyle.Render(m.errText))
+	}
+	return components.TitledBox("Linked Entities", strings.Join(lines, "\n"), m.width)
+}
@@ -0,0 +1,103 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func contextLinksFixture() ContextModel {
+	model := NewContextModel(nil)
+	model.width = 120
+	model.view = contextViewDetail
+	model.detail = &api.Context{ID: "ctx-1", Name: "Alpha"}
+	model.detailRelationships = []api.Relationship{
+		{ID: "rel-1", SourceType: "context", SourceID: "ctx-1", TargetType: "entity", TargetID: "ent-1", TargetName: "Ada", Type: "related-to", Status: "active"},
+		{ID: "rel-2", SourceType: "entity", SourceID: "ent-2", TargetType: "context", TargetID: "ctx-1", SourceName: "Nebula", Type: "cites"},
+		{ID: "rel-3", SourceType: "context", SourceID: "ctx-1", TargetType: "entity", TargetID: "ent-3", TargetName: "Gone", Type: "related-to", Status: "inactive"},
+		{ID: "rel-4", SourceType: "context", SourceID: "ctx-1", TargetType: "job", TargetID: "job-1", Type: "blocks"},
+	}
+	return model
+}
+
+func TestContextLinksListsActiveEntityLinks(t *testing.T) {
+	model := contextLinksFixture()
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'l'}})
+	require.Equal(t, contextViewLinks, model.view)
+
+	links := model.detailLinks()
+	require.Len(t, links, 2)
+	assert.Equal(t, "rel-1", links[0].ID)
+	assert.Equal(t, "rel-2", links[1].ID)
+
+	view := components.SanitizeText(model.View())
+	assert.Contains(t, view, "Linked Entities")
+	assert.Contains(t, view, "Ada")
+	assert.Contains(t, view, "cites")
+	assert.NotContains(t, view, "Gone")
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, contextViewDetail, model.view)
+}
+
+func TestContextLinksLinksAndUnlinksEntities(t *testing.T) {
+	var linked string
+	var statusUpdate api.UpdateRelationshipInput
+	_, client := relTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/context/ctx-1/link":
+			var body map[string]string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			linked = body["entity_id"]
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "rel-9"}})
+		case r.URL.Path == "/api/relationships/rel-1" && r.Method == http.MethodPatch:
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&statusUpdate))
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "rel-1"}})
+		case r.URL.Path == "/api/relationships/context/ctx-1":
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+				{"id": "rel-9", "source_type": "context", "source_id": "ctx-1", "target_type": "entity", "target_id": "ent-5", "target_name": "Grace", "relationship_type": "related-to"},
+			}})
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	model := contextLinksFixture()
+	model.client = client
+	model.openLinks()
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	require.True(t, model.linkSearching)
+	model, _ = model.Update(contextLinkResultsMsg{items: []api.Entity{{ID: "ent-1", Name: "Ada"}}})
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Nil(t, cmd)
+	assert.Contains(t, model.errText, "Ada is already linked")
+
+	model.startLinkSearch()
+	model, _ = model.Update(contextLinkResultsMsg{items: []api.Entity{{ID: "ent-5", Name: "Grace"}}})
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	assert.True(t, model.linkSaving)
+	assert.Empty(t, model.linkEntities)
+	model, _ = model.Update(cmd())
+	assert.Equal(t, "ent-5", linked)
+	assert.False(t, model.linkSaving)
+	require.Len(t, model.detailLinks(), 1)
+
+	model.detailRelationships = contextLinksFixture().detailRelationships
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+	require.True(t, model.unlinkConfirm)
+	assert.Contains(t, components.SanitizeText(model.View()), "Unlink Ada")
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	require.NotNil(t, cmd)
+	model, _ = model.Update(cmd())
+	require.NotNil(t, statusUpdate.Status)
+	assert.Equal(t, "inactive", *statusUpdate.Status)
+	assert.Equal(t, contextViewLinks, model.view)
+}
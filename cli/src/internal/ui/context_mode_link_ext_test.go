@@ -61,34 +61,34 @@ func TestContextHandleFilterInputBranchMatrix(t *testing.T) {
 
 	updated, cmd := model.handleFilterInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.filterBuf)
+	assert.Equal(t, "", updated.filterBuf.Value)
 	assert.Len(t, updated.items, 2)
 
 	updated, cmd = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
 	require.Nil(t, cmd)
-	assert.Equal(t, "a", updated.filterBuf)
+	assert.Equal(t, "a", updated.filterBuf.Value)
 	assert.Len(t, updated.items, 2)
 
 	updated, cmd = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.filterBuf)
+	assert.Equal(t, "", updated.filterBuf.Value)
 	assert.Len(t, updated.items, 2)
 
-	updated.filterBuf = "beta"
+	updated.filterBuf.Value = "beta"
 	updated.applyContextFilter()
 	assert.Len(t, updated.items, 1)
 	updated, cmd = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyEsc})
 	require.Nil(t, cmd)
 	assert.False(t, updated.filtering)
-	assert.Equal(t, "", updated.filterBuf)
+	assert.Equal(t, "", updated.filterBuf.Value)
 	assert.Len(t, updated.items, 2)
 
 	updated.filtering = true
-	updated.filterBuf = "active"
+	updated.filterBuf.Value = "active"
 	updated, cmd = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyEnter})
 	require.Nil(t, cmd)
 	assert.False(t, updated.filtering)
-	assert.Equal(t, "active", updated.filterBuf)
+	assert.Equal(t, "active", updated.filterBuf.Value)
 }
 
 func TestContextHandleDetailKeysBranchMatrix(t *testing.T) {
@@ -119,7 +119,7 @@ func TestContextHandleDetailKeysBranchMatrix(t *testing.T) {
 	require.Nil(t, cmd)
 	assert.True(t, updated.metaExpanded)
 	updated, _ = updated.handleDetailKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
-	assert.True(t, updated.contentExpanded)
+	assert.NotNil(t, updated.pager)
 	updated, _ = updated.handleDetailKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'v'}})
 	assert.True(t, updated.sourcePathExpanded)
 
@@ -127,7 +127,7 @@ func TestContextHandleDetailKeysBranchMatrix(t *testing.T) {
 	require.Nil(t, cmd)
 	assert.Equal(t, contextViewEdit, updated.view)
 	assert.Equal(t, contextEditFieldTitle, updated.editFocus)
-	assert.Equal(t, "Alpha", updated.contextEditFields[contextEditFieldTitle].value)
+	assert.Equal(t, "Alpha", updated.contextEditFields[contextEditFieldTitle].Value)
 
 	updated.view = contextViewDetail
 	updated, cmd = updated.handleDetailKeys(tea.KeyMsg{Type: tea.KeyEsc})
@@ -135,7 +135,7 @@ func TestContextHandleDetailKeysBranchMatrix(t *testing.T) {
 	assert.Equal(t, contextViewList, updated.view)
 	assert.Nil(t, updated.detail)
 	assert.False(t, updated.metaExpanded)
-	assert.False(t, updated.contentExpanded)
+	assert.Nil(t, updated.pager)
 	assert.False(t, updated.sourcePathExpanded)
 }
 
@@ -180,11 +180,11 @@ func TestContextHandleEditKeysBranchMatrix(t *testing.T) {
 		updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeySpace})
 		assert.True(t, updated.editScopeSelecting)
 		updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyRight})
-		assert.Equal(t, 1, updated.scopeIdx)
+		assert.Equal(t, 1, updated.scopePicker.idx)
 		updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeySpace})
 		assert.Equal(t, []string{"private"}, updated.editScopes)
 		updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyLeft})
-		assert.Equal(t, 0, updated.scopeIdx)
+		assert.Equal(t, 0, updated.scopePicker.idx)
 		updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeySpace})
 		assert.Equal(t, []string{"private", "public"}, updated.editScopes)
 		updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyEnter})
@@ -203,13 +203,13 @@ func TestContextHandleEditKeysBranchMatrix(t *testing.T) {
 		model.view = contextViewEdit
 		model.detail = &api.Context{ID: "ctx-1"}
 		model.editFocus = contextEditFieldTags
-		model.editTagBuf = "ab"
+		model.editTagBuf.Value = "ab"
 
 		updated, cmd := model.handleEditKeys(tea.KeyMsg{Type: tea.KeyBackspace})
 		require.Nil(t, cmd)
-		assert.Equal(t, "a", updated.editTagBuf)
+		assert.Equal(t, "a", updated.editTagBuf.Value)
 
-		updated.editTagBuf = ""
+		updated.editTagBuf.Value = ""
 		updated.editTags = []string{"alpha", "beta"}
 		updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyBackspace})
 		assert.Equal(t, []string{"alpha"}, updated.editTags)
@@ -220,12 +220,12 @@ func TestContextHandleEditKeysBranchMatrix(t *testing.T) {
 		assert.Empty(t, updated.editScopes)
 
 		updated.editFocus = contextEditFieldTitle
-		updated.contextEditFields[contextEditFieldTitle].value = "Alpha"
+		updated.contextEditFields[contextEditFieldTitle].Value = "Alpha"
 		updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-		assert.Equal(t, "Alph", updated.contextEditFields[contextEditFieldTitle].value)
+		assert.Equal(t, "Alph", updated.contextEditFields[contextEditFieldTitle].Value)
 		updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
 		updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeySpace})
-		assert.Equal(t, "Alpha ", updated.contextEditFields[contextEditFieldTitle].value)
+		assert.Equal(t, "Alpha ", updated.contextEditFields[contextEditFieldTitle].Value)
 
 		updated.editFocus = contextEditFieldMeta
 		updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyEnter})
@@ -260,7 +260,7 @@ func TestContextRenderTagsAndEditTagsBranches(t *testing.T) {
 	assert.Equal(t, "-", model.renderEditTags(false))
 
 	model.tags = []string{"alpha"}
-	model.tagBuf = "beta"
+	model.tagBuf.Value = "beta"
 	out := components.SanitizeText(model.renderTags(false))
 	assert.Contains(t, out, "alpha")
 	assert.Contains(t, out, "beta")
@@ -271,7 +271,7 @@ func TestContextRenderTagsAndEditTagsBranches(t *testing.T) {
 	assert.Contains(t, out, "█")
 
 	model.editTags = []string{"x"}
-	model.editTagBuf = "y"
+	model.editTagBuf.Value = "y"
 	out = components.SanitizeText(model.renderEditTags(false))
 	assert.Contains(t, out, "x")
 	assert.Contains(t, out, "y")
@@ -300,7 +300,7 @@ func TestContextHandleLinkSearchBranchMatrix(t *testing.T) {
 	model := NewContextModel(nil)
 	model.startLinkSearch()
 	assert.True(t, model.linkSearching)
-	assert.Equal(t, "", model.linkQuery)
+	assert.Equal(t, "", model.linkQuery.Value)
 
 	model.linkResults = []api.Entity{{ID: "ent-1", Name: "Alpha"}}
 	model.linkList.SetItems([]string{"Alpha"})
@@ -311,34 +311,34 @@ func TestContextHandleLinkSearchBranchMatrix(t *testing.T) {
 	assert.Empty(t, model.linkResults)
 
 	model.linkSearching = true
-	model.linkQuery = "ab"
+	model.linkQuery.Value = "ab"
 	model, cmd = model.handleLinkSearch(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.NotNil(t, cmd)
-	assert.Equal(t, "a", model.linkQuery)
+	assert.Equal(t, "a", model.linkQuery.Value)
 
 	model.linkResults = []api.Entity{{ID: "ent-2", Name: "Beta"}}
 	model.linkList.SetItems([]string{"Beta"})
 	model, cmd = model.handleLinkSearch(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
 	require.NotNil(t, cmd)
-	assert.Equal(t, "ax", model.linkQuery)
+	assert.Equal(t, "ax", model.linkQuery.Value)
 	assert.Empty(t, model.linkResults)
 
-	model.linkQuery = "query"
+	model.linkQuery.Value = "query"
 	model.linkResults = []api.Entity{{ID: "ent-3"}}
 	model.linkList.SetItems([]string{"ent-3"})
 	model, cmd = model.handleLinkSearch(tea.KeyMsg{Type: tea.KeyCtrlU})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", model.linkQuery)
+	assert.Equal(t, "", model.linkQuery.Value)
 	assert.Empty(t, model.linkResults)
 
 	model.linkSearching = true
-	model.linkQuery = "z"
+	model.linkQuery.Value = "z"
 	model.linkResults = []api.Entity{{ID: "ent-4"}}
 	model.linkList.SetItems([]string{"ent-4"})
 	model, cmd = model.handleLinkSearch(tea.KeyMsg{Type: tea.KeyEsc})
 	require.Nil(t, cmd)
 	assert.False(t, model.linkSearching)
-	assert.Equal(t, "", model.linkQuery)
+	assert.Equal(t, "", model.linkQuery.Value)
 	assert.Empty(t, model.linkResults)
 }
 
@@ -350,11 +350,11 @@ func TestContextRenderLinkSearchAndPreviewBranches(t *testing.T) {
 	assert.Contains(t, view, "Searching")
 
 	model.linkLoading = false
-	model.linkQuery = ""
+	model.linkQuery.Value = ""
 	view = components.SanitizeText(model.renderLinkSearch())
 	assert.Contains(t, view, "Type to search")
 
-	model.linkQuery = "alpha"
+	model.linkQuery.Value = "alpha"
 	model.linkResults = nil
 	view = components.SanitizeText(model.renderLinkSearch())
 	assert.Contains(t, view, "No matches")
@@ -384,7 +384,7 @@ func TestContextRenderLinkEntityPreviewFallbacks(t *testing.T) {
 func TestContextRenderLinkSearchWideLayoutAndSelectionFallbackBranches(t *testing.T) {
 	model := NewContextModel(nil)
 	model.width = 220 // trigger side-by-side layout branch
-	model.linkQuery = "alpha"
+	model.linkQuery.Value = "alpha"
 	model.linkResults = []api.Entity{
 		{ID: "ent-1", Name: "", Type: "", Status: ""},
 	}
@@ -445,14 +445,14 @@ func TestContextHandleLinkSearchNilListAndOutOfRangeSelectionBranches(t *testing
 	assert.True(t, updated.linkSearching)
 	updated, cmd = updated.handleLinkSearch(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.linkQuery)
+	assert.Equal(t, "", updated.linkQuery.Value)
 	updated, cmd = updated.handleLinkSearch(tea.KeyMsg{Type: tea.KeyCtrlU})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.linkQuery)
+	assert.Equal(t, "", updated.linkQuery.Value)
 
 	model = NewContextModel(nil)
 	model.startLinkSearch()
-	model.linkQuery = "alpha"
+	model.linkQuery.Value = "alpha"
 	model.linkResults = []api.Entity{{ID: "ent-1", Name: "Alpha"}}
 	model.linkList.SetItems([]string{"Alpha", "Ghost"})
 	model.linkList.Cursor = 1
@@ -462,13 +462,13 @@ func TestContextHandleLinkSearchNilListAndOutOfRangeSelectionBranches(t *testing
 	assert.False(t, updated.linkSearching)
 	assert.Empty(t, updated.linkEntities)
 	assert.Empty(t, updated.linkResults)
-	assert.Equal(t, "", updated.linkQuery)
+	assert.Equal(t, "", updated.linkQuery.Value)
 }
 
 func TestContextRenderLinkSearchSideBySidePreviewAndNarrowTableBranches(t *testing.T) {
 	model := NewContextModel(nil)
 	model.width = 220
-	model.linkQuery = "alpha"
+	model.linkQuery.Value = "alpha"
 	model.linkResults = []api.Entity{
 		{ID: "ent-1", Name: "Alpha", Type: "person", Status: "active", Metadata: api.JSONMap{"role": "builder"}},
 	}
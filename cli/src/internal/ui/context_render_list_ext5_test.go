@@ -28,7 +28,7 @@ func TestContextRenderListLoadingAndEmptyBranches(t *testing.T) {
 func TestContextRenderListPreviewAndFilterBranches(t *testing.T) {
 	model := NewContextModel(nil)
 	model.width = 160
-	model.filterBuf = "alpha"
+	model.filterBuf.Value = "alpha"
 	model.modeFocus = true
 
 	now := time.Now().UTC()
@@ -128,8 +128,9 @@ func TestContextLoadContextListErrorBranch(t *testing.T) {
 	require.NotNil(t, cmd)
 
 	msg := cmd()
-	errOut, ok := msg.(errMsg)
+	errOut, ok := msg.(loadFailedMsg)
 	require.True(t, ok)
+	assert.Equal(t, tabKnow, errOut.tab)
 	assert.ErrorContains(t, errOut.err, "CTX_FAIL")
 }
 
@@ -13,13 +13,13 @@ import (
 
 func TestContextSaveValidationBranches(t *testing.T) {
 	model := NewContextModel(nil)
-	model.fields[fieldTitle].value = "   "
+	model.fields[fieldTitle].Value = "   "
 	updated, cmd := model.save()
 	assert.Nil(t, cmd)
 	assert.Contains(t, updated.errText, "Title is required")
 
 	model = NewContextModel(nil)
-	model.fields[fieldTitle].value = "Alpha"
+	model.fields[fieldTitle].Value = "Alpha"
 	model.metaEditor.Buffer = "invalid metadata row"
 	updated, cmd = model.save()
 	assert.Nil(t, cmd)
@@ -37,8 +37,8 @@ func TestContextSaveCreateAndLinkErrorBranches(t *testing.T) {
 		})
 
 		model := NewContextModel(client)
-		model.fields[fieldTitle].value = "Alpha"
-		model.fields[fieldNotes].value = "Notes"
+		model.fields[fieldTitle].Value = "Alpha"
+		model.fields[fieldNotes].Value = "Notes"
 
 		updated, cmd := model.save()
 		require.NotNil(t, cmd)
@@ -72,9 +72,9 @@ func TestContextSaveCreateAndLinkErrorBranches(t *testing.T) {
 		})
 
 		model := NewContextModel(client)
-		model.fields[fieldTitle].value = "Alpha"
-		model.fields[fieldNotes].value = "Notes"
-		model.tagBuf = "Core Tag"
+		model.fields[fieldTitle].Value = "Alpha"
+		model.fields[fieldNotes].Value = "Notes"
+		model.tagBuf.Value = "Core Tag"
 		model.linkEntities = []api.Entity{{ID: "ent-1", Name: "Entity One"}}
 
 		updated, cmd := model.save()
@@ -39,8 +39,8 @@ func TestContextSaveLinksEntities(t *testing.T) {
 	})
 
 	model := NewContextModel(client)
-	model.fields[fieldTitle].value = "Test"
-	model.fields[fieldNotes].value = "Notes"
+	model.fields[fieldTitle].Value = "Test"
+	model.fields[fieldNotes].Value = "Notes"
 	model.linkEntities = []api.Entity{{ID: "ent-1", Name: "Alpha"}, {ID: "ent-2", Name: "Beta"}}
 
 	model, cmd := model.save()
@@ -77,7 +77,7 @@ func TestContextLinkSearchCommand(t *testing.T) {
 
 	model := NewContextModel(client)
 	model.linkSearching = true
-	model.linkQuery = ""
+	model.linkQuery.Value = ""
 
 	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
 	require.NotNil(t, cmd)
@@ -105,7 +105,7 @@ func TestNormalizeScope(t *testing.T) {
 func TestCommitTagDedupes(t *testing.T) {
 	model := NewContextModel(nil)
 	model.tags = []string{"alpha"}
-	model.tagBuf = "ALPHA"
+	model.tagBuf.Value = "ALPHA"
 	model.commitTag()
 	assert.Equal(t, []string{"alpha"}, model.tags)
 }
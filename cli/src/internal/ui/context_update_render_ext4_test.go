@@ -114,7 +114,7 @@ func TestContextViewEarlyReturnBranches(t *testing.T) {
 
 	model.metaEditor.Active = false
 	model.linkSearching = true
-	model.linkQuery = "alpha"
+	model.linkQuery.Value = "alpha"
 	model.linkResults = nil
 	out = components.SanitizeText(model.View())
 	assert.Contains(t, out, "No matches")
@@ -130,11 +130,11 @@ func TestContextRenderAddBranchMatrix(t *testing.T) {
 	model := NewContextModel(nil)
 	model.width = 92
 	model.scopeOptions = []string{"public", "private"}
-	model.fields[fieldTitle].value = "Title A"
-	model.fields[fieldURL].value = "https://a"
-	model.fields[fieldNotes].value = "notes"
+	model.fields[fieldTitle].Value = "Title A"
+	model.fields[fieldURL].Value = "https://a"
+	model.fields[fieldNotes].Value = "notes"
 	model.tags = []string{"alpha"}
-	model.tagBuf = "beta"
+	model.tagBuf.Value = "beta"
 	model.scopes = []string{"public"}
 	model.linkEntities = []api.Entity{{ID: "ent-1", Name: "Entity One"}}
 	model.metaEditor.Buffer = "meta | key | value"
@@ -177,7 +177,7 @@ func TestContextRenderAddBranchMatrix(t *testing.T) {
 	assert.Contains(t, out, "█")
 
 	model.focus = fieldTitle
-	model.fields[fieldURL].value = ""
+	model.fields[fieldURL].Value = ""
 	out = components.SanitizeText(model.renderAdd())
 	assert.Contains(t, out, "URL:")
 	assert.Contains(t, out, "-")
@@ -191,11 +191,11 @@ func TestContextRenderEditBranchMatrix(t *testing.T) {
 	model := NewContextModel(nil)
 	model.width = 92
 	model.scopeOptions = []string{"public", "private"}
-	model.contextEditFields[contextEditFieldTitle].value = "Title E"
-	model.contextEditFields[contextEditFieldURL].value = "https://e"
-	model.contextEditFields[contextEditFieldNotes].value = "notes edit"
+	model.contextEditFields[contextEditFieldTitle].Value = "Title E"
+	model.contextEditFields[contextEditFieldURL].Value = "https://e"
+	model.contextEditFields[contextEditFieldNotes].Value = "notes edit"
 	model.editTags = []string{"alpha"}
-	model.editTagBuf = "beta"
+	model.editTagBuf.Value = "beta"
 	model.editScopes = []string{"public"}
 	model.editMeta.Buffer = "group | field | value"
 
@@ -235,7 +235,7 @@ func TestContextRenderEditBranchMatrix(t *testing.T) {
 	assert.Contains(t, out, "Title E")
 	assert.Contains(t, out, "█")
 
-	model.contextEditFields[contextEditFieldURL].value = ""
+	model.contextEditFields[contextEditFieldURL].Value = ""
 	model.editFocus = contextEditFieldTitle
 	out = components.SanitizeText(model.renderEdit())
 	assert.Contains(t, out, "URL:")
@@ -275,7 +275,7 @@ func TestContextUpdateKeyBranchMatrixAdditional(t *testing.T) {
 	updated.scopeSelecting = true
 	updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyRight})
 	require.Nil(t, cmd)
-	assert.Equal(t, 1, updated.scopeIdx)
+	assert.Equal(t, 1, updated.scopePicker.idx)
 
 	updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeySpace})
 	require.Nil(t, cmd)
@@ -283,7 +283,7 @@ func TestContextUpdateKeyBranchMatrixAdditional(t *testing.T) {
 
 	updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyLeft})
 	require.Nil(t, cmd)
-	assert.Equal(t, 0, updated.scopeIdx)
+	assert.Equal(t, 0, updated.scopePicker.idx)
 
 	updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyEnter})
 	require.Nil(t, cmd)
@@ -300,11 +300,11 @@ func TestContextUpdateKeyBranchMatrixAdditional(t *testing.T) {
 	updated.focus = fieldTags
 	updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'A'}})
 	require.Nil(t, cmd)
-	assert.Equal(t, "A", updated.tagBuf)
+	assert.Equal(t, "A", updated.tagBuf.Value)
 	updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{','}})
 	require.Nil(t, cmd)
 	assert.Equal(t, []string{"a"}, updated.tags)
-	assert.Equal(t, "", updated.tagBuf)
+	assert.Equal(t, "", updated.tagBuf.Value)
 
 	// Backspace branches for tags/scopes/entities/default fields.
 	updated.tags = []string{"a", "b"}
@@ -325,10 +325,10 @@ func TestContextUpdateKeyBranchMatrixAdditional(t *testing.T) {
 	assert.Empty(t, updated.linkEntities)
 
 	updated.focus = fieldTitle
-	updated.fields[fieldTitle].value = "abc"
+	updated.fields[fieldTitle].Value = "abc"
 	updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "ab", updated.fields[fieldTitle].value)
+	assert.Equal(t, "ab", updated.fields[fieldTitle].Value)
 
 	// Entities enter opens search mode.
 	updated.focus = fieldEntities
@@ -347,11 +347,11 @@ func TestContextUpdateKeyBranchMatrixAdditional(t *testing.T) {
 	updated.metaEditor.Active = false
 	updated.focus = fieldEntities
 	updated.linkSearching = false
-	updated.linkQuery = ""
+	updated.linkQuery.Value = ""
 	updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
 	require.NotNil(t, cmd)
 	assert.True(t, updated.linkSearching)
-	assert.Equal(t, "x", updated.linkQuery)
+	assert.Equal(t, "x", updated.linkQuery.Value)
 
 	// Global navigation + reset + save branches.
 	updated.linkSearching = false
@@ -370,15 +370,15 @@ func TestContextUpdateKeyBranchMatrixAdditional(t *testing.T) {
 
 	updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyEsc})
 	require.Nil(t, cmd)
-	assert.Equal(t, "ab", updated.fields[fieldTitle].value)
+	assert.Equal(t, "ab", updated.fields[fieldTitle].Value)
 	assert.False(t, updated.modeFocus)
 
 	updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyEsc})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.fields[fieldTitle].value)
+	assert.Equal(t, "", updated.fields[fieldTitle].Value)
 	assert.False(t, updated.modeFocus)
 
-	updated.fields[fieldTitle].value = ""
+	updated.fields[fieldTitle].Value = ""
 	updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
 	require.Nil(t, cmd)
 	assert.Equal(t, "Title is required", updated.errText)
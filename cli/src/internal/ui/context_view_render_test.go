@@ -128,7 +128,7 @@ func TestContextAddLinkSearchSaveAndReset(t *testing.T) {
 	// Esc should reset add state.
 	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
 	assert.False(t, model.saved)
-	assert.Equal(t, "", model.fields[fieldTitle].value)
+	assert.Equal(t, "", model.fields[fieldTitle].Value)
 	assert.Len(t, model.tags, 0)
 	assert.Len(t, model.scopes, 0)
 }
@@ -0,0 +1,337 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+const (
+	dashboardWeeks      = 8
+	dashboardPageSize   = 100
+	dashboardSampleSize = 500
+	dashboardApprovals  = 1000
+	dashboardAgentRows  = 8
+)
+
+// dashboardJobStatuses orders the job status bars.
+var dashboardJobStatuses = []string{"pending", "active", "completed", "failed"}
+
+type dashboardLoadedMsg struct {
+	stats  dashboardStats
+	queued time.Time
+}
+
+// dashboardStats holds the aggregates the dashboard charts. Counts come from
+// the most recent rows of each table, up to dashboardSampleSize.
+type dashboardStats struct {
+	weekStart       time.Time
+	entitiesPerWeek []int
+	entitiesSampled int
+	contextPerWeek  []int
+	contextTotals   []int
+	contextSampled  int
+	approvals       []components.BarRow
+	pendingTotal    int
+	jobStatus       []components.BarRow
+	jobsCompleted   int
+	jobsFailed      int
+	jobsSampled     int
+}
+
+type DashboardModel struct {
+	client      *api.Client
+	stats       *dashboardStats
+	loading     bool
+	loadLatency time.Duration
+	errText     string
+	width       int
+	height      int
+}
+
+// NewDashboardModel builds the dashboard UI model.
+func NewDashboardModel(client *api.Client) DashboardModel {
+	return DashboardModel{client: client}
+}
+
+// Init handles init.
+func (m DashboardModel) Init() tea.Cmd {
+	m.loading = true
+	return m.loadDashboard()
+}
+
+// Update updates update.
+func (m DashboardModel) Update(msg tea.Msg) (DashboardModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case dashboardLoadedMsg:
+		m.loading = false
+		m.loadLatency = loadElapsed(msg.queued)
+		m.errText = ""
+		stats := msg.stats
+		m.stats = &stats
+	case errMsg:
+		m.loading = false
+		m.errText = msg.err.Error()
+	}
+	return m, nil
+}
+
+// loadDashboard fetches recent rows from each table and aggregates them.
+func (m DashboardModel) loadDashboard() tea.Cmd {
+	queued := time.Now()
+	return func() tea.Msg {
+		now := time.Now()
+		var entityTimes, contextTimes []time.Time
+		err := fetchDashboardPages(func(params api.QueryParams) (int, error) {
+			items, err := m.client.QueryEntities(params)
+			for _, item := range items {
+				entityTimes = append(entityTimes, item.CreatedAt)
+			}
+			return len(items), err
+		})
+		if err != nil {
+			return errMsg{err}
+		}
+		err = fetchDashboardPages(func(params api.QueryParams) (int, error) {
+			items, err := m.client.QueryContext(params)
+			for _, item := range items {
+				contextTimes = append(contextTimes, item.CreatedAt)
+			}
+			return len(items), err
+		})
+		if err != nil {
+			return errMsg{err}
+		}
+		approvals, err := m.client.GetPendingApprovalsWithParams(dashboardApprovals, 0)
+		if err != nil {
+			return errMsg{err}
+		}
+		jobs, err := m.client.QueryJobs(api.QueryParams{"limit": strconv.Itoa(dashboardPageSize)})
+		if err != nil {
+			return errMsg{err}
+		}
+		return dashboardLoadedMsg{
+			stats:  buildDashboardStats(now, entityTimes, contextTimes, approvals, jobs),
+			queued: queued,
+		}
+	}
+}
+
+// fetchDashboardPages pages through a list endpoint until it runs dry or the
+// sample is full.
+func fetchDashboardPages(fetch func(api.QueryParams) (int, error)) error {
+	for offset := 0; offset < dashboardSampleSize; offset += dashboardPageSize {
+		n, err := fetch(api.QueryParams{
+			"limit":  strconv.Itoa(dashboardPageSize),
+			"offset": strconv.Itoa(offset),
+		})
+		if err != nil {
+			return err
+		}
+		if n < dashboardPageSize {
+			return nil
+		}
+	}
+	return nil
+}
+
+// buildDashboardStats aggregates raw rows into the dashboard's series.
+func buildDashboardStats(now time.Time, entityTimes, contextTimes []time.Time, approvals []api.Approval, jobs []api.Job) dashboardStats {
+	start := dashboardWeekStart(now).AddDate(0, 0, -7*(dashboardWeeks-1))
+	stats := dashboardStats{
+		weekStart:       start,
+		entitiesPerWeek: weeklyCounts(entityTimes, start, dashboardWeeks),
+		entitiesSampled: len(entityTimes),
+		contextPerWeek:  weeklyCounts(contextTimes, start, dashboardWeeks),
+		contextTotals:   weeklyTotals(contextTimes, start, dashboardWeeks),
+		contextSampled:  len(contextTimes),
+		approvals:       approvalsByAgent(approvals),
+		pendingTotal:    len(approvals),
+		jobsSampled:     len(jobs),
+	}
+
+	counts := map[string]int{}
+	for _, job := range jobs {
+		counts[strings.ToLower(strings.TrimSpace(job.Status))]++
+	}
+	for _, status := range dashboardJobStatuses {
+		stats.jobStatus = append(stats.jobStatus, components.BarRow{Label: status, Value: counts[status]})
+	}
+	stats.jobsCompleted = counts["completed"]
+	stats.jobsFailed = counts["failed"]
+	return stats
+}
+
+// dashboardWeekStart returns local midnight on the Monday of t's week.
+func dashboardWeekStart(t time.Time) time.Time {
+	t = t.Local()
+	offset := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, time.Local)
+}
+
+// weeklyCounts buckets times into weeks starting at start. Times outside the
+// window are dropped.
+func weeklyCounts(times []time.Time, start time.Time, weeks int) []int {
+	counts := make([]int, weeks)
+	for _, at := range times {
+		if at.IsZero() || at.Before(start) {
+			continue
+		}
+		if idx := dashboardWeekIndex(at, start); idx < weeks {
+			counts[idx]++
+		}
+	}
+	return counts
+}
+
+// weeklyTotals counts the rows created by the end of each week, including
+// rows from before the window.
+func weeklyTotals(times []time.Time, start time.Time, weeks int) []int {
+	totals := make([]int, weeks)
+	earlier := 0
+	for _, at := range times {
+		if !at.IsZero() && at.Before(start) {
+			earlier++
+		}
+	}
+	running := earlier
+	for i, n := range weeklyCounts(times, start, weeks) {
+		running += n
+		totals[i] = running
+	}
+	return totals
+}
+
+// dashboardWeekIndex returns which week after start t falls in. Calendar days
+// keep the buckets stable across daylight saving changes.
+func dashboardWeekIndex(t, start time.Time) int {
+	t = t.Local()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+	days := int(day.Sub(start).Hours()+12) / 24
+	return days / 7
+}
+
+// approvalsByAgent counts pending approvals per requester, busiest first.
+func approvalsByAgent(approvals []api.Approval) []components.BarRow {
+	counts := map[string]int{}
+	for _, approval := range approvals {
+		name := firstNonEmpty(
+			strings.TrimSpace(approval.AgentName),
+			strings.TrimSpace(approval.RequestedByName),
+			shortID(strings.TrimSpace(approval.RequestedBy)),
+		)
+		if name == "" {
+			name = "unknown"
+		}
+		counts[name]++
+	}
+	rows := make([]components.BarRow, 0, len(counts))
+	for name, n := range counts {
+		rows = append(rows, components.BarRow{Label: name, Value: n})
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Value != rows[j].Value {
+			return rows[i].Value > rows[j].Value
+		}
+		return rows[i].Label < rows[j].Label
+	})
+	if len(rows) > dashboardAgentRows {
+		rest := 0
+		for _, row := range rows[dashboardAgentRows-1:] {
+			rest += row.Value
+		}
+		rows = append(rows[:dashboardAgentRows-1], components.BarRow{Label: "other", Value: rest})
+	}
+	return rows
+}
+
+// View renders the dashboard.
+func (m DashboardModel) View() string {
+	if m.errText != "" {
+		return components.Indent(components.ErrorBox("Error", m.errText, m.width), 1)
+	}
+	if m.stats == nil {
+		return "  " + MutedStyle.Render("Loading dashboard...")
+	}
+	s := *m.stats
+	chartWidth := components.BoxContentWidth(m.width)
+
+	var sections []string
+	sections = append(sections,
+		MetaKeyStyle.Render("Entities created per week")+"\n"+
+			renderWeeklySeries(s.entitiesPerWeek)+"\n"+
+			MutedStyle.Render(fmt.Sprintf("%d this week · %d over %d weeks%s",
+				s.entitiesPerWeek[len(s.entitiesPerWeek)-1], sumInts(s.entitiesPerWeek), dashboardWeeks,
+				dashboardSampleNote(s.entitiesSampled, dashboardSampleSize))),
+	)
+	sections = append(sections,
+		MetaKeyStyle.Render("Knowledge growth")+"\n"+
+			renderWeeklySeries(s.contextTotals)+"\n"+
+			MutedStyle.Render(fmt.Sprintf("%d items · +%d this week%s",
+				s.contextTotals[len(s.contextTotals)-1], s.contextPerWeek[len(s.contextPerWeek)-1],
+				dashboardSampleNote(s.contextSampled, dashboardSampleSize))),
+	)
+
+	approvals := MutedStyle.Render("No pending approvals.")
+	if len(s.approvals) > 0 {
+		approvals = components.BarChart(s.approvals, chartWidth)
+	}
+	sections = append(sections,
+		MetaKeyStyle.Render(fmt.Sprintf("Pending approvals by agent (%d)", s.pendingTotal))+"\n"+approvals,
+	)
+
+	sections = append(sections,
+		MetaKeyStyle.Render("Jobs")+"\n"+
+			components.BarChart(s.jobStatus, chartWidth)+"\n"+
+			MutedStyle.Render(fmt.Sprintf("success rate: %s%s",
+				formatSuccessRate(s.jobsCompleted, s.jobsFailed), dashboardSampleNote(s.jobsSampled, dashboardPageSize))),
+	)
+
+	since := fmt.Sprintf("since %s", s.weekStart.Format("Jan 02"))
+	header := MutedStyle.Render(since) + renderLoadLatency(m.loadLatency)
+	content := header + "\n\n" + strings.Join(sections, "\n\n")
+	return components.TitledBox("Dashboard", content+"\n", m.width)
+}
+
+// renderWeeklySeries draws a weekly series as a sparkline followed by its
+// values.
+func renderWeeklySeries(values []int) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = strconv.Itoa(v)
+	}
+	return components.Sparkline(values) + "  " + MutedStyle.Render(strings.Join(parts, " "))
+}
+
+// formatSuccessRate renders completed jobs as a share of finished jobs.
+func formatSuccessRate(completed, failed int) string {
+	finished := completed + failed
+	if finished == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d%% (%d/%d finished)", completed*100/finished, completed, finished)
+}
+
+// dashboardSampleNote flags series drawn from a sample that hit its cap.
+func dashboardSampleNote(sampled, limit int) string {
+	if sampled >= limit {
+		return fmt.Sprintf(" · latest %d rows", sampled)
+	}
+	return ""
+}
+
+// sumInts adds up values.
+func sumInts(values []int) int {
+	total := 0
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
@@ -0,0 +1,99 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeeklyCountsAndTotalsBucketByWeek(t *testing.T) {
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.Local)
+	times := []time.Time{
+		start.Add(-time.Hour),
+		start.Add(time.Hour),
+		start.AddDate(0, 0, 6).Add(23 * time.Hour),
+		start.AddDate(0, 0, 7),
+		start.AddDate(0, 0, 15),
+		start.AddDate(0, 0, 30),
+		{},
+	}
+	assert.Equal(t, []int{2, 1, 1}, weeklyCounts(times, start, 3))
+	assert.Equal(t, []int{3, 4, 5}, weeklyTotals(times, start, 3))
+	assert.Equal(t, start, dashboardWeekStart(start.AddDate(0, 0, 3).Add(5*time.Hour)))
+}
+
+func TestApprovalsByAgentSortsAndFoldsOverflow(t *testing.T) {
+	var approvals []api.Approval
+	for i, name := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i"} {
+		for j := 0; j <= i; j++ {
+			approvals = append(approvals, api.Approval{AgentName: name})
+		}
+	}
+	approvals = append(approvals, api.Approval{RequestedByName: "alxx"}, api.Approval{})
+
+	rows := approvalsByAgent(approvals)
+	require.Len(t, rows, dashboardAgentRows)
+	assert.Equal(t, components.BarRow{Label: "i", Value: 9}, rows[0])
+	assert.Equal(t, components.BarRow{Label: "other", Value: 2 + 1 + 1 + 1}, rows[len(rows)-1])
+}
+
+func TestDashboardLoadsAndRendersCharts(t *testing.T) {
+	now := time.Now()
+	var offsets []string
+	_, client := relTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/entities":
+			offsets = append(offsets, r.URL.Query().Get("offset"))
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+				{"id": "ent-1", "name": "Alpha", "created_at": now},
+				{"id": "ent-2", "name": "Beta", "created_at": now.AddDate(0, 0, -14)},
+			}})
+		case "/api/context":
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+				{"id": "ctx-1", "title": "Note", "created_at": now},
+				{"id": "ctx-2", "title": "Old", "created_at": now.AddDate(-1, 0, 0)},
+			}})
+		case "/api/approvals/pending":
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+				{"id": "ap-1", "agent_name": "scout", "status": "pending"},
+				{"id": "ap-2", "agent_name": "scout", "status": "pending"},
+				{"id": "ap-3", "agent_name": "ranger", "status": "pending"},
+			}})
+		case "/api/jobs":
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+				{"id": "job-1", "title": "A", "status": "completed"},
+				{"id": "job-2", "title": "B", "status": "completed"},
+				{"id": "job-3", "title": "C", "status": "completed"},
+				{"id": "job-4", "title": "D", "status": "failed"},
+				{"id": "job-5", "title": "E", "status": "active"},
+			}})
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	model := NewDashboardModel(client)
+	model.width = 120
+	assert.Contains(t, components.SanitizeText(model.View()), "Loading dashboard...")
+
+	cmd := model.Init()
+	require.NotNil(t, cmd)
+	model, _ = model.Update(cmd())
+	require.NotNil(t, model.stats)
+	assert.Equal(t, []string{"0"}, offsets)
+	assert.Equal(t, 1, model.stats.entitiesPerWeek[dashboardWeeks-1])
+	assert.Equal(t, 2, model.stats.contextTotals[dashboardWeeks-1])
+
+	view := components.SanitizeText(model.View())
+	assert.Contains(t, view, "Entities created per week")
+	assert.Contains(t, view, "Knowledge growth")
+	assert.Contains(t, view, "Pending approvals by agent (3)")
+	assert.Contains(t, view, "scout")
+	assert.Contains(t, view, "success rate: 75% (3/4 finished)")
+}
@@ -0,0 +1,130 @@
+package ui
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+// DeepLinkScheme is the URI scheme `nebula open` accepts, as in
+// nebula://entity/<id>.
+const DeepLinkScheme = "nebula"
+
+// deepLinkKinds maps accepted link kinds, singular or plural, to their kind.
+var deepLinkKinds = map[string]string{
+	"entity":    "entity",
+	"entities":  "entity",
+	"approval":  "approval",
+	"approvals": "approval",
+	"job":       "job",
+	"jobs":      "job",
+}
+
+// DeepLink points the TUI at one record to open on launch.
+type DeepLink struct {
+	Kind string
+	ID   string
+}
+
+// String renders the link in the <kind>/<id> form ParseDeepLink accepts.
+func (l DeepLink) String() string {
+	return l.Kind + "/" + l.ID
+}
+
+// ParseDeepLink parses "entity/<id>", "approval/<id>", "job/<id>", or the
+// same paths behind nebula://.
+func ParseDeepLink(raw string) (DeepLink, error) {
+	path := strings.TrimSpace(raw)
+	if scheme, rest, ok := strings.Cut(path, "://"); ok {
+		if !strings.EqualFold(scheme, DeepLinkScheme) {
+			return DeepLink{}, fmt.Errorf("unsupported link scheme %q (use %s://)", scheme, DeepLinkScheme)
+		}
+		path = rest
+	}
+	path, _, _ = strings.Cut(path, "?")
+	path = strings.Trim(path, "/")
+	path = strings.TrimPrefix(path, "open/")
+
+	kind, id, ok := strings.Cut(path, "/")
+	id = strings.Trim(id, "/")
+	if !ok || kind == "" || id == "" || strings.Contains(id, "/") {
+		return DeepLink{}, fmt.Errorf("invalid link %q: use <kind>/<id>", raw)
+	}
+	normalized, ok := deepLinkKinds[strings.ToLower(kind)]
+	if !ok {
+		return DeepLink{}, fmt.Errorf("unknown link kind %q (use entity, approval, job)", kind)
+	}
+	if unescaped, err := url.PathUnescape(id); err == nil {
+		id = unescaped
+	}
+	return DeepLink{Kind: normalized, ID: id}, nil
+}
+
+// deepLinkLoadedMsg carries the record a launch link points at.
+type deepLinkLoadedMsg struct {
+	entity   *api.Entity
+	approval *api.Approval
+	job      *api.Job
+}
+
+// WithDeepLink returns a copy of the app that opens link once it starts.
+func (a App) WithDeepLink(link DeepLink) App {
+	a.deepLink = &link
+	return a
+}
+
+// loadDeepLink fetches the record behind link.
+func loadDeepLink(client *api.Client, link DeepLink) tea.Cmd {
+	if client == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		switch link.Kind {
+		case "entity":
+			entity, err := client.GetEntity(link.ID)
+			if err != nil {
+				return errMsg{fmt.Errorf("open %s: %w", link, err)}
+			}
+			return deepLinkLoadedMsg{entity: entity}
+		case "approval":
+			approval, err := client.GetApproval(link.ID)
+			if err != nil {
+				return errMsg{fmt.Errorf("open %s: %w", link, err)}
+			}
+			return deepLinkLoadedMsg{approval: approval}
+		case "job":
+			job, err := client.GetJob(link.ID)
+			if err != nil {
+				return errMsg{fmt.Errorf("open %s: %w", link, err)}
+			}
+			return deepLinkLoadedMsg{job: job}
+		}
+		return errMsg{fmt.Errorf("unknown link kind %q", link.Kind)}
+	}
+}
+
+// applyDeepLink opens the detail view for a loaded launch link.
+func (a *App) applyDeepLink(msg deepLinkLoadedMsg) (tea.Model, tea.Cmd) {
+	a.deepLink = nil
+	switch {
+	case msg.entity != nil:
+		return a.applySearchSelection(searchSelectionMsg{kind: "entity", entity: msg.entity})
+	case msg.job != nil:
+		return a.applySearchSelection(searchSelectionMsg{kind: "job", job: msg.job})
+	case msg.approval != nil:
+		item := *msg.approval
+		a.tabNav = false
+		a.tab = tabInbox
+		a.inbox.openDetail(item)
+		return *a, tea.Batch(
+			a.inbox.loadApprovalDiff(item.ID),
+			a.inbox.loadApprovalLiveRecord(item),
+			a.inbox.loadApprovalComments(item.ID),
+		)
+	}
+	return *a, nil
+}
@@ -0,0 +1,73 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+func TestParseDeepLink(t *testing.T) {
+	cases := map[string]DeepLink{
+		"entity/ent-1":                 {Kind: "entity", ID: "ent-1"},
+		"nebula://entities/ent-1/":     {Kind: "entity", ID: "ent-1"},
+		"NEBULA://open/approval/ap-1":  {Kind: "approval", ID: "ap-1"},
+		"nebula://job/job-1?ref=slack": {Kind: "job", ID: "job-1"},
+		" jobs/a%20b ":                 {Kind: "job", ID: "a b"},
+	}
+	for raw, want := range cases {
+		got, err := ParseDeepLink(raw)
+		require.NoError(t, err, raw)
+		assert.Equal(t, want, got, raw)
+	}
+
+	for raw, msg := range map[string]string{
+		"entity":               "use <kind>/<id>",
+		"entity/a/b":           "use <kind>/<id>",
+		"widget/1":             "unknown link kind",
+		"https://entity/ent-1": "unsupported link scheme",
+	} {
+		_, err := ParseDeepLink(raw)
+		assert.ErrorContains(t, err, msg, raw)
+	}
+}
+
+func TestAppDeepLinkOpensDetail(t *testing.T) {
+	_, client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var data any
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/api/entities/ent-1"):
+			data = map[string]any{"id": "ent-1", "name": "Alpha"}
+		case strings.HasSuffix(r.URL.Path, "/api/approvals/ap-1"):
+			data = map[string]any{"id": "ap-1", "request_type": "create_entity", "status": "pending"}
+		default:
+			data = []any{}
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": data}))
+	})
+
+	app := NewApp(client, &config.Config{}).WithDeepLink(DeepLink{Kind: "entity", ID: "ent-1"})
+	msg := loadDeepLink(client, *app.deepLink)()
+	loaded, ok := msg.(deepLinkLoadedMsg)
+	require.True(t, ok)
+	model, _ := app.Update(loaded)
+	app = model.(App)
+	assert.Nil(t, app.deepLink)
+	assert.Equal(t, tabEntities, app.tab)
+	assert.Equal(t, entitiesViewDetail, app.entities.view)
+	require.NotNil(t, app.entities.detail)
+	assert.Equal(t, "Alpha", app.entities.detail.Name)
+
+	msg = loadDeepLink(client, DeepLink{Kind: "approval", ID: "ap-1"})()
+	model, cmd := app.Update(msg)
+	app = model.(App)
+	assert.NotNil(t, cmd)
+	assert.Equal(t, tabInbox, app.tab)
+	require.NotNil(t, app.inbox.detail)
+	assert.Equal(t, "ap-1", app.inbox.detail.ID)
+}
@@ -0,0 +1,184 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// conflictField is one field of a three-way merge between the record the
+// edit started from (base), the form (mine), and the live record (theirs).
+type conflictField struct {
+	key       string
+	label     string
+	base      string
+	mine      string
+	theirs    string
+	useTheirs bool
+}
+
+// editConflict is the merge dialog shown when a save hits a stale record.
+type editConflict struct {
+	fields []conflictField
+	idx    int
+}
+
+// conflictAction is what the merge dialog asks its owner to do after a key.
+type conflictAction int
+
+const (
+	conflictNone conflictAction = iota
+	conflictSave
+	conflictCancel
+)
+
+// isEditConflict reports whether an update failed on a stale record.
+func isEditConflict(err error) bool {
+	return errors.Is(err, api.ErrConflict)
+}
+
+// newEditConflict keeps fields that differ on either side. A field only the
+// other side changed defaults to theirs; everything else defaults to mine.
+func newEditConflict(fields []conflictField) *editConflict {
+	kept := make([]conflictField, 0, len(fields))
+	for _, f := range fields {
+		if f.mine == f.base && f.theirs == f.base {
+			continue
+		}
+		f.useTheirs = f.mine == f.base
+		kept = append(kept, f)
+	}
+	return &editConflict{fields: kept}
+}
+
+// useTheirs reports whether the merge keeps the live value for key.
+func (c *editConflict) useTheirs(key string) bool {
+	if c == nil {
+		return false
+	}
+	for _, f := range c.fields {
+		if f.key == key {
+			return f.useTheirs
+		}
+	}
+	return false
+}
+
+// handleKey moves the cursor and picks sides.
+func (c *editConflict) handleKey(msg tea.KeyMsg) conflictAction {
+	switch {
+	case isUp(msg):
+		if c.idx > 0 {
+			c.idx--
+		}
+	case isDown(msg):
+		if c.idx < len(c.fields)-1 {
+			c.idx++
+		}
+	case isKey(msg, "m"), isKey(msg, "left"):
+		if c.idx < len(c.fields) {
+			c.fields[c.idx].useTheirs = false
+		}
+	case isKey(msg, "t"), isKey(msg, "right"):
+		if c.idx < len(c.fields) {
+			c.fields[c.idx].useTheirs = true
+		}
+	case isSpace(msg):
+		if c.idx < len(c.fields) {
+			c.fields[c.idx].useTheirs = !c.fields[c.idx].useTheirs
+		}
+	case isKey(msg, "ctrl+s"), isEnter(msg):
+		return conflictSave
+	case isBack(msg):
+		return conflictCancel
+	}
+	return conflictNone
+}
+
+// render draws the base / mine / theirs table with the chosen side marked.
+func (c *editConflict) render(kind string, saving bool, errText string, width int) string {
+	contentWidth := components.BoxContentWidth(width)
+	sepWidth := 1
+	if br := lipgloss.RoundedBorder().Left; br != "" {
+		sepWidth = lipgloss.Width(br)
+	}
+	// 5 columns -> 4 separators.
+	fieldWidth := 12
+	keepWidth := 6
+	valueWidth := (contentWidth - (4 * sepWidth) - fieldWidth - keepWidth) / 3
+	if valueWidth < 10 {
+		valueWidth = 10
+	}
+	cols := []components.TableColumn{
+		{Header: "Field", Width: fieldWidth, Align: lipgloss.Left},
+		{Header: "Base", Width: valueWidth, Align: lipgloss.Left},
+		{Header: "Mine", Width: valueWidth, Align: lipgloss.Left},
+		{Header: "Theirs", Width: valueWidth, Align: lipgloss.Left},
+		{Header: "Keep", Width: keepWidth, Align: lipgloss.Left},
+	}
+	grid := make([][]string, 0, len(c.fields))
+	for _, f := range c.fields {
+		keep := "mine"
+		if f.useTheirs {
+			keep = "theirs"
+		}
+		grid = append(grid, []string{
+			components.ClampTextWidthEllipsis(f.label, fieldWidth),
+			components.ClampTextWidthEllipsis(conflictValue(f.base), valueWidth),
+			components.ClampTextWidthEllipsis(conflictValue(f.mine), valueWidth),
+			components.ClampTextWidthEllipsis(conflictValue(f.theirs), valueWidth),
+			keep,
+		})
+	}
+
+	var b strings.Builder
+	b.WriteString(WarningStyle.Render(fmt.Sprintf("This %s changed while you were editing.", kind)))
+	b.WriteString("\n")
+	b.WriteString(MutedStyle.Render("Pick mine or theirs per field, then save the merge."))
+	b.WriteString("\n\n")
+	if len(grid) == 0 {
+		b.WriteString(MutedStyle.Render("No field differs; saving keeps your edit."))
+	} else {
+		b.WriteString(components.TableGridWithActiveRow(cols, grid, contentWidth, c.idx))
+	}
+	if errText != "" {
+		b.WriteString("\n\n")
+		b.WriteString(components.ErrorBox("Error", errText, width))
+	}
+	if saving {
+		b.WriteString("\n\n" + MutedStyle.Render("Saving..."))
+	}
+	return components.TitledBox("Edit Conflict", b.String(), width)
+}
+
+// conflictValue renders one merge cell.
+func conflictValue(v string) string {
+	if strings.TrimSpace(v) == "" {
+		return "-"
+	}
+	return components.SanitizeOneLine(v)
+}
+
+// conflictMetadata renders metadata for comparison, treating empty as unset.
+func conflictMetadata(meta map[string]any) string {
+	if len(meta) == 0 {
+		return ""
+	}
+	return formatAnyInline(meta)
+}
+
+// editConflictHints lists the merge dialog keys.
+func editConflictHints() []string {
+	return []string{
+		components.Hint("↑/↓", "Fields"),
+		components.Hint("m/t", "Mine/Theirs"),
+		components.Hint("ctrl+s", "Save Merge"),
+		components.Hint("esc", "Back to Edit"),
+	}
+}
@@ -0,0 +1,96 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntitiesEditConflictMergesChosenFields(t *testing.T) {
+	base := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	live := base.Add(time.Minute)
+	var saved []api.UpdateEntityInput
+	_, client := testEntitiesClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPatch:
+			var body api.UpdateEntityInput
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			saved = append(saved, body)
+			if body.ExpectedUpdatedAt.Equal(base) {
+				w.WriteHeader(http.StatusConflict)
+				_, _ = w.Write([]byte(`{"error":{"code":"CONFLICT","message":"entity was modified"}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":{"id":"ent-1","name":"Alpha","status":"inactive","tags":["alpha","mine"]}}`))
+		case http.MethodGet:
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+				"id": "ent-1", "name": "Alpha", "status": "inactive", "tags": []string{"beta"}, "updated_at": live,
+			}}))
+		}
+	})
+
+	model := NewEntitiesModel(client)
+	model.width = 120
+	model.detail = &api.Entity{ID: "ent-1", Name: "Alpha", Status: "active", Tags: []string{"alpha"}, UpdatedAt: base}
+	model.startEdit()
+	model.editTags = []string{"alpha", "mine"}
+
+	model, cmd := model.saveEdit()
+	require.NotNil(t, cmd)
+	msg := cmd()
+	require.IsType(t, entityEditConflictMsg{}, msg)
+	model, _ = model.Update(msg)
+	assert.Equal(t, entitiesViewEditConflict, model.view)
+
+	view := components.SanitizeText(model.View())
+	assert.Contains(t, view, "changed while you were editing")
+	// Status only changed on their side, tags on both.
+	assert.True(t, model.editConflict.useTheirs("status"))
+	assert.False(t, model.editConflict.useTheirs("tags"))
+	assert.NotContains(t, view, "Metadata")
+
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	require.NotNil(t, cmd)
+	model, _ = model.Update(cmd())
+	assert.Equal(t, entitiesViewDetail, model.view)
+
+	require.Len(t, saved, 2)
+	merged := saved[1]
+	require.NotNil(t, merged.Status)
+	assert.Equal(t, "inactive", *merged.Status)
+	require.NotNil(t, merged.Tags)
+	assert.Equal(t, []string{"alpha", "mine"}, *merged.Tags)
+	require.NotNil(t, merged.ExpectedUpdatedAt)
+	assert.True(t, merged.ExpectedUpdatedAt.Equal(live))
+}
+
+func TestContextEditConflictPicksTheirsPerField(t *testing.T) {
+	model := NewContextModel(nil)
+	model.detail = &api.Context{ID: "ctx-1", Title: "Notes", SourceType: "note", Status: "active", UpdatedAt: time.Now()}
+	model.startEdit()
+	mineTitle := "My notes"
+	model.showEditConflict(contextEditConflictMsg{
+		input:  api.UpdateContextInput{Title: &mineTitle},
+		theirs: api.Context{ID: "ctx-1", Title: "Their notes", SourceType: "note", Status: "inactive"},
+	})
+	require.Equal(t, contextViewEditConflict, model.view)
+	require.Len(t, model.editConflict.fields, 2)
+	assert.Equal(t, "title", model.editConflict.fields[0].key)
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
+	input := model.mergedContextEdit()
+	require.NotNil(t, input.Title)
+	assert.Equal(t, "Their notes", *input.Title)
+	require.NotNil(t, input.Status)
+	assert.Equal(t, "inactive", *input.Status)
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, contextViewEdit, model.view)
+}
@@ -3,33 +3,51 @@ package ui
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
 	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
 )
 
 // --- Messages ---
 
-type entitiesLoadedMsg struct{ items []api.Entity }
+type entitiesLoadedMsg struct {
+	items  []api.Entity
+	queued time.Time
+}
 type relationshipsLoadedMsg struct{ items []api.Relationship }
 type entityDetailRelationshipsLoadedMsg struct {
 	id    string
 	items []api.Relationship
+	err   error
 }
 type entityUpdatedMsg struct{ entity api.Entity }
 type entityCreatedMsg struct{ entity api.Entity }
 type relationshipUpdatedMsg struct{ rel api.Relationship }
 type relationshipCreatedMsg struct{ rel api.Relationship }
 type relateResultsMsg struct{ items []api.Entity }
-type entityHistoryLoadedMsg struct{ items []api.AuditEntry }
+type entityHistoryLoadedMsg struct {
+	id    string
+	items []api.AuditEntry
+}
 type entityRevertedMsg struct{ entity api.Entity }
 type entityBulkUpdatedMsg struct{}
-type entityScopesLoadedMsg struct{ names map[string]string }
+type entitySelectionLoadedMsg struct {
+	items  []api.Entity
+	capped bool
+}
+type entityScopesLoadedMsg struct {
+	names map[string]string
+	info  map[string]scopeInfo
+}
 type entityMetadataCopiedMsg struct{ count int }
 
 // --- View States ---
@@ -49,6 +67,9 @@ const (
 	entitiesViewRelateType
 	entitiesViewRelEdit
 	entitiesViewHistory
+	entitiesViewTimeTravel
+	entitiesViewDuplicates
+	entitiesViewEditConflict
 )
 
 const (
@@ -79,11 +100,18 @@ var entityStatusOptions = []string{"active", "inactive"}
 var relationshipStatusOptions = []string{"active", "inactive"}
 var copyEntityMetadataClipboard = copyTextToClipboard
 
+// entitySelectAllCap bounds "select all matching" so one keypress cannot
+// select an unbounded result set.
+const entitySelectAllCap = 1000
+
+const entitySelectAllPage = 100
+
 type bulkTarget int
 
 const (
 	bulkTargetTags bulkTarget = iota
 	bulkTargetScopes
+	bulkTargetStatus
 )
 
 type entitiesFilterFacet int
@@ -108,10 +136,11 @@ type EntitiesModel struct {
 	allItems       []api.Entity
 	list           *components.List
 	loading        bool
+	loadLatency    time.Duration
 	view           entitiesView
 	modeFocus      bool
 	filtering      bool
-	searchBuf      string
+	searchBuf      components.TextInput
 	searchSuggest  string
 	filterFacet    entitiesFilterFacet
 	filterCursor   [entitiesFilterFacetCount]int
@@ -126,6 +155,14 @@ type EntitiesModel struct {
 
 	detail         *api.Entity
 	detailRels     []api.Relationship
+	refs           []entityReference
+	refsLoading    bool
+	refsErr        string
+	comments       recordCommentThread
+	pane           splitPane
+	rowCache       *components.RowCache
+	labelCache     *components.RowCache
+	prefetch       entityPrefetch
 	errText        string
 	metaExpanded   bool
 	metaRows       []metadataDisplayRow
@@ -141,27 +178,41 @@ type EntitiesModel struct {
 	addFocus          int
 	addStatusIdx      int
 	addTags           []string
-	addTagBuf         string
+	addTagBuf         components.TextInput
+	tagOptions        []string
 	addScopes         []string
 	addScopeBuf       string
-	addScopeIdx       int
 	addScopeSelecting bool
 	addMeta           MetadataEditor
+	addTemplate       templatePicker
+	formDefaults      config.FormDefaults
+	addFieldErrs      fieldErrors
 	addSaving         bool
 	addSaved          bool
 
+	// duplicate check
+	dupPending *api.CreateEntityInput
+	dupMatches []api.Entity
+	dupList    *components.List
+
 	// edit
 	editFocus          int
 	editTags           []string
-	editTagBuf         string
+	editTagBuf         components.TextInput
 	editStatusIdx      int
 	editScopes         []string
 	editScopeBuf       string
-	editScopeIdx       int
 	editScopeSelecting bool
 	editMeta           MetadataEditor
 	editScopesDirty    bool
 	editSaving         bool
+	editBase           *api.Entity
+
+	// edit conflict
+	editConflict   *editConflict
+	conflictMine   api.UpdateEntityInput
+	conflictScopes []string
+	conflictTheirs *api.Entity
 
 	// confirm
 	confirmKind    string
@@ -177,39 +228,75 @@ type EntitiesModel struct {
 
 	scopeNames   map[string]string
 	scopeOptions []string
+	scopeInfo    map[string]scopeInfo
+	scopePicker  scopePicker
+	typeSchemas  map[string]*metadataSchema
+	viewAsScope  string
+	showArchived bool
 
 	// history
 	history        []api.AuditEntry
 	historyList    *components.List
 	historyLoading bool
 
+	// time travel
+	timeTravelEntry *api.AuditEntry
+	timeTravelState map[string]any
+	timeTravelDiff  bool
+
+	// revert preview
+	revertFields         []string
+	revertSelected       map[string]bool
+	revertCursor         int
+	revertRels           []api.Relationship
+	revertRelsErr        string
+	revertPreviewLoading bool
+
 	// relate flow
-	relateQuery   string
+	relateQuery   components.TextInput
 	relateResults []api.Entity
 	relateList    *components.List
 	relateTarget  *api.Entity
-	relateType    string
+	relateType    components.TextInput
+	relTypeVocab  []string
+	relTypeRules  relationshipTypeRules
 	relateLoading bool
 
 	// relationship edit
 	relEditFocus     int
 	relEditStatusIdx int
-	relEditBuf       string
+	relEditMeta      MetadataEditor
 	relEditID        string
 
 	// bulk operations
 	bulkSelected map[string]bool
 	bulkPrompt   string
-	bulkBuf      string
+	bulkBuf      components.TextInput
 	bulkRunning  bool
 	bulkTarget   bulkTarget
+	bulkPreview  *bulkSetPreview
+	bulkEdit     bulkEditForm
+	bulkRecords  map[string]api.Entity
+	bulkCapped   bool
+
+	pinned map[string]bool
+
+	// collections
+	collection     *api.Collection
+	collectionPick *collectionPicker
+
+	// advanced filters
+	query     entityQuery
+	queryForm *entityQueryForm
 }
 
 // NewEntitiesModel builds the entities UI model.
 func NewEntitiesModel(client *api.Client) EntitiesModel {
 	return EntitiesModel{
-		client: client,
-		list:   components.NewList(15),
+		client:     client,
+		list:       components.NewList(15),
+		rowCache:   components.NewRowCache(),
+		labelCache: components.NewRowCache(),
 		addFields: []formField{
 			{label: "Name"},
 			{label: "Type"},
@@ -221,6 +308,7 @@ func NewEntitiesModel(client *api.Client) EntitiesModel {
 		relList:        components.NewList(8),
 		relateList:     components.NewList(8),
 		historyList:    components.NewList(8),
+		dupList:        components.NewList(8),
 		metaList:       components.NewList(metadataPanelPageSize(false)),
 		view:           entitiesViewList,
 		bulkSelected:   map[string]bool{},
@@ -239,7 +327,7 @@ func (m EntitiesModel) Init() tea.Cmd {
 	m.view = entitiesViewList
 	m.modeFocus = false
 	m.filtering = false
-	m.searchBuf = ""
+	m.searchBuf.Reset()
 	m.searchSuggest = ""
 	m.filterFacet = entitiesFilterFacetType
 	m.filterCursor = [entitiesFilterFacetCount]int{}
@@ -258,20 +346,24 @@ func (m EntitiesModel) Init() tea.Cmd {
 	}
 	syncMetadataList(m.metaList, nil, metadataPanelPageSize(false))
 	m.detailRels = nil
+	m.refs = nil
+	m.refsLoading = false
 	m.addFocus = 0
 	m.addStatusIdx = statusIndex(entityStatusOptions, "active")
 	m.addTags = nil
-	m.addTagBuf = ""
+	m.addTagBuf.Reset()
 	m.addScopes = nil
 	m.addScopeBuf = ""
-	m.addScopeIdx = 0
+	m.scopePicker.Reset()
 	m.addScopeSelecting = false
 	m.addMeta.Reset()
+	m.addTemplate.Reset()
 	m.addSaving = false
 	m.addSaved = false
 	return tea.Batch(
 		m.loadEntities(""),
 		m.loadScopeNames(),
+		loadEntityTypeSchemas(m.client),
 	)
 }
 
@@ -280,6 +372,7 @@ func (m EntitiesModel) Update(msg tea.Msg) (EntitiesModel, tea.Cmd) {
 	switch msg := msg.(type) {
 	case entitiesLoadedMsg:
 		m.loading = false
+		m.loadLatency = loadElapsed(msg.queued)
 		m.allItems = msg.items
 		m.refreshFilterSets()
 		m.applyEntityFilters()
@@ -287,22 +380,39 @@ func (m EntitiesModel) Update(msg tea.Msg) (EntitiesModel, tea.Cmd) {
 		if m.view == entitiesViewSearch {
 			m.view = entitiesViewList
 		}
-		return m, nil
+		m.pane.reset()
+		m.prefetch.reset()
+		return m, m.syncSplitPane()
 
 	case relationshipsLoadedMsg:
-		m.relLoading = false
-		m.rels = msg.items
-		labels := make([]string, len(msg.items))
-		for i, r := range msg.items {
-			labels[i] = m.formatRelationshipLine(r)
-		}
-		m.relList.SetItems(labels)
+		m.setRelationships(msg.items)
 		return m, nil
 	case entityDetailRelationshipsLoadedMsg:
+		if msg.err == nil {
+			m.prefetch.storeRels(msg.id, msg.items)
+		}
 		if m.detail != nil && m.detail.ID == msg.id {
 			m.detailRels = msg.items
 		}
 		return m, nil
+	case entityHistoryPrefetchedMsg:
+		m.prefetch.storeHistory(msg.id, msg.items)
+		return m, nil
+	case entityReferencesLoadedMsg:
+		if m.detail != nil && m.detail.ID == msg.id {
+			m.refsLoading = false
+			m.refs = append([]entityReference{}, msg.items...)
+			m.refsErr = ""
+			if msg.err != nil {
+				m.refsErr = msg.err.Error()
+			}
+		}
+		return m, nil
+	case splitPaneLoadedMsg:
+		if msg.kind == splitPaneEntity {
+			m.pane.store(msg)
+		}
+		return m, nil
 
 	case relateResultsMsg:
 		m.relateLoading = false
@@ -317,9 +427,31 @@ func (m EntitiesModel) Update(msg tea.Msg) (EntitiesModel, tea.Cmd) {
 
 	case entityUpdatedMsg:
 		m.editSaving = false
+		m.prefetch.forgetHistory(msg.entity.ID)
 		m.applyEntityUpdate(msg.entity)
 		m.view = entitiesViewDetail
 		return m, nil
+	case entityEditConflictMsg:
+		m.showEditConflict(msg)
+		return m, nil
+	case offlineEditQueuedMsg:
+		m.editSaving = false
+		if msg.err != nil {
+			m.errText = msg.err.Error()
+			return m, nil
+		}
+		m.view = entitiesViewDetail
+		return m, nil
+	case entityDuplicatesFoundMsg:
+		m.addSaving = false
+		m.showDuplicates(msg)
+		return m, nil
+
+	case fieldErrorsMsg:
+		m.addSaving = false
+		m.showAddFieldErrors(msg)
+		return m, nil
+
 	case entityCreatedMsg:
 		m.addSaving = false
 		m.addSaved = true
@@ -327,34 +459,72 @@ func (m EntitiesModel) Update(msg tea.Msg) (EntitiesModel, tea.Cmd) {
 		return m, m.loadEntities("")
 
 	case relationshipUpdatedMsg:
+		m.forgetDetailRels()
 		m.relLoading = true
 		return m, m.loadRelationships()
 
 	case relationshipCreatedMsg:
+		m.forgetDetailRels()
 		m.relLoading = true
 		return m, m.loadRelationships()
 
+	case recordCommentsLoadedMsg, recordCommentSavedMsg:
+		m.comments.apply(msg)
+		return m, nil
+
 	case entityHistoryLoadedMsg:
-		m.historyLoading = false
-		m.history = msg.items
-		labels := make([]string, len(msg.items))
-		for i, entry := range msg.items {
-			labels[i] = formatHistoryLine(entry)
+		m.prefetch.storeHistory(msg.id, msg.items)
+		m.setHistory(msg.items)
+		return m, nil
+
+	case entityRevertPreviewMsg:
+		if msg.auditID != m.confirmAuditID {
+			return m, nil
 		}
-		m.historyList.SetItems(labels)
+		m.revertPreviewLoading = false
+		if msg.err != nil {
+			m.revertRelsErr = msg.err.Error()
+			return m, nil
+		}
+		m.revertRels = msg.rels
 		return m, nil
 
 	case entityRevertedMsg:
 		m.editSaving = false
+		m.prefetch.forgetHistory(msg.entity.ID)
 		m.applyEntityUpdate(msg.entity)
 		m.view = entitiesViewDetail
 		return m, nil
 
+	case entitySelectionLoadedMsg:
+		for _, item := range msg.items {
+			if item.ID == "" || !m.matchesEntityFilters(item) {
+				continue
+			}
+			m.bulkSelected[item.ID] = true
+			m.rememberBulkRecord(item)
+		}
+		m.bulkCapped = msg.capped
+		return m, nil
+	case collectionPickLoadedMsg:
+		if m.collectionPick != nil {
+			m.collectionPick.items = msg.items
+			m.collectionPick.loading = false
+		}
+		return m, nil
+	case entityCollectionAddedMsg:
+		m.bulkRunning = false
+		m.clearBulkSelection()
+		if m.collection == nil {
+			return m, nil
+		}
+		m.loading = true
+		return m, m.loadEntities(strings.TrimSpace(m.searchBuf.Value))
 	case entityBulkUpdatedMsg:
 		m.bulkRunning = false
 		m.clearBulkSelection()
 		m.loading = true
-		return m, m.loadEntities(strings.TrimSpace(m.searchBuf))
+		return m, m.loadEntities(strings.TrimSpace(m.searchBuf.Value))
 	case entityScopesLoadedMsg:
 		if m.scopeNames == nil {
 			m.scopeNames = map[string]string{}
@@ -363,12 +533,23 @@ func (m EntitiesModel) Update(msg tea.Msg) (EntitiesModel, tea.Cmd) {
 			m.scopeNames[id] = name
 		}
 		m.scopeOptions = scopeNameList(m.scopeNames)
+		if msg.info != nil {
+			m.scopeInfo = msg.info
+		}
 		m.addMeta.SetScopeOptions(m.scopeOptions)
+		m.addMeta.SetScopeInfo(m.scopeInfo)
 		m.editMeta.SetScopeOptions(m.scopeOptions)
+		m.editMeta.SetScopeInfo(m.scopeInfo)
+		m.relEditMeta.SetScopeOptions(m.scopeOptions)
+		m.relEditMeta.SetScopeInfo(m.scopeInfo)
 		m.refreshFilterSets()
 		m.applyEntityFilters()
 		return m, nil
 
+	case entityTypeSchemasLoadedMsg:
+		m.typeSchemas = msg.schemas
+		return m, nil
+
 	case errMsg:
 		m.loading = false
 		m.relLoading = false
@@ -377,6 +558,7 @@ func (m EntitiesModel) Update(msg tea.Msg) (EntitiesModel, tea.Cmd) {
 		m.editSaving = false
 		m.addSaving = false
 		m.bulkRunning = false
+		m.collectionPick = nil
 		m.errText = msg.err.Error()
 		return m, nil
 
@@ -389,6 +571,10 @@ func (m EntitiesModel) Update(msg tea.Msg) (EntitiesModel, tea.Cmd) {
 			m.editMeta.HandleKey(msg)
 			return m, nil
 		}
+		if m.relEditMeta.Active {
+			m.relEditMeta.HandleKey(msg)
+			return m, nil
+		}
 		switch m.view {
 		case entitiesViewAdd:
 			return m.handleAddKeys(msg)
@@ -408,6 +594,12 @@ func (m EntitiesModel) Update(msg tea.Msg) (EntitiesModel, tea.Cmd) {
 			return m.handleRelEditKeys(msg)
 		case entitiesViewHistory:
 			return m.handleHistoryKeys(msg)
+		case entitiesViewTimeTravel:
+			return m.handleTimeTravelKeys(msg)
+		case entitiesViewDuplicates:
+			return m.handleDuplicateKeys(msg)
+		case entitiesViewEditConflict:
+			return m.handleEditConflictKeys(msg)
 		default:
 			return m.handleListKeys(msg)
 		}
@@ -423,12 +615,27 @@ func (m EntitiesModel) View() string {
 	if m.editMeta.Active {
 		return m.editMeta.Render(m.width)
 	}
+	if m.relEditMeta.Active {
+		return m.relEditMeta.Render(m.width)
+	}
+	if m.view == entitiesViewList && m.collectionPick != nil {
+		return components.Indent(m.renderCollectionPicker(), 1)
+	}
+	if m.view == entitiesViewList && m.bulkPreview != nil {
+		return components.Indent(m.renderBulkSetPreview(), 1)
+	}
 	if m.view == entitiesViewList && m.bulkPrompt != "" {
 		return components.Indent(components.InputDialog(m.bulkPrompt, m.bulkBuf), 1)
 	}
+	if m.view == entitiesViewList && m.bulkEdit.open {
+		return components.Indent(m.renderBulkEdit(), 1)
+	}
 	if m.view == entitiesViewList && m.filtering {
 		return components.Indent(m.renderFilterPicker(), 1)
 	}
+	if m.view == entitiesViewList && m.queryForm != nil {
+		return components.Indent(m.renderQueryForm(), 1)
+	}
 	if m.view == entitiesViewAdd {
 		if m.addSaving {
 			return "  " + MutedStyle.Render("Saving...")
@@ -465,6 +672,12 @@ func (m EntitiesModel) View() string {
 		return components.Indent(body, 1)
 	case entitiesViewHistory:
 		return m.renderHistory()
+	case entitiesViewTimeTravel:
+		return m.renderTimeTravel()
+	case entitiesViewDuplicates:
+		return m.renderDuplicates()
+	case entitiesViewEditConflict:
+		return components.Indent(m.editConflict.render("entity", m.editSaving, m.errText, m.width), 1)
 	default:
 		body := m.renderList()
 		modeLine := m.renderModeLine()
@@ -478,32 +691,56 @@ func (m EntitiesModel) View() string {
 // --- List View ---
 
 func (m EntitiesModel) handleListKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd) {
+	if m.collectionPick != nil {
+		return m.handleCollectionPickKeys(msg)
+	}
+	if m.bulkPreview != nil {
+		return m.handleBulkPreviewKeys(msg)
+	}
 	if m.bulkPrompt != "" {
 		return m.handleBulkPromptKeys(msg)
 	}
+	if m.bulkEdit.open {
+		return m.handleBulkEditKeys(msg)
+	}
 	if m.filtering {
 		return m.handleFilterInput(msg)
 	}
+	if m.queryForm != nil {
+		return m.handleQueryFormKeys(msg)
+	}
 	if m.modeFocus {
 		return m.handleModeKeys(msg)
 	}
 	switch {
 	case isDown(msg):
 		m.list.Down()
+		return m, m.syncSplitPane()
 	case isUp(msg):
 		if m.list.Selected() == 0 {
 			m.modeFocus = true
 		} else {
 			m.list.Up()
 		}
+		return m, m.syncSplitPane()
+	case isKey(msg, "ctrl+p"):
+		m.pane.open = !m.pane.open
+		return m, m.syncSplitPane()
+	case isKey(msg, "ctrl+b"):
+		return m, m.selectAllMatching(strings.TrimSpace(m.searchBuf.Value))
+	case isKey(msg, "ctrl+a"):
+		m.showArchived = !m.showArchived
+		m.clearBulkSelection()
+		m.loading = true
+		return m, m.loadEntities(strings.TrimSpace(m.searchBuf.Value))
 	case isSpace(msg):
-		if m.searchBuf == "" {
+		if m.searchBuf.Value == "" {
 			m.toggleBulkSelection(m.list.Selected())
 			return m, nil
 		}
-		m.searchBuf += " "
+		m.searchBuf.Insert(" ")
 		m.loading = true
-		return m, m.loadEntities(strings.TrimSpace(m.searchBuf))
+		return m, m.loadEntities(strings.TrimSpace(m.searchBuf.Value))
 	case isEnter(msg):
 		if idx := m.list.Selected(); idx < len(m.items) {
 			item := m.items[idx]
@@ -511,49 +748,62 @@ func (m EntitiesModel) handleListKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd) {
 			m.detailRels = nil
 			m.syncDetailMetadataRows()
 			m.view = entitiesViewDetail
-			return m, m.loadEntityDetailRelationships(item.ID)
+			m.refs = nil
+			m.refsLoading = true
+			return m, tea.Batch(m.prefetchDetail(item.ID), m.loadEntityReferences(item))
 		}
 	case isKey(msg, "f"):
 		m.filtering = true
 		m.refreshFilterSets()
 		return m, nil
+	case isKey(msg, "F"):
+		m.openQueryForm()
+		return m, nil
 	case isKey(msg, "tab"):
-		if m.searchSuggest != "" && strings.TrimSpace(m.searchBuf) != strings.TrimSpace(m.searchSuggest) {
-			m.searchBuf = m.searchSuggest
+		if m.searchSuggest != "" && strings.TrimSpace(m.searchBuf.Value) != strings.TrimSpace(m.searchSuggest) {
+			m.searchBuf.SetValue(m.searchSuggest)
 			m.loading = true
-			return m, m.loadEntities(strings.TrimSpace(m.searchBuf))
+			return m, m.loadEntities(strings.TrimSpace(m.searchBuf.Value))
 		}
 	case isKey(msg, "backspace", "delete"):
-		if len(m.searchBuf) > 0 {
-			m.searchBuf = m.searchBuf[:len(m.searchBuf)-1]
+		if m.searchBuf.Value != "" {
+			m.searchBuf.Backspace()
 			m.loading = true
-			return m, m.loadEntities(strings.TrimSpace(m.searchBuf))
+			return m, m.loadEntities(strings.TrimSpace(m.searchBuf.Value))
 		}
 	case isKey(msg, "cmd+backspace", "cmd+delete", "ctrl+u"):
-		if m.searchBuf != "" {
-			m.searchBuf = ""
+		if m.searchBuf.Value != "" {
+			m.searchBuf.Reset()
 			m.searchSuggest = ""
 			m.loading = true
 			return m, m.loadEntities("")
 		}
 	case isBack(msg):
-		if m.searchBuf != "" {
-			m.searchBuf = ""
+		if m.searchBuf.Value != "" {
+			m.searchBuf.Reset()
 			m.searchSuggest = ""
 			m.loading = true
 			return m, m.loadEntities("")
 		}
+		if !m.query.empty() {
+			m.query = entityQuery{}
+			m.loading = true
+			return m, m.loadEntities("")
+		}
+		if m.collection != nil {
+			return m, m.clearCollectionFilter()
+		}
 	case isKey(msg, "t"):
 		if m.bulkCount() > 0 {
-			m.bulkPrompt = "Bulk Tags (add:tag1,tag2)"
-			m.bulkBuf = ""
+			m.bulkPrompt = bulkPromptTitle(bulkTargetTags)
+			m.bulkBuf.Reset()
 			m.bulkTarget = bulkTargetTags
 			return m, nil
 		}
 	case isKey(msg, "p"):
 		if m.bulkCount() > 0 {
-			m.bulkPrompt = "Bulk Scopes (add:scope1,scope2)"
-			m.bulkBuf = ""
+			m.bulkPrompt = bulkPromptTitle(bulkTargetScopes)
+			m.bulkBuf.Reset()
 			m.bulkTarget = bulkTargetScopes
 			return m, nil
 		}
@@ -562,12 +812,26 @@ func (m EntitiesModel) handleListKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd) {
 			m.clearBulkSelection()
 			return m, nil
 		}
+	case isKey(msg, "E") && m.bulkCount() > 0:
+		m.openBulkEdit()
+		return m, nil
+	case isKey(msg, "C") && m.bulkCount() > 0 && m.client != nil:
+		return m, m.openCollectionPicker()
+	case isKey(msg, "*"):
+		if idx := m.list.Selected(); idx < len(m.items) {
+			item := m.items[idx]
+			return m, togglePinCmd(config.PinEntity, item.ID, item.Name)
+		}
+	case isKey(msg, "w"):
+		if idx := m.list.Selected(); idx < len(m.items) {
+			item := m.items[idx]
+			return m, toggleWatchCmd(config.PinEntity, item.ID, item.Name)
+		}
 	default:
-		ch := msg.String()
-		if len(ch) == 1 {
-			m.searchBuf += ch
+		if text, ok := typedText(msg); ok {
+			m.searchBuf.Insert(text)
 			m.loading = true
-			return m, m.loadEntities(strings.TrimSpace(m.searchBuf))
+			return m, m.loadEntities(strings.TrimSpace(m.searchBuf.Value))
 		}
 	}
 	return m, nil
@@ -774,32 +1038,30 @@ func (m *EntitiesModel) applyEntityFilters() {
 		}
 		filtered = append(filtered, item)
 	}
+	pinnedFirst(filtered, m.pinned, func(e api.Entity) string { return e.ID })
 	m.items = filtered
 	labels := make([]string, len(filtered))
 	for i, e := range filtered {
-		labels[i] = formatEntityLine(e)
+		labels[i] = m.labelCache.Row(e.ID, entityRowVersion(e), maxEntityLineLen, func() []string {
+			return []string{formatEntityLine(e)}
+		})[0]
 	}
 	m.list.SetItems(labels)
-	m.pruneBulkSelection(filtered)
 	m.updateSearchSuggest()
 }
 
-// pruneBulkSelection handles prune bulk selection.
-func (m *EntitiesModel) pruneBulkSelection(items []api.Entity) {
+// bulkOutOfView counts selected entities hidden by the current search or filters.
+func (m EntitiesModel) bulkOutOfView() int {
 	if len(m.bulkSelected) == 0 {
-		return
+		return 0
 	}
-	visible := map[string]struct{}{}
-	for _, item := range items {
-		if item.ID != "" {
-			visible[item.ID] = struct{}{}
-		}
-	}
-	for id := range m.bulkSelected {
-		if _, ok := visible[id]; !ok {
-			delete(m.bulkSelected, id)
+	visible := 0
+	for _, item := range m.items {
+		if m.bulkSelected[item.ID] {
+			visible++
 		}
 	}
+	return len(m.bulkSelected) - visible
 }
 
 // matchesEntityFilters handles matches entity filters.
@@ -977,6 +1239,26 @@ func (m EntitiesModel) handleAddKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd) {
 		return m.handleModeKeys(msg)
 	}
 
+	if m.addTemplate.open {
+		switch {
+		case isKey(msg, "left"):
+			m.addTemplate.Move(-1)
+		case isKey(msg, "right"):
+			m.addTemplate.Move(1)
+		case isEnter(msg), isSpace(msg):
+			if tmpl, ok := m.addTemplate.Pick(); ok {
+				m.applyAddTemplate(tmpl)
+			}
+		case isBack(msg), isKey(msg, "ctrl+t"):
+			m.addTemplate.open = false
+		}
+		return m, nil
+	}
+	if isKey(msg, "ctrl+t") && m.addTemplate.Toggle() {
+		m.addScopeSelecting = false
+		return m, nil
+	}
+
 	if m.addFocus == addFieldStatus {
 		switch {
 		case isKey(msg, "left"):
@@ -988,28 +1270,14 @@ func (m EntitiesModel) handleAddKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd) {
 		}
 	}
 	if m.addFocus == addFieldScopes && m.addScopeSelecting {
-		switch {
-		case isKey(msg, "left"):
-			if len(m.scopeOptions) > 0 {
-				m.addScopeIdx = (m.addScopeIdx - 1 + len(m.scopeOptions)) % len(m.scopeOptions)
-			}
-			return m, nil
-		case isKey(msg, "right"):
-			if len(m.scopeOptions) > 0 {
-				m.addScopeIdx = (m.addScopeIdx + 1) % len(m.scopeOptions)
-			}
-			return m, nil
-		case isSpace(msg):
-			if len(m.scopeOptions) > 0 {
-				scope := m.scopeOptions[m.addScopeIdx]
-				m.addScopes = toggleScope(m.addScopes, scope)
-			}
-			return m, nil
-		case isEnter(msg), isBack(msg):
-			m.addScopeSelecting = false
+		if scopes, open, ok := m.scopePicker.HandleKey(msg, m.scopeOptions, m.addScopes, m.scopeInfo); ok {
+			m.addScopes, m.addScopeSelecting = scopes, open
 			return m, nil
 		}
 	}
+	if (m.addFocus == addFieldName || m.addFocus == addFieldType) && m.addFields[m.addFocus].HandleKey(msg) {
+		return m, nil
+	}
 
 	switch {
 	case isDown(msg):
@@ -1030,8 +1298,8 @@ func (m EntitiesModel) handleAddKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd) {
 	case isKey(msg, "backspace", "delete"):
 		switch m.addFocus {
 		case addFieldTags:
-			if len(m.addTagBuf) > 0 {
-				m.addTagBuf = m.addTagBuf[:len(m.addTagBuf)-1]
+			if m.addTagBuf.Value != "" {
+				m.addTagBuf.Backspace()
 			} else if len(m.addTags) > 0 {
 				m.addTags = m.addTags[:len(m.addTags)-1]
 			}
@@ -1041,39 +1309,28 @@ func (m EntitiesModel) handleAddKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd) {
 			}
 		case addFieldMetadata:
 			m.addMeta.Buffer = dropLastRune(m.addMeta.Buffer)
-		default:
-			if m.addFocus < len(m.addFields) {
-				f := &m.addFields[m.addFocus]
-				if len(f.value) > 0 {
-					f.value = f.value[:len(f.value)-1]
-				}
-			}
 		}
 	default:
 		switch m.addFocus {
 		case addFieldTags:
 			switch {
+			case pasteList(&m.addTagBuf, msg, m.commitAddTag):
+			case isKey(msg, "tab"):
+				m.addTagBuf.SetValue(completeTag(m.tagOptions, m.addTagBuf.Value, m.addTags))
 			case isSpace(msg) || isKey(msg, ",") || isEnter(msg):
 				m.commitAddTag()
 			default:
-				ch := msg.String()
-				if len(ch) == 1 && ch != "," {
-					m.addTagBuf += ch
-				}
+				m.addTagBuf.HandleKey(msg)
 			}
 		case addFieldScopes:
 			if isSpace(msg) {
+				m.scopePicker.Reset()
 				m.addScopeSelecting = true
 			}
 		case addFieldMetadata:
 			if isEnter(msg) {
 				m.addMeta.Active = true
 			}
-		default:
-			ch := msg.String()
-			if len(ch) == 1 || ch == " " {
-				m.addFields[m.addFocus].value += ch
-			}
 		}
 	}
 	return m, nil
@@ -1082,6 +1339,10 @@ func (m EntitiesModel) handleAddKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd) {
 // renderAdd renders render add.
 func (m EntitiesModel) renderAdd() string {
 	var b strings.Builder
+	if picker := m.addTemplate.Render(); picker != "" {
+		b.WriteString(picker)
+		b.WriteString("\n\n")
+	}
 	for i, f := range m.addFields {
 		label := f.label
 		switch i {
@@ -1110,7 +1371,7 @@ func (m EntitiesModel) renderAdd() string {
 			if m.addFocus == i && m.addScopeSelecting {
 				b.WriteString(SelectedStyle.Render("  " + label + ":"))
 				b.WriteString("\n")
-				b.WriteString(NormalStyle.Render("  " + renderScopeOptions(m.addScopes, m.scopeOptions, m.addScopeIdx)))
+				b.WriteString(m.scopePicker.Render(m.scopeOptions, m.addScopes, m.scopeInfo, m.width))
 			} else if m.addFocus == i {
 				b.WriteString(SelectedStyle.Render("  " + label + ":"))
 				b.WriteString("\n")
@@ -1129,22 +1390,26 @@ func (m EntitiesModel) renderAdd() string {
 			b.WriteString("\n")
 			meta := renderMetadataEditorPreview(m.addMeta.Buffer, m.addMeta.Scopes, m.width, 6)
 			b.WriteString(NormalStyle.Render("  " + meta))
+			if schema := m.addSchema(); schema != nil {
+				errs := validateMetadataBuffer(schema, m.addMeta.Buffer, m.addMeta.Scopes)
+				b.WriteString("\n" + renderMetadataSchemaHints(schema, errs))
+			}
 		default:
 			if m.addFocus == i {
 				b.WriteString(SelectedStyle.Render("  " + label + ":"))
 				b.WriteString("\n")
-				b.WriteString(NormalStyle.Render("  " + f.value))
-				b.WriteString(AccentStyle.Render("█"))
+				b.WriteString(NormalStyle.Render("  " + renderInput(f.TextInput, m.width)))
 			} else {
 				b.WriteString(MutedStyle.Render("  " + label + ":"))
 				b.WriteString("\n")
-				val := f.value
+				val := f.Value
 				if val == "" {
 					val = "-"
 				}
 				b.WriteString(NormalStyle.Render("  " + val))
 			}
 		}
+		b.WriteString(renderFieldError(m.addFieldErrs, i))
 		if i < addFieldCount-1 {
 			b.WriteString("\n\n")
 		}
@@ -1160,12 +1425,13 @@ func (m EntitiesModel) renderAdd() string {
 
 // saveAdd handles save add.
 func (m EntitiesModel) saveAdd() (EntitiesModel, tea.Cmd) {
-	name := strings.TrimSpace(m.addFields[addFieldName].value)
+	m.addFieldErrs = nil
+	name := strings.TrimSpace(m.addFields[addFieldName].Value)
 	if name == "" {
 		m.errText = "Name is required"
 		return m, nil
 	}
-	typ := strings.TrimSpace(m.addFields[addFieldType].value)
+	typ := strings.TrimSpace(m.addFields[addFieldType].Value)
 	if typ == "" {
 		m.errText = "Type is required"
 		return m, nil
@@ -1179,11 +1445,16 @@ func (m EntitiesModel) saveAdd() (EntitiesModel, tea.Cmd) {
 		return m, nil
 	}
 	meta = mergeMetadataScopes(meta, m.addMeta.Scopes)
+	if errs := m.addSchema().Validate(meta); len(errs) > 0 {
+		m.errText = metadataSchemaError(typ, errs)
+		m.addFocus = addFieldMetadata
+		return m, nil
+	}
 
 	status := entityStatusOptions[m.addStatusIdx]
 	scopes := normalizeScopeList(m.addScopes)
 	if len(scopes) == 0 {
-		scopes = []string{"private"}
+		scopes = m.formDefaults.ScopesOrFallback()
 	}
 
 	input := api.CreateEntityInput{
@@ -1196,37 +1467,81 @@ func (m EntitiesModel) saveAdd() (EntitiesModel, tea.Cmd) {
 	}
 
 	m.addSaving = true
-	return m, func() tea.Msg {
-		created, err := m.client.CreateEntity(input)
-		if err != nil {
-			return errMsg{err}
-		}
-		return entityCreatedMsg{entity: *created}
+	return m, m.createEntityChecked(input)
+}
+
+// applyAddTemplate pre-fills the add form from a template. The template type
+// replaces the typed one; tags, scopes, and metadata keys are added.
+func (m *EntitiesModel) applyAddTemplate(tmpl config.Template) {
+	if typ := strings.TrimSpace(tmpl.Type); typ != "" {
+		m.addFields[addFieldType].SetValue(typ)
+	}
+	m.addTags = mergeTemplateTags(m.addTags, tmpl.Tags)
+	m.addScopes = mergeTemplateScopes(m.addScopes, tmpl.Scopes)
+	m.addMeta.Buffer = templateMetadataBuffer(m.addMeta.Buffer, tmpl.Metadata)
+}
+
+// entityAddFieldIndex maps create-entity JSON fields to add form fields.
+var entityAddFieldIndex = map[string]int{
+	"name":     addFieldName,
+	"type":     addFieldType,
+	"status":   addFieldStatus,
+	"tags":     addFieldTags,
+	"scopes":   addFieldScopes,
+	"metadata": addFieldMetadata,
+}
+
+// showAddFieldErrors puts a rejected create's field errors under their
+// fields and focuses the first one.
+func (m *EntitiesModel) showAddFieldErrors(msg fieldErrorsMsg) {
+	errs, first, rest := mapFieldErrors(msg.fields, entityAddFieldIndex)
+	m.view = entitiesViewAdd
+	m.modeFocus = false
+	m.addFieldErrs = errs
+	if first >= 0 {
+		m.addFocus = first
+	}
+	m.errText = rest
+}
+
+// addSchema returns the metadata schema for the type typed into the add form.
+func (m EntitiesModel) addSchema() *metadataSchema {
+	return schemaForType(m.typeSchemas, m.addFields[addFieldType].Value)
+}
+
+// editSchema returns the metadata schema for the entity being edited.
+func (m EntitiesModel) editSchema() *metadataSchema {
+	if m.detail == nil {
+		return nil
 	}
+	return schemaForType(m.typeSchemas, m.detail.Type)
 }
 
 // resetAddForm handles reset add form.
 func (m *EntitiesModel) resetAddForm() {
 	m.addSaved = false
 	m.errText = ""
+	m.addFieldErrs = nil
 	m.modeFocus = false
 	m.addFocus = 0
 	m.addStatusIdx = statusIndex(entityStatusOptions, "active")
 	m.addTags = nil
-	m.addTagBuf = ""
+	m.addTagBuf.Reset()
 	m.addScopes = nil
 	m.addScopeBuf = ""
-	m.addScopeIdx = 0
+	m.scopePicker.Reset()
 	m.addScopeSelecting = false
 	m.addMeta.Reset()
+	m.addTemplate.Reset()
 	for i := range m.addFields {
-		m.addFields[i].value = ""
+		m.addFields[i].Reset()
 	}
+	m.applyAddDefaults()
 }
 
 // renderAddTags renders render add tags.
 func (m *EntitiesModel) renderAddTags(focused bool) string {
-	if len(m.addTags) == 0 && m.addTagBuf == "" && !focused {
+	if len(m.addTags) == 0 && m.addTagBuf.Value == "" && !focused {
 		return "-"
 	}
 	var b strings.Builder
@@ -1240,15 +1555,13 @@ func (m *EntitiesModel) renderAddTags(focused bool) string {
 		if b.Len() > 0 {
 			b.WriteString(" ")
 		}
-		if m.addTagBuf != "" {
-			b.WriteString(m.addTagBuf)
-		}
-		b.WriteString(AccentStyle.Render("█"))
-	} else if m.addTagBuf != "" {
+		b.WriteString(m.addTagBuf.View(AccentStyle, 0))
+		b.WriteString(renderTagSuggestions(m.tagOptions, m.addTagBuf.Value, m.addTags))
+	} else if m.addTagBuf.Value != "" {
 		if b.Len() > 0 {
 			b.WriteString(" ")
 		}
-		b.WriteString(MutedStyle.Render(m.addTagBuf))
+		b.WriteString(MutedStyle.Render(m.addTagBuf.Value))
 	}
 	return b.String()
 }
@@ -1260,24 +1573,24 @@ func (m *EntitiesModel) renderAddScopes(focused bool) string {
 
 // commitAddTag handles commit add tag.
 func (m *EntitiesModel) commitAddTag() {
-	raw := strings.TrimSpace(m.addTagBuf)
+	raw := strings.TrimSpace(m.addTagBuf.Value)
 	if raw == "" {
-		m.addTagBuf = ""
+		m.addTagBuf.Reset()
 		return
 	}
-	tag := normalizeTag(raw)
+	tag := canonicalTag(m.tagOptions, normalizeTag(raw))
 	if tag == "" {
-		m.addTagBuf = ""
+		m.addTagBuf.Reset()
 		return
 	}
 	for _, t := range m.addTags {
 		if t == tag {
-			m.addTagBuf = ""
+			m.addTagBuf.Reset()
 			return
 		}
 	}
 	m.addTags = append(m.addTags, tag)
-	m.addTagBuf = ""
+	m.addTagBuf.Reset()
 }
 
 // commitAddScope handles commit add scope.
@@ -1305,9 +1618,37 @@ func (m *EntitiesModel) commitAddScope() {
 // renderList renders render list.
 func (m EntitiesModel) renderList() string {
 	if m.loading {
-		return "  " + MutedStyle.Render("Loading entities...")
+		return renderLoadingList("entities", len(m.items), m.list, m.width, m.renderListBody)
 	}
+	return m.renderListBody()
+}
 
+// renderListBody renders the list without the loading state.
+func (m EntitiesModel) renderListBody() string {
+	if len(m.items) == 0 && m.showArchived {
+		return components.EmptyStateBox(
+			"Entities",
+			"No archived entities found.",
+			[]string{"Press ctrl+a to show active entities", "Press ctrl+x to open the trash"},
+			m.width,
+		)
+	}
+	if len(m.items) == 0 && !m.query.empty() {
+		return components.EmptyStateBox(
+			"Entities",
+			"No entities match the advanced filters.",
+			[]string{"Press F to edit the filters", "Press esc to clear them"},
+			m.width,
+		)
+	}
+	if len(m.items) == 0 && m.collection != nil {
+		return components.EmptyStateBox(
+			"Entities",
+			fmt.Sprintf("No entities in collection %s match.", m.collection.Name),
+			[]string{"Press esc to show all entities", "Select entities with space, then press C to add them"},
+			m.width,
+		)
+	}
 	if len(m.items) == 0 {
 		return components.EmptyStateBox(
 			"Entities",
@@ -1322,6 +1663,10 @@ func (m EntitiesModel) renderList() string {
 	showCheckboxes := m.bulkCount() > 0
 
 	previewWidth := preferredPreviewWidth(contentWidth)
+	split := m.pane.active(m.width)
+	if split {
+		previewWidth = splitPaneWidth(contentWidth)
+	}
 
 	gap := 3
 	tableWidth := contentWidth
@@ -1368,39 +1713,24 @@ func (m EntitiesModel) renderList() string {
 		}
 
 		e := m.items[absIdx]
-		name, typ := normalizeEntityNameType(components.SanitizeText(e.Name), components.SanitizeText(e.Type))
-		name = components.SanitizeOneLine(name)
-		typ = components.SanitizeOneLine(typ)
-		if typ == "" {
-			typ = "?"
-		}
-		status := strings.TrimSpace(components.SanitizeOneLine(e.Status))
-		if status == "" {
-			status = "-"
-		}
-		at := e.UpdatedAt
-		if at.IsZero() {
-			at = e.CreatedAt
-		}
-
-		displayName := name
+		checkbox := ""
 		if showCheckboxes {
-			checkbox := "[ ]"
+			checkbox = "[ ]"
 			if m.isBulkSelected(absIdx) {
 				checkbox = "[X]"
 			}
-			displayName = checkbox + " " + displayName
+		}
+
+		if m.pinned[e.ID] {
+			checkbox = strings.TrimSpace(checkbox + " " + pinMark)
 		}
 
 		if m.list.IsSelected(absIdx) {
 			activeRowRel = len(tableRows)
 		}
-		tableRows = append(tableRows, []string{
-			components.ClampTextWidthEllipsis(displayName, nameWidth),
-			components.ClampTextWidthEllipsis(typ, typeWidth),
-			components.ClampTextWidthEllipsis(status, statusWidth),
-			formatLocalTimeCompact(at),
-		})
+		tableRows = append(tableRows, m.rowCache.Row(e.ID, entityRowVersion(e)+checkbox, tableWidth, func() []string {
+			return entityTableRow(e, checkbox, nameWidth, typeWidth, statusWidth)
+		}))
 	}
 	if m.modeFocus {
 		activeRowRel = -1
@@ -1408,11 +1738,20 @@ func (m EntitiesModel) renderList() string {
 
 	title := "Entities"
 	countLine := fmt.Sprintf("%d total", len(m.items))
+	if m.showArchived {
+		countLine = fmt.Sprintf("%d archived", len(m.items))
+	}
 	if selected := m.bulkCount(); selected > 0 {
 		countLine = fmt.Sprintf("%s · selected: %d", countLine, selected)
+		if hidden := m.bulkOutOfView(); hidden > 0 {
+			countLine = fmt.Sprintf("%s (%d not in current view)", countLine, hidden)
+		}
+		if m.bulkCapped {
+			countLine = fmt.Sprintf("%s · capped at %d", countLine, entitySelectAllCap)
+		}
 	}
-	if strings.TrimSpace(m.searchBuf) != "" {
-		query := strings.TrimSpace(m.searchBuf)
+	if strings.TrimSpace(m.searchBuf.Value) != "" {
+		query := strings.TrimSpace(m.searchBuf.Value)
 		countLine = fmt.Sprintf("%s · search: %s", countLine, query)
 		if m.searchSuggest != "" && !strings.EqualFold(query, strings.TrimSpace(m.searchSuggest)) {
 			countLine = fmt.Sprintf("%s · next: %s", countLine, strings.TrimSpace(m.searchSuggest))
@@ -1421,12 +1760,18 @@ func (m EntitiesModel) renderList() string {
 	if m.hasActiveEntityFilters() {
 		countLine = fmt.Sprintf("%s · filters active", countLine)
 	}
-	countLine = MutedStyle.Render(countLine)
+	if m.collection != nil {
+		countLine = fmt.Sprintf("%s · collection: %s", countLine, components.SanitizeOneLine(m.collection.Name))
+	}
+	countLine = MutedStyle.Render(countLine) + renderLoadLatency(m.loadLatency)
 
 	table := components.TableGridWithActiveRow(cols, tableRows, tableWidth, activeRowRel)
 	preview := ""
 	if previewItem != nil {
 		content := m.renderEntityPreview(*previewItem, previewBoxContentWidth(previewWidth))
+		if split {
+			content = m.renderEntitySplitPane(*previewItem, previewBoxContentWidth(previewWidth))
+		}
 		preview = renderPreviewBox(content, previewWidth)
 	}
 
@@ -1437,6 +1782,9 @@ func (m EntitiesModel) renderList() string {
 		body = table + "\n\n" + preview
 	}
 
+	if chips := renderEntityQueryChips(m.query, contentWidth); chips != "" {
+		countLine = chips + "\n" + countLine
+	}
 	content := countLine + "\n\n" + body + "\n"
 	return components.TitledBox(title, content, m.width)
 }
@@ -1493,7 +1841,7 @@ func (m EntitiesModel) renderEntityPreview(e api.Entity, width int) string {
 // updateSearchSuggest updates update search suggest.
 func (m *EntitiesModel) updateSearchSuggest() {
 	m.searchSuggest = ""
-	query := strings.ToLower(strings.TrimSpace(m.searchBuf))
+	query := strings.ToLower(strings.TrimSpace(m.searchBuf.Value))
 	if query == "" {
 		return
 	}
@@ -1521,14 +1869,26 @@ func (m *EntitiesModel) toggleBulkSelection(absIdx int) {
 	}
 	if m.bulkSelected[id] {
 		delete(m.bulkSelected, id)
+		delete(m.bulkRecords, id)
 		return
 	}
 	m.bulkSelected[id] = true
+	m.rememberBulkRecord(m.items[absIdx])
+}
+
+// rememberBulkRecord keeps a selected entity around after it leaves the view.
+func (m *EntitiesModel) rememberBulkRecord(entity api.Entity) {
+	if m.bulkRecords == nil {
+		m.bulkRecords = map[string]api.Entity{}
+	}
+	m.bulkRecords[entity.ID] = entity
 }
 
 // clearBulkSelection handles clear bulk selection.
 func (m *EntitiesModel) clearBulkSelection() {
 	m.bulkSelected = map[string]bool{}
+	m.bulkRecords = nil
+	m.bulkCapped = false
 }
 
 // bulkCount handles bulk count.
@@ -1565,33 +1925,23 @@ func (m EntitiesModel) handleBulkPromptKeys(msg tea.KeyMsg) (EntitiesModel, tea.
 	switch {
 	case isBack(msg):
 		m.bulkPrompt = ""
-		m.bulkBuf = ""
+		m.bulkBuf.Reset()
 		return m, nil
 	case isKey(msg, "enter"):
-		spec, err := parseBulkInput(m.bulkBuf)
+		spec, err := parseBulkInput(m.bulkBuf.Value)
 		if err != nil {
 			return m, func() tea.Msg { return errMsg{err} }
 		}
-		m.bulkPrompt = ""
-		m.bulkBuf = ""
-		m.bulkRunning = true
-		switch m.bulkTarget {
-		case bulkTargetScopes:
-			return m, m.bulkUpdateScopes(spec)
-		default:
-			return m, m.bulkUpdateTags(spec)
-		}
-	case isKey(msg, "backspace", "delete"):
-		if len(m.bulkBuf) > 0 {
-			m.bulkBuf = m.bulkBuf[:len(m.bulkBuf)-1]
+		preview, err := m.bulkSetPreview(spec)
+		if err != nil {
+			return m, func() tea.Msg { return errMsg{err} }
 		}
+		m.bulkPreview = preview
+		return m, nil
 	case isKey(msg, "cmd+backspace", "cmd+delete", "ctrl+u"):
-		m.bulkBuf = ""
+		m.bulkBuf.Reset()
 	default:
-		ch := msg.String()
-		if len(ch) == 1 || ch == " " {
-			m.bulkBuf += ch
-		}
+		m.bulkBuf.HandleKey(msg)
 	}
 	return m, nil
 }
@@ -1608,6 +1958,13 @@ func (m EntitiesModel) bulkUpdateTags(spec bulkInput) tea.Cmd {
 		m.bulkRunning = false
 		return func() tea.Msg { return errMsg{fmt.Errorf("no valid tags provided")} }
 	}
+	if len(ids) > 1 {
+		client := m.client
+		return queueEntityBatches("Bulk tags", ids, func(batch []string) error {
+			_, err := client.BulkUpdateEntityTags(api.BulkUpdateEntityTagsInput{EntityIDs: batch, Tags: tags, Op: spec.op})
+			return err
+		})
+	}
 	input := api.BulkUpdateEntityTagsInput{
 		EntityIDs: ids,
 		Tags:      tags,
@@ -1634,6 +1991,13 @@ func (m EntitiesModel) bulkUpdateScopes(spec bulkInput) tea.Cmd {
 		m.bulkRunning = false
 		return func() tea.Msg { return errMsg{fmt.Errorf("no valid scopes provided")} }
 	}
+	if len(ids) > 1 {
+		client := m.client
+		return queueEntityBatches("Bulk scopes", ids, func(batch []string) error {
+			_, err := client.BulkUpdateEntityScopes(api.BulkUpdateEntityScopesInput{EntityIDs: batch, Scopes: scopes, Op: spec.op})
+			return err
+		})
+	}
 	input := api.BulkUpdateEntityScopesInput{
 		EntityIDs: ids,
 		Scopes:    scopes,
@@ -1648,27 +2012,39 @@ func (m EntitiesModel) bulkUpdateScopes(spec bulkInput) tea.Cmd {
 	}
 }
 
+// queueEntityBatches runs a bulk entity update on the app operation queue in
+// batches, so large selections show progress and failed batches can be retried.
+func queueEntityBatches(label string, ids []string, update func(batch []string) error) tea.Cmd {
+	sort.Strings(ids)
+	batches := chunkIDs(ids, operationBatchSize)
+	steps := make([]operationStep, 0, len(batches))
+	for i, batch := range batches {
+		batch := batch
+		steps = append(steps, operationStep{
+			label: fmt.Sprintf("batch %d/%d (%d entities)", i+1, len(batches), len(batch)),
+			run: func() ([]string, error) {
+				return nil, update(batch)
+			},
+		})
+	}
+	return queueOperation(fmt.Sprintf("%s on %d entities", label, len(ids)), tabEntities, steps, entityBulkUpdatedMsg{})
+}
+
 // --- Search ---
 
 func (m EntitiesModel) handleSearchInput(msg tea.KeyMsg) (EntitiesModel, tea.Cmd) {
 	switch {
 	case isBack(msg):
 		m.view = entitiesViewList
-		m.searchBuf = ""
+		m.searchBuf.Reset()
 	case isEnter(msg):
-		query := strings.TrimSpace(m.searchBuf)
-		m.searchBuf = ""
+		query := strings.TrimSpace(m.searchBuf.Value)
+		m.searchBuf.Reset()
 		m.loading = true
 		m.view = entitiesViewList
 		return m, m.loadEntities(query)
-	case isKey(msg, "backspace", "delete"):
-		if len(m.searchBuf) > 0 {
-			m.searchBuf = m.searchBuf[:len(m.searchBuf)-1]
-		}
 	default:
-		if len(msg.String()) == 1 || msg.String() == " " {
-			m.searchBuf += msg.String()
-		}
+		m.searchBuf.HandleKey(msg)
 	}
 	return m, nil
 }
@@ -1677,6 +2053,9 @@ func (m EntitiesModel) handleSearchInput(msg tea.KeyMsg) (EntitiesModel, tea.Cmd
 
 func (m EntitiesModel) handleDetailKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd) {
 	m.syncDetailMetadataRows()
+	if m.comments.focused && m.detail != nil && m.comments.shows(m.detail.ID) {
+		return m, m.comments.handleKeys(m.client, msg)
+	}
 	if m.metaInspect {
 		switch {
 		case isDown(msg):
@@ -1731,7 +2110,10 @@ func (m EntitiesModel) handleDetailKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd)
 	case isBack(msg):
 		m.detail = nil
 		m.detailRels = nil
+		m.refs = nil
+		m.refsLoading = false
 		m.metaRows = nil
+		m.viewAsScope = ""
 		m.clearMetaSelection()
 		m.closeMetaInspect()
 		m.view = entitiesViewList
@@ -1739,14 +2121,31 @@ func (m EntitiesModel) handleDetailKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd)
 		m.closeMetaInspect()
 		m.startEdit()
 		m.view = entitiesViewEdit
+	case isKey(msg, "C"):
+		if m.detail == nil {
+			return m, nil
+		}
+		m.closeMetaInspect()
+		m.comments.focused = true
+		if !m.comments.shows(m.detail.ID) {
+			m.comments = newRecordCommentThread(api.CommentOnEntity, m.detail.ID)
+			m.comments.focused = true
+			return m, m.comments.load(m.client)
+		}
 	case isKey(msg, "r"):
 		m.closeMetaInspect()
 		m.view = entitiesViewRelationships
+		if m.openCachedRelationships() {
+			return m, nil
+		}
 		m.relLoading = true
 		return m, m.loadRelationships()
 	case isKey(msg, "h"):
 		m.closeMetaInspect()
 		m.view = entitiesViewHistory
+		if m.openCachedHistory() {
+			return m, nil
+		}
 		m.historyLoading = true
 		return m, m.loadHistory()
 	case isKey(msg, "m"):
@@ -1756,6 +2155,21 @@ func (m EntitiesModel) handleDetailKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd)
 			m.clearMetaSelection()
 			m.closeMetaInspect()
 		}
+	case isKey(msg, "x"):
+		return m, m.copyEntityBundle()
+	case isKey(msg, "*"):
+		if e := m.detail; e != nil {
+			return m, togglePinCmd(config.PinEntity, e.ID, e.Name)
+		}
+	case isKey(msg, "w"):
+		if e := m.detail; e != nil {
+			return m, toggleWatchCmd(config.PinEntity, e.ID, e.Name)
+		}
+	case isKey(msg, "p"):
+		m.closeMetaInspect()
+		m.clearMetaSelection()
+		m.viewAsScope = nextPreviewScope(m.scopeOptions, m.viewAsScope)
+		m.syncDetailMetadataRows()
 	case isKey(msg, "d"):
 		m.closeMetaInspect()
 		m.confirmKind = "entity-archive"
@@ -1771,6 +2185,14 @@ func (m EntitiesModel) renderDetail() string {
 		return m.renderList()
 	}
 
+	var banner string
+	if m.viewAsScope != "" {
+		preview := previewAsScope(m.scopeNamesFromIDs(m.detail.PrivacyScopeIDs), m.detail.Metadata, m.viewAsScope)
+		banner = renderScopePreviewBanner(m.viewAsScope, preview, m.width)
+		if !preview.visible {
+			return banner
+		}
+	}
 	m.syncDetailMetadataRows()
 	e := m.detail
 	rows := []components.TableRow{
@@ -1798,6 +2220,9 @@ func (m EntitiesModel) renderDetail() string {
 	}
 
 	sections := []string{components.Table("Entity", rows, m.width)}
+	if banner != "" {
+		sections = append([]string{banner}, sections...)
+	}
 	if len(e.Metadata) > 0 {
 		sections = append(sections, renderMetadataSelectableBlockWithTitle(
 			"Metadata",
@@ -1811,9 +2236,22 @@ func (m EntitiesModel) renderDetail() string {
 			sections = append(sections, m.renderMetaInspect())
 		}
 	}
+	if banner != "" {
+		return strings.Join(sections, "\n\n")
+	}
 	if len(m.detailRels) > 0 {
 		sections = append(sections, renderRelationshipSummaryTable("entity", e.ID, m.detailRels, 8, m.width))
 	}
+	if attachments := renderAttachments("entity", e.ID, m.detailRels, m.width); attachments != "" {
+		sections = append(sections, attachments)
+	}
+	// refs stays nil until a lookup runs, e.g. when opened from the palette.
+	if m.refsLoading || m.refs != nil {
+		sections = append(sections, m.renderEntityReferences())
+	}
+	if m.comments.shows(e.ID) {
+		sections = append(sections, m.comments.view(m.width))
+	}
 
 	return strings.Join(sections, "\n\n")
 }
@@ -1826,14 +2264,25 @@ func (m EntitiesModel) loadHistory() tea.Cmd {
 	}
 	entityID := m.detail.ID
 	return func() tea.Msg {
-		items, err := m.client.GetEntityHistory(entityID, 50, 0)
+		items, err := m.client.GetEntityHistory(entityID, entityPrefetchHistoryLimit, 0)
 		if err != nil {
 			return errMsg{err}
 		}
-		return entityHistoryLoadedMsg{items: items}
+		return entityHistoryLoadedMsg{id: entityID, items: items}
 	}
 }
 
+// setHistory shows history entries in the history view.
+func (m *EntitiesModel) setHistory(items []api.AuditEntry) {
+	m.historyLoading = false
+	m.history = items
+	labels := make([]string, len(items))
+	for i, entry := range items {
+		labels[i] = formatHistoryLine(entry)
+	}
+	m.historyList.SetItems(labels)
+}
+
 // loadScopeNames loads load scope names.
 func (m EntitiesModel) loadScopeNames() tea.Cmd {
 	return func() tea.Msg {
@@ -1845,7 +2294,7 @@ func (m EntitiesModel) loadScopeNames() tea.Cmd {
 		for _, scope := range scopes {
 			names[scope.ID] = scope.Name
 		}
-		return entityScopesLoadedMsg{names: names}
+		return entityScopesLoadedMsg{names: names, info: scopeInfoByName(scopes)}
 	}
 }
 
@@ -1858,13 +2307,12 @@ func (m EntitiesModel) handleHistoryKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd
 		m.historyList.Down()
 	case isUp(msg):
 		m.historyList.Up()
+	case isKey(msg, "t"):
+		m.openTimeTravel()
 	case isEnter(msg):
 		if idx := m.historyList.Selected(); idx < len(m.history) {
-			entry := m.history[idx]
-			m.confirmKind = "entity-revert"
-			m.confirmAuditID = entry.ID
-			m.confirmReturn = entitiesViewDetail
-			m.view = entitiesViewConfirm
+			cmd := m.startRevertConfirm(m.history[idx])
+			return m, cmd
 		}
 	}
 	return m, nil
@@ -2016,16 +2464,20 @@ func (m *EntitiesModel) startEdit() {
 	}
 	m.editFocus = editFieldTags
 	m.editTags = append([]string{}, m.detail.Tags...)
-	m.editTagBuf = ""
+	m.editTagBuf.Reset()
 	m.editStatusIdx = statusIndex(entityStatusOptions, m.detail.Status)
 	m.editScopes = m.scopeNamesFromIDs(m.detail.PrivacyScopeIDs)
 	m.editScopeBuf = ""
-	m.editScopeIdx = 0
+	m.scopePicker.Reset()
 	m.editScopeSelecting = false
 	m.editMeta.Reset()
 	m.editMeta.Load(map[string]any(m.detail.Metadata))
 	m.editScopesDirty = false
 	m.editSaving = false
+	base := *m.detail
+	m.editBase = &base
+	m.editConflict = nil
+	m.errText = ""
 }
 
 // handleEditKeys handles handle edit keys.
@@ -2034,26 +2486,11 @@ func (m EntitiesModel) handleEditKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd) {
 		return m, nil
 	}
 	if m.editFocus == editFieldScopes && m.editScopeSelecting {
-		switch {
-		case isKey(msg, "left"):
-			if len(m.scopeOptions) > 0 {
-				m.editScopeIdx = (m.editScopeIdx - 1 + len(m.scopeOptions)) % len(m.scopeOptions)
-			}
-			return m, nil
-		case isKey(msg, "right"):
-			if len(m.scopeOptions) > 0 {
-				m.editScopeIdx = (m.editScopeIdx + 1) % len(m.scopeOptions)
-			}
-			return m, nil
-		case isSpace(msg):
-			if len(m.scopeOptions) > 0 {
-				scope := m.scopeOptions[m.editScopeIdx]
-				m.editScopes = toggleScope(m.editScopes, scope)
+		if scopes, open, ok := m.scopePicker.HandleKey(msg, m.scopeOptions, m.editScopes, m.scopeInfo); ok {
+			if !slices.Equal(scopes, m.editScopes) {
 				m.editScopesDirty = true
 			}
-			return m, nil
-		case isEnter(msg), isBack(msg):
-			m.editScopeSelecting = false
+			m.editScopes, m.editScopeSelecting = scopes, open
 			return m, nil
 		}
 	}
@@ -2074,8 +2511,8 @@ func (m EntitiesModel) handleEditKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd) {
 	case isKey(msg, "backspace"):
 		switch m.editFocus {
 		case editFieldTags:
-			if len(m.editTagBuf) > 0 {
-				m.editTagBuf = m.editTagBuf[:len(m.editTagBuf)-1]
+			if m.editTagBuf.Value != "" {
+				m.editTagBuf.Backspace()
 			} else if len(m.editTags) > 0 {
 				m.editTags = m.editTags[:len(m.editTags)-1]
 			}
@@ -2089,16 +2526,17 @@ func (m EntitiesModel) handleEditKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd) {
 		switch m.editFocus {
 		case editFieldTags:
 			switch {
+			case pasteList(&m.editTagBuf, msg, m.commitEditTag):
+			case isKey(msg, "tab"):
+				m.editTagBuf.SetValue(completeTag(m.tagOptions, m.editTagBuf.Value, m.editTags))
 			case isSpace(msg) || isKey(msg, ",") || isEnter(msg):
 				m.commitEditTag()
 			default:
-				ch := msg.String()
-				if len(ch) == 1 && ch != "," {
-					m.editTagBuf += ch
-				}
+				m.editTagBuf.HandleKey(msg)
 			}
 		case editFieldScopes:
 			if isSpace(msg) {
+				m.scopePicker.Reset()
 				m.editScopeSelecting = true
 			}
 		case editFieldStatus:
@@ -2158,7 +2596,7 @@ func (m EntitiesModel) renderEdit() string {
 	if m.editFocus == editFieldScopes && m.editScopeSelecting {
 		b.WriteString(SelectedStyle.Render("  Scopes:"))
 		b.WriteString("\n")
-		b.WriteString(NormalStyle.Render("  " + renderScopeOptions(m.editScopes, m.scopeOptions, m.editScopeIdx)))
+		b.WriteString(m.scopePicker.Render(m.scopeOptions, m.editScopes, m.scopeInfo, m.width))
 	} else if m.editFocus == editFieldScopes {
 		b.WriteString(SelectedStyle.Render("  Scopes:"))
 		b.WriteString("\n")
@@ -2180,6 +2618,15 @@ func (m EntitiesModel) renderEdit() string {
 	b.WriteString("\n")
 	meta := renderMetadataEditorPreview(m.editMeta.Buffer, m.editMeta.Scopes, m.width, 6)
 	b.WriteString(NormalStyle.Render("  " + meta))
+	if schema := m.editSchema(); schema != nil {
+		errs := validateMetadataBuffer(schema, m.editMeta.Buffer, m.editMeta.Scopes)
+		b.WriteString("\n" + renderMetadataSchemaHints(schema, errs))
+	}
+
+	if m.errText != "" {
+		b.WriteString("\n\n")
+		b.WriteString(components.ErrorBox("Error", m.errText, m.width))
+	}
 
 	if m.editSaving {
 		b.WriteString("\n\n" + MutedStyle.Render("Saving..."))
@@ -2207,28 +2654,62 @@ func (m EntitiesModel) saveEdit() (EntitiesModel, tea.Cmd) {
 		meta = map[string]any{}
 	}
 	meta = mergeMetadataScopes(meta, m.editMeta.Scopes)
+	if errs := m.editSchema().Validate(meta); len(errs) > 0 {
+		m.errText = metadataSchemaError(m.detail.Type, errs)
+		m.editFocus = editFieldMetadata
+		return m, nil
+	}
 	input := api.UpdateEntityInput{
 		Status:   &status,
 		Tags:     &tags,
 		Metadata: meta,
 	}
+	if m.editBase != nil && !m.editBase.UpdatedAt.IsZero() {
+		seen := m.editBase.UpdatedAt
+		input.ExpectedUpdatedAt = &seen
+	}
+	var scopes []string
+	if m.editScopesDirty {
+		scopes = normalizeBulkScopes(m.editScopes)
+	}
 
 	m.editSaving = true
-	return m, func() tea.Msg {
-		updated, err := m.client.UpdateEntity(m.detail.ID, input)
+	return m, m.submitEdit(m.detail.ID, input, scopes)
+}
+
+// submitEdit saves an entity edit. Scopes are replaced only when non-nil.
+// A stale-record conflict loads the live entity for the merge dialog; an
+// unreachable server queues the edit for replay.
+func (m EntitiesModel) submitEdit(id string, input api.UpdateEntityInput, scopes []string) tea.Cmd {
+	name := ""
+	if m.detail != nil {
+		name = m.detail.Name
+	}
+	return func() tea.Msg {
+		updated, err := m.client.UpdateEntity(id, input)
 		if err != nil {
-			return errMsg{err}
+			if isUnreachable(err) {
+				return queueOfflineEdit(id, name, input, scopes)
+			}
+			if !isEditConflict(err) {
+				return errMsg{err}
+			}
+			theirs, getErr := m.client.GetEntity(id)
+			if getErr != nil {
+				return errMsg{err}
+			}
+			return entityEditConflictMsg{input: input, scopes: scopes, theirs: *theirs}
 		}
-		if m.editScopesDirty {
+		if scopes != nil {
 			scopeInput := api.BulkUpdateEntityScopesInput{
-				EntityIDs: []string{m.detail.ID},
-				Scopes:    normalizeBulkScopes(m.editScopes),
+				EntityIDs: []string{id},
+				Scopes:    scopes,
 				Op:        "set",
 			}
 			if _, err := m.client.BulkUpdateEntityScopes(scopeInput); err != nil {
 				return errMsg{err}
 			}
-			updated, err = m.client.GetEntity(m.detail.ID)
+			updated, err = m.client.GetEntity(id)
 			if err != nil {
 				return errMsg{err}
 			}
@@ -2239,25 +2720,25 @@ func (m EntitiesModel) saveEdit() (EntitiesModel, tea.Cmd) {
 
 // commitEditTag handles commit edit tag.
 func (m *EntitiesModel) commitEditTag() {
-	raw := strings.TrimSpace(m.editTagBuf)
+	raw := strings.TrimSpace(m.editTagBuf.Value)
 	if raw == "" {
-		m.editTagBuf = ""
+		m.editTagBuf.Reset()
 		return
 	}
 
-	tag := normalizeTag(raw)
+	tag := canonicalTag(m.tagOptions, normalizeTag(raw))
 	if tag == "" {
-		m.editTagBuf = ""
+		m.editTagBuf.Reset()
 		return
 	}
 	for _, t := range m.editTags {
 		if t == tag {
-			m.editTagBuf = ""
+			m.editTagBuf.Reset()
 			return
 		}
 	}
 	m.editTags = append(m.editTags, tag)
-	m.editTagBuf = ""
+	m.editTagBuf.Reset()
 }
 
 // commitEditScope handles commit edit scope.
@@ -2290,7 +2771,7 @@ func (m EntitiesModel) renderEditScopes(focused bool) string {
 
 // renderEditTags renders render edit tags.
 func (m EntitiesModel) renderEditTags(focused bool) string {
-	if len(m.editTags) == 0 && m.editTagBuf == "" && !focused {
+	if len(m.editTags) == 0 && m.editTagBuf.Value == "" && !focused {
 		return "-"
 	}
 
@@ -2305,15 +2786,13 @@ func (m EntitiesModel) renderEditTags(focused bool) string {
 		if b.Len() > 0 {
 			b.WriteString(" ")
 		}
-		if m.editTagBuf != "" {
-			b.WriteString(m.editTagBuf)
-		}
-		b.WriteString(AccentStyle.Render("█"))
-	} else if m.editTagBuf != "" {
+		b.WriteString(m.editTagBuf.View(AccentStyle, 0))
+		b.WriteString(renderTagSuggestions(m.tagOptions, m.editTagBuf.Value, m.editTags))
+	} else if m.editTagBuf.Value != "" {
 		if b.Len() > 0 {
 			b.WriteString(" ")
 		}
-		b.WriteString(MutedStyle.Render(m.editTagBuf))
+		b.WriteString(MutedStyle.Render(m.editTagBuf.Value))
 	}
 	return b.String()
 }
@@ -2321,6 +2800,9 @@ func (m EntitiesModel) renderEditTags(focused bool) string {
 // --- Confirm ---
 
 func (m EntitiesModel) handleConfirmKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd) {
+	if m.confirmKind == "entity-revert" && m.handleRevertFieldKeys(msg) {
+		return m, nil
+	}
 	switch {
 	case isKey(msg, "y"), isEnter(msg):
 		switch m.confirmKind {
@@ -2347,12 +2829,16 @@ func (m EntitiesModel) handleConfirmKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd
 				m.resetConfirmState()
 				return m, nil
 			}
+			fields := m.selectedRevertFields()
+			if fields != nil && len(fields) == 0 {
+				return m, func() tea.Msg { return errMsg{fmt.Errorf("select at least one field to revert")} }
+			}
 			entityID := m.detail.ID
 			auditID := m.confirmAuditID
 			m.view = m.confirmReturn
 			m.resetConfirmState()
 			return m, func() tea.Msg {
-				updated, err := m.client.RevertEntity(entityID, auditID)
+				updated, err := m.client.RevertEntity(entityID, auditID, fields...)
 				if err != nil {
 					return errMsg{err}
 				}
@@ -2397,6 +2883,12 @@ func (m EntitiesModel) renderConfirm() string {
 				components.TableRow{Label: "Entity", Value: m.detail.Name},
 				components.TableRow{Label: "ID", Value: m.detail.ID},
 			)
+			if len(m.refs) > 0 {
+				summary = append(summary, components.TableRow{
+					Label: "Referenced by",
+					Value: fmt.Sprintf("%d items", len(m.refs)),
+				})
+			}
 			diffs = append(diffs, components.DiffRow{
 				Label: "status",
 				From:  firstNonEmpty(m.detail.Status, "active"),
@@ -2417,7 +2909,7 @@ func (m EntitiesModel) renderConfirm() string {
 				Label: "Changed At",
 				Value: formatLocalTimeFull(m.confirmAudit.ChangedAt),
 			})
-			diffs = append(diffs, buildAuditDiffRows(*m.confirmAudit)...)
+			return m.renderRevertConfirm(summary)
 		}
 	case "rel-archive":
 		title = "Archive Relationship"
@@ -2445,6 +2937,7 @@ func (m *EntitiesModel) resetConfirmState() {
 	m.confirmRelID = ""
 	m.confirmAuditID = ""
 	m.confirmAudit = nil
+	m.resetRevertState()
 }
 
 // selectedRelationshipByID handles selected relationship by id.
@@ -2493,6 +2986,13 @@ func (m EntitiesModel) handleRelationshipsKeys(msg tea.KeyMsg) (EntitiesModel, t
 		m.relList.Down()
 	case isUp(msg):
 		m.relList.Up()
+	case isEnter(msg):
+		if rel := m.selectedRelationship(); rel != nil {
+			selected := *rel
+			return m, func() tea.Msg {
+				return searchSelectionMsg{kind: "relationship", rel: &selected}
+			}
+		}
 	case isKey(msg, "n"):
 		m.startRelate()
 		m.view = entitiesViewRelateSearch
@@ -2552,11 +3052,11 @@ func (m EntitiesModel) renderRelationships() string {
 // --- Relate Flow ---
 
 func (m *EntitiesModel) startRelate() {
-	m.relateQuery = ""
+	m.relateQuery.Reset()
 	m.relateResults = nil
 	m.relateList.SetItems(nil)
 	m.relateTarget = nil
-	m.relateType = ""
+	m.relateType.Reset()
 	m.relateLoading = false
 }
 
@@ -2568,21 +3068,15 @@ func (m EntitiesModel) handleRelateKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd)
 		case isBack(msg):
 			m.view = entitiesViewRelationships
 		case isEnter(msg):
-			query := strings.TrimSpace(m.relateQuery)
+			query := strings.TrimSpace(m.relateQuery.Value)
 			if query == "" {
 				return m, nil
 			}
 			m.relateLoading = true
 			m.view = entitiesViewRelateSelect
 			return m, m.loadRelateResults(query)
-		case isKey(msg, "backspace"):
-			if len(m.relateQuery) > 0 {
-				m.relateQuery = m.relateQuery[:len(m.relateQuery)-1]
-			}
 		default:
-			if len(msg.String()) == 1 || msg.String() == " " {
-				m.relateQuery += msg.String()
-			}
+			m.relateQuery.HandleKey(msg)
 		}
 	case entitiesViewRelateSelect:
 		switch {
@@ -2596,7 +3090,7 @@ func (m EntitiesModel) handleRelateKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd)
 			if idx := m.relateList.Selected(); idx < len(m.relateResults) {
 				item := m.relateResults[idx]
 				m.relateTarget = &item
-				m.relateType = ""
+				m.relateType.Reset()
 				m.view = entitiesViewRelateType
 			}
 		}
@@ -2608,21 +3102,20 @@ func (m EntitiesModel) handleRelateKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd)
 			if m.relateTarget == nil {
 				return m, nil
 			}
-			kind := strings.TrimSpace(m.relateType)
+			kind := m.relTypeRules.canonical(m.relateType.Value)
 			if kind == "" {
 				return m, nil
 			}
+			if err := m.relTypeRules.check(kind, m.detail.Type, m.relateTarget.Type); err != nil {
+				return m, func() tea.Msg { return errMsg{err} }
+			}
 			m.view = entitiesViewRelationships
 			m.relLoading = true
 			return m, m.createRelationship(*m.detail, *m.relateTarget, kind)
-		case isKey(msg, "backspace"):
-			if len(m.relateType) > 0 {
-				m.relateType = m.relateType[:len(m.relateType)-1]
-			}
+		case isKey(msg, "tab"):
+			m.relateType.SetValue(completeTag(m.relateTypeOptions(), m.relateType.Value, nil))
 		default:
-			if len(msg.String()) == 1 || msg.String() == " " {
-				m.relateType += msg.String()
-			}
+			m.relateType.HandleKey(msg)
 		}
 	}
 	return m, nil
@@ -2704,7 +3197,7 @@ func (m EntitiesModel) renderRelate() string {
 			}
 			tableRows = append(tableRows, []string{
 				components.ClampTextWidthEllipsis(name, nameWidth),
-				components.ClampTextWidthEllipsis(typ, typeWidth),
+				renderEntityTypeCell(typ, typ, typeWidth),
 				components.ClampTextWidthEllipsis(status, statusWidth),
 			})
 		}
@@ -2727,11 +3220,25 @@ func (m EntitiesModel) renderRelate() string {
 		content := countLine + "\n\n" + body + "\n"
 		return components.Indent(components.TitledBox("Select Entity", content, m.width), 1)
 	case entitiesViewRelateType:
-		return components.Indent(components.InputDialog("Relationship Type", m.relateType), 1)
+		dialog := components.InputDialog("Relationship Type", m.relateType)
+		if hint := renderTagSuggestions(m.relateTypeOptions(), m.relateType.Value, nil); hint != "" {
+			dialog += "\n" + hint
+		}
+		return components.Indent(dialog, 1)
 	}
 	return ""
 }
 
+// relateTypeOptions lists relationship types already on this entity plus the
+// server taxonomy, minus managed types that do not allow this pairing.
+func (m EntitiesModel) relateTypeOptions() []string {
+	options := mergeVocabulary(uniqueRelationshipTypes(m.detailRels), m.relTypeVocab)
+	if m.detail == nil || m.relateTarget == nil {
+		return options
+	}
+	return m.relTypeRules.filter(options, m.detail.Type, m.relateTarget.Type)
+}
+
 // renderRelateEntityPreview renders render relate entity preview.
 func (m EntitiesModel) renderRelateEntityPreview(e api.Entity, width int) string {
 	if width <= 0 {
@@ -2780,7 +3287,11 @@ func (m *EntitiesModel) startRelEdit() {
 	m.relEditID = rel.ID
 	m.relEditFocus = relEditFieldStatus
 	m.relEditStatusIdx = statusIndex(relationshipStatusOptions, rel.Status)
-	m.relEditBuf = compactJSON(map[string]any(rel.Properties))
+	m.relEditMeta.Reset()
+	m.relEditMeta.Label = "Properties"
+	m.relEditMeta.SetScopeOptions(m.scopeOptions)
+	m.relEditMeta.SetScopeInfo(m.scopeInfo)
+	m.relEditMeta.Load(map[string]any(rel.Properties))
 }
 
 // handleRelEditKeys handles handle rel edit keys.
@@ -2796,10 +3307,6 @@ func (m EntitiesModel) handleRelEditKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd
 		m.view = entitiesViewRelationships
 	case isKey(msg, "ctrl+s"):
 		return m.saveRelEdit()
-	case isKey(msg, "backspace"):
-		if m.relEditFocus == relEditFieldProperties && len(m.relEditBuf) > 0 {
-			m.relEditBuf = m.relEditBuf[:len(m.relEditBuf)-1]
-		}
 	default:
 		switch m.relEditFocus {
 		case relEditFieldStatus:
@@ -2810,9 +3317,8 @@ func (m EntitiesModel) handleRelEditKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd
 				m.relEditStatusIdx = (m.relEditStatusIdx + 1) % len(relationshipStatusOptions)
 			}
 		case relEditFieldProperties:
-			ch := msg.String()
-			if len(ch) == 1 || ch == " " {
-				m.relEditBuf += ch
+			if isEnter(msg) {
+				m.relEditMeta.Active = true
 			}
 		}
 	}
@@ -2837,14 +3343,21 @@ func (m EntitiesModel) renderRelEdit() string {
 	b.WriteString("\n\n")
 
 	if m.relEditFocus == relEditFieldProperties {
-		b.WriteString(SelectedStyle.Render("  Properties (JSON):"))
-		b.WriteString("\n")
-		b.WriteString(NormalStyle.Render("  " + m.relEditBuf))
-		b.WriteString(AccentStyle.Render("█"))
+		b.WriteString(SelectedStyle.Render("  Properties:"))
 	} else {
-		b.WriteString(MutedStyle.Render("  Properties (JSON):"))
-		b.WriteString("\n")
-		b.WriteString(NormalStyle.Render("  " + m.relEditBuf))
+		b.WriteString(MutedStyle.Render("  Properties:"))
+	}
+	b.WriteString("\n")
+	props := renderMetadataEditorPreview(m.relEditMeta.Buffer, m.relEditMeta.Scopes, m.width, 6)
+	if strings.TrimSpace(props) == "" {
+		props = "-"
+	}
+	b.WriteString(NormalStyle.Render("  " + props))
+	if m.relEditFocus == relEditFieldProperties {
+		b.WriteString("\n\n" + MutedStyle.Render("  enter to edit properties"))
+	}
+	if m.errText != "" {
+		b.WriteString("\n\n" + ErrorStyle.Render("  "+m.errText))
 	}
 
 	return components.Indent(components.TitledBox("Edit Relationship", b.String(), m.width), 1)
@@ -2854,14 +3367,16 @@ func (m EntitiesModel) renderRelEdit() string {
 func (m EntitiesModel) saveRelEdit() (EntitiesModel, tea.Cmd) {
 	status := relationshipStatusOptions[m.relEditStatusIdx]
 	input := api.UpdateRelationshipInput{Status: &status}
-	if strings.TrimSpace(m.relEditBuf) != "" {
-		props, err := parseJSONMap(m.relEditBuf)
-		if err != nil {
-			m.errText = err.Error()
-			return m, nil
-		}
+	props, err := parseMetadataInput(m.relEditMeta.Buffer)
+	if err != nil {
+		m.errText = err.Error()
+		return m, nil
+	}
+	props = mergeMetadataScopes(props, m.relEditMeta.Scopes)
+	if len(props) > 0 {
 		input.Properties = props
 	}
+	m.errText = ""
 
 	m.view = entitiesViewRelationships
 	return m, func() tea.Msg {
@@ -2876,25 +3391,90 @@ func (m EntitiesModel) saveRelEdit() (EntitiesModel, tea.Cmd) {
 // --- Helpers ---
 
 func (m EntitiesModel) loadEntities(search string) func() tea.Msg {
+	queued := time.Now()
 	return func() tea.Msg {
 		params := api.QueryParams{}
 		if search != "" {
 			params["search_text"] = search
 		}
+		if m.showArchived {
+			params["status_category"] = "archived"
+		}
+		if m.collection != nil {
+			params["collection_id"] = m.collection.ID
+		}
+		m.query.apply(params)
 		items, err := m.client.QueryEntities(params)
 		if err != nil {
-			return errMsg{err}
+			return loadFailed(tabEntities, err, m.loadEntities(search))
+		}
+		return entitiesLoadedMsg{items: items, queued: queued}
+	}
+}
+
+// selectAllMatching pages through every entity matching the search, not just
+// the loaded page, so they can all be bulk selected.
+func (m EntitiesModel) selectAllMatching(search string) tea.Cmd {
+	client := m.client
+	archived := m.showArchived
+	query := m.query
+	collectionID := ""
+	if m.collection != nil {
+		collectionID = m.collection.ID
+	}
+	return func() tea.Msg {
+		var all []api.Entity
+		// Ask for one row past the cap so exactly the cap is not reported as
+		// truncated.
+		for offset := 0; offset <= entitySelectAllCap; offset += entitySelectAllPage {
+			limit := min(entitySelectAllPage, entitySelectAllCap+1-offset)
+			params := api.QueryParams{
+				"limit":  strconv.Itoa(limit),
+				"offset": strconv.Itoa(offset),
+			}
+			if search != "" {
+				params["search_text"] = search
+			}
+			if archived {
+				params["status_category"] = "archived"
+			}
+			if collectionID != "" {
+				params["collection_id"] = collectionID
+			}
+			query.apply(params)
+			items, err := client.QueryEntities(params)
+			if err != nil {
+				return errMsg{err}
+			}
+			all = append(all, items...)
+			if len(items) < limit {
+				break
+			}
 		}
-		return entitiesLoadedMsg{items}
+		if len(all) > entitySelectAllCap {
+			return entitySelectionLoadedMsg{items: all[:entitySelectAllCap], capped: true}
+		}
+		return entitySelectionLoadedMsg{items: all}
 	}
 }
 
+// setRelationships shows relationships in the relationships view.
+func (m *EntitiesModel) setRelationships(items []api.Relationship) {
+	m.relLoading = false
+	m.rels = items
+	labels := make([]string, len(items))
+	for i, r := range items {
+		labels[i] = m.formatRelationshipLine(r)
+	}
+	m.relList.SetItems(labels)
+}
+
 // loadEntityDetailRelationships loads load entity detail relationships.
 func (m EntitiesModel) loadEntityDetailRelationships(entityID string) tea.Cmd {
 	return func() tea.Msg {
 		items, err := m.client.GetRelationships("entity", entityID)
 		if err != nil {
-			return entityDetailRelationshipsLoadedMsg{id: entityID, items: nil}
+			return entityDetailRelationshipsLoadedMsg{id: entityID, items: nil, err: err}
 		}
 		return entityDetailRelationshipsLoadedMsg{id: entityID, items: items}
 	}
@@ -2966,7 +3546,7 @@ func (m *EntitiesModel) syncDetailMetadataRows() {
 	}
 	rows := []metadataDisplayRow{}
 	if m.detail != nil && len(m.detail.Metadata) > 0 {
-		rows = metadataDisplayRows(map[string]any(m.detail.Metadata))
+		rows = metadataDisplayRows(map[string]any(m.detailMetadata()))
 	}
 	m.metaRows = rows
 	syncMetadataList(m.metaList, rows, metadataPanelPageSize(m.metaExpanded))
@@ -3235,6 +3815,9 @@ func (m EntitiesModel) formatRelationshipLine(rel api.Relationship) string {
 	if label == "" {
 		label = "relationship"
 	}
+	if inverse := m.relTypeRules.inverse(rel.Type); direction == "incoming" && inverse != "" {
+		label = inverse
+	}
 	if direction != "" {
 		return fmt.Sprintf("%s (%s -> %s)", label, direction, other)
 	}
@@ -3263,15 +3846,6 @@ func compactJSON(data map[string]any) string {
 	return string(b)
 }
 
-// parseJSONMap parses parse jsonmap.
-func parseJSONMap(input string) (map[string]any, error) {
-	var data map[string]any
-	if err := json.Unmarshal([]byte(input), &data); err != nil {
-		return nil, fmt.Errorf("invalid json: %w", err)
-	}
-	return data, nil
-}
-
 // shortID handles short id.
 func shortID(id string) string {
 	if len(id) <= 8 {
@@ -3296,6 +3870,18 @@ func (m EntitiesModel) formatEntityScopes(ids []string) string {
 	return formatScopePreview(names)
 }
 
+// detailMetadata returns the detail metadata, redacted to the previewed
+// scope when one is set.
+func (m EntitiesModel) detailMetadata() api.JSONMap {
+	if m.detail == nil {
+		return nil
+	}
+	if m.viewAsScope == "" {
+		return m.detail.Metadata
+	}
+	return previewAsScope(m.scopeNamesFromIDs(m.detail.PrivacyScopeIDs), m.detail.Metadata, m.viewAsScope).metadata
+}
+
 // scopeNamesFromIDs handles scope names from ids.
 func (m EntitiesModel) scopeNamesFromIDs(ids []string) []string {
 	if len(ids) == 0 {
@@ -3317,6 +3903,43 @@ func formatEntityLine(e api.Entity) string {
 	return formatEntityLineWidth(e, maxEntityLineLen)
 }
 
+// entityTableRow renders the list table cells for one entity.
+func entityTableRow(e api.Entity, checkbox string, nameWidth, typeWidth, statusWidth int) []string {
+	name, typ := normalizeEntityNameType(components.SanitizeText(e.Name), components.SanitizeText(e.Type))
+	name = components.SanitizeOneLine(name)
+	typ = components.SanitizeOneLine(typ)
+	if typ == "" {
+		typ = "?"
+	}
+	status := strings.TrimSpace(components.SanitizeOneLine(e.Status))
+	if status == "" {
+		status = "-"
+	}
+	at := e.UpdatedAt
+	if at.IsZero() {
+		at = e.CreatedAt
+	}
+	if checkbox != "" {
+		name = checkbox + " " + name
+	}
+	return []string{
+		components.ClampTextWidthEllipsis(name, nameWidth),
+		renderEntityTypeCell(typ, typ, typeWidth),
+		components.ClampTextWidthEllipsis(status, statusWidth),
+		formatLocalTimeCompact(at),
+	}
+}
+
+// entityRowVersion identifies the entity state a cached row or label was
+// rendered from. The server bumps updated_at on every change.
+func entityRowVersion(e api.Entity) string {
+	at := e.UpdatedAt
+	if at.IsZero() {
+		at = e.CreatedAt
+	}
+	return strconv.FormatInt(at.UnixNano(), 36) + "|" + e.Name + "|" + e.Type + "|" + e.Status
+}
+
 // formatEntityLineWidth handles format entity line width.
 func formatEntityLineWidth(e api.Entity, maxWidth int) string {
 	name, t := normalizeEntityNameType(
@@ -3389,7 +4012,7 @@ func formatEntityHeader(name string, typ string, maxWidth int) string {
 	if strings.TrimSpace(typ) == "" {
 		typ = "?"
 	}
-	badge := TypeBadgeStyle.Render(components.SanitizeText(typ))
+	badge := renderEntityTypeBadge(components.SanitizeText(typ))
 	header := fmt.Sprintf("%s %s", name, badge)
 	if maxWidth <= 0 || lipgloss.Width(header) <= maxWidth {
 		return header
@@ -127,6 +127,8 @@ func TestEntitiesBulkUpdateTagsCallsEndpoint(t *testing.T) {
 	for _, r := range "add:alpha" {
 		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
 	}
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, model.bulkPreview)
 	var cmd tea.Cmd
 	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
@@ -164,6 +166,7 @@ func TestEntitiesBulkUpdateScopesErrorShowsMessage(t *testing.T) {
 	for _, r := range "add:public" {
 		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
 	}
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
 	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
 	msg := cmd()
@@ -20,11 +20,11 @@ func TestEntitiesHandleAddKeysBranchMatrix(t *testing.T) {
 
 		model = NewEntitiesModel(nil)
 		model.addSaved = true
-		model.addFields[addFieldName].value = "keep"
+		model.addFields[addFieldName].Value = "keep"
 		next, cmd = model.handleAddKeys(tea.KeyMsg{Type: tea.KeyEsc})
 		assert.Nil(t, cmd)
 		assert.False(t, next.addSaved)
-		assert.Equal(t, "", next.addFields[addFieldName].value)
+		assert.Equal(t, "", next.addFields[addFieldName].Value)
 	})
 
 	t.Run("mode focus delegates to mode handler", func(t *testing.T) {
@@ -54,20 +54,20 @@ func TestEntitiesHandleAddKeysBranchMatrix(t *testing.T) {
 		next.addFocus = addFieldScopes
 		next.scopeOptions = []string{"public", "private"}
 		next.addScopeSelecting = true
-		next.addScopeIdx = 0
+		next.scopePicker.idx = 0
 
 		next, _ = next.handleAddKeys(tea.KeyMsg{Type: tea.KeyLeft})
-		assert.Equal(t, 1, next.addScopeIdx)
+		assert.Equal(t, 1, next.scopePicker.idx)
 
 		next, _ = next.handleAddKeys(tea.KeyMsg{Type: tea.KeyRight})
-		assert.Equal(t, 0, next.addScopeIdx)
+		assert.Equal(t, 0, next.scopePicker.idx)
 
 		next, _ = next.handleAddKeys(tea.KeyMsg{Type: tea.KeySpace})
 		assert.Equal(t, []string{"public"}, next.addScopes)
 
 		next.scopeOptions = nil
 		next, _ = next.handleAddKeys(tea.KeyMsg{Type: tea.KeyLeft})
-		assert.Equal(t, 0, next.addScopeIdx)
+		assert.Equal(t, 0, next.scopePicker.idx)
 
 		next, _ = next.handleAddKeys(tea.KeyMsg{Type: tea.KeyEnter})
 		assert.False(t, next.addScopeSelecting)
@@ -99,18 +99,18 @@ func TestEntitiesHandleAddKeysBranchMatrix(t *testing.T) {
 
 		// Tag input branches.
 		next.addFocus = addFieldTags
-		next.addTagBuf = "ab"
+		next.addTagBuf.Value = "ab"
 		next, _ = next.handleAddKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-		assert.Equal(t, "a", next.addTagBuf)
-		next.addTagBuf = ""
+		assert.Equal(t, "a", next.addTagBuf.Value)
+		next.addTagBuf.Value = ""
 		next.addTags = []string{"alpha", "beta"}
 		next, _ = next.handleAddKeys(tea.KeyMsg{Type: tea.KeyBackspace})
 		assert.Equal(t, []string{"alpha"}, next.addTags)
 		next, _ = next.handleAddKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'z'}})
-		assert.Equal(t, "z", next.addTagBuf)
+		assert.Equal(t, "z", next.addTagBuf.Value)
 		next, _ = next.handleAddKeys(tea.KeyMsg{Type: tea.KeyEnter})
 		assert.Equal(t, []string{"alpha", "z"}, next.addTags)
-		assert.Equal(t, "", next.addTagBuf)
+		assert.Equal(t, "", next.addTagBuf.Value)
 
 		// Scope delete and scope-select activation.
 		next.addFocus = addFieldScopes
@@ -131,16 +131,16 @@ func TestEntitiesHandleAddKeysBranchMatrix(t *testing.T) {
 
 		// Default field input/delete branches.
 		next.addFocus = addFieldType
-		next.addFields[addFieldType].value = "pers"
+		next.addFields[addFieldType].Value = "pers"
 		next, _ = next.handleAddKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-		assert.Equal(t, "per", next.addFields[addFieldType].value)
+		assert.Equal(t, "per", next.addFields[addFieldType].Value)
 		next, _ = next.handleAddKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
-		assert.Equal(t, "pers", next.addFields[addFieldType].value)
+		assert.Equal(t, "pers", next.addFields[addFieldType].Value)
 
 		// Esc resets the whole form.
-		next.addFields[addFieldName].value = "Alpha"
+		next.addFields[addFieldName].Value = "Alpha"
 		next, _ = next.handleAddKeys(tea.KeyMsg{Type: tea.KeyEsc})
-		assert.Equal(t, "", next.addFields[addFieldName].value)
+		assert.Equal(t, "", next.addFields[addFieldName].Value)
 		assert.Equal(t, 0, next.addFocus)
 	})
 }
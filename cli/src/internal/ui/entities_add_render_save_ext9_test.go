@@ -19,8 +19,8 @@ func TestEntitiesRenderAddBranchMatrix(t *testing.T) {
 	model.addTags = []string{"alpha"}
 	model.addScopes = []string{"public"}
 	model.addMeta.Buffer = `{"profile":{"name":"Alpha"}}`
-	model.addFields[addFieldName].value = "Alpha"
-	model.addFields[addFieldType].value = "person"
+	model.addFields[addFieldName].Value = "Alpha"
+	model.addFields[addFieldType].Value = "person"
 
 	// Focus each branch at least once so renderAdd executes all switch paths.
 	model.addFocus = addFieldStatus
@@ -35,7 +35,7 @@ func TestEntitiesRenderAddBranchMatrix(t *testing.T) {
 
 	model.addFocus = addFieldScopes
 	model.addScopeSelecting = true
-	model.addScopeIdx = 0
+	model.scopePicker.idx = 0
 	out = components.SanitizeText(model.renderAdd())
 	assert.Contains(t, out, "Scopes:")
 	assert.Contains(t, out, "public")
@@ -56,7 +56,7 @@ func TestEntitiesRenderAddBranchMatrix(t *testing.T) {
 
 	// Unfocused default field with empty value should show "-".
 	model.addFocus = addFieldType
-	model.addFields[addFieldName].value = ""
+	model.addFields[addFieldName].Value = ""
 	out = components.SanitizeText(model.renderAdd())
 	assert.Contains(t, out, "Name:")
 	assert.Contains(t, out, "-")
@@ -83,12 +83,12 @@ func TestEntitiesSaveAddValidationAndDefaults(t *testing.T) {
 		assert.Nil(t, cmd)
 		assert.Equal(t, "Name is required", next.errText)
 
-		model.addFields[addFieldName].value = "Alpha"
+		model.addFields[addFieldName].Value = "Alpha"
 		next, cmd = model.saveAdd()
 		assert.Nil(t, cmd)
 		assert.Equal(t, "Type is required", next.errText)
 
-		model.addFields[addFieldType].value = "person"
+		model.addFields[addFieldType].Value = "person"
 		model.addMeta.Buffer = "{"
 		next, cmd = model.saveAdd()
 		assert.Nil(t, cmd)
@@ -115,10 +115,10 @@ func TestEntitiesSaveAddValidationAndDefaults(t *testing.T) {
 		})
 
 		model := NewEntitiesModel(client)
-		model.addFields[addFieldName].value = "Alpha"
-		model.addFields[addFieldType].value = "person"
+		model.addFields[addFieldName].Value = "Alpha"
+		model.addFields[addFieldType].Value = "person"
 		model.addStatusIdx = 1
-		model.addTagBuf = "alpha"
+		model.addTagBuf.Value = "alpha"
 		model.addScopes = nil
 		model.addMeta.Buffer = ""
 
@@ -151,8 +151,8 @@ func TestEntitiesSaveAddValidationAndDefaults(t *testing.T) {
 		})
 
 		model := NewEntitiesModel(client)
-		model.addFields[addFieldName].value = "Alpha"
-		model.addFields[addFieldType].value = "person"
+		model.addFields[addFieldName].Value = "Alpha"
+		model.addFields[addFieldType].Value = "person"
 
 		next, cmd := model.saveAdd()
 		require.NotNil(t, cmd)
@@ -13,8 +13,8 @@ func TestEntitiesAddViewRendersTagsScopesAndMetadataPreview(t *testing.T) {
 	model.width = 80
 	model.view = entitiesViewAdd
 
-	model.addFields[addFieldName].value = "Alpha"
-	model.addFields[addFieldType].value = "person"
+	model.addFields[addFieldName].Value = "Alpha"
+	model.addFields[addFieldType].Value = "person"
 	model.addStatusIdx = statusIndex(entityStatusOptions, "active")
 	model.addTags = []string{"demo"}
 	model.addScopes = []string{"public", "private"}
@@ -0,0 +1,378 @@
+package ui
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+type bulkEditField int
+
+const (
+	bulkEditStatus bulkEditField = iota
+	bulkEditType
+	bulkEditMetadata
+	bulkEditFieldCount
+)
+
+// bulkEditFieldLabels names each field in the form.
+var bulkEditFieldLabels = [bulkEditFieldCount]string{"Status", "Type", "Metadata"}
+
+const (
+	bulkEditFocusField = iota
+	bulkEditFocusValue
+	bulkEditFocusMetaValue
+)
+
+// bulkEditForm holds the bulk edit form and its dry-run preview.
+type bulkEditForm struct {
+	open      bool
+	field     bulkEditField
+	focus     int
+	statusIdx int
+	typeIdx   int
+	metaKey   components.TextInput
+	metaValue components.TextInput
+	errText   string
+	preview   []bulkEditChange
+}
+
+// bulkEditChange is one row of the dry-run diff.
+type bulkEditChange struct {
+	id     string
+	name   string
+	before string
+	after  string
+}
+
+// changed reports whether committing would modify the entity.
+func (c bulkEditChange) changed() bool {
+	return c.before != c.after
+}
+
+// openBulkEdit shows the bulk edit form for the current selection.
+func (m *EntitiesModel) openBulkEdit() {
+	m.bulkEdit = bulkEditForm{open: true}
+}
+
+// bulkEditTypeOptions lists entity types from the loaded items and schemas.
+func (m EntitiesModel) bulkEditTypeOptions() []string {
+	seen := map[string]bool{}
+	var out []string
+	add := func(name string) {
+		name = strings.TrimSpace(name)
+		if name != "" && !seen[name] {
+			seen[name] = true
+			out = append(out, name)
+		}
+	}
+	for _, item := range m.allItems {
+		add(item.Type)
+	}
+	for name := range m.typeSchemas {
+		add(name)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// handleBulkEditKeys drives the form and the preview.
+func (m EntitiesModel) handleBulkEditKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd) {
+	form := &m.bulkEdit
+	if form.preview != nil {
+		switch {
+		case isBack(msg):
+			form.preview = nil
+		case isEnter(msg), isKey(msg, "ctrl+s"):
+			cmd := m.commitBulkEdit()
+			m.bulkEdit = bulkEditForm{}
+			return m, cmd
+		}
+		return m, nil
+	}
+
+	switch {
+	case isBack(msg):
+		m.bulkEdit = bulkEditForm{}
+		return m, nil
+	case isEnter(msg):
+		preview, err := m.bulkEditPreview()
+		if err != nil {
+			form.errText = err.Error()
+			return m, nil
+		}
+		form.errText = ""
+		form.preview = preview
+		return m, nil
+	case isDown(msg):
+		if form.focus < m.bulkEditLastFocus() {
+			form.focus++
+		}
+		return m, nil
+	case isUp(msg):
+		if form.focus > bulkEditFocusField {
+			form.focus--
+		}
+		return m, nil
+	}
+
+	if buf := form.input(); buf != nil {
+		if isKey(msg, "cmd+backspace", "cmd+delete", "ctrl+u") {
+			buf.Reset()
+		} else {
+			buf.HandleKey(msg)
+		}
+		return m, nil
+	}
+	if isKey(msg, "left", "right") {
+		step := 1
+		if isKey(msg, "left") {
+			step = -1
+		}
+		m.cycleBulkEdit(step)
+	}
+	return m, nil
+}
+
+// input returns the focused metadata text input, or nil when a selector row
+// has focus.
+func (form *bulkEditForm) input() *components.TextInput {
+	if form.field != bulkEditMetadata {
+		return nil
+	}
+	switch form.focus {
+	case bulkEditFocusValue:
+		return &form.metaKey
+	case bulkEditFocusMetaValue:
+		return &form.metaValue
+	}
+	return nil
+}
+
+// bulkEditLastFocus returns the last focusable row for the chosen field.
+func (m EntitiesModel) bulkEditLastFocus() int {
+	if m.bulkEdit.field == bulkEditMetadata {
+		return bulkEditFocusMetaValue
+	}
+	return bulkEditFocusValue
+}
+
+// cycleBulkEdit moves the field or option under focus by step.
+func (m *EntitiesModel) cycleBulkEdit(step int) {
+	form := &m.bulkEdit
+	switch {
+	case form.focus == bulkEditFocusField:
+		form.field = (form.field + bulkEditField(step) + bulkEditFieldCount) % bulkEditFieldCount
+		form.errText = ""
+		if form.focus > m.bulkEditLastFocus() {
+			form.focus = m.bulkEditLastFocus()
+		}
+	case form.field == bulkEditStatus:
+		n := len(entityStatusOptions)
+		form.statusIdx = (form.statusIdx + step + n) % n
+	case form.field == bulkEditType:
+		if n := len(m.bulkEditTypeOptions()); n > 0 {
+			form.typeIdx = (form.typeIdx + step + n) % n
+		}
+	}
+}
+
+// bulkEditValue returns the chosen value for status or type.
+func (m EntitiesModel) bulkEditValue() string {
+	switch m.bulkEdit.field {
+	case bulkEditStatus:
+		return entityStatusOptions[m.bulkEdit.statusIdx]
+	case bulkEditType:
+		options := m.bulkEditTypeOptions()
+		if m.bulkEdit.typeIdx < len(options) {
+			return options[m.bulkEdit.typeIdx]
+		}
+	}
+	return ""
+}
+
+// bulkEditPreview computes the dry-run diff for every selected entity.
+func (m EntitiesModel) bulkEditPreview() ([]bulkEditChange, error) {
+	form := m.bulkEdit
+	value := m.bulkEditValue()
+	var metaValue any
+	key := strings.TrimSpace(form.metaKey.Value)
+	switch form.field {
+	case bulkEditType:
+		if value == "" {
+			return nil, fmt.Errorf("no entity types loaded")
+		}
+	case bulkEditMetadata:
+		if key == "" {
+			return nil, fmt.Errorf("metadata key is required")
+		}
+		parsed, err := parseMetadataValue(form.metaValue.Value, 1)
+		if err != nil {
+			return nil, err
+		}
+		metaValue = parsed
+		value = formatMetadataValue(parsed)
+	}
+
+	byID := make(map[string]api.Entity, len(m.allItems)+len(m.bulkRecords))
+	for id, item := range m.bulkRecords {
+		byID[id] = item
+	}
+	for _, item := range m.allItems {
+		byID[item.ID] = item
+	}
+	ids := m.bulkSelectedIDs()
+	sort.Strings(ids)
+	changes := make([]bulkEditChange, 0, len(ids))
+	for _, id := range ids {
+		entity, ok := byID[id]
+		change := bulkEditChange{id: id, name: shortID(id), after: value}
+		if ok {
+			change.name = entity.Name
+			switch form.field {
+			case bulkEditStatus:
+				change.before = entity.Status
+			case bulkEditType:
+				change.before = entity.Type
+			case bulkEditMetadata:
+				if current, found := lookupMetadataPath(entity.Metadata, key); found {
+					change.before = formatMetadataValue(current)
+					if reflect.DeepEqual(current, metaValue) {
+						change.after = change.before
+					}
+				}
+			}
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// commitBulkEdit queues one update per entity that the preview would change.
+func (m EntitiesModel) commitBulkEdit() tea.Cmd {
+	form := m.bulkEdit
+	input := api.UpdateEntityInput{}
+	value := m.bulkEditValue()
+	switch form.field {
+	case bulkEditStatus:
+		input.Status = &value
+	case bulkEditType:
+		input.Type = &value
+	case bulkEditMetadata:
+		parsed, err := parseMetadataValue(form.metaValue.Value, 1)
+		if err != nil {
+			return func() tea.Msg { return errMsg{err} }
+		}
+		meta := map[string]any{}
+		if err := setMetadataPath(meta, strings.TrimSpace(form.metaKey.Value), parsed, 1); err != nil {
+			return func() tea.Msg { return errMsg{err} }
+		}
+		input.Metadata = meta
+	}
+
+	client := m.client
+	var steps []operationStep
+	for _, change := range form.preview {
+		if !change.changed() {
+			continue
+		}
+		id := change.id
+		steps = append(steps, operationStep{
+			label: change.name,
+			run: func() ([]string, error) {
+				_, err := client.UpdateEntity(id, input)
+				return nil, err
+			},
+		})
+	}
+	label := fmt.Sprintf("Bulk %s on %d entities", strings.ToLower(bulkEditFieldLabels[form.field]), len(steps))
+	return queueOperation(label, tabEntities, steps, entityBulkUpdatedMsg{})
+}
+
+// renderBulkEdit renders the bulk edit form or its preview.
+func (m EntitiesModel) renderBulkEdit() string {
+	form := m.bulkEdit
+	if form.preview != nil {
+		return m.renderBulkEditPreview()
+	}
+
+	row := func(focus int, label, value string) string {
+		style := MutedStyle
+		if form.focus == focus {
+			style = SelectedStyle
+		}
+		return style.Render("  "+label+":") + "\n" + NormalStyle.Render("  "+value)
+	}
+	input := func(focus int, label string, in components.TextInput) string {
+		if form.focus == focus {
+			return row(focus, label, renderInput(in, m.width))
+		}
+		return row(focus, label, in.Value)
+	}
+
+	lines := []string{
+		MetaKeyStyle.Render(fmt.Sprintf("Bulk Edit %d Entities", m.bulkCount())),
+		"",
+		row(bulkEditFocusField, "Field", "← "+bulkEditFieldLabels[form.field]+" →"),
+		"",
+	}
+	switch form.field {
+	case bulkEditStatus:
+		lines = append(lines, row(bulkEditFocusValue, "Set Status", "← "+m.bulkEditValue()+" →"))
+	case bulkEditType:
+		value := m.bulkEditValue()
+		if value == "" {
+			value = "(no types loaded)"
+		}
+		lines = append(lines, row(bulkEditFocusValue, "Set Type", "← "+value+" →"))
+	case bulkEditMetadata:
+		lines = append(lines,
+			input(bulkEditFocusValue, "Key (dotted path)", form.metaKey),
+			"",
+			input(bulkEditFocusMetaValue, "Value", form.metaValue),
+		)
+	}
+	if form.errText != "" {
+		lines = append(lines, "", ErrorStyle.Render(form.errText))
+	}
+	lines = append(lines, "", MutedStyle.Render("enter to preview changes"))
+	return components.Box(strings.Join(lines, "\n"), m.width)
+}
+
+// renderBulkEditPreview renders the dry-run diff before commit.
+func (m EntitiesModel) renderBulkEditPreview() string {
+	form := m.bulkEdit
+	contentWidth := components.BoxContentWidth(m.width)
+	if contentWidth <= 0 {
+		contentWidth = 72
+	}
+	field := bulkEditFieldLabels[form.field]
+	if form.field == bulkEditMetadata {
+		field += " " + strings.TrimSpace(form.metaKey.Value)
+	}
+	lines := []string{MetaKeyStyle.Render("Preview: " + components.SanitizeOneLine(field)), ""}
+	changed := 0
+	for _, change := range form.preview {
+		before := change.before
+		if before == "" {
+			before = "-"
+		}
+		line := fmt.Sprintf("%s  %s → %s", components.SanitizeOneLine(change.name), components.SanitizeOneLine(before), components.SanitizeOneLine(change.after))
+		line = components.ClampTextWidthEllipsis(line, contentWidth-2)
+		if change.changed() {
+			changed++
+			lines = append(lines, "  "+NormalStyle.Render(line))
+		} else {
+			lines = append(lines, "  "+MutedStyle.Render(line+" (unchanged)"))
+		}
+	}
+	summary := fmt.Sprintf("%d will change, %d unchanged. Nothing is saved until you confirm.", changed, len(form.preview)-changed)
+	lines = append(lines, "", MutedStyle.Render(summary))
+	return components.Box(strings.Join(lines, "\n"), m.width)
+}
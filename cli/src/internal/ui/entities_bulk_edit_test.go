@@ -0,0 +1,112 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func bulkEditModel(t *testing.T, handler http.HandlerFunc) EntitiesModel {
+	_, client := testClient(t, handler)
+	m := NewEntitiesModel(client)
+	m.width = 100
+	m, _ = m.Update(entitiesLoadedMsg{items: []api.Entity{
+		{ID: "ent-1", Name: "Alpha", Type: "project", Status: "active", Metadata: api.JSONMap{"owner": "ana"}},
+		{ID: "ent-2", Name: "Beta", Type: "person", Status: "inactive"},
+		{ID: "ent-3", Name: "Gamma", Type: "project", Status: "active"},
+	}})
+	m.bulkSelected = map[string]bool{"ent-1": true, "ent-2": true}
+	return m
+}
+
+func bulkEditKey(m EntitiesModel, keys ...tea.KeyMsg) EntitiesModel {
+	for _, key := range keys {
+		m, _ = m.handleListKeys(key)
+	}
+	return m
+}
+
+func TestEntitiesBulkEditStatusPreviewsThenQueuesChangedOnly(t *testing.T) {
+	var mu sync.Mutex
+	patched := map[string]map[string]any{}
+	m := bulkEditModel(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		mu.Lock()
+		patched[r.URL.Path] = body
+		mu.Unlock()
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "x"}}))
+	})
+
+	m = bulkEditKey(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'E'}})
+	require.True(t, m.bulkEdit.open)
+	assert.Contains(t, components.SanitizeText(m.View()), "Bulk Edit 2 Entities")
+
+	m = bulkEditKey(m,
+		tea.KeyMsg{Type: tea.KeyDown},
+		tea.KeyMsg{Type: tea.KeyRight},
+		tea.KeyMsg{Type: tea.KeyEnter},
+	)
+	require.Len(t, m.bulkEdit.preview, 2)
+	view := components.SanitizeText(m.View())
+	assert.Contains(t, view, "Alpha  active → inactive")
+	assert.Contains(t, view, "Beta  inactive → inactive (unchanged)")
+	assert.Contains(t, view, "1 will change, 1 unchanged")
+	assert.Empty(t, patched, "preview is a dry run")
+
+	var cmd tea.Cmd
+	m, cmd = m.handleListKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.False(t, m.bulkEdit.open)
+	queued := runQueued(t, cmd)
+	assert.Len(t, queued.steps, 1)
+	assert.Equal(t, map[string]any{"status": "inactive"}, patched["/api/entities/ent-1"])
+}
+
+func TestEntitiesBulkEditTypeAndMetadata(t *testing.T) {
+	var patched map[string]any
+	m := bulkEditModel(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&patched))
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "x"}}))
+	})
+	m.bulkSelected = map[string]bool{"ent-2": true}
+
+	m = bulkEditKey(m,
+		tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'E'}},
+		tea.KeyMsg{Type: tea.KeyRight},
+		tea.KeyMsg{Type: tea.KeyDown},
+		tea.KeyMsg{Type: tea.KeyRight},
+		tea.KeyMsg{Type: tea.KeyEnter},
+	)
+	assert.Contains(t, components.SanitizeText(m.View()), "Beta  person → project")
+	m, cmd := m.handleListKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	runQueued(t, cmd)
+	assert.Equal(t, map[string]any{"type": "project"}, patched)
+
+	patched = nil
+	m.bulkSelected = map[string]bool{"ent-1": true}
+	m = bulkEditKey(m,
+		tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'E'}},
+		tea.KeyMsg{Type: tea.KeyLeft},
+		tea.KeyMsg{Type: tea.KeyEnter},
+	)
+	assert.Contains(t, m.bulkEdit.errText, "key is required")
+
+	m = bulkEditKey(m, tea.KeyMsg{Type: tea.KeyDown})
+	m = typeText(m, "profile.team")
+	m = bulkEditKey(m, tea.KeyMsg{Type: tea.KeyDown})
+	m = typeText(m, "core")
+	m = bulkEditKey(m, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Contains(t, components.SanitizeText(m.View()), "Alpha  - → core")
+	m, cmd = m.handleListKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.False(t, m.bulkEdit.open)
+	runQueued(t, cmd)
+	assert.Equal(t, map[string]any{"metadata": map[string]any{"profile": map[string]any{"team": "core"}}}, patched)
+}
@@ -0,0 +1,246 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// bulkPreviewRows caps how many entities the tag/scope preview lists.
+const bulkPreviewRows = 12
+
+// bulkSetPreview is the dry run of a bulk tag or scope update.
+type bulkSetPreview struct {
+	target  bulkTarget
+	spec    bulkInput
+	changes []bulkSetChange
+}
+
+// bulkSetChange is one selected entity's set before and after the update.
+// Entities that are not loaded have no known before or after.
+type bulkSetChange struct {
+	id     string
+	name   string
+	known  bool
+	before []string
+	after  []string
+}
+
+// changed reports whether the update would modify the entity's set.
+func (c bulkSetChange) changed() bool {
+	if !c.known {
+		return true
+	}
+	before, after := slices.Clone(c.before), slices.Clone(c.after)
+	sort.Strings(before)
+	sort.Strings(after)
+	return !slices.Equal(before, after)
+}
+
+// applyBulkSetOp returns current after an add, remove, or set of values.
+func applyBulkSetOp(op string, current, values []string) []string {
+	switch op {
+	case "set":
+		return slices.Clone(values)
+	case "remove":
+		out := make([]string, 0, len(current))
+		for _, v := range current {
+			if !slices.Contains(values, v) {
+				out = append(out, v)
+			}
+		}
+		return out
+	}
+	out := slices.Clone(current)
+	for _, v := range values {
+		if !slices.Contains(out, v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// bulkSetValues normalizes the prompt values for target.
+func bulkSetValues(target bulkTarget, values []string) []string {
+	if target == bulkTargetScopes {
+		return normalizeBulkScopes(values)
+	}
+	return normalizeBulkTags(values)
+}
+
+// bulkSetNoun names the field a bulk update targets.
+func bulkSetNoun(target bulkTarget) string {
+	switch target {
+	case bulkTargetScopes:
+		return "scopes"
+	case bulkTargetStatus:
+		return "status"
+	}
+	return "tags"
+}
+
+// bulkSetPreview computes the dry run of spec for every selected entity.
+func (m EntitiesModel) bulkSetPreview(spec bulkInput) (*bulkSetPreview, error) {
+	values := bulkSetValues(m.bulkTarget, spec.values)
+	if spec.op != "set" && len(values) == 0 {
+		return nil, fmt.Errorf("no valid %s provided", bulkSetNoun(m.bulkTarget))
+	}
+	byID := make(map[string]api.Entity, len(m.allItems)+len(m.bulkRecords))
+	for id, item := range m.bulkRecords {
+		byID[id] = item
+	}
+	for _, item := range m.allItems {
+		byID[item.ID] = item
+	}
+	for _, item := range m.items {
+		byID[item.ID] = item
+	}
+	ids := m.bulkSelectedIDs()
+	sort.Strings(ids)
+	preview := &bulkSetPreview{target: m.bulkTarget, spec: bulkInput{op: spec.op, values: values}}
+	for _, id := range ids {
+		change := bulkSetChange{id: id, name: shortID(id)}
+		if entity, ok := byID[id]; ok {
+			current := entity.Tags
+			if m.bulkTarget == bulkTargetScopes {
+				current = m.scopeNamesFromIDs(entity.PrivacyScopeIDs)
+			}
+			change.name = entity.Name
+			change.known = true
+			change.before = bulkSetValues(m.bulkTarget, current)
+			change.after = applyBulkSetOp(spec.op, change.before, values)
+		}
+		preview.changes = append(preview.changes, change)
+	}
+	return preview, nil
+}
+
+// handleBulkPreviewKeys confirms or cancels a previewed bulk update. Esc goes
+// back to the prompt with the input kept.
+func (m EntitiesModel) handleBulkPreviewKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd) {
+	switch {
+	case isBack(msg):
+		m.bulkPreview = nil
+	case isEnter(msg), isKey(msg, "ctrl+s"):
+		preview := m.bulkPreview
+		m.bulkPreview = nil
+		m.bulkPrompt = ""
+		m.bulkBuf.Reset()
+		if preview.changeCount() == 0 {
+			return m, nil
+		}
+		m.bulkRunning = true
+		if preview.target == bulkTargetScopes {
+			return m, m.bulkUpdateScopes(preview.spec)
+		}
+		return m, m.bulkUpdateTags(preview.spec)
+	}
+	return m, nil
+}
+
+// changeCount returns how many selected entities the update would modify.
+func (p *bulkSetPreview) changeCount() int {
+	n := 0
+	for _, change := range p.changes {
+		if change.changed() {
+			n++
+		}
+	}
+	return n
+}
+
+// summary aggregates the preview per value, such as "adds 'urgent' to 14,
+// already present on 3". Entities that are not loaded are counted apart.
+func (p *bulkSetPreview) summary() []string {
+	var known []bulkSetChange
+	for _, change := range p.changes {
+		if change.known {
+			known = append(known, change)
+		}
+	}
+	var lines []string
+	switch p.spec.op {
+	case "set":
+		match := 0
+		for _, change := range known {
+			if !change.changed() {
+				match++
+			}
+		}
+		values := "(none)"
+		if len(p.spec.values) > 0 {
+			values = strings.Join(p.spec.values, ", ")
+		}
+		lines = append(lines, fmt.Sprintf("sets %s to %s on %d, already matching on %d",
+			bulkSetNoun(p.target), components.SanitizeOneLine(values), len(known)-match, match))
+	default:
+		for _, value := range p.spec.values {
+			has := 0
+			for _, change := range known {
+				if slices.Contains(change.before, value) {
+					has++
+				}
+			}
+			quoted := "'" + components.SanitizeOneLine(value) + "'"
+			if p.spec.op == "remove" {
+				lines = append(lines, fmt.Sprintf("removes %s from %d, absent on %d", quoted, has, len(known)-has))
+			} else {
+				lines = append(lines, fmt.Sprintf("adds %s to %d, already present on %d", quoted, len(known)-has, has))
+			}
+		}
+	}
+	if unknown := len(p.changes) - len(known); unknown > 0 {
+		lines = append(lines, fmt.Sprintf("%d selected entities are not loaded and are sent as is", unknown))
+	}
+	return lines
+}
+
+// renderBulkSetPreview renders the dry run before a bulk tag or scope update.
+func (m EntitiesModel) renderBulkSetPreview() string {
+	p := m.bulkPreview
+	contentWidth := components.BoxContentWidth(m.width)
+	if contentWidth <= 0 {
+		contentWidth = 72
+	}
+	lines := []string{MetaKeyStyle.Render(fmt.Sprintf("Preview: %s %s on %d entities", p.spec.op, bulkSetNoun(p.target), len(p.changes))), ""}
+	for _, line := range p.summary() {
+		lines = append(lines, "  "+NormalStyle.Render(components.ClampTextWidthEllipsis(line, contentWidth-2)))
+	}
+	lines = append(lines, "")
+	for i, change := range p.changes {
+		if i == bulkPreviewRows {
+			lines = append(lines, "  "+MutedStyle.Render(fmt.Sprintf("… and %d more", len(p.changes)-i)))
+			break
+		}
+		name := components.SanitizeOneLine(change.name)
+		if !change.known {
+			lines = append(lines, "  "+MutedStyle.Render(components.ClampTextWidthEllipsis(name+"  (not loaded)", contentWidth-2)))
+			continue
+		}
+		line := fmt.Sprintf("%s  %s → %s", name, bulkSetLabel(change.before), bulkSetLabel(change.after))
+		line = components.ClampTextWidthEllipsis(line, contentWidth-2)
+		if change.changed() {
+			lines = append(lines, "  "+NormalStyle.Render(line))
+		} else {
+			lines = append(lines, "  "+MutedStyle.Render(line+" (unchanged)"))
+		}
+	}
+	changed := p.changeCount()
+	summary := fmt.Sprintf("%d will change, %d unchanged. Nothing is saved until you confirm.", changed, len(p.changes)-changed)
+	lines = append(lines, "", MutedStyle.Render(summary))
+	return components.Box(strings.Join(lines, "\n"), m.width)
+}
+
+// bulkSetLabel renders a tag or scope set for the preview.
+func bulkSetLabel(values []string) string {
+	if len(values) == 0 {
+		return "-"
+	}
+	return components.SanitizeOneLine(strings.Join(values, ", "))
+}
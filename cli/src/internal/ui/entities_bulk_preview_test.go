@@ -0,0 +1,94 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func bulkPreviewTestModel(target bulkTarget, input string) EntitiesModel {
+	model := NewEntitiesModel(nil)
+	model.width = 120
+	model.scopeNames = map[string]string{"s1": "public", "s2": "private"}
+	model.items = []api.Entity{
+		{ID: "ent-1", Name: "Atlas", Tags: []string{"urgent"}, PrivacyScopeIDs: []string{"s1"}},
+		{ID: "ent-2", Name: "Borealis", Tags: []string{"ops"}},
+		{ID: "ent-3", Name: "Comet"},
+	}
+	model.allItems = model.items
+	model.bulkSelected = map[string]bool{"ent-1": true, "ent-2": true, "ent-3": true, "ent-9": true}
+	model.bulkPrompt = "bulk"
+	model.bulkTarget = target
+	model.bulkBuf.Value = input
+	return model
+}
+
+func TestApplyBulkSetOp(t *testing.T) {
+	current := []string{"a", "b"}
+	assert.Equal(t, []string{"a", "b", "c"}, applyBulkSetOp("add", current, []string{"b", "c"}))
+	assert.Equal(t, []string{"b"}, applyBulkSetOp("remove", current, []string{"a", "z"}))
+	assert.Equal(t, []string{"z"}, applyBulkSetOp("set", current, []string{"z"}))
+	assert.Equal(t, []string{"a", "b"}, current)
+
+	assert.False(t, bulkSetChange{known: true, before: []string{"a", "b"}, after: []string{"b", "a"}}.changed())
+	assert.True(t, bulkSetChange{}.changed())
+}
+
+func TestBulkTagsPreviewSummarizesBeforeCommit(t *testing.T) {
+	model := bulkPreviewTestModel(bulkTargetTags, "add:Urgent")
+
+	model, cmd := model.handleListKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	require.Nil(t, cmd)
+	require.NotNil(t, model.bulkPreview)
+	assert.False(t, model.bulkRunning)
+	assert.Equal(t, []string{"adds 'urgent' to 2, already present on 1", "1 selected entities are not loaded and are sent as is"}, model.bulkPreview.summary())
+
+	view := components.SanitizeText(model.View())
+	assert.Contains(t, view, "Preview: add tags on 4 entities")
+	assert.Contains(t, view, "Atlas  urgent → urgent (unchanged)")
+	assert.Contains(t, view, "Borealis  ops → ops, urgent")
+	assert.Contains(t, view, "Comet  - → urgent")
+	assert.Contains(t, view, "(not loaded)")
+	assert.Contains(t, view, "3 will change, 1 unchanged.")
+
+	model, _ = model.handleListKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Nil(t, model.bulkPreview)
+	assert.Equal(t, "add:Urgent", model.bulkBuf.Value)
+
+	model, _ = model.handleListKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	model, cmd = model.handleListKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	assert.True(t, model.bulkRunning)
+	assert.Nil(t, model.bulkPreview)
+	assert.Empty(t, model.bulkPrompt)
+}
+
+func TestBulkScopesPreviewRemoveAndSet(t *testing.T) {
+	model := bulkPreviewTestModel(bulkTargetScopes, "remove:public")
+	model, _ = model.handleListKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, model.bulkPreview)
+	assert.Equal(t, "removes 'public' from 1, absent on 2", model.bulkPreview.summary()[0])
+
+	model = bulkPreviewTestModel(bulkTargetScopes, "set:public")
+	delete(model.bulkSelected, "ent-9")
+	model, _ = model.handleListKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, model.bulkPreview)
+	assert.Equal(t, []string{"sets scopes to public on 2, already matching on 1"}, model.bulkPreview.summary())
+}
+
+func TestBulkPreviewWithNothingToChangeSkipsUpdate(t *testing.T) {
+	model := bulkPreviewTestModel(bulkTargetTags, "remove:missing")
+	delete(model.bulkSelected, "ent-9")
+	model, _ = model.handleListKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, model.bulkPreview)
+
+	model, cmd := model.handleListKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Nil(t, cmd)
+	assert.False(t, model.bulkRunning)
+	assert.Empty(t, model.bulkPrompt)
+}
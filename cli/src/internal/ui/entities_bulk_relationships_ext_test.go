@@ -71,30 +71,30 @@ func TestNormalizeBulkScopesExtended(t *testing.T) {
 func TestEntitiesHandleBulkPromptKeysBranchMatrix(t *testing.T) {
 	model := NewEntitiesModel(nil)
 	model.bulkPrompt = "bulk tags"
-	model.bulkBuf = "abc"
+	model.bulkBuf.Value = "abc"
 
 	updated, cmd := model.handleBulkPromptKeys(tea.KeyMsg{Type: tea.KeyEsc})
 	require.Nil(t, cmd)
 	assert.Equal(t, "", updated.bulkPrompt)
-	assert.Equal(t, "", updated.bulkBuf)
+	assert.Equal(t, "", updated.bulkBuf.Value)
 
-	updated.bulkBuf = "ab"
+	updated.bulkBuf.Value = "ab"
 	updated, cmd = updated.handleBulkPromptKeys(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "a", updated.bulkBuf)
+	assert.Equal(t, "a", updated.bulkBuf.Value)
 
-	updated.bulkBuf = "abc"
+	updated.bulkBuf.Value = "abc"
 	updated, cmd = updated.handleBulkPromptKeys(tea.KeyMsg{Type: tea.KeyCtrlU})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.bulkBuf)
+	assert.Equal(t, "", updated.bulkBuf.Value)
 
 	updated, cmd = updated.handleBulkPromptKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
 	require.Nil(t, cmd)
-	assert.Equal(t, "x", updated.bulkBuf)
+	assert.Equal(t, "x", updated.bulkBuf.Value)
 	updated, _ = updated.handleBulkPromptKeys(tea.KeyMsg{Type: tea.KeySpace})
-	assert.Equal(t, "x ", updated.bulkBuf)
+	assert.Equal(t, "x ", updated.bulkBuf.Value)
 
-	updated.bulkBuf = "   "
+	updated.bulkBuf.Value = "   "
 	_, cmd = updated.handleBulkPromptKeys(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
 	msg := cmd()
@@ -102,7 +102,7 @@ func TestEntitiesHandleBulkPromptKeysBranchMatrix(t *testing.T) {
 	require.True(t, ok)
 	require.Error(t, em.err)
 
-	updated.bulkBuf = "add:"
+	updated.bulkBuf.Value = "add:"
 	_, cmd = updated.handleBulkPromptKeys(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
 	msg = cmd()
@@ -116,19 +116,25 @@ func TestEntitiesHandleBulkPromptKeysRoutesToTagsAndScopes(t *testing.T) {
 	model.items = []api.Entity{{ID: "ent-1"}}
 	model.bulkSelected = map[string]bool{"ent-1": true}
 	model.bulkPrompt = "bulk tags"
-	model.bulkBuf = "set:alpha,beta"
+	model.bulkBuf.Value = "set:alpha,beta"
 	model.bulkTarget = bulkTargetTags
 
 	updated, cmd := model.handleBulkPromptKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	require.Nil(t, cmd)
+	require.NotNil(t, updated.bulkPreview)
+	updated, cmd = updated.handleBulkPreviewKeys(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
 	assert.Equal(t, "", updated.bulkPrompt)
-	assert.Equal(t, "", updated.bulkBuf)
+	assert.Equal(t, "", updated.bulkBuf.Value)
 	assert.True(t, updated.bulkRunning)
 
 	model.bulkPrompt = "bulk scopes"
-	model.bulkBuf = "set:public,private"
+	model.bulkBuf.Value = "set:public,private"
 	model.bulkTarget = bulkTargetScopes
-	updated, cmd = model.handleBulkPromptKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	updated, _ = model.handleBulkPromptKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, updated.bulkPreview)
+	assert.Equal(t, bulkTargetScopes, updated.bulkPreview.target)
+	updated, cmd = updated.handleBulkPreviewKeys(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
 	assert.True(t, updated.bulkRunning)
 }
@@ -0,0 +1,39 @@
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/bundle"
+)
+
+// copyEntityBundleClipboard copies context bundles. Tests replace it.
+var copyEntityBundleClipboard = copyTextToClipboard
+
+type entityBundleCopiedMsg struct {
+	tokens int
+}
+
+// copyEntityBundle builds the default context bundle for the open entity and
+// copies it to the clipboard as markdown.
+func (m EntitiesModel) copyEntityBundle() tea.Cmd {
+	if m.detail == nil || m.client == nil {
+		return nil
+	}
+	client := m.client
+	id := m.detail.ID
+	return func() tea.Msg {
+		opts := bundle.DefaultOptions()
+		b, err := bundle.Build(client, id, opts)
+		if err != nil {
+			return errMsg{err}
+		}
+		out, err := b.Render(bundle.FormatMarkdown, opts.MaxTokens)
+		if err != nil {
+			return errMsg{err}
+		}
+		if err := copyEntityBundleClipboard(out); err != nil {
+			return errMsg{err}
+		}
+		return entityBundleCopiedMsg{tokens: bundle.EstimateTokens(out)}
+	}
+}
@@ -0,0 +1,43 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+func TestEntitiesDetailCopiesContextBundle(t *testing.T) {
+	_, client := testEntitiesClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var data any = []any{}
+		if r.URL.Path == "/api/entities/ent-1" {
+			data = map[string]any{"id": "ent-1", "name": "Alpha", "type": "project"}
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": data}))
+	})
+
+	prevCopy := copyEntityBundleClipboard
+	t.Cleanup(func() { copyEntityBundleClipboard = prevCopy })
+	copied := ""
+	copyEntityBundleClipboard = func(text string) error {
+		copied = text
+		return nil
+	}
+
+	model := NewEntitiesModel(client)
+	model.view = entitiesViewDetail
+	model.detail = &api.Entity{ID: "ent-1", Name: "Alpha"}
+
+	_, cmd := model.handleDetailKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	require.NotNil(t, cmd)
+	msg, ok := cmd().(entityBundleCopiedMsg)
+	require.True(t, ok)
+	assert.Greater(t, msg.tokens, 0)
+	assert.True(t, strings.HasPrefix(copied, "# Alpha"))
+}
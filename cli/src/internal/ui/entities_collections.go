@@ -0,0 +1,185 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// collectionPickRows caps how many collections the picker lists.
+const collectionPickRows = 10
+
+// collectionPicker chooses the collection bulk-selected entities go into.
+// Typing filters by name; a name with no match creates that collection.
+type collectionPicker struct {
+	items   []api.Collection
+	loading bool
+	query   components.TextInput
+	cursor  int
+}
+
+type collectionPickLoadedMsg struct {
+	items []api.Collection
+}
+
+// entityCollectionAddedMsg reports entities added to a collection.
+type entityCollectionAddedMsg struct {
+	notice string
+}
+
+// matches returns the collections whose name contains the query.
+func (p *collectionPicker) matches() []api.Collection {
+	query := strings.ToLower(strings.TrimSpace(p.query.Value))
+	if query == "" {
+		return p.items
+	}
+	var out []api.Collection
+	for _, item := range p.items {
+		if strings.Contains(strings.ToLower(item.Name), query) {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// openCollectionPicker starts adding the bulk selection to a collection.
+func (m *EntitiesModel) openCollectionPicker() tea.Cmd {
+	m.collectionPick = &collectionPicker{loading: true}
+	client := m.client
+	return func() tea.Msg {
+		items, err := client.ListCollections()
+		if err != nil {
+			return errMsg{fmt.Errorf("load collections: %w", err)}
+		}
+		return collectionPickLoadedMsg{items: items}
+	}
+}
+
+// handleCollectionPickKeys moves through, filters, and picks a collection.
+func (m EntitiesModel) handleCollectionPickKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd) {
+	p := m.collectionPick
+	switch {
+	case isBack(msg):
+		m.collectionPick = nil
+	case isDown(msg):
+		if n := min(len(p.matches()), collectionPickRows); n > 0 {
+			p.cursor = (p.cursor + 1) % n
+		}
+	case isUp(msg):
+		if n := min(len(p.matches()), collectionPickRows); n > 0 {
+			p.cursor = (p.cursor - 1 + n) % n
+		}
+	case isEnter(msg):
+		if p.loading {
+			return m, nil
+		}
+		ids := m.bulkSelectedIDs()
+		matches := p.matches()
+		name := strings.TrimSpace(p.query.Value)
+		if len(matches) == 0 && name == "" {
+			return m, nil
+		}
+		m.collectionPick = nil
+		m.bulkRunning = true
+		if len(matches) > 0 {
+			target := matches[min(p.cursor, len(matches)-1)]
+			return m, m.addToCollection(target.ID, target.Name, ids)
+		}
+		return m, m.addToNewCollection(name, ids)
+	case isKey(msg, "cmd+backspace", "cmd+delete", "ctrl+u"):
+		p.query.Reset()
+		p.cursor = 0
+	default:
+		if editChanged(&p.query, msg) {
+			p.cursor = 0
+		}
+	}
+	return m, nil
+}
+
+// addToCollection adds ids to an existing collection.
+func (m EntitiesModel) addToCollection(id, name string, ids []string) tea.Cmd {
+	client := m.client
+	return func() tea.Msg {
+		result, err := client.AddCollectionEntities(id, ids)
+		if err != nil {
+			return errMsg{fmt.Errorf("add to collection: %w", err)}
+		}
+		return entityCollectionAddedMsg{notice: collectionAddedNotice(name, result)}
+	}
+}
+
+// addToNewCollection creates a collection named name and adds ids to it.
+func (m EntitiesModel) addToNewCollection(name string, ids []string) tea.Cmd {
+	client := m.client
+	return func() tea.Msg {
+		collection, err := client.CreateCollection(api.CreateCollectionInput{Name: name})
+		if err != nil {
+			return errMsg{fmt.Errorf("create collection: %w", err)}
+		}
+		result, err := client.AddCollectionEntities(collection.ID, ids)
+		if err != nil {
+			return errMsg{fmt.Errorf("add to collection: %w", err)}
+		}
+		return entityCollectionAddedMsg{notice: collectionAddedNotice(collection.Name, result)}
+	}
+}
+
+// collectionAddedNotice summarizes an add, counting entities already present.
+func collectionAddedNotice(name string, result *api.CollectionEntitiesResult) string {
+	notice := fmt.Sprintf("Added %d entities to %q.", result.Added, name)
+	if already := result.Requested - result.Added; already > 0 {
+		notice += fmt.Sprintf(" %d were already in it.", already)
+	}
+	return notice
+}
+
+// clearCollectionFilter stops narrowing the list to a collection.
+func (m *EntitiesModel) clearCollectionFilter() tea.Cmd {
+	m.collection = nil
+	m.clearBulkSelection()
+	m.loading = true
+	return m.loadEntities(strings.TrimSpace(m.searchBuf.Value))
+}
+
+// renderCollectionPicker renders the collection picker.
+func (m EntitiesModel) renderCollectionPicker() string {
+	p := m.collectionPick
+	contentWidth := components.BoxContentWidth(m.width)
+	if contentWidth <= 0 {
+		contentWidth = 72
+	}
+	lines := []string{
+		MetaKeyStyle.Render(fmt.Sprintf("Add %d entities to a collection", m.bulkCount())),
+		"",
+		NormalStyle.Render("Name: " + p.query.View(AccentStyle, 0)),
+		"",
+	}
+	matches := p.matches()
+	switch {
+	case p.loading:
+		lines = append(lines, MutedStyle.Render("Loading collections..."))
+	case len(matches) == 0 && strings.TrimSpace(p.query.Value) != "":
+		lines = append(lines, NormalStyle.Render(fmt.Sprintf("enter creates %q", strings.TrimSpace(components.SanitizeOneLine(p.query.Value)))))
+	case len(matches) == 0:
+		lines = append(lines, MutedStyle.Render("No collections yet. Type a name to create one."))
+	}
+	for i, item := range matches {
+		if i == collectionPickRows {
+			lines = append(lines, MutedStyle.Render(fmt.Sprintf("… and %d more", len(matches)-i)))
+			break
+		}
+		line := components.ClampTextWidthEllipsis(
+			fmt.Sprintf("%s (%d)", components.SanitizeOneLine(item.Name), item.EntityCount), contentWidth-2)
+		if i == p.cursor {
+			lines = append(lines, SelectedStyle.Render("> "+line))
+			continue
+		}
+		lines = append(lines, "  "+NormalStyle.Render(line))
+	}
+	return components.TitledBox("Add to Collection", strings.Join(lines, "\n"), m.width)
+}
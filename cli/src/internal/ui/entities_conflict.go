@@ -0,0 +1,112 @@
+package ui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+type entityEditConflictMsg struct {
+	input  api.UpdateEntityInput
+	scopes []string
+	theirs api.Entity
+}
+
+// showEditConflict opens the merge dialog for a save that hit a newer record.
+func (m *EntitiesModel) showEditConflict(msg entityEditConflictMsg) {
+	m.editSaving = false
+	m.errText = ""
+	theirs := msg.theirs
+	m.conflictMine = msg.input
+	m.conflictScopes = msg.scopes
+	m.conflictTheirs = &theirs
+	m.editConflict = newEditConflict(m.entityConflictFields())
+	m.view = entitiesViewEditConflict
+}
+
+// entityConflictFields lines up base, mine, and theirs for each editable field.
+func (m EntitiesModel) entityConflictFields() []conflictField {
+	base := api.Entity{}
+	if m.editBase != nil {
+		base = *m.editBase
+	}
+	theirs := *m.conflictTheirs
+	mine := m.conflictMine
+
+	mineStatus := base.Status
+	if mine.Status != nil {
+		mineStatus = *mine.Status
+	}
+	mineTags := base.Tags
+	if mine.Tags != nil {
+		mineTags = *mine.Tags
+	}
+	baseScopes := strings.Join(m.scopeNamesFromIDs(base.PrivacyScopeIDs), ", ")
+	mineScopes := baseScopes
+	if m.conflictScopes != nil {
+		mineScopes = strings.Join(m.conflictScopes, ", ")
+	}
+
+	return []conflictField{
+		{key: "status", label: "Status", base: base.Status, mine: mineStatus, theirs: theirs.Status},
+		{key: "tags", label: "Tags", base: strings.Join(base.Tags, ", "), mine: strings.Join(mineTags, ", "), theirs: strings.Join(theirs.Tags, ", ")},
+		{key: "scopes", label: "Scopes", base: baseScopes, mine: mineScopes, theirs: strings.Join(m.scopeNamesFromIDs(theirs.PrivacyScopeIDs), ", ")},
+		{
+			key:    "metadata",
+			label:  "Metadata",
+			base:   conflictMetadata(base.Metadata),
+			mine:   conflictMetadata(mine.Metadata),
+			theirs: conflictMetadata(theirs.Metadata),
+		},
+	}
+}
+
+// mergedEntityEdit builds the update for the chosen sides, checked against
+// the live record's timestamp.
+func (m EntitiesModel) mergedEntityEdit() (api.UpdateEntityInput, []string) {
+	theirs := *m.conflictTheirs
+	input := m.conflictMine
+	scopes := m.conflictScopes
+	c := m.editConflict
+	if c.useTheirs("status") {
+		status := theirs.Status
+		input.Status = &status
+	}
+	if c.useTheirs("tags") {
+		tags := append([]string{}, theirs.Tags...)
+		input.Tags = &tags
+	}
+	if c.useTheirs("scopes") {
+		scopes = nil
+	}
+	if c.useTheirs("metadata") {
+		input.Metadata = nil
+	}
+	if !theirs.UpdatedAt.IsZero() {
+		seen := theirs.UpdatedAt
+		input.ExpectedUpdatedAt = &seen
+	}
+	return input, scopes
+}
+
+// handleEditConflictKeys handles keys in the merge dialog.
+func (m EntitiesModel) handleEditConflictKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd) {
+	if m.editSaving || m.editConflict == nil || m.conflictTheirs == nil {
+		return m, nil
+	}
+	switch m.editConflict.handleKey(msg) {
+	case conflictSave:
+		input, scopes := m.mergedEntityEdit()
+		// The merge becomes the new base if the record moves again.
+		theirs := *m.conflictTheirs
+		m.editBase = &theirs
+		m.editSaving = true
+		m.errText = ""
+		return m, m.submitEdit(m.conflictTheirs.ID, input, scopes)
+	case conflictCancel:
+		m.view = entitiesViewEdit
+	}
+	return m, nil
+}
@@ -0,0 +1,263 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+const (
+	// duplicateSimilarityThreshold is the minimum name similarity for a warning.
+	duplicateSimilarityThreshold = 0.75
+	// maxDuplicateMatches caps the matches offered in the duplicate picker.
+	maxDuplicateMatches = 8
+)
+
+type entityDuplicatesFoundMsg struct {
+	input   api.CreateEntityInput
+	matches []api.Entity
+}
+
+// createEntityChecked looks for similar entities before creating one. The
+// lookup is advisory: when it fails the entity is created anyway.
+func (m EntitiesModel) createEntityChecked(input api.CreateEntityInput) tea.Cmd {
+	return func() tea.Msg {
+		if matches := m.similarEntities(input.Name, input.Type); len(matches) > 0 {
+			return entityDuplicatesFoundMsg{input: input, matches: matches}
+		}
+		return m.createEntity(input)()
+	}
+}
+
+// createEntity creates an entity without a duplicate check.
+func (m EntitiesModel) createEntity(input api.CreateEntityInput) tea.Cmd {
+	return func() tea.Msg {
+		created, err := m.client.CreateEntity(input)
+		if err != nil {
+			return saveFailed(err)
+		}
+		return entityCreatedMsg{entity: *created}
+	}
+}
+
+// similarEntities searches by the most distinctive name token and keeps
+// same-type entities whose names are close to name.
+func (m EntitiesModel) similarEntities(name, typ string) []api.Entity {
+	token := duplicateSearchToken(name)
+	if token == "" {
+		return nil
+	}
+	candidates, err := m.client.QueryEntities(api.QueryParams{"search_text": token, "limit": "50"})
+	if err != nil {
+		return nil
+	}
+	return findSimilarEntities(name, typ, candidates)
+}
+
+// duplicateSearchToken picks the longest name token so typos elsewhere in the
+// name still reach the server-side search.
+func duplicateSearchToken(name string) string {
+	best := ""
+	for _, field := range strings.Fields(name) {
+		field = strings.TrimFunc(field, unicode.IsPunct)
+		if len([]rune(field)) > len([]rune(best)) {
+			best = field
+		}
+	}
+	return best
+}
+
+// findSimilarEntities ranks same-type candidates by name similarity.
+func findSimilarEntities(name, typ string, candidates []api.Entity) []api.Entity {
+	type scored struct {
+		entity api.Entity
+		score  float64
+	}
+	var matches []scored
+	seen := map[string]bool{}
+	for _, candidate := range candidates {
+		if seen[candidate.ID] || !strings.EqualFold(strings.TrimSpace(candidate.Type), strings.TrimSpace(typ)) {
+			continue
+		}
+		seen[candidate.ID] = true
+		if score := nameSimilarity(name, candidate.Name); score >= duplicateSimilarityThreshold {
+			matches = append(matches, scored{entity: candidate, score: score})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].score > matches[j].score })
+	if len(matches) > maxDuplicateMatches {
+		matches = matches[:maxDuplicateMatches]
+	}
+	out := make([]api.Entity, len(matches))
+	for i, match := range matches {
+		out[i] = match.entity
+	}
+	return out
+}
+
+// nameSimilarity scores two names from 0 to 1, ignoring case and punctuation.
+// Containment counts as a strong match so "Acme" flags "Acme Corp".
+func nameSimilarity(a, b string) float64 {
+	ka, kb := suggestKey(a), suggestKey(b)
+	if ka == "" || kb == "" {
+		return 0
+	}
+	if ka == kb {
+		return 1
+	}
+	if strings.Contains(ka, kb) || strings.Contains(kb, ka) {
+		return 0.9
+	}
+	ra, rb := []rune(ka), []rune(kb)
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+// levenshtein returns the edit distance between two rune slices.
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// showDuplicates switches to the duplicate picker for a pending create.
+func (m *EntitiesModel) showDuplicates(msg entityDuplicatesFoundMsg) {
+	input := msg.input
+	m.dupPending = &input
+	m.dupMatches = msg.matches
+	labels := make([]string, len(msg.matches))
+	for i, item := range msg.matches {
+		labels[i] = components.SanitizeOneLine(item.Name)
+	}
+	m.dupList.SetItems(labels)
+	m.view = entitiesViewDuplicates
+}
+
+// clearDuplicates drops the pending create and its matches.
+func (m *EntitiesModel) clearDuplicates() {
+	m.dupPending = nil
+	m.dupMatches = nil
+	m.dupList.SetItems(nil)
+}
+
+// handleDuplicateKeys handles keys in the duplicate picker.
+func (m EntitiesModel) handleDuplicateKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd) {
+	switch {
+	case isDown(msg):
+		m.dupList.Down()
+	case isUp(msg):
+		m.dupList.Up()
+	case isEnter(msg):
+		idx := m.dupList.Selected()
+		if idx < 0 || idx >= len(m.dupMatches) {
+			return m, nil
+		}
+		item := m.dupMatches[idx]
+		m.clearDuplicates()
+		m.resetAddForm()
+		m.detail = &item
+		m.detailRels = nil
+		m.syncDetailMetadataRows()
+		m.view = entitiesViewDetail
+		m.refs = nil
+		m.refsLoading = true
+		return m, tea.Batch(m.prefetchDetail(item.ID), m.loadEntityReferences(item))
+	case isKey(msg, "c"):
+		if m.dupPending == nil {
+			return m, nil
+		}
+		input := *m.dupPending
+		m.clearDuplicates()
+		m.view = entitiesViewAdd
+		m.addSaving = true
+		return m, m.createEntity(input)
+	case isBack(msg):
+		m.clearDuplicates()
+		m.view = entitiesViewAdd
+	}
+	return m, nil
+}
+
+// renderDuplicates renders the similar-entity warning and picker.
+func (m EntitiesModel) renderDuplicates() string {
+	contentWidth := components.BoxContentWidth(m.width)
+	name := ""
+	if m.dupPending != nil {
+		name = components.SanitizeOneLine(m.dupPending.Name)
+	}
+	noun := "entities exist"
+	if len(m.dupMatches) == 1 {
+		noun = "entity exists"
+	}
+	header := WarningStyle.Render(fmt.Sprintf("%d similar %s", len(m.dupMatches), noun)) +
+		MutedStyle.Render(fmt.Sprintf("  ·  creating %q", name))
+
+	sepWidth := 1
+	if br := lipgloss.RoundedBorder().Left; br != "" {
+		sepWidth = lipgloss.Width(br)
+	}
+
+	// 4 columns -> 3 separators.
+	typeWidth := 14
+	statusWidth := 11
+	tagsWidth := 22
+	nameWidth := contentWidth - (3 * sepWidth) - typeWidth - statusWidth - tagsWidth
+	if nameWidth < 14 {
+		nameWidth = 14
+	}
+	cols := []components.TableColumn{
+		{Header: "Name", Width: nameWidth, Align: lipgloss.Left},
+		{Header: "Type", Width: typeWidth, Align: lipgloss.Left},
+		{Header: "Status", Width: statusWidth, Align: lipgloss.Left},
+		{Header: "Tags", Width: tagsWidth, Align: lipgloss.Left},
+	}
+
+	visible := m.dupList.Visible()
+	rows := make([][]string, 0, len(visible))
+	activeRowRel := -1
+	for i := range visible {
+		absIdx := m.dupList.RelToAbs(i)
+		if absIdx < 0 || absIdx >= len(m.dupMatches) {
+			continue
+		}
+		item := m.dupMatches[absIdx]
+		if m.dupList.IsSelected(absIdx) {
+			activeRowRel = len(rows)
+		}
+		rows = append(rows, []string{
+			components.ClampTextWidthEllipsis(components.SanitizeOneLine(item.Name), nameWidth),
+			components.ClampTextWidthEllipsis(components.SanitizeOneLine(item.Type), typeWidth),
+			components.SanitizeOneLine(item.Status),
+			components.ClampTextWidthEllipsis(components.SanitizeOneLine(strings.Join(item.Tags, ", ")), tagsWidth),
+		})
+	}
+
+	content := header + "\n\n" +
+		components.TableGridWithActiveRow(cols, rows, contentWidth, activeRowRel) + "\n\n" +
+		MutedStyle.Render("enter opens the selected entity instead  ·  c creates anyway  ·  esc edits the form")
+	return components.Indent(components.TitledBox("Possible Duplicates", content, m.width), 1)
+}
@@ -0,0 +1,112 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNameSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, nameSimilarity("Acme Corp", "acme-corp"))
+	assert.Equal(t, 0.9, nameSimilarity("Acme", "Acme Corp"))
+	assert.GreaterOrEqual(t, nameSimilarity("Alex Smith", "Alex Smtih"), duplicateSimilarityThreshold)
+	assert.Less(t, nameSimilarity("Alex Smith", "Jordan Lee"), duplicateSimilarityThreshold)
+	assert.Equal(t, 0.0, nameSimilarity("", "Acme"))
+	assert.Equal(t, "Smith", duplicateSearchToken("Alex Smith, Jr."))
+}
+
+func TestFindSimilarEntitiesFiltersByTypeAndRanks(t *testing.T) {
+	candidates := []api.Entity{
+		{ID: "e1", Name: "Alex Smtih", Type: "person"},
+		{ID: "e2", Name: "Alex Smith", Type: "Person"},
+		{ID: "e3", Name: "Alex Smith", Type: "project"},
+		{ID: "e4", Name: "Alexandra Stone", Type: "person"},
+		{ID: "e2", Name: "Alex Smith", Type: "person"},
+	}
+	matches := findSimilarEntities("alex smith", "person", candidates)
+	require.Len(t, matches, 2)
+	assert.Equal(t, "e2", matches[0].ID)
+	assert.Equal(t, "e1", matches[1].ID)
+}
+
+// duplicateEntitiesClient serves one similar entity and records creates.
+func duplicateEntitiesClient(t *testing.T, creates *int) *api.Client {
+	t.Helper()
+	_, client := testEntitiesClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/entities" && r.Method == http.MethodGet:
+			assert.Equal(t, "Smith", r.URL.Query().Get("search_text"))
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+				{"id": "ent-9", "name": "Alex Smith", "type": "person", "status": "active", "tags": []string{"founder"}},
+				{"id": "ent-8", "name": "Smith Industries", "type": "organization", "status": "active"},
+			}}))
+		case r.URL.Path == "/api/entities" && r.Method == http.MethodPost:
+			*creates++
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "ent-10", "name": "Alex Smith"}}))
+		default:
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []any{}}))
+		}
+	})
+	return client
+}
+
+func TestEntityAddWarnsAboutDuplicatesAndOpensExisting(t *testing.T) {
+	creates := 0
+	model := NewEntitiesModel(duplicateEntitiesClient(t, &creates))
+	model.width = 110
+	model.addFields[addFieldName].Value = "Alex  Smith"
+	model.addFields[addFieldType].Value = "person"
+
+	model, cmd := model.saveAdd()
+	require.NotNil(t, cmd)
+	model, _ = model.Update(cmd())
+	assert.Equal(t, 0, creates)
+	assert.False(t, model.addSaving)
+	require.Equal(t, entitiesViewDuplicates, model.view)
+
+	out := components.SanitizeText(model.View())
+	assert.Contains(t, out, "1 similar entity exists")
+	assert.Contains(t, out, "founder")
+	assert.NotContains(t, out, "Smith Industries")
+
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	assert.Equal(t, entitiesViewDetail, model.view)
+	require.NotNil(t, model.detail)
+	assert.Equal(t, "ent-9", model.detail.ID)
+	assert.Empty(t, model.addFields[addFieldName].Value)
+	assert.Nil(t, model.dupPending)
+	assert.Equal(t, 0, creates)
+}
+
+func TestEntityAddDuplicatesCreateAnywayOrGoBack(t *testing.T) {
+	creates := 0
+	model := NewEntitiesModel(duplicateEntitiesClient(t, &creates))
+	model.addFields[addFieldName].Value = "Alex Smith"
+	model.addFields[addFieldType].Value = "person"
+
+	model, cmd := model.saveAdd()
+	model, _ = model.Update(cmd())
+	require.Equal(t, entitiesViewDuplicates, model.view)
+
+	// Esc keeps the form so the name can be changed.
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, entitiesViewAdd, model.view)
+	assert.Equal(t, "Alex Smith", model.addFields[addFieldName].Value)
+
+	model, cmd = model.saveAdd()
+	model, _ = model.Update(cmd())
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	require.NotNil(t, cmd)
+	assert.True(t, model.addSaving)
+	assert.Equal(t, entitiesViewAdd, model.view)
+	_, ok := cmd().(entityCreatedMsg)
+	assert.True(t, ok)
+	assert.Equal(t, 1, creates)
+}
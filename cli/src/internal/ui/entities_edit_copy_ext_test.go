@@ -32,18 +32,18 @@ func TestEntitiesHandleEditKeysBranchMatrix(t *testing.T) {
 		model.editScopeSelecting = true
 		model.scopeOptions = []string{"public", "private"}
 		model.editScopes = []string{"public"}
-		model.editScopeIdx = 0
+		model.scopePicker.idx = 0
 
 		updated, cmd := model.handleEditKeys(tea.KeyMsg{Type: tea.KeyRight})
 		require.Nil(t, cmd)
-		assert.Equal(t, 1, updated.editScopeIdx)
+		assert.Equal(t, 1, updated.scopePicker.idx)
 
 		updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
 		assert.Equal(t, []string{"public", "private"}, updated.editScopes)
 		assert.True(t, updated.editScopesDirty)
 
 		updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyLeft})
-		assert.Equal(t, 0, updated.editScopeIdx)
+		assert.Equal(t, 0, updated.scopePicker.idx)
 		updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyEnter})
 		assert.False(t, updated.editScopeSelecting)
 
@@ -54,13 +54,13 @@ func TestEntitiesHandleEditKeysBranchMatrix(t *testing.T) {
 
 	t.Run("navigation, status, tags, metadata branches", func(t *testing.T) {
 		model.editFocus = editFieldTags
-		model.editTagBuf = "ab"
+		model.editTagBuf.Value = "ab"
 
 		updated, cmd := model.handleEditKeys(tea.KeyMsg{Type: tea.KeyBackspace})
 		require.Nil(t, cmd)
-		assert.Equal(t, "a", updated.editTagBuf)
+		assert.Equal(t, "a", updated.editTagBuf.Value)
 
-		updated.editTagBuf = ""
+		updated.editTagBuf.Value = ""
 		updated.editTags = []string{"alpha", "beta"}
 		updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyBackspace})
 		assert.Equal(t, []string{"alpha"}, updated.editTags)
@@ -74,10 +74,10 @@ func TestEntitiesHandleEditKeysBranchMatrix(t *testing.T) {
 
 		updated.editFocus = editFieldTags
 		updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
-		assert.Equal(t, "x", updated.editTagBuf)
+		assert.Equal(t, "x", updated.editTagBuf.Value)
 		updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyEnter})
 		assert.Equal(t, []string{"alpha", "x"}, updated.editTags)
-		assert.Equal(t, "", updated.editTagBuf)
+		assert.Equal(t, "", updated.editTagBuf.Value)
 
 		updated.editFocus = editFieldScopes
 		updated.editScopeSelecting = false
@@ -16,16 +16,18 @@ func TestPreviewTagsMatrix(t *testing.T) {
 	assert.Equal(t, "alpha, beta +1", previewTags([]string{"alpha", "beta", "gamma"}, 2))
 }
 
-func TestEntitiesPruneBulkSelection(t *testing.T) {
+func TestEntitiesBulkSelectionSurvivesFiltering(t *testing.T) {
 	model := NewEntitiesModel(nil)
 	model.bulkSelected = map[string]bool{
 		"ent-1": true,
 		"ent-2": true,
 	}
 
-	model.pruneBulkSelection([]api.Entity{{ID: "ent-2"}, {ID: ""}})
-	assert.False(t, model.bulkSelected["ent-1"])
+	model.allItems = []api.Entity{{ID: "ent-2"}, {ID: ""}}
+	model.applyEntityFilters()
+	assert.True(t, model.bulkSelected["ent-1"])
 	assert.True(t, model.bulkSelected["ent-2"])
+	assert.Equal(t, 1, model.bulkOutOfView())
 }
 
 func TestEntitiesMatchesFiltersMatrix(t *testing.T) {
@@ -78,7 +80,7 @@ func TestEntitiesMatchesFiltersMatrix(t *testing.T) {
 	assert.True(t, model.matchesEntityFilters(item))
 }
 
-func TestEntitiesApplyFiltersPrunesSelectionAndList(t *testing.T) {
+func TestEntitiesApplyFiltersKeepsSelectionAndPrunesList(t *testing.T) {
 	model := NewEntitiesModel(nil)
 	model.scopeNames = map[string]string{"scope-public-id": "public"}
 	now := time.Now()
@@ -94,7 +96,8 @@ func TestEntitiesApplyFiltersPrunesSelectionAndList(t *testing.T) {
 	require.Len(t, model.items, 1)
 	assert.Equal(t, "ent-1", model.items[0].ID)
 	assert.True(t, model.bulkSelected["ent-1"])
-	assert.False(t, model.bulkSelected["ent-2"])
+	assert.True(t, model.bulkSelected["ent-2"])
+	assert.Equal(t, 1, model.bulkOutOfView())
 	require.Len(t, model.list.Items, 1)
 }
 
@@ -17,20 +17,20 @@ func TestEntitiesAddTagScopeHelpersBranchMatrix(t *testing.T) {
 	assert.Contains(t, stripANSI(model.renderAddTags(true)), "█")
 
 	model.addTags = []string{"alpha"}
-	model.addTagBuf = "beta"
+	model.addTagBuf.Value = "beta"
 	assert.Contains(t, stripANSI(model.renderAddTags(false)), "alpha")
 	assert.Contains(t, stripANSI(model.renderAddTags(false)), "beta")
 	assert.Contains(t, stripANSI(model.renderAddTags(true)), "█")
 
-	model.addTagBuf = "   "
+	model.addTagBuf.Value = "   "
 	model.commitAddTag()
-	assert.Equal(t, "", model.addTagBuf)
+	assert.Equal(t, "", model.addTagBuf.Value)
 
-	model.addTagBuf = "ALPHA"
+	model.addTagBuf.Value = "ALPHA"
 	model.commitAddTag()
 	assert.Equal(t, []string{"alpha"}, model.addTags)
 
-	model.addTagBuf = "gamma_tag"
+	model.addTagBuf.Value = "gamma_tag"
 	model.commitAddTag()
 	assert.Equal(t, []string{"alpha", "gamma-tag"}, model.addTags)
 
@@ -54,24 +54,24 @@ func TestEntitiesSearchInputAndBulkSelectionBranchMatrix(t *testing.T) {
 
 	updated, cmd := model.handleSearchInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
 	require.Nil(t, cmd)
-	assert.Equal(t, "a", updated.searchBuf)
+	assert.Equal(t, "a", updated.searchBuf.Value)
 
 	updated, _ = updated.handleSearchInput(tea.KeyMsg{Type: tea.KeySpace})
-	assert.Equal(t, "a ", updated.searchBuf)
+	assert.Equal(t, "a ", updated.searchBuf.Value)
 
 	updated, _ = updated.handleSearchInput(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "a", updated.searchBuf)
+	assert.Equal(t, "a", updated.searchBuf.Value)
 
 	updated, _ = updated.handleSearchInput(tea.KeyMsg{Type: tea.KeyEsc})
 	assert.Equal(t, entitiesViewList, updated.view)
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 
 	updated.view = entitiesViewSearch
-	updated.searchBuf = "  alpha "
+	updated.searchBuf.Value = "  alpha "
 	updated, cmd = updated.handleSearchInput(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
 	assert.Equal(t, entitiesViewList, updated.view)
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 	assert.True(t, updated.loading)
 
 	updated.items = []api.Entity{
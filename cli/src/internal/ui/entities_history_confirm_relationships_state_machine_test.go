@@ -327,7 +327,7 @@ func TestEntitiesRelationshipsRelateArchiveAndRelEditValidation(t *testing.T) {
 	model.view = entitiesViewRelationships
 	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
 	assert.Equal(t, entitiesViewRelEdit, model.view)
-	model.relEditBuf = "{"
+	model.relEditMeta.Buffer = "  orphan: x"
 	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
 	assert.NotEmpty(t, model.errText)
 }
@@ -68,11 +68,11 @@ func TestRenderEntityHistoryPreviewReturnsEmptyForZeroWidth(t *testing.T) {
 func TestEntitiesStartEditNoDetailIsNoop(t *testing.T) {
 	model := NewEntitiesModel(nil)
 	model.editFocus = editFieldMetadata
-	model.editTagBuf = "keep"
+	model.editTagBuf.Value = "keep"
 
 	model.startEdit()
 
 	assert.Equal(t, editFieldMetadata, model.editFocus)
-	assert.Equal(t, "keep", model.editTagBuf)
+	assert.Equal(t, "keep", model.editTagBuf.Value)
 	require.Nil(t, model.detail)
 }
@@ -121,18 +121,18 @@ func TestEntitiesRelateAndRelEditBranchMatrix(t *testing.T) {
 		assert.Nil(t, cmd)
 		assert.Equal(t, entitiesViewRelateSearch, next.view)
 
-		next.relateQuery = "be"
+		next.relateQuery.Value = "be"
 		next, cmd = next.handleRelateKeys(tea.KeyMsg{Type: tea.KeyEnter})
 		require.NotNil(t, cmd)
 		assert.Equal(t, entitiesViewRelateSelect, next.view)
 		assert.True(t, next.relateLoading)
 
 		next.view = entitiesViewRelateSearch
-		next.relateQuery = "be"
+		next.relateQuery.Value = "be"
 		next, _ = next.handleRelateKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-		assert.Equal(t, "b", next.relateQuery)
+		assert.Equal(t, "b", next.relateQuery.Value)
 		next, _ = next.handleRelateKeys(tea.KeyMsg{Type: tea.KeySpace})
-		assert.Equal(t, "b ", next.relateQuery)
+		assert.Equal(t, "b ", next.relateQuery.Value)
 		next, _ = next.handleRelateKeys(tea.KeyMsg{Type: tea.KeyEsc})
 		assert.Equal(t, entitiesViewRelationships, next.view)
 	})
@@ -169,7 +169,7 @@ func TestEntitiesRelateAndRelEditBranchMatrix(t *testing.T) {
 		next, _ = next.handleRelateKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'k'}})
 		next, _ = next.handleRelateKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
 		next, _ = next.handleRelateKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-		assert.Equal(t, "k", next.relateType)
+		assert.Equal(t, "k", next.relateType.Value)
 		next, _ = next.handleRelateKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
 		next, cmd = next.handleRelateKeys(tea.KeyMsg{Type: tea.KeyEnter})
 		require.NotNil(t, cmd)
@@ -178,7 +178,7 @@ func TestEntitiesRelateAndRelEditBranchMatrix(t *testing.T) {
 
 		next.view = entitiesViewRelateType
 		next.relateTarget = nil
-		next.relateType = "knows"
+		next.relateType.Value = "knows"
 		next, cmd = next.handleRelateKeys(tea.KeyMsg{Type: tea.KeyEnter})
 		assert.Nil(t, cmd)
 		assert.Equal(t, entitiesViewRelateType, next.view)
@@ -217,7 +217,7 @@ func TestEntitiesRelateAndRelEditBranchMatrix(t *testing.T) {
 		assert.Contains(t, out, "Beta")
 
 		m.view = entitiesViewRelateType
-		m.relateType = "knows"
+		m.relateType.Value = "knows"
 		out = components.SanitizeText(m.renderRelate())
 		assert.Contains(t, out, "Relationship Type")
 
@@ -236,7 +236,8 @@ func TestEntitiesRelateAndRelEditBranchMatrix(t *testing.T) {
 
 		m.relEditFocus = relEditFieldProperties
 		out = components.SanitizeText(m.renderRelEdit())
-		assert.Contains(t, out, "Properties (JSON)")
+		assert.Contains(t, out, "Properties:")
+		assert.Contains(t, out, "note")
 	})
 }
 
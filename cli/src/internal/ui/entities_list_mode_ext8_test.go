@@ -54,10 +54,10 @@ func TestEntitiesHandleListKeysBranchMatrix(t *testing.T) {
 	t.Run("delegates to bulk prompt and filter handlers", func(t *testing.T) {
 		model := newBase()
 		model.bulkPrompt = "Bulk Tags (add:tag1,tag2)"
-		model.bulkBuf = "abc"
+		model.bulkBuf.Value = "abc"
 		next, cmd := model.handleListKeys(tea.KeyMsg{Type: tea.KeyBackspace})
 		assert.Nil(t, cmd)
-		assert.Equal(t, "ab", next.bulkBuf)
+		assert.Equal(t, "ab", next.bulkBuf.Value)
 
 		model = newBase()
 		model.filtering = true
@@ -92,7 +92,7 @@ func TestEntitiesHandleListKeysBranchMatrix(t *testing.T) {
 		assert.True(t, next.modeFocus)
 
 		next.modeFocus = false
-		next.searchBuf = ""
+		next.searchBuf.Value = ""
 		next, cmd = next.handleListKeys(tea.KeyMsg{Type: tea.KeySpace})
 		assert.Nil(t, cmd)
 		assert.True(t, next.isBulkSelected(0))
@@ -106,47 +106,47 @@ func TestEntitiesHandleListKeysBranchMatrix(t *testing.T) {
 
 	t.Run("search input and command-return branches", func(t *testing.T) {
 		model := newBase()
-		model.searchBuf = "al"
+		model.searchBuf.Value = "al"
 		model.searchSuggest = "alpha"
 
 		next, cmd := model.handleListKeys(tea.KeyMsg{Type: tea.KeyTab})
 		require.NotNil(t, cmd)
 		assert.True(t, next.loading)
-		assert.Equal(t, "alpha", next.searchBuf)
+		assert.Equal(t, "alpha", next.searchBuf.Value)
 
-		next.searchBuf = "alp"
+		next.searchBuf.Value = "alp"
 		next, cmd = next.handleListKeys(tea.KeyMsg{Type: tea.KeyBackspace})
 		require.NotNil(t, cmd)
-		assert.Equal(t, "al", next.searchBuf)
+		assert.Equal(t, "al", next.searchBuf.Value)
 
-		next.searchBuf = "alpha"
+		next.searchBuf.Value = "alpha"
 		next.searchSuggest = "alpha"
 		next, cmd = next.handleListKeys(tea.KeyMsg{Type: tea.KeyEsc})
 		require.NotNil(t, cmd)
-		assert.Equal(t, "", next.searchBuf)
+		assert.Equal(t, "", next.searchBuf.Value)
 		assert.Equal(t, "", next.searchSuggest)
 
-		next.searchBuf = "x"
+		next.searchBuf.Value = "x"
 		next, cmd = next.handleListKeys(tea.KeyMsg{Type: tea.KeySpace})
 		require.NotNil(t, cmd)
-		assert.Equal(t, "x ", next.searchBuf)
+		assert.Equal(t, "x ", next.searchBuf.Value)
 
-		next.searchBuf = "query"
+		next.searchBuf.Value = "query"
 		next.searchSuggest = "query-suggest"
 		next, cmd = next.handleListKeys(tea.KeyMsg{Type: tea.KeyCtrlU})
 		require.NotNil(t, cmd)
-		assert.Equal(t, "", next.searchBuf)
+		assert.Equal(t, "", next.searchBuf.Value)
 		assert.Equal(t, "", next.searchSuggest)
 
-		next.searchBuf = ""
+		next.searchBuf.Value = ""
 		next, cmd = next.handleListKeys(tea.KeyMsg{Type: tea.KeySpace})
 		assert.Nil(t, cmd)
 		assert.True(t, next.isBulkSelected(next.list.Selected()))
 
-		next.searchBuf = ""
+		next.searchBuf.Value = ""
 		next, cmd = next.handleListKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
 		assert.Nil(t, cmd)
-		assert.Equal(t, "", next.searchBuf)
+		assert.Equal(t, "", next.searchBuf.Value)
 	})
 
 	t.Run("bulk action prompt and clear branches", func(t *testing.T) {
@@ -175,11 +175,11 @@ func TestEntitiesHandleListKeysBranchMatrix(t *testing.T) {
 		model := newBase()
 		next, cmd := model.handleListKeys(tea.KeyMsg{Type: tea.KeySpace})
 		assert.Nil(t, cmd)
-		assert.Equal(t, "", next.searchBuf)
+		assert.Equal(t, "", next.searchBuf.Value)
 
 		next, cmd = model.handleListKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'z'}})
 		require.NotNil(t, cmd)
 		assert.True(t, next.loading)
-		assert.Equal(t, "z", next.searchBuf)
+		assert.Equal(t, "z", next.searchBuf.Value)
 	})
 }
@@ -40,7 +40,7 @@ func TestLoadEntitiesOmitsSearchParamWhenEmpty(t *testing.T) {
 	assert.Equal(t, "ent-1", loaded.items[0].ID)
 }
 
-func TestLoadEntitiesReturnsErrMsgOnQueryFailure(t *testing.T) {
+func TestLoadEntitiesReturnsLoadFailedOnQueryFailure(t *testing.T) {
 	_, client := testEntitiesClient(t, func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path != "/api/entities" || r.Method != http.MethodGet {
 			w.WriteHeader(http.StatusNotFound)
@@ -64,8 +64,9 @@ func TestLoadEntitiesReturnsErrMsgOnQueryFailure(t *testing.T) {
 	cmd := model.loadEntities("al")
 	msg := cmd()
 
-	errResult, ok := msg.(errMsg)
+	errResult, ok := msg.(loadFailedMsg)
 	require.True(t, ok)
 	require.Error(t, errResult.err)
+	assert.NotNil(t, errResult.retry)
 	assert.Contains(t, errResult.err.Error(), "db exploded")
 }
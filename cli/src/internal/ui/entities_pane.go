@@ -0,0 +1,66 @@
+package ui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// syncSplitPane loads links and history for the selected entity when the
+// split pane is showing.
+func (m *EntitiesModel) syncSplitPane() tea.Cmd {
+	if !m.pane.active(m.width) {
+		return nil
+	}
+	idx := m.list.Selected()
+	if idx < 0 || idx >= len(m.items) {
+		return nil
+	}
+	return m.pane.request(m.client, splitPaneEntity, m.items[idx].ID)
+}
+
+// renderEntitySplitPane renders the full detail pane for the selected entity.
+func (m EntitiesModel) renderEntitySplitPane(e api.Entity, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	name, typ := normalizeEntityNameType(components.SanitizeText(e.Name), components.SanitizeText(e.Type))
+	if typ == "" {
+		typ = "?"
+	}
+	status := strings.TrimSpace(e.Status)
+	if status == "" {
+		status = "-"
+	}
+
+	var lines []string
+	lines = append(lines, MetaKeyStyle.Render("Selected"))
+	for _, part := range wrapPreviewText(name, width) {
+		lines = append(lines, SelectedStyle.Render(part))
+	}
+	lines = append(lines, "")
+	lines = append(lines, renderPreviewRow("ID", e.ID, width))
+	lines = append(lines, renderPreviewRow("Type", typ, width))
+	lines = append(lines, renderPreviewRow("Status", status, width))
+	if len(e.Tags) > 0 {
+		lines = append(lines, renderPreviewRow("Tags", strings.Join(e.Tags, ", "), width))
+	}
+	if len(e.PrivacyScopeIDs) > 0 {
+		lines = append(lines, renderPreviewRow("Scopes", m.formatEntityScopes(e.PrivacyScopeIDs), width))
+	}
+	lines = append(lines, renderPreviewRow("Created", formatLocalTimeFull(e.CreatedAt), width))
+	if !e.UpdatedAt.IsZero() {
+		lines = append(lines, renderPreviewRow("Updated", formatLocalTimeFull(e.UpdatedAt), width))
+	}
+	if metaPreview := metadataPreview(map[string]any(e.Metadata), 240); metaPreview != "" {
+		lines = append(lines, "", MetaKeyStyle.Render("Metadata"))
+		for _, part := range wrapPreviewText(humanizeGoMapString(metaPreview), width) {
+			lines = append(lines, MetaValueStyle.Render(part))
+		}
+	}
+	lines = append(lines, m.pane.renderSections(e.ID, true, width)...)
+	return padPreviewLines(lines, width)
+}
@@ -0,0 +1,252 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+type entityQueryField int
+
+const (
+	entityQueryStatus entityQueryField = iota
+	entityQueryTags
+	entityQueryScopes
+	entityQueryUpdatedAfter
+	entityQueryUpdatedBefore
+	entityQueryMetadata
+	entityQueryFieldCount
+)
+
+// entityQueryLabels names each condition in the query builder.
+var entityQueryLabels = [entityQueryFieldCount]string{
+	"Status", "Tags", "Scopes", "Updated after", "Updated before", "Metadata",
+}
+
+// entityQueryHints shows the expected input for each condition.
+var entityQueryHints = [entityQueryFieldCount]string{
+	"status names, comma-separated: active,inactive",
+	"matches any tag, comma-separated: urgent,infra",
+	"scope names, comma-separated: public,personal",
+	"date as YYYY-MM-DD",
+	"date as YYYY-MM-DD",
+	"key=value pairs, comma-separated: priority=high, owner.team=core",
+}
+
+// entityQueryDateLayout is the date format the builder accepts.
+const entityQueryDateLayout = "2006-01-02"
+
+// entityQuery is an advanced entity filter. Every condition must hold.
+type entityQuery struct {
+	values [entityQueryFieldCount]string
+}
+
+// entityQueryForm is the query builder overlay editing a draft query.
+type entityQueryForm struct {
+	draft   entityQuery
+	focus   entityQueryField
+	input   components.TextInput
+	errText string
+}
+
+// focusedInput returns the input editing the focused field, reloaded when the
+// field's draft value was replaced underneath it.
+func (form *entityQueryForm) focusedInput() *components.TextInput {
+	if value := form.draft.values[form.focus]; form.input.Value != value {
+		form.input.SetValue(value)
+	}
+	return &form.input
+}
+
+// empty reports whether the query has no conditions.
+func (q entityQuery) empty() bool {
+	for _, v := range q.values {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// metadata parses the metadata condition into a containment object.
+func (q entityQuery) metadata() (map[string]any, error) {
+	raw := strings.TrimSpace(q.values[entityQueryMetadata])
+	if raw == "" {
+		return nil, nil
+	}
+	out := map[string]any{}
+	for _, pair := range strings.Split(raw, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(pair, "=")
+		key = strings.TrimPrefix(strings.TrimSpace(key), "metadata.")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("metadata: expected key=value, got %q", pair)
+		}
+		if err := setMetadataPath(out, key, parseMetadataScalar(strings.TrimSpace(value)), 1); err != nil {
+			return nil, fmt.Errorf("metadata: %w", err)
+		}
+	}
+	return out, nil
+}
+
+// validate checks the dates and metadata pairs.
+func (q entityQuery) validate() error {
+	for _, field := range []entityQueryField{entityQueryUpdatedAfter, entityQueryUpdatedBefore} {
+		raw := strings.TrimSpace(q.values[field])
+		if raw == "" {
+			continue
+		}
+		if _, err := time.Parse(entityQueryDateLayout, raw); err != nil {
+			return fmt.Errorf("%s: expected YYYY-MM-DD, got %q", strings.ToLower(entityQueryLabels[field]), raw)
+		}
+	}
+	_, err := q.metadata()
+	return err
+}
+
+// apply adds the query conditions to params.
+func (q entityQuery) apply(params api.QueryParams) {
+	if v := appendSearchValues(nil, q.values[entityQueryStatus]); len(v) > 0 {
+		params["status"] = strings.Join(v, ",")
+	}
+	if v := appendSearchValues(nil, q.values[entityQueryTags]); len(v) > 0 {
+		params["tags"] = strings.Join(v, ",")
+	}
+	if v := appendSearchValues(nil, q.values[entityQueryScopes]); len(v) > 0 {
+		params["scopes"] = strings.Join(v, ",")
+	}
+	if v := strings.TrimSpace(q.values[entityQueryUpdatedAfter]); v != "" {
+		params["updated_after"] = v
+	}
+	if v := strings.TrimSpace(q.values[entityQueryUpdatedBefore]); v != "" {
+		params["updated_before"] = v
+	}
+	if meta, err := q.metadata(); err == nil && len(meta) > 0 {
+		if data, err := json.Marshal(meta); err == nil {
+			params["metadata"] = string(data)
+		}
+	}
+}
+
+// chips renders each condition as a short label, e.g. `tag in (a,b)`.
+func (q entityQuery) chips() []string {
+	var chips []string
+	list := func(name string, field entityQueryField) {
+		values := appendSearchValues(nil, q.values[field])
+		switch len(values) {
+		case 0:
+		case 1:
+			chips = append(chips, name+"="+values[0])
+		default:
+			chips = append(chips, name+" in ("+strings.Join(values, ",")+")")
+		}
+	}
+	list("status", entityQueryStatus)
+	list("tag", entityQueryTags)
+	list("scope", entityQueryScopes)
+	if v := strings.TrimSpace(q.values[entityQueryUpdatedAfter]); v != "" {
+		chips = append(chips, "updated>"+v)
+	}
+	if v := strings.TrimSpace(q.values[entityQueryUpdatedBefore]); v != "" {
+		chips = append(chips, "updated<"+v)
+	}
+	for _, pair := range strings.Split(q.values[entityQueryMetadata], ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		key = strings.TrimPrefix(strings.TrimSpace(key), "metadata.")
+		if ok && key != "" {
+			chips = append(chips, "metadata."+key+"="+strings.TrimSpace(value))
+		}
+	}
+	return chips
+}
+
+// renderEntityQueryChips renders the active advanced filter above the list.
+func renderEntityQueryChips(q entityQuery, width int) string {
+	chips := q.chips()
+	if len(chips) == 0 {
+		return ""
+	}
+	rendered := make([]string, len(chips))
+	for i, chip := range chips {
+		rendered[i] = SelectedStyle.Render("(" + components.SanitizeOneLine(chip) + ")")
+	}
+	line := MutedStyle.Render("where: ") + strings.Join(rendered, MutedStyle.Render(" and "))
+	if width > 0 {
+		line = components.ClampTextWidthEllipsis(line, width)
+	}
+	return line
+}
+
+// openQueryForm shows the query builder seeded with the active query.
+func (m *EntitiesModel) openQueryForm() {
+	m.queryForm = &entityQueryForm{draft: m.query}
+}
+
+// handleQueryFormKeys edits the draft; enter applies it and reloads.
+func (m EntitiesModel) handleQueryFormKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd) {
+	form := m.queryForm
+	switch {
+	case isBack(msg):
+		m.queryForm = nil
+	case isEnter(msg):
+		if err := form.draft.validate(); err != nil {
+			form.errText = err.Error()
+			return m, nil
+		}
+		m.query = form.draft
+		m.queryForm = nil
+		m.clearBulkSelection()
+		m.loading = true
+		return m, m.loadEntities(strings.TrimSpace(m.searchBuf.Value))
+	case isDown(msg), isKey(msg, "tab"):
+		form.focus = (form.focus + 1) % entityQueryFieldCount
+	case isUp(msg), isKey(msg, "shift+tab"):
+		form.focus = (form.focus - 1 + entityQueryFieldCount) % entityQueryFieldCount
+	case isKey(msg, "ctrl+r"):
+		form.draft = entityQuery{}
+		form.errText = ""
+	case isKey(msg, "cmd+backspace", "cmd+delete", "ctrl+u"):
+		form.draft.values[form.focus] = ""
+	default:
+		input := form.focusedInput()
+		input.HandleKey(msg)
+		form.draft.values[form.focus] = input.Value
+	}
+	return m, nil
+}
+
+// renderQueryForm renders the query builder overlay.
+func (m EntitiesModel) renderQueryForm() string {
+	form := *m.queryForm
+	rows := make([][2]string, entityQueryFieldCount)
+	for i := range rows {
+		value := form.draft.values[i]
+		if entityQueryField(i) == form.focus {
+			value = form.focusedInput().View(AccentStyle, 0)
+		}
+		rows[i] = [2]string{entityQueryLabels[i], value}
+	}
+	grid := renderFormGrid("Advanced Filters", rows, int(form.focus), m.width)
+
+	lines := []string{
+		"  " + MetaKeyStyle.Render("Advanced Filters") + MutedStyle.Render(" · every condition must match"),
+		grid,
+		"  " + MutedStyle.Render(entityQueryHints[form.focus]),
+	}
+	if chips := renderEntityQueryChips(form.draft, components.BoxContentWidth(m.width)); chips != "" {
+		lines = append(lines, "  "+chips)
+	}
+	if form.errText != "" {
+		lines = append(lines, "  "+ErrorStyle.Render(form.errText))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,119 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestEntityQueryParamsAndChips(t *testing.T) {
+	var q entityQuery
+	q.values[entityQueryStatus] = "Active"
+	q.values[entityQueryTags] = "a, b"
+	q.values[entityQueryUpdatedAfter] = "2024-01-01"
+	q.values[entityQueryMetadata] = "priority=high, metadata.owner.team=core"
+	require.NoError(t, q.validate())
+
+	params := api.QueryParams{}
+	q.apply(params)
+	assert.Equal(t, "active", params["status"])
+	assert.Equal(t, "a,b", params["tags"])
+	assert.Equal(t, "2024-01-01", params["updated_after"])
+	assert.JSONEq(t, `{"priority":"high","owner":{"team":"core"}}`, params["metadata"])
+	assert.NotContains(t, params, "scopes")
+
+	assert.Equal(t, []string{
+		"status=active",
+		"tag in (a,b)",
+		"updated>2024-01-01",
+		"metadata.priority=high",
+		"metadata.owner.team=core",
+	}, q.chips())
+
+	q.values[entityQueryUpdatedBefore] = "soon"
+	assert.ErrorContains(t, q.validate(), "updated before")
+	q.values[entityQueryUpdatedBefore] = ""
+	q.values[entityQueryMetadata] = "priority"
+	assert.ErrorContains(t, q.validate(), "key=value")
+	assert.True(t, entityQuery{}.empty())
+}
+
+func TestEntityQueryBuilderAppliesFilterAndShowsChips(t *testing.T) {
+	var queries []url.Values
+	_, client := testEntitiesClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var data any = []any{}
+		if r.URL.Path == "/api/entities" {
+			queries = append(queries, r.URL.Query())
+			data = []map[string]any{{"id": "ent-1", "name": "Launch plan", "type": "project", "status": "active"}}
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": data}))
+	})
+	app := NewApp(client, &config.Config{})
+	app.width = 120
+	app.entities.width = 120
+	app.tab = tabEntities
+
+	press := func(keys ...tea.KeyMsg) tea.Cmd {
+		var cmd tea.Cmd
+		for _, key := range keys {
+			var model tea.Model
+			model, cmd = app.Update(key)
+			app = model.(App)
+		}
+		return cmd
+	}
+	runes := func(s string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)} }
+
+	press(runes("F"))
+	require.NotNil(t, app.entities.queryForm)
+	assert.Contains(t, components.SanitizeText(app.entities.View()), "Advanced Filters")
+
+	press(runes("active"), tea.KeyMsg{Type: tea.KeyDown}, runes("a,b"))
+	press(tea.KeyMsg{Type: tea.KeyDown}, tea.KeyMsg{Type: tea.KeyDown}, runes("2024-01-01"))
+	assert.Equal(t, tabEntities, app.tab)
+	press(tea.KeyMsg{Type: tea.KeyDown}, tea.KeyMsg{Type: tea.KeyDown}, runes("priority=high"))
+
+	cmd := press(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	assert.Nil(t, app.entities.queryForm)
+	model, _ := app.Update(cmd())
+	app = model.(App)
+
+	require.NotEmpty(t, queries)
+	last := queries[len(queries)-1]
+	assert.Equal(t, "active", last.Get("status"))
+	assert.Equal(t, "a,b", last.Get("tags"))
+	assert.Equal(t, "2024-01-01", last.Get("updated_after"))
+	assert.JSONEq(t, `{"priority":"high"}`, last.Get("metadata"))
+
+	view := components.SanitizeText(app.entities.View())
+	assert.Contains(t, view, "(status=active)")
+	assert.Contains(t, view, "(tag in (a,b))")
+	assert.Contains(t, view, "(metadata.priority=high)")
+
+	cmd = press(tea.KeyMsg{Type: tea.KeyEsc})
+	require.NotNil(t, cmd)
+	assert.True(t, app.entities.query.empty())
+}
+
+func TestEntityQueryBuilderRejectsBadDate(t *testing.T) {
+	model := NewEntitiesModel(nil)
+	model.width = 100
+	model.openQueryForm()
+	model.queryForm.focus = entityQueryUpdatedAfter
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("01/02/2024")})
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Nil(t, cmd)
+	require.NotNil(t, model.queryForm)
+	assert.Contains(t, components.SanitizeText(model.View()), "expected YYYY-MM-DD")
+	assert.True(t, model.query.empty())
+}
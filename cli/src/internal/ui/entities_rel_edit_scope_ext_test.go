@@ -211,7 +211,8 @@ func TestEntitiesStartRelEditAndHandleRelEditKeysBranchMatrix(t *testing.T) {
 	assert.Equal(t, "rel-1", model.relEditID)
 	assert.Equal(t, relEditFieldStatus, model.relEditFocus)
 	assert.Equal(t, 0, model.relEditStatusIdx)
-	assert.Equal(t, "", strings.TrimSpace(model.relEditBuf))
+	assert.Equal(t, "", strings.TrimSpace(model.relEditMeta.Buffer))
+	assert.Equal(t, "Properties", model.relEditMeta.Label)
 
 	// Status selector branches.
 	updated, cmd := model.handleRelEditKeys(tea.KeyMsg{Type: tea.KeyRight})
@@ -235,17 +236,15 @@ func TestEntitiesStartRelEditAndHandleRelEditKeysBranchMatrix(t *testing.T) {
 	updated, _ = updated.handleRelEditKeys(tea.KeyMsg{Type: tea.KeyUp})
 	assert.Equal(t, relEditFieldStatus, updated.relEditFocus)
 
+	// Properties open the structured editor.
 	updated.relEditFocus = relEditFieldProperties
-	updated.relEditBuf = "a"
-	updated, _ = updated.handleRelEditKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "", updated.relEditBuf)
-	updated, _ = updated.handleRelEditKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'{'}})
-	assert.Equal(t, "{", updated.relEditBuf)
-	updated, _ = updated.handleRelEditKeys(tea.KeyMsg{Type: tea.KeySpace})
-	assert.Equal(t, "{ ", updated.relEditBuf)
+	updated, _ = updated.handleRelEditKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.True(t, updated.relEditMeta.Active)
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.False(t, updated.relEditMeta.Active)
 
-	// Save invalid JSON branch.
-	updated.relEditBuf = "{"
+	// Save invalid properties branch.
+	updated.relEditMeta.Buffer = "  orphan: x"
 	updated, cmd = updated.handleRelEditKeys(tea.KeyMsg{Type: tea.KeyCtrlS})
 	require.Nil(t, cmd)
 	assert.NotEmpty(t, updated.errText)
@@ -253,7 +252,7 @@ func TestEntitiesStartRelEditAndHandleRelEditKeysBranchMatrix(t *testing.T) {
 	// Save valid branch.
 	updated.errText = ""
 	updated.relEditStatusIdx = 0
-	updated.relEditBuf = `{"note":"ok"}`
+	updated.relEditMeta.Buffer = "note: ok"
 	updated, cmd = updated.handleRelEditKeys(tea.KeyMsg{Type: tea.KeyCtrlS})
 	require.NotNil(t, cmd)
 	msg := cmd()
@@ -272,17 +271,17 @@ func TestEntitiesStartRelEditAndHandleRelEditKeysBranchMatrix(t *testing.T) {
 func TestEntitiesCommitEditTagScopeAndRenderEditTagsBranches(t *testing.T) {
 	model := NewEntitiesModel(nil)
 
-	model.editTagBuf = "  "
+	model.editTagBuf.Value = "  "
 	model.commitEditTag()
-	assert.Equal(t, "", model.editTagBuf)
+	assert.Equal(t, "", model.editTagBuf.Value)
 
 	model.editTags = []string{"alpha"}
-	model.editTagBuf = "ALPHA"
+	model.editTagBuf.Value = "ALPHA"
 	model.commitEditTag()
 	assert.Equal(t, []string{"alpha"}, model.editTags)
-	assert.Equal(t, "", model.editTagBuf)
+	assert.Equal(t, "", model.editTagBuf.Value)
 
-	model.editTagBuf = "beta"
+	model.editTagBuf.Value = "beta"
 	model.commitEditTag()
 	assert.Equal(t, []string{"alpha", "beta"}, model.editTags)
 
@@ -305,7 +304,7 @@ func TestEntitiesCommitEditTagScopeAndRenderEditTagsBranches(t *testing.T) {
 	empty := model.renderEditTags(false)
 	assert.NotEqual(t, "-", empty)
 	model.editTags = nil
-	model.editTagBuf = ""
+	model.editTagBuf.Value = ""
 	assert.Equal(t, "-", model.renderEditTags(false))
 	assert.Contains(t, components.SanitizeText(model.renderEditTags(true)), "█")
 }
@@ -322,18 +321,18 @@ func TestEntitiesHandleRelationshipsKeysBranchMatrix(t *testing.T) {
 
 	// Enter relate flow and ensure startRelate reset is applied.
 	model.view = entitiesViewRelationships
-	model.relateQuery = "stale"
+	model.relateQuery.Value = "stale"
 	model.relateResults = []api.Entity{{ID: "ent-x"}}
 	model.relateTarget = &api.Entity{ID: "ent-y"}
-	model.relateType = "knows"
+	model.relateType.Value = "knows"
 	model.relateLoading = true
 	next, cmd = model.handleRelationshipsKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
 	require.Nil(t, cmd)
 	assert.Equal(t, entitiesViewRelateSearch, next.view)
-	assert.Equal(t, "", next.relateQuery)
+	assert.Equal(t, "", next.relateQuery.Value)
 	assert.Nil(t, next.relateResults)
 	assert.Nil(t, next.relateTarget)
-	assert.Equal(t, "", next.relateType)
+	assert.Equal(t, "", next.relateType.Value)
 	assert.False(t, next.relateLoading)
 
 	// Down/up branches on relationship list.
@@ -481,7 +480,7 @@ func TestEntitiesSaveRelEditReturnsErrMsgOnUpdateFailure(t *testing.T) {
 	model := NewEntitiesModel(client)
 	model.relEditID = "rel-1"
 	model.relEditStatusIdx = 0
-	model.relEditBuf = `{"note":"ok"}`
+	model.relEditMeta.Buffer = "note: ok"
 
 	updated, cmd := model.saveRelEdit()
 	require.NotNil(t, cmd)
@@ -21,11 +21,11 @@ func TestEntitiesHandleEditScopesAndMetaSelectionHelpers(t *testing.T) {
 	model.scopeOptions = []string{"public", "private"}
 	model.editFocus = editFieldScopes
 	model.editScopeSelecting = true
-	model.editScopeIdx = 0
+	model.scopePicker.idx = 0
 	model.editScopes = []string{"public"}
 
 	updated, _ := model.handleEditKeys(tea.KeyMsg{Type: tea.KeyRight})
-	assert.Equal(t, 1, updated.editScopeIdx)
+	assert.Equal(t, 1, updated.scopePicker.idx)
 
 	updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
 	assert.True(t, updated.editScopesDirty)
@@ -139,7 +139,7 @@ func TestEntitiesSaveRelEditSendsPatchPayload(t *testing.T) {
 	model := NewEntitiesModel(api.NewClient(srv.URL, "test-key"))
 	model.relEditID = "rel-1"
 	model.relEditStatusIdx = 0
-	model.relEditBuf = `{"note":"ok"}`
+	model.relEditMeta.Buffer = "note: ok"
 
 	updated, cmd := model.saveRelEdit()
 	require.NotNil(t, cmd)
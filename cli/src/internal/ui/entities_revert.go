@@ -0,0 +1,269 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// entityRevertPreviewMsg carries the relationships used to preview a revert.
+type entityRevertPreviewMsg struct {
+	auditID string
+	rels    []api.Relationship
+	err     error
+}
+
+// revertImpact is one line of the revert impact preview.
+type revertImpact struct {
+	label    string
+	detail   string
+	conflict bool
+}
+
+// startRevertConfirm opens the revert confirmation for entry with every changed field selected.
+func (m *EntitiesModel) startRevertConfirm(entry api.AuditEntry) tea.Cmd {
+	m.confirmKind = "entity-revert"
+	m.confirmAuditID = entry.ID
+	m.confirmAudit = &entry
+	m.confirmReturn = entitiesViewDetail
+	m.view = entitiesViewConfirm
+
+	m.revertFields = revertableFields(entry)
+	m.revertSelected = make(map[string]bool, len(m.revertFields))
+	for _, field := range m.revertFields {
+		m.revertSelected[field] = true
+	}
+	m.revertCursor = 0
+	m.revertRels = nil
+	m.revertRelsErr = ""
+	m.revertPreviewLoading = m.client != nil && m.detail != nil
+	return m.loadRevertPreview(entry.ID)
+}
+
+// loadRevertPreview loads the entity relationships the revert could affect.
+func (m EntitiesModel) loadRevertPreview(auditID string) tea.Cmd {
+	if m.client == nil || m.detail == nil {
+		return nil
+	}
+	entityID := m.detail.ID
+	return func() tea.Msg {
+		rels, err := m.client.GetRelationships("entity", entityID)
+		return entityRevertPreviewMsg{auditID: auditID, rels: rels, err: err}
+	}
+}
+
+// revertableEntityFields are the entity columns the server can restore from
+// an audit entry.
+var revertableEntityFields = map[string]bool{
+	"privacy_scope_ids": true,
+	"name":              true,
+	"type_id":           true,
+	"status_id":         true,
+	"status_changed_at": true,
+	"status_reason":     true,
+	"tags":              true,
+	"metadata":          true,
+	"source_path":       true,
+}
+
+// revertableFields lists the fields an audit entry changed, in display order.
+func revertableFields(entry api.AuditEntry) []string {
+	fields := []string{}
+	for _, key := range auditEntryKeys(entry) {
+		if !revertableEntityFields[key] {
+			continue
+		}
+		if formatAuditValue(entry.OldData[key]) == formatAuditValue(entry.NewData[key]) {
+			continue
+		}
+		fields = append(fields, key)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// selectedRevertFields returns the chosen fields, or nil when all are chosen.
+func (m EntitiesModel) selectedRevertFields() []string {
+	selected := make([]string, 0, len(m.revertFields))
+	for _, field := range m.revertFields {
+		if m.revertSelected[field] {
+			selected = append(selected, field)
+		}
+	}
+	if len(selected) == len(m.revertFields) {
+		return nil
+	}
+	return selected
+}
+
+// handleRevertFieldKeys moves and toggles the field selection; reports whether the key was used.
+func (m *EntitiesModel) handleRevertFieldKeys(msg tea.KeyMsg) bool {
+	if len(m.revertFields) == 0 {
+		return false
+	}
+	switch {
+	case isDown(msg):
+		m.revertCursor = (m.revertCursor + 1) % len(m.revertFields)
+	case isUp(msg):
+		m.revertCursor = (m.revertCursor - 1 + len(m.revertFields)) % len(m.revertFields)
+	case isSpace(msg):
+		field := m.revertFields[m.revertCursor]
+		m.revertSelected[field] = !m.revertSelected[field]
+	case isKey(msg, "a"):
+		all := m.selectedRevertFields() == nil
+		for _, field := range m.revertFields {
+			m.revertSelected[field] = !all
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// resetRevertState clears the revert field selection and preview.
+func (m *EntitiesModel) resetRevertState() {
+	m.revertFields = nil
+	m.revertSelected = nil
+	m.revertCursor = 0
+	m.revertRels = nil
+	m.revertRelsErr = ""
+	m.revertPreviewLoading = false
+}
+
+// revertImpacts lists what a revert of the selected fields touches or conflicts with.
+func (m EntitiesModel) revertImpacts() []revertImpact {
+	if m.confirmAudit == nil {
+		return nil
+	}
+	entry := *m.confirmAudit
+	selected := map[string]bool{}
+	for _, field := range m.revertFields {
+		selected[field] = m.revertSelected[field]
+	}
+	impacts := []revertImpact{}
+
+	if selected["privacy_scope_ids"] {
+		restored := parseStringList(entry.OldData["privacy_scope_ids"])
+		missing := []string{}
+		for _, id := range restored {
+			if _, ok := m.scopeNames[id]; !ok && len(m.scopeNames) > 0 {
+				missing = append(missing, shortID(id))
+			}
+		}
+		if len(missing) > 0 {
+			impacts = append(impacts, revertImpact{
+				label:    "Scopes",
+				detail:   fmt.Sprintf("%s no longer exist", strings.Join(missing, ", ")),
+				conflict: true,
+			})
+		} else {
+			impacts = append(impacts, revertImpact{
+				label:  "Scopes",
+				detail: "restores " + firstNonEmpty(strings.Join(m.scopeNamesFromIDs(restored), ", "), "no scopes"),
+			})
+		}
+	}
+
+	if selected["status_id"] || selected["status"] {
+		impacts = append(impacts, revertImpact{
+			label:  "Status",
+			detail: "status change applies to how linked items resolve this entity",
+		})
+	}
+
+	switch {
+	case m.revertPreviewLoading:
+		impacts = append(impacts, revertImpact{label: "Links", detail: "loading relationships..."})
+	case m.revertRelsErr != "":
+		impacts = append(impacts, revertImpact{label: "Links", detail: "unavailable: " + m.revertRelsErr})
+	default:
+		newerRels, newerKnowledge := 0, 0
+		for _, rel := range m.revertRels {
+			if !rel.CreatedAt.After(entry.ChangedAt) {
+				continue
+			}
+			if rel.SourceType == "context" || rel.TargetType == "context" {
+				newerKnowledge++
+			} else {
+				newerRels++
+			}
+		}
+		if newerRels > 0 {
+			impacts = append(impacts, revertImpact{
+				label:    "Relationships",
+				detail:   fmt.Sprintf("%d created after this point stay linked", newerRels),
+				conflict: true,
+			})
+		}
+		if newerKnowledge > 0 {
+			impacts = append(impacts, revertImpact{
+				label:    "Knowledge",
+				detail:   fmt.Sprintf("%d context links created after this point stay linked", newerKnowledge),
+				conflict: true,
+			})
+		}
+		if newerRels == 0 && newerKnowledge == 0 {
+			impacts = append(impacts, revertImpact{
+				label:  "Links",
+				detail: fmt.Sprintf("%d relationship(s), none newer than this point", len(m.revertRels)),
+			})
+		}
+	}
+	return impacts
+}
+
+// renderRevertConfirm renders the field picker, impact preview, and selected changes.
+func (m EntitiesModel) renderRevertConfirm(summary []components.TableRow) string {
+	sections := []string{}
+
+	var diffs []components.DiffRow
+	if m.confirmAudit != nil {
+		for _, row := range buildAuditDiffRows(*m.confirmAudit) {
+			for _, field := range m.revertFields {
+				if humanizeAuditField(field) == row.Label && m.revertSelected[field] {
+					diffs = append(diffs, row)
+				}
+			}
+		}
+	}
+	sections = append(sections, components.ConfirmPreviewDialog("Revert Entity", summary, diffs, m.width))
+
+	if len(m.revertFields) > 0 {
+		lines := []string{MetaKeyStyle.Render("Fields to restore"), ""}
+		for i, field := range m.revertFields {
+			mark := MutedStyle.Render("[ ]")
+			if m.revertSelected[field] {
+				mark = SuccessStyle.Render("[x]")
+			}
+			name := NormalStyle.Render(humanizeAuditField(field))
+			if i == m.revertCursor {
+				name = SelectedStyle.Render(humanizeAuditField(field))
+			}
+			lines = append(lines, fmt.Sprintf("%s %s", mark, name))
+		}
+		if len(m.selectedRevertFields()) == 0 && m.selectedRevertFields() != nil {
+			lines = append(lines, "", WarningStyle.Render("select at least one field"))
+		}
+		sections = append(sections, components.TitledBox("Fields", strings.Join(lines, "\n"), m.width))
+	}
+
+	if impacts := m.revertImpacts(); len(impacts) > 0 {
+		lines := []string{MetaKeyStyle.Render("Impact"), ""}
+		for _, impact := range impacts {
+			style := MutedStyle
+			marker := "·"
+			if impact.conflict {
+				style = WarningStyle
+				marker = "!"
+			}
+			lines = append(lines, fmt.Sprintf("%s %s  %s", style.Render(marker), MetaKeyStyle.Render(impact.label), style.Render(impact.detail)))
+		}
+		sections = append(sections, components.TitledBox("Impact", strings.Join(lines, "\n"), m.width))
+	}
+	return components.Indent(strings.Join(sections, "\n\n"), 1)
+}
@@ -0,0 +1,124 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// revertFixtureEntry changes name, tags, and scopes.
+func revertFixtureEntry(at time.Time) api.AuditEntry {
+	return api.AuditEntry{
+		ID:            "audit-1",
+		Action:        "update",
+		ChangedAt:     at,
+		ChangedFields: []string{"name", "tags", "privacy_scope_ids", "embedding", "updated_at"},
+		OldData: api.JSONMap{
+			"embedding":         "[0.1]",
+			"name":              "Alpha",
+			"tags":              []any{"core"},
+			"privacy_scope_ids": []any{"scope-public", "scope-gone"},
+		},
+		NewData: api.JSONMap{
+			"embedding":         "[0.2]",
+			"name":              "Alpha Prime",
+			"tags":              []any{"core", "infra"},
+			"privacy_scope_ids": []any{"scope-public"},
+		},
+	}
+}
+
+func TestEntitiesRevertPreviewSelectiveFields(t *testing.T) {
+	at := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	var gotBody map[string]any
+	_, client := testEntitiesClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/relationships/entity/ent-1":
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+				{"id": "rel-1", "source_id": "ent-1", "target_id": "ent-2", "target_type": "entity", "created_at": at.Add(time.Hour)},
+				{"id": "rel-2", "source_id": "ent-1", "target_id": "ctx-1", "target_type": "context", "created_at": at.Add(time.Hour)},
+				{"id": "rel-3", "source_id": "ent-1", "target_id": "ent-3", "target_type": "entity", "created_at": at.Add(-time.Hour)},
+			}}))
+		case r.URL.Path == "/api/entities/ent-1/revert":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+				"id": "ent-1", "name": "Alpha", "tags": []string{"core", "infra"},
+			}}))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	model := NewEntitiesModel(client)
+	model.width = 100
+	model.detail = &api.Entity{ID: "ent-1", Name: "Alpha Prime"}
+	model.scopeNames = map[string]string{"scope-public": "public"}
+	model.history = []api.AuditEntry{revertFixtureEntry(at)}
+	model.historyList.SetItems([]string{"audit-1"})
+	model.view = entitiesViewHistory
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	assert.Equal(t, entitiesViewConfirm, model.view)
+	assert.Equal(t, []string{"name", "privacy_scope_ids", "tags"}, model.revertFields)
+	assert.True(t, model.revertPreviewLoading)
+
+	model, _ = model.Update(cmd())
+	assert.False(t, model.revertPreviewLoading)
+	require.Len(t, model.revertRels, 3)
+
+	out := components.SanitizeText(model.View())
+	assert.Contains(t, out, "Fields to restore")
+	assert.Contains(t, out, "no longer exist")
+	assert.Contains(t, out, "1 created after this point")
+	assert.Contains(t, out, "1 context links created after this point")
+
+	// Deselect privacy scopes (second field) and confirm.
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeySpace})
+	assert.Equal(t, []string{"name", "tags"}, model.selectedRevertFields())
+	assert.NotContains(t, components.SanitizeText(model.View()), "no longer exist")
+
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	model, _ = model.Update(cmd())
+	assert.Equal(t, "audit-1", gotBody["audit_id"])
+	assert.Equal(t, []any{"name", "tags"}, gotBody["fields"])
+	assert.Equal(t, entitiesViewDetail, model.view)
+	assert.Nil(t, model.revertFields)
+}
+
+func TestEntitiesRevertRequiresAtLeastOneField(t *testing.T) {
+	model := NewEntitiesModel(nil)
+	model.detail = &api.Entity{ID: "ent-1", Name: "Alpha"}
+	model.startRevertConfirm(revertFixtureEntry(time.Now()))
+	assert.False(t, model.revertPreviewLoading)
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	assert.Empty(t, model.selectedRevertFields())
+	assert.Contains(t, components.SanitizeText(model.View()), "select at least one field")
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	_, isErr := cmd().(errMsg)
+	assert.True(t, isErr)
+	assert.Equal(t, entitiesViewConfirm, model.view)
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	assert.Nil(t, model.selectedRevertFields())
+}
+
+func TestEntitiesRevertPreviewIgnoresStaleMsg(t *testing.T) {
+	model := NewEntitiesModel(nil)
+	model.detail = &api.Entity{ID: "ent-1"}
+	model.startRevertConfirm(revertFixtureEntry(time.Now()))
+	model, _ = model.Update(entityRevertPreviewMsg{auditID: "other", rels: []api.Relationship{{ID: "rel-1"}}})
+	assert.Nil(t, model.revertRels)
+}
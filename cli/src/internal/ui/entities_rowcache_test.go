@@ -0,0 +1,39 @@
+package ui
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestEntitiesListCachesVisibleRows(t *testing.T) {
+	now := time.Now()
+	items := make([]api.Entity, 40)
+	for i := range items {
+		items[i] = api.Entity{ID: fmt.Sprintf("ent-%d", i), Name: fmt.Sprintf("Entity %d", i), Type: "person", Status: "active", UpdatedAt: now}
+	}
+	model := NewEntitiesModel(nil)
+	model.width = 120
+	model, _ = model.Update(entitiesLoadedMsg{items: items})
+	require.Equal(t, len(items), model.labelCache.Len())
+
+	model.View()
+	assert.Equal(t, model.list.PageSize, model.rowCache.Len())
+	for i := 0; i < 3; i++ {
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+		model.View()
+	}
+	assert.Equal(t, model.list.PageSize, model.rowCache.Len())
+
+	// An updated entity renders its new state instead of the cached row.
+	model.items[0].Name = "Renamed"
+	model.items[0].UpdatedAt = now.Add(time.Second)
+	assert.Contains(t, components.SanitizeText(model.View()), "Renamed")
+}
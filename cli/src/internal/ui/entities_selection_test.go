@@ -0,0 +1,107 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestEntitiesSelectionPersistsAcrossSearchReloads(t *testing.T) {
+	m := NewEntitiesModel(nil)
+	m.width = 120
+	m, _ = m.Update(entitiesLoadedMsg{items: []api.Entity{
+		{ID: "ent-1", Name: "Alpha"},
+		{ID: "ent-2", Name: "Beta"},
+	}})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeySpace})
+	require.Equal(t, 1, m.bulkCount())
+
+	m, _ = m.Update(entitiesLoadedMsg{items: []api.Entity{{ID: "ent-2", Name: "Beta"}}})
+	assert.Equal(t, 1, m.bulkCount())
+	assert.Equal(t, "Alpha", m.bulkRecords["ent-1"].Name)
+	assert.Contains(t, components.SanitizeText(m.View()), "selected: 1 (1 not in current view)")
+}
+
+func TestEntitiesSelectAllMatchingPagesThroughServer(t *testing.T) {
+	var searches []string
+	_, client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		searches = append(searches, query.Get("search_text"))
+		offset, err := strconv.Atoi(query.Get("offset"))
+		require.NoError(t, err)
+		count := entitySelectAllPage
+		if offset > 0 {
+			count = 3
+		}
+		items := make([]map[string]any, 0, count)
+		for i := 0; i < count; i++ {
+			items = append(items, map[string]any{"id": fmt.Sprintf("ent-%d", offset+i), "name": "match"})
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": items}))
+	})
+
+	m := NewEntitiesModel(client)
+	m.searchBuf.Value = "match"
+	m, cmd := m.handleListKeys(tea.KeyMsg{Type: tea.KeyCtrlB})
+	require.NotNil(t, cmd)
+	m, _ = m.Update(cmd())
+
+	assert.Equal(t, entitySelectAllPage+3, m.bulkCount())
+	assert.False(t, m.bulkCapped)
+	assert.Equal(t, []string{"match", "match"}, searches)
+}
+
+// entityMatchClient serves total matching entities, honouring limit and
+// offset, and records the limit of every request.
+func entityMatchClient(t *testing.T, total int, limits *[]int) *api.Client {
+	t.Helper()
+	_, client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		limit, err := strconv.Atoi(query.Get("limit"))
+		require.NoError(t, err)
+		offset, err := strconv.Atoi(query.Get("offset"))
+		require.NoError(t, err)
+		*limits = append(*limits, limit)
+		items := []map[string]any{}
+		for i := offset; i < total && i < offset+limit; i++ {
+			items = append(items, map[string]any{"id": fmt.Sprintf("ent-%d", i), "name": "match"})
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": items}))
+	})
+	return client
+}
+
+func TestEntitiesSelectAllMatchingExactlyAtCapIsNotCapped(t *testing.T) {
+	var limits []int
+	m := NewEntitiesModel(entityMatchClient(t, entitySelectAllCap, &limits))
+	msg, ok := m.selectAllMatching("match")().(entitySelectionLoadedMsg)
+	require.True(t, ok)
+
+	assert.Len(t, msg.items, entitySelectAllCap)
+	assert.False(t, msg.capped)
+	assert.Equal(t, 1, limits[len(limits)-1])
+}
+
+func TestEntitiesSelectAllMatchingCapsPastLimit(t *testing.T) {
+	var limits []int
+	m := NewEntitiesModel(entityMatchClient(t, entitySelectAllCap+50, &limits))
+	msg, ok := m.selectAllMatching("match")().(entitySelectionLoadedMsg)
+	require.True(t, ok)
+
+	assert.Len(t, msg.items, entitySelectAllCap)
+	assert.True(t, msg.capped)
+	total := 0
+	for _, limit := range limits {
+		total += limit
+	}
+	assert.Equal(t, entitySelectAllCap+1, total)
+}
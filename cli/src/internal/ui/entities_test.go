@@ -147,7 +147,7 @@ func TestEntitiesLiveSearchTriggersQuery(t *testing.T) {
 	model, _ = model.Update(msg)
 
 	assert.Equal(t, "a", searchText)
-	assert.Equal(t, "a", model.searchBuf)
+	assert.Equal(t, "a", model.searchBuf.Value)
 }
 
 // TestNormalizeEntityNameType handles test normalize entity name type.
@@ -205,7 +205,7 @@ func TestEntitiesSearchSuggestTabAccepts(t *testing.T) {
 	})
 
 	model := NewEntitiesModel(client)
-	model.searchBuf = "al"
+	model.searchBuf.Value = "al"
 	model.searchSuggest = "alxx"
 
 	var cmd tea.Cmd
@@ -214,7 +214,7 @@ func TestEntitiesSearchSuggestTabAccepts(t *testing.T) {
 	msg := cmd()
 	model, _ = model.Update(msg)
 
-	assert.Equal(t, "alxx", model.searchBuf)
+	assert.Equal(t, "alxx", model.searchBuf.Value)
 	assert.Equal(t, "alxx", searchText)
 }
 
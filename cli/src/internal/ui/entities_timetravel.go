@@ -0,0 +1,216 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// timeTravelHiddenFields are row bookkeeping columns that say nothing about state.
+var timeTravelHiddenFields = map[string]bool{
+	"id":         true,
+	"created_at": true,
+	"updated_at": true,
+}
+
+// entityStateMap flattens an entity into audit-style column keys.
+func entityStateMap(entity api.Entity) map[string]any {
+	return recordStateMap(entity)
+}
+
+// auditEntryKeys returns the columns an audit entry touched.
+func auditEntryKeys(entry api.AuditEntry) []string {
+	if len(entry.ChangedFields) > 0 {
+		return entry.ChangedFields
+	}
+	seen := map[string]bool{}
+	keys := []string{}
+	for _, data := range []api.JSONMap{entry.OldData, entry.NewData} {
+		for key := range data {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// reconstructEntityState rebuilds the entity as it stood right after target by
+// undoing every newer entry from the current state, then overlaying the
+// target's own new values.
+func reconstructEntityState(current map[string]any, history []api.AuditEntry, target api.AuditEntry) map[string]any {
+	state := make(map[string]any, len(current))
+	for key, value := range current {
+		state[key] = value
+	}
+
+	newer := make([]api.AuditEntry, 0, len(history))
+	for _, entry := range history {
+		if entry.ID != target.ID && entry.ChangedAt.After(target.ChangedAt) {
+			newer = append(newer, entry)
+		}
+	}
+	sort.SliceStable(newer, func(i, j int) bool {
+		return newer[i].ChangedAt.After(newer[j].ChangedAt)
+	})
+	for _, entry := range newer {
+		for _, key := range auditEntryKeys(entry) {
+			if value, ok := entry.OldData[key]; ok {
+				state[key] = value
+			} else {
+				delete(state, key)
+			}
+		}
+	}
+	for key, value := range target.NewData {
+		state[key] = value
+	}
+	return state
+}
+
+// openTimeTravel shows the entity as of the selected history entry.
+func (m *EntitiesModel) openTimeTravel() {
+	idx := m.historyList.Selected()
+	if m.detail == nil || idx < 0 || idx >= len(m.history) {
+		return
+	}
+	entry := m.history[idx]
+	m.timeTravelEntry = &entry
+	current := entityStateMap(*m.detail)
+	state := reconstructEntityState(current, m.history, entry)
+	// Resolved names only describe the current ids; drop them once the id moved.
+	for name, idKey := range map[string]string{"status": "status_id", "type": "type_id"} {
+		if fmt.Sprint(state[idKey]) != fmt.Sprint(current[idKey]) {
+			delete(state, name)
+		}
+	}
+	m.timeTravelState = state
+	m.timeTravelDiff = false
+	m.view = entitiesViewTimeTravel
+}
+
+// handleTimeTravelKeys handles keys for the time-travel view.
+func (m EntitiesModel) handleTimeTravelKeys(msg tea.KeyMsg) (EntitiesModel, tea.Cmd) {
+	switch {
+	case isBack(msg):
+		m.closeTimeTravel()
+		m.view = entitiesViewHistory
+	case isKey(msg, "d"):
+		m.timeTravelDiff = !m.timeTravelDiff
+	case isEnter(msg), isKey(msg, "r"):
+		if m.timeTravelEntry == nil {
+			return m, nil
+		}
+		entry := *m.timeTravelEntry
+		m.closeTimeTravel()
+		cmd := m.startRevertConfirm(entry)
+		return m, cmd
+	}
+	return m, nil
+}
+
+// closeTimeTravel clears time-travel state.
+func (m *EntitiesModel) closeTimeTravel() {
+	m.timeTravelEntry = nil
+	m.timeTravelState = nil
+	m.timeTravelDiff = false
+}
+
+// timeTravelValue formats a reconstructed field, naming scopes where known.
+func (m EntitiesModel) timeTravelValue(key string, value any) string {
+	if key == "privacy_scope_ids" {
+		if ids := parseStringList(value); len(ids) > 0 {
+			return strings.Join(m.scopeNamesFromIDs(ids), ", ")
+		}
+	}
+	return formatAuditValue(value)
+}
+
+// timeTravelKeys returns the visible state keys in display order.
+func timeTravelKeys(states ...map[string]any) []string {
+	seen := map[string]bool{}
+	keys := []string{}
+	for _, state := range states {
+		for key := range state {
+			if timeTravelHiddenFields[key] || seen[key] {
+				continue
+			}
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// renderTimeTravel renders the reconstructed state, or its diff against now.
+func (m EntitiesModel) renderTimeTravel() string {
+	if m.timeTravelEntry == nil || m.detail == nil {
+		return components.Indent(components.Box(MutedStyle.Render("No history entry selected."), m.width), 1)
+	}
+	entry := *m.timeTravelEntry
+	action := strings.ToUpper(firstNonEmpty(strings.TrimSpace(entry.Action), "update"))
+	summary := components.Table("As Of", []components.TableRow{
+		{Label: "Entity", Value: m.detail.Name},
+		{Label: "Entry", Value: fmt.Sprintf("%s @ %s", action, formatLocalTimeFull(entry.ChangedAt))},
+		{Label: "Actor", Value: formatAuditActor(entry)},
+	}, m.width)
+
+	current := entityStateMap(*m.detail)
+	if m.timeTravelDiff {
+		rows := []components.DiffRow{}
+		for _, key := range timeTravelKeys(m.timeTravelState, current) {
+			from := m.timeTravelValue(key, m.timeTravelState[key])
+			to := m.timeTravelValue(key, current[key])
+			if from == to {
+				continue
+			}
+			rows = append(rows, components.DiffRow{
+				Label: humanizeAuditField(key),
+				From:  from,
+				To:    to,
+				Old:   m.timeTravelState[key],
+				New:   current[key],
+			})
+		}
+		body := MutedStyle.Render("Matches the current state.")
+		if len(rows) > 0 {
+			body = components.DiffView("Then → Now", rows, m.width)
+		}
+		return components.Indent(summary+"\n\n"+body, 1)
+	}
+
+	rows := []components.TableRow{}
+	changed := 0
+	for _, key := range timeTravelKeys(m.timeTravelState) {
+		value := m.timeTravelValue(key, m.timeTravelState[key])
+		differs := value != m.timeTravelValue(key, current[key])
+		if differs {
+			changed++
+		}
+		if key == "metadata" {
+			continue
+		}
+		label := humanizeAuditField(key)
+		if differs {
+			label = "* " + label
+		}
+		rows = append(rows, components.TableRow{Label: label, Value: value})
+	}
+	note := MutedStyle.Render("Identical to the current state.")
+	if changed > 0 {
+		note = WarningStyle.Render(fmt.Sprintf("%d field(s) differ from now (*)", changed))
+	}
+	sections := []string{summary, components.Table("State", rows, m.width)}
+	if meta, ok := m.timeTravelState["metadata"].(map[string]any); ok && len(meta) > 0 {
+		sections = append(sections, components.MetadataTable(meta, m.width))
+	}
+	return components.Indent(strings.Join(sections, "\n\n")+"\n"+note, 1)
+}
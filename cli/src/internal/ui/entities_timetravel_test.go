@@ -0,0 +1,115 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// timeTravelFixture returns an entity and its newest-first history.
+func timeTravelFixture() (api.Entity, []api.AuditEntry) {
+	base := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	entity := api.Entity{
+		ID:       "ent-1",
+		Name:     "Nebula Prime",
+		StatusID: "status-archived",
+		Status:   "archived",
+		Tags:     []string{"core", "infra"},
+		Metadata: api.JSONMap{"owner": "ops", "tier": "gold"},
+	}
+	history := []api.AuditEntry{
+		{
+			ID: "a3", Action: "update", ChangedAt: base.Add(2 * time.Hour),
+			ChangedFields: []string{"status_id"},
+			OldData:       api.JSONMap{"status_id": "status-active"},
+			NewData:       api.JSONMap{"status_id": "status-archived"},
+		},
+		{
+			ID: "a2", Action: "update", ChangedAt: base.Add(time.Hour),
+			ChangedFields: []string{"name", "metadata"},
+			OldData:       api.JSONMap{"name": "Nebula", "metadata": map[string]any{"owner": "ops"}},
+			NewData:       api.JSONMap{"name": "Nebula Prime", "metadata": map[string]any{"owner": "ops", "tier": "gold"}},
+		},
+		{
+			ID: "a1", Action: "insert", ChangedAt: base,
+			NewData: api.JSONMap{"name": "Nebula", "status_id": "status-active"},
+		},
+	}
+	return entity, history
+}
+
+func TestReconstructEntityStateUndoesNewerEntries(t *testing.T) {
+	entity, history := timeTravelFixture()
+	current := entityStateMap(entity)
+
+	state := reconstructEntityState(current, history, history[1])
+	assert.Equal(t, "Nebula Prime", state["name"])
+	assert.Equal(t, "status-active", state["status_id"])
+	assert.Equal(t, []any{"core", "infra"}, state["tags"])
+
+	state = reconstructEntityState(current, history, history[2])
+	assert.Equal(t, "Nebula", state["name"])
+	assert.Equal(t, map[string]any{"owner": "ops"}, state["metadata"])
+
+	state = reconstructEntityState(current, history, history[0])
+	assert.Equal(t, "status-archived", state["status_id"])
+	assert.Equal(t, "Nebula Prime", state["name"])
+}
+
+func TestEntitiesTimeTravelViewDiffAndRevert(t *testing.T) {
+	entity, history := timeTravelFixture()
+	model := NewEntitiesModel(nil)
+	model.width = 120
+	model.detail = &entity
+	model.history = history
+	model.historyList.SetItems([]string{"a3", "a2", "a1"})
+	model.historyList.Down()
+	model.view = entitiesViewHistory
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	require.Equal(t, entitiesViewTimeTravel, model.view)
+	require.NotNil(t, model.timeTravelEntry)
+	assert.Equal(t, "a2", model.timeTravelEntry.ID)
+	assert.NotContains(t, model.timeTravelState, "status", "resolved status name is stale once the id moved")
+
+	out := components.SanitizeText(model.View())
+	assert.Contains(t, out, "Nebula Prime")
+	assert.Contains(t, out, "status-active")
+	assert.Contains(t, out, "tier")
+	assert.Contains(t, out, "differ from now")
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	assert.True(t, model.timeTravelDiff)
+	out = components.SanitizeText(model.View())
+	assert.Contains(t, out, "status-active")
+	assert.Contains(t, out, "status-archived")
+	assert.NotContains(t, out, "core")
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, entitiesViewConfirm, model.view)
+	assert.Equal(t, "a2", model.confirmAuditID)
+	require.NotNil(t, model.confirmAudit)
+	assert.Nil(t, model.timeTravelEntry)
+}
+
+func TestEntitiesTimeTravelBackReturnsToHistory(t *testing.T) {
+	entity, history := timeTravelFixture()
+	model := NewEntitiesModel(nil)
+	model.detail = &entity
+	model.history = history
+	model.historyList.SetItems([]string{"a3", "a2", "a1"})
+	model.view = entitiesViewHistory
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	require.Equal(t, entitiesViewTimeTravel, model.view)
+	assert.Contains(t, components.SanitizeText(model.View()), "Identical to the current state")
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, entitiesViewHistory, model.view)
+	assert.Nil(t, model.timeTravelState)
+}
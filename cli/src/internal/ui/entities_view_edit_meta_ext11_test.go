@@ -16,7 +16,7 @@ func TestEntitiesRenderEditTagsAndRenderEditBranches(t *testing.T) {
 	assert.Equal(t, "-", model.renderEditTags(false))
 
 	model.editTags = []string{"alpha"}
-	model.editTagBuf = "beta"
+	model.editTagBuf.Value = "beta"
 	out := components.SanitizeText(model.renderEditTags(false))
 	assert.Contains(t, out, "alpha")
 	assert.Contains(t, out, "beta")
@@ -161,7 +161,7 @@ func TestEntitiesViewBranchMatrix(t *testing.T) {
 	base.historyList.SetItems([]string{formatHistoryLine(base.history[0])})
 	base.relateResults = []api.Entity{{ID: "ent-2", Name: "Beta", Type: "tool", Status: "active"}}
 	base.relateList.SetItems([]string{"Beta"})
-	base.relEditBuf = "{}"
+	base.relEditMeta.Buffer = "note: ok"
 
 	model := base
 	model.addMeta.Active = true
@@ -84,8 +84,8 @@ func TestEntitiesViewAddSavedResetsOnEsc(t *testing.T) {
 	// Esc should clear addSaved and reset fields.
 	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
 	assert.False(t, model.addSaved)
-	assert.Empty(t, model.addFields[addFieldName].value)
-	assert.Empty(t, model.addFields[addFieldType].value)
+	assert.Empty(t, model.addFields[addFieldName].Value)
+	assert.Empty(t, model.addFields[addFieldType].Value)
 }
 
 // TestEntitiesSearchInputEnterTriggersQueryAndResetsBuffer handles test entities search input enter triggers query and resets buffer.
@@ -107,7 +107,7 @@ func TestEntitiesSearchInputEnterTriggersQueryAndResetsBuffer(t *testing.T) {
 	model.view = entitiesViewSearch
 
 	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
-	assert.Equal(t, "a", model.searchBuf)
+	assert.Equal(t, "a", model.searchBuf.Value)
 
 	var cmd tea.Cmd
 	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
@@ -117,7 +117,7 @@ func TestEntitiesSearchInputEnterTriggersQueryAndResetsBuffer(t *testing.T) {
 
 	assert.Equal(t, "a", searchText)
 	assert.Equal(t, entitiesViewList, model.view)
-	assert.Empty(t, model.searchBuf)
+	assert.Empty(t, model.searchBuf.Value)
 }
 
 // TestEntitiesDetailViewRendersMetadataWhenExpanded handles test entities detail view renders metadata when expanded.
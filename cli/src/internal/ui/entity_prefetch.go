@@ -0,0 +1,122 @@
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+// entityPrefetchHistoryLimit matches the page the history view loads.
+const entityPrefetchHistoryLimit = 50
+
+// entityPrefetch caches relationships and history fetched in the background
+// when an entity detail opens, so r and h render without a loading state.
+type entityPrefetch struct {
+	rels    map[string][]api.Relationship
+	history map[string][]api.AuditEntry
+}
+
+type entityHistoryPrefetchedMsg struct {
+	id    string
+	items []api.AuditEntry
+}
+
+// storeRels caches an entity's relationships.
+func (p *entityPrefetch) storeRels(id string, items []api.Relationship) {
+	if p.rels == nil {
+		p.rels = map[string][]api.Relationship{}
+	}
+	p.rels[id] = items
+}
+
+// storeHistory caches an entity's history.
+func (p *entityPrefetch) storeHistory(id string, items []api.AuditEntry) {
+	if p.history == nil {
+		p.history = map[string][]api.AuditEntry{}
+	}
+	p.history[id] = items
+}
+
+// forgetRels drops cached relationships after they changed.
+func (p *entityPrefetch) forgetRels(id string) {
+	delete(p.rels, id)
+}
+
+// forgetHistory drops cached history after the entity changed.
+func (p *entityPrefetch) forgetHistory(id string) {
+	delete(p.history, id)
+}
+
+// reset drops everything so the next detail open refetches.
+func (p *entityPrefetch) reset() {
+	p.rels = nil
+	p.history = nil
+}
+
+// prefetchDetail fills the detail's relationship summary from cache and loads
+// whatever is missing in the background.
+func (m *EntitiesModel) prefetchDetail(id string) tea.Cmd {
+	rels, cached := m.prefetch.rels[id]
+	m.detailRels = rels
+	if m.client == nil {
+		return nil
+	}
+	var cmds []tea.Cmd
+	if !cached {
+		cmds = append(cmds, m.loadEntityDetailRelationships(id))
+	}
+	if _, ok := m.prefetch.history[id]; !ok {
+		cmds = append(cmds, m.prefetchHistory(id))
+	}
+	m.comments.focused = false
+	if !m.comments.shows(id) {
+		m.comments = newRecordCommentThread(api.CommentOnEntity, id)
+		cmds = append(cmds, m.comments.load(m.client))
+	}
+	return tea.Batch(cmds...)
+}
+
+// prefetchHistory loads an entity's history for the cache. Failures are
+// dropped; pressing h loads again and reports the error then.
+func (m EntitiesModel) prefetchHistory(id string) tea.Cmd {
+	return func() tea.Msg {
+		items, err := m.client.GetEntityHistory(id, entityPrefetchHistoryLimit, 0)
+		if err != nil {
+			return nil
+		}
+		return entityHistoryPrefetchedMsg{id: id, items: items}
+	}
+}
+
+// openCachedRelationships shows cached relationships for the detail entity.
+func (m *EntitiesModel) openCachedRelationships() bool {
+	if m.detail == nil {
+		return false
+	}
+	items, ok := m.prefetch.rels[m.detail.ID]
+	if !ok {
+		return false
+	}
+	m.setRelationships(items)
+	return true
+}
+
+// openCachedHistory shows cached history for the detail entity.
+func (m *EntitiesModel) openCachedHistory() bool {
+	if m.detail == nil {
+		return false
+	}
+	items, ok := m.prefetch.history[m.detail.ID]
+	if !ok {
+		return false
+	}
+	m.setHistory(items)
+	return true
+}
+
+// forgetDetailRels drops cached relationships for the open detail entity.
+func (m *EntitiesModel) forgetDetailRels() {
+	if m.detail != nil {
+		m.prefetch.forgetRels(m.detail.ID)
+	}
+}
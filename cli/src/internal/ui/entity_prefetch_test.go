@@ -0,0 +1,89 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+func TestEntityDetailPrefetchServesRelationshipsAndHistory(t *testing.T) {
+	var relCalls, historyCalls atomic.Int32
+	_, client := testEntitiesClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/api/relationships/"):
+			relCalls.Add(1)
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{{
+				"id": "rel-1", "source_type": "entity", "source_id": "ent-1", "target_type": "entity",
+				"target_id": "ent-2", "relationship_type": "knows", "status": "active", "created_at": time.Now(),
+			}}}))
+		case strings.HasSuffix(r.URL.Path, "/history"):
+			historyCalls.Add(1)
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{{
+				"id": "aud-1", "table_name": "entities", "record_id": "ent-1", "action": "update", "changed_at": time.Now(),
+			}}}))
+		default:
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{}}))
+		}
+	})
+
+	model := NewEntitiesModel(client)
+	model, _ = model.Update(entitiesLoadedMsg{items: []api.Entity{{ID: "ent-1", Name: "Alpha", Type: "person"}}})
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.Equal(t, entitiesViewDetail, model.view)
+	require.NotNil(t, cmd)
+	model = runEntityCmds(t, model, cmd)
+	require.Len(t, model.detailRels, 1)
+	rels, history := relCalls.Load(), historyCalls.Load()
+	assert.Equal(t, int32(1), history)
+
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	assert.Nil(t, cmd)
+	assert.False(t, model.relLoading)
+	require.Len(t, model.rels, 1)
+	assert.Equal(t, "rel-1", model.rels[0].ID)
+
+	model.view = entitiesViewDetail
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("h")})
+	assert.Nil(t, cmd)
+	assert.False(t, model.historyLoading)
+	require.Len(t, model.history, 1)
+
+	// Both drill-ins were served from cache.
+	assert.Equal(t, rels, relCalls.Load())
+	assert.Equal(t, history, historyCalls.Load())
+
+	// A changed relationship drops the cache so r loads fresh data.
+	model, _ = model.Update(relationshipCreatedMsg{})
+	model.view = entitiesViewDetail
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	assert.NotNil(t, cmd)
+}
+
+// runEntityCmds runs a command, unpacking batches, and feeds every result
+// back into the model.
+func runEntityCmds(t *testing.T, model EntitiesModel, cmd tea.Cmd) EntitiesModel {
+	t.Helper()
+	if cmd == nil {
+		return model
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		for _, c := range batch {
+			model = runEntityCmds(t, model, c)
+		}
+		return model
+	}
+	if msg != nil {
+		model, _ = model.Update(msg)
+	}
+	return model
+}
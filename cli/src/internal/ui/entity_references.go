@@ -0,0 +1,88 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+const entityReferenceRows = 8
+
+// entityReference is one item that links to or mentions an entity.
+type entityReference struct {
+	kind  string
+	id    string
+	title string
+	via   string
+}
+
+type entityReferencesLoadedMsg struct {
+	id    string
+	items []entityReference
+	err   error
+}
+
+// loadEntityReferences fetches what references entity for the detail view.
+// The server matches relationships and name mentions across context, jobs,
+// logs, and protocols, limited to what the caller can see.
+func (m EntitiesModel) loadEntityReferences(entity api.Entity) tea.Cmd {
+	return func() tea.Msg {
+		refs, err := m.client.GetEntityReferences(entity.ID)
+		if err != nil {
+			return entityReferencesLoadedMsg{id: entity.ID, err: err}
+		}
+		items := make([]entityReference, 0, len(refs))
+		for _, ref := range refs {
+			items = append(items, entityReference{kind: ref.Kind, id: ref.ID, title: ref.Title, via: ref.Via})
+		}
+		return entityReferencesLoadedMsg{id: entity.ID, items: items}
+	}
+}
+
+// renderEntityReferences renders the Referenced by section of entity detail.
+func (m EntitiesModel) renderEntityReferences() string {
+	title := "Referenced by"
+	var content string
+	switch {
+	case m.refsLoading:
+		content = MutedStyle.Render("Loading references...")
+	case m.refsErr != "":
+		content = MutedStyle.Render("Could not load references: " + components.SanitizeOneLine(m.refsErr))
+	case len(m.refs) == 0:
+		content = MutedStyle.Render("Nothing references this entity.")
+	default:
+		title = fmt.Sprintf("Referenced by (%d)", len(m.refs))
+		contentWidth := components.BoxContentWidth(m.width) - 2
+		if contentWidth < 32 {
+			contentWidth = 32
+		}
+		kindWidth, viaWidth := 10, 14
+		titleWidth := contentWidth - kindWidth - viaWidth - 2
+		if titleWidth < 12 {
+			titleWidth = 12
+		}
+		cols := []components.TableColumn{
+			{Header: "Kind", Width: kindWidth, Align: lipgloss.Left},
+			{Header: "Title", Width: titleWidth, Align: lipgloss.Left},
+			{Header: "Via", Width: viaWidth, Align: lipgloss.Left},
+		}
+		rows := make([][]string, 0, entityReferenceRows+1)
+		for i, ref := range m.refs {
+			if i >= entityReferenceRows {
+				rows = append(rows, []string{"More", fmt.Sprintf("%d more references", len(m.refs)-entityReferenceRows), ""})
+				break
+			}
+			rows = append(rows, []string{
+				ref.kind,
+				components.SanitizeOneLine(ref.title),
+				components.SanitizeOneLine(ref.via),
+			})
+		}
+		content = components.TableGrid(cols, rows, contentWidth)
+	}
+	return components.TitledBox(title, MetaKeyStyle.Render(title)+"\n\n"+content, m.width)
+}
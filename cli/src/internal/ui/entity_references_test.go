@@ -0,0 +1,87 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func entityReferencesHandler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/entities/ent-1/references" {
+			http.NotFound(w, r)
+			return
+		}
+		data := []map[string]any{
+			{"kind": "context", "id": "ctx-1", "title": "Launch Notes", "via": "related-to"},
+			{"kind": "context", "id": "ctx-2", "title": "Nebula Roadmap", "via": "mentions"},
+			{"kind": "job", "id": "job-1", "title": "Ship Nebula", "via": "owns"},
+			{"kind": "job", "id": "job-2", "title": "Write docs", "via": "mentions"},
+			{"kind": "log", "id": "log-1", "title": "note 2026-10-16 09:00", "via": "mentions"},
+			{"kind": "protocol", "id": "pro-1", "title": "Nebula Ops", "via": "mentions"},
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": data}))
+	}
+}
+
+func TestLoadEntityReferencesReadsServerEndpoint(t *testing.T) {
+	_, client := testEntitiesClient(t, entityReferencesHandler(t))
+	model := NewEntitiesModel(client)
+
+	msg, ok := model.loadEntityReferences(api.Entity{ID: "ent-1", Name: "Nebula"})().(entityReferencesLoadedMsg)
+	require.True(t, ok)
+	require.NoError(t, msg.err)
+	got := make([][3]string, len(msg.items))
+	for i, ref := range msg.items {
+		got[i] = [3]string{ref.kind, ref.id, ref.via}
+	}
+	assert.Equal(t, [][3]string{
+		{"context", "ctx-1", "related-to"},
+		{"context", "ctx-2", "mentions"},
+		{"job", "job-1", "owns"},
+		{"job", "job-2", "mentions"},
+		{"log", "log-1", "mentions"},
+		{"protocol", "pro-1", "mentions"},
+	}, got)
+}
+
+func TestEntityDetailReportsReferenceLoadFailure(t *testing.T) {
+	_, client := testEntitiesClient(t, entityReferencesHandler(t))
+	model := NewEntitiesModel(client)
+	model.width = 120
+	model.view = entitiesViewDetail
+	model.detail = &api.Entity{ID: "ent-2", Name: "Other"}
+	model.refsLoading = true
+
+	model, _ = model.Update(model.loadEntityReferences(*model.detail)())
+	assert.False(t, model.refsLoading)
+	assert.NotEmpty(t, model.refsErr)
+	assert.Contains(t, components.SanitizeText(model.View()), "Could not load references")
+}
+
+func TestEntityDetailShowsReferencedBy(t *testing.T) {
+	_, client := testEntitiesClient(t, entityReferencesHandler(t))
+	model := NewEntitiesModel(client)
+	model.width = 120
+	model.view = entitiesViewDetail
+	model.detail = &api.Entity{ID: "ent-1", Name: "Nebula"}
+	assert.NotContains(t, components.SanitizeText(model.View()), "Referenced by")
+
+	model.refsLoading = true
+	assert.Contains(t, components.SanitizeText(model.View()), "Loading references...")
+
+	model, _ = model.Update(model.loadEntityReferences(*model.detail)())
+	require.Len(t, model.refs, 6)
+	view := components.SanitizeText(model.View())
+	assert.Contains(t, view, "Referenced by (6)")
+	assert.Contains(t, view, "Launch Notes")
+	assert.Contains(t, view, "Nebula Ops")
+
+	model.confirmKind = "entity-archive"
+	assert.Contains(t, components.SanitizeText(model.renderConfirm()), "6 items")
+}
@@ -0,0 +1,96 @@
+package ui
+
+import (
+	"hash/fnv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// entityTypeLook is the color and nerd-font icon an entity type is drawn with.
+type entityTypeLook struct {
+	color lipgloss.Color
+	icon  string
+}
+
+// builtinEntityTypeLooks covers the entity types seeded by the migrations.
+var builtinEntityTypeLooks = map[string]entityTypeLook{
+	"person":       {color: "#7fa6d9", icon: "\uf007"},
+	"organization": {color: "#b48ead", icon: "\uf1ad"},
+	"project":      {color: "#9972cf", icon: "\uf07b"},
+	"tool":         {color: "#c78854", icon: "\uf0ad"},
+	"course":       {color: "#5f9ea0", icon: "\uf19d"},
+	"idea":         {color: "#d8b35a", icon: "\uf0eb"},
+	"framework":    {color: "#5fa87d", icon: "\uf121"},
+	"paper":        {color: "#c77d8f", icon: "\uf15c"},
+	"university":   {color: "#6b8fb5", icon: "\uf19c"},
+	"document":     {color: "#9ba0bf", icon: "\uf15b"},
+}
+
+// entityTypeFallbackColors color custom taxonomy types. A type always hashes
+// to the same color so it stays recognizable across sessions.
+var entityTypeFallbackColors = []lipgloss.Color{
+	"#7fa6d9", "#b48ead", "#5fa87d", "#d8b35a", "#c77d8f", "#5f9ea0", "#c78854",
+}
+
+const entityTypeFallbackIcon = "\uf02b"
+
+// entityTypeConfig holds the user overrides applied on top of the built-in
+// looks. It is set once per App from the loaded config.
+var entityTypeConfig *config.Config
+
+// configureEntityTypes applies the config's entity type colors and icons.
+func configureEntityTypes(cfg *config.Config) {
+	entityTypeConfig = cfg
+}
+
+// entityTypeLookFor resolves the color and icon for typ. Icons only show with
+// nerd_font enabled or when the config sets one explicitly.
+func entityTypeLookFor(typ string) entityTypeLook {
+	key := strings.ToLower(strings.TrimSpace(typ))
+	look, ok := builtinEntityTypeLooks[key]
+	if !ok {
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(key))
+		look = entityTypeLook{
+			color: entityTypeFallbackColors[int(h.Sum32()%uint32(len(entityTypeFallbackColors)))],
+			icon:  entityTypeFallbackIcon,
+		}
+	}
+	if entityTypeConfig == nil || !entityTypeConfig.NerdFont {
+		look.icon = ""
+	}
+	if style, ok := entityTypeConfig.EntityTypeStyleFor(key); ok {
+		if style.Color != "" {
+			look.color = lipgloss.Color(style.Color)
+		}
+		if style.Icon != "" {
+			look.icon = style.Icon
+		}
+	}
+	return look
+}
+
+// entityTypeLabel prefixes label with the icon for typ, if any.
+func entityTypeLabel(typ, label string) string {
+	label = strings.TrimSpace(components.SanitizeOneLine(label))
+	if icon := entityTypeLookFor(typ).icon; icon != "" {
+		return icon + " " + label
+	}
+	return label
+}
+
+// renderEntityTypeCell renders label for a table cell, clamped to width and
+// drawn in the color and icon of typ.
+func renderEntityTypeCell(typ, label string, width int) string {
+	text := components.ClampTextWidthEllipsis(entityTypeLabel(typ, label), width)
+	return lipgloss.NewStyle().Foreground(entityTypeLookFor(typ).color).Render(text)
+}
+
+// renderEntityTypeBadge renders a type as a TypeBadgeStyle badge in its color.
+func renderEntityTypeBadge(typ string) string {
+	return TypeBadgeStyle.Foreground(entityTypeLookFor(typ).color).Render(entityTypeLabel(typ, typ))
+}
@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntityTypeLookUsesBuiltinsAndFallbacks(t *testing.T) {
+	configureEntityTypes(nil)
+	t.Cleanup(func() { configureEntityTypes(nil) })
+
+	look := entityTypeLookFor("Person")
+	assert.Equal(t, lipgloss.Color("#7fa6d9"), look.color)
+	assert.Empty(t, look.icon)
+
+	custom := entityTypeLookFor("podcast")
+	assert.Equal(t, custom, entityTypeLookFor("podcast"))
+	assert.Contains(t, entityTypeFallbackColors, custom.color)
+	assert.Equal(t, "person", entityTypeLabel("person", "person"))
+}
+
+func TestEntityTypeLookAppliesNerdFontAndOverrides(t *testing.T) {
+	configureEntityTypes(&config.Config{
+		NerdFont: true,
+		EntityTypes: map[string]config.EntityTypeStyle{
+			"person": {Color: "#ff8800", Icon: "@"},
+			"tool":   {Color: "not-a-color"},
+		},
+	})
+	t.Cleanup(func() { configureEntityTypes(nil) })
+
+	assert.Equal(t, entityTypeLook{color: "#ff8800", icon: "@"}, entityTypeLookFor("person"))
+	assert.Equal(t, builtinEntityTypeLooks["tool"], entityTypeLookFor("tool"))
+	assert.Equal(t, entityTypeFallbackIcon, entityTypeLookFor("podcast").icon)
+	assert.Equal(t, "@ Alice", entityTypeLabel("person", "Alice"))
+	assert.Equal(t, "@ person", components.SanitizeText(renderEntityTypeCell("person", "person", 20)))
+	assert.Contains(t, components.SanitizeText(renderEntityTypeBadge("person")), "@ person")
+	assert.Equal(t, "@ pe...", components.SanitizeText(renderEntityTypeCell("person", "person", 7)))
+}
+
+func TestNewAppConfiguresEntityTypeLooks(t *testing.T) {
+	t.Cleanup(func() { configureEntityTypes(nil) })
+	app := NewApp(nil, &config.Config{
+		APIKey:      "nbl_test",
+		EntityTypes: map[string]config.EntityTypeStyle{"idea": {Icon: "*"}},
+	})
+	app.entities.width = 200
+	app.entities.items = []api.Entity{{ID: "ent-1", Name: "Moonshot", Type: "idea"}}
+	app.entities.list.SetItems([]string{"Moonshot"})
+	assert.Contains(t, components.SanitizeText(app.entities.renderList()), "* idea")
+}
+
+func TestRelationshipEdgeShowsLoadedEntityTypes(t *testing.T) {
+	configureEntityTypes(&config.Config{EntityTypes: map[string]config.EntityTypeStyle{"person": {Icon: "@"}}})
+	t.Cleanup(func() { configureEntityTypes(nil) })
+
+	_, client := relTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/entities/ent-2" {
+			_, _ = w.Write([]byte(`{"data":{"id":"ent-2","name":"Bob","type":"person"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+	model := NewRelationshipsModel(client)
+	rel := api.Relationship{SourceID: "ent-1", SourceType: "entity", SourceName: "Alpha", TargetID: "ent-2", TargetType: "entity"}
+	msg, ok := model.loadRelationshipNames([]api.Relationship{rel})().(relTabNamesLoadedMsg)
+	require.True(t, ok)
+	assert.Equal(t, map[string]string{"ent-2": "person"}, msg.types)
+
+	assert.Equal(t, "Alpha -> Bob", model.renderEdge(rel, "Alpha", "Bob", 40))
+	model, _ = model.Update(msg)
+	assert.Equal(t, "Alpha -> @ Bob", components.SanitizeText(model.renderEdge(rel, "Alpha", "Bob", 40)))
+}
@@ -0,0 +1,67 @@
+package ui
+
+import (
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+// errorRemedy is the recovery guidance shown under the global error.
+type errorRemedy struct {
+	hint    string
+	command string
+}
+
+// setError records err as the global error, classified so the error box
+// can offer recovery steps that fit it.
+func (a *App) setError(err error) {
+	a.err = err.Error()
+	a.lastErrCode, a.lastErrMsg = parseErrorCodeAndMessage(a.err)
+	a.errKind = api.ClassifyError(err)
+	a.showRecoveryHints = hasRecoveryKeys(a.errKind)
+}
+
+// clearError drops the global error and its recovery keys.
+func (a *App) clearError() {
+	a.err = ""
+	a.lastErrCode = ""
+	a.lastErrMsg = ""
+	a.errKind = api.ErrorKindUnknown
+	a.showRecoveryHints = false
+}
+
+// hasRecoveryKeys reports whether errors of kind enable the r/s/c recovery
+// keys. Only credential problems are fixed from inside the TUI.
+func hasRecoveryKeys(kind api.ErrorKind) bool {
+	return kind == api.ErrorKindAuth || kind == api.ErrorKindScope
+}
+
+// errorRemedy returns the recovery guidance for the current error.
+func (a App) errorRemedy() errorRemedy {
+	switch a.errKind {
+	case api.ErrorKindScope:
+		return errorRemedy{
+			hint:    "Recovery: this key lacks the scope for that action. [s] review keys  [r] re-login  [c] show command",
+			command: "nebula keys list",
+		}
+	case api.ErrorKindValidation:
+		return errorRemedy{hint: "Recovery: fix the input and submit again."}
+	case api.ErrorKindRateLimit:
+		return errorRemedy{hint: "Recovery: the server is throttling requests; wait a few seconds, then retry."}
+	case api.ErrorKindNetwork:
+		return errorRemedy{hint: "Recovery: start the API with `nebula start` or check api_url with `nebula doctor`."}
+	case api.ErrorKindServer:
+		if shouldShowMultiAPIRecoveryHint(a.lastErrCode, a.lastErrMsg, a.err) {
+			return errorRemedy{hint: "Recovery: stop duplicate API processes and restart with `nebula start`."}
+		}
+		return errorRemedy{hint: "Recovery: the server failed; check `nebula logs` for details."}
+	}
+	if a.showRecoveryHints {
+		return errorRemedy{
+			hint:    "Recovery: [r] re-login  [s] settings  [c] show command",
+			command: a.recoveryCommand,
+		}
+	}
+	if shouldShowMultiAPIRecoveryHint(a.lastErrCode, a.lastErrMsg, a.err) {
+		return errorRemedy{hint: "Recovery: stop duplicate API processes and restart with `nebula start`."}
+	}
+	return errorRemedy{}
+}
@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+func TestErrMsgClassifiesRemedyByKind(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		keys bool
+		hint string
+	}{
+		{"auth", &api.Error{Status: http.StatusUnauthorized}, true, "[r] re-login"},
+		{"scope", errors.New("FORBIDDEN: admin scope required"), true, "lacks the scope"},
+		{"validation", errors.New("VALIDATION_ERROR: title is required"), false, "fix the input"},
+		{"rate limit", errors.New("RATE_LIMITED: slow down"), false, "throttling"},
+		{"network", fmt.Errorf("request failed: %w", api.ErrUnreachable), false, "nebula start"},
+		{"server", errors.New("HTTP 503: unavailable"), false, "nebula logs"},
+		{"unknown", errors.New("something odd"), false, ""},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			model, _ := NewApp(nil, &config.Config{}).Update(errMsg{tc.err})
+			app := model.(App)
+			assert.Equal(t, tc.keys, app.showRecoveryHints)
+			if tc.hint == "" {
+				assert.Empty(t, app.errorRemedy().hint)
+			} else {
+				assert.Contains(t, app.errorRemedy().hint, tc.hint)
+			}
+		})
+	}
+}
+
+func TestScopeRemedyCommandKey(t *testing.T) {
+	model, _ := NewApp(nil, &config.Config{}).Update(errMsg{errors.New("FORBIDDEN: admin scope required")})
+	model, cmd := model.(App).Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	app := model.(App)
+	require.NotNil(t, cmd)
+	require.NotNil(t, app.toast)
+	assert.Equal(t, "nebula keys list", app.toast.text)
+
+	// Any other key dismisses the error and its recovery keys.
+	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	app = model.(App)
+	assert.Empty(t, app.err)
+	assert.Equal(t, api.ErrorKindUnknown, app.errKind)
+}
@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+// fieldErrorsMsg carries a rejected save's per-field validation errors back
+// to the form that submitted it.
+type fieldErrorsMsg struct{ fields map[string]string }
+
+// saveFailed is the message for a failed form save: field errors go back to
+// the form, anything else to the global error box.
+func saveFailed(err error) tea.Msg {
+	if fields := api.FieldErrors(err); len(fields) > 0 {
+		return fieldErrorsMsg{fields: fields}
+	}
+	return errMsg{err}
+}
+
+// fieldErrors maps form field indexes to their validation message.
+type fieldErrors map[int]string
+
+// mapFieldErrors assigns API field errors to form fields through index,
+// keyed by JSON field name. It returns the first offending field (-1 when
+// none map) and the errors for fields the form does not show as one line.
+func mapFieldErrors(fields map[string]string, index map[string]int) (fieldErrors, int, string) {
+	errs := fieldErrors{}
+	first := -1
+	var rest []string
+	for name, msg := range fields {
+		i, ok := index[name]
+		if !ok {
+			rest = append(rest, fmt.Sprintf("%s: %s", name, msg))
+			continue
+		}
+		if _, taken := errs[i]; !taken {
+			errs[i] = msg
+		}
+		if first < 0 || i < first {
+			first = i
+		}
+	}
+	sort.Strings(rest)
+	return errs, first, strings.Join(rest, "; ")
+}
+
+// renderFieldError renders a field's validation message under it.
+func renderFieldError(errs fieldErrors, field int) string {
+	msg, ok := errs[field]
+	if !ok {
+		return ""
+	}
+	return "\n" + ErrorStyle.Render("  ! "+msg)
+}
+
+// withFieldErrorRows inserts a row under each form grid row that has a
+// validation error and shifts the active row to match.
+func withFieldErrorRows(rows [][2]string, errs fieldErrors, active int) ([][2]string, int) {
+	if len(errs) == 0 {
+		return rows, active
+	}
+	out := make([][2]string, 0, len(rows)+len(errs))
+	shifted := active
+	for i, row := range rows {
+		out = append(out, row)
+		if msg, ok := errs[i]; ok {
+			out = append(out, [2]string{"", "! " + msg})
+			if i < active {
+				shifted++
+			}
+		}
+	}
+	return out, shifted
+}
@@ -0,0 +1,99 @@
+package ui
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// validationHandler rejects POSTs to path with FastAPI-style field errors.
+func validationHandler(path, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == path && r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte(body))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	}
+}
+
+func TestMapFieldErrors(t *testing.T) {
+	errs, first, rest := mapFieldErrors(map[string]string{
+		"tags":  "too many",
+		"name":  "too long",
+		"extra": "not allowed",
+		"other": "bad",
+	}, entityAddFieldIndex)
+	assert.Equal(t, fieldErrors{addFieldName: "too long", addFieldTags: "too many"}, errs)
+	assert.Equal(t, addFieldName, first)
+	assert.Equal(t, "extra: not allowed; other: bad", rest)
+
+	rows, active := withFieldErrorRows([][2]string{{"A", "1"}, {"B", "2"}, {"C", "3"}}, fieldErrors{0: "bad"}, 2)
+	assert.Len(t, rows, 4)
+	assert.Equal(t, "! bad", rows[1][1])
+	assert.Equal(t, 3, active)
+}
+
+func TestEntityAddShowsFieldErrorsInline(t *testing.T) {
+	_, client := testEntitiesClient(t, validationHandler("/api/entities",
+		`{"detail":[{"loc":["body","type"],"msg":"Unknown entity type"},{"loc":["body","bogus"],"msg":"extra input"}]}`))
+	model := NewEntitiesModel(client)
+	model.width = 110
+	model.addFields[addFieldName].Value = "Zed"
+	model.addFields[addFieldType].Value = "robot"
+
+	model, cmd := model.saveAdd()
+	require.NotNil(t, cmd)
+	msg := cmd()
+	require.IsType(t, fieldErrorsMsg{}, msg)
+	model, _ = model.Update(msg)
+
+	assert.False(t, model.addSaving)
+	assert.Equal(t, addFieldType, model.addFocus)
+	view := components.SanitizeText(model.View())
+	assert.Contains(t, view, "! Unknown entity type")
+	assert.Contains(t, view, "bogus: extra input")
+
+	model, _ = model.saveAdd()
+	assert.Empty(t, model.addFieldErrs)
+}
+
+func TestContextAddShowsFieldErrorsInline(t *testing.T) {
+	_, client := contextTestClient(t, validationHandler("/api/context",
+		`{"detail":[{"loc":["body","content"],"msg":"String should have at most 10 characters"}]}`))
+	model := NewContextModel(client)
+	model.width = 110
+	model.fields[fieldTitle].Value = "Long note"
+	model.fields[fieldNotes].Value = "far too long for the limit"
+
+	model, cmd := model.save()
+	require.NotNil(t, cmd)
+	model, _ = model.Update(cmd())
+	assert.False(t, model.saving)
+	assert.Equal(t, fieldNotes, model.focus)
+	assert.Empty(t, model.errText)
+	assert.Contains(t, components.SanitizeText(model.View()), "! String should have at most 10 characters")
+}
+
+func TestFileAddShowsFieldErrorsInline(t *testing.T) {
+	_, client := testFilesClient(t, validationHandler("/api/files",
+		`{"detail":[{"loc":["body","mime_type"],"msg":"Invalid mime type"}]}`))
+	model := NewFilesModel(client)
+	model.width = 110
+	model.view = filesViewAdd
+	model.addName.Value = "report.pdf"
+	model.addPath.Value = "/tmp/report.pdf"
+	model.addMime.Value = "nonsense"
+
+	model, cmd := model.saveAdd()
+	require.NotNil(t, cmd)
+	model, _ = model.Update(cmd())
+	assert.False(t, model.addSaving)
+	assert.Equal(t, fileFieldMime, model.addFocus)
+	assert.Contains(t, components.SanitizeText(model.View()), "! Invalid mime type")
+}
@@ -1,23 +1,33 @@
 package ui
 
 import (
+	"cmp"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/filecheck"
 	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
 )
 
 // --- Messages ---
 
-type filesLoadedMsg struct{ items []api.File }
+type filesLoadedMsg struct {
+	items  []api.File
+	queued time.Time
+}
 type fileCreatedMsg struct{}
 type fileUpdatedMsg struct{}
-type filesScopesLoadedMsg struct{ options []string }
+type filesBulkUpdatedMsg struct{}
+type filesScopesLoadedMsg struct {
+	options []string
+	info    map[string]scopeInfo
+}
 type fileRelationshipsLoadedMsg struct {
 	id            string
 	relationships []api.Relationship
@@ -32,6 +42,7 @@ const (
 	filesViewList
 	filesViewDetail
 	filesViewEdit
+	filesViewVerify
 )
 
 const (
@@ -55,14 +66,26 @@ type FilesModel struct {
 	items         []api.File
 	all           []api.File
 	list          *components.List
+	sort          tableSort
+	columns       tableLayout
 	loading       bool
+	loadLatency   time.Duration
 	view          filesView
 	modeFocus     bool
 	filtering     bool
-	searchBuf     string
+	searchBuf     components.TextInput
 	searchSuggest string
+	bulk          libraryBulk
 	detail        *api.File
 	detailRels    []api.Relationship
+	attach        *fileAttachPicker
+
+	// verify
+	verifyResults []filecheck.Result
+	verifyLoading bool
+	verifySaving  bool
+	verifyIndex   int
+	verifyNote    string
 	errText       string
 	metaExpanded  bool
 	width         int
@@ -74,27 +97,30 @@ type FilesModel struct {
 	addFocus     int
 	addStatusIdx int
 	addTags      []string
-	addTagBuf    string
-	addName      string
-	addPath      string
-	addMime      string
-	addSize      string
-	addChecksum  string
+	addTagBuf    components.TextInput
+	tagOptions   []string
+	addName      components.TextInput
+	addPath      components.TextInput
+	addMime      components.TextInput
+	addSize      components.TextInput
+	addChecksum  components.TextInput
 	addMeta      MetadataEditor
 	addSaving    bool
+	addProbing   bool
 	addSaved     bool
 	addErr       string
+	addFieldErrs fieldErrors
 
 	// edit
 	editFocus     int
 	editStatusIdx int
 	editTags      []string
-	editTagBuf    string
-	editName      string
-	editPath      string
-	editMime      string
-	editSize      string
-	editChecksum  string
+	editTagBuf    components.TextInput
+	editName      components.TextInput
+	editPath      components.TextInput
+	editMime      components.TextInput
+	editSize      components.TextInput
+	editChecksum  components.TextInput
 	editMeta      MetadataEditor
 	editSaving    bool
 }
@@ -124,7 +150,7 @@ func (m FilesModel) Init() tea.Cmd {
 	m.view = filesViewList
 	m.modeFocus = false
 	m.filtering = false
-	m.searchBuf = ""
+	m.searchBuf.Reset()
 	m.searchSuggest = ""
 	m.detail = nil
 	m.detailRels = nil
@@ -133,12 +159,12 @@ func (m FilesModel) Init() tea.Cmd {
 	m.addFocus = 0
 	m.addStatusIdx = statusIndex(fileStatusOptions, "active")
 	m.addTags = nil
-	m.addTagBuf = ""
-	m.addName = ""
-	m.addPath = ""
-	m.addMime = ""
-	m.addSize = ""
-	m.addChecksum = ""
+	m.addTagBuf.Reset()
+	m.addName.Reset()
+	m.addPath.Reset()
+	m.addMime.Reset()
+	m.addSize.Reset()
+	m.addChecksum.Reset()
 	m.addMeta.Reset()
 	m.addSaving = false
 	m.addSaved = false
@@ -146,12 +172,12 @@ func (m FilesModel) Init() tea.Cmd {
 	m.editFocus = 0
 	m.editStatusIdx = statusIndex(fileStatusOptions, "active")
 	m.editTags = nil
-	m.editTagBuf = ""
-	m.editName = ""
-	m.editPath = ""
-	m.editMime = ""
-	m.editSize = ""
-	m.editChecksum = ""
+	m.editTagBuf.Reset()
+	m.editName.Reset()
+	m.editPath.Reset()
+	m.editMime.Reset()
+	m.editSize.Reset()
+	m.editChecksum.Reset()
 	m.editMeta.Reset()
 	m.editSaving = false
 	return m.loadFiles()
@@ -162,19 +188,67 @@ func (m FilesModel) Update(msg tea.Msg) (FilesModel, tea.Cmd) {
 	switch msg := msg.(type) {
 	case filesLoadedMsg:
 		m.loading = false
+		m.loadLatency = loadElapsed(msg.queued)
 		m.all = msg.items
 		m.applyFileSearch()
 		return m, m.loadScopeOptions()
 	case filesScopesLoadedMsg:
 		m.scopeOptions = msg.options
 		m.addMeta.SetScopeOptions(m.scopeOptions)
+		m.addMeta.SetScopeInfo(msg.info)
 		m.editMeta.SetScopeOptions(m.scopeOptions)
+		m.editMeta.SetScopeInfo(msg.info)
 		return m, nil
 	case fileRelationshipsLoadedMsg:
 		if m.detail != nil && m.detail.ID == msg.id {
 			m.detailRels = msg.relationships
 		}
 		return m, nil
+	case filesVerifiedMsg:
+		m.verifyLoading = false
+		if msg.err != nil {
+			m.view = filesViewList
+			m.errText = msg.err.Error()
+			return m, nil
+		}
+		m.verifyResults = msg.results
+		m.verifyIndex = 0
+		return m, nil
+	case filesReregisteredMsg:
+		m.verifySaving = false
+		m.verifyNote = fmt.Sprintf("Re-registered %d files.", msg.updated)
+		if msg.updated == 1 {
+			m.verifyNote = "Re-registered 1 file."
+		}
+		if msg.err != nil {
+			m.verifyNote = msg.err.Error()
+		}
+		m.loading = true
+		return m, tea.Batch(m.verifyFiles(), m.loadFiles())
+	case fileProbedMsg:
+		m.applyProbe(msg)
+		return m, nil
+	case fileAttachResultsMsg:
+		m.applyAttachResults(msg)
+		return m, nil
+	case fileAttachedMsg:
+		if m.attach == nil {
+			return m, nil
+		}
+		if msg.err != nil {
+			m.attach.saving = false
+			m.attach.errText = msg.err.Error()
+			return m, nil
+		}
+		m.attach = nil
+		if m.detail == nil || m.detail.ID != msg.fileID {
+			return m, nil
+		}
+		return m, m.loadDetailRelationships(msg.fileID)
+	case fieldErrorsMsg:
+		m.addSaving = false
+		m.showAddFieldErrors(msg)
+		return m, nil
 	case fileCreatedMsg:
 		m.addSaving = false
 		m.addSaved = true
@@ -186,6 +260,10 @@ func (m FilesModel) Update(msg tea.Msg) (FilesModel, tea.Cmd) {
 		m.view = filesViewList
 		m.loading = true
 		return m, m.loadFiles()
+	case filesBulkUpdatedMsg:
+		m.bulk.clear()
+		m.loading = true
+		return m, m.loadFiles()
 	case errMsg:
 		m.loading = false
 		m.addSaving = false
@@ -201,6 +279,9 @@ func (m FilesModel) Update(msg tea.Msg) (FilesModel, tea.Cmd) {
 			m.editMeta.HandleKey(msg)
 			return m, nil
 		}
+		if m.attach != nil && m.view == filesViewDetail {
+			return m.handleAttachKeys(msg)
+		}
 		if m.modeFocus {
 			return m.handleModeKeys(msg)
 		}
@@ -211,6 +292,8 @@ func (m FilesModel) Update(msg tea.Msg) (FilesModel, tea.Cmd) {
 			return m.handleEditKeys(msg)
 		case filesViewDetail:
 			return m.handleDetailKeys(msg)
+		case filesViewVerify:
+			return m.handleVerifyKeys(msg)
 		default:
 			return m.handleListKeys(msg)
 		}
@@ -229,6 +312,12 @@ func (m FilesModel) View() string {
 	if m.filtering && m.view == filesViewList {
 		return components.Indent(components.InputDialog("Filter Files", m.searchBuf), 1)
 	}
+	if m.bulk.prompt != "" && m.view == filesViewList {
+		return components.Indent(components.InputDialog(m.bulk.prompt, m.bulk.buf), 1)
+	}
+	if m.attach != nil && m.view == filesViewDetail {
+		return components.Indent(m.renderAttach(), 1)
+	}
 	modeLine := m.renderModeLine()
 	var body string
 	switch m.view {
@@ -238,6 +327,8 @@ func (m FilesModel) View() string {
 		body = m.renderEdit()
 	case filesViewDetail:
 		body = m.renderDetail()
+	case filesViewVerify:
+		body = m.renderVerify()
 	default:
 		body = m.renderList()
 	}
@@ -298,8 +389,13 @@ func (m FilesModel) toggleMode() (FilesModel, tea.Cmd) {
 
 func (m FilesModel) renderList() string {
 	if m.loading {
-		return "  " + MutedStyle.Render("Loading files...")
+		return renderLoadingList("files", len(m.items), m.list, m.width, m.renderListBody)
 	}
+	return m.renderListBody()
+}
+
+// renderListBody renders the list without the loading state.
+func (m FilesModel) renderListBody() string {
 	if len(m.items) == 0 {
 		return components.EmptyStateBox(
 			"Files",
@@ -321,30 +417,7 @@ func (m FilesModel) renderList() string {
 		tableWidth = contentWidth - previewWidth - gap
 	}
 
-	sepWidth := 1
-	if b := lipgloss.RoundedBorder().Left; b != "" {
-		sepWidth = lipgloss.Width(b)
-	}
-
-	// 4 columns -> 3 separators.
-	availableCols := tableWidth - (3 * sepWidth)
-	if availableCols < 30 {
-		availableCols = 30
-	}
-
-	statusWidth := 11
-	sizeWidth := 10
-	atWidth := compactTimeColumnWidth
-	fileWidth := availableCols - (statusWidth + sizeWidth + atWidth)
-	if fileWidth < 12 {
-		fileWidth = 12
-	}
-	cols := []components.TableColumn{
-		{Header: "File", Width: fileWidth, Align: lipgloss.Left},
-		{Header: "Status", Width: statusWidth, Align: lipgloss.Left},
-		{Header: "Size", Width: sizeWidth, Align: lipgloss.Right},
-		{Header: "At", Width: atWidth, Align: lipgloss.Left},
-	}
+	cols, defs := fileColumnSet.columns(m.columns, tableWidth, m.sort)
 
 	tableRows := make([][]string, 0, len(visible))
 	activeRowRel := -1
@@ -360,41 +433,37 @@ func (m FilesModel) renderList() string {
 		}
 		f := m.items[absIdx]
 
-		status := strings.TrimSpace(components.SanitizeOneLine(f.Status))
-		if status == "" {
-			status = "-"
-		}
-		size := "-"
-		if f.SizeBytes != nil {
-			size = formatFileSize(*f.SizeBytes)
-		}
-		at := f.UpdatedAt
-		if at.IsZero() {
-			at = f.CreatedAt
-		}
-
 		if m.list.IsSelected(absIdx) {
 			activeRowRel = len(tableRows)
 		}
-		tableRows = append(tableRows, []string{
-			components.ClampTextWidthEllipsis(components.SanitizeOneLine(f.Filename), fileWidth),
-			components.ClampTextWidthEllipsis(status, statusWidth),
-			components.ClampTextWidthEllipsis(size, sizeWidth),
-			formatLocalTimeCompact(at),
-		})
+		row := make([]string, len(defs))
+		for c, d := range defs {
+			value := fileColumnValue(f, d.key)
+			if box := m.bulk.checkbox(f.ID); c == 0 && box != "" {
+				value = box + " " + value
+			}
+			row[c] = components.ClampTextWidthEllipsis(value, d.width)
+		}
+		tableRows = append(tableRows, row)
 	}
 	if m.modeFocus {
 		activeRowRel = -1
 	}
 
 	countLine := fmt.Sprintf("%d total", len(m.items))
-	if strings.TrimSpace(m.searchBuf) != "" {
-		countLine = fmt.Sprintf("%s · search: %s", countLine, strings.TrimSpace(m.searchBuf))
-		if m.searchSuggest != "" && !strings.EqualFold(strings.TrimSpace(m.searchBuf), strings.TrimSpace(m.searchSuggest)) {
+	if selected := m.bulk.count(); selected > 0 {
+		countLine = fmt.Sprintf("%s · selected: %d", countLine, selected)
+	}
+	if strings.TrimSpace(m.searchBuf.Value) != "" {
+		countLine = fmt.Sprintf("%s · search: %s", countLine, strings.TrimSpace(m.searchBuf.Value))
+		if m.searchSuggest != "" && !strings.EqualFold(strings.TrimSpace(m.searchBuf.Value), strings.TrimSpace(m.searchSuggest)) {
 			countLine = fmt.Sprintf("%s · next: %s", countLine, strings.TrimSpace(m.searchSuggest))
 		}
 	}
-	countLine = MutedStyle.Render(countLine)
+	if sortLabel := m.sort.label(); sortLabel != "" {
+		countLine = fmt.Sprintf("%s · %s", countLine, sortLabel)
+	}
+	countLine = MutedStyle.Render(countLine) + renderLoadLatency(m.loadLatency)
 
 	table := components.TableGridWithActiveRow(cols, tableRows, tableWidth, activeRowRel)
 	preview := ""
@@ -471,6 +540,9 @@ func (m FilesModel) handleListKeys(msg tea.KeyMsg) (FilesModel, tea.Cmd) {
 	if m.filtering {
 		return m.handleFilterInput(msg)
 	}
+	if m.bulk.prompt != "" {
+		return m.handleBulkPromptKeys(msg)
+	}
 	switch {
 	case isDown(msg):
 		m.list.Down()
@@ -480,6 +552,10 @@ func (m FilesModel) handleListKeys(msg tea.KeyMsg) (FilesModel, tea.Cmd) {
 		} else {
 			m.list.Up()
 		}
+	case isSpace(msg) && m.searchBuf.Value == "":
+		if idx := m.list.Selected(); idx < len(m.items) {
+			m.bulk.toggle(m.items[idx].ID)
+		}
 	case isEnter(msg), isSpace(msg):
 		if idx := m.list.Selected(); idx < len(m.items) {
 			item := m.items[idx]
@@ -492,37 +568,118 @@ func (m FilesModel) handleListKeys(msg tea.KeyMsg) (FilesModel, tea.Cmd) {
 		m.filtering = true
 		return m, nil
 	case isKey(msg, "backspace", "delete"):
-		if len(m.searchBuf) > 0 {
-			m.searchBuf = m.searchBuf[:len(m.searchBuf)-1]
+		if m.searchBuf.Value != "" {
+			m.searchBuf.Backspace()
 			m.applyFileSearch()
 		}
 	case isKey(msg, "cmd+backspace", "cmd+delete", "ctrl+u"):
-		if m.searchBuf != "" {
-			m.searchBuf = ""
+		if m.searchBuf.Value != "" {
+			m.searchBuf.Reset()
 			m.searchSuggest = ""
 			m.applyFileSearch()
 		}
 	case isBack(msg):
-		if m.searchBuf != "" {
-			m.searchBuf = ""
+		if m.searchBuf.Value != "" {
+			m.searchBuf.Reset()
 			m.searchSuggest = ""
 			m.applyFileSearch()
+		} else {
+			m.bulk.clear()
 		}
+	case isKey(msg, "t") && m.bulk.count() > 0:
+		m.bulk.open(bulkTargetTags)
+	case isKey(msg, "s") && m.bulk.count() > 0:
+		m.bulk.open(bulkTargetStatus)
+	case isKey(msg, "c") && m.bulk.count() > 0:
+		m.bulk.clear()
 	case isKey(msg, "tab"):
-		if m.searchSuggest != "" && !strings.EqualFold(strings.TrimSpace(m.searchBuf), strings.TrimSpace(m.searchSuggest)) {
-			m.searchBuf = m.searchSuggest
+		if m.searchSuggest != "" && !strings.EqualFold(strings.TrimSpace(m.searchBuf.Value), strings.TrimSpace(m.searchSuggest)) {
+			m.searchBuf.SetValue(m.searchSuggest)
 			m.applyFileSearch()
 		}
+	case isKey(msg, "o") && m.searchBuf.Value == "":
+		m.sort = m.sort.next(fileSortColumns)
+		m.applyFileSearch()
+		return m, m.sort.changed("files")
+	case isKey(msg, "O") && m.searchBuf.Value == "":
+		m.sort = m.sort.reversed()
+		m.applyFileSearch()
+		return m, m.sort.changed("files")
+	case isKey(msg, "V") && m.searchBuf.Value == "":
+		return m.startVerify()
 	default:
-		ch := msg.String()
-		if len(ch) == 1 {
-			m.searchBuf += ch
+		if text, ok := typedText(msg); ok {
+			m.searchBuf.Insert(text)
 			m.applyFileSearch()
 		}
 	}
 	return m, nil
 }
 
+// handleBulkPromptKeys edits the bulk prompt and queues the update on enter.
+func (m FilesModel) handleBulkPromptKeys(msg tea.KeyMsg) (FilesModel, tea.Cmd) {
+	spec, submit, err := m.bulk.handleKey(msg)
+	if err != nil {
+		return m, func() tea.Msg { return errMsg{err} }
+	}
+	if !submit {
+		return m, nil
+	}
+	cmd, err := m.bulkUpdate(spec)
+	if err != nil {
+		return m, func() tea.Msg { return errMsg{err} }
+	}
+	m.bulk.close()
+	return m, cmd
+}
+
+// bulkUpdate queues one update per selected file that spec changes. Files
+// have no scopes, so only tags and status are bulk edited.
+func (m FilesModel) bulkUpdate(spec bulkInput) (tea.Cmd, error) {
+	target := m.bulk.target
+	var status string
+	var err error
+	if target == bulkTargetStatus {
+		status, err = bulkStatusValue(spec, fileStatusOptions)
+	} else {
+		err = checkBulkSpec(target, spec)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	client := m.client
+	var steps []operationStep
+	for _, item := range m.all {
+		if !m.bulk.selected[item.ID] {
+			continue
+		}
+		input := api.UpdateFileInput{}
+		if target == bulkTargetStatus {
+			if item.Status == status {
+				continue
+			}
+			input.Status = &status
+		} else {
+			tags, changed := bulkSetUpdate(target, spec, item.Tags)
+			if !changed {
+				continue
+			}
+			input.Tags = &tags
+		}
+		id := item.ID
+		steps = append(steps, operationStep{
+			label: item.Filename,
+			run: func() ([]string, error) {
+				_, err := client.UpdateFile(id, input)
+				return nil, err
+			},
+		})
+	}
+	label := fmt.Sprintf("Bulk %s on %d files", bulkSetNoun(target), len(steps))
+	return queueOperation(label, tabFiles, steps, filesBulkUpdatedMsg{}), nil
+}
+
 // handleFilterInput handles handle filter input.
 func (m FilesModel) handleFilterInput(msg tea.KeyMsg) (FilesModel, tea.Cmd) {
 	switch {
@@ -530,21 +687,14 @@ func (m FilesModel) handleFilterInput(msg tea.KeyMsg) (FilesModel, tea.Cmd) {
 		m.filtering = false
 	case isBack(msg):
 		m.filtering = false
-		m.searchBuf = ""
+		m.searchBuf.Reset()
 		m.searchSuggest = ""
 		m.applyFileSearch()
-	case isKey(msg, "backspace", "delete"):
-		if len(m.searchBuf) > 0 {
-			m.searchBuf = m.searchBuf[:len(m.searchBuf)-1]
-			m.applyFileSearch()
-		}
 	default:
-		ch := msg.String()
-		if len(ch) == 1 || ch == " " {
-			if ch == " " && m.searchBuf == "" {
-				return m, nil
-			}
-			m.searchBuf += ch
+		if isSpace(msg) && m.searchBuf.Value == "" {
+			return m, nil
+		}
+		if editChanged(&m.searchBuf, msg) {
 			m.applyFileSearch()
 		}
 	}
@@ -565,6 +715,8 @@ func (m FilesModel) handleDetailKeys(msg tea.KeyMsg) (FilesModel, tea.Cmd) {
 	case isKey(msg, "e"):
 		m.startEdit()
 		m.view = filesViewEdit
+	case isKey(msg, "a"):
+		m.openAttach()
 	case isKey(msg, "m"):
 		m.metaExpanded = !m.metaExpanded
 	}
@@ -654,6 +806,9 @@ func (m FilesModel) handleAddKeys(msg tea.KeyMsg) (FilesModel, tea.Cmd) {
 			return m, nil
 		}
 	}
+	if in := m.addInput(m.addFocus); in != nil && in.HandleKey(msg) {
+		return m, nil
+	}
 	switch {
 	case isDown(msg):
 		m.addFocus = (m.addFocus + 1) % fileFieldCount
@@ -665,26 +820,18 @@ func (m FilesModel) handleAddKeys(msg tea.KeyMsg) (FilesModel, tea.Cmd) {
 		m.addFocus = (m.addFocus - 1 + fileFieldCount) % fileFieldCount
 	case isKey(msg, "ctrl+s"):
 		return m.saveAdd()
+	case isKey(msg, "ctrl+f"):
+		return m.probeAddPath()
 	case isBack(msg):
 		m.resetAddForm()
 	case isKey(msg, "backspace", "delete"):
 		switch m.addFocus {
 		case fileFieldTags:
-			if len(m.addTagBuf) > 0 {
-				m.addTagBuf = m.addTagBuf[:len(m.addTagBuf)-1]
+			if m.addTagBuf.Value != "" {
+				m.addTagBuf.Backspace()
 			} else if len(m.addTags) > 0 {
 				m.addTags = m.addTags[:len(m.addTags)-1]
 			}
-		case fileFieldName:
-			m.addName = dropLastRune(m.addName)
-		case fileFieldPath:
-			m.addPath = dropLastRune(m.addPath)
-		case fileFieldMime:
-			m.addMime = dropLastRune(m.addMime)
-		case fileFieldSize:
-			m.addSize = dropLastRune(m.addSize)
-		case fileFieldChecksum:
-			m.addChecksum = dropLastRune(m.addChecksum)
 		default:
 			return m, nil
 		}
@@ -692,24 +839,14 @@ func (m FilesModel) handleAddKeys(msg tea.KeyMsg) (FilesModel, tea.Cmd) {
 		switch m.addFocus {
 		case fileFieldTags:
 			switch {
+			case pasteList(&m.addTagBuf, msg, m.commitAddTag):
+			case isKey(msg, "tab"):
+				m.addTagBuf.SetValue(completeTag(m.tagOptions, m.addTagBuf.Value, m.addTags))
 			case isSpace(msg) || isKey(msg, ",") || isEnter(msg):
 				m.commitAddTag()
 			default:
-				ch := msg.String()
-				if len(ch) == 1 && ch != "," {
-					m.addTagBuf += ch
-				}
+				m.addTagBuf.HandleKey(msg)
 			}
-		case fileFieldName:
-			appendChar(&m.addName, msg)
-		case fileFieldPath:
-			appendChar(&m.addPath, msg)
-		case fileFieldMime:
-			appendChar(&m.addMime, msg)
-		case fileFieldSize:
-			appendChar(&m.addSize, msg)
-		case fileFieldChecksum:
-			appendChar(&m.addChecksum, msg)
 		case fileFieldMeta:
 			if isEnter(msg) {
 				m.addMeta.Active = true
@@ -719,6 +856,24 @@ func (m FilesModel) handleAddKeys(msg tea.KeyMsg) (FilesModel, tea.Cmd) {
 	return m, nil
 }
 
+// addInput returns the add form text input at focus, or nil when that field
+// is not free text.
+func (m *FilesModel) addInput(focus int) *components.TextInput {
+	switch focus {
+	case fileFieldName:
+		return &m.addName
+	case fileFieldPath:
+		return &m.addPath
+	case fileFieldMime:
+		return &m.addMime
+	case fileFieldSize:
+		return &m.addSize
+	case fileFieldChecksum:
+		return &m.addChecksum
+	}
+	return nil
+}
+
 // renderAdd renders render add.
 func (m FilesModel) renderAdd() string {
 	rows := make([][2]string, 0, len(m.addFields))
@@ -745,7 +900,11 @@ func (m FilesModel) renderAdd() string {
 		}
 		rows = append(rows, [2]string{label, value})
 	}
-	body := renderFormGrid("Add File", rows, m.addFocus, m.width)
+	rows, active := withFieldErrorRows(rows, m.addFieldErrs, m.addFocus)
+	body := renderFormGrid("Add File", rows, active, m.width)
+	if m.addProbing {
+		body += "\n\n" + MutedStyle.Render("Probing file...")
+	}
 	if m.addErr != "" {
 		body += "\n\n" + ErrorStyle.Render(m.addErr)
 	}
@@ -757,17 +916,18 @@ func (m FilesModel) renderAdd() string {
 
 // saveAdd handles save add.
 func (m FilesModel) saveAdd() (FilesModel, tea.Cmd) {
-	name := strings.TrimSpace(m.addName)
+	m.addFieldErrs = nil
+	name := strings.TrimSpace(m.addName.Value)
 	if name == "" {
 		m.addErr = "Filename is required"
 		return m, nil
 	}
-	path := strings.TrimSpace(m.addPath)
+	path := strings.TrimSpace(m.addPath.Value)
 	if path == "" {
 		m.addErr = "File path is required"
 		return m, nil
 	}
-	size, err := parseFileSize(m.addSize)
+	size, err := parseFileSize(m.addSize.Value)
 	if err != nil {
 		m.addErr = err.Error()
 		return m, nil
@@ -782,9 +942,9 @@ func (m FilesModel) saveAdd() (FilesModel, tea.Cmd) {
 	input := api.CreateFileInput{
 		Filename:  name,
 		FilePath:  path,
-		MimeType:  strings.TrimSpace(m.addMime),
+		MimeType:  strings.TrimSpace(m.addMime.Value),
 		SizeBytes: size,
-		Checksum:  strings.TrimSpace(m.addChecksum),
+		Checksum:  strings.TrimSpace(m.addChecksum.Value),
 		Status:    fileStatusOptions[m.addStatusIdx],
 		Tags:      m.addTags,
 		Metadata:  meta,
@@ -793,54 +953,82 @@ func (m FilesModel) saveAdd() (FilesModel, tea.Cmd) {
 	m.addErr = ""
 	return m, func() tea.Msg {
 		if _, err := m.client.CreateFile(input); err != nil {
-			return errMsg{err}
+			return saveFailed(err)
 		}
 		return fileCreatedMsg{}
 	}
 }
 
+// fileAddFieldIndex maps create-file JSON fields to add form fields.
+var fileAddFieldIndex = map[string]int{
+	"filename":   fileFieldName,
+	"file_path":  fileFieldPath,
+	"uri":        fileFieldPath,
+	"mime_type":  fileFieldMime,
+	"size_bytes": fileFieldSize,
+	"checksum":   fileFieldChecksum,
+	"status":     fileFieldStatus,
+	"tags":       fileFieldTags,
+	"metadata":   fileFieldMeta,
+}
+
+// showAddFieldErrors puts a rejected create's field errors under their
+// fields and focuses the first one.
+func (m *FilesModel) showAddFieldErrors(msg fieldErrorsMsg) {
+	errs, first, rest := mapFieldErrors(msg.fields, fileAddFieldIndex)
+	m.view = filesViewAdd
+	m.modeFocus = false
+	m.addFieldErrs = errs
+	if first >= 0 {
+		m.addFocus = first
+	}
+	m.addErr = rest
+}
+
 // resetAddForm handles reset add form.
 func (m *FilesModel) resetAddForm() {
 	m.addSaved = false
 	m.addSaving = false
+	m.addProbing = false
 	m.addErr = ""
+	m.addFieldErrs = nil
 	m.addFocus = 0
 	m.addStatusIdx = statusIndex(fileStatusOptions, "active")
 	m.addTags = nil
-	m.addTagBuf = ""
-	m.addName = ""
-	m.addPath = ""
-	m.addMime = ""
-	m.addSize = ""
-	m.addChecksum = ""
+	m.addTagBuf.Reset()
+	m.addName.Reset()
+	m.addPath.Reset()
+	m.addMime.Reset()
+	m.addSize.Reset()
+	m.addChecksum.Reset()
 	m.addMeta.Reset()
 }
 
 // commitAddTag handles commit add tag.
 func (m *FilesModel) commitAddTag() {
-	raw := strings.TrimSpace(m.addTagBuf)
+	raw := strings.TrimSpace(m.addTagBuf.Value)
 	if raw == "" {
-		m.addTagBuf = ""
+		m.addTagBuf.Reset()
 		return
 	}
-	tag := normalizeTag(raw)
+	tag := canonicalTag(m.tagOptions, normalizeTag(raw))
 	if tag == "" {
-		m.addTagBuf = ""
+		m.addTagBuf.Reset()
 		return
 	}
 	for _, t := range m.addTags {
 		if t == tag {
-			m.addTagBuf = ""
+			m.addTagBuf.Reset()
 			return
 		}
 	}
 	m.addTags = append(m.addTags, tag)
-	m.addTagBuf = ""
+	m.addTagBuf.Reset()
 }
 
 // renderAddTags renders render add tags.
 func (m FilesModel) renderAddTags(focused bool) string {
-	if len(m.addTags) == 0 && m.addTagBuf == "" && !focused {
+	if len(m.addTags) == 0 && m.addTagBuf.Value == "" && !focused {
 		return "-"
 	}
 	var b strings.Builder
@@ -854,15 +1042,13 @@ func (m FilesModel) renderAddTags(focused bool) string {
 		if b.Len() > 0 {
 			b.WriteString(" ")
 		}
-		if m.addTagBuf != "" {
-			b.WriteString(m.addTagBuf)
-		}
-		b.WriteString(AccentStyle.Render("█"))
-	} else if m.addTagBuf != "" {
+		b.WriteString(m.addTagBuf.View(AccentStyle, 0))
+		b.WriteString(renderTagSuggestions(m.tagOptions, m.addTagBuf.Value, m.addTags))
+	} else if m.addTagBuf.Value != "" {
 		if b.Len() > 0 {
 			b.WriteString(" ")
 		}
-		b.WriteString(MutedStyle.Render(m.addTagBuf))
+		b.WriteString(MutedStyle.Render(m.addTagBuf.Value))
 	}
 	return b.String()
 }
@@ -877,23 +1063,23 @@ func (m *FilesModel) startEdit() {
 	m.editFocus = 0
 	m.editStatusIdx = statusIndex(fileStatusOptions, f.Status)
 	m.editTags = append([]string{}, f.Tags...)
-	m.editTagBuf = ""
-	m.editName = f.Filename
-	m.editPath = f.FilePath
+	m.editTagBuf.Reset()
+	m.editName.SetValue(f.Filename)
+	m.editPath.SetValue(f.FilePath)
 	if f.MimeType != nil {
-		m.editMime = *f.MimeType
+		m.editMime.SetValue(*f.MimeType)
 	} else {
-		m.editMime = ""
+		m.editMime.Reset()
 	}
 	if f.SizeBytes != nil {
-		m.editSize = fmt.Sprintf("%d", *f.SizeBytes)
+		m.editSize.SetValue(fmt.Sprintf("%d", *f.SizeBytes))
 	} else {
-		m.editSize = ""
+		m.editSize.Reset()
 	}
 	if f.Checksum != nil {
-		m.editChecksum = *f.Checksum
+		m.editChecksum.SetValue(*f.Checksum)
 	} else {
-		m.editChecksum = ""
+		m.editChecksum.Reset()
 	}
 	m.editMeta.Load(map[string]any(f.Metadata))
 	m.editSaving = false
@@ -920,6 +1106,9 @@ func (m FilesModel) handleEditKeys(msg tea.KeyMsg) (FilesModel, tea.Cmd) {
 			return m, nil
 		}
 	}
+	if in := m.editInput(m.editFocus); in != nil && in.HandleKey(msg) {
+		return m, nil
+	}
 	switch {
 	case isDown(msg):
 		m.editFocus = (m.editFocus + 1) % fileFieldCount
@@ -934,44 +1123,24 @@ func (m FilesModel) handleEditKeys(msg tea.KeyMsg) (FilesModel, tea.Cmd) {
 	case isKey(msg, "backspace", "delete"):
 		switch m.editFocus {
 		case fileFieldTags:
-			if len(m.editTagBuf) > 0 {
-				m.editTagBuf = m.editTagBuf[:len(m.editTagBuf)-1]
+			if m.editTagBuf.Value != "" {
+				m.editTagBuf.Backspace()
 			} else if len(m.editTags) > 0 {
 				m.editTags = m.editTags[:len(m.editTags)-1]
 			}
-		case fileFieldName:
-			m.editName = dropLastRune(m.editName)
-		case fileFieldPath:
-			m.editPath = dropLastRune(m.editPath)
-		case fileFieldMime:
-			m.editMime = dropLastRune(m.editMime)
-		case fileFieldSize:
-			m.editSize = dropLastRune(m.editSize)
-		case fileFieldChecksum:
-			m.editChecksum = dropLastRune(m.editChecksum)
 		}
 	default:
 		switch m.editFocus {
 		case fileFieldTags:
 			switch {
+			case pasteList(&m.editTagBuf, msg, m.commitEditTag):
+			case isKey(msg, "tab"):
+				m.editTagBuf.SetValue(completeTag(m.tagOptions, m.editTagBuf.Value, m.editTags))
 			case isSpace(msg) || isKey(msg, ",") || isEnter(msg):
 				m.commitEditTag()
 			default:
-				ch := msg.String()
-				if len(ch) == 1 && ch != "," {
-					m.editTagBuf += ch
-				}
+				m.editTagBuf.HandleKey(msg)
 			}
-		case fileFieldName:
-			appendChar(&m.editName, msg)
-		case fileFieldPath:
-			appendChar(&m.editPath, msg)
-		case fileFieldMime:
-			appendChar(&m.editMime, msg)
-		case fileFieldSize:
-			appendChar(&m.editSize, msg)
-		case fileFieldChecksum:
-			appendChar(&m.editChecksum, msg)
 		case fileFieldMeta:
 			if isEnter(msg) {
 				m.editMeta.Active = true
@@ -981,6 +1150,24 @@ func (m FilesModel) handleEditKeys(msg tea.KeyMsg) (FilesModel, tea.Cmd) {
 	return m, nil
 }
 
+// editInput returns the edit form text input at focus, or nil when that
+// field is not free text.
+func (m *FilesModel) editInput(focus int) *components.TextInput {
+	switch focus {
+	case fileFieldName:
+		return &m.editName
+	case fileFieldPath:
+		return &m.editPath
+	case fileFieldMime:
+		return &m.editMime
+	case fileFieldSize:
+		return &m.editSize
+	case fileFieldChecksum:
+		return &m.editChecksum
+	}
+	return nil
+}
+
 // renderEdit renders render edit.
 func (m FilesModel) renderEdit() string {
 	fields := []string{"Filename", "File Path", "MIME Type", "Size (bytes)", "Checksum", "Status", "Tags", "Metadata"}
@@ -1012,7 +1199,7 @@ func (m FilesModel) renderEdit() string {
 
 // saveEdit handles save edit.
 func (m FilesModel) saveEdit() (FilesModel, tea.Cmd) {
-	size, err := parseFileSize(m.editSize)
+	size, err := parseFileSize(m.editSize.Value)
 	if err != nil {
 		m.errText = err.Error()
 		return m, nil
@@ -1031,20 +1218,20 @@ func (m FilesModel) saveEdit() (FilesModel, tea.Cmd) {
 		Status:   &status,
 		Tags:     &m.editTags,
 	}
-	if strings.TrimSpace(m.editName) != "" {
-		input.Filename = stringPtr(strings.TrimSpace(m.editName))
+	if strings.TrimSpace(m.editName.Value) != "" {
+		input.Filename = stringPtr(strings.TrimSpace(m.editName.Value))
 	}
-	if strings.TrimSpace(m.editPath) != "" {
-		input.FilePath = stringPtr(strings.TrimSpace(m.editPath))
+	if strings.TrimSpace(m.editPath.Value) != "" {
+		input.FilePath = stringPtr(strings.TrimSpace(m.editPath.Value))
 	}
-	if strings.TrimSpace(m.editMime) != "" {
-		input.MimeType = stringPtr(strings.TrimSpace(m.editMime))
+	if strings.TrimSpace(m.editMime.Value) != "" {
+		input.MimeType = stringPtr(strings.TrimSpace(m.editMime.Value))
 	}
 	if size != nil {
 		input.SizeBytes = size
 	}
-	if strings.TrimSpace(m.editChecksum) != "" {
-		input.Checksum = stringPtr(strings.TrimSpace(m.editChecksum))
+	if strings.TrimSpace(m.editChecksum.Value) != "" {
+		input.Checksum = stringPtr(strings.TrimSpace(m.editChecksum.Value))
 	}
 
 	m.editSaving = true
@@ -1059,29 +1246,29 @@ func (m FilesModel) saveEdit() (FilesModel, tea.Cmd) {
 
 // commitEditTag handles commit edit tag.
 func (m *FilesModel) commitEditTag() {
-	raw := strings.TrimSpace(m.editTagBuf)
+	raw := strings.TrimSpace(m.editTagBuf.Value)
 	if raw == "" {
-		m.editTagBuf = ""
+		m.editTagBuf.Reset()
 		return
 	}
-	tag := normalizeTag(raw)
+	tag := canonicalTag(m.tagOptions, normalizeTag(raw))
 	if tag == "" {
-		m.editTagBuf = ""
+		m.editTagBuf.Reset()
 		return
 	}
 	for _, t := range m.editTags {
 		if t == tag {
-			m.editTagBuf = ""
+			m.editTagBuf.Reset()
 			return
 		}
 	}
 	m.editTags = append(m.editTags, tag)
-	m.editTagBuf = ""
+	m.editTagBuf.Reset()
 }
 
 // renderEditTags renders render edit tags.
 func (m FilesModel) renderEditTags(focused bool) string {
-	if len(m.editTags) == 0 && m.editTagBuf == "" && !focused {
+	if len(m.editTags) == 0 && m.editTagBuf.Value == "" && !focused {
 		return "-"
 	}
 	var b strings.Builder
@@ -1095,15 +1282,13 @@ func (m FilesModel) renderEditTags(focused bool) string {
 		if b.Len() > 0 {
 			b.WriteString(" ")
 		}
-		if m.editTagBuf != "" {
-			b.WriteString(m.editTagBuf)
-		}
-		b.WriteString(AccentStyle.Render("█"))
-	} else if m.editTagBuf != "" {
+		b.WriteString(m.editTagBuf.View(AccentStyle, 0))
+		b.WriteString(renderTagSuggestions(m.tagOptions, m.editTagBuf.Value, m.editTags))
+	} else if m.editTagBuf.Value != "" {
 		if b.Len() > 0 {
 			b.WriteString(" ")
 		}
-		b.WriteString(MutedStyle.Render(m.editTagBuf))
+		b.WriteString(MutedStyle.Render(m.editTagBuf.Value))
 	}
 	return b.String()
 }
@@ -1111,12 +1296,13 @@ func (m FilesModel) renderEditTags(focused bool) string {
 // --- Data ---
 
 func (m FilesModel) loadFiles() tea.Cmd {
+	queued := time.Now()
 	return func() tea.Msg {
 		items, err := m.client.QueryFiles(api.QueryParams{"status_category": "active"})
 		if err != nil {
-			return errMsg{err}
+			return loadFailed(tabFiles, err, m.loadFiles())
 		}
-		return filesLoadedMsg{items}
+		return filesLoadedMsg{items: items, queued: queued}
 	}
 }
 
@@ -1134,15 +1320,15 @@ func (m FilesModel) loadScopeOptions() tea.Cmd {
 		for _, scope := range scopes {
 			names[scope.ID] = scope.Name
 		}
-		return filesScopesLoadedMsg{options: scopeNameList(names)}
+		return filesScopesLoadedMsg{options: scopeNameList(names), info: scopeInfoByName(scopes)}
 	}
 }
 
 // applyFileSearch handles apply file search.
 func (m *FilesModel) applyFileSearch() {
-	query := strings.TrimSpace(strings.ToLower(m.searchBuf))
+	query := strings.TrimSpace(strings.ToLower(m.searchBuf.Value))
 	if query == "" {
-		m.items = m.all
+		m.items = append([]api.File{}, m.all...)
 	} else {
 		filtered := make([]api.File, 0, len(m.all))
 		for _, f := range m.all {
@@ -1153,6 +1339,7 @@ func (m *FilesModel) applyFileSearch() {
 		}
 		m.items = filtered
 	}
+	sortRows(m.items, m.sort, compareFile)
 	labels := make([]string, len(m.items))
 	for i, f := range m.items {
 		labels[i] = formatFileLine(f)
@@ -1164,7 +1351,7 @@ func (m *FilesModel) applyFileSearch() {
 // updateSearchSuggest updates update search suggest.
 func (m *FilesModel) updateSearchSuggest() {
 	m.searchSuggest = ""
-	query := strings.ToLower(strings.TrimSpace(m.searchBuf))
+	query := strings.ToLower(strings.TrimSpace(m.searchBuf.Value))
 	if query == "" {
 		return
 	}
@@ -1228,24 +1415,15 @@ func formatFileSize(size int64) string {
 	return fmt.Sprintf("%.1f GB", gb)
 }
 
-// appendChar handles append char.
-func appendChar(target *string, msg tea.KeyMsg) {
-	ch := msg.String()
-	if len(ch) == 1 || ch == " " {
-		*target += ch
+// formatFormValue renders a form grid input, with its cursor when focused.
+func formatFormValue(in components.TextInput, focused bool) string {
+	if focused {
+		return in.View(AccentStyle, 0)
 	}
-}
-
-// formatFormValue handles format form value.
-func formatFormValue(value string, focused bool) string {
-	trimmed := strings.TrimSpace(value)
-	if trimmed == "" && !focused {
+	if strings.TrimSpace(in.Value) == "" {
 		return "-"
 	}
-	if focused {
-		return strings.TrimRight(value, "\n") + AccentStyle.Render("█")
-	}
-	return value
+	return in.Value
 }
 
 // derefString handles deref string.
@@ -1255,3 +1433,70 @@ func derefString(value *string) string {
 	}
 	return *value
 }
+
+// fileSortColumns are the columns `o` cycles through in the files list.
+var fileSortColumns = []string{"at", "name", "size", "status"}
+
+// compareFile orders files by a list column. Files without a size sort first.
+func compareFile(column string, a, b api.File) int {
+	switch column {
+	case "name":
+		return compareText(a.Filename, b.Filename)
+	case "status":
+		return compareText(a.Status, b.Status)
+	case "size":
+		return cmp.Compare(fileSortSize(a), fileSortSize(b))
+	}
+	return compareTime(fileSortTime(a), fileSortTime(b))
+}
+
+// fileSortSize returns the size used for sorting, -1 when unknown.
+func fileSortSize(f api.File) int64 {
+	if f.SizeBytes == nil {
+		return -1
+	}
+	return *f.SizeBytes
+}
+
+// fileSortTime is the time shown in the files list's At column.
+func fileSortTime(f api.File) time.Time {
+	if f.UpdatedAt.IsZero() {
+		return f.CreatedAt
+	}
+	return f.UpdatedAt
+}
+
+// fileColumnValue returns the table cell text for one file column.
+func fileColumnValue(f api.File, key string) string {
+	switch key {
+	case "name":
+		return components.SanitizeOneLine(f.Filename)
+	case "status":
+		if status := strings.TrimSpace(components.SanitizeOneLine(f.Status)); status != "" {
+			return status
+		}
+	case "size":
+		if f.SizeBytes != nil {
+			return formatFileSize(*f.SizeBytes)
+		}
+	case "mime":
+		if f.MimeType != nil && strings.TrimSpace(*f.MimeType) != "" {
+			return components.SanitizeOneLine(strings.TrimSpace(*f.MimeType))
+		}
+	case "tags":
+		if len(f.Tags) > 0 {
+			return components.SanitizeOneLine(strings.Join(f.Tags, ", "))
+		}
+	case "path":
+		if path := strings.TrimSpace(f.FilePath); path != "" {
+			return components.SanitizeOneLine(path)
+		}
+	case "at":
+		at := f.UpdatedAt
+		if at.IsZero() {
+			at = f.CreatedAt
+		}
+		return formatLocalTimeCompact(at)
+	}
+	return "-"
+}
@@ -17,8 +17,8 @@ func TestFilesStartEditBranchMatrix(t *testing.T) {
 
 	// Nil detail should no-op.
 	model.startEdit()
-	assert.Equal(t, "", model.editName)
-	assert.Equal(t, "", model.editMime)
+	assert.Equal(t, "", model.editName.Value)
+	assert.Equal(t, "", model.editMime.Value)
 
 	// Detail with nil optional pointers should hit fallback branches.
 	model.detail = &api.File{
@@ -31,11 +31,11 @@ func TestFilesStartEditBranchMatrix(t *testing.T) {
 	}
 	model.startEdit()
 	assert.Equal(t, 0, model.editFocus)
-	assert.Equal(t, "alpha.txt", model.editName)
-	assert.Equal(t, "/tmp/alpha.txt", model.editPath)
-	assert.Equal(t, "", model.editMime)
-	assert.Equal(t, "", model.editSize)
-	assert.Equal(t, "", model.editChecksum)
+	assert.Equal(t, "alpha.txt", model.editName.Value)
+	assert.Equal(t, "/tmp/alpha.txt", model.editPath.Value)
+	assert.Equal(t, "", model.editMime.Value)
+	assert.Equal(t, "", model.editSize.Value)
+	assert.Equal(t, "", model.editChecksum.Value)
 	assert.Equal(t, []string{"docs"}, model.editTags)
 
 	// Ensure tags were copied, not aliased.
@@ -57,9 +57,9 @@ func TestFilesStartEditBranchMatrix(t *testing.T) {
 		Metadata:  api.JSONMap{"a": 1},
 	}
 	model.startEdit()
-	assert.Equal(t, "text/plain", model.editMime)
-	assert.Equal(t, "4096", model.editSize)
-	assert.Equal(t, "deadbeef", model.editChecksum)
+	assert.Equal(t, "text/plain", model.editMime.Value)
+	assert.Equal(t, "4096", model.editSize.Value)
+	assert.Equal(t, "deadbeef", model.editChecksum.Value)
 }
 
 func TestFilesRenderEditTagsFocusedAndBufferBranches(t *testing.T) {
@@ -70,7 +70,7 @@ func TestFilesRenderEditTagsFocusedAndBufferBranches(t *testing.T) {
 	assert.Contains(t, focused, "one")
 	assert.Contains(t, focused, "█")
 
-	model.editTagBuf = "tmp"
+	model.editTagBuf.Value = "tmp"
 	focusedWithBuf := components.SanitizeText(model.renderEditTags(true))
 	assert.Contains(t, focusedWithBuf, "tmp")
 	assert.Contains(t, focusedWithBuf, "█")
@@ -124,6 +124,6 @@ func TestFilesLoadFilesSuccessAndErrorBranches(t *testing.T) {
 	failModel := NewFilesModel(failClient)
 	cmd = failModel.loadFiles()
 	require.NotNil(t, cmd)
-	_, ok = cmd().(errMsg)
+	_, ok = cmd().(loadFailedMsg)
 	assert.True(t, ok)
 }
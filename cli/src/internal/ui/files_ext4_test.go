@@ -77,7 +77,7 @@ func TestFilesViewCoversMetadataAndFilterBranches(t *testing.T) {
 	model.editMeta.Active = false
 
 	model.filtering = true
-	model.searchBuf = "mime:text"
+	model.searchBuf.Value = "mime:text"
 	out := components.SanitizeText(model.View())
 	assert.Contains(t, out, "Filter Files")
 	assert.Contains(t, out, "mime:text")
@@ -166,22 +166,22 @@ func TestFilesHandleFilterInputCoversSpaceBackspaceEnterAndBack(t *testing.T) {
 
 	updated, cmd := model.handleFilterInput(tea.KeyMsg{Type: tea.KeySpace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 
 	updated, _ = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'w'}})
-	assert.Equal(t, "w", updated.searchBuf)
+	assert.Equal(t, "w", updated.searchBuf.Value)
 
 	updated, _ = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 
 	updated, _ = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyEnter})
 	assert.False(t, updated.filtering)
 
 	updated.filtering = true
-	updated.searchBuf = "x"
+	updated.searchBuf.Value = "x"
 	updated, _ = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyEsc})
 	assert.False(t, updated.filtering)
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 	assert.Equal(t, "", updated.searchSuggest)
 }
 
@@ -206,24 +206,24 @@ func TestFilesHandleListKeysSearchModeAndEnterMatrix(t *testing.T) {
 	assert.Equal(t, 0, updated.list.Selected())
 
 	updated, _ = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'w'}})
-	assert.Equal(t, "w", updated.searchBuf)
+	assert.Equal(t, "w", updated.searchBuf.Value)
 	assert.Equal(t, "workout.txt", updated.searchSuggest)
 
 	updated, _ = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyTab})
-	assert.Equal(t, "workout.txt", updated.searchBuf)
+	assert.Equal(t, "workout.txt", updated.searchBuf.Value)
 
 	updated, _ = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "workout.tx", updated.searchBuf)
+	assert.Equal(t, "workout.tx", updated.searchBuf.Value)
 
 	updated, _ = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyCtrlU})
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 
 	updated, _ = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
 	assert.True(t, updated.filtering)
 
 	updated, _ = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyEsc})
 	assert.False(t, updated.filtering)
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 
 	updated, cmd = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
@@ -21,12 +21,12 @@ func TestFilesHandleAddKeysExtendedBranches(t *testing.T) {
 
 	model.addSaving = false
 	model.addSaved = true
-	model.addName = "Alpha.txt"
-	model.addPath = "/tmp/alpha.txt"
+	model.addName.Value = "Alpha.txt"
+	model.addPath.Value = "/tmp/alpha.txt"
 	updated, _ = model.handleAddKeys(tea.KeyMsg{Type: tea.KeyEsc})
 	assert.False(t, updated.addSaved)
-	assert.Equal(t, "", updated.addName)
-	assert.Equal(t, "", updated.addPath)
+	assert.Equal(t, "", updated.addName.Value)
+	assert.Equal(t, "", updated.addPath.Value)
 
 	updated.addMeta.Active = true
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyEsc})
@@ -49,27 +49,27 @@ func TestFilesHandleAddKeysExtendedBranches(t *testing.T) {
 	assert.Nil(t, cmd)
 	assert.Equal(t, "Filename is required", updated.addErr)
 
-	updated.addName = "a"
-	updated.addPath = "/tmp/a"
+	updated.addName.Value = "a"
+	updated.addPath.Value = "/tmp/a"
 	updated.addFocus = fileFieldName
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
-	assert.Equal(t, "ab", updated.addName)
+	assert.Equal(t, "ab", updated.addName.Value)
 
 	updated.addFocus = fileFieldPath
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
-	assert.Contains(t, updated.addPath, "x")
+	assert.Contains(t, updated.addPath.Value, "x")
 
 	updated.addFocus = fileFieldMime
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
-	assert.Equal(t, "t", updated.addMime)
+	assert.Equal(t, "t", updated.addMime.Value)
 
 	updated.addFocus = fileFieldSize
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'1'}})
-	assert.Equal(t, "1", updated.addSize)
+	assert.Equal(t, "1", updated.addSize.Value)
 
 	updated.addFocus = fileFieldChecksum
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
-	assert.Equal(t, "c", updated.addChecksum)
+	assert.Equal(t, "c", updated.addChecksum.Value)
 
 	updated.addFocus = fileFieldTags
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'A'}})
@@ -104,32 +104,32 @@ func TestFilesHandleEditKeysExtendedBranches(t *testing.T) {
 	assert.Equal(t, len(fileStatusOptions)-1, updated.editStatusIdx)
 
 	updated.editFocus = fileFieldName
-	updated.editName = "ab"
+	updated.editName.Value = "ab"
 	updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "a", updated.editName)
+	assert.Equal(t, "a", updated.editName.Value)
 
 	updated.editFocus = fileFieldPath
-	updated.editPath = "/tmp/ab"
+	updated.editPath.Value = "/tmp/ab"
 	updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "/tmp/a", updated.editPath)
+	assert.Equal(t, "/tmp/a", updated.editPath.Value)
 
 	updated.editFocus = fileFieldMime
-	updated.editMime = "text"
+	updated.editMime.Value = "text"
 	updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "tex", updated.editMime)
+	assert.Equal(t, "tex", updated.editMime.Value)
 
 	updated.editFocus = fileFieldSize
-	updated.editSize = "10"
+	updated.editSize.Value = "10"
 	updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "1", updated.editSize)
+	assert.Equal(t, "1", updated.editSize.Value)
 
 	updated.editFocus = fileFieldChecksum
-	updated.editChecksum = "abcd"
+	updated.editChecksum.Value = "abcd"
 	updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "abc", updated.editChecksum)
+	assert.Equal(t, "abc", updated.editChecksum.Value)
 
 	updated.editFocus = fileFieldTags
-	updated.editTagBuf = "Z"
+	updated.editTagBuf.Value = "Z"
 	updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyEnter})
 	assert.Equal(t, []string{"z"}, updated.editTags)
 
@@ -139,8 +139,8 @@ func TestFilesHandleEditKeysExtendedBranches(t *testing.T) {
 
 	updated.editMeta.Active = false
 	updated.editFocus = fileFieldName
-	updated.editName = "a"
-	updated.editSize = "bad"
+	updated.editName.Value = "a"
+	updated.editSize.Value = "bad"
 	updated, cmd = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyCtrlS})
 	assert.Nil(t, cmd)
 	assert.Contains(t, updated.errText, "non-negative")
@@ -183,26 +183,26 @@ func TestFilesHandleEditKeysNavigationAndAppendBranches(t *testing.T) {
 
 	updated.editFocus = fileFieldName
 	updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
-	assert.Equal(t, "Alpha.txtx", updated.editName)
+	assert.Equal(t, "Alpha.txtx", updated.editName.Value)
 
 	updated.editFocus = fileFieldPath
 	updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
-	assert.Equal(t, "/tmp/ab", updated.editPath)
+	assert.Equal(t, "/tmp/ab", updated.editPath.Value)
 
 	updated.editFocus = fileFieldMime
-	updated.editMime = ""
+	updated.editMime.Value = ""
 	updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
-	assert.Equal(t, "t", updated.editMime)
+	assert.Equal(t, "t", updated.editMime.Value)
 
 	updated.editFocus = fileFieldSize
-	updated.editSize = ""
+	updated.editSize.Value = ""
 	updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'1'}})
-	assert.Equal(t, "1", updated.editSize)
+	assert.Equal(t, "1", updated.editSize.Value)
 
 	updated.editFocus = fileFieldChecksum
-	updated.editChecksum = ""
+	updated.editChecksum.Value = ""
 	updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
-	assert.Equal(t, "c", updated.editChecksum)
+	assert.Equal(t, "c", updated.editChecksum.Value)
 }
 
 func TestFilesHandleAddKeysModeFocusBackspaceAndRenderBranches(t *testing.T) {
@@ -217,24 +217,24 @@ func TestFilesHandleAddKeysModeFocusBackspaceAndRenderBranches(t *testing.T) {
 
 	updated.view = filesViewAdd
 	updated.addFocus = fileFieldPath
-	updated.addPath = "/tmp/ab"
+	updated.addPath.Value = "/tmp/ab"
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "/tmp/a", updated.addPath)
+	assert.Equal(t, "/tmp/a", updated.addPath.Value)
 
 	updated.addFocus = fileFieldMime
-	updated.addMime = "text"
+	updated.addMime.Value = "text"
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "tex", updated.addMime)
+	assert.Equal(t, "tex", updated.addMime.Value)
 
 	updated.addFocus = fileFieldSize
-	updated.addSize = "12"
+	updated.addSize.Value = "12"
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "1", updated.addSize)
+	assert.Equal(t, "1", updated.addSize.Value)
 
 	updated.addFocus = fileFieldChecksum
-	updated.addChecksum = "abcd"
+	updated.addChecksum.Value = "abcd"
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "abc", updated.addChecksum)
+	assert.Equal(t, "abc", updated.addChecksum.Value)
 
 	updated.addFocus = fileFieldMeta
 	updated.addMeta.Buffer = "k: v"
@@ -245,11 +245,11 @@ func TestFilesHandleAddKeysModeFocusBackspaceAndRenderBranches(t *testing.T) {
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyUp})
 	assert.Equal(t, fileFieldName, updated.addFocus)
 
-	updated.addName = "Alpha.txt"
-	updated.addPath = "/tmp/alpha.txt"
+	updated.addName.Value = "Alpha.txt"
+	updated.addPath.Value = "/tmp/alpha.txt"
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyEsc})
-	assert.Equal(t, "", updated.addName)
-	assert.Equal(t, "", updated.addPath)
+	assert.Equal(t, "", updated.addName.Value)
+	assert.Equal(t, "", updated.addPath.Value)
 
 	updated.addErr = "bad size"
 	out := updated.renderAdd()
@@ -17,16 +17,16 @@ func TestFilesCommitAddTagAndRenderAddTagsMatrix(t *testing.T) {
 
 	assert.Equal(t, "-", model.renderAddTags(false))
 
-	model.addTagBuf = "  "
+	model.addTagBuf.Value = "  "
 	model.commitAddTag()
 	assert.Empty(t, model.addTags)
-	assert.Equal(t, "", model.addTagBuf)
+	assert.Equal(t, "", model.addTagBuf.Value)
 
-	model.addTagBuf = "#Alpha"
+	model.addTagBuf.Value = "#Alpha"
 	model.commitAddTag()
 	assert.Equal(t, []string{"alpha"}, model.addTags)
 
-	model.addTagBuf = "alpha"
+	model.addTagBuf.Value = "alpha"
 	model.commitAddTag()
 	assert.Equal(t, []string{"alpha"}, model.addTags)
 
@@ -34,7 +34,7 @@ func TestFilesCommitAddTagAndRenderAddTagsMatrix(t *testing.T) {
 	assert.Contains(t, focused, "[alpha]")
 	assert.Contains(t, focused, "█")
 
-	model.addTagBuf = "pending"
+	model.addTagBuf.Value = "pending"
 	blurred := stripANSI(model.renderAddTags(false))
 	assert.Contains(t, blurred, "[alpha]")
 	assert.Contains(t, blurred, "pending")
@@ -43,7 +43,7 @@ func TestFilesCommitAddTagAndRenderAddTagsMatrix(t *testing.T) {
 func TestFilesRenderAddTagsFocusedMultiTagAndBufferBranches(t *testing.T) {
 	model := NewFilesModel(nil)
 	model.addTags = []string{"alpha", "beta"}
-	model.addTagBuf = "tail"
+	model.addTagBuf.Value = "tail"
 
 	out := stripANSI(model.renderAddTags(true))
 	assert.Contains(t, out, "[alpha]")
@@ -57,16 +57,16 @@ func TestFilesCommitEditTagAndRenderEditTagsMatrix(t *testing.T) {
 
 	assert.Equal(t, "-", model.renderEditTags(false))
 
-	model.editTagBuf = "  "
+	model.editTagBuf.Value = "  "
 	model.commitEditTag()
 	assert.Empty(t, model.editTags)
-	assert.Equal(t, "", model.editTagBuf)
+	assert.Equal(t, "", model.editTagBuf.Value)
 
-	model.editTagBuf = "#Beta"
+	model.editTagBuf.Value = "#Beta"
 	model.commitEditTag()
 	assert.Equal(t, []string{"beta"}, model.editTags)
 
-	model.editTagBuf = "beta"
+	model.editTagBuf.Value = "beta"
 	model.commitEditTag()
 	assert.Equal(t, []string{"beta"}, model.editTags)
 
@@ -74,7 +74,7 @@ func TestFilesCommitEditTagAndRenderEditTagsMatrix(t *testing.T) {
 	assert.Contains(t, focused, "[beta]")
 	assert.Contains(t, focused, "█")
 
-	model.editTagBuf = "next"
+	model.editTagBuf.Value = "next"
 	blurred := stripANSI(model.renderEditTags(false))
 	assert.Contains(t, blurred, "[beta]")
 	assert.Contains(t, blurred, "next")
@@ -110,18 +110,18 @@ func TestFilesSaveAddValidationAndCreateCommand(t *testing.T) {
 	assert.Nil(t, cmd)
 	assert.Equal(t, "Filename is required", updated.addErr)
 
-	updated.addName = "Alpha.txt"
+	updated.addName.Value = "Alpha.txt"
 	updated, cmd = updated.saveAdd()
 	assert.Nil(t, cmd)
 	assert.Equal(t, "File path is required", updated.addErr)
 
-	updated.addPath = "/tmp/alpha.txt"
-	updated.addSize = "abc"
+	updated.addPath.Value = "/tmp/alpha.txt"
+	updated.addSize.Value = "abc"
 	updated, cmd = updated.saveAdd()
 	assert.Nil(t, cmd)
 	assert.Contains(t, updated.addErr, "non-negative")
 
-	updated.addSize = ""
+	updated.addSize.Value = ""
 	updated.addMeta.Buffer = "invalid"
 	updated, cmd = updated.saveAdd()
 	assert.Nil(t, cmd)
@@ -165,12 +165,12 @@ func TestFilesSaveEditValidationAndUpdateCommandPaths(t *testing.T) {
 	model.detail = &api.File{ID: "file-1", Filename: "Alpha.txt", FilePath: "/tmp/alpha.txt", Status: "active"}
 	model.startEdit()
 
-	model.editSize = "oops"
+	model.editSize.Value = "oops"
 	updated, cmd := model.saveEdit()
 	assert.Nil(t, cmd)
 	assert.Contains(t, updated.errText, "non-negative")
 
-	updated.editSize = ""
+	updated.editSize.Value = ""
 	updated.editMeta.Buffer = "invalid"
 	updated, cmd = updated.saveEdit()
 	assert.Nil(t, cmd)
@@ -231,11 +231,11 @@ func TestFilesSaveEditIncludesOptionalMimeSizeChecksumFields(t *testing.T) {
 		Status:   "active",
 	}
 	model.startEdit()
-	model.editName = "   "
-	model.editPath = " "
-	model.editMime = "application/pdf"
-	model.editSize = "7"
-	model.editChecksum = "abc123"
+	model.editName.Value = "   "
+	model.editPath.Value = " "
+	model.editMime.Value = "application/pdf"
+	model.editSize.Value = "7"
+	model.editChecksum.Value = "abc123"
 
 	updated, cmd := model.saveEdit()
 	require.NotNil(t, cmd)
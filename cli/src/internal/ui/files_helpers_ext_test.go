@@ -9,6 +9,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -35,17 +36,17 @@ func TestParseAndFormatFileSizeMatrix(t *testing.T) {
 	assert.Equal(t, "1.0 GB", formatFileSize(1024*1024*1024))
 }
 
-func TestAppendCharFormatFormValueAndDerefString(t *testing.T) {
-	value := "ab"
-	appendChar(&value, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
-	assert.Equal(t, "abc", value)
-	appendChar(&value, tea.KeyMsg{Type: tea.KeySpace})
-	assert.Equal(t, "abc ", value)
-	appendChar(&value, tea.KeyMsg{Type: tea.KeyEnter})
-	assert.Equal(t, "abc ", value)
-
-	assert.Equal(t, "-", formatFormValue("   ", false))
-	assert.Contains(t, stripANSI(formatFormValue("value", true)), "value")
+func TestFileFormInputFormatFormValueAndDerefString(t *testing.T) {
+	model := NewFilesModel(nil)
+	model.addFocus = fileFieldName
+	model.addName = components.NewTextInput("ab")
+	model, _ = model.handleAddKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	assert.Equal(t, "abc", model.addName.Value)
+	model, _ = model.handleAddKeys(tea.KeyMsg{Type: tea.KeySpace})
+	assert.Equal(t, "abc ", model.addName.Value)
+
+	assert.Equal(t, "-", formatFormValue(components.NewTextInput("   "), false))
+	assert.Contains(t, stripANSI(formatFormValue(components.NewTextInput("value"), true)), "value")
 	assert.Equal(t, "", derefString(nil))
 	text := "x"
 	assert.Equal(t, "x", derefString(&text))
@@ -143,19 +144,19 @@ func TestFilesHandleAddKeysStatusTagsAndSavedBranches(t *testing.T) {
 
 	updated.addFocus = fileFieldTags
 	updated.addTags = []string{"alpha"}
-	updated.addTagBuf = "z"
+	updated.addTagBuf.Value = "z"
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "", updated.addTagBuf)
+	assert.Equal(t, "", updated.addTagBuf.Value)
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyBackspace})
 	assert.Empty(t, updated.addTags)
 
 	updated.addSaved = true
-	updated.addName = "Alpha.txt"
-	updated.addPath = "/tmp/alpha.txt"
+	updated.addName.Value = "Alpha.txt"
+	updated.addPath.Value = "/tmp/alpha.txt"
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyEsc})
 	assert.False(t, updated.addSaved)
-	assert.Equal(t, "", updated.addName)
-	assert.Equal(t, "", updated.addPath)
+	assert.Equal(t, "", updated.addName.Value)
+	assert.Equal(t, "", updated.addPath.Value)
 }
 
 func TestFilesHandleEditKeysStatusTagsAndBackBranches(t *testing.T) {
@@ -172,9 +173,9 @@ func TestFilesHandleEditKeysStatusTagsAndBackBranches(t *testing.T) {
 
 	updated.editFocus = fileFieldTags
 	updated.editTags = []string{"alpha"}
-	updated.editTagBuf = "z"
+	updated.editTagBuf.Value = "z"
 	updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "", updated.editTagBuf)
+	assert.Equal(t, "", updated.editTagBuf.Value)
 	updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyBackspace})
 	assert.Empty(t, updated.editTags)
 
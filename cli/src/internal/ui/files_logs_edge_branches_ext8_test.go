@@ -40,11 +40,11 @@ func TestFilesRenderListSideBySidePreviewBranch(t *testing.T) {
 func TestFilesHandleAddKeysNameBackspaceBranch(t *testing.T) {
 	model := NewFilesModel(nil)
 	model.addFocus = fileFieldName
-	model.addName = "ab"
+	model.addName.Value = "ab"
 
 	updated, cmd := model.handleAddKeys(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "a", updated.addName)
+	assert.Equal(t, "a", updated.addName.Value)
 }
 
 func TestFilesSaveAddCreateErrorBranch(t *testing.T) {
@@ -58,8 +58,8 @@ func TestFilesSaveAddCreateErrorBranch(t *testing.T) {
 	})
 
 	model := NewFilesModel(client)
-	model.addName = "alpha.txt"
-	model.addPath = "/tmp/alpha.txt"
+	model.addName.Value = "alpha.txt"
+	model.addPath.Value = "/tmp/alpha.txt"
 
 	updated, cmd := model.saveAdd()
 	require.NotNil(t, cmd)
@@ -114,12 +114,12 @@ func TestLogsHandleAddKeysUpBackAndDefaultBackspaceBranches(t *testing.T) {
 	require.Nil(t, cmd)
 	assert.Equal(t, logFieldType, updated.addFocus)
 
-	updated.addType = "event"
-	updated.addTagBuf = "tmp"
+	updated.addType.Value = "event"
+	updated.addTagBuf.Value = "tmp"
 	updated, cmd = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyEsc})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.addType)
-	assert.Equal(t, "", updated.addTagBuf)
+	assert.Equal(t, "", updated.addType.Value)
+	assert.Equal(t, "", updated.addTagBuf.Value)
 
 	updated.addFocus = logFieldMeta
 	updated, cmd = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyBackspace})
@@ -130,8 +130,8 @@ func TestLogsHandleAddKeysUpBackAndDefaultBackspaceBranches(t *testing.T) {
 func TestLogsRenderAddValueMetaFallbackAndSaveAddErrorBranch(t *testing.T) {
 	model := NewLogsModel(nil)
 	model.width = 100
-	model.addType = "event"
-	model.addTimestamp = "2026-03-04"
+	model.addType.Value = "event"
+	model.addTimestamp.Value = "2026-03-04"
 
 	out := components.SanitizeText(model.renderAdd())
 	assert.Contains(t, out, "Value:")
@@ -150,8 +150,8 @@ func TestLogsRenderAddValueMetaFallbackAndSaveAddErrorBranch(t *testing.T) {
 	})
 
 	model = NewLogsModel(client)
-	model.addType = "event"
-	model.addTimestamp = "2026-03-04"
+	model.addType.Value = "event"
+	model.addTimestamp.Value = "2026-03-04"
 
 	updated, cmd := model.saveAdd()
 	require.NotNil(t, cmd)
@@ -0,0 +1,57 @@
+package ui
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/filecheck"
+)
+
+type fileProbedMsg struct {
+	path  string
+	probe filecheck.Probe
+	err   error
+}
+
+// probeAddPath reads the file at the add form's path to fill in its MIME
+// type, size, and checksum.
+func (m FilesModel) probeAddPath() (FilesModel, tea.Cmd) {
+	path := strings.TrimSpace(m.addPath.Value)
+	if path == "" {
+		m.addErr = "Enter a file path to probe"
+		m.addFocus = fileFieldPath
+		return m, nil
+	}
+	m.addProbing = true
+	m.addErr = ""
+	return m, func() tea.Msg {
+		probe, err := filecheck.ProbeFile(path)
+		return fileProbedMsg{path: path, probe: probe, err: err}
+	}
+}
+
+// applyProbe fills the add form from a probe of the path still entered. The
+// filename is only filled when empty; MIME type, size, and checksum are
+// replaced.
+func (m *FilesModel) applyProbe(msg fileProbedMsg) {
+	m.addProbing = false
+	if strings.TrimSpace(m.addPath.Value) != msg.path {
+		return
+	}
+	if msg.err != nil {
+		m.addErr = "Probe failed: " + msg.err.Error()
+		return
+	}
+	if strings.TrimSpace(m.addName.Value) == "" {
+		if local, ok := filecheck.ExpandPath(msg.path); ok {
+			m.addName.SetValue(filepath.Base(local))
+		}
+	}
+	m.addMime.SetValue(msg.probe.MimeType)
+	m.addSize.SetValue(strconv.FormatInt(msg.probe.Size, 10))
+	m.addChecksum.SetValue(msg.probe.Checksum)
+	m.addErr = ""
+}
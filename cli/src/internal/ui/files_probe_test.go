@@ -0,0 +1,61 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilesAddProbeFillsMimeSizeAndChecksum(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"ok":true}`), 0o600))
+
+	model := NewFilesModel(nil)
+	model.view = filesViewAdd
+	model.addPath.Value = path
+	model.addMime.Value = "text/plain"
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlF})
+	require.NotNil(t, cmd)
+	assert.True(t, model.addProbing)
+	assert.Contains(t, model.renderAdd(), "Probing file...")
+
+	model, _ = model.Update(cmd())
+	assert.False(t, model.addProbing)
+	assert.Empty(t, model.addErr)
+	assert.Equal(t, "report.json", model.addName.Value)
+	assert.Equal(t, "application/json", model.addMime.Value)
+	assert.Equal(t, "11", model.addSize.Value)
+	assert.Regexp(t, `^sha256:[0-9a-f]{64}$`, model.addChecksum.Value)
+}
+
+func TestFilesAddProbeReportsErrorsAndIgnoresStalePaths(t *testing.T) {
+	model := NewFilesModel(nil)
+	model.view = filesViewAdd
+	model.addFocus = fileFieldName
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlF})
+	assert.Nil(t, cmd)
+	assert.Equal(t, fileFieldPath, model.addFocus)
+	assert.Contains(t, model.addErr, "Enter a file path")
+
+	model.addPath.Value = filepath.Join(t.TempDir(), "missing.bin")
+	model.addName.Value = "keep.bin"
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyCtrlF})
+	require.NotNil(t, cmd)
+	msg := cmd()
+
+	model.addPath.Value = "/elsewhere.bin"
+	model, _ = model.Update(msg)
+	assert.False(t, model.addProbing)
+	assert.Empty(t, model.addErr, "a probe for an edited path is dropped")
+
+	model.addPath.Value = msg.(fileProbedMsg).path
+	model, _ = model.Update(msg)
+	assert.Contains(t, model.addErr, "Probe failed")
+	assert.Equal(t, "keep.bin", model.addName.Value)
+}
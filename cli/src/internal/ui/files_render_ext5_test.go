@@ -14,12 +14,12 @@ import (
 func TestFilesUpdateUnknownMessageReturnsNoop(t *testing.T) {
 	model := NewFilesModel(nil)
 	model.view = filesViewDetail
-	model.searchBuf = "alpha"
+	model.searchBuf.Value = "alpha"
 
 	updated, cmd := model.Update(struct{ name string }{name: "noop"})
 	require.Nil(t, cmd)
 	assert.Equal(t, filesViewDetail, updated.view)
-	assert.Equal(t, "alpha", updated.searchBuf)
+	assert.Equal(t, "alpha", updated.searchBuf.Value)
 }
 
 func TestFilesRenderListLoadingAndSmallWidthBranches(t *testing.T) {
@@ -50,7 +50,7 @@ func TestFilesRenderListLoadingAndSmallWidthBranches(t *testing.T) {
 	}
 	// Keep one extra list row so renderList hits the out-of-range guard branch.
 	model.list.SetItems([]string{"row-1", "row-2", "row-3"})
-	model.searchBuf = "be"
+	model.searchBuf.Value = "be"
 	model.searchSuggest = "Beta.txt"
 	model.modeFocus = true
 
@@ -97,17 +97,17 @@ func TestFilesHandleListKeysSpaceAndEscapeSearchBranches(t *testing.T) {
 
 	updated, cmd := model.handleListKeys(tea.KeyMsg{Type: tea.KeySpace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 
 	updated.all = []api.File{
 		{ID: "file-1", Filename: "Alpha.txt", Status: "active", CreatedAt: now},
 	}
 	updated.applyFileSearch()
-	updated.searchBuf = "Alpha"
+	updated.searchBuf.Value = "Alpha"
 	updated.searchSuggest = "Alpha.txt"
 	updated, cmd = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyEsc})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 	assert.Equal(t, "", updated.searchSuggest)
 }
 
@@ -0,0 +1,213 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gravitrone/nebula-core/cli/internal/filecheck"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+type filesVerifiedMsg struct {
+	results []filecheck.Result
+	err     error
+}
+
+type filesReregisteredMsg struct {
+	updated int
+	err     error
+}
+
+// startVerify switches to the integrity view and checks every local file.
+func (m FilesModel) startVerify() (FilesModel, tea.Cmd) {
+	m.view = filesViewVerify
+	m.verifyResults = nil
+	m.verifyIndex = 0
+	m.verifyNote = ""
+	m.verifyLoading = true
+	return m, m.verifyFiles()
+}
+
+// verifyFiles recomputes checksums for every file record on this machine.
+func (m FilesModel) verifyFiles() tea.Cmd {
+	client := m.client
+	if client == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		files, err := filecheck.Fetch(client)
+		if err != nil {
+			return filesVerifiedMsg{err: fmt.Errorf("load files: %w", err)}
+		}
+		return filesVerifiedMsg{results: filecheck.VerifyAll(files)}
+	}
+}
+
+// reregisterDrifted stores the recomputed checksum on every drifted record.
+func (m FilesModel) reregisterDrifted() tea.Cmd {
+	client := m.client
+	var drifted []filecheck.Result
+	for _, result := range m.verifyResults {
+		if result.Drifted() {
+			drifted = append(drifted, result)
+		}
+	}
+	if client == nil || len(drifted) == 0 {
+		return nil
+	}
+	return func() tea.Msg {
+		updated := 0
+		for _, result := range drifted {
+			if err := filecheck.Reregister(client, result); err != nil {
+				return filesReregisteredMsg{updated: updated, err: fmt.Errorf("re-register %s: %w", result.File.Filename, err)}
+			}
+			updated++
+		}
+		return filesReregisteredMsg{updated: updated}
+	}
+}
+
+// visibleVerifyResults drops remote records, which were not checked.
+func (m FilesModel) visibleVerifyResults() []filecheck.Result {
+	out := make([]filecheck.Result, 0, len(m.verifyResults))
+	for _, result := range m.verifyResults {
+		if result.Status != filecheck.StatusRemote {
+			out = append(out, result)
+		}
+	}
+	return out
+}
+
+// handleVerifyKeys handles keys in the integrity view: u re-registers every
+// drifted file, r checks again, enter opens the selected file.
+func (m FilesModel) handleVerifyKeys(msg tea.KeyMsg) (FilesModel, tea.Cmd) {
+	if m.verifyLoading || m.verifySaving {
+		if isBack(msg) {
+			m.view = filesViewList
+		}
+		return m, nil
+	}
+	visible := m.visibleVerifyResults()
+	switch {
+	case isBack(msg):
+		m.view = filesViewList
+	case isDown(msg):
+		if m.verifyIndex < len(visible)-1 {
+			m.verifyIndex++
+		}
+	case isUp(msg):
+		if m.verifyIndex > 0 {
+			m.verifyIndex--
+		}
+	case isKey(msg, "r"):
+		return m.startVerify()
+	case isKey(msg, "u"):
+		if cmd := m.reregisterDrifted(); cmd != nil {
+			m.verifySaving = true
+			m.verifyNote = ""
+			return m, cmd
+		}
+	case isEnter(msg):
+		if m.verifyIndex < len(visible) {
+			item := visible[m.verifyIndex].File
+			m.detail = &item
+			m.detailRels = nil
+			m.view = filesViewDetail
+			return m, m.loadDetailRelationships(item.ID)
+		}
+	}
+	return m, nil
+}
+
+// renderVerify renders the integrity results table.
+func (m FilesModel) renderVerify() string {
+	if m.verifyLoading {
+		return components.TitledBox("Integrity", MutedStyle.Render("Hashing local files..."), m.width)
+	}
+	if len(m.verifyResults) == 0 {
+		return components.EmptyStateBox(
+			"Integrity",
+			"No file records to check.",
+			[]string{"Press esc to go back."},
+			m.width,
+		)
+	}
+
+	counts := filecheck.Counts(m.verifyResults)
+	parts := []string{}
+	for _, status := range []filecheck.Status{
+		filecheck.StatusOK, filecheck.StatusDrift, filecheck.StatusMissing,
+		filecheck.StatusError, filecheck.StatusUnchecked, filecheck.StatusRemote,
+	} {
+		if n := counts[status]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", n, status))
+		}
+	}
+	summary := AccentStyle.Render(fmt.Sprintf("Checked %d files", len(m.verifyResults))) +
+		MutedStyle.Render(" · "+strings.Join(parts, " · "))
+	lines := []string{summary}
+	if counts[filecheck.StatusDrift] > 0 {
+		lines = append(lines, WarningStyle.Render(fmt.Sprintf("%d drifted. Press u to re-register their checksums.", counts[filecheck.StatusDrift])))
+	}
+	if m.verifySaving {
+		lines = append(lines, MutedStyle.Render("Re-registering..."))
+	}
+	if m.verifyNote != "" {
+		lines = append(lines, MutedStyle.Render(components.SanitizeOneLine(m.verifyNote)))
+	}
+
+	visible := m.visibleVerifyResults()
+	if len(visible) > 0 {
+		contentWidth := components.BoxContentWidth(m.width) - 2
+		if contentWidth < 40 {
+			contentWidth = 40
+		}
+		statusWidth := 10
+		nameWidth := max(12, contentWidth/3)
+		columns := []components.TableColumn{
+			{Header: "Status", Width: statusWidth, Align: lipgloss.Left, CellStyle: verifyStatusStyle},
+			{Header: "File", Width: nameWidth, Align: lipgloss.Left},
+			{Header: "Detail", Width: max(contentWidth-statusWidth-nameWidth, 10), Align: lipgloss.Left},
+		}
+		rows := make([][]string, len(visible))
+		for i, result := range visible {
+			rows[i] = []string{
+				string(result.Status),
+				components.SanitizeOneLine(result.File.Filename),
+				components.SanitizeOneLine(verifyResultDetail(result)),
+			}
+		}
+		lines = append(lines, "", components.TableGridWithActiveRow(columns, rows, contentWidth, m.verifyIndex))
+	}
+	return components.TitledBox("Integrity", strings.Join(lines, "\n"), m.width)
+}
+
+// verifyStatusStyle colors an integrity status cell.
+func verifyStatusStyle(text string) lipgloss.Style {
+	switch filecheck.Status(strings.TrimSpace(text)) {
+	case filecheck.StatusOK:
+		return SuccessStyle
+	case filecheck.StatusDrift, filecheck.StatusMissing, filecheck.StatusError:
+		return ErrorStyle
+	}
+	return MutedStyle
+}
+
+// verifyResultDetail explains a result in one line.
+func verifyResultDetail(result filecheck.Result) string {
+	switch result.Status {
+	case filecheck.StatusDrift:
+		_, stored := filecheck.ParseChecksum(result.Stored)
+		return fmt.Sprintf("%s %s → %s", result.Algorithm, stored[:min(len(stored), 12)], result.Actual[:min(len(result.Actual), 12)])
+	case filecheck.StatusMissing:
+		return "not found at " + result.Path
+	case filecheck.StatusError:
+		return result.Err.Error()
+	case filecheck.StatusUnchecked:
+		return "no stored checksum"
+	}
+	return result.Path
+}
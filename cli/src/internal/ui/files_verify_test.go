@@ -0,0 +1,73 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/filecheck"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestFilesVerifyShowsDriftAndReregisters(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "spec.txt")
+	require.NoError(t, os.WriteFile(path, []byte("edited"), 0o600))
+
+	var patched map[string]any
+	_, client := contextTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			assert.Equal(t, "/api/files/f-1", r.URL.Path)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&patched))
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "f-1"}}))
+			return
+		}
+		checksum := "sha256:0000"
+		if patched != nil {
+			checksum = patched["checksum"].(string)
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+			{"id": "f-1", "filename": "spec.txt", "file_path": path, "checksum": checksum},
+			{"id": "f-2", "filename": "cloud.bin", "uri": "s3://bucket/cloud.bin"},
+		}}))
+	})
+	model := NewFilesModel(client)
+	model.width = 120
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("V")})
+	require.NotNil(t, cmd)
+	assert.Equal(t, filesViewVerify, model.view)
+	model, _ = model.Update(cmd())
+	require.Len(t, model.verifyResults, 2)
+
+	view := components.SanitizeText(model.View())
+	assert.Contains(t, view, "Checked 2 files")
+	assert.Contains(t, view, "1 drift")
+	assert.Contains(t, view, "spec.txt")
+	assert.NotContains(t, view, "cloud.bin", "remote files are counted but not listed")
+
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u")})
+	require.NotNil(t, cmd)
+	assert.True(t, model.verifySaving)
+	model, cmd = model.Update(cmd())
+	require.NotNil(t, patched)
+	assert.Equal(t, float64(len("edited")), patched["size_bytes"])
+	assert.Contains(t, model.verifyNote, "Re-registered 1 file.")
+
+	require.NotNil(t, cmd)
+	for _, msg := range cmd().(tea.BatchMsg) {
+		if result, ok := msg().(filesVerifiedMsg); ok {
+			model, _ = model.Update(result)
+		}
+	}
+	assert.Equal(t, 0, filecheck.Counts(model.verifyResults)[filecheck.StatusDrift])
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, filesViewList, model.view)
+}
@@ -69,7 +69,7 @@ func TestFilesListViewRendersItemsAndSearchSuggestTabCompletes(t *testing.T) {
 
 	// Tab should complete to suggestion.
 	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyTab})
-	assert.Equal(t, "Alpha.txt", model.searchBuf)
+	assert.Equal(t, "Alpha.txt", model.searchBuf.Value)
 }
 
 // TestFilesDetailViewRendersMetadataWhenExpanded handles test files detail view renders metadata when expanded.
@@ -207,8 +207,8 @@ func TestFilesAddFlowRendersAndResetsOnEscAfterSave(t *testing.T) {
 	// Esc should reset add state.
 	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
 	assert.False(t, model.addSaved)
-	assert.Empty(t, model.addName)
-	assert.Empty(t, model.addPath)
+	assert.Empty(t, model.addName.Value)
+	assert.Empty(t, model.addPath.Value)
 }
 
 // TestFilesEditViewCommitsTagsAndRenders handles test files edit view commits tags and renders.
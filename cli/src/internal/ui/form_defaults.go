@@ -0,0 +1,72 @@
+package ui
+
+import (
+	"slices"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+// defaultEntityStatus is the add form status when none is configured.
+const defaultEntityStatus = "active"
+
+// entityDefaultStatus returns the configured entity status when it is one
+// the add form offers, else defaultEntityStatus.
+func entityDefaultStatus(d config.FormDefaults) string {
+	if slices.Contains(entityStatusOptions, d.Status) {
+		return d.Status
+	}
+	return defaultEntityStatus
+}
+
+// contextDefaultTypeIdx returns the add form index of the configured
+// knowledge type, or the first type when it is unset or unknown.
+func contextDefaultTypeIdx(d config.FormDefaults) int {
+	if i := slices.Index(contextTypes, d.KnowledgeType); i >= 0 {
+		return i
+	}
+	return 0
+}
+
+// SetFormDefaults changes the add form defaults. Fields still holding the
+// previous defaults take the new ones; edited fields are left alone.
+func (m *EntitiesModel) SetFormDefaults(d config.FormDefaults) {
+	prev := m.formDefaults
+	m.formDefaults = d
+	if m.addFields[addFieldType].Value == prev.EntityType {
+		m.addFields[addFieldType].SetValue(d.EntityType)
+	}
+	if entityStatusOptions[m.addStatusIdx] == entityDefaultStatus(prev) {
+		m.addStatusIdx = statusIndex(entityStatusOptions, entityDefaultStatus(d))
+	}
+	if slices.Equal(m.addScopes, prev.Scopes) {
+		m.addScopes = slices.Clone(d.Scopes)
+	}
+}
+
+// applyAddDefaults fills the add form type, status, and scopes from the
+// configured defaults.
+func (m *EntitiesModel) applyAddDefaults() {
+	m.addFields[addFieldType].SetValue(m.formDefaults.EntityType)
+	m.addStatusIdx = statusIndex(entityStatusOptions, entityDefaultStatus(m.formDefaults))
+	m.addScopes = slices.Clone(m.formDefaults.Scopes)
+}
+
+// SetFormDefaults changes the add form defaults. Fields still holding the
+// previous defaults take the new ones; edited fields are left alone.
+func (m *ContextModel) SetFormDefaults(d config.FormDefaults) {
+	prev := m.formDefaults
+	m.formDefaults = d
+	if m.typeIdx == contextDefaultTypeIdx(prev) {
+		m.typeIdx = contextDefaultTypeIdx(d)
+	}
+	if slices.Equal(m.scopes, prev.Scopes) {
+		m.scopes = slices.Clone(d.Scopes)
+	}
+}
+
+// applyFormDefaults fills the add form type and scopes from the configured
+// defaults.
+func (m *ContextModel) applyFormDefaults() {
+	m.typeIdx = contextDefaultTypeIdx(m.formDefaults)
+	m.scopes = slices.Clone(m.formDefaults.Scopes)
+}
@@ -0,0 +1,79 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+func TestSetFormDefaultsPrefillsAddForms(t *testing.T) {
+	d := config.FormDefaults{Scopes: []string{"work"}, EntityType: "project", KnowledgeType: "paper", Status: "inactive"}
+
+	entities := NewEntitiesModel(nil)
+	entities.resetAddForm()
+	entities.SetFormDefaults(d)
+	assert.Equal(t, "project", entities.addFields[addFieldType].Value)
+	assert.Equal(t, "inactive", entityStatusOptions[entities.addStatusIdx])
+	assert.Equal(t, []string{"work"}, entities.addScopes)
+
+	// Edited fields survive a defaults change; untouched ones follow it.
+	entities.addFields[addFieldType].Value = "person"
+	entities.SetFormDefaults(config.FormDefaults{EntityType: "tool"})
+	assert.Equal(t, "person", entities.addFields[addFieldType].Value)
+	assert.Equal(t, defaultEntityStatus, entityStatusOptions[entities.addStatusIdx])
+	assert.Empty(t, entities.addScopes)
+
+	entities.resetAddForm()
+	assert.Equal(t, "tool", entities.addFields[addFieldType].Value)
+
+	know := NewContextModel(nil)
+	know.resetForm()
+	know.SetFormDefaults(d)
+	assert.Equal(t, "paper", contextTypes[know.typeIdx])
+	assert.Equal(t, []string{"work"}, know.scopes)
+	know.resetForm()
+	assert.Equal(t, "paper", contextTypes[know.typeIdx])
+}
+
+func TestSettingsEditsCreateDefaults(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	cfg := &config.Config{APIKey: "nbl_test"}
+	model := NewProfileModel(nil, cfg)
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	require.True(t, model.editDefaults)
+	typeText := func(s string) {
+		for _, r := range s {
+			model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		}
+	}
+	typeText("work, public")
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	typeText("project")
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	typeText("sleeping")
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	_, ok := cmd().(errMsg)
+	assert.True(t, ok)
+	assert.Equal(t, config.FormDefaults{}, cfg.Defaults)
+
+	for range "sleeping" {
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	}
+	typeText("inactive")
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	msg, ok := cmd().(formDefaultsSavedMsg)
+	require.True(t, ok)
+	want := config.FormDefaults{Scopes: []string{"work", "public"}, EntityType: "project", Status: "inactive"}
+	assert.Equal(t, want, msg.defaults)
+	assert.Equal(t, want, cfg.Defaults)
+	assert.Equal(t, "scopes work,public · entity project, inactive · knowledge "+contextTypes[0], formDefaultsLabel(cfg))
+}
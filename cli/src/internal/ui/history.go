@@ -14,7 +14,10 @@ import (
 	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
 )
 
-type historyLoadedMsg struct{ items []api.AuditEntry }
+type historyLoadedMsg struct {
+	items  []api.AuditEntry
+	queued time.Time
+}
 type historyScopesLoadedMsg struct{ items []api.AuditScope }
 type historyActorsLoadedMsg struct{ items []api.AuditActor }
 type historyRevertedMsg struct {
@@ -29,6 +32,7 @@ const (
 	historyViewDetail
 	historyViewScopes
 	historyViewActors
+	historyViewSummary
 )
 
 type auditFilter struct {
@@ -40,26 +44,31 @@ type auditFilter struct {
 	scopeID   string
 	actor     string
 	terms     []string
+	window    api.TimeWindow
 }
 
 type HistoryModel struct {
-	client    *api.Client
-	items     []api.AuditEntry
-	list      *components.List
-	loading   bool
-	width     int
-	height    int
-	view      historyView
-	detail    *api.AuditEntry
-	filtering bool
-	filterBuf string
-	filter    auditFilter
-	errText   string
-	scopes    []api.AuditScope
-	actors    []api.AuditActor
-	scopeList *components.List
-	actorList *components.List
-	reverting bool
+	client      *api.Client
+	items       []api.AuditEntry
+	loaded      []api.AuditEntry
+	list        *components.List
+	sort        tableSort
+	loading     bool
+	loadLatency time.Duration
+	width       int
+	height      int
+	view        historyView
+	detail      *api.AuditEntry
+	filtering   bool
+	filterBuf   components.TextInput
+	filter      auditFilter
+	errText     string
+	scopes      []api.AuditScope
+	actors      []api.AuditActor
+	summary     *api.AuditStats
+	scopeList   *components.List
+	actorList   *components.List
+	reverting   bool
 }
 
 // NewHistoryModel builds the audit history UI model.
@@ -84,13 +93,10 @@ func (m HistoryModel) Update(msg tea.Msg) (HistoryModel, tea.Cmd) {
 	switch msg := msg.(type) {
 	case historyLoadedMsg:
 		m.loading = false
+		m.loadLatency = loadElapsed(msg.queued)
 		m.errText = ""
-		m.items = m.applyLocalFilters(msg.items)
-		labels := make([]string, len(m.items))
-		for i, entry := range m.items {
-			labels[i] = formatAuditLine(entry)
-		}
-		m.list.SetItems(labels)
+		m.loaded = msg.items
+		m.applySort()
 		return m, nil
 	case historyScopesLoadedMsg:
 		m.loading = false
@@ -112,6 +118,12 @@ func (m HistoryModel) Update(msg tea.Msg) (HistoryModel, tea.Cmd) {
 		}
 		m.actorList.SetItems(labels)
 		return m, nil
+	case historySummaryLoadedMsg:
+		m.loading = false
+		m.loadLatency = loadElapsed(msg.queued)
+		m.errText = ""
+		m.summary = msg.stats
+		return m, nil
 	case historyRevertedMsg:
 		m.reverting = false
 		m.view = historyViewList
@@ -154,6 +166,8 @@ func (m HistoryModel) Update(msg tea.Msg) (HistoryModel, tea.Cmd) {
 			return m.handleScopeKeys(msg)
 		case historyViewActors:
 			return m.handleActorKeys(msg)
+		case historyViewSummary:
+			return m.handleSummaryKeys(msg)
 		}
 	}
 
@@ -166,14 +180,17 @@ func (m HistoryModel) View() string {
 		return components.Indent(components.InputDialog("Filter Audit Log", m.filterBuf), 1)
 	}
 	if m.loading {
-		label := "Loading history..."
 		switch m.view {
 		case historyViewScopes:
-			label = "Loading scopes..."
+			return renderLoadingList("scopes", 0, nil, m.width, nil)
 		case historyViewActors:
-			label = "Loading actors..."
+			return renderLoadingList("actors", 0, nil, m.width, nil)
+		case historyViewSummary:
+			return renderLoadingList("summary", 0, nil, m.width, nil)
+		case historyViewList:
+			return renderLoadingList("history", len(m.items), m.list, m.width, m.renderList)
 		}
-		return "  " + MutedStyle.Render(label)
+		return renderLoadingList("history", 0, m.list, m.width, nil)
 	}
 	if m.errText != "" {
 		return components.Indent(components.ErrorBox("Error", m.errText, m.width), 1)
@@ -190,6 +207,9 @@ func (m HistoryModel) View() string {
 	if m.view == historyViewActors {
 		return m.renderActors()
 	}
+	if m.view == historyViewSummary {
+		return m.renderSummary()
+	}
 	return m.renderList()
 }
 
@@ -255,6 +275,19 @@ func (m HistoryModel) handleListKeys(msg tea.KeyMsg) (HistoryModel, tea.Cmd) {
 		}
 	case isKey(msg, "f"):
 		m.filtering = true
+	case isKey(msg, "T"):
+		m.filterBuf.SetValue(cycleWindowPreset(m.filterBuf.Value))
+		m.filter = parseAuditFilter(m.filterBuf.Value)
+		m.loading = true
+		return m, m.loadHistory()
+	case isKey(msg, "o"):
+		m.sort = m.sort.next(historySortColumns)
+		m.applySort()
+		return m, m.sort.changed("history")
+	case isKey(msg, "O"):
+		m.sort = m.sort.reversed()
+		m.applySort()
+		return m, m.sort.changed("history")
 	case isKey(msg, "s"):
 		m.view = historyViewScopes
 		m.loading = true
@@ -263,6 +296,10 @@ func (m HistoryModel) handleListKeys(msg tea.KeyMsg) (HistoryModel, tea.Cmd) {
 		m.view = historyViewActors
 		m.loading = true
 		return m, m.loadActors()
+	case isKey(msg, "S"):
+		m.view = historyViewSummary
+		m.loading = true
+		return m, m.loadSummary()
 	}
 	return m, nil
 }
@@ -272,21 +309,17 @@ func (m HistoryModel) handleFilterKeys(msg tea.KeyMsg) (HistoryModel, tea.Cmd) {
 	switch {
 	case isEnter(msg):
 		m.filtering = false
-		m.filter = parseAuditFilter(m.filterBuf)
+		m.filter = parseAuditFilter(m.filterBuf.Value)
 		m.loading = true
 		return m, m.loadHistory()
 	case isBack(msg):
 		m.filtering = false
-		m.filterBuf = ""
+		m.filterBuf.Reset()
 		m.filter = auditFilter{}
 		m.loading = true
 		return m, m.loadHistory()
-	case msg.Type == tea.KeyBackspace:
-		if len(m.filterBuf) > 0 {
-			m.filterBuf = m.filterBuf[:len(m.filterBuf)-1]
-		}
-	case msg.Type == tea.KeyRunes:
-		m.filterBuf += msg.String()
+	default:
+		m.filterBuf.HandleKey(msg)
 	}
 	return m, nil
 }
@@ -337,21 +370,23 @@ func (m HistoryModel) handleActorKeys(msg tea.KeyMsg) (HistoryModel, tea.Cmd) {
 // loadHistory loads load history.
 func (m HistoryModel) loadHistory() tea.Cmd {
 	filter := m.filter
+	queued := time.Now()
 	return func() tea.Msg {
-		items, err := m.client.QueryAuditLogWithPagination(
+		items, err := m.client.QueryAuditLogInWindow(
 			filter.tableName,
 			filter.action,
 			filter.actorType,
 			filter.actorID,
 			filter.recordID,
 			filter.scopeID,
+			filter.window,
 			50,
 			0,
 		)
 		if err != nil {
-			return errMsg{err}
+			return loadFailed(tabHistory, err, m.loadHistory())
 		}
-		return historyLoadedMsg{items: items}
+		return historyLoadedMsg{items: items, queued: queued}
 	}
 }
 
@@ -411,6 +446,9 @@ func (m HistoryModel) renderList() string {
 
 	atWidth := compactTimeColumnWidth
 	actionWidth := 6
+	if m.sort.column == "action" {
+		actionWidth = 8
+	}
 	tableNameWidth := 17
 	actorWidth := availableCols - (atWidth + actionWidth + tableNameWidth)
 	if actorWidth < 14 {
@@ -422,10 +460,10 @@ func (m HistoryModel) renderList() string {
 	}
 
 	cols := []components.TableColumn{
-		{Header: "At", Width: atWidth, Align: lipgloss.Left},
-		{Header: "Action", Width: actionWidth, Align: lipgloss.Left},
-		{Header: "Table", Width: tableNameWidth, Align: lipgloss.Left},
-		{Header: "Actor", Width: actorWidth, Align: lipgloss.Left},
+		{Header: m.sort.header("At", "at"), Width: atWidth, Align: lipgloss.Left},
+		{Header: m.sort.header("Action", "action"), Width: actionWidth, Align: lipgloss.Left},
+		{Header: m.sort.header("Table", "table"), Width: tableNameWidth, Align: lipgloss.Left},
+		{Header: m.sort.header("Actor", "actor"), Width: actorWidth, Align: lipgloss.Left},
 	}
 
 	tableRows := make([][]string, 0, len(visible))
@@ -466,7 +504,11 @@ func (m HistoryModel) renderList() string {
 		})
 	}
 
-	countLine := MutedStyle.Render(fmt.Sprintf("%d total", len(m.items)))
+	count := fmt.Sprintf("%d total", len(m.items))
+	if sortLabel := m.sort.label(); sortLabel != "" {
+		count = fmt.Sprintf("%s · %s", count, sortLabel)
+	}
+	countLine := MutedStyle.Render(count) + renderLoadLatency(m.loadLatency)
 	if filterLine != "" {
 		filterLine = MutedStyle.Render(filterLine)
 	}
@@ -794,7 +836,7 @@ func (m HistoryModel) renderDetail(entry api.AuditEntry) string {
 
 	diffRows := buildAuditDiffRows(entry)
 	if len(diffRows) > 0 {
-		diff := components.DiffTable("Changes", diffRows, m.width)
+		diff := components.DiffView("Changes", diffRows, m.width)
 		section = section + "\n\n" + diff
 	}
 	return components.Indent(section, 1)
@@ -809,6 +851,7 @@ func parseAuditFilter(input string) auditFilter {
 	}
 	for _, token := range strings.Fields(input) {
 		switch {
+		case parseWindowToken(&filter.window, token):
 		case strings.HasPrefix(token, "table:"):
 			filter.tableName = strings.TrimPrefix(token, "table:")
 		case strings.HasPrefix(token, "action:"):
@@ -1035,6 +1078,7 @@ func formatAuditFilters(filter auditFilter) string {
 	if filter.actor != "" {
 		parts = append(parts, "actor:"+filter.actor)
 	}
+	parts = append(parts, formatWindow(filter.window)...)
 	if len(parts) == 0 {
 		return ""
 	}
@@ -1085,6 +1129,8 @@ func buildAuditDiffRows(entry api.AuditEntry) []components.DiffRow {
 			Label: humanizeAuditField(key),
 			From:  formatAuditValue(from),
 			To:    formatAuditValue(to),
+			Old:   from,
+			New:   to,
 		})
 	}
 	return rows
@@ -1159,3 +1205,31 @@ func humanizeAuditField(raw string) string {
 	}
 	return components.SanitizeOneLine(strings.Join(out, " "))
 }
+
+// historySortColumns are the columns `o` cycles through in the audit log.
+var historySortColumns = []string{"at", "action", "table", "actor"}
+
+// applySort rebuilds the visible entries from the last load with the local
+// filters and sort applied.
+func (m *HistoryModel) applySort() {
+	m.items = append([]api.AuditEntry{}, m.applyLocalFilters(m.loaded)...)
+	sortRows(m.items, m.sort, compareAuditEntry)
+	labels := make([]string, len(m.items))
+	for i, entry := range m.items {
+		labels[i] = formatAuditLine(entry)
+	}
+	m.list.SetItems(labels)
+}
+
+// compareAuditEntry orders audit entries by a log column.
+func compareAuditEntry(column string, a, b api.AuditEntry) int {
+	switch column {
+	case "action":
+		return compareText(a.Action, b.Action)
+	case "table":
+		return compareText(a.TableName, b.TableName)
+	case "actor":
+		return compareText(formatAuditActor(a), formatAuditActor(b))
+	}
+	return compareTime(a.ChangedAt, b.ChangedAt)
+}
@@ -56,12 +56,12 @@ func TestHistoryLoadCommandsErrorBranches(t *testing.T) {
 	model := NewHistoryModel(client)
 
 	msg := model.loadHistory()()
-	errOut, ok := msg.(errMsg)
+	failed, ok := msg.(loadFailedMsg)
 	require.True(t, ok)
-	assert.ErrorContains(t, errOut.err, "AUDIT_FAIL")
+	assert.ErrorContains(t, failed.err, "AUDIT_FAIL")
 
 	msg = model.loadScopes()()
-	errOut, ok = msg.(errMsg)
+	errOut, ok := msg.(errMsg)
 	require.True(t, ok)
 	assert.ErrorContains(t, errOut.err, "SCOPES_FAIL")
 
@@ -0,0 +1,150 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// historySummaryTop is how many actors, tables, and records the summary ranks.
+const historySummaryTop = 8
+
+type historySummaryLoadedMsg struct {
+	stats  *api.AuditStats
+	queued time.Time
+}
+
+// handleSummaryKeys handles keys in the summary view.
+func (m HistoryModel) handleSummaryKeys(msg tea.KeyMsg) (HistoryModel, tea.Cmd) {
+	switch {
+	case isBack(msg), isKey(msg, "S"):
+		m.view = historyViewList
+	case isKey(msg, "T"):
+		m.filterBuf.SetValue(cycleWindowPreset(m.filterBuf.Value))
+		m.filter = parseAuditFilter(m.filterBuf.Value)
+		m.loading = true
+		return m, tea.Batch(m.loadSummary(), m.loadHistory())
+	}
+	return m, nil
+}
+
+// loadSummary loads audit stats for the active filter and window.
+func (m HistoryModel) loadSummary() tea.Cmd {
+	filter := m.filter
+	queued := time.Now()
+	return func() tea.Msg {
+		params := api.QueryParams{"top": fmt.Sprintf("%d", historySummaryTop)}
+		filter.window.Apply(params)
+		if filter.tableName != "" {
+			params["table"] = filter.tableName
+		}
+		if filter.action != "" {
+			params["action"] = filter.action
+		}
+		if filter.actorType != "" {
+			params["actor_type"] = filter.actorType
+		}
+		if filter.actorID != "" {
+			params["actor_id"] = filter.actorID
+		}
+		stats, err := m.client.GetAuditStats(params)
+		if err != nil {
+			return errMsg{err}
+		}
+		return historySummaryLoadedMsg{stats: stats, queued: queued}
+	}
+}
+
+// renderSummary renders the activity histogram and top rankings.
+func (m HistoryModel) renderSummary() string {
+	stats := m.summary
+	scope := "all time"
+	if window := formatWindow(m.filter.window); len(window) > 0 {
+		scope = strings.Join(window, " ")
+	}
+	if stats == nil || stats.Total == 0 {
+		return components.Indent(components.EmptyStateBox(
+			"Audit Summary",
+			"No audit activity in "+scope+".",
+			[]string{"T cycles the time range", "esc returns to the log"},
+			m.width,
+		), 1)
+	}
+
+	chartWidth := components.BoxContentWidth(m.width)
+	days := dailySeries(stats.Daily)
+	if len(days) > chartWidth {
+		days = days[len(days)-chartWidth:]
+	}
+	counts := make([]int, len(days))
+	peak := 0
+	for i, day := range days {
+		counts[i] = day.ActionCount
+		if day.ActionCount > days[peak].ActionCount {
+			peak = i
+		}
+	}
+	sections := []string{
+		MetaKeyStyle.Render("Actions per day") + "\n" +
+			components.Sparkline(counts) + "\n" +
+			MutedStyle.Render(fmt.Sprintf("%s to %s · peak %d on %s",
+				days[0].Day, days[len(days)-1].Day, days[peak].ActionCount, days[peak].Day)),
+	}
+
+	actors := make([]components.BarRow, len(stats.TopActors))
+	for i, actor := range stats.TopActors {
+		actors[i] = components.BarRow{Label: actorDisplayName(actor), Value: actor.ActionCount}
+	}
+	tables := make([]components.BarRow, len(stats.TopTables))
+	for i, table := range stats.TopTables {
+		tables[i] = components.BarRow{Label: table.TableName, Value: table.ActionCount}
+	}
+	records := make([]components.BarRow, len(stats.TopRecords))
+	for i, record := range stats.TopRecords {
+		records[i] = components.BarRow{Label: record.TableName + ":" + shortID(record.RecordID), Value: record.ActionCount}
+	}
+	sections = append(sections,
+		MetaKeyStyle.Render("Top actors")+"\n"+components.BarChart(actors, chartWidth),
+		MetaKeyStyle.Render("Most-modified tables")+"\n"+components.BarChart(tables, chartWidth),
+		MetaKeyStyle.Render("Most-modified records")+"\n"+components.BarChart(records, chartWidth),
+	)
+
+	header := MutedStyle.Render(fmt.Sprintf("%d actions · %s", stats.Total, scope))
+	if filters := formatAuditFilters(m.filter); filters != "" {
+		header += "\n" + MutedStyle.Render("filters: "+filters)
+	}
+	header += renderLoadLatency(m.loadLatency)
+	content := MetaKeyStyle.Render("Audit Summary") + "\n" + header + "\n\n" + strings.Join(sections, "\n\n")
+	return components.Indent(components.TitledBox("Audit Summary", content+"\n", m.width), 1)
+}
+
+// dailySeries fills the days between the first and last count with zeros so
+// the histogram has one bar per calendar day.
+func dailySeries(daily []api.AuditDayCount) []api.AuditDayCount {
+	if len(daily) == 0 {
+		return nil
+	}
+	byDay := make(map[string]int, len(daily))
+	for _, d := range daily {
+		byDay[d.Day] = d.ActionCount
+	}
+	first, err := time.Parse("2006-01-02", daily[0].Day)
+	if err != nil {
+		return daily
+	}
+	last, err := time.Parse("2006-01-02", daily[len(daily)-1].Day)
+	if err != nil || last.Before(first) {
+		return daily
+	}
+	var series []api.AuditDayCount
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		key := day.Format("2006-01-02")
+		series = append(series, api.AuditDayCount{Day: key, ActionCount: byDay[key]})
+	}
+	return series
+}
@@ -0,0 +1,68 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestDailySeriesFillsGaps(t *testing.T) {
+	series := dailySeries([]api.AuditDayCount{
+		{Day: "2024-01-30", ActionCount: 2},
+		{Day: "2024-02-02", ActionCount: 5},
+	})
+	require.Len(t, series, 4)
+	assert.Equal(t, "2024-01-31", series[1].Day)
+	assert.Equal(t, 0, series[1].ActionCount)
+	assert.Equal(t, 5, series[3].ActionCount)
+	assert.Nil(t, dailySeries(nil))
+}
+
+func TestHistorySummaryLoadsStatsForFilter(t *testing.T) {
+	var query url.Values
+	_, client := contextTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/audit/stats", r.URL.Path)
+		query = r.URL.Query()
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+			"total": 7,
+			"daily": []map[string]any{
+				{"day": "2024-01-01", "action_count": 2},
+				{"day": "2024-01-03", "action_count": 5},
+			},
+			"top_actors": []map[string]any{
+				{"changed_by_type": "agent", "changed_by_id": "agent-1", "actor_name": "deploy-bot", "action_count": 6},
+			},
+			"top_tables":  []map[string]any{{"table_name": "entities", "action_count": 7}},
+			"top_records": []map[string]any{{"table_name": "entities", "record_id": "ent-12345678", "action_count": 4}},
+		}}))
+	})
+	model := NewHistoryModel(client)
+	model.width = 100
+	model.filterBuf.Value = "table:entities since:2024-01-01"
+	model.filter = parseAuditFilter(model.filterBuf.Value)
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("S")})
+	require.NotNil(t, cmd)
+	assert.Equal(t, historyViewSummary, model.view)
+	model, _ = model.Update(cmd())
+
+	assert.Equal(t, "entities", query.Get("table"))
+	assert.NotEmpty(t, query.Get("since"))
+	view := components.SanitizeText(model.View())
+	assert.Contains(t, view, "7 actions · since:2024-01-01")
+	assert.Contains(t, view, "2024-01-01 to 2024-01-03 · peak 5 on 2024-01-03")
+	assert.Contains(t, view, "deploy-bot")
+	assert.Contains(t, view, "Most-modified records")
+	assert.Contains(t, view, "entities:ent-1234")
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, historyViewList, model.view)
+}
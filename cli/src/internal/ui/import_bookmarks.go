@@ -0,0 +1,180 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/bookmarks"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// bookmarkImportResource is the import choice for browser bookmark exports.
+// Bookmarks are written as knowledge articles.
+const bookmarkImportResource = "bookmarks"
+
+// bookmarksParsedMsg carries the links read from a bookmark export.
+type bookmarksParsedMsg struct {
+	set bookmarks.Set
+}
+
+// importResource is the import endpoint the chosen resource writes to.
+func (m ImportExportModel) importResource() string {
+	resource := m.resources[m.resourceIndex].value
+	if resource == bookmarkImportResource {
+		return "context"
+	}
+	return resource
+}
+
+// isBookmarkImport reports whether the wizard is importing bookmarks.
+func (m ImportExportModel) isBookmarkImport() bool {
+	return m.mode == importMode && len(m.resources) > 0 && m.resources[m.resourceIndex].value == bookmarkImportResource
+}
+
+// startBookmarkPreview reads the chosen export so its links can be reviewed
+// before anything is validated or written.
+func (m ImportExportModel) startBookmarkPreview() (ImportExportModel, tea.Cmd) {
+	m.step = stepRunning
+	m.validating = true
+	m.bookmarkSet = nil
+	format := m.formats[m.formatIndex]
+	path := strings.TrimSpace(m.path.Value)
+	return m, func() tea.Msg {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return importExportErrorMsg{err: err}
+		}
+		parsed, err := bookmarks.Parse(format, data)
+		if err != nil {
+			return importExportErrorMsg{err: fmt.Errorf("read %s: %w", path, err)}
+		}
+		return bookmarksParsedMsg{set: bookmarks.Prepare(parsed)}
+	}
+}
+
+// applyBookmarksParsed shows the preview of a read export.
+func (m ImportExportModel) applyBookmarksParsed(msg bookmarksParsedMsg) ImportExportModel {
+	if m.step != stepRunning || !m.validating {
+		return m
+	}
+	set := msg.set
+	m.validating = false
+	m.bookmarkSet = &set
+	m.previewIndex = 0
+	m.step = stepPreview
+	return m
+}
+
+// handlePreviewKeys moves through the previewed links and sends them to the
+// dry run with enter. Links already in Nebula show up there as skipped.
+func (m ImportExportModel) handlePreviewKeys(msg tea.KeyMsg) (ImportExportModel, tea.Cmd) {
+	set := m.bookmarkSet
+	switch {
+	case isDown(msg):
+		if set != nil && m.previewIndex < len(set.Bookmarks)-1 {
+			m.previewIndex++
+		}
+	case isUp(msg):
+		if m.previewIndex > 0 {
+			m.previewIndex--
+		}
+	case isEnter(msg):
+		if set == nil || len(set.Bookmarks) == 0 {
+			return m, nil
+		}
+		m.payload = api.BulkImportRequest{Format: "json", Items: set.Items(nil)}
+		m.step = stepRunning
+		m.validating = true
+		return m, m.validatePayload(m.payload)
+	case isBack(msg):
+		m.bookmarkSet = nil
+		m.step = stepPath
+	}
+	return m, nil
+}
+
+// renderBookmarkPreview renders the counts of a read export and its links
+// with the tags their folders map to.
+func (m ImportExportModel) renderBookmarkPreview() string {
+	set := m.bookmarkSet
+	if set == nil {
+		return MutedStyle.Render("No bookmarks.")
+	}
+	folders := map[string]bool{}
+	for _, b := range set.Bookmarks {
+		for _, folder := range b.Folders {
+			folders[folder] = true
+		}
+	}
+	lines := []string{
+		AccentStyle.Render(fmt.Sprintf("%d links · %d folders", len(set.Bookmarks), len(folders))),
+		MutedStyle.Render(fmt.Sprintf("%d duplicate URLs merged · %d skipped (not http or https)", set.Duplicates, set.Unsupported)),
+	}
+	if len(set.Bookmarks) == 0 {
+		lines = append(lines, "", WarningStyle.Render("No links to import."), "", MutedStyle.Render("esc: back"))
+		return strings.Join(lines, "\n")
+	}
+
+	contentWidth := components.BoxContentWidth(m.width) - 2
+	if contentWidth < 40 {
+		contentWidth = 40
+	}
+	titleWidth := max(12, contentWidth*2/5)
+	tagsWidth := max(10, contentWidth/4)
+	columns := []components.TableColumn{
+		{Header: "Title", Width: titleWidth, Align: lipgloss.Left},
+		{Header: "Tags", Width: tagsWidth, Align: lipgloss.Left},
+		{Header: "URL", Width: max(contentWidth-titleWidth-tagsWidth, 10), Align: lipgloss.Left},
+	}
+	start := min(max(0, m.previewIndex-importReportRows/2), max(0, len(set.Bookmarks)-importReportRows))
+	end := min(len(set.Bookmarks), start+importReportRows)
+	rows := make([][]string, 0, end-start)
+	for _, b := range set.Bookmarks[start:end] {
+		rows = append(rows, []string{
+			components.SanitizeOneLine(b.Title),
+			components.SanitizeOneLine(strings.Join(b.Tags(), ", ")),
+			components.SanitizeOneLine(b.URL),
+		})
+	}
+	lines = append(lines, "", components.TableGridWithActiveRow(columns, rows, contentWidth, m.previewIndex-start))
+	if len(set.Bookmarks) > importReportRows {
+		lines = append(lines, MutedStyle.Render(fmt.Sprintf("Links %d-%d of %d", start+1, end, len(set.Bookmarks))))
+	}
+	if m.previewIndex < len(set.Bookmarks) {
+		if folder := strings.Join(set.Bookmarks[m.previewIndex].Folders, " / "); folder != "" {
+			lines = append(lines, "", MutedStyle.Render("folder: ")+components.SanitizeOneLine(folder))
+		}
+	}
+	lines = append(lines, "", MutedStyle.Render("Links already saved in Nebula are flagged by the dry run."),
+		MutedStyle.Render("enter: validate | ↑/↓: links | esc: back"))
+	return strings.Join(lines, "\n")
+}
+
+// bookmarkImportSteps splits an import into batches so the operation queue
+// shows its progress.
+func bookmarkImportSteps(client *api.Client, payload api.BulkImportRequest) []operationStep {
+	var steps []operationStep
+	for start := 0; start < len(payload.Items); start += operationBatchSize {
+		end := min(start+operationBatchSize, len(payload.Items))
+		batch := api.BulkImportRequest{Format: payload.Format, Items: payload.Items[start:end], Defaults: payload.Defaults}
+		label := fmt.Sprintf("import bookmarks %d-%d", start+1, end)
+		steps = append(steps, operationStep{
+			label: label,
+			run: func() ([]string, error) {
+				switch msg := importPayload(client, "context", batch).(type) {
+				case importExportErrorMsg:
+					return nil, msg.err
+				case importExportDoneMsg:
+					return append([]string{label + ": " + msg.summary}, msg.details...), nil
+				}
+				return nil, nil
+			},
+		})
+	}
+	return steps
+}
@@ -0,0 +1,105 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestImportExportBookmarkFlowPreviewsValidatesAndBatches(t *testing.T) {
+	var imported [][]any
+	_, client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		items, _ := body["items"].([]any)
+		switch r.URL.Path {
+		case "/api/import/context/validate":
+			rows := make([]map[string]any, 0, len(items))
+			for i, item := range items {
+				action := "create"
+				if i == 0 {
+					action = "skip"
+				}
+				rows = append(rows, map[string]any{"row": i + 1, "action": action, "label": "link", "item": item})
+			}
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+				"resource": "context", "total": len(rows), "counts": map[string]int{"create": len(rows) - 1, "skip": 1}, "rows": rows,
+			}}))
+		case "/api/import/context":
+			imported = append(imported, items)
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"created": len(items)}}))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	var doc strings.Builder
+	doc.WriteString("<DL><p>\n<DT><H3>Dev Tools</H3>\n<DL><p>\n")
+	for i := 0; i < operationBatchSize+2; i++ {
+		fmt.Fprintf(&doc, "<DT><A HREF=\"https://example.com/%d\">Link %d</A>\n", i, i)
+	}
+	doc.WriteString("<DT><A HREF=\"https://example.com/0/\">Copy</A>\n</DL><p>\n</DL><p>\n")
+	path := filepath.Join(t.TempDir(), "bookmarks.html")
+	require.NoError(t, os.WriteFile(path, []byte(doc.String()), 0o644))
+
+	m := NewImportExportModel(client)
+	m.width = 100
+	m.Start(importMode)
+	for m.resources[m.resourceIndex].value != bookmarkImportResource {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, []string{"html", "json"}, m.formats)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m.path.Value = path
+
+	var cmd tea.Cmd
+	m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	assert.Contains(t, components.SanitizeText(m.View()), "Reading bookmarks...")
+	m, _ = m.Update(cmd())
+	require.Equal(t, stepPreview, m.step)
+	view := components.SanitizeText(m.View())
+	assert.Contains(t, view, fmt.Sprintf("%d links · 1 folders", operationBatchSize+2))
+	assert.Contains(t, view, "1 duplicate URLs merged")
+	assert.Contains(t, view, "bookmark, dev-tools")
+
+	m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	m, _ = m.Update(cmd())
+	require.Equal(t, stepReport, m.step)
+
+	// esc from the report goes back to the preview, not the path.
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, stepPreview, m.step)
+	m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m, _ = m.Update(cmd())
+
+	m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	require.NotNil(t, cmd)
+	assert.True(t, m.closed)
+	queued, ok := cmd().(operationQueuedMsg)
+	require.True(t, ok)
+	require.Len(t, queued.steps, 2)
+	for _, step := range queued.steps {
+		notes, err := step.run()
+		require.NoError(t, err)
+		assert.NotEmpty(t, notes)
+	}
+	require.Len(t, imported, 2)
+	assert.Len(t, imported[0], operationBatchSize)
+	assert.Len(t, imported[1], 1)
+	first := imported[0][0].(map[string]any)
+	assert.Equal(t, "article", first["source_type"])
+	assert.Equal(t, "bookmark:https://example.com/1", first["external_id"])
+}
@@ -10,6 +10,9 @@ import (
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/archive"
+	"github.com/gravitrone/nebula-core/cli/internal/bookmarks"
+	"github.com/gravitrone/nebula-core/cli/internal/exporter"
 	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
 )
 
@@ -28,13 +31,17 @@ const (
 	stepResource importExportStep = iota
 	stepFormat
 	stepPath
+	stepPassphrase
+	stepPreview
+	stepReport
 	stepRunning
 	stepResult
 )
 
 type importExportResource struct {
-	label string
-	value string
+	label  string
+	value  string
+	params api.QueryParams
 }
 
 type importExportDoneMsg struct {
@@ -46,6 +53,15 @@ type importExportErrorMsg struct {
 	err error
 }
 
+// exportProgressMsg reports a streamed export after it opened or wrote a
+// page.
+type exportProgressMsg struct {
+	exp      *exporter.Exporter
+	progress exporter.Progress
+	done     bool
+	err      error
+}
+
 type ImportExportModel struct {
 	client *api.Client
 
@@ -56,12 +72,28 @@ type ImportExportModel struct {
 
 	resourceIndex int
 	formatIndex   int
-	path          string
+	path          components.TextInput
+	encrypt       bool
+	passphrase    components.TextInput
+	confirmPass   components.TextInput
+	confirming    bool
 	summary       string
 	details       []string
 	errText       string
 	closed        bool
 
+	exportProgress exporter.Progress
+	exportOpened   bool
+	exportPausing  bool
+
+	validating  bool
+	payload     api.BulkImportRequest
+	report      *api.ImportValidation
+	reportIndex int
+
+	bookmarkSet  *bookmarks.Set
+	previewIndex int
+
 	width  int
 	height int
 }
@@ -80,12 +112,34 @@ func (m *ImportExportModel) Start(mode importExportMode) {
 	m.step = stepResource
 	m.resourceIndex = 0
 	m.formatIndex = 0
-	m.path = ""
+	m.path.Reset()
+	m.encrypt = false
+	m.resetPassphrase()
 	m.summary = ""
 	m.details = nil
 	m.errText = ""
 	m.closed = false
+	m.exportProgress = exporter.Progress{}
+	m.exportOpened = false
+	m.exportPausing = false
+	m.validating = false
+	m.payload = api.BulkImportRequest{}
+	m.report = nil
+	m.reportIndex = 0
+	m.bookmarkSet = nil
+	m.previewIndex = 0
 	m.resources = importExportResourcesForMode(mode)
+	m.formats = importExportFormatsForMode(mode)
+}
+
+// StartCollectionExport opens the export wizard for one collection's entities.
+func (m *ImportExportModel) StartCollectionExport(collection api.Collection) {
+	m.Start(exportMode)
+	m.resources = []importExportResource{{
+		label:  "Collection: " + components.SanitizeOneLine(collection.Name),
+		value:  "entities",
+		params: api.QueryParams{"collection_id": collection.ID},
+	}}
 }
 
 // Update updates update.
@@ -98,8 +152,15 @@ func (m ImportExportModel) Update(msg tea.Msg) (ImportExportModel, tea.Cmd) {
 		return m, nil
 	case importExportErrorMsg:
 		m.step = stepResult
+		m.validating = false
 		m.errText = msg.err.Error()
 		return m, nil
+	case importValidatedMsg:
+		return m.applyImportValidation(msg), nil
+	case bookmarksParsedMsg:
+		return m.applyBookmarksParsed(msg), nil
+	case exportProgressMsg:
+		return m.applyExportProgress(msg)
 	case tea.KeyMsg:
 		switch m.step {
 		case stepResource:
@@ -108,6 +169,16 @@ func (m ImportExportModel) Update(msg tea.Msg) (ImportExportModel, tea.Cmd) {
 			return m.handleFormatKeys(msg)
 		case stepPath:
 			return m.handlePathKeys(msg)
+		case stepPassphrase:
+			return m.handlePassphraseKeys(msg)
+		case stepPreview:
+			return m.handlePreviewKeys(msg)
+		case stepReport:
+			return m.handleReportKeys(msg)
+		case stepRunning:
+			if m.mode == exportMode && isBack(msg) {
+				m.exportPausing = true
+			}
 		case stepResult:
 			if isBack(msg) || isEnter(msg) {
 				m.closed = true
@@ -130,14 +201,39 @@ func (m ImportExportModel) View() string {
 		title := "Enter file path"
 		if m.mode == exportMode {
 			title = "Export file path"
+			if m.encrypt {
+				title += " (encrypted, tab: plaintext)"
+			} else {
+				title += " (tab: encrypt)"
+			}
 		}
 		return components.InputDialog(title, m.path)
+	case stepPassphrase:
+		title := "Archive passphrase"
+		input := m.passphrase
+		if m.confirming {
+			title = "Confirm passphrase"
+			input = m.confirmPass
+		}
+		if m.errText != "" {
+			title = m.errText
+		}
+		return components.InputDialog(title, input.Masked())
+	case stepPreview:
+		return components.Indent(components.TitledBox("Bookmark preview", m.renderBookmarkPreview(), m.width), 1)
+	case stepReport:
+		return components.Indent(components.TitledBox("Import validation", m.renderImportReport(), m.width), 1)
 	case stepRunning:
-		label := "Importing..."
 		if m.mode == exportMode {
-			label = "Exporting..."
+			return components.Indent(components.Box(m.renderExportProgress(), m.width), 1)
+		}
+		if m.validating && m.isBookmarkImport() && m.bookmarkSet == nil {
+			return components.Indent(components.Box(MutedStyle.Render("Reading bookmarks..."), m.width), 1)
 		}
-		return components.Indent(components.Box(MutedStyle.Render(label), m.width), 1)
+		if m.validating {
+			return components.Indent(components.Box(MutedStyle.Render("Validating..."), m.width), 1)
+		}
+		return components.Indent(components.Box(MutedStyle.Render("Importing..."), m.width), 1)
 	case stepResult:
 		if m.errText != "" {
 			return components.Indent(components.ErrorBox("Import/Export Failed", m.errText, m.width), 1)
@@ -212,6 +308,8 @@ func (m ImportExportModel) handleResourceKeys(msg tea.KeyMsg) (ImportExportModel
 			m.resourceIndex--
 		}
 	case isEnter(msg):
+		m.formats = importExportFormatsForResource(m.mode, m.resources[m.resourceIndex].value)
+		m.formatIndex = 0
 		m.step = stepFormat
 	case isBack(msg):
 		m.closed = true
@@ -244,48 +342,160 @@ func (m ImportExportModel) handlePathKeys(msg tea.KeyMsg) (ImportExportModel, te
 	case isBack(msg):
 		m.step = stepFormat
 	case isEnter(msg):
-		if strings.TrimSpace(m.path) == "" {
+		if strings.TrimSpace(m.path.Value) == "" {
+			return m, nil
+		}
+		if m.isBookmarkImport() {
+			return m.startBookmarkPreview()
+		}
+		if m.mode == importMode {
+			if encrypted, _ := archive.IsEncryptedFile(strings.TrimSpace(m.path.Value)); encrypted {
+				m.resetPassphrase()
+				m.step = stepPassphrase
+				return m, nil
+			}
+			return m.startImportValidation()
+		}
+		if m.encrypt {
+			m.resetPassphrase()
+			m.step = stepPassphrase
 			return m, nil
 		}
 		m.step = stepRunning
 		return m, m.run()
-	case msg.Type == tea.KeyBackspace:
-		if len(m.path) > 0 {
-			m.path = m.path[:len(m.path)-1]
+	case msg.Type == tea.KeyTab:
+		if m.mode == exportMode {
+			m.encrypt = !m.encrypt
 		}
-	case msg.Type == tea.KeyRunes:
-		m.path += msg.String()
+	default:
+		m.path.HandleKey(msg)
+	}
+	return m, nil
+}
+
+// handlePassphraseKeys reads the archive passphrase, asking twice before an
+// encrypted export so a typo cannot lock the archive.
+func (m ImportExportModel) handlePassphraseKeys(msg tea.KeyMsg) (ImportExportModel, tea.Cmd) {
+	input := &m.passphrase
+	if m.confirming {
+		input = &m.confirmPass
+	}
+	switch {
+	case isBack(msg):
+		m.resetPassphrase()
+		m.step = stepPath
+	case isEnter(msg):
+		if input.Value == "" {
+			return m, nil
+		}
+		if m.mode == importMode {
+			return m.startImportValidation()
+		}
+		if !m.confirming {
+			m.confirming = true
+			m.errText = ""
+			return m, nil
+		}
+		if m.confirmPass.Value != m.passphrase.Value {
+			m.resetPassphrase()
+			m.errText = "Passphrases differ, retry"
+			return m, nil
+		}
+		m.step = stepRunning
+		return m, m.run()
+	default:
+		input.HandleKey(msg)
 	}
 	return m, nil
 }
 
+// resetPassphrase clears any typed passphrase.
+func (m *ImportExportModel) resetPassphrase() {
+	m.passphrase.Reset()
+	m.confirmPass.Reset()
+	m.confirming = false
+	m.errText = ""
+}
+
 // run runs run.
 func (m ImportExportModel) run() tea.Cmd {
-	mode := m.mode
+	if m.mode == exportMode {
+		return m.startExport()
+	}
 	resource := m.resources[m.resourceIndex].value
 	format := m.formats[m.formatIndex]
-	path := m.path
+	path := m.path.Value
+	passphrase := m.passphrase.Value
 	client := m.client
 
 	return func() tea.Msg {
-		if mode == importMode {
-			return runImport(client, resource, format, path)
-		}
-		return runExport(client, resource, format, path)
+		return importFromFile(client, resource, format, path, passphrase)
+	}
+}
+
+// queueImport queues payload as a background import. skipped counts rows
+// left out after validation. Rows the server rejected are kept as notes on
+// the operation.
+func (m ImportExportModel) queueImport(payload api.BulkImportRequest, skipped int) tea.Cmd {
+	resource := m.importResource()
+	path := m.path.Value
+	client := m.client
+	if m.isBookmarkImport() {
+		return queueOperation("Import bookmarks from "+path, -1, bookmarkImportSteps(client, payload), nil)
 	}
+	step := operationStep{
+		label: "import " + path,
+		run: func() ([]string, error) {
+			switch msg := importPayload(client, resource, payload).(type) {
+			case importExportErrorMsg:
+				return nil, msg.err
+			case importExportDoneMsg:
+				notes := append([]string{msg.summary}, msg.details...)
+				if skipped > 0 {
+					notes = append(notes, fmt.Sprintf("Left out %d rows flagged by validation", skipped))
+				}
+				return notes, nil
+			}
+			return nil, nil
+		},
+	}
+	return queueOperation(fmt.Sprintf("Import %s from %s", resource, path), -1, []operationStep{step}, nil)
 }
 
 // runImport runs run import.
 func runImport(client *api.Client, resource, format, path string) tea.Msg {
-	data, err := os.ReadFile(path)
+	return importFromFile(client, resource, format, path, "")
+}
+
+// importFromFile imports path, opening it with passphrase when it is an
+// encrypted archive.
+func importFromFile(client *api.Client, resource, format, path, passphrase string) tea.Msg {
+	payload, err := readImportPayload(format, path, passphrase)
 	if err != nil {
 		return importExportErrorMsg{err: err}
 	}
-	payload := api.BulkImportRequest{
-		Format: format,
-		Data:   string(data),
+	return importPayload(client, resource, payload)
+}
+
+// readImportPayload reads path into an import request, opening it with
+// passphrase when it is an encrypted archive.
+func readImportPayload(format, path, passphrase string) (api.BulkImportRequest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return api.BulkImportRequest{}, err
 	}
+	if archive.IsEncrypted(data) {
+		if data, err = archive.Decrypt(data, passphrase); err != nil {
+			return api.BulkImportRequest{}, fmt.Errorf("decrypt %s: %w", path, err)
+		}
+	}
+	return api.BulkImportRequest{Format: format, Data: string(data)}, nil
+}
+
+// importPayload sends payload to the import endpoint of resource.
+func importPayload(client *api.Client, resource string, payload api.BulkImportRequest) tea.Msg {
 	var result *api.BulkImportResult
+	var err error
 	switch resource {
 	case "entities":
 		result, err = client.ImportEntities(payload)
@@ -302,6 +512,9 @@ func runImport(client *api.Client, resource, format, path string) tea.Msg {
 		return importExportErrorMsg{err: err}
 	}
 	summary := fmt.Sprintf("Created %d, Failed %d", result.Created, result.Failed)
+	if result.Updated > 0 {
+		summary = fmt.Sprintf("Created %d, Updated %d, Failed %d", result.Created, result.Updated, result.Failed)
+	}
 	details := []string{}
 	if len(result.Errors) > 0 {
 		for i, entry := range result.Errors {
@@ -319,9 +532,19 @@ func runImport(client *api.Client, resource, format, path string) tea.Msg {
 
 // runExport runs run export.
 func runExport(client *api.Client, resource, format, path string) tea.Msg {
+	return exportToFile(client, resource, format, path, "", nil)
+}
+
+// exportToFile exports resource to path, sealing it into an encrypted
+// archive when passphrase is set. filter narrows the export, such as to a
+// collection.
+func exportToFile(client *api.Client, resource, format, path, passphrase string, filter api.QueryParams) tea.Msg {
 	params := api.QueryParams{
 		"format": format,
 	}
+	for k, v := range filter {
+		params[k] = v
+	}
 	var result *api.ExportResult
 	var err error
 	switch resource {
@@ -349,13 +572,42 @@ func runExport(client *api.Client, resource, format, path string) tea.Msg {
 		}
 		content = string(payload)
 	}
-	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+	data := []byte(content)
+	perm := os.FileMode(0o644)
+	if passphrase != "" {
+		if data, err = archive.Encrypt(data, passphrase); err != nil {
+			return importExportErrorMsg{err: err}
+		}
+		perm = 0o600
+	}
+	if err := os.WriteFile(path, data, perm); err != nil {
 		return importExportErrorMsg{err: err}
 	}
 	summary := fmt.Sprintf("Exported %d %s to %s", result.Count, resource, path)
+	if passphrase != "" {
+		summary += " (encrypted)"
+	}
 	return importExportDoneMsg{summary: summary}
 }
 
+// importExportFormatsForMode lists the file formats a mode accepts. Only
+// imports read Markdown.
+func importExportFormatsForMode(mode importExportMode) []string {
+	if mode == importMode {
+		return []string{"json", "csv", "markdown"}
+	}
+	return []string{"json", "csv"}
+}
+
+// importExportFormatsForResource lists the file formats the chosen resource
+// accepts. Bookmarks come as the browsers export them.
+func importExportFormatsForResource(mode importExportMode, resource string) []string {
+	if mode == importMode && resource == bookmarkImportResource {
+		return []string{"html", "json"}
+	}
+	return importExportFormatsForMode(mode)
+}
+
 // importExportResourcesForMode handles import export resources for mode.
 func importExportResourcesForMode(mode importExportMode) []importExportResource {
 	if mode == importMode {
@@ -364,6 +616,7 @@ func importExportResourcesForMode(mode importExportMode) []importExportResource
 			{label: "Context", value: "context"},
 			{label: "Relationships", value: "relationships"},
 			{label: "Jobs", value: "jobs"},
+			{label: "Bookmarks (Chrome/Firefox HTML or JSON)", value: bookmarkImportResource},
 		}
 	}
 	return []importExportResource{
@@ -0,0 +1,108 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/archive"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestImportExportEncryptedExportAndImport(t *testing.T) {
+	var gotBody map[string]any
+	_, client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/export/entities":
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{"format": "json", "items": []map[string]any{{"id": "ent-1"}}, "count": 1},
+			}))
+		case "/api/import/entities/validate":
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{
+					"total":  1,
+					"counts": map[string]int{"create": 1},
+					"rows":   []map[string]any{{"row": 1, "action": "create", "label": "ent-1"}},
+				},
+			}))
+		case "/api/import/entities":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{"created": 1, "failed": 0},
+			}))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	outPath := filepath.Join(t.TempDir(), "entities.nebx")
+
+	m := NewImportExportModel(client)
+	m.width = 80
+	m.Start(exportMode)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = typeText(m, outPath)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	assert.Contains(t, components.SanitizeText(m.View()), "encrypted")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.Equal(t, stepPassphrase, m.step)
+
+	m = typeText(m, "pw")
+	assert.NotContains(t, components.SanitizeText(m.View()), "pw")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = typeText(m, "typo")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, stepPassphrase, m.step)
+	assert.Contains(t, components.SanitizeText(m.View()), "Passphrases differ")
+
+	m = typeText(m, "pw")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = typeText(m, "pw")
+	var cmd tea.Cmd
+	m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	m = runImportExportCmds(m, cmd)
+	assert.Contains(t, components.SanitizeText(m.View()), "(encrypted)")
+
+	data, err := os.ReadFile(outPath)
+	require.NoError(t, err)
+	assert.True(t, archive.IsEncrypted(data))
+
+	m.Start(importMode)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = typeText(m, outPath)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.Equal(t, stepPassphrase, m.step)
+	m = typeText(m, "pw")
+	m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	m, _ = m.Update(cmd())
+	require.Equal(t, stepReport, m.step)
+	m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	require.NotNil(t, cmd)
+	assert.True(t, m.closed)
+
+	queued, ok := cmd().(operationQueuedMsg)
+	require.True(t, ok)
+	_, err = queued.steps[0].run()
+	require.NoError(t, err)
+	assert.Contains(t, gotBody["data"], `"id": "ent-1"`)
+}
+
+func TestImportFromFileRejectsWrongPassphrase(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "entities.nebx")
+	sealed, err := archive.Encrypt([]byte("[]"), "right")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, sealed, 0o600))
+
+	msg, ok := importFromFile(nil, "entities", "json", path, "wrong").(importExportErrorMsg)
+	require.True(t, ok)
+	assert.ErrorIs(t, msg.err, archive.ErrPassphrase)
+}
@@ -55,10 +55,10 @@ func TestImportExportHandlePathKeysMatrix(t *testing.T) {
 
 	updated, _ = updated.handlePathKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
 	updated, _ = updated.handlePathKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
-	assert.Equal(t, "ab", updated.path)
+	assert.Equal(t, "ab", updated.path.Value)
 
 	updated, _ = updated.handlePathKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "a", updated.path)
+	assert.Equal(t, "a", updated.path.Value)
 
 	updated, _ = updated.handlePathKeys(tea.KeyMsg{Type: tea.KeyEsc})
 	assert.Equal(t, stepFormat, updated.step)
@@ -19,6 +19,19 @@ func TestImportExportImportFlowReadsFileCallsAPIAndShowsResult(t *testing.T) {
 	var gotBody map[string]any
 	_, client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
 		gotPath = r.URL.Path
+		if r.URL.Path == "/api/import/entities/validate" {
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{
+					"resource": "entities",
+					"total":    1,
+					"counts":   map[string]int{"create": 1, "skip": 0, "invalid": 0},
+					"rows": []map[string]any{
+						{"row": 1, "action": "create", "label": "Ada", "item": map[string]any{"name": "Ada"}},
+					},
+				},
+			}))
+			return
+		}
 		if r.URL.Path == "/api/import/entities" {
 			require.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
 			err := json.NewEncoder(w).Encode(map[string]any{
@@ -37,7 +50,7 @@ func TestImportExportImportFlowReadsFileCallsAPIAndShowsResult(t *testing.T) {
 
 	tmp := t.TempDir()
 	inPath := filepath.Join(tmp, "entities.json")
-	require.NoError(t, os.WriteFile(inPath, []byte(`[]`), 0o644))
+	require.NoError(t, os.WriteFile(inPath, []byte(`[{"name":"Ada"}]`), 0o644))
 
 	m := NewImportExportModel(client)
 	m.width = 80
@@ -54,25 +67,29 @@ func TestImportExportImportFlowReadsFileCallsAPIAndShowsResult(t *testing.T) {
 	for _, r := range inPath {
 		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
 	}
+	// Imports are validated first, then close the wizard and run on the
+	// operation queue.
 	var cmd tea.Cmd
 	m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
-	assert.Equal(t, stepRunning, m.step)
+	assert.Contains(t, components.SanitizeText(m.View()), "Validating...")
+	m, _ = m.Update(cmd())
+	require.Equal(t, stepReport, m.step)
+	assert.Equal(t, "/api/import/entities/validate", gotPath)
+	m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	require.NotNil(t, cmd)
+	assert.True(t, m.closed)
 
-	msg := cmd()
-	m, _ = m.Update(msg)
-	assert.Equal(t, stepResult, m.step)
+	queued, ok := cmd().(operationQueuedMsg)
+	require.True(t, ok)
+	require.Len(t, queued.steps, 1)
+	notes, err := queued.steps[0].run()
+	require.NoError(t, err)
 
 	assert.Equal(t, "/api/import/entities", gotPath)
 	assert.Equal(t, "json", gotBody["format"])
-	assert.Equal(t, "[]", gotBody["data"])
-
-	out := m.View()
-	clean := components.SanitizeText(out)
-	assert.Contains(t, clean, "Created 1, Failed 0")
-
-	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
-	assert.True(t, m.closed)
+	assert.Equal(t, `[{"name":"Ada"}]`, gotBody["data"])
+	assert.Contains(t, notes, "Created 1, Failed 0")
 }
 
 // TestImportExportEmptyPathDoesNotRun handles test import export empty path does not run.
@@ -125,8 +142,7 @@ func TestImportExportExportJSONWritesFile(t *testing.T) {
 	var cmd tea.Cmd
 	m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
-	msg := cmd()
-	m, _ = m.Update(msg)
+	m = runImportExportCmds(m, cmd)
 	assert.Equal(t, stepResult, m.step)
 
 	data, err := os.ReadFile(outPath)
@@ -174,8 +190,7 @@ func TestImportExportExportCSVWritesFile(t *testing.T) {
 	var cmd tea.Cmd
 	m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
-	msg := cmd()
-	m, _ = m.Update(msg)
+	m = runImportExportCmds(m, cmd)
 	assert.Equal(t, stepResult, m.step)
 
 	data, err := os.ReadFile(outPath)
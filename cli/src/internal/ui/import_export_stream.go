@@ -0,0 +1,118 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/exporter"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// startExport opens a streamed export of the chosen resource, resuming an
+// interrupted one at the same path.
+func (m ImportExportModel) startExport() tea.Cmd {
+	client := m.client
+	spec := exporter.Spec{
+		Resource: m.resources[m.resourceIndex].value,
+		Format:   m.formats[m.formatIndex],
+		Path:     m.path.Value,
+		Filter:   m.resources[m.resourceIndex].params,
+	}
+	return func() tea.Msg {
+		exp, err := exporter.Open(client, spec)
+		if err != nil {
+			return importExportErrorMsg{err: err}
+		}
+		return exportProgressMsg{exp: exp, progress: exp.Progress()}
+	}
+}
+
+// exportNextPage writes one page, moving the file into place after the last.
+func exportNextPage(exp *exporter.Exporter, passphrase string) tea.Cmd {
+	return func() tea.Msg {
+		done, err := exp.Next()
+		if err == nil && done {
+			err = exp.Finish(passphrase)
+		}
+		return exportProgressMsg{exp: exp, progress: exp.Progress(), done: done && err == nil, err: err}
+	}
+}
+
+// applyExportProgress records a page and asks for the next one, unless the
+// export finished, failed, or was paused with esc.
+func (m ImportExportModel) applyExportProgress(msg exportProgressMsg) (ImportExportModel, tea.Cmd) {
+	if m.step != stepRunning {
+		_ = msg.exp.Close()
+		return m, nil
+	}
+	m.exportOpened = true
+	m.exportProgress = msg.progress
+	records := msg.progress.Records
+	switch {
+	case msg.err != nil:
+		_ = msg.exp.Close()
+		m.step = stepResult
+		m.errText = msg.err.Error()
+		if records > 0 {
+			m.errText += fmt.Sprintf(". %d records are saved; export to the same path again to resume.", records)
+		}
+	case msg.done:
+		m.step = stepResult
+		m.summary = fmt.Sprintf("Exported %d %s to %s", records, m.resources[m.resourceIndex].value, m.path.Value)
+		if m.passphrase.Value != "" {
+			m.summary += " (encrypted)"
+		}
+		m.details = nil
+		if msg.progress.Resumed > 0 {
+			m.details = append(m.details, fmt.Sprintf("Resumed after %d records.", msg.progress.Resumed))
+		}
+	case m.exportPausing:
+		_ = msg.exp.Close()
+		m.step = stepResult
+		m.summary = fmt.Sprintf("Export paused after %d records.", records)
+		m.details = []string{"Export to the same path again to resume from the last completed page."}
+	default:
+		return m, exportNextPage(msg.exp, m.passphrase.Value)
+	}
+	return m, nil
+}
+
+// renderExportProgress renders the progress bar, throughput, and ETA of a
+// running export.
+func (m ImportExportModel) renderExportProgress() string {
+	lines := []string{MutedStyle.Render("Exporting...")}
+	if !m.exportOpened {
+		return lines[0]
+	}
+	p := m.exportProgress
+	barWidth := min(40, max(10, components.BoxContentWidth(m.width)-30))
+	if p.Total > 0 {
+		percent := int(p.Fraction() * 100)
+		lines = append(lines, fmt.Sprintf("%s %d/%d records (%d%%)",
+			operationProgressBar(min(p.Records, p.Total), p.Total, barWidth), p.Records, p.Total, percent))
+	} else {
+		lines = append(lines, fmt.Sprintf("%s %d records", operationProgressBar(0, 0, barWidth), p.Records))
+	}
+	eta := "ETA unknown"
+	if p.ETA >= 0 {
+		eta = "ETA " + formatExportDuration(p.ETA)
+	}
+	lines = append(lines, MutedStyle.Render(fmt.Sprintf("%.0f records/sec · %s · elapsed %s", p.Rate, eta, formatExportDuration(p.Elapsed))))
+	if p.Resumed > 0 {
+		lines = append(lines, MutedStyle.Render(fmt.Sprintf("Resumed after %d records.", p.Resumed)))
+	}
+	if m.exportPausing {
+		lines = append(lines, WarningStyle.Render("Pausing after this page..."))
+	} else {
+		lines = append(lines, MutedStyle.Render("esc: pause (export to the same path again to resume)"))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatExportDuration rounds d to whole seconds for display.
+func formatExportDuration(d time.Duration) string {
+	return d.Round(time.Second).String()
+}
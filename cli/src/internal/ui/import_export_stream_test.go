@@ -0,0 +1,88 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/exporter"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// runImportExportCmds feeds command results back into m until none is left.
+func runImportExportCmds(m ImportExportModel, cmd tea.Cmd) ImportExportModel {
+	for cmd != nil {
+		m, cmd = m.Update(cmd())
+	}
+	return m
+}
+
+func TestImportExportStreamsPagesPausesAndResumes(t *testing.T) {
+	const total = 1200
+	_, client := contextTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/export/entities", r.URL.Path)
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		items := []map[string]any{}
+		for i := offset; i < total && i < offset+limit; i++ {
+			items = append(items, map[string]any{"id": fmt.Sprintf("ent-%d", i)})
+		}
+		data := map[string]any{"format": "json", "items": items, "count": len(items)}
+		if offset == 0 {
+			data["total"] = total
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": data}))
+	})
+	path := filepath.Join(t.TempDir(), "entities.json")
+
+	start := func() (ImportExportModel, tea.Cmd) {
+		m := NewImportExportModel(client)
+		m.width = 100
+		m.Start(exportMode)
+		m.path.Value = path
+		m.step = stepRunning
+		return m.Update(m.run()())
+	}
+
+	m, cmd := start()
+	require.NotNil(t, cmd)
+	m, cmd = m.Update(cmd())
+	require.NotNil(t, cmd)
+	view := components.SanitizeText(m.View())
+	assert.Contains(t, view, "500/1200 records (41%)")
+	assert.Contains(t, view, "records/sec")
+	assert.Contains(t, view, "esc: pause")
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, stepRunning, m.step)
+	assert.Contains(t, components.SanitizeText(m.View()), "Pausing after this page")
+	m = runImportExportCmds(m, cmd)
+	assert.Equal(t, stepResult, m.step)
+	assert.Contains(t, m.summary, "Export paused after 1000 records.")
+	assert.NoFileExists(t, path)
+	assert.FileExists(t, exporter.PartialPath(path))
+
+	m, cmd = start()
+	assert.Equal(t, 1000, m.exportProgress.Resumed)
+	assert.Contains(t, components.SanitizeText(m.View()), "Resumed after 1000 records.")
+	m = runImportExportCmds(m, cmd)
+	assert.Empty(t, m.errText)
+	assert.Contains(t, m.summary, "Exported 1200 entities")
+	assert.Contains(t, m.details, "Resumed after 1000 records.")
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var items []map[string]any
+	require.NoError(t, json.Unmarshal(raw, &items))
+	require.Len(t, items, total)
+	assert.Equal(t, "ent-1199", items[total-1]["id"])
+	assert.NoFileExists(t, exporter.StatePath(path))
+}
@@ -0,0 +1,239 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// importReportRows caps how many report rows are listed at once.
+const importReportRows = 10
+
+// importValidatedMsg carries the dry-run report of the file about to be
+// imported, along with the request it was built from.
+type importValidatedMsg struct {
+	payload api.BulkImportRequest
+	report  *api.ImportValidation
+}
+
+// startImportValidation reads the chosen file and dry-runs it, so nothing is
+// written before the report has been reviewed.
+func (m ImportExportModel) startImportValidation() (ImportExportModel, tea.Cmd) {
+	m.step = stepRunning
+	m.validating = true
+	return m, m.validateImport()
+}
+
+// validateImport asks the server what importing the chosen file would do.
+func (m ImportExportModel) validateImport() tea.Cmd {
+	resource := m.importResource()
+	format := m.formats[m.formatIndex]
+	path := m.path.Value
+	passphrase := m.passphrase.Value
+	client := m.client
+	return func() tea.Msg {
+		payload, err := readImportPayload(format, path, passphrase)
+		if err != nil {
+			return importExportErrorMsg{err: err}
+		}
+		report, err := client.ValidateImport(resource, payload)
+		if err != nil {
+			return importExportErrorMsg{err: fmt.Errorf("validate %s: %w", path, err)}
+		}
+		return importValidatedMsg{payload: payload, report: report}
+	}
+}
+
+// validatePayload dry-runs a request built in the wizard rather than read
+// from the file as is.
+func (m ImportExportModel) validatePayload(payload api.BulkImportRequest) tea.Cmd {
+	resource := m.importResource()
+	path := m.path.Value
+	client := m.client
+	return func() tea.Msg {
+		report, err := client.ValidateImport(resource, payload)
+		if err != nil {
+			return importExportErrorMsg{err: fmt.Errorf("validate %s: %w", path, err)}
+		}
+		return importValidatedMsg{payload: payload, report: report}
+	}
+}
+
+// applyImportValidation shows the report of a finished dry run.
+func (m ImportExportModel) applyImportValidation(msg importValidatedMsg) ImportExportModel {
+	if m.step != stepRunning || !m.validating {
+		return m
+	}
+	m.validating = false
+	m.step = stepReport
+	m.payload = msg.payload
+	m.report = msg.report
+	m.reportIndex = 0
+	return m
+}
+
+// handleReportKeys moves through the report rows and applies the clean rows
+// with a. Imports can be slow, so they run on the operation queue and the
+// wizard closes right away.
+func (m ImportExportModel) handleReportKeys(msg tea.KeyMsg) (ImportExportModel, tea.Cmd) {
+	switch {
+	case isDown(msg):
+		if m.report != nil && m.reportIndex < len(m.report.Rows)-1 {
+			m.reportIndex++
+		}
+	case isUp(msg):
+		if m.reportIndex > 0 {
+			m.reportIndex--
+		}
+	case isKey(msg, "a"):
+		payload, clean := cleanImportPayload(m.payload, m.report)
+		if clean == 0 {
+			return m, nil
+		}
+		m.closed = true
+		return m, m.queueImport(payload, len(m.report.Rows)-clean)
+	case isBack(msg):
+		m.report = nil
+		m.resetPassphrase()
+		m.step = stepPath
+		if m.bookmarkSet != nil {
+			m.step = stepPreview
+		}
+	}
+	return m, nil
+}
+
+// cleanImportPayload returns the request that imports only the rows the
+// report marked create or update, and how many there are. A file with no
+// flagged rows is sent unchanged.
+func cleanImportPayload(payload api.BulkImportRequest, report *api.ImportValidation) (api.BulkImportRequest, int) {
+	if report == nil {
+		return payload, 0
+	}
+	items := make([]map[string]any, 0, len(report.Rows))
+	for _, row := range report.Rows {
+		if row.Action == "create" || row.Action == "update" {
+			items = append(items, row.Item)
+		}
+	}
+	if len(items) == len(report.Rows) {
+		return payload, len(items)
+	}
+	return api.BulkImportRequest{Format: "json", Items: items, Defaults: payload.Defaults}, len(items)
+}
+
+// renderImportReport renders the report summary, the row list, and the
+// issues of the selected row.
+func (m ImportExportModel) renderImportReport() string {
+	report := m.report
+	if report == nil {
+		return MutedStyle.Render("No report.")
+	}
+	clean := report.Counts["create"] + report.Counts["update"]
+	lines := []string{
+		AccentStyle.Render(fmt.Sprintf("Import validation · %d rows", report.Total)),
+		MutedStyle.Render(fmt.Sprintf("%d to create · %d to update · %d to skip · %d invalid · %d warnings",
+			report.Counts["create"], report.Counts["update"], report.Counts["skip"], report.Counts["invalid"], report.Warnings)),
+	}
+	if len(report.Rows) == 0 {
+		lines = append(lines, "", MutedStyle.Render("The file has no rows."), "", MutedStyle.Render("esc: back"))
+		return strings.Join(lines, "\n")
+	}
+
+	contentWidth := components.BoxContentWidth(m.width) - 2
+	if contentWidth < 40 {
+		contentWidth = 40
+	}
+	rowWidth, actionWidth := 5, 9
+	labelWidth := max(12, contentWidth/3)
+	columns := []components.TableColumn{
+		{Header: "Row", Width: rowWidth, Align: lipgloss.Right},
+		{Header: "Action", Width: actionWidth, Align: lipgloss.Left, CellStyle: importActionStyle},
+		{Header: "Label", Width: labelWidth, Align: lipgloss.Left},
+		{Header: "Issue", Width: max(contentWidth-rowWidth-actionWidth-labelWidth, 10), Align: lipgloss.Left},
+	}
+	start := min(max(0, m.reportIndex-importReportRows/2), max(0, len(report.Rows)-importReportRows))
+	end := min(len(report.Rows), start+importReportRows)
+	rows := make([][]string, 0, end-start)
+	for _, row := range report.Rows[start:end] {
+		rows = append(rows, []string{
+			fmt.Sprintf("%d", row.Row),
+			row.Action,
+			components.SanitizeOneLine(row.Label),
+			components.SanitizeOneLine(importRowIssue(row)),
+		})
+	}
+	lines = append(lines, "", components.TableGridWithActiveRow(columns, rows, contentWidth, m.reportIndex-start))
+	if len(report.Rows) > importReportRows {
+		lines = append(lines, MutedStyle.Render(fmt.Sprintf("Rows %d-%d of %d", start+1, end, len(report.Rows))))
+	}
+
+	if m.reportIndex < len(report.Rows) {
+		row := report.Rows[m.reportIndex]
+		lines = append(lines, "")
+		switch {
+		case row.Action == "update":
+			lines = append(lines, AccentStyle.Render("updates: ")+components.SanitizeOneLine(shortID(row.RecordID))+
+				MutedStyle.Render(" (external ID "+components.SanitizeOneLine(row.ExternalID)+")"))
+		case row.ExternalID != "":
+			lines = append(lines, MutedStyle.Render("external ID: ")+components.SanitizeOneLine(row.ExternalID)+MutedStyle.Render(" (new)"))
+		}
+		for _, e := range row.Errors {
+			lines = append(lines, ErrorStyle.Render("error: ")+components.SanitizeOneLine(e))
+		}
+		for _, w := range row.Warnings {
+			lines = append(lines, WarningStyle.Render("warning: ")+components.SanitizeOneLine(w))
+		}
+		for _, dup := range row.Duplicates {
+			lines = append(lines, MutedStyle.Render("matches: ")+components.SanitizeOneLine(dup.Label)+MutedStyle.Render(" ("+shortID(dup.ID)+")"))
+		}
+		if len(row.Errors)+len(row.Warnings)+len(row.Duplicates) == 0 && row.Action == "create" {
+			lines = append(lines, SuccessStyle.Render("No issues."))
+		}
+	}
+
+	hint := "↑/↓: rows | esc: back"
+	switch {
+	case clean == 0:
+		lines = append(lines, "", WarningStyle.Render("No clean rows to import."))
+	case clean == len(report.Rows):
+		hint = "a: import all | " + hint
+	default:
+		hint = fmt.Sprintf("a: import %d clean rows | ", clean) + hint
+	}
+	lines = append(lines, "", MutedStyle.Render(hint))
+	return strings.Join(lines, "\n")
+}
+
+// importActionStyle colors an action cell of the report.
+func importActionStyle(text string) lipgloss.Style {
+	switch strings.TrimSpace(text) {
+	case "create":
+		return SuccessStyle
+	case "update":
+		return AccentStyle
+	case "invalid":
+		return ErrorStyle
+	}
+	return WarningStyle
+}
+
+// importRowIssue is the first problem of a row, errors first.
+func importRowIssue(row api.ImportValidationRow) string {
+	switch {
+	case len(row.Errors) > 0:
+		return row.Errors[0]
+	case len(row.Warnings) > 0:
+		return row.Warnings[0]
+	case len(row.Duplicates) > 0:
+		return "matches " + row.Duplicates[0].Label
+	case row.Action == "update":
+		return "external ID " + row.ExternalID
+	}
+	return "-"
+}
@@ -0,0 +1,144 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestImportValidationReportAppliesCleanRowsOnly(t *testing.T) {
+	var validated, imported map[string]any
+	_, client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/import/context/validate":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&validated))
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{
+					"resource": "context",
+					"total":    3,
+					"counts":   map[string]int{"create": 1, "skip": 1, "invalid": 1},
+					"warnings": 1,
+					"rows": []map[string]any{
+						{"row": 1, "action": "create", "label": "Alpha", "item": map[string]any{"title": "Alpha"}},
+						{"row": 2, "action": "invalid", "label": "Beta", "errors": []string{"Unknown scope: secret"}},
+						{
+							"row": 3, "action": "skip", "label": "Gamma",
+							"warnings":   []string{"Already exists"},
+							"duplicates": []map[string]any{{"id": "ctx-12345678", "label": "Gamma (old)"}},
+						},
+					},
+				},
+			}))
+		case "/api/import/context":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&imported))
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{"created": 1, "failed": 0},
+			}))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	path := filepath.Join(t.TempDir(), "notes.md")
+	require.NoError(t, os.WriteFile(path, []byte("# Alpha\n# Beta\n# Gamma\n"), 0o644))
+
+	m := NewImportExportModel(client)
+	m.width = 100
+	m.Start(importMode)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = typeText(m, path)
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	m, _ = m.Update(cmd())
+	require.Equal(t, stepReport, m.step)
+	assert.Equal(t, "markdown", validated["format"])
+
+	view := components.SanitizeText(m.View())
+	assert.Contains(t, view, "1 to create · 0 to update · 1 to skip · 1 invalid · 1 warnings")
+	assert.Contains(t, view, "a: import 1 clean rows")
+	assert.Contains(t, view, "No issues.")
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	assert.Contains(t, components.SanitizeText(m.View()), "error: Unknown scope: secret")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	view = components.SanitizeText(m.View())
+	assert.Contains(t, view, "warning: Already exists")
+	assert.Contains(t, view, "matches: Gamma (old)")
+
+	m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	require.NotNil(t, cmd)
+	assert.True(t, m.closed)
+	queued, ok := cmd().(operationQueuedMsg)
+	require.True(t, ok)
+	notes, err := queued.steps[0].run()
+	require.NoError(t, err)
+	assert.Contains(t, notes, "Left out 2 rows flagged by validation")
+	assert.Equal(t, "json", imported["format"])
+	assert.Nil(t, imported["data"])
+	assert.Equal(t, []any{map[string]any{"title": "Alpha"}}, imported["items"])
+}
+
+func TestImportValidationReportBackAndNothingClean(t *testing.T) {
+	m := NewImportExportModel(nil)
+	m.width = 80
+	m.Start(importMode)
+	m.path.Value = "in.json"
+	m.step = stepRunning
+	m.validating = true
+	m, _ = m.Update(importValidatedMsg{report: &api.ImportValidation{
+		Total:  1,
+		Counts: map[string]int{"invalid": 1},
+		Rows:   []api.ImportValidationRow{{Row: 1, Action: "invalid", Errors: []string{"name is required"}}},
+	}})
+	require.Equal(t, stepReport, m.step)
+	assert.Contains(t, components.SanitizeText(m.View()), "No clean rows to import.")
+
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	assert.Nil(t, cmd)
+	assert.False(t, m.closed)
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, stepPath, m.step)
+	assert.Nil(t, m.report)
+}
+
+func TestImportValidationReportShowsExternalIDMatches(t *testing.T) {
+	report := &api.ImportValidation{
+		Total:  2,
+		Counts: map[string]int{"create": 1, "update": 1},
+		Rows: []api.ImportValidationRow{
+			{Row: 1, Action: "update", Label: "Ada", ExternalID: "crm-1", RecordID: "ent-12345678", Item: map[string]any{"external_id": "crm-1"}},
+			{Row: 2, Action: "create", Label: "Bob", ExternalID: "crm-2", Item: map[string]any{"external_id": "crm-2"}},
+		},
+	}
+	m := NewImportExportModel(nil)
+	m.width = 100
+	m.Start(importMode)
+	m.step = stepRunning
+	m.validating = true
+	m, _ = m.Update(importValidatedMsg{payload: api.BulkImportRequest{Format: "csv", Data: "raw"}, report: report})
+
+	view := components.SanitizeText(m.View())
+	assert.Contains(t, view, "1 to create · 1 to update")
+	assert.Contains(t, view, "external ID crm-1")
+	assert.Contains(t, view, "updates: ent-1234")
+	assert.Contains(t, view, "a: import all")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	assert.Contains(t, components.SanitizeText(m.View()), "external ID: crm-2 (new)")
+
+	payload, clean := cleanImportPayload(api.BulkImportRequest{Format: "csv", Data: "raw"}, report)
+	assert.Equal(t, 2, clean)
+	assert.Equal(t, "raw", payload.Data)
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -11,13 +12,18 @@ import (
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
 	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
 )
 
 // --- Messages ---
 
-type approvalsLoadedMsg struct{ items []api.Approval }
+type approvalsLoadedMsg struct {
+	items  []api.Approval
+	queued time.Time
+}
 type approvalDoneMsg struct{ id string }
+type inboxHumanTasksLoadedMsg struct{ items []api.Job }
 type approvalDiffLoadedMsg struct {
 	id      string
 	changes map[string]any
@@ -31,22 +37,40 @@ type InboxModel struct {
 	items         []api.Approval
 	list          *components.List
 	loading       bool
+	loadLatency   time.Duration
 	detail        *api.Approval
+	comparing     bool
+	liveRecord    map[string]any
+	liveErr       error
+	comments      []api.ApprovalComment
+	commentsErr   error
+	commenting    bool
+	commentBuf    components.TextInput
+	commentSaving bool
+	commentReply  int
 	filtering     bool
-	filterBuf     string
+	filterBuf     components.TextInput
 	filtered      []int
+	window        api.TimeWindow
 	selected      map[string]bool
 	confirming    bool
 	confirmBulk   bool
 	rejecting     bool
 	rejectPreview bool
-	rejectBuf     string
+	rejectBuf     components.TextInput
 	grantEditing  bool
 	grantApproval string
-	grantScopes   string
+	grantScopes   components.TextInput
 	grantTrusted  bool
 	bulkRejectIDs []string
 	pendingLimit  int
+	humanTasks    []api.Job
+	currentUserID string
+	triage        *config.InboxState
+	triagePrompt  triagePrompt
+	triageIDs     []string
+	triageBuf     components.TextInput
+	columns       tableLayout
 	width         int
 	height        int
 }
@@ -72,15 +96,23 @@ func (m InboxModel) Update(msg tea.Msg) (InboxModel, tea.Cmd) {
 	switch msg := msg.(type) {
 	case approvalsLoadedMsg:
 		m.loading = false
+		m.loadLatency = loadElapsed(msg.queued)
 		m.items = msg.items
 		m.applyFilter(true)
+		if prune := m.pruneTriage(); prune != nil {
+			return m, tea.Batch(m.loadHumanTasks(), prune)
+		}
+		return m, m.loadHumanTasks()
+
+	case inboxHumanTasksLoadedMsg:
+		m.humanTasks = openHumanTasks(msg.items)
 		return m, nil
 
 	case approvalDoneMsg:
 		m.detail = nil
 		m.rejecting = false
 		m.rejectPreview = false
-		m.rejectBuf = ""
+		m.rejectBuf.Reset()
 		m.bulkRejectIDs = nil
 		m.confirming = false
 		m.selected = make(map[string]bool)
@@ -95,6 +127,40 @@ func (m InboxModel) Update(msg tea.Msg) (InboxModel, tea.Cmd) {
 		}
 		return m, nil
 
+	case approvalDelegateResolvedMsg:
+		return m.applyDelegation(msg)
+
+	case inboxTriageSavedMsg:
+		return m, nil
+
+	case approvalCommentsLoadedMsg:
+		if m.detail != nil && m.detail.ID == msg.id {
+			m.comments = msg.items
+			m.commentsErr = msg.err
+		}
+		return m, nil
+
+	case approvalCommentAddedMsg:
+		m.commentSaving = false
+		if m.detail != nil && m.detail.ID == msg.id {
+			m.comments = append(m.comments, msg.comment)
+			m.commenting = false
+			m.commentBuf.Reset()
+			m.commentReply = -1
+		}
+		return m, nil
+
+	case errMsg:
+		m.commentSaving = false
+		return m, nil
+
+	case approvalLiveRecordLoadedMsg:
+		if m.detail != nil && m.detail.ID == msg.id {
+			m.liveRecord = msg.record
+			m.liveErr = msg.err
+		}
+		return m, nil
+
 	case tea.KeyMsg:
 		if m.confirming {
 			switch {
@@ -113,6 +179,12 @@ func (m InboxModel) Update(msg tea.Msg) (InboxModel, tea.Cmd) {
 		if m.grantEditing {
 			return m.handleGrantInput(msg)
 		}
+		if m.triagePrompt != triagePromptNone {
+			return m.handleTriageInput(msg)
+		}
+		if m.commenting && m.detail != nil {
+			return m.handleCommentInput(msg)
+		}
 		if m.filtering {
 			return m.handleFilterInput(msg)
 		}
@@ -136,8 +208,12 @@ func (m InboxModel) Update(msg tea.Msg) (InboxModel, tea.Cmd) {
 			m.toggleSelected()
 		case isEnter(msg):
 			if item, ok := m.selectedItem(); ok {
-				m.detail = &item
-				return m, m.loadApprovalDiff(item.ID)
+				m.openDetail(item)
+				return m, tea.Batch(
+					m.loadApprovalDiff(item.ID),
+					m.loadApprovalLiveRecord(item),
+					m.loadApprovalComments(item.ID),
+				)
 			}
 		case isKey(msg, "a"):
 			return m.beginApproveFlow()
@@ -151,6 +227,14 @@ func (m InboxModel) Update(msg tea.Msg) (InboxModel, tea.Cmd) {
 			return m.startReject()
 		case isKey(msg, "f"):
 			m.filtering = true
+		case isKey(msg, "T"):
+			m.filterBuf.SetValue(cycleWindowPreset(m.filterBuf.Value))
+			m.applyFilter(true)
+			return m, m.syncWindow()
+		case isKey(msg, "z"):
+			return m.startSnooze()
+		case isKey(msg, "d"):
+			return m.startDelegate()
 		case isKey(msg, "b"):
 			m.toggleSelectAll()
 		case isBack(msg):
@@ -164,8 +248,8 @@ func (m InboxModel) Update(msg tea.Msg) (InboxModel, tea.Cmd) {
 
 // View handles view.
 func (m InboxModel) View() string {
-	if m.loading {
-		return "  " + MutedStyle.Render("Loading approvals...")
+	if m.loading && len(m.items) == 0 {
+		return renderLoadingList("approvals", 0, m.list, m.width, nil)
 	}
 
 	if m.confirming {
@@ -177,11 +261,11 @@ func (m InboxModel) View() string {
 		summary := []components.TableRow{
 			{Label: "Action", Value: "Reject"},
 			{Label: "Requests", Value: fmt.Sprintf("%d", len(m.bulkRejectIDs))},
-			{Label: "Notes", Value: formatAny(strings.TrimSpace(m.rejectBuf))},
+			{Label: "Notes", Value: formatAny(strings.TrimSpace(m.rejectBuf.Value))},
 		}
 		diffs := []components.DiffRow{
 			{Label: "Status", From: "Pending", To: "Rejected"},
-			{Label: "Review Notes", From: "None", To: formatAny(strings.TrimSpace(m.rejectBuf))},
+			{Label: "Review Notes", From: "None", To: formatAny(strings.TrimSpace(m.rejectBuf.Value))},
 		}
 		return components.Indent(components.ConfirmPreviewDialog("Reject Requests", summary, diffs, m.width), 1)
 	}
@@ -194,6 +278,10 @@ func (m InboxModel) View() string {
 		return components.Indent(components.InputDialog("Reject: Enter Review Notes", m.rejectBuf), 1)
 	}
 
+	if m.triagePrompt != triagePromptNone {
+		return m.renderTriagePrompt()
+	}
+
 	if m.filtering {
 		return components.Indent(components.InputDialog("Filter Approvals", m.filterBuf), 1)
 	}
@@ -203,21 +291,30 @@ func (m InboxModel) View() string {
 	}
 
 	if len(m.items) == 0 {
-		return components.Indent(components.EmptyStateBox(
+		return components.Indent(m.withHumanTasks(components.EmptyStateBox(
 			"Inbox",
 			"No pending approvals.",
 			[]string{"Switch tabs with 1-9/0", "Open command palette with /"},
 			m.width,
-		), 1)
+		)), 1)
+	}
+
+	if len(m.filtered) == 0 && m.filterBuf.Value == "" && m.snoozedCount() > 0 {
+		return components.Indent(m.withHumanTasks(components.EmptyStateBox(
+			"Inbox",
+			fmt.Sprintf("All %d pending approvals are snoozed.", len(m.items)),
+			[]string{"Press f and filter with is:snoozed to see them"},
+			m.width,
+		)), 1)
 	}
 
 	if len(m.filtered) == 0 {
-		return components.Indent(components.EmptyStateBox(
+		return components.Indent(m.withHumanTasks(components.EmptyStateBox(
 			"Inbox",
 			"No approvals match the filter.",
 			[]string{"Press f to update filter", "Press esc to clear"},
 			m.width,
-		), 1)
+		)), 1)
 	}
 
 	contentWidth := components.BoxContentWidth(m.width)
@@ -231,32 +328,7 @@ func (m InboxModel) View() string {
 		tableWidth = contentWidth - previewWidth - gap
 	}
 
-	sepWidth := 1
-	if b := lipgloss.RoundedBorder().Left; b != "" {
-		sepWidth = lipgloss.Width(b)
-	}
-
-	// TableGrid draws separators only between columns.
-	// 4 columns -> 3 separators.
-	availableCols := tableWidth - (3 * sepWidth)
-	if availableCols < 30 {
-		availableCols = 30
-	}
-
-	actionWidth := 19
-	whoWidth := 14
-	atWidth := compactTimeColumnWidth
-
-	titleWidth := availableCols - (actionWidth + whoWidth + atWidth)
-	if titleWidth < 12 {
-		titleWidth = 12
-	}
-	cols := []components.TableColumn{
-		{Header: "Title", Width: titleWidth, Align: lipgloss.Left},
-		{Header: "Action", Width: actionWidth, Align: lipgloss.Left},
-		{Header: "Who", Width: whoWidth, Align: lipgloss.Left},
-		{Header: "At", Width: atWidth, Align: lipgloss.Left},
-	}
+	cols, defs := inboxColumnSet.columns(m.columns, tableWidth, tableSort{})
 
 	tableRows := make([][]string, 0, len(visible))
 	activeRowRel := -1
@@ -274,6 +346,9 @@ func (m InboxModel) View() string {
 		}
 
 		fullTitle := approvalTitle(item)
+		if isHighPriority(item) {
+			fullTitle = WarningStyle.Render("!") + " " + fullTitle
+		}
 		if showCheckboxes {
 			checkbox := MutedStyle.Render("[ ]")
 			if m.selected[item.ID] {
@@ -281,26 +356,32 @@ func (m InboxModel) View() string {
 			}
 			fullTitle = checkbox + " " + fullTitle
 		}
-		title := components.ClampTextWidthEllipsis(fullTitle, titleWidth)
-		action := components.ClampTextWidthEllipsis(humanizeApprovalType(item.RequestType), actionWidth)
-		who := components.ClampTextWidthEllipsis(approvalWhoLabel(item), whoWidth)
-		when := formatLocalTimeCompact(item.CreatedAt)
-
 		if m.list.IsSelected(absIdx) {
 			activeRowRel = len(tableRows)
 		}
-		tableRows = append(tableRows, []string{title, action, who, when})
+		row := make([]string, len(defs))
+		for c, d := range defs {
+			value := fullTitle
+			if d.key != "title" {
+				value = m.inboxColumnValue(item, d.key)
+			}
+			row[c] = components.ClampTextWidthEllipsis(value, d.width)
+		}
+		tableRows = append(tableRows, row)
 	}
 
 	title := "Inbox"
 	countLine := fmt.Sprintf("%d pending", len(m.items))
-	if m.filterBuf != "" {
-		countLine = fmt.Sprintf("%s · filter: %s", countLine, m.filterBuf)
+	if m.filterBuf.Value != "" {
+		countLine = fmt.Sprintf("%s · filter: %s", countLine, m.filterBuf.Value)
+	}
+	if count := m.snoozedCount(); count > 0 {
+		countLine = fmt.Sprintf("%s · snoozed: %d", countLine, count)
 	}
 	if count := m.selectedCount(); count > 0 {
 		countLine = fmt.Sprintf("%s · selected: %d", countLine, count)
 	}
-	countLine = MutedStyle.Render(countLine)
+	countLine = MutedStyle.Render(countLine) + renderLoadLatency(m.loadLatency)
 	table := components.TableGridWithActiveRow(cols, tableRows, tableWidth, activeRowRel)
 	preview := ""
 	if previewItem != nil {
@@ -317,21 +398,80 @@ func (m InboxModel) View() string {
 	}
 
 	content := countLine + "\n\n" + body + "\n"
-	return components.Indent(components.TitledBox(title, content, m.width), 1)
+	view := components.Indent(m.withHumanTasks(components.TitledBox(title, content, m.width)), 1)
+	if m.loading {
+		return renderLoadingList("approvals", len(m.items), m.list, m.width, func() string { return view })
+	}
+	return view
+}
+
+// inboxColumnValue returns the table cell text for one inbox column.
+func (m InboxModel) inboxColumnValue(a api.Approval, key string) string {
+	switch key {
+	case "action":
+		return humanizeApprovalType(a.RequestType)
+	case "who":
+		return m.triageWhoLabel(a)
+	case "status":
+		if status := strings.TrimSpace(components.SanitizeOneLine(a.Status)); status != "" {
+			return status
+		}
+	case "job":
+		if a.JobID != nil && strings.TrimSpace(*a.JobID) != "" {
+			return shortID(strings.TrimSpace(*a.JobID))
+		}
+	case "due":
+		return approvalDueLabel(a, time.Now())
+	case "priority":
+		return approvalPriorityLabel(a)
+	case "at":
+		return formatLocalTimeCompact(a.CreatedAt)
+	}
+	return "-"
+}
+
+// withHumanTasks appends the open human tasks panel below an inbox block.
+func (m InboxModel) withHumanTasks(block string) string {
+	panel := renderHumanTasksPanel(m.humanTasks, m.currentUserID, m.width, time.Now())
+	if panel == "" {
+		return block
+	}
+	return block + "\n\n" + panel
 }
 
 // --- Helpers ---
 
 func (m InboxModel) loadApprovals() tea.Msg {
+	queued := time.Now()
 	limit := m.pendingLimit
 	if limit <= 0 {
 		limit = 500
 	}
-	items, err := m.client.GetPendingApprovalsWithParams(limit, 0)
+	items, err := m.client.GetPendingApprovalsInWindow(limit, 0, m.window)
 	if err != nil {
-		return errMsg{err}
+		return loadFailed(tabInbox, err, m.loadApprovals)
+	}
+	return approvalsLoadedMsg{items: items, queued: queued}
+}
+
+// SetCurrentUser sets the entity id used to label tasks assigned to the user.
+func (m *InboxModel) SetCurrentUser(entityID string) {
+	m.currentUserID = strings.TrimSpace(entityID)
+}
+
+// loadHumanTasks loads open human tasks for the home panel.
+func (m InboxModel) loadHumanTasks() tea.Cmd {
+	if m.client == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		items, err := m.client.QueryJobs(nil)
+		if err != nil {
+			// The panel is supplementary; a failed load should not mask approvals.
+			return inboxHumanTasksLoadedMsg{}
+		}
+		return inboxHumanTasksLoadedMsg{items: items}
 	}
-	return approvalsLoadedMsg{items}
 }
 
 // SetPendingLimit sets set pending limit.
@@ -371,17 +511,43 @@ func (m InboxModel) approveSelected() (InboxModel, tea.Cmd) {
 		return m, nil
 	}
 	m.detail = nil
+	if len(ids) > 1 {
+		m.selected = make(map[string]bool)
+		return m, m.queueApprovals(ids)
+	}
 	return m, func() tea.Msg {
 		for _, id := range ids {
-			_, err := m.client.ApproveRequest(id)
+			item, err := m.client.ApproveRequest(id)
 			if err != nil {
 				return errMsg{err}
 			}
+			notifyDecision(config.WebhookApprove, id, "", item)
 		}
 		return approvalDoneMsg{""}
 	}
 }
 
+// queueApprovals approves several requests on the app operation queue, one
+// step per approval so a single failure does not stop the rest.
+func (m InboxModel) queueApprovals(ids []string) tea.Cmd {
+	client := m.client
+	steps := make([]operationStep, 0, len(ids))
+	for _, id := range ids {
+		id := id
+		steps = append(steps, operationStep{
+			label: "approve " + shortID(id),
+			run: func() ([]string, error) {
+				item, err := client.ApproveRequest(id)
+				if err == nil {
+					notifyDecision(config.WebhookApprove, id, "", item)
+				}
+				return nil, err
+			},
+		})
+	}
+	return queueOperation(fmt.Sprintf("Approve %s", approvalCountLabel(len(ids))), tabInbox, steps, approvalDoneMsg{""})
+}
+
 // beginApproveFlow handles begin approve flow.
 func (m InboxModel) beginApproveFlow() (InboxModel, tea.Cmd) {
 	ids := m.selectedIDs()
@@ -397,7 +563,7 @@ func (m InboxModel) beginApproveFlow() (InboxModel, tea.Cmd) {
 		if approval, ok := m.findApprovalByID(ids[0]); ok && approval.RequestType == "register_agent" {
 			m.grantEditing = true
 			m.grantApproval = approval.ID
-			m.grantScopes = strings.Join(requestedScopesFromApproval(approval), ",")
+			m.grantScopes.SetValue(strings.Join(requestedScopesFromApproval(approval), ","))
 			m.grantTrusted = requestedRequiresApprovalFromApproval(approval)
 			return m, nil
 		}
@@ -410,30 +576,58 @@ func (m InboxModel) beginApproveFlow() (InboxModel, tea.Cmd) {
 func (m InboxModel) handleDetailKeys(msg tea.KeyMsg) (InboxModel, tea.Cmd) {
 	switch {
 	case isBack(msg):
+		if m.comparing {
+			m.comparing = false
+			return m, nil
+		}
 		m.detail = nil
+	case isKey(msg, "c"):
+		if _, ok := approvalLiveRecordTarget(*m.detail); ok {
+			m.comparing = !m.comparing
+		}
+	case isKey(msg, "z"):
+		return m.startSnooze()
+	case isKey(msg, "d"):
+		return m.startDelegate()
+	case isKey(msg, "m"):
+		m.startComment()
 	case isKey(msg, "a"):
 		return m.beginApproveFlow()
 	case isKey(msg, "r"):
 		m.rejecting = true
-		m.rejectBuf = ""
+		m.rejectBuf.Reset()
 	}
 	return m, nil
 }
 
+// openDetail shows an approval and drops compare state from the previous one.
+func (m *InboxModel) openDetail(item api.Approval) {
+	m.detail = &item
+	m.comparing = false
+	m.liveRecord = nil
+	m.liveErr = nil
+	m.comments = nil
+	m.commentsErr = nil
+	m.commenting = false
+	m.commentBuf.Reset()
+	m.commentSaving = false
+	m.commentReply = -1
+}
+
 // handleGrantInput handles handle grant input.
 func (m InboxModel) handleGrantInput(msg tea.KeyMsg) (InboxModel, tea.Cmd) {
 	switch {
 	case isBack(msg):
 		m.grantEditing = false
 		m.grantApproval = ""
-		m.grantScopes = ""
+		m.grantScopes.Reset()
 		m.grantTrusted = false
 		return m, nil
 	case isKey(msg, "t"):
 		m.grantTrusted = !m.grantTrusted
 		return m, nil
 	case isEnter(msg):
-		scopes := parseScopesCSV(m.grantScopes)
+		scopes := parseScopesCSV(m.grantScopes.Value)
 		if len(scopes) == 0 {
 			return m, func() tea.Msg {
 				return errMsg{fmt.Errorf("at least one scope is required")}
@@ -447,26 +641,19 @@ func (m InboxModel) handleGrantInput(msg tea.KeyMsg) (InboxModel, tea.Cmd) {
 		}
 		m.grantEditing = false
 		m.grantApproval = ""
-		m.grantScopes = ""
+		m.grantScopes.Reset()
 		m.grantTrusted = false
 		m.detail = nil
 		return m, func() tea.Msg {
-			_, err := m.client.ApproveRequestWithInput(approveID, input)
+			item, err := m.client.ApproveRequestWithInput(approveID, input)
 			if err != nil {
 				return errMsg{err}
 			}
+			notifyDecision(config.WebhookApprove, approveID, "", item)
 			return approvalDoneMsg{approveID}
 		}
-	case isKey(msg, "backspace"):
-		if len(m.grantScopes) > 0 {
-			m.grantScopes = m.grantScopes[:len(m.grantScopes)-1]
-		}
-		return m, nil
 	default:
-		s := msg.String()
-		if len(s) == 1 || s == " " {
-			m.grantScopes += s
-		}
+		m.grantScopes.HandleKey(msg)
 	}
 	return m, nil
 }
@@ -480,7 +667,7 @@ func (m InboxModel) renderGrantEditor() string {
 	lines := []string{
 		"Approve register_agent with reviewer grants.",
 		"",
-		"Scopes (comma separated): " + m.grantScopes,
+		"Scopes (comma separated): " + m.grantScopes.View(AccentStyle, 0),
 		"requires_approval: " + mode + " (press t to toggle)",
 		"",
 		MutedStyle.Render("enter submit  |  esc cancel"),
@@ -495,7 +682,7 @@ func (m InboxModel) renderGrantEditor() string {
 func (m InboxModel) handleRejectInput(msg tea.KeyMsg) (InboxModel, tea.Cmd) {
 	if m.detail == nil {
 		m.rejecting = false
-		m.rejectBuf = ""
+		m.rejectBuf.Reset()
 		m.bulkRejectIDs = nil
 		return m, nil
 	}
@@ -505,7 +692,7 @@ func (m InboxModel) handleRejectInput(msg tea.KeyMsg) (InboxModel, tea.Cmd) {
 			m.detail = nil
 		}
 		m.rejecting = false
-		m.rejectBuf = ""
+		m.rejectBuf.Reset()
 		m.bulkRejectIDs = nil
 	case isEnter(msg):
 		ids := m.bulkRejectIDs
@@ -516,14 +703,8 @@ func (m InboxModel) handleRejectInput(msg tea.KeyMsg) (InboxModel, tea.Cmd) {
 		m.rejectPreview = true
 		m.bulkRejectIDs = ids
 		return m, nil
-	case isKey(msg, "backspace"):
-		if len(m.rejectBuf) > 0 {
-			m.rejectBuf = m.rejectBuf[:len(m.rejectBuf)-1]
-		}
 	default:
-		if len(msg.String()) == 1 || msg.String() == " " {
-			m.rejectBuf += msg.String()
-		}
+		m.rejectBuf.HandleKey(msg)
 	}
 	return m, nil
 }
@@ -538,17 +719,27 @@ func (m InboxModel) renderDetail() string {
 		{Label: "ID", Value: a.ID},
 		{Label: "Type", Value: a.RequestType},
 		{Label: "Status", Value: a.Status},
+	}
+	if strings.TrimSpace(a.Priority) != "" {
+		rows = append(rows, components.TableRow{Label: "Priority", Value: a.Priority})
+	}
+	rows = append(rows, []components.TableRow{
 		{Label: "Agent", Value: a.AgentName},
 		{Label: "Requested By", Value: approvalRequestedBy(*a)},
 		{Label: "Created", Value: formatLocalTimeFull(a.CreatedAt)},
-	}
+	}...)
 	if a.JobID != nil {
 		rows = append(rows, components.TableRow{Label: "Job ID", Value: *a.JobID})
 	}
 	if a.Notes != nil && *a.Notes != "" {
 		rows = append(rows, components.TableRow{Label: "Review Notes", Value: *a.Notes})
 	}
+	rows = append(rows, m.approvalTriageRows(*a)...)
 	sections = append(sections, components.Table("Approval Request", rows, m.width))
+	if m.comparing {
+		sections = append(sections, m.renderApprovalCompare())
+		return components.Indent(strings.Join(sections, "\n\n"), 1)
+	}
 
 	if len(a.ReviewDetails) > 0 {
 		reviewKeys := make([]string, 0, len(a.ReviewDetails))
@@ -604,6 +795,8 @@ func (m InboxModel) renderDetail() string {
 								Label: detailLabel(field),
 								From:  from,
 								To:    to,
+								Old:   diffObj["from"],
+								New:   diffObj["to"],
 							})
 						}
 					}
@@ -686,10 +879,11 @@ func (m InboxModel) renderDetail() string {
 
 		// Diff table for update requests.
 		if len(diffRows) > 0 {
-			sections = append(sections, components.DiffTable("Changes", diffRows, m.width))
+			sections = append(sections, components.DiffView("Changes", diffRows, m.width))
 		}
 	}
 
+	sections = append(sections, m.renderApprovalComments())
 	return components.Indent(strings.Join(sections, "\n\n"), 1)
 }
 
@@ -922,7 +1116,11 @@ func renderApprovalPreview(a api.Approval, picked bool, width int) string {
 	lines = append(lines, renderPreviewRow("Action", action, width))
 	lines = append(lines, renderPreviewRow("Who", who, width))
 	lines = append(lines, renderPreviewRow("At", when, width))
+	lines = append(lines, renderPreviewRow("Due", approvalDueLabel(a, time.Now()), width))
 	lines = append(lines, renderPreviewRow("Status", status, width))
+	if priority := approvalPriorityLabel(a); priority != "normal" {
+		lines = append(lines, renderPreviewRow("Priority", priority, width))
+	}
 	if picked {
 		lines = append(lines, renderPreviewRow("In batch", "yes", width))
 	}
@@ -972,14 +1170,18 @@ func (m *InboxModel) applyFilter(resetSelection bool) {
 		m.selected = make(map[string]bool)
 	}
 	m.filtered = m.filtered[:0]
-	labels := make([]string, 0, len(m.items))
-	filter := parseApprovalFilter(m.filterBuf)
+	filter := parseApprovalFilter(m.filterBuf.Value)
+	now := time.Now()
 	for i, a := range m.items {
-		if matchesApprovalFilter(a, filter) {
+		if matchesApprovalFilter(a, filter) && m.visibleInTriage(a, filter, now) {
 			m.filtered = append(m.filtered, i)
-			labels = append(labels, formatApprovalLine(a))
 		}
 	}
+	sortByUrgency(m.items, m.filtered, now)
+	labels := make([]string, len(m.filtered))
+	for i, idx := range m.filtered {
+		labels[i] = formatApprovalLine(m.items[idx])
+	}
 	m.list.SetItems(labels)
 }
 
@@ -1072,7 +1274,7 @@ func (m *InboxModel) startReject() (InboxModel, tea.Cmd) {
 		m.bulkRejectIDs = ids
 		m.rejecting = true
 		m.rejectPreview = false
-		m.rejectBuf = ""
+		m.rejectBuf.Reset()
 		m.detail = &api.Approval{ID: ids[0]}
 		return *m, nil
 	}
@@ -1080,7 +1282,7 @@ func (m *InboxModel) startReject() (InboxModel, tea.Cmd) {
 		m.detail = &item
 		m.rejecting = true
 		m.rejectPreview = false
-		m.rejectBuf = ""
+		m.rejectBuf.Reset()
 		return *m, nil
 	}
 	return *m, nil
@@ -1091,17 +1293,18 @@ func (m InboxModel) handleRejectPreview(msg tea.KeyMsg) (InboxModel, tea.Cmd) {
 	switch {
 	case isKey(msg, "y"), isEnter(msg):
 		ids := append([]string(nil), m.bulkRejectIDs...)
-		notes := m.rejectBuf
+		notes := m.rejectBuf.Value
 		m.rejectPreview = false
-		m.rejectBuf = ""
+		m.rejectBuf.Reset()
 		m.detail = nil
 		m.bulkRejectIDs = nil
 		return m, func() tea.Msg {
 			for _, id := range ids {
-				_, err := m.client.RejectRequest(id, notes)
+				item, err := m.client.RejectRequest(id, notes)
 				if err != nil {
 					return errMsg{err}
 				}
+				notifyDecision(config.WebhookReject, id, notes, item)
 			}
 			return approvalDoneMsg{""}
 		}
@@ -1326,30 +1529,42 @@ func (m InboxModel) handleFilterInput(msg tea.KeyMsg) (InboxModel, tea.Cmd) {
 	switch {
 	case isBack(msg):
 		m.filtering = false
-		m.filterBuf = ""
+		m.filterBuf.Reset()
 		m.applyFilter(true)
+		return m, m.syncWindow()
 	case isEnter(msg):
 		m.filtering = false
 		m.applyFilter(true)
-	case isKey(msg, "backspace"):
-		if len(m.filterBuf) > 0 {
-			m.filterBuf = m.filterBuf[:len(m.filterBuf)-1]
-			m.applyFilter(true)
-		}
+		return m, m.syncWindow()
 	default:
-		if len(msg.String()) == 1 || msg.String() == " " {
-			m.filterBuf += msg.String()
+		if editChanged(&m.filterBuf, msg) {
 			m.applyFilter(true)
 		}
 	}
 	return m, nil
 }
 
+// syncWindow refetches approvals when the filter's time window changed, so
+// the window reaches past the loaded page.
+func (m *InboxModel) syncWindow() tea.Cmd {
+	window, _ := splitWindowFilter(m.filterBuf.Value)
+	if window == m.window || m.client == nil {
+		return nil
+	}
+	m.window = window
+	m.loading = true
+	return m.loadApprovals
+}
+
 type approvalFilter struct {
-	agent string
-	req   string
-	since *time.Time
-	terms []string
+	agent     string
+	req       string
+	since     *time.Time
+	until     *time.Time
+	terms     []string
+	snoozed   bool
+	delegated bool
+	overdue   *bool
 }
 
 // parseApprovalFilter parses parse approval filter.
@@ -1365,11 +1580,21 @@ func parseApprovalFilter(raw string) approvalFilter {
 			filter.agent = strings.ToLower(strings.TrimPrefix(token, "agent:"))
 		case strings.HasPrefix(token, "type:"):
 			filter.req = strings.ToLower(strings.TrimPrefix(token, "type:"))
+		case strings.EqualFold(token, "is:snoozed"):
+			filter.snoozed = true
+		case strings.EqualFold(token, "is:delegated"):
+			filter.delegated = true
+		case strings.HasPrefix(strings.ToLower(token), "overdue:"):
+			if overdue, err := strconv.ParseBool(token[len("overdue:"):]); err == nil {
+				filter.overdue = &overdue
+			}
 		case strings.HasPrefix(token, "since:"):
 			val := strings.TrimPrefix(token, "since:")
 			if t := parseFilterTime(val); t != nil {
 				filter.since = t
 			}
+		case strings.HasPrefix(token, "until:"):
+			filter.until = parseUntilTime(strings.TrimPrefix(token, "until:"))
 		default:
 			filter.terms = append(filter.terms, strings.ToLower(token))
 		}
@@ -1419,6 +1644,12 @@ func matchesApprovalFilter(a api.Approval, filter approvalFilter) bool {
 	if filter.since != nil && a.CreatedAt.Before(*filter.since) {
 		return false
 	}
+	if filter.until != nil && !a.CreatedAt.Before(*filter.until) {
+		return false
+	}
+	if filter.overdue != nil && (approvalUrgencyAt(a, time.Now()) == urgencyOverdue) != *filter.overdue {
+		return false
+	}
 	if len(filter.terms) > 0 {
 		search := strings.ToLower(formatApprovalLine(a))
 		for _, term := range filter.terms {
@@ -0,0 +1,207 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+type approvalCommentsLoadedMsg struct {
+	id    string
+	items []api.ApprovalComment
+	err   error
+}
+
+type approvalCommentAddedMsg struct {
+	id      string
+	comment api.ApprovalComment
+}
+
+// loadApprovalComments loads the comment thread for the open approval. A
+// failed load is kept on the message so the thread can say so without
+// masking the rest of the detail view.
+func (m InboxModel) loadApprovalComments(id string) tea.Cmd {
+	if m.client == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		items, err := m.client.ListApprovalComments(id)
+		return approvalCommentsLoadedMsg{id: id, items: items, err: err}
+	}
+}
+
+// startComment opens the comment box below the thread.
+func (m *InboxModel) startComment() {
+	m.comparing = false
+	m.commenting = true
+	m.commentBuf.Reset()
+	m.commentReply = -1
+}
+
+// handleCommentInput handles keys while composing a comment.
+func (m InboxModel) handleCommentInput(msg tea.KeyMsg) (InboxModel, tea.Cmd) {
+	switch {
+	case isBack(msg):
+		m.commenting = false
+		m.commentBuf.Reset()
+		m.commentSaving = false
+		m.commentReply = -1
+	case isKey(msg, "tab"):
+		// Cycle the reply target through top-level comments, then back to a new thread.
+		roots := approvalCommentRoots(m.comments)
+		m.commentReply++
+		if m.commentReply >= len(roots) {
+			m.commentReply = -1
+		}
+	case isEnter(msg):
+		return m.submitComment()
+	default:
+		m.commentBuf.HandleKey(msg)
+	}
+	return m, nil
+}
+
+// submitComment posts the composed comment without reviewing the approval.
+func (m InboxModel) submitComment() (InboxModel, tea.Cmd) {
+	body := strings.TrimSpace(m.commentBuf.Value)
+	if body == "" || m.detail == nil || m.commentSaving {
+		return m, nil
+	}
+	input := api.CreateApprovalCommentInput{Body: body}
+	if parent, ok := m.commentReplyTarget(); ok {
+		input.ParentID = parent.ID
+	}
+	id := m.detail.ID
+	m.commentSaving = true
+	return m, func() tea.Msg {
+		created, err := m.client.AddApprovalComment(id, input)
+		if err != nil {
+			return errMsg{err}
+		}
+		return approvalCommentAddedMsg{id: id, comment: *created}
+	}
+}
+
+// commentReplyTarget returns the top-level comment being replied to.
+func (m InboxModel) commentReplyTarget() (api.ApprovalComment, bool) {
+	roots := approvalCommentRoots(m.comments)
+	if m.commentReply < 0 || m.commentReply >= len(roots) {
+		return api.ApprovalComment{}, false
+	}
+	return roots[m.commentReply], true
+}
+
+// approvalCommentRoots returns comments that start a thread.
+func approvalCommentRoots(comments []api.ApprovalComment) []api.ApprovalComment {
+	known := make(map[string]bool, len(comments))
+	for _, comment := range comments {
+		known[comment.ID] = true
+	}
+	roots := []api.ApprovalComment{}
+	for _, comment := range comments {
+		if comment.ParentID == nil || !known[*comment.ParentID] {
+			roots = append(roots, comment)
+		}
+	}
+	return roots
+}
+
+// approvalCommentReplies groups replies under their parent comment id.
+func approvalCommentReplies(comments []api.ApprovalComment) map[string][]api.ApprovalComment {
+	replies := map[string][]api.ApprovalComment{}
+	for _, comment := range comments {
+		if comment.ParentID != nil {
+			replies[*comment.ParentID] = append(replies[*comment.ParentID], comment)
+		}
+	}
+	return replies
+}
+
+// renderApprovalComments renders the thread and, while composing, the input box.
+func (m InboxModel) renderApprovalComments() string {
+	contentWidth := components.BoxContentWidth(m.width)
+	if contentWidth <= 0 {
+		contentWidth = 72
+	}
+
+	var lines []string
+	switch {
+	case m.commentsErr != nil:
+		lines = append(lines, MutedStyle.Render("Comments unavailable: "+components.SanitizeOneLine(m.commentsErr.Error())))
+	case len(m.comments) == 0:
+		lines = append(lines, MutedStyle.Render("No comments yet. Press m to ask the agent a question."))
+	default:
+		replies := approvalCommentReplies(m.comments)
+		var walk func(comment api.ApprovalComment, depth int)
+		walk = func(comment api.ApprovalComment, depth int) {
+			lines = append(lines, renderApprovalComment(comment, depth, contentWidth)...)
+			for _, reply := range replies[comment.ID] {
+				walk(reply, depth+1)
+			}
+		}
+		for i, root := range approvalCommentRoots(m.comments) {
+			if i > 0 {
+				lines = append(lines, "")
+			}
+			walk(root, 0)
+		}
+	}
+
+	content := strings.Join(lines, "\n")
+	if m.commenting {
+		title := "New Comment"
+		if parent, ok := m.commentReplyTarget(); ok {
+			title = fmt.Sprintf("Reply to %s", approvalCommentAuthor(parent))
+		}
+		if m.commentSaving {
+			title += " (sending...)"
+		}
+		content += "\n\n" + components.InputDialog(title, m.commentBuf) + "\n" +
+			MutedStyle.Render("enter send  ·  tab reply target  ·  esc cancel")
+	}
+	return components.TitledBox(fmt.Sprintf("Comments (%d)", len(m.comments)), content, m.width)
+}
+
+// renderApprovalComment renders one comment header and wrapped body.
+func renderApprovalComment(comment api.ApprovalComment, depth int, width int) []string {
+	indent := strings.Repeat("  ", depth)
+	marker := ""
+	if depth > 0 {
+		marker = "↳ "
+	}
+	author := approvalCommentAuthor(comment)
+	authorStyle := AccentStyle
+	if strings.EqualFold(comment.AuthorType, "agent") {
+		authorStyle = WarningStyle
+	}
+	header := indent + marker + authorStyle.Render(author)
+	if !comment.CreatedAt.IsZero() {
+		header += MutedStyle.Render("  " + formatLocalTimeCompact(comment.CreatedAt))
+	}
+
+	bodyIndent := indent + strings.Repeat(" ", len([]rune(marker)))
+	bodyWidth := width - len([]rune(bodyIndent))
+	if bodyWidth < 20 {
+		bodyWidth = 20
+	}
+	lines := []string{header}
+	for _, line := range wrapMetadataWords(comment.Body, bodyWidth) {
+		lines = append(lines, bodyIndent+NormalStyle.Render(line))
+	}
+	return lines
+}
+
+// approvalCommentAuthor returns a display name for a comment author.
+func approvalCommentAuthor(comment api.ApprovalComment) string {
+	if name := strings.TrimSpace(comment.AuthorName); name != "" {
+		return components.SanitizeOneLine(name)
+	}
+	if comment.AuthorID != "" {
+		return shortID(comment.AuthorID)
+	}
+	return "unknown"
+}
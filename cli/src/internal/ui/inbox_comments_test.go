@@ -0,0 +1,91 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// commentThread returns a question from the reviewer and the agent's answer.
+func commentThread() []api.ApprovalComment {
+	parent := "c-1"
+	return []api.ApprovalComment{
+		{ID: "c-1", ApprovalID: "ap-1", AuthorName: "alxx", AuthorType: "user", Body: "Why merge these two records?"},
+		{ID: "c-2", ApprovalID: "ap-1", ParentID: &parent, AuthorName: "scout", AuthorType: "agent", Body: "They share an email address."},
+		{ID: "c-3", ApprovalID: "ap-1", AuthorName: "scout", AuthorType: "agent", Body: "Also flagged two more."},
+	}
+}
+
+func TestApprovalCommentRootsAndReplies(t *testing.T) {
+	comments := commentThread()
+	roots := approvalCommentRoots(comments)
+	require.Len(t, roots, 2)
+	assert.Equal(t, "c-1", roots[0].ID)
+	assert.Equal(t, "c-3", roots[1].ID)
+
+	replies := approvalCommentReplies(comments)
+	require.Len(t, replies["c-1"], 1)
+	assert.Equal(t, "c-2", replies["c-1"][0].ID)
+
+	// Replies to unknown parents still show up as their own thread.
+	orphan := "gone"
+	roots = approvalCommentRoots([]api.ApprovalComment{{ID: "c-9", ParentID: &orphan}})
+	assert.Len(t, roots, 1)
+}
+
+func TestInboxDetailRendersCommentThread(t *testing.T) {
+	model := NewInboxModel(nil)
+	model.width = 100
+	model.openDetail(api.Approval{ID: "ap-1", RequestType: "create_entity", Status: "pending"})
+	assert.Contains(t, components.SanitizeText(model.View()), "No comments yet")
+
+	model, _ = model.Update(approvalCommentsLoadedMsg{id: "ap-1", items: commentThread()})
+	out := components.SanitizeText(model.View())
+	assert.Contains(t, out, "Why merge these two records?")
+	assert.Contains(t, out, "↳ scout")
+
+	// Threads for another approval are ignored.
+	model, _ = model.Update(approvalCommentsLoadedMsg{id: "ap-2"})
+	assert.Len(t, model.comments, 3)
+}
+
+func TestInboxCommentReplyPostsWithoutReviewing(t *testing.T) {
+	var posted map[string]string
+	_, client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/approvals/ap-1/comments", r.URL.Path)
+		require.Equal(t, http.MethodPost, r.Method)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&posted))
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+			"id": "c-4", "approval_id": "ap-1", "parent_id": "c-3", "author_name": "alxx", "body": posted["body"],
+		}}))
+	})
+	model := NewInboxModel(client)
+	model.width = 100
+	model.openDetail(api.Approval{ID: "ap-1", RequestType: "create_entity", Status: "pending"})
+	model, _ = model.Update(approvalCommentsLoadedMsg{id: "ap-1", items: commentThread()})
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	require.True(t, model.commenting)
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	assert.Contains(t, components.SanitizeText(model.View()), "Reply to scout")
+
+	// Typed keys go to the comment box, not the approve/reject shortcuts.
+	model = typeText(model, "ok, approve after")
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	assert.True(t, model.commentSaving)
+
+	model, _ = model.Update(cmd())
+	assert.Equal(t, map[string]string{"body": "ok, approve after", "parent_id": "c-3"}, posted)
+	assert.False(t, model.commenting)
+	assert.False(t, model.confirming)
+	require.Len(t, model.comments, 4)
+	assert.Contains(t, components.SanitizeText(model.View()), "ok, approve after")
+}
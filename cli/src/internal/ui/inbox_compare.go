@@ -0,0 +1,196 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// approvalLiveRecordKeys maps update request types to the change detail key
+// that names their target record.
+var approvalLiveRecordKeys = map[string]string{
+	"update_entity":     "entity_id",
+	"update_context":    "context_id",
+	"update_job":        "job_id",
+	"update_job_status": "job_id",
+	"update_log":        "id",
+	"update_file":       "file_id",
+	"update_protocol":   "name",
+}
+
+type approvalLiveRecordLoadedMsg struct {
+	id     string
+	record map[string]any
+	err    error
+}
+
+// approvalLiveRecordTarget returns the target record id for update requests.
+func approvalLiveRecordTarget(a api.Approval) (string, bool) {
+	key, ok := approvalLiveRecordKeys[strings.TrimSpace(a.RequestType)]
+	if !ok {
+		return "", false
+	}
+	target := strings.TrimSpace(fmt.Sprint(a.ChangeDetails[key]))
+	if a.ChangeDetails[key] == nil || target == "" {
+		return "", false
+	}
+	return target, true
+}
+
+// loadApprovalLiveRecord fetches the record an update request would change.
+// Failures are kept on the message so the compare view can explain them.
+func (m InboxModel) loadApprovalLiveRecord(a api.Approval) tea.Cmd {
+	target, ok := approvalLiveRecordTarget(a)
+	if !ok || m.client == nil {
+		return nil
+	}
+	id := a.ID
+	requestType := strings.TrimSpace(a.RequestType)
+	return func() tea.Msg {
+		record, err := m.fetchLiveRecord(requestType, target)
+		if err != nil {
+			return approvalLiveRecordLoadedMsg{id: id, err: err}
+		}
+		return approvalLiveRecordLoadedMsg{id: id, record: recordStateMap(record)}
+	}
+}
+
+// fetchLiveRecord loads the current state of an update request target.
+func (m InboxModel) fetchLiveRecord(requestType, target string) (any, error) {
+	switch requestType {
+	case "update_entity":
+		return m.client.GetEntity(target)
+	case "update_context":
+		return m.client.GetContext(target)
+	case "update_job", "update_job_status":
+		return m.client.GetJob(target)
+	case "update_log":
+		return m.client.GetLog(target)
+	case "update_file":
+		return m.client.GetFile(target)
+	case "update_protocol":
+		return m.client.GetProtocol(target)
+	}
+	return nil, fmt.Errorf("no live record lookup for %s", requestType)
+}
+
+// recordStateMap flattens an API record into its JSON field keys.
+func recordStateMap(record any) map[string]any {
+	raw, err := json.Marshal(record)
+	if err != nil {
+		return map[string]any{}
+	}
+	state := map[string]any{}
+	if err := json.Unmarshal(raw, &state); err != nil {
+		return map[string]any{}
+	}
+	return state
+}
+
+// approvalCompareRow is one field of the current vs proposed table.
+type approvalCompareRow struct {
+	field    string
+	current  string
+	proposed string
+	changed  bool
+}
+
+// approvalCompareRows lines up every field of the live record with the value
+// the request proposes. Fields the request leaves out keep their current value.
+func approvalCompareRows(a api.Approval, live map[string]any) []approvalCompareRow {
+	idKey := approvalLiveRecordKeys[strings.TrimSpace(a.RequestType)]
+	keys := map[string]bool{}
+	for key := range live {
+		keys[key] = true
+	}
+	for key := range a.ChangeDetails {
+		keys[key] = true
+	}
+
+	names := make([]string, 0, len(keys))
+	for key := range keys {
+		if key == idKey || key == "changes" || timeTravelHiddenFields[key] {
+			continue
+		}
+		names = append(names, key)
+	}
+	sort.Strings(names)
+
+	rows := make([]approvalCompareRow, 0, len(names))
+	for _, key := range names {
+		current := formatAny(live[key])
+		proposed := current
+		if value, ok := a.ChangeDetails[key]; ok && value != nil {
+			proposed = approvalDiffValue(a.ChangeDetails, key, value)
+		}
+		rows = append(rows, approvalCompareRow{
+			field:    key,
+			current:  current,
+			proposed: proposed,
+			changed:  current != proposed,
+		})
+	}
+	return rows
+}
+
+// renderApprovalCompare renders the live record next to the proposed values.
+func (m InboxModel) renderApprovalCompare() string {
+	a := m.detail
+	contentWidth := components.BoxContentWidth(m.width)
+	switch {
+	case m.liveErr != nil:
+		return components.TitledBox(
+			"Current vs Proposed",
+			ErrorStyle.Render("Current record unavailable: "+components.SanitizeOneLine(m.liveErr.Error())),
+			m.width,
+		)
+	case m.liveRecord == nil:
+		return components.TitledBox("Current vs Proposed", MutedStyle.Render("Loading current record..."), m.width)
+	}
+
+	rows := approvalCompareRows(*a, m.liveRecord)
+	sepWidth := 1
+	if br := lipgloss.RoundedBorder().Left; br != "" {
+		sepWidth = lipgloss.Width(br)
+	}
+	// 4 columns -> 3 separators.
+	markWidth := 1
+	fieldWidth := 16
+	valueWidth := (contentWidth - (3 * sepWidth) - markWidth - fieldWidth) / 2
+	if valueWidth < 12 {
+		valueWidth = 12
+	}
+	cols := []components.TableColumn{
+		{Header: "", Width: markWidth, Align: lipgloss.Left},
+		{Header: "Field", Width: fieldWidth, Align: lipgloss.Left},
+		{Header: "Current", Width: valueWidth, Align: lipgloss.Left},
+		{Header: "Proposed", Width: valueWidth, Align: lipgloss.Left},
+	}
+
+	changed := 0
+	grid := make([][]string, 0, len(rows))
+	for _, row := range rows {
+		mark := ""
+		if row.changed {
+			mark = "*"
+			changed++
+		}
+		grid = append(grid, []string{
+			mark,
+			components.ClampTextWidthEllipsis(detailLabel(row.field), fieldWidth),
+			components.ClampTextWidthEllipsis(components.SanitizeOneLine(row.current), valueWidth),
+			components.ClampTextWidthEllipsis(components.SanitizeOneLine(row.proposed), valueWidth),
+		})
+	}
+
+	summary := MutedStyle.Render(fmt.Sprintf("%d of %d fields change  ·  * marks a proposed change", changed, len(rows)))
+	content := summary + "\n\n" + components.TableGrid(cols, grid, contentWidth)
+	return components.TitledBox("Current vs Proposed", content, m.width)
+}
@@ -0,0 +1,111 @@
+package ui
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// updateEntityApproval returns a pending update request touching only status.
+func updateEntityApproval() api.Approval {
+	return api.Approval{
+		ID:          "ap-1",
+		RequestType: "update_entity",
+		Status:      "pending",
+		ChangeDetails: api.JSONMap{
+			"entity_id": "ent-1",
+			"status":    "inactive",
+			"tags":      nil,
+		},
+	}
+}
+
+func TestApprovalCompareRowsKeepOmittedFields(t *testing.T) {
+	live := map[string]any{
+		"id":         "ent-1",
+		"name":       "Alex",
+		"status":     "active",
+		"tags":       []any{"founder"},
+		"created_at": "2026-01-01T00:00:00Z",
+	}
+	rows := approvalCompareRows(updateEntityApproval(), live)
+	require.Len(t, rows, 3)
+
+	assert.Equal(t, approvalCompareRow{field: "name", current: "Alex", proposed: "Alex"}, rows[0])
+	assert.Equal(t, approvalCompareRow{field: "status", current: "active", proposed: "inactive", changed: true}, rows[1])
+	assert.Equal(t, "tags", rows[2].field)
+	assert.False(t, rows[2].changed)
+}
+
+func TestApprovalLiveRecordTarget(t *testing.T) {
+	target, ok := approvalLiveRecordTarget(updateEntityApproval())
+	assert.True(t, ok)
+	assert.Equal(t, "ent-1", target)
+
+	_, ok = approvalLiveRecordTarget(api.Approval{RequestType: "create_entity"})
+	assert.False(t, ok)
+	_, ok = approvalLiveRecordTarget(api.Approval{RequestType: "update_context"})
+	assert.False(t, ok)
+}
+
+func TestInboxDetailComparesAgainstLiveRecord(t *testing.T) {
+	_, client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/entities/ent-1":
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+				"id": "ent-1", "name": "Alex", "type": "person", "status": "active",
+			}}))
+		case "/api/approvals/ap-1/diff":
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+				"changes": map[string]any{"status": map[string]any{"from": "active", "to": "inactive"}},
+			}}))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	model := NewInboxModel(client)
+	model.width = 120
+	model, _ = model.Update(approvalsLoadedMsg{items: []api.Approval{updateEntityApproval()}})
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	batch, ok := cmd().(tea.BatchMsg)
+	require.True(t, ok)
+	for _, sub := range batch {
+		model, _ = model.Update(sub())
+	}
+	require.NotNil(t, model.liveRecord)
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	require.True(t, model.comparing)
+	out := components.SanitizeText(model.View())
+	assert.Contains(t, out, "Proposed")
+	assert.Contains(t, out, "1 of 5 fields change")
+	assert.Contains(t, out, "person")
+
+	// Esc leaves compare mode before leaving the detail.
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.False(t, model.comparing)
+	require.NotNil(t, model.detail)
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Nil(t, model.detail)
+}
+
+func TestInboxCompareShowsLookupFailure(t *testing.T) {
+	model := NewInboxModel(nil)
+	model.width = 100
+	approval := updateEntityApproval()
+	model.openDetail(approval)
+	model.comparing = true
+	assert.Contains(t, components.SanitizeText(model.View()), "Loading current record")
+
+	model, _ = model.Update(approvalLiveRecordLoadedMsg{id: "ap-1", err: errors.New("entity not found")})
+	assert.Contains(t, components.SanitizeText(model.View()), "Current record unavailable")
+}
@@ -112,14 +112,14 @@ func TestInboxHandleDetailAndGrantInputBranches(t *testing.T) {
 	require.Nil(t, cmd)
 	assert.True(t, updated.grantEditing)
 	assert.Equal(t, "ap-1", updated.grantApproval)
-	assert.Equal(t, "public", updated.grantScopes)
+	assert.Equal(t, "public", updated.grantScopes.Value)
 	assert.False(t, updated.grantTrusted)
 
-	updated.rejectBuf = "stale"
+	updated.rejectBuf.Value = "stale"
 	updated, cmd = updated.handleDetailKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
 	require.Nil(t, cmd)
 	assert.True(t, updated.rejecting)
-	assert.Equal(t, "", updated.rejectBuf)
+	assert.Equal(t, "", updated.rejectBuf.Value)
 
 	updated, cmd = updated.handleDetailKeys(tea.KeyMsg{Type: tea.KeyEsc})
 	require.Nil(t, cmd)
@@ -127,7 +127,7 @@ func TestInboxHandleDetailAndGrantInputBranches(t *testing.T) {
 
 	updated.grantEditing = true
 	updated.grantApproval = "ap-1"
-	updated.grantScopes = "pub"
+	updated.grantScopes.Value = "pub"
 	updated.grantTrusted = false
 
 	updated, cmd = updated.handleGrantInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
@@ -136,16 +136,16 @@ func TestInboxHandleDetailAndGrantInputBranches(t *testing.T) {
 
 	updated, cmd = updated.handleGrantInput(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "pu", updated.grantScopes)
+	assert.Equal(t, "pu", updated.grantScopes.Value)
 
 	updated, cmd = updated.handleGrantInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
 	require.Nil(t, cmd)
-	assert.Equal(t, "pux", updated.grantScopes)
+	assert.Equal(t, "pux", updated.grantScopes.Value)
 	updated, cmd = updated.handleGrantInput(tea.KeyMsg{Type: tea.KeySpace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "pux ", updated.grantScopes)
+	assert.Equal(t, "pux ", updated.grantScopes.Value)
 
-	updated.grantScopes = ""
+	updated.grantScopes.Value = ""
 	updated, cmd = updated.handleGrantInput(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
 	msg := cmd()
@@ -157,7 +157,7 @@ func TestInboxHandleDetailAndGrantInputBranches(t *testing.T) {
 	require.Nil(t, cmd)
 	assert.False(t, updated.grantEditing)
 	assert.Equal(t, "", updated.grantApproval)
-	assert.Equal(t, "", updated.grantScopes)
+	assert.Equal(t, "", updated.grantScopes.Value)
 	assert.False(t, updated.grantTrusted)
 }
 
@@ -174,7 +174,7 @@ func TestInboxHandleGrantInputSubmitErrorBranch(t *testing.T) {
 	model := NewInboxModel(client)
 	model.grantEditing = true
 	model.grantApproval = "ap-1"
-	model.grantScopes = "public"
+	model.grantScopes.Value = "public"
 	model.grantTrusted = true
 	model.detail = &api.Approval{ID: "ap-1", CreatedAt: time.Now()}
 
@@ -44,7 +44,7 @@ func TestInboxLoadApprovalsLimitFallbackAndError(t *testing.T) {
 		http.Error(w, `{"error":{"code":"FAILED","message":"pending broke"}}`, http.StatusInternalServerError)
 	})
 	errModel := NewInboxModel(errClient)
-	errMsgOut, ok := errModel.loadApprovals().(errMsg)
+	errMsgOut, ok := errModel.loadApprovals().(loadFailedMsg)
 	require.True(t, ok)
 	assert.ErrorContains(t, errMsgOut.err, "FAILED")
 }
@@ -110,7 +110,7 @@ func TestInboxHandleRejectPreviewBranches(t *testing.T) {
 	model := NewInboxModel(client)
 	model.rejectPreview = true
 	model.bulkRejectIDs = []string{"ap-1", "ap-2"}
-	model.rejectBuf = "nope"
+	model.rejectBuf.Value = "nope"
 
 	updated, cmd := model.handleRejectPreview(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
 	assert.True(t, updated.rejectPreview)
@@ -124,7 +124,7 @@ func TestInboxHandleRejectPreviewBranches(t *testing.T) {
 	updated.rejecting = false
 	updated.rejectPreview = true
 	updated.bulkRejectIDs = []string{"ap-1", "ap-2"}
-	updated.rejectBuf = "nope"
+	updated.rejectBuf.Value = "nope"
 
 	updated, cmd = updated.handleRejectPreview(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
 	require.NotNil(t, cmd)
@@ -132,7 +132,7 @@ func TestInboxHandleRejectPreviewBranches(t *testing.T) {
 	_, ok := msg.(approvalDoneMsg)
 	require.True(t, ok)
 	assert.False(t, updated.rejectPreview)
-	assert.Equal(t, "", updated.rejectBuf)
+	assert.Equal(t, "", updated.rejectBuf.Value)
 	assert.Nil(t, updated.detail)
 	assert.Nil(t, updated.bulkRejectIDs)
 	assert.Equal(t, []string{"/api/approvals/ap-1/reject", "/api/approvals/ap-2/reject"}, rejected)
@@ -141,7 +141,7 @@ func TestInboxHandleRejectPreviewBranches(t *testing.T) {
 	errModel := NewInboxModel(client)
 	errModel.rejectPreview = true
 	errModel.bulkRejectIDs = []string{"ap-err"}
-	errModel.rejectBuf = "bad"
+	errModel.rejectBuf.Value = "bad"
 	_, cmd = errModel.handleRejectPreview(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
 	errOut, ok := cmd().(errMsg)
@@ -190,29 +190,29 @@ func TestInboxHandleFilterInputMatrix(t *testing.T) {
 
 	updated, cmd := model.handleFilterInput(tea.KeyMsg{Type: tea.KeyBackspace})
 	assert.Nil(t, cmd)
-	assert.Equal(t, "", updated.filterBuf)
+	assert.Equal(t, "", updated.filterBuf.Value)
 	assert.Len(t, updated.filtered, 2)
 
 	updated, cmd = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
 	assert.Nil(t, cmd)
-	assert.Equal(t, "a", updated.filterBuf)
+	assert.Equal(t, "a", updated.filterBuf.Value)
 
 	updated, _ = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "", updated.filterBuf)
+	assert.Equal(t, "", updated.filterBuf.Value)
 
 	updated, _ = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyTab})
-	assert.Equal(t, "", updated.filterBuf)
+	assert.Equal(t, "", updated.filterBuf.Value)
 
 	updated, _ = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
-	assert.Equal(t, "b", updated.filterBuf)
+	assert.Equal(t, "b", updated.filterBuf.Value)
 	updated, _ = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyEnter})
 	assert.False(t, updated.filtering)
-	assert.Equal(t, "b", updated.filterBuf)
+	assert.Equal(t, "b", updated.filterBuf.Value)
 
 	updated.filtering = true
 	updated, _ = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyEsc})
 	assert.False(t, updated.filtering)
-	assert.Equal(t, "", updated.filterBuf)
+	assert.Equal(t, "", updated.filterBuf.Value)
 	assert.Len(t, updated.filtered, 2)
 }
 
@@ -335,7 +335,7 @@ func TestInboxViewAdditionalBranches(t *testing.T) {
 	model.confirming = false
 	model.rejectPreview = true
 	model.bulkRejectIDs = []string{"ap-1"}
-	model.rejectBuf = "no"
+	model.rejectBuf.Value = "no"
 	_ = components.SanitizeText(model.View())
 
 	model.rejectPreview = false
@@ -360,7 +360,7 @@ func TestInboxViewAdditionalBranches(t *testing.T) {
 	assert.Contains(t, out, "selected: 1")
 	assert.Contains(t, out, "In batch")
 
-	model.filterBuf = "agent:nope"
+	model.filterBuf.Value = "agent:nope"
 	model.applyFilter(true)
 	out = components.SanitizeText(model.View())
 	assert.Contains(t, out, "No approvals match the filter")
@@ -412,19 +412,19 @@ func TestInboxFilterTimeAndMatchExtraBranches(t *testing.T) {
 func TestInboxHandleRejectInputDetailNilAndEnterBranches(t *testing.T) {
 	model := NewInboxModel(nil)
 	model.rejecting = true
-	model.rejectBuf = "stale"
+	model.rejectBuf.Value = "stale"
 	model.bulkRejectIDs = []string{"ap-1"}
 
 	updated, cmd := model.handleRejectInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
 	assert.Nil(t, cmd)
 	assert.False(t, updated.rejecting)
-	assert.Equal(t, "", updated.rejectBuf)
+	assert.Equal(t, "", updated.rejectBuf.Value)
 	assert.Nil(t, updated.bulkRejectIDs)
 
 	model = NewInboxModel(nil)
 	model.detail = &api.Approval{ID: "ap-2"}
 	model.rejecting = true
-	model.rejectBuf = "reason"
+	model.rejectBuf.Value = "reason"
 
 	updated, cmd = model.handleRejectInput(tea.KeyMsg{Type: tea.KeyEnter})
 	assert.Nil(t, cmd)
@@ -439,7 +439,7 @@ func TestInboxHandleRejectInputDetailNilAndEnterBranches(t *testing.T) {
 	updated, _ = updated.handleRejectInput(tea.KeyMsg{Type: tea.KeyEsc})
 	assert.Nil(t, updated.detail)
 	assert.False(t, updated.rejecting)
-	assert.Equal(t, "", updated.rejectBuf)
+	assert.Equal(t, "", updated.rejectBuf.Value)
 	assert.Nil(t, updated.bulkRejectIDs)
 }
 
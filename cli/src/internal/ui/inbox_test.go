@@ -21,6 +21,14 @@ func testClient(t *testing.T, handler http.HandlerFunc) (*httptest.Server, *api.
 	return srv, api.NewClient(srv.URL, "test-key")
 }
 
+// typeText sends text to the model one rune at a time, as a user typing it.
+func typeText[M interface{ Update(tea.Msg) (M, tea.Cmd) }](m M, text string) M {
+	for _, r := range text {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	return m
+}
+
 // TestInboxModelInit handles test inbox model init.
 func TestInboxModelInit(t *testing.T) {
 	_, client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
@@ -157,8 +165,11 @@ func TestInboxDetailLoadsDiff(t *testing.T) {
 	var detailCmd tea.Cmd
 	model, detailCmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, detailCmd)
-	msg = detailCmd()
-	model, _ = model.Update(msg)
+	batch, ok := detailCmd().(tea.BatchMsg)
+	require.True(t, ok)
+	for _, sub := range batch {
+		model, _ = model.Update(sub())
+	}
 
 	if !diffCalled {
 		t.Fatalf("diff endpoint not called, paths=%v", paths)
@@ -287,16 +298,16 @@ func TestInboxModelRejectInputHandling(t *testing.T) {
 	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
 	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
 	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
-	assert.Equal(t, "test", model.rejectBuf)
+	assert.Equal(t, "test", model.rejectBuf.Value)
 
 	// Backspace once
 	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "tes", model.rejectBuf)
+	assert.Equal(t, "tes", model.rejectBuf.Value)
 
 	// Escape to cancel
 	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
 	assert.False(t, model.rejecting)
-	assert.Equal(t, "", model.rejectBuf)
+	assert.Equal(t, "", model.rejectBuf.Value)
 }
 
 // TestInboxToggleSelectAll handles test inbox toggle select all.
@@ -347,7 +358,7 @@ func TestInboxApproveAllFiltered(t *testing.T) {
 
 	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
 	require.NotNil(t, cmd)
-	cmd()
+	runQueuedOperation(t, cmd)
 
 	assert.ElementsMatch(t, []string{"ap-1", "ap-2"}, approved)
 }
@@ -384,7 +395,7 @@ func TestInboxApproveAllConfirmAcceptsEnter(t *testing.T) {
 
 	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
-	cmd()
+	runQueuedOperation(t, cmd)
 
 	assert.ElementsMatch(t, []string{"ap-1", "ap-2"}, approved)
 }
@@ -414,7 +425,7 @@ func TestInboxFilterByAgentAndType(t *testing.T) {
 		{ID: "ap-1", Status: "pending", RequestType: "create_entity", AgentName: "alpha", CreatedAt: time.Now()},
 		{ID: "ap-2", Status: "pending", RequestType: "update_entity", AgentName: "beta", CreatedAt: time.Now()},
 	}
-	model.filterBuf = "agent:alpha type:create"
+	model.filterBuf.Value = "agent:alpha type:create"
 	model.applyFilter(true)
 
 	assert.Len(t, model.list.Items, 1)
@@ -428,7 +439,7 @@ func TestInboxFilterByTerm(t *testing.T) {
 		{ID: "ap-1", Status: "pending", RequestType: "create_entity", AgentName: "alpha", ChangeDetails: api.JSONMap{"name": "Foo"}, CreatedAt: time.Now()},
 		{ID: "ap-2", Status: "pending", RequestType: "create_entity", AgentName: "beta", ChangeDetails: api.JSONMap{"name": "Bar"}, CreatedAt: time.Now()},
 	}
-	model.filterBuf = "foo"
+	model.filterBuf.Value = "foo"
 	model.applyFilter(true)
 
 	assert.Len(t, model.list.Items, 1)
@@ -473,7 +484,7 @@ func TestInboxBatchApproveSelected(t *testing.T) {
 
 	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
 	require.NotNil(t, cmd)
-	msg = cmd()
+	msg = runQueuedOperation(t, cmd)
 	_, _ = model.Update(msg)
 
 	assert.ElementsMatch(t, []string{"ap-1", "ap-2"}, approved)
@@ -540,7 +551,7 @@ func TestInboxApproveAllMixedRequestsStaysDeterministic(t *testing.T) {
 
 	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
 	require.NotNil(t, cmd)
-	msg = cmd()
+	msg = runQueuedOperation(t, cmd)
 	_, _ = model.Update(msg)
 
 	assert.ElementsMatch(t, []string{"ap-bulk", "ap-update"}, approved)
@@ -575,7 +586,7 @@ func TestInboxApproveRegisterAgentOpensGrantEditor(t *testing.T) {
 	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
 	assert.True(t, model.grantEditing)
 	assert.Equal(t, "ap-register", model.grantApproval)
-	assert.Equal(t, "public,private", model.grantScopes)
+	assert.Equal(t, "public,private", model.grantScopes.Value)
 	assert.False(t, model.grantTrusted)
 }
 
@@ -722,7 +733,7 @@ func TestParseApprovalFilterTokens(t *testing.T) {
 func TestInboxRenderGrantEditorShowsCurrentInputs(t *testing.T) {
 	model := NewInboxModel(nil)
 	model.width = 90
-	model.grantScopes = "public,private"
+	model.grantScopes.Value = "public,private"
 	model.grantTrusted = false
 
 	out := stripANSI(model.renderGrantEditor())
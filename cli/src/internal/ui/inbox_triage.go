@@ -0,0 +1,350 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// snoozeMorningHour is when day-based snoozes wake up.
+const snoozeMorningHour = 9
+
+// triagePrompt names the open snooze or delegate prompt.
+type triagePrompt string
+
+const (
+	triagePromptNone     triagePrompt = ""
+	triagePromptSnooze   triagePrompt = "snooze"
+	triagePromptDelegate triagePrompt = "delegate"
+)
+
+type inboxTriageSavedMsg struct{ notice string }
+
+type approvalDelegateResolvedMsg struct {
+	items  []api.Approval
+	notice string
+}
+
+// SetTriage sets the local snooze state.
+func (m *InboxModel) SetTriage(state *config.InboxState) {
+	if state == nil {
+		state = &config.InboxState{}
+	}
+	m.triage = state
+}
+
+// loadInboxTriage reads local triage state. An unreadable file starts the
+// inbox with nothing snoozed rather than blocking startup.
+func loadInboxTriage() *config.InboxState {
+	state, err := config.LoadInboxState()
+	if err != nil {
+		return &config.InboxState{}
+	}
+	return state
+}
+
+// triageTargets returns the selected approvals, or the one under the cursor.
+func (m InboxModel) triageTargets() []string {
+	if ids := m.selectedIDs(); len(ids) > 0 {
+		return ids
+	}
+	if m.detail != nil {
+		return []string{m.detail.ID}
+	}
+	if item, ok := m.selectedItem(); ok {
+		return []string{item.ID}
+	}
+	return nil
+}
+
+// startSnooze opens the snooze prompt, or wakes a single snoozed approval.
+func (m InboxModel) startSnooze() (InboxModel, tea.Cmd) {
+	ids := m.triageTargets()
+	if len(ids) == 0 || m.triage == nil {
+		return m, nil
+	}
+	if len(ids) == 1 {
+		if _, ok := m.triage.SnoozedUntil(ids[0], time.Now()); ok {
+			m.triage.Unsnooze(ids[0])
+			m.applyFilter(false)
+			return m, m.saveTriage("Snooze cleared.")
+		}
+	}
+	m.triagePrompt = triagePromptSnooze
+	m.triageIDs = ids
+	m.triageBuf.Reset()
+	return m, nil
+}
+
+// startDelegate opens the delegate prompt.
+func (m InboxModel) startDelegate() (InboxModel, tea.Cmd) {
+	ids := m.triageTargets()
+	if len(ids) == 0 || m.client == nil {
+		return m, nil
+	}
+	m.triagePrompt = triagePromptDelegate
+	m.triageIDs = ids
+	m.triageBuf.Reset()
+	if len(ids) == 1 {
+		if item, ok := m.findApprovalByID(ids[0]); ok && item.DelegatedTo != nil {
+			m.triageBuf.SetValue(item.DelegatedToName)
+		}
+	}
+	return m, nil
+}
+
+// handleTriageInput handles keys while a snooze or delegate prompt is open.
+func (m InboxModel) handleTriageInput(msg tea.KeyMsg) (InboxModel, tea.Cmd) {
+	switch {
+	case isBack(msg):
+		m.closeTriagePrompt()
+	case isEnter(msg):
+		return m.submitTriagePrompt()
+	default:
+		m.triageBuf.HandleKey(msg)
+	}
+	return m, nil
+}
+
+// closeTriagePrompt drops the open prompt and its targets.
+func (m *InboxModel) closeTriagePrompt() {
+	m.triagePrompt = triagePromptNone
+	m.triageIDs = nil
+	m.triageBuf.Reset()
+}
+
+// submitTriagePrompt applies the snooze or starts resolving the delegate.
+func (m InboxModel) submitTriagePrompt() (InboxModel, tea.Cmd) {
+	ids := m.triageIDs
+	value := strings.TrimSpace(m.triageBuf.Value)
+	switch m.triagePrompt {
+	case triagePromptSnooze:
+		until, err := parseSnoozeUntil(value, time.Now())
+		if err != nil {
+			return m, func() tea.Msg { return errMsg{err} }
+		}
+		m.closeTriagePrompt()
+		for _, id := range ids {
+			m.triage.Snooze(id, until)
+		}
+		m.leaveHiddenDetail()
+		m.applyFilter(true)
+		return m, m.saveTriage(fmt.Sprintf("Snoozed %s until %s.", approvalCountLabel(len(ids)), formatLocalTimeFull(until)))
+	case triagePromptDelegate:
+		m.closeTriagePrompt()
+		return m, m.resolveDelegate(ids, value)
+	}
+	return m, nil
+}
+
+// resolveDelegate looks up the person an approval is handed to by exact name
+// or entity id and records the delegation on the server. An empty name clears
+// it.
+func (m InboxModel) resolveDelegate(ids []string, who string) tea.Cmd {
+	client := m.client
+	return func() tea.Msg {
+		to, name := "", ""
+		if who != "" {
+			matches, err := client.QueryEntities(api.QueryParams{"search_text": who, "limit": "20"})
+			if err != nil {
+				return errMsg{err}
+			}
+			for _, entity := range matches {
+				if strings.EqualFold(strings.TrimSpace(entity.Name), who) || entity.ID == who {
+					to, name = entity.ID, entity.Name
+					break
+				}
+			}
+			if to == "" {
+				return errMsg{fmt.Errorf("no entity named %q to delegate to", who)}
+			}
+		}
+		items := make([]api.Approval, 0, len(ids))
+		for _, id := range ids {
+			item, err := client.DelegateApproval(id, to)
+			if err != nil {
+				return errMsg{err}
+			}
+			items = append(items, *item)
+		}
+		notice := "Delegation cleared."
+		if to != "" {
+			notice = fmt.Sprintf("Delegated %s to %s.", approvalCountLabel(len(ids)), name)
+		}
+		return approvalDelegateResolvedMsg{items: items, notice: notice}
+	}
+}
+
+// applyDelegation swaps in the approvals returned by the delegate call.
+func (m InboxModel) applyDelegation(msg approvalDelegateResolvedMsg) (InboxModel, tea.Cmd) {
+	updated := make(map[string]api.Approval, len(msg.items))
+	for _, item := range msg.items {
+		updated[item.ID] = item
+	}
+	for i, item := range m.items {
+		if next, ok := updated[item.ID]; ok {
+			m.items[i] = next
+		}
+	}
+	if m.detail != nil {
+		if next, ok := updated[m.detail.ID]; ok {
+			m.detail = &next
+		}
+	}
+	m.applyFilter(false)
+	notice := msg.notice
+	return m, func() tea.Msg { return inboxTriageSavedMsg{notice: notice} }
+}
+
+// leaveHiddenDetail closes the detail view when its approval was just snoozed.
+func (m *InboxModel) leaveHiddenDetail() {
+	if m.detail == nil {
+		return
+	}
+	if _, ok := m.triage.SnoozedUntil(m.detail.ID, time.Now()); ok {
+		m.detail = nil
+		m.comparing = false
+	}
+}
+
+// saveTriage persists triage state off the update loop.
+func (m InboxModel) saveTriage(notice string) tea.Cmd {
+	state := m.triage
+	return func() tea.Msg {
+		if err := state.Save(); err != nil {
+			return errMsg{err}
+		}
+		return inboxTriageSavedMsg{notice: notice}
+	}
+}
+
+// pruneTriage drops stale entries once the full pending set is known.
+func (m InboxModel) pruneTriage() tea.Cmd {
+	if m.triage == nil || len(m.items) >= m.pendingLimit {
+		return nil
+	}
+	ids := make([]string, 0, len(m.items))
+	for _, item := range m.items {
+		ids = append(ids, item.ID)
+	}
+	if !m.triage.Prune(ids, time.Now()) {
+		return nil
+	}
+	return m.saveTriage("")
+}
+
+// visibleInTriage applies the snooze and delegation filters to one approval.
+func (m InboxModel) visibleInTriage(a api.Approval, filter approvalFilter, now time.Time) bool {
+	_, snoozed := m.triage.SnoozedUntil(a.ID, now)
+	if snoozed != filter.snoozed {
+		return false
+	}
+	if filter.delegated {
+		if a.DelegatedTo == nil || m.currentUserID == "" || *a.DelegatedTo != m.currentUserID {
+			return false
+		}
+	}
+	return true
+}
+
+// snoozedCount returns how many loaded approvals are snoozed right now.
+func (m InboxModel) snoozedCount() int {
+	now := time.Now()
+	count := 0
+	for _, item := range m.items {
+		if _, ok := m.triage.SnoozedUntil(item.ID, now); ok {
+			count++
+		}
+	}
+	return count
+}
+
+// approvalTriageRows returns detail rows for snooze and delegation state.
+func (m InboxModel) approvalTriageRows(a api.Approval) []components.TableRow {
+	var rows []components.TableRow
+	if until, ok := m.triage.SnoozedUntil(a.ID, time.Now()); ok {
+		rows = append(rows, components.TableRow{Label: "Snoozed Until", Value: formatLocalTimeFull(until)})
+	}
+	if a.DelegatedTo != nil {
+		rows = append(rows, components.TableRow{Label: "Delegated To", Value: a.DelegatedToName})
+	}
+	return rows
+}
+
+// triageWhoLabel marks delegated approvals in the Who column.
+func (m InboxModel) triageWhoLabel(a api.Approval) string {
+	if a.DelegatedTo != nil {
+		return "→ " + a.DelegatedToName
+	}
+	return approvalWhoLabel(a)
+}
+
+// renderTriagePrompt renders the open snooze or delegate prompt.
+func (m InboxModel) renderTriagePrompt() string {
+	title := "Snooze Until (2h, 3d, 1w, tomorrow, 2026-01-31 14:00)"
+	if m.triagePrompt == triagePromptDelegate {
+		title = "Delegate To (person name, empty clears)"
+	}
+	return components.Indent(components.InputDialog(title, m.triageBuf), 1)
+}
+
+// parseSnoozeUntil turns a relative or absolute snooze value into a wake time.
+// Day-based values wake at the start of the working day.
+func parseSnoozeUntil(value string, now time.Time) (time.Time, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	morning := func(days int) time.Time {
+		d := now.AddDate(0, 0, days)
+		return time.Date(d.Year(), d.Month(), d.Day(), snoozeMorningHour, 0, 0, 0, now.Location())
+	}
+	var until time.Time
+	switch {
+	case value == "":
+		return time.Time{}, fmt.Errorf("snooze time is required")
+	case value == "tomorrow":
+		until = morning(1)
+	case strings.HasSuffix(value, "m") || strings.HasSuffix(value, "h"):
+		dur, err := time.ParseDuration(value)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid snooze duration %q", value)
+		}
+		until = now.Add(dur)
+	case strings.HasSuffix(value, "d") || strings.HasSuffix(value, "w"):
+		n, err := strconv.Atoi(value[:len(value)-1])
+		if err != nil || n <= 0 {
+			return time.Time{}, fmt.Errorf("invalid snooze duration %q", value)
+		}
+		if strings.HasSuffix(value, "w") {
+			n *= 7
+		}
+		until = morning(n)
+	default:
+		parsed, err := time.ParseInLocation("2006-01-02 15:04", value, now.Location())
+		if err != nil {
+			day, dayErr := time.ParseInLocation("2006-01-02", value, now.Location())
+			if dayErr != nil {
+				return time.Time{}, fmt.Errorf("invalid snooze time %q", value)
+			}
+			parsed = day.Add(snoozeMorningHour * time.Hour)
+		}
+		until = parsed
+	}
+	if !until.After(now) {
+		return time.Time{}, fmt.Errorf("snooze time must be in the future")
+	}
+	return until, nil
+}
+
+// approvalCountLabel returns "1 approval" or "N approvals".
+func approvalCountLabel(n int) string {
+	if n == 1 {
+		return "1 approval"
+	}
+	return fmt.Sprintf("%d approvals", n)
+}
@@ -0,0 +1,163 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// triageInbox returns an inbox with two approvals and empty triage state.
+func triageInbox(t *testing.T, client *api.Client) InboxModel {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	model := NewInboxModel(client)
+	model.width = 110
+	model.SetCurrentUser("ent-me")
+	model.SetTriage(nil)
+	model, _ = model.Update(approvalsLoadedMsg{items: []api.Approval{
+		{ID: "ap-1", RequestType: "create_entity", Status: "pending", CreatedAt: time.Now()},
+		{ID: "ap-2", RequestType: "update_entity", Status: "pending", CreatedAt: time.Now()},
+	}})
+	return model
+}
+
+func TestParseSnoozeUntil(t *testing.T) {
+	now := time.Date(2026, 10, 16, 15, 30, 0, 0, time.UTC)
+
+	until, err := parseSnoozeUntil("2h", now)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(2*time.Hour), until)
+
+	until, err = parseSnoozeUntil("tomorrow", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC), until)
+
+	until, err = parseSnoozeUntil("1w", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 23, 9, 0, 0, 0, time.UTC), until)
+
+	until, err = parseSnoozeUntil("2026-10-20 14:00", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 20, 14, 0, 0, 0, time.UTC), until)
+
+	for _, bad := range []string{"", "soon", "0d", "2026-10-01"} {
+		_, err := parseSnoozeUntil(bad, now)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestInboxSnoozeHidesUntilFilteredOrCleared(t *testing.T) {
+	model := triageInbox(t, nil)
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("z")})
+	require.Equal(t, triagePromptSnooze, model.triagePrompt)
+	model = typeText(model, "3d")
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	saved, ok := cmd().(inboxTriageSavedMsg)
+	require.True(t, ok)
+	assert.Contains(t, saved.notice, "Snoozed 1 approval")
+
+	assert.Len(t, model.filtered, 1)
+	assert.Contains(t, components.SanitizeText(model.View()), "snoozed: 1")
+
+	stored, err := config.LoadInboxState()
+	require.NoError(t, err)
+	_, ok = stored.SnoozedUntil("ap-1", time.Now())
+	assert.True(t, ok)
+
+	// is:snoozed shows only snoozed approvals; z on one wakes it.
+	model.filterBuf.Value = "is:snoozed"
+	model.applyFilter(true)
+	require.Len(t, model.filtered, 1)
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("z")})
+	assert.Equal(t, triagePromptNone, model.triagePrompt)
+	assert.Empty(t, model.filtered)
+}
+
+func TestInboxSnoozeAllShowsSnoozedEmptyState(t *testing.T) {
+	model := triageInbox(t, nil)
+	model.SetTriage(&config.InboxState{Snoozes: map[string]time.Time{
+		"ap-1": time.Now().Add(time.Hour),
+		"ap-2": time.Now().Add(time.Hour),
+	}})
+	model.applyFilter(true)
+	assert.Contains(t, components.SanitizeText(model.View()), "All 2 pending approvals are snoozed")
+}
+
+func TestInboxDelegateResolvesPersonAndFiltersDelegatedToMe(t *testing.T) {
+	var delegated []map[string]any
+	_, client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/entities":
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+				{"id": "ent-sam", "name": "Sam Lee", "type": "person"},
+				{"id": "ent-me", "name": "Alex", "type": "person"},
+			}}))
+		case "/api/approvals/ap-2/delegate":
+			assert.Equal(t, http.MethodPost, r.Method)
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			delegated = append(delegated, body)
+			approval := map[string]any{"id": "ap-2", "request_type": "update_entity", "status": "pending"}
+			if body["to"] != nil {
+				approval["delegated_to"] = body["to"]
+				approval["delegated_to_name"] = "Alex"
+			}
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": approval}))
+		default:
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+	})
+	model := triageInbox(t, client)
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	require.Equal(t, triagePromptDelegate, model.triagePrompt)
+	model = typeText(model, "alex")
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	model, cmd = model.Update(cmd())
+	require.NotNil(t, cmd)
+	saved, ok := cmd().(inboxTriageSavedMsg)
+	require.True(t, ok)
+	assert.Equal(t, "Delegated 1 approval to Alex.", saved.notice)
+
+	require.Len(t, delegated, 1)
+	assert.Equal(t, "ent-me", delegated[0]["to"])
+	assert.Contains(t, components.SanitizeText(model.View()), "→ Alex")
+
+	model.filterBuf.Value = "is:delegated"
+	model.applyFilter(true)
+	require.Len(t, model.filtered, 1)
+	item, _ := model.selectedItem()
+	assert.Equal(t, "ap-2", item.ID)
+
+	// An empty name clears the delegation on the server.
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	assert.Equal(t, "Alex", model.triageBuf.Value)
+	model.triageBuf.Reset()
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model, _ = model.Update(cmd())
+	require.Len(t, delegated, 2)
+	assert.Nil(t, delegated[1]["to"])
+	assert.Empty(t, model.filtered)
+
+	// Unknown names surface an error instead of delegating.
+	model.filterBuf.Value = ""
+	model.applyFilter(true)
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	model.triageBuf.Value = "Nobody"
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	_, isErr := cmd().(errMsg)
+	assert.True(t, isErr)
+	assert.Len(t, delegated, 2)
+}
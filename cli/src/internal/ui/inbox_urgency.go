@@ -0,0 +1,129 @@
+package ui
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+const (
+	// approvalAgingAfter is when a pending approval without a deadline starts
+	// to show as waiting.
+	approvalAgingAfter = 4 * time.Hour
+	// approvalSLA is when a pending approval without a deadline is overdue.
+	approvalSLA = 24 * time.Hour
+	// approvalExpiringWithin is how close a deadline must be to flag it.
+	approvalExpiringWithin = 4 * time.Hour
+)
+
+// approvalDeadlineKeys are the change_details fields an agent may set to give
+// an approval a deadline.
+var approvalDeadlineKeys = []string{"expires_at", "deadline"}
+
+type approvalUrgency int
+
+const (
+	urgencyNone approvalUrgency = iota
+	urgencyWaiting
+	urgencyExpiring
+	urgencyOverdue
+)
+
+// approvalDeadline returns the approval's deadline, if the request set one.
+func approvalDeadline(a api.Approval) (time.Time, bool) {
+	for _, key := range approvalDeadlineKeys {
+		raw, _ := a.ChangeDetails[key].(string)
+		if raw = strings.TrimSpace(raw); raw == "" {
+			continue
+		}
+		if t, err := time.Parse(time.RFC3339, raw); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// approvalUrgencyAt grades a pending approval by its deadline, or by its age
+// against approvalSLA when it has none.
+func approvalUrgencyAt(a api.Approval, now time.Time) approvalUrgency {
+	if deadline, ok := approvalDeadline(a); ok {
+		switch left := deadline.Sub(now); {
+		case left <= 0:
+			return urgencyOverdue
+		case left <= approvalExpiringWithin:
+			return urgencyExpiring
+		}
+		return urgencyNone
+	}
+	switch age := now.Sub(a.CreatedAt); {
+	case age >= approvalSLA:
+		return urgencyOverdue
+	case age >= approvalAgingAfter:
+		return urgencyWaiting
+	}
+	return urgencyNone
+}
+
+// approvalDueLabel renders an approval's deadline or age. The leading word
+// carries the urgency so approvalDueStyle can color the cell.
+func approvalDueLabel(a api.Approval, now time.Time) string {
+	if deadline, ok := approvalDeadline(a); ok {
+		left := deadline.Sub(now)
+		switch {
+		case left <= 0:
+			return "overdue " + humanizeAge(-left)
+		case left <= approvalExpiringWithin:
+			return "expires in " + humanizeAge(left)
+		}
+		return "due " + formatLocalTimeCompact(deadline)
+	}
+	age := max(now.Sub(a.CreatedAt), 0)
+	switch approvalUrgencyAt(a, now) {
+	case urgencyOverdue:
+		return "overdue " + humanizeAge(age)
+	case urgencyWaiting:
+		return "waiting " + humanizeAge(age)
+	}
+	return humanizeAge(age)
+}
+
+// approvalDueStyle colors a Due cell by the urgency its label leads with.
+func approvalDueStyle(label string) lipgloss.Style {
+	switch {
+	case strings.HasPrefix(label, "overdue"):
+		return ErrorStyle
+	case strings.HasPrefix(label, "expires"), strings.HasPrefix(label, "waiting"):
+		return WarningStyle
+	}
+	return lipgloss.NewStyle()
+}
+
+// sortByUrgency orders approval indexes overdue first, then expiring, then
+// waiting. Within a grade the soonest deadline or oldest request leads, and
+// the rest keep their order.
+func sortByUrgency(items []api.Approval, idx []int, now time.Time) {
+	sort.SliceStable(idx, func(i, j int) bool {
+		a, b := items[idx[i]], items[idx[j]]
+		ua, ub := approvalUrgencyAt(a, now), approvalUrgencyAt(b, now)
+		if ua != ub {
+			return ua > ub
+		}
+		if ua == urgencyNone {
+			return false
+		}
+		return approvalDueAt(a).Before(approvalDueAt(b))
+	})
+}
+
+// approvalDueAt is when an approval falls due: its deadline, or the end of
+// the SLA window.
+func approvalDueAt(a api.Approval) time.Time {
+	if deadline, ok := approvalDeadline(a); ok {
+		return deadline
+	}
+	return a.CreatedAt.Add(approvalSLA)
+}
@@ -0,0 +1,61 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestApprovalDueLabelByDeadlineAndAge(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	withDeadline := func(at time.Time) api.Approval {
+		return api.Approval{CreatedAt: now, ChangeDetails: api.JSONMap{"expires_at": at.Format(time.RFC3339)}}
+	}
+
+	assert.Equal(t, "expires in 2h", approvalDueLabel(withDeadline(now.Add(2*time.Hour)), now))
+	assert.Equal(t, "overdue 30m", approvalDueLabel(withDeadline(now.Add(-30*time.Minute)), now))
+	assert.Contains(t, approvalDueLabel(withDeadline(now.Add(72*time.Hour)), now), "due ")
+	assert.Equal(t, "10m", approvalDueLabel(api.Approval{CreatedAt: now.Add(-10 * time.Minute)}, now))
+	assert.Equal(t, "waiting 6h", approvalDueLabel(api.Approval{CreatedAt: now.Add(-6 * time.Hour)}, now))
+	assert.Equal(t, "overdue 2d", approvalDueLabel(api.Approval{CreatedAt: now.Add(-48 * time.Hour)}, now))
+
+	assert.Equal(t, ErrorStyle, approvalDueStyle("overdue 2d"))
+	assert.Equal(t, WarningStyle, approvalDueStyle("expires in 2h"))
+}
+
+func TestInboxSortsByUrgencyAndFiltersOverdue(t *testing.T) {
+	now := time.Now()
+	model := NewInboxModel(nil)
+	model.width = 140
+	model, _ = model.Update(approvalsLoadedMsg{items: []api.Approval{
+		{ID: "fresh", RequestType: "create_entity", Status: "pending", CreatedAt: now.Add(-5 * time.Minute)},
+		{ID: "waiting", RequestType: "create_entity", Status: "pending", CreatedAt: now.Add(-6 * time.Hour)},
+		{ID: "expiring", RequestType: "create_entity", Status: "pending", CreatedAt: now,
+			ChangeDetails: api.JSONMap{"deadline": now.Add(90 * time.Minute).Format(time.RFC3339)}},
+		{ID: "stale", RequestType: "create_entity", Status: "pending", CreatedAt: now.Add(-30 * time.Hour)},
+	}})
+
+	order := make([]string, len(model.filtered))
+	for i, idx := range model.filtered {
+		order[i] = model.items[idx].ID
+	}
+	assert.Equal(t, []string{"stale", "expiring", "waiting", "fresh"}, order)
+	view := components.SanitizeText(model.View())
+	assert.Contains(t, view, "Due")
+	assert.Contains(t, view, "overdue 1d")
+	assert.Contains(t, view, "expires in 1h")
+
+	model.filterBuf.Value = "overdue:true"
+	model.applyFilter(true)
+	require.Len(t, model.filtered, 1)
+	assert.Equal(t, "stale", model.items[model.filtered[0]].ID)
+
+	model.filterBuf.Value = "overdue:false"
+	model.applyFilter(true)
+	assert.Len(t, model.filtered, 3)
+}
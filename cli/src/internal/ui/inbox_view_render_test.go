@@ -106,7 +106,7 @@ func TestInboxFilterInputAppliesAndClears(t *testing.T) {
 	for _, r := range "agent:openai" {
 		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
 	}
-	assert.Equal(t, "agent:openai", model.filterBuf)
+	assert.Equal(t, "agent:openai", model.filterBuf.Value)
 	assert.Len(t, model.filtered, 1)
 
 	// Enter applies and exits filtering.
@@ -118,7 +118,7 @@ func TestInboxFilterInputAppliesAndClears(t *testing.T) {
 	model.filtering = true
 	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
 	assert.False(t, model.filtering)
-	assert.Equal(t, "", model.filterBuf)
+	assert.Equal(t, "", model.filterBuf.Value)
 	assert.Len(t, model.filtered, 2)
 }
 
@@ -2,21 +2,30 @@ package ui
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
 	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
 )
 
+var jobAssigneeIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
 // --- Messages ---
 
-type jobsLoadedMsg struct{ items []api.Job }
+type jobsLoadedMsg struct {
+	items  []api.Job
+	queued time.Time
+}
 type jobStatusUpdatedMsg struct{}
 type subtaskCreatedMsg struct{}
 type jobCreatedMsg struct{}
+type jobChecklistUpdatedMsg struct{ job *api.Job }
 type jobRelationshipChangedMsg struct{}
 type jobsScopesLoadedMsg struct{ options []string }
 type jobRelationshipsLoadedMsg struct {
@@ -36,8 +45,12 @@ const (
 const (
 	jobFieldTitle = iota
 	jobFieldDescription
+	jobFieldType
 	jobFieldStatus
 	jobFieldPriority
+	jobFieldAssignee
+	jobFieldDue
+	jobFieldChecklist
 	jobFieldMetadata
 	jobFieldCount
 )
@@ -46,6 +59,8 @@ const (
 	jobEditFieldStatus = iota
 	jobEditFieldDescription
 	jobEditFieldPriority
+	jobEditFieldAssignee
+	jobEditFieldDue
 	jobEditFieldMetadata
 	jobEditFieldCount
 )
@@ -62,42 +77,57 @@ type JobsModel struct {
 	list            *components.List
 	selected        map[string]bool
 	loading         bool
+	loadLatency     time.Duration
 	detail          *api.Job
 	detailRels      []api.Relationship
 	filtering       bool
-	searchBuf       string
+	searchBuf       components.TextInput
 	searchSuggest   string
 	view            jobsView
 	modeFocus       bool
 	changingSt      bool
-	statusBuf       string
+	statusBuf       components.TextInput
 	statusTargets   []string
 	creatingSubtask bool
-	subtaskBuf      string
+	subtaskBuf      components.TextInput
 	linkingRel      bool
-	linkBuf         string
+	linkBuf         components.TextInput
 	unlinkingRel    bool
-	unlinkBuf       string
+	unlinkBuf       components.TextInput
+	checklistEdit   bool
+	checklistBuf    components.TextInput
+	cancelling      bool
+	cancelBuf       components.TextInput
 	metaExpanded    bool
 	width           int
 	height          int
 	scopeOptions    []string
+	currentUserID   string
 
 	// add
 	addFields      []formField
 	addFocus       int
+	addTypeIdx     int
 	addStatusIdx   int
 	addPriorityIdx int
 	addMeta        MetadataEditor
 	addSaving      bool
 	addSaved       bool
 	addErr         string
+	addTemplate    templatePicker
+	addSubtasks    []string
+	tmplPending    *config.Template
+	tmplValues     map[string]string
+	tmplParamIdx   int
+	tmplParamBuf   components.TextInput
 
 	// edit
 	editFocus       int
 	editStatusIdx   int
 	editPriorityIdx int
-	editDesc        string
+	editDesc        components.TextInput
+	editAssignee    components.TextInput
+	editDue         components.TextInput
 	editMeta        MetadataEditor
 	editSaving      bool
 }
@@ -112,13 +142,22 @@ func NewJobsModel(client *api.Client) JobsModel {
 		addFields: []formField{
 			{label: "Title"},
 			{label: "Description"},
+			{label: "Type"},
 			{label: "Status"},
 			{label: "Priority"},
+			{label: "Assignee"},
+			{label: "Due"},
+			{label: "Checklist"},
 			{label: "Metadata"},
 		},
 	}
 }
 
+// SetCurrentUser sets the entity id used as the default human task assignee.
+func (m *JobsModel) SetCurrentUser(entityID string) {
+	m.currentUserID = strings.TrimSpace(entityID)
+}
+
 // Init handles init.
 func (m JobsModel) Init() tea.Cmd {
 	m.loading = true
@@ -126,6 +165,7 @@ func (m JobsModel) Init() tea.Cmd {
 	m.modeFocus = false
 	m.metaExpanded = false
 	m.addFocus = 0
+	m.addTypeIdx = statusIndex(jobTypeOptions, jobTypeAgent)
 	m.addStatusIdx = statusIndex(jobStatusOptions, "pending")
 	m.addPriorityIdx = statusIndex(jobPriorityOptions, "")
 	m.addMeta.Reset()
@@ -133,7 +173,7 @@ func (m JobsModel) Init() tea.Cmd {
 	m.addSaved = false
 	m.addErr = ""
 	m.filtering = false
-	m.searchBuf = ""
+	m.searchBuf.Reset()
 	m.searchSuggest = ""
 	m.selected = map[string]bool{}
 	m.statusTargets = nil
@@ -146,6 +186,7 @@ func (m JobsModel) Update(msg tea.Msg) (JobsModel, tea.Cmd) {
 	switch msg := msg.(type) {
 	case jobsLoadedMsg:
 		m.loading = false
+		m.loadLatency = loadElapsed(msg.queued)
 		m.allItems = msg.items
 		m.applyJobSearch()
 		return m, m.loadScopeOptions()
@@ -168,13 +209,38 @@ func (m JobsModel) Update(msg tea.Msg) (JobsModel, tea.Cmd) {
 	case jobStatusUpdatedMsg:
 		m.detail = nil
 		m.changingSt = false
-		m.statusBuf = ""
+		m.statusBuf.Reset()
 		m.statusTargets = nil
 		return m, m.loadJobs
+	case jobChecklistUpdatedMsg:
+		if msg.job == nil {
+			return m, nil
+		}
+		if m.detail != nil && m.detail.ID == msg.job.ID {
+			updated := *msg.job
+			m.detail = &updated
+		}
+		for i := range m.allItems {
+			if m.allItems[i].ID == msg.job.ID {
+				m.allItems[i] = *msg.job
+			}
+		}
+		m.applyJobSearch()
+		return m, nil
+	case jobCancelledMsg:
+		m.applyJobCancelled(msg)
+		return m, nil
+	case jobRetriedMsg:
+		retry := msg.job
+		m.detail = &retry
+		m.detailRels = nil
+		m.view = jobsViewDetail
+		m.loading = true
+		return m, tea.Batch(m.loadJobs, m.loadDetailRelationships(retry.ID))
 	case subtaskCreatedMsg:
 		m.detail = nil
 		m.creatingSubtask = false
-		m.subtaskBuf = ""
+		m.subtaskBuf.Reset()
 		return m, m.loadJobs
 	case jobCreatedMsg:
 		m.addSaving = false
@@ -190,6 +256,8 @@ func (m JobsModel) Update(msg tea.Msg) (JobsModel, tea.Cmd) {
 		m.creatingSubtask = false
 		m.linkingRel = false
 		m.unlinkingRel = false
+		m.checklistEdit = false
+		m.cancelling = false
 		m.addErr = msg.err.Error()
 		return m, nil
 
@@ -211,6 +279,15 @@ func (m JobsModel) Update(msg tea.Msg) (JobsModel, tea.Cmd) {
 		if m.unlinkingRel {
 			return m.handleUnlinkInput(msg)
 		}
+		if m.checklistEdit {
+			return m.handleChecklistInput(msg)
+		}
+		if m.cancelling {
+			return m.handleCancelInput(msg)
+		}
+		if m.tmplPending != nil {
+			return m.handleTemplateParamInput(msg)
+		}
 		if m.changingSt {
 			return m.handleStatusInput(msg)
 		}
@@ -254,10 +331,22 @@ func (m JobsModel) View() string {
 			1,
 		)
 	}
+	if m.checklistEdit && m.detail != nil {
+		return components.Indent(
+			components.InputDialog("Checklist (row # to toggle, text to add)", m.checklistBuf),
+			1,
+		)
+	}
 
 	if m.changingSt {
 		return components.Indent(components.InputDialog("New Status (pending/active/completed/failed)", m.statusBuf), 1)
 	}
+	if m.tmplPending != nil && m.view == jobsViewAdd {
+		return m.renderTemplateParamPrompt()
+	}
+	if m.cancelling && m.detail != nil {
+		return components.Indent(components.InputDialog("Cancel Job (reason)", m.cancelBuf), 1)
+	}
 	if m.filtering && m.view == jobsViewList {
 		return components.Indent(components.InputDialog("Filter Jobs", m.searchBuf), 1)
 	}
@@ -332,8 +421,13 @@ func (m JobsModel) toggleMode() (JobsModel, tea.Cmd) {
 
 func (m JobsModel) renderList() string {
 	if m.loading {
-		return MutedStyle.Render("Loading jobs...")
+		return renderLoadingList("jobs", len(m.items), m.list, m.width, m.renderListBody)
 	}
+	return m.renderListBody()
+}
+
+// renderListBody renders the list without the loading state.
+func (m JobsModel) renderListBody() string {
 	if len(m.items) == 0 {
 		return components.EmptyStateBox(
 			"Jobs",
@@ -359,23 +453,25 @@ func (m JobsModel) renderList() string {
 		sepWidth = lipgloss.Width(b)
 	}
 
-	// 4 columns -> 3 separators.
-	availableCols := tableWidth - (3 * sepWidth)
+	// 5 columns -> 4 separators.
+	availableCols := tableWidth - (4 * sepWidth)
 	if availableCols < 30 {
 		availableCols = 30
 	}
 
 	statusWidth := 12
 	prioWidth := 10
+	dueWidth := compactTimeColumnWidth
 	atWidth := compactTimeColumnWidth
-	titleWidth := availableCols - (statusWidth + prioWidth + atWidth)
+	titleWidth := availableCols - (statusWidth + prioWidth + dueWidth + atWidth)
 	if titleWidth < 12 {
 		titleWidth = 12
 	}
 	cols := []components.TableColumn{
 		{Header: "Title", Width: titleWidth, Align: lipgloss.Left},
-		{Header: "Status", Width: statusWidth, Align: lipgloss.Left},
+		{Header: "Status", Width: statusWidth, Align: lipgloss.Left, CellStyle: jobStatusCellStyle},
 		{Header: "Priority", Width: prioWidth, Align: lipgloss.Left},
+		{Header: "Due", Width: dueWidth, Align: lipgloss.Left},
 		{Header: "At", Width: atWidth, Align: lipgloss.Left},
 	}
 
@@ -397,6 +493,9 @@ func (m JobsModel) renderList() string {
 		if status == "" {
 			status = "-"
 		}
+		if isJobOverdue(j, time.Now()) {
+			status = "overdue"
+		}
 		priority := "-"
 		if j.Priority != nil && strings.TrimSpace(*j.Priority) != "" {
 			priority = strings.TrimSpace(components.SanitizeOneLine(*j.Priority))
@@ -410,6 +509,12 @@ func (m JobsModel) renderList() string {
 			activeRowRel = len(tableRows)
 		}
 		titleValue := components.SanitizeOneLine(j.Title)
+		if isHumanTask(j) {
+			titleValue = "@ " + titleValue
+			if done, total := checklistProgress(jobChecklist(j)); total > 0 {
+				titleValue = fmt.Sprintf("%s (%d/%d)", titleValue, done, total)
+			}
+		}
 		if len(m.selected) > 0 {
 			if m.selected[j.ID] {
 				titleValue = "[X] " + titleValue
@@ -422,6 +527,7 @@ func (m JobsModel) renderList() string {
 			components.ClampTextWidthEllipsis(titleValue, titleWidth),
 			components.ClampTextWidthEllipsis(status, statusWidth),
 			components.ClampTextWidthEllipsis(priority, prioWidth),
+			jobDueCell(j),
 			formatLocalTimeCompact(at),
 		})
 	}
@@ -430,16 +536,19 @@ func (m JobsModel) renderList() string {
 	}
 	title := "Jobs"
 	countLine := fmt.Sprintf("%d total", len(m.items))
+	if summary := humanTaskSummary(m.allItems, time.Now()); summary != "" {
+		countLine = fmt.Sprintf("%s · %s", countLine, summary)
+	}
 	if selected := m.selectedCount(); selected > 0 {
 		countLine = fmt.Sprintf("%s · selected: %d", countLine, selected)
 	}
-	if strings.TrimSpace(m.searchBuf) != "" {
-		countLine = fmt.Sprintf("%s · search: %s", countLine, strings.TrimSpace(m.searchBuf))
-		if m.searchSuggest != "" && !strings.EqualFold(strings.TrimSpace(m.searchBuf), strings.TrimSpace(m.searchSuggest)) {
+	if strings.TrimSpace(m.searchBuf.Value) != "" {
+		countLine = fmt.Sprintf("%s · search: %s", countLine, strings.TrimSpace(m.searchBuf.Value))
+		if m.searchSuggest != "" && !strings.EqualFold(strings.TrimSpace(m.searchBuf.Value), strings.TrimSpace(m.searchSuggest)) {
 			countLine = fmt.Sprintf("%s · next: %s", countLine, strings.TrimSpace(m.searchSuggest))
 		}
 	}
-	countLine = MutedStyle.Render(countLine)
+	countLine = MutedStyle.Render(countLine) + renderLoadLatency(m.loadLatency)
 
 	table := components.TableGridWithActiveRow(cols, tableRows, tableWidth, activeRowRel)
 	preview := ""
@@ -493,6 +602,14 @@ func (m JobsModel) renderJobPreview(j api.Job, width int) string {
 
 	lines = append(lines, renderPreviewRow("Status", status, width))
 	lines = append(lines, renderPreviewRow("Priority", priority, width))
+	if isHumanTask(j) {
+		lines = append(lines, renderPreviewRow("Type", jobTypeHuman, width))
+		lines = append(lines, renderPreviewRow("Assignee", jobAssigneeLabel(j, m.currentUserID), width))
+		lines = append(lines, renderPreviewRow("Due", jobDueLabel(j, time.Now()), width))
+		if done, total := checklistProgress(jobChecklist(j)); total > 0 {
+			lines = append(lines, renderPreviewRow("Checklist", fmt.Sprintf("%d/%d done", done, total), width))
+		}
+	}
 	lines = append(lines, renderPreviewRow("At", formatLocalTimeCompact(at), width))
 	if m.detail != nil && m.detail.ID == j.ID && len(m.detailRels) > 0 {
 		lines = append(lines, renderPreviewRow("Links", fmt.Sprintf("%d", len(m.detailRels)), width))
@@ -502,7 +619,7 @@ func (m JobsModel) renderJobPreview(j api.Job, width int) string {
 		desc := truncateString(strings.TrimSpace(components.SanitizeText(*j.Description)), 120)
 		lines = append(lines, renderPreviewRow("Notes", desc, width))
 	}
-	if metaPreview := metadataPreview(map[string]any(j.Metadata), 80); metaPreview != "" {
+	if metaPreview := metadataPreview(stripJobChecklist(map[string]any(j.Metadata)), 80); metaPreview != "" {
 		lines = append(lines, renderPreviewRow("Preview", metaPreview, width))
 	}
 
@@ -539,32 +656,32 @@ func (m JobsModel) handleListKeys(msg tea.KeyMsg) (JobsModel, tea.Cmd) {
 		m.filtering = true
 		return m, nil
 	case isKey(msg, "backspace", "delete"):
-		if len(m.searchBuf) > 0 {
-			m.searchBuf = m.searchBuf[:len(m.searchBuf)-1]
+		if m.searchBuf.Value != "" {
+			m.searchBuf.Backspace()
 			m.applyJobSearch()
 		}
 	case isKey(msg, "cmd+backspace", "cmd+delete", "ctrl+u"):
-		if m.searchBuf != "" {
-			m.searchBuf = ""
+		if m.searchBuf.Value != "" {
+			m.searchBuf.Reset()
 			m.searchSuggest = ""
 			m.applyJobSearch()
 		}
 	case isBack(msg):
-		if m.searchBuf != "" {
-			m.searchBuf = ""
+		if m.searchBuf.Value != "" {
+			m.searchBuf.Reset()
 			m.searchSuggest = ""
 			m.applyJobSearch()
 		}
 	case isKey(msg, "tab"):
-		if m.searchSuggest != "" && !strings.EqualFold(strings.TrimSpace(m.searchBuf), strings.TrimSpace(m.searchSuggest)) {
-			m.searchBuf = m.searchSuggest
+		if m.searchSuggest != "" && !strings.EqualFold(strings.TrimSpace(m.searchBuf.Value), strings.TrimSpace(m.searchSuggest)) {
+			m.searchBuf.SetValue(m.searchSuggest)
 			m.applyJobSearch()
 		}
 	case isKey(msg, "s"):
 		targets := m.selectedIDs()
 		if len(targets) > 0 {
 			m.changingSt = true
-			m.statusBuf = ""
+			m.statusBuf.Reset()
 			m.statusTargets = targets
 			return m, nil
 		}
@@ -573,13 +690,12 @@ func (m JobsModel) handleListKeys(msg tea.KeyMsg) (JobsModel, tea.Cmd) {
 			m.detail = &item
 			m.view = jobsViewDetail
 			m.changingSt = true
-			m.statusBuf = ""
+			m.statusBuf.Reset()
 			m.statusTargets = []string{item.ID}
 		}
 	default:
-		ch := msg.String()
-		if len(ch) == 1 || ch == " " {
-			m.searchBuf += ch
+		if text, ok := typedText(msg); ok {
+			m.searchBuf.Insert(text)
 			m.applyJobSearch()
 		}
 	}
@@ -593,21 +709,13 @@ func (m JobsModel) handleFilterInput(msg tea.KeyMsg) (JobsModel, tea.Cmd) {
 		m.filtering = false
 	case isBack(msg):
 		m.filtering = false
-		m.searchBuf = ""
+		m.searchBuf.Reset()
 		m.searchSuggest = ""
 		m.applyJobSearch()
-	case isKey(msg, "backspace", "delete"):
-		if len(m.searchBuf) > 0 {
-			m.searchBuf = m.searchBuf[:len(m.searchBuf)-1]
-			m.applyJobSearch()
-		}
+	case isSpace(msg) && m.searchBuf.Value == "":
+		return m, nil
 	default:
-		ch := msg.String()
-		if len(ch) == 1 || ch == " " {
-			if ch == " " && m.searchBuf == "" {
-				return m, nil
-			}
-			m.searchBuf += ch
+		if editChanged(&m.searchBuf, msg) {
 			m.applyJobSearch()
 		}
 	}
@@ -620,6 +728,12 @@ func (m JobsModel) handleAddKeys(msg tea.KeyMsg) (JobsModel, tea.Cmd) {
 	if m.addSaving {
 		return m, nil
 	}
+	if next, handled := m.handleAddTemplateKeys(msg); handled {
+		return next, nil
+	}
+	if jobAddFieldIsText(m.addFocus) && m.addFields[m.addFocus].HandleKey(msg) {
+		return m, nil
+	}
 	switch {
 	case isDown(msg):
 		m.addFocus = (m.addFocus + 1) % jobFieldCount
@@ -633,18 +747,15 @@ func (m JobsModel) handleAddKeys(msg tea.KeyMsg) (JobsModel, tea.Cmd) {
 		return m.saveAdd()
 	case isBack(msg):
 		m.resetAddForm()
-	case isKey(msg, "backspace"):
-		if m.addFocus == jobFieldMetadata {
-			return m, nil
-		}
-		if m.addFocus == jobFieldTitle || m.addFocus == jobFieldDescription {
-			f := &m.addFields[m.addFocus]
-			if len(f.value) > 0 {
-				f.value = f.value[:len(f.value)-1]
-			}
-		}
 	default:
 		switch m.addFocus {
+		case jobFieldType:
+			switch {
+			case isKey(msg, "left"):
+				m.addTypeIdx = (m.addTypeIdx - 1 + len(jobTypeOptions)) % len(jobTypeOptions)
+			case isKey(msg, "right"), isSpace(msg):
+				m.addTypeIdx = (m.addTypeIdx + 1) % len(jobTypeOptions)
+			}
 		case jobFieldStatus:
 			switch {
 			case isKey(msg, "left"):
@@ -663,11 +774,6 @@ func (m JobsModel) handleAddKeys(msg tea.KeyMsg) (JobsModel, tea.Cmd) {
 			if isEnter(msg) {
 				m.addMeta.Active = true
 			}
-		default:
-			ch := msg.String()
-			if len(ch) == 1 || ch == " " {
-				m.addFields[m.addFocus].value += ch
-			}
 		}
 	}
 	return m, nil
@@ -683,9 +789,22 @@ func (m JobsModel) renderAdd() string {
 	}
 
 	var b strings.Builder
+	if picker := m.addTemplate.Render(); picker != "" {
+		b.WriteString(picker)
+		b.WriteString("\n\n")
+	}
 	for i, f := range m.addFields {
 		label := f.label
 		switch i {
+		case jobFieldType:
+			jobType := jobTypeOptions[m.addTypeIdx]
+			if i == m.addFocus {
+				b.WriteString(SelectedStyle.Render("  " + label + ":"))
+			} else {
+				b.WriteString(MutedStyle.Render("  " + label + ":"))
+			}
+			b.WriteString("\n")
+			b.WriteString(NormalStyle.Render("  " + jobType))
 		case jobFieldStatus:
 			status := jobStatusOptions[m.addStatusIdx]
 			if i == m.addFocus {
@@ -724,12 +843,11 @@ func (m JobsModel) renderAdd() string {
 			if i == m.addFocus {
 				b.WriteString(SelectedStyle.Render("  " + label + ":"))
 				b.WriteString("\n")
-				b.WriteString(NormalStyle.Render("  " + f.value))
-				b.WriteString(AccentStyle.Render("█"))
+				b.WriteString(NormalStyle.Render("  " + renderInput(f.TextInput, m.width)))
 			} else {
 				b.WriteString(MutedStyle.Render("  " + label + ":"))
 				b.WriteString("\n")
-				val := f.value
+				val := f.Value
 				if val == "" {
 					val = "-"
 				}
@@ -741,6 +859,10 @@ func (m JobsModel) renderAdd() string {
 			b.WriteString("\n\n")
 		}
 	}
+	if subtasks := m.renderAddSubtasks(); subtasks != "" {
+		b.WriteString("\n\n")
+		b.WriteString(subtasks)
+	}
 
 	if m.addErr != "" {
 		b.WriteString("\n\n")
@@ -751,14 +873,24 @@ func (m JobsModel) renderAdd() string {
 
 // saveAdd handles save add.
 func (m JobsModel) saveAdd() (JobsModel, tea.Cmd) {
-	title := strings.TrimSpace(m.addFields[jobFieldTitle].value)
+	title := strings.TrimSpace(m.addFields[jobFieldTitle].Value)
 	if title == "" {
 		m.addErr = "Title is required"
 		return m, nil
 	}
-	desc := strings.TrimSpace(m.addFields[jobFieldDescription].value)
+	desc := strings.TrimSpace(m.addFields[jobFieldDescription].Value)
+	jobType := jobTypeOptions[m.addTypeIdx]
 	status := jobStatusOptions[m.addStatusIdx]
 	priority := strings.TrimSpace(jobPriorityOptions[m.addPriorityIdx])
+	assignee := strings.TrimSpace(m.addFields[jobFieldAssignee].Value)
+	if jobType == jobTypeHuman && (assignee == "" || strings.EqualFold(assignee, "me")) {
+		assignee = m.currentUserID
+	}
+	due, err := parseJobDueInput(m.addFields[jobFieldDue].Value)
+	if err != nil {
+		m.addErr = err.Error()
+		return m, nil
+	}
 
 	meta, err := parseMetadataInput(m.addMeta.Buffer)
 	if err != nil {
@@ -766,20 +898,33 @@ func (m JobsModel) saveAdd() (JobsModel, tea.Cmd) {
 		return m, nil
 	}
 	meta = mergeMetadataScopes(meta, m.addMeta.Scopes)
+	if checklist := parseChecklistInput(m.addFields[jobFieldChecklist].Value); len(checklist) > 0 {
+		meta = withJobChecklist(meta, checklist)
+	}
 
 	input := api.CreateJobInput{
 		Title:       title,
 		Description: desc,
 		Status:      status,
 		Priority:    priority,
+		JobType:     jobType,
+		AssignedTo:  assignee,
+		DueAt:       due,
 		Metadata:    meta,
 	}
 
+	subtasks := append([]string(nil), m.addSubtasks...)
 	m.addSaving = true
 	return m, func() tea.Msg {
-		if _, err := m.client.CreateJob(input); err != nil {
+		job, err := m.client.CreateJob(input)
+		if err != nil {
 			return errMsg{err}
 		}
+		for _, title := range subtasks {
+			if _, err := m.client.CreateSubtask(job.ID, map[string]string{"title": title}); err != nil {
+				return errMsg{fmt.Errorf("create subtask %q: %w", title, err)}
+			}
+		}
 		return jobCreatedMsg{}
 	}
 }
@@ -790,11 +935,17 @@ func (m *JobsModel) resetAddForm() {
 	m.addSaving = false
 	m.addErr = ""
 	m.addFocus = 0
+	m.addTypeIdx = statusIndex(jobTypeOptions, jobTypeAgent)
 	m.addStatusIdx = statusIndex(jobStatusOptions, "pending")
 	m.addPriorityIdx = statusIndex(jobPriorityOptions, "")
 	m.addMeta.Reset()
+	m.addTemplate.Reset()
+	m.addSubtasks = nil
+	m.tmplPending = nil
+	m.tmplValues = nil
+	m.tmplParamBuf.Reset()
 	for i := range m.addFields {
-		m.addFields[i].value = ""
+		m.addFields[i].Reset()
 	}
 }
 
@@ -807,9 +958,12 @@ func (m *JobsModel) startEdit() {
 	m.editFocus = jobEditFieldStatus
 	m.editStatusIdx = statusIndex(jobStatusOptions, m.detail.Status)
 	m.editPriorityIdx = statusIndex(jobPriorityOptions, valueOrEmpty(m.detail.Priority))
-	m.editDesc = valueOrEmpty(m.detail.Description)
+	m.editDesc = components.NewTextInput(valueOrEmpty(m.detail.Description))
+	m.editAssignee = components.NewTextInput(valueOrEmpty(m.detail.AssignedTo))
+	m.editDue = components.NewTextInput(formatJobDueInput(m.detail.DueAt))
 	m.editMeta.Reset()
-	m.editMeta.Load(map[string]any(m.detail.Metadata))
+	// The checklist is edited from the detail view; keep it out of the raw editor.
+	m.editMeta.Load(stripJobChecklist(map[string]any(m.detail.Metadata)))
 	m.editSaving = false
 }
 
@@ -818,6 +972,9 @@ func (m JobsModel) handleEditKeys(msg tea.KeyMsg) (JobsModel, tea.Cmd) {
 	if m.editSaving {
 		return m, nil
 	}
+	if in := m.editInput(m.editFocus); in != nil && in.HandleKey(msg) {
+		return m, nil
+	}
 	switch {
 	case isDown(msg):
 		m.editFocus = (m.editFocus + 1) % jobEditFieldCount
@@ -849,21 +1006,25 @@ func (m JobsModel) handleEditKeys(msg tea.KeyMsg) (JobsModel, tea.Cmd) {
 			if isEnter(msg) {
 				m.editMeta.Active = true
 			}
-		case jobEditFieldDescription:
-			switch {
-			case isKey(msg, "backspace"):
-				m.editDesc = dropLastRune(m.editDesc)
-			default:
-				ch := msg.String()
-				if len(ch) == 1 || ch == " " {
-					m.editDesc += ch
-				}
-			}
 		}
 	}
 	return m, nil
 }
 
+// editInput returns the edit form text input at focus, or nil when that
+// field is not free text.
+func (m *JobsModel) editInput(focus int) *components.TextInput {
+	switch focus {
+	case jobEditFieldDescription:
+		return &m.editDesc
+	case jobEditFieldAssignee:
+		return &m.editAssignee
+	case jobEditFieldDue:
+		return &m.editDue
+	}
+	return nil
+}
+
 // renderEdit renders render edit.
 func (m JobsModel) renderEdit() string {
 	var b strings.Builder
@@ -886,12 +1047,11 @@ func (m JobsModel) renderEdit() string {
 	if m.editFocus == jobEditFieldDescription {
 		b.WriteString(SelectedStyle.Render("  Description:"))
 		b.WriteString("\n")
-		b.WriteString(NormalStyle.Render("  " + m.editDesc))
-		b.WriteString(AccentStyle.Render("█"))
+		b.WriteString(NormalStyle.Render("  " + renderInput(m.editDesc, m.width)))
 	} else {
 		b.WriteString(MutedStyle.Render("  Description:"))
 		b.WriteString("\n")
-		val := m.editDesc
+		val := m.editDesc.Value
 		if val == "" {
 			val = "-"
 		}
@@ -917,6 +1077,31 @@ func (m JobsModel) renderEdit() string {
 
 	b.WriteString("\n\n")
 
+	// Assignee and due date
+	for _, field := range []struct {
+		focus int
+		label string
+		value components.TextInput
+	}{
+		{jobEditFieldAssignee, "Assignee", m.editAssignee},
+		{jobEditFieldDue, "Due", m.editDue},
+	} {
+		if m.editFocus == field.focus {
+			b.WriteString(SelectedStyle.Render("  " + field.label + ":"))
+			b.WriteString("\n")
+			b.WriteString(NormalStyle.Render("  " + renderInput(field.value, m.width)))
+		} else {
+			b.WriteString(MutedStyle.Render("  " + field.label + ":"))
+			b.WriteString("\n")
+			val := field.value.Value
+			if val == "" {
+				val = "-"
+			}
+			b.WriteString(NormalStyle.Render("  " + val))
+		}
+		b.WriteString("\n\n")
+	}
+
 	// Metadata
 	if m.editFocus == jobEditFieldMetadata {
 		b.WriteString(SelectedStyle.Render("  Metadata:"))
@@ -941,30 +1126,86 @@ func (m JobsModel) saveEdit() (JobsModel, tea.Cmd) {
 	}
 	status := jobStatusOptions[m.editStatusIdx]
 	priority := strings.TrimSpace(jobPriorityOptions[m.editPriorityIdx])
-	desc := strings.TrimSpace(m.editDesc)
+	desc := strings.TrimSpace(m.editDesc.Value)
+	assignee := strings.TrimSpace(m.editAssignee.Value)
+	due, err := parseJobDueInput(m.editDue.Value)
+	if err != nil {
+		m.addErr = err.Error()
+		return m, nil
+	}
 	meta, err := parseMetadataInput(m.editMeta.Buffer)
 	if err != nil {
 		m.addErr = err.Error()
 		return m, nil
 	}
 	meta = mergeMetadataScopes(meta, m.editMeta.Scopes)
+	if checklist := jobChecklist(*m.detail); len(checklist) > 0 {
+		meta = withJobChecklist(meta, checklist)
+	}
 
 	input := api.UpdateJobInput{
 		Status:      &status,
 		Priority:    &priority,
 		Description: &desc,
 		Metadata:    meta,
+		// Blanking a field that had a value clears it on the server.
+		ClearAssignee: assignee == "" && strings.TrimSpace(valueOrEmpty(m.detail.AssignedTo)) != "",
+		ClearDue:      due == "" && m.detail.DueAt != nil,
+	}
+	if due != "" {
+		input.DueAt = &due
 	}
 
+	client, currentUserID := m.client, m.currentUserID
 	m.editSaving = true
 	return m, func() tea.Msg {
-		if _, err := m.client.UpdateJob(m.detail.ID, input); err != nil {
+		if assignee != "" {
+			id, err := resolveJobAssignee(client, assignee, currentUserID)
+			if err != nil {
+				return errMsg{err}
+			}
+			input.AssignedTo = &id
+		}
+		if _, err := client.UpdateJob(m.detail.ID, input); err != nil {
 			return errMsg{err}
 		}
 		return jobStatusUpdatedMsg{}
 	}
 }
 
+// resolveJobAssignee turns the assignee field into the entity id the server
+// expects: "me" is the current user, an id is kept as is, and anything else
+// has to match exactly one entity by name.
+func resolveJobAssignee(client *api.Client, who, currentUserID string) (string, error) {
+	if strings.EqualFold(who, "me") {
+		if currentUserID == "" {
+			return "", fmt.Errorf("no current user to assign")
+		}
+		return currentUserID, nil
+	}
+	if jobAssigneeIDPattern.MatchString(who) {
+		return who, nil
+	}
+	matches, err := client.QueryEntities(api.QueryParams{"search_text": who, "limit": "20"})
+	if err != nil {
+		return "", err
+	}
+	id := ""
+	for _, entity := range matches {
+		if !strings.EqualFold(strings.TrimSpace(entity.Name), who) {
+			continue
+		}
+		if id != "" && id != entity.ID {
+			return "", fmt.Errorf("more than one entity named %q, use its id", who)
+		}
+		id = entity.ID
+	}
+	if id == "" {
+		return "", fmt.Errorf("no entity named %q to assign", who)
+	}
+	return id, nil
+}
+
 // valueOrEmpty handles value or empty.
 func valueOrEmpty(value *string) string {
 	if value == nil {
@@ -976,11 +1217,12 @@ func valueOrEmpty(value *string) string {
 // --- Helpers ---
 
 func (m JobsModel) loadJobs() tea.Msg {
+	queued := time.Now()
 	items, err := m.client.QueryJobs(nil)
 	if err != nil {
-		return errMsg{err}
+		return loadFailed(tabJobs, err, m.loadJobs)
 	}
-	return jobsLoadedMsg{items}
+	return jobsLoadedMsg{items: items, queued: queued}
 }
 
 // loadScopeOptions loads load scope options.
@@ -1003,7 +1245,7 @@ func (m JobsModel) loadScopeOptions() tea.Cmd {
 
 // applyJobSearch handles apply job search.
 func (m *JobsModel) applyJobSearch() {
-	query := strings.TrimSpace(strings.ToLower(m.searchBuf))
+	query := strings.TrimSpace(strings.ToLower(m.searchBuf.Value))
 	if query == "" {
 		m.items = m.allItems
 	} else {
@@ -1104,7 +1346,7 @@ func (m JobsModel) selectedCount() int {
 // updateSearchSuggest updates update search suggest.
 func (m *JobsModel) updateSearchSuggest() {
 	m.searchSuggest = ""
-	query := strings.ToLower(strings.TrimSpace(m.searchBuf))
+	query := strings.ToLower(strings.TrimSpace(m.searchBuf.Value))
 	if query == "" {
 		return
 	}
@@ -1129,22 +1371,29 @@ func (m JobsModel) handleDetailKeys(msg tea.KeyMsg) (JobsModel, tea.Cmd) {
 		m.view = jobsViewList
 	case isKey(msg, "s"):
 		m.changingSt = true
-		m.statusBuf = ""
+		m.statusBuf.Reset()
 		m.statusTargets = []string{m.detail.ID}
 	case isKey(msg, "n"):
 		m.creatingSubtask = true
-		m.subtaskBuf = ""
+		m.subtaskBuf.Reset()
 	case isKey(msg, "l"):
 		m.linkingRel = true
-		m.linkBuf = ""
+		m.linkBuf.Reset()
 	case isKey(msg, "u"):
 		m.unlinkingRel = true
-		m.unlinkBuf = ""
+		m.unlinkBuf.Reset()
+	case isKey(msg, "c"):
+		m.checklistEdit = true
+		m.checklistBuf.Reset()
 	case isKey(msg, "e"):
 		m.startEdit()
 		m.view = jobsViewEdit
 	case isKey(msg, "m"):
 		m.metaExpanded = !m.metaExpanded
+	case isKey(msg, "x"):
+		return m.startCancel()
+	case isKey(msg, "y"):
+		return m, m.retryJob()
 	}
 	return m, nil
 }
@@ -1154,22 +1403,22 @@ func (m JobsModel) handleStatusInput(msg tea.KeyMsg) (JobsModel, tea.Cmd) {
 	switch {
 	case isBack(msg):
 		m.changingSt = false
-		m.statusBuf = ""
+		m.statusBuf.Reset()
 		m.statusTargets = nil
 	case isEnter(msg):
 		ids := append([]string(nil), m.statusTargets...)
 		if len(ids) == 0 && m.detail != nil {
 			ids = []string{m.detail.ID}
 		}
-		status := strings.TrimSpace(m.statusBuf)
+		status := strings.TrimSpace(m.statusBuf.Value)
 		if len(ids) == 0 || status == "" {
 			m.changingSt = false
-			m.statusBuf = ""
+			m.statusBuf.Reset()
 			m.statusTargets = nil
 			return m, nil
 		}
 		m.changingSt = false
-		m.statusBuf = ""
+		m.statusBuf.Reset()
 		m.statusTargets = nil
 		m.selected = map[string]bool{}
 		return m, func() tea.Msg {
@@ -1180,14 +1429,8 @@ func (m JobsModel) handleStatusInput(msg tea.KeyMsg) (JobsModel, tea.Cmd) {
 			}
 			return jobStatusUpdatedMsg{}
 		}
-	case isKey(msg, "backspace"):
-		if len(m.statusBuf) > 0 {
-			m.statusBuf = m.statusBuf[:len(m.statusBuf)-1]
-		}
 	default:
-		if len(msg.String()) == 1 || msg.String() == " " {
-			m.statusBuf += msg.String()
-		}
+		m.statusBuf.HandleKey(msg)
 	}
 	return m, nil
 }
@@ -1197,20 +1440,20 @@ func (m JobsModel) handleSubtaskInput(msg tea.KeyMsg) (JobsModel, tea.Cmd) {
 	switch {
 	case isBack(msg):
 		m.creatingSubtask = false
-		m.subtaskBuf = ""
+		m.subtaskBuf.Reset()
 	case isEnter(msg):
-		title := strings.TrimSpace(m.subtaskBuf)
+		title := strings.TrimSpace(m.subtaskBuf.Value)
 		if title == "" {
 			return m, nil
 		}
 		if m.detail == nil {
 			m.creatingSubtask = false
-			m.subtaskBuf = ""
+			m.subtaskBuf.Reset()
 			return m, nil
 		}
 		id := m.detail.ID
 		m.creatingSubtask = false
-		m.subtaskBuf = ""
+		m.subtaskBuf.Reset()
 		return m, func() tea.Msg {
 			_, err := m.client.CreateSubtask(id, map[string]string{"title": title})
 			if err != nil {
@@ -1218,14 +1461,8 @@ func (m JobsModel) handleSubtaskInput(msg tea.KeyMsg) (JobsModel, tea.Cmd) {
 			}
 			return subtaskCreatedMsg{}
 		}
-	case isKey(msg, "backspace"):
-		if len(m.subtaskBuf) > 0 {
-			m.subtaskBuf = m.subtaskBuf[:len(m.subtaskBuf)-1]
-		}
 	default:
-		if len(msg.String()) == 1 || msg.String() == " " {
-			m.subtaskBuf += msg.String()
-		}
+		m.subtaskBuf.HandleKey(msg)
 	}
 	return m, nil
 }
@@ -1235,17 +1472,17 @@ func (m JobsModel) handleLinkInput(msg tea.KeyMsg) (JobsModel, tea.Cmd) {
 	switch {
 	case isBack(msg):
 		m.linkingRel = false
-		m.linkBuf = ""
+		m.linkBuf.Reset()
 	case isEnter(msg):
 		if m.detail == nil {
 			m.linkingRel = false
-			m.linkBuf = ""
+			m.linkBuf.Reset()
 			return m, nil
 		}
-		parts := strings.Fields(strings.TrimSpace(m.linkBuf))
+		parts := strings.Fields(strings.TrimSpace(m.linkBuf.Value))
 		if len(parts) < 3 {
 			m.linkingRel = false
-			m.linkBuf = ""
+			m.linkBuf.Reset()
 			return m, func() tea.Msg {
 				return errMsg{err: fmt.Errorf("link format: target_type target_id relationship_type")}
 			}
@@ -1255,7 +1492,7 @@ func (m JobsModel) handleLinkInput(msg tea.KeyMsg) (JobsModel, tea.Cmd) {
 		relType := strings.TrimSpace(strings.Join(parts[2:], " "))
 		jobID := m.detail.ID
 		m.linkingRel = false
-		m.linkBuf = ""
+		m.linkBuf.Reset()
 		return m, func() tea.Msg {
 			_, err := m.client.CreateRelationship(api.CreateRelationshipInput{
 				SourceType: "job",
@@ -1269,14 +1506,8 @@ func (m JobsModel) handleLinkInput(msg tea.KeyMsg) (JobsModel, tea.Cmd) {
 			}
 			return jobRelationshipChangedMsg{}
 		}
-	case isKey(msg, "backspace"):
-		if len(m.linkBuf) > 0 {
-			m.linkBuf = m.linkBuf[:len(m.linkBuf)-1]
-		}
 	default:
-		if len(msg.String()) == 1 || msg.String() == " " {
-			m.linkBuf += msg.String()
-		}
+		m.linkBuf.HandleKey(msg)
 	}
 	return m, nil
 }
@@ -1286,17 +1517,17 @@ func (m JobsModel) handleUnlinkInput(msg tea.KeyMsg) (JobsModel, tea.Cmd) {
 	switch {
 	case isBack(msg):
 		m.unlinkingRel = false
-		m.unlinkBuf = ""
+		m.unlinkBuf.Reset()
 	case isEnter(msg):
 		if m.detail == nil {
 			m.unlinkingRel = false
-			m.unlinkBuf = ""
+			m.unlinkBuf.Reset()
 			return m, nil
 		}
-		value := strings.TrimSpace(m.unlinkBuf)
+		value := strings.TrimSpace(m.unlinkBuf.Value)
 		if value == "" {
 			m.unlinkingRel = false
-			m.unlinkBuf = ""
+			m.unlinkBuf.Reset()
 			return m, nil
 		}
 		relID := value
@@ -1305,7 +1536,7 @@ func (m JobsModel) handleUnlinkInput(msg tea.KeyMsg) (JobsModel, tea.Cmd) {
 		}
 		status := "archived"
 		m.unlinkingRel = false
-		m.unlinkBuf = ""
+		m.unlinkBuf.Reset()
 		return m, func() tea.Msg {
 			_, err := m.client.UpdateRelationship(relID, api.UpdateRelationshipInput{Status: &status})
 			if err != nil {
@@ -1313,18 +1544,60 @@ func (m JobsModel) handleUnlinkInput(msg tea.KeyMsg) (JobsModel, tea.Cmd) {
 			}
 			return jobRelationshipChangedMsg{}
 		}
-	case isKey(msg, "backspace"):
-		if len(m.unlinkBuf) > 0 {
-			m.unlinkBuf = m.unlinkBuf[:len(m.unlinkBuf)-1]
-		}
 	default:
-		if len(msg.String()) == 1 || msg.String() == " " {
-			m.unlinkBuf += msg.String()
+		m.unlinkBuf.HandleKey(msg)
+	}
+	return m, nil
+}
+
+// handleChecklistInput toggles a checklist row by number or appends a new item.
+func (m JobsModel) handleChecklistInput(msg tea.KeyMsg) (JobsModel, tea.Cmd) {
+	switch {
+	case isBack(msg):
+		m.checklistEdit = false
+		m.checklistBuf.Reset()
+	case isEnter(msg):
+		value := strings.TrimSpace(m.checklistBuf.Value)
+		m.checklistEdit = false
+		m.checklistBuf.Reset()
+		if m.detail == nil || value == "" {
+			return m, nil
+		}
+		items := jobChecklist(*m.detail)
+		if idx := parsePositiveListIndex(value); idx > 0 {
+			if idx > len(items) {
+				return m, func() tea.Msg {
+					return errMsg{err: fmt.Errorf("checklist row %d does not exist", idx)}
+				}
+			}
+			items[idx-1].Done = !items[idx-1].Done
+		} else {
+			items = append(items, parseChecklistInput(value)...)
 		}
+		jobID := m.detail.ID
+		meta := withJobChecklist(map[string]any(m.detail.Metadata), items)
+		return m, func() tea.Msg {
+			job, err := m.client.UpdateJob(jobID, api.UpdateJobInput{Metadata: meta})
+			if err != nil {
+				return errMsg{err}
+			}
+			return jobChecklistUpdatedMsg{job: job}
+		}
+	default:
+		m.checklistBuf.HandleKey(msg)
 	}
 	return m, nil
 }
 
+// jobAddFieldIsText reports whether an add form field accepts free text.
+func jobAddFieldIsText(field int) bool {
+	switch field {
+	case jobFieldTitle, jobFieldDescription, jobFieldAssignee, jobFieldDue, jobFieldChecklist:
+		return true
+	}
+	return false
+}
+
 // parsePositiveListIndex parses parse positive list index.
 func parsePositiveListIndex(value string) int {
 	if value == "" {
@@ -1353,9 +1626,27 @@ func (m JobsModel) renderDetail() string {
 		{Label: "Title", Value: j.Title},
 		{Label: "Status", Value: j.Status},
 	}
+	if j.StatusReason != nil && strings.TrimSpace(*j.StatusReason) != "" {
+		rows = append(rows, components.TableRow{Label: "Reason", Value: components.SanitizeOneLine(*j.StatusReason)})
+	}
+	if retryOf := jobRetryOf(*j); retryOf != "" {
+		rows = append(rows, components.TableRow{Label: "Retry Of", Value: retryOf})
+	}
 	if j.Priority != nil && strings.TrimSpace(*j.Priority) != "" {
 		rows = append(rows, components.TableRow{Label: "Priority", Value: *j.Priority})
 	}
+	if isHumanTask(*j) {
+		rows = append(rows, components.TableRow{Label: "Type", Value: jobTypeHuman})
+		rows = append(rows, components.TableRow{Label: "Assignee", Value: jobAssigneeLabel(*j, m.currentUserID)})
+	}
+	if j.DueAt != nil && !j.DueAt.IsZero() {
+		due := components.TableRow{Label: "Due", Value: formatLocalTimeFull(*j.DueAt)}
+		if isJobOverdue(*j, time.Now()) {
+			due.Value += " (overdue)"
+			due.ValueColor = string(ColorWarning)
+		}
+		rows = append(rows, due)
+	}
 	rows = append(rows, components.TableRow{Label: "Created", Value: formatLocalTimeFull(j.CreatedAt)})
 	if !j.UpdatedAt.IsZero() {
 		rows = append(rows, components.TableRow{Label: "Updated", Value: formatLocalTimeFull(j.UpdatedAt)})
@@ -1373,8 +1664,12 @@ func (m JobsModel) renderDetail() string {
 		)
 	}
 
-	if len(j.Metadata) > 0 {
-		metaTable := renderMetadataBlock(map[string]any(j.Metadata), m.width, m.metaExpanded)
+	if checklist := jobChecklist(*j); len(checklist) > 0 {
+		sections = append(sections, renderChecklistBlock(checklist, m.width))
+	}
+
+	if meta := stripJobChecklist(map[string]any(j.Metadata)); len(meta) > 0 {
+		metaTable := renderMetadataBlock(meta, m.width, m.metaExpanded)
 		if metaTable != "" {
 			sections = append(sections, metaTable)
 		}
@@ -1382,6 +1677,9 @@ func (m JobsModel) renderDetail() string {
 	if len(m.detailRels) > 0 {
 		sections = append(sections, renderRelationshipSummaryTable("job", j.ID, m.detailRels, 6, m.width))
 	}
+	if attachments := renderAttachments("job", j.ID, m.detailRels, m.width); attachments != "" {
+		sections = append(sections, attachments)
+	}
 
 	return strings.Join(sections, "\n\n")
 }
@@ -0,0 +1,99 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+type jobCancelledMsg struct {
+	id     string
+	reason string
+}
+
+type jobRetriedMsg struct{ job api.Job }
+
+// startCancel opens the reason prompt for cancelling the open job.
+func (m JobsModel) startCancel() (JobsModel, tea.Cmd) {
+	if m.detail == nil {
+		return m, nil
+	}
+	if strings.EqualFold(m.detail.Status, api.JobStatusAbandoned) {
+		return m, func() tea.Msg { return errMsg{fmt.Errorf("job %s is already cancelled", m.detail.ID)} }
+	}
+	m.cancelling = true
+	m.cancelBuf.Reset()
+	return m, nil
+}
+
+// handleCancelInput handles the cancel reason prompt. A reason is required so
+// the job records why it stopped.
+func (m JobsModel) handleCancelInput(msg tea.KeyMsg) (JobsModel, tea.Cmd) {
+	switch {
+	case isBack(msg):
+		m.cancelling = false
+		m.cancelBuf.Reset()
+	case isEnter(msg):
+		reason := strings.TrimSpace(m.cancelBuf.Value)
+		if reason == "" || m.detail == nil {
+			return m, nil
+		}
+		id := m.detail.ID
+		m.cancelling = false
+		m.cancelBuf.Reset()
+		return m, func() tea.Msg {
+			if _, err := m.client.CancelJob(id, reason); err != nil {
+				return errMsg{err}
+			}
+			return jobCancelledMsg{id: id, reason: reason}
+		}
+	default:
+		m.cancelBuf.HandleKey(msg)
+	}
+	return m, nil
+}
+
+// retryJob creates a linked retry of the open job.
+func (m JobsModel) retryJob() tea.Cmd {
+	if m.detail == nil {
+		return nil
+	}
+	id := m.detail.ID
+	return func() tea.Msg {
+		retry, err := m.client.RetryJob(id)
+		if err != nil {
+			return errMsg{err}
+		}
+		return jobRetriedMsg{job: *retry}
+	}
+}
+
+// applyJobCancelled marks a job abandoned locally; the status endpoint does
+// not return the full job.
+func (m *JobsModel) applyJobCancelled(msg jobCancelledMsg) {
+	reason := msg.reason
+	mark := func(j *api.Job) {
+		j.Status = api.JobStatusAbandoned
+		j.StatusReason = &reason
+	}
+	if m.detail != nil && m.detail.ID == msg.id {
+		updated := *m.detail
+		mark(&updated)
+		m.detail = &updated
+	}
+	for i := range m.allItems {
+		if m.allItems[i].ID == msg.id {
+			mark(&m.allItems[i])
+		}
+	}
+	m.applyJobSearch()
+}
+
+// jobRetryOf returns the job a retry was created from, if any.
+func jobRetryOf(j api.Job) string {
+	id, _ := j.Metadata["retry_of"].(string)
+	return strings.TrimSpace(id)
+}
@@ -0,0 +1,92 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestJobsCancelRequiresReasonAndRecordsIt(t *testing.T) {
+	var body map[string]any
+	_, client := testJobsClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/jobs/job-1/status", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "job-1"}}))
+	})
+
+	job := api.Job{ID: "job-1", Title: "Nightly import", Status: "active"}
+	model := NewJobsModel(client)
+	model.width = 100
+	model.allItems = []api.Job{job}
+	model.applyJobSearch()
+	model.detail = &job
+	model.view = jobsViewDetail
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	require.True(t, model.cancelling)
+
+	// Enter on an empty reason keeps the prompt open.
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Nil(t, cmd)
+	assert.True(t, model.cancelling)
+
+	for _, r := range "superseded" {
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	model, _ = model.Update(cmd())
+
+	assert.False(t, model.cancelling)
+	assert.Equal(t, "abandoned", body["status"])
+	assert.Equal(t, "superseded", body["status_reason"])
+	assert.Equal(t, api.JobStatusAbandoned, model.detail.Status)
+	assert.Equal(t, api.JobStatusAbandoned, model.allItems[0].Status)
+	assert.Contains(t, components.SanitizeText(model.View()), "superseded")
+}
+
+func TestJobsRetryOpensLinkedRetryJob(t *testing.T) {
+	_, client := testJobsClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/jobs/job-1" && r.Method == http.MethodGet:
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+				"id": "job-1", "title": "Nightly import", "status": "failed",
+			}}))
+		case r.URL.Path == "/api/jobs" && r.Method == http.MethodPost:
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+				"id": "job-2", "title": "Nightly import", "status": "pending",
+				"metadata": map[string]any{"retry_of": "job-1", "retry_attempt": 1},
+			}}))
+		case r.URL.Path == "/api/relationships" && r.Method == http.MethodPost:
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "rel-1"}}))
+		default:
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []any{}}))
+		}
+	})
+
+	job := api.Job{ID: "job-1", Title: "Nightly import", Status: "failed"}
+	model := NewJobsModel(client)
+	model.width = 100
+	model.detail = &job
+	model.view = jobsViewDetail
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	require.NotNil(t, cmd)
+	msg := cmd()
+	require.IsType(t, jobRetriedMsg{}, msg)
+	model, _ = model.Update(msg)
+
+	require.NotNil(t, model.detail)
+	assert.Equal(t, "job-2", model.detail.ID)
+	assert.Equal(t, jobsViewDetail, model.view)
+	view := components.SanitizeText(model.View())
+	assert.Contains(t, view, "Retry Of")
+	assert.Contains(t, view, "job-1")
+}
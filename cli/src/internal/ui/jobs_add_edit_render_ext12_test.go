@@ -56,7 +56,7 @@ func TestJobsHandleAddKeysAdditionalBranches(t *testing.T) {
 
 	// ctrl+s branch (returns save cmd without executing)
 	updated.addFocus = jobFieldTitle
-	updated.addFields[jobFieldTitle].value = "Ship tests"
+	updated.addFields[jobFieldTitle].Value = "Ship tests"
 	updated, cmd = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyCtrlS})
 	require.NotNil(t, cmd)
 	assert.True(t, updated.addSaving)
@@ -65,11 +65,11 @@ func TestJobsHandleAddKeysAdditionalBranches(t *testing.T) {
 	updated.addSaving = false
 	updated.addSaved = true
 	updated.addErr = "boom"
-	updated.addFields[jobFieldDescription].value = "desc"
+	updated.addFields[jobFieldDescription].Value = "desc"
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyEsc})
 	assert.False(t, updated.addSaved)
 	assert.Equal(t, "", updated.addErr)
-	assert.Equal(t, "", updated.addFields[jobFieldDescription].value)
+	assert.Equal(t, "", updated.addFields[jobFieldDescription].Value)
 }
 
 func TestJobsRenderAddAndEditStateBranches(t *testing.T) {
@@ -102,7 +102,7 @@ func TestJobsRenderAddAndEditStateBranches(t *testing.T) {
 	model.view = jobsViewEdit
 	model.editFocus = jobEditFieldPriority
 	model.editPriorityIdx = 0
-	model.editDesc = ""
+	model.editDesc.Value = ""
 	model.editSaving = true
 	editOut := components.SanitizeText(model.renderEdit())
 	assert.Contains(t, editOut, "Priority")
@@ -114,14 +114,14 @@ func TestJobsRenderAddAndEditStateBranches(t *testing.T) {
 func TestJobsHandleSubtaskInputNilDetailEnterIsSafe(t *testing.T) {
 	model := NewJobsModel(nil)
 	model.creatingSubtask = true
-	model.subtaskBuf = "Child task"
+	model.subtaskBuf.Value = "Child task"
 	model.detail = nil
 
 	require.NotPanics(t, func() {
 		updated, cmd := model.handleSubtaskInput(tea.KeyMsg{Type: tea.KeyEnter})
 		assert.Nil(t, cmd)
 		assert.False(t, updated.creatingSubtask)
-		assert.Equal(t, "", updated.subtaskBuf)
+		assert.Equal(t, "", updated.subtaskBuf.Value)
 	})
 }
 
@@ -152,7 +152,7 @@ func TestJobsRenderEditDescriptionFocusAndMetaFallbackBranches(t *testing.T) {
 	model.startEdit()
 
 	model.editFocus = jobEditFieldDescription
-	model.editDesc = "ship this"
+	model.editDesc.Value = "ship this"
 	model.editMeta.Buffer = ""
 	model.editMeta.Scopes = nil
 
@@ -182,8 +182,8 @@ func TestJobsHandleEditKeysDownUpNavigationBranches(t *testing.T) {
 func TestJobsSaveAddCommandReturnsErrMsgOnCreateFailure(t *testing.T) {
 	client := api.NewClient("http://127.0.0.1:9", "test-key", 20*time.Millisecond)
 	model := NewJobsModel(client)
-	model.addFields[jobFieldTitle].value = "Ship tests"
-	model.addFields[jobFieldDescription].value = "desc"
+	model.addFields[jobFieldTitle].Value = "Ship tests"
+	model.addFields[jobFieldDescription].Value = "desc"
 	model.addStatusIdx = 0
 	model.addPriorityIdx = 1
 	model.addMeta.Buffer = "profile | owner | alxx"
@@ -38,7 +38,7 @@ func TestJobsHandleListKeysDelegatesWhenFiltering(t *testing.T) {
 
 	updated, cmd := model.handleListKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
 	require.Nil(t, cmd)
-	assert.Equal(t, "a", updated.searchBuf)
+	assert.Equal(t, "a", updated.searchBuf.Value)
 	assert.True(t, updated.filtering)
 }
 
@@ -55,14 +55,14 @@ func TestJobsHandleStatusInputFallsBackToDetailWhenTargetsEmpty(t *testing.T) {
 
 	model := NewJobsModel(client)
 	model.detail = &api.Job{ID: "job-1", Title: "Alpha", Status: "pending"}
-	model.statusBuf = "done"
+	model.statusBuf.Value = "done"
 	model.statusTargets = nil
 	model.changingSt = true
 
 	updated, cmd := model.handleStatusInput(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
 	assert.False(t, updated.changingSt)
-	assert.Equal(t, "", updated.statusBuf)
+	assert.Equal(t, "", updated.statusBuf.Value)
 	assert.Nil(t, updated.statusTargets)
 
 	msg := cmd()
@@ -89,7 +89,7 @@ func TestJobsSaveEditReturnsErrMsgOnUpdateFailure(t *testing.T) {
 	model := NewJobsModel(client)
 	model.detail = &api.Job{ID: "job-1", Title: "Alpha", Status: "pending"}
 	model.startEdit()
-	model.editDesc = "note"
+	model.editDesc.Value = "note"
 	model.editMeta.Buffer = `{"owner":"ops"}`
 
 	updated, cmd := model.saveEdit()
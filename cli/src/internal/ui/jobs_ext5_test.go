@@ -33,12 +33,12 @@ func TestJobsHandleDetailKeysMatrix(t *testing.T) {
 	updated, cmd = base.handleDetailKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'l'}})
 	require.Nil(t, cmd)
 	assert.True(t, updated.linkingRel)
-	assert.Equal(t, "", updated.linkBuf)
+	assert.Equal(t, "", updated.linkBuf.Value)
 
 	updated, cmd = base.handleDetailKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'u'}})
 	require.Nil(t, cmd)
 	assert.True(t, updated.unlinkingRel)
-	assert.Equal(t, "", updated.unlinkBuf)
+	assert.Equal(t, "", updated.unlinkBuf.Value)
 
 	updated, cmd = base.handleDetailKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
 	require.Nil(t, cmd)
@@ -103,20 +103,20 @@ func TestJobsHandleLinkAndUnlinkInputAdditionalBranches(t *testing.T) {
 
 	// Back exits link mode.
 	model.linkingRel = true
-	model.linkBuf = "entity ent-1 owns"
+	model.linkBuf.Value = "entity ent-1 owns"
 	updated, cmd := model.handleLinkInput(tea.KeyMsg{Type: tea.KeyEsc})
 	require.Nil(t, cmd)
 	assert.False(t, updated.linkingRel)
-	assert.Equal(t, "", updated.linkBuf)
+	assert.Equal(t, "", updated.linkBuf.Value)
 
 	// Default append branch.
 	updated.linkingRel = true
 	updated, cmd = updated.handleLinkInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
 	require.Nil(t, cmd)
-	assert.Equal(t, "e", updated.linkBuf)
+	assert.Equal(t, "e", updated.linkBuf.Value)
 
 	// Successful link create.
-	updated.linkBuf = "entity ent-1 owns"
+	updated.linkBuf.Value = "entity ent-1 owns"
 	updated, cmd = updated.handleLinkInput(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
 	msg := cmd()
@@ -129,23 +129,23 @@ func TestJobsHandleLinkAndUnlinkInputAdditionalBranches(t *testing.T) {
 
 	// Back exits unlink mode.
 	updated.unlinkingRel = true
-	updated.unlinkBuf = "1"
+	updated.unlinkBuf.Value = "1"
 	updated, cmd = updated.handleUnlinkInput(tea.KeyMsg{Type: tea.KeyEsc})
 	require.Nil(t, cmd)
 	assert.False(t, updated.unlinkingRel)
-	assert.Equal(t, "", updated.unlinkBuf)
+	assert.Equal(t, "", updated.unlinkBuf.Value)
 
 	// Enter with empty value exits without cmd.
 	updated.unlinkingRel = true
-	updated.unlinkBuf = "   "
+	updated.unlinkBuf.Value = "   "
 	updated, cmd = updated.handleUnlinkInput(tea.KeyMsg{Type: tea.KeyEnter})
 	require.Nil(t, cmd)
 	assert.False(t, updated.unlinkingRel)
-	assert.Equal(t, "", updated.unlinkBuf)
+	assert.Equal(t, "", updated.unlinkBuf.Value)
 
 	// List-index unlink maps row number to relationship ID.
 	updated.unlinkingRel = true
-	updated.unlinkBuf = "2"
+	updated.unlinkBuf.Value = "2"
 	updated, cmd = updated.handleUnlinkInput(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
 	msg = cmd()
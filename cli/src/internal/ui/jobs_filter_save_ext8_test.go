@@ -47,39 +47,39 @@ func TestJobsHandleFilterInputBranchMatrix(t *testing.T) {
 
 	updated, cmd := model.handleFilterInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 
 	updated, cmd = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
 	require.Nil(t, cmd)
-	assert.Equal(t, "a", updated.searchBuf)
+	assert.Equal(t, "a", updated.searchBuf.Value)
 	require.NotEmpty(t, updated.items)
 
 	updated, cmd = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 
 	updated, cmd = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyDelete})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 
 	updated, cmd = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyTab})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 
-	updated.searchBuf = "alpha"
+	updated.searchBuf.Value = "alpha"
 	updated.searchSuggest = "alpha"
 	updated, cmd = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyEsc})
 	require.Nil(t, cmd)
 	assert.False(t, updated.filtering)
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 	assert.Equal(t, "", updated.searchSuggest)
 
 	updated.filtering = true
-	updated.searchBuf = "x"
+	updated.searchBuf.Value = "x"
 	updated, cmd = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyEnter})
 	require.Nil(t, cmd)
 	assert.False(t, updated.filtering)
-	assert.Equal(t, "x", updated.searchBuf)
+	assert.Equal(t, "x", updated.searchBuf.Value)
 }
 
 func TestJobsHandleListKeysStatusAndSearchBranches(t *testing.T) {
@@ -92,35 +92,35 @@ func TestJobsHandleListKeysStatusAndSearchBranches(t *testing.T) {
 	model.applyJobSearch()
 	model.list.SetItems([]string{"alpha", "beta"})
 
-	model.searchBuf = "alp"
+	model.searchBuf.Value = "alp"
 	model.searchSuggest = "alpha"
 	updated, cmd := model.handleListKeys(tea.KeyMsg{Type: tea.KeyTab})
 	require.Nil(t, cmd)
-	assert.Equal(t, "alpha", updated.searchBuf)
+	assert.Equal(t, "alpha", updated.searchBuf.Value)
 
-	updated.searchBuf = "alpha"
+	updated.searchBuf.Value = "alpha"
 	updated.searchSuggest = "alpha"
 	updated, cmd = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyTab})
 	require.Nil(t, cmd)
-	assert.Equal(t, "alpha", updated.searchBuf)
+	assert.Equal(t, "alpha", updated.searchBuf.Value)
 
-	updated.searchBuf = "alpha"
+	updated.searchBuf.Value = "alpha"
 	updated.searchSuggest = "alpha"
 	updated, cmd = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyCtrlU})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 	assert.Equal(t, "", updated.searchSuggest)
 
-	updated.searchBuf = "ab"
+	updated.searchBuf.Value = "ab"
 	updated, cmd = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "a", updated.searchBuf)
+	assert.Equal(t, "a", updated.searchBuf.Value)
 
 	updated.view = jobsViewList
 	updated.items = append([]api.Job{}, updated.allItems...)
 	updated.list.SetItems([]string{"alpha", "beta"})
 	updated.list.Cursor = 0
-	updated.searchBuf = ""
+	updated.searchBuf.Value = ""
 	updated, cmd = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyEnter})
 	_ = cmd
 	assert.Equal(t, jobsViewDetail, updated.view)
@@ -155,7 +155,7 @@ func TestJobsSaveAddValidationMetadataAndSuccess(t *testing.T) {
 	require.Nil(t, cmd)
 	assert.Equal(t, "Title is required", updated.addErr)
 
-	updated.addFields[jobFieldTitle].value = "Ship it"
+	updated.addFields[jobFieldTitle].Value = "Ship it"
 	updated.addMeta.Buffer = "invalid"
 	updated, cmd = updated.saveAdd()
 	require.Nil(t, cmd)
@@ -174,8 +174,8 @@ func TestJobsSaveAddValidationMetadataAndSuccess(t *testing.T) {
 	})
 
 	model = NewJobsModel(client)
-	model.addFields[jobFieldTitle].value = "Ship it"
-	model.addFields[jobFieldDescription].value = "desc"
+	model.addFields[jobFieldTitle].Value = "Ship it"
+	model.addFields[jobFieldDescription].Value = "desc"
 	model.addStatusIdx = 1
 	model.addPriorityIdx = 2
 	model.addMeta.Buffer = "group | field | value"
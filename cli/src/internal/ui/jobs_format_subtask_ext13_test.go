@@ -16,7 +16,7 @@ func TestJobsStartEditNilDetailNoMutation(t *testing.T) {
 	model.editFocus = jobEditFieldPriority
 	model.editStatusIdx = 2
 	model.editPriorityIdx = 1
-	model.editDesc = "keep-me"
+	model.editDesc.Value = "keep-me"
 	model.editSaving = true
 
 	model.startEdit()
@@ -24,7 +24,7 @@ func TestJobsStartEditNilDetailNoMutation(t *testing.T) {
 	assert.Equal(t, jobEditFieldPriority, model.editFocus)
 	assert.Equal(t, 2, model.editStatusIdx)
 	assert.Equal(t, 1, model.editPriorityIdx)
-	assert.Equal(t, "keep-me", model.editDesc)
+	assert.Equal(t, "keep-me", model.editDesc.Value)
 	assert.True(t, model.editSaving)
 }
 
@@ -34,24 +34,24 @@ func TestJobsHandleSubtaskInputTypingBackspaceAndEmptyEnter(t *testing.T) {
 
 	updated, cmd := model.handleSubtaskInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
 	require.Nil(t, cmd)
-	assert.Equal(t, "a", updated.subtaskBuf)
+	assert.Equal(t, "a", updated.subtaskBuf.Value)
 
 	updated, cmd = updated.handleSubtaskInput(tea.KeyMsg{Type: tea.KeySpace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "a ", updated.subtaskBuf)
+	assert.Equal(t, "a ", updated.subtaskBuf.Value)
 
 	updated, cmd = updated.handleSubtaskInput(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "a", updated.subtaskBuf)
+	assert.Equal(t, "a", updated.subtaskBuf.Value)
 
 	updated, cmd = updated.handleSubtaskInput(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.subtaskBuf)
+	assert.Equal(t, "", updated.subtaskBuf.Value)
 
 	updated, cmd = updated.handleSubtaskInput(tea.KeyMsg{Type: tea.KeyEnter})
 	require.Nil(t, cmd)
 	assert.True(t, updated.creatingSubtask)
-	assert.Equal(t, "", updated.subtaskBuf)
+	assert.Equal(t, "", updated.subtaskBuf.Value)
 }
 
 func TestFormatJobLineIncludesPriorityAndMetadataPreview(t *testing.T) {
@@ -123,20 +123,20 @@ func TestJobsHandleLinkInputTypingBackspaceAndBack(t *testing.T) {
 
 	updated, cmd := model.handleLinkInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
 	require.Nil(t, cmd)
-	assert.Equal(t, "e", updated.linkBuf)
+	assert.Equal(t, "e", updated.linkBuf.Value)
 
 	updated, cmd = updated.handleLinkInput(tea.KeyMsg{Type: tea.KeySpace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "e ", updated.linkBuf)
+	assert.Equal(t, "e ", updated.linkBuf.Value)
 
 	updated, cmd = updated.handleLinkInput(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "e", updated.linkBuf)
+	assert.Equal(t, "e", updated.linkBuf.Value)
 
 	updated, cmd = updated.handleLinkInput(tea.KeyMsg{Type: tea.KeyEsc})
 	require.Nil(t, cmd)
 	assert.False(t, updated.linkingRel)
-	assert.Equal(t, "", updated.linkBuf)
+	assert.Equal(t, "", updated.linkBuf.Value)
 }
 
 func TestJobsToggleSelectAllNoItemsNoop(t *testing.T) {
@@ -149,28 +149,28 @@ func TestJobsToggleSelectAllNoItemsNoop(t *testing.T) {
 func TestJobsHandleSubtaskInputBackAndNoopKey(t *testing.T) {
 	model := NewJobsModel(nil)
 	model.creatingSubtask = true
-	model.subtaskBuf = "child"
+	model.subtaskBuf.Value = "child"
 
 	updated, cmd := model.handleSubtaskInput(tea.KeyMsg{Type: tea.KeyEsc})
 	require.Nil(t, cmd)
 	assert.False(t, updated.creatingSubtask)
-	assert.Equal(t, "", updated.subtaskBuf)
+	assert.Equal(t, "", updated.subtaskBuf.Value)
 
 	updated.creatingSubtask = true
-	updated.subtaskBuf = "x"
+	updated.subtaskBuf.Value = "x"
 	updated, cmd = updated.handleSubtaskInput(tea.KeyMsg{Type: tea.KeyTab})
 	require.Nil(t, cmd)
 	assert.True(t, updated.creatingSubtask)
-	assert.Equal(t, "x", updated.subtaskBuf)
+	assert.Equal(t, "x", updated.subtaskBuf.Value)
 }
 
 func TestJobsHandleLinkInputNoopKeyKeepsBuffer(t *testing.T) {
 	model := NewJobsModel(nil)
 	model.linkingRel = true
-	model.linkBuf = "entity ent-1 owns"
+	model.linkBuf.Value = "entity ent-1 owns"
 
 	updated, cmd := model.handleLinkInput(tea.KeyMsg{Type: tea.KeyTab})
 	require.Nil(t, cmd)
 	assert.True(t, updated.linkingRel)
-	assert.Equal(t, "entity ent-1 owns", updated.linkBuf)
+	assert.Equal(t, "entity ent-1 owns", updated.linkBuf.Value)
 }
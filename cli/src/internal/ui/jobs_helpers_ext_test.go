@@ -104,9 +104,9 @@ func TestJobsHandleAddKeysStatusPriorityAndMetadataBranches(t *testing.T) {
 	updated.addMeta.Active = false
 
 	updated.addFocus = jobFieldTitle
-	updated.addFields[jobFieldTitle].value = "abc"
+	updated.addFields[jobFieldTitle].Value = "abc"
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "ab", updated.addFields[jobFieldTitle].value)
+	assert.Equal(t, "ab", updated.addFields[jobFieldTitle].Value)
 
 	updated.addFocus = 0
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyUp})
@@ -131,9 +131,9 @@ func TestJobsHandleEditKeysStatusPriorityDescriptionMetadataAndBack(t *testing.T
 
 	updated.editFocus = jobEditFieldDescription
 	updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
-	assert.Contains(t, updated.editDesc, "x")
+	assert.Contains(t, updated.editDesc.Value, "x")
 	updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.NotContains(t, updated.editDesc, "x")
+	assert.NotContains(t, updated.editDesc.Value, "x")
 
 	updated.editFocus = jobEditFieldMetadata
 	updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyEnter})
@@ -183,13 +183,13 @@ func TestJobsHandleEditKeysAdditionalBranchMatrix(t *testing.T) {
 	updated.editMeta.Active = false
 
 	updated.editFocus = jobEditFieldDescription
-	updated.editDesc = "x"
+	updated.editDesc.Value = "x"
 	updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "", updated.editDesc)
+	assert.Equal(t, "", updated.editDesc.Value)
 	updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'z'}})
-	assert.Equal(t, "z", updated.editDesc)
+	assert.Equal(t, "z", updated.editDesc.Value)
 	updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyTab})
-	assert.Equal(t, "z", updated.editDesc)
+	assert.Equal(t, "z", updated.editDesc.Value)
 
 	updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyEsc})
 	assert.Equal(t, jobsViewDetail, updated.view)
@@ -198,19 +198,19 @@ func TestJobsHandleEditKeysAdditionalBranchMatrix(t *testing.T) {
 func TestJobsHandleStatusInputBranches(t *testing.T) {
 	model := NewJobsModel(nil)
 	model.changingSt = true
-	model.statusBuf = "act"
+	model.statusBuf.Value = "act"
 
 	updated, cmd := model.handleStatusInput(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "ac", updated.statusBuf)
+	assert.Equal(t, "ac", updated.statusBuf.Value)
 
-	updated.statusBuf = "active"
+	updated.statusBuf.Value = "active"
 	updated.statusTargets = nil
 	updated.detail = nil
 	updated, cmd = updated.handleStatusInput(tea.KeyMsg{Type: tea.KeyEnter})
 	assert.Nil(t, cmd)
 	assert.False(t, updated.changingSt)
-	assert.Empty(t, updated.statusBuf)
+	assert.Empty(t, updated.statusBuf.Value)
 }
 
 func TestJobsHandleStatusInputEnterWithTargetsReturnsCommand(t *testing.T) {
@@ -227,7 +227,7 @@ func TestJobsHandleStatusInputEnterWithTargetsReturnsCommand(t *testing.T) {
 
 	model := NewJobsModel(client)
 	model.changingSt = true
-	model.statusBuf = "active"
+	model.statusBuf.Value = "active"
 	model.statusTargets = []string{"job-1"}
 	model.selected = map[string]bool{"job-1": true}
 
@@ -244,7 +244,7 @@ func TestJobsHandleLinkInputInvalidAndBackspaceBranches(t *testing.T) {
 	model := NewJobsModel(nil)
 	model.detail = &api.Job{ID: "job-1"}
 	model.linkingRel = true
-	model.linkBuf = "entity-only"
+	model.linkBuf.Value = "entity-only"
 
 	updated, cmd := model.handleLinkInput(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
@@ -252,24 +252,24 @@ func TestJobsHandleLinkInputInvalidAndBackspaceBranches(t *testing.T) {
 	_, ok := msg.(errMsg)
 	require.True(t, ok)
 	assert.False(t, updated.linkingRel)
-	assert.Equal(t, "", updated.linkBuf)
+	assert.Equal(t, "", updated.linkBuf.Value)
 
 	updated.linkingRel = true
-	updated.linkBuf = "ab"
+	updated.linkBuf.Value = "ab"
 	updated, cmd = updated.handleLinkInput(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "a", updated.linkBuf)
+	assert.Equal(t, "a", updated.linkBuf.Value)
 }
 
 func TestJobsHandleUnlinkInputNilDetailAndDirectIDBranches(t *testing.T) {
 	model := NewJobsModel(nil)
 	model.unlinkingRel = true
-	model.unlinkBuf = "1"
+	model.unlinkBuf.Value = "1"
 
 	updated, cmd := model.handleUnlinkInput(tea.KeyMsg{Type: tea.KeyEnter})
 	assert.Nil(t, cmd)
 	assert.False(t, updated.unlinkingRel)
-	assert.Equal(t, "", updated.unlinkBuf)
+	assert.Equal(t, "", updated.unlinkBuf.Value)
 
 	var updatedID string
 	_, client := testJobsClient(t, func(w http.ResponseWriter, r *http.Request) {
@@ -285,7 +285,7 @@ func TestJobsHandleUnlinkInputNilDetailAndDirectIDBranches(t *testing.T) {
 	model = NewJobsModel(client)
 	model.detail = &api.Job{ID: "job-1"}
 	model.unlinkingRel = true
-	model.unlinkBuf = "rel-custom"
+	model.unlinkBuf.Value = "rel-custom"
 	updated, cmd = model.handleUnlinkInput(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
 	msg := cmd()
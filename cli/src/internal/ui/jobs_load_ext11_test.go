@@ -31,7 +31,7 @@ func TestJobsLoadJobsSuccessAndErrorBranches(t *testing.T) {
 	})
 
 	errModel := NewJobsModel(errClient)
-	errOut, ok := errModel.loadJobs().(errMsg)
+	errOut, ok := errModel.loadJobs().(loadFailedMsg)
 	require.True(t, ok)
 	assert.ErrorContains(t, errOut.err, "JOBS_FAILED")
 }
@@ -33,7 +33,7 @@ func TestJobsRenderListLoadingEmptyAndPreviewBranches(t *testing.T) {
 	}
 	model.list.SetItems([]string{"alpha", "beta"})
 	model.selected = map[string]bool{"job-1": true}
-	model.searchBuf = "a"
+	model.searchBuf.Value = "a"
 	model.searchSuggest = "alpha"
 	model.width = 84 // stacked layout branch
 	out = components.SanitizeText(model.renderList())
@@ -67,11 +67,11 @@ func TestJobsHandleListKeysAdditionalBranches(t *testing.T) {
 	require.Nil(t, cmd)
 	assert.Empty(t, updated.selected)
 
-	updated.searchBuf = "alpha"
+	updated.searchBuf.Value = "alpha"
 	updated.searchSuggest = "alpha"
 	updated, cmd = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyEsc})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 	assert.Equal(t, "", updated.searchSuggest)
 
 	updated, cmd = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
@@ -79,10 +79,10 @@ func TestJobsHandleListKeysAdditionalBranches(t *testing.T) {
 	assert.True(t, updated.filtering)
 
 	updated.filtering = false
-	updated.searchBuf = "x"
+	updated.searchBuf.Value = "x"
 	updated, cmd = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyDelete})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 
 	updated.list.Cursor = 9
 	updated.changingSt = false
@@ -92,10 +92,10 @@ func TestJobsHandleListKeysAdditionalBranches(t *testing.T) {
 	assert.False(t, updated.changingSt)
 	assert.Nil(t, updated.statusTargets)
 
-	updated.searchBuf = ""
+	updated.searchBuf.Value = ""
 	updated, cmd = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 }
 
 func TestJobsSaveEditNilMetadataErrorAndSuccess(t *testing.T) {
@@ -129,7 +129,7 @@ func TestJobsSaveEditNilMetadataErrorAndSuccess(t *testing.T) {
 	model.startEdit()
 	model.editStatusIdx = 1
 	model.editPriorityIdx = 2
-	model.editDesc = "updated desc"
+	model.editDesc.Value = "updated desc"
 	model.editMeta.Buffer = "group | field | value"
 	model.editMeta.Scopes = []string{"public"}
 
@@ -0,0 +1,303 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+const (
+	jobTypeAgent = "agent"
+	jobTypeHuman = "human"
+
+	jobChecklistKey = "checklist"
+)
+
+var jobTypeOptions = []string{jobTypeAgent, jobTypeHuman}
+
+// jobChecklistItem is one line of a human task checklist stored in job metadata.
+type jobChecklistItem struct {
+	Text string
+	Done bool
+}
+
+// isHumanTask reports whether a job is a human-assigned task.
+func isHumanTask(j api.Job) bool {
+	return strings.EqualFold(strings.TrimSpace(valueOrEmpty(j.JobType)), jobTypeHuman)
+}
+
+// isJobClosed reports whether a job no longer needs attention.
+func isJobClosed(j api.Job) bool {
+	switch strings.ToLower(strings.TrimSpace(j.Status)) {
+	case "completed", "failed", api.JobStatusAbandoned:
+		return true
+	}
+	return false
+}
+
+// isJobOverdue reports whether an open job is past its due date.
+func isJobOverdue(j api.Job, now time.Time) bool {
+	if j.DueAt == nil || j.DueAt.IsZero() || isJobClosed(j) {
+		return false
+	}
+	return j.DueAt.Before(now)
+}
+
+// jobChecklist parses the checklist stored in job metadata.
+func jobChecklist(j api.Job) []jobChecklistItem {
+	if j.Metadata == nil {
+		return nil
+	}
+	raw, ok := j.Metadata[jobChecklistKey].([]any)
+	if !ok {
+		return nil
+	}
+	items := make([]jobChecklistItem, 0, len(raw))
+	for _, entry := range raw {
+		switch typed := entry.(type) {
+		case string:
+			if text := strings.TrimSpace(typed); text != "" {
+				items = append(items, jobChecklistItem{Text: text})
+			}
+		case map[string]any:
+			text := strings.TrimSpace(fmt.Sprintf("%v", typed["text"]))
+			if text == "" || text == "<nil>" {
+				continue
+			}
+			done, _ := typed["done"].(bool)
+			items = append(items, jobChecklistItem{Text: text, Done: done})
+		}
+	}
+	return items
+}
+
+// checklistMetadataValue converts checklist items into their metadata shape.
+func checklistMetadataValue(items []jobChecklistItem) []any {
+	out := make([]any, 0, len(items))
+	for _, item := range items {
+		out = append(out, map[string]any{"text": item.Text, "done": item.Done})
+	}
+	return out
+}
+
+// parseChecklistInput splits a `;` separated checklist buffer into items.
+func parseChecklistInput(raw string) []jobChecklistItem {
+	parts := strings.FieldsFunc(raw, func(r rune) bool {
+		return r == ';' || r == '\n'
+	})
+	items := make([]jobChecklistItem, 0, len(parts))
+	for _, part := range parts {
+		text := strings.TrimSpace(part)
+		if text == "" {
+			continue
+		}
+		items = append(items, jobChecklistItem{Text: text})
+	}
+	return items
+}
+
+// checklistProgress returns completed and total checklist counts.
+func checklistProgress(items []jobChecklistItem) (int, int) {
+	done := 0
+	for _, item := range items {
+		if item.Done {
+			done++
+		}
+	}
+	return done, len(items)
+}
+
+// withJobChecklist returns a metadata copy with the checklist replaced.
+func withJobChecklist(meta map[string]any, items []jobChecklistItem) map[string]any {
+	out := make(map[string]any, len(meta)+1)
+	for k, v := range meta {
+		out[k] = v
+	}
+	if len(items) == 0 {
+		delete(out, jobChecklistKey)
+		return out
+	}
+	out[jobChecklistKey] = checklistMetadataValue(items)
+	return out
+}
+
+// stripJobChecklist returns a metadata copy without the checklist key.
+func stripJobChecklist(meta map[string]any) map[string]any {
+	if _, ok := meta[jobChecklistKey]; !ok {
+		return meta
+	}
+	out := make(map[string]any, len(meta))
+	for k, v := range meta {
+		if k == jobChecklistKey {
+			continue
+		}
+		out[k] = v
+	}
+	return out
+}
+
+// parseJobDueInput normalizes a due date entered as YYYY-MM-DD or RFC3339.
+func parseJobDueInput(raw string) (string, error) {
+	value := strings.TrimSpace(raw)
+	if value == "" {
+		return "", nil
+	}
+	if ts, err := time.Parse(time.RFC3339, value); err == nil {
+		return ts.UTC().Format(time.RFC3339), nil
+	}
+	if ts, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		// Date-only input means "by the end of that day".
+		end := ts.Add(24*time.Hour - time.Minute)
+		return end.UTC().Format(time.RFC3339), nil
+	}
+	return "", fmt.Errorf("due date must be YYYY-MM-DD or RFC3339")
+}
+
+// formatJobDueInput renders a due date back into the editable date form.
+func formatJobDueInput(due *time.Time) string {
+	if due == nil || due.IsZero() {
+		return ""
+	}
+	return due.Local().Format("2006-01-02")
+}
+
+// jobDueLabel renders a compact due label with an overdue marker.
+func jobDueLabel(j api.Job, now time.Time) string {
+	if j.DueAt == nil || j.DueAt.IsZero() {
+		return "-"
+	}
+	label := formatLocalTimeCompact(*j.DueAt)
+	if isJobOverdue(j, now) {
+		label += " (overdue)"
+	}
+	return label
+}
+
+// jobDueCell renders the due date for the jobs table, "-" when unset.
+func jobDueCell(j api.Job) string {
+	if j.DueAt == nil || j.DueAt.IsZero() {
+		return "-"
+	}
+	return formatLocalTimeCompact(*j.DueAt)
+}
+
+// jobStatusCellStyle highlights overdue jobs in the jobs table.
+func jobStatusCellStyle(text string) lipgloss.Style {
+	if strings.TrimSpace(text) == "overdue" {
+		return WarningStyle
+	}
+	return lipgloss.NewStyle()
+}
+
+// jobAssigneeLabel renders the assignee, collapsing the current user to "me".
+func jobAssigneeLabel(j api.Job, currentUserID string) string {
+	assignee := strings.TrimSpace(valueOrEmpty(j.AssignedTo))
+	if assignee == "" {
+		return "-"
+	}
+	if currentUserID != "" && assignee == currentUserID {
+		return "me"
+	}
+	return shortID(assignee)
+}
+
+// openHumanTasks returns open human tasks ordered by due date, undated last.
+func openHumanTasks(items []api.Job) []api.Job {
+	out := make([]api.Job, 0, len(items))
+	for _, item := range items {
+		if isHumanTask(item) && !isJobClosed(item) {
+			out = append(out, item)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		a, b := out[i].DueAt, out[j].DueAt
+		switch {
+		case a == nil && b == nil:
+			return false
+		case a == nil:
+			return false
+		case b == nil:
+			return true
+		default:
+			return a.Before(*b)
+		}
+	})
+	return out
+}
+
+// humanTaskSummary renders the one-line human task summary used in count lines.
+func humanTaskSummary(items []api.Job, now time.Time) string {
+	open := openHumanTasks(items)
+	if len(open) == 0 {
+		return ""
+	}
+	overdue := 0
+	for _, item := range open {
+		if isJobOverdue(item, now) {
+			overdue++
+		}
+	}
+	summary := fmt.Sprintf("human tasks: %d open", len(open))
+	if overdue > 0 {
+		summary = fmt.Sprintf("%s · %d overdue", summary, overdue)
+	}
+	return summary
+}
+
+// renderChecklistBlock renders a checklist with row numbers for toggling.
+func renderChecklistBlock(items []jobChecklistItem, width int) string {
+	done, total := checklistProgress(items)
+	lines := make([]string, 0, len(items)+2)
+	lines = append(lines,
+		MetaKeyStyle.Render("Checklist")+MutedStyle.Render(fmt.Sprintf(" · %d/%d done", done, total)),
+		"",
+	)
+	for i, item := range items {
+		mark := MutedStyle.Render("[ ]")
+		text := NormalStyle.Render(components.SanitizeOneLine(item.Text))
+		if item.Done {
+			mark = SuccessStyle.Render("[x]")
+			text = MutedStyle.Render(components.SanitizeOneLine(item.Text))
+		}
+		lines = append(lines, fmt.Sprintf("%2d. %s %s", i+1, mark, text))
+	}
+	return components.TitledBox("Checklist", strings.Join(lines, "\n"), width)
+}
+
+// renderHumanTasksPanel renders the open human tasks panel for the Inbox.
+func renderHumanTasksPanel(items []api.Job, currentUserID string, width int, now time.Time) string {
+	open := openHumanTasks(items)
+	if len(open) == 0 {
+		return ""
+	}
+	const maxRows = 5
+	lines := []string{
+		MetaKeyStyle.Render("Human Tasks") + MutedStyle.Render(" · "+humanTaskSummary(items, now)),
+		"",
+	}
+	for i, item := range open {
+		if i >= maxRows {
+			lines = append(lines, MutedStyle.Render(fmt.Sprintf("%d more in Jobs", len(open)-maxRows)))
+			break
+		}
+		detail := "due " + jobDueLabel(item, now)
+		if done, total := checklistProgress(jobChecklist(item)); total > 0 {
+			detail = fmt.Sprintf("%s · %d/%d done", detail, done, total)
+		}
+		if assignee := jobAssigneeLabel(item, currentUserID); assignee != "-" {
+			detail = fmt.Sprintf("%s · %s", detail, assignee)
+		}
+		detailStyle := MutedStyle
+		if isJobOverdue(item, now) {
+			detailStyle = WarningStyle
+		}
+		lines = append(lines, NormalStyle.Render(components.SanitizeOneLine(item.Title))+"  "+detailStyle.Render(detail))
+	}
+	return components.TitledBox("Human Tasks", strings.Join(lines, "\n"), width)
+}
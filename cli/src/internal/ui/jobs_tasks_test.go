@@ -0,0 +1,368 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// humanJob builds a human task fixture.
+func humanJob(id, title, status string, due *time.Time, meta api.JSONMap) api.Job {
+	jobType := jobTypeHuman
+	return api.Job{ID: id, Title: title, Status: status, JobType: &jobType, DueAt: due, Metadata: meta}
+}
+
+func TestJobChecklistParsesMixedShapes(t *testing.T) {
+	job := api.Job{Metadata: api.JSONMap{
+		"checklist": []any{
+			"write notes",
+			map[string]any{"text": "review", "done": true},
+			map[string]any{"text": ""},
+			42,
+		},
+	}}
+	items := jobChecklist(job)
+	require.Len(t, items, 2)
+	assert.Equal(t, jobChecklistItem{Text: "write notes"}, items[0])
+	assert.Equal(t, jobChecklistItem{Text: "review", Done: true}, items[1])
+
+	done, total := checklistProgress(items)
+	assert.Equal(t, 1, done)
+	assert.Equal(t, 2, total)
+
+	assert.Nil(t, jobChecklist(api.Job{}))
+}
+
+func TestParseChecklistInputAndMetadataRoundTrip(t *testing.T) {
+	items := parseChecklistInput(" call vendor ; ;sign contract\nfile receipt")
+	require.Len(t, items, 3)
+	assert.Equal(t, "sign contract", items[1].Text)
+
+	meta := withJobChecklist(map[string]any{"owner": "ops"}, items)
+	assert.Equal(t, "ops", meta["owner"])
+	assert.Len(t, jobChecklist(api.Job{Metadata: meta}), 3)
+
+	stripped := stripJobChecklist(meta)
+	assert.NotContains(t, stripped, "checklist")
+	assert.Contains(t, meta, "checklist")
+
+	cleared := withJobChecklist(meta, nil)
+	assert.NotContains(t, cleared, "checklist")
+}
+
+func TestParseJobDueInput(t *testing.T) {
+	value, err := parseJobDueInput("")
+	require.NoError(t, err)
+	assert.Equal(t, "", value)
+
+	value, err = parseJobDueInput("2026-03-01T10:00:00Z")
+	require.NoError(t, err)
+	assert.Equal(t, "2026-03-01T10:00:00Z", value)
+
+	value, err = parseJobDueInput("2026-03-01")
+	require.NoError(t, err)
+	ts, err := time.Parse(time.RFC3339, value)
+	require.NoError(t, err)
+	assert.Equal(t, "2026-03-01", ts.Local().Format("2006-01-02"))
+
+	_, err = parseJobDueInput("tomorrow")
+	assert.Error(t, err)
+}
+
+func TestOpenHumanTasksOrdersByDueAndSkipsClosed(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	early := now.Add(-24 * time.Hour)
+	late := now.Add(48 * time.Hour)
+	agentType := jobTypeAgent
+	items := []api.Job{
+		humanJob("h1", "undated", "pending", nil, nil),
+		humanJob("h2", "late", "active", &late, nil),
+		humanJob("h3", "early", "pending", &early, nil),
+		humanJob("h4", "done", "completed", &early, nil),
+		{ID: "a1", Title: "agent", Status: "pending", JobType: &agentType},
+	}
+
+	open := openHumanTasks(items)
+	require.Len(t, open, 3)
+	assert.Equal(t, []string{"h3", "h2", "h1"}, []string{open[0].ID, open[1].ID, open[2].ID})
+
+	assert.True(t, isJobOverdue(items[2], now))
+	assert.False(t, isJobOverdue(items[3], now))
+	assert.Equal(t, "human tasks: 3 open · 1 overdue", humanTaskSummary(items, now))
+	assert.Equal(t, "", humanTaskSummary(items[4:], now))
+}
+
+func TestJobDueCellAndOverdueStatusStyle(t *testing.T) {
+	due := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, "-", jobDueCell(api.Job{}))
+	assert.Equal(t, formatLocalTimeCompact(due), jobDueCell(api.Job{DueAt: &due}))
+	assert.Equal(t, WarningStyle.GetForeground(), jobStatusCellStyle(" overdue ").GetForeground())
+	assert.NotEqual(t, WarningStyle.GetForeground(), jobStatusCellStyle("pending").GetForeground())
+}
+
+func TestJobAssigneeLabel(t *testing.T) {
+	me := "11111111-2222-3333-4444-555555555555"
+	other := "99999999-2222-3333-4444-555555555555"
+	assert.Equal(t, "-", jobAssigneeLabel(api.Job{}, me))
+	assert.Equal(t, "me", jobAssigneeLabel(api.Job{AssignedTo: &me}, me))
+	assert.Equal(t, "99999999", jobAssigneeLabel(api.Job{AssignedTo: &other}, me))
+}
+
+func TestJobsSaveAddHumanTaskDefaultsAssigneeAndChecklist(t *testing.T) {
+	var seen api.CreateJobInput
+	_, client := testJobsClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&seen))
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "job-1"}}))
+	})
+
+	model := NewJobsModel(client)
+	model.SetCurrentUser("user-1")
+	model.addFields[jobFieldTitle].Value = "Renew domain"
+	model.addTypeIdx = statusIndex(jobTypeOptions, jobTypeHuman)
+	model.addFields[jobFieldDue].Value = "2026-04-01"
+	model.addFields[jobFieldChecklist].Value = "check card; renew"
+
+	updated, cmd := model.saveAdd()
+	require.NotNil(t, cmd)
+	assert.True(t, updated.addSaving)
+	_, ok := cmd().(jobCreatedMsg)
+	require.True(t, ok)
+
+	assert.Equal(t, jobTypeHuman, seen.JobType)
+	assert.Equal(t, "user-1", seen.AssignedTo)
+	assert.NotEmpty(t, seen.DueAt)
+	items := jobChecklist(api.Job{Metadata: seen.Metadata})
+	require.Len(t, items, 2)
+	assert.Equal(t, "renew", items[1].Text)
+}
+
+func TestJobsSaveAddRejectsInvalidDue(t *testing.T) {
+	model := NewJobsModel(nil)
+	model.addFields[jobFieldTitle].Value = "Renew domain"
+	model.addFields[jobFieldDue].Value = "soon"
+	updated, cmd := model.saveAdd()
+	assert.Nil(t, cmd)
+	assert.Contains(t, updated.addErr, "due date")
+}
+
+// jobEditPatchClient records the PATCH body of an edit and answers entity
+// lookups with the given people.
+func jobEditPatchClient(t *testing.T, people []map[string]any, seen *map[string]any) *api.Client {
+	t.Helper()
+	_, client := testJobsClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/entities":
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": people}))
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/jobs/job-1":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(seen))
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "job-1"}}))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	return client
+}
+
+func TestJobsSaveEditClearsAssignee(t *testing.T) {
+	var seen map[string]any
+	model := NewJobsModel(jobEditPatchClient(t, nil, &seen))
+	assignee := "11111111-2222-3333-4444-555555555555"
+	due := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	model.detail = &api.Job{ID: "job-1", Status: "pending", AssignedTo: &assignee, DueAt: &due, Metadata: api.JSONMap{}}
+	model.startEdit()
+	model.editAssignee.Value = "  "
+
+	_, cmd := model.saveEdit()
+	require.NotNil(t, cmd)
+	_, ok := cmd().(jobStatusUpdatedMsg)
+	require.True(t, ok)
+
+	value, sent := seen["assigned_to"]
+	assert.True(t, sent)
+	assert.Nil(t, value)
+	assert.NotNil(t, seen["due_at"])
+}
+
+func TestJobsSaveEditClearsDue(t *testing.T) {
+	var seen map[string]any
+	model := NewJobsModel(jobEditPatchClient(t, nil, &seen))
+	assignee := "11111111-2222-3333-4444-555555555555"
+	due := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	model.detail = &api.Job{ID: "job-1", Status: "pending", AssignedTo: &assignee, DueAt: &due, Metadata: api.JSONMap{}}
+	model.startEdit()
+	model.editDue.Value = ""
+
+	_, cmd := model.saveEdit()
+	require.NotNil(t, cmd)
+	_, ok := cmd().(jobStatusUpdatedMsg)
+	require.True(t, ok)
+
+	value, sent := seen["due_at"]
+	assert.True(t, sent)
+	assert.Nil(t, value)
+	assert.Equal(t, assignee, seen["assigned_to"])
+}
+
+func TestJobsSaveEditLeavesEmptyFieldsUnsent(t *testing.T) {
+	var seen map[string]any
+	model := NewJobsModel(jobEditPatchClient(t, nil, &seen))
+	model.detail = &api.Job{ID: "job-1", Status: "pending", Metadata: api.JSONMap{}}
+	model.startEdit()
+
+	_, cmd := model.saveEdit()
+	require.NotNil(t, cmd)
+	_, ok := cmd().(jobStatusUpdatedMsg)
+	require.True(t, ok)
+
+	assert.NotContains(t, seen, "assigned_to")
+	assert.NotContains(t, seen, "due_at")
+}
+
+func TestJobsSaveEditResolvesAssigneeToID(t *testing.T) {
+	people := []map[string]any{
+		{"id": "22222222-2222-3333-4444-555555555555", "name": "Ada Lovelace"},
+		{"id": "33333333-2222-3333-4444-555555555555", "name": "Ada Lovelace Jr"},
+	}
+	var seen map[string]any
+	model := NewJobsModel(jobEditPatchClient(t, people, &seen))
+	model.SetCurrentUser("11111111-2222-3333-4444-555555555555")
+	model.detail = &api.Job{ID: "job-1", Status: "pending", Metadata: api.JSONMap{}}
+
+	for input, want := range map[string]string{
+		"ada lovelace":                         "22222222-2222-3333-4444-555555555555",
+		"Me":                                   "11111111-2222-3333-4444-555555555555",
+		"44444444-2222-3333-4444-555555555555": "44444444-2222-3333-4444-555555555555",
+	} {
+		seen = nil
+		model.startEdit()
+		model.editAssignee.Value = input
+		_, cmd := model.saveEdit()
+		require.NotNil(t, cmd)
+		_, ok := cmd().(jobStatusUpdatedMsg)
+		require.True(t, ok, input)
+		assert.Equal(t, want, seen["assigned_to"], input)
+	}
+}
+
+func TestJobsSaveEditRejectsUnknownAssignee(t *testing.T) {
+	people := []map[string]any{
+		{"id": "22222222-2222-3333-4444-555555555555", "name": "Sam"},
+		{"id": "33333333-2222-3333-4444-555555555555", "name": "sam"},
+	}
+	var seen map[string]any
+	model := NewJobsModel(jobEditPatchClient(t, people, &seen))
+	model.detail = &api.Job{ID: "job-1", Status: "pending", Metadata: api.JSONMap{}}
+
+	for input, want := range map[string]string{
+		"nobody": "no entity named",
+		"Sam":    "more than one entity",
+	} {
+		model.startEdit()
+		model.editAssignee.Value = input
+		_, cmd := model.saveEdit()
+		require.NotNil(t, cmd)
+		msg, ok := cmd().(errMsg)
+		require.True(t, ok, input)
+		assert.Contains(t, msg.err.Error(), want)
+	}
+	assert.Nil(t, seen)
+}
+
+func TestJobsChecklistToggleAndAppend(t *testing.T) {
+	var seen api.UpdateJobInput
+	_, client := testJobsClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPatch, r.Method)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&seen))
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+			"id":       "job-1",
+			"title":    "Renew domain",
+			"status":   "pending",
+			"job_type": "human",
+			"metadata": seen.Metadata,
+		}}))
+	})
+
+	model := NewJobsModel(client)
+	job := humanJob("job-1", "Renew domain", "pending", nil, api.JSONMap{
+		"checklist": []any{map[string]any{"text": "check card", "done": false}},
+	})
+	model.allItems = []api.Job{job}
+	model.applyJobSearch()
+	model.detail = &job
+	model.view = jobsViewDetail
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	require.True(t, model.checklistEdit)
+	assert.Contains(t, model.View(), "Checklist")
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	assert.False(t, model.checklistEdit)
+	msg := cmd()
+	updated, ok := msg.(jobChecklistUpdatedMsg)
+	require.True(t, ok)
+	assert.True(t, jobChecklist(api.Job{Metadata: seen.Metadata})[0].Done)
+
+	model, _ = model.Update(updated)
+	require.NotNil(t, model.detail)
+	assert.True(t, jobChecklist(*model.detail)[0].Done)
+	assert.True(t, jobChecklist(model.allItems[0])[0].Done)
+
+	model.checklistEdit = true
+	model.checklistBuf.Value = "renew"
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	cmd()
+	assert.Len(t, jobChecklist(api.Job{Metadata: seen.Metadata}), 2)
+
+	model.checklistEdit = true
+	model.checklistBuf.Value = "9"
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	_, isErr := cmd().(errMsg)
+	assert.True(t, isErr)
+}
+
+func TestJobsDetailRendersHumanTaskSections(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	me := "user-1"
+	job := humanJob("job-1", "Renew domain", "pending", &past, api.JSONMap{
+		"checklist": []any{map[string]any{"text": "check card", "done": true}},
+		"owner":     "ops",
+	})
+	job.AssignedTo = &me
+	model := NewJobsModel(nil)
+	model.SetCurrentUser(me)
+	model.width = 120
+	model.detail = &job
+	model.view = jobsViewDetail
+
+	out := model.View()
+	assert.Contains(t, out, "Checklist · 1/1 done")
+	assert.Contains(t, out, "overdue")
+	assert.Contains(t, out, "me")
+	assert.Contains(t, out, "owner")
+}
+
+func TestInboxRendersHumanTasksPanel(t *testing.T) {
+	due := time.Now().Add(24 * time.Hour)
+	model := NewInboxModel(nil)
+	model.width = 120
+	model, _ = model.Update(inboxHumanTasksLoadedMsg{items: []api.Job{
+		humanJob("job-1", "Renew domain", "pending", &due, nil),
+		humanJob("job-2", "Closed", "completed", nil, nil),
+	}})
+	require.Len(t, model.humanTasks, 1)
+
+	out := model.View()
+	assert.Contains(t, out, "Human Tasks")
+	assert.Contains(t, out, "Renew domain")
+	assert.NotContains(t, out, "Closed")
+}
@@ -0,0 +1,138 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// handleAddTemplateKeys handles the template picker and ctrl+t in the add
+// form. It reports whether the key was consumed.
+func (m JobsModel) handleAddTemplateKeys(msg tea.KeyMsg) (JobsModel, bool) {
+	if m.addTemplate.open {
+		switch {
+		case isKey(msg, "left"):
+			m.addTemplate.Move(-1)
+		case isKey(msg, "right"):
+			m.addTemplate.Move(1)
+		case isEnter(msg), isSpace(msg):
+			if tmpl, ok := m.addTemplate.Pick(); ok {
+				m.startJobTemplate(tmpl)
+			}
+		case isBack(msg), isKey(msg, "ctrl+t"):
+			m.addTemplate.open = false
+		}
+		return m, true
+	}
+	if isKey(msg, "ctrl+t") && m.addTemplate.Toggle() {
+		return m, true
+	}
+	return m, false
+}
+
+// startJobTemplate prompts for the template params, or applies it directly
+// when it has none.
+func (m *JobsModel) startJobTemplate(tmpl config.Template) {
+	m.tmplPending = &tmpl
+	m.tmplValues = map[string]string{}
+	m.tmplParamIdx = 0
+	if len(tmpl.Params) == 0 {
+		m.finishJobTemplate()
+		return
+	}
+	m.tmplParamBuf.SetValue(tmpl.Params[0].Default)
+}
+
+// handleTemplateParamInput collects one template param at a time.
+func (m JobsModel) handleTemplateParamInput(msg tea.KeyMsg) (JobsModel, tea.Cmd) {
+	tmpl := m.tmplPending
+	switch {
+	case isBack(msg):
+		m.tmplPending = nil
+		m.tmplValues = nil
+		m.tmplParamBuf.Reset()
+		m.addTemplate.applied = ""
+	case isEnter(msg):
+		param := tmpl.Params[m.tmplParamIdx]
+		value := strings.TrimSpace(m.tmplParamBuf.Value)
+		if value == "" && param.Required {
+			return m, nil
+		}
+		m.tmplValues[param.Name] = value
+		m.tmplParamIdx++
+		if m.tmplParamIdx < len(tmpl.Params) {
+			m.tmplParamBuf.SetValue(tmpl.Params[m.tmplParamIdx].Default)
+			return m, nil
+		}
+		m.finishJobTemplate()
+	default:
+		m.tmplParamBuf.HandleKey(msg)
+	}
+	return m, nil
+}
+
+// finishJobTemplate expands the pending template with the collected params
+// and fills the add form from it.
+func (m *JobsModel) finishJobTemplate() {
+	tmpl := *m.tmplPending
+	m.tmplPending = nil
+	m.tmplParamBuf.Reset()
+	values, err := tmpl.ResolveParams(m.tmplValues)
+	m.tmplValues = nil
+	if err != nil {
+		m.addErr = err.Error()
+		m.addTemplate.applied = ""
+		return
+	}
+	m.applyJobTemplate(tmpl.Expand(values))
+}
+
+// applyJobTemplate fills the add form from an expanded template. Fields the
+// template leaves blank keep what was typed.
+func (m *JobsModel) applyJobTemplate(tmpl config.Template) {
+	m.addErr = ""
+	if title := strings.TrimSpace(tmpl.Title); title != "" {
+		m.addFields[jobFieldTitle].SetValue(title)
+	}
+	if desc := strings.TrimSpace(tmpl.Description); desc != "" {
+		m.addFields[jobFieldDescription].SetValue(desc)
+	}
+	if priority := strings.ToLower(strings.TrimSpace(tmpl.Priority)); priority != "" {
+		m.addPriorityIdx = statusIndex(jobPriorityOptions, priority)
+	}
+	if agent := strings.TrimSpace(tmpl.Agent); agent != "" {
+		m.addTypeIdx = statusIndex(jobTypeOptions, jobTypeAgent)
+		m.addFields[jobFieldAssignee].SetValue(agent)
+	}
+	m.addMeta.Buffer = templateMetadataBuffer(m.addMeta.Buffer, tmpl.Metadata)
+	m.addSubtasks = append([]string(nil), tmpl.Subtasks...)
+}
+
+// renderTemplateParamPrompt renders the prompt for the current template param.
+func (m JobsModel) renderTemplateParamPrompt() string {
+	tmpl := m.tmplPending
+	param := tmpl.Params[m.tmplParamIdx]
+	label := fmt.Sprintf("%s: %s (%d/%d)", tmpl.Name, param.Label(), m.tmplParamIdx+1, len(tmpl.Params))
+	if param.Required {
+		label += " *"
+	}
+	return components.Indent(components.InputDialog(label, m.tmplParamBuf), 1)
+}
+
+// renderAddSubtasks lists the subtasks a template will create with the job.
+func (m JobsModel) renderAddSubtasks() string {
+	if len(m.addSubtasks) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString(MutedStyle.Render("  Subtasks:"))
+	for _, subtask := range m.addSubtasks {
+		b.WriteString("\n")
+		b.WriteString(NormalStyle.Render("  - " + components.SanitizeOneLine(subtask)))
+	}
+	return b.String()
+}
@@ -0,0 +1,90 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestJobsAddTemplatePromptsForParamsAndCreatesSubtasks(t *testing.T) {
+	var mu sync.Mutex
+	var created map[string]any
+	var subtasks []string
+	_, client := testJobsClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.URL.Path {
+		case "/api/jobs":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "job-9"}}))
+		case "/api/jobs/job-9/subtasks":
+			var body map[string]string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			subtasks = append(subtasks, body["title"])
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "sub"}}))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	model := NewJobsModel(client)
+	model.width = 100
+	model.view = jobsViewAdd
+	model.addTemplate.templates = []config.Template{{
+		Name:     "weekly-report",
+		Kind:     config.TemplateKindJob,
+		Title:    "Weekly report W{{week}}",
+		Priority: "high",
+		Agent:    "reporter",
+		Subtasks: []string{"Collect W{{week}} metrics", "Draft summary"},
+		Params: []config.TemplateParam{
+			{Name: "week", Prompt: "Week number", Required: true},
+			{Name: "team", Default: "core"},
+		},
+	}}
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+	require.True(t, model.addTemplate.open)
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, model.tmplPending)
+	assert.Contains(t, components.SanitizeText(model.View()), "weekly-report: Week number (1/2)")
+
+	// Required params cannot be skipped.
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, 0, model.tmplParamIdx)
+
+	for _, r := range "42" {
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, "core", model.tmplParamBuf.Value)
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.Nil(t, model.tmplPending)
+
+	assert.Equal(t, "Weekly report W42", model.addFields[jobFieldTitle].Value)
+	assert.Equal(t, "reporter", model.addFields[jobFieldAssignee].Value)
+	assert.Equal(t, "high", jobPriorityOptions[model.addPriorityIdx])
+	view := components.SanitizeText(model.View())
+	assert.Contains(t, view, "Template: weekly-report")
+	assert.Contains(t, view, "- Collect W42 metrics")
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	require.NotNil(t, cmd)
+	assert.IsType(t, jobCreatedMsg{}, cmd())
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, "Weekly report W42", created["title"])
+	meta, _ := created["metadata"].(map[string]any)
+	assert.Equal(t, "weekly-report", meta["template"])
+	assert.Equal(t, map[string]any{"week": "42", "team": "core"}, meta["template_params"])
+	assert.Equal(t, []string{"Collect W42 metrics", "Draft summary"}, subtasks)
+}
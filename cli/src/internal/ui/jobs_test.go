@@ -190,16 +190,16 @@ func TestJobsModelStatusInputHandling(t *testing.T) {
 	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
 	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
 	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
-	assert.Equal(t, "act", model.statusBuf)
+	assert.Equal(t, "act", model.statusBuf.Value)
 
 	// Backspace
 	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "ac", model.statusBuf)
+	assert.Equal(t, "ac", model.statusBuf.Value)
 
 	// Escape to cancel
 	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
 	assert.False(t, model.changingSt)
-	assert.Equal(t, "", model.statusBuf)
+	assert.Equal(t, "", model.statusBuf.Value)
 }
 
 // TestJobsModelRenderEmpty handles test jobs model render empty.
@@ -302,7 +302,7 @@ func TestJobsModelCreateSubtask(t *testing.T) {
 func TestJobsSearchFiltersList(t *testing.T) {
 	model := NewJobsModel(nil)
 	model.allItems = []api.Job{{ID: "job-1", Title: "Alpha", Status: "pending"}, {ID: "job-2", Title: "Beta", Status: "active"}}
-	model.searchBuf = "al"
+	model.searchBuf.Value = "al"
 	model.applyJobSearch()
 
 	assert.Len(t, model.items, 1)
@@ -335,7 +335,7 @@ func TestJobsLinkInputCreatesRelationship(t *testing.T) {
 	model := NewJobsModel(client)
 	model.detail = &api.Job{ID: "job-1", Title: "Job"}
 	model.linkingRel = true
-	model.linkBuf = "entity ent-1 about"
+	model.linkBuf.Value = "entity ent-1 about"
 
 	model, cmd := model.handleLinkInput(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
@@ -378,7 +378,7 @@ func TestJobsUnlinkInputSupportsRowIndex(t *testing.T) {
 		{ID: "rel-1", SourceType: "job", SourceID: "job-1", TargetType: "entity", TargetID: "ent-1", Type: "about"},
 	}
 	model.unlinkingRel = true
-	model.unlinkBuf = "1"
+	model.unlinkBuf.Value = "1"
 
 	model, cmd := model.handleUnlinkInput(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
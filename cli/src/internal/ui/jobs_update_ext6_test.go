@@ -45,24 +45,24 @@ func TestJobsUpdateMessageBranchesMatrix(t *testing.T) {
 	require.NotNil(t, cmd)
 
 	model.changingSt = true
-	model.statusBuf = "active"
+	model.statusBuf.Value = "active"
 	model.statusTargets = []string{"job-1"}
 	model.detail = &api.Job{ID: "job-1"}
 	model, cmd = model.Update(jobStatusUpdatedMsg{})
 	require.NotNil(t, cmd)
 	assert.False(t, model.changingSt)
 	assert.Nil(t, model.detail)
-	assert.Empty(t, model.statusBuf)
+	assert.Empty(t, model.statusBuf.Value)
 	assert.Nil(t, model.statusTargets)
 
 	model.creatingSubtask = true
-	model.subtaskBuf = "Subtask"
+	model.subtaskBuf.Value = "Subtask"
 	model.detail = &api.Job{ID: "job-1"}
 	model, cmd = model.Update(subtaskCreatedMsg{})
 	require.NotNil(t, cmd)
 	assert.False(t, model.creatingSubtask)
 	assert.Nil(t, model.detail)
-	assert.Empty(t, model.subtaskBuf)
+	assert.Empty(t, model.subtaskBuf.Value)
 
 	model.addSaving = true
 	model, cmd = model.Update(jobCreatedMsg{})
@@ -108,31 +108,31 @@ func TestJobsUpdateKeyRoutingBranches(t *testing.T) {
 
 	model = NewJobsModel(nil)
 	model.creatingSubtask = true
-	model.subtaskBuf = "a"
+	model.subtaskBuf.Value = "a"
 	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Empty(t, updated.subtaskBuf)
+	assert.Empty(t, updated.subtaskBuf.Value)
 
 	model = NewJobsModel(nil)
 	model.linkingRel = true
-	model.linkBuf = "ab"
+	model.linkBuf.Value = "ab"
 	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "a", updated.linkBuf)
+	assert.Equal(t, "a", updated.linkBuf.Value)
 
 	model = NewJobsModel(nil)
 	model.unlinkingRel = true
-	model.unlinkBuf = "ab"
+	model.unlinkBuf.Value = "ab"
 	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "a", updated.unlinkBuf)
+	assert.Equal(t, "a", updated.unlinkBuf.Value)
 
 	model = NewJobsModel(nil)
 	model.changingSt = true
-	model.statusBuf = "ab"
+	model.statusBuf.Value = "ab"
 	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "a", updated.statusBuf)
+	assert.Equal(t, "a", updated.statusBuf.Value)
 
 	model = NewJobsModel(nil)
 	model.modeFocus = true
@@ -185,7 +185,7 @@ func TestJobsViewBranchMatrix(t *testing.T) {
 	model.width = 90
 	model.creatingSubtask = true
 	model.detail = &api.Job{ID: "job-1"}
-	model.subtaskBuf = "Subtask"
+	model.subtaskBuf.Value = "Subtask"
 	out = components.SanitizeText(model.View())
 	assert.Contains(t, out, "New Subtask Title")
 
@@ -206,7 +206,7 @@ func TestJobsViewBranchMatrix(t *testing.T) {
 	model = NewJobsModel(nil)
 	model.width = 90
 	model.changingSt = true
-	model.statusBuf = "active"
+	model.statusBuf.Value = "active"
 	out = components.SanitizeText(model.View())
 	assert.Contains(t, out, "New Status")
 
@@ -214,7 +214,7 @@ func TestJobsViewBranchMatrix(t *testing.T) {
 	model.width = 90
 	model.filtering = true
 	model.view = jobsViewList
-	model.searchBuf = "alpha"
+	model.searchBuf.Value = "alpha"
 	out = components.SanitizeText(model.View())
 	assert.Contains(t, out, "Filter Jobs")
 
@@ -81,7 +81,7 @@ func TestJobsListSearchSuggestToggleAddSaveAndReset(t *testing.T) {
 	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
 	assert.Equal(t, "Alpha Job", model.searchSuggest)
 	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyTab})
-	assert.Equal(t, "Alpha Job", strings.TrimSpace(model.searchBuf))
+	assert.Equal(t, "Alpha Job", strings.TrimSpace(model.searchBuf.Value))
 
 	// Toggle to Add via modeFocus.
 	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyUp})
@@ -111,7 +111,7 @@ func TestJobsListSearchSuggestToggleAddSaveAndReset(t *testing.T) {
 	// Esc should reset add state.
 	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
 	assert.False(t, model.addSaved)
-	assert.Equal(t, "", model.addFields[jobFieldTitle].value)
+	assert.Equal(t, "", model.addFields[jobFieldTitle].Value)
 }
 
 // TestJobsDetailRendersAndEditSaves handles test jobs detail renders and edit saves.
@@ -0,0 +1,128 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// libraryBulk is the space-to-select state of the Knowledge and Files
+// libraries. Its prompt takes the same add:/remove:/set: input as the entity
+// bulk prompt and applies it to every selected record.
+type libraryBulk struct {
+	selected map[string]bool
+	prompt   string
+	target   bulkTarget
+	buf      components.TextInput
+}
+
+// bulkPromptTitle names the bulk prompt for target, with an input example.
+func bulkPromptTitle(target bulkTarget) string {
+	switch target {
+	case bulkTargetScopes:
+		return "Bulk Scopes (add:scope1,scope2)"
+	case bulkTargetStatus:
+		return "Bulk Status (set:inactive)"
+	}
+	return "Bulk Tags (add:tag1,tag2)"
+}
+
+// toggle selects or unselects the record id.
+func (b *libraryBulk) toggle(id string) {
+	if id == "" {
+		return
+	}
+	if b.selected[id] {
+		delete(b.selected, id)
+		return
+	}
+	if b.selected == nil {
+		b.selected = map[string]bool{}
+	}
+	b.selected[id] = true
+}
+
+// count returns how many records are selected.
+func (b libraryBulk) count() int {
+	return len(b.selected)
+}
+
+// clear drops the selection and closes the prompt.
+func (b *libraryBulk) clear() {
+	b.selected = nil
+	b.close()
+}
+
+// open starts the prompt for target.
+func (b *libraryBulk) open(target bulkTarget) {
+	b.target = target
+	b.prompt = bulkPromptTitle(target)
+	b.buf.Reset()
+}
+
+// close closes the prompt and drops its input.
+func (b *libraryBulk) close() {
+	b.prompt = ""
+	b.buf.Reset()
+}
+
+// checkbox returns the selection mark for id while anything is selected.
+func (b libraryBulk) checkbox(id string) string {
+	switch {
+	case b.count() == 0:
+		return ""
+	case b.selected[id]:
+		return "[X]"
+	}
+	return "[ ]"
+}
+
+// handleKey edits the prompt. On enter it parses the input and reports
+// submit; esc closes the prompt.
+func (b *libraryBulk) handleKey(msg tea.KeyMsg) (spec bulkInput, submit bool, err error) {
+	switch {
+	case isBack(msg):
+		b.close()
+	case isEnter(msg):
+		spec, err = parseBulkInput(b.buf.Value)
+		return spec, err == nil, err
+	case isKey(msg, "cmd+backspace", "cmd+delete", "ctrl+u"):
+		b.buf.Reset()
+	default:
+		b.buf.HandleKey(msg)
+	}
+	return bulkInput{}, false, nil
+}
+
+// bulkSetUpdate applies spec to current, normalized for target, and reports
+// whether the set changed.
+func bulkSetUpdate(target bulkTarget, spec bulkInput, current []string) ([]string, bool) {
+	before := bulkSetValues(target, current)
+	after := applyBulkSetOp(spec.op, before, bulkSetValues(target, spec.values))
+	return after, bulkSetChange{known: true, before: before, after: after}.changed()
+}
+
+// checkBulkSpec rejects an add or remove whose values all normalize away.
+func checkBulkSpec(target bulkTarget, spec bulkInput) error {
+	if spec.op != "set" && len(bulkSetValues(target, spec.values)) == 0 {
+		return fmt.Errorf("no valid %s provided", bulkSetNoun(target))
+	}
+	return nil
+}
+
+// bulkStatusValue reads the one status a bulk status prompt sets. A bare
+// value counts as set:.
+func bulkStatusValue(spec bulkInput, options []string) (string, error) {
+	if spec.op == "remove" || len(spec.values) != 1 {
+		return "", fmt.Errorf("enter one status like set:%s", options[len(options)-1])
+	}
+	status := strings.ToLower(strings.TrimSpace(spec.values[0]))
+	if !slices.Contains(options, status) {
+		return "", fmt.Errorf("status must be one of %s", strings.Join(options, ", "))
+	}
+	return status, nil
+}
@@ -0,0 +1,95 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContextBulkTagsQueueOnlyChangedItems(t *testing.T) {
+	model := NewContextModel(nil)
+	model.view = contextViewList
+	model.width = 120
+	model.allItems = []api.Context{
+		{ID: "ctx-1", Title: "Alpha", Tags: []string{"ops"}},
+		{ID: "ctx-2", Title: "Beta", Tags: []string{"urgent"}},
+		{ID: "ctx-3", Title: "Gamma"},
+	}
+	model.applyContextFilter()
+
+	model, _ = model.handleListKeys(tea.KeyMsg{Type: tea.KeySpace})
+	model, _ = model.handleListKeys(tea.KeyMsg{Type: tea.KeyDown})
+	model, _ = model.handleListKeys(tea.KeyMsg{Type: tea.KeySpace})
+	assert.Equal(t, 2, model.bulk.count())
+	assert.Contains(t, components.SanitizeText(model.renderList()), "selected: 2")
+
+	model, _ = model.handleListKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	assert.Equal(t, bulkPromptTitle(bulkTargetTags), model.bulk.prompt)
+	model = typeText(model, "add:Urgent")
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	queued, ok := cmd().(operationQueuedMsg)
+	require.True(t, ok)
+	assert.Len(t, queued.steps, 1)
+	assert.Equal(t, tabKnow, queued.tab)
+	assert.Empty(t, model.bulk.prompt)
+
+	model, _ = model.Update(contextBulkUpdatedMsg{})
+	assert.Zero(t, model.bulk.count())
+	assert.True(t, model.loadingList)
+}
+
+func TestContextBulkStatusRejectsUnknownStatus(t *testing.T) {
+	model := NewContextModel(nil)
+	model.view = contextViewList
+	model.allItems = []api.Context{{ID: "ctx-1", Title: "Alpha", Status: "active"}}
+	model.applyContextFilter()
+	model, _ = model.handleListKeys(tea.KeyMsg{Type: tea.KeySpace})
+	model, _ = model.handleListKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+
+	model = typeText(model, "set:archived")
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	assert.IsType(t, errMsg{}, cmd())
+	assert.NotEmpty(t, model.bulk.prompt)
+
+	model.bulk.buf.SetValue("inactive")
+	model, cmd = model.handleListKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	queued := cmd().(operationQueuedMsg)
+	assert.Len(t, queued.steps, 1)
+}
+
+func TestFilesSpaceSelectsAndBulkStatus(t *testing.T) {
+	model := NewFilesModel(nil)
+	model.view = filesViewList
+	model.all = []api.File{
+		{ID: "file-1", Filename: "a.txt", Status: "active"},
+		{ID: "file-2", Filename: "b.txt", Status: "inactive"},
+	}
+	model.applyFileSearch()
+
+	model, _ = model.handleListKeys(tea.KeyMsg{Type: tea.KeySpace})
+	model, _ = model.handleListKeys(tea.KeyMsg{Type: tea.KeyDown})
+	model, _ = model.handleListKeys(tea.KeyMsg{Type: tea.KeySpace})
+	assert.Equal(t, filesViewList, model.view)
+	assert.Equal(t, 2, model.bulk.count())
+
+	model, _ = model.handleListKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	assert.Empty(t, model.searchBuf.Value)
+	model = typeText(model, "inactive")
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	queued := cmd().(operationQueuedMsg)
+	assert.Len(t, queued.steps, 1)
+	assert.Equal(t, tabFiles, queued.tab)
+
+	// Without a selection letters still type into the search.
+	model.bulk.clear()
+	model, _ = model.handleListKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	assert.Equal(t, "s", model.searchBuf.Value)
+}
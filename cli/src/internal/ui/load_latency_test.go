@@ -0,0 +1,34 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+func TestFormatLoadLatency(t *testing.T) {
+	assert.Equal(t, "230ms", formatLoadLatency(230*time.Millisecond))
+	assert.Equal(t, "1.4s", formatLoadLatency(1400*time.Millisecond))
+	assert.Equal(t, "", renderLoadLatency(0))
+	assert.Equal(t, " · 230ms", stripANSI(renderLoadLatency(230*time.Millisecond)))
+	assert.Equal(t, " · 1.4s slow", stripANSI(renderLoadLatency(1400*time.Millisecond)))
+	assert.Equal(t, time.Duration(0), loadElapsed(time.Time{}))
+}
+
+func TestJobsCountLineShowsLoadLatency(t *testing.T) {
+	model := NewJobsModel(nil)
+	model.width = 120
+	model, _ = model.Update(jobsLoadedMsg{
+		items:  []api.Job{{ID: "job-1", Title: "alpha", Status: "pending"}},
+		queued: time.Now().Add(-2 * time.Second),
+	})
+	assert.GreaterOrEqual(t, model.loadLatency, 2*time.Second)
+	assert.Contains(t, stripANSI(model.View()), "1 total · 2.0s slow")
+
+	model, _ = model.Update(jobsLoadedMsg{items: []api.Job{{ID: "job-1", Title: "alpha", Status: "pending"}}})
+	assert.Equal(t, time.Duration(0), model.loadLatency)
+	assert.NotContains(t, stripANSI(model.View()), "slow")
+}
@@ -0,0 +1,74 @@
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// loadFailedMsg reports a failed list load together with the command that
+// re-issues it, so the tab can offer a retry instead of a tab switch.
+type loadFailedMsg struct {
+	tab   int
+	err   error
+	retry tea.Cmd
+}
+
+// loadFailure is the failed load kept for a tab until it is retried or the
+// tab is reloaded.
+type loadFailure struct {
+	err   error
+	retry tea.Cmd
+}
+
+// loadFailed builds the message a list loader returns when its request fails.
+func loadFailed(tab int, err error, retry tea.Cmd) tea.Msg {
+	return loadFailedMsg{tab: tab, err: err, retry: retry}
+}
+
+// handleLoadFailed records a failed load for its tab and lets the tab reset its
+// loading state. Auth failures still go through the global error so the
+// recovery keys stay available.
+func (a App) handleLoadFailed(msg loadFailedMsg) (tea.Model, tea.Cmd) {
+	if isCancelled(msg.err) {
+		return a, nil
+	}
+	text := msg.err.Error()
+	if hasRecoveryKeys(api.ClassifyError(msg.err)) {
+		return a.Update(errMsg{msg.err})
+	}
+	if a.loadFailures == nil {
+		a.loadFailures = map[int]loadFailure{}
+	}
+	a.loadFailures[msg.tab] = loadFailure{err: msg.err, retry: msg.retry}
+	a.refreshing = false
+	a.notify("error", text)
+	return a, a.updateTab(msg.tab, errMsg{msg.err})
+}
+
+// retryLoad re-issues the active tab's failed load, if there is one.
+func (a *App) retryLoad() (tea.Cmd, bool) {
+	failure, ok := a.loadFailures[a.tab]
+	if !ok || failure.retry == nil {
+		return nil, false
+	}
+	delete(a.loadFailures, a.tab)
+	a.refreshing = true
+	return failure.retry, true
+}
+
+// clearLoadFailure forgets a tab's failed load once it is reloaded.
+func (a *App) clearLoadFailure(tab int) {
+	delete(a.loadFailures, tab)
+}
+
+// renderLoadFailure renders the active tab's failed load with its retry key.
+func (a App) renderLoadFailure() string {
+	failure, ok := a.loadFailures[a.tab]
+	if !ok {
+		return ""
+	}
+	message := clampFeedback(failure.err.Error(), components.BoxContentWidth(a.width))
+	return components.ErrorBox("Load failed", message+"\n\nPress r to retry.", a.width)
+}
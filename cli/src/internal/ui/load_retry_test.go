@@ -0,0 +1,65 @@
+package ui
+
+import (
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestLoadFailureShowsInlineRetry(t *testing.T) {
+	app := NewApp(nil, &config.Config{})
+	app.width = 100
+	app.tabNav = false
+	app.tab = tabEntities
+	app.entities.loading = true
+
+	retried := false
+	retry := func() tea.Msg {
+		retried = true
+		return nil
+	}
+	model, _ := app.Update(loadFailed(tabEntities, errors.New("INTERNAL: db exploded"), retry))
+	app = model.(App)
+
+	assert.False(t, app.entities.loading)
+	assert.Empty(t, app.err)
+	require.Len(t, app.notifications, 1)
+	view := components.SanitizeText(app.View())
+	assert.Contains(t, view, "Load failed")
+	assert.Contains(t, view, "db exploded")
+	assert.Contains(t, view, "Press r to retry.")
+	assert.Contains(t, view, "Retry")
+
+	model, cmd := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	app = model.(App)
+	require.NotNil(t, cmd)
+	cmd()
+	assert.True(t, retried)
+	assert.NotContains(t, components.SanitizeText(app.View()), "Load failed")
+}
+
+func TestLoadFailureClearsOnTabSwitch(t *testing.T) {
+	app := NewApp(nil, &config.Config{})
+	app.tab = tabJobs
+	model, _ := app.Update(loadFailed(tabJobs, errors.New("JOBS_FAILED"), func() tea.Msg { return nil }))
+	app = model.(App)
+	require.Contains(t, app.loadFailures, tabJobs)
+
+	app.switchTab(tabLogs)
+	app.switchTab(tabJobs)
+	assert.NotContains(t, app.loadFailures, tabJobs)
+}
+
+func TestLoadFailureAuthErrorKeepsRecovery(t *testing.T) {
+	app := NewApp(nil, &config.Config{})
+	model, _ := app.Update(loadFailed(tabInbox, errors.New("UNAUTHORIZED: invalid api key"), func() tea.Msg { return nil }))
+	app = model.(App)
+	assert.NotEmpty(t, app.err)
+	assert.NotContains(t, app.loadFailures, tabInbox)
+}
@@ -0,0 +1,17 @@
+package ui
+
+import "github.com/gravitrone/nebula-core/cli/internal/ui/components"
+
+// renderLoadingList renders a list tab while it loads. When rows from the
+// previous load exist they stay visible, greyed, under a refreshing note;
+// otherwise skeleton rows sized to the list's last known length stand in.
+func renderLoadingList(noun string, items int, list *components.List, width int, render func() string) string {
+	if items > 0 {
+		return components.Stale("Refreshing "+noun+"...", render())
+	}
+	rows := 0
+	if list != nil {
+		rows = len(list.Visible())
+	}
+	return components.Skeleton("Loading "+noun+"...", rows, width)
+}
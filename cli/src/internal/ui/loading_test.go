@@ -0,0 +1,40 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestJobsListKeepsStaleRowsWhileRefreshing(t *testing.T) {
+	model := NewJobsModel(nil)
+	model.width = 100
+	model.allItems = []api.Job{{ID: "job-1", Title: "Nightly import", Status: "active"}}
+	model.applyJobSearch()
+	model.loading = true
+
+	view := components.SanitizeText(model.renderList())
+	assert.Contains(t, view, "Refreshing jobs...")
+	assert.Contains(t, view, "Nightly import")
+}
+
+func TestEntitiesListSkeletonMatchesLastKnownLength(t *testing.T) {
+	model := NewEntitiesModel(nil)
+	model.width = 100
+	model.list.SetItems([]string{"a", "b", "c"})
+	model.loading = true
+
+	view := components.SanitizeText(model.renderList())
+	assert.Contains(t, view, "Loading entities...")
+	rows := 0
+	for _, line := range strings.Split(view, "\n") {
+		if strings.Contains(line, "░") {
+			rows++
+		}
+	}
+	assert.Equal(t, 3, rows)
+}
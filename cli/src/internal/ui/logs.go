@@ -14,7 +14,10 @@ import (
 
 // --- Messages ---
 
-type logsLoadedMsg struct{ items []api.Log }
+type logsLoadedMsg struct {
+	items  []api.Log
+	queued time.Time
+}
 type logCreatedMsg struct{}
 type logUpdatedMsg struct{}
 type logsScopesLoadedMsg struct{ options []string }
@@ -60,11 +63,13 @@ type LogsModel struct {
 	allItems      []api.Log
 	list          *components.List
 	loading       bool
+	loadLatency   time.Duration
 	view          logsView
 	modeFocus     bool
 	filtering     bool
-	searchBuf     string
+	searchBuf     components.TextInput
 	searchSuggest string
+	window        api.TimeWindow
 	detail        *api.Log
 	detailRels    []api.Relationship
 	errText       string
@@ -75,14 +80,27 @@ type LogsModel struct {
 	height        int
 	scopeOptions  []string
 
+	// tail
+	levelFilter string
+	following   bool
+	followGen   int
+	followNew   int
+
+	// groups
+	groupBy       string
+	groupIdx      int
+	groupExpanded map[string]bool
+	jobTitles     map[string]string
+
 	// add
 	addFields    []formField
 	addFocus     int
 	addStatusIdx int
 	addTags      []string
-	addTagBuf    string
-	addType      string
-	addTimestamp string
+	addTagBuf    components.TextInput
+	tagOptions   []string
+	addType      components.TextInput
+	addTimestamp components.TextInput
 	addValue     MetadataEditor
 	addMeta      MetadataEditor
 	addSaving    bool
@@ -92,7 +110,7 @@ type LogsModel struct {
 	editFocus     int
 	editStatusIdx int
 	editTags      []string
-	editTagBuf    string
+	editTagBuf    components.TextInput
 	editType      string
 	editTimestamp string
 	editValue     MetadataEditor
@@ -123,7 +141,7 @@ func (m LogsModel) Init() tea.Cmd {
 	m.view = logsViewList
 	m.modeFocus = false
 	m.filtering = false
-	m.searchBuf = ""
+	m.searchBuf.Reset()
 	m.searchSuggest = ""
 	m.detail = nil
 	m.detailRels = nil
@@ -133,9 +151,9 @@ func (m LogsModel) Init() tea.Cmd {
 	m.addFocus = 0
 	m.addStatusIdx = statusIndex(logStatusOptions, "active")
 	m.addTags = nil
-	m.addTagBuf = ""
-	m.addType = ""
-	m.addTimestamp = ""
+	m.addTagBuf.Reset()
+	m.addType.Reset()
+	m.addTimestamp.Reset()
 	m.addValue.Reset()
 	m.addMeta.Reset()
 	m.addSaving = false
@@ -143,12 +161,15 @@ func (m LogsModel) Init() tea.Cmd {
 	m.editFocus = 0
 	m.editStatusIdx = statusIndex(logStatusOptions, "active")
 	m.editTags = nil
-	m.editTagBuf = ""
+	m.editTagBuf.Reset()
 	m.editType = ""
 	m.editTimestamp = ""
 	m.editValue.Reset()
 	m.editMeta.Reset()
 	m.editSaving = false
+	if m.following {
+		return tea.Batch(m.loadLogs(), m.followTick(logFollowInterval))
+	}
 	return m.loadLogs()
 }
 
@@ -157,9 +178,18 @@ func (m LogsModel) Update(msg tea.Msg) (LogsModel, tea.Cmd) {
 	switch msg := msg.(type) {
 	case logsLoadedMsg:
 		m.loading = false
+		m.loadLatency = loadElapsed(msg.queued)
+		if m.following {
+			m.followNew += countNewLogs(m.allItems, msg.items)
+		}
 		m.allItems = msg.items
 		m.applyLogSearch()
 		return m, m.loadScopeOptions()
+	case logFollowTickMsg:
+		return m.handleFollowTick(msg)
+	case logJobTitlesLoadedMsg:
+		m.jobTitles = msg.titles
+		return m, nil
 	case logsScopesLoadedMsg:
 		m.scopeOptions = msg.options
 		m.addMeta.SetScopeOptions(m.scopeOptions)
@@ -307,15 +337,22 @@ func (m LogsModel) toggleMode() (LogsModel, tea.Cmd) {
 
 func (m LogsModel) renderList() string {
 	if m.loading {
-		return "  " + MutedStyle.Render("Loading logs...")
+		return renderLoadingList("logs", len(m.items), m.list, m.width, m.renderListBody)
 	}
+	return m.renderListBody()
+}
+
+// renderListBody renders the list without the loading state.
+func (m LogsModel) renderListBody() string {
 	if len(m.items) == 0 {
-		return components.EmptyStateBox(
-			"Logs",
-			"No logs found.",
-			[]string{"Press tab to switch Add/Library", "Press / for command palette"},
-			m.width,
-		)
+		hints := []string{"Press tab to switch Add/Library", "Press / for command palette"}
+		if m.levelFilter != "" {
+			hints = append(hints, fmt.Sprintf("Showing %s and above, press L to change", m.levelFilter))
+		}
+		return components.EmptyStateBox("Logs", "No logs found.", hints, m.width)
+	}
+	if m.groupBy != "" {
+		return m.renderGroups()
 	}
 
 	contentWidth := components.BoxContentWidth(m.width)
@@ -398,8 +435,8 @@ func (m LogsModel) renderList() string {
 			activeRowRel = len(tableRows)
 		}
 		tableRows = append(tableRows, []string{
-			components.ClampTextWidthEllipsis(typ, typeWidth),
-			components.ClampTextWidthEllipsis(value, valueWidth),
+			highlightSearchTerm(components.ClampTextWidthEllipsis(typ, typeWidth), m.searchBuf.Value),
+			highlightSearchTerm(components.ClampTextWidthEllipsis(value, valueWidth), m.searchBuf.Value),
 			components.ClampTextWidthEllipsis(status, statusWidth),
 			formatLocalTimeCompact(at),
 		})
@@ -409,13 +446,16 @@ func (m LogsModel) renderList() string {
 	}
 
 	countLine := fmt.Sprintf("%d total", len(m.items))
-	if strings.TrimSpace(m.searchBuf) != "" {
-		countLine = fmt.Sprintf("%s · search: %s", countLine, strings.TrimSpace(m.searchBuf))
-		if m.searchSuggest != "" && !strings.EqualFold(strings.TrimSpace(m.searchBuf), strings.TrimSpace(m.searchSuggest)) {
+	if strings.TrimSpace(m.searchBuf.Value) != "" {
+		countLine = fmt.Sprintf("%s · search: %s", countLine, strings.TrimSpace(m.searchBuf.Value))
+		if m.searchSuggest != "" && !strings.EqualFold(strings.TrimSpace(m.searchBuf.Value), strings.TrimSpace(m.searchSuggest)) {
 			countLine = fmt.Sprintf("%s · next: %s", countLine, strings.TrimSpace(m.searchSuggest))
 		}
 	}
-	countLine = MutedStyle.Render(countLine)
+	if tail := m.renderTailStatus(); tail != "" {
+		countLine = fmt.Sprintf("%s · %s", countLine, tail)
+	}
+	countLine = MutedStyle.Render(countLine) + renderLoadLatency(m.loadLatency)
 
 	table := components.TableGridWithActiveRow(cols, tableRows, tableWidth, activeRowRel)
 	preview := ""
@@ -465,6 +505,9 @@ func (m LogsModel) renderLogPreview(l api.Log, width int) string {
 	lines = append(lines, "")
 
 	lines = append(lines, renderPreviewRow("Status", status, width))
+	if level := logLevel(l); level != "" {
+		lines = append(lines, renderPreviewRow("Level", level, width))
+	}
 	lines = append(lines, renderPreviewRow("At", formatLocalTimeFull(at), width))
 	if len(l.Tags) > 0 {
 		lines = append(lines, renderPreviewRow("Tags", strings.Join(l.Tags, ", "), width))
@@ -484,6 +527,11 @@ func (m LogsModel) handleListKeys(msg tea.KeyMsg) (LogsModel, tea.Cmd) {
 	if m.filtering {
 		return m.handleFilterInput(msg)
 	}
+	if m.groupBy != "" {
+		if model, cmd, handled := m.handleGroupKeys(msg); handled {
+			return model, cmd
+		}
+	}
 	switch {
 	case isDown(msg):
 		m.list.Down()
@@ -504,32 +552,43 @@ func (m LogsModel) handleListKeys(msg tea.KeyMsg) (LogsModel, tea.Cmd) {
 	case isKey(msg, "f"):
 		m.filtering = true
 		return m, nil
+	case isKey(msg, "L"):
+		m.cycleLevelFilter()
+	case isKey(msg, "T"):
+		m.searchBuf.SetValue(cycleWindowPreset(m.searchBuf.Value))
+		m.applyLogSearch()
+		return m, m.syncWindow()
+	case isKey(msg, "F"):
+		return m, m.toggleFollow()
+	case isKey(msg, "G"):
+		return m, m.cycleGroupBy()
 	case isKey(msg, "backspace", "delete"):
-		if len(m.searchBuf) > 0 {
-			m.searchBuf = m.searchBuf[:len(m.searchBuf)-1]
+		if m.searchBuf.Value != "" {
+			m.searchBuf.Backspace()
 			m.applyLogSearch()
 		}
 	case isKey(msg, "cmd+backspace", "cmd+delete", "ctrl+u"):
-		if m.searchBuf != "" {
-			m.searchBuf = ""
+		if m.searchBuf.Value != "" {
+			m.searchBuf.Reset()
 			m.searchSuggest = ""
 			m.applyLogSearch()
+			return m, m.syncWindow()
 		}
 	case isBack(msg):
-		if m.searchBuf != "" {
-			m.searchBuf = ""
+		if m.searchBuf.Value != "" {
+			m.searchBuf.Reset()
 			m.searchSuggest = ""
 			m.applyLogSearch()
+			return m, m.syncWindow()
 		}
 	case isKey(msg, "tab"):
-		if m.searchSuggest != "" && !strings.EqualFold(strings.TrimSpace(m.searchBuf), strings.TrimSpace(m.searchSuggest)) {
-			m.searchBuf = m.searchSuggest
+		if m.searchSuggest != "" && !strings.EqualFold(strings.TrimSpace(m.searchBuf.Value), strings.TrimSpace(m.searchSuggest)) {
+			m.searchBuf.SetValue(m.searchSuggest)
 			m.applyLogSearch()
 		}
 	default:
-		ch := msg.String()
-		if len(ch) == 1 {
-			m.searchBuf += ch
+		if text, ok := typedText(msg); ok {
+			m.searchBuf.Insert(text)
 			m.applyLogSearch()
 		}
 	}
@@ -541,23 +600,17 @@ func (m LogsModel) handleFilterInput(msg tea.KeyMsg) (LogsModel, tea.Cmd) {
 	switch {
 	case isEnter(msg):
 		m.filtering = false
+		return m, m.syncWindow()
 	case isBack(msg):
 		m.filtering = false
-		m.searchBuf = ""
+		m.searchBuf.Reset()
 		m.searchSuggest = ""
 		m.applyLogSearch()
-	case isKey(msg, "backspace", "delete"):
-		if len(m.searchBuf) > 0 {
-			m.searchBuf = m.searchBuf[:len(m.searchBuf)-1]
-			m.applyLogSearch()
-		}
+		return m, m.syncWindow()
+	case isSpace(msg) && m.searchBuf.Value == "":
+		return m, nil
 	default:
-		ch := msg.String()
-		if len(ch) == 1 || ch == " " {
-			if ch == " " && m.searchBuf == "" {
-				return m, nil
-			}
-			m.searchBuf += ch
+		if editChanged(&m.searchBuf, msg) {
 			m.applyLogSearch()
 		}
 	}
@@ -658,6 +711,9 @@ func (m LogsModel) handleAddKeys(msg tea.KeyMsg) (LogsModel, tea.Cmd) {
 			return m, nil
 		}
 	}
+	if in := m.addInput(m.addFocus); in != nil && in.HandleKey(msg) {
+		return m, nil
+	}
 	switch {
 	case isDown(msg):
 		m.addFocus = (m.addFocus + 1) % logFieldCount
@@ -674,19 +730,11 @@ func (m LogsModel) handleAddKeys(msg tea.KeyMsg) (LogsModel, tea.Cmd) {
 	case isKey(msg, "backspace", "delete"):
 		switch m.addFocus {
 		case logFieldTags:
-			if len(m.addTagBuf) > 0 {
-				m.addTagBuf = m.addTagBuf[:len(m.addTagBuf)-1]
+			if m.addTagBuf.Value != "" {
+				m.addTagBuf.Backspace()
 			} else if len(m.addTags) > 0 {
 				m.addTags = m.addTags[:len(m.addTags)-1]
 			}
-		case logFieldType:
-			if len(m.addType) > 0 {
-				m.addType = m.addType[:len(m.addType)-1]
-			}
-		case logFieldTimestamp:
-			if len(m.addTimestamp) > 0 {
-				m.addTimestamp = m.addTimestamp[:len(m.addTimestamp)-1]
-			}
 		default:
 			return m, nil
 		}
@@ -694,23 +742,13 @@ func (m LogsModel) handleAddKeys(msg tea.KeyMsg) (LogsModel, tea.Cmd) {
 		switch m.addFocus {
 		case logFieldTags:
 			switch {
+			case pasteList(&m.addTagBuf, msg, m.commitAddTag):
+			case isKey(msg, "tab"):
+				m.addTagBuf.SetValue(completeTag(m.tagOptions, m.addTagBuf.Value, m.addTags))
 			case isSpace(msg) || isKey(msg, ",") || isEnter(msg):
 				m.commitAddTag()
 			default:
-				ch := msg.String()
-				if len(ch) == 1 && ch != "," {
-					m.addTagBuf += ch
-				}
-			}
-		case logFieldType:
-			ch := msg.String()
-			if len(ch) == 1 || ch == " " {
-				m.addType += ch
-			}
-		case logFieldTimestamp:
-			ch := msg.String()
-			if len(ch) == 1 || ch == " " || ch == ":" || ch == "-" || ch == "T" || ch == "Z" || ch == "+" {
-				m.addTimestamp += ch
+				m.addTagBuf.HandleKey(msg)
 			}
 		case logFieldValue:
 			if isEnter(msg) {
@@ -725,6 +763,18 @@ func (m LogsModel) handleAddKeys(msg tea.KeyMsg) (LogsModel, tea.Cmd) {
 	return m, nil
 }
 
+// addInput returns the add form text input at focus, or nil when that field
+// is not free text.
+func (m *LogsModel) addInput(focus int) *components.TextInput {
+	switch focus {
+	case logFieldType:
+		return &m.addType
+	case logFieldTimestamp:
+		return &m.addTimestamp
+	}
+	return nil
+}
+
 // renderAdd renders render add.
 func (m LogsModel) renderAdd() string {
 	var b strings.Builder
@@ -739,20 +789,20 @@ func (m LogsModel) renderAdd() string {
 
 		switch i {
 		case logFieldType:
-			if m.addType == "" && i != m.addFocus {
+			if m.addType.Value == "" && i != m.addFocus {
 				b.WriteString(NormalStyle.Render("  -"))
 			} else if i == m.addFocus {
-				b.WriteString(NormalStyle.Render("  " + m.addType + AccentStyle.Render("█")))
+				b.WriteString(NormalStyle.Render("  " + renderInput(m.addType, m.width)))
 			} else {
-				b.WriteString(NormalStyle.Render("  " + m.addType))
+				b.WriteString(NormalStyle.Render("  " + m.addType.Value))
 			}
 		case logFieldTimestamp:
-			if m.addTimestamp == "" && i != m.addFocus {
+			if m.addTimestamp.Value == "" && i != m.addFocus {
 				b.WriteString(NormalStyle.Render("  -"))
 			} else if i == m.addFocus {
-				b.WriteString(NormalStyle.Render("  " + m.addTimestamp + AccentStyle.Render("█")))
+				b.WriteString(NormalStyle.Render("  " + renderInput(m.addTimestamp, m.width)))
 			} else {
-				b.WriteString(NormalStyle.Render("  " + m.addTimestamp))
+				b.WriteString(NormalStyle.Render("  " + m.addTimestamp.Value))
 			}
 		case logFieldStatus:
 			status := logStatusOptions[m.addStatusIdx]
@@ -786,13 +836,13 @@ func (m LogsModel) renderAdd() string {
 
 // saveAdd handles save add.
 func (m LogsModel) saveAdd() (LogsModel, tea.Cmd) {
-	logType := strings.TrimSpace(m.addType)
+	logType := strings.TrimSpace(m.addType.Value)
 	if logType == "" {
 		m.addErr = "Type is required"
 		return m, nil
 	}
 	status := logStatusOptions[m.addStatusIdx]
-	timestamp, err := parseLogTimestamp(m.addTimestamp)
+	timestamp, err := parseLogTimestamp(m.addTimestamp.Value)
 	if err != nil {
 		m.addErr = err.Error()
 		return m, nil
@@ -835,38 +885,38 @@ func (m *LogsModel) resetAddForm() {
 	m.addFocus = 0
 	m.addStatusIdx = statusIndex(logStatusOptions, "active")
 	m.addTags = nil
-	m.addTagBuf = ""
-	m.addType = ""
-	m.addTimestamp = ""
+	m.addTagBuf.Reset()
+	m.addType.Reset()
+	m.addTimestamp.Reset()
 	m.addValue.Reset()
 	m.addMeta.Reset()
 }
 
 // commitAddTag handles commit add tag.
 func (m *LogsModel) commitAddTag() {
-	raw := strings.TrimSpace(m.addTagBuf)
+	raw := strings.TrimSpace(m.addTagBuf.Value)
 	if raw == "" {
-		m.addTagBuf = ""
+		m.addTagBuf.Reset()
 		return
 	}
-	tag := normalizeTag(raw)
+	tag := canonicalTag(m.tagOptions, normalizeTag(raw))
 	if tag == "" {
-		m.addTagBuf = ""
+		m.addTagBuf.Reset()
 		return
 	}
 	for _, t := range m.addTags {
 		if t == tag {
-			m.addTagBuf = ""
+			m.addTagBuf.Reset()
 			return
 		}
 	}
 	m.addTags = append(m.addTags, tag)
-	m.addTagBuf = ""
+	m.addTagBuf.Reset()
 }
 
 // renderAddTags renders render add tags.
 func (m LogsModel) renderAddTags(focused bool) string {
-	if len(m.addTags) == 0 && m.addTagBuf == "" && !focused {
+	if len(m.addTags) == 0 && m.addTagBuf.Value == "" && !focused {
 		return "-"
 	}
 	var b strings.Builder
@@ -880,15 +930,13 @@ func (m LogsModel) renderAddTags(focused bool) string {
 		if b.Len() > 0 {
 			b.WriteString(" ")
 		}
-		if m.addTagBuf != "" {
-			b.WriteString(m.addTagBuf)
-		}
-		b.WriteString(AccentStyle.Render("█"))
-	} else if m.addTagBuf != "" {
+		b.WriteString(m.addTagBuf.View(AccentStyle, 0))
+		b.WriteString(renderTagSuggestions(m.tagOptions, m.addTagBuf.Value, m.addTags))
+	} else if m.addTagBuf.Value != "" {
 		if b.Len() > 0 {
 			b.WriteString(" ")
 		}
-		b.WriteString(MutedStyle.Render(m.addTagBuf))
+		b.WriteString(MutedStyle.Render(m.addTagBuf.Value))
 	}
 	return b.String()
 }
@@ -903,7 +951,7 @@ func (m LogsModel) startEdit() {
 	m.editFocus = 0
 	m.editStatusIdx = statusIndex(logStatusOptions, l.Status)
 	m.editTags = append([]string{}, l.Tags...)
-	m.editTagBuf = ""
+	m.editTagBuf.Reset()
 	m.editType = l.LogType
 	m.editTimestamp = l.Timestamp.Format(time.RFC3339)
 	m.editValue.Load(map[string]any(l.Value))
@@ -940,8 +988,8 @@ func (m LogsModel) handleEditKeys(msg tea.KeyMsg) (LogsModel, tea.Cmd) {
 	case isKey(msg, "backspace", "delete"):
 		switch m.editFocus {
 		case logEditFieldTags:
-			if len(m.editTagBuf) > 0 {
-				m.editTagBuf = m.editTagBuf[:len(m.editTagBuf)-1]
+			if m.editTagBuf.Value != "" {
+				m.editTagBuf.Backspace()
 			} else if len(m.editTags) > 0 {
 				m.editTags = m.editTags[:len(m.editTags)-1]
 			}
@@ -950,13 +998,13 @@ func (m LogsModel) handleEditKeys(msg tea.KeyMsg) (LogsModel, tea.Cmd) {
 		switch m.editFocus {
 		case logEditFieldTags:
 			switch {
+			case pasteList(&m.editTagBuf, msg, m.commitEditTag):
+			case isKey(msg, "tab"):
+				m.editTagBuf.SetValue(completeTag(m.tagOptions, m.editTagBuf.Value, m.editTags))
 			case isSpace(msg) || isKey(msg, ",") || isEnter(msg):
 				m.commitEditTag()
 			default:
-				ch := msg.String()
-				if len(ch) == 1 && ch != "," {
-					m.editTagBuf += ch
-				}
+				m.editTagBuf.HandleKey(msg)
 			}
 		case logEditFieldValue:
 			if isEnter(msg) {
@@ -1038,29 +1086,29 @@ func (m LogsModel) saveEdit() (LogsModel, tea.Cmd) {
 
 // commitEditTag handles commit edit tag.
 func (m *LogsModel) commitEditTag() {
-	raw := strings.TrimSpace(m.editTagBuf)
+	raw := strings.TrimSpace(m.editTagBuf.Value)
 	if raw == "" {
-		m.editTagBuf = ""
+		m.editTagBuf.Reset()
 		return
 	}
-	tag := normalizeTag(raw)
+	tag := canonicalTag(m.tagOptions, normalizeTag(raw))
 	if tag == "" {
-		m.editTagBuf = ""
+		m.editTagBuf.Reset()
 		return
 	}
 	for _, t := range m.editTags {
 		if t == tag {
-			m.editTagBuf = ""
+			m.editTagBuf.Reset()
 			return
 		}
 	}
 	m.editTags = append(m.editTags, tag)
-	m.editTagBuf = ""
+	m.editTagBuf.Reset()
 }
 
 // renderEditTags renders render edit tags.
 func (m LogsModel) renderEditTags(focused bool) string {
-	if len(m.editTags) == 0 && m.editTagBuf == "" && !focused {
+	if len(m.editTags) == 0 && m.editTagBuf.Value == "" && !focused {
 		return "-"
 	}
 	var b strings.Builder
@@ -1074,15 +1122,13 @@ func (m LogsModel) renderEditTags(focused bool) string {
 		if b.Len() > 0 {
 			b.WriteString(" ")
 		}
-		if m.editTagBuf != "" {
-			b.WriteString(m.editTagBuf)
-		}
-		b.WriteString(AccentStyle.Render("█"))
-	} else if m.editTagBuf != "" {
+		b.WriteString(m.editTagBuf.View(AccentStyle, 0))
+		b.WriteString(renderTagSuggestions(m.tagOptions, m.editTagBuf.Value, m.editTags))
+	} else if m.editTagBuf.Value != "" {
 		if b.Len() > 0 {
 			b.WriteString(" ")
 		}
-		b.WriteString(MutedStyle.Render(m.editTagBuf))
+		b.WriteString(MutedStyle.Render(m.editTagBuf.Value))
 	}
 	return b.String()
 }
@@ -1090,12 +1136,15 @@ func (m LogsModel) renderEditTags(focused bool) string {
 // --- Data ---
 
 func (m LogsModel) loadLogs() tea.Cmd {
+	queued := time.Now()
 	return func() tea.Msg {
-		items, err := m.client.QueryLogs(api.QueryParams{"status_category": "active"})
+		params := api.QueryParams{"status_category": "active"}
+		m.window.Apply(params)
+		items, err := m.client.QueryLogs(params)
 		if err != nil {
-			return errMsg{err}
+			return loadFailed(tabLogs, err, m.loadLogs())
 		}
-		return logsLoadedMsg{items}
+		return logsLoadedMsg{items: items, queued: queued}
 	}
 }
 
@@ -1117,15 +1166,31 @@ func (m LogsModel) loadScopeOptions() tea.Cmd {
 	}
 }
 
+// syncWindow reloads logs when the search's time window changed, so the
+// window reaches past the loaded page.
+func (m *LogsModel) syncWindow() tea.Cmd {
+	window, _ := splitWindowFilter(m.searchBuf.Value)
+	if window == m.window || m.client == nil {
+		return nil
+	}
+	m.window = window
+	m.loading = true
+	return m.loadLogs()
+}
+
 // applyLogSearch handles apply log search.
 func (m *LogsModel) applyLogSearch() {
-	query := strings.TrimSpace(strings.ToLower(m.searchBuf))
-	if query == "" {
+	window, text := splitWindowFilter(m.searchBuf.Value)
+	query := strings.TrimSpace(strings.ToLower(text))
+	if query == "" && m.levelFilter == "" && window.IsZero() {
 		m.items = m.allItems
 	} else {
 		filtered := make([]api.Log, 0, len(m.allItems))
 		for _, l := range m.allItems {
-			hay := strings.ToLower(strings.Join([]string{l.LogType, l.ID, l.Status}, " "))
+			if !logMatchesLevel(l, m.levelFilter) || !windowContains(window, l.Timestamp) {
+				continue
+			}
+			hay := strings.ToLower(strings.Join([]string{l.LogType, l.ID, l.Status, metadataPreview(map[string]any(l.Value), 80)}, " "))
 			if strings.Contains(hay, query) {
 				filtered = append(filtered, l)
 			}
@@ -1143,7 +1208,7 @@ func (m *LogsModel) applyLogSearch() {
 // updateSearchSuggest updates update search suggest.
 func (m *LogsModel) updateSearchSuggest() {
 	m.searchSuggest = ""
-	query := strings.ToLower(strings.TrimSpace(m.searchBuf))
+	query := strings.ToLower(strings.TrimSpace(m.searchBuf.Value))
 	if query == "" {
 		return
 	}
@@ -50,9 +50,9 @@ func TestLogsHandleEditKeysAdditionalBranchMatrix(t *testing.T) {
 	// tags rune append + commit via comma branch.
 	updated.editFocus = logEditFieldTags
 	updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
-	assert.Equal(t, "x", updated.editTagBuf)
+	assert.Equal(t, "x", updated.editTagBuf.Value)
 	updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{','}})
-	assert.Empty(t, updated.editTagBuf)
+	assert.Empty(t, updated.editTagBuf.Value)
 	assert.Contains(t, updated.editTags, "x")
 
 	// back key branch exits edit view.
@@ -0,0 +1,326 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+const (
+	logGroupByJob   = "job"
+	logGroupByAgent = "agent"
+	logGroupRows    = 14
+)
+
+// logGroupKeys lists the value and metadata keys that name a log's job or
+// agent, in lookup order.
+var logGroupKeys = map[string][]string{
+	logGroupByJob:   {"job_id", "job"},
+	logGroupByAgent: {"agent", "agent_name", "agent_id"},
+}
+
+type logJobTitlesLoadedMsg struct{ titles map[string]string }
+
+// logGroup buckets the log entries that share a job or agent.
+type logGroup struct {
+	key    string
+	logs   []api.Log
+	counts map[string]int
+	latest time.Time
+}
+
+// logGroupRow is one line of the grouped view: a group header, or an entry of
+// an expanded group when log is set.
+type logGroupRow struct {
+	group int
+	log   *api.Log
+}
+
+// logGroupKey reads the job or agent a log belongs to, "" when it names none.
+func logGroupKey(l api.Log, groupBy string) string {
+	for _, source := range []api.JSONMap{l.Value, l.Metadata} {
+		for _, key := range logGroupKeys[groupBy] {
+			if raw, ok := source[key]; ok && raw != nil {
+				if text := strings.TrimSpace(fmt.Sprintf("%v", raw)); text != "" {
+					return text
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// buildLogGroups buckets items by groupBy. Groups with the most recent
+// activity come first and entries without a job or agent come last.
+func buildLogGroups(items []api.Log, groupBy string) []logGroup {
+	index := map[string]int{}
+	var groups []logGroup
+	for _, l := range items {
+		key := logGroupKey(l, groupBy)
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, logGroup{key: key, counts: map[string]int{}})
+		}
+		g := &groups[i]
+		g.logs = append(g.logs, l)
+		level := logLevel(l)
+		if level == "" {
+			level = "info"
+		}
+		g.counts[level]++
+		if at := logTimestamp(l); at.After(g.latest) {
+			g.latest = at
+		}
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		if (groups[i].key == "") != (groups[j].key == "") {
+			return groups[j].key == ""
+		}
+		return groups[i].latest.After(groups[j].latest)
+	})
+	return groups
+}
+
+// logTimestamp returns when a log happened, falling back to when it was saved.
+func logTimestamp(l api.Log) time.Time {
+	if !l.Timestamp.IsZero() {
+		return l.Timestamp
+	}
+	if !l.UpdatedAt.IsZero() {
+		return l.UpdatedAt
+	}
+	return l.CreatedAt
+}
+
+// groupRows flattens the groups and their expanded entries into rows.
+func (m LogsModel) groupRows() ([]logGroup, []logGroupRow) {
+	groups := buildLogGroups(m.items, m.groupBy)
+	var rows []logGroupRow
+	for i, g := range groups {
+		rows = append(rows, logGroupRow{group: i})
+		if !m.groupExpanded[g.key] {
+			continue
+		}
+		for j := range g.logs {
+			rows = append(rows, logGroupRow{group: i, log: &groups[i].logs[j]})
+		}
+	}
+	return groups, rows
+}
+
+// cycleGroupBy steps grouping through off, job, and agent. Job titles load
+// the first time jobs are grouped.
+func (m *LogsModel) cycleGroupBy() tea.Cmd {
+	switch m.groupBy {
+	case "":
+		m.groupBy = logGroupByJob
+	case logGroupByJob:
+		m.groupBy = logGroupByAgent
+	default:
+		m.groupBy = ""
+	}
+	m.groupIdx = 0
+	m.groupExpanded = map[string]bool{}
+	if m.groupBy == logGroupByJob && m.jobTitles == nil {
+		return m.loadJobTitles()
+	}
+	return nil
+}
+
+// loadJobTitles fetches job titles to label job groups.
+func (m LogsModel) loadJobTitles() tea.Cmd {
+	if m.client == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		jobs, err := m.client.QueryJobs(api.QueryParams{"limit": "100"})
+		if err != nil {
+			return errMsg{err}
+		}
+		titles := make(map[string]string, len(jobs))
+		for _, job := range jobs {
+			titles[job.ID] = job.Title
+		}
+		return logJobTitlesLoadedMsg{titles: titles}
+	}
+}
+
+// handleGroupKeys moves through the grouped view, expands groups, opens
+// entries, and jumps to a group's job. It reports false for keys the list
+// handles the same way in both modes.
+func (m LogsModel) handleGroupKeys(msg tea.KeyMsg) (LogsModel, tea.Cmd, bool) {
+	groups, rows := m.groupRows()
+	if m.groupIdx >= len(rows) {
+		m.groupIdx = len(rows) - 1
+	}
+	if m.groupIdx < 0 {
+		m.groupIdx = 0
+	}
+	switch {
+	case isDown(msg):
+		if m.groupIdx < len(rows)-1 {
+			m.groupIdx++
+		}
+	case isUp(msg):
+		if m.groupIdx == 0 {
+			m.modeFocus = true
+		} else {
+			m.groupIdx--
+		}
+	case isEnter(msg), isSpace(msg):
+		if m.groupIdx >= len(rows) {
+			return m, nil, true
+		}
+		row := rows[m.groupIdx]
+		if row.log != nil {
+			item := *row.log
+			m.detail = &item
+			m.detailRels = nil
+			m.view = logsViewDetail
+			return m, m.loadDetailRelationships(item.ID), true
+		}
+		key := groups[row.group].key
+		m.groupExpanded[key] = !m.groupExpanded[key]
+	case isKey(msg, "J"):
+		if m.groupBy != logGroupByJob || m.groupIdx >= len(rows) {
+			return m, nil, true
+		}
+		jobID := groups[rows[m.groupIdx].group].key
+		if jobID == "" {
+			m.errText = "These logs are not tied to a job"
+			return m, nil, true
+		}
+		return m, m.jumpToJob(jobID), true
+	case isBack(msg) && m.searchBuf.Value == "":
+		m.groupBy = ""
+		m.groupIdx = 0
+	default:
+		return m, nil, false
+	}
+	return m, nil, true
+}
+
+// jumpToJob opens a job in the jobs tab.
+func (m LogsModel) jumpToJob(id string) tea.Cmd {
+	return func() tea.Msg {
+		job, err := m.client.GetJob(id)
+		if err != nil {
+			return errMsg{err}
+		}
+		return searchSelectionMsg{kind: "job", job: job}
+	}
+}
+
+// groupLabel names a group for display.
+func (m LogsModel) groupLabel(g logGroup) string {
+	if g.key == "" {
+		return "(no " + m.groupBy + ")"
+	}
+	if m.groupBy == logGroupByJob {
+		if title := strings.TrimSpace(m.jobTitles[g.key]); title != "" {
+			return title + " · " + g.key
+		}
+	}
+	return g.key
+}
+
+// renderGroups renders the grouped view with level counts per group.
+func (m LogsModel) renderGroups() string {
+	groups, rows := m.groupRows()
+	contentWidth := components.BoxContentWidth(m.width)
+	sepWidth := 1
+	if b := lipgloss.RoundedBorder().Left; b != "" {
+		sepWidth = lipgloss.Width(b)
+	}
+	// 7 columns -> 6 separators.
+	countWidth := 6
+	atWidth := compactTimeColumnWidth
+	groupWidth := contentWidth - 6*sepWidth - 5*countWidth - atWidth
+	if groupWidth < 20 {
+		groupWidth = 20
+	}
+	cols := []components.TableColumn{
+		{Header: strings.ToUpper(m.groupBy[:1]) + m.groupBy[1:], Width: groupWidth, Align: lipgloss.Left},
+		{Header: "Total", Width: countWidth, Align: lipgloss.Right},
+		{Header: "Debug", Width: countWidth, Align: lipgloss.Right},
+		{Header: "Info", Width: countWidth, Align: lipgloss.Right},
+		{Header: "Warn", Width: countWidth, Align: lipgloss.Right},
+		{Header: "Error", Width: countWidth, Align: lipgloss.Right},
+		{Header: "Last", Width: atWidth, Align: lipgloss.Left},
+	}
+
+	start := 0
+	if m.groupIdx >= logGroupRows {
+		start = m.groupIdx - logGroupRows + 1
+	}
+	end := start + logGroupRows
+	if end > len(rows) {
+		end = len(rows)
+	}
+	tableRows := make([][]string, 0, end-start)
+	active := -1
+	for i := start; i < end; i++ {
+		row := rows[i]
+		if i == m.groupIdx && !m.modeFocus {
+			active = len(tableRows)
+		}
+		if row.log != nil {
+			l := *row.log
+			level := logLevel(l)
+			if level == "" {
+				level = "-"
+			}
+			line := fmt.Sprintf("  %s · %s", components.SanitizeOneLine(l.LogType), metadataPreview(map[string]any(l.Value), 80))
+			tableRows = append(tableRows, []string{
+				highlightSearchTerm(components.ClampTextWidthEllipsis(line, groupWidth), m.searchBuf.Value),
+				"", "", "", "", level,
+				formatLocalTimeCompact(logTimestamp(l)),
+			})
+			continue
+		}
+		g := groups[row.group]
+		marker := "▸ "
+		if m.groupExpanded[g.key] {
+			marker = "▾ "
+		}
+		tableRows = append(tableRows, []string{
+			components.ClampTextWidthEllipsis(marker+components.SanitizeOneLine(m.groupLabel(g)), groupWidth),
+			fmt.Sprintf("%d", len(g.logs)),
+			logGroupCount(g, "debug"),
+			logGroupCount(g, "info"),
+			logGroupCount(g, "warn"),
+			logGroupCount(g, "error"),
+			formatLocalTimeCompact(g.latest),
+		})
+	}
+
+	countLine := fmt.Sprintf("%d groups · %d logs · by %s", len(groups), len(m.items), m.groupBy)
+	if query := strings.TrimSpace(m.searchBuf.Value); query != "" {
+		countLine = fmt.Sprintf("%s · search: %s", countLine, query)
+	}
+	if tail := m.renderTailStatus(); tail != "" {
+		countLine = fmt.Sprintf("%s · %s", countLine, tail)
+	}
+	content := MutedStyle.Render(countLine) + "\n\n" + components.TableGridWithActiveRow(cols, tableRows, contentWidth, active)
+	if m.errText != "" {
+		content += "\n\n" + ErrorStyle.Render(m.errText)
+	}
+	return components.TitledBox("Logs", content+"\n", m.width)
+}
+
+// logGroupCount renders a level count, leaving zero counts blank.
+func logGroupCount(g logGroup, level string) string {
+	if n := g.counts[level]; n > 0 {
+		return fmt.Sprintf("%d", n)
+	}
+	return ""
+}
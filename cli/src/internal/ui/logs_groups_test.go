@@ -0,0 +1,90 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func logsGroupFixture() []api.Log {
+	at := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	return []api.Log{
+		{ID: "l1", LogType: "note", Timestamp: at, Value: api.JSONMap{"job_id": "job-1", "agent": "alpha", "level": "error"}},
+		{ID: "l2", LogType: "note", Timestamp: at.Add(time.Hour), Value: api.JSONMap{"job_id": "job-2", "agent": "alpha"}},
+		{ID: "l3", LogType: "note", Timestamp: at.Add(-time.Hour), Metadata: api.JSONMap{"job_id": "job-1", "agent_name": "beta"}, Value: api.JSONMap{"level": "warn"}},
+		{ID: "l4", LogType: "workout", Timestamp: at.Add(2 * time.Hour)},
+	}
+}
+
+func TestBuildLogGroupsBucketsByJobAndAgent(t *testing.T) {
+	groups := buildLogGroups(logsGroupFixture(), logGroupByJob)
+	require.Len(t, groups, 3)
+	assert.Equal(t, "job-2", groups[0].key)
+	assert.Equal(t, "job-1", groups[1].key)
+	assert.Equal(t, map[string]int{"error": 1, "warn": 1}, groups[1].counts)
+	assert.Equal(t, "", groups[2].key)
+
+	groups = buildLogGroups(logsGroupFixture(), logGroupByAgent)
+	require.Len(t, groups, 3)
+	assert.Equal(t, "alpha", groups[0].key)
+	assert.Len(t, groups[0].logs, 2)
+	assert.Equal(t, "beta", groups[1].key)
+}
+
+func TestLogsGroupViewExpandsAndJumpsToJob(t *testing.T) {
+	_, client := relTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/jobs":
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{{"id": "job-1", "title": "Nightly sync"}}})
+		case "/api/jobs/job-2":
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "job-2", "title": "Backfill"}})
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	model := NewLogsModel(client)
+	model.width = 140
+	model.allItems = logsGroupFixture()
+	model.applyLogSearch()
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'G'}})
+	require.Equal(t, logGroupByJob, model.groupBy)
+	require.NotNil(t, cmd)
+	model, _ = model.Update(cmd())
+	view := components.SanitizeText(model.View())
+	assert.Contains(t, view, "3 groups · 4 logs · by job")
+	assert.Contains(t, view, "Nightly sync · job-1")
+	assert.Contains(t, view, "(no job)")
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.True(t, model.groupExpanded["job-1"])
+	_, rows := model.groupRows()
+	assert.Len(t, rows, 5)
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.Equal(t, logsViewDetail, model.view)
+	assert.Equal(t, "l1", model.detail.ID)
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	model.groupIdx = 0
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'J'}})
+	require.NotNil(t, cmd)
+	sel, ok := cmd().(searchSelectionMsg)
+	require.True(t, ok)
+	assert.Equal(t, "job", sel.kind)
+	assert.Equal(t, "Backfill", sel.job.Title)
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'G'}})
+	assert.Equal(t, logGroupByAgent, model.groupBy)
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, "", model.groupBy)
+}
@@ -140,7 +140,7 @@ func TestLogsLoadLogsSuccessAndError(t *testing.T) {
 		cmd := model.loadLogs()
 		require.NotNil(t, cmd)
 		msg := cmd()
-		em, ok := msg.(errMsg)
+		em, ok := msg.(loadFailedMsg)
 		require.True(t, ok)
 		require.Error(t, em.err)
 		assert.Contains(t, strings.ToLower(em.err.Error()), "logs failed")
@@ -190,18 +190,18 @@ func TestLogsHandleListKeysSearchAndFilterMatrix(t *testing.T) {
 	updated := model
 
 	updated, _ = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'w'}})
-	assert.Equal(t, "w", updated.searchBuf)
+	assert.Equal(t, "w", updated.searchBuf.Value)
 	assert.Equal(t, "workout", updated.searchSuggest)
 	require.Len(t, updated.items, 1)
 
 	updated, _ = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyTab})
-	assert.Equal(t, "workout", updated.searchBuf)
+	assert.Equal(t, "workout", updated.searchBuf.Value)
 
 	updated, _ = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "workou", updated.searchBuf)
+	assert.Equal(t, "workou", updated.searchBuf.Value)
 
 	updated, _ = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyCtrlU})
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 	require.Len(t, updated.items, 2)
 
 	updated, cmd := updated.handleListKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
@@ -209,10 +209,10 @@ func TestLogsHandleListKeysSearchAndFilterMatrix(t *testing.T) {
 	assert.True(t, updated.filtering)
 
 	updated, _ = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
-	assert.Equal(t, "s", updated.searchBuf)
+	assert.Equal(t, "s", updated.searchBuf.Value)
 	updated, _ = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyEsc})
 	assert.False(t, updated.filtering)
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 }
 
 func TestLogsHandleAddKeysStatusAndBackspaceMatrix(t *testing.T) {
@@ -229,27 +229,27 @@ func TestLogsHandleAddKeysStatusAndBackspaceMatrix(t *testing.T) {
 
 	updated.addFocus = logFieldTags
 	updated.addTags = []string{"alpha"}
-	updated.addTagBuf = "x"
+	updated.addTagBuf.Value = "x"
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "", updated.addTagBuf)
+	assert.Equal(t, "", updated.addTagBuf.Value)
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyBackspace})
 	assert.Empty(t, updated.addTags)
 
 	updated.addFocus = logFieldType
-	updated.addType = "work"
+	updated.addType.Value = "work"
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "wor", updated.addType)
+	assert.Equal(t, "wor", updated.addType.Value)
 
 	updated.addFocus = logFieldTimestamp
-	updated.addTimestamp = "2026-02-27"
+	updated.addTimestamp.Value = "2026-02-27"
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "2026-02-2", updated.addTimestamp)
+	assert.Equal(t, "2026-02-2", updated.addTimestamp.Value)
 
 	updated.addSaved = true
-	updated.addType = "workout"
+	updated.addType.Value = "workout"
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyEsc})
 	assert.False(t, updated.addSaved)
-	assert.Equal(t, "", updated.addType)
+	assert.Equal(t, "", updated.addType.Value)
 }
 
 func TestLogsHandleAddKeysAdditionalBranches(t *testing.T) {
@@ -263,11 +263,11 @@ func TestLogsHandleAddKeysAdditionalBranches(t *testing.T) {
 
 	model.addSaving = false
 	model.addSaved = true
-	model.addType = "kept"
+	model.addType.Value = "kept"
 	updated, cmd = model.handleAddKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
 	require.Nil(t, cmd)
 	assert.True(t, updated.addSaved)
-	assert.Equal(t, "kept", updated.addType)
+	assert.Equal(t, "kept", updated.addType.Value)
 
 	model.addSaved = false
 	model.modeFocus = true
@@ -278,12 +278,12 @@ func TestLogsHandleAddKeysAdditionalBranches(t *testing.T) {
 	updated.view = logsViewAdd
 	updated.modeFocus = false
 	updated.addFocus = logFieldType
-	updated.addType = ""
+	updated.addType.Value = ""
 	updated, cmd = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
 	require.Nil(t, cmd)
-	assert.Equal(t, "e", updated.addType)
+	assert.Equal(t, "e", updated.addType.Value)
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeySpace})
-	assert.Equal(t, "e ", updated.addType)
+	assert.Equal(t, "e ", updated.addType.Value)
 
 	updated.addFocus = logFieldTimestamp
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'2'}})
@@ -304,9 +304,9 @@ func TestLogsHandleAddKeysAdditionalBranches(t *testing.T) {
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'5'}})
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'Z'}})
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'+'}})
-	beforeTS := updated.addTimestamp
+	beforeTS := updated.addTimestamp.Value
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyTab})
-	assert.Equal(t, beforeTS, updated.addTimestamp)
+	assert.Equal(t, beforeTS, updated.addTimestamp.Value)
 
 	updated.addFocus = logFieldValue
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyEnter})
@@ -328,13 +328,13 @@ func TestLogsRenderAddBranchMatrix(t *testing.T) {
 	model.width = 96
 
 	model.addFocus = logFieldType
-	model.addType = "event"
+	model.addType.Value = "event"
 	out := stripANSI(model.renderAdd())
 	assert.Contains(t, out, "Type:")
 	assert.Contains(t, out, "event")
 
 	model.addFocus = logFieldTimestamp
-	model.addTimestamp = "2026-03-01T12:45Z"
+	model.addTimestamp.Value = "2026-03-01T12:45Z"
 	out = stripANSI(model.renderAdd())
 	assert.Contains(t, out, "Timestamp:")
 	assert.Contains(t, out, "2026-03-01T12:45Z")
@@ -347,7 +347,7 @@ func TestLogsRenderAddBranchMatrix(t *testing.T) {
 
 	model.addFocus = logFieldTags
 	model.addTags = []string{"alpha"}
-	model.addTagBuf = "beta"
+	model.addTagBuf.Value = "beta"
 	out = stripANSI(model.renderAdd())
 	assert.Contains(t, out, "[alpha]")
 	assert.Contains(t, out, "beta")
@@ -391,9 +391,9 @@ func TestLogsHandleEditKeysStatusAndTagMatrix(t *testing.T) {
 
 	updated.editFocus = logEditFieldTags
 	updated.editTags = []string{"alpha"}
-	updated.editTagBuf = "x"
+	updated.editTagBuf.Value = "x"
 	updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "", updated.editTagBuf)
+	assert.Equal(t, "", updated.editTagBuf.Value)
 	updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyBackspace})
 	assert.Empty(t, updated.editTags)
 
@@ -413,13 +413,13 @@ func TestLogsHandleEditKeysStatusAndTagMatrix(t *testing.T) {
 
 func TestLogsSaveAddAndEditValidationBranches(t *testing.T) {
 	model := NewLogsModel(nil)
-	model.addType = "event"
-	model.addTimestamp = "not-a-date"
+	model.addType.Value = "event"
+	model.addTimestamp.Value = "not-a-date"
 	updated, cmd := model.saveAdd()
 	assert.Nil(t, cmd)
 	assert.Contains(t, updated.addErr, "timestamp")
 
-	model.addTimestamp = ""
+	model.addTimestamp.Value = ""
 	model.addValue.Buffer = "invalid"
 	updated, cmd = model.saveAdd()
 	assert.Nil(t, cmd)
@@ -482,12 +482,12 @@ func TestLogsSearchHelpersAndFormatLine(t *testing.T) {
 		{ID: "log-2", LogType: "study", Status: "inactive", Timestamp: time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)},
 	}
 
-	model.searchBuf = "work"
+	model.searchBuf.Value = "work"
 	model.applyLogSearch()
 	require.Len(t, model.items, 1)
 	assert.Equal(t, "workout", model.searchSuggest)
 
-	model.searchBuf = "zzz"
+	model.searchBuf.Value = "zzz"
 	model.applyLogSearch()
 	assert.Empty(t, model.items)
 
@@ -64,11 +64,11 @@ func TestLogsHandleListKeysAdditionalBranches(t *testing.T) {
 	assert.False(t, updated.filtering)
 
 	updated.filtering = false
-	updated.searchBuf = "workout"
+	updated.searchBuf.Value = "workout"
 	updated.searchSuggest = "workout"
 	updated, cmd = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyCtrlU})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 	assert.Equal(t, "", updated.searchSuggest)
 
 	updated.list.Cursor = 8
@@ -98,34 +98,34 @@ func TestLogsHandleListKeysAdditionalBranches(t *testing.T) {
 	require.Nil(t, cmd)
 	assert.Equal(t, 1, updated.list.Cursor)
 
-	updated.searchBuf = "wo"
+	updated.searchBuf.Value = "wo"
 	updated, cmd = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "w", updated.searchBuf)
+	assert.Equal(t, "w", updated.searchBuf.Value)
 	updated, cmd = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyDelete})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 
 	updated, cmd = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
 	require.Nil(t, cmd)
 	assert.True(t, updated.filtering)
 	updated.filtering = false
 
-	updated.searchBuf = ""
+	updated.searchBuf.Value = ""
 	updated, cmd = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 
 	updated, cmd = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyTab})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 
-	updated.searchBuf = ""
+	updated.searchBuf.Value = ""
 	updated, cmd = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'w'}})
 	require.Nil(t, cmd)
-	assert.Equal(t, "w", updated.searchBuf)
+	assert.Equal(t, "w", updated.searchBuf.Value)
 
-	updated.searchBuf = ""
+	updated.searchBuf.Value = ""
 	updated.list.Cursor = 0
 	updated, cmd = updated.handleListKeys(tea.KeyMsg{Type: tea.KeySpace})
 	require.NotNil(t, cmd)
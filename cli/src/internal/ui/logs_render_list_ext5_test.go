@@ -43,7 +43,7 @@ func TestLogsRenderListLoadingEmptyAndLayoutBranches(t *testing.T) {
 	}
 	model.list.SetItems([]string{"log-1", "log-2"})
 	model.width = 84 // stacked preview layout
-	model.searchBuf = "dep"
+	model.searchBuf.Value = "dep"
 	model.searchSuggest = "deploy"
 	out = components.SanitizeText(model.renderList())
 	assert.Contains(t, out, "2 total")
@@ -52,7 +52,7 @@ func TestLogsRenderListLoadingEmptyAndLayoutBranches(t *testing.T) {
 	assert.Contains(t, out, "log")
 	assert.Contains(t, out, "Selected")
 
-	model.searchBuf = "deploy"
+	model.searchBuf.Value = "deploy"
 	model.searchSuggest = "deploy"
 	out = components.SanitizeText(model.renderList())
 	assert.NotContains(t, out, "next: ")
@@ -79,30 +79,30 @@ func TestLogsHandleListKeysTabBackAndSpaceOutOfRange(t *testing.T) {
 	model.applyLogSearch()
 	model.list.SetItems([]string{"workout", "deploy"})
 
-	model.searchBuf = "wo"
+	model.searchBuf.Value = "wo"
 	model.searchSuggest = "workout"
 	updated, cmd := model.handleListKeys(tea.KeyMsg{Type: tea.KeyTab})
 	require.Nil(t, cmd)
-	assert.Equal(t, "workout", updated.searchBuf)
+	assert.Equal(t, "workout", updated.searchBuf.Value)
 
-	updated.searchBuf = "workout"
+	updated.searchBuf.Value = "workout"
 	updated.searchSuggest = "workout"
 	updated, cmd = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyTab})
 	require.Nil(t, cmd)
-	assert.Equal(t, "workout", updated.searchBuf)
+	assert.Equal(t, "workout", updated.searchBuf.Value)
 
-	updated.searchBuf = "abc"
+	updated.searchBuf.Value = "abc"
 	updated.searchSuggest = "abc"
 	updated, cmd = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyEsc})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 	assert.Equal(t, "", updated.searchSuggest)
 
-	updated.searchBuf = ""
+	updated.searchBuf.Value = ""
 	updated.searchSuggest = ""
 	updated, cmd = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyCtrlU})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 
 	updated.list.Cursor = 9
 	updated.view = logsViewList
@@ -112,9 +112,9 @@ func TestLogsHandleListKeysTabBackAndSpaceOutOfRange(t *testing.T) {
 	assert.Nil(t, updated.detail)
 
 	updated.filtering = true
-	updated.searchBuf = "x"
+	updated.searchBuf.Value = "x"
 	updated, cmd = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyEnter})
 	require.Nil(t, cmd)
 	assert.False(t, updated.filtering)
-	assert.Equal(t, "x", updated.searchBuf)
+	assert.Equal(t, "x", updated.searchBuf.Value)
 }
@@ -247,7 +247,7 @@ func TestLogsAddFlowCommitsTagsAndSaves(t *testing.T) {
 	for _, r := range "workout" {
 		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
 	}
-	assert.Equal(t, "workout", model.addType)
+	assert.Equal(t, "workout", model.addType.Value)
 
 	// Move to Tags field.
 	for i := 0; i < 3; i++ {
@@ -400,7 +400,7 @@ func TestLogsRenderAddEditAndTagHelpers(t *testing.T) {
 	model.width = 96
 
 	model.addFocus = logFieldTags
-	model.addTagBuf = "alpha"
+	model.addTagBuf.Value = "alpha"
 	model.commitAddTag()
 	addTags := components.SanitizeText(model.renderAddTags(true))
 	assert.Contains(t, addTags, "[alpha]")
@@ -410,12 +410,12 @@ func TestLogsRenderAddEditAndTagHelpers(t *testing.T) {
 	assert.Contains(t, addView, "Type")
 	assert.Contains(t, addView, "Status")
 
-	model.addType = "event"
-	model.addTimestamp = now.Format(time.RFC3339)
+	model.addType.Value = "event"
+	model.addTimestamp.Value = now.Format(time.RFC3339)
 	model.addStatusIdx = 2
 	model.addSaved = true
 	model.resetAddForm()
-	assert.Equal(t, "", model.addType)
+	assert.Equal(t, "", model.addType.Value)
 	assert.Equal(t, 0, model.addFocus)
 	assert.False(t, model.addSaved)
 
@@ -430,7 +430,7 @@ func TestLogsRenderAddEditAndTagHelpers(t *testing.T) {
 	}
 	model.startEdit()
 	model.editFocus = logEditFieldTags
-	model.editTagBuf = "beta"
+	model.editTagBuf.Value = "beta"
 	model.commitEditTag()
 
 	editTags := components.SanitizeText(model.renderEditTags(true))
@@ -10,25 +10,25 @@ func TestLogsCommitEditTagBranchMatrix(t *testing.T) {
 	model := NewLogsModel(nil)
 
 	model.editTags = []string{"alpha"}
-	model.editTagBuf = "   "
+	model.editTagBuf.Value = "   "
 	model.commitEditTag()
 	assert.Equal(t, []string{"alpha"}, model.editTags)
-	assert.Equal(t, "", model.editTagBuf)
+	assert.Equal(t, "", model.editTagBuf.Value)
 
-	model.editTagBuf = "#"
+	model.editTagBuf.Value = "#"
 	model.commitEditTag()
 	assert.Equal(t, []string{"alpha"}, model.editTags)
-	assert.Equal(t, "", model.editTagBuf)
+	assert.Equal(t, "", model.editTagBuf.Value)
 
-	model.editTagBuf = "alpha"
+	model.editTagBuf.Value = "alpha"
 	model.commitEditTag()
 	assert.Equal(t, []string{"alpha"}, model.editTags)
-	assert.Equal(t, "", model.editTagBuf)
+	assert.Equal(t, "", model.editTagBuf.Value)
 
-	model.editTagBuf = "beta tag"
+	model.editTagBuf.Value = "beta tag"
 	model.commitEditTag()
 	assert.Equal(t, []string{"alpha", "beta-tag"}, model.editTags)
-	assert.Equal(t, "", model.editTagBuf)
+	assert.Equal(t, "", model.editTagBuf.Value)
 }
 
 func TestLogsRenderEditTagsBranchMatrix(t *testing.T) {
@@ -41,7 +41,7 @@ func TestLogsRenderEditTagsBranchMatrix(t *testing.T) {
 	assert.Contains(t, out, "[alpha]")
 	assert.NotContains(t, out, "█")
 
-	model.editTagBuf = "beta"
+	model.editTagBuf.Value = "beta"
 	out = stripANSI(model.renderEditTags(false))
 	assert.Contains(t, out, "[alpha]")
 	assert.Contains(t, out, "beta")
@@ -52,7 +52,7 @@ func TestLogsRenderEditTagsBranchMatrix(t *testing.T) {
 	assert.Contains(t, out, "beta")
 	assert.Contains(t, out, "█")
 
-	model.editTagBuf = ""
+	model.editTagBuf.Value = ""
 	out = stripANSI(model.renderEditTags(true))
 	assert.Contains(t, out, "[alpha]")
 	assert.Contains(t, out, "█")
@@ -0,0 +1,172 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+// logFollowInterval is how often follow mode polls for new entries.
+const logFollowInterval = 2 * time.Second
+
+// logLevels orders the levels the level filter steps through.
+var logLevels = []string{"debug", "info", "warn", "error"}
+
+// logFollowTickMsg fires when follow mode should poll again. gen ties the tick
+// to the schedule that created it so toggling follow drops stale ticks.
+type logFollowTickMsg struct{ gen int }
+
+// logLevel reads an entry's level from its value, its metadata, or its type,
+// and returns "" when none of them name one.
+func logLevel(l api.Log) string {
+	for _, source := range []api.JSONMap{l.Value, l.Metadata} {
+		for _, key := range []string{"level", "severity"} {
+			if raw, ok := source[key].(string); ok {
+				if level := normalizeLogLevel(raw); level != "" {
+					return level
+				}
+			}
+		}
+	}
+	return normalizeLogLevel(l.LogType)
+}
+
+// normalizeLogLevel maps common level spellings onto logLevels.
+func normalizeLogLevel(raw string) string {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "trace", "debug":
+		return "debug"
+	case "info", "notice":
+		return "info"
+	case "warn", "warning":
+		return "warn"
+	case "error", "err", "fatal", "critical", "crit":
+		return "error"
+	}
+	return ""
+}
+
+// logLevelRank returns the position of level in logLevels. Entries without a
+// level count as info.
+func logLevelRank(level string) int {
+	for i, name := range logLevels {
+		if name == level {
+			return i
+		}
+	}
+	return 1
+}
+
+// logMatchesLevel reports whether l is at or above the minimum level.
+func logMatchesLevel(l api.Log, minimum string) bool {
+	if minimum == "" {
+		return true
+	}
+	return logLevelRank(logLevel(l)) >= logLevelRank(minimum)
+}
+
+// cycleLevelFilter steps the minimum level through all, debug, info, warn,
+// and error.
+func (m *LogsModel) cycleLevelFilter() {
+	next := ""
+	if m.levelFilter == "" {
+		next = logLevels[0]
+	} else if rank := logLevelRank(m.levelFilter); rank < len(logLevels)-1 {
+		next = logLevels[rank+1]
+	}
+	m.levelFilter = next
+	m.applyLogSearch()
+}
+
+// toggleFollow turns follow mode on or off. Turning it on polls right away.
+func (m *LogsModel) toggleFollow() tea.Cmd {
+	m.following = !m.following
+	m.followGen++
+	m.followNew = 0
+	if !m.following {
+		return nil
+	}
+	return m.followTick(0)
+}
+
+// followTick schedules the next follow poll.
+func (m LogsModel) followTick(after time.Duration) tea.Cmd {
+	tick := logFollowTickMsg{gen: m.followGen}
+	if after <= 0 {
+		return func() tea.Msg { return tick }
+	}
+	return tea.Tick(after, func(time.Time) tea.Msg { return tick })
+}
+
+// handleFollowTick reloads the list while following and re-arms the timer.
+// Polls pause outside the list view so forms and detail stay put.
+func (m LogsModel) handleFollowTick(msg logFollowTickMsg) (LogsModel, tea.Cmd) {
+	if !m.following || msg.gen != m.followGen {
+		return m, nil
+	}
+	m.followGen++
+	next := m.followTick(logFollowInterval)
+	if m.view != logsViewList || m.filtering || m.loading {
+		return m, next
+	}
+	return m, tea.Batch(m.loadLogs(), next)
+}
+
+// countNewLogs counts entries in items that are newer than the previous
+// newest entry.
+func countNewLogs(previous, items []api.Log) int {
+	if len(previous) == 0 {
+		return 0
+	}
+	for i, l := range items {
+		if l.ID == previous[0].ID {
+			return i
+		}
+	}
+	return len(items)
+}
+
+// renderTailStatus describes the level filter and follow mode for the list
+// count line.
+func (m LogsModel) renderTailStatus() string {
+	var parts []string
+	if m.levelFilter != "" {
+		parts = append(parts, fmt.Sprintf("level: %s+", m.levelFilter))
+	}
+	if m.following {
+		follow := "following"
+		if m.followNew > 0 {
+			follow = fmt.Sprintf("following · +%d new", m.followNew)
+		}
+		parts = append(parts, follow)
+	}
+	return strings.Join(parts, " · ")
+}
+
+// highlightSearchTerm marks every case-insensitive match of query in text,
+// leaving the rest unstyled so table rows keep their own styling.
+func highlightSearchTerm(text, query string) string {
+	query = strings.TrimSpace(query)
+	lower := strings.ToLower(text)
+	needle := strings.ToLower(query)
+	if needle == "" || len(lower) != len(text) {
+		return text
+	}
+	var b strings.Builder
+	for {
+		idx := strings.Index(lower, needle)
+		if idx < 0 {
+			b.WriteString(text)
+			break
+		}
+		b.WriteString(text[:idx])
+		b.WriteString(SearchMatchStyle.Render(text[idx : idx+len(needle)]))
+		text = text[idx+len(needle):]
+		lower = lower[idx+len(needle):]
+	}
+	return b.String()
+}
@@ -0,0 +1,111 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogLevelReadsValueMetadataAndType(t *testing.T) {
+	assert.Equal(t, "warn", logLevel(api.Log{LogType: "note", Value: api.JSONMap{"level": "WARNING"}}))
+	assert.Equal(t, "error", logLevel(api.Log{LogType: "note", Metadata: api.JSONMap{"severity": "fatal"}}))
+	assert.Equal(t, "debug", logLevel(api.Log{LogType: "trace"}))
+	assert.Equal(t, "", logLevel(api.Log{LogType: "workout"}))
+}
+
+func TestLogsLevelFilterCyclesMinimumLevel(t *testing.T) {
+	model := NewLogsModel(nil)
+	model.allItems = []api.Log{
+		{ID: "l1", LogType: "debug"},
+		{ID: "l2", LogType: "workout"},
+		{ID: "l3", LogType: "note", Value: api.JSONMap{"level": "warn"}},
+		{ID: "l4", LogType: "error"},
+	}
+	model.applyLogSearch()
+
+	ids := func() []string {
+		out := []string{}
+		for _, l := range model.items {
+			out = append(out, l.ID)
+		}
+		return out
+	}
+	key := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'L'}}
+
+	model, _ = model.Update(key)
+	assert.Equal(t, "debug", model.levelFilter)
+	assert.Len(t, model.items, 4)
+	model, _ = model.Update(key)
+	assert.Equal(t, []string{"l2", "l3", "l4"}, ids())
+	model, _ = model.Update(key)
+	assert.Equal(t, []string{"l3", "l4"}, ids())
+	model, _ = model.Update(key)
+	assert.Equal(t, []string{"l4"}, ids())
+	model.width = 120
+	assert.Contains(t, components.SanitizeText(model.View()), "level: error+")
+	model, _ = model.Update(key)
+	assert.Equal(t, "", model.levelFilter)
+	assert.Len(t, model.items, 4)
+	assert.Empty(t, model.searchBuf.Value)
+}
+
+func TestLogsFollowPollsAndCountsNewEntries(t *testing.T) {
+	batches := [][]map[string]any{
+		{{"id": "l1", "log_type": "note"}},
+		{{"id": "l3", "log_type": "note"}, {"id": "l2", "log_type": "note"}, {"id": "l1", "log_type": "note"}},
+	}
+	calls := 0
+	_, client := relTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/logs":
+			batch := batches[calls]
+			if calls < len(batches)-1 {
+				calls++
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": batch})
+		default:
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": []any{}})
+		}
+	})
+
+	model := NewLogsModel(client)
+	model.width = 120
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'F'}})
+	require.True(t, model.following)
+	require.NotNil(t, cmd)
+
+	tick := cmd()
+	model, cmd = model.Update(tick)
+	require.NotNil(t, cmd)
+	model, _ = model.Update(model.loadLogs()())
+	require.Len(t, model.items, 1)
+	assert.Equal(t, 0, model.followNew)
+
+	// A stale tick from before the last poll is ignored.
+	model, cmd = model.Update(tick)
+	assert.Nil(t, cmd)
+
+	model, _ = model.Update(model.loadLogs()())
+	require.Len(t, model.items, 3)
+	assert.Equal(t, 2, model.followNew)
+	assert.Equal(t, 0, model.list.Selected())
+	assert.Contains(t, components.SanitizeText(model.View()), "following · +2 new")
+
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'F'}})
+	assert.False(t, model.following)
+	assert.Nil(t, cmd)
+}
+
+func TestLogsSearchHighlightsMatches(t *testing.T) {
+	assert.Equal(t, "plain", highlightSearchTerm("plain", ""))
+	marked := highlightSearchTerm("Disk Full on disk2", "disk")
+	assert.Equal(t, "Disk Full on disk2", components.SanitizeText(marked))
+	assert.Contains(t, marked, SearchMatchStyle.Render("Disk"))
+	assert.Contains(t, marked, SearchMatchStyle.Render("disk"))
+}
@@ -0,0 +1,189 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// macroState holds the last repeatable change for `.` and the key macros
+// recorded with `q` and replayed with `@`.
+type macroState struct {
+	// change collects the keys of a change in progress, from its trigger
+	// until the list has focus again.
+	change    []tea.KeyMsg
+	changeTab int
+	last      []tea.KeyMsg
+	lastTab   int
+
+	// pending is "q" or "@" while waiting for the register name.
+	pending   string
+	times     int
+	recording string
+	keys      []tea.KeyMsg
+	registers map[string][]tea.KeyMsg
+	lastRun   string
+
+	// control marks a key the recorder consumed, so it is not recorded.
+	control bool
+}
+
+// repeatTriggers lists, per tab, the list keys that start a repeatable change.
+// Each opens a prompt or confirm that acts on the selection and then returns
+// to the list.
+var repeatTriggers = map[int][]string{
+	tabInbox:       {"a", "A", "r", "z", "d"},
+	tabEntities:    {"t", "p", "E", "C"},
+	tabKnow:        {"t", "p", "s"},
+	tabFiles:       {"t", "s"},
+	tabJobs:        {"s"},
+	tabCollections: {"d"},
+}
+
+// isRepeatTrigger reports whether key starts a repeatable change on tab.
+func isRepeatTrigger(tab int, msg tea.KeyMsg) bool {
+	return slices.Contains(repeatTriggers[tab], msg.String())
+}
+
+// isRegister reports whether key names a macro register, a to z.
+func isRegister(key string) bool {
+	return len(key) == 1 && key[0] >= 'a' && key[0] <= 'z'
+}
+
+// updateRecorded delivers a key and feeds it to the macro being recorded and
+// to the change tracker behind `.`.
+func (a App) updateRecorded(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	trigger := a.vimList() != nil && !a.vim.searching && isRepeatTrigger(a.tab, msg)
+	model, cmd := a.update(msg)
+	next, ok := model.(App)
+	if !ok {
+		return model, cmd
+	}
+	if next.macro.control {
+		next.macro.control = false
+		return next, cmd
+	}
+	next.macro.observe(msg, trigger, next.tab, next.vimList() != nil)
+	return next, cmd
+}
+
+// observe records msg into the open macro and tracks the change it belongs
+// to. A change is kept for `.` once the list has focus again, unless it was
+// cancelled or never left the list.
+func (m *macroState) observe(msg tea.KeyMsg, trigger bool, tab int, inList bool) {
+	if m.recording != "" {
+		m.keys = append(m.keys, msg)
+	}
+	switch {
+	case trigger:
+		m.change = []tea.KeyMsg{msg}
+		m.changeTab = tab
+	case m.change != nil:
+		m.change = append(m.change, msg)
+	default:
+		return
+	}
+	if !inList {
+		return
+	}
+	if len(m.change) > 1 && tab == m.changeTab && !isBack(msg) && !isKey(msg, "n") {
+		m.last = m.change
+		m.lastTab = m.changeTab
+	}
+	m.change = nil
+}
+
+// handleMacroKeys handles `.`, `q{reg}`, `q`, and `@{reg}` on a focused list.
+// ok is false when the key is not a macro key here.
+func (a App) handleMacroKeys(msg tea.KeyMsg) (model tea.Model, cmd tea.Cmd, ok bool) {
+	key := msg.String()
+	if pending := a.macro.pending; pending != "" {
+		a.macro.pending = ""
+		a.macro.control = true
+		switch {
+		case !isRegister(key) && !(pending == "@" && key == "@"):
+			return a, nil, true
+		case pending == "q":
+			a.macro.recording = key
+			a.macro.keys = nil
+			return a, nil, true
+		}
+		if key == "@" {
+			key = a.macro.lastRun
+		}
+		keys := a.macro.registers[key]
+		if len(keys) == 0 {
+			return a, a.setToast("info", fmt.Sprintf("Register %s is empty.", firstNonEmpty(key, "@"))), true
+		}
+		a.macro.lastRun = key
+		model, cmd = a.replay(keys, a.macro.times, false)
+		return model, cmd, true
+	}
+	if a.vim.searching || a.vimList() == nil {
+		return a, nil, false
+	}
+
+	switch key {
+	case "q":
+		a.macro.control = true
+		if a.macro.recording == "" {
+			a.macro.pending = "q"
+			return a, nil, true
+		}
+		if a.macro.registers == nil {
+			a.macro.registers = map[string][]tea.KeyMsg{}
+		}
+		a.macro.registers[a.macro.recording] = a.macro.keys
+		text := fmt.Sprintf("Recorded %d keys into @%s.", len(a.macro.keys), a.macro.recording)
+		a.macro.recording = ""
+		a.macro.keys = nil
+		return a, a.setToast("success", text), true
+	case "@":
+		a.macro.control = true
+		a.macro.pending = "@"
+		a.macro.times = a.vimCount()
+		a.vim.count = ""
+		return a, nil, true
+	case ".":
+		a.macro.control = true
+		a.vim.count = ""
+		if len(a.macro.last) == 0 || a.macro.lastTab != a.tab {
+			return a, a.setToast("info", "Nothing to repeat here."), true
+		}
+		model, cmd = a.replay(a.macro.last, 1, true)
+		return model, cmd, true
+	}
+	return a, nil, false
+}
+
+// replay feeds keys through the app times over. A guarded replay stops when
+// the first key leaves the list focused, so a change whose prompt does not
+// open, such as a bulk action with nothing selected, types nothing. Replayed
+// keys are added to the macro being recorded.
+func (a App) replay(keys []tea.KeyMsg, times int, guarded bool) (tea.Model, tea.Cmd) {
+	recording := a.macro
+	var cmds []tea.Cmd
+	for range max(times, 1) {
+		for i, key := range keys {
+			model, cmd := a.update(key)
+			next, ok := model.(App)
+			if !ok {
+				return model, tea.Batch(append(cmds, cmd)...)
+			}
+			a = next
+			cmds = append(cmds, cmd)
+			if guarded && i == 0 && a.vimList() != nil {
+				break
+			}
+		}
+	}
+	if recording.recording != "" {
+		for range max(times, 1) {
+			recording.keys = append(recording.keys, keys...)
+		}
+	}
+	recording.control = true
+	a.macro = recording
+	return a, tea.Batch(cmds...)
+}
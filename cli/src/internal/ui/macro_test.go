@@ -0,0 +1,57 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVimDotRepeatsLastChangeOnNewSelection(t *testing.T) {
+	app := newVimApp(t, true)
+	app = vimPress(t, app, " ", "t", "a", "d", "d", ":", "u", "r", "g", "e", "n", "t", "enter")
+	assert.NotNil(t, app.entities.bulkPreview)
+	app = vimPress(t, app, "enter")
+	assert.True(t, app.entities.bulkRunning)
+	assert.Len(t, app.macro.last, 13)
+
+	app.entities.bulkRunning = false
+	app.entities.clearBulkSelection()
+	app = vimPress(t, app, "j", " ", ".")
+	assert.True(t, app.entities.bulkRunning)
+	assert.Empty(t, app.entities.bulkPrompt)
+	assert.Nil(t, app.entities.bulkPreview)
+	assert.Len(t, app.macro.last, 13)
+
+	// Without a selection the prompt does not open and nothing is typed.
+	app.entities.bulkRunning = false
+	app.entities.clearBulkSelection()
+	app = vimPress(t, app, ".")
+	assert.False(t, app.entities.bulkRunning)
+	assert.Empty(t, app.entities.searchBuf.Value)
+
+	// A cancelled change does not replace the last one.
+	app = vimPress(t, app, " ", "t", "x", "esc")
+	assert.Len(t, app.macro.last, 13)
+}
+
+func TestVimMacroRecordsAndReplays(t *testing.T) {
+	app := newVimApp(t, true)
+	app = vimPress(t, app, "q", "a")
+	assert.Equal(t, "a", app.macro.recording)
+	assert.Contains(t, components.SanitizeText(app.View()), "recording @a")
+
+	app = vimPress(t, app, "j", "j", "q")
+	assert.Empty(t, app.macro.recording)
+	assert.Len(t, app.macro.registers["a"], 2)
+	assert.Equal(t, 2, app.entities.list.Selected())
+
+	app = vimPress(t, app, "@", "a")
+	assert.Equal(t, 4, app.entities.list.Selected())
+	app = vimPress(t, app, "k", "k", "k", "k", "2", "@", "a")
+	assert.Equal(t, 4, app.entities.list.Selected())
+	app = vimPress(t, app, "@", "@")
+	assert.Equal(t, 6, app.entities.list.Selected())
+	assert.Equal(t, tabEntities, app.tab)
+	assert.Empty(t, app.entities.searchBuf.Value)
+}
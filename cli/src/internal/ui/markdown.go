@@ -0,0 +1,218 @@
+package ui
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+var (
+	markdownHeadingPattern = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	markdownRulePattern    = regexp.MustCompile(`^\s*(?:(?:-\s*){3,}|(?:\*\s*){3,}|(?:_\s*){3,})$`)
+	markdownListPattern    = regexp.MustCompile(`^(\s*)([-*+]|\d+[.)])\s+(.*)$`)
+	markdownQuotePattern   = regexp.MustCompile(`^\s*>\s?(.*)$`)
+	markdownInlinePattern  = regexp.MustCompile("(\\*\\*[^*]+\\*\\*|__[^_]+__|`[^`]+`|\\[[^\\]]+\\]\\([^)\\s]+\\)|\\*[^*\\s][^*]*\\*|_[^_\\s][^_]*_)")
+	markdownLinkPattern    = regexp.MustCompile(`^\[([^\]]+)\]\(([^)\s]+)\)$`)
+)
+
+// markdownSpan is a run of inline text drawn in one style.
+type markdownSpan struct {
+	text  string
+	style lipgloss.Style
+}
+
+// renderMarkdown renders markdown as styled lines wrapped to width. It covers
+// the common subset knowledge content uses: headings, lists, quotes, rules,
+// fenced code, and bold, italic, code, and link spans.
+func renderMarkdown(src string, width int) []string {
+	if width < 10 {
+		width = 10
+	}
+	var out []string
+	var paragraph []string
+	flush := func() {
+		if len(paragraph) > 0 {
+			out = append(out, wrapMarkdownSpans(parseMarkdownInline(strings.Join(paragraph, " "), NormalStyle), width, "", "")...)
+			paragraph = nil
+		}
+	}
+	blank := func() {
+		if len(out) > 0 && out[len(out)-1] != "" {
+			out = append(out, "")
+		}
+	}
+
+	inFence := false
+	for _, raw := range strings.Split(components.SanitizeText(strings.ReplaceAll(src, "\t", "    ")), "\n") {
+		line := strings.TrimRight(raw, " ")
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			flush()
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			out = append(out, MarkdownCodeStyle.Render("  "+components.ClampTextWidthEllipsis(line, width-2)))
+			continue
+		}
+		switch {
+		case trimmed == "":
+			flush()
+			blank()
+		case markdownRulePattern.MatchString(line):
+			flush()
+			out = append(out, DividerStyle.Render(strings.Repeat("─", width)))
+		case markdownHeadingPattern.MatchString(trimmed):
+			flush()
+			match := markdownHeadingPattern.FindStringSubmatch(trimmed)
+			style := MarkdownHeadingStyle
+			if len(match[1]) == 1 {
+				style = style.Underline(true)
+			}
+			blank()
+			out = append(out, wrapMarkdownSpans(parseMarkdownInline(match[2], style), width, "", "")...)
+		case markdownQuotePattern.MatchString(line):
+			flush()
+			text := markdownQuotePattern.FindStringSubmatch(line)[1]
+			prefix := MutedStyle.Render("│ ")
+			out = append(out, wrapMarkdownSpans(parseMarkdownInline(text, MutedStyle.Italic(true)), width, prefix, prefix)...)
+		case markdownListPattern.MatchString(line):
+			flush()
+			match := markdownListPattern.FindStringSubmatch(line)
+			indent := strings.Repeat(" ", len(match[1])/2*2)
+			marker := "• "
+			if match[2][0] >= '0' && match[2][0] <= '9' {
+				marker = match[2] + " "
+			}
+			first := indent + AccentStyle.Render(marker)
+			rest := indent + strings.Repeat(" ", lipgloss.Width(marker))
+			out = append(out, wrapMarkdownSpans(parseMarkdownInline(match[3], NormalStyle), width, first, rest)...)
+		case strings.HasPrefix(trimmed, "|"):
+			flush()
+			out = append(out, NormalStyle.Render(components.ClampTextWidthEllipsis(line, width)))
+		default:
+			paragraph = append(paragraph, trimmed)
+		}
+	}
+	flush()
+	for len(out) > 0 && out[len(out)-1] == "" {
+		out = out[:len(out)-1]
+	}
+	return out
+}
+
+// parseMarkdownInline splits text into styled spans for emphasis, code, and
+// links. Text outside any marker keeps base.
+func parseMarkdownInline(text string, base lipgloss.Style) []markdownSpan {
+	var spans []markdownSpan
+	last := 0
+	for _, loc := range markdownInlinePattern.FindAllStringIndex(text, -1) {
+		if loc[0] > last {
+			spans = append(spans, markdownSpan{text: text[last:loc[0]], style: base})
+		}
+		token := text[loc[0]:loc[1]]
+		switch {
+		case strings.HasPrefix(token, "**"), strings.HasPrefix(token, "__"):
+			spans = append(spans, markdownSpan{text: token[2 : len(token)-2], style: base.Bold(true)})
+		case strings.HasPrefix(token, "`"):
+			spans = append(spans, markdownSpan{text: token[1 : len(token)-1], style: MarkdownCodeStyle})
+		case strings.HasPrefix(token, "["):
+			match := markdownLinkPattern.FindStringSubmatch(token)
+			spans = append(spans,
+				markdownSpan{text: match[1], style: MarkdownLinkStyle},
+				markdownSpan{text: " (" + match[2] + ")", style: MutedStyle},
+			)
+		default:
+			spans = append(spans, markdownSpan{text: token[1 : len(token)-1], style: base.Italic(true)})
+		}
+		last = loc[1]
+	}
+	if last < len(text) {
+		spans = append(spans, markdownSpan{text: text[last:], style: base})
+	}
+	return spans
+}
+
+// wrapMarkdownSpans word-wraps styled spans to width. The first line starts
+// with firstPrefix and later lines with restPrefix.
+func wrapMarkdownSpans(spans []markdownSpan, width int, firstPrefix, restPrefix string) []string {
+	type piece struct {
+		text  string
+		style lipgloss.Style
+		space bool
+	}
+	var pieces []piece
+	pendingSpace := false
+	for _, span := range spans {
+		words := strings.Fields(span.text)
+		for i, word := range words {
+			space := i > 0 || pendingSpace || strings.HasPrefix(span.text, " ")
+			pieces = append(pieces, piece{text: word, style: span.style, space: space})
+		}
+		pendingSpace = strings.HasSuffix(span.text, " ") || (len(words) == 0 && span.text != "")
+	}
+
+	var lines []string
+	var line strings.Builder
+	prefix := firstPrefix
+	lineW := 0
+	avail := width - lipgloss.Width(prefix)
+	emit := func() {
+		lines = append(lines, prefix+line.String())
+		line.Reset()
+		lineW = 0
+		prefix = restPrefix
+		avail = width - lipgloss.Width(prefix)
+	}
+	for _, p := range pieces {
+		text := p.text
+		for text != "" {
+			w := lipgloss.Width(text)
+			gap := 0
+			if p.space && lineW > 0 {
+				gap = 1
+			}
+			if lineW+gap+w <= avail {
+				if gap > 0 {
+					line.WriteString(" ")
+				}
+				line.WriteString(p.style.Render(text))
+				lineW += gap + w
+				break
+			}
+			if lineW > 0 {
+				emit()
+				continue
+			}
+			// A single word wider than the line is split where it overflows.
+			head := truncateRunesToWidth(text, avail)
+			line.WriteString(p.style.Render(head))
+			lineW = avail
+			text = strings.TrimPrefix(text, head)
+			emit()
+		}
+	}
+	if lineW > 0 || len(lines) == 0 {
+		emit()
+	}
+	return lines
+}
+
+// truncateRunesToWidth returns the longest prefix of text that fits width,
+// always keeping at least one rune.
+func truncateRunesToWidth(text string, width int) string {
+	var b strings.Builder
+	w := 0
+	for _, r := range text {
+		rw := lipgloss.Width(string(r))
+		if w+rw > width && b.Len() > 0 {
+			break
+		}
+		b.WriteRune(r)
+		w += rw
+	}
+	return b.String()
+}
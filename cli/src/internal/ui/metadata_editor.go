@@ -21,9 +21,13 @@ type MetadataEditor struct {
 	Active bool
 	Buffer string
 	Scopes []string
+	// Label names the edited map in titles, e.g. "Properties". Defaults to
+	// "Metadata".
+	Label string
 
 	scopeOptions   []string
-	scopeIdx       int
+	scopeInfo      map[string]scopeInfo
+	scopePicker    scopePicker
 	scopeSelecting bool
 
 	rows     []metadataEditorRow
@@ -31,7 +35,7 @@ type MetadataEditor struct {
 	selected map[int]bool
 
 	entryMode    bool
-	entryBuf     string
+	entryBuf     components.TextInput
 	entryEditIdx int
 
 	inspectMode   bool
@@ -52,13 +56,13 @@ func (m *MetadataEditor) Reset() {
 	m.Active = false
 	m.Buffer = ""
 	m.Scopes = nil
-	m.scopeIdx = 0
+	m.scopePicker.Reset()
 	m.scopeSelecting = false
 	m.rows = nil
 	m.list = nil
 	m.selected = nil
 	m.entryMode = false
-	m.entryBuf = ""
+	m.entryBuf.Reset()
 	m.entryEditIdx = -1
 	m.inspectMode = false
 	m.inspectRowIdx = 0
@@ -73,7 +77,7 @@ func (m *MetadataEditor) Load(initial map[string]any) {
 	m.rows = metadataEditorRowsFromMap(stripMetadataScopes(initial))
 	m.selected = map[int]bool{}
 	m.entryMode = false
-	m.entryBuf = ""
+	m.entryBuf.Reset()
 	m.entryEditIdx = -1
 	m.inspectMode = false
 	m.inspectRowIdx = 0
@@ -85,29 +89,10 @@ func (m *MetadataEditor) Load(initial map[string]any) {
 // HandleKey handles handle key.
 func (m *MetadataEditor) HandleKey(msg tea.KeyMsg) bool {
 	if m.scopeSelecting {
-		options := m.scopeOptions
-		if len(options) == 0 {
-			options = append([]string{}, m.Scopes...)
-		}
-		switch {
-		case isKey(msg, "left"):
-			if len(options) > 0 {
-				m.scopeIdx = (m.scopeIdx - 1 + len(options)) % len(options)
-			}
-			return false
-		case isKey(msg, "right"):
-			if len(options) > 0 {
-				m.scopeIdx = (m.scopeIdx + 1) % len(options)
-			}
-			return false
-		case isSpace(msg):
-			if len(options) > 0 {
-				scope := options[m.scopeIdx]
-				m.Scopes = toggleScope(m.Scopes, scope)
-			}
-			return false
-		case isEnter(msg), isBack(msg):
-			m.scopeSelecting = false
+		scopes, open, handled := m.scopePicker.HandleKey(msg, m.scopeOptions, m.Scopes, m.scopeInfo)
+		m.Scopes = scopes
+		m.scopeSelecting = open
+		if handled {
 			return false
 		}
 	}
@@ -115,21 +100,16 @@ func (m *MetadataEditor) HandleKey(msg tea.KeyMsg) bool {
 		switch {
 		case isBack(msg):
 			m.entryMode = false
-			m.entryBuf = ""
+			m.entryBuf.Reset()
 			m.entryEditIdx = -1
-		case isKey(msg, "backspace", "delete"):
-			m.entryBuf = dropLastRune(m.entryBuf)
 		case isKey(msg, "cmd+backspace", "cmd+delete", "ctrl+u"):
-			m.entryBuf = ""
+			m.entryBuf.Reset()
 		case isEnter(msg):
 			if err := m.commitEntry(); err != nil {
 				m.notice = err.Error()
 			}
 		default:
-			ch := msg.String()
-			if len(ch) == 1 || ch == " " {
-				m.entryBuf += ch
-			}
+			m.entryBuf.HandleKey(msg)
 		}
 		return false
 	}
@@ -161,6 +141,7 @@ func (m *MetadataEditor) HandleKey(msg tea.KeyMsg) bool {
 		m.Active = false
 		return true
 	case isKey(msg, "s"):
+		m.scopePicker.Reset()
 		m.scopeSelecting = true
 		return false
 	case isDown(msg):
@@ -180,7 +161,7 @@ func (m *MetadataEditor) HandleKey(msg tea.KeyMsg) bool {
 		m.toggleSelectAll()
 	case isKey(msg, "n"):
 		m.entryMode = true
-		m.entryBuf = ""
+		m.entryBuf.Reset()
 		m.entryEditIdx = -1
 		m.notice = ""
 	case isKey(msg, "e"):
@@ -188,7 +169,7 @@ func (m *MetadataEditor) HandleKey(msg tea.KeyMsg) bool {
 		if idx >= 0 && idx < len(m.rows) {
 			m.entryMode = true
 			m.entryEditIdx = idx
-			m.entryBuf = fmt.Sprintf("%s | %s", m.rows[idx].path, m.rows[idx].value)
+			m.entryBuf.SetValue(fmt.Sprintf("%s | %s", m.rows[idx].path, m.rows[idx].value))
 			m.notice = ""
 		}
 	case isKey(msg, "d"):
@@ -237,7 +218,8 @@ func (m MetadataEditor) renderTableMode(width int) string {
 	}
 	rows := m.rows
 	if len(rows) == 0 {
-		body := components.TitledBox("Metadata", MutedStyle.Render("No metadata rows. Press n to add one."), width)
+		empty := fmt.Sprintf("No %s rows. Press n to add one.", strings.ToLower(m.label()))
+		body := components.TitledBox(m.label(), MutedStyle.Render(empty), width)
 		scopeBox := m.renderScopeBox(width)
 		footer := MutedStyle.Render("n new · e edit · d delete · space select · b all · enter inspect · c copy values · s scopes · esc back")
 		if m.notice != "" {
@@ -318,16 +300,27 @@ func (m MetadataEditor) renderTableMode(width int) string {
 		footer += "\n" + MutedStyle.Render(m.notice)
 	}
 	body := table + "\n\n" + MutedStyle.Render(info) + "\n" + MutedStyle.Render(footer)
-	return components.Indent(components.TitledBox("Metadata", body, width)+"\n\n"+m.renderScopeBox(width), 1)
+	return components.Indent(components.TitledBox(m.label(), body, width)+"\n\n"+m.renderScopeBox(width), 1)
+}
+
+// label returns the display name for the edited map.
+func (m MetadataEditor) label() string {
+	if strings.TrimSpace(m.Label) == "" {
+		return "Metadata"
+	}
+	return m.Label
 }
 
 // renderEntryMode renders render entry mode.
 func (m MetadataEditor) renderEntryMode(width int) string {
-	title := "Add Metadata Row"
+	title := "Add " + m.label() + " Row"
 	if m.entryEditIdx >= 0 {
-		title = "Edit Metadata Row"
+		title = "Edit " + m.label() + " Row"
 	}
 	hint := MutedStyle.Render("format: group | field | value (or path | value)\nexample: profile | timezone | europe/warsaw\nenter save · esc cancel")
+	if typeHint := metadataEntryTypeHint(m.entryBuf.Value); typeHint != "" {
+		hint = MutedStyle.Render(typeHint) + "\n" + hint
+	}
 	body := components.InputDialog(title, m.entryBuf) + "\n\n" + hint
 	if strings.TrimSpace(m.notice) != "" {
 		body += "\n" + ErrorStyle.Render(m.notice)
@@ -373,11 +366,11 @@ func (m MetadataEditor) renderInspectMode(width int) string {
 func (m MetadataEditor) renderScopeBox(width int) string {
 	var content strings.Builder
 	content.WriteString(MutedStyle.Render("Scopes:"))
-	content.WriteString("\n  ")
+	content.WriteString("\n")
 	if m.scopeSelecting {
-		content.WriteString(renderScopeOptions(m.Scopes, m.scopeOptions, m.scopeIdx))
+		content.WriteString(m.scopePicker.Render(m.scopeOptions, m.Scopes, m.scopeInfo, width))
 	} else {
-		content.WriteString(renderScopePills(m.Scopes, true))
+		content.WriteString("  " + renderScopePills(m.Scopes, true))
 	}
 	return components.TitledBox("Scopes", content.String(), width)
 }
@@ -385,15 +378,16 @@ func (m MetadataEditor) renderScopeBox(width int) string {
 // SetScopeOptions sets set scope options.
 func (m *MetadataEditor) SetScopeOptions(options []string) {
 	m.scopeOptions = options
-	if len(m.scopeOptions) == 0 {
-		m.scopeIdx = 0
-		return
-	}
-	if m.scopeIdx >= len(m.scopeOptions) {
-		m.scopeIdx = 0
+	if m.scopePicker.idx >= len(m.scopeOptions) {
+		m.scopePicker.idx = 0
 	}
 }
 
+// SetScopeInfo sets the descriptions and counts shown in the scope picker.
+func (m *MetadataEditor) SetScopeInfo(info map[string]scopeInfo) {
+	m.scopeInfo = info
+}
+
 // dropLastRune handles drop last rune.
 func dropLastRune(s string) string {
 	if s == "" {
@@ -474,21 +468,25 @@ func (m *MetadataEditor) toggleSelectAll() {
 
 // commitEntry handles commit entry.
 func (m *MetadataEditor) commitEntry() error {
-	path, value, err := parseMetadataPipeLine(m.entryBuf, 1)
+	path, value, err := parseMetadataPipeLine(m.entryBuf.Value, 1)
 	if err != nil {
 		return err
 	}
+	if _, err := parseMetadataValue(value, 1); err != nil {
+		return err
+	}
 	entry := metadataEditorRow{path: strings.TrimSpace(path), value: strings.TrimSpace(value)}
-	if m.entryEditIdx >= 0 && m.entryEditIdx < len(m.rows) {
-		m.rows[m.entryEditIdx] = entry
+	rows := append([]metadataEditorRow{}, m.rows...)
+	if m.entryEditIdx >= 0 && m.entryEditIdx < len(rows) {
+		rows[m.entryEditIdx] = entry
 	} else {
-		m.rows = append(m.rows, entry)
+		rows = append(rows, entry)
 	}
 
 	// Keep the most recent value for duplicate paths.
-	seen := make(map[string]int, len(m.rows))
-	cleaned := make([]metadataEditorRow, 0, len(m.rows))
-	for _, row := range m.rows {
+	seen := make(map[string]int, len(rows))
+	cleaned := make([]metadataEditorRow, 0, len(rows))
+	for _, row := range rows {
 		pathKey := strings.ToLower(strings.TrimSpace(row.path))
 		if prev, ok := seen[pathKey]; ok {
 			cleaned[prev] = row
@@ -497,10 +495,14 @@ func (m *MetadataEditor) commitEntry() error {
 		seen[pathKey] = len(cleaned)
 		cleaned = append(cleaned, row)
 	}
+	// Reject rows that would turn an existing value into an object or back.
+	if _, err := metadataEditorRowsToMap(cleaned); err != nil {
+		return err
+	}
 	m.rows = cleaned
 	m.rebuildBuffer()
 	m.entryMode = false
-	m.entryBuf = ""
+	m.entryBuf.Reset()
 	m.entryEditIdx = -1
 	m.notice = "row saved."
 	m.syncList()
@@ -513,15 +515,58 @@ func (m *MetadataEditor) rebuildBuffer() {
 		m.Buffer = ""
 		return
 	}
+	root, _ := metadataEditorRowsToMap(m.rows)
+	m.Buffer = metadataToInput(root)
+}
+
+// metadataEditorRowsToMap nests rows by their dotted paths. Unparseable values
+// are kept as text; path conflicts are reported with the offending row number.
+func metadataEditorRowsToMap(rows []metadataEditorRow) (map[string]any, error) {
 	root := map[string]any{}
-	for _, row := range m.rows {
-		val, err := parseMetadataValue(row.value, 1)
+	var firstErr error
+	for idx, row := range rows {
+		val, err := parseMetadataValue(row.value, idx+1)
 		if err != nil {
 			val = row.value
 		}
-		_ = setMetadataPath(root, row.path, val, 1)
+		if err := setMetadataPath(root, row.path, val, idx+1); err != nil && firstErr == nil {
+			firstErr = err
+		}
 	}
-	m.Buffer = metadataToInput(root)
+	return root, firstErr
+}
+
+// metadataEntryTypeHint describes how an entry row will be stored, e.g.
+// "profile.timezone → text". Values are saved as text; the hint says when the
+// text reads as a number or boolean so schema checks will accept it.
+func metadataEntryTypeHint(entry string) string {
+	if !strings.Contains(entry, "|") {
+		return ""
+	}
+	path, raw, err := parseMetadataPipeLine(entry, 1)
+	if err != nil {
+		return ""
+	}
+	value, err := parseMetadataValue(raw, 1)
+	if err != nil {
+		return path + " → " + err.Error()
+	}
+	kind := "text"
+	switch v := value.(type) {
+	case []any:
+		kind = fmt.Sprintf("list (%d items)", len(v))
+	case string:
+		switch {
+		case metadataValueHasType(v, "integer"), metadataValueHasType(v, "number"):
+			kind = "number"
+		case metadataValueHasType(v, "boolean"):
+			kind = "boolean"
+		}
+	}
+	if depth := len(splitMetadataPath(path)); depth > 1 {
+		kind += fmt.Sprintf(", nested %d levels", depth)
+	}
+	return path + " → " + kind
 }
 
 // copySelectedValues handles copy selected values.
@@ -38,7 +38,7 @@ func TestMetadataEditorHandleKeyBulkCopyAndEditBranches(t *testing.T) {
 	ed.HandleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
 	assert.True(t, ed.entryMode)
 	assert.GreaterOrEqual(t, ed.entryEditIdx, 0)
-	assert.Contains(t, ed.entryBuf, "|")
+	assert.Contains(t, ed.entryBuf.Value, "|")
 }
 
 func TestMetadataEditorInspectEnterCopiesNoneForBlankValues(t *testing.T) {
@@ -78,7 +78,7 @@ func TestMetadataEditorRenderTableAndEntryBranchMatrix(t *testing.T) {
 	assert.Contains(t, table, "Scopes")
 
 	ed.entryEditIdx = 0
-	ed.entryBuf = "profile | timezone | europe/warsaw"
+	ed.entryBuf.Value = "profile | timezone | europe/warsaw"
 	entry := components.SanitizeText(ed.renderEntryMode(80))
 	assert.Contains(t, entry, "Edit Metadata Row")
 	assert.Contains(t, entry, "heads up")
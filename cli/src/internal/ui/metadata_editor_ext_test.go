@@ -15,10 +15,10 @@ func TestMetadataEditorHandleKeyScopeSelectingFallbackOptions(t *testing.T) {
 	var ed MetadataEditor
 	ed.Open(map[string]any{"scopes": []any{"public", "private"}})
 	ed.scopeSelecting = true
-	ed.scopeIdx = 0
+	ed.scopePicker.idx = 0
 
 	ed.HandleKey(tea.KeyMsg{Type: tea.KeyLeft})
-	assert.Equal(t, 1, ed.scopeIdx)
+	assert.Equal(t, 1, ed.scopePicker.idx)
 
 	ed.HandleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
 	assert.Equal(t, []string{"public"}, ed.Scopes)
@@ -31,26 +31,26 @@ func TestMetadataEditorHandleKeyEntryModeEditingPaths(t *testing.T) {
 	var ed MetadataEditor
 	ed.Open(map[string]any{})
 	ed.entryMode = true
-	ed.entryBuf = "profile | timezone | europe/warsaw"
+	ed.entryBuf.Value = "profile | timezone | europe/warsaw"
 	ed.entryEditIdx = -1
 
 	ed.HandleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'!'}})
-	assert.Contains(t, ed.entryBuf, "!")
+	assert.Contains(t, ed.entryBuf.Value, "!")
 
 	ed.HandleKey(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.NotContains(t, ed.entryBuf, "!")
+	assert.NotContains(t, ed.entryBuf.Value, "!")
 
 	ed.HandleKey(tea.KeyMsg{Type: tea.KeyCtrlU})
-	assert.Equal(t, "", ed.entryBuf)
+	assert.Equal(t, "", ed.entryBuf.Value)
 
-	ed.entryBuf = "bad line without delimiter"
+	ed.entryBuf.Value = "bad line without delimiter"
 	ed.HandleKey(tea.KeyMsg{Type: tea.KeyEnter})
 	assert.True(t, ed.entryMode)
 	assert.NotEmpty(t, ed.notice)
 
 	ed.HandleKey(tea.KeyMsg{Type: tea.KeyEsc})
 	assert.False(t, ed.entryMode)
-	assert.Equal(t, "", ed.entryBuf)
+	assert.Equal(t, "", ed.entryBuf.Value)
 	assert.Equal(t, -1, ed.entryEditIdx)
 }
 
@@ -92,13 +92,13 @@ func TestMetadataEditorHandleKeyDeleteAndCopyNoticePaths(t *testing.T) {
 
 func TestMetadataEditorSetScopeOptionsResetsOutOfRangeIndex(t *testing.T) {
 	var ed MetadataEditor
-	ed.scopeIdx = 9
+	ed.scopePicker.idx = 9
 	ed.SetScopeOptions([]string{"public"})
-	assert.Equal(t, 0, ed.scopeIdx)
+	assert.Equal(t, 0, ed.scopePicker.idx)
 
-	ed.scopeIdx = 2
+	ed.scopePicker.idx = 2
 	ed.SetScopeOptions(nil)
-	assert.Equal(t, 0, ed.scopeIdx)
+	assert.Equal(t, 0, ed.scopePicker.idx)
 }
 
 func TestMetadataEditorSyncListCleansInvalidSelectedIndexes(t *testing.T) {
@@ -147,7 +147,7 @@ func TestMetadataEditorCommitEntryDedupesAndEditPath(t *testing.T) {
 			{path: "owner", value: "alxx"},
 			{path: "owner", value: "old"},
 		},
-		entryBuf:     "owner | updated",
+		entryBuf:     components.NewTextInput("owner | updated"),
 		entryEditIdx: -1,
 	}
 	require.NoError(t, ed.commitEntry())
@@ -157,14 +157,14 @@ func TestMetadataEditorCommitEntryDedupesAndEditPath(t *testing.T) {
 
 	ed.entryMode = true
 	ed.entryEditIdx = 0
-	ed.entryBuf = "owner | edited"
+	ed.entryBuf.Value = "owner | edited"
 	require.NoError(t, ed.commitEntry())
 	require.Len(t, ed.rows, 1)
 	assert.Equal(t, "edited", ed.rows[0].value)
 }
 
 func TestMetadataEditorCommitEntryRejectsInvalidPipeLine(t *testing.T) {
-	ed := MetadataEditor{entryBuf: " | value"}
+	ed := MetadataEditor{entryBuf: components.NewTextInput(" | value")}
 	err := ed.commitEntry()
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "empty key segment")
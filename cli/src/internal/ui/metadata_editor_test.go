@@ -183,7 +183,7 @@ func TestMetadataEditorRenderEntryModeIncludesFormatHints(t *testing.T) {
 	var ed MetadataEditor
 	ed.Open(map[string]any{})
 	ed.entryMode = true
-	ed.entryBuf = "profile | timezone | europe/warsaw"
+	ed.entryBuf.Value = "profile | timezone | europe/warsaw"
 	ed.notice = "invalid value"
 
 	out := components.SanitizeText(ed.Render(90))
@@ -204,3 +204,42 @@ func splitTrimmedLines(input string) []string {
 	}
 	return out
 }
+
+// TestMetadataEditorEntryTypeHintsAndPathConflicts covers the structured
+// properties flow: labels, type hints, nesting, and conflict validation.
+func TestMetadataEditorEntryTypeHintsAndPathConflicts(t *testing.T) {
+	ed := MetadataEditor{Label: "Properties"}
+	ed.Open(map[string]any{"since": "2021"})
+	assert.Contains(t, components.SanitizeText(ed.Render(80)), "since")
+
+	typeEntry := func(text string) {
+		for _, r := range text {
+			ed.HandleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		}
+	}
+
+	ed.HandleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	typeEntry("terms | hours | 40")
+	out := components.SanitizeText(ed.Render(80))
+	assert.Contains(t, out, "Add Properties Row")
+	assert.Contains(t, out, "terms.hours → number, nested 2 levels")
+	ed.HandleKey(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Contains(t, ed.Buffer, "terms:\n  hours: 40")
+
+	ed.HandleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	typeEntry("since | start | 2021")
+	ed.HandleKey(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Contains(t, ed.notice, "key 'since' is already set as a value")
+	assert.Len(t, ed.rows, 2)
+
+	ed.HandleKey(tea.KeyMsg{Type: tea.KeyEsc})
+	ed.HandleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	typeEntry("remote | {a: 1}")
+	assert.Contains(t, components.SanitizeText(ed.Render(80)), "inline objects not supported")
+	ed.HandleKey(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Contains(t, ed.notice, "use nested keys")
+
+	assert.Equal(t, "flags → list (2 items)", metadataEntryTypeHint("flags | [a, b]"))
+	assert.Equal(t, "remote → boolean", metadataEntryTypeHint("remote | true"))
+	assert.Equal(t, "", metadataEntryTypeHint("remote"))
+}
@@ -0,0 +1,378 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+// metadataSchemaTypes lists the value types a metadata schema field may declare.
+var metadataSchemaTypes = []string{"string", "number", "integer", "boolean", "array", "object"}
+
+// metadataSchema is the per-entity-type metadata contract stored in the entity
+// type's taxonomy value_schema, using a small JSON Schema subset:
+//
+//	{"required": ["owner"], "properties": {"stage": {"type": "string", "enum": ["idea", "done"]}}}
+type metadataSchema struct {
+	Required []string
+	Fields   map[string]metadataFieldRule
+}
+
+// metadataFieldRule constrains a single metadata key.
+type metadataFieldRule struct {
+	Type string
+	Enum []string
+}
+
+// metadataFieldError is one inline validation failure.
+type metadataFieldError struct {
+	Key     string
+	Message string
+}
+
+type entityTypeSchemasLoadedMsg struct {
+	schemas map[string]*metadataSchema
+}
+
+// loadEntityTypeSchemas fetches entity type taxonomy and keeps the types that
+// declare a metadata schema. Failures leave forms unvalidated.
+func loadEntityTypeSchemas(client *api.Client) tea.Cmd {
+	if client == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		types, err := client.ListTaxonomy("entity-types", false, "", 200, 0)
+		if err != nil {
+			return entityTypeSchemasLoadedMsg{}
+		}
+		schemas := map[string]*metadataSchema{}
+		for _, typ := range types {
+			schema, err := parseMetadataSchema(map[string]any(typ.ValueSchema))
+			if err != nil || schema == nil {
+				continue
+			}
+			schemas[strings.ToLower(strings.TrimSpace(typ.Name))] = schema
+		}
+		return entityTypeSchemasLoadedMsg{schemas: schemas}
+	}
+}
+
+// schemaForType returns the schema registered for an entity type, if any.
+func schemaForType(schemas map[string]*metadataSchema, typ string) *metadataSchema {
+	if len(schemas) == 0 {
+		return nil
+	}
+	return schemas[strings.ToLower(strings.TrimSpace(typ))]
+}
+
+// parseMetadataSchema reads a value_schema map. An empty map yields nil.
+func parseMetadataSchema(raw map[string]any) (*metadataSchema, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	schema := &metadataSchema{Fields: map[string]metadataFieldRule{}}
+	if required, ok := raw["required"].([]any); ok {
+		for _, item := range required {
+			if key, ok := item.(string); ok && strings.TrimSpace(key) != "" {
+				schema.Required = append(schema.Required, strings.TrimSpace(key))
+			}
+		}
+	}
+	if props, ok := raw["properties"]; ok {
+		fields, ok := props.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("schema properties must be an object")
+		}
+		for key, def := range fields {
+			spec, ok := def.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("schema field %q must be an object", key)
+			}
+			rule := metadataFieldRule{}
+			if typ, ok := spec["type"].(string); ok {
+				rule.Type = strings.ToLower(strings.TrimSpace(typ))
+				if !isMetadataSchemaType(rule.Type) {
+					return nil, fmt.Errorf("schema field %q has unknown type %q", key, typ)
+				}
+			}
+			if enum, ok := spec["enum"].([]any); ok {
+				for _, item := range enum {
+					rule.Enum = append(rule.Enum, fmt.Sprint(item))
+				}
+			}
+			schema.Fields[key] = rule
+		}
+	}
+	if len(schema.Required) == 0 && len(schema.Fields) == 0 {
+		return nil, nil
+	}
+	return schema, nil
+}
+
+// isMetadataSchemaType reports whether typ is a supported schema value type.
+func isMetadataSchemaType(typ string) bool {
+	for _, known := range metadataSchemaTypes {
+		if typ == known {
+			return true
+		}
+	}
+	return false
+}
+
+// keys returns every key the schema mentions, sorted.
+func (s *metadataSchema) keys() []string {
+	seen := map[string]bool{}
+	keys := []string{}
+	for _, key := range s.Required {
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	for key := range s.Fields {
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// isRequired reports whether key must be present.
+func (s *metadataSchema) isRequired(key string) bool {
+	for _, item := range s.Required {
+		if item == key {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks metadata against the schema. Keys not in the schema are allowed.
+func (s *metadataSchema) Validate(meta map[string]any) []metadataFieldError {
+	if s == nil {
+		return nil
+	}
+	var errs []metadataFieldError
+	for _, key := range s.keys() {
+		value, present := lookupMetadataPath(meta, key)
+		if !present || isBlankMetadataValue(value) {
+			if s.isRequired(key) {
+				errs = append(errs, metadataFieldError{Key: key, Message: "required"})
+			}
+			continue
+		}
+		rule := s.Fields[key]
+		if rule.Type != "" && !metadataValueHasType(value, rule.Type) {
+			errs = append(errs, metadataFieldError{Key: key, Message: "must be " + rule.Type})
+			continue
+		}
+		if len(rule.Enum) > 0 && !metadataValueInEnum(value, rule.Enum) {
+			errs = append(errs, metadataFieldError{
+				Key:     key,
+				Message: "must be one of " + strings.Join(rule.Enum, ", "),
+			})
+		}
+	}
+	return errs
+}
+
+// lookupMetadataPath resolves a dotted key against nested metadata maps.
+func lookupMetadataPath(meta map[string]any, key string) (any, bool) {
+	if value, ok := meta[key]; ok {
+		return value, true
+	}
+	current := any(meta)
+	for _, part := range strings.Split(key, ".") {
+		node, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current, ok = node[part]
+		if !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// isBlankMetadataValue reports whether value carries nothing.
+func isBlankMetadataValue(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(v) == ""
+	}
+	return false
+}
+
+// metadataValueHasType checks a value against a schema type. Form input is
+// untyped text, so numeric and boolean strings are accepted as well.
+func metadataValueHasType(value any, typ string) bool {
+	switch typ {
+	case "string":
+		switch value.(type) {
+		case map[string]any, []any:
+			return false
+		}
+		return true
+	case "number":
+		switch v := value.(type) {
+		case float64, float32, int, int64:
+			return true
+		case string:
+			_, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			return err == nil
+		}
+	case "integer":
+		switch v := value.(type) {
+		case int, int64:
+			return true
+		case float64:
+			return v == float64(int64(v))
+		case string:
+			_, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+			return err == nil
+		}
+	case "boolean":
+		switch v := value.(type) {
+		case bool:
+			return true
+		case string:
+			_, err := strconv.ParseBool(strings.TrimSpace(v))
+			return err == nil
+		}
+	case "array":
+		_, ok := value.([]any)
+		return ok
+	case "object":
+		_, ok := value.(map[string]any)
+		return ok
+	}
+	return false
+}
+
+// metadataValueInEnum reports whether value matches one of the allowed values.
+func metadataValueInEnum(value any, enum []string) bool {
+	text := strings.TrimSpace(fmt.Sprint(value))
+	for _, item := range enum {
+		if strings.EqualFold(text, strings.TrimSpace(item)) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateMetadataBuffer parses editor input and validates it. Parse errors are
+// left to the save path, which already reports them.
+func validateMetadataBuffer(schema *metadataSchema, buffer string, scopes []string) []metadataFieldError {
+	if schema == nil {
+		return nil
+	}
+	meta, err := parseMetadataInput(buffer)
+	if err != nil {
+		return nil
+	}
+	return schema.Validate(mergeMetadataScopes(meta, scopes))
+}
+
+// formatMetadataSchemaSpec renders a schema as the one-line spec used by the
+// taxonomy prompt, e.g. "owner:string! stage:idea|done".
+func formatMetadataSchemaSpec(schema *metadataSchema) string {
+	if schema == nil {
+		return ""
+	}
+	parts := []string{}
+	for _, key := range schema.keys() {
+		rule := schema.Fields[key]
+		part := key
+		switch {
+		case len(rule.Enum) > 0:
+			part += ":" + strings.Join(rule.Enum, "|")
+		case rule.Type != "":
+			part += ":" + rule.Type
+		}
+		if schema.isRequired(key) {
+			part += "!"
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, " ")
+}
+
+// parseMetadataSchemaSpec turns a one-line spec into a value_schema map.
+// Each token is key[:type|a|b][!]; a trailing ! marks the key required and a
+// pipe-separated list declares an enum. An empty spec clears the schema.
+func parseMetadataSchemaSpec(spec string) (map[string]any, error) {
+	required := []any{}
+	properties := map[string]any{}
+	for _, token := range strings.Fields(spec) {
+		isRequired := strings.HasSuffix(token, "!")
+		token = strings.TrimSuffix(token, "!")
+		key, rest, _ := strings.Cut(token, ":")
+		key = strings.TrimSpace(key)
+		if key == "" {
+			return nil, fmt.Errorf("schema token %q is missing a key", token)
+		}
+		if _, dup := properties[key]; dup {
+			return nil, fmt.Errorf("schema key %q is listed twice", key)
+		}
+		field := map[string]any{}
+		switch {
+		case strings.Contains(rest, "|"):
+			enum := []any{}
+			for _, item := range strings.Split(rest, "|") {
+				if item = strings.TrimSpace(item); item != "" {
+					enum = append(enum, item)
+				}
+			}
+			field["type"] = "string"
+			field["enum"] = enum
+		case rest != "":
+			typ := strings.ToLower(rest)
+			if !isMetadataSchemaType(typ) {
+				return nil, fmt.Errorf(
+					"schema key %q has unknown type %q (use %s)",
+					key, rest, strings.Join(metadataSchemaTypes, ", "),
+				)
+			}
+			field["type"] = typ
+		}
+		properties[key] = field
+		if isRequired {
+			required = append(required, key)
+		}
+	}
+	// An empty schema is still sent as an object, since an omitted
+	// value_schema leaves the stored one unchanged.
+	return map[string]any{"required": required, "properties": properties}, nil
+}
+
+// renderMetadataSchemaHints renders the schema summary and any inline errors
+// shown under a metadata field.
+func renderMetadataSchemaHints(schema *metadataSchema, errs []metadataFieldError) string {
+	if schema == nil {
+		return ""
+	}
+	lines := []string{MutedStyle.Render("  schema: " + formatMetadataSchemaSpec(schema))}
+	for _, err := range errs {
+		lines = append(lines, ErrorStyle.Render(fmt.Sprintf("  ! %s: %s", err.Key, err.Message)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// metadataSchemaError summarizes validation failures for the form error box.
+func metadataSchemaError(typ string, errs []metadataFieldError) string {
+	parts := make([]string, len(errs))
+	for i, err := range errs {
+		parts[i] = err.Key + " " + err.Message
+	}
+	return fmt.Sprintf("Metadata does not match the %s schema: %s", typ, strings.Join(parts, "; "))
+}
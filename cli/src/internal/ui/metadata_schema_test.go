@@ -0,0 +1,184 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// meetingSchema returns a schema with a required key, a typed key, and an enum.
+func meetingSchema(t *testing.T) *metadataSchema {
+	t.Helper()
+	raw, err := parseMetadataSchemaSpec("owner! attendees:integer stage:idea|done")
+	require.NoError(t, err)
+	schema, err := parseMetadataSchema(raw)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	return schema
+}
+
+func TestMetadataSchemaValidate(t *testing.T) {
+	schema := meetingSchema(t)
+
+	errs := schema.Validate(map[string]any{"attendees": "many", "stage": "later"})
+	assert.Equal(t, []metadataFieldError{
+		{Key: "attendees", Message: "must be integer"},
+		{Key: "owner", Message: "required"},
+		{Key: "stage", Message: "must be one of idea, done"},
+	}, errs)
+
+	assert.Empty(t, schema.Validate(map[string]any{"owner": "alex", "attendees": "4", "stage": "Done"}))
+	assert.Empty(t, schema.Validate(map[string]any{"owner": "alex", "attendees": float64(4)}))
+	assert.Equal(t, "required", schema.Validate(map[string]any{"owner": "  "})[0].Message)
+
+	var none *metadataSchema
+	assert.Empty(t, none.Validate(map[string]any{}))
+}
+
+func TestMetadataSchemaNestedKeys(t *testing.T) {
+	raw, err := parseMetadataSchemaSpec("profile.email:string! flags:array")
+	require.NoError(t, err)
+	schema, err := parseMetadataSchema(raw)
+	require.NoError(t, err)
+
+	assert.Empty(t, schema.Validate(map[string]any{"profile": map[string]any{"email": "a@b.c"}}))
+	errs := schema.Validate(map[string]any{"flags": "x"})
+	require.Len(t, errs, 2)
+	assert.Equal(t, "flags", errs[0].Key)
+	assert.Equal(t, "profile.email", errs[1].Key)
+}
+
+func TestMetadataSchemaSpecRoundTrip(t *testing.T) {
+	schema := meetingSchema(t)
+	assert.Equal(t, "attendees:integer owner! stage:idea|done", formatMetadataSchemaSpec(schema))
+
+	raw, err := parseMetadataSchemaSpec("")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"required": []any{}, "properties": map[string]any{}}, raw)
+	cleared, err := parseMetadataSchema(raw)
+	require.NoError(t, err)
+	assert.Nil(t, cleared)
+
+	for _, spec := range []string{"owner:date", ":string", "owner owner"} {
+		_, err := parseMetadataSchemaSpec(spec)
+		assert.Error(t, err, spec)
+	}
+
+	_, err = parseMetadataSchema(map[string]any{"properties": "nope"})
+	assert.Error(t, err)
+}
+
+func TestEntityAddBlocksMetadataThatFailsSchema(t *testing.T) {
+	model := NewEntitiesModel(nil)
+	model.width = 100
+	model.view = entitiesViewAdd
+	model, _ = model.Update(entityTypeSchemasLoadedMsg{schemas: map[string]*metadataSchema{"meeting": meetingSchema(t)}})
+	model.addFields[addFieldName].Value = "Weekly sync"
+	model.addFields[addFieldType].Value = "Meeting"
+	model.addMeta.Buffer = "stage | later"
+
+	out := components.SanitizeText(model.renderAdd())
+	assert.Contains(t, out, "schema: attendees:integer owner! stage:idea|done")
+	assert.Contains(t, out, "! owner: required")
+	assert.Contains(t, out, "! stage: must be one of idea, done")
+
+	model, cmd := model.saveAdd()
+	assert.Nil(t, cmd)
+	assert.False(t, model.addSaving)
+	assert.Equal(t, addFieldMetadata, model.addFocus)
+	assert.Contains(t, model.errText, "Metadata does not match the Meeting schema")
+
+	// Types without a schema are not validated.
+	model.addFields[addFieldType].Value = "person"
+	assert.NotContains(t, components.SanitizeText(model.renderAdd()), "schema: attendees")
+}
+
+func TestEntityEditValidatesAgainstTypeSchema(t *testing.T) {
+	model := NewEntitiesModel(nil)
+	model.width = 100
+	model.typeSchemas = map[string]*metadataSchema{"meeting": meetingSchema(t)}
+	model.detail = &api.Entity{ID: "ent-1", Name: "Weekly sync", Type: "meeting", Status: "active"}
+	model.startEdit()
+	model.view = entitiesViewEdit
+
+	model, cmd := model.saveEdit()
+	assert.Nil(t, cmd)
+	assert.False(t, model.editSaving)
+	assert.Equal(t, editFieldMetadata, model.editFocus)
+	assert.Contains(t, components.SanitizeText(model.renderEdit()), "owner required")
+
+	model.editMeta.Buffer = "owner | alex"
+	model, cmd = model.saveEdit()
+	assert.NotNil(t, cmd)
+	assert.True(t, model.editSaving)
+}
+
+func TestProfileEntityTypeSchemaPrompt(t *testing.T) {
+	var gotBody map[string]any
+	_, client := testProfileTaxonomyClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPatch:
+			assert.Equal(t, "/api/taxonomy/entity-types/type-1", r.URL.Path)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "type-1"}}))
+		default:
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{}}))
+		}
+	})
+
+	model := NewProfileModel(client, &config.Config{APIKey: "test-key"})
+	model.section = 2
+	model.taxKind = 1
+	model.width = 100
+	model.setTaxonomyItems([]api.TaxonomyEntry{{
+		ID: "type-1", Name: "meeting", IsActive: true,
+		ValueSchema: api.JSONMap{"required": []any{"owner"}},
+	}})
+	assert.Contains(t, components.SanitizeText(model.View()), "owner!")
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	assert.Nil(t, cmd)
+	require.Equal(t, taxPromptSchema, model.taxPromptMode)
+	assert.Equal(t, "owner!", model.taxPromptBuf.Value)
+
+	model = typeText(model, " stage:idea|done")
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	model, _ = model.Update(cmd())
+	assert.Equal(t, "Updated metadata schema for meeting", model.taxNotice)
+	assert.Equal(t, map[string]any{
+		"required": []any{"owner"},
+		"properties": map[string]any{
+			"owner": map[string]any{},
+			"stage": map[string]any{"type": "string", "enum": []any{"idea", "done"}},
+		},
+	}, gotBody["value_schema"])
+
+	// Other taxonomy kinds do not carry metadata schemas.
+	model.taxKind = 0
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	assert.Equal(t, taxPromptNone, model.taxPromptMode)
+}
+
+func TestLoadEntityTypeSchemasKeepsTypesWithSchemas(t *testing.T) {
+	_, client := testProfileTaxonomyClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/taxonomy/entity-types", r.URL.Path)
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+			{"id": "t1", "name": "Meeting", "value_schema": map[string]any{"required": []string{"owner"}}},
+			{"id": "t2", "name": "person"},
+			{"id": "t3", "name": "broken", "value_schema": map[string]any{"properties": "nope"}},
+		}}))
+	})
+
+	msg := loadEntityTypeSchemas(client)().(entityTypeSchemasLoadedMsg)
+	require.Len(t, msg.schemas, 1)
+	assert.NotNil(t, schemaForType(msg.schemas, " meeting "))
+	assert.Nil(t, loadEntityTypeSchemas(nil))
+}
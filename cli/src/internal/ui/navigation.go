@@ -0,0 +1,235 @@
+package ui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// navHistoryLimit caps how many visited records the back stack remembers.
+const navHistoryLimit = 50
+
+// navCrumbLimit caps how many crumbs the breadcrumb line shows.
+const navCrumbLimit = 5
+
+// navEntry is one visited record detail.
+type navEntry struct {
+	key   string
+	label string
+	sel   searchSelectionMsg
+}
+
+// navHistory is a browser-style back/forward stack of visited details.
+type navHistory struct {
+	entries []navEntry
+	pos     int
+}
+
+// current returns the entry under the cursor.
+func (h navHistory) current() (navEntry, bool) {
+	if h.pos < 0 || h.pos >= len(h.entries) {
+		return navEntry{}, false
+	}
+	return h.entries[h.pos], true
+}
+
+// push records entry after the cursor and drops any forward entries.
+func (h *navHistory) push(entry navEntry) {
+	if cur, ok := h.current(); ok && cur.key == entry.key {
+		h.entries[h.pos] = entry
+		return
+	}
+	if len(h.entries) > 0 {
+		h.entries = h.entries[:h.pos+1]
+	}
+	h.entries = append(h.entries, entry)
+	if len(h.entries) > navHistoryLimit {
+		h.entries = h.entries[len(h.entries)-navHistoryLimit:]
+	}
+	h.pos = len(h.entries) - 1
+}
+
+// step moves the cursor by delta and returns the entry it lands on.
+func (h *navHistory) step(delta int) (navEntry, bool) {
+	next := h.pos + delta
+	if next < 0 || next >= len(h.entries) {
+		return navEntry{}, false
+	}
+	h.pos = next
+	return h.entries[next], true
+}
+
+// navEntryFor describes the detail the active tab is showing, if any.
+func (a App) navEntryFor() (navEntry, bool) {
+	switch a.tab {
+	case tabEntities:
+		switch a.entities.view {
+		case entitiesViewDetail, entitiesViewRelationships, entitiesViewHistory:
+			if e := a.entities.detail; e != nil {
+				entity := *e
+				return navEntry{key: "entity:" + e.ID, label: e.Name, sel: searchSelectionMsg{kind: "entity", entity: &entity}}, true
+			}
+		}
+	case tabRelations:
+		if r := a.rels.detail; r != nil && a.rels.view == relsViewDetail {
+			rel := *r
+			label := r.Type
+			if label == "" {
+				label = "relationship"
+			}
+			return navEntry{key: "relationship:" + r.ID, label: label, sel: searchSelectionMsg{kind: "relationship", rel: &rel}}, true
+		}
+	case tabKnow:
+		if c := a.know.detail; c != nil && a.know.view == contextViewDetail {
+			item := *c
+			return navEntry{key: "context:" + c.ID, label: c.Title, sel: searchSelectionMsg{kind: "context", context: &item}}, true
+		}
+	case tabJobs:
+		if j := a.jobs.detail; j != nil {
+			job := *j
+			return navEntry{key: "job:" + j.ID, label: j.Title, sel: searchSelectionMsg{kind: "job", job: &job}}, true
+		}
+	case tabLogs:
+		if l := a.logs.detail; l != nil && a.logs.view == logsViewDetail {
+			log := *l
+			return navEntry{key: "log:" + l.ID, label: l.LogType, sel: searchSelectionMsg{kind: "log", log: &log}}, true
+		}
+	case tabFiles:
+		if f := a.files.detail; f != nil && a.files.view == filesViewDetail {
+			file := *f
+			return navEntry{key: "file:" + f.ID, label: f.Filename, sel: searchSelectionMsg{kind: "file", file: &file}}, true
+		}
+	case tabProtocols:
+		if p := a.protocols.detail; p != nil && a.protocols.view == protocolsViewDetail {
+			proto := *p
+			return navEntry{key: "protocol:" + p.ID, label: p.Name, sel: searchSelectionMsg{kind: "protocol", proto: &proto}}, true
+		}
+	}
+	return navEntry{}, false
+}
+
+// recordNav pushes the detail now on screen when it differs from the cursor.
+func (a *App) recordNav() {
+	if entry, ok := a.navEntryFor(); ok {
+		a.nav.push(entry)
+	}
+}
+
+// navKeysAllowed reports whether [ and ] may move through history without
+// stealing the taxonomy kind switcher on the settings tab.
+func (a App) navKeysAllowed() bool {
+	return len(a.nav.entries) > 0 && a.tab != tabProfile && a.browsing()
+}
+
+// browsing reports whether the active tab shows a detail or an unfiltered
+// list, so single-key globals will not steal typed text.
+func (a App) browsing() bool {
+	if _, ok := a.navEntryFor(); ok {
+		return !(a.tab == tabJobs && (a.jobs.changingSt || a.jobs.creatingSubtask || a.jobs.checklistEdit || a.jobs.cancelling))
+	}
+	switch a.tab {
+	case tabInbox:
+		return !a.inbox.filtering && !a.inbox.rejecting && !a.inbox.rejectPreview && !a.inbox.confirming && !a.inbox.commenting && a.inbox.triagePrompt == triagePromptNone
+	case tabEntities:
+		return a.entities.view == entitiesViewList && !a.entities.filtering && a.entities.bulkPrompt == "" && !a.entities.bulkEdit.open
+	case tabRelations:
+		return a.rels.view == relsViewList && !a.rels.filtering
+	case tabKnow:
+		return a.know.view == contextViewList && !a.know.filtering && a.know.bulk.prompt == ""
+	case tabJobs:
+		return !a.jobs.filtering
+	case tabLogs:
+		return a.logs.view == logsViewList && !a.logs.filtering
+	case tabFiles:
+		return a.files.view == filesViewList && !a.files.filtering && a.files.bulk.prompt == ""
+	case tabProtocols:
+		return a.protocols.view == protocolsViewList && !a.protocols.filtering
+	case tabHistory:
+		return !a.history.filtering
+	case tabDashboard, tabStatus, tabActivity:
+		return true
+	case tabCollections:
+		return a.collections.view == collectionsViewList
+	}
+	return false
+}
+
+// navigate moves back (-1) or forward (+1) and reopens that detail. Leaving a
+// list for the entry under the cursor counts as going back to it.
+func (a *App) navigate(delta int) (tea.Model, tea.Cmd) {
+	if _, onDetail := a.navEntryFor(); !onDetail && delta < 0 {
+		if entry, ok := a.nav.current(); ok {
+			return a.openNavEntry(entry)
+		}
+	}
+	entry, ok := a.nav.step(delta)
+	if !ok {
+		return *a, nil
+	}
+	return a.openNavEntry(entry)
+}
+
+// openNavEntry reopens a recorded detail without pushing a new entry.
+func (a *App) openNavEntry(entry navEntry) (tea.Model, tea.Cmd) {
+	model, cmd := a.applySearchSelection(entry.sel)
+	app := model.(App)
+	app.bodyScroll = 0
+	return app, cmd
+}
+
+// openRelationshipNode jumps to the entity at one end of a relationship.
+func openRelationshipNode(client *api.Client, nodeType, id string) tea.Cmd {
+	if client == nil || id == "" {
+		return nil
+	}
+	if nodeType != "" && nodeType != "entity" {
+		return nil
+	}
+	return func() tea.Msg {
+		entity, err := client.GetEntity(id)
+		if err != nil {
+			return errMsg{err}
+		}
+		return searchSelectionMsg{kind: "entity", entity: entity}
+	}
+}
+
+// renderBreadcrumbs renders the visited path with the current crumb selected.
+func (a App) renderBreadcrumbs() string {
+	if len(a.nav.entries) < 2 {
+		return ""
+	}
+	start := 0
+	if len(a.nav.entries) > navCrumbLimit {
+		start = len(a.nav.entries) - navCrumbLimit
+		if a.nav.pos < start {
+			start = a.nav.pos
+		}
+	}
+	end := start + navCrumbLimit
+	if end > len(a.nav.entries) {
+		end = len(a.nav.entries)
+	}
+	crumbs := make([]string, 0, end-start+2)
+	if start > 0 {
+		crumbs = append(crumbs, MutedStyle.Render("…"))
+	}
+	for i := start; i < end; i++ {
+		label := components.ClampTextWidthEllipsis(components.SanitizeOneLine(a.nav.entries[i].label), 24)
+		if strings.TrimSpace(label) == "" {
+			label = shortID(a.nav.entries[i].key)
+		}
+		if i == a.nav.pos {
+			crumbs = append(crumbs, SelectedStyle.Render(label))
+		} else {
+			crumbs = append(crumbs, MutedStyle.Render(label))
+		}
+	}
+	if end < len(a.nav.entries) {
+		crumbs = append(crumbs, MutedStyle.Render("…"))
+	}
+	return strings.Join(crumbs, MutedStyle.Render(" › "))
+}
@@ -0,0 +1,116 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestNavHistoryPushTruncatesForward(t *testing.T) {
+	var h navHistory
+	h.push(navEntry{key: "entity:a"})
+	h.push(navEntry{key: "entity:b"})
+	h.push(navEntry{key: "entity:b", label: "B"})
+	require.Len(t, h.entries, 2)
+	assert.Equal(t, "B", h.entries[1].label)
+
+	entry, ok := h.step(-1)
+	require.True(t, ok)
+	assert.Equal(t, "entity:a", entry.key)
+	_, ok = h.step(-1)
+	assert.False(t, ok)
+
+	h.push(navEntry{key: "entity:c"})
+	require.Len(t, h.entries, 2)
+	assert.Equal(t, "entity:c", h.entries[1].key)
+	_, ok = h.step(1)
+	assert.False(t, ok)
+
+	for i := 0; i < navHistoryLimit+5; i++ {
+		h.push(navEntry{key: fmt.Sprintf("job:%d", i)})
+	}
+	assert.Len(t, h.entries, navHistoryLimit)
+	assert.Equal(t, navHistoryLimit-1, h.pos)
+}
+
+func TestAppNavigationBackForwardAndBreadcrumbs(t *testing.T) {
+	app := NewApp(nil, &config.Config{})
+	app.width = 120
+
+	step := func(msg tea.Msg) {
+		model, _ := app.Update(msg)
+		app = model.(App)
+	}
+	step(searchSelectionMsg{kind: "entity", entity: &api.Entity{ID: "ent-1", Name: "Alpha"}})
+	step(searchSelectionMsg{kind: "relationship", rel: &api.Relationship{ID: "rel-1", Type: "works-at"}})
+	step(searchSelectionMsg{kind: "entity", entity: &api.Entity{ID: "ent-2", Name: "Acme"}})
+	require.Len(t, app.nav.entries, 3)
+	assert.Contains(t, components.SanitizeText(app.View()), "Alpha › works-at › Acme")
+
+	step(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("[")})
+	assert.Equal(t, tabRelations, app.tab)
+	require.NotNil(t, app.rels.detail)
+	assert.Equal(t, "rel-1", app.rels.detail.ID)
+
+	step(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("[")})
+	assert.Equal(t, tabEntities, app.tab)
+	assert.Equal(t, "ent-1", app.entities.detail.ID)
+
+	step(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("]")})
+	assert.Equal(t, tabRelations, app.tab)
+	assert.Len(t, app.nav.entries, 3)
+	assert.Equal(t, 1, app.nav.pos)
+
+	// Leaving the detail for the list and pressing back reopens it.
+	step(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, relsViewList, app.rels.view)
+	step(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("[")})
+	assert.Equal(t, relsViewDetail, app.rels.view)
+	assert.Equal(t, 1, app.nav.pos)
+}
+
+func TestAppNavigationKeysSkipFilteringAndProfile(t *testing.T) {
+	app := NewApp(nil, &config.Config{})
+	model, _ := app.applySearchSelection(searchSelectionMsg{kind: "entity", entity: &api.Entity{ID: "ent-1", Name: "Alpha"}})
+	app = model.(App)
+	assert.True(t, app.navKeysAllowed())
+
+	app.entities.view = entitiesViewList
+	app.entities.filtering = true
+	assert.False(t, app.navKeysAllowed())
+
+	app.tab = tabProfile
+	assert.False(t, app.navKeysAllowed())
+}
+
+func TestRelationshipDetailOpensEndpointEntity(t *testing.T) {
+	_, client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/entities/ent-2", r.URL.Path)
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{"id": "ent-2", "name": "Acme"},
+		}))
+	})
+	m := NewRelationshipsModel(client)
+	m.view = relsViewDetail
+	m.detail = &api.Relationship{ID: "rel-1", SourceType: "context", SourceID: "ctx-1", TargetType: "entity", TargetID: "ent-2"}
+
+	_, cmd := m.handleDetailKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	assert.Nil(t, cmd)
+
+	_, cmd = m.handleDetailKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	require.NotNil(t, cmd)
+	msg, ok := cmd().(searchSelectionMsg)
+	require.True(t, ok)
+	assert.Equal(t, "entity", msg.kind)
+	require.NotNil(t, msg.entity)
+	assert.Equal(t, "Acme", msg.entity.Name)
+}
@@ -0,0 +1,154 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// notificationHistoryLimit caps how many notifications the panel keeps.
+const notificationHistoryLimit = 100
+
+// feedbackMaxLines caps how many wrapped lines an inline error may take before
+// it is cut and the full text is left to the notification panel.
+const feedbackMaxLines = 3
+
+// appNotification is one toast or error kept for the notification panel.
+type appNotification struct {
+	level string
+	text  string
+	at    time.Time
+}
+
+// notify records a notification and counts it as unseen.
+func (a *App) notify(level, text string) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return
+	}
+	a.notifications = append(a.notifications, appNotification{level: level, text: text, at: time.Now()})
+	if over := len(a.notifications) - notificationHistoryLimit; over > 0 {
+		a.notifications = append([]appNotification(nil), a.notifications[over:]...)
+	}
+	if !a.notifOpen {
+		a.notifUnseen = min(a.notifUnseen+1, len(a.notifications))
+	}
+}
+
+// openNotifications shows the notification panel and marks everything seen.
+func (a *App) openNotifications() {
+	a.tabNav = false
+	a.notifOpen = true
+	a.notifIndex = 0
+	a.notifUnseen = 0
+}
+
+// handleNotificationKeys handles keys while the notification panel is open.
+func (a App) handleNotificationKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case isBack(msg), isKey(msg, "N"):
+		a.notifOpen = false
+	case isUp(msg):
+		if a.notifIndex > 0 {
+			a.notifIndex--
+		}
+	case isDown(msg):
+		if a.notifIndex < len(a.notifications)-1 {
+			a.notifIndex++
+		}
+	case isKey(msg, "x"):
+		a.notifications = nil
+		a.notifIndex = 0
+	}
+	return a, nil
+}
+
+// renderNotificationStatus renders the persistent unseen-count line under the
+// banner, with the latest message so it outlives its toast.
+func (a App) renderNotificationStatus() string {
+	if a.notifUnseen == 0 || len(a.notifications) == 0 {
+		return ""
+	}
+	latest := a.notifications[len(a.notifications)-1]
+	count := fmt.Sprintf("%d unseen", a.notifUnseen)
+	if latest.level == "error" {
+		count = ErrorStyle.Render(count)
+	} else {
+		count = AccentStyle.Render(count)
+	}
+	text := components.ClampTextWidthEllipsis(components.SanitizeOneLine(latest.text), 48)
+	return MutedStyle.Render("notifications: ") + count + MutedStyle.Render(" · "+text+" · N to view")
+}
+
+// renderNotifications renders the notification history, newest first. The
+// selected entry is shown in full; the rest are cut to one line.
+func (a App) renderNotifications() string {
+	if len(a.notifications) == 0 {
+		return components.Indent(components.EmptyStateBox(
+			"Notifications",
+			"No notifications yet.",
+			[]string{"Toasts and errors are kept here after they leave the screen."},
+			a.width,
+		), 1)
+	}
+
+	contentWidth := components.BoxContentWidth(a.width)
+	if contentWidth <= 0 {
+		contentWidth = 72
+	}
+	var lines []string
+	for pos := 0; pos < len(a.notifications); pos++ {
+		n := a.notifications[len(a.notifications)-1-pos]
+		marker := "  "
+		if pos == a.notifIndex {
+			marker = AccentStyle.Render("> ")
+		}
+		header := fmt.Sprintf("%s  %s", n.at.Format("15:04:05"), notificationLevelLabel(n.level))
+		text := components.SanitizeText(n.text)
+		if pos != a.notifIndex {
+			lines = append(lines, marker+MutedStyle.Render(header)+"  "+components.ClampTextWidthEllipsis(components.SanitizeOneLine(text), contentWidth-lipgloss.Width(header)-6))
+			continue
+		}
+		lines = append(lines, marker+header)
+		for _, part := range wrapPreviewText(text, contentWidth-4) {
+			lines = append(lines, "    "+NormalStyle.Render(part))
+		}
+	}
+	return components.Indent(components.TitledBox("Notifications", strings.Join(lines, "\n"), a.width), 1)
+}
+
+// notificationLevelLabel renders a level as a colored word, so the level is
+// readable without color.
+func notificationLevelLabel(level string) string {
+	switch level {
+	case "success":
+		return SuccessStyle.Render("success")
+	case "warning":
+		return WarningStyle.Render("warning")
+	case "error":
+		return ErrorStyle.Render("error")
+	default:
+		return MutedStyle.Render("info")
+	}
+}
+
+// clampFeedback cuts an inline error to feedbackMaxLines wrapped lines so long
+// messages do not push the layout around.
+func clampFeedback(text string, width int) string {
+	if width <= 0 {
+		return text
+	}
+	lines := wrapPreviewText(components.SanitizeText(text), width)
+	if len(lines) <= feedbackMaxLines {
+		return text
+	}
+	lines = lines[:feedbackMaxLines]
+	last := components.ClampTextWidth(lines[feedbackMaxLines-1], max(width-24, 8))
+	lines[feedbackMaxLines-1] = last + "… (N for full text)"
+	return strings.Join(lines, "\n")
+}
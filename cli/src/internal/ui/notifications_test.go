@@ -0,0 +1,74 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestNotificationsKeepToastsAndErrors(t *testing.T) {
+	app := NewApp(nil, &config.Config{})
+	app.width = 100
+	app.tabNav = false
+
+	require.NotNil(t, app.setToast("success", "Entity created."))
+	model, _ := app.Update(errMsg{errors.New("NOT_FOUND: entity missing")})
+	app = model.(App)
+	model, _ = app.Update(clearToastMsg{})
+	app = model.(App)
+
+	require.Len(t, app.notifications, 2)
+	assert.Equal(t, 2, app.notifUnseen)
+	view := components.SanitizeText(app.View())
+	assert.Contains(t, view, "notifications: 2 unseen")
+	assert.Contains(t, view, "Notifications (2)")
+
+	// The first key clears the inline error; N then opens the panel.
+	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("N")})
+	app = model.(App)
+	require.True(t, app.notifOpen)
+	assert.Zero(t, app.notifUnseen)
+	view = components.SanitizeText(app.View())
+	assert.Contains(t, view, "NOT_FOUND: entity missing")
+	assert.Contains(t, view, "Entity created.")
+	assert.Less(t, strings.Index(view, "NOT_FOUND"), strings.Index(view, "Entity created."))
+
+	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	app = model.(App)
+	assert.False(t, app.notifOpen)
+	assert.NotContains(t, components.SanitizeText(app.View()), "unseen")
+}
+
+func TestNotificationKeySkipsTyping(t *testing.T) {
+	app := NewApp(nil, &config.Config{})
+	app.tabNav = false
+	app.tab = tabEntities
+	app.entities.filtering = true
+
+	model, _ := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("N")})
+	app = model.(App)
+	assert.False(t, app.notifOpen)
+}
+
+func TestToastDurationOffOnlyNotifies(t *testing.T) {
+	app := NewApp(nil, &config.Config{ToastDuration: "off"})
+	assert.Nil(t, app.setToast("info", "Vim keys off."))
+	assert.Nil(t, app.toast)
+	require.Len(t, app.notifications, 1)
+	assert.Equal(t, "info", app.notifications[0].level)
+}
+
+func TestClampFeedbackCutsLongErrors(t *testing.T) {
+	assert.Equal(t, "short", clampFeedback("short", 40))
+	long := strings.Repeat("word ", 60)
+	clamped := clampFeedback(long, 40)
+	assert.Len(t, strings.Split(clamped, "\n"), feedbackMaxLines)
+	assert.True(t, strings.HasSuffix(clamped, "(N for full text)"))
+}
@@ -0,0 +1,265 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// offlineRetryInterval is how often queued edits retry while offline.
+const offlineRetryInterval = 30 * time.Second
+
+// Replay outcomes shown on the reconciliation screen.
+const (
+	offlineApplied    = "applied"
+	offlineConflicted = "conflicted"
+	offlineFailed     = "failed"
+)
+
+// offlineEditQueuedMsg reports an entity edit saved to the offline queue
+// because the server could not be reached.
+type offlineEditQueuedMsg struct {
+	name    string
+	pending int
+	err     error
+}
+
+// offlineReplayTickMsg retries the offline queue.
+type offlineReplayTickMsg struct{}
+
+// offlineReplayedMsg reports one replay attempt. pending counts what is still
+// queued; results is empty when the server was still unreachable.
+type offlineReplayedMsg struct {
+	results []offlineResult
+	pending int
+}
+
+// offlineResult is the outcome of replaying one queued edit.
+type offlineResult struct {
+	edit    config.OfflineEdit
+	outcome string
+	detail  string
+}
+
+// isUnreachable reports whether err means the request never reached the server.
+func isUnreachable(err error) bool {
+	return errors.Is(err, api.ErrUnreachable)
+}
+
+// queueOfflineEdit stores an entity edit for replay on reconnect.
+func queueOfflineEdit(id, name string, input api.UpdateEntityInput, scopes []string) tea.Msg {
+	queue, err := config.LoadOfflineQueue()
+	if err != nil {
+		return offlineEditQueuedMsg{name: name, err: err}
+	}
+	edit := config.OfflineEdit{
+		EntityID:          id,
+		EntityName:        name,
+		Status:            input.Status,
+		Tags:              input.Tags,
+		Metadata:          input.Metadata,
+		ExpectedUpdatedAt: input.ExpectedUpdatedAt,
+		QueuedAt:          time.Now(),
+	}
+	if scopes != nil {
+		edit.Scopes = &scopes
+	}
+	queue.Add(edit)
+	if err := queue.Save(); err != nil {
+		return offlineEditQueuedMsg{name: name, err: err}
+	}
+	return offlineEditQueuedMsg{name: name, pending: len(queue.Edits)}
+}
+
+// offlineEditInput rebuilds the API update from a queued edit.
+func offlineEditInput(edit config.OfflineEdit) api.UpdateEntityInput {
+	return api.UpdateEntityInput{
+		Status:            edit.Status,
+		Tags:              edit.Tags,
+		Metadata:          edit.Metadata,
+		ExpectedUpdatedAt: edit.ExpectedUpdatedAt,
+	}
+}
+
+// replayOfflineEdits replays queued edits in order. An edit whose entity
+// changed since it was queued is reported as conflicted rather than
+// overwriting the remote change. Replay stops at the first unreachable
+// request and keeps the rest queued.
+func replayOfflineEdits(client *api.Client) tea.Cmd {
+	if client == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		queue, err := config.LoadOfflineQueue()
+		if err != nil || len(queue.Edits) == 0 {
+			return offlineReplayedMsg{}
+		}
+		var results []offlineResult
+		for _, edit := range queue.Edits {
+			result, ok := replayOfflineEdit(client, edit)
+			if !ok {
+				break
+			}
+			results = append(results, result)
+		}
+		if len(results) == 0 {
+			return offlineReplayedMsg{pending: len(queue.Edits)}
+		}
+		// Reload so edits queued during the replay are kept.
+		queue, err = config.LoadOfflineQueue()
+		if err != nil {
+			return errMsg{err}
+		}
+		for _, result := range results {
+			queue.Remove(result.edit.ID)
+		}
+		if err := queue.Save(); err != nil {
+			return errMsg{err}
+		}
+		return offlineReplayedMsg{results: results, pending: len(queue.Edits)}
+	}
+}
+
+// replayOfflineEdit applies one queued edit. ok is false when the server is
+// still unreachable and the edit should stay queued.
+func replayOfflineEdit(client *api.Client, edit config.OfflineEdit) (offlineResult, bool) {
+	result := offlineResult{edit: edit, outcome: offlineApplied}
+	_, err := client.UpdateEntity(edit.EntityID, offlineEditInput(edit))
+	if err == nil && edit.Scopes != nil {
+		_, err = client.BulkUpdateEntityScopes(api.BulkUpdateEntityScopesInput{
+			EntityIDs: []string{edit.EntityID},
+			Scopes:    *edit.Scopes,
+			Op:        "set",
+		})
+	}
+	switch {
+	case err == nil:
+	case isUnreachable(err):
+		return result, false
+	case isEditConflict(err):
+		result.outcome = offlineConflicted
+		result.detail = "changed on the server after this edit was queued; reopen it and edit again"
+	default:
+		result.outcome = offlineFailed
+		result.detail = err.Error()
+	}
+	return result, true
+}
+
+// offlineRetryCmd arms the next replay attempt unless one is already armed.
+func (a *App) offlineRetryCmd() tea.Cmd {
+	if a.offlineRetryArmed || a.offlinePending == 0 {
+		return nil
+	}
+	a.offlineRetryArmed = true
+	return tea.Tick(offlineRetryInterval, func(time.Time) tea.Msg { return offlineReplayTickMsg{} })
+}
+
+// handleOfflineQueued counts a newly queued edit and hands the result to the
+// entities tab so the edit form closes.
+func (a *App) handleOfflineQueued(msg offlineEditQueuedMsg) tea.Cmd {
+	if msg.err == nil {
+		a.offlinePending = msg.pending
+		a.notify("info", fmt.Sprintf("Offline: edit to %s queued; it will replay on reconnect.", offlineEditName(msg.name, "entity")))
+	}
+	return tea.Batch(a.updateTab(tabEntities, msg), a.offlineRetryCmd())
+}
+
+// handleOfflineReplayed records a replay attempt and opens the reconciliation
+// screen when any queued edit was resolved.
+func (a *App) handleOfflineReplayed(msg offlineReplayedMsg) tea.Cmd {
+	a.offlinePending = msg.pending
+	if len(msg.results) > 0 {
+		a.reconcile = msg.results
+		a.reconcileOpen = true
+		a.notify("info", "Offline edits replayed: "+offlineReplaySummary(msg.results))
+	}
+	return a.offlineRetryCmd()
+}
+
+// handleReconcileKeys closes the reconciliation screen.
+func (a App) handleReconcileKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if isBack(msg) || isEnter(msg) {
+		a.reconcileOpen = false
+		a.reconcile = nil
+	}
+	return a, nil
+}
+
+// offlineEditName falls back when a queued edit has no entity name.
+func offlineEditName(name, fallback string) string {
+	if name = components.SanitizeOneLine(name); name != "" {
+		return name
+	}
+	return fallback
+}
+
+// offlineReplaySummary counts replay outcomes as "2 applied, 1 conflicted".
+func offlineReplaySummary(results []offlineResult) string {
+	counts := map[string]int{}
+	for _, result := range results {
+		counts[result.outcome]++
+	}
+	var parts []string
+	for _, outcome := range []string{offlineApplied, offlineConflicted, offlineFailed} {
+		if n := counts[outcome]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", n, outcome))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// renderOfflineStatus renders the queued-edit count under the banner.
+func (a App) renderOfflineStatus() string {
+	if a.offlinePending == 0 {
+		return ""
+	}
+	count := fmt.Sprintf("%d queued", a.offlinePending)
+	return MutedStyle.Render("offline edits: ") + WarningStyle.Render(count) + MutedStyle.Render(" · replay on reconnect")
+}
+
+// renderReconcile lists replayed edits grouped by outcome.
+func (a App) renderReconcile() string {
+	contentWidth := components.BoxContentWidth(a.width)
+	if contentWidth <= 0 {
+		contentWidth = 72
+	}
+	lines := []string{
+		AccentStyle.Render("Offline edits replayed"),
+		NormalStyle.Render(offlineReplaySummary(a.reconcile)),
+	}
+	for _, group := range []struct {
+		outcome string
+		label   string
+		style   func(...string) string
+	}{
+		{offlineConflicted, "Conflicted", WarningStyle.Render},
+		{offlineFailed, "Failed", ErrorStyle.Render},
+		{offlineApplied, "Applied", SuccessStyle.Render},
+	} {
+		var rows []string
+		for _, result := range a.reconcile {
+			if result.outcome != group.outcome {
+				continue
+			}
+			name := offlineEditName(result.edit.EntityName, result.edit.EntityID)
+			rows = append(rows, "  "+components.ClampTextWidthEllipsis(name, contentWidth-4))
+			if result.detail != "" {
+				rows = append(rows, "    "+MutedStyle.Render(components.ClampTextWidthEllipsis(components.SanitizeOneLine(result.detail), contentWidth-6)))
+			}
+		}
+		if len(rows) == 0 {
+			continue
+		}
+		lines = append(lines, "", group.style(group.label))
+		lines = append(lines, rows...)
+	}
+	return components.Indent(components.TitledBox("Offline Sync", strings.Join(lines, "\n"), a.width), 1)
+}
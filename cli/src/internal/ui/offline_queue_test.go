@@ -0,0 +1,160 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestOfflineEditIsQueuedWhenServerUnreachable(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	client := api.NewClient(srv.URL, "test-key")
+	srv.Close()
+
+	app := NewApp(client, &config.Config{})
+	app.width = 100
+	app.tab = tabEntities
+	app.tabNav = false
+	seen := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	app.entities.detail = &api.Entity{ID: "ent-1", Name: "Alpha", Status: "active"}
+	app.entities.editBase = &api.Entity{ID: "ent-1", Status: "active", UpdatedAt: seen}
+	app.entities.editStatusIdx = 1
+	app.entities.view = entitiesViewEdit
+
+	var cmd tea.Cmd
+	app.entities, cmd = app.entities.saveEdit()
+	require.NotNil(t, cmd)
+	msg := cmd()
+	require.IsType(t, offlineEditQueuedMsg{}, msg)
+
+	model, retry := app.Update(msg)
+	app = model.(App)
+	assert.NotNil(t, retry)
+	assert.Equal(t, 1, app.offlinePending)
+	assert.False(t, app.entities.editSaving)
+	assert.Equal(t, entitiesViewDetail, app.entities.view)
+	assert.Contains(t, components.SanitizeText(app.View()), "offline edits: 1 queued")
+
+	queue, err := config.LoadOfflineQueue()
+	require.NoError(t, err)
+	require.Len(t, queue.Edits, 1)
+	assert.Equal(t, "Alpha", queue.Edits[0].EntityName)
+	assert.Equal(t, "inactive", *queue.Edits[0].Status)
+	assert.True(t, seen.Equal(*queue.Edits[0].ExpectedUpdatedAt))
+}
+
+func TestOfflineReplayReconcilesQueuedEdits(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	queue := &config.OfflineQueue{}
+	for i, id := range []string{"ent-ok", "ent-stale", "ent-bad"} {
+		queue.Add(config.OfflineEdit{EntityID: id, EntityName: strings.TrimPrefix(id, "ent-") + " entity", QueuedAt: time.Unix(int64(i+1), 0)})
+	}
+	require.NoError(t, queue.Save())
+
+	_, client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/entities/ent-stale":
+			w.WriteHeader(http.StatusConflict)
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"detail": "entity changed"}))
+		case "/api/entities/ent-bad":
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"detail": "invalid status"}))
+		default:
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "ent-ok"}}))
+		}
+	})
+
+	app := NewApp(client, &config.Config{})
+	app.width = 100
+	app.offlinePending = 3
+	msg := replayOfflineEdits(client)()
+	model, _ := app.Update(msg)
+	app = model.(App)
+
+	require.True(t, app.reconcileOpen)
+	assert.Zero(t, app.offlinePending)
+	view := components.SanitizeText(app.View())
+	assert.Contains(t, view, "1 applied, 1 conflicted, 1 failed")
+	assert.Contains(t, view, "Conflicted")
+	assert.Contains(t, view, "stale entity")
+	assert.Contains(t, view, "invalid status")
+	assert.Less(t, strings.Index(view, "Conflicted"), strings.Index(view, "Applied"))
+
+	remaining, err := config.LoadOfflineQueue()
+	require.NoError(t, err)
+	assert.Empty(t, remaining.Edits)
+
+	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.False(t, model.(App).reconcileOpen)
+}
+
+func TestOfflineReplayReportsServerConflict(t *testing.T) {
+	seen := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	scopes := []string{"work"}
+	status := "inactive"
+	edit := config.OfflineEdit{
+		EntityID:          "ent-1",
+		EntityName:        "Alpha",
+		Status:            &status,
+		Scopes:            &scopes,
+		ExpectedUpdatedAt: &seen,
+	}
+
+	var sent map[string]any
+	scopesCalled := false
+	_, client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/entities/ent-1":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&sent))
+			w.WriteHeader(http.StatusConflict)
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"detail": map[string]any{
+				"error": map[string]any{"code": "CONFLICT", "message": "Entity changed since it was read"},
+			}}))
+		case "/api/entities/bulk/scopes":
+			scopesCalled = true
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"updated": 1}}))
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	result, ok := replayOfflineEdit(client, edit)
+	require.True(t, ok)
+	assert.Equal(t, offlineConflicted, result.outcome)
+	assert.Contains(t, result.detail, "changed on the server")
+	assert.Equal(t, seen.Format(time.RFC3339), sent["expected_updated_at"])
+	assert.False(t, scopesCalled, "scopes are not applied over a conflicting edit")
+}
+
+func TestOfflineReplayKeepsQueueWhileUnreachable(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	queue := &config.OfflineQueue{}
+	queue.Add(config.OfflineEdit{EntityID: "ent-1", QueuedAt: time.Unix(1, 0)})
+	require.NoError(t, queue.Save())
+
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	client := api.NewClient(srv.URL, "test-key")
+	srv.Close()
+
+	msg := replayOfflineEdits(client)()
+	assert.Equal(t, offlineReplayedMsg{pending: 1}, msg)
+
+	app := NewApp(client, &config.Config{})
+	model, retry := app.Update(msg)
+	app = model.(App)
+	assert.False(t, app.reconcileOpen)
+	assert.True(t, app.offlineRetryArmed)
+	assert.NotNil(t, retry)
+}
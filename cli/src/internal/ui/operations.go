@@ -0,0 +1,323 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+const (
+	// operationBatchSize caps how many records one queued bulk step touches.
+	operationBatchSize = 25
+	// operationHistoryLimit is how many finished operations the queue keeps.
+	operationHistoryLimit = 20
+	operationBarWidth     = 20
+)
+
+// operationStep is one unit of work in a queued operation. Notes returned by
+// a step are kept for the operations view.
+type operationStep struct {
+	label string
+	run   func() ([]string, error)
+}
+
+type operationFailure struct {
+	step operationStep
+	err  error
+}
+
+// operation is a background job tracked by the app-level queue.
+type operation struct {
+	id       int
+	label    string
+	tab      int
+	steps    []operationStep
+	next     int
+	failures []operationFailure
+	notes    []string
+	onDone   tea.Msg
+	retried  bool
+}
+
+type operationQueuedMsg struct {
+	label  string
+	tab    int
+	steps  []operationStep
+	onDone tea.Msg
+}
+
+type operationStepDoneMsg struct {
+	id    int
+	notes []string
+	err   error
+}
+
+// queueOperation hands steps to the app queue. onDone is delivered to the
+// originating tab once every step has run, whether or not some failed.
+func queueOperation(label string, tab int, steps []operationStep, onDone tea.Msg) tea.Cmd {
+	if len(steps) == 0 {
+		return nil
+	}
+	return func() tea.Msg {
+		return operationQueuedMsg{label: label, tab: tab, steps: steps, onDone: onDone}
+	}
+}
+
+// chunkIDs splits ids into batches of at most size.
+func chunkIDs(ids []string, size int) [][]string {
+	var chunks [][]string
+	for len(ids) > 0 {
+		n := size
+		if len(ids) < n {
+			n = len(ids)
+		}
+		chunks = append(chunks, ids[:n])
+		ids = ids[n:]
+	}
+	return chunks
+}
+
+// running reports whether the operation still has steps to run.
+func (op operation) running() bool {
+	return op.next < len(op.steps)
+}
+
+// failed reports whether the operation finished with failures nobody retried.
+func (op operation) failed() bool {
+	return !op.running() && len(op.failures) > 0 && !op.retried
+}
+
+// runStep runs the next step of an operation off the update loop.
+func (op operation) runStep() tea.Cmd {
+	if !op.running() {
+		return nil
+	}
+	id := op.id
+	step := op.steps[op.next]
+	return func() tea.Msg {
+		notes, err := step.run()
+		return operationStepDoneMsg{id: id, notes: notes, err: err}
+	}
+}
+
+// operationCounts returns how many operations are running and failed.
+func (a App) operationCounts() (running, failed int) {
+	for _, op := range a.ops {
+		if op.running() {
+			running++
+		} else if op.failed() {
+			failed++
+		}
+	}
+	return running, failed
+}
+
+// startOperation adds a queued operation and runs its first step.
+func (a *App) startOperation(msg operationQueuedMsg) tea.Cmd {
+	a.opsNextID++
+	op := operation{
+		id:     a.opsNextID,
+		label:  msg.label,
+		tab:    msg.tab,
+		steps:  msg.steps,
+		onDone: msg.onDone,
+	}
+	a.ops = append(a.ops, op)
+	a.pruneOperations()
+	return tea.Batch(op.runStep(), a.setToast("info", fmt.Sprintf("Started %s. ctrl+o shows progress.", op.label)))
+}
+
+// finishOperationStep records a step result and either runs the next step or
+// hands the completion message back to the originating tab.
+func (a *App) finishOperationStep(msg operationStepDoneMsg) tea.Cmd {
+	idx := -1
+	for i := range a.ops {
+		if a.ops[i].id == msg.id {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 || !a.ops[idx].running() {
+		return nil
+	}
+	op := &a.ops[idx]
+	if msg.err != nil {
+		op.failures = append(op.failures, operationFailure{step: op.steps[op.next], err: msg.err})
+	}
+	op.notes = append(op.notes, msg.notes...)
+	op.next++
+	if op.running() {
+		return op.runStep()
+	}
+
+	var toast tea.Cmd
+	if len(op.failures) == 0 {
+		text := fmt.Sprintf("%s finished.", op.label)
+		if len(op.notes) > 0 {
+			text = fmt.Sprintf("%s finished: %s", op.label, op.notes[0])
+		}
+		toast = a.setToast("success", text)
+	} else {
+		toast = a.setToast("error", fmt.Sprintf("%s: %d of %d steps failed. ctrl+o to review.", op.label, len(op.failures), len(op.steps)))
+	}
+	if op.onDone == nil {
+		return toast
+	}
+	return tea.Batch(a.updateTab(op.tab, op.onDone), toast)
+}
+
+// retryOperation queues the failed steps of a finished operation again.
+func (a *App) retryOperation(idx int) tea.Cmd {
+	if idx < 0 || idx >= len(a.ops) || !a.ops[idx].failed() {
+		return nil
+	}
+	op := &a.ops[idx]
+	steps := make([]operationStep, 0, len(op.failures))
+	for _, failure := range op.failures {
+		steps = append(steps, failure.step)
+	}
+	op.retried = true
+	label := strings.TrimSuffix(op.label, " (retry)") + " (retry)"
+	return queueOperation(label, op.tab, steps, op.onDone)
+}
+
+// clearFinishedOperations drops every operation that is no longer running.
+func (a *App) clearFinishedOperations() {
+	kept := make([]operation, 0, len(a.ops))
+	for _, op := range a.ops {
+		if op.running() {
+			kept = append(kept, op)
+		}
+	}
+	a.ops = kept
+	a.opsIndex = 0
+}
+
+// pruneOperations keeps the queue history bounded, oldest finished first.
+func (a *App) pruneOperations() {
+	for len(a.ops) > operationHistoryLimit {
+		dropped := false
+		for i, op := range a.ops {
+			if !op.running() {
+				a.ops = append(append([]operation(nil), a.ops[:i]...), a.ops[i+1:]...)
+				dropped = true
+				break
+			}
+		}
+		if !dropped {
+			return
+		}
+	}
+}
+
+// handleOperationsKeys handles keys while the operations view is open.
+func (a App) handleOperationsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// The view lists newest first; opsIndex counts from the top of that list.
+	idx := len(a.ops) - 1 - a.opsIndex
+	switch {
+	case isBack(msg), isKey(msg, "ctrl+o"):
+		a.opsOpen = false
+	case isUp(msg):
+		if a.opsIndex > 0 {
+			a.opsIndex--
+		}
+	case isDown(msg):
+		if a.opsIndex < len(a.ops)-1 {
+			a.opsIndex++
+		}
+	case isKey(msg, "r"):
+		return a, a.retryOperation(idx)
+	case isKey(msg, "x"):
+		a.clearFinishedOperations()
+	}
+	return a, nil
+}
+
+// renderOperationsStatus renders the compact queue widget under the banner.
+func (a App) renderOperationsStatus() string {
+	running, failed := a.operationCounts()
+	if running == 0 && failed == 0 {
+		return ""
+	}
+	parts := []string{}
+	if running > 0 {
+		parts = append(parts, AccentStyle.Render(fmt.Sprintf("%d running", running)))
+	}
+	if failed > 0 {
+		parts = append(parts, ErrorStyle.Render(fmt.Sprintf("%d failed", failed)))
+	}
+	return MutedStyle.Render("ops: ") + strings.Join(parts, MutedStyle.Render(" · "))
+}
+
+// renderOperations renders the operations view with progress and failures.
+func (a App) renderOperations() string {
+	if len(a.ops) == 0 {
+		return components.Indent(components.EmptyStateBox(
+			"Operations",
+			"No background operations yet.",
+			[]string{"Bulk approvals, bulk tag and scope edits, and imports run here."},
+			a.width,
+		), 1)
+	}
+
+	contentWidth := components.BoxContentWidth(a.width)
+	if contentWidth <= 0 {
+		contentWidth = 72
+	}
+	var lines []string
+	for pos := 0; pos < len(a.ops); pos++ {
+		op := a.ops[len(a.ops)-1-pos]
+		if pos > 0 {
+			lines = append(lines, "")
+		}
+		marker := "  "
+		if pos == a.opsIndex {
+			marker = AccentStyle.Render("> ")
+		}
+		lines = append(lines, marker+operationHeader(op, contentWidth-2))
+		if pos != a.opsIndex {
+			continue
+		}
+		for _, failure := range op.failures {
+			line := fmt.Sprintf("✗ %s: %s", failure.step.label, components.SanitizeOneLine(failure.err.Error()))
+			lines = append(lines, "    "+ErrorStyle.Render(components.ClampTextWidthEllipsis(line, contentWidth-4)))
+		}
+		for _, note := range op.notes {
+			lines = append(lines, "    "+MutedStyle.Render(components.ClampTextWidthEllipsis(components.SanitizeOneLine(note), contentWidth-4)))
+		}
+	}
+	return components.Indent(components.TitledBox("Operations", strings.Join(lines, "\n"), a.width), 1)
+}
+
+// operationHeader renders the label, progress bar, and state of one operation.
+func operationHeader(op operation, width int) string {
+	state := MutedStyle.Render("running")
+	switch {
+	case op.running():
+	case op.retried:
+		state = MutedStyle.Render("retried")
+	case len(op.failures) > 0:
+		state = ErrorStyle.Render(fmt.Sprintf("%d failed", len(op.failures)))
+	default:
+		state = SuccessStyle.Render("done")
+	}
+	progress := fmt.Sprintf("%s %d/%d", operationProgressBar(op.next, len(op.steps), operationBarWidth), op.next, len(op.steps))
+	labelWidth := width - operationBarWidth - 24
+	if labelWidth < 12 {
+		labelWidth = 12
+	}
+	label := components.ClampTextWidthEllipsis(components.SanitizeOneLine(op.label), labelWidth)
+	return fmt.Sprintf("%s  %s  %s", NormalStyle.Render(label), progress, state)
+}
+
+// operationProgressBar renders a fixed-width bar for done of total steps.
+func operationProgressBar(done, total, width int) string {
+	filled := 0
+	if total > 0 {
+		filled = done * width / total
+	}
+	return AccentStyle.Render(strings.Repeat("█", filled)) + MutedStyle.Render(strings.Repeat("░", width-filled))
+}
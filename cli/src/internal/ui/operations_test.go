@@ -0,0 +1,132 @@
+package ui
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runQueuedOperation runs every step of a queued operation and returns the
+// message the originating tab would receive.
+func runQueuedOperation(t *testing.T, cmd tea.Cmd) tea.Msg {
+	t.Helper()
+	queued, ok := cmd().(operationQueuedMsg)
+	require.True(t, ok)
+	for _, step := range queued.steps {
+		_, _ = step.run()
+	}
+	return queued.onDone
+}
+
+// drainOperation feeds step results back into the app until op idx finishes.
+func drainOperation(app App, idx int) App {
+	for app.ops[idx].running() {
+		model, _ := app.Update(app.ops[idx].runStep()())
+		app = model.(App)
+	}
+	return app
+}
+
+func TestChunkIDs(t *testing.T) {
+	ids := make([]string, 0, 60)
+	for i := 0; i < 60; i++ {
+		ids = append(ids, fmt.Sprintf("ent-%02d", i))
+	}
+	chunks := chunkIDs(ids, operationBatchSize)
+	require.Len(t, chunks, 3)
+	assert.Len(t, chunks[0], 25)
+	assert.Len(t, chunks[2], 10)
+	assert.Nil(t, chunkIDs(nil, operationBatchSize))
+}
+
+func TestAppOperationQueueTracksProgressAndRetriesFailures(t *testing.T) {
+	app := NewApp(nil, &config.Config{})
+	app.width = 100
+	app.entities.bulkRunning = true
+
+	attempts := 0
+	steps := []operationStep{
+		{label: "batch 1/3", run: func() ([]string, error) { return nil, nil }},
+		{label: "batch 2/3", run: func() ([]string, error) {
+			attempts++
+			if attempts == 1 {
+				return nil, errors.New("timeout")
+			}
+			return nil, nil
+		}},
+		{label: "batch 3/3", run: func() ([]string, error) { return []string{"3 updated"}, nil }},
+	}
+	model, cmd := app.Update(operationQueuedMsg{label: "Bulk tags on 60 entities", tab: tabEntities, steps: steps, onDone: entityBulkUpdatedMsg{}})
+	app = model.(App)
+	require.NotNil(t, cmd)
+	running, failed := app.operationCounts()
+	assert.Equal(t, 1, running)
+	assert.Equal(t, 0, failed)
+	assert.Contains(t, components.SanitizeText(app.View()), "ops: 1 running")
+	assert.True(t, app.hasUnsaved())
+
+	app = drainOperation(app, 0)
+	running, failed = app.operationCounts()
+	assert.Equal(t, 0, running)
+	assert.Equal(t, 1, failed)
+	// Completion goes to the entities tab even though the inbox is active.
+	assert.False(t, app.entities.bulkRunning)
+
+	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyCtrlO})
+	app = model.(App)
+	require.True(t, app.opsOpen)
+	out := components.SanitizeText(app.View())
+	assert.Contains(t, out, "1 failed")
+	assert.Contains(t, out, "✗ batch 2/3: timeout")
+	assert.Contains(t, out, "3 updated")
+
+	model, cmd = app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	app = model.(App)
+	require.NotNil(t, cmd)
+	retry, ok := cmd().(operationQueuedMsg)
+	require.True(t, ok)
+	require.Len(t, retry.steps, 1)
+	assert.Equal(t, "Bulk tags on 60 entities (retry)", retry.label)
+
+	model, _ = app.Update(retry)
+	app = drainOperation(model.(App), 1)
+	_, failed = app.operationCounts()
+	assert.Equal(t, 0, failed)
+
+	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	app = model.(App)
+	assert.Empty(t, app.ops)
+	assert.Contains(t, components.SanitizeText(app.View()), "No background operations yet")
+}
+
+func TestEntitiesBulkTagsQueueBatchesForLargeSelections(t *testing.T) {
+	var batches [][]string
+	_, client := testEntitiesClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			EntityIDs []string `json:"entity_ids"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		batches = append(batches, body.EntityIDs)
+		_, _ = w.Write([]byte(`{"data":{"updated_count":1,"op":"add"}}`))
+	})
+	model := NewEntitiesModel(client)
+	model.bulkSelected = map[string]bool{}
+	for i := 0; i < 30; i++ {
+		model.bulkSelected[fmt.Sprintf("ent-%02d", i)] = true
+	}
+
+	msg := runQueuedOperation(t, model.bulkUpdateTags(bulkInput{op: "add", values: []string{"alpha"}}))
+	_, ok := msg.(entityBulkUpdatedMsg)
+	assert.True(t, ok)
+	require.Len(t, batches, 2)
+	assert.Len(t, batches[0], 25)
+	assert.Len(t, batches[1], 5)
+}
@@ -0,0 +1,340 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// paletteVerbPreviewLimit caps the targets listed in a verb confirm preview.
+const paletteVerbPreviewLimit = 8
+
+// paletteVerb is a parsed palette command such as `tag alpha +urgent`.
+type paletteVerb struct {
+	name   string
+	filter string
+	reason string
+	target string
+	add    []string
+	remove []string
+}
+
+// paletteVerbPlan is a resolved verb waiting for confirmation.
+type paletteVerbPlan struct {
+	title   string
+	preview []string
+	label   string
+	tab     int
+	steps   []operationStep
+	onDone  tea.Msg
+}
+
+type paletteVerbResolvedMsg struct {
+	plan *paletteVerbPlan
+	err  error
+}
+
+// parsePaletteVerb parses the palette command language:
+//
+//	approve all [from <inbox filter>]
+//	reject all [from <inbox filter>] [because <reason>]
+//	archive entity <name>
+//	tag <entity> +add -remove
+//
+// ok is false when input does not start with a verb, so the palette falls
+// back to filtering its static actions.
+func parsePaletteVerb(input string) (verb paletteVerb, ok bool, err error) {
+	fields := strings.Fields(input)
+	if len(fields) == 0 {
+		return paletteVerb{}, false, nil
+	}
+	verb.name = strings.ToLower(fields[0])
+	args := fields[1:]
+	switch verb.name {
+	case "approve", "reject":
+		if len(args) == 0 || !strings.EqualFold(args[0], "all") {
+			return verb, true, fmt.Errorf("usage: %s all [from agent:<name>]", verb.name)
+		}
+		args = args[1:]
+		if verb.name == "reject" {
+			for i, arg := range args {
+				if strings.EqualFold(arg, "because") {
+					verb.reason = strings.Join(args[i+1:], " ")
+					args = args[:i]
+					break
+				}
+			}
+		}
+		if len(args) > 0 {
+			if !strings.EqualFold(args[0], "from") || len(args) == 1 {
+				return verb, true, fmt.Errorf("usage: %s all [from agent:<name>]", verb.name)
+			}
+			verb.filter = strings.Join(args[1:], " ")
+		}
+		return verb, true, nil
+	case "archive":
+		if len(args) < 2 || !strings.EqualFold(args[0], "entity") {
+			return verb, true, fmt.Errorf("usage: archive entity <name>")
+		}
+		verb.target = strings.Join(args[1:], " ")
+		return verb, true, nil
+	case "tag":
+		var name []string
+		for _, arg := range args {
+			switch {
+			case len(arg) > 1 && strings.HasPrefix(arg, "+"):
+				if tag := normalizeTag(arg[1:]); tag != "" {
+					verb.add = append(verb.add, tag)
+				}
+			case len(arg) > 1 && strings.HasPrefix(arg, "-"):
+				if tag := normalizeTag(arg[1:]); tag != "" {
+					verb.remove = append(verb.remove, tag)
+				}
+			default:
+				name = append(name, arg)
+			}
+		}
+		verb.target = strings.Join(name, " ")
+		if verb.target == "" || len(verb.add)+len(verb.remove) == 0 {
+			return verb, true, fmt.Errorf("usage: tag <entity> +tag -tag")
+		}
+		return verb, true, nil
+	}
+	return paletteVerb{}, false, nil
+}
+
+// summary describes the verb for the palette row.
+func (v paletteVerb) summary() string {
+	switch v.name {
+	case "approve", "reject":
+		text := v.name + " all pending approvals"
+		if v.filter != "" {
+			text += " from " + v.filter
+		}
+		return text
+	case "archive":
+		return fmt.Sprintf("archive entity %q", v.target)
+	case "tag":
+		return fmt.Sprintf("tag %q %s", v.target, tagChangeLabel(v.add, v.remove))
+	}
+	return v.name
+}
+
+// tagChangeLabel renders tag additions and removals as +a -b.
+func tagChangeLabel(add, remove []string) string {
+	parts := make([]string, 0, len(add)+len(remove))
+	for _, tag := range add {
+		parts = append(parts, "+"+tag)
+	}
+	for _, tag := range remove {
+		parts = append(parts, "-"+tag)
+	}
+	return strings.Join(parts, " ")
+}
+
+// resolvePaletteVerb looks up the verb's targets and builds its plan.
+func resolvePaletteVerb(client *api.Client, verb paletteVerb) tea.Cmd {
+	if client == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		var (
+			plan *paletteVerbPlan
+			err  error
+		)
+		switch verb.name {
+		case "approve", "reject":
+			plan, err = planApprovalVerb(client, verb)
+		case "archive", "tag":
+			plan, err = planEntityVerb(client, verb)
+		default:
+			err = fmt.Errorf("unknown command %q", verb.name)
+		}
+		return paletteVerbResolvedMsg{plan: plan, err: err}
+	}
+}
+
+// planApprovalVerb matches pending approvals against the verb's filter.
+func planApprovalVerb(client *api.Client, verb paletteVerb) (*paletteVerbPlan, error) {
+	items, err := client.GetPendingApprovals()
+	if err != nil {
+		return nil, err
+	}
+	filter := parseApprovalFilter(verb.filter)
+	matches := make([]api.Approval, 0, len(items))
+	for _, item := range items {
+		if matchesApprovalFilter(item, filter) {
+			matches = append(matches, item)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no pending approvals match %q", strings.TrimSpace(verb.filter))
+	}
+
+	verbLabel := "Approve"
+	if verb.name == "reject" {
+		verbLabel = "Reject"
+	}
+	steps := make([]operationStep, 0, len(matches))
+	preview := make([]string, 0, paletteVerbPreviewLimit+1)
+	for i, item := range matches {
+		id := item.ID
+		if i < paletteVerbPreviewLimit {
+			preview = append(preview, formatApprovalLine(item))
+		}
+		step := operationStep{label: strings.ToLower(verbLabel) + " " + shortID(id)}
+		if verb.name == "reject" {
+			reason := verb.reason
+			step.run = func() ([]string, error) {
+				item, err := client.RejectRequest(id, reason)
+				if err == nil {
+					notifyDecision(config.WebhookReject, id, reason, item)
+				}
+				return nil, err
+			}
+		} else {
+			step.run = func() ([]string, error) {
+				item, err := client.ApproveRequest(id)
+				if err == nil {
+					notifyDecision(config.WebhookApprove, id, "", item)
+				}
+				return nil, err
+			}
+		}
+		steps = append(steps, step)
+	}
+	if extra := len(matches) - paletteVerbPreviewLimit; extra > 0 {
+		preview = append(preview, fmt.Sprintf("...and %d more", extra))
+	}
+	return &paletteVerbPlan{
+		title:   fmt.Sprintf("%s %s?", verbLabel, approvalCountLabel(len(matches))),
+		preview: preview,
+		label:   fmt.Sprintf("%s %s", verbLabel, approvalCountLabel(len(matches))),
+		tab:     tabInbox,
+		steps:   steps,
+		onDone:  approvalDoneMsg{""},
+	}, nil
+}
+
+// planEntityVerb resolves the target entity for archive and tag verbs.
+func planEntityVerb(client *api.Client, verb paletteVerb) (*paletteVerbPlan, error) {
+	entity, err := findEntityByName(client, verb.target)
+	if err != nil {
+		return nil, err
+	}
+	id := entity.ID
+	plan := &paletteVerbPlan{
+		preview: []string{formatEntityLine(*entity)},
+		tab:     tabEntities,
+		onDone:  entityBulkUpdatedMsg{},
+	}
+	if verb.name == "archive" {
+		plan.title = "Archive entity?"
+		plan.label = "Archive " + entity.Name
+		plan.steps = []operationStep{{
+			label: "archive " + shortID(id),
+			run: func() ([]string, error) {
+				status := "inactive"
+				_, err := client.UpdateEntity(id, api.UpdateEntityInput{Status: &status})
+				return nil, err
+			},
+		}}
+		return plan, nil
+	}
+
+	changes := tagChangeLabel(verb.add, verb.remove)
+	plan.title = "Update tags?"
+	plan.label = fmt.Sprintf("Tag %s %s", entity.Name, changes)
+	plan.preview = append(plan.preview, "tags: "+changes)
+	for _, change := range []struct {
+		op   string
+		tags []string
+	}{{"add", verb.add}, {"remove", verb.remove}} {
+		if len(change.tags) == 0 {
+			continue
+		}
+		input := api.BulkUpdateEntityTagsInput{EntityIDs: []string{id}, Tags: change.tags, Op: change.op}
+		plan.steps = append(plan.steps, operationStep{
+			label: change.op + " tags",
+			run: func() ([]string, error) {
+				_, err := client.BulkUpdateEntityTags(input)
+				return nil, err
+			},
+		})
+	}
+	return plan, nil
+}
+
+// findEntityByName picks the entity whose name matches, preferring exact
+// case-insensitive matches over a single fuzzy search hit.
+func findEntityByName(client *api.Client, name string) (*api.Entity, error) {
+	items, err := client.QueryEntities(api.QueryParams{"search_text": name, "limit": "20"})
+	if err != nil {
+		return nil, err
+	}
+	var exact []api.Entity
+	for _, item := range items {
+		if strings.EqualFold(strings.TrimSpace(item.Name), strings.TrimSpace(name)) {
+			exact = append(exact, item)
+		}
+	}
+	switch {
+	case len(exact) == 1:
+		return &exact[0], nil
+	case len(exact) > 1:
+		return nil, fmt.Errorf("%d entities are named %q", len(exact), name)
+	case len(items) == 1:
+		return &items[0], nil
+	case len(items) == 0:
+		return nil, fmt.Errorf("no entity matches %q", name)
+	}
+	return nil, fmt.Errorf("%d entities match %q; use the full name", len(items), name)
+}
+
+// paletteVerbActions returns the palette row for a typed verb command.
+func paletteVerbActions(query string) ([]paletteAction, *paletteVerb) {
+	verb, ok, err := parsePaletteVerb(query)
+	if !ok {
+		return nil, nil
+	}
+	if err != nil {
+		return []paletteAction{{ID: "verb:invalid", Label: "Invalid command", Desc: err.Error()}}, nil
+	}
+	return []paletteAction{{ID: "verb:run", Label: verb.summary(), Desc: "Enter to preview"}}, &verb
+}
+
+// handlePaletteVerbResolved opens the confirm preview for a resolved verb.
+func (a *App) handlePaletteVerbResolved(msg paletteVerbResolvedMsg) tea.Cmd {
+	if msg.err != nil {
+		return func() tea.Msg { return errMsg{msg.err} }
+	}
+	a.verbPlan = msg.plan
+	return nil
+}
+
+// handleVerbConfirmKeys confirms or cancels a previewed verb.
+func (a App) handleVerbConfirmKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case isKey(msg, "y"), isEnter(msg):
+		plan := a.verbPlan
+		a.verbPlan = nil
+		return a, queueOperation(plan.label, plan.tab, plan.steps, plan.onDone)
+	case isKey(msg, "n"), isBack(msg):
+		a.verbPlan = nil
+	}
+	return a, nil
+}
+
+// renderVerbConfirm renders the verb preview dialog.
+func (a App) renderVerbConfirm() string {
+	lines := make([]string, 0, len(a.verbPlan.preview))
+	for _, line := range a.verbPlan.preview {
+		lines = append(lines, "  "+components.SanitizeOneLine(line))
+	}
+	return components.Indent(components.ConfirmDialog(a.verbPlan.title, strings.Join(lines, "\n")), 1)
+}
@@ -0,0 +1,153 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePaletteVerb(t *testing.T) {
+	verb, ok, err := parsePaletteVerb("approve all from agent:research type:create")
+	require.True(t, ok)
+	require.NoError(t, err)
+	assert.Equal(t, "approve", verb.name)
+	assert.Equal(t, "agent:research type:create", verb.filter)
+
+	verb, ok, err = parsePaletteVerb("reject all from agent:spam because duplicate work")
+	require.True(t, ok)
+	require.NoError(t, err)
+	assert.Equal(t, "agent:spam", verb.filter)
+	assert.Equal(t, "duplicate work", verb.reason)
+
+	verb, ok, err = parsePaletteVerb("archive entity Project Alpha")
+	require.True(t, ok)
+	require.NoError(t, err)
+	assert.Equal(t, "Project Alpha", verb.target)
+
+	verb, ok, err = parsePaletteVerb("tag Project Alpha +Urgent -stale")
+	require.True(t, ok)
+	require.NoError(t, err)
+	assert.Equal(t, "Project Alpha", verb.target)
+	assert.Equal(t, []string{"urgent"}, verb.add)
+	assert.Equal(t, []string{"stale"}, verb.remove)
+	assert.Equal(t, `tag "Project Alpha" +urgent -stale`, verb.summary())
+
+	_, ok, err = parsePaletteVerb("tag Project Alpha")
+	assert.True(t, ok)
+	assert.Error(t, err)
+	_, ok, err = parsePaletteVerb("approve some")
+	assert.True(t, ok)
+	assert.Error(t, err)
+	_, ok, _ = parsePaletteVerb("inbox")
+	assert.False(t, ok)
+}
+
+func TestPaletteApproveAllFromAgentPreviewsAndQueues(t *testing.T) {
+	var mu sync.Mutex
+	var approved []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/approvals/pending":
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+				{"id": "ap-1", "request_type": "create_entity", "agent_name": "research", "status": "pending"},
+				{"id": "ap-2", "request_type": "create_entity", "agent_name": "ops", "status": "pending"},
+				{"id": "ap-3", "request_type": "update_entity", "agent_name": "research", "status": "pending"},
+			}}))
+		case strings.HasSuffix(r.URL.Path, "/approve"):
+			mu.Lock()
+			approved = append(approved, strings.Split(r.URL.Path, "/")[3])
+			mu.Unlock()
+			_, _ = w.Write([]byte(`{"data":{"id":"x","status":"approved"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	app := NewApp(api.NewClient(srv.URL, "key"), &config.Config{APIKey: "key"})
+	app.width = 120
+	app.paletteOpen = true
+	app.paletteQuery.Value = "/approve"
+	for _, r := range " all from agent:research" {
+		key := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}}
+		if r == ' ' {
+			key = tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+		}
+		model, _ := app.Update(key)
+		app = model.(App)
+	}
+	require.NotEmpty(t, app.paletteFiltered)
+	assert.Equal(t, "verb:run", app.paletteFiltered[0].ID)
+	assert.Equal(t, "approve all pending approvals from agent:research", app.paletteFiltered[0].Label)
+
+	model, cmd := app.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	app = model.(App)
+	require.NotNil(t, cmd)
+	model, _ = app.Update(cmd())
+	app = model.(App)
+	require.NotNil(t, app.verbPlan)
+	assert.Equal(t, "verb-confirm", app.viewStateKey())
+	assert.Len(t, app.verbPlan.steps, 2)
+	assert.Contains(t, components.SanitizeText(app.View()), "Approve 2 approvals?")
+
+	model, cmd = app.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	app = model.(App)
+	assert.Nil(t, app.verbPlan)
+	queued, ok := cmd().(operationQueuedMsg)
+	require.True(t, ok)
+	for _, step := range queued.steps {
+		_, err := step.run()
+		require.NoError(t, err)
+	}
+	assert.ElementsMatch(t, []string{"ap-1", "ap-3"}, approved)
+}
+
+func TestPaletteTagVerbResolvesEntityAndUpdatesTags(t *testing.T) {
+	var ops []api.BulkUpdateEntityTagsInput
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/entities":
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+				{"id": "ent-1", "name": "Project Alpha", "type": "project"},
+				{"id": "ent-2", "name": "Project Alpha Notes", "type": "project"},
+			}}))
+		case "/api/entities/bulk/tags":
+			var body api.BulkUpdateEntityTagsInput
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			ops = append(ops, body)
+			_, _ = w.Write([]byte(`{"data":{"updated":1,"entity_ids":["ent-1"]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	verb, _, err := parsePaletteVerb("tag project alpha +urgent -stale")
+	require.NoError(t, err)
+	msg := resolvePaletteVerb(api.NewClient(srv.URL, "key"), verb)().(paletteVerbResolvedMsg)
+	require.NoError(t, msg.err)
+	assert.Equal(t, tabEntities, msg.plan.tab)
+	require.Len(t, msg.plan.steps, 2)
+	for _, step := range msg.plan.steps {
+		_, err := step.run()
+		require.NoError(t, err)
+	}
+	require.Len(t, ops, 2)
+	assert.Equal(t, api.BulkUpdateEntityTagsInput{EntityIDs: []string{"ent-1"}, Tags: []string{"urgent"}, Op: "add"}, ops[0])
+	assert.Equal(t, "remove", ops[1].Op)
+
+	verb, _, _ = parsePaletteVerb("archive entity Project")
+	msg = resolvePaletteVerb(api.NewClient(srv.URL, "key"), verb)().(paletteVerbResolvedMsg)
+	require.Error(t, msg.err)
+	assert.Contains(t, msg.err.Error(), "2 entities match")
+}
@@ -0,0 +1,136 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// pinMark prefixes pinned rows.
+const pinMark = "★"
+
+// pinPalettePrefix marks palette actions that open a pinned item.
+const pinPalettePrefix = "pin:"
+
+// pinToggledMsg asks the app to pin or unpin an item.
+type pinToggledMsg struct {
+	pin config.Pin
+}
+
+// togglePinCmd emits a pin toggle for an item.
+func togglePinCmd(kind, id, name string) tea.Cmd {
+	if strings.TrimSpace(id) == "" {
+		return nil
+	}
+	pin := config.Pin{Kind: kind, ID: id, Name: name}
+	return func() tea.Msg { return pinToggledMsg{pin: pin} }
+}
+
+// pinnedFirst moves pinned items to the top, keeping the order within each
+// group.
+func pinnedFirst[T any](items []T, pinned map[string]bool, id func(T) string) {
+	if len(pinned) == 0 {
+		return
+	}
+	slices.SortStableFunc(items, func(a, b T) int {
+		pa, pb := pinned[id(a)], pinned[id(b)]
+		switch {
+		case pa && !pb:
+			return -1
+		case pb && !pa:
+			return 1
+		}
+		return 0
+	})
+}
+
+// applyPins shares the pinned ids with the lists that show them and re-sorts.
+func (a *App) applyPins() {
+	a.entities.pinned = a.config.PinnedIDs(config.PinEntity)
+	a.entities.applyEntityFilters()
+	a.know.pinned = a.config.PinnedIDs(config.PinContext)
+	a.know.applyContextFilter()
+}
+
+// togglePin pins or unpins an item and saves the config.
+func (a *App) togglePin(pin config.Pin) tea.Cmd {
+	if a.config == nil {
+		return a.setToast("error", "Pins need a saved config. Log in first.")
+	}
+	pinned := a.config.TogglePin(pin)
+	a.applyPins()
+	name := components.SanitizeOneLine(pin.Name)
+	if name == "" {
+		name = shortID(pin.ID)
+	}
+	text := fmt.Sprintf("Unpinned %s.", name)
+	if pinned {
+		text = fmt.Sprintf("Pinned %s.", name)
+	}
+	cfg := a.config
+	save := func() tea.Msg {
+		if err := cfg.Save(); err != nil {
+			return errMsg{fmt.Errorf("save pins: %w", err)}
+		}
+		return nil
+	}
+	return tea.Batch(save, a.setToast("success", text))
+}
+
+// pinnedPaletteActions lists pinned items for the palette, filtered by query.
+func (a App) pinnedPaletteActions(query string) []paletteAction {
+	if a.config == nil {
+		return nil
+	}
+	query = strings.ToLower(strings.TrimSpace(query))
+	var actions []paletteAction
+	for _, pin := range a.config.Pins {
+		label := strings.TrimSpace(pin.Name)
+		if label == "" {
+			label = shortID(pin.ID)
+		}
+		if query != "" && !strings.Contains(strings.ToLower(label), query) {
+			continue
+		}
+		desc := "Pinned entity"
+		if pin.Kind == config.PinContext {
+			desc = "Pinned context"
+		}
+		actions = append(actions, paletteAction{
+			ID:    pinPalettePrefix + pin.Kind + ":" + pin.ID,
+			Label: pinMark + " " + label,
+			Desc:  desc,
+		})
+	}
+	return actions
+}
+
+// openPin fetches a pinned item and opens its detail view.
+func openPin(client *api.Client, kind, id string) tea.Cmd {
+	if client == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		switch kind {
+		case config.PinEntity:
+			entity, err := client.GetEntity(id)
+			if err != nil {
+				return errMsg{fmt.Errorf("open pinned entity: %w", err)}
+			}
+			return searchSelectionMsg{kind: "entity", entity: entity}
+		case config.PinContext:
+			item, err := client.GetContext(id)
+			if err != nil {
+				return errMsg{fmt.Errorf("open pinned context: %w", err)}
+			}
+			return searchSelectionMsg{kind: "context", context: item}
+		}
+		return errMsg{fmt.Errorf("unknown pin kind %q", kind)}
+	}
+}
@@ -0,0 +1,79 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestStarPinsEntityToTopAndPersists(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	app := NewApp(nil, &config.Config{})
+	app.width = 120
+	app.entities.width = 120
+	app.entities, _ = app.entities.Update(entitiesLoadedMsg{items: []api.Entity{
+		{ID: "ent-1", Name: "Alpha", Type: "project"},
+		{ID: "ent-2", Name: "Beta", Type: "project"},
+	}})
+	app.entities.list.Down()
+
+	_, cmd := app.entities.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("*")})
+	require.NotNil(t, cmd)
+	model, save := app.Update(cmd())
+	app = model.(App)
+	require.NotNil(t, save)
+
+	assert.Equal(t, []config.Pin{{Kind: config.PinEntity, ID: "ent-2", Name: "Beta"}}, app.config.Pins)
+	assert.Equal(t, "ent-2", app.entities.items[0].ID)
+	assert.Contains(t, components.SanitizeText(app.entities.View()), pinMark+" Beta")
+}
+
+func TestStarPinsContextFromDetail(t *testing.T) {
+	model := NewContextModel(nil)
+	model.detail = &api.Context{ID: "ctx-1", Name: "Runbook"}
+	model.view = contextViewDetail
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("*")})
+	require.NotNil(t, cmd)
+	msg, ok := cmd().(pinToggledMsg)
+	require.True(t, ok)
+	assert.Equal(t, config.PinContext, msg.pin.Kind)
+	assert.Equal(t, "ctx-1", msg.pin.ID)
+}
+
+func TestPalettePinnedSectionOpensPin(t *testing.T) {
+	_, client := contextTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/entities/ent-2", r.URL.Path)
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "ent-2", "name": "Beta"}}))
+	})
+	app := NewApp(client, &config.Config{Pins: []config.Pin{
+		{Kind: config.PinEntity, ID: "ent-2", Name: "Beta"},
+		{Kind: config.PinContext, ID: "ctx-1", Name: "Runbook"},
+	}})
+	app.width = 120
+
+	app.openPaletteCommand()
+	require.GreaterOrEqual(t, len(app.paletteFiltered), 2)
+	assert.Equal(t, pinMark+" Beta", app.paletteFiltered[0].Label)
+	assert.Equal(t, "Pinned context", app.paletteFiltered[1].Desc)
+
+	app.paletteQuery.Value = ""
+	app.refreshPaletteFiltered()
+	assert.Len(t, app.paletteFiltered, 2)
+	assert.Contains(t, components.SanitizeText(app.renderPalette()), "Pinned")
+
+	_, cmd := app.runPaletteAction(app.paletteFiltered[0])
+	require.NotNil(t, cmd)
+	selection, ok := cmd().(searchSelectionMsg)
+	require.True(t, ok)
+	assert.Equal(t, "entity", selection.kind)
+	assert.Equal(t, "Beta", selection.entity.Name)
+}
@@ -0,0 +1,114 @@
+package ui
+
+import (
+	"encoding/json"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/plugins"
+)
+
+const pluginActionPrefix = "plugin:"
+
+// pluginsDir is where the app discovers plugins. Tests point it elsewhere.
+var pluginsDir = plugins.Dir
+
+type pluginDoneMsg struct {
+	name   string
+	output string
+	err    error
+}
+
+// discoverPlugins lists installed plugins, ignoring an unreadable directory so
+// a broken install never blocks startup.
+func discoverPlugins() []plugins.Plugin {
+	found, _ := plugins.Discover(pluginsDir())
+	return found
+}
+
+// pluginPaletteActions lists one palette action per plugin.
+func pluginPaletteActions(found []plugins.Plugin) []paletteAction {
+	actions := make([]paletteAction, 0, len(found))
+	for _, plugin := range found {
+		actions = append(actions, paletteAction{
+			ID:    pluginActionPrefix + plugin.Name,
+			Label: "Plugin: " + plugin.Name,
+			Desc:  "Run on current selection",
+		})
+	}
+	return actions
+}
+
+// pluginSelection returns the record the active tab is focused on: the open
+// detail, or the highlighted row when the tab is showing its list.
+func (a App) pluginSelection() (plugins.Selection, error) {
+	var kind string
+	var record any
+	switch a.tab {
+	case tabInbox:
+		if a.inbox.detail != nil {
+			kind, record = "approval", a.inbox.detail
+		} else if item, ok := a.inbox.selectedItem(); ok {
+			kind, record = "approval", item
+		}
+	case tabEntities:
+		if a.entities.detail != nil {
+			kind, record = "entity", a.entities.detail
+		} else if idx := a.entities.list.Selected(); idx >= 0 && idx < len(a.entities.items) {
+			kind, record = "entity", a.entities.items[idx]
+		}
+	case tabKnow:
+		if a.know.detail != nil {
+			kind, record = "context", a.know.detail
+		}
+	case tabJobs:
+		if a.jobs.detail != nil {
+			kind, record = "job", a.jobs.detail
+		}
+	}
+	if kind == "" {
+		return plugins.Selection{Kind: "none", Data: []byte("null")}, nil
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return plugins.Selection{}, err
+	}
+	return plugins.Selection{Kind: kind, Data: data}, nil
+}
+
+// runPlugin runs the named plugin against the current selection in the
+// background.
+func (a *App) runPlugin(name string) (tea.Model, tea.Cmd) {
+	var plugin *plugins.Plugin
+	for i := range a.plugins {
+		if a.plugins[i].Name == name {
+			plugin = &a.plugins[i]
+			break
+		}
+	}
+	if plugin == nil {
+		return *a, a.setToast("error", "Plugin not found: "+name)
+	}
+	sel, err := a.pluginSelection()
+	if err != nil {
+		return *a, a.setToast("error", err.Error())
+	}
+	run := *plugin
+	return *a, func() tea.Msg {
+		output, err := run.Run(sel)
+		return pluginDoneMsg{name: name, output: output, err: err}
+	}
+}
+
+// handlePluginDone reports a plugin run with the first line of its output.
+func (a *App) handlePluginDone(msg pluginDoneMsg) tea.Cmd {
+	if msg.err != nil {
+		return a.setToast("error", msg.err.Error())
+	}
+	text := msg.name + " finished"
+	if line, _, _ := strings.Cut(msg.output, "\n"); strings.TrimSpace(line) != "" {
+		text = msg.name + ": " + strings.TrimSpace(line)
+	}
+	return a.setToast("success", text)
+}
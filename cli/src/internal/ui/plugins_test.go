@@ -0,0 +1,64 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+func TestPluginPaletteActionRunsOnSelectedEntity(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	dir := t.TempDir()
+	script := "#!/bin/sh\nprintf '%s ' \"$NEBULA_SELECTION_KIND\"\ncat\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "tag-it.sh"), []byte(script), 0o755))
+	prev := pluginsDir
+	pluginsDir = func() string { return dir }
+	t.Cleanup(func() { pluginsDir = prev })
+
+	app := NewApp(nil, &config.Config{})
+	require.Len(t, app.plugins, 1)
+	var action paletteAction
+	for _, candidate := range app.paletteActions {
+		if candidate.ID == "plugin:tag-it" {
+			action = candidate
+		}
+	}
+	require.Equal(t, "Plugin: tag-it", action.Label)
+
+	app.tab = tabEntities
+	app.entities.items = []api.Entity{{ID: "ent-1", Name: "Luna"}}
+	app.entities.list.SetItems([]string{"Luna"})
+
+	model, cmd := app.runPaletteAction(action)
+	require.NotNil(t, cmd)
+	done, ok := cmd().(pluginDoneMsg)
+	require.True(t, ok)
+	require.NoError(t, done.err)
+	assert.Contains(t, done.output, "entity {")
+	assert.Contains(t, done.output, `"id":"ent-1"`)
+
+	updated := model.(App)
+	updated.handlePluginDone(done)
+	require.NotNil(t, updated.toast)
+	assert.Equal(t, "success", updated.toast.level)
+	assert.Contains(t, updated.toast.text, "tag-it: entity {")
+}
+
+func TestPluginSelectionFallsBackToNone(t *testing.T) {
+	app := NewApp(nil, &config.Config{})
+	app.tab = tabHistory
+	sel, err := app.pluginSelection()
+	require.NoError(t, err)
+	assert.Equal(t, "none", sel.Kind)
+	assert.Equal(t, "null", string(sel.Data))
+
+	_, cmd := app.runPlugin("missing")
+	require.NotNil(t, cmd)
+	assert.Equal(t, "error", app.toast.level)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/gravitrone/nebula-core/cli/internal/api"
 	"github.com/gravitrone/nebula-core/cli/internal/config"
 	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/gravitrone/nebula-core/cli/internal/webhooks"
 )
 
 // --- Messages ---
@@ -25,11 +26,14 @@ type pendingLimitSavedMsg struct{ limit int }
 
 // --- Profile Model ---
 
+// profileSectionCount is the number of Settings sections.
+const profileSectionCount = 5
+
 type ProfileModel struct {
 	client *api.Client
 	config *config.Config
 
-	section      int // 0 = keys, 1 = agents, 2 = taxonomy
+	section      int // 0 = keys, 1 = agents, 2 = taxonomy, 3 = tags, 4 = webhooks
 	sectionFocus bool
 
 	keys        []api.APIKey
@@ -38,14 +42,23 @@ type ProfileModel struct {
 	agentList   *components.List
 	agentDetail *api.Agent
 
+	permEditing  bool
+	permConfirm  bool
+	permFocus    int
+	permBufs     [permFieldCount]components.TextInput
+	permOriginal api.AgentPermissions
+
 	loading          bool
 	creating         bool
-	createBuf        string
+	createBuf        components.TextInput
 	createdKey       string
 	editAPIKey       bool
-	apiKeyBuf        string
+	apiKeyBuf        components.TextInput
 	editPendingLimit bool
-	pendingLimitBuf  string
+	pendingLimitBuf  components.TextInput
+	editDefaults     bool
+	defaultsFocus    int
+	defaultsBufs     [defaultsFieldCount]components.TextInput
 
 	taxKind            int
 	taxIncludeInactive bool
@@ -54,10 +67,27 @@ type ProfileModel struct {
 	taxItems           []api.TaxonomyEntry
 	taxList            *components.List
 	taxPromptMode      taxonomyPromptMode
-	taxPromptBuf       string
+	taxPromptBuf       components.TextInput
 	taxPendingName     string
 	taxPendingDesc     string
 	taxEditID          string
+	taxMergeSource     *api.TaxonomyEntry
+	taxMergeTarget     *api.TaxonomyEntry
+	taxNotice          string
+
+	tagItems        []api.TagUsage
+	tagList         *components.List
+	tagLoading      bool
+	tagsLoaded      bool
+	tagSearch       string
+	tagPromptMode   tagPromptMode
+	tagPromptBuf    components.TextInput
+	tagPromptSource string
+	tagNotice       string
+
+	webhookLog    []webhooks.Delivery
+	webhookList   *components.List
+	webhookNotice string
 
 	width  int
 	height int
@@ -66,11 +96,13 @@ type ProfileModel struct {
 // NewProfileModel builds the profile UI model.
 func NewProfileModel(client *api.Client, cfg *config.Config) ProfileModel {
 	return ProfileModel{
-		client:    client,
-		config:    cfg,
-		keyList:   components.NewList(10),
-		agentList: components.NewList(10),
-		taxList:   components.NewList(12),
+		client:      client,
+		config:      cfg,
+		keyList:     components.NewList(10),
+		agentList:   components.NewList(10),
+		taxList:     components.NewList(12),
+		tagList:     components.NewList(12),
+		webhookList: components.NewList(12),
 	}
 }
 
@@ -106,7 +138,7 @@ func (m ProfileModel) Update(msg tea.Msg) (ProfileModel, tea.Cmd) {
 
 	case keyCreatedMsg:
 		m.creating = false
-		m.createBuf = ""
+		m.createBuf.Reset()
 		m.createdKey = msg.resp.APIKey
 		return m, m.loadKeys
 
@@ -116,13 +148,27 @@ func (m ProfileModel) Update(msg tea.Msg) (ProfileModel, tea.Cmd) {
 	case agentUpdatedMsg:
 		return m, m.loadAgents
 
+	case agentPermissionsLoadedMsg:
+		if m.agentDetail == nil || m.agentDetail.ID != msg.agentID {
+			return m, nil
+		}
+		m.openPermissionEditor(msg.perms)
+		return m, nil
+
+	case agentPermissionsSavedMsg:
+		m.closePermissionEditor()
+		return m, m.loadAgents
+
 	case apiKeySavedMsg:
 		m.editAPIKey = false
-		m.apiKeyBuf = ""
+		m.apiKeyBuf.Reset()
 		return m, nil
 	case pendingLimitSavedMsg:
 		m.editPendingLimit = false
-		m.pendingLimitBuf = ""
+		m.pendingLimitBuf.Reset()
+		return m, nil
+	case formDefaultsSavedMsg:
+		m.closeDefaultsEditor()
 		return m, nil
 
 	case taxonomyLoadedMsg:
@@ -135,6 +181,39 @@ func (m ProfileModel) Update(msg tea.Msg) (ProfileModel, tea.Cmd) {
 		return m, nil
 
 	case taxonomyActionDoneMsg:
+		m.taxNotice = msg.notice
+		m.taxLoading = true
+		return m, m.loadTaxonomy
+
+	case tagsLoadedMsg:
+		m.tagLoading = false
+		if msg.err != nil {
+			err := msg.err
+			return m, func() tea.Msg { return errMsg{err} }
+		}
+		m.tagsLoaded = true
+		m.setTagItems(msg.items)
+		return m, nil
+
+	case tagActionDoneMsg:
+		m.tagNotice = msg.notice
+		m.tagLoading = true
+		return m, m.loadTags
+
+	case webhookLogLoadedMsg:
+		if msg.err != nil {
+			err := msg.err
+			return m, func() tea.Msg { return errMsg{err} }
+		}
+		m.setWebhookLog(msg.items)
+		return m, nil
+
+	case webhookTestDoneMsg:
+		m.webhookNotice = formatWebhookTestNotice(msg.deliveries)
+		return m, m.loadWebhookLog
+
+	case scopeMergedMsg:
+		m.taxNotice = formatScopeMergeNotice(msg)
 		m.taxLoading = true
 		return m, m.loadTaxonomy
 
@@ -142,9 +221,15 @@ func (m ProfileModel) Update(msg tea.Msg) (ProfileModel, tea.Cmd) {
 		if m.taxPromptMode != taxPromptNone {
 			return m.handleTaxonomyPrompt(msg)
 		}
+		if m.tagPromptMode != tagPromptNone {
+			return m.handleTagPrompt(msg)
+		}
 		if m.editPendingLimit {
 			return m.handlePendingLimitInput(msg)
 		}
+		if m.editDefaults {
+			return m.handleDefaultsKeys(msg)
+		}
 		if m.editAPIKey {
 			return m.handleAPIKeyInput(msg)
 		}
@@ -152,6 +237,9 @@ func (m ProfileModel) Update(msg tea.Msg) (ProfileModel, tea.Cmd) {
 			return m.handleCreateInput(msg)
 		}
 
+		if m.permEditing {
+			return m.handlePermissionKeys(msg)
+		}
 		if m.agentDetail != nil {
 			return m.handleAgentDetailKeys(msg)
 		}
@@ -166,22 +254,60 @@ func (m ProfileModel) Update(msg tea.Msg) (ProfileModel, tea.Cmd) {
 		if m.sectionFocus {
 			switch {
 			case isKey(msg, "left"):
-				m.section = (m.section - 1 + 3) % 3
+				m.section = (m.section - 1 + profileSectionCount) % profileSectionCount
+				return m, m.enterSection()
 			case isKey(msg, "right"):
-				m.section = (m.section + 1) % 3
+				m.section = (m.section + 1) % profileSectionCount
+				return m, m.enterSection()
 			case isDown(msg), isEnter(msg), isSpace(msg):
 				m.sectionFocus = false
 			}
 			return m, nil
 		}
 
+		if m.section == profileSectionTags {
+			switch {
+			case isDown(msg):
+				m.tagList.Down()
+				return m, nil
+			case isUp(msg):
+				if m.tagList.Selected() <= 0 {
+					m.sectionFocus = true
+				} else {
+					m.tagList.Up()
+				}
+				return m, nil
+			case isEnter(msg), isKey(msg, "r"), isKey(msg, "m"), isKey(msg, "d"), isKey(msg, "f"):
+				return m.handleTagKeys(msg)
+			}
+		}
+
+		if m.section == profileSectionWebhooks {
+			switch {
+			case isDown(msg):
+				m.webhookList.Down()
+				return m, nil
+			case isUp(msg):
+				if m.webhookList.Selected() <= 0 {
+					m.sectionFocus = true
+				} else {
+					m.webhookList.Up()
+				}
+				return m, nil
+			case isKey(msg, "r"), isKey(msg, "x"):
+				return m.handleWebhookKeys(msg)
+			}
+		}
+
 		switch {
 		case isKey(msg, "left"):
-			m.section = (m.section - 1 + 3) % 3
+			m.section = (m.section - 1 + profileSectionCount) % profileSectionCount
 			m.sectionFocus = true
+			return m, m.enterSection()
 		case isKey(msg, "right"):
-			m.section = (m.section + 1) % 3
+			m.section = (m.section + 1) % profileSectionCount
 			m.sectionFocus = true
+			return m, m.enterSection()
 		case isDown(msg):
 			if m.section == 2 {
 				m.taxList.Down()
@@ -208,14 +334,14 @@ func (m ProfileModel) Update(msg tea.Msg) (ProfileModel, tea.Cmd) {
 			switch m.section {
 			case 0:
 				m.creating = true
-				m.createBuf = ""
+				m.createBuf.Reset()
 			case 2:
 				m.openTaxPrompt(taxPromptCreateName, "")
 			}
 		case isKey(msg, "k"):
 			m.sectionFocus = false
 			m.editAPIKey = true
-			m.apiKeyBuf = m.config.APIKey
+			m.apiKeyBuf.SetValue(m.config.APIKey)
 		case isKey(msg, "p"):
 			m.sectionFocus = false
 			m.editPendingLimit = true
@@ -223,7 +349,21 @@ func (m ProfileModel) Update(msg tea.Msg) (ProfileModel, tea.Cmd) {
 			if m.config != nil && m.config.PendingLimit > 0 {
 				limit = m.config.PendingLimit
 			}
-			m.pendingLimitBuf = fmt.Sprintf("%d", limit)
+			m.pendingLimitBuf.SetValue(fmt.Sprintf("%d", limit))
+		case isKey(msg, "v"):
+			m.sectionFocus = false
+			return m, m.toggleVimKeys()
+		case isKey(msg, "A"):
+			m.sectionFocus = false
+			return m, m.toggleAccessible()
+		case isKey(msg, "b"):
+			m.sectionFocus = false
+			return m, m.cycleApprovalAlerts()
+		case isKey(msg, "c"):
+			if m.config != nil {
+				m.sectionFocus = false
+				m.openDefaultsEditor()
+			}
 		case isKey(msg, "r"):
 			if m.section == 0 {
 				return m.revokeSelected()
@@ -273,6 +413,14 @@ func (m ProfileModel) Update(msg tea.Msg) (ProfileModel, tea.Cmd) {
 			if m.section == 2 {
 				return m.taxonomyActivateSelected()
 			}
+		case isKey(msg, "m"):
+			if m.section == 2 {
+				return m.startScopeMerge()
+			}
+		case isKey(msg, "s"):
+			if m.section == 2 {
+				return m.startSchemaEdit()
+			}
 		case isKey(msg, "f"):
 			if m.section == 2 {
 				m.openTaxPrompt(taxPromptFilter, m.taxSearch)
@@ -286,12 +434,14 @@ func (m ProfileModel) Update(msg tea.Msg) (ProfileModel, tea.Cmd) {
 		case isKey(msg, "["):
 			if m.section == 2 {
 				m.taxKind = (m.taxKind - 1 + len(taxonomyKinds)) % len(taxonomyKinds)
+				m.taxNotice = ""
 				m.taxLoading = true
 				return m, m.loadTaxonomy
 			}
 		case isKey(msg, "]"), isKey(msg, "tab"):
 			if m.section == 2 {
 				m.taxKind = (m.taxKind + 1) % len(taxonomyKinds)
+				m.taxNotice = ""
 				m.taxLoading = true
 				return m, m.loadTaxonomy
 			}
@@ -312,6 +462,9 @@ func (m ProfileModel) View() string {
 	if m.editPendingLimit {
 		return components.Indent(components.InputDialog("Pending Queue Limit", m.pendingLimitBuf), 1)
 	}
+	if m.editDefaults {
+		return m.renderDefaultsEditor()
+	}
 
 	if m.creating {
 		return components.Indent(components.InputDialog("New Key Name", m.createBuf), 1)
@@ -322,6 +475,9 @@ func (m ProfileModel) View() string {
 			fmt.Sprintf("Save this key, it won't be shown again:\n\n%s\n\nPress Enter to continue.", m.createdKey)), 1)
 	}
 
+	if m.permEditing {
+		return m.renderPermissionEditor()
+	}
 	if m.agentDetail != nil {
 		return m.renderAgentDetail()
 	}
@@ -332,42 +488,32 @@ func (m ProfileModel) View() string {
 	b.WriteString(components.Indent(components.Table("Settings", []components.TableRow{
 		{Label: "User", Value: m.config.Username},
 		{Label: "API Key", Value: maskedAPIKey(m.config.APIKey)},
+		{Label: "Key Storage", Value: apiKeyStorageLabel(m.config)},
 		{Label: "Pending Queue", Value: fmt.Sprintf("%d", m.config.PendingLimit)},
+		{Label: "Auto Refresh", Value: config.FormatAutoRefresh(m.config.AutoRefresh)},
+		{Label: "Toast Duration", Value: toastDurationLabel(m.config)},
+		{Label: "Vim Keys", Value: onOffLabel(m.config.VimKeys)},
+		{Label: "Screen Reader", Value: onOffLabel(m.config.Accessible)},
+		{Label: "Approval Alerts", Value: approvalAlertsLabel(m.config)},
+		{Label: "Create Defaults", Value: formDefaultsLabel(m.config)},
 	}, m.width), 1))
 	b.WriteString("\n\n")
 
 	// Section tabs
-	keysLabel := "API Keys"
-	agentsLabel := "Agents"
-	taxonomyLabel := "Taxonomy"
-	var tabs string
-	switch m.section {
-	case 0:
-		active := TabActiveStyle
-		if m.sectionFocus {
-			active = TabFocusStyle
-		}
-		tabs = active.Render(keysLabel) +
-			" " + TabInactiveStyle.Render(agentsLabel) +
-			" " + TabInactiveStyle.Render(taxonomyLabel)
-	case 1:
-		active := TabActiveStyle
-		if m.sectionFocus {
-			active = TabFocusStyle
-		}
-		tabs = TabInactiveStyle.Render(keysLabel) +
-			" " + active.Render(agentsLabel) +
-			" " + TabInactiveStyle.Render(taxonomyLabel)
-	default:
-		active := TabActiveStyle
-		if m.sectionFocus {
-			active = TabFocusStyle
+	labels := []string{"API Keys", "Agents", "Taxonomy", "Tags", "Webhooks"}
+	active := TabActiveStyle
+	if m.sectionFocus {
+		active = TabFocusStyle
+	}
+	rendered := make([]string, len(labels))
+	for i, label := range labels {
+		if i == m.section {
+			rendered[i] = active.Render(label)
+		} else {
+			rendered[i] = TabInactiveStyle.Render(label)
 		}
-		tabs = TabInactiveStyle.Render(keysLabel) +
-			" " + TabInactiveStyle.Render(agentsLabel) +
-			" " + active.Render(taxonomyLabel)
 	}
-	b.WriteString(components.CenterLine(tabs, m.width))
+	b.WriteString(components.CenterLine(strings.Join(rendered, " "), m.width))
 	b.WriteString("\n\n")
 
 	switch m.section {
@@ -375,6 +521,10 @@ func (m ProfileModel) View() string {
 		b.WriteString(m.renderKeys())
 	case 1:
 		b.WriteString(m.renderAgents())
+	case profileSectionTags:
+		b.WriteString(m.renderTags())
+	case profileSectionWebhooks:
+		b.WriteString(m.renderWebhooks())
 	default:
 		b.WriteString(m.renderTaxonomy())
 	}
@@ -384,6 +534,14 @@ func (m ProfileModel) View() string {
 
 // --- Helpers ---
 
+// enterSection loads whatever the newly shown section needs.
+func (m *ProfileModel) enterSection() tea.Cmd {
+	if m.section == profileSectionWebhooks {
+		return m.enterWebhooksSection()
+	}
+	return m.enterTagsSection()
+}
+
 func (m *ProfileModel) activeList() *components.List {
 	if m.section == 0 {
 		return m.keyList
@@ -414,11 +572,11 @@ func (m ProfileModel) handleCreateInput(msg tea.KeyMsg) (ProfileModel, tea.Cmd)
 	switch {
 	case isBack(msg):
 		m.creating = false
-		m.createBuf = ""
+		m.createBuf.Reset()
 	case isEnter(msg):
-		name := m.createBuf
+		name := m.createBuf.Value
 		m.creating = false
-		m.createBuf = ""
+		m.createBuf.Reset()
 		return m, func() tea.Msg {
 			resp, err := m.client.CreateKey(name)
 			if err != nil {
@@ -426,14 +584,8 @@ func (m ProfileModel) handleCreateInput(msg tea.KeyMsg) (ProfileModel, tea.Cmd)
 			}
 			return keyCreatedMsg{resp}
 		}
-	case isKey(msg, "backspace"):
-		if len(m.createBuf) > 0 {
-			m.createBuf = m.createBuf[:len(m.createBuf)-1]
-		}
 	default:
-		if len(msg.String()) == 1 || msg.String() == " " {
-			m.createBuf += msg.String()
-		}
+		m.createBuf.HandleKey(msg)
 	}
 	return m, nil
 }
@@ -443,10 +595,10 @@ func (m ProfileModel) handleAPIKeyInput(msg tea.KeyMsg) (ProfileModel, tea.Cmd)
 	switch {
 	case isBack(msg):
 		m.editAPIKey = false
-		m.apiKeyBuf = ""
+		m.apiKeyBuf.Reset()
 		return m, nil
 	case isEnter(msg):
-		key := strings.TrimSpace(m.apiKeyBuf)
+		key := strings.TrimSpace(m.apiKeyBuf.Value)
 		if key == "" {
 			return m, func() tea.Msg {
 				return errMsg{fmt.Errorf("api key cannot be empty")}
@@ -462,15 +614,8 @@ func (m ProfileModel) handleAPIKeyInput(msg tea.KeyMsg) (ProfileModel, tea.Cmd)
 			}
 			return apiKeySavedMsg{}
 		}
-	case isKey(msg, "backspace", "delete"):
-		if len(m.apiKeyBuf) > 0 {
-			m.apiKeyBuf = m.apiKeyBuf[:len(m.apiKeyBuf)-1]
-		}
 	default:
-		ch := msg.String()
-		if len(ch) == 1 || ch == " " {
-			m.apiKeyBuf += ch
-		}
+		m.apiKeyBuf.HandleKey(msg)
 	}
 	return m, nil
 }
@@ -480,10 +625,10 @@ func (m ProfileModel) handlePendingLimitInput(msg tea.KeyMsg) (ProfileModel, tea
 	switch {
 	case isBack(msg):
 		m.editPendingLimit = false
-		m.pendingLimitBuf = ""
+		m.pendingLimitBuf.Reset()
 		return m, nil
 	case isEnter(msg):
-		raw := strings.TrimSpace(m.pendingLimitBuf)
+		raw := strings.TrimSpace(m.pendingLimitBuf.Value)
 		if raw == "" {
 			return m, func() tea.Msg { return errMsg{fmt.Errorf("pending limit cannot be empty")} }
 		}
@@ -501,19 +646,80 @@ func (m ProfileModel) handlePendingLimitInput(msg tea.KeyMsg) (ProfileModel, tea
 			}
 			return pendingLimitSavedMsg{limit: limit}
 		}
-	case isKey(msg, "backspace", "delete"):
-		if len(m.pendingLimitBuf) > 0 {
-			m.pendingLimitBuf = m.pendingLimitBuf[:len(m.pendingLimitBuf)-1]
-		}
 	default:
-		ch := msg.String()
-		if len(ch) == 1 && ch[0] >= '0' && ch[0] <= '9' {
-			m.pendingLimitBuf += ch
+		if !typesNonDigit(msg) {
+			m.pendingLimitBuf.HandleKey(msg)
 		}
 	}
 	return m, nil
 }
 
+// toggleVimKeys flips the vim keybindings flag and saves the config.
+func (m ProfileModel) toggleVimKeys() tea.Cmd {
+	if m.config == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		m.config.VimKeys = !m.config.VimKeys
+		if err := m.config.Save(); err != nil {
+			m.config.VimKeys = !m.config.VimKeys
+			return errMsg{err}
+		}
+		return vimKeysSavedMsg{enabled: m.config.VimKeys}
+	}
+}
+
+// toastDurationLabel renders the effective toast_duration setting.
+func toastDurationLabel(cfg *config.Config) string {
+	if timeout := cfg.ToastTimeout(); timeout > 0 {
+		return timeout.String()
+	}
+	return "off (notifications only)"
+}
+
+// toggleAccessible flips screen-reader friendly rendering and saves the config.
+func (m ProfileModel) toggleAccessible() tea.Cmd {
+	if m.config == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		m.config.Accessible = !m.config.Accessible
+		if err := m.config.Save(); err != nil {
+			m.config.Accessible = !m.config.Accessible
+			return errMsg{err}
+		}
+		return accessibleSavedMsg{enabled: m.config.Accessible}
+	}
+}
+
+// cycleApprovalAlerts steps approval_alerts through on, flash, and off and
+// saves the config.
+func (m ProfileModel) cycleApprovalAlerts() tea.Cmd {
+	if m.config == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		previous := m.config.ApprovalAlerts
+		m.config.ApprovalAlerts = config.NextApprovalAlertMode(m.config.ApprovalAlertMode())
+		if err := m.config.Save(); err != nil {
+			m.config.ApprovalAlerts = previous
+			return errMsg{err}
+		}
+		return approvalAlertsSavedMsg{mode: m.config.ApprovalAlertMode()}
+	}
+}
+
+// approvalAlertsLabel renders the effective approval_alerts setting.
+func approvalAlertsLabel(cfg *config.Config) string {
+	switch cfg.ApprovalAlertMode() {
+	case config.ApprovalAlertsFlash:
+		return "tab flash"
+	case config.ApprovalAlertsOff:
+		return "off"
+	}
+	return "bell + tab flash"
+}
+
 // parsePositiveInt parses parse positive int.
 func parsePositiveInt(raw string) (int, error) {
 	n, err := strconv.Atoi(raw)
@@ -900,8 +1106,11 @@ func (m ProfileModel) renderAgentDetail() string {
 
 // handleAgentDetailKeys handles handle agent detail keys.
 func (m ProfileModel) handleAgentDetailKeys(msg tea.KeyMsg) (ProfileModel, tea.Cmd) {
-	if isBack(msg) || isEnter(msg) {
+	switch {
+	case isBack(msg), isEnter(msg):
 		m.agentDetail = nil
+	case isKey(msg, "e"):
+		return m, m.loadAgentPermissions()
 	}
 	return m, nil
 }
@@ -931,6 +1140,22 @@ func formatAgentLine(a api.Agent) string {
 	return fmt.Sprintf("[%s] %s (%s)", status, name, trust)
 }
 
+// apiKeyStorageLabel describes where the API key is persisted.
+func apiKeyStorageLabel(cfg *config.Config) string {
+	if cfg != nil && cfg.KeyInKeyring {
+		return "OS keyring"
+	}
+	return "config file"
+}
+
+// onOffLabel renders a settings toggle.
+func onOffLabel(on bool) string {
+	if on {
+		return "on"
+	}
+	return "off"
+}
+
 // maskedAPIKey handles masked apikey.
 func maskedAPIKey(key string) string {
 	key = strings.TrimSpace(key)
@@ -0,0 +1,125 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// formDefaultsSavedMsg reports the create defaults after they were saved.
+type formDefaultsSavedMsg struct{ defaults config.FormDefaults }
+
+const (
+	defaultsFieldScopes = iota
+	defaultsFieldEntityType
+	defaultsFieldKnowledgeType
+	defaultsFieldStatus
+	defaultsFieldCount
+)
+
+var defaultsFieldLabels = [defaultsFieldCount]string{"Scopes", "Entity Type", "Knowledge Type", "Entity Status"}
+
+// openDefaultsEditor fills the editor buffers from the saved defaults.
+func (m *ProfileModel) openDefaultsEditor() {
+	d := m.config.FormDefaults()
+	m.defaultsBufs = [defaultsFieldCount]components.TextInput{
+		components.NewTextInput(strings.Join(d.Scopes, ", ")),
+		components.NewTextInput(d.EntityType),
+		components.NewTextInput(d.KnowledgeType),
+		components.NewTextInput(d.Status),
+	}
+	m.defaultsFocus = 0
+	m.editDefaults = true
+}
+
+// closeDefaultsEditor resets editor state.
+func (m *ProfileModel) closeDefaultsEditor() {
+	m.editDefaults = false
+	m.defaultsFocus = 0
+	m.defaultsBufs = [defaultsFieldCount]components.TextInput{}
+}
+
+// handleDefaultsKeys handles keys while the create defaults editor is open.
+func (m ProfileModel) handleDefaultsKeys(msg tea.KeyMsg) (ProfileModel, tea.Cmd) {
+	switch {
+	case isBack(msg):
+		m.closeDefaultsEditor()
+	case isDown(msg), isKey(msg, "tab"):
+		m.defaultsFocus = (m.defaultsFocus + 1) % defaultsFieldCount
+	case isUp(msg):
+		m.defaultsFocus = (m.defaultsFocus - 1 + defaultsFieldCount) % defaultsFieldCount
+	case isKey(msg, "ctrl+s"), isEnter(msg):
+		return m, m.saveDefaults()
+	default:
+		m.defaultsBufs[m.defaultsFocus].HandleKey(msg)
+	}
+	return m, nil
+}
+
+// parseDefaultsDraft validates the editor buffers.
+func (m ProfileModel) parseDefaultsDraft() (config.FormDefaults, error) {
+	d := config.FormDefaults{
+		Scopes:        parsePermissionList(m.defaultsBufs[defaultsFieldScopes].Value),
+		EntityType:    strings.TrimSpace(m.defaultsBufs[defaultsFieldEntityType].Value),
+		KnowledgeType: strings.ToLower(strings.TrimSpace(m.defaultsBufs[defaultsFieldKnowledgeType].Value)),
+		Status:        strings.ToLower(strings.TrimSpace(m.defaultsBufs[defaultsFieldStatus].Value)),
+	}
+	if len(d.Scopes) == 0 {
+		d.Scopes = nil
+	}
+	if d.KnowledgeType != "" && !slices.Contains(contextTypes, d.KnowledgeType) {
+		return d, fmt.Errorf("knowledge type must be one of: %s", strings.Join(contextTypes, ", "))
+	}
+	if d.Status != "" && !slices.Contains(entityStatusOptions, d.Status) {
+		return d, fmt.Errorf("entity status must be one of: %s", strings.Join(entityStatusOptions, ", "))
+	}
+	return d, nil
+}
+
+// saveDefaults validates and saves the create defaults.
+func (m ProfileModel) saveDefaults() tea.Cmd {
+	d, err := m.parseDefaultsDraft()
+	if err != nil {
+		return func() tea.Msg { return errMsg{err} }
+	}
+	return func() tea.Msg {
+		previous := m.config.Defaults
+		m.config.Defaults = d
+		if err := m.config.Save(); err != nil {
+			m.config.Defaults = previous
+			return errMsg{err}
+		}
+		return formDefaultsSavedMsg{defaults: m.config.FormDefaults()}
+	}
+}
+
+// renderDefaultsEditor renders the create defaults form.
+func (m ProfileModel) renderDefaultsEditor() string {
+	rows := make([][2]string, 0, defaultsFieldCount)
+	for i, label := range defaultsFieldLabels {
+		rows = append(rows, [2]string{label, formatFormValue(m.defaultsBufs[i], i == m.defaultsFocus)})
+	}
+	body := renderFormGrid("Create Defaults", rows, m.defaultsFocus, m.width)
+	hint := MutedStyle.Render("used by add forms and `nebula api ... create` · blank keeps the built-in default")
+	return components.Indent(body+"\n"+hint, 1)
+}
+
+// formDefaultsLabel summarizes the create defaults for the Settings table.
+func formDefaultsLabel(cfg *config.Config) string {
+	d := cfg.FormDefaults()
+	entityType := d.EntityType
+	if entityType == "" {
+		entityType = "-"
+	}
+	knowledgeType := d.KnowledgeType
+	if knowledgeType == "" {
+		knowledgeType = contextTypes[0]
+	}
+	return fmt.Sprintf("scopes %s · entity %s, %s · knowledge %s",
+		strings.Join(d.ScopesOrFallback(), ","), entityType, entityDefaultStatus(d), knowledgeType)
+}
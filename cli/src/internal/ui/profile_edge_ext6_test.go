@@ -34,7 +34,7 @@ func TestProfileUpdateDownAndPendingLimitConfigBranches(t *testing.T) {
 	updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}})
 	require.Nil(t, cmd)
 	assert.True(t, updated.editPendingLimit)
-	assert.Equal(t, "777", updated.pendingLimitBuf)
+	assert.Equal(t, "777", updated.pendingLimitBuf.Value)
 }
 
 func TestProfileViewSectionFocusTabBranches(t *testing.T) {
@@ -94,7 +94,7 @@ func TestProfileHandleInputsSaveErrorBranches(t *testing.T) {
 	model := NewProfileModel(nil, cfg)
 
 	model.editAPIKey = true
-	model.apiKeyBuf = "nbl_new"
+	model.apiKeyBuf.Value = "nbl_new"
 	updated, cmd := model.handleAPIKeyInput(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
 	assert.True(t, updated.editAPIKey)
@@ -104,7 +104,7 @@ func TestProfileHandleInputsSaveErrorBranches(t *testing.T) {
 	assert.Error(t, errMsgVal.err)
 
 	model.editPendingLimit = true
-	model.pendingLimitBuf = "900"
+	model.pendingLimitBuf.Value = "900"
 	updated, cmd = model.handlePendingLimitInput(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
 	assert.True(t, updated.editPendingLimit)
@@ -25,11 +25,11 @@ func TestProfileUpdateMessageMatrixBranches(t *testing.T) {
 	model := NewProfileModel(nil, &config.Config{Username: "alxx", APIKey: "nbl_key"})
 	model.loading = true
 	model.creating = true
-	model.createBuf = "draft"
+	model.createBuf.Value = "draft"
 	model.editAPIKey = true
-	model.apiKeyBuf = "draft"
+	model.apiKeyBuf.Value = "draft"
 	model.editPendingLimit = true
-	model.pendingLimitBuf = "42"
+	model.pendingLimitBuf.Value = "42"
 	model.taxLoading = false
 
 	updated, cmd := model.Update(keysLoadedMsg{
@@ -52,7 +52,7 @@ func TestProfileUpdateMessageMatrixBranches(t *testing.T) {
 	updated, cmd = updated.Update(keyCreatedMsg{resp: &api.CreateKeyResponse{APIKey: "nbl_created"}})
 	require.NotNil(t, cmd)
 	assert.False(t, updated.creating)
-	assert.Equal(t, "", updated.createBuf)
+	assert.Equal(t, "", updated.createBuf.Value)
 	assert.Equal(t, "nbl_created", updated.createdKey)
 
 	updated, cmd = updated.Update(keyRevokedMsg{})
@@ -64,12 +64,12 @@ func TestProfileUpdateMessageMatrixBranches(t *testing.T) {
 	updated, cmd = updated.Update(apiKeySavedMsg{})
 	require.Nil(t, cmd)
 	assert.False(t, updated.editAPIKey)
-	assert.Equal(t, "", updated.apiKeyBuf)
+	assert.Equal(t, "", updated.apiKeyBuf.Value)
 
 	updated, cmd = updated.Update(pendingLimitSavedMsg{limit: 100})
 	require.Nil(t, cmd)
 	assert.False(t, updated.editPendingLimit)
-	assert.Equal(t, "", updated.pendingLimitBuf)
+	assert.Equal(t, "", updated.pendingLimitBuf.Value)
 
 	updated.taxItems = []api.TaxonomyEntry{{ID: "tx-1", Name: "public"}}
 	updated.taxKind = 0 // scopes
@@ -137,21 +137,21 @@ func TestProfileUpdateTaxonomyHotkeysReturnLoadCommands(t *testing.T) {
 func TestProfileHandleCreateInputBackAndDeleteBranches(t *testing.T) {
 	model := NewProfileModel(nil, &config.Config{Username: "alxx"})
 	model.creating = true
-	model.createBuf = "abc"
+	model.createBuf.Value = "abc"
 
 	updated, cmd := model.handleCreateInput(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "ab", updated.createBuf)
+	assert.Equal(t, "ab", updated.createBuf.Value)
 
 	updated, _ = updated.handleCreateInput(tea.KeyMsg{Type: tea.KeySpace})
-	assert.Equal(t, "ab ", updated.createBuf)
+	assert.Equal(t, "ab ", updated.createBuf.Value)
 
 	updated, _ = updated.handleCreateInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
-	assert.Equal(t, "ab x", updated.createBuf)
+	assert.Equal(t, "ab x", updated.createBuf.Value)
 
 	updated, _ = updated.handleCreateInput(tea.KeyMsg{Type: tea.KeyEsc})
 	assert.False(t, updated.creating)
-	assert.Equal(t, "", updated.createBuf)
+	assert.Equal(t, "", updated.createBuf.Value)
 }
 
 func TestProfileHandleCreateInputEnterReturnsErrorMessage(t *testing.T) {
@@ -166,12 +166,12 @@ func TestProfileHandleCreateInputEnterReturnsErrorMessage(t *testing.T) {
 
 	model := NewProfileModel(client, &config.Config{Username: "alxx"})
 	model.creating = true
-	model.createBuf = "key-name"
+	model.createBuf.Value = "key-name"
 
 	updated, cmd := model.handleCreateInput(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
 	assert.False(t, updated.creating)
-	assert.Equal(t, "", updated.createBuf)
+	assert.Equal(t, "", updated.createBuf.Value)
 	_, ok := cmd().(errMsg)
 	assert.True(t, ok)
 }
@@ -285,7 +285,7 @@ func TestProfileUpdateSectionTwoEnterOpensEditPrompt(t *testing.T) {
 	require.Nil(t, cmd)
 	assert.Equal(t, taxPromptEditName, updated.taxPromptMode)
 	assert.Equal(t, "scope-1", updated.taxEditID)
-	assert.Equal(t, "public", updated.taxPromptBuf)
+	assert.Equal(t, "public", updated.taxPromptBuf.Value)
 }
 
 func TestProfileUpdateHandlesTaxonomyLoadedMatch(t *testing.T) {
@@ -326,7 +326,7 @@ func TestProfileHandleAPIKeyInputSavePathWithClientUpdate(t *testing.T) {
 
 	model := NewProfileModel(client, cfg)
 	model.editAPIKey = true
-	model.apiKeyBuf = "nbl_new"
+	model.apiKeyBuf.Value = "nbl_new"
 	updated, cmd := model.handleAPIKeyInput(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
 	assert.True(t, updated.editAPIKey)
@@ -17,26 +17,26 @@ func TestProfileHandleAPIKeyInputBranches(t *testing.T) {
 	require.Nil(t, cmd)
 	updated, _ = updated.handleAPIKeyInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
 	updated, _ = updated.handleAPIKeyInput(tea.KeyMsg{Type: tea.KeySpace})
-	assert.Equal(t, "nb ", updated.apiKeyBuf)
+	assert.Equal(t, "nb ", updated.apiKeyBuf.Value)
 
 	updated, _ = updated.handleAPIKeyInput(tea.KeyMsg{Type: tea.KeyUp})
-	assert.Equal(t, "nb ", updated.apiKeyBuf)
+	assert.Equal(t, "nb ", updated.apiKeyBuf.Value)
 
 	updated, _ = updated.handleAPIKeyInput(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "nb", updated.apiKeyBuf)
+	assert.Equal(t, "nb", updated.apiKeyBuf.Value)
 
 	updated, _ = updated.handleAPIKeyInput(tea.KeyMsg{Type: tea.KeyDelete})
-	assert.Equal(t, "n", updated.apiKeyBuf)
+	assert.Equal(t, "n", updated.apiKeyBuf.Value)
 
 	updated, _ = updated.handleAPIKeyInput(tea.KeyMsg{Type: tea.KeyEsc})
 	assert.False(t, updated.editAPIKey)
-	assert.Equal(t, "", updated.apiKeyBuf)
+	assert.Equal(t, "", updated.apiKeyBuf.Value)
 }
 
 func TestProfileHandleAPIKeyInputEnterEmptyReturnsErrMsg(t *testing.T) {
 	model := NewProfileModel(nil, &config.Config{Username: "alxx", APIKey: "nbl_old"})
 	model.editAPIKey = true
-	model.apiKeyBuf = "   "
+	model.apiKeyBuf.Value = "   "
 
 	updated, cmd := model.handleAPIKeyInput(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
@@ -51,23 +51,23 @@ func TestProfileHandlePendingLimitInputBranches(t *testing.T) {
 
 	updated, cmd := model.handlePendingLimitInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.pendingLimitBuf)
+	assert.Equal(t, "", updated.pendingLimitBuf.Value)
 
 	updated, _ = updated.handlePendingLimitInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}})
 	updated, _ = updated.handlePendingLimitInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'2'}})
-	assert.Equal(t, "42", updated.pendingLimitBuf)
+	assert.Equal(t, "42", updated.pendingLimitBuf.Value)
 
 	updated, _ = updated.handlePendingLimitInput(tea.KeyMsg{Type: tea.KeyDelete})
-	assert.Equal(t, "4", updated.pendingLimitBuf)
+	assert.Equal(t, "4", updated.pendingLimitBuf.Value)
 
-	updated.pendingLimitBuf = "0"
+	updated.pendingLimitBuf.Value = "0"
 	updated, cmd = updated.handlePendingLimitInput(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
 	_, ok := cmd().(errMsg)
 	assert.True(t, ok)
 
-	updated.pendingLimitBuf = "123"
+	updated.pendingLimitBuf.Value = "123"
 	updated, _ = updated.handlePendingLimitInput(tea.KeyMsg{Type: tea.KeyEsc})
 	assert.False(t, updated.editPendingLimit)
-	assert.Equal(t, "", updated.pendingLimitBuf)
+	assert.Equal(t, "", updated.pendingLimitBuf.Value)
 }
@@ -0,0 +1,267 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+type agentPermissionsLoadedMsg struct {
+	agentID string
+	perms   api.AgentPermissions
+}
+
+type agentPermissionsSavedMsg struct{ agentID string }
+
+const (
+	permFieldScopes = iota
+	permFieldRequestTypes
+	permFieldRateLimit
+	permFieldExpires
+	permFieldCount
+)
+
+var permFieldLabels = [permFieldCount]string{"Scopes", "Request Types", "Rate Limit / min", "Expires"}
+
+// permissionDraft is the parsed state of the permission editor.
+type permissionDraft struct {
+	scopes       []string
+	requestTypes []string
+	rateLimit    int
+	expiresAt    *time.Time
+}
+
+// loadAgentPermissions loads the permission set for the agent in detail view.
+func (m ProfileModel) loadAgentPermissions() tea.Cmd {
+	if m.agentDetail == nil || m.client == nil {
+		return nil
+	}
+	agentID := m.agentDetail.ID
+	return func() tea.Msg {
+		perms, err := m.client.GetAgentPermissions(agentID)
+		if err != nil {
+			return errMsg{err}
+		}
+		return agentPermissionsLoadedMsg{agentID: agentID, perms: *perms}
+	}
+}
+
+// openPermissionEditor fills the editor buffers from loaded permissions.
+func (m *ProfileModel) openPermissionEditor(perms api.AgentPermissions) {
+	m.permOriginal = perms
+	m.permBufs = [permFieldCount]components.TextInput{
+		components.NewTextInput(strings.Join(perms.Scopes, ", ")),
+		components.NewTextInput(strings.Join(perms.RequestTypes, ", ")),
+		components.NewTextInput(strconv.Itoa(perms.RateLimitPerMinute)),
+		components.NewTextInput(formatPermissionExpiry(perms.ExpiresAt)),
+	}
+	m.permFocus = 0
+	m.permConfirm = false
+	m.permEditing = true
+}
+
+// closePermissionEditor resets editor state.
+func (m *ProfileModel) closePermissionEditor() {
+	m.permEditing = false
+	m.permConfirm = false
+	m.permFocus = 0
+	m.permBufs = [permFieldCount]components.TextInput{}
+	m.permOriginal = api.AgentPermissions{}
+}
+
+// handlePermissionKeys handles keys for the permission editor and its confirm step.
+func (m ProfileModel) handlePermissionKeys(msg tea.KeyMsg) (ProfileModel, tea.Cmd) {
+	if m.permConfirm {
+		switch {
+		case isEnter(msg), isKey(msg, "y"):
+			return m.savePermissions()
+		case isBack(msg), isKey(msg, "n"):
+			m.permConfirm = false
+		}
+		return m, nil
+	}
+
+	switch {
+	case isBack(msg):
+		m.closePermissionEditor()
+	case isDown(msg), isKey(msg, "tab"):
+		m.permFocus = (m.permFocus + 1) % permFieldCount
+	case isUp(msg):
+		m.permFocus = (m.permFocus - 1 + permFieldCount) % permFieldCount
+	case isKey(msg, "ctrl+s"), isEnter(msg):
+		draft, err := m.parsePermissionDraft()
+		if err != nil {
+			return m, func() tea.Msg { return errMsg{err} }
+		}
+		if len(permissionDiffRows(m.permOriginal, draft)) == 0 {
+			return m, func() tea.Msg { return errMsg{fmt.Errorf("no permission changes")} }
+		}
+		m.permConfirm = true
+	default:
+		m.permBufs[m.permFocus].HandleKey(msg)
+	}
+	return m, nil
+}
+
+// savePermissions sends the confirmed permission set.
+func (m ProfileModel) savePermissions() (ProfileModel, tea.Cmd) {
+	if m.agentDetail == nil {
+		return m, nil
+	}
+	draft, err := m.parsePermissionDraft()
+	if err != nil {
+		m.permConfirm = false
+		return m, func() tea.Msg { return errMsg{err} }
+	}
+	m.permConfirm = false
+	agentID := m.agentDetail.ID
+	input := api.UpdateAgentPermissionsInput{
+		Scopes:             draft.scopes,
+		RequestTypes:       draft.requestTypes,
+		RateLimitPerMinute: draft.rateLimit,
+	}
+	if draft.expiresAt != nil {
+		value := draft.expiresAt.UTC().Format(time.RFC3339)
+		input.ExpiresAt = &value
+	}
+	return m, func() tea.Msg {
+		if _, err := m.client.UpdateAgentPermissions(agentID, input); err != nil {
+			return errMsg{err}
+		}
+		return agentPermissionsSavedMsg{agentID: agentID}
+	}
+}
+
+// parsePermissionDraft validates the editor buffers.
+func (m ProfileModel) parsePermissionDraft() (permissionDraft, error) {
+	draft := permissionDraft{
+		scopes:       parsePermissionList(m.permBufs[permFieldScopes].Value),
+		requestTypes: parsePermissionList(m.permBufs[permFieldRequestTypes].Value),
+	}
+	rate := strings.TrimSpace(m.permBufs[permFieldRateLimit].Value)
+	if rate != "" {
+		limit, err := strconv.Atoi(rate)
+		if err != nil || limit < 0 {
+			return draft, fmt.Errorf("rate limit must be a whole number (0 = unlimited)")
+		}
+		draft.rateLimit = limit
+	}
+	expires := strings.TrimSpace(m.permBufs[permFieldExpires].Value)
+	if expires != "" && !strings.EqualFold(expires, "never") {
+		ts, err := parsePermissionExpiry(expires)
+		if err != nil {
+			return draft, err
+		}
+		draft.expiresAt = &ts
+	}
+	return draft, nil
+}
+
+// parsePermissionList splits a comma separated list, dropping blanks and duplicates.
+func parsePermissionList(raw string) []string {
+	seen := map[string]bool{}
+	out := []string{}
+	for _, part := range strings.Split(raw, ",") {
+		value := strings.TrimSpace(part)
+		if value == "" || seen[value] {
+			continue
+		}
+		seen[value] = true
+		out = append(out, value)
+	}
+	return out
+}
+
+// parsePermissionExpiry parses YYYY-MM-DD (end of day, local) or RFC3339.
+func parsePermissionExpiry(raw string) (time.Time, error) {
+	if ts, err := time.Parse(time.RFC3339, raw); err == nil {
+		return ts, nil
+	}
+	if ts, err := time.ParseInLocation("2006-01-02", raw, time.Local); err == nil {
+		return ts.Add(24*time.Hour - time.Minute), nil
+	}
+	return time.Time{}, fmt.Errorf("expiry must be YYYY-MM-DD, RFC3339, or blank")
+}
+
+// formatPermissionExpiry renders an expiry for editing and diffs.
+func formatPermissionExpiry(ts *time.Time) string {
+	if ts == nil || ts.IsZero() {
+		return ""
+	}
+	return ts.Local().Format("2006-01-02")
+}
+
+// formatRateLimit renders a per-minute rate limit.
+func formatRateLimit(limit int) string {
+	if limit <= 0 {
+		return "unlimited"
+	}
+	return fmt.Sprintf("%d/min", limit)
+}
+
+// formatPermissionList renders a permission list for diffs.
+func formatPermissionList(items []string) string {
+	if len(items) == 0 {
+		return "none"
+	}
+	return strings.Join(items, ", ")
+}
+
+// permissionDiffRows lists the fields that differ between the stored and draft permissions.
+func permissionDiffRows(before api.AgentPermissions, draft permissionDraft) []components.DiffRow {
+	rows := []components.DiffRow{}
+	add := func(label, from, to string) {
+		if from != to {
+			rows = append(rows, components.DiffRow{Label: label, From: from, To: to})
+		}
+	}
+	add("scopes", formatPermissionList(before.Scopes), formatPermissionList(draft.scopes))
+	add("request_types", formatPermissionList(before.RequestTypes), formatPermissionList(draft.requestTypes))
+	add("rate_limit", formatRateLimit(before.RateLimitPerMinute), formatRateLimit(draft.rateLimit))
+	fromExpiry := formatPermissionExpiry(before.ExpiresAt)
+	toExpiry := formatPermissionExpiry(draft.expiresAt)
+	if fromExpiry == "" {
+		fromExpiry = "never"
+	}
+	if toExpiry == "" {
+		toExpiry = "never"
+	}
+	add("expires", fromExpiry, toExpiry)
+	return rows
+}
+
+// renderPermissionEditor renders the permission form or its confirm step.
+func (m ProfileModel) renderPermissionEditor() string {
+	name := ""
+	if m.agentDetail != nil {
+		name = m.agentDetail.Name
+	}
+	if m.permConfirm {
+		draft, err := m.parsePermissionDraft()
+		if err != nil {
+			return components.Indent(components.ErrorBox("Permissions", err.Error(), m.width), 1)
+		}
+		summary := []components.TableRow{
+			{Label: "Agent", Value: name},
+			{Label: "Action", Value: "replace permissions"},
+		}
+		return components.Indent(
+			components.ConfirmPreviewDialog("Apply Permissions", summary, permissionDiffRows(m.permOriginal, draft), m.width),
+			1,
+		)
+	}
+
+	rows := make([][2]string, 0, permFieldCount)
+	for i, label := range permFieldLabels {
+		rows = append(rows, [2]string{label, formatFormValue(m.permBufs[i], i == m.permFocus)})
+	}
+	body := renderFormGrid("Agent Permissions · "+name, rows, m.permFocus, m.width)
+	hint := MutedStyle.Render("comma separated lists · rate 0 = unlimited · expires YYYY-MM-DD or blank")
+	return components.Indent(body+"\n"+hint, 1)
+}
@@ -0,0 +1,93 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+// TestProfilePermissionEditorFlow handles test profile permission editor flow.
+func TestProfilePermissionEditorFlow(t *testing.T) {
+	var saved map[string]any
+	_, client := testProfileClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/agents/agent-1/permissions" && r.Method == http.MethodGet:
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+				"agent_id":              "agent-1",
+				"scopes":                []string{"public"},
+				"request_types":         []string{"create_entity"},
+				"rate_limit_per_minute": 60,
+			}}))
+		case r.URL.Path == "/api/agents/agent-1/permissions" && r.Method == http.MethodPatch:
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&saved))
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": saved}))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	model := NewProfileModel(client, &config.Config{Username: "alxx"})
+	model.width = 120
+	agent := api.Agent{ID: "agent-1", Name: "Alpha", Status: "active"}
+	model.agentDetail = &agent
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+	require.NotNil(t, cmd)
+	model, _ = model.Update(cmd())
+	require.True(t, model.permEditing)
+	assert.Equal(t, "public", model.permBufs[permFieldScopes].Value)
+	assert.Contains(t, stripANSI(model.View()), "Request Types")
+
+	// Unchanged buffers are rejected before confirm.
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	require.NotNil(t, cmd)
+	assert.Contains(t, cmd().(errMsg).err.Error(), "no permission changes")
+
+	model.permBufs[permFieldScopes].Value = "public, private, public"
+	model.permBufs[permFieldRateLimit].Value = "abc"
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	assert.Contains(t, cmd().(errMsg).err.Error(), "rate limit")
+
+	model.permBufs[permFieldRateLimit].Value = "30"
+	model.permBufs[permFieldExpires].Value = "2026-12-31"
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	assert.Nil(t, cmd)
+	require.True(t, model.permConfirm)
+	view := stripANSI(model.View())
+	assert.Contains(t, view, "replace permissions")
+	assert.Contains(t, view, "public, private")
+	assert.Contains(t, view, "30/min")
+
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	msg := cmd()
+	_, ok := msg.(agentPermissionsSavedMsg)
+	require.True(t, ok)
+	assert.Equal(t, []any{"public", "private"}, saved["scopes"])
+	assert.Equal(t, float64(30), saved["rate_limit_per_minute"])
+	assert.NotNil(t, saved["expires_at"])
+
+	model, _ = model.Update(msg)
+	assert.False(t, model.permEditing)
+	require.NotNil(t, model.agentDetail)
+}
+
+// TestPermissionDiffRowsSkipsUnchangedFields handles test permission diff rows skips unchanged fields.
+func TestPermissionDiffRowsSkipsUnchangedFields(t *testing.T) {
+	before := api.AgentPermissions{Scopes: []string{"public"}, RateLimitPerMinute: 0}
+	rows := permissionDiffRows(before, permissionDraft{scopes: []string{"public"}, rateLimit: 10})
+	require.Len(t, rows, 1)
+	assert.Equal(t, "rate_limit", rows[0].Label)
+	assert.Equal(t, "unlimited", rows[0].From)
+	assert.Equal(t, "10/min", rows[0].To)
+
+	_, err := parsePermissionExpiry("soon")
+	assert.Error(t, err)
+}
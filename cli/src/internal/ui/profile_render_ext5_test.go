@@ -14,7 +14,7 @@ import (
 func TestProfileUpdateRoutesPendingLimitInputFromMainUpdate(t *testing.T) {
 	model := NewProfileModel(nil, &config.Config{Username: "alxx"})
 	model.editPendingLimit = true
-	model.pendingLimitBuf = ""
+	model.pendingLimitBuf.Value = ""
 
 	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
@@ -63,7 +63,7 @@ func TestProfileUpdateNavigationAndTaxonomyAdditionalBranches(t *testing.T) {
 	updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}})
 	require.Nil(t, cmd)
 	assert.True(t, updated.editPendingLimit)
-	assert.Equal(t, "500", updated.pendingLimitBuf)
+	assert.Equal(t, "500", updated.pendingLimitBuf.Value)
 
 	desc := "scope desc"
 	updated.section = 2
@@ -93,7 +93,7 @@ func TestProfileUpdateNavigationAndTaxonomyAdditionalBranches(t *testing.T) {
 	updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
 	require.Nil(t, cmd)
 	assert.Equal(t, taxPromptFilter, updated.taxPromptMode)
-	assert.Equal(t, "pub", updated.taxPromptBuf)
+	assert.Equal(t, "pub", updated.taxPromptBuf.Value)
 }
 
 func TestProfileViewAndRenderKeysAdditionalBranches(t *testing.T) {
@@ -0,0 +1,129 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scopeMergeFixture returns a scope list with one builtin, one custom, and one archived scope.
+func scopeMergeFixture() []api.TaxonomyEntry {
+	return []api.TaxonomyEntry{
+		{ID: "scope-public", Name: "public", IsBuiltin: true, IsActive: true},
+		{ID: "scope-ops", Name: "ops-team", IsActive: true},
+		{ID: "scope-old", Name: "legacy", IsActive: false},
+	}
+}
+
+func TestProfileScopeMergeFlow(t *testing.T) {
+	var gotPath string
+	var gotBody map[string]any
+	_, client := testProfileTaxonomyClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/merge") && r.Method == http.MethodPost:
+			gotPath = r.URL.Path
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+				"source_id": "scope-ops", "target_id": "scope-public",
+				"entities_updated": 3, "knowledge_updated": 5,
+			}}))
+		case r.URL.Path == "/api/taxonomy/scopes" && r.Method == http.MethodGet:
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+				{"id": "scope-public", "name": "public", "is_builtin": true, "is_active": true},
+			}}))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	model := NewProfileModel(client, &config.Config{APIKey: "test-key"})
+	model.section = 2
+	model.width = 100
+	model.setTaxonomyItems(scopeMergeFixture())
+	model.taxList.Down()
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	require.Equal(t, taxPromptMergeTarget, model.taxPromptMode)
+	assert.Contains(t, components.SanitizeText(model.View()), `Merge "ops-team" Into Scope`)
+
+	model = typeText(model, "PUBLIC")
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Nil(t, cmd)
+	require.Equal(t, taxPromptMergeConfirm, model.taxPromptMode)
+	require.NotNil(t, model.taxMergeTarget)
+	assert.Equal(t, "scope-public", model.taxMergeTarget.ID)
+	assert.Contains(t, components.SanitizeText(model.View()), "then")
+
+	// Typing is ignored while confirming.
+	model = typeText(model, "x")
+	assert.Empty(t, model.taxPromptBuf.Value)
+
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	require.NotNil(t, cmd)
+	assert.Equal(t, taxPromptNone, model.taxPromptMode)
+	assert.Nil(t, model.taxMergeSource)
+	model, cmd = model.Update(cmd())
+	require.NotNil(t, cmd)
+	assert.Equal(t, "/api/taxonomy/scopes/scope-ops/merge", gotPath)
+	assert.Equal(t, "scope-public", gotBody["target_id"])
+	assert.Equal(t, "Merged ops-team into public: 3 entities, 5 knowledge reassigned", model.taxNotice)
+
+	model, _ = model.Update(cmd())
+	assert.False(t, model.taxLoading)
+	assert.Len(t, model.taxItems, 1)
+	assert.Contains(t, components.SanitizeText(model.View()), "Merged ops-team into public")
+}
+
+func TestProfileScopeMergeRejectsInvalidTargets(t *testing.T) {
+	model := NewProfileModel(nil, &config.Config{APIKey: "test-key"})
+	model.section = 2
+	model.setTaxonomyItems(scopeMergeFixture())
+
+	// Builtin scopes cannot be merged away.
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	require.NotNil(t, cmd)
+	_, isErr := cmd().(errMsg)
+	assert.True(t, isErr)
+	assert.Equal(t, taxPromptNone, model.taxPromptMode)
+
+	model.taxList.Down()
+	for _, target := range []string{"ops-team", "legacy", "missing", " "} {
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+		require.Equal(t, taxPromptMergeTarget, model.taxPromptMode)
+		model = typeText(model, target)
+		model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		require.NotNil(t, cmd, target)
+		_, isErr = cmd().(errMsg)
+		assert.True(t, isErr, target)
+		assert.Equal(t, taxPromptNone, model.taxPromptMode)
+		assert.Nil(t, model.taxMergeSource)
+	}
+
+	// Declining the confirm step drops the pending pair.
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	model = typeText(model, "scope-public")
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.Equal(t, taxPromptMergeConfirm, model.taxPromptMode)
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	assert.Equal(t, taxPromptNone, model.taxPromptMode)
+	assert.Nil(t, model.taxMergeTarget)
+}
+
+func TestProfileScopeMergeOnlyForScopes(t *testing.T) {
+	model := NewProfileModel(nil, &config.Config{APIKey: "test-key"})
+	model.section = 2
+	model.taxKind = 1
+	model.setTaxonomyItems([]api.TaxonomyEntry{{ID: "type-1", Name: "person", IsActive: true}})
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	assert.Nil(t, cmd)
+	assert.Equal(t, taxPromptNone, model.taxPromptMode)
+}
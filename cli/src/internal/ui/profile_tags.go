@@ -0,0 +1,337 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// profileSectionTags is the Settings section index for the tag browser.
+const profileSectionTags = 3
+
+type tagsLoadedMsg struct {
+	items []api.TagUsage
+	err   error
+}
+
+type tagActionDoneMsg struct {
+	notice string
+}
+
+type tagPromptMode int
+
+const (
+	tagPromptNone tagPromptMode = iota
+	tagPromptRename
+	tagPromptMerge
+	tagPromptFilter
+	tagPromptDeleteConfirm
+)
+
+// loadTags loads tag usage for the tag browser.
+func (m ProfileModel) loadTags() tea.Msg {
+	items, err := m.client.ListTags(m.tagSearch, 500, 0)
+	return tagsLoadedMsg{items: items, err: err}
+}
+
+// enterTagsSection loads tags the first time the section is shown.
+func (m *ProfileModel) enterTagsSection() tea.Cmd {
+	if m.section != profileSectionTags || m.tagsLoaded || m.tagLoading || m.client == nil {
+		return nil
+	}
+	m.tagLoading = true
+	return m.loadTags
+}
+
+// setTagItems sets the tag rows.
+func (m *ProfileModel) setTagItems(items []api.TagUsage) {
+	m.tagItems = items
+	labels := make([]string, len(items))
+	for i, item := range items {
+		labels[i] = components.SanitizeOneLine(item.Name)
+	}
+	m.tagList.SetItems(labels)
+}
+
+// selectedTag returns the highlighted tag.
+func (m ProfileModel) selectedTag() *api.TagUsage {
+	if m.tagList == nil {
+		return nil
+	}
+	idx := m.tagList.Selected()
+	if idx < 0 || idx >= len(m.tagItems) {
+		return nil
+	}
+	item := m.tagItems[idx]
+	return &item
+}
+
+// unusedTags lists loaded tags that nothing references.
+func (m ProfileModel) unusedTags() []string {
+	names := []string{}
+	for _, item := range m.tagItems {
+		if item.Total() == 0 {
+			names = append(names, item.Name)
+		}
+	}
+	return names
+}
+
+// openTagPrompt opens a tag prompt for the selected tag.
+func (m *ProfileModel) openTagPrompt(mode tagPromptMode, defaultValue string) {
+	m.tagPromptMode = mode
+	m.tagPromptBuf.SetValue(defaultValue)
+	if mode == tagPromptRename || mode == tagPromptMerge {
+		if item := m.selectedTag(); item != nil {
+			m.tagPromptSource = item.Name
+		}
+	}
+}
+
+// closeTagPrompt clears prompt state.
+func (m *ProfileModel) closeTagPrompt() {
+	m.tagPromptMode = tagPromptNone
+	m.tagPromptBuf.Reset()
+	m.tagPromptSource = ""
+}
+
+// tagPromptTitle returns the prompt title.
+func (m ProfileModel) tagPromptTitle() string {
+	source := components.SanitizeOneLine(m.tagPromptSource)
+	switch m.tagPromptMode {
+	case tagPromptRename:
+		return fmt.Sprintf("Rename %q Everywhere To", source)
+	case tagPromptMerge:
+		return fmt.Sprintf("Merge %q Into Tag", source)
+	case tagPromptFilter:
+		return "Tag Filter"
+	default:
+		return "Tags"
+	}
+}
+
+// handleTagKeys handles keys in the tags section.
+func (m ProfileModel) handleTagKeys(msg tea.KeyMsg) (ProfileModel, tea.Cmd) {
+	switch {
+	case isEnter(msg), isKey(msg, "r"):
+		if m.selectedTag() != nil {
+			m.openTagPrompt(tagPromptRename, m.selectedTag().Name)
+		}
+	case isKey(msg, "m"):
+		if m.selectedTag() != nil {
+			m.openTagPrompt(tagPromptMerge, "")
+		}
+	case isKey(msg, "d"):
+		if len(m.unusedTags()) == 0 {
+			return m, func() tea.Msg { return errMsg{fmt.Errorf("no unused tags to delete")} }
+		}
+		m.openTagPrompt(tagPromptDeleteConfirm, "")
+	case isKey(msg, "f"):
+		m.openTagPrompt(tagPromptFilter, m.tagSearch)
+	}
+	return m, nil
+}
+
+// handleTagPrompt handles keys while a tag prompt is open.
+func (m ProfileModel) handleTagPrompt(msg tea.KeyMsg) (ProfileModel, tea.Cmd) {
+	if m.tagPromptMode == tagPromptDeleteConfirm {
+		switch {
+		case isKey(msg, "y"), isEnter(msg):
+			return m.submitTagPrompt()
+		case isKey(msg, "n"), isBack(msg):
+			m.closeTagPrompt()
+		}
+		return m, nil
+	}
+	switch {
+	case isBack(msg):
+		m.closeTagPrompt()
+		return m, nil
+	case isEnter(msg):
+		return m.submitTagPrompt()
+	default:
+		m.tagPromptBuf.HandleKey(msg)
+		return m, nil
+	}
+}
+
+// submitTagPrompt applies the open tag prompt.
+func (m ProfileModel) submitTagPrompt() (ProfileModel, tea.Cmd) {
+	mode := m.tagPromptMode
+	source := m.tagPromptSource
+	value := strings.TrimSpace(m.tagPromptBuf.Value)
+	m.closeTagPrompt()
+
+	switch mode {
+	case tagPromptFilter:
+		m.tagSearch = value
+		m.tagLoading = true
+		return m, m.loadTags
+	case tagPromptRename:
+		if value == "" || value == source {
+			return m, func() tea.Msg { return errMsg{fmt.Errorf("new tag name must differ from %q", source)} }
+		}
+		if m.hasTag(value) {
+			return m, func() tea.Msg {
+				return errMsg{fmt.Errorf("tag %q already exists; use merge (m) instead", value)}
+			}
+		}
+		return m, func() tea.Msg {
+			result, err := m.client.RenameTag(source, value)
+			if err != nil {
+				return errMsg{err}
+			}
+			return tagActionDoneMsg{notice: formatTagResult(fmt.Sprintf("Renamed %s to %s", source, value), result)}
+		}
+	case tagPromptMerge:
+		if value == "" || value == source {
+			return m, func() tea.Msg { return errMsg{fmt.Errorf("target tag must differ from %q", source)} }
+		}
+		if !m.hasTag(value) {
+			return m, func() tea.Msg { return errMsg{fmt.Errorf("tag %q not found", value)} }
+		}
+		return m, func() tea.Msg {
+			result, err := m.client.MergeTags(source, value)
+			if err != nil {
+				return errMsg{err}
+			}
+			return tagActionDoneMsg{notice: formatTagResult(fmt.Sprintf("Merged %s into %s", source, value), result)}
+		}
+	case tagPromptDeleteConfirm:
+		unused := m.unusedTags()
+		return m, func() tea.Msg {
+			result, err := m.client.DeleteUnusedTags(unused...)
+			if err != nil {
+				return errMsg{err}
+			}
+			deleted := len(unused)
+			if result != nil && result.Deleted != nil {
+				deleted = len(result.Deleted)
+			}
+			return tagActionDoneMsg{notice: fmt.Sprintf("Deleted %d unused tag(s)", deleted)}
+		}
+	}
+	return m, nil
+}
+
+// hasTag reports whether name is a loaded tag.
+func (m ProfileModel) hasTag(name string) bool {
+	for _, item := range m.tagItems {
+		if item.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// formatTagResult summarizes a bulk tag result.
+func formatTagResult(action string, result *api.TagBulkResult) string {
+	if result == nil {
+		return action
+	}
+	return fmt.Sprintf(
+		"%s: %d entities, %d knowledge, %d files updated",
+		components.SanitizeOneLine(action),
+		result.EntitiesUpdated,
+		result.KnowledgeUpdated,
+		result.FilesUpdated,
+	)
+}
+
+// renderTags renders the tag browser.
+func (m ProfileModel) renderTags() string {
+	switch m.tagPromptMode {
+	case tagPromptNone:
+	case tagPromptDeleteConfirm:
+		unused := m.unusedTags()
+		message := fmt.Sprintf(
+			"Delete %d unused tag(s)?\n%s",
+			len(unused),
+			components.SanitizeOneLine(strings.Join(unused, ", ")),
+		)
+		return components.Indent(components.ConfirmDialog("Delete Unused Tags", message), 1)
+	default:
+		return components.Indent(components.InputDialog(m.tagPromptTitle(), m.tagPromptBuf), 1)
+	}
+
+	if m.tagLoading {
+		return components.Indent(components.Box(MutedStyle.Render("Loading tags..."), m.width), 1)
+	}
+
+	if len(m.tagItems) == 0 {
+		return components.Indent(components.Box(MutedStyle.Render("No tags found."), m.width), 1)
+	}
+
+	contentWidth := components.BoxContentWidth(m.width)
+	filterText := m.tagSearch
+	if filterText == "" {
+		filterText = "-"
+	}
+	info := fmt.Sprintf(
+		"%d tags  ·  %d unused  ·  filter: %s",
+		len(m.tagItems),
+		len(m.unusedTags()),
+		filterText,
+	)
+
+	sepWidth := 1
+	if br := lipgloss.RoundedBorder().Left; br != "" {
+		sepWidth = lipgloss.Width(br)
+	}
+
+	// 5 columns -> 4 separators.
+	countWidth := 10
+	availableCols := contentWidth - (4 * sepWidth)
+	nameWidth := availableCols - (4 * countWidth)
+	if nameWidth < 14 {
+		nameWidth = 14
+	}
+
+	cols := []components.TableColumn{
+		{Header: "Tag", Width: nameWidth, Align: lipgloss.Left},
+		{Header: "Entities", Width: countWidth, Align: lipgloss.Right},
+		{Header: "Knowledge", Width: countWidth, Align: lipgloss.Right},
+		{Header: "Files", Width: countWidth, Align: lipgloss.Right},
+		{Header: "Total", Width: countWidth, Align: lipgloss.Right},
+	}
+
+	visible := m.tagList.Visible()
+	tableRows := make([][]string, 0, len(visible))
+	activeRowRel := -1
+	for i := range visible {
+		absIdx := m.tagList.RelToAbs(i)
+		if absIdx < 0 || absIdx >= len(m.tagItems) {
+			continue
+		}
+		item := m.tagItems[absIdx]
+		if m.tagList.IsSelected(absIdx) {
+			activeRowRel = len(tableRows)
+		}
+		total := fmt.Sprintf("%d", item.Total())
+		if item.Total() == 0 {
+			total = "unused"
+		}
+		tableRows = append(tableRows, []string{
+			components.ClampTextWidthEllipsis(components.SanitizeOneLine(item.Name), nameWidth),
+			fmt.Sprintf("%d", item.Entities),
+			fmt.Sprintf("%d", item.Knowledge),
+			fmt.Sprintf("%d", item.Files),
+			total,
+		})
+	}
+	if m.sectionFocus {
+		activeRowRel = -1
+	}
+
+	content := MutedStyle.Render(info) + "\n\n" +
+		components.TableGridWithActiveRow(cols, tableRows, contentWidth, activeRowRel) + "\n"
+	if m.tagNotice != "" {
+		content = SuccessStyle.Render(m.tagNotice) + "\n" + content
+	}
+	return components.Indent(components.TitledBox("Tags", content, m.width), 1)
+}
@@ -0,0 +1,172 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tagUsageFixture returns tags ordered as the server lists them.
+func tagUsageFixture() []map[string]any {
+	return []map[string]any{
+		{"name": "infra", "entities": 3, "knowledge": 2, "files": 1},
+		{"name": "ops", "entities": 1, "knowledge": 0, "files": 0},
+		{"name": "stale", "entities": 0, "knowledge": 0, "files": 0},
+	}
+}
+
+func TestProfileTagsSectionLoadsOnEnterAndRenders(t *testing.T) {
+	listCalls := 0
+	_, client := testProfileTaxonomyClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/tags", r.URL.Path)
+		listCalls++
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": tagUsageFixture()}))
+	})
+
+	model := NewProfileModel(client, &config.Config{APIKey: "test-key"})
+	model.width = 110
+	model.section = 2
+	model.sectionFocus = true
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRight})
+	assert.Equal(t, profileSectionTags, model.section)
+	require.NotNil(t, cmd)
+	assert.True(t, model.tagLoading)
+	model, _ = model.Update(cmd())
+	assert.Equal(t, 1, listCalls)
+	require.Len(t, model.tagItems, 3)
+
+	out := components.SanitizeText(model.View())
+	assert.Contains(t, out, "Tags")
+	assert.Contains(t, out, "3 tags  ·  1 unused")
+	assert.Contains(t, out, "infra")
+	assert.Contains(t, out, "unused")
+
+	// Leaving and re-entering does not refetch.
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRight})
+	assert.Nil(t, cmd)
+
+	// Right from tags moves to webhooks, then wraps to API keys.
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRight})
+	assert.Equal(t, profileSectionWebhooks, model.section)
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRight})
+	assert.Equal(t, 0, model.section)
+}
+
+func TestProfileTagsRenameMergeAndDeleteUnused(t *testing.T) {
+	bodies := map[string]map[string]any{}
+	_, client := testProfileTaxonomyClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/tags":
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": tagUsageFixture()}))
+		case "/api/tags/rename", "/api/tags/merge", "/api/tags/delete-unused":
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			bodies[r.URL.Path] = body
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+				"entities_updated": 1, "knowledge_updated": 2, "files_updated": 3, "deleted": []string{"stale"},
+			}}))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	model := NewProfileModel(client, &config.Config{APIKey: "test-key"})
+	model.section = profileSectionTags
+	model, _ = model.Update(model.loadTags())
+
+	// Rename infra everywhere.
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	require.Equal(t, tagPromptRename, model.tagPromptMode)
+	assert.Equal(t, "infra", model.tagPromptBuf.Value)
+	model = typeText(model, "structure")
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	model, cmd = model.Update(cmd())
+	require.NotNil(t, cmd)
+	assert.Equal(t, map[string]any{"from": "infra", "to": "infrastructure"}, bodies["/api/tags/rename"])
+	assert.Equal(t, "Renamed infra to infrastructure: 1 entities, 2 knowledge, 3 files updated", model.tagNotice)
+	model, _ = model.Update(cmd())
+
+	// Merge ops into infra.
+	model.tagList.Down()
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	require.Equal(t, tagPromptMerge, model.tagPromptMode)
+	model = typeText(model, "infra")
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	model, cmd = model.Update(cmd())
+	require.NotNil(t, cmd)
+	assert.Equal(t, map[string]any{"source": "ops", "target": "infra"}, bodies["/api/tags/merge"])
+	model, _ = model.Update(cmd())
+
+	// Delete unused asks first, then sends only the unused names.
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	require.Equal(t, tagPromptDeleteConfirm, model.tagPromptMode)
+	assert.Contains(t, components.SanitizeText(model.View()), "Delete 1 unused tag(s)?")
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	require.NotNil(t, cmd)
+	model, _ = model.Update(cmd())
+	assert.Equal(t, map[string]any{"tags": []any{"stale"}}, bodies["/api/tags/delete-unused"])
+	assert.Equal(t, "Deleted 1 unused tag(s)", model.tagNotice)
+}
+
+func TestProfileTagsPromptValidation(t *testing.T) {
+	model := NewProfileModel(nil, &config.Config{APIKey: "test-key"})
+	model.section = profileSectionTags
+	model.setTagItems([]api.TagUsage{{Name: "infra", Entities: 1}, {Name: "ops", Knowledge: 1}})
+
+	cases := []struct {
+		key   string
+		input string
+	}{
+		{key: "r", input: ""},              // unchanged name
+		{key: "r", input: "\b\b\b\b\bops"}, // rename onto an existing tag
+		{key: "m", input: "missing"},       // merge into an unknown tag
+		{key: "m", input: "infra"},         // merge into itself
+	}
+	for _, tc := range cases {
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(tc.key)})
+		require.NotEqual(t, tagPromptNone, model.tagPromptMode)
+		for _, ch := range tc.input {
+			if ch == '\b' {
+				model, _ = model.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+				continue
+			}
+			model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{ch}})
+		}
+		var cmd tea.Cmd
+		model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		require.NotNil(t, cmd, tc.input)
+		_, isErr := cmd().(errMsg)
+		assert.True(t, isErr, tc.input)
+		assert.Equal(t, tagPromptNone, model.tagPromptMode)
+	}
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	require.NotNil(t, cmd)
+	_, isErr := cmd().(errMsg)
+	assert.True(t, isErr, "nothing is unused")
+	assert.Equal(t, tagPromptNone, model.tagPromptMode)
+}
+
+func TestProfileTagsLoadErrorClearsLoading(t *testing.T) {
+	model := NewProfileModel(nil, &config.Config{APIKey: "test-key"})
+	model.section = profileSectionTags
+	model.tagLoading = true
+
+	model, cmd := model.Update(tagsLoadedMsg{err: assert.AnError})
+	assert.False(t, model.tagLoading)
+	assert.False(t, model.tagsLoaded)
+	require.NotNil(t, cmd)
+	_, isErr := cmd().(errMsg)
+	assert.True(t, isErr)
+}
@@ -16,7 +16,15 @@ type taxonomyLoadedMsg struct {
 	items []api.TaxonomyEntry
 }
 
-type taxonomyActionDoneMsg struct{}
+type taxonomyActionDoneMsg struct {
+	notice string
+}
+
+type scopeMergedMsg struct {
+	source string
+	target string
+	result *api.ScopeMergeResult
+}
 
 type taxonomyPromptMode int
 
@@ -27,6 +35,10 @@ const (
 	taxPromptEditName
 	taxPromptEditDescription
 	taxPromptFilter
+	taxPromptMergeTarget
+	taxPromptMergeConfirm
+	taxPromptSchema
+	taxPromptRules
 )
 
 var taxonomyKinds = []struct {
@@ -99,7 +111,7 @@ func (m ProfileModel) selectedTaxonomy() *api.TaxonomyEntry {
 // openTaxPrompt handles open tax prompt.
 func (m *ProfileModel) openTaxPrompt(mode taxonomyPromptMode, defaultValue string) {
 	m.taxPromptMode = mode
-	m.taxPromptBuf = defaultValue
+	m.taxPromptBuf.SetValue(defaultValue)
 }
 
 // taxonomyPromptTitle handles taxonomy prompt title.
@@ -115,6 +127,15 @@ func (m ProfileModel) taxonomyPromptTitle() string {
 		return "Edit Taxonomy Description (optional)"
 	case taxPromptFilter:
 		return "Taxonomy Filter"
+	case taxPromptMergeTarget:
+		if m.taxMergeSource != nil {
+			return fmt.Sprintf("Merge %q Into Scope", components.SanitizeOneLine(m.taxMergeSource.Name))
+		}
+		return "Merge Into Scope"
+	case taxPromptSchema:
+		return fmt.Sprintf("Metadata Schema for %q (key:type! key:a|b)", components.SanitizeOneLine(m.taxPendingName))
+	case taxPromptRules:
+		return fmt.Sprintf("Rules for %q (inverse:name source:type,type target:type)", components.SanitizeOneLine(m.taxPendingName))
 	default:
 		return "Taxonomy"
 	}
@@ -122,25 +143,29 @@ func (m ProfileModel) taxonomyPromptTitle() string {
 
 // handleTaxonomyPrompt handles handle taxonomy prompt.
 func (m ProfileModel) handleTaxonomyPrompt(msg tea.KeyMsg) (ProfileModel, tea.Cmd) {
+	if m.taxPromptMode == taxPromptMergeConfirm {
+		switch {
+		case isKey(msg, "y"):
+			return m.submitTaxonomyPrompt()
+		case isKey(msg, "n"):
+			msg = tea.KeyMsg{Type: tea.KeyEsc}
+		}
+	}
 	switch {
 	case isBack(msg):
 		m.taxPromptMode = taxPromptNone
-		m.taxPromptBuf = ""
+		m.taxPromptBuf.Reset()
 		m.taxPendingName = ""
 		m.taxPendingDesc = ""
 		m.taxEditID = ""
+		m.clearScopeMerge()
 		return m, nil
 	case isEnter(msg):
 		return m.submitTaxonomyPrompt()
-	case isKey(msg, "backspace"):
-		if len(m.taxPromptBuf) > 0 {
-			m.taxPromptBuf = m.taxPromptBuf[:len(m.taxPromptBuf)-1]
-		}
+	case m.taxPromptMode == taxPromptMergeConfirm:
 		return m, nil
 	default:
-		if len(msg.String()) == 1 || msg.String() == " " {
-			m.taxPromptBuf += msg.String()
-		}
+		m.taxPromptBuf.HandleKey(msg)
 		return m, nil
 	}
 }
@@ -149,24 +174,24 @@ func (m ProfileModel) handleTaxonomyPrompt(msg tea.KeyMsg) (ProfileModel, tea.Cm
 func (m ProfileModel) submitTaxonomyPrompt() (ProfileModel, tea.Cmd) {
 	switch m.taxPromptMode {
 	case taxPromptCreateName:
-		name := strings.TrimSpace(m.taxPromptBuf)
+		name := strings.TrimSpace(m.taxPromptBuf.Value)
 		if name == "" {
 			m.taxPromptMode = taxPromptNone
-			m.taxPromptBuf = ""
+			m.taxPromptBuf.Reset()
 			return m, func() tea.Msg { return errMsg{fmt.Errorf("taxonomy name required")} }
 		}
 		m.taxPendingName = name
 		m.openTaxPrompt(taxPromptCreateDescription, "")
 		return m, nil
 	case taxPromptCreateDescription:
-		desc := strings.TrimSpace(m.taxPromptBuf)
+		desc := strings.TrimSpace(m.taxPromptBuf.Value)
 		input := api.CreateTaxonomyInput{
 			Name:        m.taxPendingName,
 			Description: desc,
 		}
 		kind := m.taxonomyKindPath()
 		m.taxPromptMode = taxPromptNone
-		m.taxPromptBuf = ""
+		m.taxPromptBuf.Reset()
 		m.taxPendingName = ""
 		m.taxPendingDesc = ""
 		m.taxLoading = true
@@ -177,10 +202,10 @@ func (m ProfileModel) submitTaxonomyPrompt() (ProfileModel, tea.Cmd) {
 			return taxonomyActionDoneMsg{}
 		}
 	case taxPromptEditName:
-		name := strings.TrimSpace(m.taxPromptBuf)
+		name := strings.TrimSpace(m.taxPromptBuf.Value)
 		if name == "" {
 			m.taxPromptMode = taxPromptNone
-			m.taxPromptBuf = ""
+			m.taxPromptBuf.Reset()
 			return m, func() tea.Msg { return errMsg{fmt.Errorf("taxonomy name required")} }
 		}
 		m.taxPendingName = name
@@ -188,11 +213,11 @@ func (m ProfileModel) submitTaxonomyPrompt() (ProfileModel, tea.Cmd) {
 		return m, nil
 	case taxPromptEditDescription:
 		name := m.taxPendingName
-		desc := strings.TrimSpace(m.taxPromptBuf)
+		desc := strings.TrimSpace(m.taxPromptBuf.Value)
 		id := m.taxEditID
 		kind := m.taxonomyKindPath()
 		m.taxPromptMode = taxPromptNone
-		m.taxPromptBuf = ""
+		m.taxPromptBuf.Reset()
 		m.taxPendingName = ""
 		m.taxPendingDesc = ""
 		m.taxEditID = ""
@@ -208,16 +233,200 @@ func (m ProfileModel) submitTaxonomyPrompt() (ProfileModel, tea.Cmd) {
 			return taxonomyActionDoneMsg{}
 		}
 	case taxPromptFilter:
-		m.taxSearch = strings.TrimSpace(m.taxPromptBuf)
+		m.taxSearch = strings.TrimSpace(m.taxPromptBuf.Value)
 		m.taxPromptMode = taxPromptNone
-		m.taxPromptBuf = ""
+		m.taxPromptBuf.Reset()
 		m.taxLoading = true
 		return m, m.loadTaxonomy
+	case taxPromptMergeTarget:
+		target, err := m.resolveScopeMergeTarget(m.taxPromptBuf.Value)
+		if err != nil {
+			m.taxPromptMode = taxPromptNone
+			m.taxPromptBuf.Reset()
+			m.clearScopeMerge()
+			return m, func() tea.Msg { return errMsg{err} }
+		}
+		m.taxMergeTarget = target
+		m.openTaxPrompt(taxPromptMergeConfirm, "")
+		return m, nil
+	case taxPromptMergeConfirm:
+		if m.taxMergeSource == nil || m.taxMergeTarget == nil {
+			m.taxPromptMode = taxPromptNone
+			m.clearScopeMerge()
+			return m, nil
+		}
+		source := *m.taxMergeSource
+		target := *m.taxMergeTarget
+		m.taxPromptMode = taxPromptNone
+		m.taxPromptBuf.Reset()
+		m.clearScopeMerge()
+		m.taxLoading = true
+		return m, func() tea.Msg {
+			result, err := m.client.MergeScope(source.ID, target.ID)
+			if err != nil {
+				return errMsg{err}
+			}
+			return scopeMergedMsg{source: source.Name, target: target.Name, result: result}
+		}
+	case taxPromptSchema:
+		id := m.taxEditID
+		name := m.taxPendingName
+		kind := m.taxonomyKindPath()
+		schema, err := parseMetadataSchemaSpec(m.taxPromptBuf.Value)
+		m.taxPromptMode = taxPromptNone
+		m.taxPromptBuf.Reset()
+		m.taxPendingName = ""
+		m.taxEditID = ""
+		if err != nil {
+			return m, func() tea.Msg { return errMsg{err} }
+		}
+		m.taxLoading = true
+		return m, func() tea.Msg {
+			_, err := m.client.UpdateTaxonomy(kind, id, api.UpdateTaxonomyInput{ValueSchema: schema})
+			if err != nil {
+				return errMsg{err}
+			}
+			return taxonomyActionDoneMsg{notice: fmt.Sprintf("Updated metadata schema for %s", name)}
+		}
+	case taxPromptRules:
+		id := m.taxEditID
+		name := m.taxPendingName
+		inverse, sources, targets, err := parseRelationshipRulesSpec(m.taxPromptBuf.Value)
+		m.taxPromptMode = taxPromptNone
+		m.taxPromptBuf.Reset()
+		m.taxPendingName = ""
+		m.taxEditID = ""
+		if err != nil {
+			return m, func() tea.Msg { return errMsg{err} }
+		}
+		m.taxLoading = true
+		return m, func() tea.Msg {
+			input := api.UpdateTaxonomyInput{
+				InverseName:       &inverse,
+				SourceEntityTypes: &sources,
+				TargetEntityTypes: &targets,
+			}
+			if _, err := m.client.UpdateTaxonomy("relationship-types", id, input); err != nil {
+				return errMsg{err}
+			}
+			return taxonomyActionDoneMsg{notice: fmt.Sprintf("Updated rules for %s", name)}
+		}
 	default:
 		return m, nil
 	}
 }
 
+// startSchemaEdit opens the metadata schema prompt for the selected entity
+// type, or the rules prompt for the selected relationship type.
+func (m ProfileModel) startSchemaEdit() (ProfileModel, tea.Cmd) {
+	if m.taxonomyKindPath() == "relationship-types" {
+		return m.startRulesEdit()
+	}
+	if m.taxonomyKindPath() != "entity-types" {
+		return m, nil
+	}
+	item := m.selectedTaxonomy()
+	if item == nil {
+		return m, nil
+	}
+	schema, err := parseMetadataSchema(map[string]any(item.ValueSchema))
+	if err != nil {
+		return m, func() tea.Msg { return errMsg{fmt.Errorf("entity type %q: %w", item.Name, err)} }
+	}
+	m.taxEditID = item.ID
+	m.taxPendingName = item.Name
+	m.openTaxPrompt(taxPromptSchema, formatMetadataSchemaSpec(schema))
+	return m, nil
+}
+
+// startRulesEdit opens the inverse and allowed entity types prompt for the
+// selected relationship type.
+func (m ProfileModel) startRulesEdit() (ProfileModel, tea.Cmd) {
+	item := m.selectedTaxonomy()
+	if item == nil {
+		return m, nil
+	}
+	m.taxEditID = item.ID
+	m.taxPendingName = item.Name
+	m.openTaxPrompt(taxPromptRules, formatRelationshipRulesSpec(*item))
+	return m, nil
+}
+
+// startScopeMerge opens the merge prompt for the selected scope.
+func (m ProfileModel) startScopeMerge() (ProfileModel, tea.Cmd) {
+	if m.taxonomyKindPath() != "scopes" {
+		return m, nil
+	}
+	item := m.selectedTaxonomy()
+	if item == nil {
+		return m, nil
+	}
+	if item.IsBuiltin {
+		return m, func() tea.Msg { return errMsg{fmt.Errorf("builtin scope %q cannot be merged away", item.Name)} }
+	}
+	m.taxMergeSource = item
+	m.taxMergeTarget = nil
+	m.openTaxPrompt(taxPromptMergeTarget, "")
+	return m, nil
+}
+
+// resolveScopeMergeTarget finds the loaded scope named (or identified) by input.
+func (m ProfileModel) resolveScopeMergeTarget(input string) (*api.TaxonomyEntry, error) {
+	needle := strings.TrimSpace(input)
+	if needle == "" {
+		return nil, fmt.Errorf("target scope required")
+	}
+	for _, item := range m.taxItems {
+		if !strings.EqualFold(item.Name, needle) && item.ID != needle {
+			continue
+		}
+		if m.taxMergeSource != nil && item.ID == m.taxMergeSource.ID {
+			return nil, fmt.Errorf("cannot merge a scope into itself")
+		}
+		if !item.IsActive {
+			return nil, fmt.Errorf("target scope %q is archived", item.Name)
+		}
+		target := item
+		return &target, nil
+	}
+	return nil, fmt.Errorf("scope %q not found", needle)
+}
+
+// clearScopeMerge clears the pending merge pair.
+func (m *ProfileModel) clearScopeMerge() {
+	m.taxMergeSource = nil
+	m.taxMergeTarget = nil
+}
+
+// scopeMergeConfirmMessage describes the pending merge for the confirm dialog.
+func (m ProfileModel) scopeMergeConfirmMessage() string {
+	if m.taxMergeSource == nil || m.taxMergeTarget == nil {
+		return ""
+	}
+	return fmt.Sprintf(
+		"Move every entity and knowledge item in %q to %q, then archive %q?\nThe merge is recorded in the audit log.",
+		components.SanitizeOneLine(m.taxMergeSource.Name),
+		components.SanitizeOneLine(m.taxMergeTarget.Name),
+		components.SanitizeOneLine(m.taxMergeSource.Name),
+	)
+}
+
+// formatScopeMergeNotice summarizes a finished merge.
+func formatScopeMergeNotice(msg scopeMergedMsg) string {
+	entities, knowledge := 0, 0
+	if msg.result != nil {
+		entities = msg.result.EntitiesUpdated
+		knowledge = msg.result.KnowledgeUpdated
+	}
+	return fmt.Sprintf(
+		"Merged %s into %s: %d entities, %d knowledge reassigned",
+		components.SanitizeOneLine(msg.source),
+		components.SanitizeOneLine(msg.target),
+		entities,
+		knowledge,
+	)
+}
+
 // taxonomyArchiveSelected handles taxonomy archive selected.
 func (m ProfileModel) taxonomyArchiveSelected() (ProfileModel, tea.Cmd) {
 	item := m.selectedTaxonomy()
@@ -265,6 +474,13 @@ func (m ProfileModel) renderTaxonomy() string {
 	b.WriteString(components.CenterLine(strings.Join(kindTabs, "   "), m.width))
 	b.WriteString("\n\n")
 
+	if m.taxPromptMode == taxPromptMergeConfirm {
+		return b.String() + components.Indent(
+			components.ConfirmDialog("Merge Scope", m.scopeMergeConfirmMessage()),
+			1,
+		)
+	}
+
 	if m.taxPromptMode != taxPromptNone {
 		return b.String() + components.Indent(
 			components.InputDialog(m.taxonomyPromptTitle(), m.taxPromptBuf),
@@ -400,6 +616,9 @@ func (m ProfileModel) renderTaxonomy() string {
 	}
 
 	content := MutedStyle.Render(info) + "\n\n" + body + "\n"
+	if m.taxNotice != "" {
+		content = SuccessStyle.Render(m.taxNotice) + "\n" + content
+	}
 	title := fmt.Sprintf("%s Taxonomy", taxonomyKinds[m.taxKind].Label)
 	return b.String() + components.Indent(components.TitledBox(title, content, m.width), 1)
 }
@@ -438,6 +657,18 @@ func (m ProfileModel) renderTaxonomyPreview(item api.TaxonomyEntry, width int) s
 	if item.IsSymmetric != nil {
 		lines = append(lines, renderPreviewRow("Symmetric", fmt.Sprintf("%t", *item.IsSymmetric), width))
 	}
+	if item.InverseName != nil && strings.TrimSpace(*item.InverseName) != "" {
+		lines = append(lines, renderPreviewRow("Inverse", strings.TrimSpace(*item.InverseName), width))
+	}
+	if len(item.SourceEntityTypes) > 0 {
+		lines = append(lines, renderPreviewRow("Sources", strings.Join(item.SourceEntityTypes, ", "), width))
+	}
+	if len(item.TargetEntityTypes) > 0 {
+		lines = append(lines, renderPreviewRow("Targets", strings.Join(item.TargetEntityTypes, ", "), width))
+	}
+	if schema, err := parseMetadataSchema(map[string]any(item.ValueSchema)); err == nil && schema != nil {
+		lines = append(lines, renderPreviewRow("Schema", formatMetadataSchemaSpec(schema), width))
+	}
 	if metaPreview := metadataPreview(map[string]any(item.Metadata), 80); metaPreview != "" {
 		lines = append(lines, renderPreviewRow("Meta", metaPreview, width))
 	}
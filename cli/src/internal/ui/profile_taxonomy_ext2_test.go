@@ -16,7 +16,7 @@ import (
 func TestSubmitTaxonomyPromptEditNameEmptyReturnsError(t *testing.T) {
 	model := NewProfileModel(nil, &config.Config{})
 	model.taxPromptMode = taxPromptEditName
-	model.taxPromptBuf = "   "
+	model.taxPromptBuf.Value = "   "
 
 	updated, cmd := model.submitTaxonomyPrompt()
 	require.NotNil(t, cmd)
@@ -24,7 +24,7 @@ func TestSubmitTaxonomyPromptEditNameEmptyReturnsError(t *testing.T) {
 	require.True(t, ok)
 	assert.Contains(t, msg.err.Error(), "taxonomy name required")
 	assert.Equal(t, taxPromptNone, updated.taxPromptMode)
-	assert.Equal(t, "", updated.taxPromptBuf)
+	assert.Equal(t, "", updated.taxPromptBuf.Value)
 }
 
 func TestSubmitTaxonomyPromptCreateDescriptionCommandSuccessAndError(t *testing.T) {
@@ -52,7 +52,7 @@ func TestSubmitTaxonomyPromptCreateDescriptionCommandSuccessAndError(t *testing.
 	model := NewProfileModel(okClient, &config.Config{})
 	model.taxPromptMode = taxPromptCreateDescription
 	model.taxPendingName = "public"
-	model.taxPromptBuf = "desc"
+	model.taxPromptBuf.Value = "desc"
 	model.taxKind = 0
 
 	updated, cmd := model.submitTaxonomyPrompt()
@@ -74,7 +74,7 @@ func TestSubmitTaxonomyPromptCreateDescriptionCommandSuccessAndError(t *testing.
 	model = NewProfileModel(failingClient, &config.Config{})
 	model.taxPromptMode = taxPromptCreateDescription
 	model.taxPendingName = "private"
-	model.taxPromptBuf = "desc"
+	model.taxPromptBuf.Value = "desc"
 	model.taxKind = 0
 
 	_, cmd = model.submitTaxonomyPrompt()
@@ -118,7 +118,7 @@ func TestSubmitTaxonomyPromptEditDescriptionCommandSuccessAndError(t *testing.T)
 	model := NewProfileModel(okClient, &config.Config{})
 	model.taxPromptMode = taxPromptEditDescription
 	model.taxPendingName = "renamed"
-	model.taxPromptBuf = "updated desc"
+	model.taxPromptBuf.Value = "updated desc"
 	model.taxEditID = "scope-1"
 	model.taxKind = 0
 
@@ -145,7 +145,7 @@ func TestSubmitTaxonomyPromptEditDescriptionCommandSuccessAndError(t *testing.T)
 	model = NewProfileModel(failingClient, &config.Config{})
 	model.taxPromptMode = taxPromptEditDescription
 	model.taxPendingName = "renamed"
-	model.taxPromptBuf = "desc"
+	model.taxPromptBuf.Value = "desc"
 	model.taxEditID = "scope-1"
 	model.taxKind = 0
 
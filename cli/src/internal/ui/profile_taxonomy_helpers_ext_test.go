@@ -60,24 +60,24 @@ func TestTaxonomyPromptTitleModeMatrix(t *testing.T) {
 func TestHandleTaxonomyPromptBackspaceRuneAndBack(t *testing.T) {
 	model := NewProfileModel(nil, &config.Config{})
 	model.taxPromptMode = taxPromptCreateName
-	model.taxPromptBuf = "alxx"
+	model.taxPromptBuf.Value = "alxx"
 	model.taxPendingName = "pending"
 	model.taxPendingDesc = "desc"
 	model.taxEditID = "scope-1"
 
 	updated, cmd := model.handleTaxonomyPrompt(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "alx", updated.taxPromptBuf)
+	assert.Equal(t, "alx", updated.taxPromptBuf.Value)
 
 	updated, _ = updated.handleTaxonomyPrompt(tea.KeyMsg{Type: tea.KeySpace})
-	assert.Equal(t, "alx ", updated.taxPromptBuf)
+	assert.Equal(t, "alx ", updated.taxPromptBuf.Value)
 
 	updated, _ = updated.handleTaxonomyPrompt(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'z'}})
-	assert.Equal(t, "alx z", updated.taxPromptBuf)
+	assert.Equal(t, "alx z", updated.taxPromptBuf.Value)
 
 	updated, _ = updated.handleTaxonomyPrompt(tea.KeyMsg{Type: tea.KeyEsc})
 	assert.Equal(t, taxPromptNone, updated.taxPromptMode)
-	assert.Equal(t, "", updated.taxPromptBuf)
+	assert.Equal(t, "", updated.taxPromptBuf.Value)
 	assert.Equal(t, "", updated.taxPendingName)
 	assert.Equal(t, "", updated.taxPendingDesc)
 	assert.Equal(t, "", updated.taxEditID)
@@ -87,7 +87,7 @@ func TestSubmitTaxonomyPromptValidationAndTransitions(t *testing.T) {
 	model := NewProfileModel(nil, &config.Config{})
 
 	model.taxPromptMode = taxPromptCreateName
-	model.taxPromptBuf = "   "
+	model.taxPromptBuf.Value = "   "
 	updated, cmd := model.submitTaxonomyPrompt()
 	require.NotNil(t, cmd)
 	msg := cmd().(errMsg) //nolint:forcetypeassert
@@ -95,26 +95,26 @@ func TestSubmitTaxonomyPromptValidationAndTransitions(t *testing.T) {
 	assert.Equal(t, taxPromptNone, updated.taxPromptMode)
 
 	model.taxPromptMode = taxPromptCreateName
-	model.taxPromptBuf = "  scopes-custom  "
+	model.taxPromptBuf.Value = "  scopes-custom  "
 	updated, cmd = model.submitTaxonomyPrompt()
 	require.Nil(t, cmd)
 	assert.Equal(t, taxPromptCreateDescription, updated.taxPromptMode)
 	assert.Equal(t, "scopes-custom", updated.taxPendingName)
 
 	model.taxPromptMode = taxPromptEditName
-	model.taxPromptBuf = "  renamed  "
+	model.taxPromptBuf.Value = "  renamed  "
 	model.taxPendingDesc = "desc"
 	updated, cmd = model.submitTaxonomyPrompt()
 	require.Nil(t, cmd)
 	assert.Equal(t, taxPromptEditDescription, updated.taxPromptMode)
 	assert.Equal(t, "renamed", updated.taxPendingName)
-	assert.Equal(t, "desc", updated.taxPromptBuf)
+	assert.Equal(t, "desc", updated.taxPromptBuf.Value)
 }
 
 func TestSubmitTaxonomyPromptFilterAndDefault(t *testing.T) {
 	model := NewProfileModel(nil, &config.Config{})
 	model.taxPromptMode = taxPromptFilter
-	model.taxPromptBuf = "  private only "
+	model.taxPromptBuf.Value = "  private only "
 
 	updated, cmd := model.submitTaxonomyPrompt()
 	require.NotNil(t, cmd)
@@ -145,7 +145,7 @@ func TestRenderTaxonomyStateMessages(t *testing.T) {
 	model.taxKind = 0
 
 	model.taxPromptMode = taxPromptFilter
-	model.taxPromptBuf = "scope"
+	model.taxPromptBuf.Value = "scope"
 	out := model.renderTaxonomy()
 	assert.Contains(t, out, "Taxonomy Filter")
 
@@ -74,7 +74,7 @@ func TestProfileSetAPIKeyPersistsAndUpdatesClient(t *testing.T) {
 	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'k'}})
 	require.True(t, model.editAPIKey)
 
-	model.apiKeyBuf = "nbl_newkey"
+	model.apiKeyBuf.Value = "nbl_newkey"
 	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
 	msg := cmd()
@@ -264,14 +264,14 @@ func TestProfileHandlePendingLimitInputAndRenderAgentDetail(t *testing.T) {
 	model := NewProfileModel(nil, cfg)
 
 	model.editPendingLimit = true
-	model.pendingLimitBuf = ""
+	model.pendingLimitBuf.Value = ""
 	_, cmd := model.handlePendingLimitInput(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
 	msg := cmd()
 	_, ok := msg.(errMsg)
 	assert.True(t, ok)
 
-	model.pendingLimitBuf = "6001"
+	model.pendingLimitBuf.Value = "6001"
 	_, cmd = model.handlePendingLimitInput(tea.KeyMsg{Type: tea.KeyEnter})
 	require.NotNil(t, cmd)
 	msg = cmd()
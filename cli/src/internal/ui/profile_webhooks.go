@@ -0,0 +1,219 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/gravitrone/nebula-core/cli/internal/webhooks"
+)
+
+// profileSectionWebhooks is the Settings section index for webhook deliveries.
+const profileSectionWebhooks = 4
+
+// webhookLogLimit caps the deliveries shown in Settings.
+const webhookLogLimit = 50
+
+type webhookLogLoadedMsg struct {
+	items []webhooks.Delivery
+	err   error
+}
+
+type webhookTestDoneMsg struct {
+	deliveries []webhooks.Delivery
+}
+
+// notifyDecision fires the configured webhooks for an approval decision
+// without blocking the caller.
+func notifyDecision(action, id, notes string, item *api.Approval) {
+	webhooks.Notify(webhooks.NewEvent(action, id, notes, item))
+}
+
+// loadWebhookLog reads the newest webhook deliveries.
+func (m ProfileModel) loadWebhookLog() tea.Msg {
+	items, err := webhooks.ReadLog(webhookLogLimit)
+	return webhookLogLoadedMsg{items: items, err: err}
+}
+
+// enterWebhooksSection reloads the delivery log whenever the section is shown.
+func (m *ProfileModel) enterWebhooksSection() tea.Cmd {
+	if m.section != profileSectionWebhooks {
+		return nil
+	}
+	return m.loadWebhookLog
+}
+
+// setWebhookLog sets the delivery rows, newest first.
+func (m *ProfileModel) setWebhookLog(items []webhooks.Delivery) {
+	m.webhookLog = make([]webhooks.Delivery, 0, len(items))
+	for i := len(items) - 1; i >= 0; i-- {
+		m.webhookLog = append(m.webhookLog, items[i])
+	}
+	labels := make([]string, len(m.webhookLog))
+	for i, item := range m.webhookLog {
+		labels[i] = item.Webhook
+	}
+	m.webhookList.SetItems(labels)
+}
+
+// configuredWebhooks returns the hooks in the loaded config.
+func (m ProfileModel) configuredWebhooks() []config.Webhook {
+	if m.config == nil {
+		return nil
+	}
+	return m.config.Webhooks
+}
+
+// handleWebhookKeys handles keys in the webhooks section.
+func (m ProfileModel) handleWebhookKeys(msg tea.KeyMsg) (ProfileModel, tea.Cmd) {
+	switch {
+	case isKey(msg, "r"):
+		return m, m.loadWebhookLog
+	case isKey(msg, "x"):
+		hooks := m.configuredWebhooks()
+		if len(hooks) == 0 {
+			return m, func() tea.Msg { return errMsg{fmt.Errorf("no webhooks configured")} }
+		}
+		m.webhookNotice = fmt.Sprintf("Sending test to %d webhook(s)...", len(hooks))
+		return m, func() tea.Msg {
+			event := webhooks.NewEvent("test", "test", "", nil)
+			return webhookTestDoneMsg{deliveries: webhooks.Deliver(hooks, event)}
+		}
+	}
+	return m, nil
+}
+
+// formatWebhookTestNotice summarizes a test delivery.
+func formatWebhookTestNotice(deliveries []webhooks.Delivery) string {
+	failed := 0
+	for _, delivery := range deliveries {
+		if !delivery.OK() {
+			failed++
+		}
+	}
+	if failed == 0 {
+		return fmt.Sprintf("Test delivered to %d webhook(s)", len(deliveries))
+	}
+	return fmt.Sprintf("Test failed for %d of %d webhook(s)", failed, len(deliveries))
+}
+
+// formatWebhookEvents renders the actions a hook fires on.
+func formatWebhookEvents(hook config.Webhook) string {
+	if hook.Disabled {
+		return "disabled"
+	}
+	if len(hook.Events) == 0 {
+		return "approve, reject"
+	}
+	return strings.Join(hook.Events, ", ")
+}
+
+// renderWebhooks renders configured hooks and the delivery log.
+func (m ProfileModel) renderWebhooks() string {
+	contentWidth := components.BoxContentWidth(m.width)
+	var b strings.Builder
+	if m.webhookNotice != "" {
+		b.WriteString(SuccessStyle.Render(m.webhookNotice))
+		b.WriteString("\n")
+	}
+
+	hooks := m.configuredWebhooks()
+	if len(hooks) == 0 {
+		b.WriteString(MutedStyle.Render("No webhooks configured. Add a webhooks: list to ~/.nebula/config."))
+		b.WriteString("\n")
+	}
+	for _, hook := range hooks {
+		line := fmt.Sprintf("%s  ·  %s  ·  %s",
+			components.SanitizeOneLine(hook.Label()),
+			components.SanitizeOneLine(hook.URL),
+			formatWebhookEvents(hook),
+		)
+		if err := hook.Validate(); err != nil {
+			line += "  ·  " + ErrorStyle.Render(err.Error())
+		}
+		b.WriteString(components.ClampTextWidthEllipsis(line, contentWidth))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+
+	if len(m.webhookLog) == 0 {
+		b.WriteString(MutedStyle.Render("No deliveries yet."))
+		return components.Indent(components.TitledBox("Webhooks", b.String(), m.width), 1)
+	}
+
+	sepWidth := 1
+	if br := lipgloss.RoundedBorder().Left; br != "" {
+		sepWidth = lipgloss.Width(br)
+	}
+	timeWidth := 11
+	actionWidth := 8
+	tryWidth := 5
+	resultWidth := 18
+	hookWidth := contentWidth - timeWidth - actionWidth - tryWidth - resultWidth - (4 * sepWidth)
+	if hookWidth < 12 {
+		hookWidth = 12
+	}
+	cols := []components.TableColumn{
+		{Header: "At", Width: timeWidth, Align: lipgloss.Left},
+		{Header: "Webhook", Width: hookWidth, Align: lipgloss.Left},
+		{Header: "Action", Width: actionWidth, Align: lipgloss.Left},
+		{Header: "Tries", Width: tryWidth, Align: lipgloss.Right},
+		{Header: "Result", Width: resultWidth, Align: lipgloss.Left},
+	}
+
+	visible := m.webhookList.Visible()
+	rows := make([][]string, 0, len(visible))
+	activeRowRel := -1
+	for i := range visible {
+		absIdx := m.webhookList.RelToAbs(i)
+		if absIdx < 0 || absIdx >= len(m.webhookLog) {
+			continue
+		}
+		item := m.webhookLog[absIdx]
+		if m.webhookList.IsSelected(absIdx) {
+			activeRowRel = len(rows)
+		}
+		result := "ok"
+		if item.Status > 0 {
+			result = fmt.Sprintf("ok %d", item.Status)
+		}
+		if !item.OK() {
+			result = item.Error
+		}
+		rows = append(rows, []string{
+			formatLocalTimeCompact(item.At),
+			components.ClampTextWidthEllipsis(components.SanitizeOneLine(item.Webhook), hookWidth),
+			item.Action,
+			fmt.Sprintf("%d", item.Attempts),
+			components.ClampTextWidthEllipsis(components.SanitizeOneLine(result), resultWidth),
+		})
+	}
+	if m.sectionFocus {
+		activeRowRel = -1
+	}
+	b.WriteString(components.TableGridWithActiveRow(cols, rows, contentWidth, activeRowRel))
+	b.WriteString("\n")
+	if item := m.selectedWebhookDelivery(); item != nil && !item.OK() {
+		b.WriteString(ErrorStyle.Render(components.SanitizeOneLine(item.Error)))
+		b.WriteString("\n")
+	}
+	return components.Indent(components.TitledBox("Webhooks", b.String(), m.width), 1)
+}
+
+// selectedWebhookDelivery returns the highlighted delivery.
+func (m ProfileModel) selectedWebhookDelivery() *webhooks.Delivery {
+	if m.webhookList == nil {
+		return nil
+	}
+	idx := m.webhookList.Selected()
+	if idx < 0 || idx >= len(m.webhookLog) {
+		return nil
+	}
+	item := m.webhookLog[idx]
+	return &item
+}
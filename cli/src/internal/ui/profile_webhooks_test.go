@@ -0,0 +1,54 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/gravitrone/nebula-core/cli/internal/webhooks"
+)
+
+func TestProfileWebhooksSectionShowsDeliveryLog(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	at := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	require.NoError(t, webhooks.AppendLog(webhooks.Delivery{At: at, Webhook: "slack", Action: "approve", ApprovalID: "ap-1", Attempts: 1, Status: 200}))
+	require.NoError(t, webhooks.AppendLog(webhooks.Delivery{At: at.Add(time.Minute), Webhook: "tickets", Action: "reject", ApprovalID: "ap-2", Attempts: 3, Error: "http 502"}))
+
+	model := NewProfileModel(nil, &config.Config{
+		APIKey:   "test-key",
+		Webhooks: []config.Webhook{{Name: "slack", URL: "https://hooks.example.com/x", Events: []string{"approve"}}},
+	})
+	model.width = 110
+	model.section = profileSectionTags
+	model.sectionFocus = true
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRight})
+	assert.Equal(t, profileSectionWebhooks, model.section)
+	require.NotNil(t, cmd)
+	model, _ = model.Update(cmd())
+	require.Len(t, model.webhookLog, 2)
+	assert.Equal(t, "tickets", model.webhookLog[0].Webhook, "newest first")
+
+	model.sectionFocus = false
+	out := components.SanitizeText(model.View())
+	assert.Contains(t, out, "Webhooks")
+	assert.Contains(t, out, "hooks.example.com")
+	assert.Contains(t, out, "ok 200")
+	assert.Contains(t, out, "http 502")
+
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	require.NotNil(t, cmd)
+	assert.Contains(t, model.webhookNotice, "Sending test")
+}
+
+func TestFormatWebhookTestNotice(t *testing.T) {
+	assert.Equal(t, "Test delivered to 2 webhook(s)", formatWebhookTestNotice([]webhooks.Delivery{{}, {}}))
+	assert.Equal(t, "Test failed for 1 of 2 webhook(s)", formatWebhookTestNotice([]webhooks.Delivery{{}, {Error: "boom"}}))
+	assert.Equal(t, "disabled", formatWebhookEvents(config.Webhook{Disabled: true}))
+	assert.Equal(t, "approve, reject", formatWebhookEvents(config.Webhook{}))
+}
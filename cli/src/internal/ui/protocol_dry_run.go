@@ -0,0 +1,168 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+const protocolDryRunOutputLines = 12
+
+type protocolDryRunMsg struct {
+	name string
+	run  *api.ProtocolDryRun
+}
+
+// openDryRun switches the detail view to a dry run and asks for sample input,
+// keeping the last input used for the same protocol.
+func (m *ProtocolsModel) openDryRun() {
+	if m.dryRunFor != m.detail.Name {
+		m.dryRunInput.Reset()
+		m.dryRunFor = m.detail.Name
+	}
+	m.view = protocolsViewDryRun
+	m.dryRun = nil
+	m.dryRunLoading = false
+	m.addErr = ""
+	m.dryRunInput.Active = true
+}
+
+// runDryRun sends the sample input to the dry-run endpoint.
+func (m ProtocolsModel) runDryRun() (ProtocolsModel, tea.Cmd) {
+	sample, err := parseMetadataInput(m.dryRunInput.Buffer)
+	if err != nil {
+		m.addErr = err.Error()
+		return m, nil
+	}
+	if sample == nil {
+		sample = map[string]any{}
+	}
+	name := m.detail.Name
+	m.addErr = ""
+	m.dryRunLoading = true
+	return m, func() tea.Msg {
+		run, err := m.client.DryRunProtocol(name, api.ProtocolDryRunInput{Input: sample})
+		if err != nil {
+			return errMsg{err}
+		}
+		return protocolDryRunMsg{name: name, run: run}
+	}
+}
+
+// handleDryRunKeys edits the sample input and reruns the dry run.
+func (m ProtocolsModel) handleDryRunKeys(msg tea.KeyMsg) (ProtocolsModel, tea.Cmd) {
+	if m.dryRunInput.Active {
+		if m.dryRunInput.HandleKey(msg) {
+			m.dryRunInput.Active = false
+			return m.runDryRun()
+		}
+		return m, nil
+	}
+	if m.dryRunLoading {
+		return m, nil
+	}
+	switch {
+	case isBack(msg):
+		m.view = protocolsViewDetail
+		m.dryRun = nil
+		m.addErr = ""
+	case isKey(msg, "i", "e"):
+		m.dryRunInput.Active = true
+	case isKey(msg, "r"), isEnter(msg):
+		return m.runDryRun()
+	}
+	return m, nil
+}
+
+// renderDryRun renders the sample input editor or the dry-run result.
+func (m ProtocolsModel) renderDryRun() string {
+	if m.detail == nil {
+		return m.renderList()
+	}
+	if m.dryRunInput.Active {
+		return m.dryRunInput.Render(m.width)
+	}
+
+	sample, _ := parseMetadataInput(m.dryRunInput.Buffer)
+	keys := make([]string, 0, len(sample))
+	for key := range sample {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	rows := []components.TableRow{
+		{Label: "Protocol", Value: m.detail.Name},
+		{Label: "Input", Value: firstNonEmpty(strings.Join(keys, ", "), "None")},
+	}
+	var sections []string
+	switch run := m.dryRun; {
+	case m.dryRunLoading:
+		rows = append(rows, components.TableRow{Label: "Result", Value: "Running..."})
+	case run == nil:
+		rows = append(rows, components.TableRow{Label: "Result", Value: "Press i to enter sample input, then r to run."})
+	default:
+		rows = append(rows,
+			components.TableRow{Label: "Placeholders", Value: fmt.Sprintf("%d", len(run.Placeholders))},
+			components.TableRow{Label: "Steps", Value: fmt.Sprintf("%d", len(run.Steps))},
+		)
+		if len(run.Missing) > 0 {
+			rows = append(rows, components.TableRow{Label: "Missing", Value: strings.Join(run.Missing, ", ")})
+		}
+		if len(run.Unused) > 0 {
+			rows = append(rows, components.TableRow{Label: "Unused", Value: strings.Join(run.Unused, ", ")})
+		}
+		sections = append(sections, m.renderDryRunSteps(*run), m.renderDryRunOutput(*run))
+	}
+	sections = append([]string{components.Table("Dry Run", rows, m.width)}, sections...)
+	if m.addErr != "" {
+		sections = append(sections, ErrorStyle.Render(m.addErr))
+	}
+	return strings.Join(sections, "\n\n")
+}
+
+// renderDryRunSteps renders the steps found in the rendered protocol.
+func (m ProtocolsModel) renderDryRunSteps(run api.ProtocolDryRun) string {
+	title := fmt.Sprintf("Steps (%d)", len(run.Steps))
+	if len(run.Steps) == 0 {
+		return components.TitledBox(title, MetaKeyStyle.Render(title)+"\n\n"+MutedStyle.Render("No numbered or bulleted steps found."), m.width)
+	}
+	contentWidth := components.BoxContentWidth(m.width)
+	sectionWidth := 18
+	stepWidth := contentWidth - 4 - sectionWidth - 2
+	if stepWidth < 16 {
+		stepWidth = 16
+	}
+	cols := []components.TableColumn{
+		{Header: "#", Width: 4, Align: lipgloss.Right},
+		{Header: "Section", Width: sectionWidth, Align: lipgloss.Left},
+		{Header: "Step", Width: stepWidth, Align: lipgloss.Left},
+	}
+	rows := make([][]string, 0, len(run.Steps))
+	for _, step := range run.Steps {
+		section := "-"
+		if step.Section != nil {
+			section = components.SanitizeOneLine(*step.Section)
+		}
+		rows = append(rows, []string{
+			fmt.Sprintf("%d", step.Index),
+			components.ClampTextWidthEllipsis(section, sectionWidth),
+			components.ClampTextWidthEllipsis(components.SanitizeOneLine(step.Text), stepWidth),
+		})
+	}
+	return components.TitledBox(title, MetaKeyStyle.Render(title)+"\n\n"+components.TableGrid(cols, rows, contentWidth), m.width)
+}
+
+// renderDryRunOutput renders the head of the rendered protocol content.
+func (m ProtocolsModel) renderDryRunOutput(run api.ProtocolDryRun) string {
+	lines := renderMarkdown(run.Output, components.BoxContentWidth(m.width))
+	if len(lines) > protocolDryRunOutputLines {
+		more := len(lines) - protocolDryRunOutputLines
+		lines = append(lines[:protocolDryRunOutputLines], MutedStyle.Render(fmt.Sprintf("+%d more lines", more)))
+	}
+	return components.TitledBox("Output", MetaKeyStyle.Render("Output")+"\n\n"+strings.Join(lines, "\n"), m.width)
+}
@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtocolDryRunSendsSampleInputAndRendersSteps(t *testing.T) {
+	var body api.ProtocolDryRunInput
+	_, client := relTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/protocols/ops/dry-run", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+			"name":         "ops",
+			"output":       "# Setup\n1. Open nebula\n2. Tag {{version}}",
+			"steps":        []map[string]any{{"index": 1, "section": "Setup", "text": "Open nebula"}, {"index": 2, "section": "Setup", "text": "Tag {{version}}"}},
+			"placeholders": []string{"repo", "version"},
+			"missing":      []string{"version"},
+			"unused":       []string{},
+		}})
+	})
+
+	model := NewProtocolsModel(client)
+	model.width = 120
+	model.view = protocolsViewDetail
+	model.detail = &api.Protocol{ID: "pro-1", Name: "ops", Title: "Ops"}
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
+	require.Equal(t, protocolsViewDryRun, model.view)
+	require.True(t, model.dryRunInput.Active)
+
+	model.dryRunInput.Load(map[string]any{"repo": "nebula"})
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	require.NotNil(t, cmd)
+	assert.False(t, model.dryRunInput.Active)
+	assert.True(t, model.dryRunLoading)
+	assert.Contains(t, components.SanitizeText(model.View()), "Running...")
+
+	model, _ = model.Update(cmd())
+	assert.Equal(t, map[string]any{"repo": "nebula"}, body.Input)
+	require.NotNil(t, model.dryRun)
+	view := components.SanitizeText(model.View())
+	assert.Contains(t, view, "Steps (2)")
+	assert.Contains(t, view, "Open nebula")
+	assert.Contains(t, view, "Missing")
+	assert.Contains(t, view, "version")
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, protocolsViewDetail, model.view)
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
+	assert.Contains(t, model.dryRunInput.Buffer, "repo")
+}
+
+func TestProtocolDryRunShowsErrors(t *testing.T) {
+	_, client := relTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(map[string]any{"detail": "Not Found"})
+	})
+
+	model := NewProtocolsModel(client)
+	model.width = 120
+	model.view = protocolsViewDetail
+	model.detail = &api.Protocol{ID: "pro-1", Name: "ops"}
+	model.openDryRun()
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	require.NotNil(t, cmd)
+	model, _ = model.Update(cmd())
+	assert.False(t, model.dryRunLoading)
+	assert.NotEmpty(t, model.addErr)
+	assert.Equal(t, protocolsViewDryRun, model.view)
+}
@@ -0,0 +1,442 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+const protocolVersionHistoryLimit = 100
+
+type protocolVersionsLoadedMsg struct {
+	id      string
+	entries []api.AuditEntry
+}
+
+type protocolRolledBackMsg struct{ item api.Protocol }
+
+// protocolVersion is one saved state of a protocol, numbered from the first
+// save.
+type protocolVersion struct {
+	number int
+	entry  api.AuditEntry
+}
+
+// protocolVersionDiff is an open comparison between two protocol states.
+type protocolVersionDiff struct {
+	from string
+	to   string
+	rows []components.DiffRow
+}
+
+// protocolVersionFields are the saved fields a version shows, diffs, and
+// restores, in display order.
+var protocolVersionFields = []struct {
+	key   string
+	label string
+}{
+	{"title", "Title"},
+	{"version", "Version"},
+	{"protocol_type", "Type"},
+	{"applies_to", "Applies To"},
+	{"tags", "Tags"},
+	{"content", "Content"},
+	{"metadata", "Metadata"},
+}
+
+// buildProtocolVersions turns newest-first audit entries into versions,
+// skipping deletes that carry no saved state.
+func buildProtocolVersions(entries []api.AuditEntry) []protocolVersion {
+	saved := make([]api.AuditEntry, 0, len(entries))
+	for _, entry := range entries {
+		if len(entry.NewData) > 0 {
+			saved = append(saved, entry)
+		}
+	}
+	versions := make([]protocolVersion, len(saved))
+	for i, entry := range saved {
+		versions[i] = protocolVersion{number: len(saved) - i, entry: entry}
+	}
+	return versions
+}
+
+// label names the version for titles and dialogs.
+func (v protocolVersion) label() string {
+	label := fmt.Sprintf("v%d", v.number)
+	if tag := strings.TrimSpace(snapshotString(v.entry.NewData["version"])); tag != "" {
+		label += " (" + tag + ")"
+	}
+	return label
+}
+
+// protocolSnapshot maps the current protocol onto the saved version fields.
+func protocolSnapshot(p api.Protocol) api.JSONMap {
+	snapshot := api.JSONMap{
+		"title":      p.Title,
+		"applies_to": p.AppliesTo,
+		"tags":       p.Tags,
+		"metadata":   map[string]any(p.Metadata),
+	}
+	for key, value := range map[string]*string{"version": p.Version, "protocol_type": p.ProtocolType, "content": p.Content} {
+		if value != nil {
+			snapshot[key] = *value
+		}
+	}
+	return snapshot
+}
+
+// snapshotString reads a string field from a saved row.
+func snapshotString(value any) string {
+	if text, ok := value.(string); ok {
+		return text
+	}
+	return ""
+}
+
+// protocolVersionDiffs compares two saved states field by field.
+func protocolVersionDiffs(from, to api.JSONMap) []components.DiffRow {
+	var rows []components.DiffRow
+	for _, field := range protocolVersionFields {
+		before, after := from[field.key], to[field.key]
+		switch field.key {
+		case "content":
+			if snapshotString(before) != snapshotString(after) {
+				rows = append(rows, components.DiffRow{Label: field.label, From: snapshotString(before), To: snapshotString(after)})
+			}
+		case "metadata":
+			if formatAuditValue(before) != formatAuditValue(after) {
+				oldMeta, _ := before.(map[string]any)
+				newMeta, _ := after.(map[string]any)
+				rows = append(rows, components.DiffRow{
+					Label: field.label,
+					From:  formatAuditValue(before),
+					To:    formatAuditValue(after),
+					Old:   oldMeta,
+					New:   newMeta,
+				})
+			}
+		case "applies_to", "tags":
+			b, a := strings.Join(parseStringList(before), ", "), strings.Join(parseStringList(after), ", ")
+			if b != a {
+				rows = append(rows, components.DiffRow{Label: field.label, From: firstNonEmpty(b, "None"), To: firstNonEmpty(a, "None")})
+			}
+		default:
+			if formatAuditValue(before) != formatAuditValue(after) {
+				rows = append(rows, components.DiffRow{Label: field.label, From: formatAuditValue(before), To: formatAuditValue(after)})
+			}
+		}
+	}
+	return rows
+}
+
+// protocolRollbackInput restores every version field from a saved row.
+func protocolRollbackInput(data api.JSONMap) api.UpdateProtocolInput {
+	title := snapshotString(data["title"])
+	version := snapshotString(data["version"])
+	protocolType := snapshotString(data["protocol_type"])
+	content := snapshotString(data["content"])
+	applies := append([]string{}, parseStringList(data["applies_to"])...)
+	tags := append([]string{}, parseStringList(data["tags"])...)
+	input := api.UpdateProtocolInput{
+		Title:        &title,
+		Version:      &version,
+		ProtocolType: &protocolType,
+		Content:      &content,
+		AppliesTo:    &applies,
+		Tags:         &tags,
+	}
+	if meta, ok := data["metadata"].(map[string]any); ok {
+		input.Metadata = meta
+	}
+	return input
+}
+
+// openVersions switches the detail view to the protocol's version history.
+func (m *ProtocolsModel) openVersions() tea.Cmd {
+	m.view = protocolsViewVersions
+	m.versions = nil
+	m.versionsLoading = true
+	m.versionMark = 0
+	m.versionDiff = nil
+	m.rollbackConfirm = false
+	m.addErr = ""
+	if m.versionList == nil {
+		m.versionList = components.NewList(10)
+	}
+	m.versionList.SetItems(nil)
+	return m.loadVersions()
+}
+
+// loadVersions fetches the audit history of the open protocol.
+func (m ProtocolsModel) loadVersions() tea.Cmd {
+	if m.detail == nil {
+		return nil
+	}
+	id := m.detail.ID
+	return func() tea.Msg {
+		entries, err := m.client.GetProtocolHistory(id, protocolVersionHistoryLimit, 0)
+		if err != nil {
+			return errMsg{err}
+		}
+		return protocolVersionsLoadedMsg{id: id, entries: entries}
+	}
+}
+
+// handleVersionsLoaded lists the loaded versions, newest first.
+func (m ProtocolsModel) handleVersionsLoaded(msg protocolVersionsLoadedMsg) ProtocolsModel {
+	if m.detail == nil || m.detail.ID != msg.id {
+		return m
+	}
+	m.versionsLoading = false
+	m.versions = buildProtocolVersions(msg.entries)
+	labels := make([]string, len(m.versions))
+	for i, v := range m.versions {
+		labels[i] = v.label()
+	}
+	if m.versionList == nil {
+		m.versionList = components.NewList(10)
+	}
+	m.versionList.SetItems(labels)
+	return m
+}
+
+// selectedVersion returns the version under the cursor.
+func (m ProtocolsModel) selectedVersion() (protocolVersion, bool) {
+	if m.versionList == nil {
+		return protocolVersion{}, false
+	}
+	idx := m.versionList.Selected()
+	if idx < 0 || idx >= len(m.versions) {
+		return protocolVersion{}, false
+	}
+	return m.versions[idx], true
+}
+
+// versionByNumber finds a loaded version by its number.
+func (m ProtocolsModel) versionByNumber(number int) (protocolVersion, bool) {
+	for _, v := range m.versions {
+		if v.number == number {
+			return v, true
+		}
+	}
+	return protocolVersion{}, false
+}
+
+// handleVersionsKeys browses versions. Space marks a version to compare,
+// enter diffs, and r rolls back to the selected version.
+func (m ProtocolsModel) handleVersionsKeys(msg tea.KeyMsg) (ProtocolsModel, tea.Cmd) {
+	if m.rollbackConfirm {
+		switch {
+		case isKey(msg, "y"), isEnter(msg):
+			m.rollbackConfirm = false
+			if v, ok := m.selectedVersion(); ok {
+				cmd := m.rollbackTo(v)
+				return m, cmd
+			}
+		case isKey(msg, "n"), isBack(msg):
+			m.rollbackConfirm = false
+		}
+		return m, nil
+	}
+	if m.versionDiff != nil {
+		if isBack(msg) {
+			m.versionDiff = nil
+		}
+		return m, nil
+	}
+	switch {
+	case isBack(msg):
+		if m.versionMark != 0 {
+			m.versionMark = 0
+			return m, nil
+		}
+		m.view = protocolsViewDetail
+	case isDown(msg):
+		if m.versionList != nil {
+			m.versionList.Down()
+		}
+	case isUp(msg):
+		if m.versionList != nil {
+			m.versionList.Up()
+		}
+	case isSpace(msg):
+		if v, ok := m.selectedVersion(); ok {
+			if m.versionMark == v.number {
+				m.versionMark = 0
+			} else {
+				m.versionMark = v.number
+			}
+		}
+	case isEnter(msg), isKey(msg, "d"):
+		m.openVersionDiff()
+	case isKey(msg, "r"):
+		if _, ok := m.selectedVersion(); ok && m.detail != nil {
+			m.rollbackConfirm = true
+		}
+	}
+	return m, nil
+}
+
+// openVersionDiff compares the selected version with the marked one, or with
+// the version before it when nothing is marked.
+func (m *ProtocolsModel) openVersionDiff() {
+	v, ok := m.selectedVersion()
+	if !ok {
+		return
+	}
+	from, fromLabel := api.JSONMap{}, "empty"
+	other, hasOther := m.versionByNumber(v.number - 1)
+	if m.versionMark != 0 && m.versionMark != v.number {
+		other, hasOther = m.versionByNumber(m.versionMark)
+	}
+	to, toLabel := v.entry.NewData, v.label()
+	if hasOther {
+		from, fromLabel = other.entry.NewData, other.label()
+		if other.number > v.number {
+			from, to = to, from
+			fromLabel, toLabel = toLabel, fromLabel
+		}
+	}
+	m.versionDiff = &protocolVersionDiff{
+		from: fromLabel,
+		to:   toLabel,
+		rows: protocolVersionDiffs(from, to),
+	}
+}
+
+// rollbackTo saves the fields of v over the current protocol. The save is
+// itself recorded as a new version.
+func (m *ProtocolsModel) rollbackTo(v protocolVersion) tea.Cmd {
+	name := m.detail.Name
+	input := protocolRollbackInput(v.entry.NewData)
+	m.editSaving = true
+	return func() tea.Msg {
+		updated, err := m.client.UpdateProtocol(name, input)
+		if err != nil {
+			return errMsg{err}
+		}
+		return protocolRolledBackMsg{item: *updated}
+	}
+}
+
+// handleRolledBack shows the restored protocol and reloads its versions.
+func (m ProtocolsModel) handleRolledBack(msg protocolRolledBackMsg) (ProtocolsModel, tea.Cmd) {
+	m.editSaving = false
+	item := msg.item
+	m.detail = &item
+	m.versionMark = 0
+	m.versionsLoading = true
+	return m, tea.Batch(m.loadVersions(), m.loadProtocols)
+}
+
+// renderVersions renders the version list, an open diff, or the rollback
+// confirmation.
+func (m ProtocolsModel) renderVersions() string {
+	if m.detail == nil {
+		return m.renderList()
+	}
+	if m.rollbackConfirm {
+		if v, ok := m.selectedVersion(); ok {
+			summary := []components.TableRow{
+				{Label: "Protocol", Value: m.detail.Name},
+				{Label: "Restore", Value: fmt.Sprintf("%s from %s", v.label(), formatLocalTimeFull(v.entry.ChangedAt))},
+			}
+			diffs := protocolVersionDiffs(protocolSnapshot(*m.detail), v.entry.NewData)
+			return components.ConfirmPreviewDialog("Roll Back Protocol", summary, diffs, m.width)
+		}
+	}
+	if d := m.versionDiff; d != nil {
+		body := MutedStyle.Render("No differences.")
+		if len(d.rows) > 0 {
+			body = components.DiffView(d.from+" → "+d.to, d.rows, m.width)
+		}
+		return MetaKeyStyle.Render(d.from+" → "+d.to) + "\n\n" + body
+	}
+
+	title := "Versions - " + components.SanitizeOneLine(m.detail.Name)
+	var content string
+	switch {
+	case m.editSaving:
+		content = MutedStyle.Render("Rolling back...")
+	case m.versionsLoading:
+		content = MutedStyle.Render("Loading versions...")
+	case len(m.versions) == 0:
+		content = MutedStyle.Render("No versions recorded yet.")
+	default:
+		content = m.renderVersionTable()
+	}
+	lines := []string{MetaKeyStyle.Render(title), "", content}
+	if m.versionMark != 0 {
+		lines = append(lines, "", MutedStyle.Render(fmt.Sprintf("comparing against v%d · enter diffs · esc clears", m.versionMark)))
+	}
+	if m.addErr != "" {
+		lines = append(lines, "", ErrorStyle.Render(m.addErr))
+	}
+	return components.TitledBox(title, strings.Join(lines, "\n"), m.width)
+}
+
+// renderVersionTable renders the visible page of versions.
+func (m ProtocolsModel) renderVersionTable() string {
+	tableWidth := components.BoxContentWidth(m.width)
+	sepWidth := 1
+	if b := lipgloss.RoundedBorder().Left; b != "" {
+		sepWidth = lipgloss.Width(b)
+	}
+	// 4 columns -> 3 separators.
+	available := tableWidth - 3*sepWidth
+	versionWidth, byWidth, atWidth := 18, 16, compactTimeColumnWidth
+	changedWidth := available - versionWidth - byWidth - atWidth
+	if changedWidth < 14 {
+		changedWidth = 14
+	}
+	cols := []components.TableColumn{
+		{Header: "Version", Width: versionWidth, Align: lipgloss.Left},
+		{Header: "Changed", Width: changedWidth, Align: lipgloss.Left},
+		{Header: "By", Width: byWidth, Align: lipgloss.Left},
+		{Header: "At", Width: atWidth, Align: lipgloss.Left},
+	}
+
+	rows := [][]string{}
+	active := -1
+	for i := range m.versionList.Visible() {
+		idx := m.versionList.RelToAbs(i)
+		if idx < 0 || idx >= len(m.versions) {
+			continue
+		}
+		v := m.versions[idx]
+		label := v.label()
+		if v.number == m.versionMark {
+			label = "* " + label
+		}
+		if m.versionList.IsSelected(idx) {
+			active = len(rows)
+		}
+		rows = append(rows, []string{
+			components.ClampTextWidthEllipsis(label, versionWidth),
+			components.ClampTextWidthEllipsis(protocolVersionChanges(v.entry), changedWidth),
+			components.ClampTextWidthEllipsis(formatAuditActor(v.entry), byWidth),
+			formatLocalTimeCompact(v.entry.ChangedAt),
+		})
+	}
+	return components.TableGridWithActiveRow(cols, rows, tableWidth, active)
+}
+
+// protocolVersionChanges summarizes which version fields a save changed.
+func protocolVersionChanges(entry api.AuditEntry) string {
+	if strings.EqualFold(entry.Action, "insert") {
+		return "created"
+	}
+	var labels []string
+	for _, row := range protocolVersionDiffs(entry.OldData, entry.NewData) {
+		labels = append(labels, strings.ToLower(row.Label))
+	}
+	if len(labels) == 0 {
+		return "-"
+	}
+	return strings.Join(labels, ", ")
+}
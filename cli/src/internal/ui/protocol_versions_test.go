@@ -0,0 +1,111 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func protocolHistoryEntries() []map[string]any {
+	return []map[string]any{
+		{"id": "aud-3", "action": "update", "changed_at": "2026-03-03T10:00:00Z",
+			"old_data": map[string]any{"title": "Ops", "version": "1.1", "content": "step one\nstep two", "tags": []any{"ops"}},
+			"new_data": map[string]any{"title": "Ops Runbook", "version": "1.2", "content": "step one\nstep three", "tags": []any{"ops"}}},
+		{"id": "aud-2", "action": "update", "changed_at": "2026-03-02T10:00:00Z",
+			"old_data": map[string]any{"title": "Ops", "version": "1.0", "content": "step one", "tags": []any{"ops"}},
+			"new_data": map[string]any{"title": "Ops", "version": "1.1", "content": "step one\nstep two", "tags": []any{"ops"}}},
+		{"id": "aud-1", "action": "insert", "changed_at": "2026-03-01T10:00:00Z",
+			"new_data": map[string]any{"title": "Ops", "version": "1.0", "content": "step one", "tags": []any{"ops"}, "metadata": map[string]any{"owner": "ada"}}},
+	}
+}
+
+func TestBuildProtocolVersionsNumbersFromFirstSave(t *testing.T) {
+	versions := buildProtocolVersions([]api.AuditEntry{
+		{ID: "aud-4", Action: "delete", OldData: api.JSONMap{"title": "Ops"}},
+		{ID: "aud-2", Action: "update", NewData: api.JSONMap{"version": "1.1"}},
+		{ID: "aud-1", Action: "insert", NewData: api.JSONMap{"version": "1.0"}},
+	})
+	require.Len(t, versions, 2)
+	assert.Equal(t, 2, versions[0].number)
+	assert.Equal(t, "v2 (1.1)", versions[0].label())
+	assert.Equal(t, "v1 (1.0)", versions[1].label())
+	assert.Equal(t, "created", protocolVersionChanges(versions[1].entry))
+}
+
+func TestProtocolVersionsDiffAndRollback(t *testing.T) {
+	var update api.UpdateProtocolInput
+	_, client := relTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/audit":
+			assert.Equal(t, "protocols", r.URL.Query().Get("table"))
+			assert.Equal(t, "pro-1", r.URL.Query().Get("record_id"))
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": protocolHistoryEntries()})
+		case r.URL.Path == "/api/protocols/ops" && r.Method == http.MethodPatch:
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&update))
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "pro-1", "name": "ops", "title": "Ops", "version": "1.0"}})
+		case r.URL.Path == "/api/protocols":
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": []any{}})
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	version, content := "1.2", "step one\nstep three"
+	model := NewProtocolsModel(client)
+	model.width = 120
+	model.view = protocolsViewDetail
+	model.detail = &api.Protocol{ID: "pro-1", Name: "ops", Title: "Ops Runbook", Version: &version, Content: &content, Tags: []string{"ops"}}
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'h'}})
+	require.Equal(t, protocolsViewVersions, model.view)
+	require.NotNil(t, cmd)
+	assert.Contains(t, components.SanitizeText(model.View()), "Loading versions...")
+	model, _ = model.Update(cmd())
+	require.Len(t, model.versions, 3)
+	view := components.SanitizeText(model.View())
+	assert.Contains(t, view, "v3 (1.2)")
+	assert.Contains(t, view, "title, version, content")
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, model.versionDiff)
+	assert.Equal(t, "v2 (1.1)", model.versionDiff.from)
+	assert.Equal(t, "v3 (1.2)", model.versionDiff.to)
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Nil(t, model.versionDiff)
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
+	assert.Equal(t, 3, model.versionMark)
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+	require.NotNil(t, model.versionDiff)
+	assert.Equal(t, "v1 (1.0)", model.versionDiff.from)
+	assert.Equal(t, "v3 (1.2)", model.versionDiff.to)
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	require.True(t, model.rollbackConfirm)
+	assert.Contains(t, components.SanitizeText(model.View()), "Restore   v1 (1.0)")
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	require.NotNil(t, cmd)
+	assert.True(t, model.editSaving)
+	model, cmd = model.Update(cmd())
+	require.NotNil(t, update.Version)
+	assert.Equal(t, "1.0", *update.Version)
+	require.NotNil(t, update.Content)
+	assert.Equal(t, "step one", *update.Content)
+	assert.Equal(t, map[string]any{"owner": "ada"}, update.Metadata)
+	assert.Equal(t, protocolsViewVersions, model.view)
+	assert.Equal(t, "1.0", *model.detail.Version)
+	assert.NotNil(t, cmd)
+
+	model.versionMark = 0
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, protocolsViewDetail, model.view)
+}
@@ -3,6 +3,7 @@ package ui
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -13,7 +14,10 @@ import (
 
 // --- Messages ---
 
-type protocolsLoadedMsg struct{ items []api.Protocol }
+type protocolsLoadedMsg struct {
+	items  []api.Protocol
+	queued time.Time
+}
 type protocolCreatedMsg struct{}
 type protocolUpdatedMsg struct{}
 type protocolRelationshipsLoadedMsg struct {
@@ -28,6 +32,8 @@ const (
 	protocolsViewList
 	protocolsViewDetail
 	protocolsViewEdit
+	protocolsViewVersions
+	protocolsViewDryRun
 )
 
 const (
@@ -62,28 +68,30 @@ var protocolStatusOptions = []string{"active", "inactive"}
 // --- Protocols Model ---
 
 type ProtocolsModel struct {
-	client     *api.Client
-	list       *components.List
-	items      []api.Protocol
-	allItems   []api.Protocol
-	loading    bool
-	view       protocolsView
-	detail     *api.Protocol
-	detailRels []api.Relationship
-	modeFocus  bool
-	filtering  bool
-	searchBuf  string
-	width      int
-	height     int
+	client      *api.Client
+	list        *components.List
+	items       []api.Protocol
+	allItems    []api.Protocol
+	loading     bool
+	loadLatency time.Duration
+	view        protocolsView
+	detail      *api.Protocol
+	detailRels  []api.Relationship
+	modeFocus   bool
+	filtering   bool
+	searchBuf   components.TextInput
+	width       int
+	height      int
 
 	// add
 	addFields    []formField
 	addFocus     int
 	addStatusIdx int
 	addTags      []string
-	addTagBuf    string
+	addTagBuf    components.TextInput
+	tagOptions   []string
 	addApplies   []string
-	addApplyBuf  string
+	addApplyBuf  components.TextInput
 	addMeta      MetadataEditor
 	addSaving    bool
 	addErr       string
@@ -93,11 +101,25 @@ type ProtocolsModel struct {
 	editFocus     int
 	editStatusIdx int
 	editTags      []string
-	editTagBuf    string
+	editTagBuf    components.TextInput
 	editApplies   []string
-	editApplyBuf  string
+	editApplyBuf  components.TextInput
 	editMeta      MetadataEditor
 	editSaving    bool
+
+	// versions
+	versions        []protocolVersion
+	versionList     *components.List
+	versionsLoading bool
+	versionMark     int
+	versionDiff     *protocolVersionDiff
+	rollbackConfirm bool
+
+	// dry run
+	dryRunInput   MetadataEditor
+	dryRunFor     string
+	dryRun        *api.ProtocolDryRun
+	dryRunLoading bool
 }
 
 // NewProtocolsModel builds the protocols UI model.
@@ -139,25 +161,34 @@ func (m ProtocolsModel) Init() tea.Cmd {
 	m.detail = nil
 	m.detailRels = nil
 	m.filtering = false
-	m.searchBuf = ""
+	m.searchBuf.Reset()
 	m.modeFocus = false
 	m.addFocus = 0
 	m.addStatusIdx = statusIndex(protocolStatusOptions, "active")
 	m.addTags = nil
-	m.addTagBuf = ""
+	m.addTagBuf.Reset()
 	m.addApplies = nil
-	m.addApplyBuf = ""
+	m.addApplyBuf.Reset()
 	m.addMeta.Reset()
 	m.addSaving = false
 	m.addErr = ""
 	m.editFocus = 0
 	m.editStatusIdx = statusIndex(protocolStatusOptions, "active")
 	m.editTags = nil
-	m.editTagBuf = ""
+	m.editTagBuf.Reset()
 	m.editApplies = nil
-	m.editApplyBuf = ""
+	m.editApplyBuf.Reset()
 	m.editMeta.Reset()
 	m.editSaving = false
+	m.versions = nil
+	m.versionsLoading = false
+	m.versionMark = 0
+	m.versionDiff = nil
+	m.rollbackConfirm = false
+	m.dryRunInput.Reset()
+	m.dryRunFor = ""
+	m.dryRun = nil
+	m.dryRunLoading = false
 	return m.loadProtocols
 }
 
@@ -166,6 +197,7 @@ func (m ProtocolsModel) Update(msg tea.Msg) (ProtocolsModel, tea.Cmd) {
 	switch msg := msg.(type) {
 	case protocolsLoadedMsg:
 		m.loading = false
+		m.loadLatency = loadElapsed(msg.queued)
 		m.allItems = msg.items
 		m.applySearch()
 		return m, nil
@@ -186,8 +218,20 @@ func (m ProtocolsModel) Update(msg tea.Msg) (ProtocolsModel, tea.Cmd) {
 			m.detailRels = msg.relationships
 		}
 		return m, nil
+	case protocolVersionsLoadedMsg:
+		return m.handleVersionsLoaded(msg), nil
+	case protocolRolledBackMsg:
+		return m.handleRolledBack(msg)
+	case protocolDryRunMsg:
+		if m.detail != nil && m.detail.Name == msg.name {
+			m.dryRunLoading = false
+			m.dryRun = msg.run
+		}
+		return m, nil
 	case errMsg:
 		m.loading = false
+		m.versionsLoading = false
+		m.dryRunLoading = false
 		m.addSaving = false
 		m.editSaving = false
 		m.addErr = msg.err.Error()
@@ -204,6 +248,10 @@ func (m ProtocolsModel) Update(msg tea.Msg) (ProtocolsModel, tea.Cmd) {
 			return m.handleDetailKeys(msg)
 		case protocolsViewEdit:
 			return m.handleEditKeys(msg)
+		case protocolsViewVersions:
+			return m.handleVersionsKeys(msg)
+		case protocolsViewDryRun:
+			return m.handleDryRunKeys(msg)
 		default:
 			return m.handleListKeys(msg)
 		}
@@ -232,6 +280,10 @@ func (m ProtocolsModel) View() string {
 		return components.Indent(body, 1)
 	case protocolsViewDetail:
 		return components.Indent(m.renderDetail(), 1)
+	case protocolsViewVersions:
+		return components.Indent(m.renderVersions(), 1)
+	case protocolsViewDryRun:
+		return components.Indent(m.renderDryRun(), 1)
 	case protocolsViewEdit:
 		body := m.renderEdit()
 		mode := m.renderModeLine()
@@ -252,16 +304,17 @@ func (m ProtocolsModel) View() string {
 // --- Loading ---
 
 func (m ProtocolsModel) loadProtocols() tea.Msg {
+	queued := time.Now()
 	items, err := m.client.QueryProtocols(api.QueryParams{"status_category": "active"})
 	if err != nil {
-		return errMsg{err}
+		return loadFailed(tabProtocols, err, m.loadProtocols)
 	}
-	return protocolsLoadedMsg{items: items}
+	return protocolsLoadedMsg{items: items, queued: queued}
 }
 
 // applySearch handles apply search.
 func (m *ProtocolsModel) applySearch() {
-	query := strings.TrimSpace(strings.ToLower(m.searchBuf))
+	query := strings.TrimSpace(strings.ToLower(m.searchBuf.Value))
 	if query == "" {
 		m.items = append([]api.Protocol{}, m.allItems...)
 	} else {
@@ -368,13 +421,13 @@ func (m ProtocolsModel) handleListKeys(msg tea.KeyMsg) (ProtocolsModel, tea.Cmd)
 		m.filtering = true
 		return m, nil
 	case isKey(msg, "backspace", "delete"):
-		if len(m.searchBuf) > 0 {
-			m.searchBuf = m.searchBuf[:len(m.searchBuf)-1]
+		if m.searchBuf.Value != "" {
+			m.searchBuf.Backspace()
 			m.applySearch()
 		}
 	default:
-		if len(msg.String()) == 1 {
-			m.searchBuf += msg.String()
+		if text, ok := typedText(msg); ok {
+			m.searchBuf.Insert(text)
 			m.applySearch()
 		}
 	}
@@ -388,16 +441,10 @@ func (m ProtocolsModel) handleFilterInput(msg tea.KeyMsg) (ProtocolsModel, tea.C
 		m.filtering = false
 	case isBack(msg):
 		m.filtering = false
-		m.searchBuf = ""
+		m.searchBuf.Reset()
 		m.applySearch()
-	case isKey(msg, "backspace", "delete"):
-		if len(m.searchBuf) > 0 {
-			m.searchBuf = m.searchBuf[:len(m.searchBuf)-1]
-			m.applySearch()
-		}
 	default:
-		if len(msg.String()) == 1 || msg.String() == " " {
-			m.searchBuf += msg.String()
+		if editChanged(&m.searchBuf, msg) {
 			m.applySearch()
 		}
 	}
@@ -407,8 +454,13 @@ func (m ProtocolsModel) handleFilterInput(msg tea.KeyMsg) (ProtocolsModel, tea.C
 // renderList renders render list.
 func (m ProtocolsModel) renderList() string {
 	if m.loading {
-		return components.CenterLine("Loading protocols...", m.width)
+		return renderLoadingList("protocols", len(m.items), m.list, m.width, m.renderListBody)
 	}
+	return m.renderListBody()
+}
+
+// renderListBody renders the list without the loading state.
+func (m ProtocolsModel) renderListBody() string {
 	if len(m.items) == 0 {
 		return components.EmptyStateBox(
 			"Protocols",
@@ -507,10 +559,10 @@ func (m ProtocolsModel) renderList() string {
 
 	title := "Protocols"
 	countLine := fmt.Sprintf("%d total", len(m.items))
-	if strings.TrimSpace(m.searchBuf) != "" {
-		countLine = fmt.Sprintf("%s · search: %s", countLine, strings.TrimSpace(m.searchBuf))
+	if strings.TrimSpace(m.searchBuf.Value) != "" {
+		countLine = fmt.Sprintf("%s · search: %s", countLine, strings.TrimSpace(m.searchBuf.Value))
 	}
-	countLine = MutedStyle.Render(countLine)
+	countLine = MutedStyle.Render(countLine) + renderLoadLatency(m.loadLatency)
 
 	table := components.TableGridWithActiveRow(cols, tableRows, tableWidth, activeRowRel)
 	preview := ""
@@ -597,6 +649,10 @@ func (m ProtocolsModel) handleDetailKeys(msg tea.KeyMsg) (ProtocolsModel, tea.Cm
 	case isKey(msg, "e"):
 		m.startEdit()
 		m.view = protocolsViewEdit
+	case isKey(msg, "h"):
+		return m, m.openVersions()
+	case isKey(msg, "t"):
+		m.openDryRun()
 	}
 	return m, nil
 }
@@ -708,16 +764,7 @@ func (m ProtocolsModel) handleAddKeys(msg tea.KeyMsg) (ProtocolsModel, tea.Cmd)
 		}
 	}
 
-	if len(msg.String()) == 1 {
-		m.addFields[m.addFocus].value += msg.String()
-		return m, nil
-	}
-	if isKey(msg, "backspace", "delete") {
-		v := m.addFields[m.addFocus].value
-		if v != "" {
-			m.addFields[m.addFocus].value = v[:len(v)-1]
-		}
-	}
+	m.addFields[m.addFocus].HandleKey(msg)
 	return m, nil
 }
 
@@ -730,13 +777,13 @@ func (m ProtocolsModel) renderAdd() string {
 		case protoFieldStatus:
 			value = protocolStatusOptions[m.addStatusIdx]
 		case protoFieldTags:
-			value = m.renderTags(m.addTags, m.addTagBuf)
+			value = m.renderTags(m.addTags, m.addTagBuf, i == m.addFocus)
 		case protoFieldApplies:
-			value = m.renderApplies(m.addApplies, m.addApplyBuf)
+			value = m.renderApplies(m.addApplies, m.addApplyBuf, i == m.addFocus)
 		case protoFieldMetadata:
 			value = renderMetadataEditorPreview(m.addMeta.Buffer, m.addMeta.Scopes, m.width, 6)
 		default:
-			value = formatFormValue(f.value, i == m.addFocus)
+			value = formatFormValue(f.TextInput, i == m.addFocus)
 		}
 		rows = append(rows, [2]string{f.label, value})
 	}
@@ -749,17 +796,17 @@ func (m ProtocolsModel) renderAdd() string {
 
 // saveAdd handles save add.
 func (m ProtocolsModel) saveAdd() (ProtocolsModel, tea.Cmd) {
-	name := strings.TrimSpace(m.addFields[protoFieldName].value)
+	name := strings.TrimSpace(m.addFields[protoFieldName].Value)
 	if name == "" {
 		m.addErr = "Name is required"
 		return m, nil
 	}
-	title := strings.TrimSpace(m.addFields[protoFieldTitle].value)
+	title := strings.TrimSpace(m.addFields[protoFieldTitle].Value)
 	if title == "" {
 		m.addErr = "Title is required"
 		return m, nil
 	}
-	content := strings.TrimSpace(m.addFields[protoFieldContent].value)
+	content := strings.TrimSpace(m.addFields[protoFieldContent].Value)
 	if content == "" {
 		m.addErr = "Content is required"
 		return m, nil
@@ -778,14 +825,14 @@ func (m ProtocolsModel) saveAdd() (ProtocolsModel, tea.Cmd) {
 	input := api.CreateProtocolInput{
 		Name:         name,
 		Title:        title,
-		Version:      strings.TrimSpace(m.addFields[protoFieldVersion].value),
+		Version:      strings.TrimSpace(m.addFields[protoFieldVersion].Value),
 		Content:      content,
-		ProtocolType: strings.TrimSpace(m.addFields[protoFieldType].value),
+		ProtocolType: strings.TrimSpace(m.addFields[protoFieldType].Value),
 		AppliesTo:    append([]string{}, m.addApplies...),
 		Status:       protocolStatusOptions[m.addStatusIdx],
 		Tags:         append([]string{}, m.addTags...),
 		Metadata:     meta,
-		SourcePath:   stringPtr(strings.TrimSpace(m.addFields[protoFieldSourcePath].value)),
+		SourcePath:   stringPtr(strings.TrimSpace(m.addFields[protoFieldSourcePath].Value)),
 	}
 	m.addSaving = true
 	return m, func() tea.Msg {
@@ -804,23 +851,23 @@ func (m ProtocolsModel) startEdit() {
 	}
 	p := m.detail
 	for i := range m.editFields {
-		m.editFields[i].value = ""
+		m.editFields[i].Reset()
 	}
-	m.editFields[protoEditFieldTitle].value = p.Title
+	m.editFields[protoEditFieldTitle].SetValue(p.Title)
 	if p.Version != nil {
-		m.editFields[protoEditFieldVersion].value = *p.Version
+		m.editFields[protoEditFieldVersion].SetValue(*p.Version)
 	}
 	if p.ProtocolType != nil {
-		m.editFields[protoEditFieldType].value = *p.ProtocolType
+		m.editFields[protoEditFieldType].SetValue(*p.ProtocolType)
 	}
 	m.editApplies = append([]string{}, p.AppliesTo...)
 	m.editTags = append([]string{}, p.Tags...)
-	m.editFields[protoEditFieldContent].value = ""
+	m.editFields[protoEditFieldContent].Reset()
 	if p.Content != nil {
-		m.editFields[protoEditFieldContent].value = *p.Content
+		m.editFields[protoEditFieldContent].SetValue(*p.Content)
 	}
 	if p.SourcePath != nil {
-		m.editFields[protoEditFieldSourcePath].value = *p.SourcePath
+		m.editFields[protoEditFieldSourcePath].SetValue(*p.SourcePath)
 	}
 	m.editStatusIdx = statusIndex(protocolStatusOptions, p.Status)
 	m.editMeta.Load(map[string]any(p.Metadata))
@@ -872,16 +919,7 @@ func (m ProtocolsModel) handleEditKeys(msg tea.KeyMsg) (ProtocolsModel, tea.Cmd)
 			return m, nil
 		}
 	}
-	if len(msg.String()) == 1 {
-		m.editFields[m.editFocus].value += msg.String()
-		return m, nil
-	}
-	if isKey(msg, "backspace", "delete") {
-		v := m.editFields[m.editFocus].value
-		if v != "" {
-			m.editFields[m.editFocus].value = v[:len(v)-1]
-		}
-	}
+	m.editFields[m.editFocus].HandleKey(msg)
 	return m, nil
 }
 
@@ -894,13 +932,13 @@ func (m ProtocolsModel) renderEdit() string {
 		case protoEditFieldStatus:
 			value = protocolStatusOptions[m.editStatusIdx]
 		case protoEditFieldTags:
-			value = m.renderTags(m.editTags, m.editTagBuf)
+			value = m.renderTags(m.editTags, m.editTagBuf, i == m.editFocus)
 		case protoEditFieldApplies:
-			value = m.renderApplies(m.editApplies, m.editApplyBuf)
+			value = m.renderApplies(m.editApplies, m.editApplyBuf, i == m.editFocus)
 		case protoEditFieldMetadata:
 			value = renderMetadataEditorPreview(m.editMeta.Buffer, m.editMeta.Scopes, m.width, 6)
 		default:
-			value = formatFormValue(f.value, i == m.editFocus)
+			value = formatFormValue(f.TextInput, i == m.editFocus)
 		}
 		rows = append(rows, [2]string{f.label, value})
 	}
@@ -922,15 +960,15 @@ func (m ProtocolsModel) saveEdit() (ProtocolsModel, tea.Cmd) {
 	meta = mergeMetadataScopes(meta, m.editMeta.Scopes)
 
 	input := api.UpdateProtocolInput{
-		Title:        stringPtr(strings.TrimSpace(m.editFields[protoEditFieldTitle].value)),
-		Version:      stringPtr(strings.TrimSpace(m.editFields[protoEditFieldVersion].value)),
-		Content:      stringPtr(strings.TrimSpace(m.editFields[protoEditFieldContent].value)),
-		ProtocolType: stringPtr(strings.TrimSpace(m.editFields[protoEditFieldType].value)),
+		Title:        stringPtr(strings.TrimSpace(m.editFields[protoEditFieldTitle].Value)),
+		Version:      stringPtr(strings.TrimSpace(m.editFields[protoEditFieldVersion].Value)),
+		Content:      stringPtr(strings.TrimSpace(m.editFields[protoEditFieldContent].Value)),
+		ProtocolType: stringPtr(strings.TrimSpace(m.editFields[protoEditFieldType].Value)),
 		AppliesTo:    slicePtr(m.editApplies),
 		Status:       stringPtr(protocolStatusOptions[m.editStatusIdx]),
 		Tags:         slicePtr(m.editTags),
 		Metadata:     meta,
-		SourcePath:   stringPtr(strings.TrimSpace(m.editFields[protoEditFieldSourcePath].value)),
+		SourcePath:   stringPtr(strings.TrimSpace(m.editFields[protoEditFieldSourcePath].Value)),
 	}
 
 	m.editSaving = true
@@ -945,55 +983,60 @@ func (m ProtocolsModel) saveEdit() (ProtocolsModel, tea.Cmd) {
 
 // --- Helpers ---
 
-func (m ProtocolsModel) renderTags(tags []string, buf string) string {
-	out := append([]string{}, tags...)
-	if strings.TrimSpace(buf) != "" {
-		out = append(out, buf)
-	}
-	return strings.Join(out, ", ")
+func (m ProtocolsModel) renderTags(tags []string, buf components.TextInput, focused bool) string {
+	return renderListInput(tags, buf, focused) + renderTagSuggestions(m.tagOptions, buf.Value, tags)
 }
 
 // renderApplies renders render applies.
-func (m ProtocolsModel) renderApplies(items []string, buf string) string {
+func (m ProtocolsModel) renderApplies(items []string, buf components.TextInput, focused bool) string {
+	return renderListInput(items, buf, focused)
+}
+
+// renderListInput joins the committed items and the pending input, which
+// shows its cursor when focused.
+func renderListInput(items []string, buf components.TextInput, focused bool) string {
 	out := append([]string{}, items...)
-	if strings.TrimSpace(buf) != "" {
-		out = append(out, buf)
+	switch {
+	case focused:
+		out = append(out, buf.View(AccentStyle, 0))
+	case strings.TrimSpace(buf.Value) != "":
+		out = append(out, buf.Value)
 	}
 	return strings.Join(out, ", ")
 }
 
 // commitTag handles commit tag.
 func (m *ProtocolsModel) commitTag(addMode bool) {
-	buf := strings.TrimSpace(m.addTagBuf)
+	buf := strings.TrimSpace(m.addTagBuf.Value)
 	if !addMode {
-		buf = strings.TrimSpace(m.editTagBuf)
+		buf = strings.TrimSpace(m.editTagBuf.Value)
 	}
 	if buf == "" {
 		return
 	}
-	tag := normalizeTag(buf)
+	tag := canonicalTag(m.tagOptions, normalizeTag(buf))
 	if tag == "" {
 		if addMode {
-			m.addTagBuf = ""
+			m.addTagBuf.Reset()
 		} else {
-			m.editTagBuf = ""
+			m.editTagBuf.Reset()
 		}
 		return
 	}
 	if addMode {
 		m.addTags = append(m.addTags, tag)
-		m.addTagBuf = ""
+		m.addTagBuf.Reset()
 	} else {
 		m.editTags = append(m.editTags, tag)
-		m.editTagBuf = ""
+		m.editTagBuf.Reset()
 	}
 }
 
 // commitApply handles commit apply.
 func (m *ProtocolsModel) commitApply(addMode bool) {
-	buf := strings.TrimSpace(m.addApplyBuf)
+	buf := strings.TrimSpace(m.addApplyBuf.Value)
 	if !addMode {
-		buf = strings.TrimSpace(m.editApplyBuf)
+		buf = strings.TrimSpace(m.editApplyBuf.Value)
 	}
 	if buf == "" {
 		return
@@ -1001,65 +1044,43 @@ func (m *ProtocolsModel) commitApply(addMode bool) {
 	item := strings.TrimSpace(buf)
 	if addMode {
 		m.addApplies = append(m.addApplies, item)
-		m.addApplyBuf = ""
+		m.addApplyBuf.Reset()
 	} else {
 		m.editApplies = append(m.editApplies, item)
-		m.editApplyBuf = ""
+		m.editApplyBuf.Reset()
 	}
 }
 
 // handleTagInput handles handle tag input.
 func (m ProtocolsModel) handleTagInput(msg tea.KeyMsg, addMode bool) (ProtocolsModel, tea.Cmd) {
-	if isKey(msg, "backspace", "delete") {
-		if addMode {
-			if len(m.addTagBuf) > 0 {
-				m.addTagBuf = m.addTagBuf[:len(m.addTagBuf)-1]
-			}
-		} else {
-			if len(m.editTagBuf) > 0 {
-				m.editTagBuf = m.editTagBuf[:len(m.editTagBuf)-1]
-			}
-		}
-		return m, nil
+	buf, tags := &m.editTagBuf, m.editTags
+	if addMode {
+		buf, tags = &m.addTagBuf, m.addTags
 	}
-	if isKey(msg, "enter", ",", " ") {
+	switch {
+	case pasteList(buf, msg, func() { m.commitTag(addMode) }):
+	case isKey(msg, "enter", ",", " "):
 		m.commitTag(addMode)
-		return m, nil
-	}
-	if len(msg.String()) == 1 {
-		if addMode {
-			m.addTagBuf += msg.String()
-		} else {
-			m.editTagBuf += msg.String()
-		}
+	case isKey(msg, "tab"):
+		buf.SetValue(completeTag(m.tagOptions, buf.Value, tags))
+	default:
+		buf.HandleKey(msg)
 	}
 	return m, nil
 }
 
 // handleApplyInput handles handle apply input.
 func (m ProtocolsModel) handleApplyInput(msg tea.KeyMsg, addMode bool) (ProtocolsModel, tea.Cmd) {
-	if isKey(msg, "backspace", "delete") {
-		if addMode {
-			if len(m.addApplyBuf) > 0 {
-				m.addApplyBuf = m.addApplyBuf[:len(m.addApplyBuf)-1]
-			}
-		} else {
-			if len(m.editApplyBuf) > 0 {
-				m.editApplyBuf = m.editApplyBuf[:len(m.editApplyBuf)-1]
-			}
-		}
-		return m, nil
+	buf := &m.editApplyBuf
+	if addMode {
+		buf = &m.addApplyBuf
 	}
-	if isKey(msg, "enter", ",", " ") {
+	switch {
+	case pasteList(buf, msg, func() { m.commitApply(addMode) }):
+	case isKey(msg, "enter", ",", " "):
 		m.commitApply(addMode)
-		return m, nil
-	}
-	if len(msg.String()) == 1 {
-		if addMode {
-			m.addApplyBuf += msg.String()
-		} else {
-			m.editApplyBuf += msg.String()
-		}
+	default:
+		buf.HandleKey(msg)
 	}
 	return m, nil
 }
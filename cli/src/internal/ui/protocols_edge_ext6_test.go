@@ -44,10 +44,10 @@ func TestProtocolsRenderPreviewAddAndEditEdgeBranches(t *testing.T) {
 
 	t.Run("startEdit is no-op when detail is nil", func(t *testing.T) {
 		model := NewProtocolsModel(nil)
-		model.editFields[protoEditFieldTitle].value = "keep"
+		model.editFields[protoEditFieldTitle].Value = "keep"
 
 		model.startEdit()
-		assert.Equal(t, "keep", model.editFields[protoEditFieldTitle].value)
+		assert.Equal(t, "keep", model.editFields[protoEditFieldTitle].Value)
 	})
 }
 
@@ -68,9 +68,9 @@ func TestProtocolsSaveErrorBranches(t *testing.T) {
 		})
 
 		model := NewProtocolsModel(client)
-		model.addFields[protoFieldName].value = "alpha"
-		model.addFields[protoFieldTitle].value = "Alpha"
-		model.addFields[protoFieldContent].value = "rules"
+		model.addFields[protoFieldName].Value = "alpha"
+		model.addFields[protoFieldTitle].Value = "Alpha"
+		model.addFields[protoFieldContent].Value = "rules"
 
 		next, cmd := model.saveAdd()
 		require.NotNil(t, cmd)
@@ -103,8 +103,8 @@ func TestProtocolsSaveErrorBranches(t *testing.T) {
 			Title:  "Alpha",
 			Status: "active",
 		}
-		model.editFields[protoEditFieldTitle].value = "Alpha"
-		model.editFields[protoEditFieldContent].value = "rules"
+		model.editFields[protoEditFieldTitle].Value = "Alpha"
+		model.editFields[protoEditFieldContent].Value = "rules"
 		model.editStatusIdx = 0
 
 		next, cmd := model.saveEdit()
@@ -68,9 +68,9 @@ func TestProtocolsHandleListKeysBranches(t *testing.T) {
 	assert.Equal(t, "proto-1", updated.detail.ID)
 
 	updated.view = protocolsViewList
-	updated.searchBuf = "a"
+	updated.searchBuf.Value = "a"
 	updated, _ = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 
 	updated, _ = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
 	assert.True(t, updated.filtering)
@@ -91,21 +91,21 @@ func TestProtocolsHandleFilterInputBranches(t *testing.T) {
 
 	updated, cmd := model.handleFilterInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
 	require.Nil(t, cmd)
-	assert.Equal(t, "a", updated.searchBuf)
+	assert.Equal(t, "a", updated.searchBuf.Value)
 
 	updated, _ = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 
-	updated.searchBuf = "abc"
+	updated.searchBuf.Value = "abc"
 	updated, _ = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyEsc})
 	assert.False(t, updated.filtering)
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 
 	updated.filtering = true
-	updated.searchBuf = "xy"
+	updated.searchBuf.Value = "xy"
 	updated, _ = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyEnter})
 	assert.False(t, updated.filtering)
-	assert.Equal(t, "xy", updated.searchBuf)
+	assert.Equal(t, "xy", updated.searchBuf.Value)
 }
 
 func TestProtocolsHandleAddKeysStatusTagsApplyMetadataAndBack(t *testing.T) {
@@ -138,9 +138,9 @@ func TestProtocolsHandleAddKeysStatusTagsApplyMetadataAndBack(t *testing.T) {
 	updated.addMeta.Active = false
 
 	updated.addFocus = protoFieldName
-	updated.addFields[protoFieldName].value = "ab"
+	updated.addFields[protoFieldName].Value = "ab"
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "a", updated.addFields[protoFieldName].value)
+	assert.Equal(t, "a", updated.addFields[protoFieldName].Value)
 
 	updated, _ = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyEsc})
 	assert.Equal(t, protocolsViewList, updated.view)
@@ -174,9 +174,9 @@ func TestProtocolsHandleEditKeysStatusTagsApplyMetadataAndBack(t *testing.T) {
 	updated.editMeta.Active = false
 
 	updated.editFocus = protoEditFieldTitle
-	updated.editFields[protoEditFieldTitle].value = "ab"
+	updated.editFields[protoEditFieldTitle].Value = "ab"
 	updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyBackspace})
-	assert.Equal(t, "a", updated.editFields[protoEditFieldTitle].value)
+	assert.Equal(t, "a", updated.editFields[protoEditFieldTitle].Value)
 
 	updated, _ = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyEsc})
 	assert.Equal(t, protocolsViewDetail, updated.view)
@@ -187,25 +187,25 @@ func TestProtocolsTagAndApplyInputHelpers(t *testing.T) {
 
 	updated, cmd := model.handleTagInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'A'}}, true)
 	require.Nil(t, cmd)
-	assert.Equal(t, "A", updated.addTagBuf)
+	assert.Equal(t, "A", updated.addTagBuf.Value)
 
 	updated, _ = updated.handleTagInput(tea.KeyMsg{Type: tea.KeyBackspace}, true)
-	assert.Equal(t, "", updated.addTagBuf)
+	assert.Equal(t, "", updated.addTagBuf.Value)
 
-	updated.addTagBuf = "#Tag"
+	updated.addTagBuf.Value = "#Tag"
 	updated, _ = updated.handleTagInput(tea.KeyMsg{Type: tea.KeyEnter}, true)
 	assert.Equal(t, []string{"tag"}, updated.addTags)
 
-	updated.editTagBuf = "edit-tag"
+	updated.editTagBuf.Value = "edit-tag"
 	updated, _ = updated.handleTagInput(tea.KeyMsg{Type: tea.KeyEnter}, false)
 	assert.Equal(t, []string{"edit-tag"}, updated.editTags)
 
 	updated, _ = updated.handleApplyInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}}, true)
-	assert.Equal(t, "e", updated.addApplyBuf)
+	assert.Equal(t, "e", updated.addApplyBuf.Value)
 	updated, _ = updated.handleApplyInput(tea.KeyMsg{Type: tea.KeyEnter}, true)
 	assert.Equal(t, []string{"e"}, updated.addApplies)
 
-	updated.editApplyBuf = "job"
+	updated.editApplyBuf.Value = "job"
 	updated, _ = updated.handleApplyInput(tea.KeyMsg{Type: tea.KeyEnter}, false)
 	assert.Equal(t, []string{"job"}, updated.editApplies)
 }
@@ -213,25 +213,25 @@ func TestProtocolsTagAndApplyInputHelpers(t *testing.T) {
 func TestProtocolsCommitTagEmptyAndNormalizeBranches(t *testing.T) {
 	model := NewProtocolsModel(nil)
 
-	model.addTagBuf = "   "
+	model.addTagBuf.Value = "   "
 	model.commitTag(true)
 	assert.Empty(t, model.addTags)
-	assert.Equal(t, "   ", model.addTagBuf)
+	assert.Equal(t, "   ", model.addTagBuf.Value)
 
-	model.addTagBuf = "#"
+	model.addTagBuf.Value = "#"
 	model.commitTag(true)
 	assert.Empty(t, model.addTags)
-	assert.Equal(t, "", model.addTagBuf)
+	assert.Equal(t, "", model.addTagBuf.Value)
 
-	model.editTagBuf = "  Mixed Case Tag  "
+	model.editTagBuf.Value = "  Mixed Case Tag  "
 	model.commitTag(false)
 	assert.Equal(t, []string{"mixed-case-tag"}, model.editTags)
-	assert.Equal(t, "", model.editTagBuf)
+	assert.Equal(t, "", model.editTagBuf.Value)
 
-	model.editTagBuf = "#"
+	model.editTagBuf.Value = "#"
 	model.commitTag(false)
 	assert.Equal(t, []string{"mixed-case-tag"}, model.editTags)
-	assert.Equal(t, "", model.editTagBuf)
+	assert.Equal(t, "", model.editTagBuf.Value)
 }
 
 func TestProtocolsHandleEditKeysAdditionalBranches(t *testing.T) {
@@ -263,15 +263,15 @@ func TestProtocolsHandleEditKeysAdditionalBranches(t *testing.T) {
 	assert.Equal(t, len(protocolStatusOptions)-1, updated.editStatusIdx)
 
 	updated.editFocus = protoEditFieldTitle
-	updated.editFields[protoEditFieldTitle].value = ""
+	updated.editFields[protoEditFieldTitle].Value = ""
 	updated, cmd = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
 	require.Nil(t, cmd)
-	assert.Equal(t, "a", updated.editFields[protoEditFieldTitle].value)
+	assert.Equal(t, "a", updated.editFields[protoEditFieldTitle].Value)
 
-	updated.editFields[protoEditFieldTitle].value = ""
+	updated.editFields[protoEditFieldTitle].Value = ""
 	updated, cmd = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.editFields[protoEditFieldTitle].value)
+	assert.Equal(t, "", updated.editFields[protoEditFieldTitle].Value)
 
 	updated, cmd = updated.handleEditKeys(tea.KeyMsg{Type: tea.KeyCtrlS})
 	require.NotNil(t, cmd)
@@ -284,17 +284,17 @@ func TestProtocolsSaveAddValidationAndMetadataError(t *testing.T) {
 	assert.Nil(t, cmd)
 	assert.Equal(t, "Name is required", updated.addErr)
 
-	updated.addFields[protoFieldName].value = "p1"
+	updated.addFields[protoFieldName].Value = "p1"
 	updated, cmd = updated.saveAdd()
 	assert.Nil(t, cmd)
 	assert.Equal(t, "Title is required", updated.addErr)
 
-	updated.addFields[protoFieldTitle].value = "Protocol"
+	updated.addFields[protoFieldTitle].Value = "Protocol"
 	updated, cmd = updated.saveAdd()
 	assert.Nil(t, cmd)
 	assert.Equal(t, "Content is required", updated.addErr)
 
-	updated.addFields[protoFieldContent].value = "rules"
+	updated.addFields[protoFieldContent].Value = "rules"
 	updated.addMeta.Buffer = "invalid"
 	updated, cmd = updated.saveAdd()
 	assert.Nil(t, cmd)
@@ -337,37 +337,37 @@ func TestProtocolsViewOverlayBranches(t *testing.T) {
 func TestProtocolsHandleApplyInputAdditionalBranches(t *testing.T) {
 	model := NewProtocolsModel(nil)
 
-	model.addApplyBuf = "entity"
+	model.addApplyBuf.Value = "entity"
 	updated, cmd := model.handleApplyInput(tea.KeyMsg{Type: tea.KeyDelete}, true)
 	require.Nil(t, cmd)
-	assert.Equal(t, "entit", updated.addApplyBuf)
+	assert.Equal(t, "entit", updated.addApplyBuf.Value)
 
-	updated.editApplyBuf = "job"
+	updated.editApplyBuf.Value = "job"
 	updated, cmd = updated.handleApplyInput(tea.KeyMsg{Type: tea.KeyBackspace}, false)
 	require.Nil(t, cmd)
-	assert.Equal(t, "jo", updated.editApplyBuf)
+	assert.Equal(t, "jo", updated.editApplyBuf.Value)
 
-	updated.addApplyBuf = "context"
+	updated.addApplyBuf.Value = "context"
 	updated, cmd = updated.handleApplyInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{','}}, true)
 	require.Nil(t, cmd)
 	assert.Contains(t, updated.addApplies, "context")
-	assert.Equal(t, "", updated.addApplyBuf)
+	assert.Equal(t, "", updated.addApplyBuf.Value)
 
-	updated.editApplyBuf = "file"
+	updated.editApplyBuf.Value = "file"
 	updated, cmd = updated.handleApplyInput(tea.KeyMsg{Type: tea.KeySpace}, false)
 	require.Nil(t, cmd)
 	assert.Contains(t, updated.editApplies, "file")
-	assert.Equal(t, "", updated.editApplyBuf)
+	assert.Equal(t, "", updated.editApplyBuf.Value)
 
 	updated, cmd = updated.handleApplyInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}}, false)
 	require.Nil(t, cmd)
-	assert.Equal(t, "x", updated.editApplyBuf)
+	assert.Equal(t, "x", updated.editApplyBuf.Value)
 
 	// Non-char keys should leave buffers unchanged.
-	beforeAdd := updated.addApplyBuf
-	beforeEdit := updated.editApplyBuf
+	beforeAdd := updated.addApplyBuf.Value
+	beforeEdit := updated.editApplyBuf.Value
 	updated, cmd = updated.handleApplyInput(tea.KeyMsg{Type: tea.KeyUp}, true)
 	require.Nil(t, cmd)
-	assert.Equal(t, beforeAdd, updated.addApplyBuf)
-	assert.Equal(t, beforeEdit, updated.editApplyBuf)
+	assert.Equal(t, beforeAdd, updated.addApplyBuf.Value)
+	assert.Equal(t, beforeEdit, updated.editApplyBuf.Value)
 }
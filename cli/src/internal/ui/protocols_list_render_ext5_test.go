@@ -36,24 +36,24 @@ func TestProtocolsHandleListKeysAdditionalBranches(t *testing.T) {
 	assert.Equal(t, protocolsViewList, updated.view)
 	assert.Nil(t, updated.detail)
 
-	updated.searchBuf = ""
+	updated.searchBuf.Value = ""
 	updated, cmd = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 
 	updated, cmd = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyTab})
 	require.Nil(t, cmd)
 	assert.Equal(t, protocolsViewAdd, updated.view)
 
 	updated.view = protocolsViewList
-	updated.searchBuf = ""
+	updated.searchBuf.Value = ""
 	updated, cmd = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyCtrlS})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.searchBuf)
+	assert.Equal(t, "", updated.searchBuf.Value)
 
 	updated, cmd = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
 	require.Nil(t, cmd)
-	assert.Equal(t, "a", updated.searchBuf)
+	assert.Equal(t, "a", updated.searchBuf.Value)
 	require.NotEmpty(t, updated.items)
 }
 
@@ -61,7 +61,7 @@ func TestProtocolsRenderListFallbackAndPreviewLayout(t *testing.T) {
 	now := time.Now().UTC()
 	model := NewProtocolsModel(nil)
 	model.width = 84 // forces stacked table+preview layout.
-	model.searchBuf = "  alpha  "
+	model.searchBuf.Value = "  alpha  "
 	model.items = []api.Protocol{
 		{
 			ID:        "proto-1",
@@ -163,10 +163,10 @@ func TestProtocolsHandleAddKeysAdditionalBranches(t *testing.T) {
 	assert.Equal(t, len(protocolStatusOptions)-1, updated.addStatusIdx)
 
 	updated.addFocus = protoFieldName
-	updated.addFields[protoFieldName].value = ""
+	updated.addFields[protoFieldName].Value = ""
 	updated, cmd = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.addFields[protoFieldName].value)
+	assert.Equal(t, "", updated.addFields[protoFieldName].Value)
 
 	updated.addFocus = protoFieldMetadata
 	updated, cmd = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeySpace})
@@ -175,9 +175,9 @@ func TestProtocolsHandleAddKeysAdditionalBranches(t *testing.T) {
 
 	updated.addMeta.Active = false
 	updated.addFocus = protoFieldName
-	updated.addFields[protoFieldName].value = "alpha"
-	updated.addFields[protoFieldTitle].value = "Alpha"
-	updated.addFields[protoFieldContent].value = "rules"
+	updated.addFields[protoFieldName].Value = "alpha"
+	updated.addFields[protoFieldTitle].Value = "Alpha"
+	updated.addFields[protoFieldContent].Value = "rules"
 	updated, cmd = updated.handleAddKeys(tea.KeyMsg{Type: tea.KeyCtrlS})
 	require.NotNil(t, cmd)
 	assert.True(t, updated.addSaving)
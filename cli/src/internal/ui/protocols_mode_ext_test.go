@@ -81,12 +81,12 @@ func TestProtocolsApplySearchByNameAndTitle(t *testing.T) {
 		{Name: "beta", Title: "ops policy"},
 	}
 
-	model.searchBuf = "ops"
+	model.searchBuf.Value = "ops"
 	model.applySearch()
 	assert.Len(t, model.items, 1)
 	assert.Equal(t, "beta", model.items[0].Name)
 
-	model.searchBuf = ""
+	model.searchBuf.Value = ""
 	model.applySearch()
 	assert.Len(t, model.items, 2)
 }
@@ -346,6 +346,6 @@ func TestProtocolsRenderHelpersCoverListAddAndEdit(t *testing.T) {
 	editView := components.SanitizeText(model.renderEdit())
 	assert.Contains(t, editView, "Tags")
 
-	assert.Equal(t, "a, b", model.renderTags([]string{"a"}, "b"))
-	assert.Equal(t, "entity, job", model.renderApplies([]string{"entity"}, "job"))
+	assert.Equal(t, "a, b", model.renderTags([]string{"a"}, components.NewTextInput("b"), false))
+	assert.Equal(t, "entity, job", model.renderApplies([]string{"entity"}, components.NewTextInput("job"), false))
 }
@@ -12,31 +12,31 @@ func TestProtocolsHandleTagInputAdditionalBranchMatrix(t *testing.T) {
 	model := NewProtocolsModel(nil)
 
 	// delete/backspace branch in edit mode with existing buffer
-	model.editTagBuf = "ab"
+	model.editTagBuf.Value = "ab"
 	updated, cmd := model.handleTagInput(tea.KeyMsg{Type: tea.KeyDelete}, false)
 	require.Nil(t, cmd)
-	assert.Equal(t, "a", updated.editTagBuf)
+	assert.Equal(t, "a", updated.editTagBuf.Value)
 
 	// backspace branch with empty buffer keeps state stable
 	updated, cmd = updated.handleTagInput(tea.KeyMsg{Type: tea.KeyBackspace}, false)
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.editTagBuf)
+	assert.Equal(t, "", updated.editTagBuf.Value)
 	updated, cmd = updated.handleTagInput(tea.KeyMsg{Type: tea.KeyBackspace}, false)
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.editTagBuf)
+	assert.Equal(t, "", updated.editTagBuf.Value)
 
 	// comma/space commit branches
-	updated.addTagBuf = "Tag-One"
+	updated.addTagBuf.Value = "Tag-One"
 	updated, cmd = updated.handleTagInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{','}}, true)
 	require.Nil(t, cmd)
 	assert.Equal(t, []string{"tag-one"}, updated.addTags)
-	assert.Equal(t, "", updated.addTagBuf)
+	assert.Equal(t, "", updated.addTagBuf.Value)
 
-	updated.editTagBuf = "Tag Two"
+	updated.editTagBuf.Value = "Tag Two"
 	updated, cmd = updated.handleTagInput(tea.KeyMsg{Type: tea.KeySpace}, false)
 	require.Nil(t, cmd)
 	assert.Equal(t, []string{"tag-two"}, updated.editTags)
-	assert.Equal(t, "", updated.editTagBuf)
+	assert.Equal(t, "", updated.editTagBuf.Value)
 
 	// non-printable/no-op branch (len(msg.String()) != 1 and not handled key)
 	before := updated
@@ -47,5 +47,5 @@ func TestProtocolsHandleTagInputAdditionalBranchMatrix(t *testing.T) {
 	// printable branch in edit mode appends rune
 	updated, cmd = updated.handleTagInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}}, false)
 	require.Nil(t, cmd)
-	assert.Equal(t, "x", updated.editTagBuf)
+	assert.Equal(t, "x", updated.editTagBuf.Value)
 }
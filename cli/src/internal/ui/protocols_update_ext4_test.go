@@ -38,7 +38,7 @@ func TestProtocolsLoadProtocolsSuccessAndError(t *testing.T) {
 		})
 		model := NewProtocolsModel(client)
 		msg := model.loadProtocols()
-		_, ok := msg.(errMsg)
+		_, ok := msg.(loadFailedMsg)
 		assert.True(t, ok)
 	})
 }
@@ -48,7 +48,7 @@ func TestProtocolsUpdateMessageMatrix(t *testing.T) {
 	model.width = 88
 	model.list.SetItems([]string{"stale"})
 	model.loading = true
-	model.searchBuf = "ops"
+	model.searchBuf.Value = "ops"
 
 	model, cmd := model.Update(protocolsLoadedMsg{items: []api.Protocol{
 		{ID: "proto-1", Name: "ops-checklist", Title: "daily ops", Status: "active"},
@@ -70,8 +70,8 @@ func TestProtocolsHandleDetailKeysEditAndBackPaths(t *testing.T) {
 	updated, cmd := model.handleDetailKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
 	require.Nil(t, cmd)
 	assert.Equal(t, protocolsViewEdit, updated.view)
-	assert.Equal(t, "Alpha", updated.editFields[protoEditFieldTitle].value)
-	assert.Equal(t, "rules", updated.editFields[protoEditFieldContent].value)
+	assert.Equal(t, "Alpha", updated.editFields[protoEditFieldTitle].Value)
+	assert.Equal(t, "rules", updated.editFields[protoEditFieldContent].Value)
 
 	updated, cmd = updated.handleDetailKeys(tea.KeyMsg{Type: tea.KeyEsc})
 	require.Nil(t, cmd)
@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+type rateLimitedMsg struct{ wait time.Duration }
+
+type rateLimitTickMsg struct{}
+
+// watchRateLimits routes the client's 429 waits into a channel the update
+// loop listens on. Sends never block the request goroutine.
+func watchRateLimits(client *api.Client) chan time.Duration {
+	if client == nil {
+		return nil
+	}
+	ch := make(chan time.Duration, 8)
+	client.OnRateLimited(func(wait time.Duration) {
+		select {
+		case ch <- wait:
+		default:
+		}
+	})
+	return ch
+}
+
+// waitForRateLimit waits for the next rate limit wait from the client.
+func waitForRateLimit(ch <-chan time.Duration) tea.Cmd {
+	if ch == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		return rateLimitedMsg{wait: <-ch}
+	}
+}
+
+// rateLimitTick refreshes the retry countdown once a second.
+func rateLimitTick() tea.Cmd {
+	return tea.Tick(time.Second, func(time.Time) tea.Msg {
+		return rateLimitTickMsg{}
+	})
+}
+
+// handleRateLimited starts the retry countdown and keeps listening.
+func (a *App) handleRateLimited(msg rateLimitedMsg) tea.Cmd {
+	now := time.Now()
+	ticking := a.rateLimitedUntil.After(now)
+	until := now.Add(msg.wait)
+	if until.After(a.rateLimitedUntil) {
+		a.rateLimitedUntil = until
+	}
+	if ticking {
+		return waitForRateLimit(a.rateLimits)
+	}
+	return tea.Batch(waitForRateLimit(a.rateLimits), rateLimitTick())
+}
+
+// handleRateLimitTick stops the countdown once the wait is over.
+func (a *App) handleRateLimitTick() tea.Cmd {
+	if a.rateLimitedUntil.After(time.Now()) {
+		return rateLimitTick()
+	}
+	a.rateLimitedUntil = time.Time{}
+	return nil
+}
+
+// renderRateLimitStatus renders the retry countdown under the banner.
+func (a App) renderRateLimitStatus() string {
+	remaining := time.Until(a.rateLimitedUntil)
+	if remaining <= 0 {
+		return ""
+	}
+	secs := int((remaining + time.Second - 1) / time.Second)
+	return WarningStyle.Render(fmt.Sprintf("rate limited, retrying in %ds", secs))
+}
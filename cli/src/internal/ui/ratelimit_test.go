@@ -0,0 +1,35 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppShowsRateLimitCountdown(t *testing.T) {
+	client := api.NewClient("http://127.0.0.1:9", "key")
+	app := NewApp(client, &config.Config{APIKey: "key"})
+	app.width = 100
+	require.NotNil(t, app.rateLimits)
+
+	// The client callback feeds the listener without blocking.
+	app.rateLimits <- 5 * time.Second
+	msg := waitForRateLimit(app.rateLimits)()
+	assert.Equal(t, rateLimitedMsg{wait: 5 * time.Second}, msg)
+
+	model, cmd := app.Update(msg)
+	app = model.(App)
+	require.NotNil(t, cmd)
+	assert.Contains(t, components.SanitizeText(app.View()), "rate limited, retrying in 5s")
+
+	app.rateLimitedUntil = time.Now().Add(-time.Second)
+	model, cmd = app.Update(rateLimitTickMsg{})
+	app = model.(App)
+	assert.Nil(t, cmd)
+	assert.NotContains(t, components.SanitizeText(app.View()), "rate limited")
+}
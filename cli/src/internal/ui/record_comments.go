@@ -0,0 +1,322 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// recordCommentThread is the comments section of an entity or context detail view.
+// target is api.CommentOnEntity or api.CommentOnContext. While focused it
+// takes the keyboard: arrows select, n adds, e edits, d deletes.
+type recordCommentThread struct {
+	target   string
+	recordID string
+	items    []api.Comment
+	loading  bool
+	errText  string
+	focused  bool
+	index    int
+	editing  bool
+	editID   string
+	buf      components.TextInput
+	saving   bool
+	deleting bool
+}
+
+type recordCommentsLoadedMsg struct {
+	target   string
+	recordID string
+	items    []api.Comment
+	err      error
+}
+
+type recordCommentSavedMsg struct {
+	target   string
+	recordID string
+	comment  *api.Comment
+	deleted  string
+	err      error
+}
+
+// newRecordCommentThread starts an empty thread for a record.
+func newRecordCommentThread(target, recordID string) recordCommentThread {
+	return recordCommentThread{target: target, recordID: recordID}
+}
+
+// shows reports whether the thread belongs to the record with id.
+func (t recordCommentThread) shows(id string) bool {
+	return id != "" && t.recordID == id
+}
+
+// load fetches the thread's comments.
+func (t *recordCommentThread) load(client *api.Client) tea.Cmd {
+	if client == nil || t.recordID == "" {
+		return nil
+	}
+	t.loading = true
+	target, id := t.target, t.recordID
+	return func() tea.Msg {
+		items, err := client.ListComments(target, id)
+		return recordCommentsLoadedMsg{target: target, recordID: id, items: items, err: err}
+	}
+}
+
+// apply folds a comment message into the thread when it is for this record.
+func (t *recordCommentThread) apply(msg tea.Msg) {
+	switch msg := msg.(type) {
+	case recordCommentsLoadedMsg:
+		if msg.target != t.target || msg.recordID != t.recordID {
+			return
+		}
+		t.loading = false
+		t.errText = ""
+		if msg.err != nil {
+			t.errText = msg.err.Error()
+			return
+		}
+		t.items = msg.items
+		t.index = min(t.index, max(len(t.items)-1, 0))
+	case recordCommentSavedMsg:
+		if msg.target != t.target || msg.recordID != t.recordID {
+			return
+		}
+		t.saving = false
+		if msg.err != nil {
+			t.errText = msg.err.Error()
+			return
+		}
+		t.errText = ""
+		t.editing = false
+		t.deleting = false
+		t.buf.Reset()
+		switch {
+		case msg.deleted != "":
+			for i, item := range t.items {
+				if item.ID == msg.deleted {
+					t.items = append(t.items[:i:i], t.items[i+1:]...)
+					break
+				}
+			}
+			t.index = min(t.index, max(len(t.items)-1, 0))
+		case msg.comment != nil:
+			for i, item := range t.items {
+				if item.ID == msg.comment.ID {
+					t.items[i] = *msg.comment
+					return
+				}
+			}
+			t.items = append(t.items, *msg.comment)
+			t.index = len(t.items) - 1
+		}
+	}
+}
+
+// handleKeys handles keys while the thread is focused.
+func (t *recordCommentThread) handleKeys(client *api.Client, msg tea.KeyMsg) tea.Cmd {
+	if t.saving {
+		return nil
+	}
+	if t.deleting {
+		switch {
+		case isKey(msg, "y"), isEnter(msg):
+			return t.remove(client)
+		case isKey(msg, "n"), isBack(msg):
+			t.deleting = false
+		}
+		return nil
+	}
+	if t.editing {
+		switch {
+		case isBack(msg):
+			t.editing = false
+			t.buf.Reset()
+		case isEnter(msg):
+			return t.submit(client)
+		case isKey(msg, "cmd+backspace", "cmd+delete", "ctrl+u"):
+			t.buf.Reset()
+		default:
+			t.buf.HandleKey(msg)
+		}
+		return nil
+	}
+	switch {
+	case isBack(msg):
+		t.focused = false
+		t.errText = ""
+	case isDown(msg):
+		if t.index < len(t.items)-1 {
+			t.index++
+		}
+	case isUp(msg):
+		if t.index > 0 {
+			t.index--
+		}
+	case isKey(msg, "n", "a"):
+		t.editing = true
+		t.editID = ""
+		t.buf.Reset()
+	case isKey(msg, "e"):
+		if t.index < len(t.items) {
+			t.editing = true
+			t.editID = t.items[t.index].ID
+			t.buf.SetValue(t.items[t.index].Body)
+		}
+	case isKey(msg, "d"):
+		if t.index < len(t.items) {
+			t.deleting = true
+		}
+	case isKey(msg, "r"):
+		return t.load(client)
+	}
+	return nil
+}
+
+// hints lists the keys the focused thread handles.
+func (t recordCommentThread) hints() []string {
+	switch {
+	case t.editing:
+		return []string{components.Hint("enter", "Save"), components.Hint("esc", "Cancel")}
+	case t.deleting:
+		return []string{components.Hint("y", "Delete"), components.Hint("esc", "Cancel")}
+	}
+	return []string{
+		components.Hint("↑/↓", "Select"),
+		components.Hint("n", "New"),
+		components.Hint("e", "Edit"),
+		components.Hint("d", "Delete"),
+		components.Hint("r", "Reload"),
+		components.Hint("esc", "Back"),
+	}
+}
+
+// commentTab is the tab that shows comments for a target.
+func commentTab(target string) int {
+	if target == api.CommentOnContext {
+		return tabKnow
+	}
+	return tabEntities
+}
+
+// commentsFocused reports whether the active tab's comment thread has the
+// keyboard.
+func (a App) commentsFocused() bool {
+	switch {
+	case a.tab == tabEntities && a.entities.view == entitiesViewDetail && a.entities.detail != nil:
+		return a.entities.comments.focused && a.entities.comments.shows(a.entities.detail.ID)
+	case a.tab == tabKnow && a.know.view == contextViewDetail && a.know.detail != nil:
+		return a.know.comments.focused && a.know.comments.shows(a.know.detail.ID)
+	}
+	return false
+}
+
+// submit posts the new comment or the edit.
+func (t *recordCommentThread) submit(client *api.Client) tea.Cmd {
+	body := strings.TrimSpace(t.buf.Value)
+	if body == "" || client == nil {
+		return nil
+	}
+	t.saving = true
+	target, recordID, editID := t.target, t.recordID, t.editID
+	return func() tea.Msg {
+		var comment *api.Comment
+		var err error
+		if editID != "" {
+			comment, err = client.UpdateComment(editID, body)
+		} else {
+			comment, err = client.AddComment(target, recordID, body)
+		}
+		if err != nil {
+			err = fmt.Errorf("save comment: %w", err)
+		}
+		return recordCommentSavedMsg{target: target, recordID: recordID, comment: comment, err: err}
+	}
+}
+
+// remove deletes the selected comment.
+func (t *recordCommentThread) remove(client *api.Client) tea.Cmd {
+	if client == nil || t.index >= len(t.items) {
+		return nil
+	}
+	t.saving = true
+	target, recordID, id := t.target, t.recordID, t.items[t.index].ID
+	return func() tea.Msg {
+		if err := client.DeleteComment(id); err != nil {
+			return recordCommentSavedMsg{target: target, recordID: recordID, err: fmt.Errorf("delete comment: %w", err)}
+		}
+		return recordCommentSavedMsg{target: target, recordID: recordID, deleted: id}
+	}
+}
+
+// view renders the comments section of a detail view.
+func (t recordCommentThread) view(width int) string {
+	contentWidth := components.BoxContentWidth(width)
+	if contentWidth <= 0 {
+		contentWidth = 72
+	}
+	heading := MetaKeyStyle.Render(fmt.Sprintf("Comments (%d)", len(t.items)))
+	if !t.focused {
+		heading += MutedStyle.Render("  · C to comment")
+	}
+	lines := []string{heading}
+	switch {
+	case t.loading:
+		lines = append(lines, MutedStyle.Render("Loading comments..."))
+	case len(t.items) == 0:
+		lines = append(lines, MutedStyle.Render("No comments yet."))
+	}
+	for i, comment := range t.items {
+		marker := "  "
+		if t.focused && i == t.index {
+			marker = AccentStyle.Render("> ")
+		}
+		lines = append(lines, marker+renderRecordCommentHeader(comment))
+		for _, line := range wrapMetadataWords(comment.Body, contentWidth-4) {
+			lines = append(lines, "    "+NormalStyle.Render(line))
+		}
+	}
+	if t.errText != "" {
+		lines = append(lines, ErrorStyle.Render(components.SanitizeOneLine(t.errText)))
+	}
+
+	content := strings.Join(lines, "\n")
+	switch {
+	case t.editing:
+		title := "New Comment"
+		if t.editID != "" {
+			title = "Edit Comment"
+		}
+		if t.saving {
+			title += " (saving...)"
+		}
+		content += "\n\n" + components.InputDialog(title, t.buf) + "\n" +
+			MutedStyle.Render("enter save  ·  esc cancel")
+	case t.deleting:
+		content += "\n\n" + WarningStyle.Render("Delete this comment? y/enter to confirm, esc to cancel")
+	}
+	return components.TitledBox("Comments", content, width)
+}
+
+// renderRecordCommentHeader renders a comment's author and timestamps.
+func renderRecordCommentHeader(comment api.Comment) string {
+	author := components.SanitizeOneLine(strings.TrimSpace(comment.AuthorName))
+	if author == "" {
+		author = shortID(comment.AuthorID)
+	}
+	style := AccentStyle
+	if strings.EqualFold(comment.AuthorType, "agent") {
+		style = WarningStyle
+	}
+	header := style.Render(author)
+	if !comment.CreatedAt.IsZero() {
+		header += MutedStyle.Render("  " + formatLocalTimeCompact(comment.CreatedAt))
+	}
+	if comment.UpdatedAt.After(comment.CreatedAt) {
+		header += MutedStyle.Render("  (edited)")
+	}
+	return header
+}
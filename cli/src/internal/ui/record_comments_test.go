@@ -0,0 +1,117 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestEntityCommentThreadAddEditDelete(t *testing.T) {
+	created := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	var requests []string
+	_, client := contextTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		var body api.CommentInput
+		if r.Body != nil {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+		}
+		comment := map[string]any{
+			"id": "cm-1", "entity_id": "ent-1", "author_type": "entity", "author_id": "me",
+			"author_name": "alxx", "body": body.Body, "created_at": created, "updated_at": created,
+		}
+		var data any
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/entities/ent-1/comments":
+			data = []map[string]any{}
+		case r.Method == http.MethodPost && r.URL.Path == "/api/entities/ent-1/comments":
+			data = comment
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/comments/cm-1":
+			comment["updated_at"] = created.Add(time.Minute)
+			data = comment
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/comments/cm-1":
+			data = map[string]any{"deleted": true}
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": data}))
+	})
+	app := NewApp(client, &config.Config{})
+	app.width = 120
+	app.tab = tabEntities
+	app.entities.detail = &api.Entity{ID: "ent-1", Name: "Atlas", Type: "project"}
+	app.entities.view = entitiesViewDetail
+
+	press := func(keys ...tea.KeyMsg) {
+		for _, key := range keys {
+			model, cmd := app.Update(key)
+			app = model.(App)
+			for cmd != nil {
+				msg := cmd()
+				if msg == nil {
+					break
+				}
+				model, cmd = app.Update(msg)
+				app = model.(App)
+			}
+		}
+	}
+	runes := func(s string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)} }
+
+	press(runes("C"))
+	require.True(t, app.commentsFocused())
+	assert.Contains(t, components.SanitizeText(app.entities.renderDetail()), "No comments yet.")
+
+	press(runes("n"), runes("needs review"), tea.KeyMsg{Type: tea.KeyEnter})
+	require.Len(t, app.entities.comments.items, 1)
+	assert.Equal(t, "needs review", app.entities.comments.items[0].Body)
+
+	press(runes("e"), tea.KeyMsg{Type: tea.KeyCtrlU}, runes("reviewed"), tea.KeyMsg{Type: tea.KeyEnter})
+	require.Len(t, app.entities.comments.items, 1)
+	view := components.SanitizeText(app.entities.renderDetail())
+	assert.Contains(t, view, "reviewed")
+	assert.Contains(t, view, "(edited)")
+
+	press(runes("d"), runes("y"))
+	assert.Empty(t, app.entities.comments.items)
+
+	press(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.False(t, app.commentsFocused())
+	assert.Equal(t, entitiesViewDetail, app.entities.view)
+	assert.Equal(t, []string{
+		"GET /api/entities/ent-1/comments",
+		"POST /api/entities/ent-1/comments",
+		"PATCH /api/comments/cm-1",
+		"DELETE /api/comments/cm-1",
+	}, requests)
+}
+
+func TestContextCommentsRouteToKnowledgeTab(t *testing.T) {
+	app := NewApp(nil, &config.Config{})
+	app.tab = tabEntities
+	app.know.detail = &api.Context{ID: "ctx-1", Name: "Runbook"}
+	app.know.view = contextViewDetail
+	app.know.comments = newRecordCommentThread(api.CommentOnContext, "ctx-1")
+
+	model, _ := app.Update(recordCommentsLoadedMsg{
+		target:   api.CommentOnContext,
+		recordID: "ctx-1",
+		items:    []api.Comment{{ID: "cm-1", AuthorName: "scout", AuthorType: "agent", Body: "Stale since v2"}},
+	})
+	app = model.(App)
+	require.Len(t, app.know.comments.items, 1)
+
+	// Comments for another record are dropped.
+	model, _ = app.Update(recordCommentsLoadedMsg{target: api.CommentOnContext, recordID: "ctx-2"})
+	app = model.(App)
+	assert.Len(t, app.know.comments.items, 1)
+	assert.Contains(t, components.SanitizeText(app.know.renderDetail()), "Stale since v2")
+}
@@ -0,0 +1,102 @@
+package ui
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// autoRefreshTickMsg fires when a tab's auto-refresh interval elapses. gen
+// ties the tick to the schedule that created it so switching tabs drops it.
+type autoRefreshTickMsg struct {
+	tab int
+	gen int
+}
+
+// refreshTab re-runs a tab's load commands and shows the refreshing marker
+// until the tab hears back.
+func (a *App) refreshTab(tab int) tea.Cmd {
+	a.clearLoadFailure(tab)
+	cmd := a.initTab(tab)
+	if cmd == nil {
+		return nil
+	}
+	a.refreshing = true
+	return cmd
+}
+
+// autoRefreshInterval returns the configured interval for a tab, zero when
+// off. The Status tab refreshes on its own unless configured otherwise.
+func (a App) autoRefreshInterval(tab int) time.Duration {
+	if tab < 0 || tab >= len(tabNames) {
+		return 0
+	}
+	interval := a.config.AutoRefreshInterval(strings.ToLower(tabNames[tab]))
+	if interval <= 0 && tab == tabStatus {
+		return statusRefreshInterval
+	}
+	return interval
+}
+
+// scheduleAutoRefresh arms the active tab's auto-refresh, replacing any
+// earlier schedule.
+func (a *App) scheduleAutoRefresh() tea.Cmd {
+	a.refreshGen++
+	return a.autoRefreshCmd()
+}
+
+// autoRefreshCmd ticks once after the active tab's interval.
+func (a App) autoRefreshCmd() tea.Cmd {
+	interval := a.autoRefreshInterval(a.tab)
+	if interval <= 0 {
+		return nil
+	}
+	tick := autoRefreshTickMsg{tab: a.tab, gen: a.refreshGen}
+	return tea.Tick(interval, func(time.Time) tea.Msg { return tick })
+}
+
+// handleAutoRefreshTick reloads the active tab unless the user is busy with
+// a form or overlay, then re-arms the timer.
+func (a *App) handleAutoRefreshTick(msg autoRefreshTickMsg) tea.Cmd {
+	if msg.gen != a.refreshGen || msg.tab != a.tab {
+		return nil
+	}
+	next := a.scheduleAutoRefresh()
+	if a.refreshBlocked() {
+		return next
+	}
+	return tea.Batch(a.refreshTab(a.tab), next)
+}
+
+// refreshBlocked reports whether a reload could disturb what the user is doing.
+func (a App) refreshBlocked() bool {
+	return a.onboarding ||
+		a.sessionExpired ||
+		a.quitConfirm ||
+		a.helpOpen ||
+		a.paletteOpen ||
+		a.verbPlan != nil ||
+		a.importExportOpen ||
+		a.opsOpen ||
+		a.columnPicker != nil ||
+		a.quickstartOpen ||
+		a.hasUnsaved()
+}
+
+// settleRefresh clears the refreshing marker once a non-key message reaches
+// the refreshed tab.
+func (a *App) settleRefresh(msg tea.Msg) {
+	if _, ok := msg.(tea.KeyMsg); ok {
+		return
+	}
+	a.refreshing = false
+}
+
+// renderRefreshStatus renders the refreshing marker under the banner.
+func (a App) renderRefreshStatus() string {
+	if !a.refreshing {
+		return ""
+	}
+	return MutedStyle.Render("refreshing…")
+}
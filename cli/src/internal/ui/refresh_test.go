@@ -0,0 +1,95 @@
+package ui
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRefreshApp(t *testing.T, autoRefresh map[string]string) (App, *int32) {
+	t.Helper()
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	app := NewApp(api.NewClient(srv.URL, "nbl_test"), &config.Config{APIKey: "nbl_test", Username: "alxx", AutoRefresh: autoRefresh})
+	app.width = 100
+	app.startupChecking = false
+	return app, &hits
+}
+
+func TestAppRefreshKeyReloadsActiveTab(t *testing.T) {
+	app, hits := newRefreshApp(t, nil)
+
+	model, cmd := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'R'}})
+	app = model.(App)
+	require.NotNil(t, cmd)
+	assert.True(t, app.refreshing)
+	assert.Contains(t, components.SanitizeText(app.View()), "refreshing…")
+
+	msg := cmd()
+	assert.Equal(t, int32(1), atomic.LoadInt32(hits))
+	model, _ = app.Update(msg)
+	app = model.(App)
+	assert.False(t, app.refreshing)
+	assert.NotContains(t, components.SanitizeText(app.View()), "refreshing…")
+
+	model, cmd = app.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	app = model.(App)
+	require.NotNil(t, cmd)
+	assert.True(t, app.refreshing)
+}
+
+func TestAppAutoRefreshFollowsActiveTab(t *testing.T) {
+	app, _ := newRefreshApp(t, map[string]string{"inbox": "30s"})
+	assert.Equal(t, 30*time.Second, app.autoRefreshInterval(tabInbox))
+	assert.Zero(t, app.autoRefreshInterval(tabJobs))
+	assert.NotNil(t, app.autoRefreshCmd())
+
+	tick := autoRefreshTickMsg{tab: tabInbox, gen: app.refreshGen}
+	model, cmd := app.Update(tick)
+	app = model.(App)
+	require.NotNil(t, cmd)
+	assert.True(t, app.refreshing)
+
+	// The reschedule bumped the generation, so the old tick is stale.
+	app.refreshing = false
+	model, cmd = app.Update(tick)
+	app = model.(App)
+	assert.Nil(t, cmd)
+	assert.False(t, app.refreshing)
+
+	app, _ = app.switchTab(tabJobs)
+	model, cmd = app.Update(autoRefreshTickMsg{tab: tabInbox, gen: app.refreshGen})
+	app = model.(App)
+	assert.Nil(t, cmd)
+}
+
+func TestAppAutoRefreshWaitsWhileBusy(t *testing.T) {
+	app, _ := newRefreshApp(t, map[string]string{"default": "1m"})
+	app.paletteOpen = true
+
+	model, cmd := app.Update(autoRefreshTickMsg{tab: tabInbox, gen: app.refreshGen})
+	app = model.(App)
+	require.NotNil(t, cmd)
+	assert.False(t, app.refreshing)
+}
+
+func TestProfileSettingsShowsAutoRefresh(t *testing.T) {
+	model := NewProfileModel(nil, &config.Config{Username: "alxx", AutoRefresh: map[string]string{"inbox": "30s"}})
+	model.width = 100
+	assert.Contains(t, components.SanitizeText(model.View()), "inbox=30s")
+}
@@ -0,0 +1,155 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+// relationshipTypeRule is the managed shape of one relationship type.
+type relationshipTypeRule struct {
+	name    string
+	inverse string
+	sources []string
+	targets []string
+}
+
+// relationshipTypeRules indexes taxonomy relationship types by suggestKey so
+// "worksAt", "works_at" and "works-at" resolve to the same managed type.
+type relationshipTypeRules map[string]relationshipTypeRule
+
+// newRelationshipTypeRules builds the rule index from taxonomy rows.
+func newRelationshipTypeRules(items []api.TaxonomyEntry) relationshipTypeRules {
+	rules := relationshipTypeRules{}
+	for _, item := range items {
+		key := suggestKey(item.Name)
+		if key == "" {
+			continue
+		}
+		rule := relationshipTypeRule{
+			name:    item.Name,
+			sources: item.SourceEntityTypes,
+			targets: item.TargetEntityTypes,
+		}
+		if item.InverseName != nil {
+			rule.inverse = strings.TrimSpace(*item.InverseName)
+		}
+		rules[key] = rule
+	}
+	return rules
+}
+
+// lookup returns the managed type matching name, ignoring case and punctuation.
+func (r relationshipTypeRules) lookup(name string) (relationshipTypeRule, bool) {
+	rule, ok := r[suggestKey(name)]
+	return rule, ok
+}
+
+// canonical snaps a typed name onto the managed taxonomy name when one matches.
+func (r relationshipTypeRules) canonical(name string) string {
+	name = strings.TrimSpace(name)
+	if rule, ok := r.lookup(name); ok {
+		return rule.name
+	}
+	return name
+}
+
+// inverse returns the inverse label for name, or "" when none is set.
+func (r relationshipTypeRules) inverse(name string) string {
+	rule, _ := r.lookup(name)
+	return rule.inverse
+}
+
+// check rejects entity types outside the allow-lists of a managed type. An
+// empty entity type means the endpoint is not an entity and is not checked.
+func (r relationshipTypeRules) check(name, sourceType, targetType string) error {
+	rule, ok := r.lookup(name)
+	if !ok {
+		return nil
+	}
+	if sourceType != "" && !allowsEntityType(rule.sources, sourceType) {
+		return fmt.Errorf("%s does not allow %s as source (allowed: %s)", rule.name, sourceType, strings.Join(rule.sources, ", "))
+	}
+	if targetType != "" && !allowsEntityType(rule.targets, targetType) {
+		return fmt.Errorf("%s does not allow %s as target (allowed: %s)", rule.name, targetType, strings.Join(rule.targets, ", "))
+	}
+	return nil
+}
+
+// filter drops options whose rules reject the given endpoint types.
+func (r relationshipTypeRules) filter(options []string, sourceType, targetType string) []string {
+	if len(r) == 0 {
+		return options
+	}
+	out := make([]string, 0, len(options))
+	for _, option := range options {
+		if r.check(option, sourceType, targetType) == nil {
+			out = append(out, option)
+		}
+	}
+	return out
+}
+
+// allowsEntityType reports whether entityType is in allowed; empty allows all.
+func allowsEntityType(allowed []string, entityType string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, name := range allowed {
+		if strings.EqualFold(name, entityType) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseRelationshipRulesSpec parses "inverse:employs source:person
+// target:organization,project". Omitted keys clear that rule.
+func parseRelationshipRulesSpec(spec string) (string, []string, []string, error) {
+	inverse := ""
+	sources, targets := []string{}, []string{}
+	for _, token := range strings.Fields(spec) {
+		key, value, ok := strings.Cut(token, ":")
+		if !ok {
+			return "", nil, nil, fmt.Errorf("rule %q must look like key:value", token)
+		}
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "inverse":
+			inverse = strings.TrimSpace(value)
+		case "source":
+			sources = splitRuleList(value)
+		case "target":
+			targets = splitRuleList(value)
+		default:
+			return "", nil, nil, fmt.Errorf("unknown rule %q (use inverse, source, target)", key)
+		}
+	}
+	return inverse, sources, targets, nil
+}
+
+// formatRelationshipRulesSpec renders a taxonomy row back into the rules spec.
+func formatRelationshipRulesSpec(item api.TaxonomyEntry) string {
+	var parts []string
+	if item.InverseName != nil && strings.TrimSpace(*item.InverseName) != "" {
+		parts = append(parts, "inverse:"+strings.TrimSpace(*item.InverseName))
+	}
+	if len(item.SourceEntityTypes) > 0 {
+		parts = append(parts, "source:"+strings.Join(item.SourceEntityTypes, ","))
+	}
+	if len(item.TargetEntityTypes) > 0 {
+		parts = append(parts, "target:"+strings.Join(item.TargetEntityTypes, ","))
+	}
+	return strings.Join(parts, " ")
+}
+
+// splitRuleList splits a comma list, dropping blanks.
+func splitRuleList(value string) []string {
+	out := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
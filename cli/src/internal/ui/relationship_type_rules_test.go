@@ -0,0 +1,151 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func testRelationshipTypeRules() relationshipTypeRules {
+	inverse := "employs"
+	return newRelationshipTypeRules([]api.TaxonomyEntry{
+		{
+			Name:              "works-at",
+			InverseName:       &inverse,
+			SourceEntityTypes: []string{"person"},
+			TargetEntityTypes: []string{"organization"},
+		},
+		{Name: "related-to"},
+	})
+}
+
+func TestRelationshipTypeRules(t *testing.T) {
+	rules := testRelationshipTypeRules()
+
+	assert.Equal(t, "works-at", rules.canonical("worksAt"))
+	assert.Equal(t, "works-at", rules.canonical(" works_at "))
+	assert.Equal(t, "custom", rules.canonical("custom"))
+	assert.Equal(t, "employs", rules.inverse("works-at"))
+
+	assert.NoError(t, rules.check("works-at", "person", "organization"))
+	assert.NoError(t, rules.check("works-at", "", "organization"))
+	assert.ErrorContains(t, rules.check("works-at", "project", "organization"), "does not allow project as source")
+	assert.ErrorContains(t, rules.check("works-at", "person", "person"), "does not allow person as target")
+
+	options := []string{"works-at", "related-to", "custom"}
+	assert.Equal(t, options, rules.filter(options, "person", "organization"))
+	assert.Equal(t, []string{"related-to", "custom"}, rules.filter(options, "project", "organization"))
+}
+
+func TestRelationshipRulesSpecRoundTrip(t *testing.T) {
+	_, _, _, err := parseRelationshipRulesSpec("inverse:employs source:person target:organization, project")
+	require.ErrorContains(t, err, "key:value")
+
+	inverse, sources, targets, err := parseRelationshipRulesSpec("inverse:employs source:person target:organization,project")
+	require.NoError(t, err)
+	assert.Equal(t, "employs", inverse)
+	assert.Equal(t, []string{"person"}, sources)
+	assert.Equal(t, []string{"organization", "project"}, targets)
+
+	spec := formatRelationshipRulesSpec(api.TaxonomyEntry{
+		InverseName:       &inverse,
+		SourceEntityTypes: sources,
+		TargetEntityTypes: targets,
+	})
+	assert.Equal(t, "inverse:employs source:person target:organization,project", spec)
+
+	inverse, sources, targets, err = parseRelationshipRulesSpec("")
+	require.NoError(t, err)
+	assert.Empty(t, inverse)
+	assert.Equal(t, []string{}, sources)
+	assert.Equal(t, []string{}, targets)
+
+	_, _, _, err = parseRelationshipRulesSpec("kind:x")
+	assert.ErrorContains(t, err, "unknown rule")
+}
+
+func TestEntityRelateSnapsTypeAndBlocksDisallowedPair(t *testing.T) {
+	var created map[string]any
+	_, client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "rel-1"}}))
+	})
+	m := NewEntitiesModel(client)
+	m.relTypeRules = testRelationshipTypeRules()
+	m.relTypeVocab = []string{"works-at", "related-to"}
+	m.detail = &api.Entity{ID: "ent-1", Name: "Ana", Type: "person"}
+	m.relateTarget = &api.Entity{ID: "ent-2", Name: "Nebula", Type: "project"}
+	m.view = entitiesViewRelateType
+
+	assert.Equal(t, []string{"related-to"}, m.relateTypeOptions())
+	m.relateType.Value = "worksAt"
+	m, cmd := m.handleRelateKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	msg, ok := cmd().(errMsg)
+	require.True(t, ok)
+	assert.ErrorContains(t, msg.err, "does not allow project as target")
+	assert.Equal(t, entitiesViewRelateType, m.view)
+
+	m.relateTarget = &api.Entity{ID: "ent-3", Name: "Acme", Type: "organization"}
+	m, cmd = m.handleRelateKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	_, ok = cmd().(relationshipCreatedMsg)
+	require.True(t, ok)
+	assert.Equal(t, "works-at", created["relationship_type"])
+	assert.Equal(t, entitiesViewRelationships, m.view)
+
+	m.detail = &api.Entity{ID: "ent-3", Name: "Acme", Type: "organization"}
+	line := m.formatRelationshipLine(api.Relationship{Type: "works-at", SourceID: "ent-1", SourceName: "Ana", TargetID: "ent-3"})
+	assert.Equal(t, "employs (incoming -> Ana)", line)
+}
+
+func TestProfileRelationshipTypeRulesPrompt(t *testing.T) {
+	var gotBody map[string]any
+	_, client := testProfileTaxonomyClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPatch:
+			assert.Equal(t, "/api/taxonomy/relationship-types/rt-1", r.URL.Path)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "rt-1"}}))
+		default:
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{}}))
+		}
+	})
+
+	inverse := "employs"
+	model := NewProfileModel(client, &config.Config{APIKey: "test-key"})
+	model.section = 2
+	model.taxKind = 2
+	model.width = 100
+	model.setTaxonomyItems([]api.TaxonomyEntry{{
+		ID: "rt-1", Name: "works-at", IsActive: true,
+		InverseName: &inverse, SourceEntityTypes: []string{"person"},
+	}})
+	view := components.SanitizeText(model.View())
+	assert.Contains(t, view, "Inverse")
+	assert.Contains(t, view, "employs")
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	assert.Nil(t, cmd)
+	require.Equal(t, taxPromptRules, model.taxPromptMode)
+	assert.Equal(t, "inverse:employs source:person", model.taxPromptBuf.Value)
+
+	model = typeText(model, " target:organization")
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	model, _ = model.Update(cmd())
+	assert.Equal(t, "Updated rules for works-at", model.taxNotice)
+	assert.Equal(t, map[string]any{
+		"inverse_name":        "employs",
+		"source_entity_types": []any{"person"},
+		"target_entity_types": []any{"organization"},
+	}, gotBody)
+}
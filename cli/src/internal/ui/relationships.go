@@ -3,6 +3,7 @@ package ui
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -13,8 +14,14 @@ import (
 
 // --- Messages ---
 
-type relTabLoadedMsg struct{ items []api.Relationship }
-type relTabNamesLoadedMsg struct{ names map[string]string }
+type relTabLoadedMsg struct {
+	items  []api.Relationship
+	queued time.Time
+}
+type relTabNamesLoadedMsg struct {
+	names map[string]string
+	types map[string]string
+}
 type relTabSavedMsg struct{}
 type relTabScopesLoadedMsg struct{ options []string }
 type relTabEntityCacheLoadedMsg struct{ items []api.Entity }
@@ -60,28 +67,41 @@ type relationshipCreateCandidate struct {
 	Metadata map[string]any
 }
 
+// entityType returns the entity type of an entity candidate, or "" otherwise.
+func (c relationshipCreateCandidate) entityType() string {
+	kind := strings.TrimPrefix(c.Kind, "entity/")
+	if c.NodeType != "entity" || kind == "entity" {
+		return ""
+	}
+	return kind
+}
+
 // --- Relationships Model ---
 
 type RelationshipsModel struct {
-	client    *api.Client
-	items     []api.Relationship
-	allItems  []api.Relationship
-	list      *components.List
-	loading   bool
-	view      relationshipsView
-	modeFocus bool
-	filtering bool
-	filterBuf string
-	width     int
-	height    int
+	client       *api.Client
+	items        []api.Relationship
+	allItems     []api.Relationship
+	list         *components.List
+	loading      bool
+	loadLatency  time.Duration
+	view         relationshipsView
+	modeFocus    bool
+	filtering    bool
+	filterBuf    components.TextInput
+	showArchived bool
+	width        int
+	height       int
 
 	names        map[string]string
+	entityTypes  map[string]string
 	scopeOptions []string
 	entityCache  []api.Entity
 	contextCache []api.Context
 	jobCache     []api.Job
 
 	detail        *api.Relationship
+	pane          splitPane
 	metaExpanded  bool
 	editFocus     int
 	editStatusIdx int
@@ -91,18 +111,20 @@ type RelationshipsModel struct {
 	confirmKind string
 
 	// create flow
-	createQuery       string
+	createQuery       components.TextInput
 	createResults     []relationshipCreateCandidate
 	createList        *components.List
 	createSource      *relationshipCreateCandidate
 	createTarget      *relationshipCreateCandidate
-	createType        string
+	createType        components.TextInput
 	createTypeResults []string
 	createTypeList    *components.List
 	createTypeNav     bool
 	createLoading     bool
 
 	typeOptions []string
+	typeVocab   []string
+	typeRules   relationshipTypeRules
 }
 
 // NewRelationshipsModel builds the relationships UI model.
@@ -123,7 +145,7 @@ func (m RelationshipsModel) Init() tea.Cmd {
 	m.loading = true
 	m.modeFocus = false
 	m.filtering = false
-	m.filterBuf = ""
+	m.filterBuf.Reset()
 	m.metaExpanded = false
 	m.editMeta.Reset()
 	return tea.Batch(
@@ -140,10 +162,12 @@ func (m RelationshipsModel) Update(msg tea.Msg) (RelationshipsModel, tea.Cmd) {
 	switch msg := msg.(type) {
 	case relTabLoadedMsg:
 		m.loading = false
+		m.loadLatency = loadElapsed(msg.queued)
 		m.allItems = append([]api.Relationship{}, msg.items...)
 		m.applyListFilter()
-		m.typeOptions = uniqueRelationshipTypes(msg.items)
-		return m, m.loadRelationshipNames(msg.items)
+		m.typeOptions = mergeVocabulary(uniqueRelationshipTypes(msg.items), m.typeVocab)
+		m.pane.reset()
+		return m, tea.Batch(m.loadRelationshipNames(msg.items), m.syncSplitPane())
 
 	case relTabNamesLoadedMsg:
 		if m.names == nil {
@@ -152,8 +176,19 @@ func (m RelationshipsModel) Update(msg tea.Msg) (RelationshipsModel, tea.Cmd) {
 		for id, name := range msg.names {
 			m.names[id] = name
 		}
+		if m.entityTypes == nil {
+			m.entityTypes = map[string]string{}
+		}
+		for id, typ := range msg.types {
+			m.entityTypes[id] = typ
+		}
 		m.applyListFilter()
 		return m, nil
+	case splitPaneLoadedMsg:
+		if msg.kind == splitPaneRelationship {
+			m.pane.store(msg)
+		}
+		return m, nil
 	case relTabScopesLoadedMsg:
 		m.scopeOptions = msg.options
 		m.editMeta.SetScopeOptions(m.scopeOptions)
@@ -169,7 +204,7 @@ func (m RelationshipsModel) Update(msg tea.Msg) (RelationshipsModel, tea.Cmd) {
 		return m, nil
 
 	case relTabResultsMsg:
-		if strings.TrimSpace(msg.query) != strings.TrimSpace(m.createQuery) {
+		if strings.TrimSpace(msg.query) != strings.TrimSpace(m.createQuery.Value) {
 			return m, nil
 		}
 		m.createLoading = false
@@ -255,12 +290,21 @@ func (m RelationshipsModel) handleListKeys(msg tea.KeyMsg) (RelationshipsModel,
 	switch {
 	case isDown(msg):
 		m.list.Down()
+		return m, m.syncSplitPane()
 	case isUp(msg):
 		if m.list.Selected() == 0 {
 			m.modeFocus = true
 		} else {
 			m.list.Up()
 		}
+		return m, m.syncSplitPane()
+	case isKey(msg, "ctrl+p"):
+		m.pane.open = !m.pane.open
+		return m, m.syncSplitPane()
+	case isKey(msg, "ctrl+a"):
+		m.showArchived = !m.showArchived
+		m.loading = true
+		return m, m.loadRelationships()
 	case isEnter(msg), isSpace(msg):
 		if rel := m.selectedRelationship(); rel != nil {
 			m.detail = rel
@@ -283,20 +327,12 @@ func (m RelationshipsModel) handleFilterInput(msg tea.KeyMsg) (RelationshipsMode
 		m.filtering = false
 	case isBack(msg):
 		m.filtering = false
-		m.filterBuf = ""
+		m.filterBuf.Reset()
 		m.applyListFilter()
-	case isKey(msg, "backspace", "delete"):
-		if len(m.filterBuf) > 0 {
-			m.filterBuf = m.filterBuf[:len(m.filterBuf)-1]
-			m.applyListFilter()
-		}
+	case isSpace(msg) && m.filterBuf.Value == "":
+		return m, nil
 	default:
-		ch := msg.String()
-		if len(ch) == 1 || ch == " " {
-			if ch == " " && m.filterBuf == "" {
-				return m, nil
-			}
-			m.filterBuf += ch
+		if editChanged(&m.filterBuf, msg) {
 			m.applyListFilter()
 		}
 	}
@@ -363,9 +399,21 @@ func (m RelationshipsModel) isAddView() bool {
 // renderList renders render list.
 func (m RelationshipsModel) renderList() string {
 	if m.loading {
-		return "  " + MutedStyle.Render("Loading relationships...")
+		return renderLoadingList("relationships", len(m.items), m.list, m.width, m.renderListBody)
 	}
+	return m.renderListBody()
+}
 
+// renderListBody renders the list without the loading state.
+func (m RelationshipsModel) renderListBody() string {
+	if len(m.items) == 0 && m.showArchived {
+		return components.EmptyStateBox(
+			"Relationships",
+			"No archived relationships found.",
+			[]string{"Press ctrl+a to show active relationships", "Press ctrl+x to open the trash"},
+			m.width,
+		)
+	}
 	if len(m.items) == 0 {
 		return components.EmptyStateBox(
 			"Relationships",
@@ -379,6 +427,10 @@ func (m RelationshipsModel) renderList() string {
 	visible := m.list.Visible()
 
 	previewWidth := preferredPreviewWidth(contentWidth)
+	split := m.pane.active(m.width)
+	if split {
+		previewWidth = splitPaneWidth(contentWidth)
+	}
 
 	gap := 3
 	tableWidth := contentWidth
@@ -430,7 +482,7 @@ func (m RelationshipsModel) renderList() string {
 		}
 		source := m.displayNode(rel.SourceID, rel.SourceType, rel.SourceName)
 		target := m.displayNode(rel.TargetID, rel.TargetType, rel.TargetName)
-		edge := components.SanitizeOneLine(fmt.Sprintf("%s -> %s", source, target))
+		edge := m.renderEdge(rel, source, target, edgeWidth)
 		status := strings.TrimSpace(components.SanitizeOneLine(rel.Status))
 		if status == "" {
 			status = "-"
@@ -442,7 +494,7 @@ func (m RelationshipsModel) renderList() string {
 		}
 		tableRows = append(tableRows, []string{
 			components.ClampTextWidthEllipsis(relType, relWidth),
-			components.ClampTextWidthEllipsis(edge, edgeWidth),
+			edge,
 			components.ClampTextWidthEllipsis(status, statusWidth),
 			when,
 		})
@@ -453,15 +505,21 @@ func (m RelationshipsModel) renderList() string {
 
 	title := "Relationships"
 	count := fmt.Sprintf("%d total", len(m.items))
-	if query := strings.TrimSpace(m.filterBuf); query != "" {
+	if m.showArchived {
+		count = fmt.Sprintf("%d archived", len(m.items))
+	}
+	if query := strings.TrimSpace(m.filterBuf.Value); query != "" {
 		count = fmt.Sprintf("%s · filter: %s", count, query)
 	}
-	countLine := MutedStyle.Render(count)
+	countLine := MutedStyle.Render(count) + renderLoadLatency(m.loadLatency)
 
 	table := components.TableGridWithActiveRow(cols, tableRows, tableWidth, activeRowRel)
 	preview := ""
 	if previewItem != nil {
 		content := m.renderRelationshipPreview(*previewItem, previewBoxContentWidth(previewWidth))
+		if split {
+			content = m.renderRelationshipSplitPane(*previewItem, previewBoxContentWidth(previewWidth))
+		}
 		preview = renderPreviewBox(content, previewWidth)
 	}
 
@@ -494,6 +552,14 @@ func (m RelationshipsModel) handleDetailKeys(msg tea.KeyMsg) (RelationshipsModel
 		m.view = relsViewConfirm
 	case isKey(msg, "m"):
 		m.metaExpanded = !m.metaExpanded
+	case isKey(msg, "s"):
+		if m.detail != nil {
+			return m, openRelationshipNode(m.client, m.detail.SourceType, m.detail.SourceID)
+		}
+	case isKey(msg, "t"):
+		if m.detail != nil {
+			return m, openRelationshipNode(m.client, m.detail.TargetType, m.detail.TargetID)
+		}
 	}
 	return m, nil
 }
@@ -567,6 +633,7 @@ func (m *RelationshipsModel) startEdit() {
 	m.editFocus = relsEditFieldStatus
 	m.editStatusIdx = statusIndex(relsStatusOptions, m.detail.Status)
 	m.editMeta.Reset()
+	m.editMeta.Label = "Properties"
 	m.editMeta.Load(map[string]any(m.detail.Properties))
 	m.editSaving = false
 }
@@ -652,7 +719,7 @@ func (m RelationshipsModel) saveEdit() (RelationshipsModel, tea.Cmd) {
 	input := api.UpdateRelationshipInput{Status: &status}
 	props, err := parseMetadataInput(m.editMeta.Buffer)
 	if err != nil {
-		return m, nil
+		return m, func() tea.Msg { return errMsg{err} }
 	}
 	props = mergeMetadataScopes(props, m.editMeta.Scopes)
 	if len(props) > 0 {
@@ -713,12 +780,12 @@ func (m RelationshipsModel) renderConfirm() string {
 // --- Create ---
 
 func (m *RelationshipsModel) startCreate() {
-	m.createQuery = ""
+	m.createQuery.Reset()
 	m.createResults = nil
 	m.createList.SetItems(nil)
 	m.createSource = nil
 	m.createTarget = nil
-	m.createType = ""
+	m.createType.Reset()
 	m.createTypeResults = nil
 	m.createTypeList.SetItems(nil)
 	m.createTypeNav = false
@@ -731,8 +798,8 @@ func (m RelationshipsModel) handleCreateKeys(msg tea.KeyMsg) (RelationshipsModel
 	case relsViewCreateSourceSearch:
 		switch {
 		case isBack(msg):
-			if m.createQuery != "" {
-				m.createQuery = ""
+			if m.createQuery.Value != "" {
+				m.createQuery.Reset()
 				m.createResults = nil
 				m.createList.SetItems(nil)
 				m.createLoading = false
@@ -740,8 +807,8 @@ func (m RelationshipsModel) handleCreateKeys(msg tea.KeyMsg) (RelationshipsModel
 			}
 			m.view = relsViewList
 		case isKey(msg, "cmd+backspace", "cmd+delete", "ctrl+u"):
-			if m.createQuery != "" {
-				m.createQuery = ""
+			if m.createQuery.Value != "" {
+				m.createQuery.Reset()
 				m.createResults = nil
 				m.createList.SetItems(nil)
 				m.createLoading = false
@@ -756,23 +823,15 @@ func (m RelationshipsModel) handleCreateKeys(msg tea.KeyMsg) (RelationshipsModel
 			if idx := m.createList.Selected(); idx < len(m.createResults) {
 				item := m.createResults[idx]
 				m.createSource = &item
-				m.createQuery = ""
+				m.createQuery.Reset()
 				m.createResults = nil
 				m.createList.SetItems(nil)
 				m.view = relsViewCreateTargetSearch
 			}
-		case isKey(msg, "backspace", "delete"):
-			if len(m.createQuery) > 0 {
-				m.createQuery = m.createQuery[:len(m.createQuery)-1]
-				return m, m.updateCreateSearch()
-			}
+		case isSpace(msg) && m.createQuery.Value == "":
+			return m, nil
 		default:
-			ch := msg.String()
-			if len(ch) == 1 || ch == " " {
-				if ch == " " && m.createQuery == "" {
-					return m, nil
-				}
-				m.createQuery += ch
+			if editChanged(&m.createQuery, msg) {
 				return m, m.updateCreateSearch()
 			}
 		}
@@ -788,7 +847,7 @@ func (m RelationshipsModel) handleCreateKeys(msg tea.KeyMsg) (RelationshipsModel
 			if idx := m.createList.Selected(); idx < len(m.createResults) {
 				item := m.createResults[idx]
 				m.createSource = &item
-				m.createQuery = ""
+				m.createQuery.Reset()
 				m.createResults = nil
 				m.createList.SetItems(nil)
 				m.view = relsViewCreateTargetSearch
@@ -797,8 +856,8 @@ func (m RelationshipsModel) handleCreateKeys(msg tea.KeyMsg) (RelationshipsModel
 	case relsViewCreateTargetSearch:
 		switch {
 		case isBack(msg):
-			if m.createQuery != "" {
-				m.createQuery = ""
+			if m.createQuery.Value != "" {
+				m.createQuery.Reset()
 				m.createResults = nil
 				m.createList.SetItems(nil)
 				m.createLoading = false
@@ -806,8 +865,8 @@ func (m RelationshipsModel) handleCreateKeys(msg tea.KeyMsg) (RelationshipsModel
 			}
 			m.view = relsViewCreateSourceSearch
 		case isKey(msg, "cmd+backspace", "cmd+delete", "ctrl+u"):
-			if m.createQuery != "" {
-				m.createQuery = ""
+			if m.createQuery.Value != "" {
+				m.createQuery.Reset()
 				m.createResults = nil
 				m.createList.SetItems(nil)
 				m.createLoading = false
@@ -822,24 +881,16 @@ func (m RelationshipsModel) handleCreateKeys(msg tea.KeyMsg) (RelationshipsModel
 			if idx := m.createList.Selected(); idx < len(m.createResults) {
 				item := m.createResults[idx]
 				m.createTarget = &item
-				m.createQuery = ""
+				m.createQuery.Reset()
 				m.createResults = nil
 				m.createList.SetItems(nil)
 				m.view = relsViewCreateType
 				m.resetTypeSuggestions()
 			}
-		case isKey(msg, "backspace", "delete"):
-			if len(m.createQuery) > 0 {
-				m.createQuery = m.createQuery[:len(m.createQuery)-1]
-				return m, m.updateCreateSearch()
-			}
+		case isSpace(msg) && m.createQuery.Value == "":
+			return m, nil
 		default:
-			ch := msg.String()
-			if len(ch) == 1 || ch == " " {
-				if ch == " " && m.createQuery == "" {
-					return m, nil
-				}
-				m.createQuery += ch
+			if editChanged(&m.createQuery, msg) {
 				return m, m.updateCreateSearch()
 			}
 		}
@@ -855,7 +906,7 @@ func (m RelationshipsModel) handleCreateKeys(msg tea.KeyMsg) (RelationshipsModel
 			if idx := m.createList.Selected(); idx < len(m.createResults) {
 				item := m.createResults[idx]
 				m.createTarget = &item
-				m.createQuery = ""
+				m.createQuery.Reset()
 				m.createResults = nil
 				m.createList.SetItems(nil)
 				m.view = relsViewCreateType
@@ -876,40 +927,47 @@ func (m RelationshipsModel) handleCreateKeys(msg tea.KeyMsg) (RelationshipsModel
 				m.createTypeNav = true
 				m.createTypeList.Up()
 			}
+		case isKey(msg, "tab"):
+			if len(m.createTypeResults) > 0 {
+				idx := 0
+				if m.createTypeNav {
+					idx = m.createTypeList.Selected()
+				}
+				if idx >= 0 && idx < len(m.createTypeResults) {
+					m.createType.SetValue(m.createTypeResults[idx])
+					m.createTypeNav = false
+					m.updateTypeSuggestions()
+				}
+			}
 		case isEnter(msg):
-			kind := strings.TrimSpace(m.createType)
+			kind := strings.TrimSpace(m.createType.Value)
 			if m.createTypeNav && len(m.createTypeResults) > 0 {
 				if idx := m.createTypeList.Selected(); idx < len(m.createTypeResults) {
 					kind = m.createTypeResults[idx]
 				}
 			}
+			kind = m.typeRules.canonical(kind)
 			if kind == "" || m.createSource == nil || m.createTarget == nil {
 				return m, nil
 			}
+			if err := m.typeRules.check(kind, m.createSource.entityType(), m.createTarget.entityType()); err != nil {
+				return m, func() tea.Msg { return errMsg{err} }
+			}
 			m.view = relsViewList
 			m.loading = true
 			return m, m.createRelationship(*m.createSource, *m.createTarget, kind)
-		case isKey(msg, "backspace", "delete"):
-			if len(m.createType) > 0 {
-				m.createType = m.createType[:len(m.createType)-1]
-				m.createTypeNav = false
-				m.updateTypeSuggestions()
-			}
 		case isKey(msg, "cmd+backspace", "cmd+delete", "ctrl+u"):
-			if m.createType != "" {
-				m.createType = ""
+			if m.createType.Value != "" {
+				m.createType.Reset()
 				m.createTypeNav = false
 				m.updateTypeSuggestions()
 				return m, nil
 			}
 			m.view = relsViewCreateTargetSearch
+		case isSpace(msg) && m.createType.Value == "":
+			return m, nil
 		default:
-			ch := msg.String()
-			if len(ch) == 1 || ch == " " {
-				if ch == " " && m.createType == "" {
-					return m, nil
-				}
-				m.createType += ch
+			if editChanged(&m.createType, msg) {
 				m.createTypeNav = false
 				m.updateTypeSuggestions()
 			}
@@ -934,13 +992,12 @@ func (m RelationshipsModel) renderCreate() string {
 // renderCreateSearch renders render create search.
 func (m RelationshipsModel) renderCreateSearch(title string) string {
 	var b strings.Builder
-	b.WriteString(MetaKeyStyle.Render("Search") + MetaPunctStyle.Render(": ") + SelectedStyle.Render(components.SanitizeText(m.createQuery)))
-	b.WriteString(AccentStyle.Render("█"))
+	b.WriteString(MetaKeyStyle.Render("Search") + MetaPunctStyle.Render(": ") + m.createQuery.View(AccentStyle, 0))
 	b.WriteString("\n\n")
 
 	if m.createLoading {
 		b.WriteString(MutedStyle.Render("Searching..."))
-	} else if strings.TrimSpace(m.createQuery) == "" {
+	} else if strings.TrimSpace(m.createQuery.Value) == "" {
 		b.WriteString(MutedStyle.Render("Type to search."))
 	} else if len(m.createResults) == 0 {
 		b.WriteString(MutedStyle.Render("No matches."))
@@ -1019,9 +1076,13 @@ func (m RelationshipsModel) renderCreateSearch(title string) string {
 				activeRowRel = len(tableRows)
 			}
 
+			kindCell := components.ClampTextWidthEllipsis(kind, kindWidth)
+			if entityType, ok := strings.CutPrefix(kind, "entity/"); ok {
+				kindCell = renderEntityTypeCell(entityType, kind, kindWidth)
+			}
 			tableRows = append(tableRows, []string{
 				components.ClampTextWidthEllipsis(name, nameWidth),
-				components.ClampTextWidthEllipsis(kind, kindWidth),
+				kindCell,
 				components.ClampTextWidthEllipsis(status, statusWidth),
 			})
 		}
@@ -1052,11 +1113,10 @@ func (m RelationshipsModel) renderCreateSearch(title string) string {
 // renderCreateType renders render create type.
 func (m RelationshipsModel) renderCreateType() string {
 	var b strings.Builder
-	b.WriteString(MetaKeyStyle.Render("Type") + MetaPunctStyle.Render(": ") + SelectedStyle.Render(components.SanitizeText(m.createType)))
-	b.WriteString(AccentStyle.Render("█"))
+	b.WriteString(MetaKeyStyle.Render("Type") + MetaPunctStyle.Render(": ") + m.createType.View(AccentStyle, 0))
 	b.WriteString("\n\n")
 
-	if strings.TrimSpace(m.createType) == "" && len(m.typeOptions) == 0 {
+	if strings.TrimSpace(m.createType.Value) == "" && len(m.typeOptions) == 0 {
 		b.WriteString(MutedStyle.Render("Type a relationship type."))
 	} else if len(m.createTypeResults) == 0 {
 		b.WriteString(MutedStyle.Render("No suggestions."))
@@ -1204,15 +1264,20 @@ func (m RelationshipsModel) renderCreateTypePreview(suggestion string, width int
 // --- Helpers ---
 
 func (m RelationshipsModel) loadRelationships() tea.Cmd {
+	queued := time.Now()
 	return func() tea.Msg {
+		category := "active"
+		if m.showArchived {
+			category = "archived"
+		}
 		items, err := m.client.QueryRelationships(api.QueryParams{
-			"status_category": "active",
+			"status_category": category,
 			"limit":           "50",
 		})
 		if err != nil {
-			return errMsg{err}
+			return loadFailed(tabRelations, err, m.loadRelationships())
 		}
-		return relTabLoadedMsg{items: items}
+		return relTabLoadedMsg{items: items, queued: queued}
 	}
 }
 
@@ -1223,6 +1288,7 @@ func (m RelationshipsModel) loadRelationshipNames(items []api.Relationship) tea.
 	}
 	return func() tea.Msg {
 		names := map[string]string{}
+		types := map[string]string{}
 		ids := map[string]string{}
 		for _, rel := range items {
 			if strings.TrimSpace(rel.SourceName) != "" {
@@ -1246,6 +1312,9 @@ func (m RelationshipsModel) loadRelationshipNames(items []api.Relationship) tea.
 				if err == nil && ent != nil && ent.Name != "" {
 					names[id] = ent.Name
 				}
+				if err == nil && ent != nil && ent.Type != "" {
+					types[id] = ent.Type
+				}
 			case "context":
 				ki, err := m.client.GetContext(id)
 				if err == nil && ki != nil && ki.Name != "" {
@@ -1258,7 +1327,7 @@ func (m RelationshipsModel) loadRelationshipNames(items []api.Relationship) tea.
 				}
 			}
 		}
-		return relTabNamesLoadedMsg{names: names}
+		return relTabNamesLoadedMsg{names: names, types: types}
 	}
 }
 
@@ -1280,7 +1349,7 @@ func (m RelationshipsModel) buildListLabels() []string {
 
 // applyListFilter handles apply list filter.
 func (m *RelationshipsModel) applyListFilter() {
-	query := strings.ToLower(strings.TrimSpace(m.filterBuf))
+	query := strings.ToLower(strings.TrimSpace(m.filterBuf.Value))
 	if query == "" {
 		m.items = append([]api.Relationship{}, m.allItems...)
 	} else {
@@ -1324,6 +1393,24 @@ func (m RelationshipsModel) displayNode(id, typ, name string) string {
 	}
 }
 
+// renderEdge renders the `source -> target` cell, drawing entity nodes in
+// their type color once the entity type is known.
+func (m RelationshipsModel) renderEdge(rel api.Relationship, source, target string, width int) string {
+	sourceType, targetType := m.entityTypes[rel.SourceID], m.entityTypes[rel.TargetID]
+	if sourceType == "" && targetType == "" {
+		return components.ClampTextWidthEllipsis(fmt.Sprintf("%s -> %s", source, target), width)
+	}
+	arrow := " -> "
+	half := (width - lipgloss.Width(arrow)) / 2
+	node := func(typ, label string) string {
+		if typ == "" {
+			return components.ClampTextWidthEllipsis(label, half)
+		}
+		return renderEntityTypeCell(typ, label, half)
+	}
+	return node(sourceType, source) + arrow + node(targetType, target)
+}
+
 // selectedRelationship handles selected relationship.
 func (m RelationshipsModel) selectedRelationship() *api.Relationship {
 	if len(m.items) == 0 {
@@ -1376,7 +1463,7 @@ func (m RelationshipsModel) createRelationship(source relationshipCreateCandidat
 
 // updateCreateSearch updates update create search.
 func (m *RelationshipsModel) updateCreateSearch() tea.Cmd {
-	query := strings.TrimSpace(m.createQuery)
+	query := strings.TrimSpace(m.createQuery.Value)
 	if query == "" {
 		m.createLoading = false
 		m.createResults = nil
@@ -1400,17 +1487,25 @@ func (m *RelationshipsModel) updateCreateSearch() tea.Cmd {
 
 // resetTypeSuggestions handles reset type suggestions.
 func (m *RelationshipsModel) resetTypeSuggestions() {
-	m.createTypeResults = filterRelationshipTypes(m.typeOptions, "")
+	m.createTypeResults = filterRelationshipTypes(m.allowedTypeOptions(), "")
 	m.createTypeList.SetItems(m.createTypeResults)
 	m.createTypeNav = false
 }
 
 // updateTypeSuggestions updates update type suggestions.
 func (m *RelationshipsModel) updateTypeSuggestions() {
-	m.createTypeResults = filterRelationshipTypes(m.typeOptions, m.createType)
+	m.createTypeResults = filterRelationshipTypes(m.allowedTypeOptions(), m.createType.Value)
 	m.createTypeList.SetItems(m.createTypeResults)
 }
 
+// allowedTypeOptions drops managed types that reject the chosen endpoints.
+func (m RelationshipsModel) allowedTypeOptions() []string {
+	if m.createSource == nil || m.createTarget == nil {
+		return m.typeOptions
+	}
+	return m.typeRules.filter(m.typeOptions, m.createSource.entityType(), m.createTarget.entityType())
+}
+
 // loadScopeOptions loads load scope options.
 func (m RelationshipsModel) loadScopeOptions() tea.Cmd {
 	if m.client == nil {
@@ -1495,17 +1590,10 @@ func filterRelationshipTypes(options []string, query string) []string {
 	if len(options) == 0 {
 		return nil
 	}
-	q := strings.ToLower(strings.TrimSpace(query))
-	if q == "" {
+	if strings.TrimSpace(query) == "" {
 		return append([]string{}, options...)
 	}
-	out := make([]string, 0, len(options))
-	for _, opt := range options {
-		if strings.Contains(strings.ToLower(opt), q) {
-			out = append(out, opt)
-		}
-	}
-	return out
+	return rankSuggestions(options, query, nil)
 }
 
 // combineCreateCandidates handles combine create candidates.
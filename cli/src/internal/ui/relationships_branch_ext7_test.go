@@ -23,7 +23,7 @@ func TestRelationshipsUpdateAdditionalBranches(t *testing.T) {
 	require.NotNil(t, updated.names)
 	assert.Equal(t, "Alpha", updated.names["ent-1"])
 
-	updated.createQuery = "fresh"
+	updated.createQuery.Value = "fresh"
 	updated.createLoading = true
 	updated.createResults = []relationshipCreateCandidate{{ID: "old"}}
 	updated, cmd = updated.Update(relTabResultsMsg{
@@ -62,7 +62,7 @@ func TestRelationshipsViewAndListFilterRouteBranches(t *testing.T) {
 	model.filtering = true
 	updated, cmd := model.handleListKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
 	require.Nil(t, cmd)
-	assert.Equal(t, "x", updated.filterBuf)
+	assert.Equal(t, "x", updated.filterBuf.Value)
 }
 
 func TestRelationshipsRenderListTinyWidthAndPreviewGuardBranches(t *testing.T) {
@@ -138,10 +138,10 @@ func TestRelationshipsCreateKeyAdditionalBranches(t *testing.T) {
 
 	updated, cmd := model.handleCreateKeys(tea.KeyMsg{Type: tea.KeySpace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.createQuery)
+	assert.Equal(t, "", updated.createQuery.Value)
 
 	updated.view = relsViewCreateTargetSearch
-	updated.createQuery = "ab"
+	updated.createQuery.Value = "ab"
 	updated.createResults = []relationshipCreateCandidate{{ID: "ent-1"}}
 	updated.createList.SetItems([]string{"ent-1"})
 	updated.createLoading = true
@@ -149,22 +149,22 @@ func TestRelationshipsCreateKeyAdditionalBranches(t *testing.T) {
 	updated, cmd = updated.handleCreateKeys(tea.KeyMsg{Type: tea.KeyEsc})
 	require.Nil(t, cmd)
 	assert.Equal(t, relsViewCreateTargetSearch, updated.view)
-	assert.Equal(t, "", updated.createQuery)
+	assert.Equal(t, "", updated.createQuery.Value)
 	assert.Empty(t, updated.createResults)
 	assert.Empty(t, updated.createList.Items)
 	assert.False(t, updated.createLoading)
 
 	updated.entityCache = []api.Entity{{ID: "ent-1", Name: "alpha", Type: "person", Status: "active"}}
-	updated.createQuery = "al"
+	updated.createQuery.Value = "al"
 	updated, cmd = updated.handleCreateKeys(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "a", updated.createQuery)
+	assert.Equal(t, "a", updated.createQuery.Value)
 	assert.NotEmpty(t, updated.createResults)
 
-	updated.createQuery = ""
+	updated.createQuery.Value = ""
 	updated, cmd = updated.handleCreateKeys(tea.KeyMsg{Type: tea.KeySpace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.createQuery)
+	assert.Equal(t, "", updated.createQuery.Value)
 
 	updated.view = relsViewCreateType
 	updated, cmd = updated.handleCreateKeys(tea.KeyMsg{Type: tea.KeyEsc})
@@ -173,12 +173,12 @@ func TestRelationshipsCreateKeyAdditionalBranches(t *testing.T) {
 
 	updated.view = relsViewCreateType
 	updated.typeOptions = []string{"depends-on"}
-	updated.createType = "de"
+	updated.createType.Value = "de"
 	updated.createTypeNav = true
 	updated.resetTypeSuggestions()
 	updated, cmd = updated.handleCreateKeys(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "d", updated.createType)
+	assert.Equal(t, "d", updated.createType.Value)
 	assert.False(t, updated.createTypeNav)
 }
 
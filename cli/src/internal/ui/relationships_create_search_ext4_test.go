@@ -10,7 +10,7 @@ import (
 func TestRelationshipsRenderCreateSearchSideBySidePreviewBranch(t *testing.T) {
 	model := NewRelationshipsModel(nil)
 	model.width = 220
-	model.createQuery = "alpha"
+	model.createQuery.Value = "alpha"
 	model.createResults = []relationshipCreateCandidate{
 		{ID: "ent-1", NodeType: "entity", Name: "Alpha", Kind: "entity/person", Status: "active"},
 		{ID: "ctx-1", NodeType: "context", Name: "Note", Kind: "context/note", Status: "inactive"},
@@ -28,7 +28,7 @@ func TestRelationshipsRenderCreateSearchSideBySidePreviewBranch(t *testing.T) {
 func TestRelationshipsRenderCreateSearchNoPreviewWhenCursorOutOfRange(t *testing.T) {
 	model := NewRelationshipsModel(nil)
 	model.width = 220
-	model.createQuery = "alpha"
+	model.createQuery.Value = "alpha"
 	model.createResults = []relationshipCreateCandidate{
 		{ID: "ent-1", NodeType: "entity", Name: "Alpha", Kind: "entity/person", Status: "active"},
 	}
@@ -44,7 +44,7 @@ func TestRelationshipsRenderCreateSearchNoPreviewWhenCursorOutOfRange(t *testing
 func TestRelationshipsRenderCreateSearchVeryNarrowWidthColumnFallbacks(t *testing.T) {
 	model := NewRelationshipsModel(nil)
 	model.width = 30
-	model.createQuery = "a"
+	model.createQuery.Value = "a"
 	model.createResults = []relationshipCreateCandidate{
 		{ID: "ent-1", NodeType: "", Name: "", Kind: "", Status: ""},
 	}
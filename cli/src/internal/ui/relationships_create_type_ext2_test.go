@@ -10,7 +10,7 @@ import (
 func TestRelationshipsRenderCreateTypeShowsPreviewForSelectedSuggestion(t *testing.T) {
 	model := NewRelationshipsModel(nil)
 	model.width = 220
-	model.createType = "dep"
+	model.createType.Value = "dep"
 	model.createTypeResults = []string{"depends-on", "related-to"}
 	model.createTypeList.SetItems(model.createTypeResults)
 	model.createTypeList.Cursor = 0
@@ -25,7 +25,7 @@ func TestRelationshipsRenderCreateTypeShowsPreviewForSelectedSuggestion(t *testi
 func TestRelationshipsRenderCreateTypeBlankSuggestionFallbackBranch(t *testing.T) {
 	model := NewRelationshipsModel(nil)
 	model.width = 220
-	model.createType = "x"
+	model.createType.Value = "x"
 	model.createTypeResults = []string{""}
 	model.createTypeList.SetItems(model.createTypeResults)
 	model.createTypeList.Cursor = 0
@@ -40,7 +40,7 @@ func TestRelationshipsRenderCreateTypeBlankSuggestionFallbackBranch(t *testing.T
 func TestRelationshipsRenderCreateTypeSkipsOutOfRangeVisibleRows(t *testing.T) {
 	model := NewRelationshipsModel(nil)
 	model.width = 220
-	model.createType = "dep"
+	model.createType.Value = "dep"
 	model.createTypeResults = []string{"depends-on"}
 	// Keep list items longer than createTypeResults so RelToAbs produces
 	// out-of-range indexes for part of visible rows.
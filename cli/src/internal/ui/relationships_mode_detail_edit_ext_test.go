@@ -194,7 +194,9 @@ func TestRelationshipsHandleEditKeysBranchMatrix(t *testing.T) {
 		model.editMeta.Buffer = "bad metadata line"
 
 		updated, cmd := model.handleEditKeys(tea.KeyMsg{Type: tea.KeyCtrlS})
-		require.Nil(t, cmd)
+		require.NotNil(t, cmd)
+		_, ok := cmd().(errMsg)
+		assert.True(t, ok)
 		assert.False(t, updated.editSaving)
 	})
 
@@ -235,39 +237,39 @@ func TestRelationshipsHandleFilterInputBranchMatrix(t *testing.T) {
 
 	updated, cmd := model.handleFilterInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
 	require.Nil(t, cmd)
-	assert.Equal(t, "a", updated.filterBuf)
+	assert.Equal(t, "a", updated.filterBuf.Value)
 
 	updated, cmd = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeySpace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "a ", updated.filterBuf)
+	assert.Equal(t, "a ", updated.filterBuf.Value)
 
 	updated, cmd = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyBackspace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "a", updated.filterBuf)
+	assert.Equal(t, "a", updated.filterBuf.Value)
 
-	updated.filterBuf = ""
+	updated.filterBuf.Value = ""
 	updated, cmd = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeySpace})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.filterBuf)
+	assert.Equal(t, "", updated.filterBuf.Value)
 
-	updated.filterBuf = ""
+	updated.filterBuf.Value = ""
 	updated, cmd = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyTab})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.filterBuf)
+	assert.Equal(t, "", updated.filterBuf.Value)
 
-	updated.filterBuf = "dep"
+	updated.filterBuf.Value = "dep"
 	updated, cmd = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyEsc})
 	require.Nil(t, cmd)
 	assert.False(t, updated.filtering)
-	assert.Equal(t, "", updated.filterBuf)
+	assert.Equal(t, "", updated.filterBuf.Value)
 	require.Len(t, updated.items, 1)
 
 	updated.filtering = true
-	updated.filterBuf = "x"
+	updated.filterBuf.Value = "x"
 	updated, cmd = updated.handleFilterInput(tea.KeyMsg{Type: tea.KeyEnter})
 	require.Nil(t, cmd)
 	assert.False(t, updated.filtering)
-	assert.Equal(t, "x", updated.filterBuf)
+	assert.Equal(t, "x", updated.filterBuf.Value)
 }
 
 func TestRelationshipsRenderModeLineStateVariants(t *testing.T) {
@@ -477,7 +479,7 @@ func TestRelationshipsLoadRelationshipsSuccessAndError(t *testing.T) {
 	errModel := NewRelationshipsModel(errClient)
 	cmd = errModel.loadRelationships()
 	require.NotNil(t, cmd)
-	errOut, ok := cmd().(errMsg)
+	errOut, ok := cmd().(loadFailedMsg)
 	require.True(t, ok)
 	assert.ErrorContains(t, errOut.err, "REL_FAILED")
 }
@@ -524,7 +526,7 @@ func TestRelationshipsHandleListKeysAdditionalBranches(t *testing.T) {
 	updated, cmd = updated.handleListKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
 	require.Nil(t, cmd)
 	assert.Equal(t, relsViewCreateSourceSearch, updated.view)
-	assert.Equal(t, "", updated.createQuery)
+	assert.Equal(t, "", updated.createQuery.Value)
 }
 
 func TestRelationshipsViewBranchMatrix(t *testing.T) {
@@ -0,0 +1,58 @@
+package ui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+// syncSplitPane loads history for the selected relationship when the split
+// pane is showing.
+func (m *RelationshipsModel) syncSplitPane() tea.Cmd {
+	if !m.pane.active(m.width) {
+		return nil
+	}
+	rel := m.selectedRelationship()
+	if rel == nil {
+		return nil
+	}
+	return m.pane.request(m.client, splitPaneRelationship, rel.ID)
+}
+
+// renderRelationshipSplitPane renders the full detail pane for the selected
+// relationship.
+func (m RelationshipsModel) renderRelationshipSplitPane(rel api.Relationship, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	relType := strings.TrimSpace(rel.Type)
+	if relType == "" {
+		relType = "-"
+	}
+	status := strings.TrimSpace(rel.Status)
+	if status == "" {
+		status = "-"
+	}
+
+	var lines []string
+	lines = append(lines, MetaKeyStyle.Render("Selected"))
+	for _, part := range wrapPreviewText(relType, width) {
+		lines = append(lines, SelectedStyle.Render(part))
+	}
+	lines = append(lines, "")
+	lines = append(lines, renderPreviewRow("ID", rel.ID, width))
+	lines = append(lines, renderPreviewRow("Status", status, width))
+	lines = append(lines, renderPreviewRow("Source", m.displayNode(rel.SourceID, rel.SourceType, rel.SourceName), width))
+	lines = append(lines, renderPreviewRow("Target", m.displayNode(rel.TargetID, rel.TargetType, rel.TargetName), width))
+	lines = append(lines, renderPreviewRow("Created", formatLocalTimeFull(rel.CreatedAt), width))
+	if props := metadataPreview(map[string]any(rel.Properties), 240); props != "" {
+		lines = append(lines, "", MetaKeyStyle.Render("Properties"))
+		for _, part := range wrapPreviewText(humanizeGoMapString(props), width) {
+			lines = append(lines, MetaValueStyle.Render(part))
+		}
+	}
+	lines = append(lines, m.pane.renderSections(rel.ID, false, width)...)
+	return padPreviewLines(lines, width)
+}
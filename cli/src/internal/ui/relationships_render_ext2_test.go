@@ -55,7 +55,7 @@ func TestRelationshipsRenderCreateTypePreviewGuardAndFallbacks(t *testing.T) {
 func TestRelationshipsRenderCreateSearchWithNarrowWidthStillShowsResults(t *testing.T) {
 	model := NewRelationshipsModel(nil)
 	model.width = 40
-	model.createQuery = "alpha"
+	model.createQuery.Value = "alpha"
 	model.createResults = []relationshipCreateCandidate{
 		{ID: "ent-1", NodeType: "entity", Name: "Alpha", Kind: "entity/person", Status: "active"},
 	}
@@ -69,7 +69,7 @@ func TestRelationshipsRenderCreateSearchWithNarrowWidthStillShowsResults(t *test
 func TestRelationshipsRenderCreateTypeWithoutSelectedSuggestionPreview(t *testing.T) {
 	model := NewRelationshipsModel(nil)
 	model.width = 88
-	model.createType = "dep"
+	model.createType.Value = "dep"
 	model.createTypeResults = []string{"depends-on"}
 	model.createTypeList.SetItems([]string{"depends-on"})
 	model.createTypeList.Cursor = 9 // out of range, keeps selectedSuggestion empty
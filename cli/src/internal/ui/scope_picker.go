@@ -0,0 +1,188 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// scopePickerRows is how many scopes the picker shows at once.
+const scopePickerRows = 8
+
+// scopeInfo describes a scope in the picker.
+type scopeInfo struct {
+	description string
+	entities    int
+	knowledge   int
+	agents      int
+}
+
+// scopeInfoByName indexes audit scopes by name for the picker.
+func scopeInfoByName(scopes []api.AuditScope) map[string]scopeInfo {
+	info := make(map[string]scopeInfo, len(scopes))
+	for _, scope := range scopes {
+		desc := ""
+		if scope.Description != nil {
+			desc = strings.TrimSpace(*scope.Description)
+		}
+		info[scope.Name] = scopeInfo{
+			description: desc,
+			entities:    scope.EntityCount,
+			knowledge:   scope.ContextCount,
+			agents:      scope.AgentCount,
+		}
+	}
+	return info
+}
+
+// scopePicker is the searchable scope multi-select shared by the entity,
+// knowledge, and file forms. The form owns the selected scopes and whether
+// the picker is open; the picker owns the search query and cursor.
+type scopePicker struct {
+	query components.TextInput
+	idx   int
+}
+
+// Reset clears the query and cursor.
+func (p *scopePicker) Reset() {
+	p.query.Reset()
+	p.idx = 0
+}
+
+// matches returns the options whose name or description contains the query.
+// With no options loaded, the current selection is offered instead.
+func (p scopePicker) matches(options, selected []string, info map[string]scopeInfo) []string {
+	if len(options) == 0 {
+		options = append([]string{}, selected...)
+	}
+	query := strings.ToLower(strings.TrimSpace(p.query.Value))
+	if query == "" {
+		return options
+	}
+	out := make([]string, 0, len(options))
+	for _, opt := range options {
+		if strings.Contains(strings.ToLower(opt), query) ||
+			strings.Contains(strings.ToLower(info[opt].description), query) {
+			out = append(out, opt)
+		}
+	}
+	return out
+}
+
+// HandleKey applies a key to the picker. It returns the updated selection,
+// whether the picker stays open, and whether the key was consumed; keys it
+// does not consume (e.g. ctrl+s, or backspace with an empty query) fall
+// through to the form.
+func (p *scopePicker) HandleKey(msg tea.KeyMsg, options, selected []string, info map[string]scopeInfo) ([]string, bool, bool) {
+	matches := p.matches(options, selected, info)
+	switch {
+	case isKey(msg, "left", "up", "shift+tab"):
+		if len(matches) > 0 {
+			p.idx = (p.idx - 1 + len(matches)) % len(matches)
+		}
+	case isKey(msg, "right", "down", "tab"):
+		if len(matches) > 0 {
+			p.idx = (p.idx + 1) % len(matches)
+		}
+	case isSpace(msg):
+		if p.idx < len(matches) {
+			selected = toggleScope(selected, matches[p.idx])
+		}
+	case isEnter(msg):
+		p.Reset()
+		return selected, false, true
+	case isBack(msg):
+		if p.query.Value != "" {
+			p.Reset()
+			return selected, true, true
+		}
+		p.Reset()
+		return selected, false, true
+	case isKey(msg, "backspace", "delete"):
+		if p.query.Value == "" {
+			return selected, true, false
+		}
+		p.query.Backspace()
+		p.idx = 0
+	default:
+		text, ok := typedText(msg)
+		if !ok {
+			return selected, true, false
+		}
+		p.query.Insert(text)
+		p.idx = 0
+	}
+	return selected, true, true
+}
+
+// Render renders the search line, a window of matching scopes with their
+// descriptions and member counts, and a summary line.
+func (p scopePicker) Render(options, selected []string, info map[string]scopeInfo, width int) string {
+	matches := p.matches(options, selected, info)
+	var b strings.Builder
+	b.WriteString("  " + MutedStyle.Render("search: ") + p.query.View(AccentStyle, 0))
+	if len(matches) == 0 {
+		if len(options) == 0 && len(selected) == 0 {
+			b.WriteString("\n  " + MutedStyle.Render("no scopes available"))
+		} else {
+			b.WriteString("\n  " + MutedStyle.Render("no scopes match"))
+		}
+	}
+	start := 0
+	if p.idx >= scopePickerRows {
+		start = p.idx - scopePickerRows + 1
+	}
+	end := min(start+scopePickerRows, len(matches))
+	for i := start; i < end; i++ {
+		name := matches[i]
+		box := "[ ]"
+		style := NormalStyle
+		if scopeSelected(selected, name) {
+			box = "[x]"
+			style = SelectedStyle
+		}
+		cursor := "  "
+		if i == p.idx {
+			cursor = "> "
+			style = AccentStyle
+		}
+		line := cursor + style.Render(box+" "+name)
+		room := components.BoxContentWidth(width) - lipgloss.Width(line) - 6
+		if detail := scopeInfoSummary(info[name]); detail != "" && room > 8 {
+			line += "  " + MutedStyle.Render(components.ClampTextWidthEllipsis(detail, room))
+		}
+		b.WriteString("\n  " + line)
+	}
+	b.WriteString("\n  " + MutedStyle.Render(fmt.Sprintf(
+		"%d of %d · %d selected · type to search · space toggle · enter done",
+		len(matches), max(len(options), len(selected)), len(selected))))
+	return b.String()
+}
+
+// scopeInfoSummary renders a scope's description and member counts.
+func scopeInfoSummary(info scopeInfo) string {
+	var parts []string
+	if info.description != "" {
+		parts = append(parts, info.description)
+	}
+	if counts := info.entities + info.knowledge + info.agents; counts > 0 {
+		parts = append(parts, fmt.Sprintf("%s · %d knowledge · %s",
+			scopeCount(info.entities, "entity", "entities"),
+			info.knowledge,
+			scopeCount(info.agents, "agent", "agents")))
+	}
+	return strings.Join(parts, " — ")
+}
+
+// scopeCount formats n with the singular or plural noun.
+func scopeCount(n int, singular, plural string) string {
+	if n == 1 {
+		return "1 " + singular
+	}
+	return fmt.Sprintf("%d %s", n, plural)
+}
@@ -0,0 +1,106 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func testScopeInfo() map[string]scopeInfo {
+	desc := "Finance team records"
+	return scopeInfoByName([]api.AuditScope{
+		{Name: "public", EntityCount: 12, ContextCount: 3, AgentCount: 2},
+		{Name: "private", EntityCount: 1},
+		{Name: "ledger", Description: &desc, AgentCount: 1},
+	})
+}
+
+func TestScopePickerSearchMatchesNameAndDescription(t *testing.T) {
+	options := []string{"public", "private", "ledger"}
+	info := testScopeInfo()
+	var p scopePicker
+
+	for _, r := range "pri" {
+		_, open, handled := p.HandleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}}, options, nil, info)
+		require.True(t, open)
+		require.True(t, handled)
+	}
+	assert.Equal(t, []string{"private"}, p.matches(options, nil, info))
+
+	p.Reset()
+	p.query.Value = "finance"
+	assert.Equal(t, []string{"ledger"}, p.matches(options, nil, info))
+
+	selected, _, _ := p.HandleKey(tea.KeyMsg{Type: tea.KeySpace}, options, nil, info)
+	assert.Equal(t, []string{"ledger"}, selected)
+}
+
+func TestScopePickerEscClearsQueryThenCloses(t *testing.T) {
+	options := []string{"public", "private"}
+	p := scopePicker{query: components.NewTextInput("pub"), idx: 0}
+
+	_, open, handled := p.HandleKey(tea.KeyMsg{Type: tea.KeyEsc}, options, nil, nil)
+	assert.True(t, open)
+	assert.True(t, handled)
+	assert.Empty(t, p.query.Value)
+
+	_, open, _ = p.HandleKey(tea.KeyMsg{Type: tea.KeyEsc}, options, nil, nil)
+	assert.False(t, open)
+}
+
+func TestScopePickerLeavesUnhandledKeysToForm(t *testing.T) {
+	var p scopePicker
+	_, open, handled := p.HandleKey(tea.KeyMsg{Type: tea.KeyCtrlS}, []string{"public"}, nil, nil)
+	assert.True(t, open)
+	assert.False(t, handled)
+
+	_, _, handled = p.HandleKey(tea.KeyMsg{Type: tea.KeyBackspace}, []string{"public"}, nil, nil)
+	assert.False(t, handled)
+}
+
+func TestScopePickerRenderShowsDescriptionsAndCounts(t *testing.T) {
+	p := scopePicker{}
+	out := components.SanitizeText(p.Render([]string{"public", "private", "ledger"}, []string{"public"}, testScopeInfo(), 120))
+
+	assert.Contains(t, out, "> [x] public  12 entities · 3 knowledge · 2 agents")
+	assert.Contains(t, out, "[ ] private  1 entity · 0 knowledge · 0 agents")
+	assert.Contains(t, out, "[ ] ledger  Finance team records — 0 entities · 0 knowledge · 1 agent")
+	assert.Contains(t, out, "3 of 3 · 1 selected")
+
+	p.query.Value = "zzz"
+	out = components.SanitizeText(p.Render([]string{"public"}, nil, nil, 120))
+	assert.Contains(t, out, "no scopes match")
+	assert.Contains(t, out, "0 of 1 · 0 selected")
+}
+
+func TestEntitiesAddScopePickerSearchAndToggle(t *testing.T) {
+	model := NewEntitiesModel(nil)
+	model.width = 120
+	model.view = entitiesViewAdd
+	model.addFocus = addFieldScopes
+	model, _ = model.Update(entityScopesLoadedMsg{
+		names: map[string]string{"s1": "public", "s2": "private", "s3": "ledger"},
+		info:  testScopeInfo(),
+	})
+
+	model, _ = model.handleAddKeys(tea.KeyMsg{Type: tea.KeySpace})
+	require.True(t, model.addScopeSelecting)
+	for _, r := range "led" {
+		model, _ = model.handleAddKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	view := components.SanitizeText(model.renderAdd())
+	assert.Contains(t, view, "search: led")
+	assert.Contains(t, view, "Finance team records")
+	assert.NotContains(t, view, "[ ] public")
+
+	model, _ = model.handleAddKeys(tea.KeyMsg{Type: tea.KeySpace})
+	model, _ = model.handleAddKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.False(t, model.addScopeSelecting)
+	assert.Equal(t, []string{"ledger"}, model.addScopes)
+	assert.Empty(t, model.scopePicker.query.Value)
+}
@@ -0,0 +1,105 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// scopePreview is a record as an agent limited to one scope would read it.
+type scopePreview struct {
+	visible  bool
+	metadata api.JSONMap
+	hidden   int
+}
+
+// previewAsScope applies the server's scope rules for a single agent scope:
+// records with no scopes or sharing the scope are visible, and metadata
+// context segments are kept only when they list the scope.
+func previewAsScope(recordScopes []string, metadata api.JSONMap, scope string) scopePreview {
+	preview := scopePreview{visible: len(recordScopes) == 0}
+	for _, name := range recordScopes {
+		if name == scope {
+			preview.visible = true
+			break
+		}
+	}
+	if !preview.visible {
+		return preview
+	}
+	segments, ok := metadata["context_segments"].([]any)
+	if !ok {
+		preview.metadata = metadata
+		return preview
+	}
+	kept := make([]any, 0, len(segments))
+	for _, raw := range segments {
+		segment, ok := raw.(map[string]any)
+		if ok && segmentHasScope(segment, scope) {
+			kept = append(kept, segment)
+			continue
+		}
+		preview.hidden++
+	}
+	preview.metadata = make(api.JSONMap, len(metadata))
+	for key, value := range metadata {
+		preview.metadata[key] = value
+	}
+	preview.metadata["context_segments"] = kept
+	return preview
+}
+
+// segmentHasScope reports whether a context segment lists scope.
+func segmentHasScope(segment map[string]any, scope string) bool {
+	switch scopes := segment["scopes"].(type) {
+	case []any:
+		for _, item := range scopes {
+			if name, ok := item.(string); ok && name == scope {
+				return true
+			}
+		}
+	case []string:
+		for _, name := range scopes {
+			if name == scope {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// nextPreviewScope cycles from no preview through each scope and back.
+func nextPreviewScope(options []string, current string) string {
+	if len(options) == 0 {
+		return ""
+	}
+	if current == "" {
+		return options[0]
+	}
+	for i, option := range options {
+		if option == current {
+			if i+1 < len(options) {
+				return options[i+1]
+			}
+			return ""
+		}
+	}
+	return ""
+}
+
+// renderScopePreviewBanner renders the notice above a scope preview.
+func renderScopePreviewBanner(scope string, preview scopePreview, width int) string {
+	lines := []string{MetaKeyStyle.Render("Viewing As " + scope)}
+	if !preview.visible {
+		lines = append(lines, MutedStyle.Render(fmt.Sprintf("An agent limited to %q cannot see this record.", scope)))
+		return components.Box(strings.Join(lines, "\n"), width)
+	}
+	lines = append(lines, fmt.Sprintf("Showing what an agent limited to %q sees.", scope))
+	if preview.hidden > 0 {
+		lines = append(lines, WarningStyle.Render(fmt.Sprintf("%d context segment(s) hidden.", preview.hidden)))
+	}
+	lines = append(lines, MutedStyle.Render("Relationships are scoped per record and omitted here. p to cycle scopes."))
+	return components.Box(strings.Join(lines, "\n"), width)
+}
@@ -0,0 +1,90 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func scopedSegmentsMetadata() api.JSONMap {
+	return api.JSONMap{
+		"role": "lead",
+		"context_segments": []any{
+			map[string]any{"text": "public note", "scopes": []any{"public"}},
+			map[string]any{"text": "admin note", "scopes": []any{"admin"}},
+		},
+	}
+}
+
+func TestPreviewAsScopeFiltersSegments(t *testing.T) {
+	metadata := scopedSegmentsMetadata()
+
+	preview := previewAsScope([]string{"public", "admin"}, metadata, "public")
+	assert.True(t, preview.visible)
+	assert.Equal(t, 1, preview.hidden)
+	assert.Len(t, preview.metadata["context_segments"], 1)
+	assert.Equal(t, "lead", preview.metadata["role"])
+	assert.Len(t, metadata["context_segments"], 2, "original metadata is untouched")
+
+	assert.False(t, previewAsScope([]string{"admin"}, metadata, "public").visible)
+	assert.True(t, previewAsScope(nil, metadata, "public").visible)
+}
+
+func TestNextPreviewScopeCycles(t *testing.T) {
+	options := []string{"admin", "public"}
+	assert.Equal(t, "admin", nextPreviewScope(options, ""))
+	assert.Equal(t, "public", nextPreviewScope(options, "admin"))
+	assert.Equal(t, "", nextPreviewScope(options, "public"))
+	assert.Equal(t, "", nextPreviewScope(nil, ""))
+}
+
+func TestEntitiesDetailViewAsScopeRedactsSegments(t *testing.T) {
+	model := NewEntitiesModel(nil)
+	model.width = 100
+	model.scopeNames = map[string]string{"s-pub": "public", "s-adm": "admin"}
+	model.scopeOptions = []string{"admin", "public"}
+	model.view = entitiesViewDetail
+	model.detail = &api.Entity{
+		ID:              "ent-1",
+		Name:            "Alpha",
+		PrivacyScopeIDs: []string{"s-pub", "s-adm"},
+		Metadata:        scopedSegmentsMetadata(),
+	}
+	model.metaExpanded = true
+
+	key := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")}
+	model, _ = model.handleDetailKeys(key)
+	model, _ = model.handleDetailKeys(key)
+	assert.Equal(t, "public", model.viewAsScope)
+
+	out := components.SanitizeText(model.renderDetail())
+	assert.Contains(t, out, "Viewing As public")
+	assert.Contains(t, out, "1 context segment(s) hidden")
+	assert.Contains(t, out, "public note")
+	assert.NotContains(t, out, "admin note")
+
+	model, _ = model.handleDetailKeys(key)
+	assert.Equal(t, "", model.viewAsScope)
+	assert.Contains(t, components.SanitizeText(model.renderDetail()), "admin note")
+}
+
+func TestContextDetailViewAsScopeHidesRecord(t *testing.T) {
+	model := NewContextModel(nil)
+	model.width = 100
+	model.scopeNames = map[string]string{"s-adm": "admin"}
+	model.scopeOptions = []string{"public"}
+	model.view = contextViewDetail
+	model.detail = &api.Context{ID: "ctx-1", Title: "Secret plan", PrivacyScopeIDs: []string{"s-adm"}}
+
+	model, _ = model.handleDetailKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	out := components.SanitizeText(model.renderDetail())
+	assert.Contains(t, out, "cannot see this record")
+	assert.NotContains(t, out, "Secret plan")
+
+	model, _ = model.handleDetailKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, "", model.viewAsScope)
+}
@@ -94,32 +94,3 @@ func renderScopeBadge(scope string) string {
 	}
 	return scopeBadgeStyle(scope).Render("[" + scope + "]")
 }
-
-// renderScopeOptions renders render scope options.
-func renderScopeOptions(selected []string, options []string, idx int) string {
-	if len(options) == 0 {
-		options = append([]string{}, selected...)
-	}
-	if len(options) == 0 {
-		return MutedStyle.Render("no scopes available")
-	}
-	var b strings.Builder
-	for i, opt := range options {
-		label := opt
-		if scopeSelected(selected, opt) {
-			label = "[" + opt + "]"
-		}
-		switch {
-		case i == idx:
-			b.WriteString(AccentStyle.Render(label))
-		case scopeSelected(selected, opt):
-			b.WriteString(SelectedStyle.Render(label))
-		default:
-			b.WriteString(MutedStyle.Render(label))
-		}
-		if i < len(options)-1 {
-			b.WriteString(" ")
-		}
-	}
-	return b.String()
-}
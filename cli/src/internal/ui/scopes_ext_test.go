@@ -62,24 +62,25 @@ func TestRenderScopeBadgeSupportsKnownAndUnknownScopes(t *testing.T) {
 	assert.Equal(t, "[custom-scope]", custom)
 }
 
-func TestRenderScopeOptionsNoScopesAvailableMessage(t *testing.T) {
-	out := stripANSI(renderScopeOptions(nil, nil, 0))
+func TestScopePickerRenderNoScopesAvailableMessage(t *testing.T) {
+	out := stripANSI(scopePicker{}.Render(nil, nil, nil, 80))
 	assert.Contains(t, out, "no scopes available")
 }
 
-func TestRenderScopeOptionsCursorAndSelectionMatrix(t *testing.T) {
-	out := stripANSI(renderScopeOptions(
-		[]string{"private"},
+func TestScopePickerRenderCursorAndSelectionMatrix(t *testing.T) {
+	out := stripANSI(scopePicker{idx: 2}.Render(
 		[]string{"public", "private", "admin"},
-		2,
+		[]string{"private"},
+		nil,
+		80,
 	))
-	assert.Contains(t, out, "public")
-	assert.Contains(t, out, "[private]")
-	assert.Contains(t, out, "admin")
+	assert.Contains(t, out, "[ ] public")
+	assert.Contains(t, out, "[x] private")
+	assert.Contains(t, out, "> [ ] admin")
 }
 
-func TestRenderScopeOptionsFallbackOptionsFromSelected(t *testing.T) {
-	out := stripANSI(renderScopeOptions([]string{"sensitive"}, []string{}, 0))
+func TestScopePickerRenderFallbackOptionsFromSelected(t *testing.T) {
+	out := stripANSI(scopePicker{}.Render([]string{}, []string{"sensitive"}, nil, 80))
 	require.NotEmpty(t, out)
-	assert.Contains(t, out, "[sensitive]")
+	assert.Contains(t, out, "[x] sensitive")
 }
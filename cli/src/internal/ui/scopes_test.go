@@ -12,23 +12,24 @@ func TestScopeSelectedReportsMembership(t *testing.T) {
 	assert.False(t, scopeSelected([]string{"public", "private"}, "admin"))
 }
 
-// TestRenderScopeOptionsShowsSelectionAndCursor handles test render scope options shows selection and cursor.
-func TestRenderScopeOptionsShowsSelectionAndCursor(t *testing.T) {
-	out := renderScopeOptions(
-		[]string{"private"},
+// TestScopePickerRenderShowsSelectionAndCursor handles test scope picker render shows selection and cursor.
+func TestScopePickerRenderShowsSelectionAndCursor(t *testing.T) {
+	out := scopePicker{idx: 1}.Render(
 		[]string{"public", "private", "admin"},
-		1,
+		[]string{"private"},
+		nil,
+		80,
 	)
 	clean := stripANSI(out)
 
-	assert.Contains(t, clean, "[private]")
+	assert.Contains(t, clean, "> [x] private")
 	assert.Contains(t, clean, "public")
 	assert.Contains(t, clean, "admin")
 }
 
-// TestRenderScopeOptionsFallbacksToSelectedWhenOptionsEmpty handles test render scope options fallbacks to selected when options empty.
-func TestRenderScopeOptionsFallbacksToSelectedWhenOptionsEmpty(t *testing.T) {
-	out := renderScopeOptions([]string{"sensitive"}, nil, 0)
+// TestScopePickerRenderFallsBackToSelectedWhenOptionsEmpty handles test scope picker render falls back to selected when options empty.
+func TestScopePickerRenderFallsBackToSelectedWhenOptionsEmpty(t *testing.T) {
+	out := scopePicker{}.Render(nil, []string{"sensitive"}, nil, 80)
 	clean := stripANSI(out)
-	assert.Contains(t, clean, "[sensitive]")
+	assert.Contains(t, clean, "[x] sensitive")
 }
@@ -47,12 +47,16 @@ type searchEntry struct {
 
 type SearchModel struct {
 	client  *api.Client
-	query   string
+	query   components.TextInput
 	mode    string
 	loading bool
 	list    *components.List
 	items   []searchEntry
 	width   int
+
+	// tagOptions and scopeOptions complete tag: and scope: filters.
+	tagOptions   []string
+	scopeOptions []string
 }
 
 const (
@@ -78,7 +82,7 @@ func (m SearchModel) Init() tea.Cmd {
 func (m SearchModel) Update(msg tea.Msg) (SearchModel, tea.Cmd) {
 	switch msg := msg.(type) {
 	case searchResultsMsg:
-		if strings.TrimSpace(msg.query) != strings.TrimSpace(m.query) {
+		if strings.TrimSpace(msg.query) != strings.TrimSpace(m.query.Value) {
 			return m, nil
 		}
 		if msg.mode != m.mode {
@@ -88,7 +92,7 @@ func (m SearchModel) Update(msg tea.Msg) (SearchModel, tea.Cmd) {
 		if m.mode == searchModeSemantic {
 			m.items = buildSemanticEntries(msg.semantic)
 		} else {
-			m.items = buildSearchEntries(msg.query, msg.entities, msg.context, msg.jobs)
+			m.items = buildSearchEntries(parseSearchQuery(msg.query).text, msg.entities, msg.context, msg.jobs)
 		}
 		labels := make([]string, len(m.items))
 		for i, item := range m.items {
@@ -103,56 +107,54 @@ func (m SearchModel) Update(msg tea.Msg) (SearchModel, tea.Cmd) {
 	case tea.KeyMsg:
 		switch {
 		case isBack(msg):
-			if m.query != "" {
-				m.query = ""
+			if m.query.Value != "" {
+				m.query.Reset()
 				m.items = nil
 				m.list.SetItems(nil)
 				m.loading = false
 				return m, nil
 			}
 		case isKey(msg, "cmd+backspace", "cmd+delete", "ctrl+u"):
-			if m.query != "" {
-				m.query = ""
+			if m.query.Value != "" {
+				m.query.Reset()
 				m.items = nil
 				m.list.SetItems(nil)
 				m.loading = false
 				return m, nil
 			}
-		case isKey(msg, "backspace", "delete"):
-			if len(m.query) > 0 {
-				m.query = m.query[:len(m.query)-1]
-				return m, m.search(m.query)
-			}
 		case isDown(msg):
 			m.list.Down()
 		case isUp(msg):
 			m.list.Up()
 		case isKey(msg, "tab"):
+			if m.mode == searchModeText {
+				if completion := searchCompletion(m.query.Value, m.tagOptions, m.scopeOptions); completion != "" {
+					m.query.SetValue(completion)
+					return m, m.search(m.query.Value)
+				}
+			}
 			if m.mode == searchModeText {
 				m.mode = searchModeSemantic
 			} else {
 				m.mode = searchModeText
 			}
-			if strings.TrimSpace(m.query) == "" {
+			if strings.TrimSpace(m.query.Value) == "" {
 				m.loading = false
 				m.items = nil
 				m.list.SetItems(nil)
 				return m, nil
 			}
-			return m, m.search(m.query)
+			return m, m.search(m.query.Value)
 		case isEnter(msg):
 			if idx := m.list.Selected(); idx < len(m.items) {
 				entry := m.items[idx]
 				return m, m.emitSelection(entry)
 			}
+		case isSpace(msg) && m.query.Value == "":
+			return m, nil
 		default:
-			ch := msg.String()
-			if len(ch) == 1 || ch == " " {
-				if ch == " " && m.query == "" {
-					return m, nil
-				}
-				m.query += ch
-				return m, m.search(m.query)
+			if editChanged(&m.query, msg) {
+				return m, m.search(m.query.Value)
 			}
 		}
 	}
@@ -164,20 +166,22 @@ func (m SearchModel) View() string {
 	var b strings.Builder
 	b.WriteString(MutedStyle.Render(fmt.Sprintf("Mode: %s (tab to toggle)", m.mode)))
 	b.WriteString("\n\n")
-	query := components.SanitizeText(m.query)
 	queryWidth := components.BoxContentWidth(m.width) - 8
 	if queryWidth < 10 {
 		queryWidth = 10
 	}
-	query = components.ClampTextWidthEllipsis(query, queryWidth)
-	b.WriteString(MetaKeyStyle.Render("Query") + MetaPunctStyle.Render(": ") + SelectedStyle.Render(query))
-	b.WriteString(AccentStyle.Render("█"))
+	b.WriteString(MetaKeyStyle.Render("Query") + MetaPunctStyle.Render(": ") + m.query.View(AccentStyle, queryWidth))
+	if m.mode == searchModeText {
+		if completion := searchCompletion(m.query.Value, m.tagOptions, m.scopeOptions); completion != "" {
+			b.WriteString("  " + MutedStyle.Render("tab: "+components.SanitizeOneLine(completion)))
+		}
+	}
 	b.WriteString("\n\n")
 
 	if m.loading {
 		b.WriteString(MutedStyle.Render("Searching..."))
-	} else if strings.TrimSpace(m.query) == "" {
-		b.WriteString(MutedStyle.Render("Type to search."))
+	} else if strings.TrimSpace(m.query.Value) == "" {
+		b.WriteString(MutedStyle.Render("Type to search. Filter with tag:, scope:, and type:."))
 	} else if len(m.items) == 0 {
 		b.WriteString(MutedStyle.Render("No matches."))
 	} else {
@@ -252,9 +256,13 @@ func (m SearchModel) View() string {
 				activeRowRel = len(tableRows)
 			}
 
+			kindCell := components.ClampTextWidthEllipsis(kind, kindWidth)
+			if entry.entity != nil && strings.TrimSpace(entry.entity.Type) != "" {
+				kindCell = renderEntityTypeCell(entry.entity.Type, kind, kindWidth)
+			}
 			tableRows = append(tableRows, []string{
 				components.ClampTextWidthEllipsis(title, titleWidth),
-				components.ClampTextWidthEllipsis(kind, kindWidth),
+				kindCell,
 				components.ClampTextWidthEllipsis(info, infoWidth),
 			})
 		}
@@ -381,33 +389,33 @@ func (m *SearchModel) search(query string) tea.Cmd {
 				semantic: results,
 			}
 		}
-		entities, err := m.client.QueryEntities(api.QueryParams{
-			"search_text": q,
-			"limit":       "20",
-		})
-		if err != nil {
-			return errMsg{err}
+		parsed := parseSearchQuery(q)
+		var entities []api.Entity
+		var context []api.Context
+		var jobs []api.Job
+		var err error
+		if parsed.includes("entity") {
+			if entities, err = m.client.QueryEntities(parsed.params("entity", "20")); err != nil {
+				return errMsg{err}
+			}
+			entities = parsed.matchesEntityTypes(entities)
 		}
-		context, err := m.client.QueryContext(api.QueryParams{
-			"search_text": q,
-			"limit":       "20",
-		})
-		if err != nil {
-			return errMsg{err}
+		if parsed.includes("context") {
+			if context, err = m.client.QueryContext(parsed.params("context", "20")); err != nil {
+				return errMsg{err}
+			}
 		}
-		jobs, err := m.client.QueryJobs(api.QueryParams{
-			"search_text": q,
-			"limit":       "20",
-		})
-		if err != nil {
-			return errMsg{err}
+		if parsed.includes("job") {
+			if jobs, err = m.client.QueryJobs(parsed.params("job", "20")); err != nil {
+				return errMsg{err}
+			}
 		}
 		return searchResultsMsg{
 			query:    q,
 			mode:     mode,
-			entities: filterEntitiesByQuery(entities, q),
-			context:  filterContextByQuery(context, q),
-			jobs:     filterJobsByQuery(jobs, q),
+			entities: filterEntitiesByQuery(entities, parsed.text),
+			context:  filterContextByQuery(context, parsed.text),
+			jobs:     filterJobsByQuery(jobs, parsed.text),
 		}
 	}
 }
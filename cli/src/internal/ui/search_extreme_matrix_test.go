@@ -192,7 +192,7 @@ func TestRenderSearchPreviewShowsEntityContextAndJobDetails(t *testing.T) {
 func TestSearchViewRendersTableAndPreviewContent(t *testing.T) {
 	model := NewSearchModel(nil)
 	model.width = 120
-	model.query = "alpha"
+	model.query.Value = "alpha"
 	model.items = []searchEntry{
 		{
 			kind:  "entity",
@@ -23,7 +23,7 @@ func TestSearchViewRendersEmptyAndPopulatedStates(t *testing.T) {
 	assert.Contains(t, out, "Type to search.")
 
 	// Inject a results message directly to avoid needing a live client.
-	model.query = "a"
+	model.query.Value = "a"
 	model.mode = searchModeText
 	model, _ = model.Update(searchResultsMsg{
 		query:    "a",
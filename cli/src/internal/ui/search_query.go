@@ -0,0 +1,166 @@
+package ui
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+// searchKinds are the resource kinds a `type:` filter can name.
+var searchKinds = []string{"entity", "context", "job"}
+
+// searchKindAliases maps plural and alternate names to a search kind.
+var searchKindAliases = map[string]string{
+	"entity":    "entity",
+	"entities":  "entity",
+	"context":   "context",
+	"knowledge": "context",
+	"job":       "job",
+	"jobs":      "job",
+}
+
+// searchQuery is a search split into free text and filters, from syntax such
+// as `launch tag:urgent scope:personal type:entity`. A `type:` value that is
+// not a resource kind filters entities by entity type.
+type searchQuery struct {
+	text        string
+	tags        []string
+	scopes      []string
+	kinds       []string
+	entityTypes []string
+}
+
+// parseSearchQuery splits raw into free text and filters. Filters with an
+// empty value are kept as text so a half-typed `tag:` still searches.
+func parseSearchQuery(raw string) searchQuery {
+	var q searchQuery
+	var text []string
+	for _, token := range strings.Fields(raw) {
+		key, value, ok := strings.Cut(token, ":")
+		value = strings.TrimSpace(value)
+		if !ok || value == "" {
+			text = append(text, token)
+			continue
+		}
+		switch strings.ToLower(key) {
+		case "tag", "tags":
+			q.tags = appendSearchValues(q.tags, value)
+		case "scope", "scopes":
+			q.scopes = appendSearchValues(q.scopes, value)
+		case "type", "kind":
+			for _, v := range appendSearchValues(nil, value) {
+				if kind, ok := searchKindAliases[v]; ok {
+					q.kinds = appendSearchValues(q.kinds, kind)
+				} else {
+					q.entityTypes = appendSearchValues(q.entityTypes, v)
+				}
+			}
+		default:
+			text = append(text, token)
+		}
+	}
+	if len(q.entityTypes) > 0 && !slices.Contains(q.kinds, "entity") {
+		q.kinds = append(q.kinds, "entity")
+	}
+	q.text = strings.Join(text, " ")
+	return q
+}
+
+// appendSearchValues adds comma-separated values, lowercased and deduped.
+func appendSearchValues(values []string, raw string) []string {
+	for _, v := range strings.Split(raw, ",") {
+		v = strings.ToLower(strings.TrimSpace(v))
+		if v != "" && !slices.Contains(values, v) {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// filtered reports whether the query has any filter beyond free text.
+func (q searchQuery) filtered() bool {
+	return len(q.tags) > 0 || len(q.scopes) > 0 || len(q.kinds) > 0
+}
+
+// includes reports whether results of kind are wanted. Jobs carry no tags or
+// scopes, so tag and scope filters leave them out.
+func (q searchQuery) includes(kind string) bool {
+	if len(q.kinds) > 0 && !slices.Contains(q.kinds, kind) {
+		return false
+	}
+	if kind == "job" && (len(q.tags) > 0 || len(q.scopes) > 0) {
+		return false
+	}
+	return true
+}
+
+// params returns the server query params for kind.
+func (q searchQuery) params(kind, limit string) api.QueryParams {
+	params := api.QueryParams{"limit": limit}
+	if text := strings.TrimSpace(q.text); text != "" {
+		params["search_text"] = text
+	}
+	if kind == "job" {
+		return params
+	}
+	if len(q.tags) > 0 {
+		params["tags"] = strings.Join(q.tags, ",")
+	}
+	if len(q.scopes) > 0 {
+		params["scopes"] = strings.Join(q.scopes, ",")
+	}
+	if kind == "entity" && len(q.entityTypes) == 1 {
+		params["type"] = q.entityTypes[0]
+	}
+	return params
+}
+
+// matchesEntityTypes keeps entities of the queried types. The server filters
+// one type; more than one is filtered here.
+func (q searchQuery) matchesEntityTypes(items []api.Entity) []api.Entity {
+	if len(q.entityTypes) < 2 {
+		return items
+	}
+	out := make([]api.Entity, 0, len(items))
+	for _, item := range items {
+		if slices.Contains(q.entityTypes, strings.ToLower(strings.TrimSpace(item.Type))) {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// searchCompletion completes the filter being typed at the end of raw from
+// the known tags, scopes, and kinds. It returns "" when nothing matches.
+func searchCompletion(raw string, tags, scopes []string) string {
+	if raw == "" || strings.HasSuffix(raw, " ") {
+		return ""
+	}
+	start := strings.LastIndex(raw, " ") + 1
+	key, partial, ok := strings.Cut(raw[start:], ":")
+	if !ok {
+		return ""
+	}
+	// Complete the last value of a comma-separated list.
+	prefix := ""
+	if i := strings.LastIndex(partial, ","); i >= 0 {
+		prefix, partial = partial[:i+1], partial[i+1:]
+	}
+	var options []string
+	switch strings.ToLower(key) {
+	case "tag", "tags":
+		options = tags
+	case "scope", "scopes":
+		options = scopes
+	case "type", "kind":
+		options = searchKinds
+	default:
+		return ""
+	}
+	ranked := rankSuggestions(options, partial, nil)
+	if len(ranked) == 0 || strings.EqualFold(ranked[0], partial) {
+		return ""
+	}
+	return raw[:start] + key + ":" + prefix + ranked[0]
+}
@@ -0,0 +1,93 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestParseSearchQuery(t *testing.T) {
+	q := parseSearchQuery("launch tag:Urgent,infra scope:personal type:entity plan tag:")
+	assert.Equal(t, "launch plan tag:", q.text)
+	assert.Equal(t, []string{"urgent", "infra"}, q.tags)
+	assert.Equal(t, []string{"personal"}, q.scopes)
+	assert.Equal(t, []string{"entity"}, q.kinds)
+	assert.True(t, q.includes("entity"))
+	assert.False(t, q.includes("context"))
+	assert.Equal(t, api.QueryParams{
+		"limit":       "8",
+		"search_text": "launch plan tag:",
+		"tags":        "urgent,infra",
+		"scopes":      "personal",
+	}, q.params("entity", "8"))
+
+	typed := parseSearchQuery("type:person,project")
+	assert.Equal(t, []string{"entity"}, typed.kinds)
+	assert.Equal(t, []string{"person", "project"}, typed.entityTypes)
+	assert.NotContains(t, typed.params("entity", "8"), "type")
+	kept := typed.matchesEntityTypes([]api.Entity{{Name: "a", Type: "Person"}, {Name: "b", Type: "tool"}})
+	require.Len(t, kept, 1)
+	assert.Equal(t, "a", kept[0].Name)
+
+	assert.False(t, parseSearchQuery("scope:public").includes("job"))
+	assert.True(t, parseSearchQuery("deploy").includes("job"))
+	assert.False(t, parseSearchQuery("deploy").filtered())
+}
+
+func TestSearchCompletion(t *testing.T) {
+	tags := []string{"urgent", "infra"}
+	scopes := []string{"public", "personal"}
+	assert.Equal(t, "launch tag:urgent", searchCompletion("launch tag:ur", tags, scopes))
+	assert.Equal(t, "tag:infra,urgent", searchCompletion("tag:infra,urg", tags, scopes))
+	assert.Equal(t, "scope:personal", searchCompletion("scope:pers", tags, scopes))
+	assert.Equal(t, "type:context", searchCompletion("type:co", tags, scopes))
+	assert.Empty(t, searchCompletion("tag:urgent", tags, scopes))
+	assert.Empty(t, searchCompletion("tag:ur ", tags, scopes))
+	assert.Empty(t, searchCompletion("launch", tags, scopes))
+}
+
+func TestPaletteSearchSendsFiltersAndCompletes(t *testing.T) {
+	seen := map[string]url.Values{}
+	_, client := contextTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		seen[r.URL.Path] = r.URL.Query()
+		var data any = []any{}
+		if r.URL.Path == "/api/entities" {
+			data = []map[string]any{{"id": "ent-1", "name": "Launch plan", "type": "project"}}
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": data}))
+	})
+	app := NewApp(client, &config.Config{})
+	app.width = 120
+	app.applyVocabulary(vocabularyLoadedMsg{tags: []string{"urgent"}, scopes: []string{"personal"}})
+	app.paletteOpen = true
+
+	for _, r := range "launch tag:ur" {
+		model, _ := app.handlePaletteKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		app = model.(App)
+	}
+	assert.Contains(t, components.SanitizeText(app.renderPalette()), "tab: launch tag:urgent")
+
+	model, cmd := app.handlePaletteKeys(tea.KeyMsg{Type: tea.KeyTab})
+	app = model.(App)
+	assert.Equal(t, "launch tag:urgent", app.paletteQuery.Value)
+	require.NotNil(t, cmd)
+	model, _ = app.Update(cmd())
+	app = model.(App)
+
+	assert.Equal(t, "urgent", seen["/api/entities"].Get("tags"))
+	assert.Equal(t, "launch", seen["/api/entities"].Get("search_text"))
+	assert.Equal(t, "urgent", seen["/api/context"].Get("tags"))
+	assert.NotContains(t, seen, "/api/jobs")
+	assert.NotContains(t, seen, "/api/relationships")
+	require.Len(t, app.paletteFiltered, 1)
+	assert.Equal(t, "Launch plan", app.paletteFiltered[0].Label)
+}
@@ -303,14 +303,14 @@ func TestSearchModelUpdateClearAndSpaceHandling(t *testing.T) {
 
 	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.query)
+	assert.Equal(t, "", updated.query.Value)
 
-	updated.query = "abc"
+	updated.query.Value = "abc"
 	updated.items = []searchEntry{{id: "x"}}
 	updated.list.SetItems([]string{"x"})
 
 	updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyCtrlU})
 	require.Nil(t, cmd)
-	assert.Equal(t, "", updated.query)
+	assert.Equal(t, "", updated.query.Value)
 	assert.Empty(t, updated.items)
 }
@@ -0,0 +1,164 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// sessionListenGrace bounds how long a 401 waits for the app to pick it up.
+const sessionListenGrace = 200 * time.Millisecond
+
+// sessionExpiredMsg carries one blocked request waiting on re-auth.
+type sessionExpiredMsg struct{ reply chan bool }
+
+// sessionReloadedMsg carries SSO tokens re-read from the config file.
+type sessionReloadedMsg struct {
+	cfg *config.Config
+	err error
+}
+
+// watchSessionExpiry routes the client's unrecoverable 401s into a channel
+// the update loop listens on. The failing request blocks until the app
+// answers; if nothing picks it up the 401 is returned as before.
+func watchSessionExpiry(client *api.Client) chan chan bool {
+	if client == nil {
+		return nil
+	}
+	ch := make(chan chan bool)
+	client.OnSessionExpired(func() bool {
+		reply := make(chan bool, 1)
+		select {
+		case ch <- reply:
+		case <-time.After(sessionListenGrace):
+			return false
+		}
+		return <-reply
+	})
+	return ch
+}
+
+// waitForSessionExpiry waits for the next expired request from the client.
+func waitForSessionExpiry(ch <-chan chan bool) tea.Cmd {
+	if ch == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		return sessionExpiredMsg{reply: <-ch}
+	}
+}
+
+// handleSessionExpired opens the re-auth modal and parks the request.
+func (a *App) handleSessionExpired(msg sessionExpiredMsg) tea.Cmd {
+	a.sessionExpired = true
+	a.sessionWaiters = append(a.sessionWaiters, msg.reply)
+	return waitForSessionExpiry(a.sessionExpiry)
+}
+
+// resolveSession releases parked requests, replaying them when replay is set.
+func (a *App) resolveSession(replay bool) int {
+	count := len(a.sessionWaiters)
+	for _, reply := range a.sessionWaiters {
+		reply <- replay
+	}
+	a.sessionWaiters = nil
+	a.sessionExpired = false
+	a.sessionRelogging = false
+	a.sessionErr = ""
+	return count
+}
+
+// handleSessionKeys handles keys while the session expired modal is open.
+func (a App) handleSessionKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case isKey(msg, "r"):
+		if a.sessionRelogging {
+			return a, nil
+		}
+		a.sessionRelogging = true
+		a.sessionErr = ""
+		if a.config.UsesSSO() {
+			return a, reloadSessionCmd()
+		}
+		return a, a.reloginCmd()
+	case msg.String() == "ctrl+c":
+		a.resolveSession(false)
+		return a, tea.Quit
+	case isBack(msg):
+		count := a.resolveSession(false)
+		return a, a.setToast("warning", fmt.Sprintf("Session still expired. %d request(s) cancelled.", count))
+	}
+	return a, nil
+}
+
+// handleSessionRelogin finishes a re-login started from the modal.
+func (a *App) handleSessionRelogin(msg reloginDoneMsg) tea.Cmd {
+	if msg.err != nil {
+		a.sessionRelogging = false
+		a.sessionErr = fmt.Sprintf("re-login failed: %v", msg.err)
+		return nil
+	}
+	if a.config != nil {
+		a.config.APIKey = msg.apiKey
+		if err := a.config.Save(); err != nil {
+			a.sessionRelogging = false
+			a.sessionErr = fmt.Sprintf("save config: %v", err)
+			return nil
+		}
+	}
+	if a.client != nil {
+		a.client.SetAPIKey(msg.apiKey)
+	}
+	count := a.resolveSession(true)
+	a.clearError()
+	return a.setToast("success", fmt.Sprintf("Re-login complete. Replaying %d request(s).", count))
+}
+
+// reloadSessionCmd re-reads the config after an SSO login in another shell.
+func reloadSessionCmd() tea.Cmd {
+	return func() tea.Msg {
+		cfg, err := config.Load()
+		return sessionReloadedMsg{cfg: cfg, err: err}
+	}
+}
+
+// handleSessionReloaded adopts fresh SSO tokens and replays parked requests.
+func (a *App) handleSessionReloaded(msg sessionReloadedMsg) tea.Cmd {
+	a.sessionRelogging = false
+	if msg.err != nil {
+		a.sessionErr = fmt.Sprintf("load config: %v", msg.err)
+		return nil
+	}
+	if a.config != nil && msg.cfg.APIKey == a.config.APIKey {
+		a.sessionErr = "still signed out; run `" + a.recoveryCommand + "` first"
+		return nil
+	}
+	a.config = msg.cfg
+	a.profile.config = msg.cfg
+	if a.client != nil {
+		a.client.SetOAuthToken(msg.cfg.APIKey, msg.cfg.RefreshToken, msg.cfg.TokenExpiresAt)
+	}
+	count := a.resolveSession(true)
+	return a.setToast("success", fmt.Sprintf("Signed in again. Replaying %d request(s).", count))
+}
+
+// renderSessionExpired renders the session expired modal.
+func (a App) renderSessionExpired() string {
+	body := "Session expired — press r to re-login."
+	if a.config.UsesSSO() {
+		body = "Session expired — run `" + a.recoveryCommand + "` in another shell, then press r."
+	}
+	body += fmt.Sprintf("\n\nWaiting requests: %d", len(a.sessionWaiters))
+	if a.sessionRelogging {
+		body += "\n\nLogging in..."
+	}
+	if a.sessionErr != "" {
+		body += "\n\n" + ErrorStyle.Render(a.sessionErr)
+	}
+	return components.Indent(components.TitledBox("Session Expired", body, a.width), 1)
+}
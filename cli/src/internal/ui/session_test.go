@@ -0,0 +1,88 @@
+package ui
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSessionExpiryApp(t *testing.T) (App, func() <-chan error) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/keys/login" {
+			_, _ = w.Write([]byte(`{"data":{"api_key":"nbl_new","entity_id":"ent-1","username":"alxx"}}`))
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer nbl_new" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":{"code":"INVALID_API_KEY","message":"expired"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	client := api.NewClient(srv.URL, "nbl_old")
+	app := NewApp(client, &config.Config{APIKey: "nbl_old", Username: "alxx"})
+	app.width = 100
+	require.NotNil(t, app.sessionExpiry)
+
+	request := func() <-chan error {
+		done := make(chan error, 1)
+		go func() {
+			_, err := client.ListKeys()
+			done <- err
+		}()
+		return done
+	}
+	return app, request
+}
+
+func TestAppSessionExpiryReplaysRequestAfterRelogin(t *testing.T) {
+	app, request := newSessionExpiryApp(t)
+	done := request()
+
+	msg := waitForSessionExpiry(app.sessionExpiry)()
+	model, cmd := app.Update(msg)
+	app = model.(App)
+	require.NotNil(t, cmd)
+	assert.True(t, app.sessionExpired)
+	assert.Equal(t, "session-expired", app.viewStateKey())
+	assert.Contains(t, components.SanitizeText(app.View()), "Session expired — press r to re-login.")
+
+	model, cmd = app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	app = model.(App)
+	require.NotNil(t, cmd)
+	model, _ = app.Update(cmd())
+	app = model.(App)
+
+	assert.False(t, app.sessionExpired)
+	assert.Empty(t, app.sessionWaiters)
+	require.NoError(t, <-done)
+	assert.Equal(t, "nbl_new", app.config.APIKey)
+}
+
+func TestAppSessionExpiryEscCancelsParkedRequests(t *testing.T) {
+	app, request := newSessionExpiryApp(t)
+	done := request()
+
+	model, _ := app.Update(waitForSessionExpiry(app.sessionExpiry)())
+	app = model.(App)
+	require.True(t, app.sessionExpired)
+
+	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	app = model.(App)
+	assert.False(t, app.sessionExpired)
+	err := <-done
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "INVALID_API_KEY")
+}
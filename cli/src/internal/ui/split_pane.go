@@ -0,0 +1,163 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+const (
+	splitPaneMinContentWidth = 150
+	splitPaneWidthPercent    = 45
+	splitPaneLinkLimit       = 6
+	splitPaneHistoryLimit    = 5
+)
+
+// Split pane kinds name the record type a pane load belongs to.
+const (
+	splitPaneEntity       = "entity"
+	splitPaneRelationship = "relationship"
+)
+
+// splitPane is the persistent detail pane a list tab shows beside its table
+// on wide terminals. Links and history are fetched once per record.
+type splitPane struct {
+	open    bool
+	rels    map[string][]api.Relationship
+	history map[string][]api.AuditEntry
+	pending map[string]bool
+}
+
+type splitPaneLoadedMsg struct {
+	kind    string
+	id      string
+	rels    []api.Relationship
+	history []api.AuditEntry
+}
+
+// splitPaneWidth returns the detail pane width for a box content width.
+func splitPaneWidth(contentWidth int) int {
+	width := contentWidth * splitPaneWidthPercent / 100
+	if width < previewMaxWidth {
+		width = previewMaxWidth
+	}
+	return width
+}
+
+// active reports whether the pane is on and the terminal is wide enough.
+func (p splitPane) active(width int) bool {
+	return p.open && components.BoxContentWidth(width) >= splitPaneMinContentWidth
+}
+
+// request marks id as loading and returns its load, or nil when the pane
+// already has it.
+func (p *splitPane) request(client *api.Client, kind, id string) tea.Cmd {
+	if client == nil || id == "" || p.pending[id] {
+		return nil
+	}
+	if _, ok := p.history[id]; ok {
+		return nil
+	}
+	if p.pending == nil {
+		p.pending = map[string]bool{}
+	}
+	p.pending[id] = true
+	return loadSplitPane(client, kind, id)
+}
+
+// store caches a finished pane load.
+func (p *splitPane) store(msg splitPaneLoadedMsg) {
+	delete(p.pending, msg.id)
+	if p.rels == nil {
+		p.rels = map[string][]api.Relationship{}
+	}
+	if p.history == nil {
+		p.history = map[string][]api.AuditEntry{}
+	}
+	p.rels[msg.id] = msg.rels
+	p.history[msg.id] = msg.history
+}
+
+// reset drops cached links and history so the next selection refetches.
+func (p *splitPane) reset() {
+	p.rels = nil
+	p.history = nil
+	p.pending = nil
+}
+
+// loadSplitPane fetches links and recent history for one record. Failures
+// leave the sections empty; the pane is a convenience, not a source of errors.
+func loadSplitPane(client *api.Client, kind, id string) tea.Cmd {
+	return func() tea.Msg {
+		msg := splitPaneLoadedMsg{kind: kind, id: id}
+		switch kind {
+		case splitPaneEntity:
+			msg.rels, _ = client.GetRelationships("entity", id)
+			msg.history, _ = client.GetEntityHistory(id, splitPaneHistoryLimit, 0)
+		case splitPaneRelationship:
+			msg.history, _ = client.QueryAuditLogWithPagination("relationships", "", "", "", id, "", splitPaneHistoryLimit, 0)
+		}
+		if msg.history == nil {
+			msg.history = []api.AuditEntry{}
+		}
+		return msg
+	}
+}
+
+// renderSplitPaneLinks renders the relationship summary for the pane.
+func renderSplitPaneLinks(id string, rels []api.Relationship, width int) []string {
+	lines := []string{MetaKeyStyle.Render(fmt.Sprintf("Relationships (%d)", len(rels)))}
+	if len(rels) == 0 {
+		return append(lines, MutedStyle.Render("none"))
+	}
+	for i, rel := range rels {
+		if i == splitPaneLinkLimit {
+			lines = append(lines, MutedStyle.Render(fmt.Sprintf("...and %d more", len(rels)-i)))
+			break
+		}
+		arrow, other := "→", relationshipLabel(rel.TargetID, rel.TargetName)
+		if rel.TargetID == id {
+			arrow, other = "←", relationshipLabel(rel.SourceID, rel.SourceName)
+		}
+		line := components.SanitizeOneLine(fmt.Sprintf("%s %s %s", arrow, rel.Type, other))
+		lines = append(lines, MetaValueStyle.Render(components.ClampTextWidthEllipsis(line, width)))
+	}
+	return lines
+}
+
+// renderSplitPaneHistory renders the recent changes summary for the pane.
+func renderSplitPaneHistory(history []api.AuditEntry, width int) []string {
+	lines := []string{MetaKeyStyle.Render("Recent History")}
+	if len(history) == 0 {
+		return append(lines, MutedStyle.Render("no changes recorded"))
+	}
+	for _, entry := range history {
+		line := formatHistoryLine(entry)
+		if entry.ActorName != nil && strings.TrimSpace(*entry.ActorName) != "" {
+			line += " by " + strings.TrimSpace(*entry.ActorName)
+		}
+		line = components.SanitizeOneLine(line)
+		lines = append(lines, MetaValueStyle.Render(components.ClampTextWidthEllipsis(line, width)))
+	}
+	return lines
+}
+
+// renderSections appends links and history, or a loading note.
+func (p splitPane) renderSections(id string, withLinks bool, width int) []string {
+	history, ok := p.history[id]
+	if !ok {
+		return []string{"", MutedStyle.Render("Loading links and history...")}
+	}
+	var lines []string
+	if withLinks {
+		lines = append(lines, "")
+		lines = append(lines, renderSplitPaneLinks(id, p.rels[id], width)...)
+	}
+	lines = append(lines, "")
+	lines = append(lines, renderSplitPaneHistory(history, width)...)
+	return lines
+}
@@ -0,0 +1,85 @@
+package ui
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntitiesSplitPaneLoadsSelectedDetail(t *testing.T) {
+	var loads int32
+	_, client := testEntitiesClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/relationships/entity/ent-1":
+			atomic.AddInt32(&loads, 1)
+			_, _ = w.Write([]byte(`{"data":[{"id":"rel-1","source_id":"ent-1","target_id":"ent-2","target_name":"Beta","relationship_type":"depends-on"}]}`))
+		case "/api/entities/ent-1/history":
+			_, _ = w.Write([]byte(`{"data":[{"id":"aud-1","action":"update","changed_fields":["status"],"actor_name":"alxx","changed_at":"2026-10-16T12:00:00Z"}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"data":[]}`))
+		}
+	})
+	model := NewEntitiesModel(client)
+	model.width = 180
+	model.view = entitiesViewList
+	model.items = []api.Entity{{ID: "ent-1", Name: "Alpha", Type: "project", Status: "active"}, {ID: "ent-2", Name: "Beta", Type: "project"}}
+	model.list.SetItems([]string{"Alpha", "Beta"})
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	require.True(t, model.pane.open)
+	require.NotNil(t, cmd)
+	assert.Contains(t, components.SanitizeText(model.View()), "Loading links and history...")
+
+	// The pending load is not requested twice.
+	assert.Nil(t, model.syncSplitPane())
+
+	model, _ = model.Update(cmd())
+	view := components.SanitizeText(model.View())
+	assert.Contains(t, view, "Relationships (1)")
+	assert.Contains(t, view, "→ depends-on Beta")
+	assert.Contains(t, view, "update (1 fields) by alxx")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&loads))
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	assert.False(t, model.pane.open)
+	assert.NotContains(t, components.SanitizeText(model.View()), "Recent History")
+}
+
+func TestSplitPaneStaysOffOnNarrowTerminals(t *testing.T) {
+	pane := splitPane{open: true}
+	assert.False(t, pane.active(100))
+	assert.True(t, pane.active(180))
+	assert.GreaterOrEqual(t, splitPaneWidth(150), previewMaxWidth)
+}
+
+func TestRelationshipsSplitPaneShowsHistory(t *testing.T) {
+	_, client := testEntitiesClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/audit" {
+			assert.Equal(t, "relationships", r.URL.Query().Get("table"))
+			assert.Equal(t, "rel-1", r.URL.Query().Get("record_id"))
+			_, _ = w.Write([]byte(`{"data":[{"id":"aud-2","action":"insert","changed_at":"2026-10-16T12:00:00Z"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	})
+	model := NewRelationshipsModel(client)
+	model.width = 180
+	model.items = []api.Relationship{{ID: "rel-1", Type: "owns", SourceID: "ent-1", SourceName: "Alpha", TargetID: "ent-2", TargetName: "Beta", Status: "active"}}
+	model.list.SetItems([]string{"owns"})
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	require.NotNil(t, cmd)
+	model, _ = model.Update(cmd())
+	view := components.SanitizeText(model.View())
+	assert.Contains(t, view, "Recent History")
+	assert.Contains(t, view, "insert")
+	assert.NotContains(t, view, "Relationships (")
+}
@@ -0,0 +1,265 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/gravitrone/nebula-core/cli/internal/version"
+)
+
+const (
+	// statusRefreshInterval is how often the Status tab re-probes the server
+	// when auto_refresh does not set its own interval.
+	statusRefreshInterval = 15 * time.Second
+	// statusLatencySamples caps the latency sparkline.
+	statusLatencySamples = 40
+	// keyExpiryWarning is how close to expiry credentials are flagged.
+	keyExpiryWarning = 7 * 24 * time.Hour
+)
+
+// statusTaxonomyKinds are the taxonomies the Status tab fingerprints.
+var statusTaxonomyKinds = []string{"scopes", "entity-types", "relationship-types"}
+
+type statusProbedMsg struct{ probe statusProbe }
+
+// statusProbe is one round of server diagnostics.
+type statusProbe struct {
+	at              time.Time
+	latency         time.Duration
+	apiErr          string
+	serverVersion   string
+	authErr         string
+	key             *api.APIKey
+	taxonomyErr     string
+	taxonomyEntries int
+	taxonomyUpdated time.Time
+	lastSync        time.Time
+}
+
+// StatusModel is the Status tab: a persistent view of the startup checks
+// plus latency, credential expiry, and version compatibility.
+type StatusModel struct {
+	client    *api.Client
+	config    *config.Config
+	probe     *statusProbe
+	latencies []int
+	lastOK    time.Time
+	width     int
+	height    int
+}
+
+// NewStatusModel builds the status UI model.
+func NewStatusModel(client *api.Client, cfg *config.Config) StatusModel {
+	return StatusModel{client: client, config: cfg}
+}
+
+// Init handles init.
+func (m StatusModel) Init() tea.Cmd {
+	return m.probeStatus()
+}
+
+// Update updates update.
+func (m StatusModel) Update(msg tea.Msg) (StatusModel, tea.Cmd) {
+	if msg, ok := msg.(statusProbedMsg); ok {
+		probe := msg.probe
+		m.probe = &probe
+		if probe.apiErr == "" {
+			m.lastOK = probe.at
+			m.latencies = append(m.latencies, int(probe.latency.Milliseconds()))
+			if len(m.latencies) > statusLatencySamples {
+				m.latencies = m.latencies[len(m.latencies)-statusLatencySamples:]
+			}
+		}
+	}
+	return m, nil
+}
+
+// probeStatus times a health call, then checks auth and taxonomy the way the
+// startup check does. Later checks are skipped when the API is down.
+func (m StatusModel) probeStatus() tea.Cmd {
+	client := m.client
+	apiKey := ""
+	if m.config != nil {
+		apiKey = m.config.APIKey
+	}
+	return func() tea.Msg {
+		probe := statusProbe{at: time.Now()}
+		if state, err := config.LoadSyncState(); err == nil {
+			probe.lastSync = state.LastSync
+		}
+		if client == nil {
+			probe.apiErr = "not logged in"
+			return statusProbedMsg{probe}
+		}
+		started := time.Now()
+		info, err := client.HealthInfo()
+		probe.latency = time.Since(started)
+		if err != nil {
+			probe.apiErr = err.Error()
+			return statusProbedMsg{probe}
+		}
+		probe.serverVersion = info.Version
+
+		keys, err := client.ListKeys()
+		if err != nil {
+			probe.authErr = err.Error()
+		} else {
+			probe.key = currentAPIKey(keys, apiKey)
+		}
+		for _, kind := range statusTaxonomyKinds {
+			entries, err := client.ListTaxonomy(kind, true, "", 1000, 0)
+			if err != nil {
+				probe.taxonomyErr = err.Error()
+				break
+			}
+			probe.taxonomyEntries += len(entries)
+			for _, entry := range entries {
+				if entry.UpdatedAt.After(probe.taxonomyUpdated) {
+					probe.taxonomyUpdated = entry.UpdatedAt
+				}
+			}
+		}
+		return statusProbedMsg{probe}
+	}
+}
+
+// currentAPIKey finds the key the CLI is logged in with by its prefix.
+func currentAPIKey(keys []api.APIKey, apiKey string) *api.APIKey {
+	apiKey = strings.TrimSpace(apiKey)
+	for i := range keys {
+		if prefix := strings.TrimSpace(keys[i].KeyPrefix); prefix != "" && strings.HasPrefix(apiKey, prefix) {
+			return &keys[i]
+		}
+	}
+	return nil
+}
+
+// View handles view.
+func (m StatusModel) View() string {
+	if m.probe == nil {
+		return components.Indent(components.Skeleton("Checking server...", 8, m.width), 1)
+	}
+	p := *m.probe
+	now := time.Now()
+	apiStatus := classifyStartupAPI(p.apiErr)
+
+	rows := []components.TableRow{{
+		Label:      "API",
+		Value:      apiStatus,
+		ValueColor: startupStatusColor(apiStatus),
+	}}
+	if apiStatus == "ok" {
+		rows[0].Value = fmt.Sprintf("ok · %s", formatLoadLatency(p.latency))
+	} else if !m.lastOK.IsZero() {
+		rows[0].Value = fmt.Sprintf("%s · last ok %s", apiStatus, formatLocalTimeCompact(m.lastOK))
+	}
+	if len(m.latencies) > 0 {
+		rows = append(rows, components.TableRow{Label: "Latency", Value: latencySummary(m.latencies)})
+	}
+
+	if apiStatus == "ok" {
+		auth := classifyStartupAuth(p.authErr, m.config)
+		authValue, authColor := m.credentialExpiry(auth, p.key, now)
+		rows = append(rows, components.TableRow{Label: "Auth", Value: authValue, ValueColor: authColor})
+
+		taxonomy := classifyStartupTaxonomy(p.taxonomyErr)
+		taxValue := taxonomy
+		if taxonomy == "ok" {
+			taxValue = fmt.Sprintf("%d entries · rev %s", p.taxonomyEntries, formatLocalTimeFull(p.taxonomyUpdated))
+		}
+		rows = append(rows, components.TableRow{Label: "Taxonomy", Value: taxValue, ValueColor: startupStatusColor(taxonomy)})
+
+		server := p.serverVersion
+		if server == "" {
+			server = "unknown"
+		}
+		compat := version.Check(p.serverVersion)
+		versionColor := string(ColorSuccess)
+		switch {
+		case !compat.OK:
+			versionColor = string(ColorError)
+		case compat.Warning != "":
+			versionColor = string(ColorWarning)
+		}
+		rows = append(rows, components.TableRow{
+			Label:      "Version",
+			Value:      fmt.Sprintf("server %s · cli %s", server, version.Version),
+			ValueColor: versionColor,
+		})
+		if compat.Warning != "" {
+			rows = append(rows, components.TableRow{Label: "Compat", Value: compat.Warning, ValueColor: versionColor})
+		}
+	}
+
+	lastSync := "never"
+	if !p.lastSync.IsZero() {
+		lastSync = fmt.Sprintf("%s (%s ago)", formatLocalTimeFull(p.lastSync), humanizeAge(now.Sub(p.lastSync)))
+	}
+	rows = append(rows,
+		components.TableRow{Label: "Last Sync", Value: lastSync},
+		components.TableRow{Label: "Checked", Value: formatLocalTimeCompact(p.at)},
+	)
+	return components.Indent(components.Table("Status", rows, m.width), 1)
+}
+
+// credentialExpiry describes the auth status with when the credentials
+// expire: the SSO token for SSO logins, the API key otherwise.
+func (m StatusModel) credentialExpiry(auth string, key *api.APIKey, now time.Time) (string, string) {
+	color := startupStatusColor(auth)
+	if auth != "ok" {
+		return auth, color
+	}
+	var expires time.Time
+	switch {
+	case m.config != nil && m.config.UsesSSO():
+		expires = m.config.TokenExpiresAt
+	case key != nil && key.ExpiresAt != nil:
+		expires = *key.ExpiresAt
+	case key != nil:
+		return fmt.Sprintf("ok · key %s... never expires", key.KeyPrefix), color
+	default:
+		return "ok", color
+	}
+	if expires.IsZero() {
+		return "ok", color
+	}
+	left := expires.Sub(now)
+	switch {
+	case left <= 0:
+		return fmt.Sprintf("expired %s", formatLocalTimeFull(expires)), string(ColorError)
+	case left < keyExpiryWarning:
+		return fmt.Sprintf("ok · expires in %s", humanizeAge(left)), string(ColorWarning)
+	}
+	return fmt.Sprintf("ok · expires %s", formatLocalTimeFull(expires)), color
+}
+
+// latencySummary renders the latency sparkline with min, average, and max.
+func latencySummary(samples []int) string {
+	lo, hi, sum := samples[0], samples[0], 0
+	for _, v := range samples {
+		lo = min(lo, v)
+		hi = max(hi, v)
+		sum += v
+	}
+	return fmt.Sprintf("%s  min %dms · avg %dms · max %dms",
+		components.Sparkline(samples), lo, sum/len(samples), hi)
+}
+
+// humanizeAge renders a duration as its largest whole unit.
+func humanizeAge(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	}
+	return fmt.Sprintf("%dd", int(d.Hours()/24))
+}
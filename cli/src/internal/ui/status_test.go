@@ -0,0 +1,73 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestStatusProbeReportsVersionsKeyExpiryAndTaxonomy(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	synced := time.Now().Add(-2 * time.Hour)
+	require.NoError(t, (&config.SyncState{LastSync: synced}).Save())
+
+	expires := time.Now().Add(72 * time.Hour)
+	_, client := testJobsClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var payload any
+		switch {
+		case r.URL.Path == "/api/health":
+			payload = map[string]any{"status": "ok", "version": "9.0.0"}
+		case r.URL.Path == "/api/keys":
+			payload = map[string]any{"data": []map[string]any{
+				{"id": "k-other", "key_prefix": "nbl_zzzz", "name": "other"},
+				{"id": "k-1", "key_prefix": "nbl_abcd", "name": "cli", "expires_at": expires},
+			}}
+		case strings.HasPrefix(r.URL.Path, "/api/taxonomy/"):
+			payload = map[string]any{"data": []map[string]any{
+				{"id": r.URL.Path, "name": "x", "updated_at": "2026-10-01T09:00:00Z"},
+			}}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(payload))
+	})
+
+	model := NewStatusModel(client, &config.Config{APIKey: "nbl_abcd1234"})
+	model.width = 120
+	assert.Contains(t, components.SanitizeText(model.View()), "Checking server...")
+
+	model, _ = model.Update(model.Init()())
+	model, _ = model.Update(model.Init()())
+	require.Len(t, model.latencies, 2)
+
+	view := components.SanitizeText(model.View())
+	assert.Contains(t, view, "ok · expires in 2d")
+	assert.Contains(t, view, "3 entries")
+	assert.Contains(t, view, "server 9.0.0")
+	assert.Contains(t, view, "not compatible")
+	assert.Contains(t, view, "(2h ago)")
+	assert.Contains(t, view, "min ")
+}
+
+func TestStatusProbeKeepsLastOKWhenServerGoesDown(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	model := NewStatusModel(nil, nil)
+	model.width = 100
+	model.lastOK = time.Now().Add(-time.Minute)
+	model.latencies = []int{12}
+
+	model, _ = model.Update(model.Init()())
+	view := components.SanitizeText(model.View())
+	assert.Contains(t, view, "down · last ok")
+	assert.Contains(t, view, "never")
+	assert.Len(t, model.latencies, 1)
+}
@@ -58,6 +58,12 @@ var (
 				Bold(true).
 				Padding(0, 1)
 
+	TabAlertStyle = lipgloss.NewStyle().
+			Foreground(ColorBackground).
+			Background(ColorWarning).
+			Bold(true).
+			Padding(0, 1)
+
 	StatusBarStyle = lipgloss.NewStyle().
 			Foreground(ColorMuted).
 			PaddingTop(1)
@@ -119,6 +125,21 @@ var (
 
 	MetaPunctStyle = lipgloss.NewStyle().
 			Foreground(ColorMuted)
+
+	MarkdownHeadingStyle = lipgloss.NewStyle().
+				Foreground(ColorSecondary).
+				Bold(true)
+
+	MarkdownCodeStyle = lipgloss.NewStyle().
+				Foreground(ColorWarning)
+
+	MarkdownLinkStyle = lipgloss.NewStyle().
+				Foreground(ColorBlue).
+				Underline(true)
+
+	SearchMatchStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("#0b0f14")).
+				Background(ColorWarning)
 )
 
 // Divider returns a horizontal line.
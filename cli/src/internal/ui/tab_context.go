@@ -0,0 +1,78 @@
+package ui
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+// bindTabContexts gives every tab a client whose reads are cancelled when the
+// user leaves that tab.
+func (a *App) bindTabContexts() {
+	for tab := range tabNames {
+		a.bindTabContext(tab)
+	}
+}
+
+// bindTabContext starts a fresh request context for a tab and hands the tab a
+// client bound to it.
+func (a *App) bindTabContext(tab int) {
+	if a.client == nil {
+		return
+	}
+	if a.tabCancels == nil {
+		a.tabCancels = map[int]context.CancelFunc{}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	a.tabCancels[tab] = cancel
+	a.setTabClient(tab, a.client.WithContext(ctx))
+}
+
+// cancelTabRequests cancels a tab's in-flight reads so their results cannot
+// land after the user moved on, then rebinds the tab for its next load.
+func (a *App) cancelTabRequests(tab int) {
+	if cancel, ok := a.tabCancels[tab]; ok {
+		cancel()
+	}
+	a.bindTabContext(tab)
+}
+
+// setTabClient swaps the client a tab model issues its requests with.
+func (a *App) setTabClient(tab int, client *api.Client) {
+	switch tab {
+	case tabInbox:
+		a.inbox.client = client
+	case tabEntities:
+		a.entities.client = client
+	case tabRelations:
+		a.rels.client = client
+	case tabKnow:
+		a.know.client = client
+	case tabJobs:
+		a.jobs.client = client
+	case tabLogs:
+		a.logs.client = client
+	case tabFiles:
+		a.files.client = client
+	case tabProtocols:
+		a.protocols.client = client
+	case tabHistory:
+		a.history.client = client
+	case tabProfile:
+		a.profile.client = client
+	case tabDashboard:
+		a.dashboard.client = client
+	case tabStatus:
+		a.status.client = client
+	case tabActivity:
+		a.activity.client = client
+	case tabCollections:
+		a.collections.client = client
+	}
+}
+
+// isCancelled reports whether err comes from a request cancelled on tab switch.
+func isCancelled(err error) bool {
+	return errors.Is(err, context.Canceled)
+}
@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+func TestSwitchTabCancelsLeftTabReads(t *testing.T) {
+	_, client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{}}))
+	})
+	app := NewApp(client, &config.Config{})
+	app.tab = tabEntities
+	left := app.entities
+
+	_, err := left.client.QueryEntities(nil)
+	require.NoError(t, err)
+
+	app.switchTab(tabJobs)
+	_, err = left.client.QueryEntities(nil)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.True(t, isCancelled(left.loadEntities("")().(loadFailedMsg).err))
+
+	// The tab gets a fresh client for its next load.
+	_, err = app.entities.client.QueryEntities(nil)
+	assert.NoError(t, err)
+	_, err = app.jobs.client.QueryJobs(nil)
+	assert.NoError(t, err)
+}
+
+func TestCancelledLoadsAreDropped(t *testing.T) {
+	app := NewApp(nil, &config.Config{})
+	cancelled := fmt.Errorf("request cancelled: %w", context.Canceled)
+
+	model, _ := app.Update(errMsg{cancelled})
+	app = model.(App)
+	model, _ = app.Update(loadFailedMsg{tab: tabInbox, err: cancelled})
+	app = model.(App)
+
+	assert.Empty(t, app.err)
+	assert.Empty(t, app.loadFailures)
+	assert.Empty(t, app.notifications)
+}
@@ -0,0 +1,356 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+const tableColumnMinFlexWidth = 12
+
+// tableColumnDef describes one column a table can show. A zero width marks
+// the flexible column that takes whatever space the others leave.
+type tableColumnDef struct {
+	key    string
+	header string
+	width  int
+	align  lipgloss.Position
+	style  func(string) lipgloss.Style
+}
+
+// tableLayout is the ordered list of column keys a table shows.
+type tableLayout []string
+
+// tableColumnSet is the catalog of columns one table offers.
+type tableColumnSet struct {
+	tab      string
+	title    string
+	defs     []tableColumnDef
+	defaults tableLayout
+}
+
+var (
+	contextColumnSet = tableColumnSet{
+		tab:   "context",
+		title: "Context",
+		defs: []tableColumnDef{
+			{key: "title", header: "Title", align: lipgloss.Left},
+			{key: "type", header: "Type", width: 10, align: lipgloss.Left},
+			{key: "status", header: "Status", width: 11, align: lipgloss.Left},
+			{key: "tags", header: "Tags", width: 18, align: lipgloss.Left},
+			{key: "url", header: "URL", width: 24, align: lipgloss.Left},
+			{key: "at", header: "At", width: compactTimeColumnWidth, align: lipgloss.Left},
+		},
+		defaults: tableLayout{"title", "type", "status", "at"},
+	}
+	fileColumnSet = tableColumnSet{
+		tab:   "files",
+		title: "Files",
+		defs: []tableColumnDef{
+			{key: "name", header: "File", align: lipgloss.Left},
+			{key: "status", header: "Status", width: 11, align: lipgloss.Left},
+			{key: "size", header: "Size", width: 10, align: lipgloss.Right},
+			{key: "mime", header: "MIME", width: 16, align: lipgloss.Left},
+			{key: "tags", header: "Tags", width: 18, align: lipgloss.Left},
+			{key: "path", header: "Path", width: 24, align: lipgloss.Left},
+			{key: "at", header: "At", width: compactTimeColumnWidth, align: lipgloss.Left},
+		},
+		defaults: tableLayout{"name", "status", "size", "at"},
+	}
+	inboxColumnSet = tableColumnSet{
+		tab:   "inbox",
+		title: "Inbox",
+		defs: []tableColumnDef{
+			{key: "title", header: "Title", align: lipgloss.Left},
+			{key: "action", header: "Action", width: 19, align: lipgloss.Left},
+			{key: "who", header: "Who", width: 14, align: lipgloss.Left},
+			{key: "status", header: "Status", width: 11, align: lipgloss.Left},
+			{key: "job", header: "Job", width: 10, align: lipgloss.Left},
+			{key: "at", header: "At", width: compactTimeColumnWidth, align: lipgloss.Left},
+			{key: "due", header: "Due", width: compactTimeColumnWidth, align: lipgloss.Left, style: approvalDueStyle},
+			{key: "priority", header: "Priority", width: 8, align: lipgloss.Left, style: approvalPriorityStyle},
+		},
+		defaults: tableLayout{"title", "action", "who", "due"},
+	}
+)
+
+// def returns the column definition for key.
+func (s tableColumnSet) def(key string) (tableColumnDef, bool) {
+	for _, d := range s.defs {
+		if d.key == key {
+			return d, true
+		}
+	}
+	return tableColumnDef{}, false
+}
+
+// parse reads a persisted layout such as `title,tags,at`. Unknown and
+// repeated keys are dropped; an empty result falls back to the defaults.
+func (s tableColumnSet) parse(raw string) tableLayout {
+	var layout tableLayout
+	seen := map[string]bool{}
+	for _, part := range strings.Split(raw, ",") {
+		key := strings.ToLower(strings.TrimSpace(part))
+		if _, ok := s.def(key); !ok || seen[key] {
+			continue
+		}
+		seen[key] = true
+		layout = append(layout, key)
+	}
+	if len(layout) == 0 {
+		return s.defaults
+	}
+	return layout
+}
+
+// String renders the layout the way tableColumnSet.parse reads it.
+func (l tableLayout) String() string {
+	return strings.Join(l, ",")
+}
+
+// equal reports whether two layouts show the same columns in the same order.
+func (l tableLayout) equal(other tableLayout) bool {
+	return l.String() == other.String()
+}
+
+// columns sizes the layout for a table of width and returns the grid columns
+// alongside each column's key and width. The flexible column takes the space
+// left over; without one, the first column does.
+func (s tableColumnSet) columns(layout tableLayout, width int, sort tableSort) ([]components.TableColumn, []tableColumnDef) {
+	defs := make([]tableColumnDef, 0, len(layout))
+	for _, key := range layout {
+		if d, ok := s.def(key); ok {
+			defs = append(defs, d)
+		}
+	}
+	if len(defs) == 0 {
+		return s.columns(s.defaults, width, sort)
+	}
+
+	sepWidth := 1
+	if b := lipgloss.RoundedBorder().Left; b != "" {
+		sepWidth = lipgloss.Width(b)
+	}
+	available := width - (len(defs)-1)*sepWidth
+	if available < 30 {
+		available = 30
+	}
+	flex := 0
+	for i, d := range defs {
+		if d.width == 0 {
+			flex = i
+		}
+		available -= d.width
+	}
+	if available > 0 {
+		defs[flex].width += available
+	}
+	if defs[flex].width < tableColumnMinFlexWidth {
+		defs[flex].width = tableColumnMinFlexWidth
+	}
+
+	cols := make([]components.TableColumn, len(defs))
+	for i, d := range defs {
+		cols[i] = components.TableColumn{Header: sort.header(d.header, d.key), Width: d.width, Align: d.align, CellStyle: d.style}
+	}
+	return cols, defs
+}
+
+// tableColumnsSavedMsg asks the app to persist a table's column layout.
+type tableColumnsSavedMsg struct {
+	tab    string
+	layout tableLayout
+}
+
+// columnPicker is the overlay that shows, hides, and reorders the columns of
+// the active table.
+type columnPicker struct {
+	set    tableColumnSet
+	order  tableLayout
+	shown  map[string]bool
+	cursor int
+}
+
+// newColumnPicker lists the shown columns first in their current order,
+// followed by the hidden ones.
+func newColumnPicker(set tableColumnSet, layout tableLayout) *columnPicker {
+	if len(layout) == 0 {
+		layout = set.defaults
+	}
+	p := &columnPicker{set: set, shown: map[string]bool{}}
+	for _, key := range layout {
+		p.order = append(p.order, key)
+		p.shown[key] = true
+	}
+	for _, d := range set.defs {
+		if !p.shown[d.key] {
+			p.order = append(p.order, d.key)
+		}
+	}
+	return p
+}
+
+// layout returns the shown columns in picker order.
+func (p *columnPicker) layout() tableLayout {
+	var layout tableLayout
+	for _, key := range p.order {
+		if p.shown[key] {
+			layout = append(layout, key)
+		}
+	}
+	return layout
+}
+
+// toggle shows or hides the column under the cursor, keeping at least one.
+func (p *columnPicker) toggle() {
+	key := p.order[p.cursor]
+	if p.shown[key] && len(p.layout()) == 1 {
+		return
+	}
+	p.shown[key] = !p.shown[key]
+}
+
+// move shifts the column under the cursor by delta places.
+func (p *columnPicker) move(delta int) {
+	target := p.cursor + delta
+	if target < 0 || target >= len(p.order) {
+		return
+	}
+	p.order[p.cursor], p.order[target] = p.order[target], p.order[p.cursor]
+	p.cursor = target
+}
+
+// tableColumnSetForTab returns the column catalog and current layout while
+// the active tab's configurable table has focus.
+func (a *App) tableColumnSetForTab() (tableColumnSet, tableLayout, bool) {
+	if a.vimList() == nil {
+		return tableColumnSet{}, nil, false
+	}
+	switch a.tab {
+	case tabKnow:
+		return contextColumnSet, a.know.columns, true
+	case tabFiles:
+		return fileColumnSet, a.files.columns, true
+	case tabInbox:
+		return inboxColumnSet, a.inbox.columns, true
+	}
+	return tableColumnSet{}, nil, false
+}
+
+// openColumnPicker opens the column picker for the active table.
+func (a *App) openColumnPicker() bool {
+	set, layout, ok := a.tableColumnSetForTab()
+	if !ok {
+		return false
+	}
+	a.columnPicker = newColumnPicker(set, layout)
+	return true
+}
+
+// setTableLayout applies a layout to the table that owns tab.
+func (a *App) setTableLayout(tab string, layout tableLayout) {
+	switch tab {
+	case contextColumnSet.tab:
+		a.know.columns = layout
+	case fileColumnSet.tab:
+		a.files.columns = layout
+	case inboxColumnSet.tab:
+		a.inbox.columns = layout
+	}
+}
+
+// handleColumnPickerKeys edits the picker. Enter applies and saves the
+// layout; esc discards it.
+func (a App) handleColumnPickerKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	p := a.columnPicker
+	switch {
+	case isBack(msg), isKey(msg, "ctrl+k"):
+		a.columnPicker = nil
+	case isEnter(msg):
+		a.columnPicker = nil
+		layout := p.layout()
+		a.setTableLayout(p.set.tab, layout)
+		return a, func() tea.Msg { return tableColumnsSavedMsg{tab: p.set.tab, layout: layout} }
+	case isKey(msg, "shift+up", "K"):
+		p.move(-1)
+	case isKey(msg, "shift+down", "J"):
+		p.move(1)
+	case isUp(msg):
+		if p.cursor > 0 {
+			p.cursor--
+		}
+	case isDown(msg):
+		if p.cursor < len(p.order)-1 {
+			p.cursor++
+		}
+	case isSpace(msg):
+		p.toggle()
+	case isKey(msg, "r"):
+		a.columnPicker = newColumnPicker(p.set, p.set.defaults)
+	}
+	return a, nil
+}
+
+// saveTableColumns records a table's layout in the config. The default
+// layout is stored as absent so later default changes still apply.
+func (a *App) saveTableColumns(msg tableColumnsSavedMsg) tea.Cmd {
+	set, ok := tableColumnSetByTab(msg.tab)
+	if a.config == nil || !ok {
+		return nil
+	}
+	if msg.layout.equal(set.defaults) {
+		delete(a.config.TableColumns, msg.tab)
+	} else {
+		if a.config.TableColumns == nil {
+			a.config.TableColumns = map[string]string{}
+		}
+		a.config.TableColumns[msg.tab] = msg.layout.String()
+	}
+	cfg := a.config
+	return func() tea.Msg {
+		if err := cfg.Save(); err != nil {
+			return errMsg{err}
+		}
+		return nil
+	}
+}
+
+// tableColumnSetByTab looks up a column catalog by its config key.
+func tableColumnSetByTab(tab string) (tableColumnSet, bool) {
+	for _, set := range []tableColumnSet{contextColumnSet, fileColumnSet, inboxColumnSet} {
+		if set.tab == tab {
+			return set, true
+		}
+	}
+	return tableColumnSet{}, false
+}
+
+// renderColumnPicker renders the column picker overlay.
+func (a App) renderColumnPicker() string {
+	p := a.columnPicker
+	var lines []string
+	lines = append(lines,
+		MetaKeyStyle.Render(p.set.title+" Columns"),
+		MutedStyle.Render("space shows or hides · shift+↑/↓ reorders · r resets"),
+		"",
+	)
+	for i, key := range p.order {
+		d, _ := p.set.def(key)
+		box := MutedStyle.Render("[ ]")
+		if p.shown[key] {
+			box = AccentStyle.Render("[x]")
+		}
+		marker := "  "
+		label := d.header
+		if i == p.cursor {
+			marker = AccentStyle.Render("> ")
+			label = SelectedStyle.Render(label)
+		}
+		lines = append(lines, fmt.Sprintf("%s%s %s", marker, box, label))
+	}
+	return components.Indent(components.TitledBox(p.set.title+" Columns", strings.Join(lines, "\n"), a.width), 1)
+}
@@ -0,0 +1,126 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableColumnSetParse(t *testing.T) {
+	assert.Equal(t, tableLayout{"tags", "name", "at"}, fileColumnSet.parse("Tags, name,bogus,name,at"))
+	assert.Equal(t, fileColumnSet.defaults, fileColumnSet.parse(""))
+	assert.Equal(t, fileColumnSet.defaults, fileColumnSet.parse("nope"))
+	assert.Equal(t, "tags,name,at", tableLayout{"tags", "name", "at"}.String())
+}
+
+func TestTableColumnSetColumnsGivesFlexTheRest(t *testing.T) {
+	cols, defs := contextColumnSet.columns(tableLayout{"tags", "title", "at"}, 100, tableSort{column: "title"})
+	require.Len(t, cols, 3)
+	assert.Equal(t, []string{"Tags", "Title ↑", "At"}, []string{cols[0].Header, cols[1].Header, cols[2].Header})
+	assert.Equal(t, "title", defs[1].key)
+	assert.Equal(t, 100-2-18-compactTimeColumnWidth, cols[1].Width)
+
+	cols, _ = contextColumnSet.columns(tableLayout{"type", "at"}, 100, tableSort{})
+	assert.Equal(t, 100-1-compactTimeColumnWidth, cols[0].Width)
+
+	cols, _ = contextColumnSet.columns(nil, 100, tableSort{})
+	assert.Len(t, cols, len(contextColumnSet.defaults))
+}
+
+func TestColumnPickerToggleMoveAndKeepOne(t *testing.T) {
+	p := newColumnPicker(inboxColumnSet, tableLayout{"title", "at"})
+	assert.Equal(t, tableLayout{"title", "at", "action", "who", "status", "job", "due", "priority"}, p.order)
+
+	p.cursor = 4
+	p.toggle()
+	p.move(-1)
+	p.move(-1)
+	p.move(-1)
+	assert.Equal(t, tableLayout{"title", "status", "at"}, p.layout())
+
+	p = newColumnPicker(inboxColumnSet, tableLayout{"who"})
+	p.toggle()
+	assert.Equal(t, tableLayout{"who"}, p.layout())
+}
+
+func TestColumnPickerAppliesAndPersistsLayout(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	app := NewApp(nil, &config.Config{APIKey: "nbl_test", Username: "alxx"})
+	sized, _ := app.Update(tea.WindowSizeMsg{Width: 200, Height: 60})
+	app = sized.(App)
+	app.tab = tabFiles
+	app.tabNav = false
+	app.files.view = filesViewList
+	app.files.all = []api.File{{ID: "f-1", Filename: "a.txt", Tags: []string{"alpha", "beta"}}}
+	app.files.applyFileSearch()
+
+	press := func(msg tea.KeyMsg) tea.Cmd {
+		model, cmd := app.Update(msg)
+		app = model.(App)
+		return cmd
+	}
+	press(tea.KeyMsg{Type: tea.KeyCtrlK})
+	require.NotNil(t, app.columnPicker)
+	assert.Equal(t, "columns", app.viewStateKey())
+	assert.Contains(t, components.SanitizeText(app.View()), "Files Columns")
+
+	for i := 0; i < 5; i++ {
+		press(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	press(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	press(tea.KeyMsg{Type: tea.KeyShiftUp})
+	press(tea.KeyMsg{Type: tea.KeyShiftUp})
+	cmd := press(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	assert.Nil(t, app.columnPicker)
+	assert.Equal(t, tableLayout{"name", "status", "size", "tags", "at"}, app.files.columns)
+	assert.Contains(t, components.SanitizeText(app.View()), "alpha, beta")
+
+	model, saveCmd := app.Update(cmd())
+	app = model.(App)
+	require.NotNil(t, saveCmd)
+	assert.Nil(t, saveCmd())
+	loaded, err := config.LoadFile()
+	require.NoError(t, err)
+	assert.Equal(t, "name,status,size,tags,at", loaded.TableColumns["files"])
+
+	restored := NewApp(nil, loaded)
+	assert.Equal(t, app.files.columns, restored.files.columns)
+}
+
+func TestColumnPickerEscDiscardsAndDefaultsAreNotStored(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	cfg := &config.Config{APIKey: "nbl_test", Username: "alxx", TableColumns: map[string]string{"inbox": "title,job"}}
+	app := NewApp(nil, cfg)
+	assert.Equal(t, tableLayout{"title", "job"}, app.inbox.columns)
+
+	app.columnPicker = newColumnPicker(inboxColumnSet, app.inbox.columns)
+	app.columnPicker.cursor = 1
+	app.columnPicker.toggle()
+	model, cmd := app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	app = model.(App)
+	assert.Nil(t, cmd)
+	assert.Equal(t, tableLayout{"title", "job"}, app.inbox.columns)
+
+	saveCmd := app.saveTableColumns(tableColumnsSavedMsg{tab: "inbox", layout: inboxColumnSet.defaults})
+	require.NotNil(t, saveCmd)
+	assert.Nil(t, saveCmd())
+	_, ok := cfg.TableColumns["inbox"]
+	assert.False(t, ok)
+}
+
+func TestColumnPickerNeedsFocusedTable(t *testing.T) {
+	app := NewApp(nil, &config.Config{APIKey: "nbl_test", Username: "alxx"})
+	app.tab = tabJobs
+	app.tabNav = false
+	assert.False(t, app.openColumnPicker())
+
+	app.tab = tabKnow
+	app.know.filtering = true
+	assert.False(t, app.openColumnPicker())
+}
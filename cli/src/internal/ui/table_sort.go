@@ -0,0 +1,143 @@
+package ui
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// tableSort is a table's sort column and direction. An empty column keeps
+// the order the server returned.
+type tableSort struct {
+	column string
+	desc   bool
+}
+
+// tableSortChangedMsg asks the app to persist a tab's sort.
+type tableSortChangedMsg struct {
+	tab  string
+	sort tableSort
+}
+
+// parseTableSort reads a persisted sort such as `size:desc`.
+func parseTableSort(raw string, columns []string) tableSort {
+	column, dir, _ := strings.Cut(strings.ToLower(strings.TrimSpace(raw)), ":")
+	for _, c := range columns {
+		if c == column {
+			return tableSort{column: column, desc: dir == "desc"}
+		}
+	}
+	return tableSort{}
+}
+
+// String renders the sort the way parseTableSort reads it.
+func (s tableSort) String() string {
+	if s.column == "" {
+		return ""
+	}
+	if s.desc {
+		return s.column + ":desc"
+	}
+	return s.column + ":asc"
+}
+
+// next cycles to the following column, then back to server order. Time
+// columns start newest first.
+func (s tableSort) next(columns []string) tableSort {
+	idx := -1
+	for i, c := range columns {
+		if c == s.column {
+			idx = i
+		}
+	}
+	if idx+1 >= len(columns) {
+		return tableSort{}
+	}
+	column := columns[idx+1]
+	return tableSort{column: column, desc: column == "at"}
+}
+
+// reversed flips the direction of an active sort.
+func (s tableSort) reversed() tableSort {
+	if s.column != "" {
+		s.desc = !s.desc
+	}
+	return s
+}
+
+// header marks the sorted column's header with its direction.
+func (s tableSort) header(label, column string) string {
+	if s.column != column {
+		return label
+	}
+	if s.desc {
+		return label + " ↓"
+	}
+	return label + " ↑"
+}
+
+// label describes the sort for a table's count line.
+func (s tableSort) label() string {
+	if s.column == "" {
+		return ""
+	}
+	dir := "asc"
+	if s.desc {
+		dir = "desc"
+	}
+	return "sort: " + s.column + " " + dir
+}
+
+// changed returns the message that persists the sort for tab.
+func (s tableSort) changed(tab string) tea.Cmd {
+	return func() tea.Msg { return tableSortChangedMsg{tab: tab, sort: s} }
+}
+
+// sortRows orders items by the active column. compare returns <0, 0, >0
+// for a before, equal to, or after b in ascending order.
+func sortRows[T any](items []T, s tableSort, compare func(column string, a, b T) int) {
+	if s.column == "" {
+		return
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		c := compare(s.column, items[i], items[j])
+		if s.desc {
+			return c > 0
+		}
+		return c < 0
+	})
+}
+
+// compareText orders strings case-insensitively.
+func compareText(a, b string) int {
+	return strings.Compare(strings.ToLower(strings.TrimSpace(a)), strings.ToLower(strings.TrimSpace(b)))
+}
+
+// compareTime orders timestamps oldest first.
+func compareTime(a, b time.Time) int {
+	return a.Compare(b)
+}
+
+// saveTableSort records a tab's sort in the config so it survives restarts.
+func (a *App) saveTableSort(msg tableSortChangedMsg) tea.Cmd {
+	if a.config == nil {
+		return nil
+	}
+	if msg.sort.column == "" {
+		delete(a.config.TableSort, msg.tab)
+	} else {
+		if a.config.TableSort == nil {
+			a.config.TableSort = map[string]string{}
+		}
+		a.config.TableSort[msg.tab] = msg.sort.String()
+	}
+	cfg := a.config
+	return func() tea.Msg {
+		if err := cfg.Save(); err != nil {
+			return errMsg{err}
+		}
+		return nil
+	}
+}
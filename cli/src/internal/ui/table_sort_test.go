@@ -0,0 +1,136 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableSortCyclesAndParses(t *testing.T) {
+	columns := []string{"at", "name", "size"}
+	s := tableSort{}
+	s = s.next(columns)
+	assert.Equal(t, tableSort{column: "at", desc: true}, s)
+	s = s.next(columns)
+	assert.Equal(t, tableSort{column: "name"}, s)
+	assert.Equal(t, tableSort{column: "name", desc: true}, s.reversed())
+	assert.Equal(t, "Name ↑", s.header("Name", "name"))
+	assert.Equal(t, "Size", s.header("Size", "size"))
+	assert.Equal(t, tableSort{}, s.next(columns).next(columns))
+	assert.Equal(t, tableSort{}, tableSort{}.reversed())
+
+	assert.Equal(t, tableSort{column: "size", desc: true}, parseTableSort("size:desc", columns))
+	assert.Equal(t, tableSort{}, parseTableSort("bogus:asc", columns))
+	assert.Equal(t, "size:desc", parseTableSort("size:desc", columns).String())
+}
+
+func TestFilesSortBySizeAndPersist(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	small, big := int64(10), int64(5000)
+	app := NewApp(nil, &config.Config{APIKey: "nbl_test", Username: "alxx"})
+	sized, _ := app.Update(tea.WindowSizeMsg{Width: 200, Height: 60})
+	app = sized.(App)
+	app.tab = tabFiles
+	app.tabNav = false
+	app.files.view = filesViewList
+	app.files.all = []api.File{
+		{ID: "f-1", Filename: "b.txt", SizeBytes: &big},
+		{ID: "f-2", Filename: "a.txt"},
+		{ID: "f-3", Filename: "c.txt", SizeBytes: &small},
+	}
+	app.files.applyFileSearch()
+
+	press := func(r rune) tea.Cmd {
+		model, cmd := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		app = model.(App)
+		return cmd
+	}
+	press('o')
+	cmd := press('o')
+	assert.Equal(t, "name", app.files.sort.column)
+	require.NotNil(t, cmd)
+	cmd = press('o')
+	assert.Equal(t, tableSort{column: "size"}, app.files.sort)
+	assert.Equal(t, []string{"f-2", "f-3", "f-1"}, fileIDs(app.files.items))
+
+	cmd = press('O')
+	assert.Equal(t, []string{"f-1", "f-3", "f-2"}, fileIDs(app.files.items))
+	view := components.SanitizeText(app.View())
+	assert.Contains(t, view, "Size ↓")
+	assert.Contains(t, view, "sort: size desc")
+
+	model, saveCmd := app.Update(cmd())
+	app = model.(App)
+	require.NotNil(t, saveCmd)
+	assert.Nil(t, saveCmd())
+	loaded, err := config.LoadFile()
+	require.NoError(t, err)
+	assert.Equal(t, "size:desc", loaded.TableSort["files"])
+
+	restored := NewApp(nil, loaded)
+	assert.Equal(t, tableSort{column: "size", desc: true}, restored.files.sort)
+}
+
+func TestFilesSortKeysTypeWhileSearching(t *testing.T) {
+	model := NewFilesModel(nil)
+	model.view = filesViewList
+	model.searchBuf.Value = "n"
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+	assert.Equal(t, "no", model.searchBuf.Value)
+	assert.Equal(t, tableSort{}, model.sort)
+}
+
+func TestHistorySortRestoresServerOrder(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	model := NewHistoryModel(nil)
+	model.view = historyViewList
+	model, _ = model.Update(historyLoadedMsg{items: []api.AuditEntry{
+		{ID: "a-1", Action: "update", TableName: "entities", ChangedAt: now},
+		{ID: "a-2", Action: "delete", TableName: "context", ChangedAt: now.Add(-time.Hour)},
+		{ID: "a-3", Action: "insert", TableName: "jobs", ChangedAt: now.Add(-2 * time.Hour)},
+	}})
+
+	for _, want := range []string{"at", "action"} {
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+		assert.Equal(t, want, model.sort.column)
+	}
+	assert.Equal(t, []string{"a-2", "a-3", "a-1"}, auditIDs(model.items))
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+	assert.Equal(t, tableSort{}, model.sort)
+	assert.Equal(t, []string{"a-1", "a-2", "a-3"}, auditIDs(model.items))
+}
+
+func TestContextSortByTitle(t *testing.T) {
+	model := NewContextModel(nil)
+	model.view = contextViewList
+	model.sort = tableSort{column: "title"}
+	model.allItems = []api.Context{{ID: "c-1", Title: "Zebra"}, {ID: "c-2", Title: "apple"}}
+	model.applyContextFilter()
+	assert.Equal(t, "c-2", model.items[0].ID)
+	assert.Equal(t, "c-1", model.items[1].ID)
+}
+
+func fileIDs(items []api.File) []string {
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = item.ID
+	}
+	return ids
+}
+
+func auditIDs(items []api.AuditEntry) []string {
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = item.ID
+	}
+	return ids
+}
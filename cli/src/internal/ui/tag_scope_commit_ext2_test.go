@@ -12,44 +12,44 @@ func TestContextCommitTagAndToggleModeAdditionalBranches(t *testing.T) {
 	model := NewContextModel(nil)
 	model.tags = []string{"alpha-tag"}
 
-	model.tagBuf = "   "
+	model.tagBuf.Value = "   "
 	model.commitTag()
 	assert.Equal(t, []string{"alpha-tag"}, model.tags)
-	assert.Equal(t, "", model.tagBuf)
+	assert.Equal(t, "", model.tagBuf.Value)
 
-	model.tagBuf = "#"
+	model.tagBuf.Value = "#"
 	model.commitTag()
 	assert.Equal(t, []string{"alpha-tag"}, model.tags)
-	assert.Equal(t, "", model.tagBuf)
+	assert.Equal(t, "", model.tagBuf.Value)
 
-	model.tagBuf = "Beta Tag"
+	model.tagBuf.Value = "Beta Tag"
 	model.commitTag()
 	assert.Equal(t, []string{"alpha-tag", "beta-tag"}, model.tags)
-	assert.Equal(t, "", model.tagBuf)
+	assert.Equal(t, "", model.tagBuf.Value)
 
 	model.editTags = []string{"alpha-tag"}
-	model.editTagBuf = "#"
+	model.editTagBuf.Value = "#"
 	model.commitEditTag()
 	assert.Equal(t, []string{"alpha-tag"}, model.editTags)
-	assert.Equal(t, "", model.editTagBuf)
+	assert.Equal(t, "", model.editTagBuf.Value)
 
-	model.editTagBuf = "Beta_Tag"
+	model.editTagBuf.Value = "Beta_Tag"
 	model.commitEditTag()
 	assert.Equal(t, []string{"alpha-tag", "beta-tag"}, model.editTags)
-	assert.Equal(t, "", model.editTagBuf)
+	assert.Equal(t, "", model.editTagBuf.Value)
 
 	model.view = contextViewDetail
 	model.modeFocus = true
 	model.detail = &apiContextFixture
 	model.metaExpanded = true
-	model.contentExpanded = true
+	model.pager = newContentPager("body")
 	model.sourcePathExpanded = true
 	updated, cmd := model.toggleMode()
 	require.Nil(t, cmd)
 	assert.False(t, updated.modeFocus)
 	assert.Nil(t, updated.detail)
 	assert.False(t, updated.metaExpanded)
-	assert.False(t, updated.contentExpanded)
+	assert.Nil(t, updated.pager)
 	assert.False(t, updated.sourcePathExpanded)
 	assert.Equal(t, contextViewList, updated.view)
 
@@ -62,15 +62,15 @@ func TestContextCommitTagAndToggleModeAdditionalBranches(t *testing.T) {
 func TestEntityAndFileTagScopeCommitAdditionalBranches(t *testing.T) {
 	entities := NewEntitiesModel(nil)
 	entities.addTags = []string{"alpha-tag"}
-	entities.addTagBuf = "#"
+	entities.addTagBuf.Value = "#"
 	entities.commitAddTag()
 	assert.Equal(t, []string{"alpha-tag"}, entities.addTags)
-	assert.Equal(t, "", entities.addTagBuf)
+	assert.Equal(t, "", entities.addTagBuf.Value)
 
-	entities.addTagBuf = "Beta Tag"
+	entities.addTagBuf.Value = "Beta Tag"
 	entities.commitAddTag()
 	assert.Equal(t, []string{"alpha-tag", "beta-tag"}, entities.addTags)
-	assert.Equal(t, "", entities.addTagBuf)
+	assert.Equal(t, "", entities.addTagBuf.Value)
 
 	entities.addScopes = []string{"public"}
 	entities.addScopeBuf = "#"
@@ -84,10 +84,10 @@ func TestEntityAndFileTagScopeCommitAdditionalBranches(t *testing.T) {
 	assert.Equal(t, "", entities.addScopeBuf)
 
 	entities.editTags = []string{"alpha-tag"}
-	entities.editTagBuf = "#"
+	entities.editTagBuf.Value = "#"
 	entities.commitEditTag()
 	assert.Equal(t, []string{"alpha-tag"}, entities.editTags)
-	assert.Equal(t, "", entities.editTagBuf)
+	assert.Equal(t, "", entities.editTagBuf.Value)
 
 	entities.editScopeBuf = "#"
 	entities.editScopes = []string{"private"}
@@ -103,26 +103,26 @@ func TestEntityAndFileTagScopeCommitAdditionalBranches(t *testing.T) {
 
 	files := NewFilesModel(nil)
 	files.addTags = []string{"alpha-tag"}
-	files.addTagBuf = "#"
+	files.addTagBuf.Value = "#"
 	files.commitAddTag()
 	assert.Equal(t, []string{"alpha-tag"}, files.addTags)
-	assert.Equal(t, "", files.addTagBuf)
+	assert.Equal(t, "", files.addTagBuf.Value)
 
-	files.addTagBuf = "Beta Tag"
+	files.addTagBuf.Value = "Beta Tag"
 	files.commitAddTag()
 	assert.Equal(t, []string{"alpha-tag", "beta-tag"}, files.addTags)
-	assert.Equal(t, "", files.addTagBuf)
+	assert.Equal(t, "", files.addTagBuf.Value)
 
 	files.editTags = []string{"alpha-tag"}
-	files.editTagBuf = "#"
+	files.editTagBuf.Value = "#"
 	files.commitEditTag()
 	assert.Equal(t, []string{"alpha-tag"}, files.editTags)
-	assert.Equal(t, "", files.editTagBuf)
+	assert.Equal(t, "", files.editTagBuf.Value)
 
-	files.editTagBuf = "Beta Tag"
+	files.editTagBuf.Value = "Beta Tag"
 	files.commitEditTag()
 	assert.Equal(t, []string{"alpha-tag", "beta-tag"}, files.editTags)
-	assert.Equal(t, "", files.editTagBuf)
+	assert.Equal(t, "", files.editTagBuf.Value)
 }
 
 var apiContextFixture = apiContextForToggleMode()
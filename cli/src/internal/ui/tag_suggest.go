@@ -0,0 +1,175 @@
+package ui
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	// maxContentTagSuggestions caps the suggestion chips under a Tags field.
+	maxContentTagSuggestions = 6
+	// minKeywordLen is the shortest word offered as a new tag.
+	minKeywordLen = 4
+)
+
+// tagStopwords are common words never suggested as tags.
+var tagStopwords = map[string]bool{
+	"about": true, "after": true, "also": true, "been": true, "before": true,
+	"being": true, "between": true, "both": true, "could": true, "does": true,
+	"each": true, "from": true, "have": true, "here": true, "html": true,
+	"http": true, "https": true, "index": true, "into": true, "just": true,
+	"like": true, "more": true, "most": true, "much": true, "must": true,
+	"only": true, "other": true, "over": true, "some": true, "such": true,
+	"than": true, "that": true, "their": true, "them": true, "then": true,
+	"there": true, "these": true, "they": true, "this": true, "those": true,
+	"through": true, "very": true, "want": true, "what": true, "when": true,
+	"where": true, "which": true, "while": true, "will": true, "with": true,
+	"without": true, "would": true, "your": true,
+}
+
+// suggestContentTags proposes tags for a knowledge item. Existing vocabulary
+// tags mentioned in the text come first, so tagging stays consistent with
+// the taxonomy; frequent keywords fill the remaining slots. The title counts
+// double.
+func suggestContentTags(vocab []string, title, rawURL, notes string) []string {
+	counts := map[string]int{}
+	for _, word := range tagWords(title) {
+		counts[word] += 2
+	}
+	for _, word := range append(tagWords(urlWords(rawURL)), tagWords(notes)...) {
+		counts[word]++
+	}
+	if len(counts) == 0 {
+		return nil
+	}
+
+	type scored struct {
+		tag   string
+		score int
+		known bool
+	}
+	var ranked []scored
+	seen := map[string]bool{}
+	for _, tag := range vocab {
+		tag = normalizeTag(tag)
+		key := suggestKey(tag)
+		if key == "" || seen[key] {
+			continue
+		}
+		score := 0
+		for _, part := range strings.FieldsFunc(tag, func(r rune) bool { return r == '-' || r == '_' || r == '/' }) {
+			n := counts[suggestKey(part)]
+			if n == 0 {
+				score = 0
+				break
+			}
+			score += n
+		}
+		if n := counts[key]; n > score {
+			score = n
+		}
+		if score > 0 {
+			seen[key] = true
+			ranked = append(ranked, scored{tag: tag, score: score, known: true})
+		}
+	}
+	for word, n := range counts {
+		if seen[word] || n < 2 || len([]rune(word)) < minKeywordLen {
+			continue
+		}
+		ranked = append(ranked, scored{tag: word, score: n})
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].known != ranked[j].known {
+			return ranked[i].known
+		}
+		if ranked[i].score != ranked[j].score {
+			return ranked[i].score > ranked[j].score
+		}
+		return ranked[i].tag < ranked[j].tag
+	})
+	if len(ranked) > maxContentTagSuggestions {
+		ranked = ranked[:maxContentTagSuggestions]
+	}
+	out := make([]string, len(ranked))
+	for i, item := range ranked {
+		out[i] = item.tag
+	}
+	return out
+}
+
+// tagWords splits text into lowercase words, dropping stopwords and numbers.
+func tagWords(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	out := fields[:0]
+	for _, word := range fields {
+		if len([]rune(word)) < 2 || tagStopwords[word] || strings.IndexFunc(word, unicode.IsLetter) < 0 {
+			continue
+		}
+		out = append(out, word)
+	}
+	return out
+}
+
+// urlWords returns the host name and path of a URL as plain text.
+func urlWords(raw string) string {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Host == "" {
+		return ""
+	}
+	host := strings.TrimPrefix(u.Hostname(), "www.")
+	if i := strings.LastIndex(host, "."); i > 0 {
+		host = host[:i]
+	}
+	return host + " " + u.Path
+}
+
+// hasTag reports whether tags contains tag, ignoring case.
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// toggleTag adds tag when missing and removes it when present.
+func toggleTag(tags []string, tag string) []string {
+	if !hasTag(tags, tag) {
+		return append(tags, tag)
+	}
+	out := make([]string, 0, len(tags))
+	for _, t := range tags {
+		if !strings.EqualFold(t, tag) {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// renderTagChips renders suggestions as chips, marking the ones already
+// applied and highlighting the cursor when focused.
+func renderTagChips(suggestions, applied []string, cursor int, focused bool) string {
+	if len(suggestions) == 0 {
+		return ""
+	}
+	chips := make([]string, len(suggestions))
+	for i, tag := range suggestions {
+		chip := "+ " + tag
+		style := MutedStyle
+		if hasTag(applied, tag) {
+			chip = "✓ " + tag
+			style = SuccessStyle
+		}
+		if focused && i == cursor {
+			style = SelectedStyle
+		}
+		chips[i] = style.Render("(" + chip + ")")
+	}
+	return MutedStyle.Render("suggested: ") + strings.Join(chips, " ")
+}
@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestSuggestContentTagsPrefersVocabulary(t *testing.T) {
+	vocab := []string{"postgres", "machine-learning", "go", "unrelated"}
+	got := suggestContentTags(vocab,
+		"Tuning Postgres indexes",
+		"https://www.example.com/blog/postgres-indexes",
+		"Notes on machine learning for query plans. Indexes matter; indexes everywhere.")
+
+	require.NotEmpty(t, got)
+	assert.Equal(t, []string{"postgres", "machine-learning", "indexes"}, got[:3])
+	assert.NotContains(t, got, "unrelated")
+	assert.NotContains(t, got, "notes")
+	assert.Empty(t, suggestContentTags(vocab, "", "", ""))
+}
+
+func TestToggleTag(t *testing.T) {
+	tags := toggleTag([]string{"go"}, "api")
+	assert.Equal(t, []string{"go", "api"}, tags)
+	assert.Equal(t, []string{"api"}, toggleTag(tags, "GO"))
+}
+
+func TestContextAddTagSuggestionChipsToggle(t *testing.T) {
+	model := NewContextModel(nil)
+	model.width = 100
+	model.tagOptions = []string{"postgres"}
+	model.fields[fieldTitle].Value = "Postgres vacuum tuning"
+	model.fields[fieldNotes].Value = "vacuum settings and vacuum cost limits"
+	model.focus = fieldTags
+
+	suggestions := model.contentTagSuggestions()
+	// Title words count double, so "tuning" qualifies from the title alone.
+	require.Equal(t, []string{"postgres", "vacuum", "tuning"}, suggestions)
+	view := components.SanitizeText(model.View())
+	assert.Contains(t, view, "suggested: (+ postgres) (+ vacuum) (+ tuning)")
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRight})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, []string{"vacuum"}, model.tags)
+	assert.Contains(t, components.SanitizeText(model.View()), "(✓ vacuum)")
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Empty(t, model.tags)
+
+	// With a tag being typed, enter commits the buffer as before.
+	model.tagBuf.Value = "infra"
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, []string{"infra"}, model.tags)
+}
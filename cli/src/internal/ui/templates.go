@@ -0,0 +1,153 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+// templatePicker is the inline template selector shared by the Add forms.
+type templatePicker struct {
+	templates []config.Template
+	idx       int
+	open      bool
+	applied   string
+}
+
+// Toggle opens the picker, or closes it when already open.
+func (p *templatePicker) Toggle() bool {
+	if len(p.templates) == 0 {
+		return false
+	}
+	p.open = !p.open
+	return true
+}
+
+// Move shifts the highlighted template by delta, wrapping around.
+func (p *templatePicker) Move(delta int) {
+	if len(p.templates) == 0 {
+		return
+	}
+	p.idx = (p.idx + delta + len(p.templates)) % len(p.templates)
+}
+
+// Pick closes the picker and returns the highlighted template.
+func (p *templatePicker) Pick() (config.Template, bool) {
+	p.open = false
+	if p.idx < 0 || p.idx >= len(p.templates) {
+		return config.Template{}, false
+	}
+	tmpl := p.templates[p.idx]
+	p.applied = tmpl.Name
+	return tmpl, true
+}
+
+// Reset clears picker state but keeps the loaded templates.
+func (p *templatePicker) Reset() {
+	p.idx = 0
+	p.open = false
+	p.applied = ""
+}
+
+// Render renders the picker row, or the applied template when closed.
+func (p templatePicker) Render() string {
+	if p.open {
+		var b strings.Builder
+		b.WriteString(SelectedStyle.Render("  Template:"))
+		b.WriteString("\n  ")
+		for i, tmpl := range p.templates {
+			if i > 0 {
+				b.WriteString(" ")
+			}
+			if i == p.idx {
+				b.WriteString(AccentStyle.Render("[" + tmpl.Name + "]"))
+			} else {
+				b.WriteString(MutedStyle.Render(" " + tmpl.Name + " "))
+			}
+		}
+		return b.String()
+	}
+	if p.applied != "" {
+		return MutedStyle.Render("  Template: " + p.applied)
+	}
+	if len(p.templates) > 0 {
+		return MutedStyle.Render("  ctrl+t to start from a template")
+	}
+	return ""
+}
+
+// mergeTemplateTags appends template tags not already chosen.
+func mergeTemplateTags(current []string, extra []string) []string {
+	out := append([]string{}, current...)
+	for _, raw := range extra {
+		tag := normalizeTag(raw)
+		if tag == "" || containsString(out, tag) {
+			continue
+		}
+		out = append(out, tag)
+	}
+	return out
+}
+
+// mergeTemplateScopes appends template scopes not already chosen.
+func mergeTemplateScopes(current []string, extra []string) []string {
+	out := append([]string{}, current...)
+	for _, raw := range extra {
+		scope := normalizeScope(raw)
+		if scope == "" || containsString(out, scope) {
+			continue
+		}
+		out = append(out, scope)
+	}
+	return out
+}
+
+// templateMetadataBuffer lays the template metadata skeleton under whatever
+// the editor already holds, so typed values are never overwritten.
+func templateMetadataBuffer(buffer string, skeleton map[string]any) string {
+	if len(skeleton) == 0 {
+		return buffer
+	}
+	current, err := parseMetadataInput(buffer)
+	if err != nil {
+		return buffer
+	}
+	merged := blankTemplateValues(skeleton)
+	for key, value := range blankTemplateValues(current) {
+		merged[key] = value
+	}
+	return metadataToInput(merged)
+}
+
+// blankTemplateValues copies metadata, writing empty values as a quoted empty
+// string so the editor shows an open slot instead of "None".
+func blankTemplateValues(skeleton map[string]any) map[string]any {
+	out := make(map[string]any, len(skeleton))
+	for key, value := range skeleton {
+		switch typed := value.(type) {
+		case map[string]any:
+			out[key] = blankTemplateValues(typed)
+		case nil:
+			out[key] = `""`
+		case string:
+			if strings.TrimSpace(typed) == "" {
+				out[key] = `""`
+			} else {
+				out[key] = typed
+			}
+		default:
+			out[key] = typed
+		}
+	}
+	return out
+}
+
+// containsString reports whether items holds value.
+func containsString(items []string, value string) bool {
+	for _, item := range items {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// templateConfig returns a config with one entity and one knowledge template.
+func templateConfig() *config.Config {
+	return &config.Config{
+		APIKey: "test-key",
+		Templates: map[string]config.Template{
+			"meeting": {
+				Type:     "meeting",
+				Tags:     []string{"Sync"},
+				Scopes:   []string{"work"},
+				Metadata: map[string]any{"agenda": "", "owner": "alxx"},
+			},
+			"person": {Type: "person"},
+			"paper":  {Kind: config.TemplateKindKnowledge, Type: "paper", Tags: []string{"research"}},
+		},
+	}
+}
+
+func TestEntityAddTemplatePickerPrefillsForm(t *testing.T) {
+	app := NewApp(nil, templateConfig())
+	model := app.entities
+	model.width = 100
+	model.view = entitiesViewAdd
+	require.Len(t, model.addTemplate.templates, 2)
+	assert.Contains(t, components.SanitizeText(model.renderAdd()), "ctrl+t to start from a template")
+
+	model.addFields[addFieldName].Value = "Weekly"
+	model.addTags = []string{"team"}
+	model.addMeta.Buffer = "owner | sam"
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+	require.True(t, model.addTemplate.open)
+	assert.Contains(t, components.SanitizeText(model.renderAdd()), "[meeting]")
+
+	// Typing is captured by the picker, not the focused field.
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRight})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.False(t, model.addTemplate.open)
+	assert.Equal(t, "Weekly", model.addFields[addFieldName].Value)
+	assert.Equal(t, "meeting", model.addFields[addFieldType].Value)
+	assert.Equal(t, []string{"team", "sync"}, model.addTags)
+	assert.Equal(t, []string{"work"}, model.addScopes)
+
+	meta, err := parseMetadataInput(model.addMeta.Buffer)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"agenda": "", "owner": "sam"}, meta)
+	assert.Contains(t, components.SanitizeText(model.renderAdd()), "Template: meeting")
+
+	model.resetAddForm()
+	assert.Empty(t, model.addTemplate.applied)
+	assert.Len(t, model.addTemplate.templates, 2)
+}
+
+func TestEntityAddTemplatePickerCancelAndNoTemplates(t *testing.T) {
+	model := NewApp(nil, templateConfig()).entities
+	model.view = entitiesViewAdd
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.False(t, model.addTemplate.open)
+	assert.Empty(t, model.addFields[addFieldType].Value)
+
+	bare := NewEntitiesModel(nil)
+	bare.view = entitiesViewAdd
+	bare, _ = bare.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+	assert.False(t, bare.addTemplate.open)
+	assert.NotContains(t, components.SanitizeText(bare.renderAdd()), "ctrl+t")
+}
+
+func TestContextAddTemplatePickerPrefillsForm(t *testing.T) {
+	model := NewApp(nil, templateConfig()).know
+	model.width = 100
+	require.Len(t, model.template.templates, 1)
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+	require.True(t, model.template.open)
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, "paper", contextTypes[model.typeIdx])
+	assert.Equal(t, []string{"research"}, model.tags)
+	assert.Contains(t, components.SanitizeText(model.renderAdd()), "Template: paper")
+}
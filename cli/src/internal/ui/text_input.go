@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"strings"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// formInputIndent is the indent before a focused form input.
+const formInputIndent = 4
+
+// renderInput renders a focused text input with its cursor, scrolled to fit
+// the width left after the form indent.
+func renderInput(in components.TextInput, width int) string {
+	return in.View(AccentStyle, max(width-formInputIndent, 0))
+}
+
+// typedText returns the text a key types, for type-to-search buffers that
+// take text at the end and leave the arrow keys to list navigation. A paste
+// comes back folded onto one line, so the search runs once for all of it.
+func typedText(msg tea.KeyMsg) (string, bool) {
+	switch {
+	case msg.Type != tea.KeyRunes:
+		return "", false
+	case msg.Paste:
+		return components.FoldLines(string(msg.Runes)), true
+	case msg.Alt:
+		return "", false
+	}
+	return string(msg.Runes), true
+}
+
+// pasteList splits a paste into a list field on commas and whitespace and
+// commits each item through buf, so a pasted list of tags becomes tags
+// rather than one long tag. It reports whether msg was a paste.
+func pasteList(buf *components.TextInput, msg tea.KeyMsg, commit func()) bool {
+	if msg.Type != tea.KeyRunes || !msg.Paste {
+		return false
+	}
+	items := strings.FieldsFunc(string(msg.Runes), func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+	for _, item := range items {
+		buf.Insert(item)
+		commit()
+	}
+	return true
+}
+
+// typesNonDigit reports whether msg would type anything but digits, for
+// inputs that only take numbers.
+func typesNonDigit(msg tea.KeyMsg) bool {
+	switch msg.Type {
+	case tea.KeySpace:
+		return true
+	case tea.KeyRunes:
+		return strings.Trim(string(msg.Runes), "0123456789") != ""
+	}
+	return false
+}
+
+// editChanged applies msg to in and reports whether the text changed, so live
+// filters do not re-run on cursor moves.
+func editChanged(in *components.TextInput, msg tea.KeyMsg) bool {
+	before := in.Value
+	return in.HandleKey(msg) && in.Value != before
+}
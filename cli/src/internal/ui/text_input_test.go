@@ -0,0 +1,71 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntitiesAddFormEditsMultiByteTextByCharacter(t *testing.T) {
+	model := NewEntitiesModel(nil)
+	model.view = entitiesViewAdd
+	model.modeFocus = false
+	model.width = 80
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("zażółć 東京")})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("👍🏽")})
+	assert.Equal(t, "zażółć 東京👍🏽", model.addFields[addFieldName].Value)
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	assert.Equal(t, "zażółć 東京", model.addFields[addFieldName].Value)
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	assert.Equal(t, "zażółć 東", model.addFields[addFieldName].Value)
+
+	// Left moves the cursor instead of leaving the field.
+	for range 3 {
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	}
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("ź")})
+	assert.Equal(t, "zażóźć 東", model.addFields[addFieldName].Value)
+	assert.Equal(t, addFieldName, model.addFocus)
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRight})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyDelete})
+	assert.Equal(t, "zażóźć東", model.addFields[addFieldName].Value)
+	assert.Contains(t, stripANSI(model.renderAdd()), "zażóźć")
+}
+
+func TestContextLinkSearchIgnoresCursorMoves(t *testing.T) {
+	model := NewContextModel(nil)
+	model.linkSearching = true
+	model.linkQuery.SetValue("alpha")
+
+	updated, cmd := model.handleLinkSearch(tea.KeyMsg{Type: tea.KeyLeft})
+	assert.Nil(t, cmd)
+	assert.Equal(t, 4, updated.linkQuery.Cursor())
+
+	updated, cmd = updated.handleLinkSearch(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("é")})
+	assert.NotNil(t, cmd)
+	assert.Equal(t, "alphéa", updated.linkQuery.Value)
+}
+
+func TestEntitiesPasteIsOneEdit(t *testing.T) {
+	model := NewEntitiesModel(nil)
+	model.view = entitiesViewAdd
+	model.modeFocus = false
+	model.addFocus = addFieldTags
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Alpha, beta\r\ngamma"), Paste: true})
+	assert.Equal(t, []string{"alpha", "beta", "gamma"}, model.addTags)
+	assert.Empty(t, model.addTagBuf.Value)
+
+	// Pasting into the list search folds the lines and loads once.
+	model = NewEntitiesModel(nil)
+	model.modeFocus = false
+	model.view = entitiesViewList
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("acme\r\ncorp\r\n"), Paste: true})
+	assert.NotNil(t, cmd)
+	assert.Equal(t, "acme corp", model.searchBuf.Value)
+}
@@ -0,0 +1,130 @@
+package ui
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+// timeWindowPresets are the quick ranges T cycles through; "" is all time.
+var timeWindowPresets = []string{"", "today", "7d", "30d"}
+
+// parseWindowToken reads a `since:` or `until:` token into w and reports
+// whether token was one. Values take the same forms as parseFilterTime.
+func parseWindowToken(w *api.TimeWindow, token string) bool {
+	key, value, ok := strings.Cut(token, ":")
+	if !ok {
+		return false
+	}
+	switch strings.ToLower(key) {
+	case "since":
+		if t := parseFilterTime(value); t != nil {
+			w.Since = *t
+		}
+	case "until":
+		if t := parseUntilTime(value); t != nil {
+			w.Until = *t
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// parseUntilTime parses an until bound. A day such as `today` or
+// `2024-01-31` includes that whole day, so the bound is the next midnight.
+func parseUntilTime(value string) *time.Time {
+	t := parseFilterTime(value)
+	if t == nil {
+		return nil
+	}
+	value = strings.TrimSpace(strings.ToLower(value))
+	if strings.HasSuffix(value, "h") || strings.HasSuffix(value, "d") {
+		return t
+	}
+	next := t.AddDate(0, 0, 1)
+	return &next
+}
+
+// splitWindowFilter parses the window tokens out of a filter string and
+// returns the window and the remaining text.
+func splitWindowFilter(raw string) (api.TimeWindow, string) {
+	var w api.TimeWindow
+	var rest []string
+	for _, token := range strings.Fields(raw) {
+		if !parseWindowToken(&w, token) {
+			rest = append(rest, token)
+		}
+	}
+	return w, strings.Join(rest, " ")
+}
+
+// formatWindow renders the bounds of w as since:/until: tokens.
+func formatWindow(w api.TimeWindow) []string {
+	var parts []string
+	if !w.Since.IsZero() {
+		parts = append(parts, "since:"+formatWindowBound(w.Since))
+	}
+	if !w.Until.IsZero() {
+		until := w.Until
+		if isMidnight(until) {
+			// Show the last included day, as the user typed it.
+			until = until.AddDate(0, 0, -1)
+		}
+		parts = append(parts, "until:"+formatWindowBound(until))
+	}
+	return parts
+}
+
+// formatWindowBound shows a date alone when t is midnight.
+func formatWindowBound(t time.Time) string {
+	if isMidnight(t) {
+		return t.Format("2006-01-02")
+	}
+	return t.Format("2006-01-02T15:04")
+}
+
+// isMidnight reports whether t is the start of a day.
+func isMidnight(t time.Time) bool {
+	return t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0
+}
+
+// windowContains reports whether t falls inside w.
+func windowContains(w api.TimeWindow, t time.Time) bool {
+	if !w.Since.IsZero() && t.Before(w.Since) {
+		return false
+	}
+	if !w.Until.IsZero() && !t.Before(w.Until) {
+		return false
+	}
+	return true
+}
+
+// cycleWindowPreset replaces the window tokens in a filter string with the
+// preset after the current one, or removes them at the end of the cycle.
+func cycleWindowPreset(raw string) string {
+	current := ""
+	var rest []string
+	for _, token := range strings.Fields(raw) {
+		var w api.TimeWindow
+		if parseWindowToken(&w, token) {
+			if key, value, _ := strings.Cut(token, ":"); strings.EqualFold(key, "since") {
+				current = strings.ToLower(value)
+			}
+			continue
+		}
+		rest = append(rest, token)
+	}
+	next := timeWindowPresets[1]
+	for i, preset := range timeWindowPresets {
+		if preset == current {
+			next = timeWindowPresets[(i+1)%len(timeWindowPresets)]
+			break
+		}
+	}
+	if next != "" {
+		rest = append(rest, "since:"+next)
+	}
+	return strings.Join(rest, " ")
+}
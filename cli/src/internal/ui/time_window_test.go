@@ -0,0 +1,113 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+)
+
+func TestSplitWindowFilter(t *testing.T) {
+	window, rest := splitWindowFilter("deploy since:2024-01-01 until:2024-01-31 failed")
+	assert.Equal(t, "deploy failed", rest)
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local), window.Since)
+	assert.Equal(t, time.Date(2024, 2, 1, 0, 0, 0, 0, time.Local), window.Until)
+	assert.Equal(t, []string{"since:2024-01-01", "until:2024-01-31"}, formatWindow(window))
+
+	assert.True(t, windowContains(window, time.Date(2024, 1, 31, 23, 0, 0, 0, time.Local)))
+	assert.False(t, windowContains(window, time.Date(2024, 2, 1, 0, 0, 0, 0, time.Local)))
+	assert.False(t, windowContains(window, time.Date(2023, 12, 31, 0, 0, 0, 0, time.Local)))
+
+	relative, _ := splitWindowFilter("since:7d")
+	assert.WithinDuration(t, time.Now().Add(-7*24*time.Hour), relative.Since, time.Minute)
+	assert.True(t, relative.Until.IsZero())
+}
+
+func TestCycleWindowPreset(t *testing.T) {
+	raw := "deploy"
+	var seen []string
+	for range timeWindowPresets {
+		raw = cycleWindowPreset(raw)
+		seen = append(seen, raw)
+	}
+	assert.Equal(t, []string{"deploy since:today", "deploy since:7d", "deploy since:30d", "deploy"}, seen)
+	assert.Equal(t, "since:today", cycleWindowPreset("since:2024-01-01 until:2024-02-01"))
+}
+
+func TestHistoryTimeRangeSendsWindow(t *testing.T) {
+	var queries []url.Values
+	_, client := contextTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/audit", r.URL.Path)
+		queries = append(queries, r.URL.Query())
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []any{}}))
+	})
+	model := NewHistoryModel(client)
+	model.width = 100
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("T")})
+	require.NotNil(t, cmd)
+	cmd()
+	require.Len(t, queries, 1)
+	today := time.Now()
+	midnight := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.Local)
+	assert.Equal(t, midnight.Format(time.RFC3339), queries[0].Get("since"))
+	assert.Equal(t, "since:today", model.filterBuf.Value)
+	assert.Contains(t, formatAuditFilters(model.filter), "since:"+midnight.Format("2006-01-02"))
+}
+
+func TestLogsWindowFiltersLoadedAndReloads(t *testing.T) {
+	var queries []url.Values
+	_, client := contextTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []any{}}))
+	})
+	model := NewLogsModel(client)
+	model.allItems = []api.Log{
+		{ID: "log-old", LogType: "deploy", Timestamp: time.Date(2023, 6, 1, 0, 0, 0, 0, time.Local)},
+		{ID: "log-new", LogType: "deploy", Timestamp: time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local)},
+	}
+	model.filtering = true
+	for _, r := range "deploy since:2024-01-01" {
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	require.Len(t, model.items, 1)
+	assert.Equal(t, "log-new", model.items[0].ID)
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	cmd()
+	require.NotEmpty(t, queries)
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local).Format(time.RFC3339), queries[len(queries)-1].Get("since"))
+
+	model.filtering = true
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Nil(t, cmd, "an unchanged window does not reload")
+}
+
+func TestInboxUntilFilterAndWindowReload(t *testing.T) {
+	filter := parseApprovalFilter("until:2024-01-31")
+	require.NotNil(t, filter.until)
+	assert.True(t, matchesApprovalFilter(api.Approval{CreatedAt: time.Date(2024, 1, 31, 12, 0, 0, 0, time.Local)}, filter))
+	assert.False(t, matchesApprovalFilter(api.Approval{CreatedAt: time.Date(2024, 2, 1, 12, 0, 0, 0, time.Local)}, filter))
+
+	var since string
+	_, client := contextTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/approvals/pending" {
+			since = r.URL.Query().Get("since")
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []any{}}))
+	})
+	model := NewInboxModel(client)
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("T")})
+	require.NotNil(t, cmd)
+	cmd()
+	assert.Equal(t, "since:today", model.filterBuf.Value)
+	assert.NotEmpty(t, since)
+}
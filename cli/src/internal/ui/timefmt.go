@@ -1,6 +1,9 @@
 package ui
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 const compactTimeColumnWidth = 15
 
@@ -19,3 +22,34 @@ func formatLocalTimeFull(ts time.Time) string {
 	}
 	return ts.Local().Format("2006-01-02 15:04 MST")
 }
+
+// slowLoadThreshold marks view loads that get highlighted in count lines.
+const slowLoadThreshold = time.Second
+
+// loadElapsed returns the time since a view load was queued, zero when untracked.
+func loadElapsed(queued time.Time) time.Duration {
+	if queued.IsZero() {
+		return 0
+	}
+	return time.Since(queued)
+}
+
+// formatLoadLatency renders a load duration in ms below one second.
+func formatLoadLatency(d time.Duration) string {
+	if d < time.Second {
+		return fmt.Sprintf("%dms", d.Milliseconds())
+	}
+	return fmt.Sprintf("%.1fs", d.Seconds())
+}
+
+// renderLoadLatency renders the count line suffix for the last view load.
+func renderLoadLatency(d time.Duration) string {
+	if d <= 0 {
+		return ""
+	}
+	label := " · " + formatLoadLatency(d)
+	if d > slowLoadThreshold {
+		return WarningStyle.Render(label + " slow")
+	}
+	return MutedStyle.Render(label)
+}
@@ -0,0 +1,353 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// trashConfirmWord must be typed before archived records are deleted for good.
+const trashConfirmWord = "delete"
+
+const trashLoadLimit = "100"
+
+type trashKind int
+
+const (
+	trashEntity trashKind = iota
+	trashRelationship
+)
+
+type trashItem struct {
+	kind   trashKind
+	id     string
+	label  string
+	detail string
+}
+
+type trashLoadedMsg struct {
+	items []trashItem
+}
+
+// TrashModel lists archived entities and relationships for restore or
+// permanent delete.
+type TrashModel struct {
+	client *api.Client
+
+	items    []trashItem
+	list     *components.List
+	selected map[string]bool
+	loading  bool
+	closed   bool
+
+	confirmDelete bool
+	typed         components.TextInput
+
+	width  int
+	height int
+}
+
+// NewTrashModel builds the trash overlay model.
+func NewTrashModel(client *api.Client) TrashModel {
+	return TrashModel{
+		client:   client,
+		list:     components.NewList(15),
+		selected: map[string]bool{},
+	}
+}
+
+// Start resets the overlay and loads archived records.
+func (m *TrashModel) Start() tea.Cmd {
+	m.items = nil
+	m.list.SetItems(nil)
+	m.selected = map[string]bool{}
+	m.loading = true
+	m.closed = false
+	m.confirmDelete = false
+	m.typed.Reset()
+	return m.load()
+}
+
+// load fetches archived entities and relationships.
+func (m TrashModel) load() tea.Cmd {
+	client := m.client
+	return func() tea.Msg {
+		params := api.QueryParams{"status_category": "archived", "limit": trashLoadLimit}
+		entities, err := client.QueryEntities(params)
+		if err != nil {
+			return errMsg{err}
+		}
+		rels, err := client.QueryRelationships(params)
+		if err != nil {
+			return errMsg{err}
+		}
+		items := make([]trashItem, 0, len(entities)+len(rels))
+		for _, entity := range entities {
+			items = append(items, trashItem{
+				kind:   trashEntity,
+				id:     entity.ID,
+				label:  entity.Name,
+				detail: strings.TrimSpace(entity.Type + " · " + entity.Status),
+			})
+		}
+		for _, rel := range rels {
+			items = append(items, trashItem{
+				kind:   trashRelationship,
+				id:     rel.ID,
+				label:  fmt.Sprintf("%s -> %s", relationshipNodeLabel(rel.SourceName, rel.SourceID, rel.SourceType), relationshipNodeLabel(rel.TargetName, rel.TargetID, rel.TargetType)),
+				detail: strings.TrimSpace(rel.Type + " · " + rel.Status),
+			})
+		}
+		return trashLoadedMsg{items: items}
+	}
+}
+
+// Update handles trash messages and keys.
+func (m TrashModel) Update(msg tea.Msg) (TrashModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case trashLoadedMsg:
+		m.loading = false
+		m.setItems(msg.items)
+		return m, nil
+	case tea.KeyMsg:
+		if m.confirmDelete {
+			return m.handleConfirmKeys(msg)
+		}
+		return m.handleListKeys(msg)
+	}
+	return m, nil
+}
+
+// handleListKeys moves, selects, and acts on archived records.
+func (m TrashModel) handleListKeys(msg tea.KeyMsg) (TrashModel, tea.Cmd) {
+	switch {
+	case isDown(msg):
+		m.list.Down()
+	case isUp(msg):
+		m.list.Up()
+	case isSpace(msg):
+		if item, ok := m.current(); ok {
+			key := item.key()
+			if m.selected[key] {
+				delete(m.selected, key)
+			} else {
+				m.selected[key] = true
+			}
+		}
+	case isKey(msg, "b"):
+		if len(m.selected) == len(m.items) {
+			m.selected = map[string]bool{}
+		} else {
+			for _, item := range m.items {
+				m.selected[item.key()] = true
+			}
+		}
+	case isKey(msg, "r"):
+		return m.restore()
+	case isKey(msg, "D"):
+		if len(m.targets()) > 0 {
+			m.confirmDelete = true
+			m.typed.Reset()
+		}
+	case isBack(msg):
+		m.closed = true
+	}
+	return m, nil
+}
+
+// handleConfirmKeys collects the typed confirmation for permanent delete.
+func (m TrashModel) handleConfirmKeys(msg tea.KeyMsg) (TrashModel, tea.Cmd) {
+	switch {
+	case isBack(msg):
+		m.confirmDelete = false
+		m.typed.Reset()
+	case isEnter(msg):
+		if m.typed.Value != trashConfirmWord {
+			return m, nil
+		}
+		m.confirmDelete = false
+		m.typed.Reset()
+		return m.purge()
+	default:
+		m.typed.HandleKey(msg)
+	}
+	return m, nil
+}
+
+// restore queues restoring the targets to active and drops them from the list.
+func (m TrashModel) restore() (TrashModel, tea.Cmd) {
+	targets := m.targets()
+	if len(targets) == 0 {
+		return m, nil
+	}
+	client := m.client
+	steps := make([]operationStep, 0, len(targets))
+	for _, item := range targets {
+		item := item
+		steps = append(steps, operationStep{
+			label: item.label,
+			run: func() ([]string, error) {
+				status := "active"
+				var err error
+				if item.kind == trashEntity {
+					_, err = client.UpdateEntity(item.id, api.UpdateEntityInput{Status: &status})
+				} else {
+					_, err = client.UpdateRelationship(item.id, api.UpdateRelationshipInput{Status: &status})
+				}
+				return nil, err
+			},
+		})
+	}
+	m.drop(targets)
+	label := fmt.Sprintf("Restore %d archived", len(targets))
+	return m, queueOperation(label, tabEntities, steps, entityBulkUpdatedMsg{})
+}
+
+// purge queues permanent deletes for the targets and drops them from the list.
+func (m TrashModel) purge() (TrashModel, tea.Cmd) {
+	targets := m.targets()
+	if len(targets) == 0 {
+		return m, nil
+	}
+	client := m.client
+	steps := make([]operationStep, 0, len(targets))
+	for _, item := range targets {
+		item := item
+		steps = append(steps, operationStep{
+			label: item.label,
+			run: func() ([]string, error) {
+				if item.kind == trashEntity {
+					return nil, client.DeleteEntity(item.id)
+				}
+				return nil, client.DeleteRelationship(item.id)
+			},
+		})
+	}
+	m.drop(targets)
+	label := fmt.Sprintf("Delete %d archived", len(targets))
+	return m, queueOperation(label, tabEntities, steps, entityBulkUpdatedMsg{})
+}
+
+// targets returns the selected items, or the current one when none are.
+func (m TrashModel) targets() []trashItem {
+	if len(m.selected) == 0 {
+		if item, ok := m.current(); ok {
+			return []trashItem{item}
+		}
+		return nil
+	}
+	var out []trashItem
+	for _, item := range m.items {
+		if m.selected[item.key()] {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// current returns the item under the cursor.
+func (m TrashModel) current() (trashItem, bool) {
+	idx := m.list.Selected()
+	if idx < 0 || idx >= len(m.items) {
+		return trashItem{}, false
+	}
+	return m.items[idx], true
+}
+
+// drop removes items from the list and clears the selection.
+func (m *TrashModel) drop(items []trashItem) {
+	gone := make(map[string]bool, len(items))
+	for _, item := range items {
+		gone[item.key()] = true
+	}
+	kept := m.items[:0:0]
+	for _, item := range m.items {
+		if !gone[item.key()] {
+			kept = append(kept, item)
+		}
+	}
+	m.selected = map[string]bool{}
+	m.setItems(kept)
+}
+
+// setItems replaces the items and rebuilds the list labels.
+func (m *TrashModel) setItems(items []trashItem) {
+	m.items = items
+	labels := make([]string, len(items))
+	for i, item := range items {
+		labels[i] = item.label
+	}
+	m.list.SetItems(labels)
+}
+
+// key identifies an item across kinds.
+func (t trashItem) key() string {
+	if t.kind == trashEntity {
+		return "entity:" + t.id
+	}
+	return "relationship:" + t.id
+}
+
+// View renders the trash overlay.
+func (m TrashModel) View() string {
+	if m.confirmDelete {
+		title := fmt.Sprintf("Type %q to delete %d for good", trashConfirmWord, len(m.targets()))
+		return components.InputDialog(title, m.typed)
+	}
+	if m.loading {
+		return components.Indent(components.Box(MutedStyle.Render("Loading archived records..."), m.width), 1)
+	}
+	if len(m.items) == 0 {
+		return components.Indent(components.EmptyStateBox(
+			"Trash",
+			"Nothing archived.",
+			[]string{"Archived entities and relationships show up here for restore or permanent delete."},
+			m.width,
+		), 1)
+	}
+
+	contentWidth := components.BoxContentWidth(m.width)
+	if contentWidth <= 0 {
+		contentWidth = 72
+	}
+	lines := []string{MetaKeyStyle.Render("Trash"), ""}
+	visible := m.list.Visible()
+	for i, label := range visible {
+		idx := m.list.RelToAbs(i)
+		item := m.items[idx]
+		marker := "  "
+		if idx == m.list.Selected() {
+			marker = AccentStyle.Render("> ")
+		}
+		check := "[ ] "
+		if m.selected[item.key()] {
+			check = "[x] "
+		}
+		kind := "entity"
+		if item.kind == trashRelationship {
+			kind = "rel"
+		}
+		line := fmt.Sprintf("%s%-7s%s", check, kind, components.SanitizeOneLine(label))
+		if item.detail != "" {
+			line += "  " + MutedStyle.Render(components.SanitizeOneLine(item.detail))
+		}
+		lines = append(lines, marker+components.ClampTextWidthEllipsis(line, contentWidth-2))
+	}
+	count := fmt.Sprintf("%d archived", len(m.items))
+	if len(m.selected) > 0 {
+		count += fmt.Sprintf(" · %d selected", len(m.selected))
+	}
+	lines = append(lines, "", MutedStyle.Render(count))
+	return components.Indent(components.Box(strings.Join(lines, "\n"), m.width), 1)
+}
+
+// openTrash shows the trash overlay and loads archived records.
+func (a *App) openTrash() tea.Cmd {
+	a.tabNav = false
+	a.trashOpen = true
+	return a.trash.Start()
+}
@@ -0,0 +1,143 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func trashTestServer(t *testing.T, calls *[]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*calls = append(*calls, r.Method+" "+r.URL.Path)
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/entities":
+			assert.Equal(t, "archived", r.URL.Query().Get("status_category"))
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+				"data": []map[string]any{{"id": "ent-1", "name": "Old Project", "type": "project", "status": "inactive"}},
+			}))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/relationships":
+			assert.Equal(t, "archived", r.URL.Query().Get("status_category"))
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+				"data": []map[string]any{{
+					"id": "rel-1", "source_name": "Alpha", "target_name": "Beta",
+					"relationship_type": "depends-on", "status": "inactive",
+				}},
+			}))
+		case r.Method == http.MethodPatch:
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "active", body["status"])
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "x"}}))
+		case r.Method == http.MethodDelete:
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": "x"}}))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+}
+
+func loadedTrash(t *testing.T, calls *[]string) TrashModel {
+	_, client := testClient(t, trashTestServer(t, calls))
+	m := NewTrashModel(client)
+	m.width = 100
+	cmd := m.Start()
+	require.NotNil(t, cmd)
+	m, _ = m.Update(cmd())
+	return m
+}
+
+func runQueued(t *testing.T, cmd tea.Cmd) operationQueuedMsg {
+	require.NotNil(t, cmd)
+	queued, ok := cmd().(operationQueuedMsg)
+	require.True(t, ok)
+	for _, step := range queued.steps {
+		_, err := step.run()
+		require.NoError(t, err)
+	}
+	return queued
+}
+
+func TestTrashLoadsArchivedEntitiesAndRelationships(t *testing.T) {
+	var calls []string
+	m := loadedTrash(t, &calls)
+
+	require.Len(t, m.items, 2)
+	view := components.SanitizeText(m.View())
+	assert.Contains(t, view, "Old Project")
+	assert.Contains(t, view, "Alpha -> Beta")
+	assert.Contains(t, view, "2 archived")
+}
+
+func TestTrashRestoresSelectionThroughQueue(t *testing.T) {
+	var calls []string
+	m := loadedTrash(t, &calls)
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	assert.Contains(t, components.SanitizeText(m.View()), "2 selected")
+	var cmd tea.Cmd
+	m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	queued := runQueued(t, cmd)
+
+	assert.Empty(t, m.items)
+	assert.Equal(t, tabEntities, queued.tab)
+	assert.Contains(t, calls, "PATCH /api/entities/ent-1")
+	assert.Contains(t, calls, "PATCH /api/relationships/rel-1")
+}
+
+func TestTrashPermanentDeleteNeedsTypedConfirmation(t *testing.T) {
+	var calls []string
+	m := loadedTrash(t, &calls)
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'D'}})
+	require.True(t, m.confirmDelete)
+	assert.Contains(t, components.SanitizeText(m.View()), `"delete"`)
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("delet")})
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Nil(t, cmd)
+	assert.True(t, m.confirmDelete)
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	runQueued(t, cmd)
+
+	assert.False(t, m.confirmDelete)
+	require.Len(t, m.items, 1)
+	assert.Equal(t, "rel-1", m.items[0].id)
+	assert.Contains(t, calls, "DELETE /api/entities/ent-1")
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'D'}})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.False(t, m.confirmDelete)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.True(t, m.closed)
+}
+
+func TestArchivedTogglesQueryArchivedCategory(t *testing.T) {
+	var categories []string
+	_, client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		categories = append(categories, r.URL.Path+"="+r.URL.Query().Get("status_category"))
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": []any{}}))
+	})
+
+	entities := NewEntitiesModel(client)
+	entities, cmd := entities.handleListKeys(tea.KeyMsg{Type: tea.KeyCtrlA})
+	require.NotNil(t, cmd)
+	entities, _ = entities.Update(cmd())
+	assert.Contains(t, components.SanitizeText(entities.View()), "No archived entities")
+
+	rels := NewRelationshipsModel(client)
+	rels, cmd = rels.handleListKeys(tea.KeyMsg{Type: tea.KeyCtrlA})
+	require.NotNil(t, cmd)
+	rels, _ = rels.Update(cmd())
+	assert.Contains(t, components.SanitizeText(rels.View()), "No archived relationships")
+
+	assert.Contains(t, categories, "/api/entities=archived")
+	assert.Contains(t, categories, "/api/relationships=archived")
+}
@@ -0,0 +1,41 @@
+package ui
+
+import (
+	"context"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/update"
+	"github.com/gravitrone/nebula-core/cli/internal/version"
+)
+
+// updateCheckTimeout bounds the background release check so a slow network
+// never holds up the TUI.
+const updateCheckTimeout = 3 * time.Second
+
+type updateAvailableMsg struct{ version string }
+
+// checkForUpdate looks for a newer release in the background. Failures are
+// silent; the hint is a convenience, not a requirement.
+func checkForUpdate() tea.Msg {
+	if update.CheckDisabled() {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), updateCheckTimeout)
+	defer cancel()
+	latest, err := update.Available(ctx, version.Version, time.Now())
+	if err != nil || latest == "" {
+		return nil
+	}
+	return updateAvailableMsg{version: latest}
+}
+
+// renderUpdateStatus renders the new-version hint under the banner.
+func (a App) renderUpdateStatus() string {
+	if a.updateAvailable == "" {
+		return ""
+	}
+	return MutedStyle.Render("nebula "+a.updateAvailable+" is available · run ") +
+		AccentStyle.Render("nebula update")
+}
@@ -0,0 +1,31 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestCheckForUpdateUsesCachedRelease(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	require.NoError(t, (&config.UpdateCheck{CheckedAt: time.Now(), Latest: "v99.0.0"}).Save())
+
+	assert.Equal(t, updateAvailableMsg{version: "v99.0.0"}, checkForUpdate())
+
+	t.Setenv("NEBULA_NO_UPDATE_CHECK", "1")
+	assert.Nil(t, checkForUpdate())
+}
+
+func TestUpdateHintRendersInBanner(t *testing.T) {
+	app := NewApp(nil, &config.Config{})
+	assert.Empty(t, app.renderUpdateStatus())
+
+	model, _ := app.Update(updateAvailableMsg{version: "v99.0.0"})
+	app = model.(App)
+	assert.Contains(t, components.SanitizeText(app.renderUpdateStatus()), "nebula v99.0.0 is available · run nebula update")
+}
@@ -0,0 +1,277 @@
+package ui
+
+import (
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// vimState holds a pending count prefix, a half-typed `gg`, and the in-list
+// search started with `/`.
+type vimState struct {
+	count     string
+	pendingG  bool
+	searching bool
+	query     components.TextInput
+	origin    int
+}
+
+type vimKeysSavedMsg struct{ enabled bool }
+
+// vimEnabled reports whether the config opted into vim keybindings.
+func (a App) vimEnabled() bool {
+	return a.config != nil && a.config.VimKeys
+}
+
+// vimList returns the active tab's list while it is a plain list with focus,
+// or nil when keys belong to a form, filter, or detail view.
+func (a *App) vimList() *components.List {
+	if a.tabNav {
+		return nil
+	}
+	switch a.tab {
+	case tabInbox:
+		if a.inbox.filtering || a.inbox.triagePrompt != triagePromptNone || a.inbox.rejecting ||
+			a.inbox.commenting || a.inbox.confirming || a.inbox.rejectPreview || a.inbox.grantEditing ||
+			a.inbox.detail != nil {
+			return nil
+		}
+		return a.inbox.list
+	case tabEntities:
+		if a.entities.view != entitiesViewList || a.entities.modeFocus || a.entities.filtering || a.entities.bulkPrompt != "" ||
+			a.entities.bulkPreview != nil || a.entities.bulkEdit.open || a.entities.collectionPick != nil {
+			return nil
+		}
+		return a.entities.list
+	case tabRelations:
+		if a.rels.view != relsViewList || a.rels.modeFocus || a.rels.filtering || a.rels.editMeta.Active {
+			return nil
+		}
+		return a.rels.list
+	case tabKnow:
+		if a.know.view != contextViewList || a.know.modeFocus || a.know.filtering || a.know.bulk.prompt != "" {
+			return nil
+		}
+		return a.know.list
+	case tabJobs:
+		if a.jobs.view != jobsViewList || a.jobs.modeFocus || a.jobs.filtering || a.jobs.detail != nil || a.jobs.changingSt {
+			return nil
+		}
+		return a.jobs.list
+	case tabLogs:
+		if a.logs.view != logsViewList || a.logs.modeFocus || a.logs.filtering {
+			return nil
+		}
+		return a.logs.list
+	case tabFiles:
+		if a.files.view != filesViewList || a.files.modeFocus || a.files.filtering || a.files.bulk.prompt != "" {
+			return nil
+		}
+		return a.files.list
+	case tabProtocols:
+		if a.protocols.view != protocolsViewList || a.protocols.modeFocus || a.protocols.filtering {
+			return nil
+		}
+		return a.protocols.list
+	case tabHistory:
+		if a.history.view != historyViewList || a.history.filtering {
+			return nil
+		}
+		return a.history.list
+	case tabActivity:
+		return a.activity.list
+	case tabCollections:
+		if a.collections.view != collectionsViewList || a.collections.prompt != "" || a.collections.confirmDelete {
+			return nil
+		}
+		return a.collections.list
+	}
+	return nil
+}
+
+// handleVimKeys applies vim motions to the focused list. ok is false when
+// the key is not a vim key here and should take the normal route.
+func (a App) handleVimKeys(msg tea.KeyMsg) (model tea.Model, cmd tea.Cmd, ok bool) {
+	if a.vim.searching {
+		model, cmd = a.handleVimSearchKeys(msg)
+		return model, cmd, true
+	}
+	list := a.vimList()
+	if list == nil || len(list.Items) == 0 {
+		a.vim = vimState{}
+		return a, nil, false
+	}
+
+	key := msg.String()
+	if len(key) == 1 && key[0] >= '0' && key[0] <= '9' && (key != "0" || a.vim.count != "") {
+		a.vim.count += key
+		a.vim.pendingG = false
+		return a, nil, true
+	}
+	typed := a.vim.count
+	count := a.vimCount()
+	pendingG := a.vim.pendingG
+	a.vim.count = ""
+	a.vim.pendingG = false
+
+	half := list.PageSize / 2
+	if half < 1 {
+		half = 1
+	}
+	switch key {
+	case "j":
+		return a, a.vimMoveTo(list.Selected() + count), true
+	case "k":
+		return a, a.vimMoveTo(list.Selected() - count), true
+	case "ctrl+d":
+		return a, a.vimMoveTo(list.Selected() + half*count), true
+	case "ctrl+u":
+		return a, a.vimMoveTo(list.Selected() - half*count), true
+	case "G":
+		target := len(list.Items) - 1
+		if typed != "" {
+			target = count - 1
+		}
+		return a, a.vimMoveTo(target), true
+	case "g":
+		if !pendingG {
+			a.vim.pendingG = true
+			a.vim.count = typed
+			return a, nil, true
+		}
+		target := 0
+		if typed != "" {
+			target = count - 1
+		}
+		return a, a.vimMoveTo(target), true
+	case "/":
+		a.vim.searching = true
+		a.vim.query.Reset()
+		a.vim.origin = list.Selected()
+		return a, nil, true
+	}
+	return a, nil, false
+}
+
+// vimCount returns the typed count prefix, defaulting to one.
+func (a App) vimCount() int {
+	count, err := strconv.Atoi(a.vim.count)
+	if err != nil || count < 1 {
+		return 1
+	}
+	return count
+}
+
+// vimMoveTo moves the focused list to target. All but the last step move the
+// cursor directly, so per-row side effects only fire for the landing row.
+func (a *App) vimMoveTo(target int) tea.Cmd {
+	list := a.vimList()
+	if list == nil || len(list.Items) == 0 {
+		return nil
+	}
+	if target < 0 {
+		target = 0
+	}
+	if target > len(list.Items)-1 {
+		target = len(list.Items) - 1
+	}
+	switch {
+	case target > list.Selected():
+		for list.Selected() < target-1 {
+			list.Down()
+		}
+		return a.updateTab(a.tab, tea.KeyMsg{Type: tea.KeyDown})
+	case target < list.Selected():
+		for list.Selected() > target+1 {
+			list.Up()
+		}
+		return a.updateTab(a.tab, tea.KeyMsg{Type: tea.KeyUp})
+	}
+	return nil
+}
+
+// handleVimSearchKeys edits the in-list search and jumps to the first match.
+func (a App) handleVimSearchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case isEnter(msg):
+		a.vim.searching = false
+		return a, nil
+	case isBack(msg):
+		a.vim.searching = false
+		a.vim.query.Reset()
+		return a, a.vimMoveTo(a.vim.origin)
+	case !editChanged(&a.vim.query, msg):
+		return a, nil
+	}
+	if idx := vimSearchMatch(a.vimList(), a.vim.query.Value, a.vim.origin); idx >= 0 {
+		return a, a.vimMoveTo(idx)
+	}
+	return a, nil
+}
+
+// vimSearchMatch finds the first item containing query, searching forward
+// from origin and wrapping.
+func vimSearchMatch(list *components.List, query string, origin int) int {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if list == nil || query == "" {
+		return -1
+	}
+	n := len(list.Items)
+	for i := 0; i < n; i++ {
+		idx := (origin + i) % n
+		if strings.Contains(strings.ToLower(components.SanitizeText(list.Items[idx])), query) {
+			return idx
+		}
+	}
+	return -1
+}
+
+// renderVimStatus renders the pending count, in-list search, or macro being
+// recorded under the banner.
+func (a App) renderVimStatus() string {
+	status := a.renderVimPending()
+	if a.macro.recording != "" {
+		recording := AccentStyle.Render("recording @" + a.macro.recording)
+		if status == "" {
+			return recording
+		}
+		return recording + "  " + status
+	}
+	return status
+}
+
+// renderVimPending renders the pending count, register, or in-list search.
+func (a App) renderVimPending() string {
+	switch {
+	case a.vim.searching:
+		status := MutedStyle.Render("/") + a.vim.query.View(AccentStyle, 0)
+		if vimSearchMatch(a.vimList(), a.vim.query.Value, a.vim.origin) < 0 && strings.TrimSpace(a.vim.query.Value) != "" {
+			status += MutedStyle.Render("  (no match)")
+		}
+		return status
+	case a.vim.count != "" || a.vim.pendingG || a.macro.pending != "":
+		text := a.vim.count + a.macro.pending
+		if a.vim.pendingG {
+			text += "g"
+		}
+		return MutedStyle.Render(text)
+	}
+	return ""
+}
+
+// vimHints lists the vim keys shown in help when they are enabled.
+func vimHints() []string {
+	return []string{
+		components.Hint("j/k", "Move"),
+		components.Hint("gg/G", "Top/Bottom"),
+		components.Hint("ctrl+d/u", "Half Page"),
+		components.Hint("5j", "Count"),
+		components.Hint("/", "Find in List"),
+		components.Hint(".", "Repeat Change"),
+		components.Hint("qa/q", "Record Macro"),
+		components.Hint("@a/@@", "Replay Macro"),
+	}
+}
@@ -0,0 +1,133 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newVimApp(t *testing.T, vim bool) App {
+	t.Helper()
+	app := NewApp(nil, &config.Config{APIKey: "nbl_test", Username: "alxx", VimKeys: vim})
+	app.width = 120
+	app.tab = tabEntities
+	app.tabNav = false
+	app.entities.view = entitiesViewList
+	names := []string{"alpha", "beta", "gamma", "delta", "epsilon", "zeta", "eta", "theta"}
+	items := make([]api.Entity, len(names))
+	for i, name := range names {
+		items[i] = api.Entity{ID: "ent-" + name, Name: name, Type: "project"}
+	}
+	app.entities.items = items
+	app.entities.list.SetItems(names)
+	return app
+}
+
+func vimPress(t *testing.T, app App, keys ...string) App {
+	t.Helper()
+	for _, key := range keys {
+		var msg tea.KeyMsg
+		switch key {
+		case "ctrl+d":
+			msg = tea.KeyMsg{Type: tea.KeyCtrlD}
+		case "ctrl+u":
+			msg = tea.KeyMsg{Type: tea.KeyCtrlU}
+		case "enter":
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		case "esc":
+			msg = tea.KeyMsg{Type: tea.KeyEsc}
+		default:
+			msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+		}
+		model, _ := app.Update(msg)
+		app = model.(App)
+	}
+	return app
+}
+
+func TestVimMotionsMoveFocusedList(t *testing.T) {
+	app := newVimApp(t, true)
+
+	app = vimPress(t, app, "j")
+	assert.Equal(t, 1, app.entities.list.Selected())
+	app = vimPress(t, app, "3", "j")
+	assert.Equal(t, 4, app.entities.list.Selected())
+	app = vimPress(t, app, "k")
+	assert.Equal(t, 3, app.entities.list.Selected())
+	app = vimPress(t, app, "G")
+	assert.Equal(t, 7, app.entities.list.Selected())
+	app = vimPress(t, app, "g", "g")
+	assert.Equal(t, 0, app.entities.list.Selected())
+	assert.False(t, app.entities.modeFocus)
+	app = vimPress(t, app, "2", "G")
+	assert.Equal(t, 1, app.entities.list.Selected())
+	app = vimPress(t, app, "ctrl+d")
+	assert.Equal(t, 7, app.entities.list.Selected())
+	app = vimPress(t, app, "ctrl+u")
+	assert.Equal(t, 0, app.entities.list.Selected())
+	assert.Equal(t, tabEntities, app.tab)
+	assert.Empty(t, app.entities.searchBuf.Value)
+}
+
+func TestVimCountPrefixShowsUnderBanner(t *testing.T) {
+	app := newVimApp(t, true)
+	app = vimPress(t, app, "1", "2")
+	assert.Equal(t, "12", app.vim.count)
+	assert.Contains(t, components.SanitizeText(app.View()), "12")
+	assert.Equal(t, tabEntities, app.tab)
+
+	app = vimPress(t, app, "j")
+	assert.Equal(t, 7, app.entities.list.Selected())
+	assert.Empty(t, app.vim.count)
+}
+
+func TestVimInListSearchJumpsAndRestores(t *testing.T) {
+	app := newVimApp(t, true)
+	app = vimPress(t, app, "/", "t", "h")
+	assert.True(t, app.vim.searching)
+	assert.False(t, app.paletteOpen)
+	assert.Equal(t, 7, app.entities.list.Selected())
+	assert.Contains(t, components.SanitizeText(app.View()), "/th")
+
+	app = vimPress(t, app, "esc")
+	assert.False(t, app.vim.searching)
+	assert.Equal(t, 0, app.entities.list.Selected())
+
+	app = vimPress(t, app, "/", "z", "enter")
+	assert.False(t, app.vim.searching)
+	assert.Equal(t, 5, app.entities.list.Selected())
+	assert.Empty(t, app.entities.searchBuf.Value)
+}
+
+func TestVimKeysOffKeepsDefaultBindings(t *testing.T) {
+	app := newVimApp(t, false)
+	app = vimPress(t, app, "j")
+	assert.Equal(t, 0, app.entities.list.Selected())
+	assert.Equal(t, "j", app.entities.searchBuf.Value)
+
+	app = newVimApp(t, false)
+	model, _ := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	assert.True(t, model.(App).paletteOpen)
+}
+
+func TestSettingsToggleVimKeys(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	cfg := &config.Config{APIKey: "nbl_test", Username: "alxx"}
+	model := NewProfileModel(nil, cfg)
+	model.width = 100
+	assert.Contains(t, components.SanitizeText(model.View()), "Vim Keys")
+
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'v'}})
+	require.NotNil(t, cmd)
+	assert.Equal(t, vimKeysSavedMsg{enabled: true}, cmd())
+	assert.True(t, cfg.VimKeys)
+
+	loaded, err := config.LoadFile()
+	require.NoError(t, err)
+	assert.True(t, loaded.VimKeys)
+}
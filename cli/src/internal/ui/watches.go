@@ -0,0 +1,285 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+// watchPollInterval is how often watched records are checked for changes.
+const watchPollInterval = time.Minute
+
+// watchHistoryLimit caps how many audit entries one poll reads per record.
+const watchHistoryLimit = 20
+
+// watchEvent is an unread audit entry on a watched record.
+type watchEvent struct {
+	watch config.Watch
+	entry api.AuditEntry
+}
+
+// watchToggledMsg asks the app to watch or unwatch an item.
+type watchToggledMsg struct {
+	watch config.Watch
+}
+
+// watchTickMsg re-polls the watched records.
+type watchTickMsg struct{}
+
+// watchEventsMsg carries the unread events a poll found.
+type watchEventsMsg struct {
+	events []watchEvent
+}
+
+// toggleWatchCmd emits a watch toggle for an item.
+func toggleWatchCmd(kind, id, name string) tea.Cmd {
+	if strings.TrimSpace(id) == "" {
+		return nil
+	}
+	watch := config.Watch{Kind: kind, ID: id, Name: name}
+	return func() tea.Msg { return watchToggledMsg{watch: watch} }
+}
+
+// watchTickCmd schedules the next poll.
+func watchTickCmd() tea.Cmd {
+	return tea.Tick(watchPollInterval, func(time.Time) tea.Msg { return watchTickMsg{} })
+}
+
+// pollWatchesCmd reads the history of every watched record and reports the
+// entries after each watch's SeenAt. The user's own changes are skipped, and
+// records that fail to load are left for the next poll.
+func (a App) pollWatchesCmd() tea.Cmd {
+	if a.client == nil || a.config == nil || len(a.config.Watches) == 0 {
+		return nil
+	}
+	client := a.client
+	watches := slices.Clone(a.config.Watches)
+	self := strings.TrimSpace(a.config.UserEntityID)
+	return func() tea.Msg {
+		var events []watchEvent
+		for _, watch := range watches {
+			var entries []api.AuditEntry
+			var err error
+			switch watch.Kind {
+			case config.PinEntity:
+				entries, err = client.GetEntityHistory(watch.ID, watchHistoryLimit, 0)
+			case config.PinContext:
+				entries, err = client.GetContextHistory(watch.ID, watchHistoryLimit, 0)
+			}
+			if err != nil {
+				continue
+			}
+			for _, entry := range entries {
+				if !entry.ChangedAt.After(watch.SeenAt) {
+					continue
+				}
+				if self != "" && entry.ChangedByID != nil && *entry.ChangedByID == self {
+					continue
+				}
+				events = append(events, watchEvent{watch: watch, entry: entry})
+			}
+		}
+		return watchEventsMsg{events: events}
+	}
+}
+
+// applyWatchEvents adds the events not seen before to the unread list and
+// toasts them.
+func (a *App) applyWatchEvents(events []watchEvent) tea.Cmd {
+	known := make(map[string]bool, len(a.watchEvents))
+	for _, event := range a.watchEvents {
+		known[event.entry.ID] = true
+	}
+	var fresh []watchEvent
+	for _, event := range events {
+		if known[event.entry.ID] || !a.config.IsWatched(event.watch.Kind, event.watch.ID) {
+			continue
+		}
+		known[event.entry.ID] = true
+		fresh = append(fresh, event)
+	}
+	if len(fresh) == 0 {
+		return nil
+	}
+	a.watchEvents = append(a.watchEvents, fresh...)
+	slices.SortStableFunc(a.watchEvents, func(x, y watchEvent) int {
+		return y.entry.ChangedAt.Compare(x.entry.ChangedAt)
+	})
+	text := fmt.Sprintf("%d changes to watched items. W to view.", len(fresh))
+	if len(fresh) == 1 {
+		text = formatWatchEvent(fresh[0]) + ". W to view."
+	}
+	return a.setToast("info", text)
+}
+
+// toggleWatch watches or unwatches an item and saves the config.
+func (a *App) toggleWatch(watch config.Watch) tea.Cmd {
+	if a.config == nil {
+		return a.setToast("error", "Watches need a saved config. Log in first.")
+	}
+	watched := a.config.ToggleWatch(watch, time.Now())
+	name := components.SanitizeOneLine(watch.Name)
+	if name == "" {
+		name = shortID(watch.ID)
+	}
+	text := fmt.Sprintf("Watching %s.", name)
+	if !watched {
+		text = fmt.Sprintf("Stopped watching %s.", name)
+		a.watchEvents = slices.DeleteFunc(a.watchEvents, func(event watchEvent) bool {
+			return event.watch.Kind == watch.Kind && event.watch.ID == strings.TrimSpace(watch.ID)
+		})
+		a.watchIndex = min(a.watchIndex, max(len(a.watchEvents)-1, 0))
+	}
+	return tea.Batch(a.saveWatches(), a.setToast("success", text))
+}
+
+// saveWatches persists the watch list.
+func (a App) saveWatches() tea.Cmd {
+	cfg := a.config
+	return func() tea.Msg {
+		if err := cfg.Save(); err != nil {
+			return errMsg{fmt.Errorf("save watches: %w", err)}
+		}
+		return nil
+	}
+}
+
+// markWatchesRead marks the events that match as read, advancing each
+// watch's SeenAt to its newest event, and saves the config.
+func (a *App) markWatchesRead(match func(watchEvent) bool) tea.Cmd {
+	changed := false
+	a.watchEvents = slices.DeleteFunc(a.watchEvents, func(event watchEvent) bool {
+		if !match(event) {
+			return false
+		}
+		if a.config.MarkWatchSeen(event.watch.Kind, event.watch.ID, event.entry.ChangedAt) {
+			changed = true
+		}
+		return true
+	})
+	a.watchIndex = min(a.watchIndex, max(len(a.watchEvents)-1, 0))
+	if !changed {
+		return nil
+	}
+	return a.saveWatches()
+}
+
+// openWatches shows the unread watch list.
+func (a *App) openWatches() {
+	a.tabNav = false
+	a.watchOpen = true
+	a.watchIndex = 0
+}
+
+// handleWatchKeys handles keys while the watch list is open. Enter opens the
+// selected record and marks its events read; x marks everything read.
+func (a App) handleWatchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case isBack(msg), isKey(msg, "W"):
+		a.watchOpen = false
+	case isUp(msg):
+		if a.watchIndex > 0 {
+			a.watchIndex--
+		}
+	case isDown(msg):
+		if a.watchIndex < len(a.watchEvents)-1 {
+			a.watchIndex++
+		}
+	case isKey(msg, "x"):
+		return a, a.markWatchesRead(func(watchEvent) bool { return true })
+	case isEnter(msg):
+		if a.watchIndex >= len(a.watchEvents) {
+			return a, nil
+		}
+		selected := a.watchEvents[a.watchIndex].watch
+		a.watchOpen = false
+		read := a.markWatchesRead(func(event watchEvent) bool {
+			return event.watch.Kind == selected.Kind && event.watch.ID == selected.ID
+		})
+		return a, tea.Batch(read, openPin(a.client, selected.Kind, selected.ID))
+	}
+	return a, nil
+}
+
+// formatWatchEvent describes an event as "Atlas updated by deploy-bot".
+func formatWatchEvent(event watchEvent) string {
+	name := strings.TrimSpace(event.watch.Name)
+	if name == "" {
+		name = shortID(event.watch.ID)
+	}
+	return fmt.Sprintf("%s %s by %s", name, watchActionVerb(event.entry.Action), formatAuditActor(event.entry))
+}
+
+// watchActionVerb turns an audit action into a past-tense verb.
+func watchActionVerb(action string) string {
+	switch action = strings.ToLower(strings.TrimSpace(action)); action {
+	case "insert":
+		return "created"
+	case "update":
+		return "updated"
+	case "delete":
+		return "deleted"
+	case "":
+		return "changed"
+	}
+	return action
+}
+
+// renderWatches renders the unread events on watched records, newest first,
+// followed by everything being watched.
+func (a App) renderWatches() string {
+	var watches []config.Watch
+	if a.config != nil {
+		watches = a.config.Watches
+	}
+	if len(watches) == 0 {
+		return components.Indent(components.EmptyStateBox(
+			"Watching",
+			"Not watching anything yet.",
+			[]string{"Press w on an entity or knowledge item to watch it."},
+			a.width,
+		), 1)
+	}
+
+	contentWidth := components.BoxContentWidth(a.width)
+	if contentWidth <= 0 {
+		contentWidth = 72
+	}
+	lines := []string{AccentStyle.Render(fmt.Sprintf("%d unread", len(a.watchEvents)))}
+	if len(a.watchEvents) == 0 {
+		lines = append(lines, MutedStyle.Render("No changes since you last looked."))
+	}
+	for i, event := range a.watchEvents {
+		marker := "  "
+		if i == a.watchIndex {
+			marker = AccentStyle.Render("> ")
+		}
+		prefix := formatLocalTimeCompact(event.entry.ChangedAt) + "  "
+		text := components.SanitizeOneLine(formatWatchEvent(event))
+		if fields := event.entry.ChangedFields; len(fields) > 0 {
+			text += " · " + strings.Join(fields, ", ")
+		}
+		lines = append(lines, marker+MutedStyle.Render(prefix)+
+			components.ClampTextWidthEllipsis(text, contentWidth-len(prefix)-2))
+	}
+
+	names := make([]string, len(watches))
+	for i, watch := range watches {
+		names[i] = components.SanitizeOneLine(watch.Name)
+		if names[i] == "" {
+			names[i] = shortID(watch.ID)
+		}
+	}
+	lines = append(lines, "",
+		MetaKeyStyle.Render(fmt.Sprintf("Watching (%d)", len(watches))),
+		components.ClampTextWidthEllipsis(MutedStyle.Render(strings.Join(names, " · ")), contentWidth),
+	)
+	return components.Indent(components.TitledBox("Watching", strings.Join(lines, "\n"), a.width), 1)
+}
@@ -0,0 +1,102 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/ui/components"
+)
+
+func TestWatchKeyTogglesWatchAndPersists(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	app := NewApp(nil, &config.Config{})
+	app.entities, _ = app.entities.Update(entitiesLoadedMsg{items: []api.Entity{
+		{ID: "ent-1", Name: "Atlas", Type: "project"},
+	}})
+
+	_, cmd := app.entities.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")})
+	require.NotNil(t, cmd)
+	model, save := app.Update(cmd())
+	app = model.(App)
+	require.NotNil(t, save)
+	require.Len(t, app.config.Watches, 1)
+	assert.Equal(t, "ent-1", app.config.Watches[0].ID)
+	assert.False(t, app.config.Watches[0].SeenAt.IsZero())
+
+	detail := NewContextModel(nil)
+	detail.detail = &api.Context{ID: "ctx-1", Name: "Runbook"}
+	detail.view = contextViewDetail
+	_, cmd = detail.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")})
+	require.NotNil(t, cmd)
+	msg, ok := cmd().(watchToggledMsg)
+	require.True(t, ok)
+	assert.Equal(t, config.PinContext, msg.watch.Kind)
+}
+
+func TestWatchPollSurfacesOtherActorsChanges(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	seen := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	_, client := contextTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var data []map[string]any
+		switch r.URL.Path {
+		case "/api/entities/ent-1/history":
+			data = []map[string]any{
+				{"id": "audit-3", "table_name": "entities", "record_id": "ent-1", "action": "update",
+					"changed_by_type": "agent", "changed_by_id": "agent-1", "actor_name": "deploy-bot",
+					"changed_fields": []string{"status"}, "changed_at": seen.Add(2 * time.Hour)},
+				{"id": "audit-2", "table_name": "entities", "record_id": "ent-1", "action": "update",
+					"changed_by_type": "entity", "changed_by_id": "me", "changed_at": seen.Add(time.Hour)},
+				{"id": "audit-1", "table_name": "entities", "record_id": "ent-1", "action": "insert",
+					"changed_by_type": "agent", "changed_by_id": "agent-1", "changed_at": seen.Add(-time.Hour)},
+			}
+		case "/api/context/ctx-1/history":
+			data = []map[string]any{}
+		default:
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": data}))
+	})
+	app := NewApp(client, &config.Config{UserEntityID: "me", Watches: []config.Watch{
+		{Kind: config.PinEntity, ID: "ent-1", Name: "Atlas", SeenAt: seen},
+		{Kind: config.PinContext, ID: "ctx-1", Name: "Runbook", SeenAt: seen},
+	}})
+	app.width = 120
+
+	poll := app.pollWatchesCmd()
+	require.NotNil(t, poll)
+	msg := poll()
+	model, toast := app.Update(msg)
+	app = model.(App)
+	require.NotNil(t, toast)
+	require.Len(t, app.watchEvents, 1)
+	assert.Equal(t, "audit-3", app.watchEvents[0].entry.ID)
+	require.NotNil(t, app.toast)
+	assert.Contains(t, app.toast.text, "Atlas updated by deploy-bot")
+
+	model, again := app.Update(msg)
+	app = model.(App)
+	assert.Nil(t, again, "a repeated poll does not toast twice")
+	assert.Len(t, app.watchEvents, 1)
+
+	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("W")})
+	app = model.(App)
+	require.True(t, app.watchOpen)
+	view := components.SanitizeText(app.renderWatches())
+	assert.Contains(t, view, "1 unread")
+	assert.Contains(t, view, "status")
+	assert.Contains(t, view, "Watching (2)")
+
+	model, save := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	app = model.(App)
+	require.NotNil(t, save)
+	assert.Empty(t, app.watchEvents)
+	assert.Equal(t, seen.Add(2*time.Hour), app.config.Watches[0].SeenAt.UTC())
+}
@@ -0,0 +1,302 @@
+// Package update finds, verifies, and installs new CLI releases.
+//
+// A release is a GitHub-style release with one binary per platform named
+// nebula_<os>_<arch> (".exe" on Windows), a checksums.txt listing the SHA-256
+// of every binary, and checksums.txt.sig, a base64 ed25519 signature of
+// checksums.txt made with the release key. The release workflow in
+// .github/workflows/release.yml publishes these assets.
+package update
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+	"github.com/gravitrone/nebula-core/cli/internal/version"
+)
+
+// EndpointEnv overrides the release endpoint, for mirrors and tests.
+const EndpointEnv = "NEBULA_UPDATE_URL"
+
+// DefaultEndpoint is the latest-release endpoint of the public repository.
+const DefaultEndpoint = "https://api.github.com/repos/gravitrone/nebula-core/releases/latest"
+
+const (
+	checksumsAsset = "checksums.txt"
+	signatureAsset = "checksums.txt.sig"
+	// maxBinarySize caps downloads so a bad release cannot fill the disk.
+	maxBinarySize = 200 << 20
+)
+
+// PublicKey is the base64 ed25519 release signing key. Release builds set it
+// with -ldflags "-X github.com/gravitrone/nebula-core/cli/internal/update.PublicKey=...".
+// Builds without a key refuse to update unless the caller allows unsigned
+// releases.
+var PublicKey = ""
+
+// ErrNoAsset means the release has no binary for this platform.
+var ErrNoAsset = errors.New("no release binary for this platform")
+
+// ErrUnsigned means this build has no release key to check the signature.
+var ErrUnsigned = errors.New("this build has no release signing key")
+
+var httpClient = &http.Client{Timeout: 60 * time.Second}
+
+// Release is the latest published release.
+type Release struct {
+	Version string  `json:"tag_name"`
+	URL     string  `json:"html_url"`
+	Assets  []Asset `json:"assets"`
+}
+
+// Asset is one downloadable release file.
+type Asset struct {
+	Name string `json:"name"`
+	URL  string `json:"browser_download_url"`
+}
+
+// Endpoint returns the release endpoint, honoring EndpointEnv.
+func Endpoint() string {
+	if url := strings.TrimSpace(os.Getenv(EndpointEnv)); url != "" {
+		return url
+	}
+	return DefaultEndpoint
+}
+
+// Latest fetches the latest release from endpoint.
+func Latest(ctx context.Context, endpoint string) (*Release, error) {
+	data, err := fetch(ctx, endpoint, 1<<20)
+	if err != nil {
+		return nil, fmt.Errorf("check latest release: %w", err)
+	}
+	var release Release
+	if err := json.Unmarshal(data, &release); err != nil {
+		return nil, fmt.Errorf("decode release: %w", err)
+	}
+	if strings.TrimSpace(release.Version) == "" {
+		return nil, fmt.Errorf("decode release: missing tag_name")
+	}
+	return &release, nil
+}
+
+// BinaryName returns the release asset name for a platform.
+func BinaryName(goos, goarch string) string {
+	name := fmt.Sprintf("nebula_%s_%s", goos, goarch)
+	if goos == "windows" {
+		name += ".exe"
+	}
+	return name
+}
+
+// asset returns the named asset.
+func (r *Release) asset(name string) (Asset, bool) {
+	for _, asset := range r.Assets {
+		if asset.Name == name {
+			return asset, true
+		}
+	}
+	return Asset{}, false
+}
+
+// Download fetches the binary for this platform and verifies it against the
+// release checksums, and the checksums against PublicKey. Without a key it
+// returns ErrUnsigned unless allowUnsigned is set. It reports whether the
+// signature was checked.
+func Download(ctx context.Context, release *Release, allowUnsigned bool) ([]byte, bool, error) {
+	if strings.TrimSpace(PublicKey) == "" && !allowUnsigned {
+		return nil, false, ErrUnsigned
+	}
+	name := BinaryName(runtime.GOOS, runtime.GOARCH)
+	binaryAsset, ok := release.asset(name)
+	if !ok {
+		return nil, false, fmt.Errorf("%w: %s", ErrNoAsset, name)
+	}
+	sumsAsset, ok := release.asset(checksumsAsset)
+	if !ok {
+		return nil, false, fmt.Errorf("release %s has no %s", release.Version, checksumsAsset)
+	}
+	sums, err := fetch(ctx, sumsAsset.URL, 1<<20)
+	if err != nil {
+		return nil, false, fmt.Errorf("download %s: %w", checksumsAsset, err)
+	}
+	signed := false
+	if key := strings.TrimSpace(PublicKey); key != "" {
+		sigAsset, ok := release.asset(signatureAsset)
+		if !ok {
+			return nil, false, fmt.Errorf("release %s is not signed", release.Version)
+		}
+		sig, err := fetch(ctx, sigAsset.URL, 4<<10)
+		if err != nil {
+			return nil, false, fmt.Errorf("download %s: %w", signatureAsset, err)
+		}
+		if err := VerifySignature(sums, sig, key); err != nil {
+			return nil, false, err
+		}
+		signed = true
+	}
+	binary, err := fetch(ctx, binaryAsset.URL, maxBinarySize)
+	if err != nil {
+		return nil, false, fmt.Errorf("download %s: %w", name, err)
+	}
+	if err := VerifyChecksum(binary, sums, name); err != nil {
+		return nil, false, err
+	}
+	return binary, signed, nil
+}
+
+// VerifyChecksum checks binary against its line in a checksums.txt file.
+func VerifyChecksum(binary, sums []byte, name string) error {
+	scanner := bufio.NewScanner(bytes.NewReader(sums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 || strings.TrimPrefix(fields[1], "*") != name {
+			continue
+		}
+		sum := sha256.Sum256(binary)
+		if !strings.EqualFold(fields[0], hex.EncodeToString(sum[:])) {
+			return fmt.Errorf("checksum mismatch for %s", name)
+		}
+		return nil
+	}
+	return fmt.Errorf("no checksum listed for %s", name)
+}
+
+// VerifySignature checks a base64 ed25519 signature of data against a
+// base64 public key.
+func VerifySignature(data, sig []byte, publicKey string) error {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(publicKey))
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return fmt.Errorf("invalid release signing key")
+	}
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(sig)))
+	if err != nil {
+		return fmt.Errorf("decode release signature: %w", err)
+	}
+	if !ed25519.Verify(ed25519.PublicKey(key), data, raw) {
+		return fmt.Errorf("release signature does not match")
+	}
+	return nil
+}
+
+// Install replaces the executable at path with binary. The new file is
+// written beside the old one and renamed over it, so an interrupted update
+// leaves the old binary in place.
+func Install(path string, binary []byte) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("stat executable: %w", err)
+	}
+	dir := filepath.Dir(path)
+	tmp, err := os.CreateTemp(dir, ".nebula-update-*")
+	if err != nil {
+		return fmt.Errorf("stage update: %w", err)
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath)
+	if _, err := tmp.Write(binary); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("stage update: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("stage update: %w", err)
+	}
+	if err := os.Chmod(tmpPath, info.Mode().Perm()|0o111); err != nil {
+		return fmt.Errorf("stage update: %w", err)
+	}
+	// Windows cannot replace a running executable, but it can rename it.
+	old := path + ".old"
+	_ = os.Remove(old)
+	if err := os.Rename(path, old); err != nil {
+		return fmt.Errorf("replace executable: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		_ = os.Rename(old, path)
+		return fmt.Errorf("replace executable: %w", err)
+	}
+	_ = os.Remove(old)
+	return nil
+}
+
+// Executable returns the real path of the running binary.
+func Executable() (string, error) {
+	path, err := os.Executable()
+	if err != nil {
+		return "", fmt.Errorf("locate executable: %w", err)
+	}
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		path = resolved
+	}
+	return path, nil
+}
+
+// fetch GETs url and returns at most limit bytes of a 200 response.
+func fetch(ctx context.Context, url string, limit int64) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json, application/octet-stream")
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > limit {
+		return nil, fmt.Errorf("response larger than %d bytes", limit)
+	}
+	return data, nil
+}
+
+// CheckInterval is how long a cached release check stays fresh.
+const CheckInterval = 24 * time.Hour
+
+// Available returns the latest release version when it is newer than
+// current, using the cached check while it is fresh. It returns "" when up
+// to date.
+func Available(ctx context.Context, current string, now time.Time) (string, error) {
+	check, _ := config.LoadUpdateCheck()
+	if check.Latest == "" || now.Sub(check.CheckedAt) >= CheckInterval {
+		release, err := Latest(ctx, Endpoint())
+		if err != nil {
+			return "", err
+		}
+		check = &config.UpdateCheck{CheckedAt: now, Latest: release.Version}
+		if err := check.Save(); err != nil {
+			return "", err
+		}
+	}
+	if version.Newer(check.Latest, current) {
+		return check.Latest, nil
+	}
+	return "", nil
+}
+
+// DisableCheckEnv turns off the background release check when set to 1.
+const DisableCheckEnv = "NEBULA_NO_UPDATE_CHECK"
+
+// CheckDisabled reports whether the background release check is turned off.
+func CheckDisabled() bool {
+	return strings.TrimSpace(os.Getenv(DisableCheckEnv)) == "1"
+}
@@ -0,0 +1,137 @@
+package update
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// releaseServer serves a signed release with binary for this platform.
+func releaseServer(t *testing.T, binary []byte, priv ed25519.PrivateKey, checks *int32) *httptest.Server {
+	t.Helper()
+	name := BinaryName(runtime.GOOS, runtime.GOARCH)
+	sum := sha256.Sum256(binary)
+	sums := []byte(fmt.Sprintf("%s  other_binary\n%s  %s\n", hex.EncodeToString(make([]byte, 32)), hex.EncodeToString(sum[:]), name))
+	sig := base64.StdEncoding.EncodeToString(ed25519.Sign(priv, sums))
+
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/latest":
+			if checks != nil {
+				atomic.AddInt32(checks, 1)
+			}
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+				"tag_name": "v0.9.0",
+				"html_url": srv.URL + "/release",
+				"assets": []map[string]any{
+					{"name": name, "browser_download_url": srv.URL + "/bin"},
+					{"name": checksumsAsset, "browser_download_url": srv.URL + "/sums"},
+					{"name": signatureAsset, "browser_download_url": srv.URL + "/sig"},
+				},
+			}))
+		case "/bin":
+			_, _ = w.Write(binary)
+		case "/sums":
+			_, _ = w.Write(sums)
+		case "/sig":
+			_, _ = w.Write([]byte(sig))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestDownloadVerifiesSignatureAndChecksum(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	srv := releaseServer(t, []byte("new-binary"), priv, nil)
+
+	release, err := Latest(context.Background(), srv.URL+"/latest")
+	require.NoError(t, err)
+	assert.Equal(t, "v0.9.0", release.Version)
+
+	PublicKey = base64.StdEncoding.EncodeToString(pub)
+	t.Cleanup(func() { PublicKey = "" })
+	binary, signed, err := Download(context.Background(), release, false)
+	require.NoError(t, err)
+	assert.True(t, signed)
+	assert.Equal(t, []byte("new-binary"), binary)
+
+	otherPub, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	PublicKey = base64.StdEncoding.EncodeToString(otherPub)
+	_, _, err = Download(context.Background(), release, true)
+	assert.ErrorContains(t, err, "signature does not match")
+
+	PublicKey = ""
+	_, _, err = Download(context.Background(), release, false)
+	assert.ErrorIs(t, err, ErrUnsigned)
+	_, signed, err = Download(context.Background(), release, true)
+	require.NoError(t, err)
+	assert.False(t, signed)
+}
+
+func TestVerifyChecksumRejectsTamperedBinary(t *testing.T) {
+	sum := sha256.Sum256([]byte("good"))
+	sums := []byte(hex.EncodeToString(sum[:]) + " *nebula_linux_amd64\n")
+	assert.NoError(t, VerifyChecksum([]byte("good"), sums, "nebula_linux_amd64"))
+	assert.ErrorContains(t, VerifyChecksum([]byte("evil"), sums, "nebula_linux_amd64"), "checksum mismatch")
+	assert.ErrorContains(t, VerifyChecksum([]byte("good"), sums, "nebula_darwin_arm64"), "no checksum listed")
+}
+
+func TestInstallSwapsExecutableInPlace(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nebula")
+	require.NoError(t, os.WriteFile(path, []byte("old"), 0o755))
+
+	require.NoError(t, Install(path, []byte("new")))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "new", string(data))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o755), info.Mode().Perm())
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestAvailableCachesDailyCheck(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	_, priv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	var checks int32
+	srv := releaseServer(t, []byte("bin"), priv, &checks)
+	t.Setenv(EndpointEnv, srv.URL+"/latest")
+
+	now := time.Now()
+	latest, err := Available(context.Background(), "0.1.0", now)
+	require.NoError(t, err)
+	assert.Equal(t, "v0.9.0", latest)
+
+	latest, err = Available(context.Background(), "0.9.0", now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, latest)
+	assert.EqualValues(t, 1, atomic.LoadInt32(&checks))
+
+	_, err = Available(context.Background(), "0.1.0", now.Add(CheckInterval))
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, atomic.LoadInt32(&checks))
+}
@@ -0,0 +1,84 @@
+// Package version reports the CLI version and checks it against the server.
+package version
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version is the CLI version. Release builds override it with
+// -ldflags "-X github.com/gravitrone/nebula-core/cli/internal/version.Version=x.y.z".
+var Version = "0.1.0"
+
+// Compatibility describes how a server version relates to this CLI.
+type Compatibility struct {
+	OK      bool
+	Warning string
+}
+
+// Check compares a server version with the CLI version. Major versions must
+// match, and before 1.0 so must minor versions. A server older than the CLI
+// within the same line still works but may lack newer endpoints. An empty or
+// unparseable server version is treated as compatible with a warning.
+func Check(server string) Compatibility {
+	return check(Version, server)
+}
+
+// check compares two versions; split out so tests can pin the CLI version.
+func check(cli, server string) Compatibility {
+	server = strings.TrimSpace(server)
+	if server == "" {
+		return Compatibility{OK: true, Warning: "server does not report a version"}
+	}
+	cv, cok := parse(cli)
+	sv, sok := parse(server)
+	if !cok || !sok {
+		return Compatibility{OK: true, Warning: fmt.Sprintf("cannot compare cli %s with server %s", cli, server)}
+	}
+	if cv[0] != sv[0] || (cv[0] == 0 && cv[1] != sv[1]) {
+		return Compatibility{Warning: fmt.Sprintf("cli %s is not compatible with server %s; upgrade the older one", cli, server)}
+	}
+	if sv[1] < cv[1] {
+		return Compatibility{OK: true, Warning: fmt.Sprintf("server %s is older than cli %s; some features may be unavailable", server, cli)}
+	}
+	return Compatibility{OK: true}
+}
+
+// Newer reports whether candidate is a later version than current. Versions
+// that do not parse are never newer.
+func Newer(candidate, current string) bool {
+	cv, cok := parse(candidate)
+	rv, rok := parse(current)
+	if !cok || !rok {
+		return false
+	}
+	for i := range cv {
+		if cv[i] != rv[i] {
+			return cv[i] > rv[i]
+		}
+	}
+	return false
+}
+
+// parse reads major, minor, and patch from "v1.2.3" style versions, ignoring
+// pre-release and build suffixes.
+func parse(raw string) ([3]int, bool) {
+	var out [3]int
+	raw = strings.TrimPrefix(strings.TrimSpace(raw), "v")
+	if i := strings.IndexAny(raw, "-+ "); i >= 0 {
+		raw = raw[:i]
+	}
+	parts := strings.Split(raw, ".")
+	if len(parts) == 0 || len(parts) > 3 {
+		return out, false
+	}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return out, false
+		}
+		out[i] = n
+	}
+	return out, true
+}
@@ -0,0 +1,32 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckCompatibility(t *testing.T) {
+	assert.Equal(t, Compatibility{OK: true}, check("0.1.0", "0.1.4"))
+	assert.Equal(t, Compatibility{OK: true}, check("1.2.0", "v1.3.0-rc1"))
+
+	older := check("1.3.0", "1.1.9")
+	assert.True(t, older.OK)
+	assert.Contains(t, older.Warning, "server 1.1.9 is older")
+
+	assert.False(t, check("0.2.0", "0.1.0").OK)
+	assert.False(t, check("2.0.0", "1.9.0").OK)
+
+	unknown := check("0.1.0", "")
+	assert.True(t, unknown.OK)
+	assert.Contains(t, unknown.Warning, "does not report")
+	assert.True(t, check("0.1.0", "nightly").OK)
+}
+
+func TestNewer(t *testing.T) {
+	assert.True(t, Newer("v0.2.0", "0.1.9"))
+	assert.True(t, Newer("1.0.1", "1.0.0"))
+	assert.False(t, Newer("0.1.0", "0.1.0"))
+	assert.False(t, Newer("0.0.9", "0.1.0"))
+	assert.False(t, Newer("latest", "0.1.0"))
+}
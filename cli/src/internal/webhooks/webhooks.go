@@ -0,0 +1,264 @@
+package webhooks
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+// MaxAttempts is how many times a delivery is tried before it is logged as failed.
+const MaxAttempts = 3
+
+// RequestTimeout caps a single delivery attempt.
+const RequestTimeout = 10 * time.Second
+
+// logLimit is how many delivery records the log keeps.
+const logLimit = 200
+
+// Backoff is the wait before each retry. Tests shorten it.
+var Backoff = func(attempt int) time.Duration {
+	return time.Duration(attempt) * time.Second
+}
+
+var httpClient = &http.Client{Timeout: RequestTimeout}
+
+var logMu sync.Mutex
+
+// Event is one approval decision, and the data a hook template renders.
+type Event struct {
+	Action      string    `json:"action"`
+	ApprovalID  string    `json:"approval_id"`
+	RequestType string    `json:"request_type,omitempty"`
+	RequestedBy string    `json:"requested_by,omitempty"`
+	Status      string    `json:"status,omitempty"`
+	Notes       string    `json:"notes,omitempty"`
+	Reviewer    string    `json:"reviewer,omitempty"`
+	At          time.Time `json:"at"`
+}
+
+// Delivery is one logged webhook attempt series.
+type Delivery struct {
+	At         time.Time `json:"at"`
+	Webhook    string    `json:"webhook"`
+	Action     string    `json:"action"`
+	ApprovalID string    `json:"approval_id"`
+	Attempts   int       `json:"attempts"`
+	Status     int       `json:"status,omitempty"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// OK reports whether the delivery reached the endpoint.
+func (d Delivery) OK() bool {
+	return d.Error == ""
+}
+
+// NewEvent builds the event for a decision on item. Missing fields fall back
+// to the id and notes the caller acted with.
+func NewEvent(action, id, notes string, item *api.Approval) Event {
+	event := Event{Action: action, ApprovalID: id, Notes: notes, At: time.Now().UTC()}
+	if item != nil {
+		if item.ID != "" {
+			event.ApprovalID = item.ID
+		}
+		event.RequestType = item.RequestType
+		event.RequestedBy = item.RequestedByName
+		if event.RequestedBy == "" {
+			event.RequestedBy = item.AgentName
+		}
+		event.Status = item.Status
+		if event.Notes == "" && item.Notes != nil {
+			event.Notes = *item.Notes
+		}
+	}
+	if cfg, err := config.LoadFile(); err == nil {
+		event.Reviewer = cfg.Username
+	}
+	return event
+}
+
+// LogPath returns the delivery log path next to the config file.
+func LogPath() string {
+	return filepath.Join(filepath.Dir(config.Path()), "webhooks.log")
+}
+
+// Configured returns the hooks in the config file that fire on action.
+func Configured(action string) []config.Webhook {
+	cfg, err := config.LoadFile()
+	if err != nil {
+		return nil
+	}
+	return cfg.ActiveWebhooks(action)
+}
+
+// Render builds the request body for hook. Without a template the event is
+// sent as JSON.
+func Render(hook config.Webhook, event Event) ([]byte, error) {
+	if strings.TrimSpace(hook.Template) == "" {
+		return json.Marshal(event)
+	}
+	tmpl, err := template.New(hook.Label()).Option("missingkey=zero").Parse(hook.Template)
+	if err != nil {
+		return nil, fmt.Errorf("webhook %s: template: %w", hook.Label(), err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, event); err != nil {
+		return nil, fmt.Errorf("webhook %s: template: %w", hook.Label(), err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Send delivers event to hook, retrying failed attempts, and returns the
+// record it would log.
+func Send(hook config.Webhook, event Event) Delivery {
+	delivery := Delivery{
+		At:         time.Now().UTC(),
+		Webhook:    hook.Label(),
+		Action:     event.Action,
+		ApprovalID: event.ApprovalID,
+	}
+	body, err := Render(hook, event)
+	if err != nil {
+		delivery.Error = err.Error()
+		return delivery
+	}
+	for attempt := 1; attempt <= MaxAttempts; attempt++ {
+		delivery.Attempts = attempt
+		status, err := post(hook, body)
+		delivery.Status = status
+		if err == nil {
+			delivery.Error = ""
+			return delivery
+		}
+		delivery.Error = err.Error()
+		if status >= 400 && status < 500 && status != http.StatusTooManyRequests {
+			return delivery
+		}
+		if attempt < MaxAttempts {
+			time.Sleep(Backoff(attempt))
+		}
+	}
+	return delivery
+}
+
+// post makes one delivery attempt.
+func post(hook config.Webhook, body []byte) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, strings.TrimSpace(hook.URL), bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("build request: %w", err)
+	}
+	contentType := "application/json"
+	if hook.Template != "" && !json.Valid(body) {
+		contentType = "text/plain; charset=utf-8"
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("User-Agent", "nebula-cli")
+	for key, value := range hook.Headers {
+		req.Header.Set(key, os.ExpandEnv(value))
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("http %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// Deliver sends event to every hook concurrently, logs each result, and
+// returns the deliveries in hook order once all are done.
+func Deliver(hooks []config.Webhook, event Event) []Delivery {
+	out := make([]Delivery, len(hooks))
+	var wg sync.WaitGroup
+	for i, hook := range hooks {
+		wg.Add(1)
+		go func(i int, hook config.Webhook) {
+			defer wg.Done()
+			out[i] = Send(hook, event)
+			_ = AppendLog(out[i])
+		}(i, hook)
+	}
+	wg.Wait()
+	return out
+}
+
+// Notify delivers event to the hooks configured for its action in the
+// background, for callers that do not wait on the result.
+func Notify(event Event) {
+	hooks := Configured(event.Action)
+	if len(hooks) == 0 {
+		return
+	}
+	go Deliver(hooks, event)
+}
+
+// AppendLog records a delivery, keeping only the newest entries.
+func AppendLog(delivery Delivery) error {
+	logMu.Lock()
+	defer logMu.Unlock()
+
+	entries, err := ReadLog(0)
+	if err != nil {
+		entries = nil
+	}
+	entries = append(entries, delivery)
+	if len(entries) > logLimit {
+		entries = entries[len(entries)-logLimit:]
+	}
+	var buf bytes.Buffer
+	for _, entry := range entries {
+		line, err := json.Marshal(entry)
+		if err != nil {
+			return fmt.Errorf("marshal webhook delivery: %w", err)
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	path := LogPath()
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("create config dir: %w", err)
+	}
+	return os.WriteFile(path, buf.Bytes(), 0600)
+}
+
+// ReadLog returns logged deliveries, newest last. A positive limit keeps only
+// the newest entries. A missing log yields none.
+func ReadLog(limit int) ([]Delivery, error) {
+	data, err := os.ReadFile(LogPath())
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read webhook log: %w", err)
+	}
+	var out []Delivery
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var entry Delivery
+		if err := json.Unmarshal(line, &entry); err != nil {
+			continue
+		}
+		out = append(out, entry)
+	}
+	if limit > 0 && len(out) > limit {
+		out = out[len(out)-limit:]
+	}
+	return out, nil
+}
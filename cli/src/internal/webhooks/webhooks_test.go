@@ -0,0 +1,144 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitrone/nebula-core/cli/internal/api"
+	"github.com/gravitrone/nebula-core/cli/internal/config"
+)
+
+func noBackoff(t *testing.T) {
+	t.Helper()
+	prev := Backoff
+	Backoff = func(int) time.Duration { return 0 }
+	t.Cleanup(func() { Backoff = prev })
+}
+
+// TestRenderTemplateAndDefaultJSON handles test render template and default json.
+func TestRenderTemplateAndDefaultJSON(t *testing.T) {
+	event := Event{Action: "reject", ApprovalID: "ap-1", RequestType: "create_entity", Notes: "dup"}
+
+	body, err := Render(config.Webhook{URL: "https://x"}, event)
+	require.NoError(t, err)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(body, &decoded))
+	assert.Equal(t, "ap-1", decoded["approval_id"])
+
+	hook := config.Webhook{URL: "https://x", Template: `{"text":"{{.Action}} {{.RequestType}} {{.ApprovalID}}: {{.Notes}}"}`}
+	body, err = Render(hook, event)
+	require.NoError(t, err)
+	assert.Equal(t, `{"text":"reject create_entity ap-1: dup"}`, string(body))
+}
+
+// TestNewEventFillsFromApproval handles test new event fills from approval.
+func TestNewEventFillsFromApproval(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	notes := "looks good"
+	event := NewEvent("approve", "ap-1", "", &api.Approval{
+		ID:          "ap-1",
+		RequestType: "update_entity",
+		AgentName:   "scout",
+		Status:      "approved",
+		Notes:       &notes,
+	})
+	assert.Equal(t, "update_entity", event.RequestType)
+	assert.Equal(t, "scout", event.RequestedBy)
+	assert.Equal(t, "looks good", event.Notes)
+}
+
+// TestDeliverRetriesAndLogs handles test deliver retries and logs.
+func TestDeliverRetriesAndLogs(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	noBackoff(t)
+
+	calls := 0
+	var lastBody string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		raw, _ := io.ReadAll(r.Body)
+		lastBody = string(raw)
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		if calls < 2 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+	t.Setenv("HOOK_TOKEN", "secret")
+
+	hooks := []config.Webhook{{
+		Name:     "ticketing",
+		URL:      srv.URL,
+		Template: "{{.Action}} {{.ApprovalID}}",
+		Headers:  map[string]string{"Authorization": "Bearer $HOOK_TOKEN"},
+	}}
+	deliveries := Deliver(hooks, Event{Action: "approve", ApprovalID: "ap-9"})
+	require.Len(t, deliveries, 1)
+	assert.True(t, deliveries[0].OK())
+	assert.Equal(t, 2, deliveries[0].Attempts)
+	assert.Equal(t, http.StatusNoContent, deliveries[0].Status)
+	assert.Equal(t, "approve ap-9", lastBody)
+
+	logged, err := ReadLog(0)
+	require.NoError(t, err)
+	require.Len(t, logged, 1)
+	assert.Equal(t, "ticketing", logged[0].Webhook)
+	info, err := os.Stat(LogPath())
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+// TestSendStopsOnClientError handles test send stops on client error.
+func TestSendStopsOnClientError(t *testing.T) {
+	noBackoff(t)
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	delivery := Send(config.Webhook{URL: srv.URL}, Event{Action: "reject", ApprovalID: "ap-1"})
+	assert.False(t, delivery.OK())
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, "http 404", delivery.Error)
+
+	calls = 0
+	srv.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	delivery = Send(config.Webhook{URL: srv.URL}, Event{Action: "reject"})
+	assert.Equal(t, MaxAttempts, calls)
+	assert.Equal(t, MaxAttempts, delivery.Attempts)
+}
+
+// TestReadLogKeepsNewest handles test read log keeps newest.
+func TestReadLogKeepsNewest(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	missing, err := ReadLog(10)
+	require.NoError(t, err)
+	assert.Empty(t, missing)
+
+	for i := 0; i < logLimit+5; i++ {
+		require.NoError(t, AppendLog(Delivery{Webhook: "hook", Attempts: i}))
+	}
+	all, err := ReadLog(0)
+	require.NoError(t, err)
+	require.Len(t, all, logLimit)
+	assert.Equal(t, logLimit+4, all[len(all)-1].Attempts)
+
+	newest, err := ReadLog(3)
+	require.NoError(t, err)
+	assert.Len(t, newest, 3)
+}
@@ -0,0 +1,12 @@
+-- Relationship type directionality: an inverse label for reading an edge from
+-- its target, and optional allow-lists of source and target entity types.
+-- Empty allow-lists place no restriction.
+
+ALTER TABLE relationship_types
+ADD COLUMN IF NOT EXISTS inverse_name TEXT;
+
+ALTER TABLE relationship_types
+ADD COLUMN IF NOT EXISTS source_entity_types TEXT[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE relationship_types
+ADD COLUMN IF NOT EXISTS target_entity_types TEXT[] NOT NULL DEFAULT '{}';
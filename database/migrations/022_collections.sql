@@ -0,0 +1,29 @@
+-- Named entity collections: user-owned pinboards that group arbitrary
+-- entities. Membership is kept in collection_items so an entity can sit in
+-- many collections and deleting either side cleans up the link.
+
+CREATE TABLE IF NOT EXISTS collections (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    owner_entity_id UUID NOT NULL REFERENCES entities(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT collections_owner_name_key UNIQUE (owner_entity_id, name),
+    CONSTRAINT collections_name_not_blank CHECK (btrim(name) <> '')
+);
+
+CREATE TABLE IF NOT EXISTS collection_items (
+    collection_id UUID NOT NULL REFERENCES collections(id) ON DELETE CASCADE,
+    entity_id UUID NOT NULL REFERENCES entities(id) ON DELETE CASCADE,
+    added_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (collection_id, entity_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_collection_items_entity
+ON collection_items (entity_id);
+
+DROP TRIGGER IF EXISTS update_collections_updated_at ON collections;
+CREATE TRIGGER update_collections_updated_at
+BEFORE UPDATE ON collections
+FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,27 @@
+-- Comments: user and agent notes on entities and knowledge items, kept out
+-- of metadata. Each comment belongs to exactly one record and is removed
+-- with it.
+
+CREATE TABLE IF NOT EXISTS comments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    entity_id UUID REFERENCES entities(id) ON DELETE CASCADE,
+    context_id UUID REFERENCES context_items(id) ON DELETE CASCADE,
+    author_entity_id UUID REFERENCES entities(id) ON DELETE SET NULL,
+    author_agent_id UUID REFERENCES agents(id) ON DELETE SET NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT comments_one_target CHECK (num_nonnulls(entity_id, context_id) = 1),
+    CONSTRAINT comments_body_not_blank CHECK (btrim(body) <> '')
+);
+
+CREATE INDEX IF NOT EXISTS idx_comments_entity
+ON comments (entity_id, created_at);
+
+CREATE INDEX IF NOT EXISTS idx_comments_context
+ON comments (context_id, created_at);
+
+DROP TRIGGER IF EXISTS update_comments_updated_at ON comments;
+CREATE TRIGGER update_comments_updated_at
+BEFORE UPDATE ON comments
+FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
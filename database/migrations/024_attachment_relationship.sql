@@ -0,0 +1,11 @@
+-- File attachments: an "attachment" edge runs from a file to the entity,
+-- context item, or job that owns it.
+
+INSERT INTO relationship_types (name, description, is_symmetric, is_builtin, is_active, inverse_name)
+VALUES ('attachment', 'Source file is attached to target', FALSE, TRUE, TRUE, 'has-attachment')
+ON CONFLICT (name) DO UPDATE
+SET description = EXCLUDED.description,
+    is_symmetric = EXCLUDED.is_symmetric,
+    is_builtin = TRUE,
+    is_active = TRUE,
+    inverse_name = EXCLUDED.inverse_name;
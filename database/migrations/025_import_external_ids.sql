@@ -0,0 +1,21 @@
+-- Import external IDs: maps the external_id of an imported row to the record
+-- it created, so re-running the same import updates that record instead of
+-- creating a duplicate. record_id is text because job IDs are not UUIDs.
+
+CREATE TABLE IF NOT EXISTS import_external_ids (
+    resource TEXT NOT NULL,
+    external_id TEXT NOT NULL,
+    record_id TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (resource, external_id),
+    CONSTRAINT import_external_ids_resource_check
+        CHECK (resource IN ('entities', 'context', 'relationships', 'jobs')),
+    CONSTRAINT import_external_ids_external_id_not_blank
+        CHECK (btrim(external_id) <> '')
+);
+
+DROP TRIGGER IF EXISTS update_import_external_ids_updated_at ON import_external_ids;
+CREATE TRIGGER update_import_external_ids_updated_at
+BEFORE UPDATE ON import_external_ids
+FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,11 @@
+-- Approval priority: lets an agent flag an approval request as urgent so
+-- reviewers are alerted to it instead of finding it on their next visit.
+
+ALTER TABLE approval_requests
+    ADD COLUMN IF NOT EXISTS priority TEXT NOT NULL DEFAULT 'normal';
+
+ALTER TABLE approval_requests
+    DROP CONSTRAINT IF EXISTS approval_requests_priority_check;
+ALTER TABLE approval_requests
+    ADD CONSTRAINT approval_requests_priority_check
+    CHECK (priority IN ('low', 'normal', 'high'));
@@ -0,0 +1,17 @@
+-- Agent permissions: narrows what an enrolled agent may do beyond its scopes.
+-- An empty allowed_request_types allows every action, a zero rate limit means
+-- no limit, and a NULL expiry never expires.
+
+ALTER TABLE agents
+    ADD COLUMN IF NOT EXISTS allowed_request_types TEXT[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE agents
+    ADD COLUMN IF NOT EXISTS rate_limit_per_minute INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE agents
+    ADD COLUMN IF NOT EXISTS permissions_expire_at TIMESTAMPTZ;
+
+ALTER TABLE agents
+    DROP CONSTRAINT IF EXISTS agents_rate_limit_check;
+ALTER TABLE agents
+    ADD CONSTRAINT agents_rate_limit_check CHECK (rate_limit_per_minute >= 0);
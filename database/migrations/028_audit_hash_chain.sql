@@ -0,0 +1,122 @@
+-- Audit hash chain: every audit_log row stores the sha256 of its contents and
+-- of the row before it, so an export can prove no entry was edited or dropped.
+--
+-- The hash covers each chained column as "<char length>:<value>", NULL as an
+-- empty value, in this order: prev_hash, id, table_name, record_id, action,
+-- changed_by_type, changed_by_id, old_data, new_data, changed_fields (joined
+-- with ","), change_reason, changed_at (UTC, microseconds, trailing Z).
+-- metadata is left out because approvals link themselves to entries after
+-- the insert. audit_chain_head holds the newest hash; the insert trigger
+-- locks it, which also orders concurrent inserts.
+
+ALTER TABLE audit_log ADD COLUMN IF NOT EXISTS prev_hash TEXT;
+ALTER TABLE audit_log ADD COLUMN IF NOT EXISTS hash TEXT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_audit_log_hash ON audit_log(hash);
+
+CREATE TABLE IF NOT EXISTS audit_chain_head (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    entry_id UUID,
+    hash TEXT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO audit_chain_head (id) VALUES (TRUE) ON CONFLICT (id) DO NOTHING;
+
+CREATE OR REPLACE FUNCTION audit_chain_field(value TEXT)
+RETURNS TEXT AS $$
+    SELECT char_length(COALESCE(value, ''))::TEXT || ':' || COALESCE(value, '');
+$$ LANGUAGE sql IMMUTABLE;
+
+CREATE OR REPLACE FUNCTION audit_entry_hash(entry audit_log)
+RETURNS TEXT AS $$
+    SELECT encode(sha256(convert_to(
+        audit_chain_field(entry.prev_hash)
+        || audit_chain_field(entry.id::TEXT)
+        || audit_chain_field(entry.table_name)
+        || audit_chain_field(entry.record_id)
+        || audit_chain_field(entry.action)
+        || audit_chain_field(entry.changed_by_type)
+        || audit_chain_field(entry.changed_by_id::TEXT)
+        || audit_chain_field(entry.old_data::TEXT)
+        || audit_chain_field(entry.new_data::TEXT)
+        || audit_chain_field(array_to_string(entry.changed_fields, ','))
+        || audit_chain_field(entry.change_reason)
+        || audit_chain_field(to_char(
+            entry.changed_at AT TIME ZONE 'UTC',
+            'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'
+        )),
+        'UTF8'
+    )), 'hex');
+$$ LANGUAGE sql STABLE;
+
+CREATE OR REPLACE FUNCTION audit_log_chain()
+RETURNS TRIGGER AS $$
+DECLARE
+    head_hash TEXT;
+BEGIN
+    SELECT hash INTO head_hash FROM audit_chain_head WHERE id FOR UPDATE;
+    NEW.prev_hash := head_hash;
+    NEW.hash := audit_entry_hash(NEW);
+    INSERT INTO audit_chain_head (id, entry_id, hash, updated_at)
+    VALUES (TRUE, NEW.id, NEW.hash, NOW())
+    ON CONFLICT (id) DO UPDATE
+    SET entry_id = EXCLUDED.entry_id,
+        hash = EXCLUDED.hash,
+        updated_at = EXCLUDED.updated_at;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION audit_log_guard_chain()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF (
+        NEW.id, NEW.table_name, NEW.record_id, NEW.action, NEW.changed_by_type,
+        NEW.changed_by_id, NEW.old_data, NEW.new_data, NEW.changed_fields,
+        NEW.change_reason, NEW.changed_at, NEW.prev_hash, NEW.hash
+    ) IS DISTINCT FROM (
+        OLD.id, OLD.table_name, OLD.record_id, OLD.action, OLD.changed_by_type,
+        OLD.changed_by_id, OLD.old_data, OLD.new_data, OLD.changed_fields,
+        OLD.change_reason, OLD.changed_at, OLD.prev_hash, OLD.hash
+    ) THEN
+        RAISE EXCEPTION 'audit_log entries are append-only; only metadata may change';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Chain the entries written before this migration, oldest first.
+DO $$
+DECLARE
+    entry audit_log%ROWTYPE;
+    previous TEXT;
+    last_id UUID;
+BEGIN
+    SELECT hash, entry_id INTO previous, last_id FROM audit_chain_head WHERE id;
+    FOR entry IN
+        SELECT * FROM audit_log WHERE hash IS NULL ORDER BY changed_at, id
+    LOOP
+        entry.prev_hash := previous;
+        entry.hash := audit_entry_hash(entry);
+        UPDATE audit_log
+        SET prev_hash = entry.prev_hash, hash = entry.hash
+        WHERE id = entry.id;
+        previous := entry.hash;
+        last_id := entry.id;
+    END LOOP;
+    UPDATE audit_chain_head
+    SET entry_id = last_id, hash = previous, updated_at = NOW()
+    WHERE id;
+END;
+$$;
+
+DROP TRIGGER IF EXISTS audit_log_chain ON audit_log;
+CREATE TRIGGER audit_log_chain
+    BEFORE INSERT ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_chain();
+
+DROP TRIGGER IF EXISTS audit_log_guard_chain ON audit_log;
+CREATE TRIGGER audit_log_guard_chain
+    BEFORE UPDATE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_guard_chain();
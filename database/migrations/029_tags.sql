@@ -0,0 +1,47 @@
+-- Tag registry: every tag used on an entity, knowledge item, or file gets a
+-- row here, so a tag stays listed after its last use until it is deleted as
+-- unused. Renames and merges rewrite the tag arrays and this table together.
+
+CREATE TABLE IF NOT EXISTS tags (
+    name TEXT PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT tags_name_not_blank CHECK (btrim(name) <> '')
+);
+
+CREATE OR REPLACE FUNCTION register_tags()
+RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO tags (name)
+    SELECT DISTINCT tag FROM unnest(COALESCE(NEW.tags, '{}'::TEXT[])) AS tag
+    WHERE btrim(tag) <> ''
+    ON CONFLICT (name) DO NOTHING;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+INSERT INTO tags (name)
+SELECT DISTINCT tag
+FROM (
+    SELECT unnest(tags) AS tag FROM entities
+    UNION ALL
+    SELECT unnest(tags) FROM context_items
+    UNION ALL
+    SELECT unnest(tags) FROM files
+) used
+WHERE btrim(tag) <> ''
+ON CONFLICT (name) DO NOTHING;
+
+DROP TRIGGER IF EXISTS register_entity_tags ON entities;
+CREATE TRIGGER register_entity_tags
+AFTER INSERT OR UPDATE OF tags ON entities
+FOR EACH ROW EXECUTE FUNCTION register_tags();
+
+DROP TRIGGER IF EXISTS register_context_tags ON context_items;
+CREATE TRIGGER register_context_tags
+AFTER INSERT OR UPDATE OF tags ON context_items
+FOR EACH ROW EXECUTE FUNCTION register_tags();
+
+DROP TRIGGER IF EXISTS register_file_tags ON files;
+CREATE TRIGGER register_file_tags
+AFTER INSERT OR UPDATE OF tags ON files
+FOR EACH ROW EXECUTE FUNCTION register_tags();
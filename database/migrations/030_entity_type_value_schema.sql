@@ -0,0 +1,5 @@
+-- Entity type metadata schemas: an entity type may declare the metadata keys
+-- its entities carry, as a small JSON Schema subset that clients validate
+-- forms against. NULL means the type declares no schema.
+
+ALTER TABLE entity_types ADD COLUMN IF NOT EXISTS value_schema JSONB;
@@ -0,0 +1,16 @@
+-- Approval delegation: a reviewer can hand a pending approval to another
+-- person, who then finds it under "delegated to me" on any machine.
+-- delegated_to is NULL when the approval is not delegated.
+
+ALTER TABLE approval_requests
+    ADD COLUMN IF NOT EXISTS delegated_to UUID REFERENCES entities(id) ON DELETE SET NULL;
+
+ALTER TABLE approval_requests
+    ADD COLUMN IF NOT EXISTS delegated_by UUID REFERENCES entities(id) ON DELETE SET NULL;
+
+ALTER TABLE approval_requests
+    ADD COLUMN IF NOT EXISTS delegated_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_approval_delegated_to
+ON approval_requests (delegated_to)
+WHERE delegated_to IS NOT NULL;
@@ -0,0 +1,18 @@
+-- Approval comments: a discussion thread on an approval request. Reviewers
+-- ask questions and the requesting agent answers without the approval
+-- changing status. parent_id threads a reply under another comment of the
+-- same approval.
+
+CREATE TABLE IF NOT EXISTS approval_comments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    approval_id UUID NOT NULL REFERENCES approval_requests(id) ON DELETE CASCADE,
+    parent_id UUID REFERENCES approval_comments(id) ON DELETE CASCADE,
+    author_entity_id UUID REFERENCES entities(id) ON DELETE SET NULL,
+    author_agent_id UUID REFERENCES agents(id) ON DELETE SET NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT approval_comments_body_not_blank CHECK (btrim(body) <> '')
+);
+
+CREATE INDEX IF NOT EXISTS idx_approval_comments_approval
+ON approval_comments (approval_id, created_at);
@@ -22,6 +22,18 @@
 -- - 018_context_core_rename.sql
 -- - 019_source_refs_and_files_uri.sql
 -- - 020_requires_approval_defaults.sql
+-- - 021_relationship_type_rules.sql
+-- - 022_collections.sql
+-- - 023_comments.sql
+-- - 024_attachment_relationship.sql
+-- - 025_import_external_ids.sql
+-- - 026_approval_priority.sql
+-- - 027_agent_permissions.sql
+-- - 028_audit_hash_chain.sql
+-- - 029_tags.sql
+-- - 030_entity_type_value_schema.sql
+-- - 031_approval_delegation.sql
+-- - 032_approval_comments.sql
 --
 -- Generated from a clean temporary database (nebula_schema_snapshot) on 2026-02-20 13:57:40Z.
 -- Source migrations dir: database/migrations/
@@ -74,6 +86,65 @@ CREATE EXTENSION IF NOT EXISTS vector WITH SCHEMA public;
 COMMENT ON EXTENSION vector IS 'vector data type and ivfflat and hnsw access methods';
 
 
+--
+-- Name: audit_chain_field(text); Type: FUNCTION; Schema: public; Owner: -
+--
+
+CREATE FUNCTION public.audit_chain_field(value text) RETURNS text
+    LANGUAGE sql IMMUTABLE
+    AS $$
+    SELECT char_length(COALESCE(value, ''))::TEXT || ':' || COALESCE(value, '');
+$$;
+
+
+--
+-- Name: audit_log_chain(); Type: FUNCTION; Schema: public; Owner: -
+--
+
+CREATE FUNCTION public.audit_log_chain() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+DECLARE
+    head_hash TEXT;
+BEGIN
+    SELECT hash INTO head_hash FROM audit_chain_head WHERE id FOR UPDATE;
+    NEW.prev_hash := head_hash;
+    NEW.hash := audit_entry_hash(NEW);
+    INSERT INTO audit_chain_head (id, entry_id, hash, updated_at)
+    VALUES (TRUE, NEW.id, NEW.hash, NOW())
+    ON CONFLICT (id) DO UPDATE
+    SET entry_id = EXCLUDED.entry_id,
+        hash = EXCLUDED.hash,
+        updated_at = EXCLUDED.updated_at;
+    RETURN NEW;
+END;
+$$;
+
+
+--
+-- Name: audit_log_guard_chain(); Type: FUNCTION; Schema: public; Owner: -
+--
+
+CREATE FUNCTION public.audit_log_guard_chain() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    IF (
+        NEW.id, NEW.table_name, NEW.record_id, NEW.action, NEW.changed_by_type,
+        NEW.changed_by_id, NEW.old_data, NEW.new_data, NEW.changed_fields,
+        NEW.change_reason, NEW.changed_at, NEW.prev_hash, NEW.hash
+    ) IS DISTINCT FROM (
+        OLD.id, OLD.table_name, OLD.record_id, OLD.action, OLD.changed_by_type,
+        OLD.changed_by_id, OLD.old_data, OLD.new_data, OLD.changed_fields,
+        OLD.change_reason, OLD.changed_at, OLD.prev_hash, OLD.hash
+    ) THEN
+        RAISE EXCEPTION 'audit_log entries are append-only; only metadata may change';
+    END IF;
+    RETURN NEW;
+END;
+$$;
+
+
 --
 -- Name: audit_trigger_function(); Type: FUNCTION; Schema: public; Owner: -
 --
@@ -237,6 +308,23 @@ END;
 $$;
 
 
+--
+-- Name: register_tags(); Type: FUNCTION; Schema: public; Owner: -
+--
+
+CREATE FUNCTION public.register_tags() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    INSERT INTO tags (name)
+    SELECT DISTINCT tag FROM unnest(COALESCE(NEW.tags, '{}'::TEXT[])) AS tag
+    WHERE btrim(tag) <> ''
+    ON CONFLICT (name) DO NOTHING;
+    RETURN NEW;
+END;
+$$;
+
+
 --
 -- Name: sync_symmetric_relationships(); Type: FUNCTION; Schema: public; Owner: -
 --
@@ -429,6 +517,10 @@ CREATE TABLE public.agents (
     created_at timestamp with time zone DEFAULT now(),
     updated_at timestamp with time zone DEFAULT now(),
     requires_approval boolean DEFAULT true NOT NULL,
+    allowed_request_types text[] DEFAULT '{}'::text[] NOT NULL,
+    rate_limit_per_minute integer DEFAULT 0 NOT NULL,
+    permissions_expire_at timestamp with time zone,
+    CONSTRAINT agents_rate_limit_check CHECK ((rate_limit_per_minute >= 0)),
     CONSTRAINT metadata_is_object CHECK ((jsonb_typeof(metadata) = 'object'::text))
 );
 
@@ -453,6 +545,22 @@ CREATE TABLE public.api_keys (
 );
 
 
+--
+-- Name: approval_comments; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.approval_comments (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    approval_id uuid NOT NULL,
+    parent_id uuid,
+    author_entity_id uuid,
+    author_agent_id uuid,
+    body text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT approval_comments_body_not_blank CHECK ((btrim(body) <> ''::text))
+);
+
+
 --
 -- Name: approval_requests; Type: TABLE; Schema: public; Owner: -
 --
@@ -470,6 +578,11 @@ CREATE TABLE public.approval_requests (
     created_at timestamp with time zone DEFAULT now(),
     execution_error text,
     review_details jsonb DEFAULT '{}'::jsonb NOT NULL,
+    priority text DEFAULT 'normal'::text NOT NULL,
+    delegated_to uuid,
+    delegated_by uuid,
+    delegated_at timestamp with time zone,
+    CONSTRAINT approval_requests_priority_check CHECK ((priority = ANY (ARRAY['low'::text, 'normal'::text, 'high'::text]))),
     CONSTRAINT approval_requests_review_details_is_object CHECK ((jsonb_typeof(review_details) = 'object'::text)),
     CONSTRAINT approval_requests_status_check CHECK ((status = ANY (ARRAY['pending'::text, 'approved'::text, 'rejected'::text, 'approved-failed'::text]))),
     CONSTRAINT change_details_is_object CHECK ((jsonb_typeof(change_details) = 'object'::text))
@@ -507,11 +620,98 @@ CREATE TABLE public.audit_log (
     change_reason text,
     changed_at timestamp with time zone DEFAULT now(),
     metadata jsonb DEFAULT '{}'::jsonb,
+    prev_hash text,
+    hash text,
     CONSTRAINT audit_log_action_check CHECK ((action = ANY (ARRAY['insert'::text, 'update'::text, 'delete'::text]))),
     CONSTRAINT audit_log_changed_by_type_check CHECK ((changed_by_type = ANY (ARRAY['agent'::text, 'entity'::text, 'system'::text])))
 );
 
 
+--
+-- Name: audit_chain_head; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.audit_chain_head (
+    id boolean DEFAULT true NOT NULL,
+    entry_id uuid,
+    hash text,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT audit_chain_head_id_check CHECK (id)
+);
+
+
+--
+-- Name: audit_entry_hash(public.audit_log); Type: FUNCTION; Schema: public; Owner: -
+--
+
+CREATE FUNCTION public.audit_entry_hash(entry public.audit_log) RETURNS text
+    LANGUAGE sql STABLE
+    AS $$
+    SELECT encode(sha256(convert_to(
+        audit_chain_field(entry.prev_hash)
+        || audit_chain_field(entry.id::TEXT)
+        || audit_chain_field(entry.table_name)
+        || audit_chain_field(entry.record_id)
+        || audit_chain_field(entry.action)
+        || audit_chain_field(entry.changed_by_type)
+        || audit_chain_field(entry.changed_by_id::TEXT)
+        || audit_chain_field(entry.old_data::TEXT)
+        || audit_chain_field(entry.new_data::TEXT)
+        || audit_chain_field(array_to_string(entry.changed_fields, ','))
+        || audit_chain_field(entry.change_reason)
+        || audit_chain_field(to_char(
+            entry.changed_at AT TIME ZONE 'UTC',
+            'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'
+        )),
+        'UTF8'
+    )), 'hex');
+$$;
+
+
+--
+-- Name: collection_items; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.collection_items (
+    collection_id uuid NOT NULL,
+    entity_id uuid NOT NULL,
+    added_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: collections; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.collections (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    owner_entity_id uuid NOT NULL,
+    name text NOT NULL,
+    description text,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT collections_name_not_blank CHECK ((btrim(name) <> ''::text))
+);
+
+
+--
+-- Name: comments; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.comments (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    entity_id uuid,
+    context_id uuid,
+    author_entity_id uuid,
+    author_agent_id uuid,
+    body text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT comments_body_not_blank CHECK ((btrim(body) <> ''::text)),
+    CONSTRAINT comments_one_target CHECK ((num_nonnulls(entity_id, context_id) = 1))
+);
+
+
 --
 -- Name: context_items; Type: TABLE; Schema: public; Owner: -
 --
@@ -572,6 +772,7 @@ CREATE TABLE public.entity_types (
     is_builtin boolean DEFAULT false NOT NULL,
     is_active boolean DEFAULT true NOT NULL,
     metadata jsonb DEFAULT '{}'::jsonb NOT NULL,
+    value_schema jsonb,
     CONSTRAINT entity_types_metadata_is_object CHECK ((jsonb_typeof(metadata) = 'object'::text))
 );
 
@@ -619,6 +820,21 @@ CREATE TABLE public.files (
 );
 
 
+--
+-- Name: import_external_ids; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.import_external_ids (
+    resource text NOT NULL,
+    external_id text NOT NULL,
+    record_id text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT import_external_ids_external_id_not_blank CHECK ((btrim(external_id) <> ''::text)),
+    CONSTRAINT import_external_ids_resource_check CHECK ((resource = ANY (ARRAY['entities'::text, 'context'::text, 'relationships'::text, 'jobs'::text])))
+);
+
+
 --
 -- Name: jobs; Type: TABLE; Schema: public; Owner: -
 --
@@ -739,6 +955,9 @@ CREATE TABLE public.relationship_types (
     is_builtin boolean DEFAULT false NOT NULL,
     is_active boolean DEFAULT true NOT NULL,
     metadata jsonb DEFAULT '{}'::jsonb NOT NULL,
+    inverse_name text,
+    source_entity_types text[] DEFAULT '{}'::text[] NOT NULL,
+    target_entity_types text[] DEFAULT '{}'::text[] NOT NULL,
     CONSTRAINT relationship_types_metadata_is_object CHECK ((jsonb_typeof(metadata) = 'object'::text))
 );
 
@@ -797,6 +1016,17 @@ CREATE TABLE public.statuses (
 );
 
 
+--
+-- Name: tags; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.tags (
+    name text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT tags_name_not_blank CHECK ((btrim(name) <> ''::text))
+);
+
+
 --
 -- Name: agent_enrollment_sessions agent_enrollment_sessions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -829,6 +1059,14 @@ ALTER TABLE ONLY public.api_keys
     ADD CONSTRAINT api_keys_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: approval_comments approval_comments_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.approval_comments
+    ADD CONSTRAINT approval_comments_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: approval_requests approval_requests_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -837,6 +1075,14 @@ ALTER TABLE ONLY public.approval_requests
     ADD CONSTRAINT approval_requests_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: audit_chain_head audit_chain_head_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.audit_chain_head
+    ADD CONSTRAINT audit_chain_head_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: audit_log audit_log_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -845,6 +1091,38 @@ ALTER TABLE ONLY public.audit_log
     ADD CONSTRAINT audit_log_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: collection_items collection_items_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.collection_items
+    ADD CONSTRAINT collection_items_pkey PRIMARY KEY (collection_id, entity_id);
+
+
+--
+-- Name: collections collections_owner_name_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.collections
+    ADD CONSTRAINT collections_owner_name_key UNIQUE (owner_entity_id, name);
+
+
+--
+-- Name: collections collections_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.collections
+    ADD CONSTRAINT collections_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: comments comments_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.comments
+    ADD CONSTRAINT comments_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: entities entities_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -885,6 +1163,14 @@ ALTER TABLE ONLY public.files
     ADD CONSTRAINT files_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: import_external_ids import_external_ids_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.import_external_ids
+    ADD CONSTRAINT import_external_ids_pkey PRIMARY KEY (resource, external_id);
+
+
 --
 -- Name: jobs jobs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -1013,6 +1299,14 @@ ALTER TABLE ONLY public.statuses
     ADD CONSTRAINT statuses_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: tags tags_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.tags
+    ADD CONSTRAINT tags_pkey PRIMARY KEY (name);
+
+
 --
 -- Name: idx_agent_enroll_approval_request; Type: INDEX; Schema: public; Owner: -
 --
@@ -1090,6 +1384,20 @@ CREATE INDEX idx_api_keys_entity ON public.api_keys USING btree (entity_id);
 CREATE INDEX idx_api_keys_prefix ON public.api_keys USING btree (key_prefix);
 
 
+--
+-- Name: idx_approval_comments_approval; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_approval_comments_approval ON public.approval_comments USING btree (approval_id, created_at);
+
+
+--
+-- Name: idx_approval_delegated_to; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_approval_delegated_to ON public.approval_requests USING btree (delegated_to) WHERE (delegated_to IS NOT NULL);
+
+
 --
 -- Name: idx_approval_job; Type: INDEX; Schema: public; Owner: -
 --
@@ -1139,6 +1447,13 @@ CREATE INDEX idx_audit_changed_at ON public.audit_log USING btree (changed_at DE
 CREATE INDEX idx_audit_changed_by ON public.audit_log USING btree (changed_by_type, changed_by_id);
 
 
+--
+-- Name: idx_audit_log_hash; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX idx_audit_log_hash ON public.audit_log USING btree (hash);
+
+
 --
 -- Name: idx_audit_log_metadata_approval; Type: INDEX; Schema: public; Owner: -
 --
@@ -1160,6 +1475,27 @@ CREATE INDEX idx_audit_table_name ON public.audit_log USING btree (table_name);
 CREATE INDEX idx_audit_table_record ON public.audit_log USING btree (table_name, record_id);
 
 
+--
+-- Name: idx_collection_items_entity; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_collection_items_entity ON public.collection_items USING btree (entity_id);
+
+
+--
+-- Name: idx_comments_context; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_comments_context ON public.comments USING btree (context_id, created_at);
+
+
+--
+-- Name: idx_comments_entity; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_comments_entity ON public.comments USING btree (entity_id, created_at);
+
+
 --
 -- Name: idx_context_metadata; Type: INDEX; Schema: public; Owner: -
 --
@@ -1573,6 +1909,20 @@ CREATE UNIQUE INDEX uq_privacy_scopes_name_ci ON public.privacy_scopes USING btr
 CREATE UNIQUE INDEX uq_relationship_types_name_ci ON public.relationship_types USING btree (lower(name));
 
 
+--
+-- Name: audit_log audit_log_chain; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER audit_log_chain BEFORE INSERT ON public.audit_log FOR EACH ROW EXECUTE FUNCTION public.audit_log_chain();
+
+
+--
+-- Name: audit_log audit_log_guard_chain; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER audit_log_guard_chain BEFORE UPDATE ON public.audit_log FOR EACH ROW EXECUTE FUNCTION public.audit_log_guard_chain();
+
+
 --
 -- Name: agent_enrollment_sessions audit_agent_enrollment_sessions_trigger; Type: TRIGGER; Schema: public; Owner: -
 --
@@ -1678,6 +2028,27 @@ CREATE TRIGGER cascade_log_status_trigger AFTER UPDATE OF status_id ON public.lo
 CREATE TRIGGER cascade_protocol_status_trigger AFTER UPDATE OF status_id ON public.protocols FOR EACH ROW EXECUTE FUNCTION public.cascade_status_to_relationships();
 
 
+--
+-- Name: context_items register_context_tags; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER register_context_tags AFTER INSERT OR UPDATE OF tags ON public.context_items FOR EACH ROW EXECUTE FUNCTION public.register_tags();
+
+
+--
+-- Name: entities register_entity_tags; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER register_entity_tags AFTER INSERT OR UPDATE OF tags ON public.entities FOR EACH ROW EXECUTE FUNCTION public.register_tags();
+
+
+--
+-- Name: files register_file_tags; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER register_file_tags AFTER INSERT OR UPDATE OF tags ON public.files FOR EACH ROW EXECUTE FUNCTION public.register_tags();
+
+
 --
 -- Name: relationships sync_symmetric_relationships_trigger; Type: TRIGGER; Schema: public; Owner: -
 --
@@ -1699,6 +2070,20 @@ CREATE TRIGGER update_agent_enrollment_sessions_updated_at BEFORE UPDATE ON publ
 CREATE TRIGGER update_agents_updated_at BEFORE UPDATE ON public.agents FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
 
 
+--
+-- Name: collections update_collections_updated_at; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER update_collections_updated_at BEFORE UPDATE ON public.collections FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
+
+
+--
+-- Name: comments update_comments_updated_at; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER update_comments_updated_at BEFORE UPDATE ON public.comments FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
+
+
 --
 -- Name: context_items update_context_items_updated_at; Type: TRIGGER; Schema: public; Owner: -
 --
@@ -1734,6 +2119,13 @@ CREATE TRIGGER update_external_refs_updated_at BEFORE UPDATE ON public.external_
 CREATE TRIGGER update_files_updated_at BEFORE UPDATE ON public.files FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
 
 
+--
+-- Name: import_external_ids update_import_external_ids_updated_at; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER update_import_external_ids_updated_at BEFORE UPDATE ON public.import_external_ids FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
+
+
 --
 -- Name: jobs update_jobs_updated_at; Type: TRIGGER; Schema: public; Owner: -
 --
@@ -1845,6 +2237,54 @@ ALTER TABLE ONLY public.api_keys
     ADD CONSTRAINT api_keys_entity_id_fkey FOREIGN KEY (entity_id) REFERENCES public.entities(id);
 
 
+--
+-- Name: approval_comments approval_comments_approval_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.approval_comments
+    ADD CONSTRAINT approval_comments_approval_id_fkey FOREIGN KEY (approval_id) REFERENCES public.approval_requests(id) ON DELETE CASCADE;
+
+
+--
+-- Name: approval_comments approval_comments_author_agent_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.approval_comments
+    ADD CONSTRAINT approval_comments_author_agent_id_fkey FOREIGN KEY (author_agent_id) REFERENCES public.agents(id) ON DELETE SET NULL;
+
+
+--
+-- Name: approval_comments approval_comments_author_entity_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.approval_comments
+    ADD CONSTRAINT approval_comments_author_entity_id_fkey FOREIGN KEY (author_entity_id) REFERENCES public.entities(id) ON DELETE SET NULL;
+
+
+--
+-- Name: approval_comments approval_comments_parent_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.approval_comments
+    ADD CONSTRAINT approval_comments_parent_id_fkey FOREIGN KEY (parent_id) REFERENCES public.approval_comments(id) ON DELETE CASCADE;
+
+
+--
+-- Name: approval_requests approval_requests_delegated_by_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.approval_requests
+    ADD CONSTRAINT approval_requests_delegated_by_fkey FOREIGN KEY (delegated_by) REFERENCES public.entities(id) ON DELETE SET NULL;
+
+
+--
+-- Name: approval_requests approval_requests_delegated_to_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.approval_requests
+    ADD CONSTRAINT approval_requests_delegated_to_fkey FOREIGN KEY (delegated_to) REFERENCES public.entities(id) ON DELETE SET NULL;
+
+
 --
 -- Name: approval_requests approval_requests_job_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1869,6 +2309,62 @@ ALTER TABLE ONLY public.approval_requests
     ADD CONSTRAINT approval_requests_reviewed_by_fkey FOREIGN KEY (reviewed_by) REFERENCES public.entities(id);
 
 
+--
+-- Name: collection_items collection_items_collection_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.collection_items
+    ADD CONSTRAINT collection_items_collection_id_fkey FOREIGN KEY (collection_id) REFERENCES public.collections(id) ON DELETE CASCADE;
+
+
+--
+-- Name: collection_items collection_items_entity_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.collection_items
+    ADD CONSTRAINT collection_items_entity_id_fkey FOREIGN KEY (entity_id) REFERENCES public.entities(id) ON DELETE CASCADE;
+
+
+--
+-- Name: collections collections_owner_entity_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.collections
+    ADD CONSTRAINT collections_owner_entity_id_fkey FOREIGN KEY (owner_entity_id) REFERENCES public.entities(id) ON DELETE CASCADE;
+
+
+--
+-- Name: comments comments_author_agent_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.comments
+    ADD CONSTRAINT comments_author_agent_id_fkey FOREIGN KEY (author_agent_id) REFERENCES public.agents(id) ON DELETE SET NULL;
+
+
+--
+-- Name: comments comments_author_entity_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.comments
+    ADD CONSTRAINT comments_author_entity_id_fkey FOREIGN KEY (author_entity_id) REFERENCES public.entities(id) ON DELETE SET NULL;
+
+
+--
+-- Name: comments comments_context_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.comments
+    ADD CONSTRAINT comments_context_id_fkey FOREIGN KEY (context_id) REFERENCES public.context_items(id) ON DELETE CASCADE;
+
+
+--
+-- Name: comments comments_entity_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.comments
+    ADD CONSTRAINT comments_entity_id_fkey FOREIGN KEY (entity_id) REFERENCES public.entities(id) ON DELETE CASCADE;
+
+
 --
 -- Name: entities entities_status_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -0,0 +1,67 @@
+#!/usr/bin/env bash
+set -euo pipefail
+
+# Build and sign the CLI release assets that `nebula update` downloads:
+# nebula_<os>_<arch> per platform, checksums.txt, and checksums.txt.sig, a
+# base64 ed25519 signature of checksums.txt.
+#
+# usage: scripts/release.sh <version> <signing-key.pem> [out-dir]
+#
+# The signing key is an ed25519 private key in PEM form
+# (openssl genpkey -algorithm ed25519). Its public half is baked into every
+# binary so later updates can check the signature.
+
+ROOT_DIR="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+GO_DIR="${ROOT_DIR}/cli/src"
+MODULE="github.com/gravitrone/nebula-core/cli"
+
+PLATFORMS=(
+  darwin/amd64
+  darwin/arm64
+  linux/amd64
+  linux/arm64
+  windows/amd64
+)
+
+VERSION="${1:?usage: scripts/release.sh <version> <signing-key.pem> [out-dir]}"
+KEY_FILE="${2:?usage: scripts/release.sh <version> <signing-key.pem> [out-dir]}"
+OUT_DIR="${3:-${ROOT_DIR}/dist}"
+VERSION="${VERSION#v}"
+
+public_key() {
+  # The DER public key ends with the 32 raw key bytes.
+  openssl pkey -in "${KEY_FILE}" -pubout -outform DER | tail -c 32 | base64 | tr -d '\n'
+}
+
+build_binaries() {
+  local key="$1"
+  local ldflags="-s -w -X ${MODULE}/internal/version.Version=${VERSION} -X ${MODULE}/internal/update.PublicKey=${key}"
+  local platform goos goarch name
+  for platform in "${PLATFORMS[@]}"; do
+    goos="${platform%/*}"
+    goarch="${platform#*/}"
+    name="nebula_${goos}_${goarch}"
+    if [[ "${goos}" == "windows" ]]; then
+      name="${name}.exe"
+    fi
+    echo "building ${name}"
+    (cd "${GO_DIR}" && CGO_ENABLED=0 GOOS="${goos}" GOARCH="${goarch}" \
+      go build -trimpath -ldflags "${ldflags}" -o "${OUT_DIR}/${name}" ./cmd/nebula)
+  done
+}
+
+write_checksums() {
+  (cd "${OUT_DIR}" && sha256sum nebula_* >checksums.txt)
+}
+
+sign_checksums() {
+  openssl pkeyutl -sign -inkey "${KEY_FILE}" -rawin -in "${OUT_DIR}/checksums.txt" \
+    | base64 | tr -d '\n' >"${OUT_DIR}/checksums.txt.sig"
+}
+
+rm -rf "${OUT_DIR}"
+mkdir -p "${OUT_DIR}"
+build_binaries "$(public_key)"
+write_checksums
+sign_checksums
+echo "release assets written to ${OUT_DIR}"
@@ -12,6 +12,8 @@ from nebula_api.routes import (
     agents,
     approvals,
     audit,
+    collections,
+    comments,
     context,
     entities,
     exports,
@@ -24,6 +26,7 @@ from nebula_api.routes import (
     relationships,
     schema,
     search,
+    tags,
     taxonomy,
 )
 
@@ -33,6 +36,8 @@ from nebula_mcp.enums import load_enums
 
 load_dotenv()
 
+API_VERSION = "0.1.0"
+
 
 @asynccontextmanager
 async def lifespan(app: FastAPI) -> AsyncIterator[None]:
@@ -57,7 +62,7 @@ async def lifespan(app: FastAPI) -> AsyncIterator[None]:
 
 app = FastAPI(
     title="Nebula API",
-    version="0.1.0",
+    version=API_VERSION,
     description="REST API for Nebula - Agent Context Control",
     lifespan=lifespan,
 )
@@ -65,6 +70,10 @@ app = FastAPI(
 app.include_router(entities.router, prefix="/api/entities", tags=["Entities"])
 app.include_router(audit.router, prefix="/api/audit", tags=["Audit"])
 app.include_router(context.router, prefix="/api/context", tags=["Context"])
+app.include_router(
+    collections.router, prefix="/api/collections", tags=["Collections"]
+)
+app.include_router(comments.router, prefix="/api", tags=["Comments"])
 app.include_router(
     relationships.router, prefix="/api/relationships", tags=["Relationships"]
 )
@@ -80,6 +89,7 @@ app.include_router(agents.router, prefix="/api/agents", tags=["Agents"])
 app.include_router(keys.router, prefix="/api/keys", tags=["Keys"])
 app.include_router(taxonomy.router, prefix="/api/taxonomy", tags=["Taxonomy"])
 app.include_router(schema.router, prefix="/api/schema", tags=["Schema"])
+app.include_router(tags.router, prefix="/api/tags", tags=["Tags"])
 
 
 @app.get("/api/health")
@@ -87,7 +97,8 @@ async def health() -> dict[str, str]:
     """Health check endpoint.
 
     Returns:
-        Dict with status ok.
+        Dict with status ok and the API version, which clients compare
+        against their own version to warn about incompatibilities.
     """
 
-    return {"status": "ok"}
+    return {"status": "ok", "version": API_VERSION}
@@ -0,0 +1,57 @@
+"""Ed25519 signing of the audit hash chain head."""
+
+# Standard Library
+import base64
+import binascii
+import os
+
+# Third-Party
+from cryptography.hazmat.primitives.asymmetric.ed25519 import Ed25519PrivateKey
+from cryptography.hazmat.primitives.serialization import Encoding, PublicFormat
+
+SIGNING_KEY_ENV = "NEBULA_AUDIT_SIGNING_KEY"
+ALGORITHM = "ed25519"
+
+
+def load_signing_key() -> Ed25519PrivateKey | None:
+    """Load the audit signing key from the environment.
+
+    The variable holds a base64 32-byte Ed25519 seed, for example from
+    ``openssl rand -base64 32``.
+
+    Returns:
+        Private key, or None when signing is not configured.
+
+    Raises:
+        ValueError: If the variable is set but is not a valid seed.
+    """
+
+    raw = os.getenv(SIGNING_KEY_ENV, "").strip()
+    if not raw:
+        return None
+    try:
+        seed = base64.b64decode(raw, validate=True)
+    except binascii.Error as exc:
+        raise ValueError(f"{SIGNING_KEY_ENV} is not base64") from exc
+    if len(seed) != 32:
+        raise ValueError(f"{SIGNING_KEY_ENV} must decode to 32 bytes")
+    return Ed25519PrivateKey.from_private_bytes(seed)
+
+
+def sign_chain_hash(key: Ed25519PrivateKey, chain_hash: str) -> dict[str, str]:
+    """Sign an audit chain hash.
+
+    Args:
+        key: Audit signing key.
+        chain_hash: Hex hash of the audit entry being attested.
+
+    Returns:
+        Algorithm, base64 signature over the hash text, and base64 public key.
+    """
+
+    public_key = key.public_key().public_bytes(Encoding.Raw, PublicFormat.Raw)
+    return {
+        "algorithm": ALGORITHM,
+        "signature": base64.b64encode(key.sign(chain_hash.encode("utf-8"))).decode(),
+        "public_key": base64.b64encode(public_key).decode(),
+    }
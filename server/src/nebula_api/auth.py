@@ -13,12 +13,17 @@ from fastapi import HTTPException, Request
 from starlette.responses import JSONResponse
 
 # Local
+from nebula_api.response import api_error
+from nebula_mcp.context import AgentPermissionError, enforce_agent_permissions
 from nebula_mcp.query_loader import QueryLoader
 
 QUERIES = QueryLoader(Path(__file__).resolve().parents[1] / "queries")
 
 ph = PasswordHasher()
 
+# Agents flag an urgent request with this header; see maybe_check_agent_approval.
+APPROVAL_PRIORITY_HEADER = "X-Approval-Priority"
+
 
 def _merge_scopes(key_scopes: list | None, owner_scopes: list | None) -> list:
     """Return owner scopes narrowed by key scopes when provided."""
@@ -101,6 +106,12 @@ async def require_auth(request: Request) -> dict:
         agent = await pool.fetchrow(QUERIES["agents/get_by_id"], row["agent_id"])
         if not agent:
             raise HTTPException(status_code=401, detail="Agent not found or inactive")
+        try:
+            enforce_agent_permissions(dict(agent), count_request=True)
+        except AgentPermissionError as exc:
+            if exc.code == "PERMISSIONS_EXPIRED":
+                raise HTTPException(status_code=401, detail=str(exc))
+            api_error(exc.code, str(exc), 429)
         scopes = _merge_scopes(row["scopes"], agent.get("scopes"))
 
         return {
@@ -111,6 +122,7 @@ async def require_auth(request: Request) -> dict:
             "agent_id": row["agent_id"],
             "agent": dict(agent),
             "scopes": scopes,
+            "approval_priority": request.headers.get(APPROVAL_PRIORITY_HEADER),
         }
 
     raise HTTPException(status_code=401, detail="Invalid API key")
@@ -124,6 +136,10 @@ async def maybe_check_agent_approval(
 ) -> JSONResponse | None:
     """Check if caller is untrusted agent and create approval request.
 
+    The request carries the priority the agent sent in the
+    X-Approval-Priority header (low, normal, or high). Agents limited to a
+    set of request types get 403 for any other action.
+
     Args:
         pool: Database connection pool.
         auth: Auth context from require_auth.
@@ -137,6 +153,10 @@ async def maybe_check_agent_approval(
     if auth["caller_type"] != "agent":
         return None
     agent = auth["agent"]
+    try:
+        enforce_agent_permissions(agent, action=action)
+    except AgentPermissionError as exc:
+        api_error(exc.code, str(exc), 403)
     if not agent.get("requires_approval", True):
         return None
 
@@ -153,7 +173,13 @@ async def maybe_check_agent_approval(
             },
         )
 
-    approval = await create_approval_request(pool, agent["id"], action, payload)
+    approval = await create_approval_request(
+        pool,
+        agent["id"],
+        action,
+        payload,
+        priority=auth.get("approval_priority"),
+    )
     return JSONResponse(
         status_code=202,
         content={
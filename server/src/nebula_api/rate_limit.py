@@ -13,6 +13,26 @@ from fastapi import HTTPException, Request
 _buckets: dict[str, list[float]] = defaultdict(list)
 
 
+def allow_request(key: str, max_requests: int, window: int = 60) -> bool:
+    """Record a request for key unless its window is already full.
+
+    Args:
+        key: Bucket key, such as an API key or agent id.
+        max_requests: Maximum requests allowed in window.
+        window: Time window in seconds.
+
+    Returns:
+        True when the request fits in the window.
+    """
+
+    now = time.time()
+    _buckets[key] = [t for t in _buckets[key] if now - t < window]
+    if len(_buckets[key]) >= max_requests:
+        return False
+    _buckets[key].append(now)
+    return True
+
+
 def rate_limit(
     max_requests: int = 60, window: int = 60
 ) -> Callable[
@@ -55,9 +75,7 @@ def rate_limit(
             """
 
             key = request.headers.get("Authorization", request.client.host)
-            now = time.time()
-            _buckets[key] = [t for t in _buckets[key] if now - t < window]
-            if len(_buckets[key]) >= max_requests:
+            if not allow_request(key, max_requests, window):
                 raise HTTPException(
                     status_code=429,
                     detail={
@@ -67,7 +85,6 @@ def rate_limit(
                         }
                     },
                 )
-            _buckets[key].append(now)
             return await func(*args, request=request, **kwargs)
 
         return wrapper
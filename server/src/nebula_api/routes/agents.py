@@ -1,6 +1,7 @@
 """Agent API routes."""
 
 # Standard Library
+from datetime import datetime
 from pathlib import Path
 from typing import Any
 from uuid import UUID
@@ -14,6 +15,7 @@ from starlette.responses import JSONResponse
 from nebula_api.auth import require_auth
 from nebula_api.response import api_error, success
 from nebula_mcp.enums import EnumRegistry, load_enums, require_scopes
+from nebula_mcp.executors import EXECUTORS
 from nebula_mcp.helpers import create_approval_request, create_enrollment_session
 from nebula_mcp.query_loader import QueryLoader
 
@@ -107,6 +109,45 @@ class UpdateAgentBody(BaseModel):
     scopes: list[str] | None = None
 
 
+class UpdateAgentPermissionsBody(BaseModel):
+    """Payload replacing an agent's permission set.
+
+    Attributes:
+        scopes: Allowed privacy scope names.
+        request_types: Allowed write actions; empty allows every action.
+        rate_limit_per_minute: Request cap per minute; zero means no limit.
+        expires_at: When the agent's access ends; None never expires.
+    """
+
+    scopes: list[str]
+    request_types: list[str] = []
+    rate_limit_per_minute: int = 0
+    expires_at: datetime | None = None
+
+
+def _permissions_response(row: Any, enums: EnumRegistry) -> dict[str, Any]:
+    """Shape a permissions row for the API, naming its scopes.
+
+    Args:
+        row: Row from agents/get_permissions or agents/update_permissions.
+        enums: Enum registry used to name scope ids.
+
+    Returns:
+        Permission set with scope names.
+    """
+
+    return {
+        "agent_id": str(row["agent_id"]),
+        "scopes": [
+            enums.scopes.id_to_name.get(scope_id, str(scope_id))
+            for scope_id in row["scopes"] or []
+        ],
+        "request_types": list(row["allowed_request_types"] or []),
+        "rate_limit_per_minute": row["rate_limit_per_minute"],
+        "expires_at": row["permissions_expire_at"],
+    }
+
+
 @router.post("/register")
 async def register_agent(payload: RegisterAgentBody, request: Request) -> JSONResponse:
     """Register a new agent and create an approval request.
@@ -232,6 +273,89 @@ async def update_agent(
     return success(dict(row))
 
 
+@router.get("/{agent_id}/permissions")
+async def get_agent_permissions(
+    agent_id: str,
+    request: Request,
+    auth: dict = Depends(require_auth),
+) -> dict:
+    """Get an agent's scopes, allowed request types, rate limit, and expiry.
+
+    Args:
+        agent_id: Agent id.
+        request: FastAPI request.
+        auth: Auth context.
+
+    Returns:
+        API response with the permission set.
+    """
+
+    pool = request.app.state.pool
+    enums = request.app.state.enums
+
+    _require_admin_scope(auth, enums)
+    _require_uuid(agent_id, "agent")
+
+    row = await pool.fetchrow(QUERIES["agents/get_permissions"], agent_id)
+    if not row:
+        api_error("NOT_FOUND", "Agent not found", 404)
+
+    return success(_permissions_response(row, enums))
+
+
+@router.patch("/{agent_id}/permissions")
+async def update_agent_permissions(
+    agent_id: str,
+    payload: UpdateAgentPermissionsBody,
+    request: Request,
+    auth: dict = Depends(require_auth),
+) -> dict:
+    """Replace an agent's permission set.
+
+    Args:
+        agent_id: Agent id.
+        payload: Permission set payload.
+        request: FastAPI request.
+        auth: Auth context.
+
+    Returns:
+        API response with the saved permission set.
+    """
+
+    pool = request.app.state.pool
+    enums = request.app.state.enums
+
+    _require_admin_scope(auth, enums)
+    _require_uuid(agent_id, "agent")
+
+    try:
+        scope_ids = require_scopes(payload.scopes, enums)
+    except ValueError as exc:
+        api_error("INVALID_INPUT", str(exc), 400)
+
+    request_types = sorted({t.strip().lower() for t in payload.request_types if t})
+    unknown = [t for t in request_types if t not in EXECUTORS]
+    if unknown:
+        api_error(
+            "INVALID_INPUT", f"Unknown request types: {', '.join(unknown)}", 400
+        )
+    if payload.rate_limit_per_minute < 0:
+        api_error("INVALID_INPUT", "rate_limit_per_minute must be >= 0", 400)
+
+    row = await pool.fetchrow(
+        QUERIES["agents/update_permissions"],
+        agent_id,
+        scope_ids,
+        request_types,
+        payload.rate_limit_per_minute,
+        payload.expires_at,
+    )
+    if not row:
+        api_error("NOT_FOUND", "Agent not found", 404)
+
+    return success(_permissions_response(row, enums))
+
+
 @router.get("/{agent_name}")
 async def get_agent_info(
     agent_name: str,
@@ -7,7 +7,7 @@ from uuid import UUID
 
 # Third-Party
 from fastapi import APIRouter, Depends, Query, Request
-from pydantic import BaseModel
+from pydantic import BaseModel, field_validator
 
 # Local
 from nebula_api.auth import require_auth
@@ -26,12 +26,14 @@ from nebula_mcp.helpers import (
 from nebula_mcp.helpers import (
     reject_request as do_reject,
 )
+from nebula_mcp.models import parse_optional_datetime
 from nebula_mcp.query_loader import QueryLoader
 
 QUERIES = QueryLoader(Path(__file__).resolve().parents[2] / "queries")
 
 router = APIRouter()
 ADMIN_SCOPE_NAMES = {"admin"}
+MAX_COMMENT_LENGTH = 4000
 
 
 def _require_admin_scope(auth: dict, enums: Any) -> None:
@@ -76,6 +78,40 @@ class RejectBody(BaseModel):
     review_notes: str = ""
 
 
+class DelegateBody(BaseModel):
+    """Payload for delegating an approval request.
+
+    Attributes:
+        to: Entity id to hand the approval to, or None to clear it.
+    """
+
+    to: str | None = None
+
+
+class ApprovalCommentBody(BaseModel):
+    """Payload for commenting on an approval request.
+
+    Attributes:
+        body: Comment text.
+        parent_id: Comment being replied to, if any.
+    """
+
+    body: str
+    parent_id: str | None = None
+
+    @field_validator("body")
+    @classmethod
+    def _body(cls, value: str) -> str:
+        """Validate body."""
+
+        body = value.strip()
+        if not body:
+            raise ValueError("Comment body is required")
+        if len(body) > MAX_COMMENT_LENGTH:
+            raise ValueError("Comment too long")
+        return body
+
+
 class ApproveBody(BaseModel):
     """Optional reviewer input for approval grants.
 
@@ -96,12 +132,21 @@ async def get_pending(
     auth: dict = Depends(require_auth),
     limit: int = Query(200, ge=1, le=5000),
     offset: int = Query(0, ge=0),
+    since: str | None = None,
+    until: str | None = None,
+    delegated_to: str | None = None,
 ) -> dict[str, Any]:
     """List pending approval requests.
 
     Args:
         request: FastAPI request.
         auth: Auth context.
+        limit: Max rows.
+        offset: Offset for pagination.
+        since: ISO date or datetime; only requests created since.
+        until: ISO date or datetime; only requests created before.
+        delegated_to: Entity id, or "me" for the caller; only requests
+            delegated to that person.
 
     Returns:
         API response with pending approvals.
@@ -110,7 +155,25 @@ async def get_pending(
     pool = request.app.state.pool
     enums = request.app.state.enums
     _require_admin_scope(auth, enums)
-    results = await get_pending_approvals_all(pool, limit=limit, offset=offset)
+    try:
+        since_at = parse_optional_datetime(since, "since")
+        until_at = parse_optional_datetime(until, "until")
+    except ValueError as exc:
+        api_error("INVALID_INPUT", str(exc), 400)
+    if delegated_to == "me":
+        if not auth.get("entity_id"):
+            api_error("INVALID_INPUT", "delegated_to=me needs a user key", 400)
+        delegated_to = str(auth["entity_id"])
+    elif delegated_to is not None:
+        _require_uuid(delegated_to, "delegate")
+    results = await get_pending_approvals_all(
+        pool,
+        limit=limit,
+        offset=offset,
+        since=since_at,
+        until=until_at,
+        delegated_to=delegated_to,
+    )
     return success(results)
 
 
@@ -250,6 +313,148 @@ async def reject(
     return success(result)
 
 
+@router.post("/{approval_id}/delegate")
+async def delegate(
+    approval_id: str,
+    payload: DelegateBody,
+    request: Request,
+    auth: dict = Depends(require_auth),
+) -> dict[str, Any]:
+    """Hand a pending approval request to another person, or take it back.
+
+    Args:
+        approval_id: Approval request UUID.
+        payload: Delegation payload with the delegate's entity id.
+        request: FastAPI request.
+        auth: Auth context.
+
+    Returns:
+        API response with the updated approval request.
+    """
+
+    pool = request.app.state.pool
+    enums = request.app.state.enums
+    _require_admin_scope(auth, enums)
+    _require_uuid(approval_id, "approval")
+    to = payload.to.strip() if payload.to else None
+    if to:
+        _require_uuid(to, "delegate")
+        if not await pool.fetchrow(QUERIES["entities/get_by_id"], to):
+            api_error("NOT_FOUND", "Delegate not found", 404)
+    by = str(auth["entity_id"]) if auth.get("entity_id") else None
+
+    updated = await pool.fetchval(
+        QUERIES["approvals/delegate"], approval_id, to or None, by
+    )
+    if not updated:
+        if not await pool.fetchrow(QUERIES["approvals/get_request"], approval_id):
+            api_error("NOT_FOUND", "Approval request not found", 404)
+        api_error("CONFLICT", "Only pending approvals can be delegated", 409)
+
+    return success(await get_approval_request(pool, approval_id))
+
+
+async def _get_thread_approval(
+    pool: Any, auth: dict, enums: Any, approval_id: str
+) -> dict[str, Any]:
+    """Fetch an approval whose comment thread the caller may use.
+
+    Admins can use every thread; an agent can use the threads of its own
+    requests so it can answer reviewers.
+
+    Args:
+        pool: Database pool.
+        auth: Auth context.
+        enums: Enum registry.
+        approval_id: Approval request UUID.
+
+    Returns:
+        Approval request row.
+    """
+
+    _require_uuid(approval_id, "approval")
+    row = await pool.fetchrow(QUERIES["approvals/get_request"], approval_id)
+    is_requester = (
+        row is not None
+        and auth.get("caller_type") == "agent"
+        and str(row["requested_by"] or "") == str(auth.get("agent_id") or "")
+    )
+    if not is_requester:
+        _require_admin_scope(auth, enums)
+    if not row:
+        api_error("NOT_FOUND", "Approval request not found", 404)
+    return dict(row)
+
+
+@router.get("/{approval_id}/comments")
+async def list_comments(
+    approval_id: str,
+    request: Request,
+    auth: dict = Depends(require_auth),
+) -> dict[str, Any]:
+    """List the comment thread of an approval request, oldest first.
+
+    Args:
+        approval_id: Approval request UUID.
+        request: FastAPI request.
+        auth: Auth context.
+
+    Returns:
+        API response with comments.
+    """
+
+    pool = request.app.state.pool
+    await _get_thread_approval(pool, auth, request.app.state.enums, approval_id)
+    rows = await pool.fetch(QUERIES["approvals/list_comments"], approval_id)
+    return success([dict(r) for r in rows])
+
+
+@router.post("/{approval_id}/comments")
+async def add_comment(
+    approval_id: str,
+    payload: ApprovalCommentBody,
+    request: Request,
+    auth: dict = Depends(require_auth),
+) -> dict[str, Any]:
+    """Comment on an approval request, or reply to a comment.
+
+    Comments never change the approval status.
+
+    Args:
+        approval_id: Approval request UUID.
+        payload: Comment payload.
+        request: FastAPI request.
+        auth: Auth context.
+
+    Returns:
+        API response with the new comment.
+    """
+
+    pool = request.app.state.pool
+    await _get_thread_approval(pool, auth, request.app.state.enums, approval_id)
+    parent_id = payload.parent_id.strip() if payload.parent_id else None
+    if parent_id:
+        _require_uuid(parent_id, "parent comment")
+        parent = await pool.fetchrow(QUERIES["approvals/get_comment"], parent_id)
+        if not parent or parent["approval_id"] != UUID(approval_id):
+            api_error("INVALID_INPUT", "Parent comment not on this approval", 400)
+
+    author_agent_id = (
+        auth.get("agent_id") if auth.get("caller_type") == "agent" else None
+    )
+    author_entity_id = None if author_agent_id else auth.get("entity_id")
+    row = await pool.fetchrow(
+        QUERIES["approvals/create_comment"],
+        approval_id,
+        parent_id,
+        author_entity_id,
+        author_agent_id,
+        payload.body,
+    )
+    comment = await pool.fetchrow(QUERIES["approvals/get_comment"], row["id"])
+    return success(dict(comment))
+
+
 @router.get("/{approval_id}/diff")
 async def get_diff(
     approval_id: str,
@@ -1,20 +1,25 @@
 """Audit API routes."""
 
 # Standard Library
+from datetime import datetime, timezone
 from uuid import UUID
 
 # Third-Party
 from fastapi import APIRouter, Depends, Query, Request
 
 # Local
+from nebula_api.audit_signing import load_signing_key, sign_chain_hash
 from nebula_api.auth import require_auth
 from nebula_api.response import api_error, paginated, success
 from nebula_mcp.enums import EnumRegistry
 from nebula_mcp.helpers import (
+    audit_stats,
+    get_audit_chain_entry,
     list_audit_actors,
     list_audit_scopes,
     query_audit_log,
 )
+from nebula_mcp.models import parse_optional_datetime
 
 router = APIRouter()
 ADMIN_SCOPE_NAMES = {"admin"}
@@ -62,10 +67,17 @@ async def list_audit_log(
     actor_id: str | None = None,
     record_id: str | None = None,
     scope_id: str | None = None,
+    since: str | None = None,
+    until: str | None = None,
+    before_changed_at: str | None = None,
+    before_id: str | None = None,
     limit: int = Query(50, le=200),
     offset: int = 0,
 ) -> dict:
-    """List audit log entries with optional filters.
+    """List audit log entries with optional filters, newest first.
+
+    Exports page with before_changed_at and before_id set to the last entry
+    of the previous page, which stays stable while new entries are written.
 
     Args:
         request: FastAPI request.
@@ -76,6 +88,10 @@ async def list_audit_log(
         actor_id: Actor id filter.
         record_id: Record id filter.
         scope_id: Privacy scope filter.
+        since: ISO date or datetime; only entries changed since.
+        until: ISO date or datetime; only entries changed before.
+        before_changed_at: Cursor changed_at of the last entry seen.
+        before_id: Cursor id of the last entry seen.
         limit: Max rows.
         offset: Offset for pagination.
 
@@ -92,6 +108,18 @@ async def list_audit_log(
         _require_uuid(record_id, "record")
     if scope_id:
         _require_uuid(scope_id, "scope")
+    if bool(before_changed_at) != bool(before_id):
+        api_error(
+            "INVALID_INPUT", "before_changed_at and before_id go together", 400
+        )
+    if before_id:
+        _require_uuid(before_id, "before")
+    try:
+        since_at = parse_optional_datetime(since, "since")
+        until_at = parse_optional_datetime(until, "until")
+        before_at = parse_optional_datetime(before_changed_at, "before_changed_at")
+    except ValueError as exc:
+        api_error("INVALID_INPUT", str(exc), 400)
     rows = await query_audit_log(
         pool,
         table,
@@ -102,6 +130,10 @@ async def list_audit_log(
         scope_id,
         limit,
         offset,
+        since=since_at,
+        until=until_at,
+        before_changed_at=before_at,
+        before_id=before_id,
     )
     return paginated(rows, len(rows), limit, offset)
 
@@ -150,3 +182,99 @@ async def list_actors(
     _require_admin_scope(auth, enums)
     rows = await list_audit_actors(pool, actor_type)
     return success(rows)
+
+
+@router.get("/stats")
+async def get_audit_stats(
+    request: Request,
+    auth: dict = Depends(require_auth),
+    table: str | None = None,
+    action: str | None = None,
+    actor_type: str | None = None,
+    actor_id: str | None = None,
+    since: str | None = None,
+    until: str | None = None,
+    top: int = Query(10, ge=1, le=50),
+) -> dict:
+    """Summarize audit activity over a time window.
+
+    Args:
+        request: FastAPI request.
+        auth: Auth context.
+        table: Table name filter.
+        action: Action filter.
+        actor_type: Actor type filter.
+        actor_id: Actor id filter.
+        since: ISO date or datetime; only entries changed since.
+        until: ISO date or datetime; only entries changed before.
+        top: Max rows in each ranking.
+
+    Returns:
+        Daily counts and top actors, tables, and records.
+    """
+
+    pool = request.app.state.pool
+    enums = request.app.state.enums
+    _require_admin_scope(auth, enums)
+    if actor_id:
+        _require_uuid(actor_id, "actor")
+    try:
+        since_at = parse_optional_datetime(since, "since")
+        until_at = parse_optional_datetime(until, "until")
+    except ValueError as exc:
+        api_error("INVALID_INPUT", str(exc), 400)
+    stats = await audit_stats(
+        pool,
+        table,
+        action,
+        actor_type,
+        actor_id,
+        since=since_at,
+        until=until_at,
+        top=top,
+    )
+    return success(stats)
+
+
+@router.get("/integrity")
+async def get_audit_integrity(
+    request: Request,
+    auth: dict = Depends(require_auth),
+    entry_id: str | None = None,
+) -> dict:
+    """Sign the audit chain hash at an entry, or at the chain head.
+
+    Args:
+        request: FastAPI request.
+        auth: Auth context.
+        entry_id: Audit entry id; omitted signs the newest entry.
+
+    Returns:
+        Entry id, hash, and an Ed25519 signature over the hash.
+    """
+
+    pool = request.app.state.pool
+    enums = request.app.state.enums
+    _require_admin_scope(auth, enums)
+    if entry_id:
+        _require_uuid(entry_id, "entry")
+    try:
+        key = load_signing_key()
+    except ValueError as exc:
+        api_error("INTERNAL", str(exc), 500)
+    if key is None:
+        api_error(
+            "UNAVAILABLE",
+            "Audit signing is not configured (set NEBULA_AUDIT_SIGNING_KEY)",
+            503,
+        )
+    entry = await get_audit_chain_entry(pool, entry_id)
+    if not entry:
+        api_error("NOT_FOUND", "Audit entry not found in the hash chain", 404)
+    return success(
+        {
+            **entry,
+            **sign_chain_hash(key, entry["hash"]),
+            "signed_at": datetime.now(timezone.utc).isoformat(),
+        }
+    )
@@ -0,0 +1,454 @@
+"""Collection API routes.
+
+Collections are named, user-owned groups of entities ("pinboards"). They are
+private to their owner; members still follow entity privacy scopes.
+"""
+
+# Standard Library
+from pathlib import Path
+from typing import Any
+from uuid import UUID
+
+# Third-Party
+from asyncpg import UniqueViolationError
+from fastapi import APIRouter, Depends, Query, Request
+from pydantic import BaseModel, field_validator
+
+# Local
+from nebula_api.auth import require_auth
+from nebula_api.response import api_error, success
+from nebula_mcp.helpers import filter_context_segments
+from nebula_mcp.query_loader import QueryLoader
+
+QUERIES = QueryLoader(Path(__file__).resolve().parents[2] / "queries")
+
+router = APIRouter()
+
+MAX_COLLECTION_NAME_LENGTH = 120
+MAX_COLLECTION_ENTITIES = 500
+
+
+def _require_uuid(value: str, label: str) -> None:
+    """Reject ids that are not UUIDs.
+
+    Args:
+        value: Raw id.
+        label: Name used in the error message.
+    """
+
+    try:
+        UUID(str(value))
+    except ValueError:
+        api_error("INVALID_INPUT", f"Invalid {label} id", 400)
+
+
+def _require_owner(auth: dict) -> Any:
+    """Return the caller's entity id; collections belong to users.
+
+    Args:
+        auth: Auth context.
+
+    Returns:
+        Owning entity id.
+    """
+
+    owner_id = auth.get("entity_id")
+    if auth.get("caller_type") != "user" or not owner_id:
+        api_error("FORBIDDEN", "Collections belong to users", 403)
+    return owner_id
+
+
+def _clean_name(value: str | None) -> str | None:
+    """Validate a collection name.
+
+    Args:
+        value: Raw name.
+
+    Returns:
+        Trimmed name, or None when not supplied.
+    """
+
+    if value is None:
+        return None
+    name = value.strip()
+    if not name:
+        raise ValueError("Name is required")
+    if len(name) > MAX_COLLECTION_NAME_LENGTH:
+        raise ValueError("Name too long")
+    return name
+
+
+class CreateCollectionInput(BaseModel):
+    """Payload for creating a collection.
+
+    Attributes:
+        name: Collection name, unique per owner.
+        description: Optional description.
+    """
+
+    name: str
+    description: str | None = None
+
+    @field_validator("name")
+    @classmethod
+    def _name(cls, value: str) -> str:
+        """Validate name."""
+
+        return _clean_name(value)
+
+
+class UpdateCollectionInput(BaseModel):
+    """Payload for renaming or describing a collection.
+
+    Attributes:
+        name: New name.
+        description: New description.
+    """
+
+    name: str | None = None
+    description: str | None = None
+
+    @field_validator("name")
+    @classmethod
+    def _name(cls, value: str | None) -> str | None:
+        """Validate name."""
+
+        return _clean_name(value)
+
+
+class CollectionEntitiesInput(BaseModel):
+    """Payload for adding entities to a collection.
+
+    Attributes:
+        entity_ids: Entity ids to add.
+    """
+
+    entity_ids: list[str]
+
+    @field_validator("entity_ids")
+    @classmethod
+    def _entity_ids(cls, value: list[str]) -> list[str]:
+        """Validate entity ids."""
+
+        ids = list(dict.fromkeys(v.strip() for v in value if v and v.strip()))
+        if not ids:
+            raise ValueError("entity_ids is required")
+        if len(ids) > MAX_COLLECTION_ENTITIES:
+            raise ValueError("Too many entity ids")
+        for entity_id in ids:
+            UUID(entity_id)
+        return ids
+
+
+async def _get_owned(pool: Any, collection_id: str, owner_id: Any) -> dict[str, Any]:
+    """Fetch a collection the caller owns.
+
+    Args:
+        pool: Database pool.
+        collection_id: Collection id.
+        owner_id: Owning entity id.
+
+    Returns:
+        Collection row.
+    """
+
+    _require_uuid(collection_id, "collection")
+    row = await pool.fetchrow(QUERIES["collections/get"], collection_id, owner_id)
+    if not row:
+        api_error("NOT_FOUND", "Collection not found", 404)
+    return dict(row)
+
+
+async def collection_entities(
+    pool: Any,
+    enums: Any,
+    collection_id: str,
+    scope_ids: list,
+    *,
+    type_id: Any = None,
+    tags: list[str] | None = None,
+    search_text: str | None = None,
+    status_category: str | None = "active",
+    statuses: list[str] | None = None,
+    updated_after: Any = None,
+    updated_before: Any = None,
+    metadata: str | None = None,
+    limit: int = 50,
+    offset: int = 0,
+) -> list[dict[str, Any]]:
+    """List a collection's entities visible to scope_ids.
+
+    Shared by the collection, entity query, and export routes.
+
+    Args:
+        pool: Database pool.
+        enums: Enum registry.
+        collection_id: Collection id.
+        scope_ids: Caller privacy scope ids.
+        type_id: Optional entity type filter.
+        tags: Optional tag filter.
+        search_text: Optional full-text filter.
+        status_category: Status category filter; None for all.
+        statuses: Optional status name filter.
+        updated_after: Optional inclusive lower bound on updated_at.
+        updated_before: Optional exclusive upper bound on updated_at.
+        metadata: Optional JSON object the metadata must contain.
+        limit: Max rows.
+        offset: Offset for pagination.
+
+    Returns:
+        Entity rows with metadata segments filtered to the caller.
+    """
+
+    rows = await pool.fetch(
+        QUERIES["collections/entities"],
+        type_id,
+        tags or None,
+        search_text,
+        status_category,
+        scope_ids,
+        limit,
+        offset,
+        collection_id,
+        statuses or None,
+        updated_after,
+        updated_before,
+        metadata,
+    )
+    scope_names = [enums.scopes.id_to_name.get(s, "") for s in scope_ids]
+    results = []
+    for row in rows:
+        entity = dict(row)
+        if entity.get("metadata"):
+            entity["metadata"] = filter_context_segments(
+                entity["metadata"], scope_names
+            )
+        results.append(entity)
+    return results
+
+
+async def require_collection_access(
+    pool: Any, auth: dict, collection_id: str
+) -> None:
+    """Ensure the caller owns collection_id, for routes that filter by it.
+
+    Args:
+        pool: Database pool.
+        auth: Auth context.
+        collection_id: Collection id.
+    """
+
+    await _get_owned(pool, collection_id, _require_owner(auth))
+
+
+@router.get("/")
+async def list_collections(
+    request: Request,
+    auth: dict = Depends(require_auth),
+) -> dict[str, Any]:
+    """List the caller's collections.
+
+    Args:
+        request: FastAPI request.
+        auth: Auth context.
+
+    Returns:
+        API response with collections and member counts.
+    """
+
+    owner_id = _require_owner(auth)
+    rows = await request.app.state.pool.fetch(QUERIES["collections/list"], owner_id)
+    return success([dict(row) for row in rows])
+
+
+@router.post("/")
+async def create_collection(
+    payload: CreateCollectionInput,
+    request: Request,
+    auth: dict = Depends(require_auth),
+) -> dict[str, Any]:
+    """Create a collection.
+
+    Args:
+        payload: Collection payload.
+        request: FastAPI request.
+        auth: Auth context.
+
+    Returns:
+        API response with the new collection.
+    """
+
+    owner_id = _require_owner(auth)
+    try:
+        row = await request.app.state.pool.fetchrow(
+            QUERIES["collections/create"],
+            owner_id,
+            payload.name,
+            payload.description,
+        )
+    except UniqueViolationError:
+        api_error("CONFLICT", f"Collection '{payload.name}' already exists", 409)
+    return success(dict(row))
+
+
+@router.get("/{collection_id}")
+async def get_collection(
+    collection_id: str,
+    request: Request,
+    auth: dict = Depends(require_auth),
+    limit: int = Query(100, le=500),
+    offset: int = 0,
+) -> dict[str, Any]:
+    """Fetch a collection with the entities the caller can see.
+
+    Args:
+        collection_id: Collection id.
+        request: FastAPI request.
+        auth: Auth context.
+        limit: Max member rows.
+        offset: Member offset.
+
+    Returns:
+        API response with the collection and its entities.
+    """
+
+    pool = request.app.state.pool
+    collection = await _get_owned(pool, collection_id, _require_owner(auth))
+    collection["entities"] = await collection_entities(
+        pool,
+        request.app.state.enums,
+        collection_id,
+        auth.get("scopes", []) or [],
+        status_category=None,
+        limit=limit,
+        offset=offset,
+    )
+    return success(collection)
+
+
+@router.patch("/{collection_id}")
+async def update_collection(
+    collection_id: str,
+    payload: UpdateCollectionInput,
+    request: Request,
+    auth: dict = Depends(require_auth),
+) -> dict[str, Any]:
+    """Rename or describe a collection.
+
+    Args:
+        collection_id: Collection id.
+        payload: Fields to change.
+        request: FastAPI request.
+        auth: Auth context.
+
+    Returns:
+        API response with the updated collection.
+    """
+
+    pool = request.app.state.pool
+    owner_id = _require_owner(auth)
+    await _get_owned(pool, collection_id, owner_id)
+    try:
+        await pool.fetchrow(
+            QUERIES["collections/update"],
+            collection_id,
+            owner_id,
+            payload.name,
+            payload.description,
+        )
+    except UniqueViolationError:
+        api_error("CONFLICT", f"Collection '{payload.name}' already exists", 409)
+    return success(await _get_owned(pool, collection_id, owner_id))
+
+
+@router.delete("/{collection_id}")
+async def delete_collection(
+    collection_id: str,
+    request: Request,
+    auth: dict = Depends(require_auth),
+) -> dict[str, Any]:
+    """Delete a collection. Its entities are untouched.
+
+    Args:
+        collection_id: Collection id.
+        request: FastAPI request.
+        auth: Auth context.
+
+    Returns:
+        API response confirming deletion.
+    """
+
+    owner_id = _require_owner(auth)
+    _require_uuid(collection_id, "collection")
+    row = await request.app.state.pool.fetchrow(
+        QUERIES["collections/delete"], collection_id, owner_id
+    )
+    if not row:
+        api_error("NOT_FOUND", "Collection not found", 404)
+    return success({"deleted": True})
+
+
+@router.post("/{collection_id}/entities")
+async def add_collection_entities(
+    collection_id: str,
+    payload: CollectionEntitiesInput,
+    request: Request,
+    auth: dict = Depends(require_auth),
+) -> dict[str, Any]:
+    """Add entities to a collection. Entities the caller cannot see are refused.
+
+    Args:
+        collection_id: Collection id.
+        payload: Entity ids to add.
+        request: FastAPI request.
+        auth: Auth context.
+
+    Returns:
+        API response with how many entities were newly added.
+    """
+
+    pool = request.app.state.pool
+    await _get_owned(pool, collection_id, _require_owner(auth))
+
+    rows = await pool.fetch(QUERIES["entities/scopes_by_ids"], payload.entity_ids)
+    found = {str(row["id"]): row.get("privacy_scope_ids") or [] for row in rows}
+    missing = [eid for eid in payload.entity_ids if eid not in found]
+    if missing:
+        api_error("NOT_FOUND", f"Entity not found: {missing[0]}", 404)
+    caller_scopes = auth.get("scopes", []) or []
+    for entity_id, entity_scopes in found.items():
+        if entity_scopes and not any(s in caller_scopes for s in entity_scopes):
+            api_error("FORBIDDEN", f"Entity not in your scopes: {entity_id}", 403)
+
+    added = await pool.fetch(
+        QUERIES["collections/add_items"], collection_id, payload.entity_ids
+    )
+    return success({"added": len(added), "requested": len(payload.entity_ids)})
+
+
+@router.delete("/{collection_id}/entities/{entity_id}")
+async def remove_collection_entity(
+    collection_id: str,
+    entity_id: str,
+    request: Request,
+    auth: dict = Depends(require_auth),
+) -> dict[str, Any]:
+    """Remove an entity from a collection.
+
+    Args:
+        collection_id: Collection id.
+        entity_id: Entity id.
+        request: FastAPI request.
+        auth: Auth context.
+
+    Returns:
+        API response confirming removal.
+    """
+
+    pool = request.app.state.pool
+    await _get_owned(pool, collection_id, _require_owner(auth))
+    _require_uuid(entity_id, "entity")
+    row = await pool.fetchrow(QUERIES["collections/remove_item"], collection_id, entity_id)
+    if not row:
+        api_error("NOT_FOUND", "Entity not in collection", 404)
+    return success({"removed": True})
@@ -0,0 +1,335 @@
+"""Comment API routes.
+
+Comments are notes on an entity or context item, kept apart from metadata.
+Anyone who can see the record can read and add comments; only the author
+can edit or delete one.
+"""
+
+# Standard Library
+from pathlib import Path
+from typing import Any
+from uuid import UUID
+
+# Third-Party
+from fastapi import APIRouter, Depends, Request
+from pydantic import BaseModel, field_validator
+
+# Local
+from nebula_api.auth import require_auth
+from nebula_api.response import api_error, success
+from nebula_mcp.query_loader import QueryLoader
+
+QUERIES = QueryLoader(Path(__file__).resolve().parents[2] / "queries")
+
+router = APIRouter()
+
+MAX_COMMENT_LENGTH = 4000
+
+
+def _require_uuid(value: str, label: str) -> None:
+    """Reject ids that are not UUIDs.
+
+    Args:
+        value: Raw id.
+        label: Name used in the error message.
+    """
+
+    try:
+        UUID(str(value))
+    except ValueError:
+        api_error("INVALID_INPUT", f"Invalid {label} id", 400)
+
+
+def _clean_body(value: str) -> str:
+    """Validate a comment body.
+
+    Args:
+        value: Raw body.
+
+    Returns:
+        Trimmed body.
+    """
+
+    body = value.strip()
+    if not body:
+        raise ValueError("Comment body is required")
+    if len(body) > MAX_COMMENT_LENGTH:
+        raise ValueError("Comment too long")
+    return body
+
+
+class CommentInput(BaseModel):
+    """Payload for adding or editing a comment.
+
+    Attributes:
+        body: Comment text.
+    """
+
+    body: str
+
+    @field_validator("body")
+    @classmethod
+    def _body(cls, value: str) -> str:
+        """Validate body."""
+
+        return _clean_body(value)
+
+
+async def _require_entity_visible(pool: Any, auth: dict, entity_id: str) -> None:
+    """Ensure the caller can see an entity.
+
+    Args:
+        pool: Database pool.
+        auth: Auth context.
+        entity_id: Entity id.
+    """
+
+    _require_uuid(entity_id, "entity")
+    row = await pool.fetchrow(QUERIES["entities/get"], entity_id)
+    if not row:
+        api_error("NOT_FOUND", "Entity not found", 404)
+    entity_scopes = dict(row).get("privacy_scope_ids") or []
+    auth_scopes = auth.get("scopes", [])
+    if entity_scopes and not any(s in auth_scopes for s in entity_scopes):
+        api_error("FORBIDDEN", "Entity not in your scopes", 403)
+
+
+async def _require_context_visible(pool: Any, auth: dict, context_id: str) -> None:
+    """Ensure the caller can see a context item.
+
+    Args:
+        pool: Database pool.
+        auth: Auth context.
+        context_id: Context item id.
+    """
+
+    _require_uuid(context_id, "context")
+    row = await pool.fetchrow(
+        QUERIES["context/get"], context_id, auth.get("scopes", [])
+    )
+    if not row:
+        api_error("NOT_FOUND", "Context not found", 404)
+
+
+def _public(row: Any) -> dict[str, Any]:
+    """Drop the raw author columns from a comment row.
+
+    Args:
+        row: Comment row from comments/get.
+
+    Returns:
+        Comment payload.
+    """
+
+    comment = dict(row)
+    comment.pop("author_entity_id", None)
+    comment.pop("author_agent_id", None)
+    return comment
+
+
+async def _add_comment(
+    pool: Any,
+    auth: dict,
+    body: str,
+    *,
+    entity_id: str | None = None,
+    context_id: str | None = None,
+) -> dict[str, Any]:
+    """Insert a comment authored by the caller.
+
+    Args:
+        pool: Database pool.
+        auth: Auth context.
+        body: Comment text.
+        entity_id: Target entity id.
+        context_id: Target context item id.
+
+    Returns:
+        The new comment.
+    """
+
+    author_agent_id = auth.get("agent_id") if auth.get("caller_type") == "agent" else None
+    author_entity_id = None if author_agent_id else auth.get("entity_id")
+    row = await pool.fetchrow(
+        QUERIES["comments/create"],
+        entity_id,
+        context_id,
+        author_entity_id,
+        author_agent_id,
+        body,
+    )
+    return _public(await pool.fetchrow(QUERIES["comments/get"], row["id"]))
+
+
+async def _get_authored(pool: Any, auth: dict, comment_id: str) -> dict[str, Any]:
+    """Fetch a comment the caller wrote on a record they can still see.
+
+    Args:
+        pool: Database pool.
+        auth: Auth context.
+        comment_id: Comment id.
+
+    Returns:
+        Comment row.
+    """
+
+    _require_uuid(comment_id, "comment")
+    row = await pool.fetchrow(QUERIES["comments/get"], comment_id)
+    if not row:
+        api_error("NOT_FOUND", "Comment not found", 404)
+    comment = dict(row)
+    if comment["entity_id"]:
+        await _require_entity_visible(pool, auth, str(comment["entity_id"]))
+    else:
+        await _require_context_visible(pool, auth, str(comment["context_id"]))
+    if auth.get("caller_type") == "agent":
+        is_author = str(comment["author_agent_id"] or "") == str(auth.get("agent_id") or "")
+    else:
+        is_author = str(comment["author_entity_id"] or "") == str(auth.get("entity_id") or "")
+    if not is_author:
+        api_error("FORBIDDEN", "Only the author can change a comment", 403)
+    return comment
+
+
+@router.get("/entities/{entity_id}/comments")
+async def list_entity_comments(
+    entity_id: str,
+    request: Request,
+    auth: dict = Depends(require_auth),
+) -> dict[str, Any]:
+    """List comments on an entity, oldest first.
+
+    Args:
+        entity_id: Entity id.
+        request: FastAPI request.
+        auth: Auth context.
+
+    Returns:
+        API response with comments.
+    """
+
+    pool = request.app.state.pool
+    await _require_entity_visible(pool, auth, entity_id)
+    rows = await pool.fetch(QUERIES["comments/list"], entity_id, None)
+    return success([dict(row) for row in rows])
+
+
+@router.post("/entities/{entity_id}/comments")
+async def add_entity_comment(
+    entity_id: str,
+    payload: CommentInput,
+    request: Request,
+    auth: dict = Depends(require_auth),
+) -> dict[str, Any]:
+    """Add a comment to an entity.
+
+    Args:
+        entity_id: Entity id.
+        payload: Comment payload.
+        request: FastAPI request.
+        auth: Auth context.
+
+    Returns:
+        API response with the new comment.
+    """
+
+    pool = request.app.state.pool
+    await _require_entity_visible(pool, auth, entity_id)
+    return success(await _add_comment(pool, auth, payload.body, entity_id=entity_id))
+
+
+@router.get("/context/{context_id}/comments")
+async def list_context_comments(
+    context_id: str,
+    request: Request,
+    auth: dict = Depends(require_auth),
+) -> dict[str, Any]:
+    """List comments on a context item, oldest first.
+
+    Args:
+        context_id: Context item id.
+        request: FastAPI request.
+        auth: Auth context.
+
+    Returns:
+        API response with comments.
+    """
+
+    pool = request.app.state.pool
+    await _require_context_visible(pool, auth, context_id)
+    rows = await pool.fetch(QUERIES["comments/list"], None, context_id)
+    return success([dict(row) for row in rows])
+
+
+@router.post("/context/{context_id}/comments")
+async def add_context_comment(
+    context_id: str,
+    payload: CommentInput,
+    request: Request,
+    auth: dict = Depends(require_auth),
+) -> dict[str, Any]:
+    """Add a comment to a context item.
+
+    Args:
+        context_id: Context item id.
+        payload: Comment payload.
+        request: FastAPI request.
+        auth: Auth context.
+
+    Returns:
+        API response with the new comment.
+    """
+
+    pool = request.app.state.pool
+    await _require_context_visible(pool, auth, context_id)
+    return success(
+        await _add_comment(pool, auth, payload.body, context_id=context_id)
+    )
+
+
+@router.patch("/comments/{comment_id}")
+async def update_comment(
+    comment_id: str,
+    payload: CommentInput,
+    request: Request,
+    auth: dict = Depends(require_auth),
+) -> dict[str, Any]:
+    """Edit a comment the caller wrote.
+
+    Args:
+        comment_id: Comment id.
+        payload: New comment body.
+        request: FastAPI request.
+        auth: Auth context.
+
+    Returns:
+        API response with the updated comment.
+    """
+
+    pool = request.app.state.pool
+    await _get_authored(pool, auth, comment_id)
+    await pool.fetchrow(QUERIES["comments/update"], comment_id, payload.body)
+    return success(_public(await pool.fetchrow(QUERIES["comments/get"], comment_id)))
+
+
+@router.delete("/comments/{comment_id}")
+async def delete_comment(
+    comment_id: str,
+    request: Request,
+    auth: dict = Depends(require_auth),
+) -> dict[str, Any]:
+    """Delete a comment the caller wrote.
+
+    Args:
+        comment_id: Comment id.
+        request: FastAPI request.
+        auth: Auth context.
+
+    Returns:
+        API response confirming deletion.
+    """
+
+    pool = request.app.state.pool
+    await _get_authored(pool, auth, comment_id)
+    await pool.fetchrow(QUERIES["comments/delete"], comment_id)
+    return success({"deleted": True})
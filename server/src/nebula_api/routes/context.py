@@ -14,6 +14,7 @@ from nebula_api.auth import maybe_check_agent_approval, require_auth
 from nebula_api.response import paginated, success
 from nebula_mcp.enums import require_relationship_type, require_scopes, require_status
 from nebula_mcp.executors import (
+    UpdateConflictError,
     execute_create_context,
     execute_create_relationship,
     execute_update_context,
@@ -21,12 +22,15 @@ from nebula_mcp.executors import (
 from nebula_mcp.helpers import (
     enforce_scope_subset,
     filter_context_segments,
+    get_context_history,
+    narrow_scope_ids,
     scope_names_from_ids,
 )
 from nebula_mcp.models import (
     MAX_PAGE_LIMIT,
     MAX_TAG_LENGTH,
     MAX_TAGS,
+    parse_optional_datetime,
     validate_metadata_payload,
 )
 from nebula_mcp.query_loader import QueryLoader
@@ -233,6 +237,8 @@ class UpdateContextBody(BaseModel):
         tags: Updated tags.
         scopes: Updated scopes.
         metadata: Updated metadata.
+        expected_updated_at: updated_at the caller last read; the update is
+            rejected with 409 if the item changed since.
     """
 
     title: str | None = None
@@ -243,6 +249,7 @@ class UpdateContextBody(BaseModel):
     tags: list[str] | None = None
     scopes: list[str] | None = None
     metadata: dict | None = None
+    expected_updated_at: str | None = None
 
     @field_validator("tags", mode="before")
     @classmethod
@@ -336,6 +343,7 @@ async def query_context(
     auth: dict = Depends(require_auth),
     source_type: str | None = None,
     tags: str | None = None,
+    scopes: str | None = None,
     search_text: str | None = None,
     limit: int = Query(50, le=MAX_PAGE_LIMIT),
     offset: int = 0,
@@ -347,6 +355,7 @@ async def query_context(
         auth: Auth context.
         source_type: Source type filter.
         tags: Comma-separated tag filters.
+        scopes: Comma-separated scope names; only items in these scopes.
         search_text: Full-text search filter.
         limit: Max rows.
         offset: Offset for pagination.
@@ -364,7 +373,7 @@ async def query_context(
         source_type,
         tag_list,
         search_text,
-        scope_ids,
+        narrow_scope_ids(scope_ids, scopes, request.app.state.enums),
         limit,
         offset,
     )
@@ -415,6 +424,43 @@ async def get_context(
     return success(item)
 
 
+@router.get("/{context_id}/history")
+async def get_context_item_history(
+    context_id: str,
+    request: Request,
+    auth: dict = Depends(require_auth),
+    limit: int = Query(50, le=200),
+    offset: int = 0,
+) -> dict[str, Any]:
+    """List audit history entries for a context item.
+
+    Args:
+        context_id: Context id.
+        request: FastAPI request.
+        auth: Auth context.
+        limit: Max rows.
+        offset: Offset for pagination.
+
+    Returns:
+        API response with audit history entries.
+    """
+
+    pool = request.app.state.pool
+    try:
+        UUID(context_id)
+    except ValueError:
+        raise HTTPException(status_code=400, detail="Invalid context id")
+    row = await pool.fetchrow(
+        QUERIES["context/get"],
+        context_id,
+        auth.get("scopes", []),
+    )
+    if not row:
+        raise HTTPException(status_code=404, detail="Not Found")
+    rows = await get_context_history(pool, context_id, limit, offset)
+    return success(rows)
+
+
 @router.post("/{context_id}/link")
 async def link_to_entity(
     context_id: str,
@@ -496,6 +542,10 @@ async def update_context(
     await _require_context_write_access(pool, enums, auth, context_id)
 
     data = payload.model_dump()
+    try:
+        parse_optional_datetime(data["expected_updated_at"], "expected_updated_at")
+    except ValueError as exc:
+        raise HTTPException(status_code=400, detail=str(exc))
     if data.get("status"):
         try:
             require_status(data["status"], enums)
@@ -524,6 +574,8 @@ async def update_context(
         return resp
     try:
         updated = await execute_update_context(pool, enums, change)
+    except UpdateConflictError as exc:
+        raise HTTPException(status_code=409, detail=str(exc))
     except ValueError as exc:
         raise HTTPException(status_code=400, detail=str(exc))
     return success(updated)
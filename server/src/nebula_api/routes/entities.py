@@ -7,14 +7,23 @@ from typing import Any
 from uuid import UUID
 
 # Third-Party
+from asyncpg import ForeignKeyViolationError
 from fastapi import APIRouter, Depends, Query, Request
 from pydantic import BaseModel, Field, field_validator
 
 # Local
 from nebula_api.auth import maybe_check_agent_approval, require_auth
+from nebula_api.routes.collections import (
+    collection_entities,
+    require_collection_access,
+)
 from nebula_api.response import api_error, paginated, success
 from nebula_mcp.enums import require_entity_type, require_scopes, require_status
-from nebula_mcp.executors import execute_create_entity, execute_update_entity
+from nebula_mcp.executors import (
+    UpdateConflictError,
+    execute_create_entity,
+    execute_update_entity,
+)
 from nebula_mcp.helpers import (
     bulk_update_entity_scopes as do_bulk_update_entity_scopes,
 )
@@ -24,6 +33,7 @@ from nebula_mcp.helpers import (
 from nebula_mcp.helpers import (
     enforce_scope_subset,
     filter_context_segments,
+    narrow_scope_ids,
     normalize_bulk_operation,
     scope_names_from_ids,
 )
@@ -36,6 +46,7 @@ from nebula_mcp.helpers import (
 from nebula_mcp.models import (
     MAX_TAG_LENGTH,
     MAX_TAGS,
+    parse_optional_datetime,
     validate_metadata_payload,
 )
 from nebula_mcp.query_loader import QueryLoader
@@ -44,6 +55,7 @@ QUERIES = QueryLoader(Path(__file__).resolve().parents[2] / "queries")
 
 router = APIRouter()
 ADMIN_SCOPE_NAMES = {"admin"}
+MIN_REFERENCE_NAME_LENGTH = 3
 
 
 def _normalize_entity_metadata(entity: dict[str, Any]) -> dict[str, Any]:
@@ -191,6 +203,27 @@ def _validate_tag_list(tags: list[str] | None) -> list[str] | None:
     return cleaned
 
 
+def _parse_metadata_filter(raw: str | None) -> str | None:
+    """Validate a metadata containment filter from a query string.
+
+    Args:
+        raw: JSON object text, or None.
+
+    Returns:
+        Normalized JSON text for the query, or None when unset.
+    """
+
+    if raw is None or not raw.strip():
+        return None
+    try:
+        parsed = json.loads(raw)
+    except json.JSONDecodeError:
+        api_error("INVALID_INPUT", "metadata filter must be a JSON object", 400)
+    if not isinstance(parsed, dict):
+        api_error("INVALID_INPUT", "metadata filter must be a JSON object", 400)
+    return json.dumps(parsed)
+
+
 class CreateEntityBody(BaseModel):
     """Payload for creating an entity.
 
@@ -235,12 +268,17 @@ class UpdateEntityBody(BaseModel):
         tags: Updated tag list.
         status: Updated status name.
         status_reason: Optional status reason.
+        type: Updated entity type name.
+        expected_updated_at: updated_at the caller last read; the update is
+            rejected with 409 if the entity changed since.
     """
 
     metadata: dict | None = None
     tags: list[str] | None = None
     status: str | None = None
     status_reason: str | None = None
+    type: str | None = None
+    expected_updated_at: str | None = None
 
     @field_validator("tags", mode="before")
     @classmethod
@@ -274,9 +312,11 @@ class RevertEntityBody(BaseModel):
 
     Attributes:
         audit_id: Audit log entry id.
+        fields: Columns to restore; omitted restores every column.
     """
 
     audit_id: str
+    fields: list[str] | None = None
 
 
 class BulkUpdateTagsBody(BaseModel):
@@ -452,6 +492,59 @@ async def get_entity_history(
     return success(rows)
 
 
+@router.get("/{entity_id}/references")
+async def get_entity_references(
+    entity_id: str,
+    request: Request,
+    auth: dict = Depends(require_auth),
+    limit: int = Query(50, ge=1, le=200),
+) -> dict[str, Any]:
+    """List the context, jobs, logs, and protocols that reference an entity.
+
+    An item references the entity through an active relationship or by
+    mentioning its name in text or metadata. Names shorter than three
+    characters only match relationships.
+
+    Args:
+        entity_id: Entity id.
+        request: FastAPI request.
+        auth: Auth context.
+        limit: Max rows.
+
+    Returns:
+        API response with kind, id, title, and via for each reference.
+    """
+
+    pool = request.app.state.pool
+    enums = request.app.state.enums
+
+    try:
+        UUID(entity_id)
+    except ValueError:
+        api_error("INVALID_INPUT", "Invalid entity id", 400)
+
+    row = await pool.fetchrow(QUERIES["entities/get"], entity_id)
+    if not row:
+        api_error("NOT_FOUND", "Entity not found", 404)
+    entity = dict(row)
+    entity_scopes = entity.get("privacy_scope_ids", [])
+    auth_scopes = auth.get("scopes", [])
+    if entity_scopes and not any(s in auth_scopes for s in entity_scopes):
+        api_error("FORBIDDEN", "Entity not in your scopes", 403)
+
+    name = (entity.get("name") or "").strip()
+    pattern = None
+    if len(name) >= MIN_REFERENCE_NAME_LENGTH:
+        for char in ("\\", "%", "_"):
+            name = name.replace(char, "\\" + char)
+        pattern = f"%{name}%"
+    scopes = None if _is_admin(auth, enums) else auth_scopes
+    rows = await pool.fetch(
+        QUERIES["entities/references"], entity_id, pattern, scopes, limit
+    )
+    return success([dict(r) for r in rows])
+
+
 @router.post("/{entity_id}/revert")
 async def revert_entity(
     entity_id: str,
@@ -463,7 +556,7 @@ async def revert_entity(
 
     Args:
         entity_id: Entity id.
-        payload: Revert payload containing audit id.
+        payload: Revert payload with the audit id and optional fields.
         request: FastAPI request.
         auth: Auth context.
 
@@ -481,7 +574,11 @@ async def revert_entity(
             QUERIES["runtime/set_changed_by_id"], str(auth["entity_id"])
         )
         try:
-            result = await do_revert_entity(conn, entity_id, payload.audit_id)
+            result = await do_revert_entity(
+                conn, entity_id, payload.audit_id, payload.fields
+            )
+        except ValueError as exc:
+            api_error("INVALID_INPUT", str(exc), 400)
         finally:
             await conn.execute(QUERIES["runtime/reset_changed_by_type"])
             await conn.execute(QUERIES["runtime/reset_changed_by_id"])
@@ -588,8 +685,14 @@ async def query_entities(
     auth: dict = Depends(require_auth),
     type: str | None = None,
     tags: str | None = None,
+    scopes: str | None = None,
     search_text: str | None = None,
     status_category: str = "active",
+    status: str | None = None,
+    updated_after: str | None = None,
+    updated_before: str | None = None,
+    metadata: str | None = None,
+    collection_id: str | None = None,
     limit: int = Query(50, le=100),
     offset: int = 0,
 ) -> dict[str, Any]:
@@ -600,8 +703,14 @@ async def query_entities(
         auth: Auth context.
         type: Entity type filter.
         tags: Comma-separated tag filters.
+        scopes: Comma-separated scope names; only entities in these scopes.
         search_text: Full-text search filter.
         status_category: Status category filter.
+        status: Comma-separated status names.
+        updated_after: ISO date or datetime; only entities updated since.
+        updated_before: ISO date or datetime; only entities updated before.
+        metadata: JSON object the entity metadata must contain.
+        collection_id: Only entities in this collection of the caller's.
         limit: Max rows.
         offset: Offset for pagination.
 
@@ -615,16 +724,50 @@ async def query_entities(
     type_id = require_entity_type(type, enums) if type else None
     tag_list = tags.split(",") if tags else None
     scope_ids = _list_scope_ids(auth, enums)
+    query_scope_ids = narrow_scope_ids(scope_ids, scopes, enums)
+    status_list = (
+        [name.strip() for name in status.split(",") if name.strip()] if status else None
+    )
+    try:
+        updated_from = parse_optional_datetime(updated_after, "updated_after")
+        updated_to = parse_optional_datetime(updated_before, "updated_before")
+    except ValueError as exc:
+        api_error("INVALID_INPUT", str(exc), 400)
+    metadata_filter = _parse_metadata_filter(metadata)
+
+    if collection_id:
+        await require_collection_access(pool, auth, collection_id)
+        results = await collection_entities(
+            pool,
+            enums,
+            collection_id,
+            query_scope_ids,
+            type_id=type_id,
+            tags=tag_list,
+            search_text=search_text,
+            status_category=status_category,
+            statuses=status_list,
+            updated_after=updated_from,
+            updated_before=updated_to,
+            metadata=metadata_filter,
+            limit=limit,
+            offset=offset,
+        )
+        return paginated(results, len(results), limit, offset)
 
     rows = await pool.fetch(
-        QUERIES["entities/query"],
+        QUERIES["entities/query_filtered"],
         type_id,
         tag_list,
         search_text,
         status_category,
-        scope_ids,
+        query_scope_ids,
         limit,
         offset,
+        status_list,
+        updated_from,
+        updated_to,
+        metadata_filter,
     )
     scope_names = [enums.scopes.id_to_name.get(s, "") for s in scope_ids]
     results = []
@@ -669,6 +812,10 @@ async def update_entity(
 
     change = payload.model_dump()
     change["entity_id"] = entity_id
+    try:
+        parse_optional_datetime(change["expected_updated_at"], "expected_updated_at")
+    except ValueError as exc:
+        api_error("INVALID_INPUT", str(exc), 400)
     if change.get("metadata") is None:
         change.pop("metadata", None)
     else:
@@ -689,16 +836,73 @@ async def update_entity(
             require_status(change["status"], enums)
         except ValueError as exc:
             api_error("INVALID_INPUT", str(exc), 400)
+    if change.get("type") is not None:
+        try:
+            require_entity_type(change["type"], enums)
+        except ValueError as exc:
+            api_error("INVALID_INPUT", str(exc), 400)
     if resp := await maybe_check_agent_approval(pool, auth, "update_entity", change):
         return resp
     try:
         result = await execute_update_entity(pool, enums, change)
+    except UpdateConflictError as exc:
+        api_error("CONFLICT", str(exc), 409)
     except ValueError as exc:
         api_error("INVALID_INPUT", str(exc), 400)
     _normalize_entity_metadata(result)
     return success(result)
 
 
+@router.delete("/{entity_id}")
+async def delete_entity(
+    entity_id: str,
+    request: Request,
+    auth: dict = Depends(require_auth),
+) -> dict[str, Any]:
+    """Permanently delete an archived entity and its relationships.
+
+    Args:
+        entity_id: Entity id.
+        request: FastAPI request.
+        auth: Auth context.
+
+    Returns:
+        API response with the deleted entity id and relationship count.
+    """
+
+    pool = request.app.state.pool
+    enums = request.app.state.enums
+
+    try:
+        UUID(entity_id)
+    except ValueError:
+        api_error("INVALID_INPUT", "Invalid entity id", 400)
+    if auth["caller_type"] != "user":
+        api_error("FORBIDDEN", "Only users can permanently delete entities", 403)
+
+    await _require_entity_write_access(pool, enums, auth, [entity_id])
+    row = await pool.fetchrow(QUERIES["entities/get_status_category"], entity_id)
+    if not row:
+        api_error("NOT_FOUND", "Entity not found", 404)
+    if row["status_category"] != "archived":
+        api_error("CONFLICT", "Archive the entity before deleting it", 409)
+
+    try:
+        async with pool.acquire() as conn:
+            async with conn.transaction():
+                removed = await conn.fetch(
+                    QUERIES["relationships/delete_for_node"], "entity", entity_id
+                )
+                await conn.fetchrow(QUERIES["entities/delete"], entity_id)
+    except ForeignKeyViolationError:
+        api_error(
+            "CONFLICT",
+            "Entity is still referenced by keys, jobs, or approvals",
+            409,
+        )
+    return success({"id": entity_id, "relationships_deleted": len(removed)})
+
+
 @router.post("/search")
 async def search_by_metadata(
     payload: MetadataSearchBody,
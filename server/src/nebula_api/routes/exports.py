@@ -13,6 +13,10 @@ from fastapi import APIRouter, Depends, Query, Request
 # Local
 from nebula_api.auth import require_auth
 from nebula_api.response import api_error, success
+from nebula_api.routes.collections import (
+    collection_entities,
+    require_collection_access,
+)
 from nebula_mcp.enums import require_entity_type, require_scopes
 from nebula_mcp.helpers import (
     enforce_scope_subset,
@@ -176,12 +180,15 @@ def _to_csv(rows: list[dict[str, Any]], field_order: list[str] | None = None) ->
     return buffer.getvalue()
 
 
-def _export_response(rows: list[dict[str, Any]], fmt: str) -> dict[str, Any]:
+def _export_response(
+    rows: list[dict[str, Any]], fmt: str, total: int | None = None
+) -> dict[str, Any]:
     """Build a standardized export response.
 
     Args:
         rows: List of row dictionaries.
         fmt: Export format, json or csv.
+        total: Rows matching the filters across all pages, when counted.
 
     Returns:
         API response payload.
@@ -191,8 +198,12 @@ def _export_response(rows: list[dict[str, Any]], fmt: str) -> dict[str, Any]:
     if fmt not in {"json", "csv"}:
         api_error("VALIDATION_ERROR", "Format must be json or csv", 400)
     if fmt == "csv":
-        return success({"format": "csv", "content": _to_csv(rows), "count": len(rows)})
-    return success({"format": "json", "items": rows, "count": len(rows)})
+        payload = {"format": "csv", "content": _to_csv(rows), "count": len(rows)}
+    else:
+        payload = {"format": "json", "items": rows, "count": len(rows)}
+    if total is not None:
+        payload["total"] = total
+    return success(payload)
 
 
 @router.get("/schema")
@@ -217,6 +228,7 @@ async def export_entities(
     search_text: str | None = None,
     status_category: str = "active",
     scopes: list[str] = Query(default_factory=list),
+    collection_id: str | None = None,
     limit: int = Query(500, le=2000),
     offset: int = 0,
 ) -> dict[str, Any]:
@@ -231,8 +243,10 @@ async def export_entities(
         search_text: Full-text search filter.
         status_category: Status category filter.
         scopes: Privacy scope filters.
+        collection_id: Only entities in this collection of the caller's.
         limit: Max rows.
-        offset: Offset for pagination.
+        offset: Offset for pagination. The first page also reports the
+            total row count so clients can show progress.
 
     Returns:
         Export response payload.
@@ -249,6 +263,22 @@ async def export_entities(
         api_error("VALIDATION_ERROR", str(exc), 400)
     scope_ids = _resolve_scope_ids(scopes, auth, enums)
 
+    if collection_id:
+        await require_collection_access(pool, auth, collection_id)
+        results = await collection_entities(
+            pool,
+            enums,
+            collection_id,
+            scope_ids,
+            type_id=type_id,
+            tags=tags,
+            search_text=search_text,
+            status_category=status_category,
+            limit=limit,
+            offset=offset,
+        )
+        return _export_response(results, format)
+
     rows = await pool.fetch(
         QUERIES["entities/query"],
         type_id,
@@ -259,6 +289,16 @@ async def export_entities(
         limit,
         offset,
     )
+    total = None
+    if offset == 0:
+        total = await pool.fetchval(
+            QUERIES["entities/count"],
+            type_id,
+            tags or None,
+            search_text,
+            status_category,
+            scope_ids,
+        )
     scope_names = scope_names_from_ids(scope_ids or [], enums)
     results = []
     for row in rows:
@@ -266,7 +306,7 @@ async def export_entities(
         if item.get("metadata"):
             item["metadata"] = filter_context_segments(item["metadata"], scope_names)
         results.append(item)
-    return _export_response(results, format)
+    return _export_response(results, format, total)
 
 
 @router.get("/context")
@@ -292,7 +332,8 @@ async def export_context(
         search_text: Full-text search filter.
         scopes: Privacy scope filters.
         limit: Max rows.
-        offset: Offset for pagination.
+        offset: Offset for pagination. The first page also reports the
+            total row count.
 
     Returns:
         Export response payload.
@@ -312,6 +353,15 @@ async def export_context(
         limit,
         offset,
     )
+    total = None
+    if offset == 0:
+        total = await pool.fetchval(
+            QUERIES["context/count"],
+            source_type,
+            tags or None,
+            search_text,
+            scope_ids,
+        )
     scope_names = scope_names_from_ids(scope_ids or [], enums)
     results = []
     for row in rows:
@@ -319,7 +369,7 @@ async def export_context(
         if item.get("metadata"):
             item["metadata"] = filter_context_segments(item["metadata"], scope_names)
         results.append(item)
-    return _export_response(results, format)
+    return _export_response(results, format, total)
 
 
 @router.get("/relationships")
@@ -332,6 +382,7 @@ async def export_relationships(
     relationship_types: list[str] = Query(default_factory=list),
     status_category: str = "active",
     limit: int = Query(500, le=2000),
+    offset: int = 0,
 ) -> dict[str, Any]:
     """Export relationships as JSON or CSV.
 
@@ -344,6 +395,8 @@ async def export_relationships(
         relationship_types: Relationship type filters.
         status_category: Status category filter.
         limit: Max rows.
+        offset: Offset for pagination. The first page also reports the
+            total row count, before job visibility filtering.
 
     Returns:
         Export response payload.
@@ -362,7 +415,18 @@ async def export_relationships(
         status_category,
         limit,
         scope_ids,
+        offset,
     )
+    total = None
+    if offset == 0:
+        total = await pool.fetchval(
+            QUERIES["relationships/count"],
+            source_type,
+            target_type,
+            relationship_types or None,
+            status_category,
+            scope_ids,
+        )
     results = []
     for row in rows:
         if not _is_admin(auth, enums):
@@ -373,7 +437,7 @@ async def export_relationships(
                 if not await _job_visible(pool, auth, enums, row["target_id"]):
                     continue
         results.append(_normalize_relationship_export_row(row, scope_names))
-    return _export_response(results, format)
+    return _export_response(results, format, total)
 
 
 @router.get("/jobs")
@@ -390,6 +454,7 @@ async def export_jobs(
     overdue: bool = False,
     parent_job_id: str | None = None,
     limit: int = Query(500, le=2000),
+    offset: int = 0,
 ) -> dict[str, Any]:
     """Export jobs as JSON or CSV.
 
@@ -406,6 +471,8 @@ async def export_jobs(
         overdue: Overdue filter.
         parent_job_id: Parent job filter.
         limit: Max rows.
+        offset: Offset for pagination. The first page also reports the
+            total row count.
 
     Returns:
         Export response payload.
@@ -428,8 +495,23 @@ async def export_jobs(
         parent_job_id,
         scope_filter,
         limit,
+        offset,
     )
-    return _export_response([dict(r) for r in rows], format)
+    total = None
+    if offset == 0:
+        total = await pool.fetchval(
+            QUERIES["jobs/count"],
+            status_names or None,
+            assigned_to,
+            agent_filter,
+            priority,
+            due_before,
+            due_after,
+            overdue,
+            parent_job_id,
+            scope_filter,
+        )
+    return _export_response([dict(r) for r in rows], format, total)
 
 
 @router.get("/snapshot")
@@ -488,6 +570,7 @@ async def export_snapshot(
         "active",
         limit,
         scope_filter,
+        offset,
     )
     job_agent_filter = None
     scope_filter = None if _is_admin(auth, enums) else (scope_ids or [])
@@ -503,6 +586,7 @@ async def export_snapshot(
         None,
         scope_filter,
         limit,
+        offset,
     )
 
     scope_names = _visible_scope_names(auth, enums, scope_ids)
@@ -23,6 +23,7 @@ from nebula_mcp.executors import (
     execute_create_entity,
     execute_create_job,
     execute_create_relationship,
+    execute_import_row,
 )
 from nebula_mcp.helpers import (
     create_approval_request,
@@ -32,11 +33,13 @@ from nebula_mcp.helpers import (
 )
 from nebula_mcp.imports import (
     extract_items,
+    import_external_id,
     normalize_context,
     normalize_entity,
     normalize_job,
     normalize_relationship,
 )
+from nebula_mcp.models import validate_entity_metadata
 from nebula_mcp.query_loader import QueryLoader
 
 router = APIRouter()
@@ -227,6 +230,9 @@ async def _run_import(
 ) -> dict[str, Any]:
     """Run a bulk import with normalization and approval gating.
 
+    Rows with an external_id that was imported before update the record it
+    maps to instead of creating another.
+
     Args:
         request: FastAPI request.
         auth: Auth context.
@@ -236,7 +242,7 @@ async def _run_import(
         approval_action: Approval action name for audit/approval workflow.
 
     Returns:
-        API response with created items and errors.
+        API response with created and updated items and errors.
     """
 
     pool = request.app.state.pool
@@ -284,6 +290,8 @@ async def _run_import(
                         normalized.get("target_id", ""),
                     )
                 _validate_taxonomy_before_approval(approval_action, enums, normalized)
+                if external_id := import_external_id(item):
+                    normalized["external_id"] = external_id
                 approval = await create_approval_request(
                     pool,
                     agent["id"],
@@ -309,7 +317,10 @@ async def _run_import(
     ):
         return resp
 
-    created: list[dict[str, Any]] = []
+    resource = approval_action.removeprefix("bulk_import_")
+    written: list[dict[str, Any]] = []
+    created = 0
+    updated = 0
     errors: list[dict[str, Any]] = []
 
     async with pool.acquire() as conn:
@@ -358,17 +369,26 @@ async def _run_import(
                             normalized.get("target_type", ""),
                             normalized.get("target_id", ""),
                         )
-                    result = await executor(conn, enums, normalized)
-                    created.append(result)
+                    if external_id := import_external_id(item):
+                        normalized["external_id"] = external_id
+                    result, was_update = await execute_import_row(
+                        conn, enums, resource, normalized, executor
+                    )
+                    written.append(result)
+                    if was_update:
+                        updated += 1
+                    else:
+                        created += 1
                 except Exception as exc:
                     errors.append({"row": idx, "error": str(exc)})
 
     return success(
         {
-            "created": len(created),
+            "created": created,
+            "updated": updated,
             "failed": len(errors),
             "errors": errors,
-            "items": created,
+            "items": written,
         }
     )
 
@@ -479,3 +499,307 @@ async def import_jobs(
         execute_create_job,
         "bulk_import_jobs",
     )
+
+
+IMPORT_RESOURCES: dict[str, tuple[Callable[..., dict[str, Any]], str]] = {
+    "entities": (normalize_entity, "bulk_import_entities"),
+    "context": (normalize_context, "bulk_import_context"),
+    "relationships": (normalize_relationship, "bulk_import_relationships"),
+    "jobs": (normalize_job, "bulk_import_jobs"),
+}
+RELATIONSHIP_NODE_QUERIES = {
+    "entity": ("entities/get",),
+    "context": ("context/get", None),
+    "job": ("jobs/get",),
+}
+
+
+def _import_row_label(resource: str, item: dict[str, Any]) -> str:
+    """Describe an import row in a few words.
+
+    Args:
+        resource: Import resource name.
+        item: Raw or normalized row.
+
+    Returns:
+        A short label for the row.
+    """
+
+    if resource == "entities":
+        return str(item.get("name") or "")
+    if resource == "relationships":
+        return (
+            f"{item.get('source_type')}:{item.get('source_id')} "
+            f"-{item.get('relationship_type')}-> "
+            f"{item.get('target_type')}:{item.get('target_id')}"
+        )
+    return str(item.get("title") or "")
+
+
+def _import_row_key(resource: str, normalized: dict[str, Any]) -> tuple:
+    """Build the key two rows of one file must not share.
+
+    Args:
+        resource: Import resource name.
+        normalized: Normalized row.
+
+    Returns:
+        A hashable identity for the row.
+    """
+
+    if resource == "entities":
+        return (
+            normalized["name"].lower(),
+            normalized["type"].lower(),
+            tuple(sorted(normalized["scopes"])),
+        )
+    if resource == "context":
+        if normalized.get("url"):
+            return ("url", normalized["url"])
+        return ("title", normalized["title"].lower())
+    if resource == "relationships":
+        return (
+            normalized["source_type"],
+            normalized["source_id"],
+            normalized["target_type"],
+            normalized["target_id"],
+            normalized["relationship_type"],
+        )
+    return (normalized["title"].lower(),)
+
+
+async def _import_row_errors(
+    pool: Any,
+    enums: Any,
+    auth: dict,
+    resource: str,
+    normalized: dict[str, Any],
+    allowed_scopes: list[str],
+) -> list[str]:
+    """Collect the schema violations that would make a row fail to import.
+
+    Args:
+        pool: Database pool.
+        enums: Enum registry.
+        auth: Auth context.
+        resource: Import resource name.
+        normalized: Normalized row.
+        allowed_scopes: Scope names the caller may write.
+
+    Returns:
+        Error messages, empty when the row is valid.
+    """
+
+    errors: list[str] = []
+    scopes = normalized.get("scopes")
+    if scopes is not None:
+        unknown = [name for name in scopes if name not in enums.scopes.name_to_id]
+        if unknown:
+            errors.append(f"Unknown scopes: {', '.join(unknown)}")
+        else:
+            try:
+                enforce_scope_subset(scopes, allowed_scopes)
+            except ValueError as exc:
+                errors.append(str(exc))
+    if errors:
+        return errors
+
+    try:
+        _validate_taxonomy_before_approval(
+            IMPORT_RESOURCES[resource][1], enums, normalized
+        )
+        if resource == "entities":
+            validate_entity_metadata(normalized["type"], normalized["metadata"])
+    except ValueError as exc:
+        errors.append(str(exc))
+
+    if resource == "relationships":
+        source = (normalized["source_type"], normalized["source_id"])
+        target = (normalized["target_type"], normalized["target_id"])
+        if source == target:
+            errors.append("Self-referential relationships are not allowed")
+        for side, (node_type, node_id) in (("Source", source), ("Target", target)):
+            if node_type not in RELATIONSHIP_NODE_QUERIES:
+                errors.append(f"{side} type must be entity, context, or job")
+                continue
+            query, *extra = RELATIONSHIP_NODE_QUERIES[node_type]
+            try:
+                if not await pool.fetchrow(QUERIES[query], node_id, *extra):
+                    errors.append(f"{side} {node_type} {node_id} not found")
+                    continue
+                await _validate_relationship_node(pool, enums, auth, node_type, node_id)
+            except ValueError as exc:
+                errors.append(f"{side} {node_type}: {exc}")
+            except Exception:
+                errors.append(f"{side} {node_type} id is invalid: {node_id}")
+    return errors
+
+
+async def _import_row_duplicates(
+    pool: Any, enums: Any, auth: dict, resource: str, normalized: dict[str, Any]
+) -> list[dict[str, str]]:
+    """Find existing records a row duplicates.
+
+    Entities, context, and relationships report exact matches the import
+    would reject. Jobs report same-title jobs as candidates only.
+
+    Args:
+        pool: Database pool.
+        enums: Enum registry.
+        auth: Auth context.
+        resource: Import resource name.
+        normalized: Normalized, valid row.
+
+    Returns:
+        Matching records as id and label pairs.
+    """
+
+    if resource == "entities":
+        row = await pool.fetchrow(
+            QUERIES["entities/check_duplicate"],
+            normalized["name"],
+            require_entity_type(normalized["type"], enums),
+            require_scopes(normalized["scopes"], enums),
+        )
+        return [{"id": str(row["id"]), "label": row["name"]}] if row else []
+    if resource == "context":
+        if not normalized.get("url"):
+            return []
+        row = await pool.fetchrow(QUERIES["context/check_url"], normalized["url"])
+        return [{"id": str(row["id"]), "label": row["title"]}] if row else []
+    if resource == "relationships":
+        row = await pool.fetchrow(
+            QUERIES["imports/relationship_exists"],
+            normalized["source_type"],
+            normalized["source_id"],
+            normalized["target_type"],
+            normalized["target_id"],
+            normalized["relationship_type"],
+        )
+        return [{"id": str(row["id"]), "label": "existing relationship"}] if row else []
+    scope_filter = None if _is_admin(auth, enums) else (auth.get("scopes", []) or [])
+    rows = await pool.fetch(
+        QUERIES["imports/job_title_matches"], normalized["title"], scope_filter
+    )
+    return [{"id": str(row["id"]), "label": row["title"]} for row in rows]
+
+
+@router.post("/{resource}/validate")
+async def validate_import(
+    resource: str,
+    payload: BulkImportBody,
+    request: Request,
+    auth: dict = Depends(require_auth),
+) -> dict[str, Any]:
+    """Dry-run a bulk import and report what it would do, writing nothing.
+
+    Every row is classified as create, update (its external_id maps to a
+    record an earlier import created), skip (it duplicates an existing
+    record or an earlier row), or invalid (schema violations, unknown or
+    disallowed scopes, missing relationship endpoints). Tags nobody has used
+    yet and same-title jobs are reported as warnings.
+
+    Args:
+        resource: Import resource name.
+        payload: Bulk import payload, as sent to the import itself.
+        request: FastAPI request.
+        auth: Auth context.
+
+    Returns:
+        API response with per-row results and counts.
+    """
+
+    if resource not in IMPORT_RESOURCES:
+        api_error("NOT_FOUND", f"Unknown import resource: {resource}", 404)
+    pool = request.app.state.pool
+    enums = request.app.state.enums
+    try:
+        items = extract_items(payload.format, payload.data, payload.items)
+    except ValueError as exc:
+        api_error("VALIDATION_ERROR", str(exc), 400)
+    normalizer = IMPORT_RESOURCES[resource][0]
+    allowed_scopes = scope_names_from_ids(auth.get("scopes", []), enums)
+
+    normalized_rows: list[dict[str, Any] | str] = []
+    tags: set[str] = set()
+    for item in items:
+        try:
+            normalized = normalizer(item, payload.defaults)
+        except ValueError as exc:
+            normalized_rows.append(str(exc))
+            continue
+        normalized_rows.append(normalized)
+        tags.update(normalized.get("tags") or [])
+    known_tags: set[str] = set()
+    if tags:
+        known_rows = await pool.fetch(QUERIES["imports/known_tags"], sorted(tags))
+        known_tags = {row["tag"] for row in known_rows}
+
+    rows: list[dict[str, Any]] = []
+    seen: dict[tuple, int] = {}
+    for idx, (item, normalized) in enumerate(zip(items, normalized_rows), start=1):
+        report: dict[str, Any] = {
+            "row": idx,
+            "action": "create",
+            "label": _import_row_label(resource, item),
+            "errors": [],
+            "warnings": [],
+            "duplicates": [],
+            "external_id": import_external_id(item),
+            "record_id": None,
+            "item": item,
+        }
+        rows.append(report)
+        if isinstance(normalized, str):
+            report["action"] = "invalid"
+            report["errors"].append(normalized)
+            continue
+        report["label"] = _import_row_label(resource, normalized)
+        report["errors"] = await _import_row_errors(
+            pool, enums, auth, resource, normalized, allowed_scopes
+        )
+        for tag in normalized.get("tags") or []:
+            if tag not in known_tags:
+                report["warnings"].append(f"New tag: {tag}")
+        if report["errors"]:
+            report["action"] = "invalid"
+            continue
+        external_id = report["external_id"]
+        if external_id:
+            key: tuple = ("external_id", external_id)
+        else:
+            key = _import_row_key(resource, normalized)
+        if key in seen:
+            report["action"] = "skip"
+            report["warnings"].append(f"Duplicate of row {seen[key]}")
+            continue
+        seen[key] = idx
+        if external_id:
+            record_id = await pool.fetchval(
+                QUERIES["imports/external_id_get"], resource, external_id
+            )
+            if record_id:
+                report["action"] = "update"
+                report["record_id"] = str(record_id)
+                continue
+        report["duplicates"] = await _import_row_duplicates(
+            pool, enums, auth, resource, normalized
+        )
+        if report["duplicates"] and resource == "jobs":
+            report["warnings"].append("A job with this title already exists")
+        elif report["duplicates"]:
+            report["action"] = "skip"
+            report["warnings"].append("Already exists")
+
+    counts = {"create": 0, "update": 0, "skip": 0, "invalid": 0}
+    for report in rows:
+        counts[report["action"]] += 1
+    return success(
+        {
+            "resource": resource,
+            "total": len(rows),
+            "counts": counts,
+            "warnings": sum(1 for report in rows if report["warnings"]),
+            "rows": rows,
+        }
+    )
@@ -321,6 +321,7 @@ async def query_jobs(
         parent_job_id,
         scope_filter,
         limit,
+        0,
     )
     return success([dict(r) for r in rows])
 
@@ -16,7 +16,7 @@ from nebula_api.auth import maybe_check_agent_approval, require_auth
 from nebula_api.response import api_error, success
 from nebula_mcp.enums import require_log_type, require_status
 from nebula_mcp.executors import execute_create_log, execute_update_log
-from nebula_mcp.models import validate_metadata_payload
+from nebula_mcp.models import parse_optional_datetime, validate_metadata_payload
 from nebula_mcp.query_loader import QueryLoader
 
 QUERIES = QueryLoader(Path(__file__).resolve().parents[2] / "queries")
@@ -242,6 +242,8 @@ async def query_logs(
     log_type: str | None = None,
     tags: list[str] = Query(default_factory=list),
     status_category: str = "active",
+    since: str | None = None,
+    until: str | None = None,
     limit: int = Query(50, le=500),
     offset: int = 0,
 ) -> dict[str, Any]:
@@ -252,6 +254,8 @@ async def query_logs(
 
     try:
         log_type_id = require_log_type(log_type, enums) if log_type else None
+        since_at = parse_optional_datetime(since, "since")
+        until_at = parse_optional_datetime(until, "until")
     except ValueError as exc:
         api_error("INVALID_INPUT", str(exc), 400)
 
@@ -262,6 +266,8 @@ async def query_logs(
         status_category,
         limit,
         offset,
+        since_at,
+        until_at,
     )
     if _is_admin(auth, enums):
         return success([_normalize_log_payload(dict(r)) for r in rows])
@@ -1,6 +1,8 @@
 """Protocol API routes."""
 
 # Standard Library
+import json
+import re
 from pathlib import Path
 from typing import Any
 
@@ -22,6 +24,10 @@ router = APIRouter()
 
 ADMIN_SCOPE_NAMES = {"admin"}
 
+PLACEHOLDER_PATTERN = re.compile(r"\{\{\s*([A-Za-z_][\w.]*)\s*\}\}")
+STEP_PATTERN = re.compile(r"^\s*(?:\d+[.)]|[-*+])\s+(.*\S)\s*$")
+HEADING_PATTERN = re.compile(r"^\s*#{1,6}\s+(.*\S)\s*$")
+
 
 def _is_admin(auth: dict, enums: Any) -> bool:
     """Handle is admin.
@@ -72,6 +78,83 @@ def _validate_tag_list(tags: list[str] | None) -> list[str] | None:
     return cleaned
 
 
+def _lookup_input(sample: dict[str, Any], path: str) -> tuple[bool, Any]:
+    """Resolve a dotted placeholder path against sample input.
+
+    Args:
+        sample: Sample input supplied for the dry run.
+        path: Dotted placeholder name, e.g. ``owner.name``.
+
+    Returns:
+        Whether the path resolved, and the resolved value.
+    """
+
+    current: Any = sample
+    for part in path.split("."):
+        if not isinstance(current, dict) or part not in current:
+            return False, None
+        current = current[part]
+    return True, current
+
+
+def _render_protocol(content: str, sample: dict[str, Any]) -> dict[str, Any]:
+    """Fill placeholders in protocol content and split it into steps.
+
+    Placeholders are written ``{{name}}`` and resolve against ``sample``.
+    Unresolved placeholders are left in place and reported as missing.
+
+    Args:
+        content: Protocol content.
+        sample: Sample input supplied for the dry run.
+
+    Returns:
+        Rendered output, steps, and placeholder bookkeeping.
+    """
+
+    placeholders: list[str] = []
+    missing: list[str] = []
+
+    def _fill(match: re.Match[str]) -> str:
+        name = match.group(1)
+        if name not in placeholders:
+            placeholders.append(name)
+        found, value = _lookup_input(sample, name)
+        if not found or value is None:
+            if name not in missing:
+                missing.append(name)
+            return match.group(0)
+        return value if isinstance(value, str) else json.dumps(value)
+
+    output = PLACEHOLDER_PATTERN.sub(_fill, content or "")
+
+    steps: list[dict[str, Any]] = []
+    section = None
+    in_fence = False
+    for line in output.splitlines():
+        if line.lstrip().startswith("```"):
+            in_fence = not in_fence
+            continue
+        if in_fence:
+            continue
+        if heading := HEADING_PATTERN.match(line):
+            section = heading.group(1)
+            continue
+        if step := STEP_PATTERN.match(line):
+            steps.append(
+                {"index": len(steps) + 1, "section": section, "text": step.group(1)}
+            )
+
+    used_roots = {name.split(".")[0] for name in placeholders}
+    unused = sorted(key for key in sample if key not in used_roots)
+    return {
+        "output": output,
+        "steps": steps,
+        "placeholders": placeholders,
+        "missing": missing,
+        "unused": unused,
+    }
+
+
 class CreateProtocolBody(BaseModel):
     """Payload for creating a protocol."""
 
@@ -131,6 +214,12 @@ class UpdateProtocolBody(BaseModel):
         return _validate_tag_list(v)
 
 
+class DryRunProtocolBody(BaseModel):
+    """Payload for a protocol dry run."""
+
+    input: dict[str, Any] = {}
+
+
 @router.get("/")
 async def query_protocols(
     request: Request,
@@ -175,6 +264,34 @@ async def get_protocol(
     return success(dict(row))
 
 
+@router.post("/{protocol_name}/dry-run")
+async def dry_run_protocol(
+    protocol_name: str,
+    payload: DryRunProtocolBody,
+    request: Request,
+    auth: dict = Depends(require_auth),
+) -> dict[str, Any]:
+    """Render a protocol against sample input without saving anything."""
+
+    pool = request.app.state.pool
+    enums = request.app.state.enums
+    row = await pool.fetchrow(QUERIES["protocols/get"], protocol_name)
+    if not row:
+        raise HTTPException(status_code=404, detail="Not Found")
+    if row.get("trusted") and not _is_admin(auth, enums):
+        raise HTTPException(status_code=403, detail="Forbidden")
+    result = _render_protocol(row.get("content") or "", payload.input)
+    return success(
+        {
+            "name": row.get("name"),
+            "title": row.get("title"),
+            "version": row.get("version"),
+            "status": row.get("status"),
+            **result,
+        }
+    )
+
+
 @router.post("/")
 async def create_protocol(
     payload: CreateProtocolBody,
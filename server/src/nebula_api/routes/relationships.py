@@ -381,6 +381,7 @@ async def query_relationships(
         status_category,
         limit,
         scope_ids,
+        0,
     )
     scope_names = _visible_scope_names(auth, enums)
     if _is_admin(auth, enums):
@@ -458,3 +459,48 @@ async def update_relationship(
         api_error("NOT_FOUND", "Relationship not found", 404)
 
     return success(dict(row))
+
+
+@router.delete("/{relationship_id}")
+async def delete_relationship(
+    relationship_id: str,
+    request: Request,
+    auth: dict = Depends(require_auth),
+) -> dict[str, Any]:
+    """Permanently delete an archived relationship.
+
+    Args:
+        relationship_id: Relationship id.
+        request: FastAPI request.
+        auth: Auth context.
+
+    Returns:
+        API response with the deleted relationship id.
+    """
+
+    pool = request.app.state.pool
+    enums = request.app.state.enums
+
+    try:
+        UUID(relationship_id)
+    except ValueError:
+        api_error("INVALID_INPUT", "Invalid relationship id", 400)
+    if auth["caller_type"] != "user":
+        api_error("FORBIDDEN", "Only users can permanently delete relationships", 403)
+
+    row = await pool.fetchrow(
+        QUERIES["relationships/get_status_category"], relationship_id
+    )
+    if not row:
+        api_error("NOT_FOUND", "Relationship not found", 404)
+    await _validate_relationship_node(
+        pool, enums, auth, row["source_type"], row["source_id"]
+    )
+    await _validate_relationship_node(
+        pool, enums, auth, row["target_type"], row["target_id"]
+    )
+    if row["status_category"] != "archived":
+        api_error("CONFLICT", "Archive the relationship before deleting it", 409)
+
+    await pool.fetchrow(QUERIES["relationships/delete"], relationship_id)
+    return success({"id": relationship_id})
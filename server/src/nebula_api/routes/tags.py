@@ -0,0 +1,288 @@
+"""Tag management API routes.
+
+Tags live in the tag arrays of entities, context items, and files, and in the
+tag registry that keeps unused tags listed until they are deleted. Anyone can
+list the tags on records they can see; renaming, merging, and deleting tags
+rewrites records across every scope and needs the admin scope.
+"""
+
+# Standard Library
+from pathlib import Path
+from typing import Any
+
+# Third-Party
+from fastapi import APIRouter, Depends, Request
+from pydantic import BaseModel, ConfigDict, Field
+
+# Local
+from nebula_api.auth import require_auth
+from nebula_api.response import api_error, success
+from nebula_mcp.enums import EnumRegistry
+from nebula_mcp.query_loader import QueryLoader
+
+QUERIES = QueryLoader(Path(__file__).resolve().parents[2] / "queries")
+
+router = APIRouter()
+ADMIN_SCOPE_NAMES = {"admin"}
+
+
+class TagRenameBody(BaseModel):
+    """Payload for renaming a tag.
+
+    Attributes:
+        from_: Current tag name, sent as "from".
+        to: New tag name.
+    """
+
+    model_config = ConfigDict(populate_by_name=True)
+
+    from_: str = Field(alias="from")
+    to: str
+
+
+class TagMergeBody(BaseModel):
+    """Payload for merging one tag into another."""
+
+    source: str
+    target: str
+
+
+class TagDeleteUnusedBody(BaseModel):
+    """Payload for deleting unused tags.
+
+    Attributes:
+        tags: Tags to delete when unused, or None for every unused tag.
+    """
+
+    tags: list[str] | None = None
+
+
+def _is_admin(auth: dict, enums: EnumRegistry) -> bool:
+    """Report whether the caller holds the admin scope.
+
+    Args:
+        auth: Auth context.
+        enums: Enum registry.
+
+    Returns:
+        True for admin callers.
+    """
+
+    scope_ids = set(auth.get("scopes", []))
+    allowed_ids = {
+        enums.scopes.name_to_id.get(name)
+        for name in ADMIN_SCOPE_NAMES
+        if enums.scopes.name_to_id.get(name)
+    }
+    return bool(scope_ids.intersection(allowed_ids))
+
+
+def _require_admin(auth: dict, enums: EnumRegistry) -> None:
+    """Reject callers without the admin scope.
+
+    Args:
+        auth: Auth context.
+        enums: Enum registry.
+    """
+
+    if not _is_admin(auth, enums):
+        api_error("FORBIDDEN", "Admin scope required", 403)
+
+
+def _clean_tag(value: str, label: str) -> str:
+    """Trim a tag name and reject blanks.
+
+    Args:
+        value: Raw tag name.
+        label: Field name for error messages.
+
+    Returns:
+        Trimmed tag name.
+    """
+
+    tag = str(value).strip()
+    if not tag:
+        api_error("INVALID_INPUT", f"{label} tag required", 400)
+    return tag
+
+
+async def _replace_tag(conn: Any, auth: dict, source: str, target: str) -> dict:
+    """Replace source with target on every tagged record.
+
+    Args:
+        conn: Connection inside a transaction.
+        auth: Auth context, recorded as the actor in the audit log.
+        source: Tag to replace.
+        target: Replacement tag.
+
+    Returns:
+        Counts of updated entities, context items, and files.
+    """
+
+    if auth["caller_type"] == "user":
+        await conn.execute(QUERIES["runtime/set_changed_by_type"], "entity")
+        await conn.execute(
+            QUERIES["runtime/set_changed_by_id"], str(auth["entity_id"])
+        )
+    else:
+        await conn.execute(QUERIES["runtime/set_changed_by_type"], "agent")
+        await conn.execute(
+            QUERIES["runtime/set_changed_by_id"], str(auth["agent_id"])
+        )
+    return {
+        "entities_updated": await conn.fetchval(
+            QUERIES["tags/replace_entity_tags"], source, target
+        ),
+        "knowledge_updated": await conn.fetchval(
+            QUERIES["tags/replace_context_tags"], source, target
+        ),
+        "files_updated": await conn.fetchval(
+            QUERIES["tags/replace_file_tags"], source, target
+        ),
+    }
+
+
+@router.get("")
+async def list_tags(
+    request: Request,
+    search: str | None = None,
+    limit: int = 500,
+    offset: int = 0,
+    auth: dict = Depends(require_auth),
+) -> dict[str, Any]:
+    """List tags with usage counts.
+
+    Admins see every registered tag, including unused ones. Other callers see
+    the tags used on entities and context items in their scopes.
+
+    Args:
+        request: FastAPI request.
+        search: Optional substring filter.
+        limit: Max rows.
+        offset: Row offset.
+        auth: Auth context.
+
+    Returns:
+        API response with tag usage rows.
+    """
+
+    pool = request.app.state.pool
+    enums = request.app.state.enums
+    scopes = None if _is_admin(auth, enums) else auth.get("scopes", [])
+    limit = max(1, min(limit, 1000))
+    offset = max(0, offset)
+    rows = await pool.fetch(
+        QUERIES["tags/list"],
+        search.strip() if search and search.strip() else None,
+        limit,
+        offset,
+        scopes,
+    )
+    return success([dict(r) for r in rows])
+
+
+@router.post("/rename")
+async def rename_tag(
+    payload: TagRenameBody,
+    request: Request,
+    auth: dict = Depends(require_auth),
+) -> dict[str, Any]:
+    """Rename a tag on every record that uses it.
+
+    Args:
+        payload: Rename payload with the current and new names.
+        request: FastAPI request.
+        auth: Auth context.
+
+    Returns:
+        API response with the update counts.
+    """
+
+    pool = request.app.state.pool
+    _require_admin(auth, request.app.state.enums)
+    source = _clean_tag(payload.from_, "from")
+    target = _clean_tag(payload.to, "to")
+    if source == target:
+        api_error("INVALID_INPUT", "Tag names must differ", 400)
+    if not await pool.fetchrow(QUERIES["tags/get"], source):
+        api_error("NOT_FOUND", f"Tag not found: {source}", 404)
+    if await pool.fetchrow(QUERIES["tags/get"], target):
+        api_error("CONFLICT", f"Tag already exists: {target}; merge instead", 409)
+
+    async with pool.acquire() as conn:
+        async with conn.transaction():
+            await conn.fetchrow(QUERIES["tags/rename"], source, target)
+            result = await _replace_tag(conn, auth, source, target)
+
+    return success(result)
+
+
+@router.post("/merge")
+async def merge_tags(
+    payload: TagMergeBody,
+    request: Request,
+    auth: dict = Depends(require_auth),
+) -> dict[str, Any]:
+    """Replace a tag with another everywhere and drop it.
+
+    Args:
+        payload: Merge payload with the source and target tags.
+        request: FastAPI request.
+        auth: Auth context.
+
+    Returns:
+        API response with the update counts.
+    """
+
+    pool = request.app.state.pool
+    _require_admin(auth, request.app.state.enums)
+    source = _clean_tag(payload.source, "source")
+    target = _clean_tag(payload.target, "target")
+    if source == target:
+        api_error("INVALID_INPUT", "Source and target must differ", 400)
+    for tag in (source, target):
+        if not await pool.fetchrow(QUERIES["tags/get"], tag):
+            api_error("NOT_FOUND", f"Tag not found: {tag}", 404)
+
+    async with pool.acquire() as conn:
+        async with conn.transaction():
+            result = await _replace_tag(conn, auth, source, target)
+            await conn.execute(QUERIES["tags/delete"], source)
+
+    return success(result)
+
+
+@router.post("/delete-unused")
+async def delete_unused_tags(
+    payload: TagDeleteUnusedBody,
+    request: Request,
+    auth: dict = Depends(require_auth),
+) -> dict[str, Any]:
+    """Delete tags that no record uses.
+
+    Args:
+        payload: Tags to delete, or none for every unused tag.
+        request: FastAPI request.
+        auth: Auth context.
+
+    Returns:
+        API response with the deleted tag names.
+    """
+
+    pool = request.app.state.pool
+    _require_admin(auth, request.app.state.enums)
+    tags = None
+    if payload.tags is not None:
+        tags = [t.strip() for t in payload.tags if t.strip()]
+        if not tags:
+            api_error("INVALID_INPUT", "No tags provided", 400)
+
+    rows = await pool.fetch(QUERIES["tags/delete_unused"], tags)
+    return success(
+        {
+            "entities_updated": 0,
+            "knowledge_updated": 0,
+            "files_updated": 0,
+            "deleted": sorted(r["name"] for r in rows),
+        }
+    )
@@ -37,7 +37,7 @@ KIND_MAP = {
         "update": "taxonomy/update_entity_type",
         "set_active": "taxonomy/set_entity_type_active",
         "usage": "taxonomy/count_entity_type_usage",
-        "supports": set(),
+        "supports": {"value_schema"},
     },
     "relationship-types": {
         "list": "taxonomy/list_relationship_types",
@@ -45,7 +45,7 @@ KIND_MAP = {
         "update": "taxonomy/update_relationship_type",
         "set_active": "taxonomy/set_relationship_type_active",
         "usage": "taxonomy/count_relationship_type_usage",
-        "supports": {"is_symmetric"},
+        "supports": {"is_symmetric", "rules"},
     },
     "log-types": {
         "list": "taxonomy/list_log_types",
@@ -192,6 +192,9 @@ class TaxonomyCreateBody(BaseModel):
     metadata: dict[str, Any] | None = None
     is_symmetric: bool | None = None
     value_schema: dict[str, Any] | None = None
+    inverse_name: str | None = None
+    source_entity_types: list[str] | None = None
+    target_entity_types: list[str] | None = None
 
 
 class TaxonomyUpdateBody(BaseModel):
@@ -202,6 +205,59 @@ class TaxonomyUpdateBody(BaseModel):
     metadata: dict[str, Any] | None = None
     is_symmetric: bool | None = None
     value_schema: dict[str, Any] | None = None
+    inverse_name: str | None = None
+    source_entity_types: list[str] | None = None
+    target_entity_types: list[str] | None = None
+
+
+class ScopeMergeBody(BaseModel):
+    """Payload for merging one privacy scope into another."""
+
+    target_id: str
+
+
+def _has_rules(payload: TaxonomyCreateBody | TaxonomyUpdateBody) -> bool:
+    """Report whether a payload sets relationship type rules.
+
+    Args:
+        payload: Create or update payload.
+
+    Returns:
+        True when any rule field is present.
+    """
+
+    return (
+        payload.inverse_name is not None
+        or payload.source_entity_types is not None
+        or payload.target_entity_types is not None
+    )
+
+
+def _clean_entity_types(
+    values: list[str] | None, enums: EnumRegistry, label: str
+) -> list[str] | None:
+    """Normalize an entity type allow-list and reject unknown names.
+
+    Args:
+        values: Entity type names, or None to leave unchanged.
+        enums: Enum registry with active entity types.
+        label: Field name for error messages.
+
+    Returns:
+        De-duplicated names in input order, or None.
+    """
+
+    if values is None:
+        return None
+    cleaned: list[str] = []
+    for value in values:
+        name = str(value).strip()
+        if not name or name in cleaned:
+            continue
+        if name not in enums.entity_types.name_to_id:
+            api_error("INVALID_INPUT", f"Unknown entity type in {label}: {name}", 400)
+        cleaned.append(name)
+    return cleaned
 
 
 def _validate_payload(
@@ -223,7 +279,17 @@ def _validate_payload(
             400,
         )
     if payload.value_schema is not None and "value_schema" not in supports:
-        api_error("INVALID_INPUT", "value_schema is only valid for log-types", 400)
+        api_error(
+            "INVALID_INPUT",
+            "value_schema is only valid for entity-types and log-types",
+            400,
+        )
+    if _has_rules(payload) and "rules" not in supports:
+        api_error(
+            "INVALID_INPUT",
+            "inverse_name and entity type rules are only valid for relationship-types",
+            400,
+        )
 
 
 @router.get("/{kind}")
@@ -274,13 +340,21 @@ async def create_taxonomy(
         api_error("INVALID_INPUT", "Name required", 400)
 
     try:
-        if kind in {"scopes", "entity-types"}:
+        if kind == "scopes":
             row = await pool.fetchrow(
                 QUERIES[cfg["create"]],
                 name,
                 payload.description,
                 json.dumps(payload.metadata or {}),
             )
+        elif kind == "entity-types":
+            row = await pool.fetchrow(
+                QUERIES[cfg["create"]],
+                name,
+                payload.description,
+                json.dumps(payload.metadata or {}),
+                json.dumps(payload.value_schema) if payload.value_schema else None,
+            )
         elif kind == "relationship-types":
             row = await pool.fetchrow(
                 QUERIES[cfg["create"]],
@@ -288,6 +362,13 @@ async def create_taxonomy(
                 payload.description,
                 payload.is_symmetric,
                 json.dumps(payload.metadata or {}),
+                (payload.inverse_name or "").strip(),
+                _clean_entity_types(
+                    payload.source_entity_types, enums, "source_entity_types"
+                ),
+                _clean_entity_types(
+                    payload.target_entity_types, enums, "target_entity_types"
+                ),
             )
         else:
             row = await pool.fetchrow(
@@ -330,7 +411,7 @@ async def update_taxonomy(
         api_error("CONFLICT", "Built-in taxonomy names are immutable", 409)
 
     try:
-        if kind in {"scopes", "entity-types"}:
+        if kind == "scopes":
             row = await pool.fetchrow(
                 QUERIES[cfg["update"]],
                 item_id,
@@ -338,6 +419,19 @@ async def update_taxonomy(
                 payload.description,
                 json.dumps(payload.metadata) if payload.metadata is not None else None,
             )
+        elif kind == "entity-types":
+            row = await pool.fetchrow(
+                QUERIES[cfg["update"]],
+                item_id,
+                name,
+                payload.description,
+                json.dumps(payload.metadata) if payload.metadata is not None else None,
+                (
+                    json.dumps(payload.value_schema)
+                    if payload.value_schema is not None
+                    else None
+                ),
+            )
         elif kind == "relationship-types":
             row = await pool.fetchrow(
                 QUERIES[cfg["update"]],
@@ -346,6 +440,17 @@ async def update_taxonomy(
                 payload.description,
                 payload.is_symmetric,
                 json.dumps(payload.metadata) if payload.metadata is not None else None,
+                (
+                    payload.inverse_name.strip()
+                    if payload.inverse_name is not None
+                    else None
+                ),
+                _clean_entity_types(
+                    payload.source_entity_types, enums, "source_entity_types"
+                ),
+                _clean_entity_types(
+                    payload.target_entity_types, enums, "target_entity_types"
+                ),
             )
         else:
             row = await pool.fetchrow(
@@ -418,3 +523,83 @@ async def activate_taxonomy(
 
     await _refresh_enums(request)
     return success(dict(row))
+
+
+@router.post("/scopes/{scope_id}/merge")
+async def merge_scope(
+    scope_id: str,
+    payload: ScopeMergeBody,
+    request: Request,
+    auth: dict = Depends(require_auth),
+) -> dict[str, Any]:
+    """Merge a privacy scope into another and archive it.
+
+    Entities and context items tagged with the source scope are moved to the
+    target, the source is archived, and the merge is written to the audit log.
+
+    Args:
+        scope_id: Source scope id.
+        payload: Merge payload with the target scope id.
+        request: FastAPI request.
+        auth: Auth context.
+
+    Returns:
+        API response with the merge counts and audit entry id.
+    """
+
+    pool = request.app.state.pool
+    enums = request.app.state.enums
+    _require_admin_scope(auth, enums)
+    _require_uuid(scope_id, "scopes")
+    _require_uuid(payload.target_id, "scopes")
+    if str(UUID(scope_id)) == str(UUID(payload.target_id)):
+        api_error("INVALID_INPUT", "Cannot merge a scope into itself", 400)
+
+    source = await _fetch_taxonomy_row(pool, "scopes", scope_id)
+    if source is None:
+        api_error("NOT_FOUND", "scopes entry not found", 404)
+    target = await _fetch_taxonomy_row(pool, "scopes", payload.target_id)
+    if target is None:
+        api_error("NOT_FOUND", "Target scope not found", 404)
+    if source["is_builtin"]:
+        api_error("CONFLICT", "Built-in scopes cannot be merged away", 409)
+    if not source["is_active"] or not target["is_active"]:
+        api_error("CONFLICT", "Both scopes must be active to merge", 409)
+
+    if auth["caller_type"] == "user":
+        actor_type, actor_id = "entity", str(auth["entity_id"])
+    else:
+        actor_type, actor_id = "agent", str(auth["agent_id"])
+
+    async with pool.acquire() as conn:
+        async with conn.transaction():
+            await conn.execute(QUERIES["runtime/set_changed_by_type"], actor_type)
+            await conn.execute(QUERIES["runtime/set_changed_by_id"], actor_id)
+            entities_updated = await conn.fetchval(
+                QUERIES["taxonomy/merge_scope_entities"], scope_id, payload.target_id
+            )
+            knowledge_updated = await conn.fetchval(
+                QUERIES["taxonomy/merge_scope_context"], scope_id, payload.target_id
+            )
+            await conn.fetchrow(QUERIES["taxonomy/set_scope_active"], scope_id, False)
+            audit_id = await conn.fetchval(
+                QUERIES["taxonomy/insert_scope_merge_audit"],
+                scope_id,
+                payload.target_id,
+                actor_type,
+                actor_id,
+                entities_updated,
+                knowledge_updated,
+                f"merged {source['name']} into {target['name']}",
+            )
+
+    await _refresh_enums(request)
+    return success(
+        {
+            "source_id": str(source["id"]),
+            "target_id": str(target["id"]),
+            "entities_updated": entities_updated,
+            "knowledge_updated": knowledge_updated,
+            "audit_id": str(audit_id),
+        }
+    )
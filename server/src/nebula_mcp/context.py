@@ -3,6 +3,7 @@
 # Standard Library
 import json
 import os
+from datetime import datetime, timezone
 from pathlib import Path
 from typing import Any
 
@@ -11,6 +12,8 @@ from asyncpg import Pool
 from mcp.server.fastmcp import Context
 
 # Local
+from nebula_api.rate_limit import allow_request
+
 from .db import get_agent
 from .enums import EnumRegistry
 from .query_loader import QueryLoader
@@ -24,9 +27,55 @@ AgentDict = dict[str, Any]
 LOCAL_INSECURE_MODE_ENV = "NEBULA_MCP_LOCAL_INSECURE"
 LOCAL_INSECURE_AGENT_ENV = "NEBULA_MCP_LOCAL_AGENT_NAME"
 LOCAL_INSECURE_DEFAULT_AGENT = "local-dev-agent"
+APPROVAL_PRIORITY_ENV = "NEBULA_APPROVAL_PRIORITY"
 LOCAL_INSECURE_SCOPE_ORDER = ("public", "private", "sensitive", "admin")
 
 
+class AgentPermissionError(ValueError):
+    """Raised when an agent's permission rules refuse a request.
+
+    Attributes:
+        code: PERMISSIONS_EXPIRED, RATE_LIMITED, or FORBIDDEN.
+    """
+
+    def __init__(self, code: str, message: str) -> None:
+        super().__init__(message)
+        self.code = code
+
+
+def enforce_agent_permissions(
+    agent: AgentDict,
+    *,
+    action: str | None = None,
+    count_request: bool = False,
+) -> None:
+    """Apply an agent's permission expiry, rate limit, and request types.
+
+    The REST and MCP auth paths both call this. Expiry is always checked.
+    count_request records one request against rate_limit_per_minute and is
+    set once per incoming request. action is checked against
+    allowed_request_types when the agent has an allowlist.
+
+    Args:
+        agent: Agent row.
+        action: Request type about to run, e.g. create_entity.
+        count_request: Whether to count this call against the rate limit.
+
+    Raises:
+        AgentPermissionError: If the agent may not make the request.
+    """
+
+    expires_at = agent.get("permissions_expire_at")
+    if expires_at and expires_at <= datetime.now(timezone.utc):
+        raise AgentPermissionError("PERMISSIONS_EXPIRED", "Agent permissions expired")
+    limit = agent.get("rate_limit_per_minute") or 0
+    if count_request and limit and not allow_request(f"agent:{agent['id']}", limit):
+        raise AgentPermissionError("RATE_LIMITED", f"Max {limit} requests per minute")
+    allowed = agent.get("allowed_request_types") or []
+    if action is not None and allowed and action not in allowed:
+        raise AgentPermissionError("FORBIDDEN", f"Agent is not allowed to {action}")
+
+
 def enrollment_required_error() -> ValueError:
     """Build a structured error for unauthenticated bootstrap callers."""
 
@@ -80,6 +129,7 @@ async def require_context(
             raise ValueError("Agent not found or inactive")
         agent = dict(refreshed)
         lifespan_ctx["agent"] = agent
+        enforce_agent_permissions(agent, count_request=True)
 
     if agent is None and not allow_bootstrap:
         raise enrollment_required_error()
@@ -127,6 +177,7 @@ async def require_agent(pool: Pool, agent_name: str) -> AgentDict:
     if not agent:
         raise ValueError("Agent not found or inactive")
 
+    enforce_agent_permissions(agent)
     return agent
 
 
@@ -139,7 +190,9 @@ async def maybe_require_approval(
     """Return approval response if agent requires it, else None.
 
     Checks agent trust level and routes to approval workflow if needed.
-    Trusted agents return None to proceed with direct execution.
+    Trusted agents return None to proceed with direct execution. Requests
+    take their priority from NEBULA_APPROVAL_PRIORITY (low, normal, high).
+    Agents limited to a set of request types are refused any other action.
 
     Args:
         pool: Database connection pool.
@@ -149,11 +202,15 @@ async def maybe_require_approval(
 
     Returns:
         Approval response if untrusted, None if trusted.
+
+    Raises:
+        AgentPermissionError: If the agent may not run action.
     """
 
     # Import here to avoid circular dependency
     from .helpers import create_approval_request, ensure_approval_capacity
 
+    enforce_agent_permissions(agent, action=action)
     if not agent.get("requires_approval", True):
         return None
 
@@ -165,6 +222,7 @@ async def maybe_require_approval(
         action,
         payload_dict,
         None,
+        priority=os.environ.get(APPROVAL_PRIORITY_ENV),
     )
 
     return {
@@ -230,7 +288,9 @@ async def authenticate_agent_with_key(
     if not agent:
         raise ValueError(f"Agent not found or inactive for {key_name}")
 
-    return dict(agent)
+    agent = dict(agent)
+    enforce_agent_permissions(agent)
+    return agent
 
 
 def _env_truthy(name: str) -> bool:
@@ -5,6 +5,7 @@ import hashlib
 import json
 from datetime import datetime, timezone
 from pathlib import Path
+from typing import TYPE_CHECKING, Awaitable, Callable
 from uuid import UUID
 
 # Third-Party
@@ -13,6 +14,7 @@ from asyncpg import Pool, UniqueViolationError
 # Local
 from .enums import (
     EnumRegistry,
+    require_entity_type,
     require_relationship_type,
     require_scopes,
     require_status,
@@ -20,8 +22,16 @@ from .enums import (
 from .helpers import normalize_bulk_operation
 from .query_loader import QueryLoader
 
+if TYPE_CHECKING:
+    from .models import CreateRelationshipInput
+
 QUERIES = QueryLoader(Path(__file__).resolve().parents[1] / "queries")
 
+
+class UpdateConflictError(ValueError):
+    """Raised when a record changed since the caller last read it."""
+
+
 CYCLE_SENSITIVE_REL_TYPES = {
     "owns",
     "manages",
@@ -302,14 +312,25 @@ async def execute_update_context(
 
     Returns:
         Updated context row as dict.
+
+    Raises:
+        ValueError: If the context item is not found.
+        UpdateConflictError: If the item changed since expected_updated_at.
     """
 
-    from .models import UpdateContextInput, validate_metadata_payload
+    from .models import (
+        UpdateContextInput,
+        parse_optional_datetime,
+        validate_metadata_payload,
+    )
 
     if isinstance(change_details, str):
         change_details = json.loads(change_details)
 
     payload = UpdateContextInput(**change_details)
+    expected_updated_at = parse_optional_datetime(
+        payload.expected_updated_at, "expected_updated_at"
+    )
 
     status_id = None
     if payload.status:
@@ -343,13 +364,55 @@ async def execute_update_context(
         payload.tags,
         scope_ids,
         json.dumps(metadata) if metadata is not None else None,
+        expected_updated_at,
     )
 
     if not row:
+        if expected_updated_at and await pool.fetchrow(
+            QUERIES["context/get"], payload.context_id, None
+        ):
+            raise UpdateConflictError("Context changed since it was read")
         raise ValueError("Context not found")
     return dict(row)
 
 
+async def _check_relationship_type_rules(
+    pool: Pool, enums: EnumRegistry, payload: "CreateRelationshipInput", type_id: UUID
+) -> None:
+    """Reject entity endpoints outside the relationship type allow-lists.
+
+    Args:
+        pool: Database connection pool.
+        enums: Enum registry for entity type names.
+        payload: Relationship create payload.
+        type_id: Relationship type id.
+
+    Raises:
+        ValueError: If an entity endpoint has a disallowed type.
+    """
+
+    rows = await pool.fetch(QUERIES["relationships/type_rules"], type_id)
+    if not rows:
+        return
+    rules = rows[0]
+    sides = (
+        ("source", payload.source_type, payload.source_id, rules["source_entity_types"]),
+        ("target", payload.target_type, payload.target_id, rules["target_entity_types"]),
+    )
+    for side, node_type, node_id, allowed in sides:
+        if node_type != "entity" or not allowed:
+            continue
+        entity = await pool.fetchrow(QUERIES["entities/get_type_and_metadata"], node_id)
+        if not entity:
+            raise ValueError(f"Relationship {side} entity not found")
+        entity_type = enums.entity_types.id_to_name.get(entity["type_id"], "")
+        if entity_type not in allowed:
+            raise ValueError(
+                f"{payload.relationship_type} does not allow {entity_type or 'unknown'} "
+                f"as {side} (allowed: {', '.join(allowed)})"
+            )
+
+
 async def execute_create_relationship(
     pool: Pool, enums: EnumRegistry, change_details: dict
 ) -> dict:
@@ -378,6 +441,7 @@ async def execute_create_relationship(
         and payload.source_id == payload.target_id
     ):
         raise ValueError("Self-referential relationships are not allowed")
+    await _check_relationship_type_rules(pool, enums, payload, type_id)
     if payload.relationship_type in CYCLE_SENSITIVE_REL_TYPES:
         from .models import MAX_GRAPH_HOPS
 
@@ -476,6 +540,7 @@ async def execute_update_job(
         change_details = json.loads(change_details)
 
     due_at_supplied = "due_at" in change_details
+    assignee_supplied = "assigned_to" in change_details
     payload = UpdateJobInput(**change_details)
 
     status_id = None
@@ -494,6 +559,7 @@ async def execute_update_job(
         payload.assigned_to,
         due_at,
         due_at_supplied,
+        assignee_supplied,
     )
 
     if not row:
@@ -785,19 +851,30 @@ async def execute_update_entity(
 
     Raises:
         ValueError: If entity not found.
+        UpdateConflictError: If the entity changed since expected_updated_at.
     """
 
-    from .models import UpdateEntityInput, validate_entity_metadata
+    from .models import (
+        UpdateEntityInput,
+        parse_optional_datetime,
+        validate_entity_metadata,
+    )
 
     if isinstance(change_details, str):
         change_details = json.loads(change_details)
 
     payload = UpdateEntityInput(**change_details)
+    expected_updated_at = parse_optional_datetime(
+        payload.expected_updated_at, "expected_updated_at"
+    )
 
     # Validate status if provided
     status_id = None
     if payload.status:
         status_id = require_status(payload.status, enums)
+    type_id = None
+    if payload.type:
+        type_id = require_entity_type(payload.type, enums)
 
     # Validate metadata if provided.
     metadata = None
@@ -808,7 +885,7 @@ async def execute_update_entity(
         if not entity:
             raise ValueError("Entity not found")
 
-        type_name = enums.entity_types.id_to_name[entity["type_id"]]
+        type_name = payload.type or enums.entity_types.id_to_name[entity["type_id"]]
         existing_metadata = _decode_json_object(entity.get("metadata"))
         merged_metadata = _deep_merge_dict(existing_metadata, payload.metadata)
         metadata = validate_entity_metadata(type_name, merged_metadata)
@@ -820,8 +897,15 @@ async def execute_update_entity(
         payload.tags,
         status_id,
         payload.status_reason,
+        type_id,
+        expected_updated_at,
     )
 
+    if not row and expected_updated_at:
+        if await pool.fetchrow(
+            QUERIES["entities/get_type_and_metadata"], payload.entity_id
+        ):
+            raise UpdateConflictError("Entity changed since it was read")
     return _normalize_entity_row(dict(row) if row else {})
 
 
@@ -975,12 +1059,128 @@ async def execute_register_agent(
     }
 
 
+async def _execute_import_update(
+    pool: Pool, enums: EnumRegistry, resource: str, record_id: str, normalized: dict
+) -> dict:
+    """Apply an import row to the record its external ID maps to.
+
+    Only fields the update executors accept change: entity names and scopes,
+    relationship endpoints and types, and job assignments to agents keep
+    their stored values.
+
+    Args:
+        pool: Database connection pool.
+        enums: Enum registry for validation.
+        resource: Import resource name.
+        record_id: ID of the mapped record.
+        normalized: Normalized import row.
+
+    Returns:
+        Updated row as dict.
+    """
+
+    if resource == "entities":
+        return await execute_update_entity(
+            pool,
+            enums,
+            {
+                "entity_id": record_id,
+                "metadata": normalized.get("metadata"),
+                "tags": normalized.get("tags"),
+                "status": normalized.get("status"),
+                "type": normalized.get("type"),
+            },
+        )
+    if resource == "context":
+        return await execute_update_context(
+            pool,
+            enums,
+            {
+                "context_id": record_id,
+                "title": normalized.get("title"),
+                "url": normalized.get("url"),
+                "source_type": normalized.get("source_type"),
+                "content": normalized.get("content"),
+                "tags": normalized.get("tags"),
+                "scopes": normalized.get("scopes"),
+                "metadata": normalized.get("metadata"),
+            },
+        )
+    if resource == "relationships":
+        return await execute_update_relationship(
+            pool,
+            enums,
+            {
+                "relationship_id": record_id,
+                "properties": normalized.get("properties"),
+            },
+        )
+    details = {
+        "job_id": record_id,
+        "title": normalized.get("title"),
+        "description": normalized.get("description"),
+        "priority": normalized.get("priority"),
+        "assigned_to": normalized.get("assigned_to"),
+        "metadata": normalized.get("metadata"),
+    }
+    if normalized.get("due_at"):
+        details["due_at"] = normalized["due_at"]
+    return await execute_update_job(pool, enums, details)
+
+
+async def execute_import_row(
+    pool: Pool,
+    enums: EnumRegistry,
+    resource: str,
+    change_details: dict,
+    create: Callable[..., Awaitable[dict]],
+) -> tuple[dict, bool]:
+    """Import one normalized row, keyed by its external ID when it has one.
+
+    A row whose external ID was imported before updates that record instead
+    of creating a duplicate. A new record is mapped to the row's external ID.
+
+    Args:
+        pool: Database connection pool.
+        enums: Enum registry for validation.
+        resource: Import resource name.
+        change_details: Normalized row, with an optional external_id.
+        create: Executor that creates the resource.
+
+    Returns:
+        The written row and whether it updated an existing record.
+    """
+
+    if isinstance(change_details, str):
+        change_details = json.loads(change_details)
+    details = dict(change_details)
+    external_id = details.pop("external_id", None)
+    if external_id:
+        record_id = await pool.fetchval(
+            QUERIES["imports/external_id_get"], resource, external_id
+        )
+        if record_id:
+            row = await _execute_import_update(
+                pool, enums, resource, record_id, details
+            )
+            return row, True
+    row = await create(pool, enums, details)
+    if external_id and row.get("id"):
+        await pool.execute(
+            QUERIES["imports/external_id_upsert"], resource, external_id, str(row["id"])
+        )
+    return row, False
+
+
 async def execute_bulk_import_entities(
     pool: Pool, enums: EnumRegistry, change_details: dict
 ) -> dict:
     """Execute a single entity import row created via bulk import approvals."""
 
-    return await execute_create_entity(pool, enums, change_details)
+    row, _ = await execute_import_row(
+        pool, enums, "entities", change_details, execute_create_entity
+    )
+    return row
 
 
 async def execute_bulk_import_context(
@@ -988,7 +1188,10 @@ async def execute_bulk_import_context(
 ) -> dict:
     """Execute a single context import row created via bulk import approvals."""
 
-    return await execute_create_context(pool, enums, change_details)
+    row, _ = await execute_import_row(
+        pool, enums, "context", change_details, execute_create_context
+    )
+    return row
 
 
 async def execute_bulk_import_relationships(
@@ -996,7 +1199,10 @@ async def execute_bulk_import_relationships(
 ) -> dict:
     """Execute a single relationship import row created via bulk import approvals."""
 
-    return await execute_create_relationship(pool, enums, change_details)
+    row, _ = await execute_import_row(
+        pool, enums, "relationships", change_details, execute_create_relationship
+    )
+    return row
 
 
 async def execute_bulk_import_jobs(
@@ -1004,7 +1210,10 @@ async def execute_bulk_import_jobs(
 ) -> dict:
     """Execute a single job import row created via bulk import approvals."""
 
-    return await execute_create_job(pool, enums, change_details)
+    row, _ = await execute_import_row(
+        pool, enums, "jobs", change_details, execute_create_job
+    )
+    return row
 
 
 async def execute_revert_entity(
@@ -1022,7 +1231,8 @@ async def execute_revert_entity(
     if not entity_id or not audit_id:
         raise ValueError("entity_id and audit_id are required for revert_entity")
 
-    return await do_revert_entity(pool, entity_id, audit_id)
+    fields = change_details.get("fields")
+    return await do_revert_entity(pool, entity_id, audit_id, fields)
 
 
 # --- Executor Registry ---
@@ -19,6 +19,7 @@ from asyncpg import Connection, Pool
 # Local
 from .enums import EnumRegistry, require_scopes
 from .query_loader import QueryLoader
+from .schema import APPROVAL_PRIORITY_VALUES
 
 QUERIES = QueryLoader(Path(__file__).resolve().parents[1] / "queries")
 ph = PasswordHasher()
@@ -50,6 +51,19 @@ def enforce_scope_subset(requested: list[str], allowed: list[str]) -> list[str]:
     return list(requested)
 
 
+def narrow_scope_ids(scope_ids: list, requested: str | None, enums: EnumRegistry) -> list:
+    """Keep the caller scope ids named in a comma-separated scope filter.
+
+    Scopes the caller does not hold are dropped, so filtering by them matches
+    nothing instead of widening visibility.
+    """
+
+    names = {n.strip() for n in (requested or "").split(",") if n.strip()}
+    if not names:
+        return scope_ids
+    return [s for s in scope_ids or [] if enums.scopes.id_to_name.get(s) in names]
+
+
 # --- Privacy Filtering ---
 
 
@@ -153,6 +167,7 @@ def _pending_approval_limit() -> int:
 
 
 MAX_PENDING_APPROVALS = _pending_approval_limit()
+DEFAULT_APPROVAL_PRIORITY = "normal"
 ENROLLMENT_TTL_HOURS = 24
 ENROLLMENT_WAIT_POLL_SECONDS = 1
 
@@ -226,6 +241,22 @@ async def ensure_approval_capacity(
         raise ValueError("Approval queue limit reached")
 
 
+def normalize_approval_priority(value: str | None) -> str:
+    """Return a known approval priority, falling back to normal.
+
+    Args:
+        value: Priority requested by the agent, if any.
+
+    Returns:
+        One of APPROVAL_PRIORITY_VALUES.
+    """
+
+    cleaned = (value or "").strip().lower()
+    if cleaned in APPROVAL_PRIORITY_VALUES:
+        return cleaned
+    return DEFAULT_APPROVAL_PRIORITY
+
+
 async def create_approval_request(
     pool: Pool,
     agent_id: str,
@@ -233,6 +264,7 @@ async def create_approval_request(
     change_details: dict,
     job_id: str | None = None,
     conn: Connection | None = None,
+    priority: str | None = None,
 ) -> dict:
     """Create an approval request for untrusted agent actions.
 
@@ -242,6 +274,7 @@ async def create_approval_request(
         request_type: Action type (e.g., create_entity).
         change_details: Full payload of requested change.
         job_id: Optional related job ID.
+        priority: Optional urgency (low, normal, high); defaults to normal.
 
     Returns:
         Created approval request row as dict.
@@ -268,6 +301,7 @@ async def create_approval_request(
                 else change_details
             ),
             job_id,
+            normalize_approval_priority(priority),
         )
         return dict(row) if row else {}
 
@@ -453,7 +487,12 @@ async def redeem_enrollment_key(
 
 
 async def get_pending_approvals_all(
-    pool: Pool, limit: int = 200, offset: int = 0
+    pool: Pool,
+    limit: int = 200,
+    offset: int = 0,
+    since: datetime | None = None,
+    until: datetime | None = None,
+    delegated_to: str | None = None,
 ) -> list[dict]:
     """Get all pending approval requests for admin review.
 
@@ -461,12 +500,17 @@ async def get_pending_approvals_all(
         pool: Database connection pool.
         limit: Max rows to return.
         offset: Pagination offset.
+        since: Only requests created at or after this time.
+        until: Only requests created before this time.
+        delegated_to: Only requests delegated to this entity id.
 
     Returns:
         List of pending approval request dicts.
     """
 
-    rows = await pool.fetch(QUERIES["approvals/get_pending"], limit, offset)
+    rows = await pool.fetch(
+        QUERIES["approvals/get_pending"], limit, offset, since, until, delegated_to
+    )
     return await _enrich_approval_rows(pool, [dict(r) for r in rows])
 
 
@@ -1010,21 +1054,68 @@ async def get_entity_history(
     return [dict(r) for r in rows]
 
 
-async def revert_entity(pool: Pool, entity_id: str, audit_id: str) -> dict:
+async def get_context_history(
+    pool: Pool, context_id: str, limit: int = 50, offset: int = 0
+) -> list[dict]:
+    """List audit history entries for a single context item.
+
+    Args:
+        pool: Database connection pool.
+        context_id: Context item UUID.
+        limit: Max rows to return.
+        offset: Pagination offset.
+
+    Returns:
+        List of audit entries as dicts.
+    """
+
+    rows = await pool.fetch(QUERIES["audit/context_history"], context_id, limit, offset)
+    return [dict(r) for r in rows]
+
+
+REVERTABLE_ENTITY_FIELDS = (
+    "privacy_scope_ids",
+    "name",
+    "type_id",
+    "status_id",
+    "status_changed_at",
+    "status_reason",
+    "tags",
+    "metadata",
+    "source_path",
+)
+
+
+async def revert_entity(
+    pool: Pool,
+    entity_id: str,
+    audit_id: str,
+    fields: list[str] | None = None,
+) -> dict:
     """Revert an entity to a historical audit snapshot.
 
     Args:
         pool: Database connection pool.
         entity_id: Entity UUID to revert.
         audit_id: Audit log entry to restore.
+        fields: Columns to restore; None restores every column.
 
     Returns:
         Updated entity row as dict.
 
     Raises:
-        ValueError: If audit entry is missing or mismatched.
+        ValueError: If audit entry is missing or mismatched, or a field
+            cannot be reverted.
     """
 
+    if fields is not None:
+        fields = sorted({str(f).strip() for f in fields if str(f).strip()})
+        if not fields:
+            raise ValueError("No fields selected to revert")
+        unknown = [f for f in fields if f not in REVERTABLE_ENTITY_FIELDS]
+        if unknown:
+            raise ValueError(f"Cannot revert fields: {', '.join(unknown)}")
+
     audit_row = await pool.fetchrow(QUERIES["audit/get"], audit_id)
     if not audit_row:
         raise ValueError("Audit entry not found")
@@ -1061,6 +1152,7 @@ async def revert_entity(pool: Pool, entity_id: str, audit_id: str) -> dict:
         snapshot.get("tags") or [],
         metadata_json,
         snapshot.get("source_path"),
+        fields,
     )
     return dict(row) if row else {}
 
@@ -1144,8 +1236,12 @@ async def query_audit_log(
     scope_id: str | None = None,
     limit: int = 50,
     offset: int = 0,
+    since: datetime | None = None,
+    until: datetime | None = None,
+    before_changed_at: datetime | None = None,
+    before_id: str | None = None,
 ) -> list[dict]:
-    """List audit log entries with optional filters.
+    """List audit log entries with optional filters, newest first.
 
     Args:
         pool: Database connection pool.
@@ -1156,6 +1252,11 @@ async def query_audit_log(
         record_id: Record id filter.
         limit: Max rows to return.
         offset: Pagination offset.
+        since: Only entries changed at or after this time.
+        until: Only entries changed before this time.
+        before_changed_at: Keyset cursor; only entries older than this
+            changed_at and before_id pair.
+        before_id: Id of the last entry seen, paired with before_changed_at.
 
     Returns:
         List of audit entries as dicts.
@@ -1172,10 +1273,82 @@ async def query_audit_log(
         scope_id,
         limit,
         offset,
+        since,
+        until,
+        before_changed_at,
+        before_id,
     )
     return [dict(r) for r in rows]
 
 
+async def audit_stats(
+    pool: Pool,
+    table_name: str | None = None,
+    action: str | None = None,
+    actor_type: str | None = None,
+    actor_id: str | None = None,
+    since: datetime | None = None,
+    until: datetime | None = None,
+    top: int = 10,
+) -> dict:
+    """Aggregate audit activity per day and by actor, table, and record.
+
+    Args:
+        pool: Database connection pool.
+        table_name: Table name filter.
+        action: Action filter (insert, update, delete).
+        actor_type: Actor type filter (agent, entity, system).
+        actor_id: Actor UUID filter.
+        since: Only entries changed at or after this time.
+        until: Only entries changed before this time.
+        top: Max rows in each ranking.
+
+    Returns:
+        Dict with total, daily counts, and top actors, tables, and records.
+    """
+
+    args = (table_name, action, actor_type, actor_id, since, until)
+    daily = [dict(r) for r in await pool.fetch(QUERIES["audit/stats_daily"], *args)]
+    actors = [
+        dict(r) for r in await pool.fetch(QUERIES["audit/stats_actors"], *args, top)
+    ]
+    tables = [
+        dict(r) for r in await pool.fetch(QUERIES["audit/stats_tables"], *args, top)
+    ]
+    records = [
+        dict(r) for r in await pool.fetch(QUERIES["audit/stats_records"], *args, top)
+    ]
+    for item in actors:
+        changed_by_type = str(item.get("changed_by_type") or "").strip().lower()
+        if changed_by_type in {"", "unknown", "none", "null"}:
+            item["changed_by_type"] = "system"
+            item["changed_by_id"] = ""
+    return {
+        "total": sum(int(r["action_count"]) for r in daily),
+        "daily": daily,
+        "top_actors": actors,
+        "top_tables": tables,
+        "top_records": records,
+    }
+
+
+async def get_audit_chain_entry(pool: Pool, entry_id: str | None = None) -> dict | None:
+    """Return the chain hash of an audit entry, or of the chain head.
+
+    Args:
+        pool: Database connection pool.
+        entry_id: Audit entry id; None selects the newest chained entry.
+
+    Returns:
+        Dict with entry_id and hash, or None when nothing is chained yet.
+    """
+
+    row = await pool.fetchrow(QUERIES["audit/chain_entry"], entry_id)
+    if not row or not row["hash"]:
+        return None
+    return {"entry_id": str(row["entry_id"]), "hash": row["hash"]}
+
+
 async def list_audit_scopes(pool: Pool) -> list[dict]:
     """List privacy scopes with usage counts."""
 
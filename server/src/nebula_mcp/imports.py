@@ -86,14 +86,90 @@ def parse_csv_rows(data: str) -> list[dict[str, Any]]:
     return rows
 
 
+def parse_json_items(data: str) -> list[dict[str, Any]]:
+    """Parse a JSON document into import items.
+
+    Args:
+        data: A JSON array of objects, or an object with an "items" array.
+
+    Returns:
+        The object items of the document.
+
+    Raises:
+        ValueError: If the document is not valid JSON.
+    """
+
+    try:
+        parsed = json.loads(data)
+    except json.JSONDecodeError as exc:
+        raise ValueError("Invalid JSON data") from exc
+    if isinstance(parsed, dict):
+        parsed = parsed.get("items") or []
+    if not isinstance(parsed, list):
+        return []
+    return [item for item in parsed if isinstance(item, dict)]
+
+
+def parse_markdown_items(data: str) -> list[dict[str, Any]]:
+    """Parse a markdown document into context items.
+
+    Each top-level "# " heading starts an item titled by the heading, with
+    the text below it as content. A leading front matter block of
+    "key: value" lines between "---" fences applies to every item, so it can
+    carry tags, scopes, url, or source_type. Without any heading, the whole
+    document is one item titled by its front matter title.
+
+    Args:
+        data: Markdown content.
+
+    Returns:
+        A list of context item dictionaries.
+    """
+
+    lines = data.splitlines()
+    shared: dict[str, Any] = {"source_type": "note"}
+    if lines and lines[0].strip() == "---":
+        for end in range(1, len(lines)):
+            if lines[end].strip() == "---":
+                for line in lines[1:end]:
+                    key, sep, value = line.partition(":")
+                    if sep and key.strip():
+                        shared[key.strip().lower()] = value.strip()
+                lines = lines[end + 1 :]
+                break
+
+    items: list[dict[str, Any]] = []
+    title: str | None = None
+    body: list[str] = []
+
+    def flush() -> None:
+        """Close the item being collected, if it has a title or content."""
+
+        content = "\n".join(body).strip()
+        item_title = title if title is not None else shared.get("title")
+        if item_title or content:
+            items.append({**shared, "title": item_title, "content": content or None})
+
+    for line in lines:
+        if line.startswith("# "):
+            if title is not None or "\n".join(body).strip():
+                flush()
+            title = line[2:].strip()
+            body = []
+            continue
+        body.append(line)
+    flush()
+    return items
+
+
 def extract_items(
     fmt: str, data: str | None, items: list[dict[str, Any]] | None
 ) -> list[dict[str, Any]]:
-    """Extract items from JSON payload or CSV data.
+    """Extract items from JSON payload, CSV data, or markdown data.
 
     Args:
-        fmt: Input format, either "json" or "csv".
-        data: CSV content when format is csv.
+        fmt: Input format, "json", "csv", or "markdown".
+        data: Raw file content. JSON imports may send it instead of items.
         items: JSON items when format is json.
 
     Returns:
@@ -104,8 +180,8 @@ def extract_items(
     """
 
     fmt = (fmt or "json").lower()
-    if fmt not in {"json", "csv"}:
-        raise ValueError("Format must be json or csv")
+    if fmt not in {"json", "csv", "markdown"}:
+        raise ValueError("Format must be json, csv, or markdown")
     if fmt == "csv":
         if not data:
             raise ValueError("CSV data is required")
@@ -113,6 +189,13 @@ def extract_items(
         if not rows:
             raise ValueError("CSV data is empty")
         return rows
+    if fmt == "markdown":
+        rows = parse_markdown_items(data or "")
+        if not rows:
+            raise ValueError("Markdown data is empty")
+        return rows
+    if not items and data:
+        items = parse_json_items(data)
     if not items:
         raise ValueError("Items are required for JSON import")
     return items
@@ -138,6 +221,21 @@ def merge_defaults(
     return merged
 
 
+def import_external_id(item: dict[str, Any]) -> str | None:
+    """Read the external ID an import row is keyed by.
+
+    Defaults never supply one, since every row needs its own.
+
+    Args:
+        item: Raw item dictionary.
+
+    Returns:
+        The trimmed external ID, or None when the row has none.
+    """
+
+    return coerce_text(item.get("external_id"))
+
+
 def normalize_entity(item: dict[str, Any], defaults: dict[str, Any] | None) -> dict:
     """Normalize an entity payload for bulk import.
 
@@ -705,6 +705,11 @@ class UpdateEntityInput(BaseModel):
     status_reason: str | None = Field(
         default=None, description="Reason for status change"
     )
+    type: str | None = Field(default=None, description="New entity type name")
+    expected_updated_at: str | None = Field(
+        default=None,
+        description="Reject the update if the entity changed since this time",
+    )
 
     @field_validator("tags", mode="before")
     @classmethod
@@ -904,6 +909,10 @@ class UpdateContextInput(BaseModel):
     tags: list[str] | None = Field(default=None, description="Updated tags")
     scopes: list[str] | None = Field(default=None, description="Updated scopes")
     metadata: dict | None = Field(default=None, description="Updated metadata")
+    expected_updated_at: str | None = Field(
+        default=None,
+        description="Reject the update if the item changed since this time",
+    )
 
     @field_validator("title", "source_type", mode="before")
     @classmethod
@@ -1656,7 +1665,9 @@ class AttachFileInput(BaseModel):
 
     file_id: str = Field(..., description="File UUID")
     target_id: str = Field(..., description="Target record id")
-    relationship_type: str = Field(default="has-file", description="Relationship type")
+    relationship_type: str = Field(
+        default="attachment", description="Relationship type"
+    )
 
 
 # --- Protocol Input Models ---
@@ -2186,7 +2197,18 @@ class CreateTaxonomyInput(BaseModel):
     is_symmetric: bool | None = Field(
         default=None, description="Relationship symmetry flag"
     )
-    value_schema: dict | None = Field(default=None, description="Log type value schema")
+    value_schema: dict | None = Field(
+        default=None, description="Entity or log type value schema"
+    )
+    inverse_name: str | None = Field(
+        default=None, description="Relationship name read from the target side"
+    )
+    source_entity_types: list[str] | None = Field(
+        default=None, description="Entity types allowed as relationship source"
+    )
+    target_entity_types: list[str] | None = Field(
+        default=None, description="Entity types allowed as relationship target"
+    )
 
     @field_validator("kind", mode="before")
     @classmethod
@@ -2202,7 +2224,7 @@ class CreateTaxonomyInput(BaseModel):
 
         return _validate_taxonomy_kind(v)
 
-    @field_validator("name", "description", mode="before")
+    @field_validator("name", "description", "inverse_name", mode="before")
     @classmethod
     def _clean_text(cls, v: str | None) -> str | None:
         """Handle clean text.
@@ -2240,8 +2262,22 @@ class CreateTaxonomyInput(BaseModel):
 
         if self.kind != "relationship-types" and self.is_symmetric is not None:
             raise ValueError("is_symmetric is only valid for relationship-types")
-        if self.kind != "log-types" and self.value_schema is not None:
-            raise ValueError("value_schema is only valid for log-types")
+        if (
+            self.kind not in {"entity-types", "log-types"}
+            and self.value_schema is not None
+        ):
+            raise ValueError(
+                "value_schema is only valid for entity-types and log-types"
+            )
+        if self.kind != "relationship-types" and (
+            self.inverse_name is not None
+            or self.source_entity_types is not None
+            or self.target_entity_types is not None
+        ):
+            raise ValueError(
+                "inverse_name and entity type rules are only valid for "
+                "relationship-types"
+            )
         return self
 
 
@@ -2256,7 +2292,18 @@ class UpdateTaxonomyInput(BaseModel):
     is_symmetric: bool | None = Field(
         default=None, description="Relationship symmetry flag"
     )
-    value_schema: dict | None = Field(default=None, description="Log type value schema")
+    value_schema: dict | None = Field(
+        default=None, description="Entity or log type value schema"
+    )
+    inverse_name: str | None = Field(
+        default=None, description="Relationship name read from the target side"
+    )
+    source_entity_types: list[str] | None = Field(
+        default=None, description="Entity types allowed as relationship source"
+    )
+    target_entity_types: list[str] | None = Field(
+        default=None, description="Entity types allowed as relationship target"
+    )
 
     @field_validator("kind", mode="before")
     @classmethod
@@ -2272,7 +2319,7 @@ class UpdateTaxonomyInput(BaseModel):
 
         return _validate_taxonomy_kind(v)
 
-    @field_validator("name", "description", "item_id", mode="before")
+    @field_validator("name", "description", "item_id", "inverse_name", mode="before")
     @classmethod
     def _clean_text(cls, v: str | None) -> str | None:
         """Handle clean text.
@@ -2310,8 +2357,22 @@ class UpdateTaxonomyInput(BaseModel):
 
         if self.kind != "relationship-types" and self.is_symmetric is not None:
             raise ValueError("is_symmetric is only valid for relationship-types")
-        if self.kind != "log-types" and self.value_schema is not None:
-            raise ValueError("value_schema is only valid for log-types")
+        if (
+            self.kind not in {"entity-types", "log-types"}
+            and self.value_schema is not None
+        ):
+            raise ValueError(
+                "value_schema is only valid for entity-types and log-types"
+            )
+        if self.kind != "relationship-types" and (
+            self.inverse_name is not None
+            or self.source_entity_types is not None
+            or self.target_entity_types is not None
+        ):
+            raise ValueError(
+                "inverse_name and entity type rules are only valid for "
+                "relationship-types"
+            )
         return self
 
 
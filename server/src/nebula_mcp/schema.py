@@ -18,6 +18,7 @@ QUERIES = QueryLoader(Path(__file__).resolve().parents[1] / "queries")
 
 JOB_PRIORITY_VALUES = ["low", "medium", "high", "critical"]
 APPROVAL_STATUS_VALUES = ["pending", "approved", "rejected", "approved-failed"]
+APPROVAL_PRIORITY_VALUES = ["low", "normal", "high"]
 RELATIONSHIP_NODE_TYPE_VALUES = [
     "entity",
     "context",
@@ -90,6 +91,7 @@ async def load_schema_contract(pool: Pool) -> dict[str, Any]:
             },
             "approval_requests": {
                 "status": APPROVAL_STATUS_VALUES,
+                "priority": APPROVAL_PRIORITY_VALUES,
             },
             "relationships": {
                 "node_types": RELATIONSHIP_NODE_TYPE_VALUES,
@@ -24,11 +24,13 @@ from nebula_api.auth import generate_api_key
 from nebula_mcp.context import (
     authenticate_agent_optional,
     authenticate_agent_with_key,
+    enforce_agent_permissions,
     maybe_require_approval,
     require_context,
 )
 from nebula_mcp.db import get_pool
 from nebula_mcp.enums import (
+    EnumRegistry,
     load_enums,
     require_entity_type,
     require_log_type,
@@ -44,6 +46,7 @@ from nebula_mcp.executors import (
     execute_create_log,
     execute_create_protocol,
     execute_create_relationship,
+    execute_import_row,
     execute_update_context,
     execute_update_entity,
     execute_update_file,
@@ -98,6 +101,7 @@ from nebula_mcp.helpers import (
 )
 from nebula_mcp.imports import (
     extract_items,
+    import_external_id,
     normalize_context,
     normalize_entity,
     normalize_job,
@@ -199,7 +203,7 @@ TAXONOMY_KIND_MAP = {
         "update": "taxonomy/update_entity_type",
         "set_active": "taxonomy/set_entity_type_active",
         "usage": "taxonomy/count_entity_type_usage",
-        "supports": set(),
+        "supports": {"value_schema"},
     },
     "relationship-types": {
         "list": "taxonomy/list_relationship_types",
@@ -582,7 +586,36 @@ def _validate_taxonomy_payload(
     if is_symmetric is not None and "is_symmetric" not in supports:
         raise ValueError("is_symmetric is only valid for relationship-types")
     if value_schema is not None and "value_schema" not in supports:
-        raise ValueError("value_schema is only valid for log-types")
+        raise ValueError(
+            "value_schema is only valid for entity-types and log-types"
+        )
+
+
+def _clean_entity_types(
+    values: list[str] | None, enums: EnumRegistry, label: str
+) -> list[str] | None:
+    """Normalize an entity type allow-list and reject unknown names.
+
+    Args:
+        values: Entity type names, or None to leave unchanged.
+        enums: Enum registry with active entity types.
+        label: Field name for error messages.
+
+    Returns:
+        De-duplicated names in input order, or None.
+    """
+
+    if values is None:
+        return None
+    cleaned: list[str] = []
+    for value in values:
+        name = str(value).strip()
+        if not name or name in cleaned:
+            continue
+        if name not in enums.entity_types.name_to_id:
+            raise ValueError(f"Unknown entity type in {label}: {name}")
+        cleaned.append(name)
+    return cleaned
 
 
 async def _refresh_enums_in_context(ctx: Context, pool: Pool) -> None:
@@ -945,13 +978,17 @@ async def _run_bulk_import(
         action: Approval action name for audit/approval workflow.
 
     Returns:
-        Dict with created count, error list, and created items.
+        Dict with created and updated counts, error list, and written items.
     """
 
     pool, enums, agent = await require_context(ctx)
+    enforce_agent_permissions(agent, action=action)
     items = extract_items(payload.format, payload.data, payload.items)
     allowed_scopes = scope_names_from_ids(agent.get("scopes", []), enums)
-    created: list[dict] = []
+    resource = action.removeprefix("bulk_import_")
+    written: list[dict] = []
+    created = 0
+    updated = 0
     errors: list[dict] = []
 
     def _validate_taxonomy_before_approval(normalized: dict[str, Any]) -> None:
@@ -1012,6 +1049,8 @@ async def _run_bulk_import(
                         require_write=True,
                     )
                 _validate_taxonomy_before_approval(normalized)
+                if external_id := import_external_id(item):
+                    normalized["external_id"] = external_id
                 approval = await create_approval_request(
                     pool,
                     agent["id"],
@@ -1064,16 +1103,25 @@ async def _run_bulk_import(
                             "Target",
                             require_write=True,
                         )
-                    result = await executor(conn, enums, normalized)
-                    created.append(result)
+                    if external_id := import_external_id(item):
+                        normalized["external_id"] = external_id
+                    result, was_update = await execute_import_row(
+                        conn, enums, resource, normalized, executor
+                    )
+                    written.append(result)
+                    if was_update:
+                        updated += 1
+                    else:
+                        created += 1
                 except Exception as exc:
                     errors.append({"row": idx, "error": str(exc)})
 
     return {
-        "created": len(created),
+        "created": created,
+        "updated": updated,
         "failed": len(errors),
         "errors": errors,
-        "items": created,
+        "items": written,
     }
 
 
@@ -1173,6 +1221,7 @@ async def export_data(payload: ExportDataInput, ctx: Context) -> dict:
             status_category,
             limit,
             scope_filter,
+            0,
         )
         out_rows: list[dict[str, Any]] = []
         for row in rows:
@@ -1207,6 +1256,7 @@ async def export_data(payload: ExportDataInput, ctx: Context) -> dict:
             parent_job_id,
             scope_filter,
             limit,
+            0,
         )
         return _export_response_rows([dict(row) for row in rows], payload.format)
 
@@ -2219,6 +2269,8 @@ async def query_logs(payload: QueryLogsInput, ctx: Context) -> list[dict]:
         payload.status_category,
         limit,
         offset,
+        None,
+        None,
     )
     results = []
     for row in rows:
@@ -2332,6 +2384,7 @@ async def query_relationships(
         payload.status_category,
         limit,
         scope_ids,
+        0,
     )
     results = []
     for row in rows:
@@ -2561,6 +2614,7 @@ async def query_jobs(payload: QueryJobsInput, ctx: Context) -> list[dict]:
         payload.parent_job_id,
         scope_filter,
         limit,
+        0,
     )
     return [dict(r) for r in rows]
 
@@ -3046,13 +3100,25 @@ async def create_taxonomy(payload: CreateTaxonomyInput, ctx: Context) -> dict:
         raise ValueError("Taxonomy name required")
 
     try:
-        if payload.kind in {"scopes", "entity-types"}:
+        if payload.kind == "scopes":
             row = await pool.fetchrow(
                 QUERIES[cfg["create"]],
                 name,
                 payload.description,
                 json.dumps(payload.metadata or {}),
             )
+        elif payload.kind == "entity-types":
+            row = await pool.fetchrow(
+                QUERIES[cfg["create"]],
+                name,
+                payload.description,
+                json.dumps(payload.metadata or {}),
+                (
+                    json.dumps(payload.value_schema)
+                    if payload.value_schema is not None
+                    else None
+                ),
+            )
         elif payload.kind == "relationship-types":
             row = await pool.fetchrow(
                 QUERIES[cfg["create"]],
@@ -3060,6 +3126,13 @@ async def create_taxonomy(payload: CreateTaxonomyInput, ctx: Context) -> dict:
                 payload.description,
                 payload.is_symmetric,
                 json.dumps(payload.metadata or {}),
+                (payload.inverse_name or "").strip(),
+                _clean_entity_types(
+                    payload.source_entity_types, enums, "source_entity_types"
+                ),
+                _clean_entity_types(
+                    payload.target_entity_types, enums, "target_entity_types"
+                ),
             )
         else:
             row = await pool.fetchrow(
@@ -3103,7 +3176,7 @@ async def update_taxonomy(payload: UpdateTaxonomyInput, ctx: Context) -> dict:
         raise ValueError("Built-in taxonomy names are immutable")
 
     try:
-        if payload.kind in {"scopes", "entity-types"}:
+        if payload.kind == "scopes":
             row = await pool.fetchrow(
                 QUERIES[cfg["update"]],
                 payload.item_id,
@@ -3111,6 +3184,17 @@ async def update_taxonomy(payload: UpdateTaxonomyInput, ctx: Context) -> dict:
                 payload.description,
                 json.dumps(payload.metadata) if payload.metadata is not None else None,
             )
+        elif payload.kind == "entity-types":
+            row = await pool.fetchrow(
+                QUERIES[cfg["update"]],
+                payload.item_id,
+                name,
+                payload.description,
+                json.dumps(payload.metadata) if payload.metadata is not None else None,
+                json.dumps(payload.value_schema)
+                if payload.value_schema is not None
+                else None,
+            )
         elif payload.kind == "relationship-types":
             row = await pool.fetchrow(
                 QUERIES[cfg["update"]],
@@ -3119,6 +3203,17 @@ async def update_taxonomy(payload: UpdateTaxonomyInput, ctx: Context) -> dict:
                 payload.description,
                 payload.is_symmetric,
                 json.dumps(payload.metadata) if payload.metadata is not None else None,
+                (
+                    payload.inverse_name.strip()
+                    if payload.inverse_name is not None
+                    else None
+                ),
+                _clean_entity_types(
+                    payload.source_entity_types, enums, "source_entity_types"
+                ),
+                _clean_entity_types(
+                    payload.target_entity_types, enums, "target_entity_types"
+                ),
             )
         else:
             row = await pool.fetchrow(
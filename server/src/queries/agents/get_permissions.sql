@@ -0,0 +1,9 @@
+-- Get the permission set of an agent
+SELECT
+    id AS agent_id,
+    scopes,
+    allowed_request_types,
+    rate_limit_per_minute,
+    permissions_expire_at
+FROM agents
+WHERE id = $1::uuid;
@@ -0,0 +1,15 @@
+-- Replace the permission set of an agent ($5 clears the expiry when NULL)
+UPDATE agents
+SET
+    scopes = $2,
+    allowed_request_types = $3,
+    rate_limit_per_minute = $4,
+    permissions_expire_at = $5,
+    updated_at = NOW()
+WHERE id = $1::uuid
+RETURNING
+    id AS agent_id,
+    scopes,
+    allowed_request_types,
+    rate_limit_per_minute,
+    permissions_expire_at;
@@ -0,0 +1,4 @@
+-- Add a comment or reply to an approval request
+INSERT INTO approval_comments (approval_id, parent_id, author_entity_id, author_agent_id, body)
+VALUES ($1::uuid, $2::uuid, $3::uuid, $4::uuid, $5)
+RETURNING id;
@@ -3,7 +3,8 @@ INSERT INTO approval_requests (
   request_type,
   requested_by,
   change_details,
-  job_id
+  job_id,
+  priority
 )
-VALUES ($1, $2, $3, $4)
+VALUES ($1, $2, $3, $4, $5)
 RETURNING *;
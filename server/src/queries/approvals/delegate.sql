@@ -0,0 +1,9 @@
+-- Hand a pending approval request to someone, or clear the delegation
+UPDATE approval_requests
+SET
+    delegated_to = $2::uuid,
+    delegated_by = CASE WHEN $2::uuid IS NULL THEN NULL ELSE $3::uuid END,
+    delegated_at = CASE WHEN $2::uuid IS NULL THEN NULL ELSE NOW() END
+WHERE id = $1::uuid
+  AND status = 'pending'
+RETURNING id;
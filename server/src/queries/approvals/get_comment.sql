@@ -0,0 +1,14 @@
+-- Get one approval comment with its author
+SELECT
+    c.id,
+    c.approval_id,
+    c.parent_id,
+    CASE WHEN c.author_agent_id IS NOT NULL THEN 'agent' ELSE 'entity' END AS author_type,
+    COALESCE(c.author_agent_id, c.author_entity_id) AS author_id,
+    COALESCE(agents.name, entities.name) AS author_name,
+    c.body,
+    c.created_at
+FROM approval_comments c
+LEFT JOIN entities ON entities.id = c.author_entity_id
+LEFT JOIN agents ON agents.id = c.author_agent_id
+WHERE c.id = $1::uuid;
@@ -1,9 +1,14 @@
 -- List pending approval requests
 SELECT 
   ar.*,
-  a.name as agent_name
+  a.name as agent_name,
+  d.name as delegated_to_name
 FROM approval_requests ar
 LEFT JOIN agents a ON ar.requested_by = a.id
+LEFT JOIN entities d ON ar.delegated_to = d.id
 WHERE ar.status = 'pending'
+  AND ($3::timestamptz IS NULL OR ar.created_at >= $3)
+  AND ($4::timestamptz IS NULL OR ar.created_at < $4)
+  AND ($5::uuid IS NULL OR ar.delegated_to = $5)
 ORDER BY ar.created_at ASC
 LIMIT $1 OFFSET $2;
@@ -2,8 +2,10 @@
 SELECT 
   ar.*,
   a.name as agent_name,
-  e.name as reviewer_name
+  e.name as reviewer_name,
+  d.name as delegated_to_name
 FROM approval_requests ar
 LEFT JOIN agents a ON ar.requested_by = a.id
 LEFT JOIN entities e ON ar.reviewed_by = e.id
+LEFT JOIN entities d ON ar.delegated_to = d.id
 WHERE ar.id = $1;
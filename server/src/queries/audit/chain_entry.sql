@@ -0,0 +1,8 @@
+-- Get the chain hash of one audit entry, or of the chain head when $1 is NULL
+SELECT id AS entry_id, hash
+FROM audit_log
+WHERE $1::uuid IS NOT NULL AND id = $1::uuid
+UNION ALL
+SELECT entry_id, hash
+FROM audit_chain_head
+WHERE $1::uuid IS NULL AND id AND hash IS NOT NULL;
@@ -0,0 +1,18 @@
+-- List audit log entries for a single context item
+SELECT
+  id,
+  table_name,
+  record_id,
+  action,
+  changed_by_type,
+  changed_by_id,
+  old_data,
+  new_data,
+  changed_fields,
+  changed_at
+FROM audit_log
+WHERE table_name = 'context_items'
+  AND record_id = $1
+ORDER BY changed_at DESC
+LIMIT $2
+OFFSET $3;
@@ -1,4 +1,5 @@
--- List audit log entries with optional filters
+-- List audit log entries with optional filters, newest first. $11/$12 are a
+-- keyset cursor: only entries older than (changed_at, id) of the last row seen.
 SELECT
   audit_log.id,
   audit_log.table_name,
@@ -12,6 +13,8 @@ SELECT
   audit_log.changed_at,
   audit_log.change_reason,
   audit_log.metadata,
+  audit_log.prev_hash,
+  audit_log.hash,
   COALESCE(entities.name, agents.name) AS actor_name
 FROM audit_log
 LEFT JOIN entities
@@ -38,6 +41,12 @@ WHERE ($1::text IS NULL OR audit_log.table_name = $1)
       OR scoped_context.privacy_scope_ids && ARRAY[$6]
     )
   )
-ORDER BY audit_log.changed_at DESC
+  AND ($9::timestamptz IS NULL OR audit_log.changed_at >= $9)
+  AND ($10::timestamptz IS NULL OR audit_log.changed_at < $10)
+  AND (
+    $11::timestamptz IS NULL
+    OR (audit_log.changed_at, audit_log.id) < ($11::timestamptz, $12::uuid)
+  )
+ORDER BY audit_log.changed_at DESC, audit_log.id DESC
 LIMIT $7
 OFFSET $8;
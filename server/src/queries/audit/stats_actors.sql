@@ -0,0 +1,22 @@
+-- Rank audit actors by activity with optional filters
+SELECT
+  audit_log.changed_by_type,
+  audit_log.changed_by_id,
+  COALESCE(entities.name, agents.name) AS actor_name,
+  COUNT(*) AS action_count
+FROM audit_log
+LEFT JOIN entities
+  ON audit_log.changed_by_type = 'entity'
+  AND audit_log.changed_by_id = entities.id
+LEFT JOIN agents
+  ON audit_log.changed_by_type = 'agent'
+  AND audit_log.changed_by_id = agents.id
+WHERE ($1::text IS NULL OR audit_log.table_name = $1)
+  AND ($2::text IS NULL OR audit_log.action = $2)
+  AND ($3::text IS NULL OR audit_log.changed_by_type = $3)
+  AND ($4::uuid IS NULL OR audit_log.changed_by_id = $4)
+  AND ($5::timestamptz IS NULL OR audit_log.changed_at >= $5)
+  AND ($6::timestamptz IS NULL OR audit_log.changed_at < $6)
+GROUP BY audit_log.changed_by_type, audit_log.changed_by_id, actor_name
+ORDER BY action_count DESC, actor_name
+LIMIT $7;
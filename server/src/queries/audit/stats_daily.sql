@@ -0,0 +1,13 @@
+-- Count audit log entries per day with optional filters
+SELECT
+  date_trunc('day', audit_log.changed_at)::date AS day,
+  COUNT(*) AS action_count
+FROM audit_log
+WHERE ($1::text IS NULL OR audit_log.table_name = $1)
+  AND ($2::text IS NULL OR audit_log.action = $2)
+  AND ($3::text IS NULL OR audit_log.changed_by_type = $3)
+  AND ($4::uuid IS NULL OR audit_log.changed_by_id = $4)
+  AND ($5::timestamptz IS NULL OR audit_log.changed_at >= $5)
+  AND ($6::timestamptz IS NULL OR audit_log.changed_at < $6)
+GROUP BY day
+ORDER BY day;
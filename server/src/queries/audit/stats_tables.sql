@@ -0,0 +1,14 @@
+-- Rank audited tables by changes with optional filters
+SELECT
+  audit_log.table_name,
+  COUNT(*) AS action_count
+FROM audit_log
+WHERE ($1::text IS NULL OR audit_log.table_name = $1)
+  AND ($2::text IS NULL OR audit_log.action = $2)
+  AND ($3::text IS NULL OR audit_log.changed_by_type = $3)
+  AND ($4::uuid IS NULL OR audit_log.changed_by_id = $4)
+  AND ($5::timestamptz IS NULL OR audit_log.changed_at >= $5)
+  AND ($6::timestamptz IS NULL OR audit_log.changed_at < $6)
+GROUP BY audit_log.table_name
+ORDER BY action_count DESC, audit_log.table_name
+LIMIT $7;
@@ -0,0 +1,6 @@
+-- Add entities to a collection, skipping ones already in it
+INSERT INTO collection_items (collection_id, entity_id)
+SELECT $1::uuid, entity_id
+FROM unnest($2::uuid[]) AS entity_id
+ON CONFLICT (collection_id, entity_id) DO NOTHING
+RETURNING entity_id;
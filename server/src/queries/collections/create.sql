@@ -0,0 +1,4 @@
+-- Create a collection for an entity
+INSERT INTO collections (owner_entity_id, name, description)
+VALUES ($1::uuid, $2, $3)
+RETURNING id, name, description, created_at, updated_at, 0::bigint AS entity_count;
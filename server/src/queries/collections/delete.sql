@@ -0,0 +1,4 @@
+-- Delete a collection owned by an entity
+DELETE FROM collections
+WHERE id = $1::uuid AND owner_entity_id = $2::uuid
+RETURNING id;
@@ -0,0 +1,33 @@
+-- Search entities in a collection with the same filters as
+-- entities/query_filtered
+SELECT
+    e.id,
+    e.name,
+    et.name AS type,
+    s.name AS status,
+    e.privacy_scope_ids,
+    e.tags,
+    e.metadata,
+    e.created_at,
+    e.updated_at
+FROM collection_items ci
+JOIN entities e ON e.id = ci.entity_id
+JOIN entity_types et ON e.type_id = et.id
+JOIN statuses s ON e.status_id = s.id
+WHERE
+    ci.collection_id = $8::uuid
+    AND ($1::uuid IS NULL OR e.type_id = $1)
+    AND ($2::text[] IS NULL OR e.tags && $2)
+    AND (
+        $3::text IS NULL
+        OR to_tsvector('english', e.name || ' ' || COALESCE(e.metadata::text, '')) @@ plainto_tsquery('english', $3)
+        OR e.name ILIKE '%' || $3 || '%'
+    )
+    AND ($4::text IS NULL OR s.category = $4)
+    AND ($5::uuid[] IS NULL OR e.privacy_scope_ids && $5)
+    AND ($9::text[] IS NULL OR s.name = ANY($9))
+    AND ($10::timestamptz IS NULL OR e.updated_at >= $10)
+    AND ($11::timestamptz IS NULL OR e.updated_at < $11)
+    AND ($12::jsonb IS NULL OR e.metadata @> $12)
+ORDER BY ci.added_at DESC
+LIMIT $6 OFFSET $7;
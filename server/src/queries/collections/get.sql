@@ -0,0 +1,12 @@
+-- Get one collection owned by an entity with its member count
+SELECT
+    c.id,
+    c.name,
+    c.description,
+    c.created_at,
+    c.updated_at,
+    COUNT(ci.entity_id) AS entity_count
+FROM collections c
+LEFT JOIN collection_items ci ON ci.collection_id = c.id
+WHERE c.id = $1::uuid AND c.owner_entity_id = $2::uuid
+GROUP BY c.id;
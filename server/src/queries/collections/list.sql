@@ -0,0 +1,13 @@
+-- List collections owned by an entity with member counts
+SELECT
+    c.id,
+    c.name,
+    c.description,
+    c.created_at,
+    c.updated_at,
+    COUNT(ci.entity_id) AS entity_count
+FROM collections c
+LEFT JOIN collection_items ci ON ci.collection_id = c.id
+WHERE c.owner_entity_id = $1::uuid
+GROUP BY c.id
+ORDER BY lower(c.name);
@@ -0,0 +1,4 @@
+-- Remove an entity from a collection
+DELETE FROM collection_items
+WHERE collection_id = $1::uuid AND entity_id = $2::uuid
+RETURNING entity_id;
@@ -0,0 +1,7 @@
+-- Rename or describe a collection owned by an entity
+UPDATE collections
+SET
+    name = COALESCE($3, name),
+    description = COALESCE($4, description)
+WHERE id = $1::uuid AND owner_entity_id = $2::uuid
+RETURNING id;
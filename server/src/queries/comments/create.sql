@@ -0,0 +1,4 @@
+-- Add a comment to an entity or context item
+INSERT INTO comments (entity_id, context_id, author_entity_id, author_agent_id, body)
+VALUES ($1::uuid, $2::uuid, $3::uuid, $4::uuid, $5)
+RETURNING id;
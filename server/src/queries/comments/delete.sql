@@ -0,0 +1,4 @@
+-- Delete a comment
+DELETE FROM comments
+WHERE id = $1::uuid
+RETURNING id;
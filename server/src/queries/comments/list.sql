@@ -0,0 +1,17 @@
+-- List comments on an entity or context item, oldest first
+SELECT
+    c.id,
+    c.entity_id,
+    c.context_id,
+    CASE WHEN c.author_agent_id IS NOT NULL THEN 'agent' ELSE 'entity' END AS author_type,
+    COALESCE(c.author_agent_id, c.author_entity_id) AS author_id,
+    COALESCE(agents.name, entities.name) AS author_name,
+    c.body,
+    c.created_at,
+    c.updated_at
+FROM comments c
+LEFT JOIN entities ON entities.id = c.author_entity_id
+LEFT JOIN agents ON agents.id = c.author_agent_id
+WHERE ($1::uuid IS NULL OR c.entity_id = $1::uuid)
+  AND ($2::uuid IS NULL OR c.context_id = $2::uuid)
+ORDER BY c.created_at, c.id;
@@ -0,0 +1,5 @@
+-- Edit the body of a comment
+UPDATE comments
+SET body = $2
+WHERE id = $1::uuid
+RETURNING id;
@@ -0,0 +1,10 @@
+-- Count context items matching the query.sql filters
+SELECT COUNT(*)::INT AS total
+FROM context_items k
+JOIN statuses s ON k.status_id = s.id
+WHERE 
+    ($1::text IS NULL OR k.source_type = $1)
+    AND ($2::text[] IS NULL OR k.tags && $2)
+    AND ($3::text IS NULL OR to_tsvector('english', k.title || ' ' || COALESCE(k.content, '') || ' ' || COALESCE(k.metadata::text, '')) @@ plainto_tsquery('english', $3))
+    AND ($4::uuid[] IS NULL OR k.privacy_scope_ids && $4)
+    AND s.category = 'active';
@@ -11,6 +11,7 @@ WITH updated AS (
         privacy_scope_ids = COALESCE($8, privacy_scope_ids),
         metadata = COALESCE($9::jsonb, metadata)
     WHERE id = $1
+      AND ($10::timestamptz IS NULL OR updated_at = $10::timestamptz)
     RETURNING *
 )
 SELECT
@@ -0,0 +1,14 @@
+-- Count entities matching the query.sql filters
+SELECT COUNT(*)::INT AS total
+FROM entities e
+JOIN statuses s ON e.status_id = s.id
+WHERE 
+    ($1::uuid IS NULL OR e.type_id = $1)
+    AND ($2::text[] IS NULL OR e.tags && $2)
+    AND (
+        $3::text IS NULL
+        OR to_tsvector('english', e.name || ' ' || COALESCE(e.metadata::text, '')) @@ plainto_tsquery('english', $3)
+        OR e.name ILIKE '%' || $3 || '%'
+    )
+    AND s.category = $4
+    AND ($5::uuid[] IS NULL OR e.privacy_scope_ids && $5);
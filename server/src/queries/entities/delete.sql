@@ -0,0 +1,4 @@
+-- Permanently delete an entity
+DELETE FROM entities
+WHERE id = $1::uuid
+RETURNING id;
@@ -0,0 +1,8 @@
+-- Fetch entity scopes and status category for permanent deletion
+SELECT
+    e.id,
+    e.privacy_scope_ids,
+    s.category AS status_category
+FROM entities e
+JOIN statuses s ON e.status_id = s.id
+WHERE e.id = $1::uuid;
@@ -0,0 +1,31 @@
+-- Search entities with the entities/query filters plus status names, an
+-- updated_at range, and JSONB metadata containment
+SELECT
+    e.id,
+    e.name,
+    et.name AS type,
+    s.name AS status,
+    e.privacy_scope_ids,
+    e.tags,
+    e.metadata,
+    e.created_at,
+    e.updated_at
+FROM entities e
+JOIN entity_types et ON e.type_id = et.id
+JOIN statuses s ON e.status_id = s.id
+WHERE
+    ($1::uuid IS NULL OR e.type_id = $1)
+    AND ($2::text[] IS NULL OR e.tags && $2)
+    AND (
+        $3::text IS NULL
+        OR to_tsvector('english', e.name || ' ' || COALESCE(e.metadata::text, '')) @@ plainto_tsquery('english', $3)
+        OR e.name ILIKE '%' || $3 || '%'
+    )
+    AND s.category = $4
+    AND ($5::uuid[] IS NULL OR e.privacy_scope_ids && $5)
+    AND ($8::text[] IS NULL OR s.name = ANY($8))
+    AND ($9::timestamptz IS NULL OR e.updated_at >= $9)
+    AND ($10::timestamptz IS NULL OR e.updated_at < $10)
+    AND ($11::jsonb IS NULL OR e.metadata @> $11)
+ORDER BY e.created_at DESC
+LIMIT $6 OFFSET $7;
@@ -0,0 +1,145 @@
+-- List context, jobs, logs, and protocols that link to or mention an entity.
+-- $1 entity id, $2 ILIKE pattern for the entity name (NULL skips mentions),
+-- $3 caller scope ids (NULL for admins), $4 row limit. A relationship link
+-- wins over a mention of the same item.
+WITH links AS (
+    SELECT
+        CASE WHEN r.source_type = 'entity' AND r.source_id = $1::uuid::text
+            THEN r.target_type ELSE r.source_type END AS kind,
+        CASE WHEN r.source_type = 'entity' AND r.source_id = $1::uuid::text
+            THEN r.target_id ELSE r.source_id END AS id,
+        rt.name AS via,
+        0 AS rank
+    FROM relationships r
+    JOIN relationship_types rt ON rt.id = r.type_id
+    LEFT JOIN statuses rs ON rs.id = r.status_id
+    WHERE (
+        (r.source_type = 'entity' AND r.source_id = $1::uuid::text)
+        OR (r.target_type = 'entity' AND r.target_id = $1::uuid::text)
+    )
+      AND COALESCE(rs.category, 'active') = 'active'
+),
+mentions AS (
+    SELECT 'context' AS kind, c.id::text AS id, 'mentions' AS via, 1 AS rank
+    FROM context_items c
+    LEFT JOIN statuses s ON s.id = c.status_id
+    WHERE $2::text IS NOT NULL
+      AND COALESCE(s.category, 'active') = 'active'
+      AND (
+          c.title ILIKE $2::text
+          OR c.content ILIKE $2::text
+          OR c.metadata::text ILIKE $2::text
+      )
+    UNION ALL
+    SELECT 'job', j.id, 'mentions', 1
+    FROM jobs j
+    LEFT JOIN statuses s ON s.id = j.status_id
+    WHERE $2::text IS NOT NULL
+      AND COALESCE(s.category, 'active') = 'active'
+      AND (
+          j.title ILIKE $2::text
+          OR j.description ILIKE $2::text
+          OR j.metadata::text ILIKE $2::text
+      )
+    UNION ALL
+    SELECT 'log', l.id::text, 'mentions', 1
+    FROM logs l
+    LEFT JOIN statuses s ON s.id = l.status_id
+    WHERE $2::text IS NOT NULL
+      AND COALESCE(s.category, 'active') = 'active'
+      AND (l.value::text ILIKE $2::text OR l.metadata::text ILIKE $2::text)
+    UNION ALL
+    SELECT 'protocol', p.id::text, 'mentions', 1
+    FROM protocols p
+    LEFT JOIN statuses s ON s.id = p.status_id
+    WHERE $2::text IS NOT NULL
+      AND COALESCE(s.category, 'active') = 'active'
+      AND (
+          p.title ILIKE $2::text
+          OR p.content ILIKE $2::text
+          OR p.metadata::text ILIKE $2::text
+      )
+),
+candidates AS (
+    SELECT DISTINCT ON (kind, id) kind, id, via
+    FROM (SELECT * FROM links UNION ALL SELECT * FROM mentions) refs
+    ORDER BY kind, id, rank
+),
+resolved AS (
+    SELECT c.kind, c.id, ctx.title, c.via
+    FROM candidates c
+    JOIN context_items ctx ON c.kind = 'context' AND ctx.id::text = c.id
+    WHERE $3::uuid[] IS NULL
+       OR COALESCE(cardinality(ctx.privacy_scope_ids), 0) = 0
+       OR ctx.privacy_scope_ids && $3::uuid[]
+    UNION ALL
+    SELECT c.kind, c.id, j.title, c.via
+    FROM candidates c
+    JOIN jobs j ON c.kind = 'job' AND j.id = c.id
+    WHERE $3::uuid[] IS NULL
+       OR COALESCE(cardinality(j.privacy_scope_ids), 0) = 0
+       OR j.privacy_scope_ids && $3::uuid[]
+    UNION ALL
+    SELECT
+        c.kind,
+        c.id,
+        lt.name || ' ' || to_char(l.timestamp AT TIME ZONE 'UTC', 'YYYY-MM-DD HH24:MI'),
+        c.via
+    FROM candidates c
+    JOIN logs l ON c.kind = 'log' AND l.id::text = c.id
+    JOIN log_types lt ON lt.id = l.log_type_id
+    -- Logs take their visibility from the records they are linked to.
+    WHERE $3::uuid[] IS NULL OR NOT EXISTS (
+        SELECT 1
+        FROM relationships lr
+        CROSS JOIN LATERAL (
+            SELECT
+                CASE WHEN lr.source_type = 'log' AND lr.source_id = c.id
+                    THEN lr.target_type ELSE lr.source_type END AS kind,
+                CASE WHEN lr.source_type = 'log' AND lr.source_id = c.id
+                    THEN lr.target_id ELSE lr.source_id END AS id
+        ) other
+        WHERE (
+            (lr.source_type = 'log' AND lr.source_id = c.id)
+            OR (lr.target_type = 'log' AND lr.target_id = c.id)
+        )
+          AND (
+              (other.kind = 'entity' AND NOT EXISTS (
+                  SELECT 1 FROM entities e
+                  WHERE e.id::text = other.id
+                    AND (
+                        COALESCE(cardinality(e.privacy_scope_ids), 0) = 0
+                        OR e.privacy_scope_ids && $3::uuid[]
+                    )
+              ))
+              OR (other.kind = 'context' AND NOT EXISTS (
+                  SELECT 1 FROM context_items x
+                  WHERE x.id::text = other.id
+                    AND (
+                        COALESCE(cardinality(x.privacy_scope_ids), 0) = 0
+                        OR x.privacy_scope_ids && $3::uuid[]
+                    )
+              ))
+              OR (other.kind = 'job' AND NOT EXISTS (
+                  SELECT 1 FROM jobs x
+                  WHERE x.id = other.id
+                    AND (
+                        COALESCE(cardinality(x.privacy_scope_ids), 0) = 0
+                        OR x.privacy_scope_ids && $3::uuid[]
+                    )
+              ))
+          )
+    )
+    UNION ALL
+    SELECT c.kind, c.id, COALESCE(NULLIF(p.title, ''), p.name), c.via
+    FROM candidates c
+    JOIN protocols p ON c.kind = 'protocol' AND p.id::text = c.id
+    WHERE $3::uuid[] IS NULL OR p.trusted IS NOT TRUE
+)
+SELECT kind, id, title, via
+FROM resolved
+ORDER BY
+    array_position(ARRAY['context', 'job', 'log', 'protocol'], kind),
+    lower(title),
+    id
+LIMIT $4;
@@ -1,15 +1,25 @@
--- Revert entity fields from an audit snapshot
+-- Revert entity fields from an audit snapshot ($11 limits the restored
+-- columns; NULL restores every column)
 UPDATE entities
 SET
-  privacy_scope_ids = $2::uuid[],
-  name = $3::text,
-  type_id = $4::uuid,
-  status_id = $5::uuid,
-  status_changed_at = $6::timestamptz,
-  status_reason = $7::text,
-  tags = COALESCE($8::text[], '{}'),
-  metadata = COALESCE($9::jsonb, '{}'::jsonb),
-  source_path = $10::text
+  privacy_scope_ids = CASE WHEN $11::text[] IS NULL OR 'privacy_scope_ids' = ANY($11::text[])
+    THEN $2::uuid[] ELSE privacy_scope_ids END,
+  name = CASE WHEN $11::text[] IS NULL OR 'name' = ANY($11::text[])
+    THEN $3::text ELSE name END,
+  type_id = CASE WHEN $11::text[] IS NULL OR 'type_id' = ANY($11::text[])
+    THEN $4::uuid ELSE type_id END,
+  status_id = CASE WHEN $11::text[] IS NULL OR 'status_id' = ANY($11::text[])
+    THEN $5::uuid ELSE status_id END,
+  status_changed_at = CASE WHEN $11::text[] IS NULL OR 'status_changed_at' = ANY($11::text[])
+    THEN $6::timestamptz ELSE status_changed_at END,
+  status_reason = CASE WHEN $11::text[] IS NULL OR 'status_reason' = ANY($11::text[])
+    THEN $7::text ELSE status_reason END,
+  tags = CASE WHEN $11::text[] IS NULL OR 'tags' = ANY($11::text[])
+    THEN COALESCE($8::text[], '{}') ELSE tags END,
+  metadata = CASE WHEN $11::text[] IS NULL OR 'metadata' = ANY($11::text[])
+    THEN COALESCE($9::jsonb, '{}'::jsonb) ELSE metadata END,
+  source_path = CASE WHEN $11::text[] IS NULL OR 'source_path' = ANY($11::text[])
+    THEN $10::text ELSE source_path END
 WHERE id = $1::uuid
 RETURNING
   id,
@@ -0,0 +1,12 @@
+-- Find the record an imported external ID maps to, ignoring deleted records
+SELECT m.record_id
+FROM import_external_ids m
+WHERE m.resource = $1
+  AND m.external_id = $2
+  AND CASE m.resource
+      WHEN 'entities' THEN EXISTS (SELECT 1 FROM entities e WHERE e.id::text = m.record_id)
+      WHEN 'context' THEN EXISTS (SELECT 1 FROM context_items c WHERE c.id::text = m.record_id)
+      WHEN 'relationships' THEN EXISTS (SELECT 1 FROM relationships r WHERE r.id::text = m.record_id)
+      WHEN 'jobs' THEN EXISTS (SELECT 1 FROM jobs j WHERE j.id = m.record_id)
+      ELSE FALSE
+  END;
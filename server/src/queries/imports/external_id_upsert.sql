@@ -0,0 +1,5 @@
+-- Map an imported external ID to the record it created
+INSERT INTO import_external_ids (resource, external_id, record_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (resource, external_id)
+DO UPDATE SET record_id = EXCLUDED.record_id;
@@ -0,0 +1,11 @@
+-- Visible jobs whose title matches $1, ignoring case
+SELECT j.id, j.title
+FROM jobs j
+WHERE lower(j.title) = lower($1)
+  AND (
+    $2::uuid[] IS NULL
+    OR cardinality(j.privacy_scope_ids) = 0
+    OR j.privacy_scope_ids && $2
+  )
+ORDER BY j.created_at DESC
+LIMIT 3;
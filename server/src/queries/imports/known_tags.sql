@@ -0,0 +1,8 @@
+-- Tags from $1 already used on entities or context items
+SELECT DISTINCT tag
+FROM (
+    SELECT unnest(tags) AS tag FROM entities
+    UNION ALL
+    SELECT unnest(tags) AS tag FROM context_items
+) used
+WHERE tag = ANY($1::text[]);
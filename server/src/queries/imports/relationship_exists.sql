@@ -0,0 +1,10 @@
+-- Find a relationship with the same endpoints and type
+SELECT r.id
+FROM relationships r
+JOIN relationship_types rt ON r.type_id = rt.id
+WHERE r.source_type = $1
+  AND r.source_id = $2
+  AND r.target_type = $3
+  AND r.target_id = $4
+  AND rt.name = $5
+LIMIT 1;
@@ -30,4 +30,4 @@ WHERE
         OR j.privacy_scope_ids && $9
     )
 ORDER BY j.created_at DESC
-LIMIT $10;
+LIMIT $10 OFFSET $11;
@@ -7,7 +7,10 @@ WITH updated AS (
         status_id = COALESCE($4, status_id),
         priority = COALESCE($5, priority),
         metadata = COALESCE($6::jsonb, metadata),
-        assigned_to = COALESCE($7::uuid, assigned_to),
+        assigned_to = CASE
+            WHEN $10::boolean THEN $7::uuid
+            ELSE assigned_to
+        END,
         due_at = CASE
             WHEN $9::boolean THEN $8::timestamptz
             ELSE due_at
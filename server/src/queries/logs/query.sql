@@ -16,5 +16,7 @@ WHERE
     ($1::uuid IS NULL OR l.log_type_id = $1)
     AND ($2::text[] IS NULL OR l.tags && $2)
     AND s.category = $3
+    AND ($6::timestamptz IS NULL OR l.timestamp >= $6)
+    AND ($7::timestamptz IS NULL OR l.timestamp < $7)
 ORDER BY l.timestamp DESC
 LIMIT $4 OFFSET $5;
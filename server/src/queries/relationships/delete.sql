@@ -0,0 +1,4 @@
+-- Permanently delete a relationship
+DELETE FROM relationships
+WHERE id = $1::uuid
+RETURNING id;
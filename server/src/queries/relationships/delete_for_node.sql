@@ -0,0 +1,5 @@
+-- Delete every relationship touching a node
+DELETE FROM relationships
+WHERE (source_type = $1 AND source_id = $2)
+   OR (target_type = $1 AND target_id = $2)
+RETURNING id;
@@ -0,0 +1,11 @@
+-- Fetch relationship endpoints and status category for permanent deletion
+SELECT
+    r.id,
+    r.source_type,
+    r.source_id,
+    r.target_type,
+    r.target_id,
+    s.category AS status_category
+FROM relationships r
+JOIN statuses s ON r.status_id = s.id
+WHERE r.id = $1::uuid;
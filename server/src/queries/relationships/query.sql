@@ -41,4 +41,4 @@ WHERE
     )
     AND s.category = $4
 ORDER BY r.created_at DESC
-LIMIT $5;
+LIMIT $5 OFFSET $7;
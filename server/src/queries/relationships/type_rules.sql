@@ -0,0 +1,4 @@
+-- Allowed source and target entity types for a relationship type
+SELECT source_entity_types, target_entity_types
+FROM relationship_types
+WHERE id = $1::uuid;
@@ -0,0 +1,3 @@
+-- Drop a registered tag
+DELETE FROM tags
+WHERE name = $1;
@@ -0,0 +1,7 @@
+-- Drop registered tags that no entity, context item, or file uses
+DELETE FROM tags t
+WHERE ($1::text[] IS NULL OR t.name = ANY($1::text[]))
+  AND NOT EXISTS (SELECT 1 FROM entities WHERE t.name = ANY(tags))
+  AND NOT EXISTS (SELECT 1 FROM context_items WHERE t.name = ANY(tags))
+  AND NOT EXISTS (SELECT 1 FROM files WHERE t.name = ANY(tags))
+RETURNING t.name;
@@ -0,0 +1,4 @@
+-- Fetch a registered tag by name
+SELECT name, created_at
+FROM tags
+WHERE name = $1;
@@ -0,0 +1,35 @@
+-- List registered tags with usage counts, optionally limited to scoped records
+WITH entity_usage AS (
+    SELECT tag, COUNT(*)::INT AS uses
+    FROM entities, unnest(tags) AS tag
+    WHERE $4::uuid[] IS NULL OR privacy_scope_ids && $4::uuid[]
+    GROUP BY tag
+),
+context_usage AS (
+    SELECT tag, COUNT(*)::INT AS uses
+    FROM context_items, unnest(tags) AS tag
+    WHERE $4::uuid[] IS NULL OR privacy_scope_ids && $4::uuid[]
+    GROUP BY tag
+),
+file_usage AS (
+    SELECT tag, COUNT(*)::INT AS uses
+    FROM files, unnest(tags) AS tag
+    WHERE $4::uuid[] IS NULL
+    GROUP BY tag
+)
+SELECT
+    t.name,
+    COALESCE(e.uses, 0) AS entities,
+    COALESCE(c.uses, 0) AS knowledge,
+    COALESCE(f.uses, 0) AS files
+FROM tags t
+LEFT JOIN entity_usage e ON e.tag = t.name
+LEFT JOIN context_usage c ON c.tag = t.name
+LEFT JOIN file_usage f ON f.tag = t.name
+WHERE ($1::text IS NULL OR t.name ILIKE '%' || $1::text || '%')
+  AND (
+      $4::uuid[] IS NULL
+      OR COALESCE(e.uses, 0) + COALESCE(c.uses, 0) > 0
+  )
+ORDER BY t.name
+LIMIT $2 OFFSET $3;
@@ -0,0 +1,5 @@
+-- Rename a registered tag
+UPDATE tags
+SET name = $2
+WHERE name = $1
+RETURNING name;
@@ -0,0 +1,11 @@
+-- Replace one tag with another on entities
+WITH updated AS (
+    UPDATE entities
+    SET tags = CASE
+        WHEN $2::text = ANY(tags) THEN array_remove(tags, $1::text)
+        ELSE array_replace(tags, $1::text, $2::text)
+    END
+    WHERE $1::text = ANY(tags)
+    RETURNING 1
+)
+SELECT COUNT(*)::INT FROM updated;
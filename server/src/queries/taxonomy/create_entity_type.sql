@@ -3,6 +3,7 @@ INSERT INTO entity_types (
     name,
     description,
     metadata,
+    value_schema,
     is_builtin,
     is_active
 )
@@ -10,6 +11,7 @@ VALUES (
     $1,
     NULLIF($2, ''),
     COALESCE($3::jsonb, '{}'::jsonb),
+    $4::jsonb,
     FALSE,
     TRUE
 )
@@ -20,5 +22,6 @@ RETURNING
     is_builtin,
     is_active,
     metadata,
+    value_schema,
     created_at,
     updated_at;
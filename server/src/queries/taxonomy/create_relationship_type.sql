@@ -4,6 +4,9 @@ INSERT INTO relationship_types (
     description,
     is_symmetric,
     metadata,
+    inverse_name,
+    source_entity_types,
+    target_entity_types,
     is_builtin,
     is_active
 )
@@ -12,6 +15,9 @@ VALUES (
     NULLIF($2, ''),
     COALESCE($3, FALSE),
     COALESCE($4::jsonb, '{}'::jsonb),
+    NULLIF($5, ''),
+    COALESCE($6::text[], '{}'),
+    COALESCE($7::text[], '{}'),
     FALSE,
     TRUE
 )
@@ -23,5 +29,8 @@ RETURNING
     is_builtin,
     is_active,
     metadata,
+    inverse_name,
+    source_entity_types,
+    target_entity_types,
     created_at,
     updated_at;
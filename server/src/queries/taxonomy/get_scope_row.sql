@@ -1,4 +1,4 @@
 -- Fetch scope taxonomy row by ID
-SELECT id, name, is_builtin
+SELECT id, name, is_builtin, is_active
 FROM privacy_scopes
 WHERE id = $1::uuid;
@@ -0,0 +1,29 @@
+-- Record a privacy scope merge in the audit log
+INSERT INTO audit_log (
+    table_name,
+    record_id,
+    action,
+    changed_by_type,
+    changed_by_id,
+    old_data,
+    new_data,
+    changed_fields,
+    change_reason
+)
+VALUES (
+    'privacy_scopes',
+    $1::text,
+    'update',
+    $3,
+    $4::uuid,
+    jsonb_build_object('is_active', TRUE),
+    jsonb_build_object(
+        'is_active', FALSE,
+        'merged_into', $2::text,
+        'entities_updated', $5::int,
+        'knowledge_updated', $6::int
+    ),
+    ARRAY['is_active'],
+    $7
+)
+RETURNING id;
@@ -6,6 +6,7 @@ SELECT
     is_builtin,
     is_active,
     metadata,
+    value_schema,
     created_at,
     updated_at
 FROM entity_types
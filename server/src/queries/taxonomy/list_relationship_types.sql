@@ -7,6 +7,9 @@ SELECT
     is_builtin,
     is_active,
     metadata,
+    inverse_name,
+    source_entity_types,
+    target_entity_types,
     created_at,
     updated_at
 FROM relationship_types
@@ -0,0 +1,12 @@
+-- Move context items from one privacy scope to another
+WITH updated AS (
+    UPDATE context_items
+    SET privacy_scope_ids = CASE
+        WHEN $2::uuid = ANY(privacy_scope_ids)
+            THEN array_remove(privacy_scope_ids, $1::uuid)
+        ELSE array_replace(privacy_scope_ids, $1::uuid, $2::uuid)
+    END
+    WHERE $1::uuid = ANY(privacy_scope_ids)
+    RETURNING 1
+)
+SELECT COUNT(*)::INT FROM updated;
@@ -10,5 +10,6 @@ RETURNING
     is_builtin,
     is_active,
     metadata,
+    value_schema,
     created_at,
     updated_at;
@@ -11,5 +11,8 @@ RETURNING
     is_builtin,
     is_active,
     metadata,
+    inverse_name,
+    source_entity_types,
+    target_entity_types,
     created_at,
     updated_at;
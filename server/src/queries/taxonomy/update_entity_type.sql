@@ -3,7 +3,8 @@ UPDATE entity_types
 SET
     name = COALESCE(NULLIF($2, ''), name),
     description = COALESCE($3, description),
-    metadata = COALESCE($4::jsonb, metadata)
+    metadata = COALESCE($4::jsonb, metadata),
+    value_schema = COALESCE($5::jsonb, value_schema)
 WHERE id = $1
 RETURNING
     id,
@@ -12,5 +13,6 @@ RETURNING
     is_builtin,
     is_active,
     metadata,
+    value_schema,
     created_at,
     updated_at;
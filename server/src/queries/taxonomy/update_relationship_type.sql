@@ -4,7 +4,10 @@ SET
     name = COALESCE(NULLIF($2, ''), name),
     description = COALESCE($3, description),
     is_symmetric = COALESCE($4, is_symmetric),
-    metadata = COALESCE($5::jsonb, metadata)
+    metadata = COALESCE($5::jsonb, metadata),
+    inverse_name = CASE WHEN $6::text IS NULL THEN inverse_name ELSE NULLIF($6, '') END,
+    source_entity_types = COALESCE($7::text[], source_entity_types),
+    target_entity_types = COALESCE($8::text[], target_entity_types)
 WHERE id = $1
 RETURNING
     id,
@@ -14,5 +17,8 @@ RETURNING
     is_builtin,
     is_active,
     metadata,
+    inverse_name,
+    source_entity_types,
+    target_entity_types,
     created_at,
     updated_at;
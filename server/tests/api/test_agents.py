@@ -110,3 +110,63 @@ async def test_update_agent_not_found(api, auth_override, enums):
         json={"description": "nope"},
     )
     assert r.status_code == 404
+
+
+@pytest.mark.asyncio
+async def test_agent_permissions_round_trip(api, agent_row, auth_override, enums):
+    """Test reading and replacing an agent's permission set."""
+
+    auth_override["scopes"] = [enums.scopes.name_to_id["admin"]]
+    r = await api.get(f"/api/agents/{agent_row['id']}/permissions")
+    assert r.status_code == 200
+    data = r.json()["data"]
+    assert data["scopes"] == ["public"]
+    assert data["request_types"] == []
+    assert data["rate_limit_per_minute"] == 0
+    assert data["expires_at"] is None
+
+    r = await api.patch(
+        f"/api/agents/{agent_row['id']}/permissions",
+        json={
+            "scopes": ["public", "code"],
+            "request_types": ["create_log", "Create_Entity"],
+            "rate_limit_per_minute": 30,
+            "expires_at": "2030-01-01T00:00:00Z",
+        },
+    )
+    assert r.status_code == 200
+    data = r.json()["data"]
+    assert sorted(data["scopes"]) == ["code", "public"]
+    assert data["request_types"] == ["create_entity", "create_log"]
+    assert data["rate_limit_per_minute"] == 30
+    assert data["expires_at"].startswith("2030-01-01")
+
+    r = await api.patch(
+        f"/api/agents/{agent_row['id']}/permissions",
+        json={"scopes": ["public"]},
+    )
+    assert r.status_code == 200
+    assert r.json()["data"]["expires_at"] is None
+
+
+@pytest.mark.asyncio
+async def test_agent_permissions_reject_unknown_request_type(
+    api, agent_row, auth_override, enums
+):
+    """Test unknown request types are rejected."""
+
+    auth_override["scopes"] = [enums.scopes.name_to_id["admin"]]
+    r = await api.patch(
+        f"/api/agents/{agent_row['id']}/permissions",
+        json={"scopes": ["public"], "request_types": ["drop_everything"]},
+    )
+    assert r.status_code == 400
+
+
+@pytest.mark.asyncio
+async def test_agent_permissions_require_admin(api, agent_row, auth_override, enums):
+    """Test non-admin callers cannot read permissions."""
+
+    auth_override["scopes"] = [enums.scopes.name_to_id["public"]]
+    r = await api.get(f"/api/agents/{agent_row['id']}/permissions")
+    assert r.status_code == 403
@@ -659,6 +659,143 @@ async def test_reject_request(api, pending_approval, auth_override, enums):
     assert r.status_code == 200
 
 
+@pytest.mark.asyncio
+async def test_delegate_approval_filters_delegated_to_me(
+    api, pending_approval, auth_override, enums, test_entity
+):
+    """Delegation is stored on the request and drives the delegated filter."""
+
+    auth_override["scopes"] = [enums.scopes.name_to_id["admin"]]
+    approval_id = str(pending_approval["id"])
+
+    r = await api.get("/api/approvals/pending", params={"delegated_to": "me"})
+    assert r.status_code == 200
+    assert r.json()["data"] == []
+
+    r = await api.post(
+        f"/api/approvals/{approval_id}/delegate",
+        json={"to": str(test_entity["id"])},
+    )
+    assert r.status_code == 200, r.text
+    data = r.json()["data"]
+    assert data["delegated_to"] == str(test_entity["id"])
+    assert data["delegated_to_name"] == test_entity["name"]
+    assert data["delegated_by"] == str(test_entity["id"])
+
+    r = await api.get("/api/approvals/pending", params={"delegated_to": "me"})
+    assert [row["id"] for row in r.json()["data"]] == [approval_id]
+
+    r = await api.post(f"/api/approvals/{approval_id}/delegate", json={"to": None})
+    assert r.status_code == 200, r.text
+    assert r.json()["data"]["delegated_to"] is None
+    r = await api.get("/api/approvals/pending", params={"delegated_to": "me"})
+    assert r.json()["data"] == []
+
+
+@pytest.mark.asyncio
+async def test_delegate_approval_rejects_unknown_delegate_and_closed_requests(
+    api, pending_approval, auth_override, enums, db_pool
+):
+    """Delegating needs a real entity and a pending request."""
+
+    auth_override["scopes"] = [enums.scopes.name_to_id["admin"]]
+    approval_id = str(pending_approval["id"])
+
+    r = await api.post(
+        f"/api/approvals/{approval_id}/delegate",
+        json={"to": "00000000-0000-0000-0000-000000000000"},
+    )
+    assert r.status_code == 404
+
+    await db_pool.execute(
+        "UPDATE approval_requests SET status = 'rejected' WHERE id = $1::uuid",
+        approval_id,
+    )
+    r = await api.post(f"/api/approvals/{approval_id}/delegate", json={"to": None})
+    assert r.status_code == 409
+
+
+@pytest.mark.asyncio
+async def test_approval_comment_thread(
+    api, pending_approval, auth_override, enums, test_entity, untrusted_agent
+):
+    """Reviewers and the requesting agent share a threaded discussion."""
+
+    auth_override["scopes"] = [enums.scopes.name_to_id["admin"]]
+    approval_id = str(pending_approval["id"])
+
+    r = await api.post(
+        f"/api/approvals/{approval_id}/comments", json={"body": "  Why public?  "}
+    )
+    assert r.status_code == 200, r.text
+    question = r.json()["data"]
+    assert question["body"] == "Why public?"
+    assert question["author_type"] == "entity"
+    assert question["author_name"] == test_entity["name"]
+    assert question["parent_id"] is None
+
+    auth_override.update(
+        {
+            "caller_type": "agent",
+            "entity_id": None,
+            "agent_id": untrusted_agent["id"],
+            "scopes": [enums.scopes.name_to_id["public"]],
+        }
+    )
+    r = await api.post(
+        f"/api/approvals/{approval_id}/comments",
+        json={"body": "Shared roadmap", "parent_id": question["id"]},
+    )
+    assert r.status_code == 200, r.text
+    assert r.json()["data"]["author_type"] == "agent"
+    assert r.json()["data"]["parent_id"] == question["id"]
+
+    r = await api.get(f"/api/approvals/{approval_id}/comments")
+    assert r.status_code == 200, r.text
+    thread = r.json()["data"]
+    assert [c["body"] for c in thread] == ["Why public?", "Shared roadmap"]
+    assert thread[1]["author_name"] == untrusted_agent["name"]
+
+    status = await api.get(f"/api/approvals/{approval_id}")
+    assert status.status_code == 403
+
+
+@pytest.mark.asyncio
+async def test_approval_comments_reject_outsiders_and_foreign_parents(
+    api, db_pool, pending_approval, auth_override, enums
+):
+    """Only admins and the requester comment; replies stay on one approval."""
+
+    approval_id = str(pending_approval["id"])
+    r = await api.get(f"/api/approvals/{approval_id}/comments")
+    assert r.status_code == 403
+
+    auth_override["scopes"] = [enums.scopes.name_to_id["admin"]]
+    r = await api.post(f"/api/approvals/{approval_id}/comments", json={"body": " "})
+    assert r.status_code == 422
+
+    other_id = await db_pool.fetchval(
+        """
+        INSERT INTO approval_requests (request_type, requested_by, change_details, status)
+        VALUES ('create_entity', $1, '{}'::jsonb, 'pending')
+        RETURNING id
+        """,
+        pending_approval["requested_by"],
+    )
+    r = await api.post(f"/api/approvals/{other_id}/comments", json={"body": "First"})
+    assert r.status_code == 200, r.text
+    r = await api.post(
+        f"/api/approvals/{approval_id}/comments",
+        json={"body": "Reply", "parent_id": r.json()["data"]["id"]},
+    )
+    assert r.status_code == 400
+
+    r = await api.get(
+        "/api/approvals/00000000-0000-0000-0000-000000000000/comments"
+    )
+    assert r.status_code == 404
+
+
 @pytest.mark.asyncio
 async def test_get_approval_not_found(api, auth_override, enums):
     """Test get approval not found."""
@@ -677,6 +814,9 @@ async def test_get_approval_not_found(api, auth_override, enums):
         ("get", "/api/approvals/not-a-uuid/diff", None),
         ("post", "/api/approvals/not-a-uuid/approve", None),
         ("post", "/api/approvals/not-a-uuid/reject", {"review_notes": "x"}),
+        ("post", "/api/approvals/not-a-uuid/delegate", {"to": None}),
+        ("get", "/api/approvals/not-a-uuid/comments", None),
+        ("post", "/api/approvals/not-a-uuid/comments", {"body": "x"}),
     ],
 )
 async def test_approval_routes_validate_uuid_for_admin(
@@ -1,7 +1,11 @@
 """Audit route tests."""
 
+# Standard Library
+import base64
+
 # Third-Party
 import pytest
+from cryptography.hazmat.primitives.asymmetric.ed25519 import Ed25519PublicKey
 
 
 @pytest.mark.asyncio
@@ -49,3 +53,137 @@ async def test_list_audit_scope_filter(api, enums, test_entity, auth_override):
     assert r.status_code == 200
     data = r.json()["data"]
     assert isinstance(data, list)
+
+
+@pytest.mark.asyncio
+async def test_list_audit_time_window(api, enums, test_entity, auth_override):
+    """Filter audit log by since and until."""
+
+    auth_override["scopes"] = [enums.scopes.name_to_id["admin"]]
+
+    r = await api.get("/api/audit", params={"since": "2000-01-01"})
+    assert r.status_code == 200
+    assert len(r.json()["data"]) >= 1
+
+    r = await api.get("/api/audit", params={"until": "2000-01-01"})
+    assert r.status_code == 200
+    assert r.json()["data"] == []
+
+    r = await api.get("/api/audit", params={"since": "last week"})
+    assert r.status_code == 400
+
+
+@pytest.mark.asyncio
+async def test_audit_stats(api, enums, test_entity, auth_override):
+    """Summarize audit activity by day, actor, table, and record."""
+
+    auth_override["scopes"] = [enums.scopes.name_to_id["admin"]]
+
+    r = await api.get("/api/audit/stats", params={"table": "entities", "top": 50})
+    assert r.status_code == 200
+    data = r.json()["data"]
+    assert data["total"] >= 1
+    assert sum(d["action_count"] for d in data["daily"]) == data["total"]
+    assert data["top_tables"][0]["table_name"] == "entities"
+    assert any(rec["record_id"] == str(test_entity["id"]) for rec in data["top_records"])
+
+    r = await api.get("/api/audit/stats", params={"until": "2000-01-01"})
+    assert r.status_code == 200
+    assert r.json()["data"]["total"] == 0
+
+    r = await api.get("/api/audit/stats", params={"since": "last week"})
+    assert r.status_code == 400
+
+
+@pytest.mark.asyncio
+async def test_list_audit_includes_chain_hashes(api, enums, test_entity, auth_override):
+    """Audit entries carry their chain hash."""
+
+    auth_override["scopes"] = [enums.scopes.name_to_id["admin"]]
+
+    r = await api.get("/api/audit", params={"record_id": str(test_entity["id"])})
+    assert r.status_code == 200
+    data = r.json()["data"]
+    assert data
+    assert all(len(row["hash"]) == 64 for row in data)
+
+
+@pytest.mark.asyncio
+async def test_list_audit_pages_by_keyset_cursor(
+    api, db_pool, enums, test_entity, auth_override
+):
+    """A before cursor returns the entries older than the last one seen."""
+
+    auth_override["scopes"] = [enums.scopes.name_to_id["admin"]]
+    await db_pool.execute(
+        "UPDATE entities SET tags = $2 WHERE id = $1", test_entity["id"], ["cursor"]
+    )
+
+    r = await api.get("/api/audit", params={"limit": 200})
+    assert r.status_code == 200
+    everything = r.json()["data"]
+    assert len(everything) >= 2
+
+    r = await api.get("/api/audit", params={"limit": 1})
+    first = r.json()["data"]
+    assert [row["id"] for row in first] == [everything[0]["id"]]
+
+    r = await api.get(
+        "/api/audit",
+        params={
+            "limit": 200,
+            "before_changed_at": first[0]["changed_at"],
+            "before_id": first[0]["id"],
+        },
+    )
+    assert r.status_code == 200, r.text
+    assert [row["id"] for row in r.json()["data"]] == [
+        row["id"] for row in everything[1:]
+    ]
+
+    r = await api.get(
+        "/api/audit", params={"before_changed_at": first[0]["changed_at"]}
+    )
+    assert r.status_code == 400
+
+
+@pytest.mark.asyncio
+async def test_audit_integrity_signs_chain_head(
+    api, enums, test_entity, auth_override, monkeypatch
+):
+    """The integrity endpoint signs the hash of the requested entry."""
+
+    auth_override["scopes"] = [enums.scopes.name_to_id["admin"]]
+    seed = base64.b64encode(bytes(range(32))).decode()
+    monkeypatch.setenv("NEBULA_AUDIT_SIGNING_KEY", seed)
+
+    r = await api.get("/api/audit", params={"record_id": str(test_entity["id"])})
+    entry = r.json()["data"][0]
+
+    r = await api.get("/api/audit/integrity", params={"entry_id": entry["id"]})
+    assert r.status_code == 200
+    data = r.json()["data"]
+    assert data["entry_id"] == entry["id"]
+    assert data["hash"] == entry["hash"]
+    assert data["algorithm"] == "ed25519"
+    public_key = Ed25519PublicKey.from_public_bytes(
+        base64.b64decode(data["public_key"])
+    )
+    public_key.verify(base64.b64decode(data["signature"]), data["hash"].encode())
+
+    r = await api.get("/api/audit/integrity")
+    assert r.status_code == 200
+    assert len(r.json()["data"]["hash"]) == 64
+
+
+@pytest.mark.asyncio
+async def test_audit_integrity_requires_signing_key(
+    api, enums, auth_override, monkeypatch
+):
+    """Without a signing key the integrity endpoint is unavailable."""
+
+    auth_override["scopes"] = [enums.scopes.name_to_id["admin"]]
+    monkeypatch.delenv("NEBULA_AUDIT_SIGNING_KEY", raising=False)
+
+    r = await api.get("/api/audit/integrity")
+    assert r.status_code == 503
@@ -0,0 +1,122 @@
+"""Collection route tests."""
+
+# Standard Library
+import json
+
+# Third-Party
+import pytest
+
+pytestmark = pytest.mark.api
+
+
+async def _insert_entity(db_pool, enums, name: str, scopes: list[str]) -> str:
+    """Insert an entity and return its id."""
+
+    row = await db_pool.fetchrow(
+        """
+        INSERT INTO entities (name, type_id, status_id, privacy_scope_ids, tags, metadata)
+        VALUES ($1, $2, $3, $4, $5, $6::jsonb)
+        RETURNING id
+        """,
+        name,
+        enums.entity_types.name_to_id["project"],
+        enums.statuses.name_to_id["active"],
+        [enums.scopes.name_to_id[s] for s in scopes],
+        [],
+        json.dumps({}),
+    )
+    return str(row["id"])
+
+
+@pytest.mark.asyncio
+async def test_collection_lifecycle(api, db_pool, enums):
+    """Create, fill, filter, export, rename, and delete a collection."""
+
+    launch = await _insert_entity(db_pool, enums, "Launch plan", ["public"])
+    docs = await _insert_entity(db_pool, enums, "Docs site", ["public"])
+    await _insert_entity(db_pool, enums, "Unrelated", ["public"])
+
+    r = await api.post("/api/collections", json={"name": " Q3 launch "})
+    assert r.status_code == 200
+    collection = r.json()["data"]
+    assert collection["name"] == "Q3 launch"
+    assert collection["entity_count"] == 0
+    cid = collection["id"]
+
+    r = await api.post(
+        f"/api/collections/{cid}/entities", json={"entity_ids": [launch, docs, launch]}
+    )
+    assert r.status_code == 200
+    assert r.json()["data"] == {"added": 2, "requested": 2}
+
+    r = await api.get("/api/collections")
+    assert [c["entity_count"] for c in r.json()["data"]] == [2]
+
+    r = await api.get(f"/api/entities?collection_id={cid}&search_text=launch")
+    assert [e["id"] for e in r.json()["data"]] == [launch]
+
+    r = await api.get(f"/api/export/entities?collection_id={cid}")
+    assert r.json()["data"]["count"] == 2
+
+    r = await api.delete(f"/api/collections/{cid}/entities/{docs}")
+    assert r.status_code == 200
+    r = await api.get(f"/api/collections/{cid}")
+    assert [e["name"] for e in r.json()["data"]["entities"]] == ["Launch plan"]
+
+    r = await api.patch(f"/api/collections/{cid}", json={"name": "Q4 launch"})
+    assert r.json()["data"]["name"] == "Q4 launch"
+
+    r = await api.delete(f"/api/collections/{cid}")
+    assert r.status_code == 200
+    r = await api.get(f"/api/collections/{cid}")
+    assert r.status_code == 404
+
+
+@pytest.mark.asyncio
+async def test_collection_names_are_unique_per_owner(api):
+    """Duplicate names conflict and blank names are rejected."""
+
+    r = await api.post("/api/collections", json={"name": "Onboarding docs"})
+    assert r.status_code == 200
+    r = await api.post("/api/collections", json={"name": "Onboarding docs"})
+    assert r.status_code == 409
+    r = await api.post("/api/collections", json={"name": "   "})
+    assert r.status_code == 422
+
+
+@pytest.mark.asyncio
+async def test_collection_refuses_entities_outside_caller_scopes(api, db_pool, enums):
+    """Entities the caller cannot see cannot be added."""
+
+    hidden = await _insert_entity(db_pool, enums, "Hidden", ["sensitive"])
+    r = await api.post("/api/collections", json={"name": "Pins"})
+    cid = r.json()["data"]["id"]
+
+    r = await api.post(f"/api/collections/{cid}/entities", json={"entity_ids": [hidden]})
+    assert r.status_code == 403
+
+    r = await api.post(
+        f"/api/collections/{cid}/entities",
+        json={"entity_ids": ["00000000-0000-0000-0000-000000000000"]},
+    )
+    assert r.status_code == 404
+
+
+@pytest.mark.asyncio
+async def test_collections_are_user_only(api_agent_auth):
+    """Agents cannot own collections."""
+
+    r = await api_agent_auth.get("/api/collections")
+    assert r.status_code == 403
+
+
+@pytest.mark.asyncio
+async def test_collection_filter_rejects_unknown_collection(api):
+    """Filtering by a collection the caller does not own is a 404."""
+
+    r = await api.get(
+        "/api/entities?collection_id=00000000-0000-0000-0000-000000000000"
+    )
+    assert r.status_code == 404
+    r = await api.get("/api/entities?collection_id=nope")
+    assert r.status_code == 400
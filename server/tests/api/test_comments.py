@@ -0,0 +1,89 @@
+"""Comment route tests."""
+
+# Standard Library
+import json
+
+# Third-Party
+import pytest
+
+pytestmark = pytest.mark.api
+
+
+async def _insert_entity(db_pool, enums, name: str, scopes: list[str]) -> str:
+    """Insert an entity and return its id."""
+
+    row = await db_pool.fetchrow(
+        """
+        INSERT INTO entities (name, type_id, status_id, privacy_scope_ids, tags, metadata)
+        VALUES ($1, $2, $3, $4, $5, $6::jsonb)
+        RETURNING id
+        """,
+        name,
+        enums.entity_types.name_to_id["project"],
+        enums.statuses.name_to_id["active"],
+        [enums.scopes.name_to_id[s] for s in scopes],
+        [],
+        json.dumps({}),
+    )
+    return str(row["id"])
+
+
+@pytest.mark.asyncio
+async def test_entity_comment_lifecycle(api, db_pool, enums, test_entity, auth_override):
+    """Add, list, edit, and delete a comment on an entity."""
+
+    entity_id = await _insert_entity(db_pool, enums, "Launch plan", ["public"])
+
+    r = await api.post(
+        f"/api/entities/{entity_id}/comments", json={"body": "  Needs review  "}
+    )
+    assert r.status_code == 200
+    comment = r.json()["data"]
+    assert comment["body"] == "Needs review"
+    assert comment["author_type"] == "entity"
+    assert comment["author_id"] == str(test_entity["id"])
+    assert comment["author_name"] == test_entity["name"]
+
+    r = await api.get(f"/api/entities/{entity_id}/comments")
+    assert [c["id"] for c in r.json()["data"]] == [comment["id"]]
+
+    r = await api.patch(f"/api/comments/{comment['id']}", json={"body": "Reviewed"})
+    assert r.status_code == 200
+    assert r.json()["data"]["body"] == "Reviewed"
+
+    other = await _insert_entity(db_pool, enums, "Someone else", ["public"])
+    owner = auth_override["entity_id"]
+    auth_override["entity_id"] = other
+    r = await api.delete(f"/api/comments/{comment['id']}")
+    assert r.status_code == 403
+    auth_override["entity_id"] = owner
+
+    r = await api.delete(f"/api/comments/{comment['id']}")
+    assert r.status_code == 200
+    r = await api.get(f"/api/entities/{entity_id}/comments")
+    assert r.json()["data"] == []
+
+
+@pytest.mark.asyncio
+async def test_context_comments_and_validation(api):
+    """Comment on a context item and reject bad input."""
+
+    created = await api.post(
+        "/api/context",
+        json={"title": "Runbook", "source_type": "article", "scopes": ["public"]},
+    )
+    context_id = created.json()["data"]["id"]
+
+    r = await api.post(f"/api/context/{context_id}/comments", json={"body": "Outdated"})
+    assert r.status_code == 200
+    r = await api.get(f"/api/context/{context_id}/comments")
+    assert [c["body"] for c in r.json()["data"]] == ["Outdated"]
+
+    r = await api.post(f"/api/context/{context_id}/comments", json={"body": "   "})
+    assert r.status_code == 422
+    r = await api.get("/api/entities/not-a-uuid/comments")
+    assert r.status_code == 400
+    r = await api.get(
+        "/api/context/00000000-0000-0000-0000-000000000001/comments"
+    )
+    assert r.status_code == 404
@@ -168,6 +168,32 @@ async def test_get_context_validation_and_not_found(api):
     assert missing.status_code == 404
 
 
+@pytest.mark.asyncio
+async def test_get_context_history(api):
+    """History route should list audit entries for a visible context item."""
+
+    created = await api.post(
+        "/api/context",
+        json={"title": "Watched", "source_type": "article", "scopes": ["public"]},
+    )
+    context_id = created.json()["data"]["id"]
+    await api.patch(f"/api/context/{context_id}", json={"title": "Watched v2"})
+
+    r = await api.get(f"/api/context/{context_id}/history")
+    assert r.status_code == 200
+    rows = r.json()["data"]
+    assert len(rows) >= 2
+    assert all(row["record_id"] == context_id for row in rows)
+
+    invalid = await api.get("/api/context/not-a-uuid/history")
+    assert invalid.status_code == 400
+
+    missing = await api.get(
+        "/api/context/00000000-0000-0000-0000-000000000001/history"
+    )
+    assert missing.status_code == 404
+
+
 @pytest.mark.asyncio
 async def test_link_context_validation_and_relationship_type_errors(api):
     """Link route should reject invalid ids and unknown relationship types."""
@@ -547,6 +573,33 @@ async def test_update_context_accepts_valid_url_and_null_tags(api):
     assert resp.json()["data"]["url"] == "https://example.com/new"
 
 
+@pytest.mark.asyncio
+async def test_update_context_rejects_stale_expected_updated_at(api):
+    """Updates carrying an outdated expected_updated_at return 409."""
+
+    created = (
+        await api.post(
+            "/api/context",
+            json={"title": "Ctx Conflict", "scopes": ["public"]},
+        )
+    ).json()["data"]
+    seen = (await api.get(f"/api/context/{created['id']}")).json()["data"]
+
+    resp = await api.patch(
+        f"/api/context/{created['id']}",
+        json={"title": "Ctx Mine", "expected_updated_at": seen["updated_at"]},
+    )
+    assert resp.status_code == 200, resp.text
+
+    resp = await api.patch(
+        f"/api/context/{created['id']}",
+        json={"title": "Ctx Theirs", "expected_updated_at": seen["updated_at"]},
+    )
+    assert resp.status_code == 409
+    current = await api.get(f"/api/context/{created['id']}")
+    assert current.json()["data"]["title"] == "Ctx Mine"
+
+
 @pytest.mark.asyncio
 async def test_update_context_metadata_patch_merges_nested_keys(api):
     """Update should deep-merge context metadata patches."""
@@ -247,6 +247,76 @@ async def test_query_entities(api):
     assert len(data) >= 1
 
 
+@pytest.mark.asyncio
+async def test_query_entities_filters_by_scope_names(api):
+    """Scope filters narrow results and never widen visibility."""
+
+    await api.post(
+        "/api/entities",
+        json={"name": "ScopedPublic", "type": "project", "scopes": ["public"]},
+    )
+    await api.post(
+        "/api/entities",
+        json={"name": "ScopedPrivate", "type": "project", "scopes": ["private"]},
+    )
+
+    r = await api.get("/api/entities", params={"scopes": "private"})
+    assert r.status_code == 200
+    names = {e["name"] for e in r.json()["data"]}
+    assert "ScopedPrivate" in names
+    assert "ScopedPublic" not in names
+
+    r = await api.get("/api/entities", params={"scopes": "no-such-scope"})
+    assert r.json()["data"] == []
+
+
+@pytest.mark.asyncio
+async def test_query_entities_advanced_filters(api):
+    """Status, updated range, and metadata filters combine with AND."""
+
+    await api.post(
+        "/api/entities",
+        json={
+            "name": "AdvancedHigh",
+            "type": "project",
+            "status": "active",
+            "scopes": ["public"],
+            "metadata": {"priority": "high"},
+        },
+    )
+    await api.post(
+        "/api/entities",
+        json={
+            "name": "AdvancedLow",
+            "type": "project",
+            "status": "active",
+            "scopes": ["public"],
+            "metadata": {"priority": "low"},
+        },
+    )
+
+    r = await api.get(
+        "/api/entities",
+        params={
+            "status": "active",
+            "updated_after": "2024-01-01",
+            "metadata": json.dumps({"priority": "high"}),
+        },
+    )
+    assert r.status_code == 200
+    names = {e["name"] for e in r.json()["data"]}
+    assert "AdvancedHigh" in names
+    assert "AdvancedLow" not in names
+
+    r = await api.get("/api/entities", params={"updated_before": "2000-01-01"})
+    assert r.json()["data"] == []
+
+    r = await api.get("/api/entities", params={"updated_after": "yesterday"})
+    assert r.status_code == 400
+    r = await api.get("/api/entities", params={"metadata": "[1]"})
+    assert r.status_code == 400
+
+
 @pytest.mark.asyncio
 async def test_entities_metadata_constraint_rejects_stringified_payload(db_pool, enums):
     """Database should reject stringified metadata payload storage."""
@@ -503,6 +573,50 @@ async def test_update_entity(api, test_entity):
     assert r.status_code == 200
 
 
+@pytest.mark.asyncio
+async def test_update_entity_rejects_stale_expected_updated_at(
+    api, db_pool, test_entity
+):
+    """Updates carrying an outdated expected_updated_at return 409."""
+
+    seen = await db_pool.fetchval(
+        "SELECT updated_at FROM entities WHERE id = $1", test_entity["id"]
+    )
+    r = await api.patch(
+        f"/api/entities/{test_entity['id']}",
+        json={"tags": ["first"], "expected_updated_at": seen.isoformat()},
+    )
+    assert r.status_code == 200, r.text
+
+    r = await api.patch(
+        f"/api/entities/{test_entity['id']}",
+        json={"tags": ["second"], "expected_updated_at": seen.isoformat()},
+    )
+    assert r.status_code == 409
+    assert r.json()["detail"]["error"]["code"] == "CONFLICT"
+    tags = await db_pool.fetchval(
+        "SELECT tags FROM entities WHERE id = $1", test_entity["id"]
+    )
+    assert tags == ["first"]
+
+    r = await api.patch(
+        f"/api/entities/{test_entity['id']}",
+        json={"tags": ["second"], "expected_updated_at": "yesterday"},
+    )
+    assert r.status_code == 400
+
+
+@pytest.mark.asyncio
+async def test_update_entity_rejects_unknown_type(api, test_entity):
+    """Update route should reject entity types outside the taxonomy."""
+
+    r = await api.patch(
+        f"/api/entities/{test_entity['id']}",
+        json={"type": "not-a-real-type"},
+    )
+    assert r.status_code == 400
+
+
 @pytest.mark.asyncio
 async def test_update_entity_normalizes_string_metadata_response(api, test_entity, monkeypatch):
     """Update route should normalize malformed string metadata payloads."""
@@ -1262,6 +1376,70 @@ async def test_entity_history_success_returns_rows(api, test_entity):
     assert isinstance(r.json()["data"], list)
 
 
+@pytest.mark.asyncio
+async def test_entity_references_combine_links_and_mentions(
+    api, db_pool, enums, test_entity
+):
+    """References list linked and mentioning items the caller can see."""
+
+    active_id = enums.statuses.name_to_id["active"]
+    public_id = enums.scopes.name_to_id["public"]
+    for title, scope in (
+        ("Notes on api-test-user", "public"),
+        ("Secret api-test-user file", "sensitive"),
+    ):
+        await db_pool.execute(
+            """
+            INSERT INTO context_items (title, source_type, privacy_scope_ids, status_id)
+            VALUES ($1, 'note', $2::uuid[], $3)
+            """,
+            title,
+            [enums.scopes.name_to_id[scope]],
+            active_id,
+        )
+    job_id = await db_pool.fetchval(
+        """
+        INSERT INTO jobs (title, status_id, privacy_scope_ids)
+        VALUES ('Quarterly review', $1, $2::uuid[])
+        RETURNING id
+        """,
+        active_id,
+        [public_id],
+    )
+    await db_pool.execute(
+        """
+        INSERT INTO relationships (source_type, source_id, target_type, target_id, type_id, status_id)
+        VALUES ('job', $1, 'entity', $2, $3, $4)
+        """,
+        job_id,
+        str(test_entity["id"]),
+        enums.relationship_types.name_to_id["related-to"],
+        active_id,
+    )
+    await db_pool.execute(
+        """
+        INSERT INTO logs (log_type_id, timestamp, value, status_id)
+        VALUES ($1, NOW(), $2::jsonb, $3)
+        """,
+        enums.log_types.name_to_id["note"],
+        json.dumps({"text": "Pinged API-TEST-USER"}),
+        active_id,
+    )
+
+    r = await api.get(f"/api/entities/{test_entity['id']}/references")
+    assert r.status_code == 200, r.text
+    refs = [(ref["kind"], ref["title"], ref["via"]) for ref in r.json()["data"]]
+    assert refs[0] == ("context", "Notes on api-test-user", "mentions")
+    assert refs[1] == ("job", "Quarterly review", "related-to")
+    assert refs[2][0] == "log" and refs[2][2] == "mentions"
+    assert len(refs) == 3
+
+    r = await api.get(
+        "/api/entities/00000000-0000-0000-0000-000000000000/references"
+    )
+    assert r.status_code == 404
+
+
 @pytest.mark.asyncio
 async def test_revert_entity_forbidden_for_agents(db_pool, enums, test_entity):
     """Entity revert should be blocked for agent callers."""
@@ -1315,6 +1493,70 @@ async def test_revert_entity_forbidden_for_agents(db_pool, enums, test_entity):
     assert r.json()["detail"]["error"]["code"] == "FORBIDDEN"
 
 
+@pytest.mark.asyncio
+async def test_revert_entity_restores_only_selected_fields(api, db_pool, test_entity):
+    """Revert with fields should leave the other columns untouched."""
+
+    entity_id = str(test_entity["id"])
+    await db_pool.execute(
+        "UPDATE entities SET name = $2, tags = $3 WHERE id = $1::uuid",
+        entity_id,
+        "Revert Midpoint",
+        ["mid"],
+    )
+    audit_id = await db_pool.fetchval(
+        """
+        SELECT id
+        FROM audit_log
+        WHERE table_name = 'entities' AND record_id = $1
+        ORDER BY changed_at DESC
+        LIMIT 1
+        """,
+        entity_id,
+    )
+    await db_pool.execute(
+        "UPDATE entities SET name = $2, tags = $3 WHERE id = $1::uuid",
+        entity_id,
+        "Revert Current",
+        ["now"],
+    )
+
+    r = await api.post(
+        f"/api/entities/{entity_id}/revert",
+        json={"audit_id": str(audit_id), "fields": ["name"]},
+    )
+    assert r.status_code == 200
+
+    row = await db_pool.fetchrow(
+        "SELECT name, tags FROM entities WHERE id = $1::uuid", entity_id
+    )
+    assert row["name"] == "Revert Midpoint"
+    assert row["tags"] == ["now"]
+
+
+@pytest.mark.asyncio
+async def test_revert_entity_rejects_unknown_fields(api, db_pool, test_entity):
+    """Revert should reject fields it cannot restore."""
+
+    entity_id = str(test_entity["id"])
+    audit_id = await db_pool.fetchval(
+        "SELECT id FROM audit_log WHERE record_id = $1 LIMIT 1", entity_id
+    )
+
+    r = await api.post(
+        f"/api/entities/{entity_id}/revert",
+        json={"audit_id": str(audit_id), "fields": ["created_at"]},
+    )
+    assert r.status_code == 400
+    assert r.json()["detail"]["error"]["code"] == "INVALID_INPUT"
+
+    r = await api.post(
+        f"/api/entities/{entity_id}/revert",
+        json={"audit_id": str(audit_id), "fields": []},
+    )
+    assert r.status_code == 400
+
+
 @pytest.mark.asyncio
 async def test_search_by_metadata(api):
     """Test search by metadata."""
@@ -1398,3 +1640,61 @@ async def test_query_with_pagination(api):
     meta = r.json()["meta"]
     assert meta["limit"] == 2
     assert meta["offset"] == 0
+
+
+@pytest.mark.asyncio
+async def test_delete_entity_requires_archive_then_removes_relationships(api):
+    """Permanent delete should only remove archived entities and their edges."""
+
+    source = await api.post(
+        "/api/entities",
+        json={"name": "Trash Source", "type": "person", "scopes": ["public"]},
+    )
+    target = await api.post(
+        "/api/entities",
+        json={"name": "Trash Target", "type": "person", "scopes": ["public"]},
+    )
+    source_id = source.json()["data"]["id"]
+    target_id = target.json()["data"]["id"]
+    rel = await api.post(
+        "/api/relationships",
+        json={
+            "source_type": "entity",
+            "source_id": str(source_id),
+            "target_type": "entity",
+            "target_id": str(target_id),
+            "relationship_type": "depends-on",
+        },
+    )
+    assert rel.status_code == 200, rel.text
+
+    active_delete = await api.delete(f"/api/entities/{source_id}")
+    assert active_delete.status_code == 409
+    assert active_delete.json()["detail"]["error"]["code"] == "CONFLICT"
+
+    archive = await api.patch(f"/api/entities/{source_id}", json={"status": "archived"})
+    assert archive.status_code == 200, archive.text
+
+    r = await api.delete(f"/api/entities/{source_id}")
+    assert r.status_code == 200, r.text
+    assert r.json()["data"]["relationships_deleted"] >= 1
+
+    assert (await api.get(f"/api/entities/{source_id}")).status_code == 404
+    remaining = await api.get(f"/api/relationships/entity/{target_id}")
+    assert remaining.json()["data"] == []
+
+
+@pytest.mark.asyncio
+async def test_delete_entity_invalid_id_returns_400(api):
+    """Permanent delete should reject malformed ids."""
+
+    r = await api.delete("/api/entities/not-a-uuid")
+    assert r.status_code == 400
+
+
+@pytest.mark.asyncio
+async def test_delete_entity_rejects_agent_callers(api_agent_auth):
+    """Permanent delete is limited to users."""
+
+    r = await api_agent_auth.delete("/api/entities/00000000-0000-0000-0000-000000000000")
+    assert r.status_code == 403
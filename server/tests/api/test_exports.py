@@ -116,3 +116,46 @@ async def test_export_snapshot_rejects_non_json_format(api):
     r = await api.get("/api/export/snapshot", params={"format": "csv"})
     assert r.status_code == 400
     assert r.json()["detail"]["error"]["code"] == "VALIDATION_ERROR"
+
+
+@pytest.mark.asyncio
+async def test_export_entities_pages_report_total_on_first_page(api):
+    """Paged entity exports should count matches on the first page only."""
+
+    for i in range(3):
+        await api.post(
+            "/api/entities",
+            json={"name": f"PagedExport{i}", "type": "person", "scopes": ["public"]},
+        )
+    params = {"search_text": "PagedExport", "limit": 2}
+    first = (await api.get("/api/export/entities", params=params)).json()["data"]
+    assert first["total"] == 3
+    assert first["count"] == 2
+
+    second = (
+        await api.get("/api/export/entities", params={**params, "offset": 2})
+    ).json()["data"]
+    assert "total" not in second
+    assert second["count"] == 1
+    names = {item["name"] for item in first["items"] + second["items"]}
+    assert names == {"PagedExport0", "PagedExport1", "PagedExport2"}
+
+
+@pytest.mark.asyncio
+async def test_export_jobs_and_relationships_accept_offset(api):
+    """Job and relationship exports should page with offset."""
+
+    for i in range(3):
+        await api.post("/api/jobs", json={"title": f"Paged job {i}", "priority": "low"})
+    first = (await api.get("/api/export/jobs", params={"limit": 2})).json()["data"]
+    rest = (
+        await api.get("/api/export/jobs", params={"limit": 2, "offset": 2})
+    ).json()["data"]
+    assert first["total"] >= 3
+    assert first["count"] == 2
+    first_ids = {item["id"] for item in first["items"]}
+    assert first_ids.isdisjoint({item["id"] for item in rest["items"]})
+
+    r = await api.get("/api/export/relationships", params={"limit": 1, "offset": 10000})
+    assert r.status_code == 200
+    assert r.json()["data"]["count"] == 0
@@ -687,3 +687,138 @@ async def test_import_entities_trusted_agent_runs_direct_write_path(api_agent_au
     data = resp.json()["data"]
     assert data["created"] == 1
     assert data["failed"] == 0
+
+
+@pytest.mark.asyncio
+async def test_validate_import_entities_reports_rows_without_writing(api):
+    """Dry runs should classify rows and leave the database untouched."""
+
+    existing = await api.post(
+        "/api/entities",
+        json={"name": "Dry Run Existing", "type": "person", "scopes": ["public"]},
+    )
+    assert existing.status_code == 200
+    payload = {
+        "format": "json",
+        "items": [
+            {"name": "Dry Run New", "type": "person", "tags": ["dry-run-fresh-tag"]},
+            {"name": "Dry Run Existing", "type": "person", "scopes": ["public"]},
+            {"name": "Dry Run Bad Type", "type": "not-a-type"},
+            {"name": "Dry Run Bad Scope", "type": "person", "scopes": ["nowhere"]},
+            {"name": "Dry Run New", "type": "person"},
+            {"type": "person"},
+        ],
+    }
+    r = await api.post("/api/import/entities/validate", json=payload)
+    assert r.status_code == 200
+    data = r.json()["data"]
+    assert data["total"] == 6
+    assert data["counts"] == {"create": 1, "update": 0, "skip": 2, "invalid": 3}
+    rows = data["rows"]
+    assert rows[0]["action"] == "create"
+    assert rows[0]["warnings"] == ["New tag: dry-run-fresh-tag"]
+    assert rows[1]["action"] == "skip"
+    assert rows[1]["duplicates"][0]["id"] == existing.json()["data"]["id"]
+    assert rows[2]["action"] == "invalid"
+    assert "Unknown scopes: nowhere" in rows[3]["errors"]
+    assert rows[4]["warnings"] == ["Duplicate of row 1"]
+    assert rows[5]["errors"] == ["Entity name and type are required"]
+    assert rows[0]["item"] == payload["items"][0]
+
+    listed = await api.get("/api/entities", params={"search_text": "Dry Run New"})
+    assert listed.json()["data"] == []
+
+
+@pytest.mark.asyncio
+async def test_validate_import_context_accepts_markdown(api):
+    """Markdown context imports should validate one row per heading."""
+
+    data = "---\ntags: notes\n---\n# First note\nalpha\n\n# Second note\nbeta\n"
+    r = await api.post(
+        "/api/import/context/validate", json={"format": "markdown", "data": data}
+    )
+    assert r.status_code == 200
+    body = r.json()["data"]
+    assert body["counts"]["create"] == 2
+    assert [row["label"] for row in body["rows"]] == ["First note", "Second note"]
+
+
+@pytest.mark.asyncio
+async def test_validate_import_unknown_resource_returns_404(api):
+    """Unknown import resources should be rejected."""
+
+    r = await api.post("/api/import/widgets/validate", json={"format": "json"})
+    assert r.status_code == 404
+
+
+@pytest.mark.asyncio
+async def test_import_entities_external_id_updates_on_rerun(api):
+    """Re-running an import with the same external IDs should update in place."""
+
+    item = {
+        "external_id": "crm-1001",
+        "name": "External Id Entity",
+        "type": "person",
+        "scopes": ["public"],
+        "tags": ["first-run"],
+    }
+    first = await api.post(
+        "/api/import/entities", json={"format": "json", "items": [item]}
+    )
+    assert first.status_code == 200
+    first_data = first.json()["data"]
+    assert first_data["created"] == 1
+    assert first_data["updated"] == 0
+    entity_id = str(first_data["items"][0]["id"])
+
+    rerun = {"format": "json", "items": [{**item, "tags": ["second-run"]}]}
+    report = await api.post("/api/import/entities/validate", json=rerun)
+    assert report.status_code == 200
+    row = report.json()["data"]["rows"][0]
+    assert row["action"] == "update"
+    assert row["external_id"] == "crm-1001"
+    assert row["record_id"] == entity_id
+    assert report.json()["data"]["counts"]["update"] == 1
+
+    second = await api.post("/api/import/entities", json=rerun)
+    assert second.status_code == 200
+    second_data = second.json()["data"]
+    assert second_data["created"] == 0
+    assert second_data["updated"] == 1
+    assert second_data["failed"] == 0
+
+    fetched = await api.get(f"/api/entities/{entity_id}")
+    assert fetched.json()["data"]["tags"] == ["second-run"]
+
+
+@pytest.mark.asyncio
+async def test_import_context_external_id_updates_content_and_skips_repeats(api):
+    """Context rows keyed by external ID should update, and repeats in one file skip."""
+
+    item = {
+        "external_id": "notes/ext-1",
+        "title": "External Note",
+        "source_type": "note",
+        "content": "v1",
+    }
+    first = await api.post(
+        "/api/import/context", json={"format": "json", "items": [item]}
+    )
+    assert first.json()["data"]["created"] == 1
+    context_id = str(first.json()["data"]["items"][0]["id"])
+
+    rerun = {
+        "format": "json",
+        "items": [{**item, "content": "v2"}, {**item, "content": "v3"}],
+    }
+    report = await api.post("/api/import/context/validate", json=rerun)
+    rows = report.json()["data"]["rows"]
+    assert [row["action"] for row in rows] == ["update", "skip"]
+    assert rows[1]["warnings"] == ["Duplicate of row 1"]
+
+    second = await api.post(
+        "/api/import/context", json={"format": "json", "items": rerun["items"][:1]}
+    )
+    assert second.json()["data"]["updated"] == 1
+    fetched = await api.get(f"/api/context/{context_id}")
+    assert fetched.json()["data"]["content"] == "v2"
@@ -68,6 +68,27 @@ async def test_create_job_accepts_iso_due_at(api):
     assert data["due_at"] is not None
 
 
+@pytest.mark.asyncio
+async def test_update_job_assigned_to_null_clears_existing_value(api, test_entity):
+    """Explicit assigned_to null should clear an existing assignee."""
+
+    created = await api.post(
+        "/api/jobs",
+        json={"title": "Assignee Clear", "assigned_to": str(test_entity["id"])},
+    )
+    assert created.status_code == 200
+    job_id = created.json()["data"]["id"]
+    assert created.json()["data"]["assigned_to"] is not None
+
+    kept = await api.patch(f"/api/jobs/{job_id}", json={"title": "Still Assigned"})
+    assert kept.status_code == 200
+    assert kept.json()["data"]["assigned_to"] is not None
+
+    patched = await api.patch(f"/api/jobs/{job_id}", json={"assigned_to": None})
+    assert patched.status_code == 200
+    assert patched.json()["data"]["assigned_to"] is None
+
+
 @pytest.mark.asyncio
 @pytest.mark.parametrize(
     "due_at",
@@ -171,3 +171,56 @@ async def test_update_protocol_missing_row_returns_empty_payload(api):
     )
     assert resp.status_code == 200
     assert resp.json()["data"] == {}
+
+
+@pytest.mark.asyncio
+async def test_dry_run_protocol_fills_placeholders_and_lists_steps(api):
+    """Protocol dry run should render sample input without saving it."""
+
+    content = (
+        "# Setup\n"
+        "1. Greet {{ owner.name }}\n"
+        "2. Open {{ repo }}\n"
+        "```\n"
+        "- not a step\n"
+        "```\n"
+        "## Ship\n"
+        "- Tag {{version}}\n"
+    )
+    create = await api.post(
+        "/api/protocols/",
+        json={
+            "name": "dry-run-sample",
+            "title": "Dry Run Sample",
+            "version": "1.0.0",
+            "content": content,
+            "status": "active",
+        },
+    )
+    assert create.status_code == 200
+
+    resp = await api.post(
+        "/api/protocols/dry-run-sample/dry-run",
+        json={"input": {"owner": {"name": "Ada"}, "repo": "nebula", "extra": 1}},
+    )
+    assert resp.status_code == 200
+    data = resp.json()["data"]
+    assert data["steps"] == [
+        {"index": 1, "section": "Setup", "text": "Greet Ada"},
+        {"index": 2, "section": "Setup", "text": "Open nebula"},
+        {"index": 3, "section": "Ship", "text": "Tag {{version}}"},
+    ]
+    assert data["placeholders"] == ["owner.name", "repo", "version"]
+    assert data["missing"] == ["version"]
+    assert data["unused"] == ["extra"]
+
+    fetched = await api.get("/api/protocols/dry-run-sample")
+    assert fetched.json()["data"]["content"] == content
+
+
+@pytest.mark.asyncio
+async def test_dry_run_protocol_not_found(api):
+    """Protocol dry run should return 404 when name does not exist."""
+
+    resp = await api.post("/api/protocols/missing-protocol/dry-run", json={})
+    assert resp.status_code == 404
@@ -257,3 +257,33 @@ async def test_update_relationship_accepts_archived_alias(api, db_pool):
     )
     assert row["category"] == "archived"
     assert row["name"] in archived_names
+
+
+@pytest.mark.asyncio
+async def test_delete_relationship_requires_archive(api):
+    """Permanent relationship delete should refuse active relationships."""
+
+    e1 = await _make_entity(api, "DelSrc")
+    e2 = await _make_entity(api, "DelTgt")
+    cr = await api.post(
+        "/api/relationships",
+        json={
+            "source_type": "entity",
+            "source_id": str(e1["id"]),
+            "target_type": "entity",
+            "target_id": str(e2["id"]),
+            "relationship_type": "depends-on",
+        },
+    )
+    rel_id = cr.json()["data"]["id"]
+
+    r = await api.delete(f"/api/relationships/{rel_id}")
+    assert r.status_code == 409
+
+    await api.patch(f"/api/relationships/{rel_id}", json={"status": "archived"})
+    r = await api.delete(f"/api/relationships/{rel_id}")
+    assert r.status_code == 200
+    assert r.json()["data"]["id"] == rel_id
+
+    r = await api.delete(f"/api/relationships/{rel_id}")
+    assert r.status_code == 404
@@ -48,6 +48,7 @@ async def test_get_schema_includes_enterprise_taxonomy(api):
     assert builtin_relationship_types == {
         "about",
         "assigned-to",
+        "attachment",
         "blocks",
         "created-by",
         "depends-on",
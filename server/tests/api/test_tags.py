@@ -0,0 +1,134 @@
+"""Tag route tests."""
+
+# Standard Library
+import json
+
+# Third-Party
+import pytest
+
+pytestmark = pytest.mark.api
+
+
+async def _insert_entity(db_pool, enums, name: str, scope: str, tags: list[str]):
+    """Insert an entity and return its id."""
+
+    return await db_pool.fetchval(
+        """
+        INSERT INTO entities (name, type_id, status_id, privacy_scope_ids, tags, metadata)
+        VALUES ($1, $2, $3, $4, $5, $6::jsonb)
+        RETURNING id
+        """,
+        name,
+        enums.entity_types.name_to_id["project"],
+        enums.statuses.name_to_id["active"],
+        [enums.scopes.name_to_id[scope]],
+        tags,
+        json.dumps({}),
+    )
+
+
+async def _insert_context(db_pool, enums, title: str, tags: list[str]):
+    """Insert a public context item and return its id."""
+
+    return await db_pool.fetchval(
+        """
+        INSERT INTO context_items (title, source_type, privacy_scope_ids, status_id, tags, metadata)
+        VALUES ($1, $2, $3::uuid[], $4::uuid, $5, $6::jsonb)
+        RETURNING id
+        """,
+        title,
+        "note",
+        [enums.scopes.name_to_id["public"]],
+        enums.statuses.name_to_id["active"],
+        tags,
+        json.dumps({}),
+    )
+
+
+@pytest.mark.asyncio
+async def test_list_tags_counts_visible_usage(api, db_pool, enums):
+    """Non-admin callers see counts only for records in their scopes."""
+
+    await _insert_entity(db_pool, enums, "Tagged", "public", ["ops", "infra"])
+    await _insert_entity(db_pool, enums, "Hidden", "sensitive", ["ops", "secret"])
+    await _insert_context(db_pool, enums, "Runbook", ["ops"])
+
+    r = await api.get("/api/tags")
+    assert r.status_code == 200, r.text
+    rows = {row["name"]: row for row in r.json()["data"]}
+    assert rows["ops"]["entities"] == 1
+    assert rows["ops"]["knowledge"] == 1
+    assert rows["infra"]["entities"] == 1
+    assert "secret" not in rows
+
+    r = await api.get("/api/tags", params={"search": "inf"})
+    assert [row["name"] for row in r.json()["data"]] == ["infra"]
+
+
+@pytest.mark.asyncio
+async def test_tag_management_requires_admin(api):
+    """Rename, merge, and delete-unused are admin only."""
+
+    for path, body in (
+        ("/api/tags/rename", {"from": "a", "to": "b"}),
+        ("/api/tags/merge", {"source": "a", "target": "b"}),
+        ("/api/tags/delete-unused", {}),
+    ):
+        r = await api.post(path, json=body)
+        assert r.status_code == 403, path
+
+
+@pytest.mark.asyncio
+async def test_rename_and_merge_tags(api, db_pool, enums, auth_override):
+    """Rename rewrites every record; merge folds a tag into another."""
+
+    auth_override["scopes"] = [enums.scopes.name_to_id["admin"]]
+    entity_id = await _insert_entity(
+        db_pool, enums, "Tagged", "public", ["ops", "infra"]
+    )
+    context_id = await _insert_context(db_pool, enums, "Runbook", ["infra"])
+
+    r = await api.post("/api/tags/rename", json={"from": "infra", "to": "ops"})
+    assert r.status_code == 409
+
+    r = await api.post("/api/tags/rename", json={"from": "infra", "to": "platform"})
+    assert r.status_code == 200, r.text
+    assert r.json()["data"]["entities_updated"] == 1
+    assert r.json()["data"]["knowledge_updated"] == 1
+    context_tags = await db_pool.fetchval(
+        "SELECT tags FROM context_items WHERE id = $1", context_id
+    )
+    assert context_tags == ["platform"]
+
+    r = await api.post("/api/tags/merge", json={"source": "platform", "target": "ops"})
+    assert r.status_code == 200, r.text
+    entity_tags = await db_pool.fetchval(
+        "SELECT tags FROM entities WHERE id = $1", entity_id
+    )
+    assert entity_tags == ["ops"]
+    names = await db_pool.fetch("SELECT name FROM tags ORDER BY name")
+    assert [row["name"] for row in names] == ["ops"]
+
+
+@pytest.mark.asyncio
+async def test_delete_unused_tags_keeps_used_ones(api, db_pool, enums, auth_override):
+    """Tags that lost their last use stay listed until deleted."""
+
+    auth_override["scopes"] = [enums.scopes.name_to_id["admin"]]
+    entity_id = await _insert_entity(
+        db_pool, enums, "Tagged", "public", ["ops", "stale"]
+    )
+    await db_pool.execute(
+        "UPDATE entities SET tags = $2 WHERE id = $1", entity_id, ["ops"]
+    )
+
+    r = await api.get("/api/tags")
+    rows = {row["name"]: row for row in r.json()["data"]}
+    assert rows["stale"]["entities"] == 0
+
+    r = await api.post("/api/tags/delete-unused", json={"tags": ["ops", "stale"]})
+    assert r.status_code == 200, r.text
+    assert r.json()["data"]["deleted"] == ["stale"]
+
+    r = await api.get("/api/tags")
+    assert [row["name"] for row in r.json()["data"]] == ["ops"]
@@ -138,6 +138,95 @@ async def test_taxonomy_crud_roundtrip(api_admin, kind, payload, patch_payload):
     assert activated.json()["data"]["is_active"] is True
 
 
+@pytest.mark.asyncio
+async def test_taxonomy_entity_type_value_schema_roundtrip(api_admin):
+    """Entity types store and return a metadata value_schema."""
+
+    schema = {
+        "required": ["owner"],
+        "properties": {"stage": {"type": "string", "enum": ["idea", "done"]}},
+    }
+    create = await api_admin.post(
+        "/api/taxonomy/entity-types",
+        json={"name": "sdk-schema-entity", "value_schema": schema},
+    )
+    assert create.status_code == 200, create.text
+    item = create.json()["data"]
+    assert json.loads(item["value_schema"]) == schema
+
+    listing = await api_admin.get(
+        "/api/taxonomy/entity-types", params={"search": "sdk-schema-entity"}
+    )
+    assert listing.status_code == 200, listing.text
+    listed = listing.json()["data"][0]
+    assert json.loads(listed["value_schema"]) == schema
+
+    update = await api_admin.patch(
+        f"/api/taxonomy/entity-types/{item['id']}",
+        json={"value_schema": {"required": ["stage"]}},
+    )
+    assert update.status_code == 200, update.text
+    assert json.loads(update.json()["data"]["value_schema"]) == {
+        "required": ["stage"]
+    }
+
+    rejected = await api_admin.post(
+        "/api/taxonomy/scopes",
+        json={"name": "sdk-schema-scope", "value_schema": schema},
+    )
+    assert rejected.status_code == 400
+
+
+@pytest.mark.asyncio
+async def test_taxonomy_relationship_type_rules_roundtrip(api_admin):
+    """Relationship types store inverse names and entity type allow-lists."""
+
+    create = await api_admin.post(
+        "/api/taxonomy/relationship-types",
+        json={
+            "name": "sdk-employed-by",
+            "inverse_name": "employs",
+            "source_entity_types": ["person", "person"],
+            "target_entity_types": ["organization"],
+        },
+    )
+    assert create.status_code == 200, create.text
+    item = create.json()["data"]
+    assert item["inverse_name"] == "employs"
+    assert item["source_entity_types"] == ["person"]
+    assert item["target_entity_types"] == ["organization"]
+
+    update = await api_admin.patch(
+        f"/api/taxonomy/relationship-types/{item['id']}",
+        json={"inverse_name": "", "target_entity_types": []},
+    )
+    assert update.status_code == 200, update.text
+    updated = update.json()["data"]
+    assert updated["inverse_name"] is None
+    assert updated["source_entity_types"] == ["person"]
+    assert updated["target_entity_types"] == []
+
+
+@pytest.mark.asyncio
+async def test_taxonomy_relationship_type_rules_reject_unknown_entity_type(
+    api_admin,
+):
+    """Allow-lists must name existing entity types."""
+
+    resp = await api_admin.post(
+        "/api/taxonomy/relationship-types",
+        json={"name": "sdk-bad-rules", "source_entity_types": ["nope"]},
+    )
+    assert resp.status_code == 400
+    assert "nope" in resp.text
+
+    scoped = await api_admin.post(
+        "/api/taxonomy/scopes",
+        json={"name": "sdk-scope-rules", "inverse_name": "x"},
+    )
+    assert scoped.status_code == 400
+
+
 @pytest.mark.asyncio
 async def test_taxonomy_list_supports_search_and_pagination(api_admin):
     """List endpoint supports include_inactive, search, limit and offset."""
@@ -215,6 +304,98 @@ async def test_taxonomy_scope_archive_conflict_when_referenced(
     assert body["detail"]["error"]["code"] == "CONFLICT"
 
 
+@pytest.mark.asyncio
+async def test_taxonomy_scope_merge_moves_records_and_archives_source(
+    api_admin, db_pool, enums
+):
+    """Merging a scope retags entities and context, archives it, and audits."""
+
+    created = await api_admin.post(
+        "/api/taxonomy/scopes",
+        json={"name": "sdk-scope-merge-src", "description": "merge source"},
+    )
+    assert created.status_code == 200, created.text
+    source = created.json()["data"]
+    target_id = enums.scopes.name_to_id["public"]
+
+    entity_id = await db_pool.fetchval(
+        """
+        INSERT INTO entities (name, type_id, status_id, privacy_scope_ids, tags, metadata)
+        VALUES ($1, $2, $3, $4, $5, $6::jsonb)
+        RETURNING id
+        """,
+        "sdk-scope-merge-entity",
+        enums.entity_types.name_to_id["person"],
+        enums.statuses.name_to_id["active"],
+        [source["id"], target_id],
+        ["sdk"],
+        json.dumps({"source": "test"}),
+    )
+    context_id = await db_pool.fetchval(
+        """
+        INSERT INTO context_items (title, source_type, privacy_scope_ids, status_id, tags, metadata)
+        VALUES ($1, $2, $3::uuid[], $4::uuid, $5, $6::jsonb)
+        RETURNING id
+        """,
+        "sdk-scope-merge-context",
+        "note",
+        [source["id"]],
+        enums.statuses.name_to_id["active"],
+        ["sdk"],
+        json.dumps({}),
+    )
+
+    resp = await api_admin.post(
+        f"/api/taxonomy/scopes/{source['id']}/merge",
+        json={"target_id": str(target_id)},
+    )
+    assert resp.status_code == 200, resp.text
+    data = resp.json()["data"]
+    assert data["entities_updated"] == 1
+    assert data["knowledge_updated"] == 1
+    assert data["audit_id"]
+
+    entity_scopes = await db_pool.fetchval(
+        "SELECT privacy_scope_ids FROM entities WHERE id = $1", entity_id
+    )
+    assert [str(s) for s in entity_scopes] == [str(target_id)]
+    context_scopes = await db_pool.fetchval(
+        "SELECT privacy_scope_ids FROM context_items WHERE id = $1", context_id
+    )
+    assert [str(s) for s in context_scopes] == [str(target_id)]
+
+    is_active = await db_pool.fetchval(
+        "SELECT is_active FROM privacy_scopes WHERE id = $1::uuid", source["id"]
+    )
+    assert is_active is False
+
+    audit = await db_pool.fetchrow(
+        "SELECT table_name, record_id, new_data FROM audit_log WHERE id = $1::uuid",
+        data["audit_id"],
+    )
+    assert audit["table_name"] == "privacy_scopes"
+    assert audit["record_id"] == source["id"]
+    assert json.loads(audit["new_data"])["merged_into"] == str(target_id)
+
+
+@pytest.mark.asyncio
+async def test_taxonomy_scope_merge_rejects_builtin_and_self(api_admin, enums):
+    """Built-in scopes cannot be merged away and a scope cannot merge into itself."""
+
+    public_id = str(enums.scopes.name_to_id["public"])
+    admin_id = str(enums.scopes.name_to_id["admin"])
+
+    resp = await api_admin.post(
+        f"/api/taxonomy/scopes/{public_id}/merge", json={"target_id": admin_id}
+    )
+    assert resp.status_code == 409
+
+    resp = await api_admin.post(
+        f"/api/taxonomy/scopes/{public_id}/merge", json={"target_id": public_id}
+    )
+    assert resp.status_code == 400
+
+
 @pytest.mark.asyncio
 async def test_taxonomy_entity_type_archive_conflict_when_referenced(
     api_admin, db_pool, enums
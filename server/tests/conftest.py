@@ -37,6 +37,18 @@ MIGRATION_FILES = [
     "018_context_core_rename.sql",
     "019_source_refs_and_files_uri.sql",
     "020_requires_approval_defaults.sql",
+    "021_relationship_type_rules.sql",
+    "022_collections.sql",
+    "023_comments.sql",
+    "024_attachment_relationship.sql",
+    "025_import_external_ids.sql",
+    "026_approval_priority.sql",
+    "027_agent_permissions.sql",
+    "028_audit_hash_chain.sql",
+    "029_tags.sql",
+    "030_entity_type_value_schema.sql",
+    "031_approval_delegation.sql",
+    "032_approval_comments.sql",
 ]
 
 TEST_DB = os.getenv("NEBULA_TEST_DB", "postgres")
@@ -61,6 +73,7 @@ MUTABLE_TABLES = [
     "entities",
     "agents",
     "external_refs",
+    "tags",
 ]
 
 
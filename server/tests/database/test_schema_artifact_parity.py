@@ -26,13 +26,19 @@ CORE_TABLES = {
     "agent_enrollment_sessions",
     "agents",
     "api_keys",
+    "approval_comments",
     "approval_requests",
+    "audit_chain_head",
     "audit_log",
+    "collection_items",
+    "collections",
+    "comments",
     "context_items",
     "entities",
     "entity_types",
     "external_refs",
     "files",
+    "import_external_ids",
     "jobs",
     "log_types",
     "logs",
@@ -42,6 +48,7 @@ CORE_TABLES = {
     "relationships",
     "semantic_search",
     "statuses",
+    "tags",
 }
 
 
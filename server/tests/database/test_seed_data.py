@@ -193,6 +193,7 @@ async def test_relationship_type_related_to_is_symmetric(db_pool):
         "mentions",
         "created-by",
         "has-file",
+        "attachment",
     ],
 )
 async def test_enterprise_relationship_types_not_symmetric(db_pool, rel_type_name):
@@ -224,4 +225,5 @@ async def test_active_relationship_types_are_minimal_enterprise_set(db_pool):
         "mentions",
         "created-by",
         "has-file",
+        "attachment",
     }
@@ -2,7 +2,9 @@
 
 # Standard Library
 import asyncio
+import hashlib
 import re
+from datetime import timezone
 
 import asyncpg
 import pytest
@@ -306,6 +308,87 @@ async def test_audit_log_update(db_pool, enums):
     assert "name" in audit["changed_fields"]
 
 
+# --- Audit Hash Chain ---
+
+
+def _audit_chain_hash(row) -> str:
+    """Recompute an audit entry hash the way clients verify exports."""
+
+    stamp = row["changed_at"].astimezone(timezone.utc)
+    stamp = stamp.strftime("%Y-%m-%dT%H:%M:%S.%fZ")
+    fields = [
+        row["prev_hash"],
+        str(row["id"]),
+        row["table_name"],
+        row["record_id"],
+        row["action"],
+        row["changed_by_type"],
+        str(row["changed_by_id"]) if row["changed_by_id"] else None,
+        row["old_data"],
+        row["new_data"],
+        ",".join(row["changed_fields"]) if row["changed_fields"] else None,
+        row["change_reason"],
+        stamp,
+    ]
+    payload = "".join(f"{len(v or '')}:{v or ''}" for v in fields)
+    return hashlib.sha256(payload.encode("utf-8")).hexdigest()
+
+
+@pytest.mark.asyncio
+async def test_audit_log_entries_form_hash_chain(db_pool, enums):
+    """Each audit entry hashes its contents and links to the previous hash."""
+
+    entity = await _make_entity(db_pool, enums, "audit-chain-entity")
+    await db_pool.execute(
+        "UPDATE entities SET name = $1 WHERE id = $2",
+        "audit-chain-renamed",
+        entity["id"],
+    )
+
+    rows = await db_pool.fetch(
+        """
+        SELECT * FROM audit_log
+        WHERE table_name = 'entities' AND record_id = $1
+        ORDER BY changed_at
+        """,
+        str(entity["id"]),
+    )
+    inserted, updated = rows[0], rows[-1]
+    assert inserted["action"] == "insert"
+    assert updated["action"] == "update"
+    for row in rows:
+        assert row["hash"] == _audit_chain_hash(row)
+    assert updated["prev_hash"] == inserted["hash"]
+
+    head = await db_pool.fetchrow("SELECT entry_id, hash FROM audit_chain_head")
+    assert head["entry_id"] == updated["id"]
+    assert head["hash"] == updated["hash"]
+
+
+@pytest.mark.asyncio
+async def test_audit_log_rejects_edits_but_allows_metadata(db_pool, enums):
+    """Chained audit columns are append-only; metadata links still work."""
+
+    entity = await _make_entity(db_pool, enums, "audit-guard-entity")
+    audit_id = await db_pool.fetchval(
+        "SELECT id FROM audit_log WHERE record_id = $1", str(entity["id"])
+    )
+
+    with pytest.raises(asyncpg.RaiseError):
+        await db_pool.execute(
+            "UPDATE audit_log SET action = 'delete' WHERE id = $1", audit_id
+        )
+
+    await db_pool.execute(
+        """
+        UPDATE audit_log
+        SET metadata = metadata || '{"approval_id": "x"}'::jsonb
+        WHERE id = $1
+        """,
+        audit_id,
+    )
+
+
 # --- Job ID Generation ---
 
 
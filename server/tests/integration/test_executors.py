@@ -539,6 +539,17 @@ class TestUpdateEntity:
 
         assert result["status_id"] == enums.statuses.name_to_id["on-hold"]
 
+    async def test_type_change(self, db_pool, enums, test_entity):
+        """Updating an entity type should swap its type id."""
+
+        result = await execute_update_entity(
+            db_pool,
+            enums,
+            {"entity_id": str(test_entity["id"]), "type": "project"},
+        )
+
+        assert result["type_id"] == enums.entity_types.name_to_id["project"]
+
     async def test_metadata_change(self, db_pool, enums, test_entity):
         """Updating entity metadata should merge with existing metadata."""
 
@@ -40,6 +40,27 @@ async def test_create_approval_request_returns_pending(db_pool, enums, untrusted
     )
 
     assert result["status"] == "pending"
+    assert result["priority"] == "normal"
+
+
+async def test_create_approval_request_keeps_priority(db_pool, enums, untrusted_agent):
+    """A known priority is stored; anything else falls back to normal."""
+
+    payload = {
+        "name": "Urgent Entity",
+        "type": "project",
+        "status": "active",
+        "scopes": ["public"],
+    }
+    urgent = await create_approval_request(
+        db_pool, str(untrusted_agent["id"]), "create_entity", payload, priority="HIGH"
+    )
+    unknown = await create_approval_request(
+        db_pool, str(untrusted_agent["id"]), "create_entity", payload, priority="asap"
+    )
+
+    assert urgent["priority"] == "high"
+    assert unknown["priority"] == "normal"
 
 
 # --- get_pending_approvals_all ---
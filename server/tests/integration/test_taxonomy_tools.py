@@ -110,3 +110,48 @@ async def test_archive_taxonomy_conflict_when_in_use(mock_mcp_context, db_pool,
             ToggleTaxonomyInput(kind="entity-types", item_id=str(created["id"])),
             mock_mcp_context,
         )
+
+
+async def test_relationship_type_rules_roundtrip(mock_mcp_context):
+    """Relationship types keep their inverse name and entity type rules."""
+
+    created = await create_taxonomy(
+        CreateTaxonomyInput(
+            kind="relationship-types",
+            name="maintains",
+            is_symmetric=False,
+            inverse_name="maintained-by",
+            source_entity_types=["person", "person"],
+            target_entity_types=["project", "tool"],
+        ),
+        mock_mcp_context,
+    )
+    assert created["inverse_name"] == "maintained-by"
+    assert created["source_entity_types"] == ["person"]
+    assert created["target_entity_types"] == ["project", "tool"]
+
+    updated = await update_taxonomy(
+        UpdateTaxonomyInput(
+            kind="relationship-types",
+            item_id=str(created["id"]),
+            inverse_name="",
+            target_entity_types=["project"],
+        ),
+        mock_mcp_context,
+    )
+    assert updated["inverse_name"] is None
+    assert updated["source_entity_types"] == ["person"]
+    assert updated["target_entity_types"] == ["project"]
+
+    with pytest.raises(ValueError, match="Unknown entity type"):
+        await update_taxonomy(
+            UpdateTaxonomyInput(
+                kind="relationship-types",
+                item_id=str(created["id"]),
+                source_entity_types=["starship"],
+            ),
+            mock_mcp_context,
+        )
+
+    with pytest.raises(ValueError, match="only valid for relationship-types"):
+        CreateTaxonomyInput(kind="scopes", name="team", inverse_name="x")
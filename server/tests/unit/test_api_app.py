@@ -18,7 +18,10 @@ pytestmark = pytest.mark.unit
 async def test_health_endpoint_returns_ok():
     """Health handler should return canonical ok payload."""
 
-    assert await app_mod.health() == {"status": "ok"}
+    assert await app_mod.health() == {
+        "status": "ok",
+        "version": app_mod.API_VERSION,
+    }
 
 
 @pytest.mark.asyncio
@@ -66,6 +69,7 @@ def test_app_has_expected_api_prefixes():
     expected_prefixes = {
         "/api/entities",
         "/api/audit",
+        "/api/collections",
         "/api/context",
         "/api/relationships",
         "/api/jobs",
@@ -2,6 +2,7 @@
 
 # Standard Library
 import json
+from datetime import datetime, timedelta, timezone
 from types import SimpleNamespace
 from unittest.mock import AsyncMock
 from uuid import uuid4
@@ -274,6 +275,102 @@ async def test_require_auth_agent_owner_returns_agent_context(monkeypatch):
     pool.execute.assert_awaited_once()
 
 
+@pytest.mark.asyncio
+async def test_require_auth_expired_agent_permissions_raise_401(monkeypatch):
+    """Agents past their permission expiry should be rejected."""
+
+    key_row = {
+        "id": str(uuid4()),
+        "key_hash": "hash",
+        "entity_id": None,
+        "agent_id": str(uuid4()),
+        "scopes": None,
+    }
+    agent_row = {
+        "id": key_row["agent_id"],
+        "scopes": ["public"],
+        "permissions_expire_at": datetime.now(timezone.utc) - timedelta(minutes=1),
+    }
+    pool = _pool_stub(fetchrow_side_effect=[key_row, agent_row])
+    request = _request_with_pool(pool, "nbl_1234567890")
+
+    monkeypatch.setattr(
+        "nebula_api.auth.ph",
+        SimpleNamespace(verify=lambda *_args: True),
+    )
+
+    with pytest.raises(HTTPException) as exc:
+        await require_auth(request)
+
+    assert exc.value.status_code == 401
+    assert exc.value.detail == "Agent permissions expired"
+
+
+@pytest.mark.asyncio
+async def test_require_auth_agent_rate_limit_raises_429(monkeypatch):
+    """Agents over their per-minute limit should get 429."""
+
+    key_row = {
+        "id": str(uuid4()),
+        "key_hash": "hash",
+        "entity_id": None,
+        "agent_id": str(uuid4()),
+        "scopes": None,
+    }
+    agent_row = {
+        "id": key_row["agent_id"],
+        "scopes": ["public"],
+        "rate_limit_per_minute": 1,
+    }
+    pool = _pool_stub(fetchrow_side_effect=[key_row, agent_row, key_row, agent_row])
+
+    monkeypatch.setattr(
+        "nebula_api.auth.ph",
+        SimpleNamespace(verify=lambda *_args: True),
+    )
+
+    result = await require_auth(_request_with_pool(pool, "nbl_1234567890"))
+    assert result["caller_type"] == "agent"
+
+    with pytest.raises(HTTPException) as exc:
+        await require_auth(_request_with_pool(pool, "nbl_1234567890"))
+
+    assert exc.value.status_code == 429
+    assert exc.value.detail["error"]["code"] == "RATE_LIMITED"
+
+
+@pytest.mark.asyncio
+async def test_maybe_check_agent_approval_disallowed_request_type_raises_403():
+    """Agents limited to some request types should be refused others."""
+
+    auth = {
+        "caller_type": "agent",
+        "agent": {
+            "id": str(uuid4()),
+            "requires_approval": False,
+            "allowed_request_types": ["create_log"],
+        },
+    }
+
+    with pytest.raises(HTTPException) as exc:
+        await maybe_check_agent_approval(
+            pool=SimpleNamespace(),
+            auth=auth,
+            action="create_entity",
+            payload={"name": "x"},
+        )
+
+    assert exc.value.status_code == 403
+
+    result = await maybe_check_agent_approval(
+        pool=SimpleNamespace(),
+        auth=auth,
+        action="create_log",
+        payload={"value": 1},
+    )
+    assert result is None
+
+
 @pytest.mark.asyncio
 async def test_maybe_check_agent_approval_rate_limited_returns_429(monkeypatch):
     """Approval-capacity failures should map to explicit 429 response."""
@@ -2,6 +2,7 @@
 
 # Standard Library
 import json
+from datetime import datetime, timedelta, timezone
 
 # Third-Party
 from unittest.mock import MagicMock, patch
@@ -10,6 +11,7 @@ from uuid import uuid4
 import pytest
 
 from nebula_mcp.context import (
+    AgentPermissionError,
     _env_truthy,
     _get_or_create_local_insecure_agent,
     _local_insecure_agent_name,
@@ -576,3 +578,77 @@ class TestMaybeRequireApproval:
         assert result is not None
         assert result["approval_request_id"] is None
         assert result["requested_action"] == "update_entity"
+
+
+# --- agent permissions ---
+
+
+class TestAgentPermissions:
+    """MCP auth paths apply agent expiry, rate limits, and request types."""
+
+    async def test_require_context_rejects_expired_permissions(
+        self, mock_context, mock_pool, mock_agent
+    ):
+        """An agent whose permissions expired mid-session is refused."""
+
+        mock_pool.fetchrow.return_value = {
+            **mock_agent,
+            "permissions_expire_at": datetime.now(timezone.utc)
+            - timedelta(minutes=1),
+        }
+
+        with pytest.raises(AgentPermissionError, match="expired") as exc:
+            await require_context(mock_context)
+        assert exc.value.code == "PERMISSIONS_EXPIRED"
+
+    @patch("argon2.PasswordHasher.verify")
+    async def test_authenticate_rejects_expired_permissions(self, _verify, mock_pool):
+        """Expired agents cannot start an MCP session."""
+
+        agent_id = str(uuid4())
+        mock_pool.fetchrow.side_effect = [
+            {"key_hash": "hash", "agent_id": agent_id},
+            {
+                "id": agent_id,
+                "name": "agent-1",
+                "permissions_expire_at": datetime.now(timezone.utc)
+                - timedelta(seconds=1),
+            },
+        ]
+
+        with pytest.raises(AgentPermissionError, match="expired"):
+            await authenticate_agent_with_key(mock_pool, "nbl_abcdef123456")
+
+    async def test_require_context_rate_limits_tool_calls(
+        self, mock_context, mock_pool, mock_agent
+    ):
+        """Tool calls past rate_limit_per_minute are refused."""
+
+        mock_pool.fetchrow.return_value = {
+            **mock_agent,
+            "id": uuid4(),
+            "rate_limit_per_minute": 1,
+        }
+
+        await require_context(mock_context)
+        with pytest.raises(AgentPermissionError, match="1 requests per minute") as exc:
+            await require_context(mock_context)
+        assert exc.value.code == "RATE_LIMITED"
+
+    async def test_maybe_require_approval_rejects_disallowed_type(
+        self, mock_pool, mock_agent
+    ):
+        """Agents limited to some request types are refused others."""
+
+        agent = {**mock_agent, "allowed_request_types": ["create_log"]}
+
+        with pytest.raises(AgentPermissionError, match="create_entity") as exc:
+            await maybe_require_approval(
+                mock_pool, agent, "create_entity", {"name": "test"}
+            )
+        assert exc.value.code == "FORBIDDEN"
+
+        result = await maybe_require_approval(
+            mock_pool, agent, "create_log", {"value": {}}
+        )
+        assert result is None
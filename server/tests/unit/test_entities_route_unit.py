@@ -140,6 +140,31 @@ async def test_revert_entity_sets_and_resets_runtime_markers(monkeypatch, mock_e
     assert conn.execute.await_count == 4
 
 
+@pytest.mark.asyncio
+async def test_revert_entity_passes_fields_and_maps_errors(monkeypatch, mock_enums):
+    """Revert should forward the field selection and map bad input to 400."""
+
+    entity_id = str(uuid4())
+    audit_id = str(uuid4())
+    auth = {"caller_type": "user", "entity_id": uuid4()}
+    conn = SimpleNamespace(execute=AsyncMock())
+    pool = SimpleNamespace(acquire=MagicMock(return_value=_AcquireCtx(conn)))
+    revert = AsyncMock(side_effect=ValueError("Cannot revert fields: id"))
+    monkeypatch.setattr("nebula_api.routes.entities.do_revert_entity", revert)
+
+    with pytest.raises(HTTPException) as exc:
+        await revert_entity(
+            entity_id,
+            RevertEntityBody(audit_id=audit_id, fields=["id"]),
+            _request(pool, mock_enums),
+            auth=auth,
+        )
+
+    assert exc.value.status_code == 400
+    revert.assert_awaited_once_with(conn, entity_id, audit_id, ["id"])
+    assert conn.execute.await_count == 4
+
+
 @pytest.mark.asyncio
 async def test_update_entity_agent_scope_subset_error_maps_400(
     monkeypatch, mock_enums
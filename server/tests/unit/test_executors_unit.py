@@ -451,6 +451,60 @@ async def test_execute_create_relationship_rejects_self_reference(mock_enums):
         )
 
 
+@pytest.mark.asyncio
+async def test_execute_create_relationship_rejects_disallowed_entity_type(mock_enums):
+    """Relationship type allow-lists should reject other entity types."""
+
+    pool = _PoolStub(
+        fetch_rows=[[{"source_entity_types": ["project"], "target_entity_types": []}]],
+        fetchrow_rows=[
+            {"type_id": mock_enums.entity_types.name_to_id["person"], "metadata": {}}
+        ],
+    )
+
+    with pytest.raises(ValueError, match="does not allow person as source"):
+        await executors.execute_create_relationship(
+            pool,
+            mock_enums,
+            {
+                "source_type": "entity",
+                "source_id": str(uuid4()),
+                "target_type": "entity",
+                "target_id": str(uuid4()),
+                "relationship_type": "related-to",
+            },
+        )
+
+
+@pytest.mark.asyncio
+async def test_execute_create_relationship_allows_listed_entity_type(mock_enums):
+    """Entities matching the allow-list should pass through to create."""
+
+    relationship_id = str(uuid4())
+    pool = _PoolStub(
+        fetch_rows=[[{"source_entity_types": ["project"], "target_entity_types": []}]],
+        fetchrow_rows=[
+            {"type_id": mock_enums.entity_types.name_to_id["project"], "metadata": {}},
+            {"id": relationship_id, "metadata": "{}"},
+        ],
+    )
+
+    result = await executors.execute_create_relationship(
+        pool,
+        mock_enums,
+        {
+            "source_type": "entity",
+            "source_id": str(uuid4()),
+            "target_type": "entity",
+            "target_id": str(uuid4()),
+            "relationship_type": "related-to",
+        },
+    )
+
+    assert result["id"] == relationship_id
+    assert len(pool.fetchrow_calls) == 2
+
+
 @pytest.mark.asyncio
 async def test_execute_create_relationship_duplicate_maps_value_error(
     monkeypatch, mock_enums
@@ -9,12 +9,14 @@ from nebula_mcp.imports import (
     coerce_list,
     coerce_text,
     extract_items,
+    import_external_id,
     merge_defaults,
     normalize_context,
     normalize_entity,
     normalize_job,
     normalize_relationship,
     parse_csv_rows,
+    parse_markdown_items,
 )
 
 
@@ -94,7 +96,7 @@ def test_parse_csv_rows_skips_empty_rows():
 def test_extract_items_rejects_invalid_format():
     """Unsupported import formats should raise clear errors."""
 
-    with pytest.raises(ValueError, match="Format must be json or csv"):
+    with pytest.raises(ValueError, match="Format must be json, csv, or markdown"):
         extract_items("yaml", None, None)
 
 
@@ -133,6 +135,32 @@ def test_extract_items_returns_json_items():
     assert extract_items("json", None, items) == items
 
 
+def test_extract_items_parses_json_data_string():
+    """JSON mode should accept the raw document when items are not sent."""
+
+    assert extract_items("json", '[{"name": "alpha"}]', None) == [{"name": "alpha"}]
+    assert extract_items("json", '{"items": [{"name": "beta"}]}', None) == [
+        {"name": "beta"}
+    ]
+    with pytest.raises(ValueError, match="Invalid JSON data"):
+        extract_items("json", "[{", None)
+
+
+def test_parse_markdown_items_splits_headings_and_applies_front_matter():
+    """Markdown imports should make one context item per top-level heading."""
+
+    data = "---\ntags: ml, papers\nsource_type: paper\n---\n# First\nalpha\n\n# Second\nbeta\n"
+    assert parse_markdown_items(data) == [
+        {"source_type": "paper", "tags": "ml, papers", "title": "First", "content": "alpha"},
+        {"source_type": "paper", "tags": "ml, papers", "title": "Second", "content": "beta"},
+    ]
+    assert parse_markdown_items("---\ntitle: Notes\n---\njust text") == [
+        {"source_type": "note", "title": "Notes", "content": "just text"}
+    ]
+    with pytest.raises(ValueError, match="Markdown data is empty"):
+        extract_items("markdown", "", None)
+
+
 def test_merge_defaults_without_defaults_returns_copy():
     """Missing defaults should still return a copied mapping."""
 
@@ -228,3 +256,12 @@ def test_normalize_job_defaults_priority():
 
     result = normalize_job({"title": "ship it"}, None)
     assert result["priority"] == "medium"
+
+
+def test_import_external_id_ignores_defaults_and_blanks():
+    """External IDs come from the row itself, trimmed."""
+
+    assert import_external_id({"external_id": " crm-42 "}) == "crm-42"
+    assert import_external_id({"external_id": 42}) == "42"
+    assert import_external_id({"external_id": "  "}) is None
+    assert import_external_id({"name": "x"}) is None
@@ -851,11 +851,19 @@ class TestModelEdgeInputs:
             CreateTaxonomyInput(kind="scopes", name="public", is_symmetric=True)
 
     def test_create_taxonomy_rejects_value_schema_for_non_log_type(self):
-        """Create taxonomy should gate value_schema to log-types."""
+        """Create taxonomy should gate value_schema to entity and log types."""
 
-        with pytest.raises(ValidationError, match="value_schema is only valid for log-types"):
+        with pytest.raises(ValidationError, match="value_schema is only valid for"):
             CreateTaxonomyInput(kind="scopes", name="public", value_schema={"a": "b"})
 
+    def test_create_taxonomy_accepts_value_schema_for_entity_type(self):
+        """Entity types may declare a metadata value_schema."""
+
+        model = CreateTaxonomyInput(
+            kind="entity-types", name="project", value_schema={"required": ["owner"]}
+        )
+        assert model.value_schema == {"required": ["owner"]}
+
     def test_update_taxonomy_rejects_is_symmetric_for_non_relationship_kind(self):
         """Update taxonomy should gate is_symmetric to relationship-types."""
 
@@ -869,9 +877,9 @@ class TestModelEdgeInputs:
             )
 
     def test_update_taxonomy_rejects_value_schema_for_non_log_type(self):
-        """Update taxonomy should gate value_schema to log-types."""
+        """Update taxonomy should gate value_schema to entity and log types."""
 
-        with pytest.raises(ValidationError, match="value_schema is only valid for log-types"):
+        with pytest.raises(ValidationError, match="value_schema is only valid for"):
             UpdateTaxonomyInput(
                 kind="scopes",
                 item_id="scope-1",
@@ -157,11 +157,12 @@ def test_validate_taxonomy_payload_support_matrix():
     _validate_taxonomy_payload("relationship-types", is_symmetric=True, value_schema=None)
     _validate_taxonomy_payload("log-types", is_symmetric=None, value_schema={"type": "object"})
     _validate_taxonomy_payload("scopes", is_symmetric=None, value_schema=None)
+    _validate_taxonomy_payload("entity-types", is_symmetric=None, value_schema={})
 
     with pytest.raises(ValueError, match="is_symmetric is only valid"):
         _validate_taxonomy_payload("scopes", is_symmetric=True, value_schema=None)
     with pytest.raises(ValueError, match="value_schema is only valid"):
-        _validate_taxonomy_payload("entity-types", is_symmetric=None, value_schema={})
+        _validate_taxonomy_payload("scopes", is_symmetric=None, value_schema={})
 
 
 def test_admin_detection_and_scope_filters(mock_enums):
@@ -12,6 +12,7 @@ from fastapi import HTTPException
 # Local
 from nebula_api.routes.taxonomy import (
     KIND_MAP,
+    ScopeMergeBody,
     TaxonomyCreateBody,
     TaxonomyUpdateBody,
     _kind_or_error,
@@ -20,6 +21,7 @@ from nebula_api.routes.taxonomy import (
     _validate_payload,
     activate_taxonomy,
     create_taxonomy,
+    merge_scope,
     update_taxonomy,
 )
 
@@ -80,7 +82,7 @@ def test_validate_payload_rejects_is_symmetric_for_scopes():
 
 
 def test_validate_payload_rejects_value_schema_for_scopes():
-    """Non-log kinds should reject value_schema fields."""
+    """Kinds without schemas should reject value_schema fields."""
 
     payload = TaxonomyCreateBody(name="x", value_schema={"k": "v"})
     with pytest.raises(HTTPException) as exc:
@@ -252,3 +254,26 @@ async def test_activate_taxonomy_missing_row_maps_404(mock_enums):
 
     assert exc.value.status_code == 404
     assert exc.value.detail["error"]["code"] == "NOT_FOUND"
+
+
+@pytest.mark.asyncio
+async def test_merge_scope_missing_target_maps_404(monkeypatch, mock_enums):
+    """Merging into a missing scope should return NOT_FOUND."""
+
+    source = {"id": uuid4(), "name": "old", "is_builtin": False, "is_active": True}
+    monkeypatch.setattr(
+        "nebula_api.routes.taxonomy._fetch_taxonomy_row",
+        AsyncMock(side_effect=[source, None]),
+    )
+    pool = SimpleNamespace(acquire=AsyncMock())
+
+    with pytest.raises(HTTPException) as exc:
+        await merge_scope(
+            str(source["id"]),
+            ScopeMergeBody(target_id=str(uuid4())),
+            _request(pool, mock_enums),
+            auth=_admin_auth(mock_enums),
+        )
+
+    assert exc.value.status_code == 404
+    pool.acquire.assert_not_called()